@@ -39,6 +39,7 @@ type IntrospectionConfig struct {
 	MachineLock        machinelock.Lock
 	PrometheusGatherer prometheus.Gatherer
 	FlightRecorder     flightrecorder.FlightRecorder
+	ChangeStream       introspection.ChangeStream
 
 	Clock  clock.Clock
 	Logger logger.Logger
@@ -65,6 +66,7 @@ func StartIntrospection(cfg IntrospectionConfig) error {
 		MachineLock:        cfg.MachineLock,
 		PrometheusGatherer: cfg.PrometheusGatherer,
 		FlightRecorder:     cfg.FlightRecorder,
+		ChangeStream:       cfg.ChangeStream,
 		// TODO(leases) - add lease introspection
 	})
 	if err != nil {
@@ -43,6 +43,7 @@ import (
 	"github.com/juju/juju/internal/cloudconfig/instancecfg"
 	"github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/password"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -239,7 +240,7 @@ func (b *AgentBootstrap) Initialize(ctx context.Context) (resultErr error) {
 		cloudbootstrap.InsertCloud(user.NameFromTag(b.adminUser), stateParams.ControllerCloud),
 		credbootstrap.InsertCredential(credential.KeyFromTag(cloudCredTag), cloudCred),
 		modeldefaultsbootstrap.SetCloudDefaults(stateParams.ControllerCloud.Name, stateParams.ControllerInheritedConfig),
-		secretbackendbootstrap.CreateDefaultBackends(modelType),
+		secretbackendbootstrap.CreateDefaultBackends(modelType, encryption.NewFileKeyStore(agentConfig.DataDir())),
 		controllerModelCreateFunc,
 		localModelRecordOp,
 		modelbootstrap.SetModelConstraints(stateParams.ModelConstraints),
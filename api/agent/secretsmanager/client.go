@@ -16,6 +16,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	coresecrets "github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/secrets"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -393,3 +394,90 @@ func (c *Client) OwnedSecretRevisions(ctx context.Context, unit names.UnitTag, u
 	}
 	return result.Revisions, nil
 }
+
+// GetBackendContent returns the content of a secret revision held in a
+// backend which agents access through the controller.
+func (c *Client) GetBackendContent(ctx context.Context, backendID, token, revisionId string) (coresecrets.SecretValue, error) {
+	var results params.SecretValueResults
+	args := params.BackendContentArgs{
+		Args: []params.BackendContentArg{{
+			BackendID:  backendID,
+			Token:      token,
+			RevisionID: revisionId,
+		}},
+	}
+	err := c.facade.FacadeCall(ctx, "GetBackendContent", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, backendContentError(result.Error)
+	}
+	return coresecrets.NewSecretValue(result.Data), nil
+}
+
+// SaveBackendContent saves the content of a secret revision to a backend
+// which agents access through the controller.
+func (c *Client) SaveBackendContent(
+	ctx context.Context, backendID, token string, uri *coresecrets.URI, revision int, value coresecrets.SecretValue,
+) (string, error) {
+	var results params.StringResults
+	args := params.BackendContentArgs{
+		Args: []params.BackendContentArg{{
+			BackendID: backendID,
+			Token:     token,
+			URI:       uri.String(),
+			Revision:  revision,
+			Data:      value.EncodedValues(),
+		}},
+	}
+	err := c.facade.FacadeCall(ctx, "SaveBackendContent", args, &results)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", backendContentError(result.Error)
+	}
+	return result.Result, nil
+}
+
+// DeleteBackendContent deletes the content of a secret revision from a
+// backend which agents access through the controller.
+func (c *Client) DeleteBackendContent(ctx context.Context, backendID, token, revisionId string) error {
+	var results params.ErrorResults
+	args := params.BackendContentArgs{
+		Args: []params.BackendContentArg{{
+			BackendID:  backendID,
+			Token:      token,
+			RevisionID: revisionId,
+		}},
+	}
+	err := c.facade.FacadeCall(ctx, "DeleteBackendContent", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return backendContentError(err)
+	}
+	return nil
+}
+
+// backendContentError returns the error for a backend content result, such
+// that callers can test for denied access and missing content.
+func backendContentError(err *params.Error) error {
+	if params.IsCodeUnauthorized(err) {
+		return errors.WithType(err, secrets.PermissionDenied)
+	}
+	return params.TranslateWellKnownError(err)
+}
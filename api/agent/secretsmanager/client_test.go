@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/api/agent/secretsmanager"
 	"github.com/juju/juju/api/base/testing"
 	coresecrets "github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/internal/secrets"
 	"github.com/juju/juju/internal/secrets/provider"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -715,3 +716,94 @@ func (s *SecretsSuite) TestOwnedSecretRevisions(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Check(revs, tc.DeepEquals, []int{1, 2, 3, 4, 5})
 }
+
+func (s *SecretsSuite) TestGetBackendContent(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "SecretsManager")
+		c.Check(version, tc.Equals, 0)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "GetBackendContent")
+		c.Check(arg, tc.DeepEquals, params.BackendContentArgs{
+			Args: []params.BackendContentArg{{
+				BackendID:  "backend-id",
+				Token:      "token",
+				RevisionID: "rev-id",
+			}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.SecretValueResults{})
+		*(result.(*params.SecretValueResults)) = params.SecretValueResults{
+			Results: []params.SecretValueResult{{
+				Data: map[string]string{"foo": "bar"},
+			}},
+		}
+		return nil
+	})
+	client := secretsmanager.NewClient(apiCaller)
+	val, err := client.GetBackendContent(c.Context(), "backend-id", "token", "rev-id")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "bar"})
+}
+
+func (s *SecretsSuite) TestGetBackendContentPermissionDenied(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		*(result.(*params.SecretValueResults)) = params.SecretValueResults{
+			Results: []params.SecretValueResult{{
+				Error: &params.Error{Code: params.CodeUnauthorized, Message: "permission denied"},
+			}},
+		}
+		return nil
+	})
+	client := secretsmanager.NewClient(apiCaller)
+	_, err := client.GetBackendContent(c.Context(), "backend-id", "token", "rev-id")
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+}
+
+func (s *SecretsSuite) TestSaveBackendContent(c *tc.C) {
+	uri := coresecrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "SecretsManager")
+		c.Check(request, tc.Equals, "SaveBackendContent")
+		c.Check(arg, tc.DeepEquals, params.BackendContentArgs{
+			Args: []params.BackendContentArg{{
+				BackendID: "backend-id",
+				Token:     "token",
+				URI:       uri.String(),
+				Revision:  2,
+				Data:      map[string]string{"foo": "bar"},
+			}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.StringResults{})
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{{Result: "rev-id"}},
+		}
+		return nil
+	})
+	client := secretsmanager.NewClient(apiCaller)
+	revId, err := client.SaveBackendContent(c.Context(), "backend-id", "token", uri, 2, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(revId, tc.Equals, "rev-id")
+}
+
+func (s *SecretsSuite) TestDeleteBackendContent(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "SecretsManager")
+		c.Check(request, tc.Equals, "DeleteBackendContent")
+		c.Check(arg, tc.DeepEquals, params.BackendContentArgs{
+			Args: []params.BackendContentArg{{
+				BackendID:  "backend-id",
+				Token:      "token",
+				RevisionID: "rev-id",
+			}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{
+				Error: &params.Error{Code: params.CodeSecretRevisionNotFound, Message: "not found"},
+			}},
+		}
+		return nil
+	})
+	client := secretsmanager.NewClient(apiCaller)
+	err := client.DeleteBackendContent(c.Context(), "backend-id", "token", "rev-id")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
@@ -89,6 +89,24 @@ func (st *Client) WatchFilesystems(ctx context.Context, scope names.Tag) (watche
 	return st.watchStorageEntities(ctx, "WatchFilesystems", scope)
 }
 
+// WatchVolumeResizes watches for volumes scoped to the entity with the
+// specified tag, that need to be grown to their requested size.
+func (st *Client) WatchVolumeResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error) {
+	return st.watchStorageEntities(ctx, "WatchVolumeResizes", scope)
+}
+
+// WatchFilesystemResizes watches for filesystems scoped to the entity with
+// the specified tag, that need to be grown to their requested size.
+func (st *Client) WatchFilesystemResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error) {
+	return st.watchStorageEntities(ctx, "WatchFilesystemResizes", scope)
+}
+
+// WatchStorageSnapshots watches for snapshots of volumes scoped to the
+// entity with the specified tag, that are ready to be taken.
+func (st *Client) WatchStorageSnapshots(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error) {
+	return st.watchStorageEntities(ctx, "WatchStorageSnapshots", scope)
+}
+
 func (st *Client) watchStorageEntities(ctx context.Context, method string, scope names.Tag) (watcher.StringsWatcher, error) {
 	var results params.StringsWatchResults
 	args := params.Entities{
@@ -381,6 +399,35 @@ func (st *Client) SetVolumeInfo(ctx context.Context, volumes []params.Volume) ([
 	return results.Results, nil
 }
 
+// StorageSnapshotParams returns the parameters for taking the storage
+// snapshots with the specified ids.
+func (st *Client) StorageSnapshotParams(ctx context.Context, ids []string) ([]params.StorageSnapshotParamsResult, error) {
+	args := params.StorageSnapshotIds{Ids: ids}
+	var results params.StorageSnapshotParamsResults
+	err := st.facade.FacadeCall(ctx, "StorageSnapshotParams", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(ids) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(ids), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// SetStorageSnapshotResults records the outcome of taking storage snapshots.
+func (st *Client) SetStorageSnapshotResults(ctx context.Context, snapshots []params.StorageSnapshotResult) ([]params.ErrorResult, error) {
+	args := params.StorageSnapshotResults{Results: snapshots}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall(ctx, "SetStorageSnapshotResults", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(snapshots) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(snapshots), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // SetFilesystemInfo records the details of newly provisioned filesystems.
 func (st *Client) SetFilesystemInfo(ctx context.Context, filesystems []params.Filesystem) ([]params.ErrorResult, error) {
 	args := params.Filesystems{Filesystems: filesystems}
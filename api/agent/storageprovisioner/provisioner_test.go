@@ -80,6 +80,155 @@ func (s *provisionerSuite) TestWatchFilesystems(c *tc.C) {
 	c.Check(callCount, tc.Equals, 1)
 }
 
+func (s *provisionerSuite) TestWatchVolumeResizes(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 0)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "WatchVolumeResizes")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-123"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.StringsWatchResults{})
+		*(result.(*params.StringsWatchResults)) = params.StringsWatchResults{
+			Results: []params.StringsWatchResult{{
+				Error: &params.Error{Message: "FAIL"},
+			}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.WatchVolumeResizes(c.Context(), names.NewMachineTag("123"))
+	c.Check(err, tc.ErrorMatches, "FAIL")
+	c.Check(callCount, tc.Equals, 1)
+}
+
+func (s *provisionerSuite) TestWatchFilesystemResizes(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 0)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "WatchFilesystemResizes")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-123"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.StringsWatchResults{})
+		*(result.(*params.StringsWatchResults)) = params.StringsWatchResults{
+			Results: []params.StringsWatchResult{{
+				Error: &params.Error{Message: "FAIL"},
+			}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.WatchFilesystemResizes(c.Context(), names.NewMachineTag("123"))
+	c.Check(err, tc.ErrorMatches, "FAIL")
+	c.Check(callCount, tc.Equals, 1)
+}
+
+func (s *provisionerSuite) TestWatchStorageSnapshots(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 0)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "WatchStorageSnapshots")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-123"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.StringsWatchResults{})
+		*(result.(*params.StringsWatchResults)) = params.StringsWatchResults{
+			Results: []params.StringsWatchResult{{
+				Error: &params.Error{Message: "FAIL"},
+			}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.WatchStorageSnapshots(c.Context(), names.NewMachineTag("123"))
+	c.Check(err, tc.ErrorMatches, "FAIL")
+	c.Check(callCount, tc.Equals, 1)
+}
+
+func (s *provisionerSuite) TestStorageSnapshotParams(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 0)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "StorageSnapshotParams")
+		c.Check(arg, tc.DeepEquals, params.StorageSnapshotIds{Ids: []string{"0"}})
+		c.Assert(result, tc.FitsTypeOf, &params.StorageSnapshotParamsResults{})
+		*(result.(*params.StorageSnapshotParamsResults)) = params.StorageSnapshotParamsResults{
+			Results: []params.StorageSnapshotParamsResult{{
+				Result: params.StorageSnapshotParams{
+					Id:        "0",
+					VolumeTag: "volume-100",
+					VolumeId:  "vol-100",
+					Provider:  "loop",
+				},
+			}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	results, err := st.StorageSnapshotParams(c.Context(), []string{"0"})
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(callCount, tc.Equals, 1)
+	c.Check(results, tc.DeepEquals, []params.StorageSnapshotParamsResult{{
+		Result: params.StorageSnapshotParams{
+			Id:        "0",
+			VolumeTag: "volume-100",
+			VolumeId:  "vol-100",
+			Provider:  "loop",
+		},
+	}})
+}
+
+func (s *provisionerSuite) TestSetStorageSnapshotResults(c *tc.C) {
+	snapshots := []params.StorageSnapshotResult{{
+		Id:         "0",
+		ProviderId: "snap-0",
+		SizeMiB:    1024,
+	}}
+
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 0)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "SetStorageSnapshotResults")
+		c.Check(arg, tc.DeepEquals, params.StorageSnapshotResults{Results: snapshots})
+		c.Assert(result, tc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: nil}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	errorResults, err := st.SetStorageSnapshotResults(c.Context(), snapshots)
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(callCount, tc.Equals, 1)
+	c.Check(errorResults, tc.HasLen, 1)
+}
+
 func (s *provisionerSuite) TestWatchVolumeAttachments(c *tc.C) {
 	var callCount int
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
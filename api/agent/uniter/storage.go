@@ -178,3 +178,79 @@ func (sa *StorageAccessor) RemoveStorageAttachment(ctx context.Context, storageT
 	}
 	return nil
 }
+
+// WatchUnitStorageSnapshots starts a watcher that notifies when a storage
+// snapshot in the model changes, so that the unit can check for snapshots
+// waiting on its storage snapshot hooks.
+func (sa *StorageAccessor) WatchUnitStorageSnapshots(ctx context.Context, unitTag names.UnitTag) (watcher.NotifyWatcher, error) {
+	if sa.facade.BestAPIVersion() < 23 {
+		// WatchUnitStorageSnapshots() was introduced in UniterAPIV23.
+		return nil, errors.NotImplementedf("WatchUnitStorageSnapshots() (need V23+)")
+	}
+	var results params.NotifyWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: unitTag.String()}},
+	}
+	err := sa.facade.FacadeCall(ctx, "WatchUnitStorageSnapshots", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewNotifyWatcher(sa.facade.RawAPICaller(), result)
+	return w, nil
+}
+
+// UnitStorageSnapshots returns the storage snapshots that are waiting on
+// the unit to run one of its storage snapshot hooks.
+func (sa *StorageAccessor) UnitStorageSnapshots(ctx context.Context, unitTag names.UnitTag) ([]params.UnitStorageSnapshot, error) {
+	if sa.facade.BestAPIVersion() < 23 {
+		// UnitStorageSnapshots() was introduced in UniterAPIV23.
+		return nil, errors.NotImplementedf("UnitStorageSnapshots() (need V23+)")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: unitTag.String()}},
+	}
+	var results params.UnitStorageSnapshotsResults
+	err := sa.facade.FacadeCall(ctx, "UnitStorageSnapshots", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Snapshots, nil
+}
+
+// SetStorageSnapshotHookRun records that the unit has run the storage
+// snapshot hook the snapshot with the specified id was waiting on.
+func (sa *StorageAccessor) SetStorageSnapshotHookRun(ctx context.Context, unitTag names.UnitTag, id string) error {
+	var results params.ErrorResults
+	args := params.StorageSnapshotHookRunArgs{
+		Args: []params.StorageSnapshotHookRunArg{{
+			UnitTag: unitTag.String(),
+			Id:      id,
+		}},
+	}
+	err := sa.facade.FacadeCall(ctx, "SetStorageSnapshotHookRun", args, &results)
+	if err != nil {
+		return err
+	}
+	if len(results.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
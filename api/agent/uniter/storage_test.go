@@ -259,3 +259,72 @@ func (s *storageSuite) TestRemoveStorageAttachment(c *tc.C) {
 	err := client.RemoveStorageAttachment(c.Context(), names.NewStorageTag("data/0"), names.NewUnitTag("mysql/0"))
 	c.Check(err, tc.ErrorMatches, "yoink")
 }
+
+func (s *storageSuite) TestUnitStorageSnapshots(c *tc.C) {
+	snapshots := []params.UnitStorageSnapshot{{
+		Id:         "1",
+		StorageTag: "storage-data-0",
+		Status:     "pending",
+	}}
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "Uniter")
+		c.Check(version, tc.Equals, 23)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "UnitStorageSnapshots")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "unit-mysql-0"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.UnitStorageSnapshotsResults{})
+		*(result.(*params.UnitStorageSnapshotsResults)) = params.UnitStorageSnapshotsResults{
+			Results: []params.UnitStorageSnapshotsResult{{
+				Snapshots: snapshots,
+			}},
+		}
+		return nil
+	})
+
+	caller := testing.BestVersionCaller{apiCaller, 23}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+	results, err := client.UnitStorageSnapshots(c.Context(), names.NewUnitTag("mysql/0"))
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, snapshots)
+}
+
+func (s *storageSuite) TestUnitStorageSnapshotsNotImplemented(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+
+	caller := testing.BestVersionCaller{apiCaller, 22}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+	_, err := client.UnitStorageSnapshots(c.Context(), names.NewUnitTag("mysql/0"))
+	c.Check(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *storageSuite) TestSetStorageSnapshotHookRun(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "Uniter")
+		c.Check(version, tc.Equals, 23)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "SetStorageSnapshotHookRun")
+		c.Check(arg, tc.DeepEquals, params.StorageSnapshotHookRunArgs{
+			Args: []params.StorageSnapshotHookRunArg{{
+				UnitTag: "unit-mysql-0",
+				Id:      "1",
+			}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{
+				Error: &params.Error{Message: "yoink"},
+			}},
+		}
+		return nil
+	})
+
+	caller := testing.BestVersionCaller{apiCaller, 23}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+	err := client.SetStorageSnapshotHookRun(c.Context(), names.NewUnitTag("mysql/0"), "1")
+	c.Check(err, tc.ErrorMatches, "yoink")
+}
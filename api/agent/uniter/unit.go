@@ -676,6 +676,18 @@ func (u *Unit) WatchStorage(ctx context.Context) (watcher.StringsWatcher, error)
 	return u.client.WatchUnitStorageAttachments(ctx, u.tag)
 }
 
+// WatchStorageSnapshots returns a watcher for observing changes to the
+// storage snapshots that may be waiting on the unit's storage snapshot hooks.
+func (u *Unit) WatchStorageSnapshots(ctx context.Context) (watcher.NotifyWatcher, error) {
+	return u.client.WatchUnitStorageSnapshots(ctx, u.tag)
+}
+
+// StorageSnapshots returns the storage snapshots that are waiting on the
+// unit to run one of its storage snapshot hooks.
+func (u *Unit) StorageSnapshots(ctx context.Context) ([]params.UnitStorageSnapshot, error) {
+	return u.client.UnitStorageSnapshots(ctx, u.tag)
+}
+
 // WatchInstanceData returns a watcher for observing changes to the
 // instanceData of the unit's machine.  Primarily used for watching
 // LXDProfile changes.
@@ -770,6 +782,11 @@ func (u *Unit) SetState(ctx context.Context, unitState params.SetUnitStateArg) e
 	return u.client.SetState(ctx, unitState)
 }
 
+// RecordHookHistory records the input entries in the unit's hook history.
+func (u *Unit) RecordHookHistory(ctx context.Context, entries []params.HookHistoryEntry) error {
+	return u.client.RecordHookHistory(ctx, entries)
+}
+
 // CommitHookChanges batches together all required API calls for applying
 // a set of changes after a hook successfully completes and executes them in a
 // single transaction.
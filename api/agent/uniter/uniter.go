@@ -6,6 +6,7 @@ package uniter
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/juju/collections/transform"
 	"github.com/juju/errors"
@@ -602,6 +603,9 @@ type UnitContext struct {
 	OpenedPortRangesByEndpoint map[names.UnitTag]network.GroupedPortRanges
 	// CharmTracingConfig contains the tracing configuration for the charm.
 	CharmTracingConfig CharmTracingConfig
+	// HookTimeout is how long a hook may run before it is killed. A zero
+	// value means that hooks are not timed out.
+	HookTimeout time.Duration
 }
 
 // GetUnitContext returns context information required for the construction of a
@@ -639,6 +643,7 @@ func decodeUnitContext(paramsUnitContext params.UnitContext) (UnitContext, error
 			GRPCEndpoint:  paramsUnitContext.CharmTracingConfig.GRPCEndpoint,
 			CACertificate: paramsUnitContext.CharmTracingConfig.CACertificate,
 		},
+		HookTimeout: paramsUnitContext.HookTimeout,
 	}, nil
 }
 
@@ -5,6 +5,7 @@ package uniter_test
 
 import (
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -258,6 +259,23 @@ func (s *uniterSuite) TestGetUnitContextDecodesCharmTracingConfig(c *tc.C) {
 	})
 }
 
+func (s *uniterSuite) TestGetUnitContextDecodesHookTimeout(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Uniter")
+		c.Assert(request, tc.Equals, "GetUnitContext")
+		*(result.(*params.UnitContext)) = params.UnitContext{
+			HookTimeout: 30 * time.Minute,
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 22}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+
+	result, err := client.GetUnitContext(c.Context(), names.NewUnitTag("mysql/0"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.HookTimeout, tc.Equals, 30*time.Minute)
+}
+
 func (s *uniterSuite) TestGetUnitContextAPICallError(c *tc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
 		return errors.New("boom")
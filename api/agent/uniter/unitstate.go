@@ -49,6 +49,27 @@ func (client *Client) SetState(ctx context.Context, unitState params.SetUnitStat
 	return maybeRestoreQuotaLimitError(results.OneError())
 }
 
+// RecordHookHistory records the input entries in the hook history of the
+// client's unit.
+func (client *Client) RecordHookHistory(ctx context.Context, entries []params.HookHistoryEntry) error {
+	if client.BestAPIVersion() < 24 {
+		// RecordHookHistory() was introduced in UniterAPIV24.
+		return errors.NotImplementedf("RecordHookHistory() (need V24+)")
+	}
+	var results params.ErrorResults
+	args := params.UnitHookHistoryArgs{
+		Args: []params.UnitHookHistoryArg{{
+			Tag:     client.unitTag.String(),
+			Entries: entries,
+		}},
+	}
+	err := client.facade.FacadeCall(ctx, "RecordHookHistory", args, &results)
+	if err != nil {
+		return errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return apiservererrors.RestoreError(results.OneError())
+}
+
 // maybeRestoreQuotaLimitError checks if the server emitted a quota limit
 // exceeded error and restores it back to a typed error from juju/errors.
 // Ideally, we would use apiserver/common.RestoreError but apparently, that
@@ -5,6 +5,7 @@ package uniter
 
 import (
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -132,3 +133,39 @@ func (s *unitStateSuite) TestStateMultipleReturnsError(c *tc.C) {
 	_, err := api.State(c.Context())
 	c.Assert(err, tc.ErrorMatches, "expected 1 result, got 2")
 }
+
+func (s *unitStateSuite) TestRecordHookHistory(c *tc.C) {
+	entries := []params.HookHistoryEntry{{
+		Kind:     "hook",
+		Name:     "install",
+		Duration: time.Second,
+	}}
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "Uniter")
+		c.Check(version, tc.Equals, 24)
+		c.Check(request, tc.Equals, "RecordHookHistory")
+		c.Check(arg, tc.DeepEquals, params.UnitHookHistoryArgs{
+			Args: []params.UnitHookHistoryArg{{
+				Tag:     s.tag.String(),
+				Entries: entries,
+			}},
+		})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{}},
+		}
+		return nil
+	})
+	api := NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 24}, s.tag)
+	err := api.RecordHookHistory(c.Context(), entries)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *unitStateSuite) TestRecordHookHistoryNotImplemented(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+	api := NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 23}, s.tag)
+	err := api.RecordHookHistory(c.Context(), nil)
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
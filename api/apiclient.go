@@ -1251,13 +1251,30 @@ func isX509Error(err error) bool {
 // This fills out the rpc.Request on the given facade, version for a given
 // object id, and the specific RPC method. It marshalls the Arguments, and will
 // unmarshall the result into the response object that is supplied.
+//
+// If the controller rejects the call because the rate limit for the method
+// has been exceeded, the call is retried with backoff, waiting at least as
+// long as the controller asks.
 func (c *conn) APICall(ctx context.Context, facade string, vers int, id, method string, args, response any) error {
-	err := c.client.Call(ctx, rpc.Request{
+	req := rpc.Request{
 		Type:    facade,
 		Version: vers,
 		Id:      id,
 		Action:  method,
-	}, args, response)
+	}
+	err := c.client.Call(ctx, req, args, response)
+	delay := rateLimitInitialDelay
+	for attempt := 1; attempt < rateLimitMaxAttempts && params.IsCodeRateLimitExceeded(err); attempt++ {
+		wait := max(delay, rateLimitRetryAfter(err))
+		logger.Debugf(ctx, "%s.%s rate limited, retrying in %v", facade, method, wait)
+		select {
+		case <-c.clock.After(wait):
+		case <-ctx.Done():
+			return errors.Trace(context.Cause(ctx))
+		}
+		delay = min(delay*2, rateLimitMaxDelay)
+		err = c.client.Call(ctx, req, args, response)
+	}
 
 	if code := params.ErrCode(err); code == params.CodeNotImplemented {
 		return errors.NewNotImplemented(fmt.Errorf("%w\nre-install your juju client to match the version running on the controller", err), "\njuju client not compatible with server")
@@ -1265,6 +1282,31 @@ func (c *conn) APICall(ctx context.Context, facade string, vers int, id, method
 	return errors.Trace(err)
 }
 
+const (
+	// rateLimitMaxAttempts is the number of times an API call is
+	// made before a rate limit exceeded error is returned.
+	rateLimitMaxAttempts = 5
+
+	// rateLimitInitialDelay is the minimum time to wait before retrying an
+	// API call which was rate limited. It doubles with each attempt, up to
+	// rateLimitMaxDelay.
+	rateLimitInitialDelay = 500 * time.Millisecond
+	rateLimitMaxDelay     = 30 * time.Second
+)
+
+// rateLimitRetryAfter returns how long the controller asked us to wait
+// before retrying an API call which was rate limited, or zero if the
+// error doesn't say.
+func rateLimitRetryAfter(err error) time.Duration {
+	var info params.RateLimitExceededErrorInfo
+	if rpcErr, ok := errors.AsType[*rpc.RequestError](err); ok {
+		_ = rpcErr.UnmarshalInfo(&info)
+	} else if paramsErr, ok := errors.AsType[*params.Error](err); ok {
+		_ = paramsErr.UnmarshalInfo(&info)
+	}
+	return info.RetryAfter
+}
+
 func (c *conn) Close() error {
 	c.closeMutex.Lock()
 	defer c.closeMutex.Unlock()
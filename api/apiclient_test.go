@@ -1207,6 +1207,44 @@ func (s *apiclientSuite) TestAPICallErrorNotImplemented(c *tc.C) {
 	c.Check(clock.waits, tc.HasLen, 0)
 }
 
+func (s *apiclientSuite) TestAPICallRateLimitedRetries(c *tc.C) {
+	clock := &fakeClock{}
+	rpcConn := newRPCConnection(
+		apiservererrors.ServerError(&apiservererrors.RateLimitExceededError{RetryAfter: 2 * time.Second}),
+		apiservererrors.ServerError(&apiservererrors.RateLimitExceededError{RetryAfter: 100 * time.Millisecond}),
+		nil,
+	)
+	conn := api.NewTestingConnection(c, api.TestingConnectionParams{
+		RPCConnection: rpcConn,
+		Clock:         clock,
+	})
+
+	err := conn.APICall(c.Context(), "facade", 1, "id", "method", nil, nil)
+	c.Check(err, tc.ErrorIsNil)
+	rpcConn.stub.CheckCallNames(c, "facade.method", "facade.method", "facade.method")
+
+	// The first wait honours the controller's retry-after, the second
+	// backs off further than it asks.
+	c.Check(clock.waits, tc.DeepEquals, []time.Duration{2 * time.Second, time.Second})
+}
+
+func (s *apiclientSuite) TestAPICallRateLimitedGivesUp(c *tc.C) {
+	clock := &fakeClock{}
+	rateLimited := apiservererrors.ServerError(&apiservererrors.RateLimitExceededError{RetryAfter: time.Second})
+	rpcConn := newRPCConnection(rateLimited, rateLimited, rateLimited, rateLimited, rateLimited, rateLimited)
+	conn := api.NewTestingConnection(c, api.TestingConnectionParams{
+		RPCConnection: rpcConn,
+		Clock:         clock,
+	})
+
+	err := conn.APICall(c.Context(), "facade", 1, "id", "method", nil, nil)
+	c.Check(err, tc.Satisfies, params.IsCodeRateLimitExceeded)
+	c.Check(rpcConn.stub.Calls(), tc.HasLen, 5)
+	c.Check(clock.waits, tc.DeepEquals, []time.Duration{
+		time.Second, time.Second, 2 * time.Second, 4 * time.Second,
+	})
+}
+
 func (s *apiclientSuite) TestIsBrokenOk(c *tc.C) {
 	conn := api.NewTestingConnection(c, api.TestingConnectionParams{
 		RPCConnection: newRPCConnection(),
@@ -1408,6 +1446,8 @@ func (s *apiclientSuite) TestWatchDebugLogParamsEncoded(c *tc.C) {
 		NoTail:        true,
 		Firehose:      true,
 		StartTime:     time.Date(2016, 11, 30, 11, 48, 0, 100, time.UTC),
+		EndTime:       time.Date(2016, 11, 30, 12, 48, 0, 0, time.UTC),
+		Offset:        50,
 	}
 
 	urlValues := url.Values{
@@ -1425,6 +1465,8 @@ func (s *apiclientSuite) TestWatchDebugLogParamsEncoded(c *tc.C) {
 		"noTail":        {"true"},
 		"firehose":      {"true"},
 		"startTime":     {"2016-11-30T11:48:00.0000001Z"},
+		"endTime":       {"2016-11-30T12:48:00Z"},
+		"offset":        {"50"},
 	}
 
 	info := s.APIInfo()
@@ -1033,6 +1033,77 @@ func unitInfoFromParams(in params.UnitInfoResult) UnitInfo {
 	return info
 }
 
+// UnitHookHistory holds the hook history of a unit.
+type UnitHookHistory struct {
+	Entries []params.HookHistoryEntry
+	Error   error
+}
+
+// UnitsHookHistory retrieves the hook history of the specified units.
+func (c *Client) UnitsHookHistory(ctx context.Context, units []names.UnitTag) ([]UnitHookHistory, error) {
+	if c.BestAPIVersion() < 23 {
+		return nil, errors.NotImplementedf("unit hook history on this version of Juju")
+	}
+	all := make([]params.Entity, len(units))
+	for i, one := range units {
+		all[i] = params.Entity{Tag: one.String()}
+	}
+	in := params.Entities{Entities: all}
+	var out params.UnitHookHistoryResults
+	err := c.facade.FacadeCall(ctx, "UnitsHookHistory", in, &out)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resultsLen := len(out.Results); resultsLen != len(units) {
+		return nil, errors.Errorf("expected %d results, got %d", len(units), resultsLen)
+	}
+	history := make([]UnitHookHistory, len(out.Results))
+	for i, r := range out.Results {
+		if r.Error != nil {
+			history[i].Error = apiservererrors.RestoreError(r.Error)
+			continue
+		}
+		history[i].Entries = r.Entries
+	}
+	return history, nil
+}
+
+// ApplicationHookStats holds the hook stats of an application.
+type ApplicationHookStats struct {
+	Stats []params.HookStats
+	Error error
+}
+
+// ApplicationsHookStats retrieves statistics aggregated over the hook
+// history of the units of the specified applications.
+func (c *Client) ApplicationsHookStats(ctx context.Context, applications []string) ([]ApplicationHookStats, error) {
+	if c.BestAPIVersion() < 23 {
+		return nil, errors.NotImplementedf("application hook stats on this version of Juju")
+	}
+	all := make([]params.Entity, len(applications))
+	for i, one := range applications {
+		all[i] = params.Entity{Tag: names.NewApplicationTag(one).String()}
+	}
+	in := params.Entities{Entities: all}
+	var out params.ApplicationHookStatsResults
+	err := c.facade.FacadeCall(ctx, "ApplicationsHookStats", in, &out)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resultsLen := len(out.Results); resultsLen != len(applications) {
+		return nil, errors.Errorf("expected %d results, got %d", len(applications), resultsLen)
+	}
+	stats := make([]ApplicationHookStats, len(out.Results))
+	for i, r := range out.Results {
+		if r.Error != nil {
+			stats[i].Error = apiservererrors.RestoreError(r.Error)
+			continue
+		}
+		stats[i].Stats = r.Stats
+	}
+	return stats, nil
+}
+
 type DeployInfo struct {
 	// Architecture is the architecture used to deploy the charm.
 	Architecture string
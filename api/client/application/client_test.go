@@ -1465,6 +1465,108 @@ func (s *applicationSuite) TestUnitsInfoResultMismatch(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "expected 2 results, got 3")
 }
 
+func (s *applicationSuite) TestUnitsHookHistory(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: "unit-foo-0"},
+			{Tag: "unit-bar-1"},
+		}}
+	result := new(params.UnitHookHistoryResults)
+	results := params.UnitHookHistoryResults{
+		Results: []params.UnitHookHistoryResult{
+			{Error: &params.Error{Code: params.CodeNotFound, Message: "unit foo/0 not found"}},
+			{Entries: []params.HookHistoryEntry{{
+				Kind:     "hook",
+				Name:     "install",
+				Started:  started,
+				Duration: time.Second,
+			}}},
+		},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UnitsHookHistory", args, result).SetArg(3, results).Return(nil)
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	res, err := client.UnitsHookHistory(
+		c.Context(),
+		[]names.UnitTag{
+			names.NewUnitTag("foo/0"),
+			names.NewUnitTag("bar/1"),
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res, tc.HasLen, 2)
+	c.Check(res[0].Error, tc.ErrorIs, errors.NotFound)
+	c.Check(res[1], tc.DeepEquals, application.UnitHookHistory{
+		Entries: []params.HookHistoryEntry{{
+			Kind:     "hook",
+			Name:     "install",
+			Started:  started,
+			Duration: time.Second,
+		}},
+	})
+}
+
+func (s *applicationSuite) TestUnitsHookHistoryNotImplemented(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(22).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	_, err := client.UnitsHookHistory(c.Context(), []names.UnitTag{names.NewUnitTag("foo/0")})
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *applicationSuite) TestApplicationsHookStats(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	lastRun := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-foo"},
+		}}
+	result := new(params.ApplicationHookStatsResults)
+	results := params.ApplicationHookStatsResults{
+		Results: []params.ApplicationHookStatsResult{{
+			Stats: []params.HookStats{{
+				Kind:         "hook",
+				Name:         "config-changed",
+				Count:        2,
+				Failures:     1,
+				MeanDuration: time.Second,
+				MaxDuration:  time.Second,
+				LastRun:      lastRun,
+			}},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApplicationsHookStats", args, result).SetArg(3, results).Return(nil)
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	res, err := client.ApplicationsHookStats(c.Context(), []string{"foo"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res, tc.DeepEquals, []application.ApplicationHookStats{{
+		Stats: results.Results[0].Stats,
+	}})
+}
+
 func (s *applicationSuite) TestExpose(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -0,0 +1,33 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// List returns the metadata of all backups stored on the controller.
+func (c *Client) List(ctx context.Context) (*params.BackupsListResult, error) {
+	var result params.BackupsListResult
+	if err := c.facade.FacadeCall(ctx, "List", params.BackupsListArgs{}, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
+
+// Info returns the metadata of the backup with the given id.
+func (c *Client) Info(ctx context.Context, id string) (*params.BackupsMetadataResult, error) {
+	var result params.BackupsMetadataResult
+	args := params.BackupsInfoArgs{
+		ID: id,
+	}
+	if err := c.facade.FacadeCall(ctx, "Info", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	backupstesting "github.com/juju/juju/core/backups/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type listSuite struct {
+	baseSuite
+}
+
+func TestListSuite(t *testing.T) {
+	tc.Run(t, &listSuite{})
+}
+
+func (s *listSuite) TestList(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := backupstesting.NewMetadata()
+	result := params.BackupsListResult{
+		List: []params.BackupsMetadataResult{
+			params.CreateResult(meta, "test-filename"),
+		},
+	}
+	s.facade.EXPECT().FacadeCall(gomock.Any(), "List", params.BackupsListArgs{}, gomock.Any()).SetArg(3, result)
+
+	client := s.newClient()
+	got, err := client.List(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got.List, tc.HasLen, 1)
+	s.checkMetadataResult(c, &got.List[0], meta)
+}
+
+func (s *listSuite) TestInfo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := backupstesting.NewMetadata()
+	result := params.CreateResult(meta, "test-filename")
+	args := params.BackupsInfoArgs{ID: "test-filename"}
+	s.facade.EXPECT().FacadeCall(gomock.Any(), "Info", args, gomock.Any()).SetArg(3, result)
+
+	client := s.newClient()
+	got, err := client.Info(c.Context(), "test-filename")
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkMetadataResult(c, got, meta)
+}
+
+func (s *listSuite) TestRemove(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	args := params.BackupsRemoveArgs{IDs: []string{"one", "two"}}
+	result := params.ErrorResults{
+		Results: []params.ErrorResult{{}, {Error: &params.Error{Message: "boom"}}},
+	}
+	s.facade.EXPECT().FacadeCall(gomock.Any(), "Remove", args, gomock.Any()).SetArg(3, result)
+
+	client := s.newClient()
+	got, err := client.Remove(c.Context(), "one", "two")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, result.Results)
+}
+
+func (s *listSuite) TestRemoveResultCountMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	args := params.BackupsRemoveArgs{IDs: []string{"one", "two"}}
+	s.facade.EXPECT().FacadeCall(gomock.Any(), "Remove", args, gomock.Any()).SetArg(3, params.ErrorResults{})
+
+	client := s.newClient()
+	_, err := client.Remove(c.Context(), "one", "two")
+	c.Check(err, tc.ErrorMatches, `expected 2 result\(s\), got 0`)
+}
+
+func (s *listSuite) TestRestore(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := backupstesting.NewMetadata()
+	result := params.BackupsRestoreResult{
+		Metadata: params.CreateResult(meta, "test-filename"),
+	}
+	args := params.BackupsRestoreArgs{ID: "test-filename", DryRun: true}
+	s.facade.EXPECT().FacadeCall(gomock.Any(), "Restore", args, gomock.Any()).SetArg(3, result)
+
+	client := s.newClient()
+	got, err := client.Restore(c.Context(), "test-filename", true)
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkMetadataResult(c, &got.Metadata, meta)
+	c.Check(got.Error, tc.IsNil)
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Remove deletes the backups with the given ids from the controller.
+func (c *Client) Remove(ctx context.Context, ids ...string) ([]params.ErrorResult, error) {
+	var result params.ErrorResults
+	args := params.BackupsRemoveArgs{
+		IDs: ids,
+	}
+	if err := c.facade.FacadeCall(ctx, "Remove", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(result.Results) != len(ids) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(ids), len(result.Results))
+	}
+	return result.Results, nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Restore requests that the controller restores the backup with the given
+// id. When dryRun is true, the controller only validates that the backup
+// is compatible with it; the outcome of that validation is reported in
+// the result's Error field.
+func (c *Client) Restore(ctx context.Context, id string, dryRun bool) (*params.BackupsRestoreResult, error) {
+	var result params.BackupsRestoreResult
+	args := params.BackupsRestoreArgs{
+		ID:     id,
+		DryRun: dryRun,
+	}
+	if err := c.facade.FacadeCall(ctx, "Restore", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
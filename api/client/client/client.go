@@ -12,9 +12,11 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"gopkg.in/httprequest.v1"
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/status"
@@ -117,6 +119,48 @@ func (c *Client) StatusHistory(ctx context.Context, kind status.HistoryKind, tag
 	return history, nil
 }
 
+// QueryStatusHistory returns the status history of all the entities in the
+// model matching the query, oldest first.
+func (c *Client) QueryStatusHistory(ctx context.Context, query status.StatusHistoryQuery) ([]status.HistoryEntry, error) {
+	if c.facade.BestAPIVersion() < 9 {
+		return nil, errors.NotSupportedf("querying status history on this version of Juju")
+	}
+
+	args := params.StatusHistoryQuery{
+		Application: query.Application,
+		From:        query.From,
+		To:          query.To,
+		Message:     query.Message,
+		Size:        query.Size,
+	}
+	for _, kind := range query.Kinds {
+		args.Kinds = append(args.Kinds, kind.String())
+	}
+	for _, s := range query.Statuses {
+		args.Statuses = append(args.Statuses, s.String())
+	}
+
+	var result params.StatusHistoryQueryResult
+	if err := c.facade.FacadeCall(ctx, "QueryStatusHistory", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	entries := make([]status.HistoryEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = status.HistoryEntry{
+			ID: entry.Id,
+			DetailedStatus: status.DetailedStatus{
+				Status: status.Status(entry.Status.Status),
+				Info:   entry.Status.Info,
+				Data:   entry.Status.Data,
+				Since:  entry.Status.Since,
+				Kind:   status.HistoryKind(entry.Status.Kind),
+			},
+		}
+	}
+	return entries, nil
+}
+
 // Close closes the Client's underlying State connection
 // Client is unique among the api.State facades in closing its own State
 // connection, but it is conventional to use a Client object without any access
@@ -147,6 +191,26 @@ func (c *Client) UploadTools(ctx context.Context, r io.ReadSeeker, vers semversi
 	return resp.ToolsList, nil
 }
 
+type exportModelParams struct {
+	httprequest.Route `httprequest:"GET /export"`
+}
+
+// ExportModel returns a reader for a portable archive of the model the
+// client is connected to. The archive is streamed by the controller, so it
+// should be verified once read in full.
+func (c *Client) ExportModel(ctx context.Context) (io.ReadCloser, error) {
+	httpClient, err := c.conn.HTTPClient(base.HTTPClientScopeModel)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var resp *http.Response
+	if err := httpClient.Call(ctx, &exportModelParams{}, &resp); err != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return resp.Body, nil
+}
+
 func (c *Client) httpPost(ctx context.Context, content io.ReadSeeker, endpoint, contentType string, response any) error {
 	req, err := http.NewRequest("POST", endpoint, content)
 	if err != nil {
@@ -171,3 +235,9 @@ func (c *Client) httpPost(ctx context.Context, content io.ReadSeeker, endpoint,
 func (c *Client) WatchDebugLog(ctx context.Context, args common.DebugLogParams) (<-chan common.LogMessage, error) {
 	return common.StreamDebugLog(ctx, c.conn, args)
 }
+
+// DebugLogHistogram returns the number of log entries matching the filtering
+// specified in the DebugLogParams at each severity.
+func (c *Client) DebugLogHistogram(ctx context.Context, args common.DebugLogParams) (map[string]int, error) {
+	return common.DebugLogHistogram(ctx, c.conn, args)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/base/mocks"
+	internallogger "github.com/juju/juju/internal/logger"
+)
+
+type exportSuite struct{}
+
+func TestExportSuite(t *testing.T) {
+	tc.Run(t, &exportSuite{})
+}
+
+func (s *exportSuite) TestExportModel(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, "GET")
+		c.Check(r.URL.String(), tc.Equals, "/export")
+		_, err := w.Write([]byte("archive"))
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+
+	apiCaller := mocks.NewMockAPICallCloser(ctrl)
+	apiCaller.EXPECT().HTTPClient(base.HTTPClientScopeModel).Return(&httprequest.Client{BaseURL: srv.URL}, nil)
+
+	client := &Client{
+		conn:   apiCaller,
+		logger: internallogger.GetLogger("juju.api.client"),
+	}
+	rdr, err := client.ExportModel(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rdr.Close() }()
+
+	data, err := io.ReadAll(rdr)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "archive")
+}
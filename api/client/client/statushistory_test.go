@@ -0,0 +1,91 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/base"
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/rpc/params"
+)
+
+type statusHistorySuite struct{}
+
+func TestStatusHistorySuite(t *testing.T) {
+	tc.Run(t, &statusHistorySuite{})
+}
+
+func (s *statusHistorySuite) TestQueryStatusHistory(c *tc.C) {
+	from := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	since := from.Add(time.Minute)
+
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "Client")
+			c.Check(version, tc.Equals, 9)
+			c.Check(request, tc.Equals, "QueryStatusHistory")
+			c.Check(arg, tc.DeepEquals, params.StatusHistoryQuery{
+				Kinds:       []string{"unit"},
+				Application: "mysql",
+				Statuses:    []string{"error"},
+				From:        &from,
+				Message:     "hook failed",
+				Size:        10,
+			})
+			*(result.(*params.StatusHistoryQueryResult)) = params.StatusHistoryQueryResult{
+				Entries: []params.StatusHistoryEntry{{
+					Id: "mysql/0",
+					Status: params.DetailedStatus{
+						Status: "error",
+						Info:   "hook failed: install",
+						Since:  &since,
+						Kind:   "workload",
+					},
+				}},
+			}
+			return nil
+		}),
+		BestVersion: 9,
+	}
+	client := &Client{facade: base.NewFacadeCaller(apiCaller, "Client")}
+
+	entries, err := client.QueryStatusHistory(c.Context(), status.StatusHistoryQuery{
+		Kinds:       []status.HistoryKind{status.KindUnit},
+		Application: "mysql",
+		Statuses:    []status.Status{status.Error},
+		From:        &from,
+		Message:     "hook failed",
+		Size:        10,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []status.HistoryEntry{{
+		ID: "mysql/0",
+		DetailedStatus: status.DetailedStatus{
+			Status: status.Error,
+			Info:   "hook failed: install",
+			Since:  &since,
+			Kind:   status.KindWorkload,
+		},
+	}})
+}
+
+func (s *statusHistorySuite) TestQueryStatusHistoryNotSupported(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		}),
+		BestVersion: 8,
+	}
+	client := &Client{facade: base.NewFacadeCaller(apiCaller, "Client")}
+
+	_, err := client.QueryStatusHistory(c.Context(), status.StatusHistoryQuery{})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
@@ -37,8 +38,16 @@ func NewClient(caller base.APICallCloser, options ...Option) *Client {
 type ControllerDetails struct {
 	ControllerID string
 	APIEndpoints []string
+
+	// DqliteNodeID is the ID of the controller's Dqlite node.
+	DqliteNodeID uint64
+	// DqliteRole is the role of the controller's Dqlite node in the Dqlite
+	// cluster. It is empty if the node isn't a member of the cluster.
+	DqliteRole string
 }
 
+// ControllerDetails returns the details of each controller, keyed by
+// controller ID.
 func (c *Client) ControllerDetails(ctx context.Context) (map[string]ControllerDetails, error) {
 	if c.BestAPIVersion() < 3 {
 		return nil, errors.NotImplemented
@@ -60,6 +69,8 @@ func (c *Client) ControllerDetails(ctx context.Context) (map[string]ControllerDe
 		result[r.ControllerId] = ControllerDetails{
 			ControllerID: r.ControllerId,
 			APIEndpoints: r.APIAddresses,
+			DqliteNodeID: r.DqliteNodeID,
+			DqliteRole:   r.DqliteRole,
 		}
 	}
 	return result, nil
@@ -91,3 +102,24 @@ func (c *Client) EnableHA(
 	}
 	return result.Result, nil
 }
+
+// RemoveControllerNode removes the Dqlite node of the controller machine from
+// the Dqlite cluster. The node is demoted before it's removed, and a voting
+// node is only removed if a majority of the voters remain.
+func (c *Client) RemoveControllerNode(ctx context.Context, machineID string) error {
+	if c.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("removing controller nodes")
+	}
+
+	var results params.ErrorResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewMachineTag(machineID).String()}},
+	}
+	if err := c.facade.FacadeCall(ctx, "RemoveControllerNode", args, &results); err != nil {
+		return apiservererrors.RestoreError(err)
+	}
+	if err := results.OneError(); err != nil {
+		return apiservererrors.RestoreError(err)
+	}
+	return nil
+}
@@ -97,3 +97,59 @@ func (s *clientSuite) TestEnableHa(c *tc.C) {
 		Removed: []string{"machine2"},
 	})
 }
+
+func (s *clientSuite) TestRemoveControllerNode(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: "machine-2"}},
+	}
+	res := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveControllerNode", args, res).SetArg(3, results).Return(nil)
+	mockClient := basemocks.NewMockClientFacade(ctrl)
+	mockClient.EXPECT().BestAPIVersion().Return(4)
+	client := highavailability.NewClientFromCaller(mockFacadeCaller, mockClient)
+
+	err := client.RemoveControllerNode(c.Context(), "2")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestRemoveControllerNodeError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{
+			Error: &params.Error{Message: "removing controller node would lose Dqlite quorum"},
+		}},
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveControllerNode", gomock.Any(), res).SetArg(3, results).Return(nil)
+	mockClient := basemocks.NewMockClientFacade(ctrl)
+	mockClient.EXPECT().BestAPIVersion().Return(4)
+	client := highavailability.NewClientFromCaller(mockFacadeCaller, mockClient)
+
+	err := client.RemoveControllerNode(c.Context(), "0")
+	c.Assert(err, tc.ErrorMatches, "removing controller node would lose Dqlite quorum")
+}
+
+func (s *clientSuite) TestRemoveControllerNodeNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockClient := basemocks.NewMockClientFacade(ctrl)
+	mockClient.EXPECT().BestAPIVersion().Return(3)
+	client := highavailability.NewClientFromCaller(mockFacadeCaller, mockClient)
+
+	err := client.RemoveControllerNode(c.Context(), "0")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
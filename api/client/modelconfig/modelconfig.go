@@ -144,6 +144,27 @@ func (c *Client) SetModelSecretBackend(ctx context.Context, secretBackendName st
 	return nil
 }
 
+// AbortModelSecretBackendDrain aborts the drain of the model's secrets to its
+// current secret backend, reverting the model to the backend it used before,
+// returning an error satisfying [errors.NotFound] if the model has no drain,
+// returning an error satisfying [secretbackenderrors.Forbidden] if the drain
+// has already been aborted.
+func (c *Client) AbortModelSecretBackendDrain(ctx context.Context) error {
+	if c.facade.BestAPIVersion() < 5 {
+		return errors.NotSupportedf("aborting model secret backend drain")
+	}
+
+	var result params.ErrorResult
+	err := c.facade.FacadeCall(ctx, "AbortModelSecretBackendDrain", nil, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Error != nil {
+		return params.TranslateWellKnownError(result.Error)
+	}
+	return nil
+}
+
 // BestAPIVersion returns the best API version supported by the client.
 func (c *Client) BestAPIVersion() int {
 	return c.facade.BestAPIVersion()
@@ -290,3 +290,46 @@ func (s *modelconfigSuite) TestSetModelSecretBackendFailedModelNotFound(c *tc.C)
 	c.Assert(err, tc.ErrorIs, modelerrors.NotFound)
 	c.Assert(err, tc.ErrorMatches, fmt.Sprintf("model %q not found", modelID))
 }
+
+func (s *modelconfigSuite) TestAbortModelSecretBackendDrainNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	client := modelconfig.NewClientFromCaller(mockFacadeCaller)
+	err := client.AbortModelSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorMatches, "aborting model secret backend drain not supported")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *modelconfigSuite) TestAbortModelSecretBackendDrain(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(5)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AbortModelSecretBackendDrain", nil, gomock.Any()).
+		SetArg(3, params.ErrorResult{}).Return(nil)
+	client := modelconfig.NewClientFromCaller(mockFacadeCaller)
+	err := client.AbortModelSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *modelconfigSuite) TestAbortModelSecretBackendDrainFailedNotFound(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(5)
+	results := params.ErrorResult{
+		Error: &params.Error{
+			Code: params.CodeNotFound,
+		},
+	}
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AbortModelSecretBackendDrain", nil, gomock.Any()).
+		SetArg(3, results).Return(nil)
+	client := modelconfig.NewClientFromCaller(mockFacadeCaller)
+	err := client.AbortModelSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
@@ -374,6 +374,33 @@ func (c *Client) DestroyModel(ctx context.Context, tag names.ModelTag, destroySt
 	return nil
 }
 
+// PlanDestroyModel returns every entity that would be removed by destroying
+// the specified model, and which of them would require force. Nothing is
+// removed.
+func (c *Client) PlanDestroyModel(ctx context.Context, tag names.ModelTag, destroyStorage *bool) (params.RemovalPlan, error) {
+	if c.BestAPIVersion() < 12 {
+		return params.RemovalPlan{}, errors.NotSupportedf("planning model removal")
+	}
+	args := params.DestroyModelsParams{Models: []params.DestroyModelParams{{
+		ModelTag:       tag.String(),
+		DestroyStorage: destroyStorage,
+	}}}
+	var results params.RemovalPlanResults
+	if err := c.facade.FacadeCall(ctx, "PlanDestroyModels", args, &results); err != nil {
+		return params.RemovalPlan{}, errors.Trace(err)
+	}
+	if n := len(results.Results); n != 1 {
+		return params.RemovalPlan{}, errors.Errorf("expected 1 result, got %d", n)
+	}
+	if err := results.Results[0].Error; err != nil {
+		return params.RemovalPlan{}, errors.Trace(err)
+	}
+	if results.Results[0].Plan == nil {
+		return params.RemovalPlan{}, nil
+	}
+	return *results.Results[0].Plan, nil
+}
+
 // GrantModel grants a user access to the specified models.
 func (c *Client) GrantModel(ctx context.Context, user, access string, modelUUIDs ...string) error {
 	return c.modifyModelUser(ctx, params.GrantModelAccess, user, access, modelUUIDs)
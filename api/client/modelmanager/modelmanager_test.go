@@ -258,6 +258,45 @@ func (s *modelmanagerSuite) TestDestroyModel(c *tc.C) {
 	s.testDestroyModel(c, &false_, &true_, &defaultMin, time.Minute)
 }
 
+func (s *modelmanagerSuite) TestPlanDestroyModel(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	destroyStorage := true
+	args := params.DestroyModelsParams{
+		Models: []params.DestroyModelParams{{
+			ModelTag:       coretesting.ModelTag.String(),
+			DestroyStorage: &destroyStorage,
+		}},
+	}
+	plan := params.RemovalPlan{
+		Units: []params.PlannedRemoval{{Name: "foo/0", ForceRequired: "unit is in an error state"}},
+	}
+
+	result := new(params.RemovalPlanResults)
+	ress := params.RemovalPlanResults{
+		Results: []params.RemovalPlanResult{{Plan: &plan}},
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "PlanDestroyModels", args, result).SetArg(3, ress).Return(nil)
+	client := modelmanager.NewClientFromCaller(mockFacadeCaller)
+
+	obtained, err := client.PlanDestroyModel(c.Context(), coretesting.ModelTag, &destroyStorage)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, plan)
+}
+
+func (s *modelmanagerSuite) TestPlanDestroyModelNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	client := modelmanager.NewLegacyClientFromCaller(basemocks.NewMockFacadeCaller(ctrl))
+
+	_, err := client.PlanDestroyModel(c.Context(), coretesting.ModelTag, nil)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
 func (s *modelmanagerSuite) TestModelDefaults(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -0,0 +1,65 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the removals API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the removals API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "Removals", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// ListRemovals returns the removal jobs that are pending for the model.
+func (c *Client) ListRemovals(ctx context.Context) ([]params.RemovalJob, error) {
+	var results params.RemovalJobResults
+	if err := c.facade.FacadeCall(ctx, "ListRemovals", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results, nil
+}
+
+// ForceRemovals escalates the removal jobs with the input UUIDs so that they
+// are executed with force from their next attempt onwards.
+func (c *Client) ForceRemovals(ctx context.Context, uuids ...string) error {
+	return c.call(ctx, "ForceRemovals", uuids)
+}
+
+// CancelRemovals deletes the removal jobs with the input UUIDs, provided that
+// none of them has been attempted.
+func (c *Client) CancelRemovals(ctx context.Context, uuids ...string) error {
+	return c.call(ctx, "CancelRemovals", uuids)
+}
+
+func (c *Client) call(ctx context.Context, method string, uuids []string) error {
+	args := params.RemovalJobArgs{UUIDs: uuids}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, method, args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != len(uuids) {
+		return errors.Errorf("expected %d results, got %d", len(uuids), len(results.Results))
+	}
+	return results.Combine()
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/removals"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalsSuite struct{}
+
+func TestRemovalsSuite(t *testing.T) {
+	tc.Run(t, &removalsSuite{})
+}
+
+func (s *removalsSuite) TestListRemovals(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	results := params.RemovalJobResults{Results: []params.RemovalJob{{
+		UUID: "job-1", Type: "unit", EntityName: "foo/0", Attempts: 2,
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListRemovals", nil, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := removals.NewClientFromCaller(mockFacadeCaller)
+	jobs, err := client.ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(jobs, tc.DeepEquals, results.Results)
+}
+
+func (s *removalsSuite) TestForceRemovals(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RemovalJobArgs{UUIDs: []string{"job-1"}}
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ForceRemovals", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := removals.NewClientFromCaller(mockFacadeCaller)
+	err := client.ForceRemovals(c.Context(), "job-1")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *removalsSuite) TestCancelRemovalsError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RemovalJobArgs{UUIDs: []string{"job-1"}}
+	results := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Message: "removal job \"job-1\" has already been attempted"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CancelRemovals", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := removals.NewClientFromCaller(mockFacadeCaller)
+	err := client.CancelRemovals(c.Context(), "job-1")
+	c.Assert(err, tc.ErrorMatches, `removal job "job-1" has already been attempted`)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/core/status"
@@ -44,6 +45,21 @@ type SecretBackend struct {
 	Message             string
 	ID                  string
 	Error               error
+	Drains              []SecretBackendDrain
+}
+
+// SecretBackendDrain holds the progress of a drain of a model's secrets
+// from one secret backend to another.
+type SecretBackendDrain struct {
+	ModelUUID         string
+	ModelName         string
+	SourceBackend     string
+	TargetBackend     string
+	Status            string
+	StartedAt         time.Time
+	TotalRevisions    int
+	MovedRevisions    int
+	VerifiedRevisions int
 }
 
 var notSupported = errors.NotSupportedf("secret backends on this juju version")
@@ -76,6 +92,9 @@ func (api *Client) ListSecretBackends(ctx context.Context, names []string, revea
 			ID:                  r.ID,
 			Error:               resultErr,
 		}
+		for _, d := range r.Drains {
+			details.Drains = append(details.Drains, SecretBackendDrain(d))
+		}
 		result[i] = details
 	}
 	return result, err
@@ -167,3 +186,48 @@ func (api *Client) RemoveSecretBackend(ctx context.Context, name string, force b
 	}
 	return params.TranslateWellKnownError(results.OneError())
 }
+
+// RotateSecretEncryptionKeyResult holds the outcome of rotating the controller
+// secret encryption key.
+type RotateSecretEncryptionKeyResult struct {
+	// KeyUUID is the UUID of the new active key-encryption key.
+	KeyUUID string
+
+	// FailedModels holds the error for each model, keyed by model UUID,
+	// whose data key could not be rewrapped.
+	FailedModels map[string]error
+
+	// RetiredKeysRemoved is true if the previous key-encryption keys were
+	// deleted.
+	RetiredKeysRemoved bool
+}
+
+// RotateSecretEncryptionKey replaces the controller key-encryption key and
+// rewraps each model's secret data key with it.
+func (api *Client) RotateSecretEncryptionKey(ctx context.Context) (RotateSecretEncryptionKeyResult, error) {
+	if api.BestAPIVersion() < 2 {
+		return RotateSecretEncryptionKeyResult{}, errors.NotSupportedf("rotating the secret encryption key on this juju version")
+	}
+
+	var response params.RotateSecretEncryptionKeyResult
+	err := api.facade.FacadeCall(ctx, "RotateSecretEncryptionKey", nil, &response)
+	if err != nil {
+		return RotateSecretEncryptionKeyResult{}, errors.Trace(err)
+	}
+	result := RotateSecretEncryptionKeyResult{
+		KeyUUID:            response.KeyUUID,
+		FailedModels:       make(map[string]error),
+		RetiredKeysRemoved: response.RetiredKeysRemoved,
+	}
+	for _, m := range response.Models {
+		if m.Error == nil {
+			continue
+		}
+		tag, err := names.ParseModelTag(m.ModelTag)
+		if err != nil {
+			return RotateSecretEncryptionKeyResult{}, errors.Trace(err)
+		}
+		result.FailedModels[tag.Id()] = m.Error
+	}
+	return result, nil
+}
@@ -7,6 +7,7 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base/testing"
@@ -175,3 +176,45 @@ func (s *SecretBackendsSuite) TestUpdateSecretsBackend(c *tc.C) {
 	err := client.UpdateSecretBackend(c.Context(), backend, true)
 	c.Assert(err, tc.ErrorMatches, "FAIL")
 }
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "SecretBackends")
+			c.Check(version, tc.Equals, 2)
+			c.Check(id, tc.Equals, "")
+			c.Check(request, tc.Equals, "RotateSecretEncryptionKey")
+			c.Check(arg, tc.IsNil)
+			c.Assert(result, tc.FitsTypeOf, &params.RotateSecretEncryptionKeyResult{})
+			*(result.(*params.RotateSecretEncryptionKeyResult)) = params.RotateSecretEncryptionKeyResult{
+				KeyUUID: "key-uuid",
+				Models: []params.RewrapSecretDataKeyResult{{
+					ModelTag: coretesting.ModelTag.String(),
+				}, {
+					ModelTag: "model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+					Error:    &params.Error{Message: "FAIL"},
+				}},
+			}
+			return nil
+		}), BestVersion: 2,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	result, err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.KeyUUID, tc.Equals, "key-uuid")
+	c.Check(result.RetiredKeysRemoved, tc.IsFalse)
+	c.Assert(result.FailedModels, tc.HasLen, 1)
+	c.Check(result.FailedModels["deadbeef-0bad-400d-8000-4b1d0d06f00d"], tc.ErrorMatches, "FAIL")
+}
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKeyNotSupported(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		}), BestVersion: 1,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	_, err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
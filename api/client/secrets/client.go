@@ -5,6 +5,7 @@ package secrets
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -309,3 +310,71 @@ func (c *Client) RevokeSecret(ctx context.Context, uri *secrets.URI, name string
 	}
 	return processErrors(results), nil
 }
+
+// SecretAccessLogEntry holds a read of, or change to the access to,
+// a secret.
+type SecretAccessLogEntry struct {
+	Action    string
+	Revision  *int
+	Accessor  names.Tag
+	Subject   names.Tag
+	BackendID string
+	Time      time.Time
+}
+
+// SecretAccessLog returns the reads of, and grants and revokes of access
+// to, the specified secret, oldest first. If since is set, entries recorded
+// before it are excluded. If limit is positive, only that many of the most
+// recent entries are returned.
+func (c *Client) SecretAccessLog(
+	ctx context.Context, uri *secrets.URI, name string, since *time.Time, limit int,
+) ([]SecretAccessLogEntry, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("secret access log")
+	}
+	var uriString string
+	if uri != nil {
+		uriString = uri.String()
+	}
+	args := params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{
+			URI:   uriString,
+			Label: name,
+			Since: since,
+			Limit: limit,
+		}},
+	}
+
+	var results params.SecretAccessLogResults
+	err := c.facade.FacadeCall(ctx, "ListSecretAccessLog", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	entries := make([]SecretAccessLogEntry, len(result.Entries))
+	for i, e := range result.Entries {
+		accessor, err := names.ParseTag(e.AccessorTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		entries[i] = SecretAccessLogEntry{
+			Action:    e.Action,
+			Revision:  e.Revision,
+			Accessor:  accessor,
+			BackendID: e.BackendID,
+			Time:      e.Time,
+		}
+		if e.SubjectTag != "" {
+			if entries[i].Subject, err = names.ParseTag(e.SubjectTag); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+	}
+	return entries, nil
+}
@@ -7,6 +7,8 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base/testing"
@@ -451,3 +453,59 @@ func (s *SecretsSuite) TestRevokeSecretByName(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(result, tc.DeepEquals, []error{nil})
 }
+
+func (s *SecretsSuite) TestSecretAccessLog(c *tc.C) {
+	uri := secrets.NewURI()
+	now := time.Now()
+	since := now.Add(-time.Hour)
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "ListSecretAccessLog")
+		c.Assert(arg, tc.DeepEquals, params.SecretAccessLogArgs{
+			Args: []params.SecretAccessLogArg{{URI: uri.String(), Since: &since, Limit: 10}},
+		})
+		*(result.(*params.SecretAccessLogResults)) = params.SecretAccessLogResults{
+			Results: []params.SecretAccessLogResult{{
+				Entries: []params.SecretAccessLogEntry{{
+					Action:      "grant",
+					AccessorTag: "user-fred",
+					SubjectTag:  "application-gitlab",
+					Time:        now,
+				}, {
+					Action:      "read",
+					Revision:    new(2),
+					AccessorTag: "unit-gitlab-0",
+					BackendID:   "backend-id",
+					Time:        now,
+				}},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.SecretAccessLog(c.Context(), uri, "", &since, 10)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []apisecrets.SecretAccessLogEntry{{
+		Action:   "grant",
+		Accessor: names.NewUserTag("fred"),
+		Subject:  names.NewApplicationTag("gitlab"),
+		Time:     now,
+	}, {
+		Action:    "read",
+		Revision:  new(2),
+		Accessor:  names.NewUnitTag("gitlab/0"),
+		BackendID: "backend-id",
+		Time:      now,
+	}})
+}
+
+func (s *SecretsSuite) TestSecretAccessLogNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.SecretAccessLog(c.Context(), nil, "my-secret", nil, 0)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
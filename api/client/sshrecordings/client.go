@@ -0,0 +1,68 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshrecordings provides access to the recordings of SSH sessions
+// proxied by the controller.
+package sshrecordings
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/juju/errors"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Client lists and downloads SSH session recordings.
+type Client struct {
+	caller base.APICaller
+}
+
+// NewClient returns a new Client based on an existing API connection.
+func NewClient(caller base.APICaller) *Client {
+	return &Client{caller: caller}
+}
+
+type listParams struct {
+	httprequest.Route `httprequest:"GET /ssh-recordings"`
+	User              string `httprequest:"user,form,omitempty"`
+}
+
+// List returns the recorded SSH sessions, oldest first. If user is not
+// empty, only the sessions of that user are returned.
+func (c *Client) List(ctx context.Context, user string) ([]params.SSHRecording, error) {
+	httpClient, err := c.caller.HTTPClient(base.HTTPClientScopeUnscoped)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var result params.SSHRecordingsResult
+	if err := httpClient.Call(ctx, &listParams{User: user}, &result); err != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return result.Recordings, nil
+}
+
+type openParams struct {
+	httprequest.Route `httprequest:"GET /ssh-recordings/:uuid"`
+	UUID              string `httprequest:"uuid,path"`
+}
+
+// Open returns a reader for the asciicast recording with the given UUID.
+func (c *Client) Open(ctx context.Context, uuid string) (io.ReadCloser, error) {
+	httpClient, err := c.caller.HTTPClient(base.HTTPClientScopeUnscoped)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var resp *http.Response
+	if err := httpClient.Call(ctx, &openParams{UUID: uuid}, &resp); err != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshrecordings
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+func TestClientSuite(t *testing.T) {
+	tc.Run(t, &clientSuite{})
+}
+
+func (s *clientSuite) TestList(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	started := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	recordings := []params.SSHRecording{{
+		UUID:      "deadbeef",
+		User:      "bob",
+		Target:    "0.machine.juju.local",
+		Size:      42,
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+	}}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, "GET")
+		c.Check(r.URL.String(), tc.Equals, "/ssh-recordings?user=bob")
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(params.SSHRecordingsResult{Recordings: recordings})
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+
+	apiCaller := mocks.NewMockAPICaller(ctrl)
+	apiCaller.EXPECT().HTTPClient(base.HTTPClientScopeUnscoped).Return(&httprequest.Client{BaseURL: srv.URL}, nil)
+
+	result, err := NewClient(apiCaller).List(c.Context(), "bob")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, recordings)
+}
+
+func (s *clientSuite) TestOpen(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, "GET")
+		c.Check(r.URL.String(), tc.Equals, "/ssh-recordings/deadbeef")
+		_, err := w.Write([]byte("recording"))
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+
+	apiCaller := mocks.NewMockAPICaller(ctrl)
+	apiCaller.EXPECT().HTTPClient(base.HTTPClientScopeUnscoped).Return(&httprequest.Client{BaseURL: srv.URL}, nil)
+
+	rdr, err := NewClient(apiCaller).Open(c.Context(), "deadbeef")
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rdr.Close() }()
+
+	data, err := io.ReadAll(rdr)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "recording")
+}
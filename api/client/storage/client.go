@@ -188,6 +188,11 @@ func (c *Client) ListFilesystems(ctx context.Context, machines []string) ([]para
 
 // AddToUnit adds specified storage to desired units.
 func (c *Client) AddToUnit(ctx context.Context, storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+	for _, one := range storages {
+		if one.SnapshotId != "" && c.BestAPIVersion() < 9 {
+			return nil, errors.NotSupportedf("adding storage from a snapshot on this version of Juju")
+		}
+	}
 	out := params.AddStorageResults{}
 	in := params.StoragesAddParams{Storages: storages}
 	err := c.facade.FacadeCall(ctx, "AddToUnit", in, &out)
@@ -260,6 +265,66 @@ func (c *Client) Remove(ctx context.Context, storageIds []string, destroyAttachm
 	return results.Results, nil
 }
 
+// Resize grows the specified storage instance to sizeMiB.
+func (c *Client) Resize(ctx context.Context, storageId string, sizeMiB uint64) error {
+	if c.BestAPIVersion() < 8 {
+		return errors.NotSupportedf("resizing storage on this version of Juju")
+	}
+	if !names.IsValidStorage(storageId) {
+		return errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			SizeMiB:    sizeMiB,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "ResizeStorage", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// CreateSnapshot requests a snapshot of the specified storage instance,
+// returning the id of the new snapshot.
+func (c *Client) CreateSnapshot(ctx context.Context, storageId string) (string, error) {
+	if c.BestAPIVersion() < 9 {
+		return "", errors.NotSupportedf("storage snapshots on this version of Juju")
+	}
+	if !names.IsValidStorage(storageId) {
+		return "", errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{
+			Tag: names.NewStorageTag(storageId).String(),
+		}},
+	}
+	var results params.StringResults
+	if err := c.facade.FacadeCall(ctx, "CreateStorageSnapshots", args, &results); err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return "", err
+	}
+	return results.Results[0].Result, nil
+}
+
+// ListSnapshots returns the details of all storage snapshots in the model.
+func (c *Client) ListSnapshots(ctx context.Context) ([]params.StorageSnapshotDetails, error) {
+	if c.BestAPIVersion() < 9 {
+		return nil, errors.NotSupportedf("storage snapshots on this version of Juju")
+	}
+	var results params.StorageSnapshotDetailsResults
+	if err := c.facade.FacadeCall(ctx, "ListStorageSnapshots", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results, nil
+}
+
 // Detach detaches the specified storage entities.
 func (c *Client) Detach(ctx context.Context, storageIds []string, force *bool, maxWait *time.Duration) ([]params.ErrorResult, error) {
 	results := params.ErrorResults{}
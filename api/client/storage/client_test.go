@@ -637,6 +637,65 @@ func (s *storageMockSuite) TestAttach(c *tc.C) {
 	c.Assert(obtained[1].Error, tc.DeepEquals, &params.Error{Message: "qux"})
 }
 
+func (s *storageMockSuite) TestResize(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.ResizeStorageArgs{Storage: []params.ResizeStorageArg{{
+		StorageTag: "storage-bar-1",
+		SizeMiB:    2048,
+	}}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ResizeStorage", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	err := storageClient.Resize(c.Context(), "bar/1", 2048)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *storageMockSuite) TestResizeError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "qux"}}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ResizeStorage", gomock.AssignableToTypeOf(params.ResizeStorageArgs{}), result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	err := storageClient.Resize(c.Context(), "bar/1", 2048)
+	c.Assert(err, tc.ErrorMatches, "qux")
+}
+
+func (s *storageMockSuite) TestResizeNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(7).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	err := storageClient.Resize(c.Context(), "bar/1", 2048)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
 func (s *storageMockSuite) TestAttachArityMismatch(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -889,3 +948,108 @@ func (s *storageMockSuite) TestUpdatePoolFacadeCallError(c *tc.C) {
 	err := storageClient.UpdatePool(c.Context(), "", "", nil)
 	c.Assert(err, tc.ErrorMatches, msg)
 }
+
+func (s *storageMockSuite) TestAddToUnitFromSnapshotNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	_, err := storageClient.AddToUnit(c.Context(), []params.StorageAddParams{{
+		UnitTag:     "unit-foo-0",
+		StorageName: "data",
+		SnapshotId:  "0",
+	}})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestCreateSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.Entities{Entities: []params.Entity{{
+		Tag: "storage-bar-1",
+	}}}
+	result := new(params.StringResults)
+	results := params.StringResults{
+		Results: []params.StringResult{{Result: "0"}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CreateStorageSnapshots", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(9).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	id, err := storageClient.CreateSnapshot(c.Context(), "bar/1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "0")
+}
+
+func (s *storageMockSuite) TestCreateSnapshotError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	result := new(params.StringResults)
+	results := params.StringResults{
+		Results: []params.StringResult{{Error: &params.Error{Message: "qux"}}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CreateStorageSnapshots", gomock.AssignableToTypeOf(params.Entities{}), result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(9).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	_, err := storageClient.CreateSnapshot(c.Context(), "bar/1")
+	c.Assert(err, tc.ErrorMatches, "qux")
+}
+
+func (s *storageMockSuite) TestCreateSnapshotNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(8).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	_, err := storageClient.CreateSnapshot(c.Context(), "bar/1")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestListSnapshots(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expected := []params.StorageSnapshotDetails{{
+		Id:         "0",
+		StorageTag: "storage-bar-1",
+		Kind:       params.StorageKindBlock,
+		Pool:       "loop",
+		Status:     "available",
+		SizeMiB:    1024,
+	}}
+	result := new(params.StorageSnapshotDetailsResults)
+	results := params.StorageSnapshotDetailsResults{Results: expected}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListStorageSnapshots", nil, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+
+	mockClientFacade := basemocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(9).AnyTimes()
+	storageClient.ClientFacade = mockClientFacade
+
+	snapshots, err := storageClient.ListSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshots, tc.DeepEquals, expected)
+}
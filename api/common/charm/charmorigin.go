@@ -54,6 +54,10 @@ type Origin struct {
 	// charmhub Refresh action related to an application. Create with the
 	// charmhub.CreateInstanceKey method. LP: 1944582
 	InstanceKey string
+
+	// Publisher is the username of the publisher of the charm in the
+	// repository it was resolved from.
+	Publisher string
 }
 
 // WithBase allows to update the base of an origin.
@@ -98,6 +102,7 @@ func (o Origin) ParamsCharmOrigin() params.CharmOrigin {
 		Architecture: o.Architecture,
 		Base:         params.Base{Name: o.Base.OS, Channel: o.Base.Channel.String()},
 		InstanceKey:  o.InstanceKey,
+		Publisher:    o.Publisher,
 	}
 }
 
@@ -132,6 +137,7 @@ func (o Origin) CoreCharmOrigin() corecharm.Origin {
 			Channel:      o.Base.Channel.Track,
 		},
 		InstanceKey: o.InstanceKey,
+		Publisher:   o.Publisher,
 	}
 }
 
@@ -154,6 +160,7 @@ func APICharmOrigin(origin params.CharmOrigin) (Origin, error) {
 		Architecture: origin.Architecture,
 		Base:         base,
 		InstanceKey:  origin.InstanceKey,
+		Publisher:    origin.Publisher,
 	}, nil
 }
 
@@ -188,5 +195,6 @@ func CoreCharmOrigin(origin corecharm.Origin) (Origin, error) {
 		Architecture: origin.Platform.Architecture,
 		Base:         chBase,
 		InstanceKey:  origin.InstanceKey,
+		Publisher:    origin.Publisher,
 	}, nil
 }
@@ -61,6 +61,14 @@ type DebugLogParams struct {
 	// StartTime should be a time in the past - only records with a
 	// log time on or after StartTime will be returned.
 	StartTime time.Time
+	// EndTime, if set, limits the records returned to those with a log
+	// time on or before EndTime. The server doesn't wait for new records
+	// when it's set.
+	EndTime time.Time
+	// Offset tells the server to skip this many of the filtered lines
+	// before sending any, allowing results to be paged through. It counts
+	// from the start of the log, or from the end when Backlog is set.
+	Offset uint
 	// Firehose streams logs from all models from the logsink.log file.
 	Firehose bool
 }
@@ -117,6 +125,12 @@ func (args DebugLogParams) URLQuery() url.Values {
 	if !args.StartTime.IsZero() {
 		attrs.Set("startTime", args.StartTime.Format(time.RFC3339Nano))
 	}
+	if !args.EndTime.IsZero() {
+		attrs.Set("endTime", args.EndTime.Format(time.RFC3339Nano))
+	}
+	if args.Offset > 0 {
+		attrs.Set("offset", fmt.Sprint(args.Offset))
+	}
 	return attrs
 }
 
@@ -175,3 +189,26 @@ func StreamDebugLog(ctx context.Context, source base.StreamConnector, args Debug
 
 	return messages, nil
 }
+
+// DebugLogHistogram requests the number of debug log records matching the
+// specified filters at each severity from the server.
+func DebugLogHistogram(ctx context.Context, source base.StreamConnector, args DebugLogParams) (map[string]int, error) {
+	attrs := args.URLQuery()
+	attrs.Set("histogram", "true")
+
+	connection, err := source.ConnectStream(ctx, "/log", attrs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = connection.Close() }()
+
+	var histogram params.LogHistogram
+	if err := connection.ReadJSON(&histogram); err != nil {
+		return nil, errors.Annotate(err, "reading log histogram")
+	}
+	// Controllers that don't support histograms send log records instead.
+	if histogram.Counts == nil {
+		return nil, errors.NotSupportedf("log histograms")
+	}
+	return histogram.Counts, nil
+}
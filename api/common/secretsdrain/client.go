@@ -63,6 +63,8 @@ type ChangeSecretBackendArg struct {
 	Revision int
 	Data     map[string]string
 	ValueRef *coresecrets.ValueRef
+	// Checksum is the checksum of the content verified in the new backend.
+	Checksum string
 }
 
 // ChangeSecretBackendResult is the result for ChangeSecretBackend.
@@ -90,6 +92,7 @@ func (c *Client) ChangeSecretBackend(ctx context.Context, metaRevisions []Change
 			URI:      mdr.URI.String(),
 			Revision: mdr.Revision,
 			Content:  params.SecretContentParams{Data: mdr.Data},
+			Checksum: mdr.Checksum,
 		}
 		if mdr.ValueRef != nil {
 			arg.Content.ValueRef = &params.SecretValueRef{
@@ -99,6 +99,7 @@ func (s *secretsDrainSuite) TestChangeSecretBackend(c *tc.C) {
 							RevisionID: "rev-id",
 						},
 					},
+					Checksum: "checksum",
 				},
 			},
 		},
@@ -122,6 +123,7 @@ func (s *secretsDrainSuite) TestChangeSecretBackend(c *tc.C) {
 					BackendID:  "backend-id",
 					RevisionID: "rev-id",
 				},
+				Checksum: "checksum",
 			},
 		},
 	)
@@ -6,6 +6,8 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 	"time"
 
 	"github.com/juju/errors"
@@ -15,6 +17,7 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
 	"github.com/juju/juju/api/common/cloudspec"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
@@ -437,3 +440,24 @@ func (c *Client) DashboardConnectionInfo(ctx context.Context, factory ProxierFac
 	rval.Proxier = proxier
 	return rval, nil
 }
+
+// ImportModel uploads a portable model archive, as written by a model
+// export, to the controller, which creates the model it holds.
+func (c *Client) ImportModel(ctx context.Context, archive io.ReadSeeker) (params.ImportModelResult, error) {
+	req, err := http.NewRequest("POST", "/model-import", archive)
+	if err != nil {
+		return params.ImportModelResult{}, errors.Annotate(err, "cannot create upload request")
+	}
+	req.Header.Set("Content-Type", "application/gzip")
+
+	httpClient, err := c.facade.RawAPICaller().HTTPClient(base.HTTPClientScopeUnscoped)
+	if err != nil {
+		return params.ImportModelResult{}, errors.Trace(err)
+	}
+
+	var result params.ImportModelResult
+	if err := httpClient.Do(ctx, req, &result); err != nil {
+		return params.ImportModelResult{}, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return result, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/rpc/params"
+)
+
+func (s *Suite) TestImportModel(c *tc.C) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, "POST")
+		c.Check(r.URL.Path, tc.Equals, "/model-import")
+		c.Check(r.Header.Get("Content-Type"), tc.Equals, "application/gzip")
+		data, err := io.ReadAll(r.Body)
+		c.Check(err, tc.ErrorIsNil)
+		c.Check(string(data), tc.Equals, "archive")
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(params.ImportModelResult{
+			ModelTag:  "model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+			Name:      "foo",
+			Qualifier: "prod",
+		})
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+
+	srvURL, err := url.Parse(srv.URL)
+	c.Assert(err, tc.ErrorIsNil)
+	client := controller.NewClient(&httpAPICallCloser{url: srvURL})
+
+	result, err := client.ImportModel(c.Context(), strings.NewReader("archive"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ImportModelResult{
+		ModelTag:  "model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		Name:      "foo",
+		Qualifier: "prod",
+	})
+}
@@ -25,9 +25,9 @@ var facadeVersions = facades.FacadeVersions{
 	"Agent":             {3},
 	"AgentLifeFlag":     {1},
 	"Annotations":       {2},
-	"Application":       {19, 20, 21, 22},
+	"Application":       {19, 20, 21, 22, 23},
 	"ApplicationOffers": {5, 6},
-	"Backups":           {3},
+	"Backups":           {3, 4},
 	"Block":             {2},
 	// Note that this version of Juju does not implement version 6 of the
 	// facade, but 3.6 does. Care must be taken not to break client
@@ -43,7 +43,7 @@ var facadeVersions = facades.FacadeVersions{
 	"CAASModelOperator":            {1},
 	"CAASOperatorUpgrader":         {1},
 	"Charms":                       {7},
-	"Client":                       {8},
+	"Client":                       {8, 9},
 	"Cloud":                        {7},
 	"Controller":                   {12, 13},
 	"CredentialManager":            {1},
@@ -57,7 +57,7 @@ var facadeVersions = facades.FacadeVersions{
 	"ExternalControllerUpdater":    {1},
 	"FilesystemAttachmentsWatcher": {2},
 	"Firewaller":                   {7},
-	"HighAvailability":             {2, 3},
+	"HighAvailability":             {2, 3, 4},
 	"HostKeyReporter":              {1},
 	"ImageMetadata":                {3},
 	"ImageMetadataManager":         {1},
@@ -77,8 +77,8 @@ var facadeVersions = facades.FacadeVersions{
 	"MigrationMinion":              {1},
 	"MigrationStatusWatcher":       {1},
 	"MigrationTarget":              {4, 5, 6, 7},
-	"ModelConfig":                  {3, 4},
-	"ModelManager":                 {9, 10, 11},
+	"ModelConfig":                  {3, 4, 5},
+	"ModelManager":                 {9, 10, 11, 12},
 	"ModelSummaryWatcher":          {1},
 	"ModelUpgrader":                {1},
 	"NotifyWatcher":                {1},
@@ -90,26 +90,27 @@ var facadeVersions = facades.FacadeVersions{
 	"RelationStatusWatcher":        {1},
 	"RelationUnitsWatcher":         {1},
 	"RemoteRelationWatcher":        {1},
+	"Removals":                     {1},
 	"Resources":                    {3},
 	"ResourcesHookContext":         {1},
 	"RetryStrategy":                {1},
 	"SecretsTriggerWatcher":        {1},
-	"SecretBackends":               {1},
+	"SecretBackends":               {1, 2},
 	"SecretBackendsManager":        {1},
 	"SecretBackendsRotateWatcher":  {1},
 	"SecretsRevisionWatcher":       {1},
-	"Secrets":                      {1, 2},
-	"SecretsManager":               {4},
+	"Secrets":                      {1, 2, 3},
+	"SecretsManager":               {4, 5},
 	"SecretsDrain":                 {1},
 	"UserSecretsDrain":             {1},
 	"UserSecretsManager":           {1},
 	"Spaces":                       {6},
 	"SSHClient":                    {4, 5},
-	"Storage":                      {6, 7},
-	"StorageProvisioner":           {5, 6},
+	"Storage":                      {6, 7, 8, 9},
+	"StorageProvisioner":           {5, 6, 7, 8},
 	"StringsWatcher":               {1},
 	"Subnets":                      {5},
-	"Uniter":                       {19, 20, 21, 22},
+	"Uniter":                       {19, 20, 21, 22, 23, 24},
 	"Upgrader":                     {1},
 	"UserManager":                  {3},
 	"VolumeAttachmentsWatcher":     {2},
@@ -55,6 +55,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/modelmanager"   // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelupgrader"
 	"github.com/juju/juju/apiserver/facades/client/pinger"
+	"github.com/juju/juju/apiserver/facades/client/removals" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/resources"
 	"github.com/juju/juju/apiserver/facades/client/secretbackends"
 	"github.com/juju/juju/apiserver/facades/client/secrets"
@@ -122,6 +123,7 @@ func requiredMigrationFacadeVersions() facades.FacadeVersions {
 	provisioner.Register(registry)
 	proxyupdater.Register(registry)
 	reboot.Register(registry)
+	removals.Register(registry)
 	resourceshookcontext.Register(registry)
 	retrystrategy.Register(registry)
 	secretsdrain.Register(registry)
@@ -203,6 +205,7 @@ func AllFacades() *facade.Registry {
 	provisioner.Register(registry)
 	proxyupdater.Register(registry)
 	reboot.Register(registry)
+	removals.Register(registry)
 	resources.Register(registry)
 	resourceshookcontext.Register(registry)
 	retrystrategy.Register(registry)
@@ -36,16 +36,21 @@ import (
 	"github.com/juju/juju/apiserver/httpcontext"
 	"github.com/juju/juju/apiserver/internal/crossmodel"
 	crossmodelbakery "github.com/juju/juju/apiserver/internal/crossmodel/bakery"
+	handlersbackups "github.com/juju/juju/apiserver/internal/handlers/backups"
 	handlerscrossmodel "github.com/juju/juju/apiserver/internal/handlers/crossmodel"
+	"github.com/juju/juju/apiserver/internal/handlers/modelexport"
+	"github.com/juju/juju/apiserver/internal/handlers/modelimport"
 	"github.com/juju/juju/apiserver/internal/handlers/objects"
 	handlersresources "github.com/juju/juju/apiserver/internal/handlers/resources"
 	resourcesdownload "github.com/juju/juju/apiserver/internal/handlers/resources/download"
+	"github.com/juju/juju/apiserver/internal/handlers/sshrecordings"
 	"github.com/juju/juju/apiserver/logsink"
 	"github.com/juju/juju/apiserver/observer"
 	"github.com/juju/juju/apiserver/stateauthenticator"
 	"github.com/juju/juju/apiserver/websocket"
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/auditlog"
+	corebackups "github.com/juju/juju/core/backups"
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/flightrecorder"
@@ -59,6 +64,7 @@ import (
 	"github.com/juju/juju/core/securitylog"
 	coretrace "github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
+	coreuser "github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	internalerrors "github.com/juju/juju/internal/errors"
@@ -150,6 +156,11 @@ type Server struct {
 	agentRateLimitRate time.Duration
 	agentRateLimit     *ratelimit.Bucket
 
+	// apiCallRateLimit limits the rate of API calls made by users, per
+	// facade and method. It is configured from controller config, and can
+	// be updated on the fly.
+	apiCallRateLimit *apiCallRateLimiter
+
 	// resourceLock is used to limit the number of
 	// concurrent resource downloads to units.
 	resourceLock resource.ResourceDownloadLock
@@ -433,7 +444,9 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 
 		healthStatus: "starting",
 	}
+	srv.apiCallRateLimit = newAPICallRateLimiter(srv.clock, srv.metricsCollector.RateLimitedRequests)
 	srv.updateAgentRateLimiter(controllerConfig)
+	srv.updateAPICallRateLimiter(controllerConfig)
 	if err := srv.updateResourceDownloadLimiters(controllerConfig); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -461,11 +474,15 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 
 // Report is shown in the juju_engine_report.
 func (srv *Server) Report(ctx context.Context) map[string]any {
+	apiRateLimitMax, apiRateLimitRate := srv.apiCallRateLimit.config()
+
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
 	result := map[string]any{
 		"agent-ratelimit-max":  srv.agentRateLimitMax,
 		"agent-ratelimit-rate": srv.agentRateLimitRate,
+		"api-ratelimit-max":    apiRateLimitMax,
+		"api-ratelimit-rate":   apiRateLimitRate,
 	}
 
 	if srv.publicDNSName_ != "" {
@@ -518,6 +535,10 @@ func (srv *Server) updateAgentRateLimiter(cfg controller.Config) {
 	}
 }
 
+func (srv *Server) updateAPICallRateLimiter(cfg controller.Config) {
+	srv.apiCallRateLimit.configure(cfg.APIRateLimitMax(), cfg.APIRateLimitRate())
+}
+
 func (srv *Server) updateResourceDownloadLimiters(cfg controller.Config) error {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
@@ -678,6 +699,7 @@ func (srv *Server) loop(ready chan struct{}) error {
 			}
 
 			srv.updateAgentRateLimiter(controllerConfig)
+			srv.updateAPICallRateLimiter(controllerConfig)
 			srv.shared.updateControllerConfig(ctx, controllerConfig)
 
 			// If the update fails, there is nothing else we can do but log the
@@ -825,6 +847,29 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		&objectStoreServiceGetter{ctxt: httpCtxt},
 	), "objects")
 
+	var modelExportAuthorizer httpcontext.CompositeAuthorizer = []authentication.Authorizer{
+		controllerAdminAuthorizer,
+		modelPermissionAuthorizer{
+			perm: permission.AdminAccess,
+		},
+	}
+	modelExportHandler := srv.monitoredHandler(modelexport.NewModelExportHandler(
+		&modelExportServicesGetter{ctxt: httpCtxt},
+		srv.clock,
+	), "export")
+	modelImportHandler := srv.monitoredHandler(modelimport.NewModelImportHandler(
+		&modelImportServicesGetter{ctxt: httpCtxt},
+		srv.shared.controllerUUID,
+	), "import")
+
+	backupsHandler := srv.monitoredHandler(handlersbackups.NewBackupsHandler(
+		corebackups.BackupDir(srv.dataDir),
+	), "backups")
+
+	sshRecordingsHandler := srv.monitoredHandler(sshrecordings.NewSSHRecordingsHandler(
+		&sshRecordingsServicesGetter{ctxt: httpCtxt},
+	), "ssh-recordings")
+
 	modelToolsUploadHandler := srv.monitoredHandler(newToolsUploadHandler(
 		BlockCheckerGetterForServices(httpCtxt.domainServicesForRequestContext),
 		modelAgentBinaryStoreForHTTPContext(httpCtxt),
@@ -962,6 +1007,11 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		pattern:         modelRoutePrefix + "/tools/:version",
 		handler:         modelToolsDownloadHandler,
 		unauthenticated: true,
+	}, {
+		pattern:    modelRoutePrefix + "/export",
+		methods:    []string{"GET"},
+		handler:    modelExportHandler,
+		authorizer: modelExportAuthorizer,
 	}, {
 		pattern:    modelRoutePrefix + "/applications/:application/resources/:resource",
 		handler:    resourcesHandler,
@@ -970,6 +1020,26 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		pattern:    modelRoutePrefix + "/units/:unit/resources/:resource",
 		handler:    unitResourcesHandler,
 		authorizer: httpcontext.TODOAuthorizer,
+	}, {
+		pattern:    modelRoutePrefix + "/backups",
+		methods:    []string{"GET"},
+		handler:    backupsHandler,
+		authorizer: controllerAdminAuthorizer,
+	}, {
+		pattern:    "/model-import",
+		methods:    []string{"POST"},
+		handler:    modelImportHandler,
+		authorizer: controllerAdminAuthorizer,
+	}, {
+		pattern:    "/ssh-recordings",
+		methods:    []string{"GET"},
+		handler:    sshRecordingsHandler,
+		authorizer: controllerAdminAuthorizer,
+	}, {
+		pattern:    "/ssh-recordings/:uuid",
+		methods:    []string{"GET"},
+		handler:    sshRecordingsHandler,
+		authorizer: controllerAdminAuthorizer,
 	}, {
 		pattern:    "/migrate/charms/:object",
 		handler:    migrateObjectsCharmsHTTPHandler,
@@ -1480,6 +1550,98 @@ func (a *objectStoreServiceGetter) ObjectStore(r *http.Request) (objects.ObjectS
 	return objectStore, nil
 }
 
+type modelExportServicesGetter struct {
+	ctxt httpContext
+}
+
+func (a *modelExportServicesGetter) ExportService(r *http.Request) (modelexport.ExportService, error) {
+	domainServices, err := a.ctxt.domainServicesForRequest(r)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.Export(), nil
+}
+
+func (a *modelExportServicesGetter) ObjectStore(r *http.Request) (modelexport.ObjectStore, error) {
+	objectStore, err := a.ctxt.objectStoreForRequest(r.Context())
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return objectStore, nil
+}
+
+type modelImportServicesGetter struct {
+	ctxt httpContext
+}
+
+func (a *modelImportServicesGetter) Importer(r *http.Request) (coreuser.UUID, error) {
+	tag, err := a.ctxt.authenticatedUserFromRequest(r)
+	if err != nil {
+		return "", internalerrors.Capture(err)
+	}
+	domainServices, err := a.ctxt.domainServicesForRequest(r)
+	if err != nil {
+		return "", internalerrors.Capture(err)
+	}
+	user, err := domainServices.Access().GetUserByName(r.Context(), coreuser.NameFromTag(tag.(names.UserTag)))
+	if err != nil {
+		return "", internalerrors.Capture(err)
+	}
+	return user.UUID, nil
+}
+
+func (a *modelImportServicesGetter) ModelImportService(r *http.Request) (modelimport.ModelImportService, error) {
+	domainServices, err := a.ctxt.domainServicesForRequest(r)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.ModelImport(), nil
+}
+
+func (a *modelImportServicesGetter) ImportService(ctx context.Context, modelUUID coremodel.UUID) (modelimport.ImportService, error) {
+	domainServices, err := a.ctxt.srv.shared.domainServicesGetter.ServicesForModel(ctx, modelUUID)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.Export(), nil
+}
+
+func (a *modelImportServicesGetter) ModelMigrationService(ctx context.Context, modelUUID coremodel.UUID) (modelimport.ModelMigrationService, error) {
+	domainServices, err := a.ctxt.srv.shared.domainServicesGetter.ServicesForModel(ctx, modelUUID)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.ModelMigration(), nil
+}
+
+func (a *modelImportServicesGetter) RemovalService(ctx context.Context, modelUUID coremodel.UUID) (modelimport.RemovalService, error) {
+	domainServices, err := a.ctxt.srv.shared.domainServicesGetter.ServicesForModel(ctx, modelUUID)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.Removal(), nil
+}
+
+func (a *modelImportServicesGetter) ObjectStore(ctx context.Context, modelUUID coremodel.UUID) (modelimport.ObjectStore, error) {
+	objectStore, err := a.ctxt.srv.shared.objectStoreGetter.GetObjectStore(ctx, modelUUID.String())
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return objectStore, nil
+}
+
+type sshRecordingsServicesGetter struct {
+	ctxt httpContext
+}
+
+func (a *sshRecordingsServicesGetter) RecordingService(r *http.Request) (sshrecordings.RecordingService, error) {
+	domainServices, err := a.ctxt.domainServicesForRequest(r)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.SSHRecording(), nil
+}
+
 type domainServiceGetter func(r *http.Request) (services.DomainServices, error)
 
 type resourcesModelServiceGetter struct {
@@ -40,7 +40,7 @@ func (s *apiservermetricsSuite) TestDescribe(c *tc.C) {
 	for desc := range ch {
 		descs = append(descs, desc)
 	}
-	c.Assert(descs, tc.HasLen, 11)
+	c.Assert(descs, tc.HasLen, 12)
 	c.Assert(descs[0].String(), tc.Matches, `.*fqName: "juju_apiserver_connections_total".*`)
 	c.Assert(descs[1].String(), tc.Matches, `.*fqName: "juju_apiserver_connections".*`)
 	c.Assert(descs[2].String(), tc.Matches, `.*fqName: "juju_apiserver_active_login_attempts".*`)
@@ -52,7 +52,8 @@ func (s *apiservermetricsSuite) TestDescribe(c *tc.C) {
 	c.Assert(descs[7].String(), tc.Matches, `.*fqName: "juju_apiserver_outbound_requests_total".*`)
 	c.Assert(descs[8].String(), tc.Matches, `.*fqName: "juju_apiserver_outbound_request_errors_total".*`)
 	c.Assert(descs[9].String(), tc.Matches, `.*fqName: "juju_apiserver_outbound_request_duration_seconds".*`)
-	build_info_description := descs[10].String()
+	c.Assert(descs[10].String(), tc.Matches, `.*fqName: "juju_apiserver_ratelimited_requests_total".*`)
+	build_info_description := descs[11].String()
 	c.Check(build_info_description, tc.Matches, `.*fqName: "juju_apiserver_build_info".*`)
 	// Ensure that the current version of the Juju controller is one of the const labels on the
 	//build_info metric.
@@ -108,6 +109,11 @@ func (s *apiservermetricsSuite) TestLabelNames(c *tc.C) {
 			labels:  apiserver.MetricTotalRequestsLabelNames,
 			checker: tc.IsTrue,
 		},
+		{
+			name:    "rate limited requests label names",
+			labels:  apiserver.MetricRateLimitedRequestsLabelNames,
+			checker: tc.IsTrue,
+		},
 		{
 			name:    "invalid names",
 			labels:  []string{"model-uuid"},
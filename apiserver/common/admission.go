@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/admission"
+	coremodel "github.com/juju/juju/core/model"
+)
+
+// ModelInfoService provides information about the current model.
+type ModelInfoService interface {
+	// GetModelInfo returns the read only model information for the current
+	// model.
+	GetModelInfo(context.Context) (coremodel.ModelInfo, error)
+}
+
+// AdmissionChecker checks changes to a model against the admission policy
+// held in controller config.
+type AdmissionChecker struct {
+	controllerConfigService ControllerConfigService
+	modelInfoService        ModelInfoService
+	user                    string
+}
+
+// NewAdmissionChecker returns a new AdmissionChecker for changes made by the
+// specified user to the current model.
+func NewAdmissionChecker(
+	controllerConfigService ControllerConfigService,
+	modelInfoService ModelInfoService,
+	user string,
+) *AdmissionChecker {
+	return &AdmissionChecker{
+		controllerConfigService: controllerConfigService,
+		modelInfoService:        modelInfoService,
+		user:                    user,
+	}
+}
+
+// Admit checks the request against the admission policy, filling in the
+// user and model. If the request is denied, an error satisfying
+// [admission.PolicyDenied] is returned, holding the reason.
+func (c *AdmissionChecker) Admit(ctx context.Context, req admission.Request) error {
+	cfg, err := c.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	policy := cfg.AdmissionPolicy()
+	if len(policy) == 0 {
+		return nil
+	}
+
+	modelInfo, err := c.modelInfoService.GetModelInfo(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.User = c.user
+	req.Model = modelInfo.Name
+	return policy.Admit(req)
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/common/mocks"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/admission"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/rpc/params"
+)
+
+type admissionCheckerSuite struct {
+	controllerConfigService *mocks.MockControllerConfigService
+	modelInfoService        *mocks.MockModelInfoService
+	checker                 *common.AdmissionChecker
+}
+
+func TestAdmissionCheckerSuite(t *testing.T) {
+	tc.Run(t, &admissionCheckerSuite{})
+}
+
+const testAdmissionPolicy = `
+- name: no-expose-on-production
+  operations: [expose]
+  match:
+    model: ["prod-*"]
+    user: [fred]
+  reason: applications cannot be exposed on production models
+`
+
+func (s *admissionCheckerSuite) TestAdmitNoPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil)
+
+	err := s.checker.Admit(c.Context(), admission.Request{Operation: admission.Expose})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *admissionCheckerSuite) TestAdmitDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.AdmissionPolicy: testAdmissionPolicy,
+	}, nil)
+	s.modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{Name: "prod-db"}, nil)
+
+	err := s.checker.Admit(c.Context(), admission.Request{Operation: admission.Expose, Application: "mysql"})
+	c.Assert(err, tc.ErrorIs, admission.PolicyDenied)
+	c.Check(err, tc.ErrorMatches, `operation "expose" denied by admission policy rule "no-expose-on-production": .*`)
+
+	// The denial is reported to clients as forbidden, with the reason.
+	serverErr := apiservererrors.ServerError(err)
+	c.Check(serverErr.Code, tc.Equals, params.CodeForbidden)
+	c.Check(serverErr.Message, tc.Equals, err.Error())
+}
+
+func (s *admissionCheckerSuite) TestAdmitAllowed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.AdmissionPolicy: testAdmissionPolicy,
+	}, nil)
+	s.modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{Name: "staging"}, nil)
+
+	err := s.checker.Admit(c.Context(), admission.Request{Operation: admission.Expose, Application: "mysql"})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *admissionCheckerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.controllerConfigService = mocks.NewMockControllerConfigService(ctrl)
+	s.modelInfoService = mocks.NewMockModelInfoService(ctrl)
+	s.checker = common.NewAdmissionChecker(s.controllerConfigService, s.modelInfoService, "fred")
+	return ctrl
+}
@@ -3,7 +3,14 @@
 
 package common
 
-import "time"
+import (
+	"time"
+
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/rpc/params"
+)
 
 // MaxWait is how far in the future the backstop force cleanup will be scheduled.
 // Default is 1min if no value is provided.
@@ -13,3 +20,43 @@ func MaxWait(in *time.Duration) time.Duration {
 	}
 	return 1 * time.Minute
 }
+
+// RemovalPlan converts a removal plan from the removal domain into its wire
+// representation.
+func RemovalPlan(plan removal.Plan) *params.RemovalPlan {
+	return &params.RemovalPlan{
+		Applications:     plannedRemovals(plan.Applications),
+		Units:            plannedRemovals(plan.Units),
+		Relations:        plannedRemovals(plan.Relations),
+		Machines:         plannedRemovals(plan.Machines),
+		Offers:           plannedRemovals(plan.Offers),
+		DestroyedStorage: plannedRemovals(plan.DestroyedStorage),
+		DetachedStorage:  plannedRemovals(plan.DetachedStorage),
+	}
+}
+
+func plannedRemovals(planned []removal.PlannedRemoval) []params.PlannedRemoval {
+	if len(planned) == 0 {
+		return nil
+	}
+	result := make([]params.PlannedRemoval, len(planned))
+	for i, p := range planned {
+		result[i] = params.PlannedRemoval{
+			Name:          p.Name,
+			ForceRequired: p.ForceRequired,
+		}
+	}
+	return result
+}
+
+// StorageEntities returns the tags of the planned storage instance removals.
+func StorageEntities(planned []removal.PlannedRemoval) []params.Entity {
+	var entities []params.Entity
+	for _, p := range planned {
+		if !names.IsValidStorage(p.Name) {
+			continue
+		}
+		entities = append(entities, params.Entity{Tag: names.NewStorageTag(p.Name).String()})
+	}
+	return entities
+}
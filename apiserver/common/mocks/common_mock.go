@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/common (interfaces: BlockCommandService,CloudService,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ModelAgentService,MachineRebootService,WatchableMachineService,ApplicationService,MachineService,StatusService,AgentPasswordService,AgentBinaryService,ModelService)
+// Source: github.com/juju/juju/apiserver/common (interfaces: BlockCommandService,CloudService,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ModelAgentService,MachineRebootService,WatchableMachineService,ApplicationService,MachineService,StatusService,AgentPasswordService,AgentBinaryService,ModelService,ModelInfoService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/common_mock.go github.com/juju/juju/apiserver/common BlockCommandService,CloudService,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ModelAgentService,MachineRebootService,WatchableMachineService,ApplicationService,MachineService,StatusService,AgentPasswordService,AgentBinaryService,ModelService
+//	mockgen -typed -package mocks -destination mocks/common_mock.go github.com/juju/juju/apiserver/common BlockCommandService,CloudService,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ModelAgentService,MachineRebootService,WatchableMachineService,ApplicationService,MachineService,StatusService,AgentPasswordService,AgentBinaryService,ModelService,ModelInfoService
 //
 
 // Package mocks is a generated GoMock package.
@@ -1718,3 +1718,65 @@ func (c *MockModelServiceModelRedirectionCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockModelInfoService is a mock of ModelInfoService interface.
+type MockModelInfoService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelInfoServiceMockRecorder
+}
+
+// MockModelInfoServiceMockRecorder is the mock recorder for MockModelInfoService.
+type MockModelInfoServiceMockRecorder struct {
+	mock *MockModelInfoService
+}
+
+// NewMockModelInfoService creates a new mock instance.
+func NewMockModelInfoService(ctrl *gomock.Controller) *MockModelInfoService {
+	mock := &MockModelInfoService{ctrl: ctrl}
+	mock.recorder = &MockModelInfoServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelInfoService) EXPECT() *MockModelInfoServiceMockRecorder {
+	return m.recorder
+}
+
+// GetModelInfo mocks base method.
+func (m *MockModelInfoService) GetModelInfo(arg0 context.Context) (model.ModelInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelInfo", arg0)
+	ret0, _ := ret[0].(model.ModelInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelInfo indicates an expected call of GetModelInfo.
+func (mr *MockModelInfoServiceMockRecorder) GetModelInfo(arg0 any) *MockModelInfoServiceGetModelInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelInfo", reflect.TypeOf((*MockModelInfoService)(nil).GetModelInfo), arg0)
+	return &MockModelInfoServiceGetModelInfoCall{Call: call}
+}
+
+// MockModelInfoServiceGetModelInfoCall wrap *gomock.Call
+type MockModelInfoServiceGetModelInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelInfoServiceGetModelInfoCall) Return(arg0 model.ModelInfo, arg1 error) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelInfoServiceGetModelInfoCall) Do(f func(context.Context) (model.ModelInfo, error)) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelInfoServiceGetModelInfoCall) DoAndReturn(f func(context.Context) (model.ModelInfo, error)) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -189,6 +189,7 @@ func toChangeSecretBackendParams(accessor secret.SecretAccessor, arg params.Chan
 	params := secretservice.ChangeSecretBackendParams{
 		Accessor: accessor,
 		Data:     arg.Content.Data,
+		Checksum: arg.Checksum,
 	}
 	if arg.Content.ValueRef != nil {
 		params.ValueRef = &coresecrets.ValueRef{
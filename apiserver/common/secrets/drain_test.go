@@ -270,6 +270,7 @@ func (s *secretsDrainSuite) TestChangeSecretBackend(c *tc.C) {
 				BackendID:  "backend-id",
 				RevisionID: "rev-666",
 			},
+			Checksum: "checksum-666",
 		},
 	).Return(nil)
 	s.secretService.EXPECT().ChangeSecretBackend(
@@ -296,6 +297,7 @@ func (s *secretsDrainSuite) TestChangeSecretBackend(c *tc.C) {
 						RevisionID: "rev-666",
 					},
 				},
+				Checksum: "checksum-666",
 			},
 			{
 				URI:      uri2.String(),
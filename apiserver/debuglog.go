@@ -39,6 +39,7 @@ type debugLogHandler struct {
 	authorizer    authentication.Authorizer
 	handle        debugLogHandlerFunc
 	logDir        string
+	store         *logtailer.LogStore
 }
 
 type debugLogHandlerFunc func(
@@ -47,6 +48,7 @@ type debugLogHandlerFunc func(
 	debugLogParams,
 	debugLogSocket,
 	logTailerFunc,
+	logHistogramFunc,
 	<-chan struct{},
 ) error
 
@@ -63,6 +65,9 @@ func newDebugLogHandler(
 		authorizer:    authorizer,
 		handle:        handle,
 		logDir:        logDir,
+		// TODO (stickupkid): This should come from the logsink directly, to
+		// prevent unfettered access.
+		store: logtailer.NewLogStore(filepath.Join(logDir, "logsink.log")),
 	}
 }
 
@@ -95,6 +100,13 @@ func newDebugLogHandler(
 //	replay -> string - one of [true, false], if true, start the file from the start
 //	noTail -> string - one of [true, false], if true, existing logs are sent back,
 //	   - but the command does not wait for new ones.
+//	startTime -> string - only send lines logged at or after this RFC3339 time
+//	endTime -> string - only send lines logged at or before this RFC3339 time
+//	   - implies noTail
+//	offset -> uint - skip this many matching lines before sending any
+//	   - counted from the oldest line, or from the newest when used with backlog
+//	histogram -> string - one of [true, false], if true, the number of matching
+//	   - lines at each level is sent instead of the lines themselves.
 func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	handler := func(conn *websocket.Conn) {
 		socket := &debugLogSocketImpl{conn: conn}
@@ -124,14 +136,13 @@ func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		maxDuration := h.ctxt.srv.shared.maxDebugLogDuration()
 
 		logTailerFunc := func(p logtailer.LogTailerParams) (logtailer.LogTailer, error) {
-			// TODO (stickupkid): This should come from the logsink directly, to
-			// prevent unfettered access.
-			logFile := filepath.Join(h.logDir, "logsink.log")
 			if p.Firehose {
 				modelUUID = ""
 			}
-
-			return logtailer.NewLogTailer(modelUUID, logFile, p)
+			return logtailer.NewIndexedLogTailer(modelUUID, h.store, p)
+		}
+		logHistogramFunc := func(p logtailer.LogTailerParams) (map[corelogger.Level]int, error) {
+			return h.store.Histogram(req.Context(), modelUUID, p)
 		}
 
 		// This should really use a tomb, then we don't have to do this song
@@ -146,7 +157,7 @@ func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			}
 		}()
 
-		if err := h.handle(clock, maxDuration, params, socket, logTailerFunc, done); err != nil {
+		if err := h.handle(clock, maxDuration, params, socket, logTailerFunc, logHistogramFunc, done); err != nil {
 			if isBrokenPipe(err) {
 				logger.Tracef(req.Context(), "debug-log handler stopped (client disconnected)")
 			} else {
@@ -179,6 +190,9 @@ type debugLogSocket interface {
 
 	// sendLogRecord sends record JSON encoded.
 	sendLogRecord(*params.LogMessage, int) error
+
+	// sendHistogram sends histogram JSON encoded.
+	sendHistogram(*params.LogHistogram) error
 }
 
 // debugLogSocketImpl implements the debugLogSocket interface. It
@@ -221,10 +235,18 @@ func (s *debugLogSocketImpl) sendLogRecord(record *params.LogMessage, version in
 	return s.conn.WriteJSON(record)
 }
 
+// sendHistogram implements debugLogSocket.
+func (s *debugLogSocketImpl) sendHistogram(histogram *params.LogHistogram) error {
+	return s.conn.WriteJSON(histogram)
+}
+
 // debugLogParams contains the parsed debuglog API request parameters.
 type debugLogParams struct {
 	version       int
 	startTime     time.Time
+	endTime       time.Time
+	offset        uint
+	histogram     bool
 	fromTheStart  bool
 	noTail        bool
 	firehose      bool
@@ -307,6 +329,32 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.startTime = startTime
 	}
 
+	if value := queryMap.Get("endTime"); value != "" {
+		endTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return params, errors.Errorf("end time %q is not a valid time in RFC3339 format", value)
+		}
+		params.endTime = endTime
+		// No records logged from now on can fall within the range.
+		params.noTail = true
+	}
+
+	if value := queryMap.Get("offset"); value != "" {
+		offset, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return params, errors.Errorf("offset value %q is not a valid unsigned number", value)
+		}
+		params.offset = uint(offset)
+	}
+
+	if value := queryMap.Get("histogram"); value != "" {
+		histogram, err := strconv.ParseBool(value)
+		if err != nil {
+			return params, errors.Errorf("histogram value %q is not a valid boolean", value)
+		}
+		params.histogram = histogram
+	}
+
 	params.includeEntity = queryMap["includeEntity"]
 	params.excludeEntity = queryMap["excludeEntity"]
 	params.includeModule = queryMap["includeModule"]
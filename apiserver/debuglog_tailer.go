@@ -28,15 +28,22 @@ func newDebugLogTailerHandler(
 
 type logTailerFunc func(logtailer.LogTailerParams) (logtailer.LogTailer, error)
 
+type logHistogramFunc func(logtailer.LogTailerParams) (map[corelogger.Level]int, error)
+
 func handleDebugLogRequest(
 	clock clock.Clock,
 	maxDuration time.Duration,
 	reqParams debugLogParams,
 	socket debugLogSocket,
 	logTailerFunc logTailerFunc,
+	logHistogramFunc logHistogramFunc,
 	stop <-chan struct{},
 ) error {
 	tailerParams := makeLogTailerParams(reqParams)
+	if reqParams.histogram {
+		return handleDebugLogHistogram(tailerParams, socket, logHistogramFunc)
+	}
+
 	tailer, err := logTailerFunc(tailerParams)
 	if err != nil {
 		return errors.Trace(err)
@@ -74,12 +81,37 @@ func handleDebugLogRequest(
 	}
 }
 
+func handleDebugLogHistogram(
+	tailerParams logtailer.LogTailerParams,
+	socket debugLogSocket,
+	logHistogramFunc logHistogramFunc,
+) error {
+	counts, err := logHistogramFunc(tailerParams)
+	if err != nil {
+		socket.sendError(err)
+		return errors.Trace(err)
+	}
+
+	// Indicate that all is well.
+	socket.sendOk()
+
+	histogram := &params.LogHistogram{
+		Counts: make(map[string]int, len(counts)),
+	}
+	for level, count := range counts {
+		histogram.Counts[level.String()] = count
+	}
+	return errors.Annotate(socket.sendHistogram(histogram), "sending failed")
+}
+
 func makeLogTailerParams(reqParams debugLogParams) logtailer.LogTailerParams {
 	return logtailer.LogTailerParams{
 		MinLevel:      reqParams.filterLevel,
 		NoTail:        reqParams.noTail,
 		Firehose:      reqParams.firehose,
 		StartTime:     reqParams.startTime,
+		EndTime:       reqParams.endTime,
+		Offset:        int(reqParams.offset),
 		InitialLines:  int(reqParams.initialLines),
 		IncludeEntity: reqParams.includeEntity,
 		ExcludeEntity: reqParams.excludeEntity,
@@ -25,6 +25,7 @@ var allowedEmbeddedCommands = []string{
 	"consume",
 	"controller-config",
 	"create-storage-pool",
+	"create-storage-snapshot",
 	"credentials",
 	"deploy",
 	"detach-storage",
@@ -60,6 +61,7 @@ var allowedEmbeddedCommands = []string{
 	"remove-unit",
 	"remove-user",
 	"rename-space",
+	"resize-storage",
 	"resolved",
 	"resolve",
 	"resources",
@@ -91,6 +93,7 @@ var allowedEmbeddedCommands = []string{
 	"status",
 	"storage",
 	"storage-pools",
+	"storage-snapshots",
 	"subnets",
 	"suspend-relation",
 	"trust",
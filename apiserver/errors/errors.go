@@ -134,6 +134,8 @@ func ServerErrorAndStatus(err error) (*params.Error, int) {
 		status = http.StatusUnauthorized
 	case params.CodeRedirect:
 		status = http.StatusMovedPermanently
+	case params.CodeRateLimitExceeded:
+		status = http.StatusTooManyRequests
 	case params.CodeNotYetAvailable:
 		// The request could not be completed due to a conflict with
 		// the current state of the resource. This code is only allowed
@@ -169,6 +171,7 @@ func ServerError(err error) *params.Error {
 		notLeaderError         *NotLeaderError
 		redirectError          *RedirectError
 		accessRequiredError    *AccessRequiredError
+		rateLimitExceededError *RateLimitExceededError
 	)
 	// Skip past annotations when looking for the code.
 	err = errors.Cause(err)
@@ -265,6 +268,9 @@ func ServerError(err error) *params.Error {
 	case errors.As(err, &accessRequiredError):
 		code = params.CodeAccessRequired
 		info = accessRequiredError.AsMap()
+	case errors.As(err, &rateLimitExceededError):
+		code = params.CodeRateLimitExceeded
+		info = rateLimitExceededError.AsMap()
 	default:
 		code = params.ErrCode(err)
 	}
@@ -369,6 +375,16 @@ func RestoreError(err error) error {
 		return fmt.Errorf(msg+"%w", errors.Hide(DeadlineExceededError))
 	case params.IsCodeTryAgain(err):
 		return ErrTryAgain
+	case params.IsCodeRateLimitExceeded(err):
+		e, ok := err.(*params.Error)
+		if !ok {
+			return err
+		}
+		var info params.RateLimitExceededErrorInfo
+		if err := e.UnmarshalInfo(&info); err != nil {
+			return err
+		}
+		return &RateLimitExceededError{RetryAfter: info.RetryAfter}
 	default:
 		// Handle all other codes here.
 		return params.TranslateWellKnownError(err)
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"reflect"
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -243,6 +244,14 @@ var errorTransformTests = []struct {
 	err:    apiservererrors.DeadlineExceededError,
 	code:   params.CodeDeadlineExceeded,
 	status: http.StatusInternalServerError,
+}, {
+	err:        &apiservererrors.RateLimitExceededError{RetryAfter: time.Second},
+	code:       params.CodeRateLimitExceeded,
+	status:     http.StatusTooManyRequests,
+	helperFunc: params.IsCodeRateLimitExceeded,
+	targetTester: func(e error) bool {
+		return errors.HasType[*apiservererrors.RateLimitExceededError](e)
+	},
 }, {
 	err:    nil,
 	code:   "",
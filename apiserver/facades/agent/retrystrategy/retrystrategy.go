@@ -83,8 +83,8 @@ func (h *RetryStrategyAPI) RetryStrategy(ctx context.Context, args params.Entiti
 		}
 		err = apiservererrors.ErrPerm
 		if canAccess(tag) {
-			// Right now the only real configurable value is ShouldRetry,
-			// which is taken from the model.
+			// Right now the only real configurable values are ShouldRetry
+			// and ShouldRetryTimedOut, which are taken from the model.
 			// The rest are hardcoded.
 			results.Results[i].Result = &params.RetryStrategy{
 				ShouldRetry:     config.AutomaticallyRetryHooks(),
@@ -92,6 +92,8 @@ func (h *RetryStrategyAPI) RetryStrategy(ctx context.Context, args params.Entiti
 				MaxRetryTime:    MaxRetryTime,
 				JitterRetryTime: JitterRetryTime,
 				RetryTimeFactor: RetryTimeFactor,
+
+				ShouldRetryTimedOut: config.AutomaticallyRetryTimedOutHooks(),
 			}
 			err = nil
 		}
@@ -171,6 +171,29 @@ func (s *retryStrategySuite) assertRetryStrategy(c *tc.C, tag string) {
 	c.Assert(r.Results[0].Result, tc.DeepEquals, expected)
 }
 
+func (s *retryStrategySuite) TestRetryStrategyTimedOutHooks(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "unit-mysql-0"}}}
+
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(
+		config.New(false, map[string]any{
+			"name":                         "donotuse",
+			"type":                         "donotuse",
+			"uuid":                         "00000000-0000-0000-0000-000000000000",
+			config.AutomaticallyRetryHooks: false,
+			config.AutomaticallyRetryTimedOutHooksKey: true,
+		}),
+	)
+	r, err := s.strategy.RetryStrategy(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(r.Results, tc.HasLen, 1)
+	c.Assert(r.Results[0].Error, tc.IsNil)
+	c.Check(r.Results[0].Result.ShouldRetry, tc.IsFalse)
+	c.Check(r.Results[0].Result.ShouldRetryTimedOut, tc.IsTrue)
+}
+
 func (s *retryStrategySuite) TestWatchRetryStrategyUnauthenticated(c *tc.C) {
 	ctrl := s.setupAPI(c)
 	defer ctrl.Finish()
@@ -967,6 +967,45 @@ func (c *MockSecretBackendServiceDrainBackendConfigInfoCall) DoAndReturn(f func(
 	return c
 }
 
+// GetBackendForToken mocks base method.
+func (m *MockSecretBackendService) GetBackendForToken(ctx context.Context, p service0.BackendForTokenParams) (provider.SecretsBackend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackendForToken", ctx, p)
+	ret0, _ := ret[0].(provider.SecretsBackend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackendForToken indicates an expected call of GetBackendForToken.
+func (mr *MockSecretBackendServiceMockRecorder) GetBackendForToken(ctx, p any) *MockSecretBackendServiceGetBackendForTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackendForToken", reflect.TypeOf((*MockSecretBackendService)(nil).GetBackendForToken), ctx, p)
+	return &MockSecretBackendServiceGetBackendForTokenCall{Call: call}
+}
+
+// MockSecretBackendServiceGetBackendForTokenCall wrap *gomock.Call
+type MockSecretBackendServiceGetBackendForTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendServiceGetBackendForTokenCall) Return(arg0 provider.SecretsBackend, arg1 error) *MockSecretBackendServiceGetBackendForTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendServiceGetBackendForTokenCall) Do(f func(context.Context, service0.BackendForTokenParams) (provider.SecretsBackend, error)) *MockSecretBackendServiceGetBackendForTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendServiceGetBackendForTokenCall) DoAndReturn(f func(context.Context, service0.BackendForTokenParams) (provider.SecretsBackend, error)) *MockSecretBackendServiceGetBackendForTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockApplicationService is a mock of ApplicationService interface.
 type MockApplicationService struct {
 	ctrl     *gomock.Controller
@@ -24,10 +24,21 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("SecretsManager", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretManagerAPIV4(stdCtx, ctx)
+	}, reflect.TypeFor[*SecretsManagerAPIV4]())
+	registry.MustRegister("SecretsManager", 5, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return NewSecretManagerAPI(stdCtx, ctx)
 	}, reflect.TypeFor[*SecretsManagerAPI]())
 }
 
+func newSecretManagerAPIV4(stdCtx context.Context, ctx facade.ModelContext) (*SecretsManagerAPIV4, error) {
+	api, err := NewSecretManagerAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretsManagerAPIV4{SecretsManagerAPI: api}, nil
+}
+
 // NewSecretManagerAPI creates a SecretsManagerAPI.
 func NewSecretManagerAPI(_ context.Context, ctx facade.ModelContext) (*SecretsManagerAPI, error) {
 	if !ctx.Auth().AuthUnitAgent() {
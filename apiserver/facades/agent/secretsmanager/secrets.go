@@ -67,6 +67,21 @@ type SecretsManagerAPI struct {
 	logger logger.Logger
 }
 
+// SecretsManagerAPIV4 implements version (v4) of the SecretsManager API,
+// which does not support accessing backend content through the controller.
+type SecretsManagerAPIV4 struct {
+	*SecretsManagerAPI
+}
+
+// GetBackendContent isn't on the v4 API.
+func (*SecretsManagerAPIV4) GetBackendContent(_, _ struct{}) {}
+
+// SaveBackendContent isn't on the v4 API.
+func (*SecretsManagerAPIV4) SaveBackendContent(_, _ struct{}) {}
+
+// DeleteBackendContent isn't on the v4 API.
+func (*SecretsManagerAPIV4) DeleteBackendContent(_, _ struct{}) {}
+
 // GetSecretBackendConfigs gets the config needed to create a client to secret backends.
 func (s *SecretsManagerAPI) GetSecretBackendConfigs(ctx context.Context, arg params.SecretBackendArgs) (params.SecretBackendConfigResults, error) {
 	if arg.ForDrain {
@@ -547,6 +562,97 @@ func (s *SecretsManagerAPI) GetSecretRevisionContentInfo(ctx context.Context, ar
 	return result, nil
 }
 
+// GetBackendContent returns the content of secret revisions held in
+// backends which agents access through the controller, as permitted by
+// the access tokens issued to the agent.
+func (s *SecretsManagerAPI) GetBackendContent(ctx context.Context, args params.BackendContentArgs) (params.SecretValueResults, error) {
+	result := params.SecretValueResults{
+		Results: make([]params.SecretValueResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		backend, err := s.getBackendForToken(ctx, arg)
+		if err != nil {
+			result.Results[i].Error = backendContentError(err)
+			continue
+		}
+		val, err := backend.GetContent(ctx, arg.RevisionID)
+		if err != nil {
+			result.Results[i].Error = backendContentError(err)
+			continue
+		}
+		result.Results[i].Data = val.EncodedValues()
+	}
+	return result, nil
+}
+
+// SaveBackendContent saves the content of secret revisions to backends which
+// agents access through the controller, as permitted by the access tokens
+// issued to the agent, returning the IDs of the saved revisions.
+func (s *SecretsManagerAPI) SaveBackendContent(ctx context.Context, args params.BackendContentArgs) (params.StringResults, error) {
+	result := params.StringResults{
+		Results: make([]params.StringResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		uri, err := coresecrets.ParseURI(arg.URI)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		backend, err := s.getBackendForToken(ctx, arg)
+		if err != nil {
+			result.Results[i].Error = backendContentError(err)
+			continue
+		}
+		revisionID, err := backend.SaveContent(ctx, uri, arg.Revision, coresecrets.NewSecretValue(arg.Data))
+		if err != nil {
+			result.Results[i].Error = backendContentError(err)
+			continue
+		}
+		result.Results[i].Result = revisionID
+	}
+	return result, nil
+}
+
+// DeleteBackendContent deletes the content of secret revisions from backends
+// which agents access through the controller, as permitted by the access
+// tokens issued to the agent.
+func (s *SecretsManagerAPI) DeleteBackendContent(ctx context.Context, args params.BackendContentArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		backend, err := s.getBackendForToken(ctx, arg)
+		if err == nil {
+			err = backend.DeleteContent(ctx, arg.RevisionID)
+		}
+		if err != nil {
+			result.Results[i].Error = backendContentError(err)
+		}
+	}
+	return result, nil
+}
+
+func (s *SecretsManagerAPI) getBackendForToken(ctx context.Context, arg params.BackendContentArg) (secretsprovider.SecretsBackend, error) {
+	return s.secretBackendService.GetBackendForToken(ctx, secretbackendservice.BackendForTokenParams{
+		Accessor: secret.SecretAccessor{
+			Kind: secret.UnitAccessor,
+			ID:   s.authTag.Id(),
+		},
+		ModelUUID: model.UUID(s.modelUUID),
+		BackendID: arg.BackendID,
+		Token:     arg.Token,
+	})
+}
+
+// backendContentError returns the API error for accessing backend content,
+// reporting denied access as such.
+func backendContentError(err error) *params.Error {
+	if errors.Is(err, secrets.PermissionDenied) {
+		return apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	return apiservererrors.ServerError(err)
+}
+
 func (s *SecretsManagerAPI) getSecretContent(ctx context.Context, arg params.GetSecretContentArg) (
 	*secrets.ContentParams, *secretsprovider.ModelBackendConfig, bool, error,
 ) {
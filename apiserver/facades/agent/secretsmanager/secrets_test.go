@@ -29,6 +29,7 @@ import (
 	secretservice "github.com/juju/juju/domain/secret/service"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	"github.com/juju/juju/internal/secrets"
+	secretsmocks "github.com/juju/juju/internal/secrets/mocks"
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/testhelpers"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -1386,3 +1387,93 @@ func (s *SecretsManagerSuite) TestGetSecretContentCrossModelExistingConsumerMigr
 		}},
 	})
 }
+
+func (s *SecretsManagerSuite) tokenParams(backendID string) secretbackendservice.BackendForTokenParams {
+	return secretbackendservice.BackendForTokenParams{
+		Accessor: secret.SecretAccessor{
+			Kind: secret.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		ModelUUID: model.UUID(coretesting.ModelTag.Id()),
+		BackendID: backendID,
+		Token:     "token",
+	}
+}
+
+func (s *SecretsManagerSuite) TestGetBackendContent(c *tc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	backend := secretsmocks.NewMockSecretsBackend(ctrl)
+	s.secretBackendService.EXPECT().GetBackendForToken(gomock.Any(), s.tokenParams("backend-id")).Return(backend, nil)
+	backend.EXPECT().GetContent(gomock.Any(), "rev-id").Return(coresecrets.NewSecretValue(map[string]string{"foo": "bar"}), nil)
+	s.secretBackendService.EXPECT().GetBackendForToken(gomock.Any(), s.tokenParams("other-id")).
+		Return(nil, errors.WithType(errors.New("boom"), secrets.PermissionDenied))
+
+	results, err := s.facade.GetBackendContent(c.Context(), params.BackendContentArgs{
+		Args: []params.BackendContentArg{{
+			BackendID:  "backend-id",
+			Token:      "token",
+			RevisionID: "rev-id",
+		}, {
+			BackendID:  "other-id",
+			Token:      "token",
+			RevisionID: "rev-id",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, params.SecretValueResults{
+		Results: []params.SecretValueResult{{
+			Data: map[string]string{"foo": "bar"},
+		}, {
+			Error: &params.Error{Code: params.CodeUnauthorized, Message: "permission denied"},
+		}},
+	})
+}
+
+func (s *SecretsManagerSuite) TestSaveBackendContent(c *tc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	uri := coresecrets.NewURI()
+	backend := secretsmocks.NewMockSecretsBackend(ctrl)
+	s.secretBackendService.EXPECT().GetBackendForToken(gomock.Any(), s.tokenParams("backend-id")).Return(backend, nil)
+	backend.EXPECT().SaveContent(gomock.Any(), uri, 2, coresecrets.NewSecretValue(map[string]string{"foo": "bar"})).Return("rev-id", nil)
+
+	results, err := s.facade.SaveBackendContent(c.Context(), params.BackendContentArgs{
+		Args: []params.BackendContentArg{{
+			BackendID: "backend-id",
+			Token:     "token",
+			URI:       uri.String(),
+			Revision:  2,
+			Data:      map[string]string{"foo": "bar"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, params.StringResults{
+		Results: []params.StringResult{{Result: "rev-id"}},
+	})
+}
+
+func (s *SecretsManagerSuite) TestDeleteBackendContent(c *tc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	backend := secretsmocks.NewMockSecretsBackend(ctrl)
+	s.secretBackendService.EXPECT().GetBackendForToken(gomock.Any(), s.tokenParams("backend-id")).Return(backend, nil)
+	backend.EXPECT().DeleteContent(gomock.Any(), "rev-id").Return(errors.WithType(errors.New("boom"), secrets.PermissionDenied))
+
+	results, err := s.facade.DeleteBackendContent(c.Context(), params.BackendContentArgs{
+		Args: []params.BackendContentArg{{
+			BackendID:  "backend-id",
+			Token:      "token",
+			RevisionID: "rev-id",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{
+			Error: &params.Error{Code: params.CodeUnauthorized, Message: "permission denied"},
+		}},
+	})
+}
@@ -64,6 +64,9 @@ type SecretBackendService interface {
 	BackendConfigInfo(
 		ctx context.Context, p secretbackendservice.BackendConfigParams,
 	) (*provider.ModelBackendConfigInfo, error)
+	GetBackendForToken(
+		ctx context.Context, p secretbackendservice.BackendForTokenParams,
+	) (provider.SecretsBackend, error)
 }
 
 // ApplicationService provides access to the application service.
@@ -335,6 +335,66 @@ type StorageProvisioningService interface {
 		ctx context.Context, machineUUID machine.UUID,
 	) (watcher.StringsWatcher, error)
 
+	// WatchModelProvisionedVolumeResizes returns a watcher that emits volume
+	// IDs, whenever a model provisioned volume needs to be grown to its
+	// requested size.
+	WatchModelProvisionedVolumeResizes(
+		ctx context.Context,
+	) (watcher.StringsWatcher, error)
+
+	// WatchMachineProvisionedVolumeResizes returns a watcher that emits volume
+	// IDs, whenever one of the given machine's provisioned volumes needs to be
+	// grown to its requested size.
+	WatchMachineProvisionedVolumeResizes(
+		ctx context.Context, machineUUID machine.UUID,
+	) (watcher.StringsWatcher, error)
+
+	// WatchModelProvisionedFilesystemResizes returns a watcher that emits
+	// filesystem IDs, whenever a model provisioned filesystem needs to be
+	// grown to its requested size.
+	WatchModelProvisionedFilesystemResizes(
+		ctx context.Context,
+	) (watcher.StringsWatcher, error)
+
+	// WatchMachineProvisionedFilesystemResizes returns a watcher that emits
+	// filesystem IDs, whenever one of the given machine's provisioned
+	// filesystems needs to be grown to its requested size.
+	WatchMachineProvisionedFilesystemResizes(
+		ctx context.Context, machineUUID machine.UUID,
+	) (watcher.StringsWatcher, error)
+
+	// WatchModelProvisionedStorageSnapshots returns a watcher that emits
+	// the ids of the snapshots of model provisioned volumes that are ready
+	// to be taken.
+	WatchModelProvisionedStorageSnapshots(
+		ctx context.Context,
+	) (watcher.StringsWatcher, error)
+
+	// WatchMachineProvisionedStorageSnapshots returns a watcher that emits
+	// the ids of the snapshots of the given machine's provisioned volumes
+	// that are ready to be taken.
+	WatchMachineProvisionedStorageSnapshots(
+		ctx context.Context, machineUUID machine.UUID,
+	) (watcher.StringsWatcher, error)
+
+	// GetStorageSnapshotParams returns the parameters required to take the
+	// storage snapshot with the supplied id.
+	GetStorageSnapshotParams(
+		ctx context.Context, id string,
+	) (storageprovisioning.StorageSnapshotParams, error)
+
+	// SetStorageSnapshotCreated records that the storage snapshot with the
+	// supplied id has been taken by the storage provider.
+	SetStorageSnapshotCreated(
+		ctx context.Context, id string, providerID string, sizeMiB uint64,
+	) error
+
+	// SetStorageSnapshotFailed records that the storage provider failed to
+	// take the storage snapshot with the supplied id.
+	SetStorageSnapshotFailed(
+		ctx context.Context, id string, message string,
+	) error
+
 	// WatchVolumeAttachmentPlans returns a watcher that emits volume attachment
 	// plan volume ids, whenever the given machine's volume attachment plan life
 	// changes.
@@ -1031,6 +1031,45 @@ func (c *MockStorageProvisioningServiceGetStorageResourceTagsForModelCall) DoAnd
 	return c
 }
 
+// GetStorageSnapshotParams mocks base method.
+func (m *MockStorageProvisioningService) GetStorageSnapshotParams(arg0 context.Context, arg1 string) (storageprovisioning.StorageSnapshotParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotParams", arg0, arg1)
+	ret0, _ := ret[0].(storageprovisioning.StorageSnapshotParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotParams indicates an expected call of GetStorageSnapshotParams.
+func (mr *MockStorageProvisioningServiceMockRecorder) GetStorageSnapshotParams(arg0, arg1 any) *MockStorageProvisioningServiceGetStorageSnapshotParamsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotParams", reflect.TypeOf((*MockStorageProvisioningService)(nil).GetStorageSnapshotParams), arg0, arg1)
+	return &MockStorageProvisioningServiceGetStorageSnapshotParamsCall{Call: call}
+}
+
+// MockStorageProvisioningServiceGetStorageSnapshotParamsCall wrap *gomock.Call
+type MockStorageProvisioningServiceGetStorageSnapshotParamsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceGetStorageSnapshotParamsCall) Return(arg0 storageprovisioning.StorageSnapshotParams, arg1 error) *MockStorageProvisioningServiceGetStorageSnapshotParamsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceGetStorageSnapshotParamsCall) Do(f func(context.Context, string) (storageprovisioning.StorageSnapshotParams, error)) *MockStorageProvisioningServiceGetStorageSnapshotParamsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceGetStorageSnapshotParamsCall) DoAndReturn(f func(context.Context, string) (storageprovisioning.StorageSnapshotParams, error)) *MockStorageProvisioningServiceGetStorageSnapshotParamsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetVolumeAttachment mocks base method.
 func (m *MockStorageProvisioningService) GetVolumeAttachment(arg0 context.Context, arg1 storage.VolumeAttachmentUUID) (storageprovisioning.VolumeAttachment, error) {
 	m.ctrl.T.Helper()
@@ -1652,6 +1691,82 @@ func (c *MockStorageProvisioningServiceSetFilesystemProvisionedInfoCall) DoAndRe
 	return c
 }
 
+// SetStorageSnapshotCreated mocks base method.
+func (m *MockStorageProvisioningService) SetStorageSnapshotCreated(arg0 context.Context, arg1, arg2 string, arg3 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotCreated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotCreated indicates an expected call of SetStorageSnapshotCreated.
+func (mr *MockStorageProvisioningServiceMockRecorder) SetStorageSnapshotCreated(arg0, arg1, arg2, arg3 any) *MockStorageProvisioningServiceSetStorageSnapshotCreatedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotCreated", reflect.TypeOf((*MockStorageProvisioningService)(nil).SetStorageSnapshotCreated), arg0, arg1, arg2, arg3)
+	return &MockStorageProvisioningServiceSetStorageSnapshotCreatedCall{Call: call}
+}
+
+// MockStorageProvisioningServiceSetStorageSnapshotCreatedCall wrap *gomock.Call
+type MockStorageProvisioningServiceSetStorageSnapshotCreatedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceSetStorageSnapshotCreatedCall) Return(arg0 error) *MockStorageProvisioningServiceSetStorageSnapshotCreatedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceSetStorageSnapshotCreatedCall) Do(f func(context.Context, string, string, uint64) error) *MockStorageProvisioningServiceSetStorageSnapshotCreatedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceSetStorageSnapshotCreatedCall) DoAndReturn(f func(context.Context, string, string, uint64) error) *MockStorageProvisioningServiceSetStorageSnapshotCreatedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetStorageSnapshotFailed mocks base method.
+func (m *MockStorageProvisioningService) SetStorageSnapshotFailed(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotFailed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotFailed indicates an expected call of SetStorageSnapshotFailed.
+func (mr *MockStorageProvisioningServiceMockRecorder) SetStorageSnapshotFailed(arg0, arg1, arg2 any) *MockStorageProvisioningServiceSetStorageSnapshotFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotFailed", reflect.TypeOf((*MockStorageProvisioningService)(nil).SetStorageSnapshotFailed), arg0, arg1, arg2)
+	return &MockStorageProvisioningServiceSetStorageSnapshotFailedCall{Call: call}
+}
+
+// MockStorageProvisioningServiceSetStorageSnapshotFailedCall wrap *gomock.Call
+type MockStorageProvisioningServiceSetStorageSnapshotFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceSetStorageSnapshotFailedCall) Return(arg0 error) *MockStorageProvisioningServiceSetStorageSnapshotFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceSetStorageSnapshotFailedCall) Do(f func(context.Context, string, string) error) *MockStorageProvisioningServiceSetStorageSnapshotFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceSetStorageSnapshotFailedCall) DoAndReturn(f func(context.Context, string, string) error) *MockStorageProvisioningServiceSetStorageSnapshotFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetVolumeAttachmentPlanProvisionedBlockDevice mocks base method.
 func (m *MockStorageProvisioningService) SetVolumeAttachmentPlanProvisionedBlockDevice(arg0 context.Context, arg1 storage.VolumeAttachmentPlanUUID, arg2 blockdevice0.BlockDeviceUUID) error {
 	m.ctrl.T.Helper()
@@ -1843,6 +1958,45 @@ func (c *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemAttachme
 	return c
 }
 
+// WatchMachineProvisionedFilesystemResizes mocks base method.
+func (m *MockStorageProvisioningService) WatchMachineProvisionedFilesystemResizes(arg0 context.Context, arg1 machine.UUID) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMachineProvisionedFilesystemResizes", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchMachineProvisionedFilesystemResizes indicates an expected call of WatchMachineProvisionedFilesystemResizes.
+func (mr *MockStorageProvisioningServiceMockRecorder) WatchMachineProvisionedFilesystemResizes(arg0, arg1 any) *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMachineProvisionedFilesystemResizes", reflect.TypeOf((*MockStorageProvisioningService)(nil).WatchMachineProvisionedFilesystemResizes), arg0, arg1)
+	return &MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall{Call: call}
+}
+
+// MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall wrap *gomock.Call
+type MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall) Do(f func(context.Context, machine.UUID) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall) DoAndReturn(f func(context.Context, machine.UUID) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchMachineProvisionedFilesystems mocks base method.
 func (m *MockStorageProvisioningService) WatchMachineProvisionedFilesystems(arg0 context.Context, arg1 machine.UUID) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -1882,6 +2036,45 @@ func (c *MockStorageProvisioningServiceWatchMachineProvisionedFilesystemsCall) D
 	return c
 }
 
+// WatchMachineProvisionedStorageSnapshots mocks base method.
+func (m *MockStorageProvisioningService) WatchMachineProvisionedStorageSnapshots(arg0 context.Context, arg1 machine.UUID) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMachineProvisionedStorageSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchMachineProvisionedStorageSnapshots indicates an expected call of WatchMachineProvisionedStorageSnapshots.
+func (mr *MockStorageProvisioningServiceMockRecorder) WatchMachineProvisionedStorageSnapshots(arg0, arg1 any) *MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMachineProvisionedStorageSnapshots", reflect.TypeOf((*MockStorageProvisioningService)(nil).WatchMachineProvisionedStorageSnapshots), arg0, arg1)
+	return &MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall{Call: call}
+}
+
+// MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall wrap *gomock.Call
+type MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall) Do(f func(context.Context, machine.UUID) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall) DoAndReturn(f func(context.Context, machine.UUID) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchMachineProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchMachineProvisionedVolumeAttachments mocks base method.
 func (m *MockStorageProvisioningService) WatchMachineProvisionedVolumeAttachments(arg0 context.Context, arg1 machine.UUID) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -1921,6 +2114,45 @@ func (c *MockStorageProvisioningServiceWatchMachineProvisionedVolumeAttachmentsC
 	return c
 }
 
+// WatchMachineProvisionedVolumeResizes mocks base method.
+func (m *MockStorageProvisioningService) WatchMachineProvisionedVolumeResizes(arg0 context.Context, arg1 machine.UUID) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMachineProvisionedVolumeResizes", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchMachineProvisionedVolumeResizes indicates an expected call of WatchMachineProvisionedVolumeResizes.
+func (mr *MockStorageProvisioningServiceMockRecorder) WatchMachineProvisionedVolumeResizes(arg0, arg1 any) *MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMachineProvisionedVolumeResizes", reflect.TypeOf((*MockStorageProvisioningService)(nil).WatchMachineProvisionedVolumeResizes), arg0, arg1)
+	return &MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall{Call: call}
+}
+
+// MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall wrap *gomock.Call
+type MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall) Do(f func(context.Context, machine.UUID) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall) DoAndReturn(f func(context.Context, machine.UUID) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchMachineProvisionedVolumeResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchMachineProvisionedVolumes mocks base method.
 func (m *MockStorageProvisioningService) WatchMachineProvisionedVolumes(arg0 context.Context, arg1 machine.UUID) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -1999,6 +2231,45 @@ func (c *MockStorageProvisioningServiceWatchModelProvisionedFilesystemAttachment
 	return c
 }
 
+// WatchModelProvisionedFilesystemResizes mocks base method.
+func (m *MockStorageProvisioningService) WatchModelProvisionedFilesystemResizes(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModelProvisionedFilesystemResizes", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchModelProvisionedFilesystemResizes indicates an expected call of WatchModelProvisionedFilesystemResizes.
+func (mr *MockStorageProvisioningServiceMockRecorder) WatchModelProvisionedFilesystemResizes(arg0 any) *MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModelProvisionedFilesystemResizes", reflect.TypeOf((*MockStorageProvisioningService)(nil).WatchModelProvisionedFilesystemResizes), arg0)
+	return &MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall{Call: call}
+}
+
+// MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall wrap *gomock.Call
+type MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchModelProvisionedFilesystemResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchModelProvisionedFilesystems mocks base method.
 func (m *MockStorageProvisioningService) WatchModelProvisionedFilesystems(arg0 context.Context) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -2038,6 +2309,45 @@ func (c *MockStorageProvisioningServiceWatchModelProvisionedFilesystemsCall) DoA
 	return c
 }
 
+// WatchModelProvisionedStorageSnapshots mocks base method.
+func (m *MockStorageProvisioningService) WatchModelProvisionedStorageSnapshots(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModelProvisionedStorageSnapshots", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchModelProvisionedStorageSnapshots indicates an expected call of WatchModelProvisionedStorageSnapshots.
+func (mr *MockStorageProvisioningServiceMockRecorder) WatchModelProvisionedStorageSnapshots(arg0 any) *MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModelProvisionedStorageSnapshots", reflect.TypeOf((*MockStorageProvisioningService)(nil).WatchModelProvisionedStorageSnapshots), arg0)
+	return &MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall{Call: call}
+}
+
+// MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall wrap *gomock.Call
+type MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchModelProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchModelProvisionedVolumeAttachments mocks base method.
 func (m *MockStorageProvisioningService) WatchModelProvisionedVolumeAttachments(arg0 context.Context) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -2077,6 +2387,45 @@ func (c *MockStorageProvisioningServiceWatchModelProvisionedVolumeAttachmentsCal
 	return c
 }
 
+// WatchModelProvisionedVolumeResizes mocks base method.
+func (m *MockStorageProvisioningService) WatchModelProvisionedVolumeResizes(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModelProvisionedVolumeResizes", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchModelProvisionedVolumeResizes indicates an expected call of WatchModelProvisionedVolumeResizes.
+func (mr *MockStorageProvisioningServiceMockRecorder) WatchModelProvisionedVolumeResizes(arg0 any) *MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModelProvisionedVolumeResizes", reflect.TypeOf((*MockStorageProvisioningService)(nil).WatchModelProvisionedVolumeResizes), arg0)
+	return &MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall{Call: call}
+}
+
+// MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall wrap *gomock.Call
+type MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStorageProvisioningServiceWatchModelProvisionedVolumeResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchModelProvisionedVolumes mocks base method.
 func (m *MockStorageProvisioningService) WatchModelProvisionedVolumes(arg0 context.Context) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -37,7 +37,7 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
-// StorageProvisionerAPI provides the StorageProvisioner API v6 facade.
+// StorageProvisionerAPI provides the StorageProvisioner API v8 facade.
 type StorageProvisionerAPI struct {
 	*common.InstanceIdGetter
 
@@ -63,9 +63,19 @@ type StorageProvisionerAPI struct {
 	modelUUID      model.UUID
 }
 
+// StorageProvisionerAPIv7 provides the StorageProvisioner API v7 facade.
+type StorageProvisionerAPIv7 struct {
+	*StorageProvisionerAPI
+}
+
+// StorageProvisionerAPIv6 provides the StorageProvisioner API v6 facade.
+type StorageProvisionerAPIv6 struct {
+	*StorageProvisionerAPIv7
+}
+
 // StorageProvisionerAPIv5 provides the StorageProvisioner API v5 facade.
 type StorageProvisionerAPIv5 struct {
-	*StorageProvisionerAPI
+	*StorageProvisionerAPIv6
 }
 
 // StorageProvisionerAPIv4 provides the StorageProvisioner API v4 facade.
@@ -77,7 +87,7 @@ type instanceIDSelector func(
 	fsParams storageprovisioning.FilesystemAttachmentParams,
 ) string
 
-// NewStorageProvisionerAPI creates a new server-side StorageProvisioner v6 facade.
+// NewStorageProvisionerAPI creates a new server-side StorageProvisioner v8 facade.
 func NewStorageProvisionerAPI(
 	ctx context.Context,
 	watcherRegistry facade.WatcherRegistry,
@@ -439,6 +449,53 @@ func (s *StorageProvisionerAPI) WatchFilesystems(
 	)
 }
 
+// WatchVolumeResizes watches for volumes scoped to the entity with the tag
+// passed to NewState, whose requested size is larger than their provisioned
+// size.
+func (s *StorageProvisionerAPI) WatchVolumeResizes(
+	ctx context.Context, args params.Entities,
+) (params.StringsWatchResults, error) {
+	return s.watchStorageEntities(
+		ctx, args,
+		s.storageProvisioningService.WatchModelProvisionedVolumeResizes,
+		s.storageProvisioningService.WatchMachineProvisionedVolumeResizes,
+	)
+}
+
+// WatchFilesystemResizes watches for filesystems scoped to the entity with
+// the tag passed to NewState, whose requested size is larger than their
+// provisioned size.
+func (s *StorageProvisionerAPI) WatchFilesystemResizes(
+	ctx context.Context, args params.Entities,
+) (params.StringsWatchResults, error) {
+	return s.watchStorageEntities(
+		ctx, args,
+		s.storageProvisioningService.WatchModelProvisionedFilesystemResizes,
+		s.storageProvisioningService.WatchMachineProvisionedFilesystemResizes,
+	)
+}
+
+// WatchVolumeResizes isn't implemented in the StorageProvisionerAPIv6 facade.
+func (*StorageProvisionerAPIv6) WatchVolumeResizes(_, _ struct{}) {}
+
+// WatchFilesystemResizes isn't implemented in the StorageProvisionerAPIv6 facade.
+func (*StorageProvisionerAPIv6) WatchFilesystemResizes(_, _ struct{}) {}
+
+// WatchStorageSnapshots watches for storage snapshots of volumes scoped to
+// the entity with the tag passed to NewState, that are ready to be taken.
+func (s *StorageProvisionerAPI) WatchStorageSnapshots(
+	ctx context.Context, args params.Entities,
+) (params.StringsWatchResults, error) {
+	return s.watchStorageEntities(
+		ctx, args,
+		s.storageProvisioningService.WatchModelProvisionedStorageSnapshots,
+		s.storageProvisioningService.WatchMachineProvisionedStorageSnapshots,
+	)
+}
+
+// WatchStorageSnapshots isn't implemented in the StorageProvisionerAPIv7 facade.
+func (*StorageProvisionerAPIv7) WatchStorageSnapshots(_, _ struct{}) {}
+
 func (s *StorageProvisionerAPI) watchStorageEntities(
 	ctx context.Context,
 	args params.Entities,
@@ -1538,6 +1595,7 @@ func (s *StorageProvisionerAPI) VolumeParams(ctx context.Context, args params.En
 			Provider:   volParams.Provider,
 			SizeMiB:    volParams.SizeMiB,
 			Tags:       volModelTags,
+			SnapshotId: volParams.SnapshotProviderID,
 		}
 		for k, v := range volParams.Attributes {
 			rval.Attributes[k] = v
@@ -2147,6 +2205,106 @@ func (s *StorageProvisionerAPI) SetVolumeInfo(ctx context.Context, args params.V
 	return results, nil
 }
 
+// StorageSnapshotParams returns the parameters for taking the storage
+// snapshots with the given ids.
+func (s *StorageProvisionerAPI) StorageSnapshotParams(
+	ctx context.Context, args params.StorageSnapshotIds,
+) (params.StorageSnapshotParamsResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc(ctx)
+	if err != nil {
+		return params.StorageSnapshotParamsResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	results := params.StorageSnapshotParamsResults{
+		Results: make([]params.StorageSnapshotParamsResult, len(args.Ids)),
+	}
+
+	var modelTags map[string]string
+	one := func(id string) (params.StorageSnapshotParams, error) {
+		snapshotParams, err := s.storageProvisioningService.GetStorageSnapshotParams(ctx, id)
+		if errors.Is(err, storageerrors.StorageSnapshotNotFound) {
+			return params.StorageSnapshotParams{}, apiservererrors.ErrPerm
+		} else if err != nil {
+			return params.StorageSnapshotParams{}, errors.Capture(err)
+		}
+		volumeTag := names.NewVolumeTag(snapshotParams.VolumeID)
+		if !canAccess(volumeTag) {
+			return params.StorageSnapshotParams{}, apiservererrors.ErrPerm
+		}
+
+		if modelTags == nil {
+			modelTags, err = s.storageProvisioningService.
+				GetStorageResourceTagsForModel(ctx)
+			if err != nil {
+				return params.StorageSnapshotParams{}, errors.Errorf(
+					"getting storage snapshot model tags: %w", err,
+				)
+			}
+		}
+
+		return params.StorageSnapshotParams{
+			Id:        id,
+			VolumeTag: volumeTag.String(),
+			VolumeId:  snapshotParams.VolumeProviderID,
+			Provider:  snapshotParams.Provider,
+			Tags:      modelTags,
+		}, nil
+	}
+	for i, id := range args.Ids {
+		result, err := one(id)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = result
+	}
+	return results, nil
+}
+
+// SetStorageSnapshotResults records the outcome of taking storage snapshots.
+// Snapshots with an error in their result are recorded as failed.
+func (s *StorageProvisionerAPI) SetStorageSnapshotResults(
+	ctx context.Context, args params.StorageSnapshotResults,
+) (params.ErrorResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc(ctx)
+	if err != nil {
+		return params.ErrorResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Results)),
+	}
+	one := func(arg params.StorageSnapshotResult) error {
+		snapshotParams, err := s.storageProvisioningService.GetStorageSnapshotParams(ctx, arg.Id)
+		if errors.Is(err, storageerrors.StorageSnapshotNotFound) {
+			return apiservererrors.ErrPerm
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		if !canAccess(names.NewVolumeTag(snapshotParams.VolumeID)) {
+			return apiservererrors.ErrPerm
+		}
+
+		if arg.Error != nil {
+			return s.storageProvisioningService.SetStorageSnapshotFailed(
+				ctx, arg.Id, arg.Error.Message,
+			)
+		}
+		return s.storageProvisioningService.SetStorageSnapshotCreated(
+			ctx, arg.Id, arg.ProviderId, arg.SizeMiB,
+		)
+	}
+	for i, arg := range args.Results {
+		err := one(arg)
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// StorageSnapshotParams isn't implemented in the StorageProvisionerAPIv7 facade.
+func (*StorageProvisionerAPIv7) StorageSnapshotParams(_, _ struct{}) {}
+
+// SetStorageSnapshotResults isn't implemented in the StorageProvisionerAPIv7 facade.
+func (*StorageProvisionerAPIv7) SetStorageSnapshotResults(_, _ struct{}) {}
+
 // SetFilesystemInfo records the details of newly provisioned filesystems.
 func (s *StorageProvisionerAPI) SetFilesystemInfo(ctx context.Context, args params.Filesystems) (params.ErrorResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc(ctx)
@@ -30,6 +30,7 @@ import (
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	removalerrors "github.com/juju/juju/domain/removal/errors"
 	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
 	"github.com/juju/juju/domain/storageprovisioning"
 	storageprovisioningerrors "github.com/juju/juju/domain/storageprovisioning/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -168,7 +169,7 @@ func (s *provisionerV5Suite) setupAPI(c *tc.C) *gomock.Controller {
 	)
 	c.Assert(err, tc.IsNil)
 
-	s.api = &StorageProvisionerAPIv5{apiV6}
+	s.api = &StorageProvisionerAPIv5{&StorageProvisionerAPIv6{&StorageProvisionerAPIv7{apiV6}}}
 
 	c.Cleanup(func() {
 		s.authorizer = nil
@@ -2313,6 +2314,218 @@ func (s *provisionerSuite) TestWatchFilesystemsForMachineNotFound(c *tc.C) {
 	c.Assert(result.Error.Code, tc.Equals, params.CodeNotFound)
 }
 
+func (s *provisionerSuite) TestWatchVolumeResizesForModel(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	volumeChanged := make(chan []string, 1)
+	volumeChanged <- []string{"vol1"}
+
+	sourceWatcher := watchertest.NewMockStringsWatcher(volumeChanged)
+
+	s.storageProvisioningService.EXPECT().
+		WatchModelProvisionedVolumeResizes(gomock.Any()).
+		Return(sourceWatcher, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("66", nil)
+
+	results, err := s.api.WatchVolumeResizes(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewModelTag(s.modelUUID.String()).String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, tc.IsNil)
+	c.Assert(result.StringsWatcherId, tc.Equals, "66")
+	c.Assert(result.Changes, tc.DeepEquals, []string{"vol1"})
+}
+
+func (s *provisionerSuite) TestWatchVolumeResizesForMachine(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	volumeChanged := make(chan []string, 1)
+	volumeChanged <- []string{"vol1"}
+
+	sourceWatcher := watchertest.NewMockStringsWatcher(volumeChanged)
+	machineUUID := machinetesting.GenUUID(c)
+
+	s.machineService.EXPECT().
+		GetMachineUUID(gomock.Any(), s.machineName).
+		Return(machineUUID, nil)
+	s.storageProvisioningService.EXPECT().
+		WatchMachineProvisionedVolumeResizes(gomock.Any(), machineUUID).
+		Return(sourceWatcher, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("66", nil)
+
+	results, err := s.api.WatchVolumeResizes(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewMachineTag(s.machineName.String()).String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, tc.IsNil)
+	c.Assert(result.StringsWatcherId, tc.Equals, "66")
+	c.Assert(result.Changes, tc.DeepEquals, []string{"vol1"})
+}
+
+func (s *provisionerSuite) TestWatchFilesystemResizesForModel(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	filesystemChanged := make(chan []string, 1)
+	filesystemChanged <- []string{"1"}
+
+	sourceWatcher := watchertest.NewMockStringsWatcher(filesystemChanged)
+
+	s.storageProvisioningService.EXPECT().
+		WatchModelProvisionedFilesystemResizes(gomock.Any()).
+		Return(sourceWatcher, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("66", nil)
+
+	results, err := s.api.WatchFilesystemResizes(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewModelTag(s.modelUUID.String()).String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, tc.IsNil)
+	c.Assert(result.StringsWatcherId, tc.Equals, "66")
+	c.Assert(result.Changes, tc.DeepEquals, []string{"1"})
+}
+
+func (s *provisionerSuite) TestWatchFilesystemResizesForMachineNotFound(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	s.disableAuthz(c)
+
+	s.machineService.EXPECT().
+		GetMachineUUID(gomock.Any(), s.machineName).
+		Return("", machineerrors.MachineNotFound)
+
+	results, err := s.api.WatchFilesystemResizes(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewMachineTag(s.machineName.String()).String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error.Code, tc.Equals, params.CodeNotFound)
+}
+
+func (s *provisionerSuite) TestWatchStorageSnapshotsForModel(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	snapshotChanged := make(chan []string, 1)
+	snapshotChanged <- []string{"0"}
+
+	sourceWatcher := watchertest.NewMockStringsWatcher(snapshotChanged)
+
+	s.storageProvisioningService.EXPECT().
+		WatchModelProvisionedStorageSnapshots(gomock.Any()).
+		Return(sourceWatcher, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("66", nil)
+
+	results, err := s.api.WatchStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewModelTag(s.modelUUID.String()).String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, tc.IsNil)
+	c.Assert(result.StringsWatcherId, tc.Equals, "66")
+	c.Assert(result.Changes, tc.DeepEquals, []string{"0"})
+}
+
+func (s *provisionerSuite) TestStorageSnapshotParams(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	s.disableAuthz(c)
+
+	s.storageProvisioningService.EXPECT().
+		GetStorageSnapshotParams(gomock.Any(), "0").
+		Return(storageprovisioning.StorageSnapshotParams{
+			Provider:         "ebs",
+			Status:           domainstorage.StorageSnapshotStatusReady,
+			VolumeID:         "1",
+			VolumeProviderID: "vol-1",
+		}, nil)
+	s.storageProvisioningService.EXPECT().
+		GetStorageSnapshotParams(gomock.Any(), "42").
+		Return(storageprovisioning.StorageSnapshotParams{}, storageerrors.StorageSnapshotNotFound)
+	s.storageProvisioningService.EXPECT().
+		GetStorageResourceTagsForModel(gomock.Any()).
+		Return(map[string]string{"tag1": "value1"}, nil)
+
+	results, err := s.api.StorageSnapshotParams(c.Context(), params.StorageSnapshotIds{
+		Ids: []string{"0", "42"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results[0], tc.DeepEquals, params.StorageSnapshotParamsResult{
+		Result: params.StorageSnapshotParams{
+			Id:        "0",
+			VolumeTag: names.NewVolumeTag("1").String(),
+			VolumeId:  "vol-1",
+			Provider:  "ebs",
+			Tags:      map[string]string{"tag1": "value1"},
+		},
+	})
+	c.Check(results.Results[1].Error.Code, tc.Equals, params.CodeUnauthorized)
+}
+
+func (s *provisionerSuite) TestSetStorageSnapshotResults(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	s.disableAuthz(c)
+
+	snapshotParams := storageprovisioning.StorageSnapshotParams{
+		Provider:         "ebs",
+		Status:           domainstorage.StorageSnapshotStatusReady,
+		VolumeID:         "1",
+		VolumeProviderID: "vol-1",
+	}
+	s.storageProvisioningService.EXPECT().
+		GetStorageSnapshotParams(gomock.Any(), "0").
+		Return(snapshotParams, nil)
+	s.storageProvisioningService.EXPECT().
+		GetStorageSnapshotParams(gomock.Any(), "1").
+		Return(snapshotParams, nil)
+	s.storageProvisioningService.EXPECT().
+		SetStorageSnapshotCreated(gomock.Any(), "0", "snap-0", uint64(1024)).
+		Return(nil)
+	s.storageProvisioningService.EXPECT().
+		SetStorageSnapshotFailed(gomock.Any(), "1", "boom").
+		Return(nil)
+
+	results, err := s.api.SetStorageSnapshotResults(c.Context(), params.StorageSnapshotResults{
+		Results: []params.StorageSnapshotResult{{
+			Id:         "0",
+			ProviderId: "snap-0",
+			SizeMiB:    1024,
+		}, {
+			Id:    "1",
+			Error: &params.Error{Message: "boom"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}, {}},
+	})
+}
+
 func (s *provisionerSuite) TestWatchVolumeAttachmentPlans(c *tc.C) {
 	ctrl := s.setupAPI(c)
 	defer ctrl.Finish()
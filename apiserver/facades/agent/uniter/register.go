@@ -28,6 +28,12 @@ func Register(registry facade.FacadeRegistry) {
 		return newUniterAPIv21(stdCtx, ctx)
 	}, reflect.TypeFor[*UniterAPIv21]())
 	registry.MustRegister("Uniter", 22, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newUniterAPIv22(stdCtx, ctx)
+	}, reflect.TypeFor[*UniterAPIv22]())
+	registry.MustRegister("Uniter", 23, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newUniterAPIv23(stdCtx, ctx)
+	}, reflect.TypeFor[*UniterAPIv23]())
+	registry.MustRegister("Uniter", 24, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newUniterAPI(stdCtx, ctx)
 	}, reflect.TypeFor[*UniterAPI]())
 }
@@ -49,11 +55,27 @@ func newUniterAPIv20(stdCtx context.Context, ctx facade.ModelContext) (*UniterAP
 }
 
 func newUniterAPIv21(stdCtx context.Context, ctx facade.ModelContext) (*UniterAPIv21, error) {
+	api, err := newUniterAPIv22(stdCtx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIv21{UniterAPIv22: api}, nil
+}
+
+func newUniterAPIv22(stdCtx context.Context, ctx facade.ModelContext) (*UniterAPIv22, error) {
+	api, err := newUniterAPIv23(stdCtx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIv22{UniterAPIv23: api}, nil
+}
+
+func newUniterAPIv23(stdCtx context.Context, ctx facade.ModelContext) (*UniterAPIv23, error) {
 	api, err := newUniterAPI(stdCtx, ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &UniterAPIv21{UniterAPI: api}, nil
+	return &UniterAPIv23{UniterAPI: api}, nil
 }
 
 // newUniterAPI creates a new instance of the core Uniter API.
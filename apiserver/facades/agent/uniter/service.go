@@ -339,6 +339,9 @@ type UnitStateService interface {
 	SetState(context.Context, unitstate.UnitState) error
 	// GetState returns the full unit state. The state may be empty.
 	GetState(ctx context.Context, uuid coreunit.Name) (unitstate.RetrievedUnitState, error)
+	// RecordHookHistory adds the input entries to the hook history of the
+	// unit with the input name.
+	RecordHookHistory(ctx context.Context, name coreunit.Name, entries []unitstate.HookHistoryEntry) error
 }
 
 // PortService describes the ability to open and close port ranges for units.
@@ -650,6 +653,25 @@ type StorageProvisioningService interface {
 	WatchStorageAttachment(
 		ctx context.Context, uuid domainstorage.StorageAttachmentUUID,
 	) (watcher.NotifyWatcher, error)
+
+	// WatchStorageSnapshotsForUnit returns a watcher that notifies whenever
+	// a storage snapshot in the model changes.
+	WatchStorageSnapshotsForUnit(
+		ctx context.Context, unitUUID coreunit.UUID,
+	) (watcher.NotifyWatcher, error)
+
+	// GetStorageSnapshotHookInfoForUnit returns the storage snapshots that
+	// are waiting on the supplied unit to run one of its storage snapshot
+	// hooks.
+	GetStorageSnapshotHookInfoForUnit(
+		ctx context.Context, unitUUID coreunit.UUID,
+	) ([]storageprovisioning.StorageSnapshotHookInfo, error)
+
+	// SetStorageSnapshotHookRun records that the supplied unit has run the
+	// storage snapshot hook the snapshot with the supplied id was waiting on.
+	SetStorageSnapshotHookRun(
+		ctx context.Context, unitUUID coreunit.UUID, id string,
+	) error
 }
 
 // TracingService provides methods to retrieve tracing configuration for charms.
@@ -3479,6 +3479,45 @@ func (c *MockStorageProvisioningServiceGetStorageAttachmentUUIDForUnitCall) DoAn
 	return c
 }
 
+// GetStorageSnapshotHookInfoForUnit mocks base method.
+func (m *MockStorageProvisioningService) GetStorageSnapshotHookInfoForUnit(arg0 context.Context, arg1 unit.UUID) ([]storageprovisioning.StorageSnapshotHookInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotHookInfoForUnit", arg0, arg1)
+	ret0, _ := ret[0].([]storageprovisioning.StorageSnapshotHookInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotHookInfoForUnit indicates an expected call of GetStorageSnapshotHookInfoForUnit.
+func (mr *MockStorageProvisioningServiceMockRecorder) GetStorageSnapshotHookInfoForUnit(arg0, arg1 any) *MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotHookInfoForUnit", reflect.TypeOf((*MockStorageProvisioningService)(nil).GetStorageSnapshotHookInfoForUnit), arg0, arg1)
+	return &MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall{Call: call}
+}
+
+// MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall wrap *gomock.Call
+type MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall) Return(arg0 []storageprovisioning.StorageSnapshotHookInfo, arg1 error) *MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall) Do(f func(context.Context, unit.UUID) ([]storageprovisioning.StorageSnapshotHookInfo, error)) *MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall) DoAndReturn(f func(context.Context, unit.UUID) ([]storageprovisioning.StorageSnapshotHookInfo, error)) *MockStorageProvisioningServiceGetStorageSnapshotHookInfoForUnitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitStorageAttachmentInfo mocks base method.
 func (m *MockStorageProvisioningService) GetUnitStorageAttachmentInfo(arg0 context.Context, arg1 storage.StorageAttachmentUUID) (storageprovisioning.StorageAttachmentInfo, error) {
 	m.ctrl.T.Helper()
@@ -3518,6 +3557,44 @@ func (c *MockStorageProvisioningServiceGetUnitStorageAttachmentInfoCall) DoAndRe
 	return c
 }
 
+// SetStorageSnapshotHookRun mocks base method.
+func (m *MockStorageProvisioningService) SetStorageSnapshotHookRun(arg0 context.Context, arg1 unit.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotHookRun", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotHookRun indicates an expected call of SetStorageSnapshotHookRun.
+func (mr *MockStorageProvisioningServiceMockRecorder) SetStorageSnapshotHookRun(arg0, arg1, arg2 any) *MockStorageProvisioningServiceSetStorageSnapshotHookRunCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotHookRun", reflect.TypeOf((*MockStorageProvisioningService)(nil).SetStorageSnapshotHookRun), arg0, arg1, arg2)
+	return &MockStorageProvisioningServiceSetStorageSnapshotHookRunCall{Call: call}
+}
+
+// MockStorageProvisioningServiceSetStorageSnapshotHookRunCall wrap *gomock.Call
+type MockStorageProvisioningServiceSetStorageSnapshotHookRunCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceSetStorageSnapshotHookRunCall) Return(arg0 error) *MockStorageProvisioningServiceSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceSetStorageSnapshotHookRunCall) Do(f func(context.Context, unit.UUID, string) error) *MockStorageProvisioningServiceSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceSetStorageSnapshotHookRunCall) DoAndReturn(f func(context.Context, unit.UUID, string) error) *MockStorageProvisioningServiceSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchStorageAttachment mocks base method.
 func (m *MockStorageProvisioningService) WatchStorageAttachment(arg0 context.Context, arg1 storage.StorageAttachmentUUID) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -3596,6 +3673,45 @@ func (c *MockStorageProvisioningServiceWatchStorageAttachmentsForUnitCall) DoAnd
 	return c
 }
 
+// WatchStorageSnapshotsForUnit mocks base method.
+func (m *MockStorageProvisioningService) WatchStorageSnapshotsForUnit(arg0 context.Context, arg1 unit.UUID) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchStorageSnapshotsForUnit", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchStorageSnapshotsForUnit indicates an expected call of WatchStorageSnapshotsForUnit.
+func (mr *MockStorageProvisioningServiceMockRecorder) WatchStorageSnapshotsForUnit(arg0, arg1 any) *MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchStorageSnapshotsForUnit", reflect.TypeOf((*MockStorageProvisioningService)(nil).WatchStorageSnapshotsForUnit), arg0, arg1)
+	return &MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall{Call: call}
+}
+
+// MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall wrap *gomock.Call
+type MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall) Do(f func(context.Context, unit.UUID) (watcher.Watcher[struct{}], error)) *MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall) DoAndReturn(f func(context.Context, unit.UUID) (watcher.Watcher[struct{}], error)) *MockStorageProvisioningServiceWatchStorageSnapshotsForUnitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockBlockDeviceService is a mock of BlockDeviceService interface.
 type MockBlockDeviceService struct {
 	ctrl     *gomock.Controller
@@ -3937,6 +4053,44 @@ func (c *MockUnitStateServiceGetStateCall) DoAndReturn(f func(context.Context, u
 	return c
 }
 
+// RecordHookHistory mocks base method.
+func (m *MockUnitStateService) RecordHookHistory(arg0 context.Context, arg1 unit.Name, arg2 []unitstate.HookHistoryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordHookHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordHookHistory indicates an expected call of RecordHookHistory.
+func (mr *MockUnitStateServiceMockRecorder) RecordHookHistory(arg0, arg1, arg2 any) *MockUnitStateServiceRecordHookHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHookHistory", reflect.TypeOf((*MockUnitStateService)(nil).RecordHookHistory), arg0, arg1, arg2)
+	return &MockUnitStateServiceRecordHookHistoryCall{Call: call}
+}
+
+// MockUnitStateServiceRecordHookHistoryCall wrap *gomock.Call
+type MockUnitStateServiceRecordHookHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStateServiceRecordHookHistoryCall) Return(arg0 error) *MockUnitStateServiceRecordHookHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStateServiceRecordHookHistoryCall) Do(f func(context.Context, unit.Name, []unitstate.HookHistoryEntry) error) *MockUnitStateServiceRecordHookHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStateServiceRecordHookHistoryCall) DoAndReturn(f func(context.Context, unit.Name, []unitstate.HookHistoryEntry) error) *MockUnitStateServiceRecordHookHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetState mocks base method.
 func (m *MockUnitStateService) SetState(arg0 context.Context, arg1 unitstate.UnitState) error {
 	m.ctrl.T.Helper()
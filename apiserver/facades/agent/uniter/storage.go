@@ -563,3 +563,166 @@ func (s *StorageAPI) RemoveStorageAttachments(ctx context.Context, args params.S
 	}
 	return results, nil
 }
+
+// WatchUnitStorageSnapshots creates watchers for a collection of units, each
+// of which notifies when a storage snapshot in the model changes, so that the
+// unit can check for snapshots waiting on its storage snapshot hooks.
+func (s *StorageAPI) WatchUnitStorageSnapshots(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
+	canAccess, err := s.accessUnit(ctx)
+	if err != nil {
+		return params.NotifyWatchResults{}, err
+	}
+
+	one := func(tag string) (watcher.NotifyWatcher, error) {
+		unitTag, err := names.ParseUnitTag(tag)
+		if err != nil {
+			return nil, internalerrors.Errorf("parsing unit tag %q: %w", tag, err)
+		}
+		if !canAccess(unitTag) {
+			return nil, apiservererrors.ErrPerm
+		}
+
+		unitUUID, err := s.getUnitUUID(ctx, unitTag)
+		if err != nil {
+			return nil, internalerrors.Capture(err)
+		}
+
+		w, err := s.storageProvisioningService.WatchStorageSnapshotsForUnit(ctx, unitUUID)
+		if err != nil {
+			return nil, internalerrors.Errorf(
+				"watching storage snapshots for unit %q: %w", unitTag.Id(), err,
+			)
+		}
+		return w, nil
+	}
+
+	results := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		w, err := one(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].NotifyWatcherId, _, err = internal.EnsureRegisterWatcher(
+			ctx, s.watcherRegistry, w,
+		)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+// UnitStorageSnapshots returns the storage snapshots that are waiting on each
+// of the given units to run one of their storage snapshot hooks.
+func (s *StorageAPI) UnitStorageSnapshots(ctx context.Context, args params.Entities) (params.UnitStorageSnapshotsResults, error) {
+	canAccess, err := s.accessUnit(ctx)
+	if err != nil {
+		return params.UnitStorageSnapshotsResults{}, err
+	}
+
+	one := func(tag string) ([]params.UnitStorageSnapshot, error) {
+		unitTag, err := names.ParseUnitTag(tag)
+		if err != nil {
+			return nil, internalerrors.Errorf("parsing unit tag %q: %w", tag, err)
+		}
+		if !canAccess(unitTag) {
+			return nil, apiservererrors.ErrPerm
+		}
+
+		unitUUID, err := s.getUnitUUID(ctx, unitTag)
+		if err != nil {
+			return nil, internalerrors.Capture(err)
+		}
+
+		infos, err := s.storageProvisioningService.GetStorageSnapshotHookInfoForUnit(ctx, unitUUID)
+		switch {
+		case errors.Is(err, applicationerrors.UnitNotFound):
+			return nil, internalerrors.Errorf(
+				"unit %q not found", unitTag.Id(),
+			).Add(coreerrors.NotFound)
+		case err != nil:
+			return nil, internalerrors.Errorf(
+				"getting storage snapshots for unit %q: %w", unitTag.Id(), err,
+			)
+		}
+
+		snapshots := make([]params.UnitStorageSnapshot, 0, len(infos))
+		for _, info := range infos {
+			if !names.IsValidStorage(info.StorageID) {
+				// This should never happen. But to avoid a panic, we
+				// return an error if we encounter an invalid storage ID.
+				return nil, internalerrors.Errorf(
+					"invalid storage ID %q for unit %q", info.StorageID, unitTag.Id(),
+				).Add(errors.NotValid)
+			}
+			snapshots = append(snapshots, params.UnitStorageSnapshot{
+				Id:         info.ID,
+				StorageTag: names.NewStorageTag(info.StorageID).String(),
+				Status:     info.Status.String(),
+			})
+		}
+		return snapshots, nil
+	}
+
+	results := params.UnitStorageSnapshotsResults{
+		Results: make([]params.UnitStorageSnapshotsResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		snapshots, err := one(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Snapshots = snapshots
+	}
+	return results, nil
+}
+
+// SetStorageSnapshotHookRun records that units have run the storage snapshot
+// hook that each of the given snapshots was waiting on.
+func (s *StorageAPI) SetStorageSnapshotHookRun(ctx context.Context, args params.StorageSnapshotHookRunArgs) (params.ErrorResults, error) {
+	canAccess, err := s.accessUnit(ctx)
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	one := func(arg params.StorageSnapshotHookRunArg) error {
+		unitTag, err := names.ParseUnitTag(arg.UnitTag)
+		if err != nil {
+			return internalerrors.Errorf("parsing unit tag %q: %w", arg.UnitTag, err)
+		}
+		if !canAccess(unitTag) {
+			return apiservererrors.ErrPerm
+		}
+
+		unitUUID, err := s.getUnitUUID(ctx, unitTag)
+		if err != nil {
+			return internalerrors.Capture(err)
+		}
+
+		err = s.storageProvisioningService.SetStorageSnapshotHookRun(ctx, unitUUID, arg.Id)
+		switch {
+		case errors.Is(err, domainstorageerrors.StorageSnapshotNotFound):
+			return internalerrors.Errorf(
+				"storage snapshot %q not found", arg.Id,
+			).Add(coreerrors.NotFound)
+		case err != nil:
+			return internalerrors.Errorf(
+				"recording storage snapshot hook run for %q unit %q: %w",
+				arg.Id, unitTag.Id(), err,
+			)
+		}
+		return nil
+	}
+
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		results.Results[i].Error = apiservererrors.ServerError(one(arg))
+	}
+	return results, nil
+}
@@ -986,3 +986,105 @@ func (s *storageSuite) TestRemoveStorageAttachmentsNotFoundIgnoredLater(c *tc.C)
 	c.Check(res.Results, tc.HasLen, 1)
 	c.Check(res.Results[0].Error, tc.IsNil)
 }
+
+func (s *storageSuite) TestWatchUnitStorageSnapshots(c *tc.C) {
+	api, ctrl := s.getAPI(c)
+	defer ctrl.Finish()
+
+	changed := make(chan struct{}, 1)
+	changed <- struct{}{}
+	sourceWatcher := watchertest.NewMockNotifyWatcher(changed)
+
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+
+	s.mockApplicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("wordpress/0")).Return(unitUUID, nil)
+	s.mockStorageProvisioningService.EXPECT().WatchStorageSnapshotsForUnit(
+		gomock.Any(), unitUUID).Return(sourceWatcher, nil)
+	s.mockWatcherRegistry.EXPECT().Register(gomock.Any(), sourceWatcher).Return("66", nil)
+
+	results, err := api.WatchUnitStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "unit-wordpress-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Assert(results.Results[0].NotifyWatcherId, tc.Equals, "66")
+}
+
+func (s *storageSuite) TestUnitStorageSnapshots(c *tc.C) {
+	api, ctrl := s.getAPI(c)
+	defer ctrl.Finish()
+
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+
+	s.mockApplicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("wordpress/0")).Return(unitUUID, nil)
+	s.mockStorageProvisioningService.EXPECT().GetStorageSnapshotHookInfoForUnit(
+		gomock.Any(), unitUUID,
+	).Return([]storageprovisioning.StorageSnapshotHookInfo{{
+		ID:        "1",
+		StorageID: "data/0",
+		Status:    domainstorage.StorageSnapshotStatusPending,
+	}, {
+		ID:        "2",
+		StorageID: "data/0",
+		Status:    domainstorage.StorageSnapshotStatusCreated,
+	}}, nil)
+
+	results, err := api.UnitStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "unit-wordpress-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.DeepEquals, []params.UnitStorageSnapshotsResult{{
+		Snapshots: []params.UnitStorageSnapshot{{
+			Id:         "1",
+			StorageTag: "storage-data-0",
+			Status:     "pending",
+		}, {
+			Id:         "2",
+			StorageTag: "storage-data-0",
+			Status:     "created",
+		}},
+	}})
+}
+
+func (s *storageSuite) TestUnitStorageSnapshotsWithUnitNotFound(c *tc.C) {
+	api, ctrl := s.getAPI(c)
+	defer ctrl.Finish()
+
+	s.mockApplicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("wordpress/0")).Return("", applicationerrors.UnitNotFound)
+
+	results, err := api.UnitStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "unit-wordpress-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *storageSuite) TestSetStorageSnapshotHookRun(c *tc.C) {
+	api, ctrl := s.getAPI(c)
+	defer ctrl.Finish()
+
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+
+	s.mockApplicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("wordpress/0")).Return(unitUUID, nil).Times(2)
+	s.mockStorageProvisioningService.EXPECT().SetStorageSnapshotHookRun(
+		gomock.Any(), unitUUID, "1").Return(nil)
+	s.mockStorageProvisioningService.EXPECT().SetStorageSnapshotHookRun(
+		gomock.Any(), unitUUID, "2").Return(domainstorageerrors.StorageSnapshotNotFound)
+
+	results, err := api.SetStorageSnapshotHookRun(c.Context(), params.StorageSnapshotHookRunArgs{
+		Args: []params.StorageSnapshotHookRunArg{
+			{UnitTag: "unit-wordpress-0", Id: "1"},
+			{UnitTag: "unit-wordpress-0", Id: "2"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
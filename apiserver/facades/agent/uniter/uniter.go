@@ -53,7 +53,7 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
-// UniterAPI implements the latest version (v22) of the Uniter API.
+// UniterAPI implements the latest version (v24) of the Uniter API.
 type UniterAPI struct {
 	*StatusAPI
 	*StorageAPI
@@ -113,9 +113,33 @@ type UniterAPIv20 struct {
 }
 
 type UniterAPIv21 struct {
+	*UniterAPIv22
+}
+
+// UniterAPIv22 implements version (v22) of the Uniter API, which does not
+// support storage snapshot hooks.
+type UniterAPIv22 struct {
+	*UniterAPIv23
+}
+
+// WatchUnitStorageSnapshots isn't on the v22 API.
+func (*UniterAPIv22) WatchUnitStorageSnapshots(_, _ struct{}) {}
+
+// UnitStorageSnapshots isn't on the v22 API.
+func (*UniterAPIv22) UnitStorageSnapshots(_, _ struct{}) {}
+
+// SetStorageSnapshotHookRun isn't on the v22 API.
+func (*UniterAPIv22) SetStorageSnapshotHookRun(_, _ struct{}) {}
+
+// UniterAPIv23 implements version (v23) of the Uniter API, which does not
+// support recording the hook history of units.
+type UniterAPIv23 struct {
 	*UniterAPI
 }
 
+// RecordHookHistory isn't on the v23 API.
+func (*UniterAPIv23) RecordHookHistory(_, _ struct{}) {}
+
 // EnsureDead calls EnsureDead on each given unit from state.
 // If it's Alive, nothing will happen.
 func (u *UniterAPI) EnsureDead(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
@@ -3326,6 +3350,7 @@ func (u *UniterAPI) getCAASUnitContext(ctx context.Context, unitName coreunit.Na
 		LegacyProxySettings:        encodeProxySettings(unitContext.LegacyProxySettings),
 		JujuProxySettings:          encodeProxySettings(unitContext.JujuProxySettings),
 		OpenedPortRangesByEndpoint: encodeOpenedPortRangesByEndpoint(unitContext.OpenedPortRangesByEndpoint),
+		HookTimeout:                unitContext.HookTimeout,
 	}, nil
 }
 
@@ -3343,6 +3368,7 @@ func (u *UniterAPI) getIAASUnitContext(ctx context.Context, unitName coreunit.Na
 		JujuProxySettings:                 encodeProxySettings(unitContext.JujuProxySettings),
 		PrivateAddress:                    unitContext.PrivateAddress,
 		OpenedMachinePortRangesByEndpoint: encodeOpenedPortRangesByEndpoint(unitContext.OpenedMachinePortRangesByEndpoint),
+		HookTimeout:                       unitContext.HookTimeout,
 	}, nil
 }
 
@@ -1888,8 +1888,12 @@ func (s *uniterv19Suite) SetUpTest(c *tc.C) {
 		s.uniter = &UniterAPIv19{
 			UniterAPIv20: &UniterAPIv20{
 				UniterAPIv21: &UniterAPIv21{
-					UniterAPI: &UniterAPI{
-						watcherRegistry: s.watcherRegistry,
+					UniterAPIv22: &UniterAPIv22{
+						UniterAPIv23: &UniterAPIv23{
+							UniterAPI: &UniterAPI{
+								watcherRegistry: s.watcherRegistry,
+							},
+						},
 					},
 				},
 			},
@@ -1916,10 +1920,14 @@ func (s *uniterv20Suite) SetUpTest(c *tc.C) {
 
 		s.uniter = &UniterAPIv20{
 			UniterAPIv21: &UniterAPIv21{
-				UniterAPI: &UniterAPI{
-					modelUUID:       tc.Must(c, coremodel.NewUUID),
-					modelType:       coremodel.IAAS,
-					watcherRegistry: s.watcherRegistry,
+				UniterAPIv22: &UniterAPIv22{
+					UniterAPIv23: &UniterAPIv23{
+						UniterAPI: &UniterAPI{
+							modelUUID:       tc.Must(c, coremodel.NewUUID),
+							modelType:       coremodel.IAAS,
+							watcherRegistry: s.watcherRegistry,
+						},
+					},
 				},
 			},
 		}
@@ -112,3 +112,64 @@ func (u *UniterAPI) SetState(ctx context.Context, args params.SetUnitStateArgs)
 
 	return params.ErrorResults{Results: res}, nil
 }
+
+// RecordHookHistory records the hooks, actions and commands run by
+// each unit in its hook history.
+func (u *UniterAPI) RecordHookHistory(ctx context.Context, args params.UnitHookHistoryArgs) (params.ErrorResults, error) {
+	canAccess, err := u.accessUnit(ctx)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	res := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		unitTag, err := names.ParseUnitTag(arg.Tag)
+		if err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		if !canAccess(unitTag) {
+			res[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		unitName, err := coreunit.NewName(unitTag.Id())
+		if err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		entries, err := hookHistoryFromParams(arg.Entries)
+		if err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		if err := u.unitStateService.RecordHookHistory(ctx, unitName, entries); err != nil {
+			res[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+
+	return params.ErrorResults{Results: res}, nil
+}
+
+func hookHistoryFromParams(in []params.HookHistoryEntry) ([]unitstate.HookHistoryEntry, error) {
+	entries := make([]unitstate.HookHistoryEntry, len(in))
+	for i, entry := range in {
+		kind, err := unitstate.ParseHookHistoryKind(entry.Kind)
+		if err != nil {
+			return nil, errors.NotValidf("hook history kind %q", entry.Kind)
+		}
+		entries[i] = unitstate.HookHistoryEntry{
+			Kind:       kind,
+			Name:       entry.Name,
+			RelationID: entry.RelationId,
+			Started:    entry.Started,
+			Duration:   entry.Duration,
+			ExitCode:   entry.ExitCode,
+			Error:      entry.Error,
+		}
+	}
+	return entries, nil
+}
@@ -6,6 +6,7 @@ package uniter
 import (
 	"context"
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
@@ -158,3 +159,50 @@ func (s *unitStateSuite) TestSetStateUniterState(c *tc.C) {
 		},
 	})
 }
+
+func (s *unitStateSuite) TestRecordHookHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	args := params.UnitHookHistoryArgs{
+		Args: []params.UnitHookHistoryArg{
+			{Tag: "not-a-unit-tag"},
+			{Tag: "unit-wordpress-0", Entries: []params.HookHistoryEntry{{
+				Kind:       "hook",
+				Name:       "db-relation-changed",
+				RelationId: new(2),
+				Started:    started,
+				Duration:   time.Second,
+				ExitCode:   1,
+				Error:      "exit status 1",
+			}}},
+			{Tag: "unit-wordpress-0", Entries: []params.HookHistoryEntry{{
+				Kind: "bogus",
+			}}},
+			{Tag: "unit-mysql-0"}, // not accessible by current user
+		},
+	}
+
+	s.unitStateService.EXPECT().RecordHookHistory(
+		gomock.Any(), unittesting.GenNewName(c, "wordpress/0"), []unitstate.HookHistoryEntry{{
+			Kind:       unitstate.HookHistoryHook,
+			Name:       "db-relation-changed",
+			RelationID: new(2),
+			Started:    started,
+			Duration:   time.Second,
+			ExitCode:   1,
+			Error:      "exit status 1",
+		}},
+	).Return(nil)
+
+	result, err := s.uniter.RecordHookHistory(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: &params.Error{Message: `"not-a-unit-tag" is not a valid tag`}},
+			{Error: nil},
+			{Error: &params.Error{Message: `hook history kind "bogus" not valid`, Code: params.CodeNotValid}},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+}
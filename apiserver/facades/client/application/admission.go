@@ -0,0 +1,87 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	apiservercharms "github.com/juju/juju/apiserver/internal/charms"
+	"github.com/juju/juju/core/admission"
+	corecharm "github.com/juju/juju/core/charm"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// deployAdmissionRequest returns the admission request for deploying an
+// application with the charm stored under the input locator.
+func deployAdmissionRequest(arg params.ApplicationDeploy, locator applicationcharm.CharmLocator) admission.Request {
+	req := charmAdmissionRequest(arg.ApplicationName, locator)
+	req.Constraints = admission.ConstraintValues(arg.Constraints)
+	return req
+}
+
+// setCharmAdmissionRequest returns the admission request for changing the
+// charm of an application. Refreshing an application can switch it to any
+// charm, so it is checked as a deployment of the new charm. A charm which
+// isn't stored in the model has no charm attributes.
+func (api *APIBase) setCharmAdmissionRequest(ctx context.Context, arg params.ApplicationSetCharmV2) (admission.Request, error) {
+	req := admission.Request{
+		Operation:   admission.Deploy,
+		Application: arg.ApplicationName,
+	}
+	locator, err := apiservercharms.CharmLocatorFromURL(arg.CharmURL)
+	if err != nil {
+		return req, nil
+	}
+	_, locator, _, err = api.applicationService.GetCharm(ctx, locator)
+	if errors.Is(err, applicationerrors.CharmNotFound) ||
+		errors.Is(err, applicationerrors.CharmNameNotValid) ||
+		errors.Is(err, applicationerrors.CharmSourceNotValid) {
+		return req, nil
+	} else if err != nil {
+		return admission.Request{}, errors.Annotate(err, "getting charm for admission")
+	}
+	return charmAdmissionRequest(arg.ApplicationName, locator), nil
+}
+
+// charmAdmissionRequest returns the admission request for deploying the
+// charm stored under the input locator to an application. The attributes
+// are read from the stored charm, never from the charm origin sent by the
+// client. The publisher and channel of a stored charm aren't known, so they
+// are left unset and any rule requiring them denies the request.
+func charmAdmissionRequest(applicationName string, locator applicationcharm.CharmLocator) admission.Request {
+	req := admission.Request{
+		Operation:   admission.Deploy,
+		Application: applicationName,
+		Charm:       locator.Name,
+	}
+	switch locator.Source {
+	case applicationcharm.CharmHubSource:
+		req.CharmSource = corecharm.CharmHub.String()
+	case applicationcharm.LocalSource:
+		req.CharmSource = corecharm.Local.String()
+	}
+	return req
+}
+
+// deployFromRepositoryAdmissionRequest returns the admission request for
+// deploying an application from a charm repository, once the charm has been
+// resolved in the repository.
+func deployFromRepositoryAdmissionRequest(dt deployTemplate) admission.Request {
+	req := admission.Request{
+		Operation:   admission.Deploy,
+		Application: dt.applicationName,
+		Charm:       dt.charmURL.Name,
+		CharmSource: dt.origin.Source.String(),
+		Publisher:   dt.origin.Publisher,
+		Constraints: admission.ConstraintValues(dt.constraints),
+	}
+	if dt.origin.Channel != nil {
+		req.CharmChannel = dt.origin.Channel.String()
+	}
+	return req
+}
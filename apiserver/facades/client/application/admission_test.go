@@ -0,0 +1,79 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/admission"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/rpc/params"
+)
+
+type admissionSuite struct{}
+
+func TestAdmissionSuite(t *testing.T) {
+	tc.Run(t, &admissionSuite{})
+}
+
+const approvedCharmsPolicy = `
+- name: approved-charms
+  operations: [deploy]
+  require:
+    charm: [mysql]
+    charm-source: [charm-hub]
+`
+
+func (s *admissionSuite) TestDeployAdmissionRequestFailsClosed(c *tc.C) {
+	policy, err := admission.Parse(approvedCharmsPolicy)
+	c.Assert(err, tc.ErrorIsNil)
+
+	tests := []applicationcharm.CharmLocator{{
+		Name: "mysql",
+	}, {
+		Name:   "mysql",
+		Source: applicationcharm.CMRSource,
+	}, {
+		Name:   "mysql",
+		Source: applicationcharm.LocalSource,
+	}}
+	for i, locator := range tests {
+		c.Logf("test %d: %+v", i, locator)
+		err := policy.Admit(deployAdmissionRequest(params.ApplicationDeploy{
+			ApplicationName: "mysql",
+		}, locator))
+		c.Check(err, tc.ErrorIs, admission.PolicyDenied)
+	}
+
+	err = policy.Admit(deployAdmissionRequest(params.ApplicationDeploy{
+		ApplicationName: "mysql",
+	}, applicationcharm.CharmLocator{
+		Name:   "mysql",
+		Source: applicationcharm.CharmHubSource,
+	}))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *admissionSuite) TestDeployAdmissionRequestIgnoresClientOrigin(c *tc.C) {
+	req := deployAdmissionRequest(params.ApplicationDeploy{
+		ApplicationName: "db",
+		CharmURL:        "ch:mysql",
+		CharmOrigin: &params.CharmOrigin{
+			Source:    "charm-hub",
+			Risk:      "stable",
+			Publisher: "data-platform",
+		},
+	}, applicationcharm.CharmLocator{
+		Name:   "mysql",
+		Source: applicationcharm.LocalSource,
+	})
+	c.Check(req, tc.DeepEquals, admission.Request{
+		Operation:   admission.Deploy,
+		Application: "db",
+		Charm:       "mysql",
+		CharmSource: "local",
+	})
+}
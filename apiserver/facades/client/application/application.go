@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"maps"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +26,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	apiservercharms "github.com/juju/juju/apiserver/internal/charms"
+	"github.com/juju/juju/core/admission"
 	coreapplication "github.com/juju/juju/core/application"
 	corebase "github.com/juju/juju/core/base"
 	corecharm "github.com/juju/juju/core/charm"
@@ -66,9 +68,14 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
+// APIv23 provides the Application API facade for version 23.
+type APIv23 struct {
+	*APIBase
+}
+
 // APIv22 provides the Application API facade for version 22.
 type APIv22 struct {
-	*APIBase
+	*APIv23
 }
 
 // APIv21 provides the Application API facade for version 21.
@@ -93,6 +100,7 @@ type APIBase struct {
 
 	authorizer facade.Authorizer
 	check      BlockChecker
+	admission  AdmissionChecker
 	repoDeploy DeployFromRepository
 
 	controllerUUID            string
@@ -109,6 +117,7 @@ type APIBase struct {
 	resourceService           ResourceService
 	statusService             StatusService
 	storageService            StorageService
+	unitStateService          UnitStateService
 	externalControllerService ExternalControllerService
 	crossModelRelationService CrossModelRelationService
 
@@ -128,6 +137,8 @@ type CaasBrokerInterface interface {
 func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, error) {
 	domainServices := ctx.DomainServices()
 	blockChecker := common.NewBlockChecker(domainServices.BlockCommand())
+	admissionChecker := common.NewAdmissionChecker(
+		domainServices.ControllerConfig(), domainServices.ModelInfo(), ctx.Auth().GetAuthTag().Id())
 
 	storageService := domainServices.Storage()
 
@@ -169,6 +180,7 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 		applicationService,
 		ctx.ObjectStore(),
 		makeDeployFromRepositoryValidator(stdCtx, validatorCfg),
+		admissionChecker,
 		repoLogger,
 		ctx.Clock(),
 	)
@@ -187,10 +199,12 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 			ResourceService:           domainServices.Resource(),
 			StatusService:             domainServices.Status(),
 			StorageService:            storageService,
+			UnitStateService:          domainServices.UnitState(),
 			CrossModelRelationService: domainServices.CrossModelRelation(),
 		},
 		ctx.Auth(),
 		blockChecker,
+		admissionChecker,
 		ctx.ControllerUUID(),
 		modelInfo.UUID,
 		modelInfo.Type,
@@ -221,6 +235,7 @@ func NewAPIBase(
 	services Services,
 	authorizer facade.Authorizer,
 	blockChecker BlockChecker,
+	admissionChecker AdmissionChecker,
 	controllerUUID string,
 	modelUUID model.UUID,
 	modelType model.ModelType,
@@ -244,6 +259,7 @@ func NewAPIBase(
 		authorizer:            authorizer,
 		repoDeploy:            repoDeploy,
 		check:                 blockChecker,
+		admission:             admissionChecker,
 		controllerUUID:        controllerUUID,
 		modelUUID:             modelUUID,
 		modelType:             modelType,
@@ -264,6 +280,7 @@ func NewAPIBase(
 		resourceService:           services.ResourceService,
 		statusService:             services.StatusService,
 		storageService:            services.StorageService,
+		unitStateService:          services.UnitStateService,
 		crossModelRelationService: services.CrossModelRelationService,
 
 		logger: logger,
@@ -364,7 +381,10 @@ func (api *APIBase) cleanupResourcesAddedBeforeApp(ctx context.Context, appName
 
 // ConfigSchema returns the config schema and defaults for an application.
 func ConfigSchema() (configschema.Fields, schema.Defaults, error) {
-	return trustFields, trustDefaults, nil
+	fields := make(configschema.Fields, len(trustFields)+len(hookTimeoutFields))
+	maps.Copy(fields, trustFields)
+	maps.Copy(fields, hookTimeoutFields)
+	return fields, trustDefaults, nil
 }
 
 func splitTrustFromApplicationConfig(cfg charm.Config) (bool, charm.Config) {
@@ -482,6 +502,9 @@ func (api *APIBase) deployApplication(
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if err := api.admission.Admit(ctx, deployAdmissionRequest(args, ch.locator)); err != nil {
+		return errors.Trace(err)
+	}
 
 	if err := jujuversion.CheckJujuMinVersion(ch.Meta().MinJujuVersion, jujuversion.Current); err != nil {
 		return errors.Trace(err)
@@ -697,6 +720,13 @@ func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetChar
 	if err := apiservercharms.ValidateCharmOrigin(args.CharmOrigin); err != nil {
 		return err
 	}
+	admissionReq, err := api.setCharmAdmissionRequest(ctx, args)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := api.admission.Admit(ctx, admissionReq); err != nil {
+		return errors.Trace(err)
+	}
 
 	newCharmLocator, err := apiservercharms.CharmLocatorFromURL(args.CharmURL)
 	if err != nil {
@@ -958,6 +988,12 @@ func (api *APIBase) Expose(ctx context.Context, args params.ApplicationExpose) e
 	if err := api.check.ChangeAllowed(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	if err := api.admission.Admit(ctx, admission.Request{
+		Operation:   admission.Expose,
+		Application: args.ApplicationName,
+	}); err != nil {
+		return errors.Trace(err)
+	}
 
 	// Map space names to space IDs before calling SetExposed
 	mappedExposeParams, err := api.mapExposedEndpointParams(ctx, args.ExposedEndpoints)
@@ -1153,20 +1189,27 @@ func (api *APIBase) DestroyUnit(ctx context.Context, args params.DestroyUnitsPar
 			return nil, errors.NotSupportedf("removing units from the controller application")
 		}
 
-		var info params.DestroyUnitInfo
-
-		// TODO(storage): return detached / destroyed storage volumes/filesystems
-
-		if arg.DryRun {
-			return &info, nil
-		}
-
 		unitUUID, err := api.applicationService.GetUnitUUID(ctx, unitName)
 		if errors.Is(err, applicationerrors.UnitNotFound) {
 			return nil, errors.NotFoundf("unit %q", unitName)
 		} else if err != nil {
 			return nil, errors.Trace(err)
 		}
+
+		var info params.DestroyUnitInfo
+		if arg.DryRun {
+			plan, err := api.removalService.PlanUnitRemoval(ctx, unitUUID, arg.DestroyStorage)
+			if errors.Is(err, applicationerrors.UnitNotFound) {
+				return nil, errors.NotFoundf("unit %q", unitName)
+			} else if err != nil {
+				return nil, errors.Trace(err)
+			}
+			info.Plan = common.RemovalPlan(plan)
+			info.DestroyedStorage = common.StorageEntities(plan.DestroyedStorage)
+			info.DetachedStorage = common.StorageEntities(plan.DetachedStorage)
+			return &info, nil
+		}
+
 		maxWait := time.Duration(0)
 		if arg.MaxWait != nil {
 			maxWait = *arg.MaxWait
@@ -1233,12 +1276,6 @@ func (api *APIBase) DestroyApplication(ctx context.Context, args params.DestroyA
 				info.DestroyedUnits,
 				params.Entity{Tag: unitTag.String()},
 			)
-
-			// TODO(storage): return detached / destroyed storage volumes/filesystems
-		}
-
-		if arg.DryRun {
-			return &info, nil
 		}
 
 		appID, err := api.applicationService.GetApplicationUUIDByName(ctx, tag.Id())
@@ -1247,6 +1284,19 @@ func (api *APIBase) DestroyApplication(ctx context.Context, args params.DestroyA
 		} else if err != nil {
 			return nil, errors.Annotatef(err, "getting application UUID %q", tag.Id())
 		}
+
+		if arg.DryRun {
+			plan, err := api.removalService.PlanApplicationRemoval(ctx, appID, arg.DestroyStorage)
+			if errors.Is(err, applicationerrors.ApplicationNotFound) {
+				return nil, errors.NotFoundf("application %q", tag.Id())
+			} else if err != nil {
+				return nil, errors.Annotatef(err, "planning removal of application %q", tag.Id())
+			}
+			info.Plan = common.RemovalPlan(plan)
+			info.DestroyedStorage = common.StorageEntities(plan.DestroyedStorage)
+			info.DetachedStorage = common.StorageEntities(plan.DetachedStorage)
+			return &info, nil
+		}
 		maxWait := time.Duration(0)
 		if arg.MaxWait != nil {
 			maxWait = *arg.MaxWait
@@ -1450,6 +1500,13 @@ func (api *APIBase) SetConstraints(ctx context.Context, args params.SetConstrain
 	if err := api.check.ChangeAllowed(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	if err := api.admission.Admit(ctx, admission.Request{
+		Operation:   admission.SetConstraints,
+		Application: args.ApplicationName,
+		Constraints: admission.ConstraintValues(args.Constraints),
+	}); err != nil {
+		return errors.Trace(err)
+	}
 
 	appID, err := api.applicationService.GetApplicationUUIDByName(ctx, args.ApplicationName)
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
@@ -1839,6 +1896,13 @@ func (api *APIBase) setConfig(ctx context.Context, arg params.ConfigSet) params.
 	if arg.ConfigYAML != "" {
 		return params.ErrorResult{Error: apiservererrors.ServerError(errors.NotImplementedf("config yaml not supported"))}
 	}
+	if err := api.admission.Admit(ctx, admission.Request{
+		Operation:   admission.SetConfig,
+		Application: arg.ApplicationName,
+		ConfigKeys:  slices.Sorted(maps.Keys(arg.Config)),
+	}); err != nil {
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}
+	}
 
 	appDetails, err := api.applicationService.GetApplicationDetailsByName(ctx, arg.ApplicationName)
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
@@ -2534,3 +2598,108 @@ func (api *APIBase) UpdateApplicationStorage(ctx context.Context, args params.Ap
 
 // UpdateApplicationStorage isn't on the v21 API.
 func (api *APIv21) UpdateApplicationStorage(_ struct{}) {}
+
+// UnitsHookHistory returns the hook history of the specified units, oldest
+// first.
+func (api *APIBase) UnitsHookHistory(ctx context.Context, args params.Entities) (params.UnitHookHistoryResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.UnitHookHistoryResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.UnitHookHistoryResult, len(args.Entities))
+	for i, entity := range args.Entities {
+		entries, err := api.unitHookHistory(ctx, entity.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Entries = entries
+	}
+	return params.UnitHookHistoryResults{Results: results}, nil
+}
+
+func (api *APIBase) unitHookHistory(ctx context.Context, tag string) ([]params.HookHistoryEntry, error) {
+	unitTag, err := names.ParseUnitTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	unitName, err := coreunit.NewName(unitTag.Id())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	history, err := api.unitStateService.GetHookHistory(ctx, unitName)
+	if errors.Is(err, applicationerrors.UnitNotFound) {
+		return nil, errors.NotFoundf("unit %s", unitName)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	entries := make([]params.HookHistoryEntry, len(history))
+	for i, entry := range history {
+		entries[i] = params.HookHistoryEntry{
+			Kind:       entry.Kind.String(),
+			Name:       entry.Name,
+			RelationId: entry.RelationID,
+			Started:    entry.Started,
+			Duration:   entry.Duration,
+			ExitCode:   entry.ExitCode,
+			Error:      entry.Error,
+		}
+	}
+	return entries, nil
+}
+
+// UnitsHookHistory isn't on the v22 API.
+func (api *APIv22) UnitsHookHistory(_ struct{}) {}
+
+// ApplicationsHookStats returns statistics for each hook, action and
+// commands execution aggregated over the hook history of the units of the
+// specified applications.
+func (api *APIBase) ApplicationsHookStats(ctx context.Context, args params.Entities) (params.ApplicationHookStatsResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.ApplicationHookStatsResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ApplicationHookStatsResult, len(args.Entities))
+	for i, entity := range args.Entities {
+		stats, err := api.applicationHookStats(ctx, entity.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Stats = stats
+	}
+	return params.ApplicationHookStatsResults{Results: results}, nil
+}
+
+func (api *APIBase) applicationHookStats(ctx context.Context, tag string) ([]params.HookStats, error) {
+	appTag, err := names.ParseApplicationTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stats, err := api.unitStateService.GetApplicationHookStats(ctx, appTag.Id())
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %s", appTag.Id())
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]params.HookStats, len(stats))
+	for i, stat := range stats {
+		result[i] = params.HookStats{
+			Kind:         stat.Kind.String(),
+			Name:         stat.Name,
+			Count:        stat.Count,
+			Failures:     stat.Failures,
+			MeanDuration: stat.MeanDuration,
+			MaxDuration:  stat.MaxDuration,
+			LastRun:      stat.LastRun,
+		}
+	}
+	return result, nil
+}
+
+// ApplicationsHookStats isn't on the v22 API.
+func (api *APIv22) ApplicationsHookStats(_ struct{}) {}
@@ -49,6 +49,9 @@ func (api *APIBase) getConfig(
 	appSettings := map[string]any{
 		coreapplication.TrustConfigOptionName: appInfo.Trust,
 	}
+	if appInfo.HookTimeout != nil {
+		appSettings[coreapplication.HookTimeoutConfigOptionName] = appInfo.HookTimeout.String()
+	}
 	providerSchema, providerDefaults, err := ConfigSchema()
 	if err != nil {
 		return params.ApplicationGetResults{}, err
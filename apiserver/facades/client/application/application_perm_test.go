@@ -12,6 +12,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/core/errors"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/rpc/params"
 )
@@ -31,6 +32,7 @@ func (s *permBaseSuite) TestAPIConstruction(c *tc.C) {
 		Services{},
 		s.authorizer,
 		nil,
+		nil,
 		s.controllerUUID, s.modelUUID, "",
 		nil, nil, nil, nil, nil, nil,
 		clock.WallClock,
@@ -47,6 +49,7 @@ func (s *permBaseSuite) TestAPIServiceConstruction(c *tc.C) {
 		Services{},
 		s.authorizer,
 		nil,
+		nil,
 		s.controllerUUID, s.modelUUID, "",
 		nil, nil, nil, nil, nil, nil,
 		clock.WallClock,
@@ -129,7 +132,10 @@ func (s *permBaseSuite) TestSetCharmValidOrigin(c *tc.C) {
 	s.expectAuthClient()
 	s.expectAnyChangeOrRemoval()
 	s.expectHasWritePermission()
+	s.expectAnyAdmitted()
 
+	s.applicationService.EXPECT().GetCharm(gomock.Any(), gomock.Any()).
+		Return(nil, applicationcharm.CharmLocator{}, false, applicationerrors.CharmNotFound)
 	s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(applicationerrors.ApplicationNotFound)
 
@@ -16,6 +16,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/admission"
 	"github.com/juju/juju/core/application"
 	corearch "github.com/juju/juju/core/arch"
 	corecharm "github.com/juju/juju/core/charm"
@@ -47,6 +48,7 @@ import (
 	removalerrors "github.com/juju/juju/domain/removal/errors"
 	"github.com/juju/juju/domain/resolve"
 	resolveerrors "github.com/juju/juju/domain/resolve/errors"
+	"github.com/juju/juju/domain/unitstate"
 	"github.com/juju/juju/environs/bootstrap"
 	"github.com/juju/juju/internal/uuid"
 	"github.com/juju/juju/rpc/params"
@@ -70,6 +72,7 @@ func TestApplicationSuite(t *stdtesting.T) {
 
 func (s *applicationSuite) TestDeploy(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	s.expectCharm(c, charmParams{name: "foo"})
@@ -100,6 +103,45 @@ func (s *applicationSuite) TestDeploy(c *tc.C) {
 	c.Assert(errorResults.Results[0].Error, tc.IsNil)
 }
 
+func (s *applicationSuite) TestDeployDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectCharm(c, charmParams{name: "foo"})
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.Deploy,
+		Application: "foo",
+		Charm:       "foo",
+		CharmSource: "local",
+		Constraints: []string{"mem=1024M"},
+	}).Return(admission.PolicyDenied)
+
+	errorResults, err := s.api.Deploy(c.Context(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{
+			{
+				ApplicationName: "foo",
+				CharmURL:        "local:foo-42",
+				CharmOrigin: &params.CharmOrigin{
+					Type:   "charm",
+					Source: "local",
+					Base: params.Base{
+						Name:    "ubuntu",
+						Channel: "24.04",
+					},
+					Architecture: "amd64",
+					Revision:     new(42),
+					Track:        new("1.0"),
+					Risk:         "stable",
+				},
+				Constraints: constraints.MustParse("mem=1G"),
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errorResults.Results, tc.HasLen, 1)
+	c.Assert(errorResults.Results[0].Error, tc.ErrorMatches, `cannot deploy "foo": denied by admission policy`)
+}
+
 // TestDeployWithResources test the scenario of deploying
 // local charms, or charms via bundles that have resources.
 // Deploy rather than DeployFromRepository is called by the
@@ -107,6 +149,7 @@ func (s *applicationSuite) TestDeploy(c *tc.C) {
 // provided for all charm resources.
 func (s *applicationSuite) TestDeployWithPendingResources(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	resourceUUID := testing.GenResourceUUID(c)
@@ -145,6 +188,7 @@ func (s *applicationSuite) TestDeployWithPendingResources(c *tc.C) {
 
 func (s *applicationSuite) TestDeployWithApplicationConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	s.expectCharm(c, charmParams{name: "foo"})
@@ -179,6 +223,7 @@ func (s *applicationSuite) TestDeployWithApplicationConfig(c *tc.C) {
 
 func (s *applicationSuite) TestDeploySubordinate(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	s.expectCharm(c, charmParams{name: "foo", subordinate: true})
@@ -221,6 +266,7 @@ func (s *applicationSuite) TestDeploySubordinate(c *tc.C) {
 
 func (s *applicationSuite) TestDeployFailureDeletesPendingResources(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	s.expectCharm(c, charmParams{name: "foo", resources: map[string]charmresource.Meta{
@@ -262,6 +308,7 @@ func (s *applicationSuite) TestDeployFailureDeletesPendingResources(c *tc.C) {
 // count and pending resource count do not match.
 func (s *applicationSuite) TestDeployMismatchedResources(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	s.expectCharm(c, charmParams{name: "foo", resources: map[string]charmresource.Meta{
@@ -535,6 +582,53 @@ func (s *applicationSuite) TestDestroyApplicationController(c *tc.C) {
 	c.Assert(res.Results[0].Error, tc.Satisfies, params.IsCodeNotSupported)
 }
 
+func (s *applicationSuite) TestDestroyApplicationDryRun(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	s.setupAPI(c)
+
+	appID := tc.Must(c, application.NewUUID)
+	charmLocator := applicationcharm.CharmLocator{
+		Name:     "foo",
+		Revision: 42,
+		Source:   applicationcharm.CharmHubSource,
+	}
+	s.applicationService.EXPECT().GetCharmLocatorByApplicationName(gomock.Any(), "foo").Return(charmLocator, nil)
+	s.applicationService.EXPECT().GetCharmMetadataName(gomock.Any(), charmLocator).Return("foo", nil)
+	s.applicationService.EXPECT().GetUnitNamesForApplication(gomock.Any(), "foo").Return([]coreunit.Name{"foo/0"}, nil)
+	s.applicationService.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.removalService.EXPECT().PlanApplicationRemoval(gomock.Any(), appID, false).Return(removal.Plan{
+		Applications:    []removal.PlannedRemoval{{Name: "foo"}},
+		Units:           []removal.PlannedRemoval{{Name: "foo/0", ForceRequired: "unit is in an error state"}},
+		Relations:       []removal.PlannedRemoval{{Name: "foo:db bar:db"}},
+		DetachedStorage: []removal.PlannedRemoval{{Name: "data/0"}},
+	}, nil)
+
+	// Act:
+	res, err := s.api.DestroyApplication(c.Context(), params.DestroyApplicationsParams{
+		Applications: []params.DestroyApplicationParams{{
+			ApplicationTag: names.NewApplicationTag("foo").String(),
+			DryRun:         true,
+		}},
+	})
+
+	// Assert:
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Assert(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[0].Info, tc.DeepEquals, &params.DestroyApplicationInfo{
+		DestroyedUnits:  []params.Entity{{Tag: "unit-foo-0"}},
+		DetachedStorage: []params.Entity{{Tag: "storage-data-0"}},
+		Plan: &params.RemovalPlan{
+			Applications:    []params.PlannedRemoval{{Name: "foo"}},
+			Units:           []params.PlannedRemoval{{Name: "foo/0", ForceRequired: "unit is in an error state"}},
+			Relations:       []params.PlannedRemoval{{Name: "foo:db bar:db"}},
+			DetachedStorage: []params.PlannedRemoval{{Name: "data/0"}},
+		},
+	})
+}
+
 func (s *applicationSuite) TestGetApplicationConstraintsAppNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -581,6 +675,7 @@ func (s *applicationSuite) TestGetApplicationConstraints(c *tc.C) {
 
 func (s *applicationSuite) TestSetApplicationConstraintsAppNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 
@@ -595,6 +690,7 @@ func (s *applicationSuite) TestSetApplicationConstraintsAppNotFound(c *tc.C) {
 
 func (s *applicationSuite) TestSetApplicationConstraintsError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 
@@ -610,6 +706,7 @@ func (s *applicationSuite) TestSetApplicationConstraintsError(c *tc.C) {
 
 func (s *applicationSuite) TestSetApplicationConstraints(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 
@@ -623,6 +720,59 @@ func (s *applicationSuite) TestSetApplicationConstraints(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *applicationSuite) TestSetApplicationConstraintsDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.SetConstraints,
+		Application: "foo",
+		Constraints: []string{"mem=42M"},
+	}).Return(admission.PolicyDenied)
+
+	err := s.api.SetConstraints(c.Context(), params.SetConstraints{
+		ApplicationName: "foo",
+		Constraints:     constraints.Value{Mem: new(uint64(42))},
+	})
+	c.Assert(err, tc.ErrorIs, admission.PolicyDenied)
+}
+
+func (s *applicationSuite) TestSetConfigsDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.SetConfig,
+		Application: "foo",
+		ConfigKeys:  []string{"a", "trust"},
+	}).Return(admission.PolicyDenied)
+
+	res, err := s.api.SetConfigs(c.Context(), params.ConfigSetArgs{
+		Args: []params.ConfigSet{{
+			ApplicationName: "foo",
+			Config:          map[string]string{"trust": "true", "a": "b"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Assert(res.Results[0].Error, tc.ErrorMatches, "denied by admission policy")
+}
+
+func (s *applicationSuite) TestExposeDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.Expose,
+		Application: "foo",
+	}).Return(admission.PolicyDenied)
+
+	err := s.api.Expose(c.Context(), params.ApplicationExpose{
+		ApplicationName: "foo",
+	})
+	c.Assert(err, tc.ErrorIs, admission.PolicyDenied)
+}
+
 func (s *applicationSuite) TestAddRelation(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -956,6 +1106,18 @@ func (s *applicationSuite) TestSetCharm(c *tc.C) {
 
 	s.setupAPI(c)
 	revisionPtr := new(42)
+	s.expectCharmLookup(applicationcharm.CharmLocator{
+		Name:         "foo",
+		Revision:     42,
+		Source:       applicationcharm.CharmHubSource,
+		Architecture: architecture.ARM64,
+	})
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.Deploy,
+		Application: "foo",
+		Charm:       "foo",
+		CharmSource: "charm-hub",
+	}).Return(nil)
 	s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), "foo", applicationcharm.CharmLocator{
 		Name:         "foo",
 		Revision:     42,
@@ -1008,6 +1170,40 @@ func (s *applicationSuite) TestSetCharm(c *tc.C) {
 
 }
 
+func (s *applicationSuite) TestSetCharmDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectCharmLookup(applicationcharm.CharmLocator{
+		Name:     "bar",
+		Revision: 7,
+		Source:   applicationcharm.CharmHubSource,
+	})
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.Deploy,
+		Application: "foo",
+		Charm:       "bar",
+		CharmSource: "charm-hub",
+	}).Return(admission.PolicyDenied)
+
+	err := s.api.SetCharm(c.Context(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "ch:amd64/bar-7",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "charm-hub",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "24.04",
+			},
+			Architecture: "amd64",
+			Revision:     new(7),
+			Risk:         "edge",
+		},
+	})
+	c.Assert(err, tc.ErrorIs, admission.PolicyDenied)
+}
+
 func (s *applicationSuite) TestSetConfigsYAMLNotImplemented(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1026,6 +1222,7 @@ func (s *applicationSuite) TestSetConfigsYAMLNotImplemented(c *tc.C) {
 
 func (s *applicationSuite) TestSetConfigsApplicationNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 
@@ -1044,6 +1241,7 @@ func (s *applicationSuite) TestSetConfigsApplicationNotFound(c *tc.C) {
 
 func (s *applicationSuite) TestSetConfigsNotValidApplicationName(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 
@@ -1062,6 +1260,7 @@ func (s *applicationSuite) TestSetConfigsNotValidApplicationName(c *tc.C) {
 
 func (s *applicationSuite) TestSetConfigsInvalidConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	appID := tc.Must(c, application.NewUUID)
@@ -1087,6 +1286,7 @@ func (s *applicationSuite) TestSetConfigsInvalidConfig(c *tc.C) {
 
 func (s *applicationSuite) TestSetConfigs(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	appID := tc.Must(c, application.NewUUID)
@@ -1112,6 +1312,7 @@ func (s *applicationSuite) TestSetConfigs(c *tc.C) {
 
 func (s *applicationSuite) TestSetConfigsSAASApplicationNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.setupAPI(c)
 	appID := tc.Must(c, application.NewUUID)
@@ -1567,6 +1768,94 @@ func (s *applicationSuite) TestUnitsInfoApplicationNotFound(c *tc.C) {
 	c.Check(res.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
 }
 
+func (s *applicationSuite) TestUnitsHookHistory(c *tc.C) {
+	// Arrange
+	defer s.setupMocks(c).Finish()
+
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.unitStateService.EXPECT().GetHookHistory(gomock.Any(), coreunit.Name("foo/0")).Return([]unitstate.HookHistoryEntry{{
+		Kind:       unitstate.HookHistoryHook,
+		Name:       "db-relation-changed",
+		RelationID: new(1),
+		Started:    started,
+		Duration:   time.Second,
+		ExitCode:   1,
+		Error:      "exit status 1",
+	}}, nil)
+	s.unitStateService.EXPECT().GetHookHistory(gomock.Any(), coreunit.Name("foo/1")).Return(nil, applicationerrors.UnitNotFound)
+
+	s.setupAPI(c)
+
+	// Act
+	res, err := s.api.UnitsHookHistory(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewUnitTag("foo/0").String()},
+			{Tag: names.NewUnitTag("foo/1").String()},
+			{Tag: names.NewApplicationTag("foo").String()},
+		},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 3)
+	c.Check(res.Results[0], tc.DeepEquals, params.UnitHookHistoryResult{
+		Entries: []params.HookHistoryEntry{{
+			Kind:       "hook",
+			Name:       "db-relation-changed",
+			RelationId: new(1),
+			Started:    started,
+			Duration:   time.Second,
+			ExitCode:   1,
+			Error:      "exit status 1",
+		}},
+	})
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[2].Error, tc.ErrorMatches, `"application-foo" is not a valid unit tag`)
+}
+
+func (s *applicationSuite) TestApplicationsHookStats(c *tc.C) {
+	// Arrange
+	defer s.setupMocks(c).Finish()
+
+	lastRun := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.unitStateService.EXPECT().GetApplicationHookStats(gomock.Any(), "foo").Return([]unitstate.HookStats{{
+		Kind:         unitstate.HookHistoryHook,
+		Name:         "config-changed",
+		Count:        4,
+		Failures:     1,
+		MeanDuration: 2 * time.Second,
+		MaxDuration:  5 * time.Second,
+		LastRun:      lastRun,
+	}}, nil)
+	s.unitStateService.EXPECT().GetApplicationHookStats(gomock.Any(), "bar").Return(nil, applicationerrors.ApplicationNotFound)
+
+	s.setupAPI(c)
+
+	// Act
+	res, err := s.api.ApplicationsHookStats(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewApplicationTag("foo").String()},
+			{Tag: names.NewApplicationTag("bar").String()},
+		},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 2)
+	c.Check(res.Results[0], tc.DeepEquals, params.ApplicationHookStatsResult{
+		Stats: []params.HookStats{{
+			Kind:         "hook",
+			Name:         "config-changed",
+			Count:        4,
+			Failures:     1,
+			MeanDuration: 2 * time.Second,
+			MaxDuration:  5 * time.Second,
+			LastRun:      lastRun,
+		}},
+	})
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
 func (s *applicationSuite) TestConsumeWithNoArgs(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -2192,6 +2481,12 @@ options:
 	s.applicationService.EXPECT().IsCharmAvailable(gomock.Any(), locator).Return(true, nil)
 }
 
+// expectCharmLookup expects the charm stored under the locator to be read,
+// without its availability being checked.
+func (s *applicationSuite) expectCharmLookup(locator applicationcharm.CharmLocator) {
+	s.applicationService.EXPECT().GetCharm(gomock.Any(), locator).Return(nil, locator, true, nil)
+}
+
 func (s *applicationSuite) expectGetRelationUUIDForRemoval(c *tc.C, args relation.GetRelationUUIDForRemovalArgs, err error) corerelation.UUID {
 	relUUID := relationtesting.GenRelationUUID(c)
 	s.relationService.EXPECT().GetRelationUUIDForRemoval(gomock.Any(), args).Return(relUUID, err)
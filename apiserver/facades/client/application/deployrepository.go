@@ -60,6 +60,7 @@ type DeployFromRepositoryAPI struct {
 	store              objectstore.ObjectStore
 	validator          DeployFromRepositoryValidator
 	applicationService ApplicationService
+	admission          AdmissionChecker
 	logger             corelogger.Logger
 	clock              clock.Clock
 }
@@ -69,6 +70,7 @@ func NewDeployFromRepositoryAPI(
 	modelType model.ModelType,
 	applicationService ApplicationService,
 	store objectstore.ObjectStore, validator DeployFromRepositoryValidator,
+	admission AdmissionChecker,
 	logger corelogger.Logger,
 	clock clock.Clock,
 ) DeployFromRepository {
@@ -77,6 +79,7 @@ func NewDeployFromRepositoryAPI(
 		store:              store,
 		validator:          validator,
 		applicationService: applicationService,
+		admission:          admission,
 		logger:             logger,
 		clock:              clock,
 	}
@@ -91,6 +94,13 @@ func (api *DeployFromRepositoryAPI) DeployFromRepository(ctx context.Context, ar
 		return params.DeployFromRepositoryInfo{}, nil, errs
 	}
 
+	// The charm is checked against the admission policy once it has been
+	// resolved, so that rules match the channel and publisher it resolved
+	// to rather than those requested.
+	if err := api.admission.Admit(ctx, deployFromRepositoryAdmissionRequest(dt)); err != nil {
+		return params.DeployFromRepositoryInfo{}, nil, []error{err}
+	}
+
 	info := params.DeployFromRepositoryInfo{
 		Architecture: dt.origin.Platform.Architecture,
 		Base: params.Base{
@@ -4,18 +4,23 @@
 package application
 
 import (
+	"context"
 	"errors"
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	"github.com/juju/juju/core/admission"
 	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/model"
 	applicationservice "github.com/juju/juju/domain/application/service"
 	"github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/domain/deployment/charm/repository"
 	"github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/environs/config"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -160,6 +165,50 @@ func (s *deployRepositorySuite) TestResolveResourcesWithResourcesErrorWhileCharm
 }
 
 // expectValidator sets up a mock deployFromRepositoryValidator with predefined expectations for testing purposes.
+func (s *deployRepositorySuite) TestDeployFromRepositoryDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	validator := stubValidator{template: deployTemplate{
+		applicationName: "db",
+		charmURL:        charm.MustParseURL("ch:amd64/mysql-42"),
+		origin: corecharm.Origin{
+			Source:    corecharm.CharmHub,
+			Channel:   &charm.Channel{Track: "8.0", Risk: charm.Stable},
+			Publisher: "data-platform",
+		},
+		numUnits: 1,
+	}}
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:    admission.Deploy,
+		Application:  "db",
+		Charm:        "mysql",
+		CharmSource:  "charm-hub",
+		CharmChannel: "8.0/stable",
+		Publisher:    "data-platform",
+		Constraints:  []string{},
+	}).Return(admission.PolicyDenied)
+	api := NewDeployFromRepositoryAPI(
+		model.IAAS, s.applicationService, nil, validator, s.admissionChecker,
+		loggertesting.WrapCheckLog(c), clock.WallClock,
+	)
+
+	// Act
+	_, _, errs := api.DeployFromRepository(c.Context(), params.DeployFromRepositoryArg{CharmName: "mysql"})
+
+	// Assert
+	c.Assert(errs, tc.HasLen, 1)
+	c.Check(errs[0], tc.ErrorIs, admission.PolicyDenied)
+}
+
+type stubValidator struct {
+	template deployTemplate
+}
+
+func (v stubValidator) ValidateArg(context.Context, params.DeployFromRepositoryArg) (deployTemplate, []error) {
+	return v.template, nil
+}
+
 func (s *deployRepositorySuite) expectValidator() deployFromRepositoryValidator {
 	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(&config.Config{}, nil)
 	validator := deployFromRepositoryValidator{
@@ -0,0 +1,20 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/internal/configschema"
+)
+
+// hookTimeoutFields describes the application setting that overrides the
+// hook-timeout model config. It has no default, as the model config applies
+// until it is set.
+var hookTimeoutFields = configschema.Fields{
+	application.HookTimeoutConfigOptionName: {
+		Description: "How long a hook of this application may run before it is killed, overriding the hook-timeout model config",
+		Type:        configschema.Tstring,
+		Group:       configschema.JujuGroup,
+	},
+}
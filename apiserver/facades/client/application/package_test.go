@@ -20,7 +20,7 @@ import (
 	"github.com/juju/juju/internal/uuid"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService,StatusService
+//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,DeployFromRepository,BlockChecker,AdmissionChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService,StatusService,UnitStateService
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination legacy_mock_test.go github.com/juju/juju/apiserver/facades/client/application CaasBrokerInterface
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStore
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination facade_mock_test.go github.com/juju/juju/apiserver/facade Authorizer
@@ -35,6 +35,7 @@ type baseSuite struct {
 	applicationService        *MockApplicationService
 	authorizer                *MockAuthorizer
 	blockChecker              *MockBlockChecker
+	admissionChecker          *MockAdmissionChecker
 	crossModelRelationService *MockCrossModelRelationService
 	deployFromRepo            *MockDeployFromRepository
 	externalControllerService *MockExternalControllerService
@@ -50,6 +51,7 @@ type baseSuite struct {
 	resourceService           *MockResourceService
 	statusService             *MockStatusService
 	storageService            *MockStorageService
+	unitStateService          *MockUnitStateService
 
 	charmRepository        *MockRepository
 	charmRepositoryFactory *MockRepositoryFactory
@@ -79,9 +81,11 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.resourceService = NewMockResourceService(ctrl)
 	s.statusService = NewMockStatusService(ctrl)
 	s.storageService = NewMockStorageService(ctrl)
+	s.unitStateService = NewMockUnitStateService(ctrl)
 
 	s.authorizer = NewMockAuthorizer(ctrl)
 	s.blockChecker = NewMockBlockChecker(ctrl)
+	s.admissionChecker = NewMockAdmissionChecker(ctrl)
 	s.leadershipReader = NewMockLeadership(ctrl)
 	s.deployFromRepo = NewMockDeployFromRepository(ctrl)
 
@@ -123,6 +127,10 @@ func (s *baseSuite) expectAnyChangeOrRemoval() {
 	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).Return(nil).AnyTimes()
 }
 
+func (s *baseSuite) expectAnyAdmitted() {
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
 func (s *baseSuite) newIAASAPI(c *tc.C) {
 	s.newAPI(c, model.IAAS)
 }
@@ -150,9 +158,11 @@ func (s *baseSuite) newAPI(c *tc.C, modelType model.ModelType) {
 			ResourceService:           s.resourceService,
 			StatusService:             s.statusService,
 			StorageService:            s.storageService,
+			UnitStateService:          s.unitStateService,
 		},
 		s.authorizer,
 		s.blockChecker,
+		s.admissionChecker,
 		s.controllerUUID,
 		s.modelUUID,
 		s.modelType,
@@ -27,6 +27,9 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Application", 22, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV22(stdCtx, ctx) // Added GetApplicationStorage and UpdateApplicationStorage storage constraints support
 	}, reflect.TypeFor[*APIv22]())
+	registry.MustRegister("Application", 23, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV23(stdCtx, ctx) // Added UnitsHookHistory and ApplicationsHookStats
+	}, reflect.TypeFor[*APIv23]())
 }
 
 func newFacadeV19(stdCtx context.Context, ctx facade.ModelContext) (*APIv19, error) {
@@ -54,9 +57,17 @@ func newFacadeV21(stdCtx context.Context, ctx facade.ModelContext) (*APIv21, err
 }
 
 func newFacadeV22(stdCtx context.Context, ctx facade.ModelContext) (*APIv22, error) {
-	api, err := newFacadeBase(stdCtx, ctx)
+	api, err := newFacadeV23(stdCtx, ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &APIv22{api}, nil
 }
+
+func newFacadeV23(stdCtx context.Context, ctx facade.ModelContext) (*APIv23, error) {
+	api, err := newFacadeBase(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv23{api}, nil
+}
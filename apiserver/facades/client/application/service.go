@@ -11,6 +11,7 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/admission"
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/base"
@@ -38,6 +39,7 @@ import (
 	"github.com/juju/juju/domain/removal"
 	"github.com/juju/juju/domain/resolve"
 	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/domain/unitstate"
 	"github.com/juju/juju/environs/config"
 )
 
@@ -55,6 +57,7 @@ type Services struct {
 	ResourceService           ResourceService
 	StatusService             StatusService
 	StorageService            StorageService
+	UnitStateService          UnitStateService
 	CrossModelRelationService CrossModelRelationService
 }
 
@@ -96,6 +99,9 @@ func (s Services) Validate() error {
 	if s.StorageService == nil {
 		return errors.NotValidf("empty StorageService")
 	}
+	if s.UnitStateService == nil {
+		return errors.NotValidf("empty UnitStateService")
+	}
 	if s.CrossModelRelationService == nil {
 		return errors.NotValidf("empty CrossModelRelationService")
 	}
@@ -444,6 +450,18 @@ type StatusService interface {
 	SetRemoteRelationStatus(ctx context.Context, relationUUID corerelation.UUID, statusInfo status.StatusInfo) error
 }
 
+// UnitStateService provides access to the hook history of units.
+type UnitStateService interface {
+	// GetHookHistory returns the hook history of the unit with the input
+	// name, oldest first.
+	GetHookHistory(ctx context.Context, name unit.Name) ([]unitstate.HookHistoryEntry, error)
+
+	// GetApplicationHookStats returns statistics for each hook, action and
+	// commands execution aggregated over the hook history of the units of
+	// the application with the input name.
+	GetApplicationHookStats(ctx context.Context, appName string) ([]unitstate.HookStats, error)
+}
+
 // BlockChecker defines the block-checking functionality required by
 // the application facade. This is implemented by
 // apiserver/common.BlockChecker.
@@ -452,6 +470,13 @@ type BlockChecker interface {
 	RemoveAllowed(context.Context) error
 }
 
+// AdmissionChecker defines the admission policy checking functionality
+// required by the application facade. This is implemented by
+// apiserver/common.AdmissionChecker.
+type AdmissionChecker interface {
+	Admit(context.Context, admission.Request) error
+}
+
 // Leadership describes the capability to read the current state of leadership.
 type Leadership interface {
 
@@ -542,6 +567,18 @@ type RemovalService interface {
 		wait time.Duration,
 	) (removal.UUID, error)
 
+	// PlanApplicationRemoval returns the entities that would be removed along
+	// with the application identified by the input UUID, and which of them
+	// would require force. Nothing is removed.
+	PlanApplicationRemoval(
+		ctx context.Context, appUUID coreapplication.UUID, destroyStorage bool,
+	) (removal.Plan, error)
+
+	// PlanUnitRemoval returns the entities that would be removed along with
+	// the unit identified by the input UUID, and which of them would require
+	// force. Nothing is removed.
+	PlanUnitRemoval(ctx context.Context, unitUUID unit.UUID, destroyStorage bool) (removal.Plan, error)
+
 	// RemoveRelation checks if a relation with the input UUID exists.
 	// If it does, the relation is guaranteed after this call to be:
 	// - No longer alive.
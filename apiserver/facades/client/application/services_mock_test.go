@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService,StatusService)
+// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: NetworkService,DeployFromRepository,BlockChecker,AdmissionChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService,StatusService,UnitStateService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService,StatusService
+//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,DeployFromRepository,BlockChecker,AdmissionChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService,StatusService,UnitStateService
 //
 
 // Package application is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	set "github.com/juju/collections/set"
+	admission "github.com/juju/juju/core/admission"
 	application "github.com/juju/juju/core/application"
 	assumes "github.com/juju/juju/core/assumes"
 	base "github.com/juju/juju/core/base"
@@ -40,6 +41,7 @@ import (
 	removal "github.com/juju/juju/domain/removal"
 	resolve "github.com/juju/juju/domain/resolve"
 	storage "github.com/juju/juju/domain/storage"
+	unitstate "github.com/juju/juju/domain/unitstate"
 	config "github.com/juju/juju/environs/config"
 	params "github.com/juju/juju/rpc/params"
 	gomock "go.uber.org/mock/gomock"
@@ -308,6 +310,67 @@ func (c *MockBlockCheckerRemoveAllowedCall) DoAndReturn(f func(context.Context)
 	return c
 }
 
+// MockAdmissionChecker is a mock of AdmissionChecker interface.
+type MockAdmissionChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionCheckerMockRecorder
+}
+
+// MockAdmissionCheckerMockRecorder is the mock recorder for MockAdmissionChecker.
+type MockAdmissionCheckerMockRecorder struct {
+	mock *MockAdmissionChecker
+}
+
+// NewMockAdmissionChecker creates a new mock instance.
+func NewMockAdmissionChecker(ctrl *gomock.Controller) *MockAdmissionChecker {
+	mock := &MockAdmissionChecker{ctrl: ctrl}
+	mock.recorder = &MockAdmissionCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionChecker) EXPECT() *MockAdmissionCheckerMockRecorder {
+	return m.recorder
+}
+
+// Admit mocks base method.
+func (m *MockAdmissionChecker) Admit(arg0 context.Context, arg1 admission.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admit", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Admit indicates an expected call of Admit.
+func (mr *MockAdmissionCheckerMockRecorder) Admit(arg0, arg1 any) *MockAdmissionCheckerAdmitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admit", reflect.TypeOf((*MockAdmissionChecker)(nil).Admit), arg0, arg1)
+	return &MockAdmissionCheckerAdmitCall{Call: call}
+}
+
+// MockAdmissionCheckerAdmitCall wrap *gomock.Call
+type MockAdmissionCheckerAdmitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionCheckerAdmitCall) Return(arg0 error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionCheckerAdmitCall) Do(f func(context.Context, admission.Request) error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionCheckerAdmitCall) DoAndReturn(f func(context.Context, admission.Request) error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockModelConfigService is a mock of ModelConfigService interface.
 type MockModelConfigService struct {
 	ctrl     *gomock.Controller
@@ -2778,6 +2841,84 @@ func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
 	return m.recorder
 }
 
+// PlanApplicationRemoval mocks base method.
+func (m *MockRemovalService) PlanApplicationRemoval(arg0 context.Context, arg1 application.UUID, arg2 bool) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanApplicationRemoval", arg0, arg1, arg2)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanApplicationRemoval indicates an expected call of PlanApplicationRemoval.
+func (mr *MockRemovalServiceMockRecorder) PlanApplicationRemoval(arg0, arg1, arg2 any) *MockRemovalServicePlanApplicationRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanApplicationRemoval", reflect.TypeOf((*MockRemovalService)(nil).PlanApplicationRemoval), arg0, arg1, arg2)
+	return &MockRemovalServicePlanApplicationRemovalCall{Call: call}
+}
+
+// MockRemovalServicePlanApplicationRemovalCall wrap *gomock.Call
+type MockRemovalServicePlanApplicationRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServicePlanApplicationRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockRemovalServicePlanApplicationRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServicePlanApplicationRemovalCall) Do(f func(context.Context, application.UUID, bool) (removal.Plan, error)) *MockRemovalServicePlanApplicationRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServicePlanApplicationRemovalCall) DoAndReturn(f func(context.Context, application.UUID, bool) (removal.Plan, error)) *MockRemovalServicePlanApplicationRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PlanUnitRemoval mocks base method.
+func (m *MockRemovalService) PlanUnitRemoval(arg0 context.Context, arg1 unit.UUID, arg2 bool) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanUnitRemoval", arg0, arg1, arg2)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanUnitRemoval indicates an expected call of PlanUnitRemoval.
+func (mr *MockRemovalServiceMockRecorder) PlanUnitRemoval(arg0, arg1, arg2 any) *MockRemovalServicePlanUnitRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanUnitRemoval", reflect.TypeOf((*MockRemovalService)(nil).PlanUnitRemoval), arg0, arg1, arg2)
+	return &MockRemovalServicePlanUnitRemovalCall{Call: call}
+}
+
+// MockRemovalServicePlanUnitRemovalCall wrap *gomock.Call
+type MockRemovalServicePlanUnitRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServicePlanUnitRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockRemovalServicePlanUnitRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServicePlanUnitRemovalCall) Do(f func(context.Context, unit.UUID, bool) (removal.Plan, error)) *MockRemovalServicePlanUnitRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServicePlanUnitRemovalCall) DoAndReturn(f func(context.Context, unit.UUID, bool) (removal.Plan, error)) *MockRemovalServicePlanUnitRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveApplication mocks base method.
 func (m *MockRemovalService) RemoveApplication(arg0 context.Context, arg1 application.UUID, arg2, arg3 bool, arg4 time.Duration) (removal.UUID, error) {
 	m.ctrl.T.Helper()
@@ -3233,3 +3374,104 @@ func (c *MockStatusServiceSetRemoteRelationStatusCall) DoAndReturn(f func(contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockUnitStateService is a mock of UnitStateService interface.
+type MockUnitStateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitStateServiceMockRecorder
+}
+
+// MockUnitStateServiceMockRecorder is the mock recorder for MockUnitStateService.
+type MockUnitStateServiceMockRecorder struct {
+	mock *MockUnitStateService
+}
+
+// NewMockUnitStateService creates a new mock instance.
+func NewMockUnitStateService(ctrl *gomock.Controller) *MockUnitStateService {
+	mock := &MockUnitStateService{ctrl: ctrl}
+	mock.recorder = &MockUnitStateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitStateService) EXPECT() *MockUnitStateServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationHookStats mocks base method.
+func (m *MockUnitStateService) GetApplicationHookStats(arg0 context.Context, arg1 string) ([]unitstate.HookStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationHookStats", arg0, arg1)
+	ret0, _ := ret[0].([]unitstate.HookStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationHookStats indicates an expected call of GetApplicationHookStats.
+func (mr *MockUnitStateServiceMockRecorder) GetApplicationHookStats(arg0, arg1 any) *MockUnitStateServiceGetApplicationHookStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationHookStats", reflect.TypeOf((*MockUnitStateService)(nil).GetApplicationHookStats), arg0, arg1)
+	return &MockUnitStateServiceGetApplicationHookStatsCall{Call: call}
+}
+
+// MockUnitStateServiceGetApplicationHookStatsCall wrap *gomock.Call
+type MockUnitStateServiceGetApplicationHookStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStateServiceGetApplicationHookStatsCall) Return(arg0 []unitstate.HookStats, arg1 error) *MockUnitStateServiceGetApplicationHookStatsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStateServiceGetApplicationHookStatsCall) Do(f func(context.Context, string) ([]unitstate.HookStats, error)) *MockUnitStateServiceGetApplicationHookStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStateServiceGetApplicationHookStatsCall) DoAndReturn(f func(context.Context, string) ([]unitstate.HookStats, error)) *MockUnitStateServiceGetApplicationHookStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetHookHistory mocks base method.
+func (m *MockUnitStateService) GetHookHistory(arg0 context.Context, arg1 unit.Name) ([]unitstate.HookHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHookHistory", arg0, arg1)
+	ret0, _ := ret[0].([]unitstate.HookHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHookHistory indicates an expected call of GetHookHistory.
+func (mr *MockUnitStateServiceMockRecorder) GetHookHistory(arg0, arg1 any) *MockUnitStateServiceGetHookHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHookHistory", reflect.TypeOf((*MockUnitStateService)(nil).GetHookHistory), arg0, arg1)
+	return &MockUnitStateServiceGetHookHistoryCall{Call: call}
+}
+
+// MockUnitStateServiceGetHookHistoryCall wrap *gomock.Call
+type MockUnitStateServiceGetHookHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStateServiceGetHookHistoryCall) Return(arg0 []unitstate.HookHistoryEntry, arg1 error) *MockUnitStateServiceGetHookHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStateServiceGetHookHistoryCall) Do(f func(context.Context, unit.Name) ([]unitstate.HookHistoryEntry, error)) *MockUnitStateServiceGetHookHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStateServiceGetHookHistoryCall) DoAndReturn(f func(context.Context, unit.Name) ([]unitstate.HookHistoryEntry, error)) *MockUnitStateServiceGetHookHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
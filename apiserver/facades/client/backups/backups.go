@@ -5,6 +5,7 @@ package backups
 
 import (
 	"context"
+	"io"
 
 	"github.com/juju/names/v6"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
 	corebackups "github.com/juju/juju/core/backups"
+	coremodel "github.com/juju/juju/core/model"
 )
 
 // ControllerConfigService is an interface that provides the controller config.
@@ -20,19 +22,59 @@ type ControllerConfigService interface {
 	ControllerConfig(context.Context) (controller.Config, error)
 }
 
+// ControllerNodeService provides the nodes of the controller.
+type ControllerNodeService interface {
+	// GetControllerIDs returns the list of controller IDs from the
+	// controller node records.
+	GetControllerIDs(ctx context.Context) ([]string, error)
+}
+
+// ModelService provides the models of the controller.
+type ModelService interface {
+	// GetModelUUIDs returns a list of all model UUIDs in the controller
+	// that are active.
+	GetModelUUIDs(ctx context.Context) ([]coremodel.UUID, error)
+}
+
+// BackupService dumps a controller or model database.
+type BackupService interface {
+	// DumpDatabase writes the schema and content of the database to w.
+	DumpDatabase(ctx context.Context, w io.Writer) error
+}
+
+// BackupServiceGetter returns the backup service for the given model.
+type BackupServiceGetter func(ctx context.Context, modelUUID coremodel.UUID) (BackupService, error)
+
+// Services holds the domain services used by the backups facade.
+type Services struct {
+	ControllerConfigService ControllerConfigService
+	ControllerNodeService   ControllerNodeService
+	ModelService            ModelService
+	ControllerBackupService BackupService
+	ModelBackupService      BackupServiceGetter
+}
+
 // API provides backup-specific API methods.
 type API struct {
 	controllerConfigService ControllerConfigService
+	controllerNodeService   ControllerNodeService
+	modelService            ModelService
+	controllerBackupService BackupService
+	modelBackupService      BackupServiceGetter
 	paths                   *corebackups.Paths
 
+	// modelUUID is the UUID of the model the facade is accessed through.
+	modelUUID coremodel.UUID
+
 	// machineID is the ID of the machine where the API server is running.
 	machineID string
 }
 
 // NewAPI creates a new instance of the Backups API facade.
 func NewAPI(
-	controllerConfigService ControllerConfigService,
+	services Services,
 	authorizer facade.Authorizer,
+	modelUUID coremodel.UUID,
 	machineTag names.Tag,
 	dataDir, logDir string,
 ) (*API, error) {
@@ -45,14 +87,38 @@ func NewAPI(
 	}
 
 	paths := corebackups.Paths{
-		DataDir: dataDir,
-		LogsDir: logDir,
+		BackupDir: corebackups.BackupDir(dataDir),
+		DataDir:   dataDir,
+		LogsDir:   logDir,
 	}
 
 	b := API{
-		controllerConfigService: controllerConfigService,
+		controllerConfigService: services.ControllerConfigService,
+		controllerNodeService:   services.ControllerNodeService,
+		modelService:            services.ModelService,
+		controllerBackupService: services.ControllerBackupService,
+		modelBackupService:      services.ModelBackupService,
 		paths:                   &paths,
+		modelUUID:               modelUUID,
 		machineID:               machineTag.Id(),
 	}
 	return &b, nil
 }
+
+// APIv3 provides version 3 of the backups facade, which only supports
+// creating backups.
+type APIv3 struct {
+	*API
+}
+
+// List is not available on version 3 of the facade.
+func (api *APIv3) List(_ struct{}) {}
+
+// Info is not available on version 3 of the facade.
+func (api *APIv3) Info(_ struct{}) {}
+
+// Remove is not available on version 3 of the facade.
+func (api *APIv3) Remove(_ struct{}) {}
+
+// Restore is not available on version 3 of the facade.
+func (api *APIv3) Restore(_ struct{}) {}
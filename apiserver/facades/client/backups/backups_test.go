@@ -0,0 +1,247 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"github.com/juju/utils/v4/tar"
+
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
+	corebackups "github.com/juju/juju/core/backups"
+	bt "github.com/juju/juju/core/backups/testing"
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type backupsSuite struct {
+	testhelpers.IsolationSuite
+
+	dataDir     string
+	controllers []string
+	api         *API
+}
+
+func TestBackupsSuite(t *stdtesting.T) {
+	tc.Run(t, &backupsSuite{})
+}
+
+type stubControllerConfigService struct{}
+
+func (stubControllerConfigService) ControllerConfig(context.Context) (controller.Config, error) {
+	return testing.FakeControllerConfig(), nil
+}
+
+type stubControllerNodeService struct {
+	controllers *[]string
+}
+
+func (s stubControllerNodeService) GetControllerIDs(context.Context) ([]string, error) {
+	return *s.controllers, nil
+}
+
+type stubModelService struct{}
+
+func (stubModelService) GetModelUUIDs(context.Context) ([]coremodel.UUID, error) {
+	return []coremodel.UUID{modelUUID}, nil
+}
+
+type stubBackupService struct {
+	dump string
+}
+
+func (s stubBackupService) DumpDatabase(_ context.Context, w io.Writer) error {
+	_, err := io.WriteString(w, s.dump)
+	return err
+}
+
+var modelUUID = coremodel.UUID(testing.ModelTag.Id())
+
+func (s *backupsSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.dataDir = c.MkDir()
+	s.controllers = []string{"0"}
+	authorizer := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("admin")}
+
+	services := Services{
+		ControllerConfigService: stubControllerConfigService{},
+		ControllerNodeService:   stubControllerNodeService{controllers: &s.controllers},
+		ModelService:            stubModelService{},
+		ControllerBackupService: stubBackupService{dump: "controller dump"},
+		ModelBackupService: func(_ context.Context, uuid coremodel.UUID) (BackupService, error) {
+			return stubBackupService{dump: "dump of " + uuid.String()}, nil
+		},
+	}
+
+	var err error
+	s.api, err = NewAPI(services, authorizer, modelUUID, names.NewMachineTag("0"), s.dataDir, c.MkDir())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *backupsSuite) writeArchive(c *tc.C, filename, controllerUUID string) {
+	meta := corebackups.NewMetadata()
+	meta.SetID(filename)
+	meta.Origin.Version = jujuversion.Current
+	meta.Controller.UUID = controllerUUID
+	err := meta.MarkComplete(10, "checksum")
+	c.Assert(err, tc.ErrorIsNil)
+
+	archive, err := bt.NewArchive(meta, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	dir := corebackups.BackupDir(s.dataDir)
+	err = os.MkdirAll(dir, 0700)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(filepath.Join(dir, filename), archive.Bytes(), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *backupsSuite) TestListEmpty(c *tc.C) {
+	result, err := s.api.List(c.Context(), params.BackupsListArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.List, tc.HasLen, 0)
+}
+
+func (s *backupsSuite) TestList(c *tc.C) {
+	s.writeArchive(c, "juju-backup-20260101-000000.tar.gz", testing.ControllerTag.Id())
+
+	result, err := s.api.List(c.Context(), params.BackupsListArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.List, tc.HasLen, 1)
+	c.Check(result.List[0].Filename, tc.Equals, "juju-backup-20260101-000000.tar.gz")
+	c.Check(result.List[0].ControllerUUID, tc.Equals, testing.ControllerTag.Id())
+}
+
+func (s *backupsSuite) TestInfoNotFound(c *tc.C) {
+	_, err := s.api.Info(c.Context(), params.BackupsInfoArgs{ID: "juju-backup-20260101-000000.tar.gz"})
+	c.Check(err, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *backupsSuite) TestRemove(c *tc.C) {
+	s.writeArchive(c, "juju-backup-20260101-000000.tar.gz", testing.ControllerTag.Id())
+
+	result, err := s.api.Remove(c.Context(), params.BackupsRemoveArgs{
+		IDs: []string{"juju-backup-20260101-000000.tar.gz", "juju-backup-20260102-000000.tar.gz"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *backupsSuite) TestRestoreDryRun(c *tc.C) {
+	s.writeArchive(c, "juju-backup-20260101-000000.tar.gz", testing.ControllerTag.Id())
+
+	result, err := s.api.Restore(c.Context(), params.BackupsRestoreArgs{
+		ID:     "juju-backup-20260101-000000.tar.gz",
+		DryRun: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.IsNil)
+	c.Check(result.ControllerVersion, tc.Equals, jujuversion.Current)
+	c.Check(result.Metadata.Filename, tc.Equals, "juju-backup-20260101-000000.tar.gz")
+}
+
+func (s *backupsSuite) TestRestoreDryRunIncompatible(c *tc.C) {
+	s.writeArchive(c, "juju-backup-20260101-000000.tar.gz", "another-controller")
+
+	result, err := s.api.Restore(c.Context(), params.BackupsRestoreArgs{
+		ID:     "juju-backup-20260101-000000.tar.gz",
+		DryRun: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.NotNil)
+	c.Check(result.Error, tc.ErrorMatches, `backup of controller "another-controller" cannot be restored .*`)
+}
+
+func (s *backupsSuite) TestRestoreStaged(c *tc.C) {
+	s.writeArchive(c, "juju-backup-20260101-000000.tar.gz", testing.ControllerTag.Id())
+
+	result, err := s.api.Restore(c.Context(), params.BackupsRestoreArgs{
+		ID: "juju-backup-20260101-000000.tar.gz",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.IsNil)
+
+	path, err := corebackups.PendingRestore(corebackups.BackupDir(s.dataDir))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(filepath.Base(path), tc.Equals, "juju-backup-20260101-000000.tar.gz")
+}
+
+func (s *backupsSuite) TestRestoreIncompatibleNotStaged(c *tc.C) {
+	s.writeArchive(c, "juju-backup-20260101-000000.tar.gz", "another-controller")
+
+	result, err := s.api.Restore(c.Context(), params.BackupsRestoreArgs{
+		ID: "juju-backup-20260101-000000.tar.gz",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.NotNil)
+
+	_, err = corebackups.PendingRestore(corebackups.BackupDir(s.dataDir))
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *backupsSuite) TestRestoreHAControllerNotSupported(c *tc.C) {
+	s.writeArchive(c, "juju-backup-20260101-000000.tar.gz", testing.ControllerTag.Id())
+	s.controllers = []string{"0", "1", "2"}
+
+	_, err := s.api.Restore(c.Context(), params.BackupsRestoreArgs{
+		ID: "juju-backup-20260101-000000.tar.gz",
+	})
+	c.Check(err, tc.ErrorMatches, `restoring a backup into a controller with 3 nodes not supported`)
+
+	_, err = corebackups.PendingRestore(corebackups.BackupDir(s.dataDir))
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *backupsSuite) TestCreate(c *tc.C) {
+	result, err := s.api.Create(c.Context(), params.BackupsCreateArgs{Notes: "my notes"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Notes, tc.Equals, "my notes")
+	c.Check(result.ControllerUUID, tc.Equals, testing.ControllerTag.Id())
+	c.Check(result.Model, tc.Equals, modelUUID.String())
+	c.Check(result.Machine, tc.Equals, "0")
+	c.Check(result.HANodes, tc.Equals, int64(1))
+	c.Check(result.Version, tc.Equals, jujuversion.Current)
+	c.Check(result.Size, tc.Not(tc.Equals), int64(0))
+
+	// The archive is stored on the controller, holding the dump of the
+	// controller and every model database.
+	archivePath := filepath.Join(corebackups.BackupDir(s.dataDir), result.Filename)
+	f, err := os.Open(archivePath)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = f.Close() }()
+	archive, err := corebackups.NewArchiveDataReader(f)
+	c.Assert(err, tc.ErrorIsNil)
+	for namespace, dump := range map[string]string{
+		"controller":       "controller dump",
+		modelUUID.String(): "dump of " + modelUUID.String(),
+	} {
+		_, r, err := tar.FindFile(archive.NewBuffer(), "juju-backup/dump/"+namespace+".jsonl")
+		c.Assert(err, tc.ErrorIsNil)
+		data, err := io.ReadAll(r)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(string(data), tc.Equals, dump)
+	}
+
+	// A created backup can be restored into the controller.
+	restore, err := s.api.Restore(c.Context(), params.BackupsRestoreArgs{
+		ID:     result.Filename,
+		DryRun: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(restore.Error, tc.IsNil)
+}
@@ -5,15 +5,85 @@ package backups
 
 import (
 	"context"
+	"io"
+	"os"
 
 	"github.com/juju/errors"
 
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	corebackups "github.com/juju/juju/core/backups"
+	coredatabase "github.com/juju/juju/core/database"
+	coremodel "github.com/juju/juju/core/model"
+	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/rpc/params"
 )
 
 // Create is the API method that requests juju to create a new backup
-// of its state.
-func (a *API) Create(context.Context, params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
+// of its state. The backup holds the controller database, the database of
+// every model and the files of the object store, and is stored in the
+// backup directory of the controller node serving the request.
+func (a *API) Create(ctx context.Context, args params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
 	result := params.BackupsMetadataResult{}
-	return result, errors.NotImplementedf("Dqlite-based backups")
+
+	cfg, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+	nodes, err := a.controllerNodeService.GetControllerIDs(ctx)
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+	modelUUIDs, err := a.modelService.GetModelUUIDs(ctx)
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = corebackups.UnknownString
+	}
+
+	meta := corebackups.NewMetadata()
+	meta.Notes = args.Notes
+	meta.Origin = corebackups.Origin{
+		Model:    a.modelUUID.String(),
+		Machine:  a.machineID,
+		Hostname: hostname,
+		Version:  jujuversion.Current,
+	}
+	meta.Controller = corebackups.ControllerMetadata{
+		UUID:              cfg.ControllerUUID(),
+		MachineID:         a.machineID,
+		MachineInstanceID: corebackups.UnknownString,
+		HANodes:           int64(len(nodes)),
+	}
+
+	namespaces := []string{coredatabase.ControllerNS}
+	for _, modelUUID := range modelUUIDs {
+		namespaces = append(namespaces, modelUUID.String())
+	}
+
+	archive, err := corebackups.CreateArchive(ctx, corebackups.CreateArgs{
+		Paths:      *a.paths,
+		Metadata:   meta,
+		Namespaces: namespaces,
+		Dump:       a.dumpDatabase,
+	})
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Annotate(err, "creating backup"))
+	}
+	return params.CreateResult(archive.Metadata, archive.Filename), nil
+}
+
+// dumpDatabase writes the dump of the controller database, or of the model
+// database with the given namespace, to w.
+func (a *API) dumpDatabase(ctx context.Context, namespace string, w io.Writer) error {
+	if namespace == coredatabase.ControllerNS {
+		return errors.Trace(a.controllerBackupService.DumpDatabase(ctx, w))
+	}
+	svc, err := a.modelBackupService(ctx, coremodel.UUID(namespace))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(svc.DumpDatabase(ctx, w))
 }
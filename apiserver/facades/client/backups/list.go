@@ -0,0 +1,40 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+// List returns the metadata of all backup archives stored on the
+// controller.
+func (a *API) List(ctx context.Context, args params.BackupsListArgs) (params.BackupsListResult, error) {
+	var result params.BackupsListResult
+
+	archives, err := corebackups.ListArchives(a.paths.BackupDir)
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+
+	result.List = make([]params.BackupsMetadataResult, len(archives))
+	for i, archive := range archives {
+		result.List[i] = params.CreateResult(archive.Metadata, archive.Filename)
+	}
+	return result, nil
+}
+
+// Info returns the metadata of the requested backup archive.
+func (a *API) Info(ctx context.Context, args params.BackupsInfoArgs) (params.BackupsMetadataResult, error) {
+	archive, err := corebackups.OpenArchive(a.paths.BackupDir, args.ID)
+	if err != nil {
+		return params.BackupsMetadataResult{}, apiservererrors.ServerError(errors.Trace(err))
+	}
+	return params.CreateResult(archive.Metadata, archive.Filename), nil
+}
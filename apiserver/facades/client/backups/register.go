@@ -8,20 +8,49 @@ import (
 	"reflect"
 
 	"github.com/juju/juju/apiserver/facade"
+	coremodel "github.com/juju/juju/core/model"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
-	registry.MustRegister("Backups", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+	registry.MustRegisterForMultiModel("Backups", 3, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return newFacadeV3(ctx)
+	}, reflect.TypeFor[*APIv3]())
+	registry.MustRegisterForMultiModel("Backups", 4, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
 		return newFacade(ctx)
 	}, reflect.TypeFor[*API]())
 }
 
+// newFacadeV3 provides the required signature for version 3 facade
+// registration.
+func newFacadeV3(ctx facade.MultiModelContext) (*APIv3, error) {
+	api, err := newFacade(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &APIv3{API: api}, nil
+}
+
 // newFacade provides the required signature for facade registration.
-func newFacade(ctx facade.ModelContext) (*API, error) {
+func newFacade(ctx facade.MultiModelContext) (*API, error) {
+	domainServices := ctx.DomainServices()
+	services := Services{
+		ControllerConfigService: domainServices.ControllerConfig(),
+		ControllerNodeService:   domainServices.ControllerNode(),
+		ModelService:            domainServices.Model(),
+		ControllerBackupService: domainServices.ControllerBackup(),
+		ModelBackupService: func(c context.Context, modelUUID coremodel.UUID) (BackupService, error) {
+			svc, err := ctx.DomainServicesForModel(c, modelUUID)
+			if err != nil {
+				return nil, err
+			}
+			return svc.Backup(), nil
+		},
+	}
 	return NewAPI(
-		ctx.DomainServices().ControllerConfig(),
+		services,
 		ctx.Auth(),
+		ctx.ModelUUID(),
 		ctx.MachineTag(),
 		ctx.DataDir(),
 		ctx.LogDir(),
@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Remove deletes the requested backup archives from the controller.
+func (a *API) Remove(ctx context.Context, args params.BackupsRemoveArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.IDs)),
+	}
+	for i, id := range args.IDs {
+		if err := corebackups.RemoveArchive(a.paths.BackupDir, id); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	corebackups "github.com/juju/juju/core/backups"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Restore validates that the requested backup archive can be restored into
+// this controller. Validation failures are reported in the result rather
+// than as an error, so that a dry run can describe the backup regardless.
+//
+// Unless this is a dry run, the archive is then staged for restore. The
+// databases and object store can only be replaced while they are not in use,
+// so the controller agent applies the staged restore when it next starts,
+// before it opens the controller database.
+func (a *API) Restore(ctx context.Context, args params.BackupsRestoreArgs) (params.BackupsRestoreResult, error) {
+	result := params.BackupsRestoreResult{
+		ControllerVersion: jujuversion.Current,
+	}
+
+	archive, err := corebackups.OpenArchive(a.paths.BackupDir, args.ID)
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+	result.Metadata = params.CreateResult(archive.Metadata, archive.Filename)
+
+	cfg, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+	err = corebackups.ValidateRestore(archive.Metadata, cfg.ControllerUUID(), jujuversion.Current)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	if args.DryRun {
+		return result, nil
+	}
+
+	// The restore replaces the database of the node it is applied on, so
+	// the other nodes of an HA controller would diverge from it.
+	nodes, err := a.controllerNodeService.GetControllerIDs(ctx)
+	if err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+	if len(nodes) != 1 {
+		return result, apiservererrors.ServerError(errors.NotSupportedf(
+			"restoring a backup into a controller with %d nodes", len(nodes)))
+	}
+
+	if err := corebackups.StageRestore(a.paths.BackupDir, archive.Filename); err != nil {
+		return result, apiservererrors.ServerError(errors.Trace(err))
+	}
+	return result, nil
+}
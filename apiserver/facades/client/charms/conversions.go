@@ -45,6 +45,7 @@ func convertOrigin(origin corecharm.Origin) (params.CharmOrigin, error) {
 		Architecture: origin.Platform.Architecture,
 		Base:         params.Base{Name: base.OS, Channel: base.Channel.String()},
 		InstanceKey:  origin.InstanceKey,
+		Publisher:    origin.Publisher,
 	}, nil
 }
 
@@ -80,5 +81,6 @@ func ConvertParamsOrigin(origin params.CharmOrigin) (corecharm.Origin, error) {
 			Channel:      base.Channel.Track,
 		},
 		InstanceKey: origin.InstanceKey,
+		Publisher:   origin.Publisher,
 	}, nil
 }
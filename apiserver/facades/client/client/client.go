@@ -45,6 +45,15 @@ type Client struct {
 	isControllerModel bool
 }
 
+// ClientV8 serves version 8 of the client API methods, which has no status
+// history query.
+type ClientV8 struct {
+	*Client
+}
+
+// QueryStatusHistory is not available on version 8 of the facade.
+func (c *ClientV8) QueryStatusHistory(_ struct{}) {}
+
 func (c *Client) checkCanRead(ctx context.Context) error {
 	err := c.auth.HasPermission(ctx, permission.SuperuserAccess, c.controllerTag)
 	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
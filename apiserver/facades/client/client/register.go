@@ -18,11 +18,23 @@ import (
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Client", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV8(ctx)
+	}, reflect.TypeFor[*ClientV8]())
+	registry.MustRegister("Client", 9, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacade(ctx)
 	}, reflect.TypeFor[*Client]())
 }
 
 // newFacadeV8 returns a new Client facade (v8).
-func newFacadeV8(ctx facade.ModelContext) (*Client, error) {
+func newFacadeV8(ctx facade.ModelContext) (*ClientV8, error) {
+	client, err := newFacade(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientV8{Client: client}, nil
+}
+
+// newFacade returns a new Client facade.
+func newFacade(ctx facade.ModelContext) (*Client, error) {
 	authorizer := ctx.Auth()
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
@@ -76,6 +76,10 @@ type StatusService interface {
 	// GetStatusHistory returns the status history based on the request.
 	GetStatusHistory(context.Context, statusservice.StatusHistoryRequest) ([]status.DetailedStatus, error)
 
+	// QueryStatusHistory returns the status history of all the entities in
+	// the model matching the query.
+	QueryStatusHistory(context.Context, status.StatusHistoryQuery) ([]status.HistoryEntry, error)
+
 	// GetModelStatus returns the current status of the model.
 	GetModelStatus(context.Context) (status.StatusInfo, error)
 
@@ -1276,3 +1276,42 @@ func (c *MockStatusServiceGetStatusHistoryCall) DoAndReturn(f func(context.Conte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// QueryStatusHistory mocks base method.
+func (m *MockStatusService) QueryStatusHistory(arg0 context.Context, arg1 status.StatusHistoryQuery) ([]status.HistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryStatusHistory", arg0, arg1)
+	ret0, _ := ret[0].([]status.HistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryStatusHistory indicates an expected call of QueryStatusHistory.
+func (mr *MockStatusServiceMockRecorder) QueryStatusHistory(arg0, arg1 any) *MockStatusServiceQueryStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatusHistory", reflect.TypeOf((*MockStatusService)(nil).QueryStatusHistory), arg0, arg1)
+	return &MockStatusServiceQueryStatusHistoryCall{Call: call}
+}
+
+// MockStatusServiceQueryStatusHistoryCall wrap *gomock.Call
+type MockStatusServiceQueryStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceQueryStatusHistoryCall) Return(arg0 []status.HistoryEntry, arg1 error) *MockStatusServiceQueryStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceQueryStatusHistoryCall) Do(f func(context.Context, status.StatusHistoryQuery) ([]status.HistoryEntry, error)) *MockStatusServiceQueryStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceQueryStatusHistoryCall) DoAndReturn(f func(context.Context, status.StatusHistoryQuery) ([]status.HistoryEntry, error)) *MockStatusServiceQueryStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
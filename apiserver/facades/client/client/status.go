@@ -110,6 +110,50 @@ func (c *Client) StatusHistory(ctx context.Context, requests params.StatusHistor
 	}
 }
 
+// QueryStatusHistory returns the status history of all the entities in the
+// model matching the query, oldest first.
+func (c *Client) QueryStatusHistory(ctx context.Context, args params.StatusHistoryQuery) (params.StatusHistoryQueryResult, error) {
+	if err := c.checkCanRead(ctx); err != nil {
+		return params.StatusHistoryQueryResult{}, err
+	}
+
+	query := status.StatusHistoryQuery{
+		Application: args.Application,
+		From:        args.From,
+		To:          args.To,
+		Message:     args.Message,
+		Size:        args.Size,
+	}
+	for _, kind := range args.Kinds {
+		query.Kinds = append(query.Kinds, status.HistoryKind(kind))
+	}
+	for _, s := range args.Statuses {
+		query.Statuses = append(query.Statuses, status.Status(s))
+	}
+
+	history, err := c.statusService.QueryStatusHistory(ctx, query)
+	if err != nil {
+		return params.StatusHistoryQueryResult{}, apiservererrors.ServerError(err)
+	}
+
+	entries := make([]params.StatusHistoryEntry, len(history))
+	for i, entry := range history {
+		entries[i] = params.StatusHistoryEntry{
+			Id: entry.ID,
+			Status: params.DetailedStatus{
+				Status: entry.Status.String(),
+				Info:   entry.Info,
+				Since:  entry.Since,
+				Kind:   entry.Kind.String(),
+				Data:   entry.Data,
+			},
+		}
+	}
+	return params.StatusHistoryQueryResult{
+		Entries: entries,
+	}, nil
+}
+
 func statusHistoryResultsError(err error, amount int) params.StatusHistoryResults {
 	results := make([]params.StatusHistoryResult, amount)
 	for i := range results {
@@ -5,6 +5,7 @@ package client
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -193,6 +194,67 @@ func (s *statusSuite) TestStatusHistoryError(c *tc.C) {
 	}})
 }
 
+func (s *statusSuite) TestQueryStatusHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().QueryStatusHistory(gomock.Any(), status.StatusHistoryQuery{
+		Kinds:       []status.HistoryKind{status.KindUnit},
+		Application: "foo",
+		Statuses:    []status.Status{status.Error},
+		Message:     "hook failed",
+	}).Return([]status.HistoryEntry{{
+		ID: "foo/0",
+		DetailedStatus: status.DetailedStatus{
+			Kind:   status.KindWorkload,
+			Status: status.Error,
+			Info:   "hook failed: install",
+			Since:  &since,
+		},
+	}}, nil)
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	result, err := client.QueryStatusHistory(c.Context(), params.StatusHistoryQuery{
+		Kinds:       []string{"unit"},
+		Application: "foo",
+		Statuses:    []string{"error"},
+		Message:     "hook failed",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.StatusHistoryQueryResult{
+		Entries: []params.StatusHistoryEntry{{
+			Id: "foo/0",
+			Status: params.DetailedStatus{
+				Kind:   "workload",
+				Status: "error",
+				Info:   "hook failed: install",
+				Since:  &since,
+			},
+		}},
+	})
+}
+
+func (s *statusSuite) TestQueryStatusHistoryNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().QueryStatusHistory(gomock.Any(), gomock.Any()).Return(nil, errors.NotValidf("message pattern"))
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	_, err := client.QueryStatusHistory(c.Context(), params.StatusHistoryQuery{
+		Message: "(",
+	})
+	c.Assert(err, tc.Satisfies, params.IsCodeNotValid)
+}
+
 func (s *statusSuite) TestFetchOffers(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -12,8 +12,15 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	corelogger "github.com/juju/juju/core/logger"
+	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/permission"
+	coreunit "github.com/juju/juju/core/unit"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
 	"github.com/juju/juju/rpc/params"
 )
@@ -23,28 +30,178 @@ type ControllerNodeService interface {
 	// GetControllerAPIAddresses returns the list of API addresses for all
 	// controllers.
 	GetAPIAddressesByControllerIDForClients(ctx context.Context) (map[string][]string, error)
+
+	// GetControllerIDs returns the list of controller IDs from the controller
+	// node records.
+	GetControllerIDs(ctx context.Context) ([]string, error)
+}
+
+// ControllerClusterService describes the management of the membership of
+// controller nodes in the Dqlite cluster.
+type ControllerClusterService interface {
+	// GetClusterNodes returns every controller node, ordered by controller
+	// ID, along with the role of its Dqlite node in the cluster.
+	GetClusterNodes(ctx context.Context) ([]controllernode.ClusterNode, error)
+
+	// RemoveControllerNode removes the Dqlite node of the input controller
+	// from the Dqlite cluster, and then removes the controller node.
+	RemoveControllerNode(ctx context.Context, controllerID string) error
+}
+
+// ApplicationService describes the adding of units of the controller
+// application.
+type ApplicationService interface {
+	// AddIAASUnits adds the specified units to the IAAS application, returning
+	// the names of the units and of the machines they were placed on.
+	AddIAASUnits(ctx context.Context, name string, units ...applicationservice.AddIAASUnitArg) ([]coreunit.Name, []coremachine.Name, error)
+}
+
+// SecretBackendService describes the checking of secret backends against the
+// number of controller nodes.
+type SecretBackendService interface {
+	// ValidateControllerNodes returns an error if any secret backend cannot
+	// be used by a controller with the input number of nodes.
+	ValidateControllerNodes(ctx context.Context, numNodes int) error
 }
 
 // HighAvailabilityAPI implements the HighAvailability interface and is the concrete
 // implementation of the api end point.
 type HighAvailabilityAPI struct {
-	controllerTag         names.ControllerTag
-	controllerNodeService ControllerNodeService
-	authorizer            facade.Authorizer
-	logger                corelogger.Logger
+	controllerTag            names.ControllerTag
+	isControllerModel        bool
+	controllerNodeService    ControllerNodeService
+	controllerClusterService ControllerClusterService
+	applicationService       ApplicationService
+	secretBackendService     SecretBackendService
+	authorizer               facade.Authorizer
+	logger                   corelogger.Logger
 }
 
+// HighAvailabilityAPIV3 implements v3 of the high availability facade.
+type HighAvailabilityAPIV3 struct {
+	HighAvailabilityAPI
+}
+
+// RemoveControllerNode is only available on V4 or later.
+func (api *HighAvailabilityAPIV3) RemoveControllerNode(_ struct{}) {}
+
 // HighAvailabilityAPIV2 implements v2 of the high availability facade.
 type HighAvailabilityAPIV2 struct {
-	HighAvailabilityAPI
+	HighAvailabilityAPIV3
 }
 
 // EnableHA adds controller machines as necessary to ensure the
-// controller has the number of machines specified.
+// controller has the number of machines specified. Controllers are added by
+// adding units of the controller application; they can't be removed this way.
 func (api *HighAvailabilityAPI) EnableHA(
 	ctx context.Context, args params.ControllersSpecs,
 ) (params.ControllersChangeResults, error) {
-	return params.ControllersChangeResults{}, apiservererrors.ServerError(errors.NotSupportedf("enable HA"))
+	results := params.ControllersChangeResults{}
+
+	err := api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+	if err != nil {
+		return results, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+
+	if !api.isControllerModel {
+		return results, apiservererrors.ServerError(errors.NotSupportedf("enabling HA outside of the controller model"))
+	}
+	if len(args.Specs) == 0 {
+		return results, nil
+	}
+	if len(args.Specs) > 1 {
+		return results, errors.New("only one controller spec is supported")
+	}
+
+	result, err := api.enableHA(ctx, args.Specs[0])
+	results.Results = []params.ControllersChangeResult{{
+		Result: result,
+		Error:  apiservererrors.ServerError(err),
+	}}
+	return results, nil
+}
+
+func (api *HighAvailabilityAPI) enableHA(
+	ctx context.Context, spec params.ControllersSpec,
+) (params.ControllersChanges, error) {
+	if spec.NumControllers < 0 {
+		return params.ControllersChanges{}, errors.NotValidf("negative number of controllers")
+	}
+	if !constraints.IsEmpty(&spec.Constraints) {
+		return params.ControllersChanges{}, errors.NotSupportedf(
+			"constraints for new controllers, set them on the %q application instead",
+			coreapplication.ControllerApplicationName,
+		)
+	}
+
+	controllerIDs, err := api.controllerNodeService.GetControllerIDs(ctx)
+	if err != nil && !errors.Is(err, controllernodeerrors.EmptyControllerIDs) {
+		return params.ControllersChanges{}, errors.Trace(err)
+	}
+	sort.Strings(controllerIDs)
+
+	numControllers := spec.NumControllers
+	if numControllers == 0 {
+		numControllers = max(3, len(controllerIDs))
+	}
+	switch {
+	case numControllers < len(controllerIDs):
+		return params.ControllersChanges{}, errors.NotValidf(
+			"reducing the number of controllers from %d to %d, use remove-controller-node instead",
+			len(controllerIDs), numControllers,
+		)
+	case numControllers%2 != 1 && numControllers != len(controllerIDs):
+		return params.ControllersChanges{}, errors.NotValidf("even number of controllers %d", numControllers)
+	}
+
+	toAdd := numControllers - len(controllerIDs)
+	if len(spec.Placement) > toAdd {
+		return params.ControllersChanges{}, errors.NotValidf(
+			"%d placement directives for %d new controllers", len(spec.Placement), toAdd,
+		)
+	}
+	units := make([]applicationservice.AddIAASUnitArg, toAdd)
+	for i, directive := range spec.Placement {
+		placement, err := parsePlacement(directive)
+		if err != nil {
+			return params.ControllersChanges{}, errors.Trace(err)
+		}
+		units[i].Placement = placement
+	}
+
+	var machineNames []coremachine.Name
+	if toAdd > 0 {
+		// Backends held by a controller node, such as a file backend not on
+		// shared storage, cannot be used by the new controllers.
+		if err := api.secretBackendService.ValidateControllerNodes(ctx, numControllers); err != nil {
+			return params.ControllersChanges{}, errors.Annotate(err, "adding controllers")
+		}
+		_, machineNames, err = api.applicationService.AddIAASUnits(ctx, coreapplication.ControllerApplicationName, units...)
+		if err != nil {
+			return params.ControllersChanges{}, errors.Annotate(err, "adding controllers")
+		}
+	}
+
+	changes := params.ControllersChanges{
+		Maintained: controllerIDs,
+	}
+	for _, name := range machineNames {
+		changes.Added = append(changes.Added, name.String())
+	}
+	return changes, nil
+}
+
+// parsePlacement parses a placement directive for a new controller. A
+// directive without a scope, such as "zone=us-east-1a", is given the model
+// scope.
+func parsePlacement(directive string) (*instance.Placement, error) {
+	placement, err := instance.ParsePlacement(directive)
+	if errors.Is(err, instance.ErrPlacementScopeMissing) {
+		return &instance.Placement{Scope: instance.ModelScope, Directive: directive}, nil
+	} else if err != nil {
+		return nil, errors.NotValidf("placement directive %q", directive)
+	}
+	return placement, nil
 }
 
 // ControllerDetails is only available on V3 or later.
@@ -69,11 +226,25 @@ func (api *HighAvailabilityAPI) ControllerDetails(
 		return results, apiservererrors.ServerError(errors.Trace(err))
 	}
 
+	// The membership of the Dqlite cluster is informational; a failure to
+	// get it shouldn't prevent clients from finding the API addresses.
+	clusterNodes, err := api.controllerClusterService.GetClusterNodes(ctx)
+	if err != nil {
+		api.logger.Warningf(ctx, "getting Dqlite cluster nodes: %v", err)
+	}
+	nodes := make(map[string]controllernode.ClusterNode, len(clusterNodes))
+	for _, node := range clusterNodes {
+		nodes[node.ControllerID] = node
+	}
+
 	details := make([]params.ControllerDetails, 0, len(controllerAddresses))
 	for id, addresses := range controllerAddresses {
+		node := nodes[id]
 		details = append(details, params.ControllerDetails{
 			ControllerId: id,
 			APIAddresses: addresses,
+			DqliteNodeID: node.DqliteNodeID,
+			DqliteRole:   node.Role.String(),
 		})
 	}
 
@@ -85,3 +256,34 @@ func (api *HighAvailabilityAPI) ControllerDetails(
 
 	return results, nil
 }
+
+// RemoveControllerNode removes the Dqlite node of each of the controller
+// machines from the Dqlite cluster. The node is demoted before it's removed,
+// and a voting node is only removed if a majority of the voters remain. The
+// controller machine itself is not removed.
+func (api *HighAvailabilityAPI) RemoveControllerNode(
+	ctx context.Context, args params.Entities,
+) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Entities)),
+	}
+
+	err := api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+	if err != nil {
+		return results, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+
+	for i, entity := range args.Entities {
+		tag, err := names.ParseMachineTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = api.controllerClusterService.RemoveControllerNode(ctx, tag.Id())
+		if errors.Is(err, controllernodeerrors.NotFound) {
+			err = errors.NotFoundf("controller node %q", tag.Id())
+		}
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
@@ -10,14 +10,24 @@ import (
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/unit"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
 type clientSuite struct {
-	authorizer            *MockAuthorizer
-	controllerNodeService *MockControllerNodeService
+	authorizer               *MockAuthorizer
+	controllerNodeService    *MockControllerNodeService
+	controllerClusterService *MockControllerClusterService
+	applicationService       *MockApplicationService
+	secretBackendService     *MockSecretBackendService
 }
 
 func TestClientSuite(t *stdtesting.T) {
@@ -29,10 +39,7 @@ func (s *clientSuite) TestControllerDetailsPermissionDenied(c *tc.C) {
 
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.LoginAccess, gomock.Any()).Return(errors.New("boom"))
 
-	api := HighAvailabilityAPI{
-		controllerNodeService: s.controllerNodeService,
-		authorizer:            s.authorizer,
-	}
+	api := s.api(c)
 	_, err := api.ControllerDetails(c.Context())
 	c.Assert(err, tc.DeepEquals, &params.Error{Message: "permission denied", Code: "unauthorized access"})
 }
@@ -43,10 +50,7 @@ func (s *clientSuite) TestControllerDetailsEmpty(c *tc.C) {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.LoginAccess, gomock.Any()).Return(nil)
 	s.controllerNodeService.EXPECT().GetAPIAddressesByControllerIDForClients(gomock.Any()).Return(map[string][]string{}, controllernodeerrors.EmptyAPIAddresses)
 
-	api := HighAvailabilityAPI{
-		controllerNodeService: s.controllerNodeService,
-		authorizer:            s.authorizer,
-	}
+	api := s.api(c)
 	results, err := api.ControllerDetails(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(results.Results, tc.HasLen, 0)
@@ -60,11 +64,14 @@ func (s *clientSuite) TestControllerDetails(c *tc.C) {
 		"0": {"10.0.0.1:17070"},
 		"1": {"10.0.0.43:17070", "10.0.0.7:17070"},
 	}, nil)
+	s.controllerClusterService.EXPECT().GetClusterNodes(gomock.Any()).Return([]controllernode.ClusterNode{{
+		ControllerNode: controllernode.ControllerNode{ControllerID: "0", DqliteNodeID: 100},
+		Role:           database.Voter,
+	}, {
+		ControllerNode: controllernode.ControllerNode{ControllerID: "1", DqliteNodeID: 101},
+	}}, nil)
 
-	api := HighAvailabilityAPI{
-		controllerNodeService: s.controllerNodeService,
-		authorizer:            s.authorizer,
-	}
+	api := s.api(c)
 	results, err := api.ControllerDetails(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(results.Results, tc.HasLen, 2)
@@ -72,21 +79,181 @@ func (s *clientSuite) TestControllerDetails(c *tc.C) {
 	c.Check(results.Results, tc.DeepEquals, []params.ControllerDetails{{
 		ControllerId: "0",
 		APIAddresses: []string{"10.0.0.1:17070"},
+		DqliteNodeID: 100,
+		DqliteRole:   "voter",
 	}, {
 		ControllerId: "1",
 		APIAddresses: []string{"10.0.0.43:17070", "10.0.0.7:17070"},
+		DqliteNodeID: 101,
 	}})
 }
 
+func (s *clientSuite) TestEnableHA(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"0"}, nil)
+	s.secretBackendService.EXPECT().ValidateControllerNodes(gomock.Any(), 3).Return(nil)
+	s.applicationService.EXPECT().AddIAASUnits(gomock.Any(), "controller",
+		applicationservice.AddIAASUnitArg{AddUnitArg: applicationservice.AddUnitArg{
+			Placement: &instance.Placement{Scope: instance.MachineScope, Directive: "4"},
+		}},
+		applicationservice.AddIAASUnitArg{AddUnitArg: applicationservice.AddUnitArg{
+			Placement: &instance.Placement{Scope: instance.ModelScope, Directive: "zone=b"},
+		}},
+	).Return([]unit.Name{"controller/1", "controller/2"}, []machine.Name{"4", "5"}, nil)
+
+	results, err := s.api(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{
+			NumControllers: 3,
+			Placement:      []string{"4", "zone=b"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Result, tc.DeepEquals, params.ControllersChanges{
+		Added:      []string{"4", "5"},
+		Maintained: []string{"0"},
+	})
+}
+
+func (s *clientSuite) TestEnableHASecretBackendNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"0"}, nil)
+	s.secretBackendService.EXPECT().ValidateControllerNodes(gomock.Any(), 3).Return(
+		errors.New(`secret backend "myfile": file backend path not on shared storage`))
+
+	results, err := s.api(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 3}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.ErrorMatches, `adding controllers: secret backend "myfile": .*`)
+}
+
+func (s *clientSuite) TestEnableHADefault(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"0", "1", "2"}, nil)
+
+	results, err := s.api(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Result, tc.DeepEquals, params.ControllersChanges{
+		Maintained: []string{"0", "1", "2"},
+	})
+}
+
+func (s *clientSuite) TestEnableHAEven(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"0"}, nil)
+
+	results, err := s.api(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 4}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.ErrorMatches, "even number of controllers 4 not valid")
+}
+
+func (s *clientSuite) TestEnableHAReduce(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"0", "1", "2"}, nil)
+
+	results, err := s.api(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 1}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.ErrorMatches, "reducing the number of controllers from 3 to 1, use remove-controller-node instead not valid")
+}
+
+func (s *clientSuite) TestEnableHAPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(errors.New("boom"))
+
+	_, err := s.api(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 3}},
+	})
+	c.Assert(err, tc.DeepEquals, &params.Error{Message: "permission denied", Code: "unauthorized access"})
+}
+
+func (s *clientSuite) TestEnableHANotControllerModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+
+	api := s.api(c)
+	api.isControllerModel = false
+	_, err := api.EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 3}},
+	})
+	c.Assert(err, tc.ErrorMatches, "enabling HA outside of the controller model not supported")
+}
+
+func (s *clientSuite) TestRemoveControllerNode(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.controllerClusterService.EXPECT().RemoveControllerNode(gomock.Any(), "2").Return(nil)
+	s.controllerClusterService.EXPECT().RemoveControllerNode(gomock.Any(), "0").Return(controllernodeerrors.QuorumLoss)
+	s.controllerClusterService.EXPECT().RemoveControllerNode(gomock.Any(), "7").Return(controllernodeerrors.NotFound)
+
+	results, err := s.api(c).RemoveControllerNode(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "machine-2"},
+			{Tag: "machine-0"},
+			{Tag: "machine-7"},
+			{Tag: "unit-foo-0"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 4)
+	c.Check(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[1].Error, tc.ErrorMatches, "removing controller node would lose Dqlite quorum")
+	c.Check(results.Results[2].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(results.Results[3].Error, tc.ErrorMatches, `"unit-foo-0" is not a valid machine tag`)
+}
+
+func (s *clientSuite) api(c *tc.C) *HighAvailabilityAPI {
+	return &HighAvailabilityAPI{
+		isControllerModel:        true,
+		controllerNodeService:    s.controllerNodeService,
+		controllerClusterService: s.controllerClusterService,
+		applicationService:       s.applicationService,
+		secretBackendService:     s.secretBackendService,
+		authorizer:               s.authorizer,
+		logger:                   loggertesting.WrapCheckLog(c),
+	}
+}
+
 func (s *clientSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.controllerNodeService = NewMockControllerNodeService(ctrl)
+	s.controllerClusterService = NewMockControllerClusterService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.secretBackendService = NewMockSecretBackendService(ctrl)
 	s.authorizer = NewMockAuthorizer(ctrl)
 
 	c.Cleanup(func() {
 		s.authorizer = nil
 		s.controllerNodeService = nil
+		s.controllerClusterService = nil
+		s.applicationService = nil
+		s.secretBackendService = nil
 	})
 
 	return ctrl
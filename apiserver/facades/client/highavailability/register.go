@@ -21,17 +21,31 @@ func Register(registry facade.FacadeRegistry) {
 		return newHighAvailabilityAPIV2(stdCtx, ctx)
 	}, reflect.TypeFor[*HighAvailabilityAPIV2]())
 	registry.MustRegister("HighAvailability", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newHighAvailabilityAPIV3(stdCtx, ctx)
+	}, reflect.TypeFor[*HighAvailabilityAPIV3]())
+	registry.MustRegister("HighAvailability", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newHighAvailabilityAPI(stdCtx, ctx)
 	}, reflect.TypeFor[*HighAvailabilityAPI]())
 }
 
-// newHighAvailabilityAPI creates a new server-side highavailability API end point.
+// newHighAvailabilityAPIV2 creates a new server-side highavailability API
+// end point for version 2.
 func newHighAvailabilityAPIV2(stdCtx context.Context, ctx facade.ModelContext) (*HighAvailabilityAPIV2, error) {
-	v3, err := newHighAvailabilityAPI(stdCtx, ctx)
+	v3, err := newHighAvailabilityAPIV3(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &HighAvailabilityAPIV2{HighAvailabilityAPIV3: *v3}, nil
+}
+
+// newHighAvailabilityAPIV3 creates a new server-side highavailability API
+// end point for version 3.
+func newHighAvailabilityAPIV3(stdCtx context.Context, ctx facade.ModelContext) (*HighAvailabilityAPIV3, error) {
+	api, err := newHighAvailabilityAPI(stdCtx, ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &HighAvailabilityAPIV2{HighAvailabilityAPI: *v3}, nil
+	return &HighAvailabilityAPIV3{HighAvailabilityAPI: *api}, nil
 }
 
 // newHighAvailabilityAPI creates a new server-side highavailability API end point.
@@ -54,9 +68,13 @@ func newHighAvailabilityAPI(stdCtx context.Context, ctx facade.ModelContext) (*H
 	}
 
 	return &HighAvailabilityAPI{
-		controllerTag:         names.NewControllerTag(ctx.ControllerUUID()),
-		controllerNodeService: domainServices.ControllerNode(),
-		authorizer:            authorizer,
-		logger:                ctx.Logger().Child("highavailability"),
+		controllerTag:            names.NewControllerTag(ctx.ControllerUUID()),
+		isControllerModel:        ctx.IsControllerModelScoped(),
+		controllerNodeService:    domainServices.ControllerNode(),
+		controllerClusterService: domainServices.ControllerCluster(),
+		applicationService:       domainServices.Application(),
+		secretBackendService:     domainServices.SecretBackend(),
+		authorizer:               authorizer,
+		logger:                   ctx.Logger().Child("highavailability"),
 	}, nil
 }
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/highavailability (interfaces: ControllerNodeService)
+// Source: github.com/juju/juju/apiserver/facades/client/highavailability (interfaces: ControllerNodeService,ControllerClusterService,ApplicationService,SecretBackendService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package highavailability -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/highavailability ControllerNodeService
+//	mockgen -typed -package highavailability -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/highavailability ControllerNodeService,ControllerClusterService,ApplicationService,SecretBackendService
 //
 
 // Package highavailability is a generated GoMock package.
@@ -13,6 +13,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	machine "github.com/juju/juju/core/machine"
+	unit "github.com/juju/juju/core/unit"
+	service "github.com/juju/juju/domain/application/service"
+	controllernode "github.com/juju/juju/domain/controllernode"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -77,3 +81,271 @@ func (c *MockControllerNodeServiceGetAPIAddressesByControllerIDForClientsCall) D
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetControllerIDs mocks base method.
+func (m *MockControllerNodeService) GetControllerIDs(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetControllerIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetControllerIDs indicates an expected call of GetControllerIDs.
+func (mr *MockControllerNodeServiceMockRecorder) GetControllerIDs(arg0 any) *MockControllerNodeServiceGetControllerIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerIDs", reflect.TypeOf((*MockControllerNodeService)(nil).GetControllerIDs), arg0)
+	return &MockControllerNodeServiceGetControllerIDsCall{Call: call}
+}
+
+// MockControllerNodeServiceGetControllerIDsCall wrap *gomock.Call
+type MockControllerNodeServiceGetControllerIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerNodeServiceGetControllerIDsCall) Return(arg0 []string, arg1 error) *MockControllerNodeServiceGetControllerIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerNodeServiceGetControllerIDsCall) Do(f func(context.Context) ([]string, error)) *MockControllerNodeServiceGetControllerIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerNodeServiceGetControllerIDsCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockControllerNodeServiceGetControllerIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockControllerClusterService is a mock of ControllerClusterService interface.
+type MockControllerClusterService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerClusterServiceMockRecorder
+}
+
+// MockControllerClusterServiceMockRecorder is the mock recorder for MockControllerClusterService.
+type MockControllerClusterServiceMockRecorder struct {
+	mock *MockControllerClusterService
+}
+
+// NewMockControllerClusterService creates a new mock instance.
+func NewMockControllerClusterService(ctrl *gomock.Controller) *MockControllerClusterService {
+	mock := &MockControllerClusterService{ctrl: ctrl}
+	mock.recorder = &MockControllerClusterServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerClusterService) EXPECT() *MockControllerClusterServiceMockRecorder {
+	return m.recorder
+}
+
+// GetClusterNodes mocks base method.
+func (m *MockControllerClusterService) GetClusterNodes(arg0 context.Context) ([]controllernode.ClusterNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterNodes", arg0)
+	ret0, _ := ret[0].([]controllernode.ClusterNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterNodes indicates an expected call of GetClusterNodes.
+func (mr *MockControllerClusterServiceMockRecorder) GetClusterNodes(arg0 any) *MockControllerClusterServiceGetClusterNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterNodes", reflect.TypeOf((*MockControllerClusterService)(nil).GetClusterNodes), arg0)
+	return &MockControllerClusterServiceGetClusterNodesCall{Call: call}
+}
+
+// MockControllerClusterServiceGetClusterNodesCall wrap *gomock.Call
+type MockControllerClusterServiceGetClusterNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerClusterServiceGetClusterNodesCall) Return(arg0 []controllernode.ClusterNode, arg1 error) *MockControllerClusterServiceGetClusterNodesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerClusterServiceGetClusterNodesCall) Do(f func(context.Context) ([]controllernode.ClusterNode, error)) *MockControllerClusterServiceGetClusterNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerClusterServiceGetClusterNodesCall) DoAndReturn(f func(context.Context) ([]controllernode.ClusterNode, error)) *MockControllerClusterServiceGetClusterNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveControllerNode mocks base method.
+func (m *MockControllerClusterService) RemoveControllerNode(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveControllerNode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveControllerNode indicates an expected call of RemoveControllerNode.
+func (mr *MockControllerClusterServiceMockRecorder) RemoveControllerNode(arg0, arg1 any) *MockControllerClusterServiceRemoveControllerNodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveControllerNode", reflect.TypeOf((*MockControllerClusterService)(nil).RemoveControllerNode), arg0, arg1)
+	return &MockControllerClusterServiceRemoveControllerNodeCall{Call: call}
+}
+
+// MockControllerClusterServiceRemoveControllerNodeCall wrap *gomock.Call
+type MockControllerClusterServiceRemoveControllerNodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerClusterServiceRemoveControllerNodeCall) Return(arg0 error) *MockControllerClusterServiceRemoveControllerNodeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerClusterServiceRemoveControllerNodeCall) Do(f func(context.Context, string) error) *MockControllerClusterServiceRemoveControllerNodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerClusterServiceRemoveControllerNodeCall) DoAndReturn(f func(context.Context, string) error) *MockControllerClusterServiceRemoveControllerNodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// AddIAASUnits mocks base method.
+func (m *MockApplicationService) AddIAASUnits(arg0 context.Context, arg1 string, arg2 ...service.AddIAASUnitArg) ([]unit.Name, []machine.Name, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddIAASUnits", varargs...)
+	ret0, _ := ret[0].([]unit.Name)
+	ret1, _ := ret[1].([]machine.Name)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddIAASUnits indicates an expected call of AddIAASUnits.
+func (mr *MockApplicationServiceMockRecorder) AddIAASUnits(arg0, arg1 any, arg2 ...any) *MockApplicationServiceAddIAASUnitsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIAASUnits", reflect.TypeOf((*MockApplicationService)(nil).AddIAASUnits), varargs...)
+	return &MockApplicationServiceAddIAASUnitsCall{Call: call}
+}
+
+// MockApplicationServiceAddIAASUnitsCall wrap *gomock.Call
+type MockApplicationServiceAddIAASUnitsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceAddIAASUnitsCall) Return(arg0 []unit.Name, arg1 []machine.Name, arg2 error) *MockApplicationServiceAddIAASUnitsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceAddIAASUnitsCall) Do(f func(context.Context, string, ...service.AddIAASUnitArg) ([]unit.Name, []machine.Name, error)) *MockApplicationServiceAddIAASUnitsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceAddIAASUnitsCall) DoAndReturn(f func(context.Context, string, ...service.AddIAASUnitArg) ([]unit.Name, []machine.Name, error)) *MockApplicationServiceAddIAASUnitsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSecretBackendService is a mock of SecretBackendService interface.
+type MockSecretBackendService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretBackendServiceMockRecorder
+}
+
+// MockSecretBackendServiceMockRecorder is the mock recorder for MockSecretBackendService.
+type MockSecretBackendServiceMockRecorder struct {
+	mock *MockSecretBackendService
+}
+
+// NewMockSecretBackendService creates a new mock instance.
+func NewMockSecretBackendService(ctrl *gomock.Controller) *MockSecretBackendService {
+	mock := &MockSecretBackendService{ctrl: ctrl}
+	mock.recorder = &MockSecretBackendServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretBackendService) EXPECT() *MockSecretBackendServiceMockRecorder {
+	return m.recorder
+}
+
+// ValidateControllerNodes mocks base method.
+func (m *MockSecretBackendService) ValidateControllerNodes(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateControllerNodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateControllerNodes indicates an expected call of ValidateControllerNodes.
+func (mr *MockSecretBackendServiceMockRecorder) ValidateControllerNodes(arg0, arg1 any) *MockSecretBackendServiceValidateControllerNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateControllerNodes", reflect.TypeOf((*MockSecretBackendService)(nil).ValidateControllerNodes), arg0, arg1)
+	return &MockSecretBackendServiceValidateControllerNodesCall{Call: call}
+}
+
+// MockSecretBackendServiceValidateControllerNodesCall wrap *gomock.Call
+type MockSecretBackendServiceValidateControllerNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendServiceValidateControllerNodesCall) Return(arg0 error) *MockSecretBackendServiceValidateControllerNodesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendServiceValidateControllerNodesCall) Do(f func(context.Context, int) error) *MockSecretBackendServiceValidateControllerNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendServiceValidateControllerNodesCall) DoAndReturn(f func(context.Context, int) error) *MockSecretBackendServiceValidateControllerNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/admission"
 	corebase "github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/instance"
 	corelogger "github.com/juju/juju/core/logger"
@@ -44,6 +45,7 @@ type MachineManagerAPI struct {
 	modelUUID       coremodel.UUID
 	authorizer      Authorizer
 	check           *common.BlockChecker
+	admission       AdmissionChecker
 	controllerStore objectstore.ObjectStore
 	clock           clock.Clock
 
@@ -79,6 +81,7 @@ func NewMachineManagerAPI(
 		controllerStore: controllerStore,
 		authorizer:      auth,
 		check:           common.NewBlockChecker(services.BlockCommandService),
+		admission:       services.AdmissionChecker,
 		clock:           clock,
 		logger:          logger,
 
@@ -112,6 +115,13 @@ func (mm *MachineManagerAPI) AddMachines(ctx context.Context, args params.AddMac
 	}
 
 	for i, p := range args.MachineParams {
+		if err := mm.admission.Admit(ctx, admission.Request{
+			Operation:   admission.AddMachine,
+			Constraints: admission.ConstraintValues(p.Constraints),
+		}); err != nil {
+			results.Machines[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
 		machineName, err := mm.addOneMachine(ctx, p)
 		results.Machines[i].Error = apiservererrors.ServerError(err)
 		if err == nil {
@@ -413,6 +423,14 @@ func (mm *MachineManagerAPI) destroyMachine(ctx context.Context, args params.Ent
 		}
 
 		if dryRun {
+			plan, err := mm.removalService.PlanMachineRemoval(ctx, machineUUID)
+			if err != nil {
+				fail(internalerrors.Errorf("planning machine removal: %w", err))
+				continue
+			}
+			info.Plan = common.RemovalPlan(plan)
+			info.DestroyedStorage = common.StorageEntities(plan.DestroyedStorage)
+			info.DetachedStorage = common.StorageEntities(plan.DetachedStorage)
 			result.Info = &info
 			results[i] = result
 			continue
@@ -17,7 +17,9 @@ import (
 
 	commonmocks "github.com/juju/juju/apiserver/common/mocks"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/admission"
 	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/instance"
 	coremachine "github.com/juju/juju/core/machine"
 	machinetesting "github.com/juju/juju/core/machine/testing"
@@ -30,6 +32,7 @@ import (
 	"github.com/juju/juju/domain/deployment"
 	domainmachine "github.com/juju/juju/domain/machine"
 	machineservice "github.com/juju/juju/domain/machine/service"
+	"github.com/juju/juju/domain/removal"
 	"github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testhelpers"
@@ -48,6 +51,7 @@ type AddMachineManagerSuite struct {
 	machineService      *MockMachineService
 	networkService      *MockNetworkService
 	blockCommandService *MockBlockCommandService
+	admissionChecker    *MockAdmissionChecker
 }
 
 func TestAddMachineManagerSuite(t *testing.T) {
@@ -70,6 +74,8 @@ func (s *AddMachineManagerSuite) setup(c *tc.C) *gomock.Controller {
 	s.blockCommandService = NewMockBlockCommandService(ctrl)
 	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound).AnyTimes()
 
+	s.admissionChecker = NewMockAdmissionChecker(ctrl)
+
 	s.api = NewMachineManagerAPI(
 		s.controllerUUID,
 		s.modelUUID,
@@ -80,6 +86,7 @@ func (s *AddMachineManagerSuite) setup(c *tc.C) *gomock.Controller {
 		loggertesting.WrapCheckLog(c),
 		clock.WallClock,
 		Services{
+			AdmissionChecker:    s.admissionChecker,
 			BlockCommandService: s.blockCommandService,
 			CloudService:        s.cloudService,
 			MachineService:      s.machineService,
@@ -88,6 +95,7 @@ func (s *AddMachineManagerSuite) setup(c *tc.C) *gomock.Controller {
 	)
 
 	c.Cleanup(func() {
+		s.admissionChecker = nil
 		s.blockCommandService = nil
 		s.cloudService = nil
 		s.machineService = nil
@@ -98,9 +106,14 @@ func (s *AddMachineManagerSuite) setup(c *tc.C) *gomock.Controller {
 	return ctrl
 }
 
+func (s *AddMachineManagerSuite) expectAnyAdmitted() {
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
 func (s *AddMachineManagerSuite) TestAddMachines(c *tc.C) {
 	ctrl := s.setup(c)
 	defer ctrl.Finish()
+	s.expectAnyAdmitted()
 
 	apiParams := make([]params.AddMachineParams, 2)
 	for i := range apiParams {
@@ -135,6 +148,7 @@ func (s *AddMachineManagerSuite) TestAddMachines(c *tc.C) {
 func (s *AddMachineManagerSuite) TestAddMachinesContainerPlacement(c *tc.C) {
 	ctrl := s.setup(c)
 	defer ctrl.Finish()
+	s.expectAnyAdmitted()
 
 	apiParams := params.AddMachineParams{
 		Base:      &params.Base{Name: "ubuntu", Channel: "22.04"},
@@ -167,6 +181,7 @@ func (s *AddMachineManagerSuite) TestAddMachinesContainerPlacement(c *tc.C) {
 func (s *AddMachineManagerSuite) TestAddMachinesContainerMembers(c *tc.C) {
 	ctrl := s.setup(c)
 	defer ctrl.Finish()
+	s.expectAnyAdmitted()
 
 	apiParams := params.AddMachineParams{
 		Base:          &params.Base{Name: "ubuntu", Channel: "22.04"},
@@ -199,6 +214,7 @@ func (s *AddMachineManagerSuite) TestAddMachinesContainerMembers(c *tc.C) {
 
 func (s *AddMachineManagerSuite) TestAddMachinesStateError(c *tc.C) {
 	defer s.setup(c).Finish()
+	s.expectAnyAdmitted()
 
 	s.machineService.EXPECT().AddMachine(gomock.Any(), domainmachine.AddMachineArgs{
 		Platform: deployment.Platform{
@@ -220,6 +236,26 @@ func (s *AddMachineManagerSuite) TestAddMachinesStateError(c *tc.C) {
 	})
 }
 
+func (s *AddMachineManagerSuite) TestAddMachinesDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.admissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.AddMachine,
+		Constraints: []string{"mem=4096M"},
+	}).Return(admission.PolicyDenied)
+
+	results, err := s.api.AddMachines(c.Context(), params.AddMachines{
+		MachineParams: []params.AddMachineParams{{
+			Base:        &params.Base{Name: "ubuntu", Channel: "22.04"},
+			Constraints: constraints.MustParse("mem=4G"),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Machines, tc.HasLen, 1)
+	c.Check(results.Machines[0].Machine, tc.Equals, "")
+	c.Check(results.Machines[0].Error, tc.ErrorMatches, `denied by admission policy`)
+}
+
 type DestroyMachineManagerSuite struct {
 	testhelpers.CleanupSuite
 	authorizer     *apiservertesting.FakeAuthorizer
@@ -312,6 +348,11 @@ func (s *DestroyMachineManagerSuite) TestDestroyMachineDryRun(c *tc.C) {
 
 	s.machineService.EXPECT().GetMachineContainers(gomock.Any(), machineUUID).Return(nil, nil)
 	s.expectCalculateDestroyResult(c, ctrl, "0", nil, nil)
+	s.removalService.EXPECT().PlanMachineRemoval(gomock.Any(), machineUUID).Return(removal.Plan{
+		Machines:        []removal.PlannedRemoval{{Name: "0", ForceRequired: "machine has units"}},
+		Units:           []removal.PlannedRemoval{{Name: "foo/0"}, {Name: "foo/1"}, {Name: "foo/2"}},
+		DetachedStorage: []removal.PlannedRemoval{{Name: "data/0"}},
+	}, nil)
 
 	results, err := s.api.DestroyMachineWithParams(c.Context(), params.DestroyMachinesParams{
 		MachineTags: []string{"machine-0"},
@@ -328,6 +369,12 @@ func (s *DestroyMachineManagerSuite) TestDestroyMachineDryRun(c *tc.C) {
 					{Tag: "unit-foo-1"},
 					{Tag: "unit-foo-2"},
 				},
+				DetachedStorage: []params.Entity{{Tag: "storage-data-0"}},
+				Plan: &params.RemovalPlan{
+					Machines:        []params.PlannedRemoval{{Name: "0", ForceRequired: "machine has units"}},
+					Units:           []params.PlannedRemoval{{Name: "foo/0"}, {Name: "foo/1"}, {Name: "foo/2"}},
+					DetachedStorage: []params.PlannedRemoval{{Name: "data/0"}},
+				},
 			},
 		}},
 	})
@@ -343,6 +390,9 @@ func (s *DestroyMachineManagerSuite) TestDestroyMachineWithContainersDryRun(c *t
 	s.machineService.EXPECT().GetMachineContainers(gomock.Any(), machineUUID).Return([]coremachine.Name{"0/lxd/0"}, nil)
 	s.expectCalculateDestroyResult(c, ctrl, "0", nil, nil)
 	s.expectCalculateDestroyResult(c, ctrl, "0/lxd/0", nil, nil)
+	s.removalService.EXPECT().PlanMachineRemoval(gomock.Any(), machineUUID).Return(removal.Plan{
+		Machines: []removal.PlannedRemoval{{Name: "0", ForceRequired: "machine has containers"}, {Name: "0/lxd/0"}},
+	}, nil)
 
 	results, err := s.api.DestroyMachineWithParams(c.Context(), params.DestroyMachinesParams{
 		MachineTags: []string{"machine-0"},
@@ -358,6 +408,9 @@ func (s *DestroyMachineManagerSuite) TestDestroyMachineWithContainersDryRun(c *t
 					{Tag: "unit-foo-1"},
 					{Tag: "unit-foo-2"},
 				},
+				Plan: &params.RemovalPlan{
+					Machines: []params.PlannedRemoval{{Name: "0", ForceRequired: "machine has containers"}, {Name: "0/lxd/0"}},
+				},
 				DestroyedContainers: []params.DestroyMachineResult{{
 					Info: &params.DestroyMachineInfo{
 						MachineId: "0/lxd/0",
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/machinemanager (interfaces: Authorizer,CharmhubClient,ControllerConfigService,MachineService,ApplicationService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService,AgentPasswordService,ControllerNodeService,StatusService,RemovalService)
+// Source: github.com/juju/juju/apiserver/facades/client/machinemanager (interfaces: Authorizer,CharmhubClient,ControllerConfigService,MachineService,ApplicationService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService,AgentPasswordService,ControllerNodeService,StatusService,RemovalService,AdmissionChecker)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package machinemanager -destination package_mock_test.go github.com/juju/juju/apiserver/facades/client/machinemanager Authorizer,CharmhubClient,ControllerConfigService,MachineService,ApplicationService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService,AgentPasswordService,ControllerNodeService,StatusService,RemovalService
+//	mockgen -typed -package machinemanager -destination package_mock_test.go github.com/juju/juju/apiserver/facades/client/machinemanager Authorizer,CharmhubClient,ControllerConfigService,MachineService,ApplicationService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService,AgentPasswordService,ControllerNodeService,StatusService,RemovalService,AdmissionChecker
 //
 
 // Package machinemanager is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	controller "github.com/juju/juju/controller"
+	admission "github.com/juju/juju/core/admission"
 	base "github.com/juju/juju/core/base"
 	instance "github.com/juju/juju/core/instance"
 	machine "github.com/juju/juju/core/machine"
@@ -1363,6 +1364,45 @@ func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
 	return m.recorder
 }
 
+// PlanMachineRemoval mocks base method.
+func (m *MockRemovalService) PlanMachineRemoval(arg0 context.Context, arg1 machine.UUID) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanMachineRemoval", arg0, arg1)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanMachineRemoval indicates an expected call of PlanMachineRemoval.
+func (mr *MockRemovalServiceMockRecorder) PlanMachineRemoval(arg0, arg1 any) *MockRemovalServicePlanMachineRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanMachineRemoval", reflect.TypeOf((*MockRemovalService)(nil).PlanMachineRemoval), arg0, arg1)
+	return &MockRemovalServicePlanMachineRemovalCall{Call: call}
+}
+
+// MockRemovalServicePlanMachineRemovalCall wrap *gomock.Call
+type MockRemovalServicePlanMachineRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServicePlanMachineRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockRemovalServicePlanMachineRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServicePlanMachineRemovalCall) Do(f func(context.Context, machine.UUID) (removal.Plan, error)) *MockRemovalServicePlanMachineRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServicePlanMachineRemovalCall) DoAndReturn(f func(context.Context, machine.UUID) (removal.Plan, error)) *MockRemovalServicePlanMachineRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveMachine mocks base method.
 func (m *MockRemovalService) RemoveMachine(arg0 context.Context, arg1 machine.UUID, arg2 bool, arg3 time.Duration) (removal.UUID, error) {
 	m.ctrl.T.Helper()
@@ -1401,3 +1441,64 @@ func (c *MockRemovalServiceRemoveMachineCall) DoAndReturn(f func(context.Context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockAdmissionChecker is a mock of AdmissionChecker interface.
+type MockAdmissionChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionCheckerMockRecorder
+}
+
+// MockAdmissionCheckerMockRecorder is the mock recorder for MockAdmissionChecker.
+type MockAdmissionCheckerMockRecorder struct {
+	mock *MockAdmissionChecker
+}
+
+// NewMockAdmissionChecker creates a new mock instance.
+func NewMockAdmissionChecker(ctrl *gomock.Controller) *MockAdmissionChecker {
+	mock := &MockAdmissionChecker{ctrl: ctrl}
+	mock.recorder = &MockAdmissionCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionChecker) EXPECT() *MockAdmissionCheckerMockRecorder {
+	return m.recorder
+}
+
+// Admit mocks base method.
+func (m *MockAdmissionChecker) Admit(arg0 context.Context, arg1 admission.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admit", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Admit indicates an expected call of Admit.
+func (mr *MockAdmissionCheckerMockRecorder) Admit(arg0, arg1 any) *MockAdmissionCheckerAdmitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admit", reflect.TypeOf((*MockAdmissionChecker)(nil).Admit), arg0, arg1)
+	return &MockAdmissionCheckerAdmitCall{Call: call}
+}
+
+// MockAdmissionCheckerAdmitCall wrap *gomock.Call
+type MockAdmissionCheckerAdmitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionCheckerAdmitCall) Return(arg0 error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionCheckerAdmitCall) Do(f func(context.Context, admission.Request) error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionCheckerAdmitCall) DoAndReturn(f func(context.Context, admission.Request) error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
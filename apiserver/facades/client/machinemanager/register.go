@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 )
@@ -38,6 +39,11 @@ func makeFacadeV11(stdCtx context.Context, ctx facade.ModelContext) (*MachineMan
 	logger := ctx.Logger().Child("machinemanager")
 
 	services := Services{
+		AdmissionChecker: common.NewAdmissionChecker(
+			domainServices.ControllerConfig(),
+			domainServices.ModelInfo(),
+			ctx.Auth().GetAuthTag().Id(),
+		),
 		AgentBinaryService:      domainServices.AgentBinary(),
 		AgentPasswordService:    domainServices.AgentPassword(),
 		ApplicationService:      domainServices.Application(),
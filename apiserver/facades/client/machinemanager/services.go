@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/admission"
 	"github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/instance"
 	coremachine "github.com/juju/juju/core/machine"
@@ -26,6 +27,7 @@ import (
 )
 
 type Services struct {
+	AdmissionChecker        AdmissionChecker
 	AgentBinaryService      AgentBinaryService
 	AgentPasswordService    AgentPasswordService
 	ApplicationService      ApplicationService
@@ -156,6 +158,13 @@ type NetworkService interface {
 	GetAllSpaces(ctx context.Context) (network.SpaceInfos, error)
 }
 
+// AdmissionChecker defines the admission policy checking functionality
+// required by the machine manager facade. This is implemented by
+// apiserver/common.AdmissionChecker.
+type AdmissionChecker interface {
+	Admit(context.Context, admission.Request) error
+}
+
 // BlockCommandService defines methods for interacting with block commands.
 type BlockCommandService interface {
 	// GetBlockSwitchedOn returns the optional block message if it is switched
@@ -188,4 +197,9 @@ type RemovalService interface {
 		force bool,
 		wait time.Duration,
 	) (removal.UUID, error)
+
+	// PlanMachineRemoval returns the entities that would be removed along
+	// with the machine identified by the input UUID, and which of them would
+	// require force. Nothing is removed.
+	PlanMachineRemoval(ctx context.Context, machineUUID coremachine.UUID) (removal.Plan, error)
 }
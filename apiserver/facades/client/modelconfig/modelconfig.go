@@ -6,6 +6,8 @@ package modelconfig
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo/v2"
@@ -15,6 +17,7 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/admission"
 	"github.com/juju/juju/core/agentbinary"
 	coreerrors "github.com/juju/juju/core/errors"
 	corelogger "github.com/juju/juju/core/logger"
@@ -24,6 +27,7 @@ import (
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	networkerrors "github.com/juju/juju/domain/network/errors"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/environs/config"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
@@ -31,9 +35,10 @@ import (
 
 // ModelConfigAPI provides the base implementation of the methods.
 type ModelConfigAPI struct {
-	auth   facade.Authorizer
-	check  *common.BlockChecker
-	logger corelogger.Logger
+	auth      facade.Authorizer
+	check     *common.BlockChecker
+	admission AdmissionChecker
+	logger    corelogger.Logger
 
 	controllerUUID string
 	modelUUID      coremodel.UUID
@@ -44,7 +49,14 @@ type ModelConfigAPI struct {
 	modelSericve              ModelService
 }
 
-// ModelConfigAPIV3 is currently the latest.
+// ModelConfigAPIV4 provides the ModelConfig v4 facade, which does not
+// support aborting secret backend drains.
+type ModelConfigAPIV4 struct {
+	*ModelConfigAPI
+}
+
+// ModelConfigAPIV3 provides the ModelConfig v3 facade, which does not
+// support model secret backends.
 type ModelConfigAPIV3 struct {
 	*ModelConfigAPI
 }
@@ -56,15 +68,17 @@ func NewModelConfigAPI(
 	modelUUID coremodel.UUID,
 	modelAgentService ModelAgentService,
 	blockCommandService common.BlockCommandService,
+	admissionChecker AdmissionChecker,
 	modelConfigService ModelConfigService,
 	modelSecretBackendService ModelSecretBackendService,
 	modelSericve ModelService,
 	logger corelogger.Logger,
 ) *ModelConfigAPI {
 	return &ModelConfigAPI{
-		auth:   authorizer,
-		check:  common.NewBlockChecker(blockCommandService),
-		logger: logger,
+		auth:      authorizer,
+		check:     common.NewBlockChecker(blockCommandService),
+		admission: admissionChecker,
+		logger:    logger,
 
 		controllerUUID: controllerUUID,
 		modelUUID:      modelUUID,
@@ -189,6 +203,13 @@ func (c *ModelConfigAPI) ModelSet(ctx context.Context, args params.ModelSet) err
 
 	logValidator := LogTracingValidator(isLoggingAdmin)
 
+	if err := c.admission.Admit(ctx, admission.Request{
+		Operation:  admission.SetModelConfig,
+		ConfigKeys: slices.Sorted(maps.Keys(args.Config)),
+	}); err != nil {
+		return errors.Trace(err)
+	}
+
 	if val, has := args.Config[config.AgentStreamKey]; has {
 		agentStreamStr, ok := val.(string)
 		if !ok {
@@ -343,6 +364,12 @@ func (c *ModelConfigAPI) SetModelConstraints(ctx context.Context, args params.Se
 	if err := c.check.ChangeAllowed(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	if err := c.admission.Admit(ctx, admission.Request{
+		Operation:   admission.SetConstraints,
+		Constraints: admission.ConstraintValues(args.Constraints),
+	}); err != nil {
+		return errors.Trace(err)
+	}
 	err := c.modelSericve.SetModelConstraints(ctx, args.Constraints)
 	if errors.Is(err, modelerrors.NotFound) {
 		return apiservererrors.ParamsErrorf(
@@ -420,3 +447,27 @@ func (s *ModelConfigAPI) SetModelSecretBackend(ctx context.Context, arg params.S
 	err := s.modelSecretBackendService.SetModelSecretBackend(ctx, arg.SecretBackendName)
 	return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
 }
+
+// AbortModelSecretBackendDrain isn't implemented in the ModelConfigAPIV3 facade.
+func (s *ModelConfigAPIV3) AbortModelSecretBackendDrain(struct{}) {}
+
+// AbortModelSecretBackendDrain isn't implemented in the ModelConfigAPIV4 facade.
+func (s *ModelConfigAPIV4) AbortModelSecretBackendDrain(struct{}) {}
+
+// AbortModelSecretBackendDrain aborts the drain of the model's secrets to its
+// current secret backend, reverting the model to the backend it used before,
+// returning an error satisfying [authentication.ErrorEntityMissingPermission] if the user does not have write access to the model,
+// returning [params.CodeNotFound] if the model has no secret backend drain,
+// returning [params.CodeSecretBackendForbidden] if the drain has already been aborted.
+func (s *ModelConfigAPI) AbortModelSecretBackendDrain(ctx context.Context) (params.ErrorResult, error) {
+	if err := s.auth.HasPermission(ctx, permission.WriteAccess, names.NewModelTag(s.modelUUID.String())); err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	err := s.modelSecretBackendService.AbortSecretBackendDrain(ctx)
+	if errors.Is(err, secretbackenderrors.DrainNotFound) {
+		return params.ErrorResult{
+			Error: apiservererrors.ParamsErrorf(params.CodeNotFound, "model %q has no secret backend drain", s.modelUUID),
+		}, nil
+	}
+	return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
+}
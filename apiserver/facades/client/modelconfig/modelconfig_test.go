@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/core/admission"
 	coreagentbinary "github.com/juju/juju/core/agentbinary"
 	"github.com/juju/juju/core/constraints"
 	coreerrors "github.com/juju/juju/core/errors"
@@ -38,6 +39,7 @@ type modelconfigSuite struct {
 	mockModelSecretBackendService *MockModelSecretBackendService
 	mockModelService              *MockModelService
 	mockBlockCommandService       *MockBlockCommandService
+	mockAdmissionChecker          *MockAdmissionChecker
 
 	modelUUID      coremodel.UUID
 	controllerUUID string
@@ -64,6 +66,7 @@ func (s *modelconfigSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.mockModelSecretBackendService = NewMockModelSecretBackendService(ctrl)
 	s.mockModelService = NewMockModelService(ctrl)
 	s.mockBlockCommandService = NewMockBlockCommandService(ctrl)
+	s.mockAdmissionChecker = NewMockAdmissionChecker(ctrl)
 	c.Cleanup(func() {
 		s.authorizer = nil
 		s.mockModelAgentService = nil
@@ -71,6 +74,7 @@ func (s *modelconfigSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.mockModelSecretBackendService = nil
 		s.mockModelService = nil
 		s.mockBlockCommandService = nil
+		s.mockAdmissionChecker = nil
 	})
 	return ctrl
 }
@@ -91,6 +95,7 @@ func (s *modelconfigSuite) getAPI(c *tc.C) *ModelConfigAPI {
 		s.modelUUID,
 		s.mockModelAgentService,
 		s.mockBlockCommandService,
+		s.mockAdmissionChecker,
 		s.mockModelConfigService,
 		s.mockModelSecretBackendService,
 		s.mockModelService,
@@ -99,6 +104,10 @@ func (s *modelconfigSuite) getAPI(c *tc.C) *ModelConfigAPI {
 	return api
 }
 
+func (s *modelconfigSuite) expectAnyAdmitted() {
+	s.mockAdmissionChecker.EXPECT().Admit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
 func (s *modelconfigSuite) expectModelReadAccess() {
 	gomock.InOrder(
 		s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, names.NewControllerTag(s.controllerUUID)).
@@ -193,6 +202,7 @@ func (s *modelconfigSuite) TestModelGetReadAccess(c *tc.C) {
 
 func (s *modelconfigSuite) TestModelSetModelAdmin(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 	api := s.getAPI(c)
 
 	s.expectModelWriteAccess()
@@ -223,6 +233,7 @@ func (s *modelconfigSuite) TestModelSetModelAdmin(c *tc.C) {
 // model config and the value is correctly abstracted from config and removed.
 func (s *modelconfigSuite) TestSetModelConfigAgentStream(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 	api := s.getAPI(c)
 
 	s.expectModelWriteAccess()
@@ -253,6 +264,7 @@ func (s *modelconfigSuite) TestSetModelConfigAgentStream(c *tc.C) {
 // resultes in an error of not valid.
 func (s *modelconfigSuite) TestSetModelConfigAgentStreamInvalid(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 	api := s.getAPI(c)
 
 	s.expectModelWriteAccess()
@@ -272,6 +284,28 @@ func (s *modelconfigSuite) TestSetModelConfigAgentStreamInvalid(c *tc.C) {
 	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
 }
 
+func (s *modelconfigSuite) TestModelSetDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.getAPI(c)
+
+	s.expectModelWriteAccess()
+	s.expectModelAdminAccess()
+	s.expectNoControllerAdminAccess()
+	s.expectNoBlocks()
+	s.mockAdmissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:  admission.SetModelConfig,
+		ConfigKeys: []string{"other-key", "some-key"},
+	}).Return(admission.PolicyDenied)
+
+	err := api.ModelSet(c.Context(), params.ModelSet{
+		Config: map[string]any{
+			"some-key":  "value",
+			"other-key": "other value",
+		},
+	})
+	c.Assert(err, tc.ErrorIs, admission.PolicyDenied)
+}
+
 func (s *modelconfigSuite) assertBlocked(c *tc.C, err error, msg string) {
 	c.Assert(params.IsCodeOperationBlocked(err), tc.IsTrue, tc.Commentf("error: %#v", err))
 	c.Assert(errors.Cause(err), tc.DeepEquals, &params.Error{
@@ -436,6 +470,7 @@ func (s *modelconfigSuite) TestModelUnsetMissing(c *tc.C) {
 
 func (s *modelconfigSuite) TestClientSetModelConstraints(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 	api := s.getAPI(c)
 
 	// Set constraints for the model.
@@ -455,6 +490,7 @@ func (s *modelconfigSuite) TestClientSetModelConstraints(c *tc.C) {
 
 func (s *modelconfigSuite) TestClientSetModelConstraintsFailedModelNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 	api := s.getAPI(c)
 
 	cons, err := constraints.Parse("mem=4096", "cores=2")
@@ -473,6 +509,7 @@ func (s *modelconfigSuite) TestClientSetModelConstraintsFailedModelNotFound(c *t
 
 func (s *modelconfigSuite) TestClientSetModelConstraintsFailedSpaceNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 	api := s.getAPI(c)
 
 	cons, err := constraints.Parse("mem=4096", "cores=2")
@@ -491,6 +528,7 @@ func (s *modelconfigSuite) TestClientSetModelConstraintsFailedSpaceNotFound(c *t
 
 func (s *modelconfigSuite) TestClientSetModelConstraintsFailedInvalidContainerType(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectAnyAdmitted()
 	api := s.getAPI(c)
 
 	cons, err := constraints.Parse("mem=4096", "cores=2")
@@ -507,6 +545,27 @@ func (s *modelconfigSuite) TestClientSetModelConstraintsFailedInvalidContainerTy
 	c.Assert(params.ErrCode(err), tc.Equals, params.CodeNotValid)
 }
 
+func (s *modelconfigSuite) TestClientSetModelConstraintsDeniedByAdmissionPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.getAPI(c)
+
+	cons, err := constraints.Parse("mem=4096", "cores=2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectModelWriteAccess()
+	s.expectNoBlocks()
+	s.mockAdmissionChecker.EXPECT().Admit(gomock.Any(), admission.Request{
+		Operation:   admission.SetConstraints,
+		Constraints: []string{"cores=2", "mem=4096M"},
+	}).Return(admission.PolicyDenied)
+
+	err = api.SetModelConstraints(c.Context(), params.SetConstraints{
+		ApplicationName: "app",
+		Constraints:     cons,
+	})
+	c.Assert(err, tc.ErrorIs, admission.PolicyDenied)
+}
+
 func (s *modelconfigSuite) assertSetModelConstraintsBlocked(c *tc.C, msg string) {
 	defer s.setupMocks(c).Finish()
 	api := s.getAPI(c)
@@ -669,3 +728,53 @@ func (s *modelconfigSuite) TestSetModelSecretBackend(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(result.Error, tc.IsNil)
 }
+
+func (s *modelconfigSuite) TestAbortModelSecretBackendDrainFailedPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	facade := s.getAPI(c)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, names.NewModelTag(s.modelUUID.String())).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission),
+	)
+
+	_, err := facade.AbortModelSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+	c.Assert(err, tc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
+func (s *modelconfigSuite) TestAbortModelSecretBackendDrainFailedNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	facade := s.getAPI(c)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, names.NewModelTag(s.modelUUID.String()))
+	s.mockModelSecretBackendService.EXPECT().AbortSecretBackendDrain(gomock.Any()).Return(secretbackenderrors.DrainNotFound)
+
+	result, err := facade.AbortModelSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.ErrorMatches, `model ".*" has no secret backend drain`)
+	c.Assert(result.Error.Code, tc.Equals, params.CodeNotFound)
+}
+
+func (s *modelconfigSuite) TestAbortModelSecretBackendDrainFailedAlreadyReverting(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	facade := s.getAPI(c)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, names.NewModelTag(s.modelUUID.String()))
+	s.mockModelSecretBackendService.EXPECT().AbortSecretBackendDrain(gomock.Any()).Return(secretbackenderrors.Forbidden)
+
+	result, err := facade.AbortModelSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error.Code, tc.Equals, params.CodeSecretBackendForbidden)
+}
+
+func (s *modelconfigSuite) TestAbortModelSecretBackendDrain(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	facade := s.getAPI(c)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, names.NewModelTag(s.modelUUID.String()))
+	s.mockModelSecretBackendService.EXPECT().AbortSecretBackendDrain(gomock.Any())
+
+	result, err := facade.AbortModelSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.IsNil)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 )
@@ -24,6 +25,13 @@ func Register(registry facade.FacadeRegistry) {
 		return facade, nil
 	}, reflect.TypeFor[*ModelConfigAPIV3]())
 	registry.MustRegister("ModelConfig", 4, func(_ context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		facade, err := makeFacadeV4(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("registering model config client facade: %w", err)
+		}
+		return facade, nil
+	}, reflect.TypeFor[*ModelConfigAPIV4]())
+	registry.MustRegister("ModelConfig", 5, func(_ context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		facade, err := makeFacade(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("registering model config client facade: %w", err)
@@ -46,6 +54,11 @@ func makeFacade(ctx facade.ModelContext) (*ModelConfigAPI, error) {
 		ctx.ModelUUID(),
 		domainServices.Agent(),
 		domainServices.BlockCommand(),
+		common.NewAdmissionChecker(
+			domainServices.ControllerConfig(),
+			domainServices.ModelInfo(),
+			auth.GetAuthTag().Id(),
+		),
 		domainServices.Config(),
 		domainServices.ModelSecretBackend(),
 		domainServices.ModelInfo(),
@@ -61,3 +74,12 @@ func makeFacadeV3(ctx facade.ModelContext) (*ModelConfigAPIV3, error) {
 	}
 	return &ModelConfigAPIV3{api}, nil
 }
+
+// makeFacadeV4 is used for API registration.
+func makeFacadeV4(ctx facade.ModelContext) (*ModelConfigAPIV4, error) {
+	api, err := makeFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ModelConfigAPIV4{api}, nil
+}
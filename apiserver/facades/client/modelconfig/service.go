@@ -6,6 +6,7 @@ package modelconfig
 import (
 	"context"
 
+	"github.com/juju/juju/core/admission"
 	"github.com/juju/juju/core/constraints"
 	domainagentbinary "github.com/juju/juju/domain/agentbinary"
 	"github.com/juju/juju/domain/blockcommand"
@@ -59,6 +60,10 @@ type ModelSecretBackendService interface {
 
 	// SetModelSecretBackend sets the secret backend for the model.
 	SetModelSecretBackend(ctx context.Context, backendName string) error
+
+	// AbortSecretBackendDrain aborts the drain of the model's secret content
+	// to its current secret backend.
+	AbortSecretBackendDrain(ctx context.Context) error
 }
 
 // BlockCommandService defines methods for interacting with block commands.
@@ -70,3 +75,10 @@ type BlockCommandService interface {
 	// GetBlocks returns all the blocks that are currently in place.
 	GetBlocks(ctx context.Context) ([]blockcommand.Block, error)
 }
+
+// AdmissionChecker defines the admission policy checking functionality
+// required by the model config facade. This is implemented by
+// apiserver/common.AdmissionChecker.
+type AdmissionChecker interface {
+	Admit(context.Context, admission.Request) error
+}
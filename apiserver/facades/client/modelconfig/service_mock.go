@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/modelconfig (interfaces: BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService)
+// Source: github.com/juju/juju/apiserver/facades/client/modelconfig (interfaces: BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService,AdmissionChecker)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package modelconfig -destination service_mock.go github.com/juju/juju/apiserver/facades/client/modelconfig BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService
+//	mockgen -typed -package modelconfig -destination service_mock.go github.com/juju/juju/apiserver/facades/client/modelconfig BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService,AdmissionChecker
 //
 
 // Package modelconfig is a generated GoMock package.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	admission "github.com/juju/juju/core/admission"
 	constraints "github.com/juju/juju/core/constraints"
 	agentbinary "github.com/juju/juju/domain/agentbinary"
 	blockcommand "github.com/juju/juju/domain/blockcommand"
@@ -310,6 +311,44 @@ func (m *MockModelSecretBackendService) EXPECT() *MockModelSecretBackendServiceM
 	return m.recorder
 }
 
+// AbortSecretBackendDrain mocks base method.
+func (m *MockModelSecretBackendService) AbortSecretBackendDrain(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortSecretBackendDrain", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortSecretBackendDrain indicates an expected call of AbortSecretBackendDrain.
+func (mr *MockModelSecretBackendServiceMockRecorder) AbortSecretBackendDrain(arg0 any) *MockModelSecretBackendServiceAbortSecretBackendDrainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortSecretBackendDrain", reflect.TypeOf((*MockModelSecretBackendService)(nil).AbortSecretBackendDrain), arg0)
+	return &MockModelSecretBackendServiceAbortSecretBackendDrainCall{Call: call}
+}
+
+// MockModelSecretBackendServiceAbortSecretBackendDrainCall wrap *gomock.Call
+type MockModelSecretBackendServiceAbortSecretBackendDrainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelSecretBackendServiceAbortSecretBackendDrainCall) Return(arg0 error) *MockModelSecretBackendServiceAbortSecretBackendDrainCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelSecretBackendServiceAbortSecretBackendDrainCall) Do(f func(context.Context) error) *MockModelSecretBackendServiceAbortSecretBackendDrainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelSecretBackendServiceAbortSecretBackendDrainCall) DoAndReturn(f func(context.Context) error) *MockModelSecretBackendServiceAbortSecretBackendDrainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetModelSecretBackend mocks base method.
 func (m *MockModelSecretBackendService) GetModelSecretBackend(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -486,3 +525,64 @@ func (c *MockModelServiceSetModelConstraintsCall) DoAndReturn(f func(context.Con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockAdmissionChecker is a mock of AdmissionChecker interface.
+type MockAdmissionChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionCheckerMockRecorder
+}
+
+// MockAdmissionCheckerMockRecorder is the mock recorder for MockAdmissionChecker.
+type MockAdmissionCheckerMockRecorder struct {
+	mock *MockAdmissionChecker
+}
+
+// NewMockAdmissionChecker creates a new mock instance.
+func NewMockAdmissionChecker(ctrl *gomock.Controller) *MockAdmissionChecker {
+	mock := &MockAdmissionChecker{ctrl: ctrl}
+	mock.recorder = &MockAdmissionCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionChecker) EXPECT() *MockAdmissionCheckerMockRecorder {
+	return m.recorder
+}
+
+// Admit mocks base method.
+func (m *MockAdmissionChecker) Admit(arg0 context.Context, arg1 admission.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admit", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Admit indicates an expected call of Admit.
+func (mr *MockAdmissionCheckerMockRecorder) Admit(arg0, arg1 any) *MockAdmissionCheckerAdmitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admit", reflect.TypeOf((*MockAdmissionChecker)(nil).Admit), arg0, arg1)
+	return &MockAdmissionCheckerAdmitCall{Call: call}
+}
+
+// MockAdmissionCheckerAdmitCall wrap *gomock.Call
+type MockAdmissionCheckerAdmitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionCheckerAdmitCall) Return(arg0 error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionCheckerAdmitCall) Do(f func(context.Context, admission.Request) error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionCheckerAdmitCall) DoAndReturn(f func(context.Context, admission.Request) error) *MockAdmissionCheckerAdmitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -13,6 +13,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/common"
 	commonmodel "github.com/juju/juju/apiserver/common/model"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
@@ -51,9 +52,17 @@ type ModelStatusAPI interface {
 
 // ModelManagerAPIV10 implements the model manager V10.
 type ModelManagerAPIV10 struct {
+	*ModelManagerAPIV11
+}
+
+// ModelManagerAPIV11 implements the model manager V11.
+type ModelManagerAPIV11 struct {
 	*ModelManagerAPI
 }
 
+// PlanDestroyModels is not available on version 11 of the facade.
+func (*ModelManagerAPIV11) PlanDestroyModels(_ struct{}) {}
+
 // ModelManagerAPI implements the model manager interface and is
 // the concrete implementation of the api end point.
 type ModelManagerAPI struct {
@@ -851,6 +860,58 @@ func (m *ModelManagerAPI) DestroyModels(
 	return results, nil
 }
 
+// PlanDestroyModels returns, for each of the input models, every
+// application, unit, relation, machine, offer and storage instance that would
+// be removed by destroying the model, and which of them would require force.
+// Nothing is removed.
+func (m *ModelManagerAPI) PlanDestroyModels(
+	ctx context.Context, args params.DestroyModelsParams,
+) (params.RemovalPlanResults, error) {
+	results := params.RemovalPlanResults{
+		Results: make([]params.RemovalPlanResult, len(args.Models)),
+	}
+
+	planModel := func(arg params.DestroyModelParams) (*params.RemovalPlan, error) {
+		modelTag, err := names.ParseModelTag(arg.ModelTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !m.isAdmin {
+			if err := m.authorizer.HasPermission(ctx, permission.AdminAccess, modelTag); err != nil {
+				return nil, err
+			}
+		}
+
+		var destroyStorage bool
+		if arg.DestroyStorage != nil {
+			destroyStorage = *arg.DestroyStorage
+		}
+
+		mUUID := coremodel.UUID(modelTag.Id())
+		modelDomainServices, err := m.domainServicesGetter.DomainServicesForModel(ctx, mUUID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		plan, err := modelDomainServices.Removal().PlanModelRemoval(ctx, mUUID, destroyStorage)
+		if errors.Is(err, modelerrors.NotFound) {
+			return nil, errors.NotFoundf("model %q", modelTag.Id())
+		} else if err != nil {
+			return nil, errors.Annotatef(err, "planning removal of model %q", modelTag.Id())
+		}
+		return common.RemovalPlan(plan), nil
+	}
+
+	for i, arg := range args.Models {
+		plan, err := planModel(arg)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Plan = plan
+	}
+	return results, nil
+}
+
 // ModelInfo returns information about the specified models.
 func (m *ModelManagerAPI) ModelInfo(ctx context.Context, args params.Entities) (params.ModelInfoResults, error) {
 	results := params.ModelInfoResults{
@@ -42,6 +42,7 @@ import (
 	domainmodel "github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	"github.com/juju/juju/domain/modeldefaults"
+	"github.com/juju/juju/domain/removal"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	_ "github.com/juju/juju/internal/provider/azure"
@@ -72,6 +73,7 @@ type modelManagerSuite struct {
 	controllerUUID       uuid.UUID
 	modelConfigService   *MockModelConfigService
 	machineService       *MockMachineService
+	removalService       *MockRemovalService
 
 	modelStatusAPI *MockModelStatusAPI
 }
@@ -101,6 +103,7 @@ func (s *modelManagerSuite) setUpMocks(c *tc.C) *gomock.Controller {
 	s.blockCommandService = NewMockBlockCommandService(ctrl)
 	s.machineService = NewMockMachineService(ctrl)
 	s.domainServices = NewMockModelDomainServices(ctrl)
+	s.removalService = NewMockRemovalService(ctrl)
 	s.modelStatusAPI = NewMockModelStatusAPI(ctrl)
 
 	c.Cleanup(func() {
@@ -113,6 +116,7 @@ func (s *modelManagerSuite) setUpMocks(c *tc.C) *gomock.Controller {
 		s.blockCommandService = nil
 		s.machineService = nil
 		s.domainServices = nil
+		s.removalService = nil
 		s.modelStatusAPI = nil
 	})
 
@@ -886,6 +890,57 @@ func (s *modelManagerSuite) TestModelInfoDBDeadTranslated(c *tc.C) {
 	c.Check(results.Results[0].Error.Code, tc.Equals, params.CodeNotFound)
 }
 
+func (s *modelManagerSuite) TestPlanDestroyModels(c *tc.C) {
+	ctrl := s.setUpAPI(c)
+	defer ctrl.Finish()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any(), modelUUID).Return(s.domainServices, nil)
+	s.domainServices.EXPECT().Removal().Return(s.removalService)
+	s.removalService.EXPECT().PlanModelRemoval(gomock.Any(), modelUUID, true).Return(removal.Plan{
+		Applications:     []removal.PlannedRemoval{{Name: "foo"}},
+		Offers:           []removal.PlannedRemoval{{Name: "foo-offer", ForceRequired: "offer has 1 connection(s)"}},
+		DestroyedStorage: []removal.PlannedRemoval{{Name: "data/0"}},
+	}, nil)
+
+	destroyStorage := true
+	results, err := s.api.PlanDestroyModels(c.Context(), params.DestroyModelsParams{
+		Models: []params.DestroyModelParams{{
+			ModelTag:       modelTag.String(),
+			DestroyStorage: &destroyStorage,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.RemovalPlanResults{
+		Results: []params.RemovalPlanResult{{
+			Plan: &params.RemovalPlan{
+				Applications:     []params.PlannedRemoval{{Name: "foo"}},
+				Offers:           []params.PlannedRemoval{{Name: "foo-offer", ForceRequired: "offer has 1 connection(s)"}},
+				DestroyedStorage: []params.PlannedRemoval{{Name: "data/0"}},
+			},
+		}},
+	})
+}
+
+func (s *modelManagerSuite) TestPlanDestroyModelsNotFound(c *tc.C) {
+	ctrl := s.setUpAPI(c)
+	defer ctrl.Finish()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any(), modelUUID).Return(s.domainServices, nil)
+	s.domainServices.EXPECT().Removal().Return(s.removalService)
+	s.removalService.EXPECT().PlanModelRemoval(gomock.Any(), modelUUID, false).Return(removal.Plan{}, modelerrors.NotFound)
+
+	results, err := s.api.PlanDestroyModels(c.Context(), params.DestroyModelsParams{
+		Models: []params.DestroyModelParams{{ModelTag: modelTag.String()}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
 func (s *modelManagerSuite) TestChangeModelCredential(c *tc.C) {
 	defer s.setUpAPI(c).Finish()
 	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound)
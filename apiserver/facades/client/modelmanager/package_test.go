@@ -5,5 +5,5 @@ package modelmanager
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package modelmanager_test -destination common_mock_test.go github.com/juju/juju/apiserver/common BlockCheckerInterface
 //go:generate go run go.uber.org/mock/mockgen -typed -package modelmanager_test -destination domain_mock_test.go github.com/juju/juju/apiserver/common ControllerConfigService,BlockCommandService
-//go:generate go run go.uber.org/mock/mockgen -typed -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService
+//go:generate go run go.uber.org/mock/mockgen -typed -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService,RemovalService
 //go:generate go run go.uber.org/mock/mockgen -typed -package modelmanager_test -destination status_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ModelStatusAPI
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService)
+// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService,RemovalService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService
+//	mockgen -typed -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService,RemovalService
 //
 
 // Package modelmanager_test is a generated GoMock package.
@@ -29,6 +29,7 @@ import (
 	access "github.com/juju/juju/domain/access"
 	model0 "github.com/juju/juju/domain/model"
 	modeldefaults "github.com/juju/juju/domain/modeldefaults"
+	removal "github.com/juju/juju/domain/removal"
 	service "github.com/juju/juju/domain/secretbackend/service"
 	status0 "github.com/juju/juju/domain/status"
 	gomock "go.uber.org/mock/gomock"
@@ -2421,3 +2422,104 @@ func (c *MockStatusServiceGetModelStatusInfoCall) DoAndReturn(f func(context.Con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockRemovalService is a mock of RemovalService interface.
+type MockRemovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovalServiceMockRecorder
+}
+
+// MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
+type MockRemovalServiceMockRecorder struct {
+	mock *MockRemovalService
+}
+
+// NewMockRemovalService creates a new mock instance.
+func NewMockRemovalService(ctrl *gomock.Controller) *MockRemovalService {
+	mock := &MockRemovalService{ctrl: ctrl}
+	mock.recorder = &MockRemovalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
+	return m.recorder
+}
+
+// PlanModelRemoval mocks base method.
+func (m *MockRemovalService) PlanModelRemoval(arg0 context.Context, arg1 model.UUID, arg2 bool) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanModelRemoval", arg0, arg1, arg2)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanModelRemoval indicates an expected call of PlanModelRemoval.
+func (mr *MockRemovalServiceMockRecorder) PlanModelRemoval(arg0, arg1, arg2 any) *MockRemovalServicePlanModelRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanModelRemoval", reflect.TypeOf((*MockRemovalService)(nil).PlanModelRemoval), arg0, arg1, arg2)
+	return &MockRemovalServicePlanModelRemovalCall{Call: call}
+}
+
+// MockRemovalServicePlanModelRemovalCall wrap *gomock.Call
+type MockRemovalServicePlanModelRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServicePlanModelRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockRemovalServicePlanModelRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServicePlanModelRemovalCall) Do(f func(context.Context, model.UUID, bool) (removal.Plan, error)) *MockRemovalServicePlanModelRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServicePlanModelRemovalCall) DoAndReturn(f func(context.Context, model.UUID, bool) (removal.Plan, error)) *MockRemovalServicePlanModelRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveModel mocks base method.
+func (m *MockRemovalService) RemoveModel(arg0 context.Context, arg1 model.UUID, arg2 bool, arg3 time.Duration) (removal.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveModel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(removal.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveModel indicates an expected call of RemoveModel.
+func (mr *MockRemovalServiceMockRecorder) RemoveModel(arg0, arg1, arg2, arg3 any) *MockRemovalServiceRemoveModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveModel", reflect.TypeOf((*MockRemovalService)(nil).RemoveModel), arg0, arg1, arg2, arg3)
+	return &MockRemovalServiceRemoveModelCall{Call: call}
+}
+
+// MockRemovalServiceRemoveModelCall wrap *gomock.Call
+type MockRemovalServiceRemoveModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceRemoveModelCall) Return(arg0 removal.UUID, arg1 error) *MockRemovalServiceRemoveModelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceRemoveModelCall) Do(f func(context.Context, model.UUID, bool, time.Duration) (removal.UUID, error)) *MockRemovalServiceRemoveModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceRemoveModelCall) DoAndReturn(f func(context.Context, model.UUID, bool, time.Duration) (removal.UUID, error)) *MockRemovalServiceRemoveModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -324,6 +324,12 @@ type RemovalService interface {
 	RemoveModel(
 		ctx context.Context, modelUUID coremodel.UUID, force bool, wait time.Duration,
 	) (removal.UUID, error)
+
+	// PlanModelRemoval returns every entity that would be removed along with
+	// the model, and which of them would require force. Nothing is removed.
+	PlanModelRemoval(
+		ctx context.Context, modelUUID coremodel.UUID, destroyStorage bool,
+	) (removal.Plan, error)
 }
 
 // Services holds the services needed by the model manager api.
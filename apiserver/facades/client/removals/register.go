@@ -0,0 +1,38 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("Removals", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return NewAPI(ctx)
+	}, reflect.TypeFor[*API]())
+}
+
+// NewAPI returns a new removals API facade.
+func NewAPI(ctx facade.ModelContext) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	domainServices := ctx.DomainServices()
+	return &API{
+		modelTag:     names.NewModelTag(ctx.ModelUUID().String()),
+		service:      domainServices.Removal(),
+		blockChecker: common.NewBlockChecker(domainServices.BlockCommand()),
+		authorizer:   authorizer,
+	}, nil
+}
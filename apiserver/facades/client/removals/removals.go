@@ -0,0 +1,127 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RemovalService defines the methods that the Removals facade requires from
+// the removal domain service.
+type RemovalService interface {
+	// GetAllJobStatuses returns all removal jobs along with their execution
+	// history.
+	GetAllJobStatuses(ctx context.Context) ([]removal.JobStatus, error)
+	// ForceJob escalates the removal job with the input UUID so that it is
+	// executed with force from its next attempt onwards.
+	ForceJob(ctx context.Context, jobUUID removal.UUID) error
+	// CancelJob deletes the removal job with the input UUID if it has not
+	// yet been attempted.
+	CancelJob(ctx context.Context, jobUUID removal.UUID) error
+}
+
+// BlockChecker defines the block-checking functionality required by the
+// Removals facade.
+type BlockChecker interface {
+	// RemoveAllowed checks if remove block is in place.
+	RemoveAllowed(context.Context) error
+}
+
+// Authorizer defines the methods that the Removals facade requires from the
+// facade authorizer.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// API implements the Removals facade, which allows operators to inspect and
+// intervene in the removal jobs of a model.
+type API struct {
+	modelTag     names.ModelTag
+	service      RemovalService
+	blockChecker BlockChecker
+	authorizer   Authorizer
+}
+
+// ListRemovals returns the removal jobs that are pending for the model.
+func (a *API) ListRemovals(ctx context.Context) (params.RemovalJobResults, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.ReadAccess, a.modelTag); err != nil {
+		return params.RemovalJobResults{}, err
+	}
+
+	jobs, err := a.service.GetAllJobStatuses(ctx)
+	if err != nil {
+		return params.RemovalJobResults{}, apiservererrors.ServerError(err)
+	}
+
+	results := make([]params.RemovalJob, len(jobs))
+	for i, job := range jobs {
+		results[i] = params.RemovalJob{
+			UUID:         job.UUID.String(),
+			Type:         job.RemovalType.String(),
+			EntityUUID:   job.EntityUUID,
+			EntityName:   job.EntityName,
+			Force:        job.Force,
+			CreatedAt:    job.CreatedAt,
+			ScheduledFor: job.ScheduledFor,
+			Attempts:     job.Attempts,
+			LastError:    job.LastError,
+		}
+		if !job.LastAttemptedAt.IsZero() {
+			attemptedAt := job.LastAttemptedAt
+			results[i].LastAttemptedAt = &attemptedAt
+		}
+	}
+	return params.RemovalJobResults{Results: results}, nil
+}
+
+// ForceRemovals escalates the input removal jobs so that they are executed
+// with force from their next attempt onwards.
+func (a *API) ForceRemovals(ctx context.Context, args params.RemovalJobArgs) (params.ErrorResults, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.WriteAccess, a.modelTag); err != nil {
+		return params.ErrorResults{}, err
+	}
+	if err := a.blockChecker.RemoveAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	return a.forEachJob(args, func(jobUUID removal.UUID) error {
+		return a.service.ForceJob(ctx, jobUUID)
+	}), nil
+}
+
+// CancelRemovals deletes the input removal jobs, provided that none of their
+// executions have been attempted. The entities that the jobs were to remove
+// retain their current life.
+func (a *API) CancelRemovals(ctx context.Context, args params.RemovalJobArgs) (params.ErrorResults, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.WriteAccess, a.modelTag); err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	return a.forEachJob(args, func(jobUUID removal.UUID) error {
+		return a.service.CancelJob(ctx, jobUUID)
+	}), nil
+}
+
+func (a *API) forEachJob(args params.RemovalJobArgs, fn func(removal.UUID) error) params.ErrorResults {
+	results := make([]params.ErrorResult, len(args.UUIDs))
+	for i, id := range args.UUIDs {
+		err := fn(removal.UUID(id))
+		if errors.Is(err, removalerrors.RemovalJobNotFound) {
+			err = errors.NotFoundf("removal job %q", id)
+		}
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: results}
+}
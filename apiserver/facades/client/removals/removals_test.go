@@ -0,0 +1,148 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalsSuite struct {
+	api *API
+
+	service      *MockRemovalService
+	blockChecker *MockBlockChecker
+	authorizer   *MockAuthorizer
+}
+
+func TestRemovalsSuite(t *testing.T) {
+	tc.Run(t, &removalsSuite{})
+}
+
+func (s *removalsSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.service = NewMockRemovalService(ctrl)
+	s.blockChecker = NewMockBlockChecker(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+
+	s.api = &API{
+		modelTag:     names.NewModelTag("beef1beef1-0000-0000-000011112222"),
+		service:      s.service,
+		blockChecker: s.blockChecker,
+		authorizer:   s.authorizer,
+	}
+
+	return ctrl
+}
+
+func (s *removalsSuite) TestListRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	attempted := created.Add(time.Hour)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().GetAllJobStatuses(gomock.Any()).Return([]removal.JobStatus{{
+		Job: removal.Job{
+			UUID:         "job-1",
+			RemovalType:  removal.MachineJob,
+			EntityUUID:   "machine-uuid",
+			Force:        true,
+			ScheduledFor: created,
+		},
+		EntityName:      "0",
+		CreatedAt:       created,
+		Attempts:        3,
+		LastAttemptedAt: attempted,
+		LastError:       "instance still running",
+	}, {
+		Job: removal.Job{
+			UUID:         "job-2",
+			RemovalType:  removal.RelationJob,
+			EntityUUID:   "relation-uuid",
+			ScheduledFor: created,
+		},
+		CreatedAt: created,
+	}}, nil)
+
+	result, err := s.api.ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.RemovalJobResults{Results: []params.RemovalJob{{
+		UUID:            "job-1",
+		Type:            "machine",
+		EntityUUID:      "machine-uuid",
+		EntityName:      "0",
+		Force:           true,
+		CreatedAt:       created,
+		ScheduledFor:    created,
+		Attempts:        3,
+		LastAttemptedAt: &attempted,
+		LastError:       "instance still running",
+	}, {
+		UUID:         "job-2",
+		Type:         "relation",
+		EntityUUID:   "relation-uuid",
+		CreatedAt:    created,
+		ScheduledFor: created,
+	}}})
+}
+
+func (s *removalsSuite) TestListRemovalsPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(
+		apiservererrors.ErrPerm)
+
+	_, err := s.api.ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *removalsSuite) TestForceRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(nil)
+	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).Return(nil)
+	s.service.EXPECT().ForceJob(gomock.Any(), removal.UUID("job-1")).Return(nil)
+	s.service.EXPECT().ForceJob(gomock.Any(), removal.UUID("job-2")).Return(removalerrors.RemovalJobNotFound)
+
+	result, err := s.api.ForceRemovals(c.Context(), params.RemovalJobArgs{UUIDs: []string{"job-1", "job-2"}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *removalsSuite) TestForceRemovalsBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(nil)
+	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).Return(apiservererrors.OperationBlockedError("blocked"))
+
+	_, err := s.api.ForceRemovals(c.Context(), params.RemovalJobArgs{UUIDs: []string{"job-1"}})
+	c.Assert(err, tc.Satisfies, params.IsCodeOperationBlocked)
+}
+
+func (s *removalsSuite) TestCancelRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().CancelJob(gomock.Any(), removal.UUID("job-1")).Return(nil)
+	s.service.EXPECT().CancelJob(gomock.Any(), removal.UUID("job-2")).Return(removalerrors.RemovalJobStarted)
+
+	result, err := s.api.CancelRemovals(c.Context(), params.RemovalJobArgs{UUIDs: []string{"job-1", "job-2"}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.ErrorMatches, ".*already started.*")
+}
@@ -0,0 +1,280 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/removals (interfaces: RemovalService,BlockChecker,Authorizer)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package removals -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/removals RemovalService,BlockChecker,Authorizer
+//
+
+// Package removals is a generated GoMock package.
+package removals
+
+import (
+	context "context"
+	reflect "reflect"
+
+	permission "github.com/juju/juju/core/permission"
+	removal "github.com/juju/juju/domain/removal"
+	names "github.com/juju/names/v6"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRemovalService is a mock of RemovalService interface.
+type MockRemovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovalServiceMockRecorder
+}
+
+// MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
+type MockRemovalServiceMockRecorder struct {
+	mock *MockRemovalService
+}
+
+// NewMockRemovalService creates a new mock instance.
+func NewMockRemovalService(ctrl *gomock.Controller) *MockRemovalService {
+	mock := &MockRemovalService{ctrl: ctrl}
+	mock.recorder = &MockRemovalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelJob mocks base method.
+func (m *MockRemovalService) CancelJob(arg0 context.Context, arg1 removal.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockRemovalServiceMockRecorder) CancelJob(arg0, arg1 any) *MockRemovalServiceCancelJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockRemovalService)(nil).CancelJob), arg0, arg1)
+	return &MockRemovalServiceCancelJobCall{Call: call}
+}
+
+// MockRemovalServiceCancelJobCall wrap *gomock.Call
+type MockRemovalServiceCancelJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceCancelJobCall) Return(arg0 error) *MockRemovalServiceCancelJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceCancelJobCall) Do(f func(context.Context, removal.UUID) error) *MockRemovalServiceCancelJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceCancelJobCall) DoAndReturn(f func(context.Context, removal.UUID) error) *MockRemovalServiceCancelJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ForceJob mocks base method.
+func (m *MockRemovalService) ForceJob(arg0 context.Context, arg1 removal.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceJob indicates an expected call of ForceJob.
+func (mr *MockRemovalServiceMockRecorder) ForceJob(arg0, arg1 any) *MockRemovalServiceForceJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceJob", reflect.TypeOf((*MockRemovalService)(nil).ForceJob), arg0, arg1)
+	return &MockRemovalServiceForceJobCall{Call: call}
+}
+
+// MockRemovalServiceForceJobCall wrap *gomock.Call
+type MockRemovalServiceForceJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceForceJobCall) Return(arg0 error) *MockRemovalServiceForceJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceForceJobCall) Do(f func(context.Context, removal.UUID) error) *MockRemovalServiceForceJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceForceJobCall) DoAndReturn(f func(context.Context, removal.UUID) error) *MockRemovalServiceForceJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllJobStatuses mocks base method.
+func (m *MockRemovalService) GetAllJobStatuses(arg0 context.Context) ([]removal.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllJobStatuses", arg0)
+	ret0, _ := ret[0].([]removal.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllJobStatuses indicates an expected call of GetAllJobStatuses.
+func (mr *MockRemovalServiceMockRecorder) GetAllJobStatuses(arg0 any) *MockRemovalServiceGetAllJobStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllJobStatuses", reflect.TypeOf((*MockRemovalService)(nil).GetAllJobStatuses), arg0)
+	return &MockRemovalServiceGetAllJobStatusesCall{Call: call}
+}
+
+// MockRemovalServiceGetAllJobStatusesCall wrap *gomock.Call
+type MockRemovalServiceGetAllJobStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceGetAllJobStatusesCall) Return(arg0 []removal.JobStatus, arg1 error) *MockRemovalServiceGetAllJobStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceGetAllJobStatusesCall) Do(f func(context.Context) ([]removal.JobStatus, error)) *MockRemovalServiceGetAllJobStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceGetAllJobStatusesCall) DoAndReturn(f func(context.Context) ([]removal.JobStatus, error)) *MockRemovalServiceGetAllJobStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockBlockChecker is a mock of BlockChecker interface.
+type MockBlockChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockCheckerMockRecorder
+}
+
+// MockBlockCheckerMockRecorder is the mock recorder for MockBlockChecker.
+type MockBlockCheckerMockRecorder struct {
+	mock *MockBlockChecker
+}
+
+// NewMockBlockChecker creates a new mock instance.
+func NewMockBlockChecker(ctrl *gomock.Controller) *MockBlockChecker {
+	mock := &MockBlockChecker{ctrl: ctrl}
+	mock.recorder = &MockBlockCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockChecker) EXPECT() *MockBlockCheckerMockRecorder {
+	return m.recorder
+}
+
+// RemoveAllowed mocks base method.
+func (m *MockBlockChecker) RemoveAllowed(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllowed", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAllowed indicates an expected call of RemoveAllowed.
+func (mr *MockBlockCheckerMockRecorder) RemoveAllowed(arg0 any) *MockBlockCheckerRemoveAllowedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllowed", reflect.TypeOf((*MockBlockChecker)(nil).RemoveAllowed), arg0)
+	return &MockBlockCheckerRemoveAllowedCall{Call: call}
+}
+
+// MockBlockCheckerRemoveAllowedCall wrap *gomock.Call
+type MockBlockCheckerRemoveAllowedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockCheckerRemoveAllowedCall) Return(arg0 error) *MockBlockCheckerRemoveAllowedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockCheckerRemoveAllowedCall) Do(f func(context.Context) error) *MockBlockCheckerRemoveAllowedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockCheckerRemoveAllowedCall) DoAndReturn(f func(context.Context) error) *MockBlockCheckerRemoveAllowedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAuthorizer is a mock of Authorizer interface.
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer.
+type MockAuthorizerMockRecorder struct {
+	mock *MockAuthorizer
+}
+
+// NewMockAuthorizer creates a new mock instance.
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// HasPermission mocks base method.
+func (m *MockAuthorizer) HasPermission(arg0 context.Context, arg1 permission.Access, arg2 names.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockAuthorizerMockRecorder) HasPermission(arg0, arg1, arg2 any) *MockAuthorizerHasPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockAuthorizer)(nil).HasPermission), arg0, arg1, arg2)
+	return &MockAuthorizerHasPermissionCall{Call: call}
+}
+
+// MockAuthorizerHasPermissionCall wrap *gomock.Call
+type MockAuthorizerHasPermissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthorizerHasPermissionCall) Return(arg0 error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthorizerHasPermissionCall) Do(f func(context.Context, permission.Access, names.Tag) error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthorizerHasPermissionCall) DoAndReturn(f func(context.Context, permission.Access, names.Tag) error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/secretbackends (interfaces: SecretBackendService)
+// Source: github.com/juju/juju/apiserver/facades/client/secretbackends (interfaces: SecretBackendService,EncryptionKeyService,ModelService,SecretService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService
+//	mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService,EncryptionKeyService,ModelService,SecretService
 //
 
 // Package secretbackends is a generated GoMock package.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/juju/juju/core/model"
 	secrets "github.com/juju/juju/core/secrets"
 	service "github.com/juju/juju/domain/secretbackend/service"
 	gomock "go.uber.org/mock/gomock"
@@ -198,3 +199,227 @@ func (c *MockSecretBackendServiceUpdateSecretBackendCall) DoAndReturn(f func(con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockEncryptionKeyService is a mock of EncryptionKeyService interface.
+type MockEncryptionKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncryptionKeyServiceMockRecorder
+}
+
+// MockEncryptionKeyServiceMockRecorder is the mock recorder for MockEncryptionKeyService.
+type MockEncryptionKeyServiceMockRecorder struct {
+	mock *MockEncryptionKeyService
+}
+
+// NewMockEncryptionKeyService creates a new mock instance.
+func NewMockEncryptionKeyService(ctrl *gomock.Controller) *MockEncryptionKeyService {
+	mock := &MockEncryptionKeyService{ctrl: ctrl}
+	mock.recorder = &MockEncryptionKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncryptionKeyService) EXPECT() *MockEncryptionKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// RemoveRetiredSecretEncryptionKeys mocks base method.
+func (m *MockEncryptionKeyService) RemoveRetiredSecretEncryptionKeys(arg0 context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRetiredSecretEncryptionKeys", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRetiredSecretEncryptionKeys indicates an expected call of RemoveRetiredSecretEncryptionKeys.
+func (mr *MockEncryptionKeyServiceMockRecorder) RemoveRetiredSecretEncryptionKeys(arg0 any) *MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRetiredSecretEncryptionKeys", reflect.TypeOf((*MockEncryptionKeyService)(nil).RemoveRetiredSecretEncryptionKeys), arg0)
+	return &MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall{Call: call}
+}
+
+// MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall wrap *gomock.Call
+type MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall) Return(arg0 bool, arg1 error) *MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall) Do(f func(context.Context) (bool, error)) *MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall) DoAndReturn(f func(context.Context) (bool, error)) *MockEncryptionKeyServiceRemoveRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RotateSecretEncryptionKey mocks base method.
+func (m *MockEncryptionKeyService) RotateSecretEncryptionKey(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecretEncryptionKey", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSecretEncryptionKey indicates an expected call of RotateSecretEncryptionKey.
+func (mr *MockEncryptionKeyServiceMockRecorder) RotateSecretEncryptionKey(arg0 any) *MockEncryptionKeyServiceRotateSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecretEncryptionKey", reflect.TypeOf((*MockEncryptionKeyService)(nil).RotateSecretEncryptionKey), arg0)
+	return &MockEncryptionKeyServiceRotateSecretEncryptionKeyCall{Call: call}
+}
+
+// MockEncryptionKeyServiceRotateSecretEncryptionKeyCall wrap *gomock.Call
+type MockEncryptionKeyServiceRotateSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEncryptionKeyServiceRotateSecretEncryptionKeyCall) Return(arg0 string, arg1 error) *MockEncryptionKeyServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEncryptionKeyServiceRotateSecretEncryptionKeyCall) Do(f func(context.Context) (string, error)) *MockEncryptionKeyServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEncryptionKeyServiceRotateSecretEncryptionKeyCall) DoAndReturn(f func(context.Context) (string, error)) *MockEncryptionKeyServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock *MockModelService
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// GetModelUUIDs mocks base method.
+func (m *MockModelService) GetModelUUIDs(arg0 context.Context) ([]model.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelUUIDs", arg0)
+	ret0, _ := ret[0].([]model.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelUUIDs indicates an expected call of GetModelUUIDs.
+func (mr *MockModelServiceMockRecorder) GetModelUUIDs(arg0 any) *MockModelServiceGetModelUUIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelUUIDs", reflect.TypeOf((*MockModelService)(nil).GetModelUUIDs), arg0)
+	return &MockModelServiceGetModelUUIDsCall{Call: call}
+}
+
+// MockModelServiceGetModelUUIDsCall wrap *gomock.Call
+type MockModelServiceGetModelUUIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelServiceGetModelUUIDsCall) Return(arg0 []model.UUID, arg1 error) *MockModelServiceGetModelUUIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelServiceGetModelUUIDsCall) Do(f func(context.Context) ([]model.UUID, error)) *MockModelServiceGetModelUUIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelServiceGetModelUUIDsCall) DoAndReturn(f func(context.Context) ([]model.UUID, error)) *MockModelServiceGetModelUUIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// RewrapSecretDataKey mocks base method.
+func (m *MockSecretService) RewrapSecretDataKey(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewrapSecretDataKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RewrapSecretDataKey indicates an expected call of RewrapSecretDataKey.
+func (mr *MockSecretServiceMockRecorder) RewrapSecretDataKey(arg0 any) *MockSecretServiceRewrapSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewrapSecretDataKey", reflect.TypeOf((*MockSecretService)(nil).RewrapSecretDataKey), arg0)
+	return &MockSecretServiceRewrapSecretDataKeyCall{Call: call}
+}
+
+// MockSecretServiceRewrapSecretDataKeyCall wrap *gomock.Call
+type MockSecretServiceRewrapSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceRewrapSecretDataKeyCall) Return(arg0 error) *MockSecretServiceRewrapSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceRewrapSecretDataKeyCall) Do(f func(context.Context) error) *MockSecretServiceRewrapSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceRewrapSecretDataKeyCall) DoAndReturn(f func(context.Context) error) *MockSecretServiceRewrapSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -9,19 +9,25 @@ import (
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService
+//go:generate go run go.uber.org/mock/mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService,EncryptionKeyService,ModelService,SecretService
 
 func NewTestAPI(
 	authorizer facade.Authorizer,
 	backendService SecretBackendService,
+	keyService EncryptionKeyService,
+	modelService ModelService,
+	secretServiceGetter SecretServiceGetter,
 ) (*SecretBackendsAPI, error) {
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
 
 	return &SecretBackendsAPI{
-		authorizer:     authorizer,
-		controllerUUID: coretesting.ControllerTag.Id(),
-		backendService: backendService,
+		authorizer:          authorizer,
+		controllerUUID:      coretesting.ControllerTag.Id(),
+		backendService:      backendService,
+		keyService:          keyService,
+		modelService:        modelService,
+		secretServiceGetter: secretServiceGetter,
 	}, nil
 }
@@ -9,25 +9,43 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	coremodel "github.com/juju/juju/core/model"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
-	registry.MustRegister("SecretBackends", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+	registry.MustRegisterForMultiModel("SecretBackends", 1, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		api, err := newSecretBackendsAPI(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &SecretBackendsAPIV1{SecretBackendsAPI: api}, nil
+	}, reflect.TypeFor[*SecretBackendsAPIV1]())
+	// v2 adds RotateSecretEncryptionKey.
+	registry.MustRegisterForMultiModel("SecretBackends", 2, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
 		return newSecretBackendsAPI(ctx)
 	}, reflect.TypeFor[*SecretBackendsAPI]())
 }
 
 // newSecretBackendsAPI creates a SecretBackendsAPI.
-func newSecretBackendsAPI(context facade.ModelContext) (*SecretBackendsAPI, error) {
-	if !context.Auth().AuthClient() {
+func newSecretBackendsAPI(ctx facade.MultiModelContext) (*SecretBackendsAPI, error) {
+	if !ctx.Auth().AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
-	domainServices := context.DomainServices()
-	secretBackendService := domainServices.SecretBackend()
+	domainServices := ctx.DomainServices()
+	secretServiceGetter := func(c context.Context, modelUUID coremodel.UUID) (SecretService, error) {
+		svc, err := ctx.DomainServicesForModel(c, modelUUID)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Secret(), nil
+	}
 	return &SecretBackendsAPI{
-		authorizer:     context.Auth(),
-		controllerUUID: context.ControllerUUID(),
-		backendService: secretBackendService,
+		authorizer:          ctx.Auth(),
+		controllerUUID:      ctx.ControllerUUID(),
+		backendService:      domainServices.SecretBackend(),
+		keyService:          domainServices.SecretEncryptionKey(),
+		modelService:        domainServices.Model(),
+		secretServiceGetter: secretServiceGetter,
 	}, nil
 }
@@ -11,6 +11,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secretbackend"
@@ -23,11 +24,23 @@ import (
 
 // SecretBackendsAPI is the server implementation for the SecretBackends facade.
 type SecretBackendsAPI struct {
-	authorizer     facade.Authorizer
-	controllerUUID string
-	backendService SecretBackendService
+	authorizer          facade.Authorizer
+	controllerUUID      string
+	backendService      SecretBackendService
+	keyService          EncryptionKeyService
+	modelService        ModelService
+	secretServiceGetter SecretServiceGetter
 }
 
+// SecretBackendsAPIV1 is the server implementation for the SecretBackends
+// facade v1, which does not support rotating the secret encryption key.
+type SecretBackendsAPIV1 struct {
+	*SecretBackendsAPI
+}
+
+// RotateSecretEncryptionKey is not available on v1.
+func (*SecretBackendsAPIV1) RotateSecretEncryptionKey(_ context.Context, _ struct{}) {}
+
 func (s *SecretBackendsAPI) checkCanAdmin(ctx context.Context) error {
 	return s.authorizer.HasPermission(ctx, permission.SuperuserAccess, names.NewControllerTag(s.controllerUUID))
 }
@@ -118,6 +131,19 @@ func (s *SecretBackendsAPI) ListSecretBackends(ctx context.Context, arg params.L
 				Config:              backend.Config,
 			},
 		}
+		for _, d := range backend.Drains {
+			result.Results[i].Drains = append(result.Results[i].Drains, params.SecretBackendDrain{
+				ModelUUID:         d.ModelUUID.String(),
+				ModelName:         d.ModelName,
+				SourceBackend:     d.SourceBackendName,
+				TargetBackend:     d.TargetBackendName,
+				Status:            string(d.Status),
+				StartedAt:         d.StartedAt,
+				TotalRevisions:    d.TotalRevisions,
+				MovedRevisions:    d.MovedRevisions,
+				VerifiedRevisions: d.VerifiedRevisions,
+			})
+		}
 	}
 	return result, nil
 }
@@ -146,3 +172,58 @@ func (s *SecretBackendsAPI) RemoveSecretBackends(ctx context.Context, args param
 	}
 	return result, nil
 }
+
+// RotateSecretEncryptionKey replaces the controller key-encryption key used to
+// protect secret content stored in the internal backend, and rewraps each
+// model's data key with it. Secret content is not re-encrypted, so the
+// rotation does not interrupt access to secrets. The previous keys are only
+// removed once every model has been rewrapped, and are retained while any
+// model, including one still being imported, may hold a data key wrapped by
+// them. Running the rotation again rewraps any models that failed and removes
+// keys that are no longer referenced.
+func (s *SecretBackendsAPI) RotateSecretEncryptionKey(ctx context.Context) (params.RotateSecretEncryptionKeyResult, error) {
+	var result params.RotateSecretEncryptionKeyResult
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	keyUUID, err := s.keyService.RotateSecretEncryptionKey(ctx)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.KeyUUID = keyUUID
+
+	modelUUIDs, err := s.modelService.GetModelUUIDs(ctx)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	allRewrapped := true
+	result.Models = make([]params.RewrapSecretDataKeyResult, len(modelUUIDs))
+	for i, modelUUID := range modelUUIDs {
+		result.Models[i].ModelTag = names.NewModelTag(modelUUID.String()).String()
+		err := s.rewrapSecretDataKey(ctx, modelUUID)
+		if err != nil {
+			allRewrapped = false
+			result.Models[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	if !allRewrapped {
+		return result, nil
+	}
+
+	removed, err := s.keyService.RemoveRetiredSecretEncryptionKeys(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "removing retired secret encryption keys")
+	}
+	result.RetiredKeysRemoved = removed
+	return result, nil
+}
+
+func (s *SecretBackendsAPI) rewrapSecretDataKey(ctx context.Context, modelUUID coremodel.UUID) error {
+	secretService, err := s.secretServiceGetter(ctx, modelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(secretService.RewrapSecretDataKey(ctx))
+}
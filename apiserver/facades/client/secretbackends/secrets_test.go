@@ -4,6 +4,7 @@
 package secretbackends
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secretbackend"
@@ -29,6 +31,9 @@ type SecretsSuite struct {
 
 	authorizer         *facademocks.MockAuthorizer
 	mockBackendService *MockSecretBackendService
+	mockKeyService     *MockEncryptionKeyService
+	mockModelService   *MockModelService
+	mockSecretServices map[coremodel.UUID]*MockSecretService
 }
 
 func TestSecretsSuite(t *testing.T) {
@@ -41,7 +46,17 @@ func (s *SecretsSuite) setup(c *tc.C) (*SecretBackendsAPI, *gomock.Controller) {
 	s.authorizer = facademocks.NewMockAuthorizer(ctrl)
 	s.authorizer.EXPECT().AuthClient().Return(true)
 	s.mockBackendService = NewMockSecretBackendService(ctrl)
-	api, err := NewTestAPI(s.authorizer, s.mockBackendService)
+	s.mockKeyService = NewMockEncryptionKeyService(ctrl)
+	s.mockModelService = NewMockModelService(ctrl)
+	s.mockSecretServices = make(map[coremodel.UUID]*MockSecretService)
+	secretServiceGetter := func(_ context.Context, modelUUID coremodel.UUID) (SecretService, error) {
+		svc, ok := s.mockSecretServices[modelUUID]
+		if !ok {
+			return nil, errors.NotFoundf("model %q", modelUUID)
+		}
+		return svc, nil
+	}
+	api, err := NewTestAPI(s.authorizer, s.mockBackendService, s.mockKeyService, s.mockModelService, secretServiceGetter)
 	c.Assert(err, tc.ErrorIsNil)
 	return api, ctrl
 }
@@ -186,6 +201,52 @@ func (s *SecretsSuite) assertListSecretBackends(c *tc.C, reveal bool) {
 	})
 }
 
+func (s *SecretsSuite) TestListSecretBackendsWithDrains(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	modelUUID := coremodel.UUID(coretesting.ModelTag.Id())
+	started := time.Now().UTC()
+	s.mockBackendService.EXPECT().BackendSummaryInfo(gomock.Any(), false, "myvault").
+		Return([]*secretbackendservice.SecretBackendInfo{{
+			SecretBackend: secrets.SecretBackend{
+				ID:          "backend-id",
+				Name:        "myvault",
+				BackendType: "vault",
+			},
+			NumSecrets: 3,
+			Status:     "active",
+			Drains: []secretbackend.Drain{{
+				ModelUUID:         modelUUID,
+				ModelName:         "mymodel",
+				SourceBackendID:   coretesting.ControllerTag.Id(),
+				SourceBackendName: "internal",
+				TargetBackendID:   "backend-id",
+				TargetBackendName: "myvault",
+				Status:            secretbackend.DrainStatusDraining,
+				StartedAt:         started,
+				TotalRevisions:    3,
+				MovedRevisions:    2,
+				VerifiedRevisions: 1,
+			}},
+		}}, nil)
+
+	results, err := facade.ListSecretBackends(c.Context(), params.ListSecretBackendsArgs{Names: []string{"myvault"}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Drains, tc.DeepEquals, []params.SecretBackendDrain{{
+		ModelUUID:         modelUUID.String(),
+		ModelName:         "mymodel",
+		SourceBackend:     "internal",
+		TargetBackend:     "myvault",
+		Status:            "draining",
+		StartedAt:         started,
+		TotalRevisions:    3,
+		MovedRevisions:    2,
+		VerifiedRevisions: 1,
+	}})
+}
+
 func (s *SecretsSuite) TestListSecretBackendsPermissionDeniedReveal(c *tc.C) {
 	facade, ctrl := s.setup(c)
 	defer ctrl.Finish()
@@ -335,3 +396,86 @@ func (s *SecretsSuite) TestRemoveSecretBackendsInUse(c *tc.C) {
 			Message: `deleting in use secret backend not supported`}},
 	})
 }
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	modelA := coremodel.UUID(coretesting.ModelTag.Id())
+	modelB := coremodel.UUID("deadbeef-0bad-400d-8000-4b1d0d06f00d")
+	s.mockSecretServices[modelA] = NewMockSecretService(ctrl)
+	s.mockSecretServices[modelB] = NewMockSecretService(ctrl)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockKeyService.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return("key-uuid", nil)
+	s.mockModelService.EXPECT().GetModelUUIDs(gomock.Any()).Return([]coremodel.UUID{modelA, modelB}, nil)
+	s.mockSecretServices[modelA].EXPECT().RewrapSecretDataKey(gomock.Any()).Return(nil)
+	s.mockSecretServices[modelB].EXPECT().RewrapSecretDataKey(gomock.Any()).Return(nil)
+	s.mockKeyService.EXPECT().RemoveRetiredSecretEncryptionKeys(gomock.Any()).Return(true, nil)
+
+	result, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.RotateSecretEncryptionKeyResult{
+		KeyUUID: "key-uuid",
+		Models: []params.RewrapSecretDataKeyResult{
+			{ModelTag: "model-" + modelA.String()},
+			{ModelTag: "model-" + modelB.String()},
+		},
+		RetiredKeysRemoved: true,
+	})
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyRetiredKeysRetained(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	modelA := coremodel.UUID(coretesting.ModelTag.Id())
+	s.mockSecretServices[modelA] = NewMockSecretService(ctrl)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockKeyService.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return("key-uuid", nil)
+	s.mockModelService.EXPECT().GetModelUUIDs(gomock.Any()).Return([]coremodel.UUID{modelA}, nil)
+	s.mockSecretServices[modelA].EXPECT().RewrapSecretDataKey(gomock.Any()).Return(nil)
+	// A model not yet activated, such as one being imported, still
+	// references a retired key.
+	s.mockKeyService.EXPECT().RemoveRetiredSecretEncryptionKeys(gomock.Any()).Return(false, nil)
+
+	result, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.RetiredKeysRemoved, tc.IsFalse)
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyRewrapFailure(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	modelA := coremodel.UUID(coretesting.ModelTag.Id())
+	modelB := coremodel.UUID("deadbeef-0bad-400d-8000-4b1d0d06f00d")
+	s.mockSecretServices[modelA] = NewMockSecretService(ctrl)
+	s.mockSecretServices[modelB] = NewMockSecretService(ctrl)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockKeyService.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return("key-uuid", nil)
+	s.mockModelService.EXPECT().GetModelUUIDs(gomock.Any()).Return([]coremodel.UUID{modelA, modelB}, nil)
+	s.mockSecretServices[modelA].EXPECT().RewrapSecretDataKey(gomock.Any()).Return(errors.New("boom"))
+	s.mockSecretServices[modelB].EXPECT().RewrapSecretDataKey(gomock.Any()).Return(nil)
+
+	result, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.KeyUUID, tc.Equals, "key-uuid")
+	c.Check(result.RetiredKeysRemoved, tc.IsFalse)
+	c.Assert(result.Models, tc.HasLen, 2)
+	c.Check(result.Models[0].Error, tc.ErrorMatches, "boom")
+	c.Check(result.Models[1].Error, tc.IsNil)
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyPermissionDenied(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	_, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
@@ -6,6 +6,7 @@ package secretbackends
 import (
 	"context"
 
+	coremodel "github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 )
@@ -17,3 +18,26 @@ type SecretBackendService interface {
 	DeleteSecretBackend(context.Context, secretbackendservice.DeleteSecretBackendParams) error
 	BackendSummaryInfo(ctx context.Context, reveal bool, names ...string) ([]*secretbackendservice.SecretBackendInfo, error)
 }
+
+// EncryptionKeyService provides access to the controller key-encryption keys
+// which protect secret content stored in the internal backend.
+type EncryptionKeyService interface {
+	RotateSecretEncryptionKey(context.Context) (string, error)
+	RemoveRetiredSecretEncryptionKeys(context.Context) (bool, error)
+}
+
+// ModelService provides access to the models hosted by the controller.
+type ModelService interface {
+	// GetModelUUIDs returns the UUIDs of all active models in the controller.
+	GetModelUUIDs(context.Context) ([]coremodel.UUID, error)
+}
+
+// SecretService provides access to a model's secrets.
+type SecretService interface {
+	// RewrapSecretDataKey wraps the model's secret data key with the active
+	// controller key-encryption key.
+	RewrapSecretDataKey(context.Context) error
+}
+
+// SecretServiceGetter returns the secret service for the input model.
+type SecretServiceGetter func(context.Context, coremodel.UUID) (SecretService, error)
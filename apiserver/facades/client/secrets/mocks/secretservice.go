@@ -121,18 +121,18 @@ func (c *MockSecretServiceDeleteSecretCall) DoAndReturn(f func(context.Context,
 }
 
 // GetSecretContentFromBackend mocks base method.
-func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretValue, error) {
+func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 secret.SecretAccessor) (secrets.SecretValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(secrets.SecretValue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSecretContentFromBackend indicates an expected call of GetSecretContentFromBackend.
-func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2 any) *MockSecretServiceGetSecretContentFromBackendCall {
+func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretContentFromBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2, arg3)
 	return &MockSecretServiceGetSecretContentFromBackendCall{Call: call}
 }
 
@@ -148,13 +148,13 @@ func (c *MockSecretServiceGetSecretContentFromBackendCall) Return(arg0 secrets.S
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int, secret.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -320,6 +320,45 @@ func (c *MockSecretServiceListCharmSecretsCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// ListSecretAccessLog mocks base method.
+func (m *MockSecretService) ListSecretAccessLog(arg0 context.Context, arg1 *secrets.URI, arg2 secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secret.SecretAccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretAccessLog indicates an expected call of ListSecretAccessLog.
+func (mr *MockSecretServiceMockRecorder) ListSecretAccessLog(arg0, arg1, arg2 any) *MockSecretServiceListSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretAccessLog", reflect.TypeOf((*MockSecretService)(nil).ListSecretAccessLog), arg0, arg1, arg2)
+	return &MockSecretServiceListSecretAccessLogCall{Call: call}
+}
+
+// MockSecretServiceListSecretAccessLogCall wrap *gomock.Call
+type MockSecretServiceListSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceListSecretAccessLogCall) Return(arg0 []secret.SecretAccessLogEntry, arg1 error) *MockSecretServiceListSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceListSecretAccessLogCall) Do(f func(context.Context, *secrets.URI, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockSecretServiceListSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceListSecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets.URI, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockSecretServiceListSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecrets mocks base method.
 func (m *MockSecretService) ListSecrets(arg0 context.Context, arg1 *secrets.URI, arg2 *int, arg3 secret.Labels) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -19,6 +19,9 @@ func Register(registry facade.FacadeRegistry) {
 		return newSecretsAPIV1(stdCtx, ctx)
 	}, reflect.TypeFor[*SecretsAPI]())
 	registry.MustRegister("Secrets", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV2(stdCtx, ctx)
+	}, reflect.TypeFor[*SecretsAPIV2]())
+	registry.MustRegister("Secrets", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPI(stdCtx, ctx)
 	}, reflect.TypeFor[*SecretsAPI]())
 }
@@ -28,7 +31,15 @@ func newSecretsAPIV1(stdCtx context.Context, context facade.ModelContext) (*Secr
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &SecretsAPIV1{SecretsAPI: api}, nil
+	return &SecretsAPIV1{SecretsAPIV2: &SecretsAPIV2{SecretsAPI: api}}, nil
+}
+
+func newSecretsAPIV2(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV2, error) {
+	api, err := newSecretsAPI(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV2{SecretsAPI: api}, nil
 }
 
 // newSecretsAPI creates a SecretsAPI.
@@ -35,9 +35,14 @@ type SecretsAPI struct {
 	secretService        SecretService
 }
 
+// SecretsAPIV2 is the backend for the Secrets facade v2.
+type SecretsAPIV2 struct {
+	*SecretsAPI
+}
+
 // SecretsAPIV1 is the backend for the Secrets facade v1.
 type SecretsAPIV1 struct {
-	*SecretsAPI
+	*SecretsAPIV2
 }
 
 func (s *SecretsAPI) checkCanRead(ctx context.Context) error {
@@ -184,7 +189,7 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 			if arg.Filter.Revision != nil {
 				rev = *arg.Filter.Revision
 			}
-			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev)
+			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev, s.userAccessor())
 			valueResult := &params.SecretValueResult{
 				Error: apiservererrors.ServerError(err),
 			}
@@ -202,10 +207,12 @@ func tagFromSubject(access domainsecret.SecretAccessor) (names.Tag, error) {
 	switch kind := access.Kind; kind {
 	case domainsecret.UnitAccessor:
 		return names.NewUnitTag(access.ID), nil
-	case domainsecret.ApplicationAccessor:
+	case domainsecret.ApplicationAccessor, domainsecret.RemoteApplicationAccessor:
 		return names.NewApplicationTag(access.ID), nil
 	case domainsecret.ModelAccessor:
 		return names.NewModelTag(access.ID), nil
+	case domainsecret.UserAccessor:
+		return names.NewUserTag(access.ID), nil
 	default:
 		return nil, errors.NotValidf("subject kind %q", kind)
 	}
@@ -427,6 +434,7 @@ func (s *SecretsAPI) secretsGrantRevoke(ctx context.Context, arg params.GrantRev
 	one := func(appName string) error {
 		if err := op(ctx, uri, domainsecret.SecretAccessParams{
 			Accessor: domainsecret.SecretAccessor{Kind: domainsecret.ModelAccessor, ID: s.modelUUID},
+			User:     s.authTag.Id(),
 			Scope:    domainsecret.SecretAccessScope{Kind: domainsecret.ModelAccessScope, ID: s.modelUUID},
 			Subject:  domainsecret.SecretAccessor{Kind: domainsecret.ApplicationAccessor, ID: appName},
 			Role:     coresecrets.RoleView,
@@ -440,3 +448,67 @@ func (s *SecretsAPI) secretsGrantRevoke(ctx context.Context, arg params.GrantRev
 	}
 	return results, nil
 }
+
+// userAccessor returns the authenticated user as recorded in the secret
+// access log.
+func (s *SecretsAPI) userAccessor() domainsecret.SecretAccessor {
+	return domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: s.authTag.Id()}
+}
+
+// ListSecretAccessLog isn't on the v2 API.
+func (s *SecretsAPIV2) ListSecretAccessLog(_ context.Context, _ struct{}) {}
+
+// ListSecretAccessLog returns the reads of, and grants and revokes of
+// access to, the specified secrets.
+func (s *SecretsAPI) ListSecretAccessLog(ctx context.Context, args params.SecretAccessLogArgs) (params.SecretAccessLogResults, error) {
+	result := params.SecretAccessLogResults{
+		Results: make([]params.SecretAccessLogResult, len(args.Args)),
+	}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		entries, err := s.secretAccessLog(ctx, arg)
+		result.Results[i] = params.SecretAccessLogResult{
+			Entries: entries,
+			Error:   apiservererrors.ServerError(err),
+		}
+	}
+	return result, nil
+}
+
+func (s *SecretsAPI) secretAccessLog(ctx context.Context, arg params.SecretAccessLogArg) ([]params.SecretAccessLogEntry, error) {
+	uri, err := s.secretURI(ctx, arg.URI, arg.Label)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	entries, err := s.secretService.ListSecretAccessLog(ctx, uri, domainsecret.SecretAccessLogFilter{
+		Since: arg.Since,
+		Limit: arg.Limit,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]params.SecretAccessLogEntry, len(entries))
+	for i, entry := range entries {
+		accessorTag, err := tagFromSubject(entry.Accessor)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[i] = params.SecretAccessLogEntry{
+			Action:      entry.Action.String(),
+			Revision:    entry.Revision,
+			AccessorTag: accessorTag.String(),
+			BackendID:   entry.BackendID,
+			Time:        entry.Time,
+		}
+		if entry.Subject != nil {
+			subjectTag, err := tagFromSubject(*entry.Subject)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			result[i].SubjectTag = subjectTag.String()
+		}
+	}
+	return result, nil
+}
@@ -249,7 +249,9 @@ func (s *SecretsSuite) assertListSecrets(c *tc.C, reveal bool) {
 		valueResult = &params.SecretValueResult{
 			Data: map[string]string{"foo": "bar"},
 		}
-		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2).Return(
+		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2, secret.SecretAccessor{
+			Kind: secret.UserAccessor, ID: "foo",
+		}).Return(
 			coresecrets.NewSecretValue(valueResult.Data), nil,
 		)
 	}
@@ -582,6 +584,7 @@ func (s *SecretsSuite) TestGrantSecret(c *tc.C) {
 			c.Assert(params.Subject, tc.DeepEquals,
 				secret.SecretAccessor{Kind: secret.ApplicationAccessor, ID: "gitlab"})
 			c.Assert(params.Role, tc.Equals, coresecrets.RoleView)
+			c.Assert(params.User, tc.Equals, "foo")
 			return nil
 		},
 	)
@@ -593,6 +596,7 @@ func (s *SecretsSuite) TestGrantSecret(c *tc.C) {
 			c.Assert(params.Subject, tc.DeepEquals,
 				secret.SecretAccessor{Kind: secret.ApplicationAccessor, ID: "mysql"})
 			c.Assert(params.Role, tc.Equals, coresecrets.RoleView)
+			c.Assert(params.User, tc.Equals, "foo")
 			return nil
 		},
 	)
@@ -626,6 +630,7 @@ func (s *SecretsSuite) TestGrantSecretByName(c *tc.C) {
 			c.Assert(params.Subject, tc.DeepEquals,
 				secret.SecretAccessor{Kind: secret.ApplicationAccessor, ID: "gitlab"})
 			c.Assert(params.Role, tc.Equals, coresecrets.RoleView)
+			c.Assert(params.User, tc.Equals, "foo")
 			return nil
 		},
 	)
@@ -637,6 +642,7 @@ func (s *SecretsSuite) TestGrantSecretByName(c *tc.C) {
 			c.Assert(params.Subject, tc.DeepEquals,
 				secret.SecretAccessor{Kind: secret.ApplicationAccessor, ID: "mysql"})
 			c.Assert(params.Role, tc.Equals, coresecrets.RoleView)
+			c.Assert(params.User, tc.Equals, "foo")
 			return nil
 		},
 	)
@@ -684,6 +690,7 @@ func (s *SecretsSuite) TestRevokeSecret(c *tc.C) {
 			c.Assert(params.Subject, tc.DeepEquals,
 				secret.SecretAccessor{Kind: secret.ApplicationAccessor, ID: "gitlab"})
 			c.Assert(params.Role, tc.Equals, coresecrets.RoleView)
+			c.Assert(params.User, tc.Equals, "foo")
 			return nil
 		},
 	)
@@ -695,6 +702,7 @@ func (s *SecretsSuite) TestRevokeSecret(c *tc.C) {
 			c.Assert(params.Subject, tc.DeepEquals,
 				secret.SecretAccessor{Kind: secret.ApplicationAccessor, ID: "mysql"})
 			c.Assert(params.Role, tc.Equals, coresecrets.RoleView)
+			c.Assert(params.User, tc.Equals, "foo")
 			return nil
 		},
 	)
@@ -726,3 +734,82 @@ func (s *SecretsSuite) TestRevokeSecretPermissionDenied(c *tc.C) {
 	_, err = facade.RevokeSecret(c.Context(), params.GrantRevokeUserSecretArg{Label: "my-secret"})
 	c.Assert(err, tc.ErrorMatches, "permission denied")
 }
+
+func (s *SecretsSuite) TestListSecretAccessLog(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	now := time.Now()
+	since := now.Add(-time.Hour)
+	s.secretService.EXPECT().ListSecretAccessLog(gomock.Any(), uri, secret.SecretAccessLogFilter{
+		Since: &since,
+		Limit: 10,
+	}).Return([]secret.SecretAccessLogEntry{{
+		Action:   secret.SecretAccessGrant,
+		Accessor: secret.SecretAccessor{Kind: secret.UserAccessor, ID: "fred"},
+		Subject:  &secret.SecretAccessor{Kind: secret.ApplicationAccessor, ID: "gitlab"},
+		Time:     now,
+	}, {
+		Action:    secret.SecretAccessRead,
+		Revision:  new(2),
+		Accessor:  secret.SecretAccessor{Kind: secret.UnitAccessor, ID: "gitlab/0"},
+		BackendID: "backend-id",
+		Time:      now,
+	}, {
+		Action:   secret.SecretAccessRead,
+		Revision: new(2),
+		Accessor: secret.SecretAccessor{Kind: secret.RemoteApplicationAccessor, ID: "remote-mattermost"},
+		Time:     now,
+	}}, nil)
+	s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), "missing").Return(nil, secreterrors.SecretNotFound)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	results, err := facade.ListSecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{URI: uri.String(), Since: &since, Limit: 10}, {Label: "missing"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results[0], tc.DeepEquals, params.SecretAccessLogResult{
+		Entries: []params.SecretAccessLogEntry{{
+			Action:      "grant",
+			AccessorTag: "user-fred",
+			SubjectTag:  "application-gitlab",
+			Time:        now,
+		}, {
+			Action:      "read",
+			Revision:    new(2),
+			AccessorTag: "unit-gitlab-0",
+			BackendID:   "backend-id",
+			Time:        now,
+		}, {
+			Action:      "read",
+			Revision:    new(2),
+			AccessorTag: "application-remote-mattermost",
+			Time:        now,
+		}},
+	})
+	c.Check(results.Results[1].Error, tc.ErrorMatches, `getting user secret for label "missing": secret not found`)
+}
+
+func (s *SecretsSuite) TestListSecretAccessLogPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.ListSecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{Label: "my-secret"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
@@ -23,7 +23,7 @@ type SecretService interface {
 	// View and fetch secrets.
 
 	GetUserSecretURIByLabel(ctx context.Context, label string) (*secrets.URI, error)
-	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error)
+	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) (secrets.SecretValue, error)
 	ListSecrets(ctx context.Context, uri *secrets.URI,
 		revision *int,
 		labels domainsecret.Labels,
@@ -39,6 +39,10 @@ type SecretService interface {
 	GetSecretGrants(ctx context.Context, uri *secrets.URI, role secrets.SecretRole) ([]secretservice.SecretAccess, error)
 	GrantSecretAccess(ctx context.Context, uri *secrets.URI, p domainsecret.SecretAccessParams) error
 	RevokeSecretAccess(ctx context.Context, uri *secrets.URI, p domainsecret.SecretAccessParams) error
+
+	// Audit secret access.
+
+	ListSecretAccessLog(ctx context.Context, uri *secrets.URI, filter domainsecret.SecretAccessLogFilter) ([]domainsecret.SecretAccessLogEntry, error)
 }
 
 // SecretBackendService provides access to the secret backend service,
@@ -782,6 +782,45 @@ func (c *MockStorageServiceCreateStoragePoolCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// CreateStorageSnapshot mocks base method.
+func (m *MockStorageService) CreateStorageSnapshot(arg0 context.Context, arg1 storage0.StorageInstanceUUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageSnapshot indicates an expected call of CreateStorageSnapshot.
+func (mr *MockStorageServiceMockRecorder) CreateStorageSnapshot(arg0, arg1 any) *MockStorageServiceCreateStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageSnapshot", reflect.TypeOf((*MockStorageService)(nil).CreateStorageSnapshot), arg0, arg1)
+	return &MockStorageServiceCreateStorageSnapshotCall{Call: call}
+}
+
+// MockStorageServiceCreateStorageSnapshotCall wrap *gomock.Call
+type MockStorageServiceCreateStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceCreateStorageSnapshotCall) Return(arg0 string, arg1 error) *MockStorageServiceCreateStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceCreateStorageSnapshotCall) Do(f func(context.Context, storage0.StorageInstanceUUID) (string, error)) *MockStorageServiceCreateStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceCreateStorageSnapshotCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID) (string, error)) *MockStorageServiceCreateStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetFilesystemsByMachines mocks base method.
 func (m *MockStorageService) GetFilesystemsByMachines(arg0 context.Context, arg1 []machine.UUID) ([]storage0.FilesystemUUID, error) {
 	m.ctrl.T.Helper()
@@ -1016,6 +1055,84 @@ func (c *MockStorageServiceGetStoragePoolUUIDCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// GetStorageSnapshotUUIDForID mocks base method.
+func (m *MockStorageService) GetStorageSnapshotUUIDForID(arg0 context.Context, arg1 string) (storage0.StorageSnapshotUUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotUUIDForID", arg0, arg1)
+	ret0, _ := ret[0].(storage0.StorageSnapshotUUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotUUIDForID indicates an expected call of GetStorageSnapshotUUIDForID.
+func (mr *MockStorageServiceMockRecorder) GetStorageSnapshotUUIDForID(arg0, arg1 any) *MockStorageServiceGetStorageSnapshotUUIDForIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotUUIDForID", reflect.TypeOf((*MockStorageService)(nil).GetStorageSnapshotUUIDForID), arg0, arg1)
+	return &MockStorageServiceGetStorageSnapshotUUIDForIDCall{Call: call}
+}
+
+// MockStorageServiceGetStorageSnapshotUUIDForIDCall wrap *gomock.Call
+type MockStorageServiceGetStorageSnapshotUUIDForIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceGetStorageSnapshotUUIDForIDCall) Return(arg0 storage0.StorageSnapshotUUID, arg1 error) *MockStorageServiceGetStorageSnapshotUUIDForIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceGetStorageSnapshotUUIDForIDCall) Do(f func(context.Context, string) (storage0.StorageSnapshotUUID, error)) *MockStorageServiceGetStorageSnapshotUUIDForIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceGetStorageSnapshotUUIDForIDCall) DoAndReturn(f func(context.Context, string) (storage0.StorageSnapshotUUID, error)) *MockStorageServiceGetStorageSnapshotUUIDForIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageSnapshots mocks base method.
+func (m *MockStorageService) GetStorageSnapshots(arg0 context.Context) ([]storage0.StorageSnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshots", arg0)
+	ret0, _ := ret[0].([]storage0.StorageSnapshotInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshots indicates an expected call of GetStorageSnapshots.
+func (mr *MockStorageServiceMockRecorder) GetStorageSnapshots(arg0 any) *MockStorageServiceGetStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshots", reflect.TypeOf((*MockStorageService)(nil).GetStorageSnapshots), arg0)
+	return &MockStorageServiceGetStorageSnapshotsCall{Call: call}
+}
+
+// MockStorageServiceGetStorageSnapshotsCall wrap *gomock.Call
+type MockStorageServiceGetStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceGetStorageSnapshotsCall) Return(arg0 []storage0.StorageSnapshotInfo, arg1 error) *MockStorageServiceGetStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceGetStorageSnapshotsCall) Do(f func(context.Context) ([]storage0.StorageSnapshotInfo, error)) *MockStorageServiceGetStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceGetStorageSnapshotsCall) DoAndReturn(f func(context.Context) ([]storage0.StorageSnapshotInfo, error)) *MockStorageServiceGetStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetVolumesByMachines mocks base method.
 func (m *MockStorageService) GetVolumesByMachines(arg0 context.Context, arg1 []machine.UUID) ([]storage0.VolumeUUID, error) {
 	m.ctrl.T.Helper()
@@ -1210,3 +1327,41 @@ func (c *MockStorageServiceListStoragePoolsByProvidersCall) DoAndReturn(f func(c
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ResizeStorageInstance mocks base method.
+func (m *MockStorageService) ResizeStorageInstance(arg0 context.Context, arg1 storage0.StorageInstanceUUID, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeStorageInstance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeStorageInstance indicates an expected call of ResizeStorageInstance.
+func (mr *MockStorageServiceMockRecorder) ResizeStorageInstance(arg0, arg1, arg2 any) *MockStorageServiceResizeStorageInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeStorageInstance", reflect.TypeOf((*MockStorageService)(nil).ResizeStorageInstance), arg0, arg1, arg2)
+	return &MockStorageServiceResizeStorageInstanceCall{Call: call}
+}
+
+// MockStorageServiceResizeStorageInstanceCall wrap *gomock.Call
+type MockStorageServiceResizeStorageInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceResizeStorageInstanceCall) Return(arg0 error) *MockStorageServiceResizeStorageInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceResizeStorageInstanceCall) Do(f func(context.Context, storage0.StorageInstanceUUID, uint64) error) *MockStorageServiceResizeStorageInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceResizeStorageInstanceCall) DoAndReturn(f func(context.Context, storage0.StorageInstanceUUID, uint64) error) *MockStorageServiceResizeStorageInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -187,7 +187,7 @@ func TestImportV6Suite(t *testing.T) {
 }
 
 func (s *importV6Suite) makeTestAPIV6ForIAASModel(c *tc.C) *StorageAPIv6 {
-	return &StorageAPIv6{s.makeTestAPIForIAASModel(c)}
+	return &StorageAPIv6{&StorageAPIv7{&StorageAPIv8{s.makeTestAPIForIAASModel(c)}}}
 }
 
 func (s *importV6Suite) TestImport(c *tc.C) {
@@ -20,12 +20,20 @@ func Register(registry facade.FacadeRegistry) {
 	}, reflect.TypeFor[*StorageAPIv6]())
 
 	registry.MustRegister("Storage", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newStorageAPI(stdCtx, ctx) // support force option on import-fileystem.
+		return newStorageAPIV7(stdCtx, ctx) // support force option on import-fileystem.
+	}, reflect.TypeFor[*StorageAPIv7]())
+
+	registry.MustRegister("Storage", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newStorageAPIV8(stdCtx, ctx) // add ResizeStorage.
+	}, reflect.TypeFor[*StorageAPIv8]())
+
+	registry.MustRegister("Storage", 9, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newStorageAPI(stdCtx, ctx) // add CreateStorageSnapshots, ListStorageSnapshots and restore from snapshot.
 	}, reflect.TypeFor[*StorageAPI]())
 }
 
 func newStorageAPIV6(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIv6, error) {
-	storageAPI, err := newStorageAPI(stdCtx, ctx)
+	storageAPI, err := newStorageAPIV7(stdCtx, ctx)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
@@ -34,6 +42,26 @@ func newStorageAPIV6(stdCtx context.Context, ctx facade.ModelContext) (*StorageA
 	}, nil
 }
 
+func newStorageAPIV7(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIv7, error) {
+	storageAPI, err := newStorageAPIV8(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &StorageAPIv7{
+		storageAPI,
+	}, nil
+}
+
+func newStorageAPIV8(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIv8, error) {
+	storageAPI, err := newStorageAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &StorageAPIv8{
+		storageAPI,
+	}, nil
+}
+
 // newStorageAPI returns a new storage API facade.
 func newStorageAPI(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPI, error) {
 	domainServices := ctx.DomainServices()
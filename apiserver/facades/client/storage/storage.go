@@ -118,6 +118,49 @@ type StorageService interface {
 		storageID string,
 	) (domainstorage.StorageInstanceUUID, error)
 
+	// ResizeStorageInstance requests that the storage instance be grown to
+	// sizeMiB.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when the storage instance does not exist in the model.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive]
+	// when the storage instance is not alive.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceShrinkNotSupported]
+	// when sizeMiB is less than the current requested size.
+	ResizeStorageInstance(
+		ctx context.Context, uuid domainstorage.StorageInstanceUUID, sizeMiB uint64,
+	) error
+
+	// CreateStorageSnapshot requests a point-in-time snapshot of the volume
+	// backing the storage instance, returning the id of the new snapshot.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when the storage instance does not exist in the model.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive]
+	// when the storage instance is not alive.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotSnapshottable]
+	// when the storage instance is not backed by a provisioned volume.
+	CreateStorageSnapshot(
+		ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+	) (string, error)
+
+	// GetStorageSnapshots returns all of the storage snapshots in the model.
+	GetStorageSnapshots(
+		ctx context.Context,
+	) ([]domainstorage.StorageSnapshotInfo, error)
+
+	// GetStorageSnapshotUUIDForID returns the uuid of the storage snapshot
+	// with the supplied id.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]
+	// when no storage snapshot exists for the supplied id.
+	GetStorageSnapshotUUIDForID(
+		ctx context.Context, id string,
+	) (domainstorage.StorageSnapshotUUID, error)
+
 	// GetStoragePoolUUID returns the UUID of the storage pool for the specified name.
 	GetStoragePoolUUID(context.Context, string) (domainstorage.StoragePoolUUID, error)
 
@@ -233,10 +276,20 @@ type MachineService interface {
 
 // StorageAPIv6 provides the Storage API facade for version 6.
 type StorageAPIv6 struct {
+	*StorageAPIv7
+}
+
+// StorageAPIv7 provides the Storage API facade for version 7.
+type StorageAPIv7 struct {
+	*StorageAPIv8
+}
+
+// StorageAPIv8 provides the Storage API facade for version 8.
+type StorageAPIv8 struct {
 	*StorageAPI
 }
 
-// StorageAPI implements the latest version (v7) of the Storage API.
+// StorageAPI implements the latest version (v9) of the Storage API.
 type StorageAPI struct {
 	blockChecker       BlockChecker
 	applicationService ApplicationService
@@ -1144,9 +1197,27 @@ func (a *StorageAPI) addOneStorage(ctx context.Context, one params.StorageAddPar
 		storageCount = uint32(*one.Directives.Count)
 	}
 
+	var snapshotUUID *domainstorage.StorageSnapshotUUID
+	if one.SnapshotId != "" {
+		uuid, err := a.storageService.GetStorageSnapshotUUIDForID(
+			ctx, one.SnapshotId,
+		)
+		switch {
+		case errors.Is(err, storageerrors.StorageSnapshotNotFound):
+			return nil, apiservererrors.ParamsErrorf(params.CodeNotFound,
+				"storage snapshot %q does not exist", one.SnapshotId)
+		case err != nil:
+			return nil, errors.Errorf(
+				"getting storage snapshot uuid for %q: %w", one.SnapshotId, err,
+			)
+		}
+		snapshotUUID = &uuid
+	}
+
 	args := domainstorage.AddUnitStorageOverride{
 		StoragePoolUUID: storagePoolUUID,
 		SizeMiB:         one.Directives.SizeMiB,
+		SnapshotUUID:    snapshotUUID,
 	}
 	var result []corestorage.ID
 	if a.modelType == coremodel.CAAS {
@@ -1179,6 +1250,12 @@ func handleUnitAddStorageError(err error, unitName coreunit.Name, one params.Sto
 	case errors.Is(err, corestorage.InvalidStorageName):
 		return apiservererrors.ParamsErrorf(params.CodeNotValid,
 			"invalid storage name %q", one.StorageName)
+	case errors.Is(err, storageerrors.StorageSnapshotNotFound):
+		return apiservererrors.ParamsErrorf(params.CodeNotFound,
+			"storage snapshot %q does not exist", one.SnapshotId)
+	case errors.Is(err, storageerrors.StorageSnapshotNotAvailable):
+		return apiservererrors.ParamsErrorf(params.CodeNotValid,
+			"storage snapshot %q is not available", one.SnapshotId)
 	case errors.Is(err, applicationerrors.StorageNameNotSupported):
 		return apiservererrors.ParamsErrorf(params.CodeNotSupported,
 			"storage name %q not supported by charm", one.StorageName)
@@ -1289,6 +1366,176 @@ func (a *StorageAPI) removeStorageInstance(
 	return nil
 }
 
+// ResizeStorage grows the specified storage instances to the requested
+// sizes. The storage provisioner responsible for each storage instance
+// grows the backing volume or filesystem in place.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) ResizeStorage(
+	ctx context.Context, args params.ResizeStorageArgs,
+) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+
+	// Check if changes are allowed and the operation may proceed.
+	if err := a.blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Storage))
+	for i, one := range args.Storage {
+		err := a.resizeOneStorage(ctx, one)
+		result[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+func (a *StorageAPI) resizeOneStorage(ctx context.Context, arg params.ResizeStorageArg) error {
+	tag, err := names.ParseStorageTag(arg.StorageTag)
+	if err != nil {
+		return apiservererrors.ParamsErrorf(params.CodeNotValid, "invalid storage tag")
+	}
+
+	uuid, err := a.storageService.GetStorageInstanceUUIDForID(ctx, tag.Id())
+	if errors.Is(err, storageerrors.StorageInstanceNotFound) {
+		return apiservererrors.ParamsErrorf(params.CodeNotFound, "storage %q does not exist", tag.Id())
+	} else if err != nil {
+		return errors.Errorf(
+			"getting storage instance uuid for storage id %q: %w",
+			tag.Id(), err,
+		)
+	}
+
+	err = a.storageService.ResizeStorageInstance(ctx, uuid, arg.SizeMiB)
+	switch {
+	case errors.Is(err, storageerrors.StorageInstanceNotFound):
+		return apiservererrors.ParamsErrorf(params.CodeNotFound, "storage %q does not exist", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceNotAlive):
+		return apiservererrors.ParamsErrorf(params.CodeNotValid, "storage %q is not alive", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceShrinkNotSupported):
+		return apiservererrors.ParamsErrorf(params.CodeNotSupported,
+			"storage %q cannot be shrunk", tag.Id())
+	case errors.Is(err, coreerrors.NotValid):
+		return apiservererrors.ParamsErrorf(params.CodeNotValid,
+			"invalid size %dMiB for storage %q", arg.SizeMiB, tag.Id())
+	case err != nil:
+		return errors.Errorf("resizing storage %q: %w", tag.Id(), err)
+	}
+	return nil
+}
+
+// ResizeStorage isn't implemented in the StorageAPIv7 facade.
+func (*StorageAPIv7) ResizeStorage(_, _ struct{}) {}
+
+// CreateStorageSnapshots requests point-in-time snapshots of the volumes
+// backing the specified storage instances, returning the id of each new
+// snapshot. Units the storage is attached to run their storage-snapshot-pre
+// hook before the snapshot is taken and storage-snapshot-post afterwards.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) CreateStorageSnapshots(
+	ctx context.Context, args params.Entities,
+) (params.StringResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.StringResults{}, errors.Capture(err)
+	}
+
+	// Check if changes are allowed and the operation may proceed.
+	if err := a.blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.StringResults{}, errors.Capture(err)
+	}
+
+	result := make([]params.StringResult, len(args.Entities))
+	for i, one := range args.Entities {
+		id, err := a.createOneStorageSnapshot(ctx, one.Tag)
+		if err != nil {
+			result[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result[i].Result = id
+	}
+	return params.StringResults{Results: result}, nil
+}
+
+func (a *StorageAPI) createOneStorageSnapshot(ctx context.Context, storageTag string) (string, error) {
+	tag, err := names.ParseStorageTag(storageTag)
+	if err != nil {
+		return "", apiservererrors.ParamsErrorf(params.CodeNotValid, "invalid storage tag")
+	}
+
+	uuid, err := a.storageService.GetStorageInstanceUUIDForID(ctx, tag.Id())
+	if errors.Is(err, storageerrors.StorageInstanceNotFound) {
+		return "", apiservererrors.ParamsErrorf(params.CodeNotFound, "storage %q does not exist", tag.Id())
+	} else if err != nil {
+		return "", errors.Errorf(
+			"getting storage instance uuid for storage id %q: %w",
+			tag.Id(), err,
+		)
+	}
+
+	id, err := a.storageService.CreateStorageSnapshot(ctx, uuid)
+	switch {
+	case errors.Is(err, storageerrors.StorageInstanceNotFound):
+		return "", apiservererrors.ParamsErrorf(params.CodeNotFound, "storage %q does not exist", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceNotAlive):
+		return "", apiservererrors.ParamsErrorf(params.CodeNotValid, "storage %q is not alive", tag.Id())
+	case errors.Is(err, storageerrors.StorageInstanceNotSnapshottable):
+		return "", apiservererrors.ParamsErrorf(params.CodeNotSupported,
+			"storage %q is not backed by a provisioned volume", tag.Id())
+	case err != nil:
+		return "", errors.Errorf("creating snapshot of storage %q: %w", tag.Id(), err)
+	}
+	return id, nil
+}
+
+// ListStorageSnapshots returns the details of all storage snapshots in the
+// model.
+func (a *StorageAPI) ListStorageSnapshots(
+	ctx context.Context,
+) (params.StorageSnapshotDetailsResults, error) {
+	if err := a.checkCanRead(ctx); err != nil {
+		return params.StorageSnapshotDetailsResults{}, errors.Capture(err)
+	}
+
+	snapshots, err := a.storageService.GetStorageSnapshots(ctx)
+	if err != nil {
+		return params.StorageSnapshotDetailsResults{}, errors.Errorf(
+			"getting storage snapshots: %w", err,
+		)
+	}
+
+	results := make([]params.StorageSnapshotDetails, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		kind := params.StorageKindUnknown
+		switch snapshot.Kind {
+		case domainstorage.StorageKindBlock:
+			kind = params.StorageKindBlock
+		case domainstorage.StorageKindFilesystem:
+			kind = params.StorageKindFilesystem
+		}
+		details := params.StorageSnapshotDetails{
+			Id:         snapshot.ID,
+			StorageTag: names.NewStorageTag(snapshot.StorageID).String(),
+			Kind:       kind,
+			Pool:       snapshot.PoolName,
+			Status:     snapshot.Status.String(),
+			Message:    snapshot.Message,
+			SizeMiB:    snapshot.SizeMiB,
+			Created:    snapshot.CreatedAt,
+		}
+		if snapshot.UnitName != "" {
+			details.UnitTag = names.NewUnitTag(snapshot.UnitName).String()
+		}
+		results = append(results, details)
+	}
+	return params.StorageSnapshotDetailsResults{Results: results}, nil
+}
+
+// CreateStorageSnapshots isn't implemented in the StorageAPIv8 facade.
+func (*StorageAPIv8) CreateStorageSnapshots(_, _ struct{}) {}
+
+// ListStorageSnapshots isn't implemented in the StorageAPIv8 facade.
+func (*StorageAPIv8) ListStorageSnapshots(_, _ struct{}) {}
+
 // Attach attaches existing storage instances to units.
 // A "CHANGE" block can block this operation.
 func (a *StorageAPI) Attach(ctx context.Context, args params.StorageAttachmentIds) (params.ErrorResults, error) {
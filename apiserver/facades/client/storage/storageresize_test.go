@@ -0,0 +1,175 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// storageResizeSuite provides a suite of tests for asserting the functionality
+// behind resizing a storage instance.
+type storageResizeSuite struct {
+	baseStorageSuite
+}
+
+func TestStorageResizeSuite(t *testing.T) {
+	tc.Run(t, &storageResizeSuite{})
+}
+
+func (s *storageResizeSuite) TestResizeStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	storageExp := s.storageService.EXPECT()
+	storageExp.GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/1",
+	).Return(storageInstanceUUID, nil)
+	storageExp.ResizeStorageInstance(
+		gomock.Any(), storageInstanceUUID, uint64(2048),
+	).Return(nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "storage-data/1",
+			SizeMiB:    2048,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error, tc.IsNil)
+}
+
+func (s *storageResizeSuite) TestResizeStorageInvalidTag(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "unit-foo-0",
+			SizeMiB:    2048,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotValid)
+}
+
+func (s *storageResizeSuite) TestResizeStorageNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/1",
+	).Return("", storageerrors.StorageInstanceNotFound)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "storage-data/1",
+			SizeMiB:    2048,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotFound)
+}
+
+func (s *storageResizeSuite) TestResizeStorageShrink(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	storageExp := s.storageService.EXPECT()
+	storageExp.GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/1",
+	).Return(storageInstanceUUID, nil)
+	storageExp.ResizeStorageInstance(
+		gomock.Any(), storageInstanceUUID, uint64(512),
+	).Return(storageerrors.StorageInstanceShrinkNotSupported)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "storage-data/1",
+			SizeMiB:    512,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotSupported)
+}
+
+func (s *storageResizeSuite) TestResizeStorageNotAlive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	storageExp := s.storageService.EXPECT()
+	storageExp.GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/1",
+	).Return(storageInstanceUUID, nil)
+	storageExp.ResizeStorageInstance(
+		gomock.Any(), storageInstanceUUID, uint64(2048),
+	).Return(storageerrors.StorageInstanceNotAlive)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "storage-data/1",
+			SizeMiB:    2048,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotValid)
+}
+
+func (s *storageResizeSuite) TestResizeStorageBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(
+		apiservererrors.OperationBlockedError("change blocked"),
+	)
+
+	api := s.makeTestAPIForIAASModel(c)
+	_, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "storage-data/1",
+			SizeMiB:    2048,
+		}},
+	})
+	c.Check(params.IsCodeOperationBlocked(err), tc.IsTrue)
+}
+
+func (s *storageResizeSuite) TestResizeStorageNoWritePermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer = apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("bob")}
+
+	api := s.makeTestAPIForIAASModel(c)
+	_, err := api.ResizeStorage(c.Context(), params.ResizeStorageArgs{
+		Storage: []params.ResizeStorageArg{{
+			StorageTag: "storage-data/1",
+			SizeMiB:    2048,
+		}},
+	})
+	c.Check(err, tc.Satisfies, params.IsCodeUnauthorized)
+}
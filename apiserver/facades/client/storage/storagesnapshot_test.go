@@ -0,0 +1,256 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	corestorage "github.com/juju/juju/core/storage"
+	coreunit "github.com/juju/juju/core/unit"
+	unittesting "github.com/juju/juju/core/unit/testing"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// storageSnapshotSuite provides a suite of tests for asserting the
+// functionality behind taking, listing and restoring storage snapshots.
+type storageSnapshotSuite struct {
+	baseStorageSuite
+}
+
+func TestStorageSnapshotSuite(t *testing.T) {
+	tc.Run(t, &storageSnapshotSuite{})
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	storageExp := s.storageService.EXPECT()
+	storageExp.GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/1",
+	).Return(storageInstanceUUID, nil)
+	storageExp.CreateStorageSnapshot(
+		gomock.Any(), storageInstanceUUID,
+	).Return("0", nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.CreateStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "storage-data/1"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res.Results, tc.DeepEquals, []params.StringResult{{Result: "0"}})
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsInvalidTag(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.CreateStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "unit-foo-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotValid)
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.storageService.EXPECT().GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/1",
+	).Return("", storageerrors.StorageInstanceNotFound)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.CreateStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "storage-data/1"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotFound)
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsNotSnapshottable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	storageExp := s.storageService.EXPECT()
+	storageExp.GetStorageInstanceUUIDForID(
+		gomock.Any(), "data/1",
+	).Return(storageInstanceUUID, nil)
+	storageExp.CreateStorageSnapshot(
+		gomock.Any(), storageInstanceUUID,
+	).Return("", storageerrors.StorageInstanceNotSnapshottable)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.CreateStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "storage-data/1"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotSupported)
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(
+		apiservererrors.OperationBlockedError("change blocked"),
+	)
+
+	api := s.makeTestAPIForIAASModel(c)
+	_, err := api.CreateStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "storage-data/1"}},
+	})
+	c.Check(params.IsCodeOperationBlocked(err), tc.IsTrue)
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsNoWritePermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer = apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("bob")}
+
+	api := s.makeTestAPIForIAASModel(c)
+	_, err := api.CreateStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "storage-data/1"}},
+	})
+	c.Check(err, tc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *storageSnapshotSuite) TestListStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.storageService.EXPECT().GetStorageSnapshots(gomock.Any()).Return(
+		[]domainstorage.StorageSnapshotInfo{
+			{
+				UUID:        tc.Must(c, domainstorage.NewStorageSnapshotUUID),
+				ID:          "0",
+				StorageID:   "data/1",
+				StorageName: "data",
+				Kind:        domainstorage.StorageKindBlock,
+				PoolName:    "loop",
+				UnitName:    "foo/0",
+				Status:      domainstorage.StorageSnapshotStatusAvailable,
+				SizeMiB:     1024,
+				CreatedAt:   created,
+			},
+			{
+				UUID:        tc.Must(c, domainstorage.NewStorageSnapshotUUID),
+				ID:          "1",
+				StorageID:   "data/2",
+				StorageName: "data",
+				Kind:        domainstorage.StorageKindFilesystem,
+				PoolName:    "ebs",
+				Status:      domainstorage.StorageSnapshotStatusError,
+				Message:     "boom",
+				CreatedAt:   created,
+			},
+		}, nil,
+	)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.ListStorageSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res.Results, tc.DeepEquals, []params.StorageSnapshotDetails{
+		{
+			Id:         "0",
+			StorageTag: "storage-data-1",
+			Kind:       params.StorageKindBlock,
+			Pool:       "loop",
+			UnitTag:    "unit-foo-0",
+			Status:     "available",
+			SizeMiB:    1024,
+			Created:    created,
+		},
+		{
+			Id:         "1",
+			StorageTag: "storage-data-2",
+			Kind:       params.StorageKindFilesystem,
+			Pool:       "ebs",
+			Status:     "error",
+			Message:    "boom",
+			Created:    created,
+		},
+	})
+}
+
+// TestAddToUnitFromSnapshot asserts that adding storage to a unit from a
+// snapshot passes the snapshot through to the application service.
+func (s *storageSnapshotSuite) TestAddToUnitFromSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitUUID := unittesting.GenUnitUUID(c)
+	snapshotUUID := tc.Must(c, domainstorage.NewStorageSnapshotUUID)
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.applicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("foo/0"),
+	).Return(unitUUID, nil)
+	s.storageService.EXPECT().GetStorageSnapshotUUIDForID(
+		gomock.Any(), "0",
+	).Return(snapshotUUID, nil)
+	s.applicationService.EXPECT().AddStorageForIAASUnit(
+		gomock.Any(), corestorage.Name("data"), unitUUID, uint32(1),
+		domainstorage.AddUnitStorageOverride{SnapshotUUID: &snapshotUUID},
+	).Return([]corestorage.ID{"data/3"}, nil)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.AddToUnit(c.Context(), params.StoragesAddParams{
+		Storages: []params.StorageAddParams{{
+			UnitTag:     "unit-foo-0",
+			StorageName: "data",
+			SnapshotId:  "0",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res.Results, tc.DeepEquals, []params.AddStorageResult{{
+		Result: &params.AddStorageDetails{
+			StorageTags: []string{"storage-data-3"},
+		},
+	}})
+}
+
+// TestAddToUnitFromSnapshotNotFound asserts that adding storage to a unit
+// from a snapshot that does not exist results in a not found error.
+func (s *storageSnapshotSuite) TestAddToUnitFromSnapshotNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitUUID := unittesting.GenUnitUUID(c)
+
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.applicationService.EXPECT().GetUnitUUID(
+		gomock.Any(), coreunit.Name("foo/0"),
+	).Return(unitUUID, nil)
+	s.storageService.EXPECT().GetStorageSnapshotUUIDForID(
+		gomock.Any(), "0",
+	).Return("", storageerrors.StorageSnapshotNotFound)
+
+	api := s.makeTestAPIForIAASModel(c)
+	res, err := api.AddToUnit(c.Context(), params.StoragesAddParams{
+		Storages: []params.StorageAddParams{{
+			UnitTag:     "unit-foo-0",
+			StorageName: "data",
+			SnapshotId:  "0",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotFound)
+}
@@ -26,6 +26,7 @@ import (
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/migration"
 	_ "github.com/juju/juju/internal/provider/unmanaged"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/storage"
 	jujutesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
@@ -520,6 +521,7 @@ func (s *Suite) expectImportModel(c *tc.C) {
 			corestorage.ConstModelStorageRegistry(func() storage.ProviderRegistry {
 				return nil
 			}),
+			encryption.NewFileKeyStore(c.MkDir()),
 			"",
 			loggertesting.WrapCheckLog(c),
 			clock.WallClock,
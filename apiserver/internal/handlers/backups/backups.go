@@ -0,0 +1,86 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	corebackups "github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/rpc/params"
+)
+
+var logger = internallogger.GetLogger("juju.apiserver.backups")
+
+// BackupsHandler implements the http.Handler interface for downloading the
+// backup archives stored on the controller.
+type BackupsHandler struct {
+	backupDir string
+}
+
+// NewBackupsHandler returns a new BackupsHandler serving the archives in the
+// given backup directory.
+func NewBackupsHandler(backupDir string) *BackupsHandler {
+	return &BackupsHandler{
+		backupDir: backupDir,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *BackupsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		if err := h.serveDownload(w, r); err != nil {
+			if err := internalhttp.SendError(w, errors.Errorf("cannot download backup: %w", err), logger); err != nil {
+				logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+			}
+		}
+	default:
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+	}
+}
+
+// serveDownload streams the archive named in the request body.
+func (h *BackupsHandler) serveDownload(w http.ResponseWriter, r *http.Request) error {
+	var args params.BackupsDownloadArgs
+	if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+		return errors.Errorf("reading request body: %v %w", err, coreerrors.BadRequest)
+	}
+
+	path, err := corebackups.ArchivePath(h.backupDir, args.ID)
+	if errors.Is(err, coreerrors.NotValid) {
+		return errors.Errorf("%w: %w", err, coreerrors.BadRequest)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	archive, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return errors.Errorf("backup %q %w", args.ID, coreerrors.NotFound)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	info, err := archive.Stat()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	w.Header().Set("Content-Type", params.ContentTypeRaw)
+	w.Header().Set("Content-Length", fmt.Sprint(info.Size()))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", args.ID))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, archive); err != nil {
+		// The response has started, so the error can only be logged.
+		logger.Errorf(r.Context(), "sending backup %q: %v", args.ID, err)
+	}
+	return nil
+}
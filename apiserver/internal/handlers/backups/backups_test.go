@@ -0,0 +1,105 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/rpc/params"
+)
+
+const route = "/backups"
+
+type backupsHandlerSuite struct {
+	backupDir string
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestBackupsHandlerSuite(t *stdtesting.T) {
+	tc.Run(t, &backupsHandlerSuite{})
+}
+
+func (s *backupsHandlerSuite) SetUpTest(c *tc.C) {
+	s.backupDir = c.MkDir()
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *backupsHandlerSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *backupsHandlerSuite) addHandler(c *tc.C, method string) {
+	s.mux.AddHandler(method, route, NewBackupsHandler(s.backupDir))
+	c.Cleanup(func() {
+		s.mux.RemoveHandler(method, route)
+	})
+}
+
+func (s *backupsHandlerSuite) download(c *tc.C, id string) *http.Response {
+	body, err := json.Marshal(params.BackupsDownloadArgs{ID: id})
+	c.Assert(err, tc.ErrorIsNil)
+	req, err := http.NewRequest("GET", s.srv.URL+route, strings.NewReader(string(body)))
+	c.Assert(err, tc.ErrorIsNil)
+	resp, err := http.DefaultClient.Do(req)
+	c.Assert(err, tc.ErrorIsNil)
+	return resp
+}
+
+func (s *backupsHandlerSuite) TestServeMethodNotSupported(c *tc.C) {
+	s.addHandler(c, "POST")
+
+	resp, err := http.Post(s.srv.URL+route, "application/json", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotImplemented)
+}
+
+func (s *backupsHandlerSuite) TestDownload(c *tc.C) {
+	s.addHandler(c, "GET")
+	err := os.WriteFile(filepath.Join(s.backupDir, "juju-backup-20260101-000000.tar.gz"), []byte("archive"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	resp := s.download(c, "juju-backup-20260101-000000.tar.gz")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), tc.Equals, params.ContentTypeRaw)
+	c.Check(resp.Header.Get("Content-Length"), tc.Equals, "7")
+
+	data, err := io.ReadAll(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "archive")
+}
+
+func (s *backupsHandlerSuite) TestDownloadNotFound(c *tc.C) {
+	s.addHandler(c, "GET")
+
+	resp := s.download(c, "juju-backup-20260101-000000.tar.gz")
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotFound)
+}
+
+func (s *backupsHandlerSuite) TestDownloadNotValid(c *tc.C) {
+	s.addHandler(c, "GET")
+	err := os.WriteFile(filepath.Join(s.backupDir, "secret"), []byte("secret"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, id := range []string{"secret", "../juju-backup-20260101-000000.tar.gz"} {
+		resp := s.download(c, id)
+		_ = resp.Body.Close()
+		c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest, tc.Commentf("id %q", id))
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelexport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/apiserver/httpcontext"
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	"github.com/juju/juju/core/objectstore"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/internal/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/modelarchive"
+)
+
+var logger = internallogger.GetLogger("juju.apiserver.modelexport")
+
+// ExportService exports the data of a model.
+type ExportService interface {
+	// Export exports all model data.
+	Export(ctx context.Context) (*domainexport.ModelExport, error)
+}
+
+// ObjectStore provides read access to the objects of a model.
+type ObjectStore interface {
+	// Get returns an io.ReadCloser for data at path, namespaced to the
+	// model.
+	Get(context.Context, string) (io.ReadCloser, objectstore.Digest, error)
+}
+
+// ServicesGetter returns the services needed to export the model targeted by
+// a request.
+type ServicesGetter interface {
+	// ExportService returns the export service for the request model.
+	ExportService(*http.Request) (ExportService, error)
+
+	// ObjectStore returns the object store for the request model.
+	ObjectStore(*http.Request) (ObjectStore, error)
+}
+
+// ModelExportHandler implements the http.Handler interface for downloading a
+// portable model archive.
+type ModelExportHandler struct {
+	servicesGetter ServicesGetter
+	clock          clock.Clock
+}
+
+// NewModelExportHandler returns a new ModelExportHandler.
+func NewModelExportHandler(servicesGetter ServicesGetter, clock clock.Clock) *ModelExportHandler {
+	return &ModelExportHandler{
+		servicesGetter: servicesGetter,
+		clock:          clock,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *ModelExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		if err := h.serveGet(w, r); err != nil {
+			if err := internalhttp.SendError(w, errors.Errorf("cannot export model: %w", err), logger); err != nil {
+				logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+			}
+		}
+	default:
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+	}
+}
+
+// serveGet streams the model archive to the client. Errors encountered
+// before the response is started are returned, so that they can be sent to
+// the client. Once the archive is being written an error can only be logged;
+// the client detects the truncated archive when verifying it.
+func (h *ModelExportHandler) serveGet(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	modelUUID, ok := httpcontext.RequestModelUUID(ctx)
+	if !ok {
+		return errors.New("missing model uuid")
+	}
+
+	exportService, err := h.servicesGetter.ExportService(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	objectStore, err := h.servicesGetter.ObjectStore(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	export, err := exportService.Export(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", archiveFilename(modelUUID)))
+	w.WriteHeader(http.StatusOK)
+
+	if err := modelarchive.Write(ctx, w, modelUUID, export, objectStore, h.clock.Now()); err != nil {
+		logger.Errorf(ctx, "writing model archive for %q: %v", modelUUID, err)
+	}
+	return nil
+}
+
+// archiveFilename returns the default filename of the archive for the
+// given model.
+func archiveFilename(modelUUID string) string {
+	return fmt.Sprintf("juju-model-%s.tar.gz", modelUUID)
+}
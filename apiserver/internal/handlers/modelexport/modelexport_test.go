@@ -0,0 +1,136 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/apiserver/httpcontext"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/domain/export/types/v4_0_4"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/modelarchive"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+const exportRoute = "/model/:modeluuid/export"
+
+type modelExportHandlerSuite struct {
+	servicesGetter *MockServicesGetter
+	exportService  *MockExportService
+	objectStore    *MockObjectStore
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestModelExportHandlerSuite(t *stdtesting.T) {
+	tc.Run(t, &modelExportHandlerSuite{})
+}
+
+func (s *modelExportHandlerSuite) SetUpTest(c *tc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *modelExportHandlerSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *modelExportHandlerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.servicesGetter = NewMockServicesGetter(ctrl)
+	s.exportService = NewMockExportService(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+
+	c.Cleanup(func() {
+		s.servicesGetter = nil
+		s.exportService = nil
+		s.objectStore = nil
+	})
+
+	return ctrl
+}
+
+func (s *modelExportHandlerSuite) addHandler(c *tc.C, method string) {
+	handler := &httpcontext.QueryModelHandler{
+		Handler: NewModelExportHandler(s.servicesGetter, testclock.NewClock(time.Now())),
+		Query:   ":modeluuid",
+	}
+	s.mux.AddHandler(method, exportRoute, handler)
+	c.Cleanup(func() { s.mux.RemoveHandler(method, exportRoute) })
+}
+
+func (s *modelExportHandlerSuite) url() string {
+	return fmt.Sprintf("%s/model/%s/export", s.srv.URL, testing.ModelTag.Id())
+}
+
+func (s *modelExportHandlerSuite) TestServeMethodNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "POST")
+
+	resp, err := http.Post(s.url(), "application/octet-stream", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotImplemented)
+}
+
+func (s *modelExportHandlerSuite) TestServeGet(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "GET")
+
+	s.servicesGetter.EXPECT().ExportService(gomock.Any()).Return(s.exportService, nil)
+	s.servicesGetter.EXPECT().ObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.exportService.EXPECT().Export(gomock.Any()).Return(&domainexport.ModelExport{
+		Version: "4.0.4",
+		Payload: &v4_0_4.ModelExport{},
+	}, nil)
+
+	resp, err := http.Get(s.url())
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), tc.Equals, "application/gzip")
+	c.Check(resp.Header.Get("Content-Disposition"), tc.Equals,
+		fmt.Sprintf(`attachment; filename="juju-model-%s.tar.gz"`, testing.ModelTag.Id()))
+
+	manifest, err := modelarchive.Verify(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(manifest.ModelUUID, tc.Equals, testing.ModelTag.Id())
+	c.Check(manifest.ExportVersion, tc.Equals, "4.0.4")
+}
+
+func (s *modelExportHandlerSuite) TestServeGetExportError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "GET")
+
+	s.servicesGetter.EXPECT().ExportService(gomock.Any()).Return(s.exportService, nil)
+	s.servicesGetter.EXPECT().ObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.exportService.EXPECT().Export(gomock.Any()).Return(nil, errors.New("boom"))
+
+	resp, err := http.Get(s.url())
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusInternalServerError)
+
+	body, err := io.ReadAll(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	var result params.ErrorResult
+	err = json.Unmarshal(body, &result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error.Message, tc.Equals, "cannot export model: boom")
+}
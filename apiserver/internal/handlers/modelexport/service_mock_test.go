@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/handlers/modelexport (interfaces: ServicesGetter,ExportService,ObjectStore)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package modelexport -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/modelexport ServicesGetter,ExportService,ObjectStore
+//
+
+// Package modelexport is a generated GoMock package.
+package modelexport
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+	reflect "reflect"
+
+	objectstore "github.com/juju/juju/core/objectstore"
+	export "github.com/juju/juju/domain/export"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServicesGetter is a mock of ServicesGetter interface.
+type MockServicesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServicesGetterMockRecorder
+}
+
+// MockServicesGetterMockRecorder is the mock recorder for MockServicesGetter.
+type MockServicesGetterMockRecorder struct {
+	mock *MockServicesGetter
+}
+
+// NewMockServicesGetter creates a new mock instance.
+func NewMockServicesGetter(ctrl *gomock.Controller) *MockServicesGetter {
+	mock := &MockServicesGetter{ctrl: ctrl}
+	mock.recorder = &MockServicesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServicesGetter) EXPECT() *MockServicesGetterMockRecorder {
+	return m.recorder
+}
+
+// ExportService mocks base method.
+func (m *MockServicesGetter) ExportService(arg0 *http.Request) (ExportService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportService", arg0)
+	ret0, _ := ret[0].(ExportService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportService indicates an expected call of ExportService.
+func (mr *MockServicesGetterMockRecorder) ExportService(arg0 any) *MockServicesGetterExportServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportService", reflect.TypeOf((*MockServicesGetter)(nil).ExportService), arg0)
+	return &MockServicesGetterExportServiceCall{Call: call}
+}
+
+// MockServicesGetterExportServiceCall wrap *gomock.Call
+type MockServicesGetterExportServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterExportServiceCall) Return(arg0 ExportService, arg1 error) *MockServicesGetterExportServiceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterExportServiceCall) Do(f func(*http.Request) (ExportService, error)) *MockServicesGetterExportServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterExportServiceCall) DoAndReturn(f func(*http.Request) (ExportService, error)) *MockServicesGetterExportServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ObjectStore mocks base method.
+func (m *MockServicesGetter) ObjectStore(arg0 *http.Request) (ObjectStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStore", arg0)
+	ret0, _ := ret[0].(ObjectStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectStore indicates an expected call of ObjectStore.
+func (mr *MockServicesGetterMockRecorder) ObjectStore(arg0 any) *MockServicesGetterObjectStoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockServicesGetter)(nil).ObjectStore), arg0)
+	return &MockServicesGetterObjectStoreCall{Call: call}
+}
+
+// MockServicesGetterObjectStoreCall wrap *gomock.Call
+type MockServicesGetterObjectStoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterObjectStoreCall) Return(arg0 ObjectStore, arg1 error) *MockServicesGetterObjectStoreCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterObjectStoreCall) Do(f func(*http.Request) (ObjectStore, error)) *MockServicesGetterObjectStoreCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterObjectStoreCall) DoAndReturn(f func(*http.Request) (ObjectStore, error)) *MockServicesGetterObjectStoreCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockExportService is a mock of ExportService interface.
+type MockExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceMockRecorder
+}
+
+// MockExportServiceMockRecorder is the mock recorder for MockExportService.
+type MockExportServiceMockRecorder struct {
+	mock *MockExportService
+}
+
+// NewMockExportService creates a new mock instance.
+func NewMockExportService(ctrl *gomock.Controller) *MockExportService {
+	mock := &MockExportService{ctrl: ctrl}
+	mock.recorder = &MockExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportService) EXPECT() *MockExportServiceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockExportService) Export(arg0 context.Context) (*export.ModelExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0)
+	ret0, _ := ret[0].(*export.ModelExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExportServiceMockRecorder) Export(arg0 any) *MockExportServiceExportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExportService)(nil).Export), arg0)
+	return &MockExportServiceExportCall{Call: call}
+}
+
+// MockExportServiceExportCall wrap *gomock.Call
+type MockExportServiceExportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportServiceExportCall) Return(arg0 *export.ModelExport, arg1 error) *MockExportServiceExportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportServiceExportCall) Do(f func(context.Context) (*export.ModelExport, error)) *MockExportServiceExportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportServiceExportCall) DoAndReturn(f func(context.Context) (*export.ModelExport, error)) *MockExportServiceExportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockObjectStore is a mock of ObjectStore interface.
+type MockObjectStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStoreMockRecorder
+}
+
+// MockObjectStoreMockRecorder is the mock recorder for MockObjectStore.
+type MockObjectStoreMockRecorder struct {
+	mock *MockObjectStore
+}
+
+// NewMockObjectStore creates a new mock instance.
+func NewMockObjectStore(ctrl *gomock.Controller) *MockObjectStore {
+	mock := &MockObjectStore{ctrl: ctrl}
+	mock.recorder = &MockObjectStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStore) EXPECT() *MockObjectStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockObjectStore) Get(arg0 context.Context, arg1 string) (io.ReadCloser, objectstore.Digest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(objectstore.Digest)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockObjectStoreMockRecorder) Get(arg0, arg1 any) *MockObjectStoreGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockObjectStore)(nil).Get), arg0, arg1)
+	return &MockObjectStoreGetCall{Call: call}
+}
+
+// MockObjectStoreGetCall wrap *gomock.Call
+type MockObjectStoreGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreGetCall) Return(arg0 io.ReadCloser, arg1 objectstore.Digest, arg2 error) *MockObjectStoreGetCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreGetCall) Do(f func(context.Context, string) (io.ReadCloser, objectstore.Digest, error)) *MockObjectStoreGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreGetCall) DoAndReturn(f func(context.Context, string) (io.ReadCloser, objectstore.Digest, error)) *MockObjectStoreGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
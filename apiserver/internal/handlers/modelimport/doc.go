@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package modelimport provides the handler for importing a portable model
+// archive into the controller.
+
+package modelimport
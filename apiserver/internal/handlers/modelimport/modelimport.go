@@ -0,0 +1,296 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelimport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/juju/names/v6"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	"github.com/juju/juju/core/credential"
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	coreuser "github.com/juju/juju/core/user"
+	domainexport "github.com/juju/juju/domain/export"
+	domainmodel "github.com/juju/juju/domain/model"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/modelarchive"
+	"github.com/juju/juju/rpc/params"
+)
+
+var logger = internallogger.GetLogger("juju.apiserver.modelimport")
+
+// ModelImportService creates the controller records of an imported model.
+type ModelImportService interface {
+	// ImportModel creates the controller records of a model that is being
+	// imported, marking it as importing.
+	ImportModel(context.Context, domainmodel.ModelImportArgs) error
+
+	// ActivateModel marks the model as active once it has been imported.
+	ActivateModel(context.Context, coremodel.UUID) error
+}
+
+// ImportService loads the data of an imported model into its database.
+type ImportService interface {
+	// Import loads a serialised model export into the model database,
+	// recording the model as managed by the controller with the input UUID.
+	Import(ctx context.Context, data []byte, controllerUUID string) error
+}
+
+// ModelMigrationService completes the import of a model.
+type ModelMigrationService interface {
+	// ActivateImport finalises the import of the model, so that it can be
+	// used.
+	ActivateImport(ctx context.Context) error
+}
+
+// RemovalService removes a model whose import failed.
+type RemovalService interface {
+	// RemoveMigratingModel removes a model that is being imported.
+	RemoveMigratingModel(ctx context.Context, modelUUID coremodel.UUID) error
+}
+
+// ObjectStore stores the objects of an imported model.
+type ObjectStore interface {
+	// PutAndCheckHash stores data from reader at path, namespaced to the
+	// model, checking that its hash matches the input SHA384.
+	PutAndCheckHash(ctx context.Context, path string, r io.Reader, size int64, sha384 string) (objectstore.UUID, error)
+}
+
+// ServicesGetter returns the services needed to import a model.
+type ServicesGetter interface {
+	// Importer returns the UUID of the user making the request, who is made
+	// an admin of the imported model.
+	Importer(*http.Request) (coreuser.UUID, error)
+
+	// ModelImportService returns the service creating the controller
+	// records of imported models.
+	ModelImportService(*http.Request) (ModelImportService, error)
+
+	// ImportService returns the import service for the model with the
+	// input UUID.
+	ImportService(context.Context, coremodel.UUID) (ImportService, error)
+
+	// ModelMigrationService returns the model migration service for the
+	// model with the input UUID.
+	ModelMigrationService(context.Context, coremodel.UUID) (ModelMigrationService, error)
+
+	// RemovalService returns the removal service for the model with the
+	// input UUID.
+	RemovalService(context.Context, coremodel.UUID) (RemovalService, error)
+
+	// ObjectStore returns the object store for the model with the input
+	// UUID.
+	ObjectStore(context.Context, coremodel.UUID) (ObjectStore, error)
+}
+
+// ModelImportHandler implements the http.Handler interface for importing a
+// portable model archive, as written by the model export handler, into the
+// controller.
+type ModelImportHandler struct {
+	servicesGetter ServicesGetter
+	controllerUUID string
+}
+
+// NewModelImportHandler returns a new ModelImportHandler importing models
+// into the controller with the input UUID.
+func NewModelImportHandler(servicesGetter ServicesGetter, controllerUUID string) *ModelImportHandler {
+	return &ModelImportHandler{
+		servicesGetter: servicesGetter,
+		controllerUUID: controllerUUID,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *ModelImportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		result, err := h.servePost(r)
+		if err != nil {
+			if err := internalhttp.SendError(w, errors.Errorf("cannot import model: %w", err), logger); err != nil {
+				logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+			}
+			return
+		}
+		if err := internalhttp.SendStatusAndJSON(w, http.StatusOK, result); err != nil {
+			logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return result to user: %w", err))
+		}
+	default:
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+	}
+}
+
+// servePost imports the model archive held in the request body. The archive
+// is verified in full before the model is created.
+func (h *ModelImportHandler) servePost(r *http.Request) (params.ImportModelResult, error) {
+	ctx := r.Context()
+
+	archive, err := os.CreateTemp("", "juju-model-import-*.tar.gz")
+	if err != nil {
+		return params.ImportModelResult{}, errors.Capture(err)
+	}
+	defer func() {
+		_ = archive.Close()
+		_ = os.Remove(archive.Name())
+	}()
+	if _, err := io.Copy(archive, r.Body); err != nil {
+		return params.ImportModelResult{}, errors.Errorf("receiving model archive: %w", err)
+	}
+
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return params.ImportModelResult{}, errors.Capture(err)
+	}
+	manifest, err := modelarchive.Verify(archive)
+	if errors.Is(err, coreerrors.NotValid) || errors.Is(err, coreerrors.NotSupported) {
+		return params.ImportModelResult{}, errors.Errorf("verifying model archive: %w", err).Add(coreerrors.BadRequest)
+	} else if err != nil {
+		return params.ImportModelResult{}, errors.Errorf("verifying model archive: %w", err)
+	}
+	if version := domainexport.ImportVersion(); manifest.ExportVersion != version {
+		return params.ImportModelResult{}, errors.Errorf(
+			"model archive holds a version %q export, only version %q can be imported",
+			manifest.ExportVersion, version).Add(coreerrors.BadRequest)
+	}
+
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return params.ImportModelResult{}, errors.Capture(err)
+	}
+	reader, err := modelarchive.NewReader(archive)
+	if errors.Is(err, coreerrors.NotValid) {
+		return params.ImportModelResult{}, errors.Errorf("reading model archive: %w", err).Add(coreerrors.BadRequest)
+	} else if err != nil {
+		return params.ImportModelResult{}, errors.Errorf("reading model archive: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	model := reader.Model()
+	if model.IsControllerModel {
+		return params.ImportModelResult{}, errors.New(
+			"model archive holds a controller model").Add(coreerrors.BadRequest)
+	}
+	modelUUID := coremodel.UUID(model.UUID)
+
+	modelImportService, err := h.servicesGetter.ModelImportService(r)
+	if err != nil {
+		return params.ImportModelResult{}, errors.Capture(err)
+	}
+	if err := h.createModel(r, modelImportService, model); err != nil {
+		return params.ImportModelResult{}, errors.Capture(err)
+	}
+	if err := h.loadModel(ctx, modelImportService, modelUUID, reader); err != nil {
+		h.removeModel(ctx, modelUUID)
+		return params.ImportModelResult{}, errors.Capture(err)
+	}
+
+	logger.Infof(ctx, "imported model %q (%s/%s) from archive", modelUUID, model.Qualifier, model.Name)
+	return params.ImportModelResult{
+		ModelTag:  names.NewModelTag(model.UUID).String(),
+		Name:      model.Name,
+		Qualifier: model.Qualifier,
+	}, nil
+}
+
+// createModel creates the controller records of the model, marking it as
+// importing. The user making the request becomes the admin of the model.
+func (h *ModelImportHandler) createModel(
+	r *http.Request, modelImportService ModelImportService, model modelarchive.Model,
+) error {
+	importer, err := h.servicesGetter.Importer(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var cred credential.Key
+	if model.CredentialName != "" {
+		owner, err := coreuser.NewName(model.CredentialOwner)
+		if err != nil {
+			return errors.Errorf("model cloud credential owner: %w", err).Add(coreerrors.BadRequest)
+		}
+		cred = credential.Key{
+			Cloud: model.Cloud,
+			Owner: owner,
+			Name:  model.CredentialName,
+		}
+	}
+
+	if err := modelImportService.ImportModel(r.Context(), domainmodel.ModelImportArgs{
+		GlobalModelCreationArgs: domainmodel.GlobalModelCreationArgs{
+			Cloud:       model.Cloud,
+			CloudRegion: model.CloudRegion,
+			Credential:  cred,
+			Name:        model.Name,
+			Qualifier:   coremodel.Qualifier(model.Qualifier),
+			AdminUsers:  []coreuser.UUID{importer},
+		},
+		UUID: coremodel.UUID(model.UUID),
+	}); err != nil {
+		return errors.Errorf("creating model %q: %w", model.UUID, err)
+	}
+	return nil
+}
+
+// loadModel loads the data and objects of the model from the archive, then
+// activates the model.
+func (h *ModelImportHandler) loadModel(
+	ctx context.Context, modelImportService ModelImportService, modelUUID coremodel.UUID, reader *modelarchive.Reader,
+) error {
+	importService, err := h.servicesGetter.ImportService(ctx, modelUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := importService.Import(ctx, reader.ModelExport(), h.controllerUUID); err != nil {
+		return errors.Errorf("loading model data: %w", err)
+	}
+
+	objectStore, err := h.servicesGetter.ObjectStore(ctx, modelUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	for {
+		obj, content, err := reader.NextObject()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		// The metadata of every object was loaded with the model data, so
+		// storing the object only writes its content.
+		_, err = objectStore.PutAndCheckHash(ctx, obj.Path, content, obj.Size, obj.SHA384)
+		if err != nil && !errors.Is(err, objectstoreerrors.ErrHashAndSizeAlreadyExists) {
+			return errors.Errorf("storing object %q: %w", obj.Path, err)
+		}
+	}
+
+	if err := modelImportService.ActivateModel(ctx, modelUUID); err != nil {
+		return errors.Errorf("activating model: %w", err)
+	}
+	modelMigrationService, err := h.servicesGetter.ModelMigrationService(ctx, modelUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := modelMigrationService.ActivateImport(ctx); err != nil {
+		return errors.Errorf("completing import: %w", err)
+	}
+	return nil
+}
+
+// removeModel removes a model whose import failed. A failure is only logged,
+// so that the error that failed the import is returned.
+func (h *ModelImportHandler) removeModel(ctx context.Context, modelUUID coremodel.UUID) {
+	removalService, err := h.servicesGetter.RemovalService(ctx, modelUUID)
+	if err == nil {
+		err = removalService.RemoveMigratingModel(ctx, modelUUID)
+	}
+	if err != nil {
+		logger.Errorf(ctx, "removing model %q after failed import: %v", modelUUID, err)
+	}
+}
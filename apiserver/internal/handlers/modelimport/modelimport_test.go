@@ -0,0 +1,262 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelimport
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/core/credential"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	coreuser "github.com/juju/juju/core/user"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/domain/export/types/v4_0_4"
+	domainmodel "github.com/juju/juju/domain/model"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/modelarchive"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+const importRoute = "/model-import"
+
+type modelImportHandlerSuite struct {
+	servicesGetter        *MockServicesGetter
+	modelImportService    *MockModelImportService
+	importService         *MockImportService
+	modelMigrationService *MockModelMigrationService
+	removalService        *MockRemovalService
+	objectStore           *MockObjectStore
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestModelImportHandlerSuite(t *stdtesting.T) {
+	tc.Run(t, &modelImportHandlerSuite{})
+}
+
+func (s *modelImportHandlerSuite) SetUpTest(c *tc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *modelImportHandlerSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *modelImportHandlerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.servicesGetter = NewMockServicesGetter(ctrl)
+	s.modelImportService = NewMockModelImportService(ctrl)
+	s.importService = NewMockImportService(ctrl)
+	s.modelMigrationService = NewMockModelMigrationService(ctrl)
+	s.removalService = NewMockRemovalService(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+
+	c.Cleanup(func() {
+		s.servicesGetter = nil
+		s.modelImportService = nil
+		s.importService = nil
+		s.modelMigrationService = nil
+		s.removalService = nil
+		s.objectStore = nil
+	})
+
+	return ctrl
+}
+
+func (s *modelImportHandlerSuite) addHandler(c *tc.C, method string) {
+	s.mux.AddHandler(method, importRoute, NewModelImportHandler(s.servicesGetter, testing.ControllerTag.Id()))
+	c.Cleanup(func() { s.mux.RemoveHandler(method, importRoute) })
+}
+
+func (s *modelImportHandlerSuite) url() string {
+	return s.srv.URL + importRoute
+}
+
+type fakeObjects map[string][]byte
+
+func (f fakeObjects) Get(_ context.Context, path string) (io.ReadCloser, objectstore.Digest, error) {
+	data := f[path]
+	return io.NopCloser(bytes.NewReader(data)), objectstore.Digest{Size: int64(len(data))}, nil
+}
+
+// newArchive returns a model archive holding a model with a single charm
+// archive in its object store.
+func (s *modelImportHandlerSuite) newArchive(c *tc.C, isController bool) []byte {
+	charm := []byte("charm archive")
+	sum256 := sha256.Sum256(charm)
+	sum384 := sha512.Sum384(charm)
+
+	credOwner, credName := "admin", "default"
+	export := &domainexport.ModelExport{
+		Version: "4.0.4",
+		Payload: &v4_0_4.ModelExport{
+			Model: []v4_0_4.Model{{
+				UUID:              testing.ModelTag.Id(),
+				ControllerUUID:    "other-controller-uuid",
+				Name:              "foo",
+				Qualifier:         "prod",
+				Type:              "iaas",
+				Cloud:             "lxd",
+				CloudType:         "lxd",
+				CredentialOwner:   &credOwner,
+				CredentialName:    &credName,
+				IsControllerModel: &isController,
+			}},
+			ObjectStoreMetadata: []v4_0_4.ObjectStoreMetadata{{
+				UUID:   "charm-uuid",
+				Sha256: hex.EncodeToString(sum256[:]),
+				Sha384: hex.EncodeToString(sum384[:]),
+				Size:   int64(len(charm)),
+			}},
+			ObjectStoreMetadataPath: []v4_0_4.ObjectStoreMetadataPath{{
+				Path:         "charms/foo",
+				MetadataUUID: "charm-uuid",
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, testing.ModelTag.Id(), export, fakeObjects{
+		"charms/foo": charm,
+	}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	return buf.Bytes()
+}
+
+func (s *modelImportHandlerSuite) readError(c *tc.C, resp *http.Response) string {
+	body, err := io.ReadAll(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	var result params.ErrorResult
+	err = json.Unmarshal(body, &result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.NotNil)
+	return result.Error.Message
+}
+
+func (s *modelImportHandlerSuite) TestServeMethodNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "GET")
+
+	resp, err := http.Get(s.url())
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotImplemented)
+}
+
+func (s *modelImportHandlerSuite) TestServePost(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "POST")
+
+	modelUUID := coremodel.UUID(testing.ModelTag.Id())
+	importer := coreuser.UUID("importer-uuid")
+
+	s.servicesGetter.EXPECT().ModelImportService(gomock.Any()).Return(s.modelImportService, nil)
+	s.servicesGetter.EXPECT().Importer(gomock.Any()).Return(importer, nil)
+	s.modelImportService.EXPECT().ImportModel(gomock.Any(), domainmodel.ModelImportArgs{
+		GlobalModelCreationArgs: domainmodel.GlobalModelCreationArgs{
+			Cloud: "lxd",
+			Credential: credential.Key{
+				Cloud: "lxd",
+				Owner: coreuser.AdminUserName,
+				Name:  "default",
+			},
+			Name:       "foo",
+			Qualifier:  "prod",
+			AdminUsers: []coreuser.UUID{importer},
+		},
+		UUID: modelUUID,
+	}).Return(nil)
+
+	s.servicesGetter.EXPECT().ImportService(gomock.Any(), modelUUID).Return(s.importService, nil)
+	s.importService.EXPECT().Import(gomock.Any(), gomock.Any(), testing.ControllerTag.Id()).Return(nil)
+
+	s.servicesGetter.EXPECT().ObjectStore(gomock.Any(), modelUUID).Return(s.objectStore, nil)
+	s.objectStore.EXPECT().PutAndCheckHash(gomock.Any(), "charms/foo", gomock.Any(), int64(13), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ int64, _ string) (objectstore.UUID, error) {
+			data, err := io.ReadAll(r)
+			c.Check(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, "charm archive")
+			return "", objectstoreerrors.ErrHashAndSizeAlreadyExists
+		})
+
+	s.modelImportService.EXPECT().ActivateModel(gomock.Any(), modelUUID).Return(nil)
+	s.servicesGetter.EXPECT().ModelMigrationService(gomock.Any(), modelUUID).Return(s.modelMigrationService, nil)
+	s.modelMigrationService.EXPECT().ActivateImport(gomock.Any()).Return(nil)
+
+	resp, err := http.Post(s.url(), "application/gzip", bytes.NewReader(s.newArchive(c, false)))
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+
+	var result params.ImportModelResult
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ImportModelResult{
+		ModelTag:  testing.ModelTag.String(),
+		Name:      "foo",
+		Qualifier: "prod",
+	})
+}
+
+func (s *modelImportHandlerSuite) TestServePostLoadErrorRemovesModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "POST")
+
+	modelUUID := coremodel.UUID(testing.ModelTag.Id())
+
+	s.servicesGetter.EXPECT().ModelImportService(gomock.Any()).Return(s.modelImportService, nil)
+	s.servicesGetter.EXPECT().Importer(gomock.Any()).Return(coreuser.UUID("importer-uuid"), nil)
+	s.modelImportService.EXPECT().ImportModel(gomock.Any(), gomock.Any()).Return(nil)
+	s.servicesGetter.EXPECT().ImportService(gomock.Any(), modelUUID).Return(s.importService, nil)
+	s.importService.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	s.servicesGetter.EXPECT().RemovalService(gomock.Any(), modelUUID).Return(s.removalService, nil)
+	s.removalService.EXPECT().RemoveMigratingModel(gomock.Any(), modelUUID).Return(nil)
+
+	resp, err := http.Post(s.url(), "application/gzip", bytes.NewReader(s.newArchive(c, false)))
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusInternalServerError)
+	c.Check(s.readError(c, resp), tc.Equals, "cannot import model: loading model data: boom")
+}
+
+func (s *modelImportHandlerSuite) TestServePostControllerModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "POST")
+
+	resp, err := http.Post(s.url(), "application/gzip", bytes.NewReader(s.newArchive(c, true)))
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+	c.Check(s.readError(c, resp), tc.Equals, "cannot import model: model archive holds a controller model")
+}
+
+func (s *modelImportHandlerSuite) TestServePostInvalidArchive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandler(c, "POST")
+
+	resp, err := http.Post(s.url(), "application/gzip", bytes.NewReader([]byte("not an archive")))
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
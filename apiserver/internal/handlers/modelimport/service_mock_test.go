@@ -0,0 +1,624 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/handlers/modelimport (interfaces: ServicesGetter,ModelImportService,ImportService,ModelMigrationService,RemovalService,ObjectStore)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package modelimport -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/modelimport ServicesGetter,ModelImportService,ImportService,ModelMigrationService,RemovalService,ObjectStore
+//
+
+// Package modelimport is a generated GoMock package.
+package modelimport
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+	reflect "reflect"
+
+	model "github.com/juju/juju/core/model"
+	objectstore "github.com/juju/juju/core/objectstore"
+	user "github.com/juju/juju/core/user"
+	model0 "github.com/juju/juju/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServicesGetter is a mock of ServicesGetter interface.
+type MockServicesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServicesGetterMockRecorder
+}
+
+// MockServicesGetterMockRecorder is the mock recorder for MockServicesGetter.
+type MockServicesGetterMockRecorder struct {
+	mock *MockServicesGetter
+}
+
+// NewMockServicesGetter creates a new mock instance.
+func NewMockServicesGetter(ctrl *gomock.Controller) *MockServicesGetter {
+	mock := &MockServicesGetter{ctrl: ctrl}
+	mock.recorder = &MockServicesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServicesGetter) EXPECT() *MockServicesGetterMockRecorder {
+	return m.recorder
+}
+
+// ImportService mocks base method.
+func (m *MockServicesGetter) ImportService(arg0 context.Context, arg1 model.UUID) (ImportService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportService", arg0, arg1)
+	ret0, _ := ret[0].(ImportService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportService indicates an expected call of ImportService.
+func (mr *MockServicesGetterMockRecorder) ImportService(arg0, arg1 any) *MockServicesGetterImportServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportService", reflect.TypeOf((*MockServicesGetter)(nil).ImportService), arg0, arg1)
+	return &MockServicesGetterImportServiceCall{Call: call}
+}
+
+// MockServicesGetterImportServiceCall wrap *gomock.Call
+type MockServicesGetterImportServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterImportServiceCall) Return(arg0 ImportService, arg1 error) *MockServicesGetterImportServiceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterImportServiceCall) Do(f func(context.Context, model.UUID) (ImportService, error)) *MockServicesGetterImportServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterImportServiceCall) DoAndReturn(f func(context.Context, model.UUID) (ImportService, error)) *MockServicesGetterImportServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Importer mocks base method.
+func (m *MockServicesGetter) Importer(arg0 *http.Request) (user.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Importer", arg0)
+	ret0, _ := ret[0].(user.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Importer indicates an expected call of Importer.
+func (mr *MockServicesGetterMockRecorder) Importer(arg0 any) *MockServicesGetterImporterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Importer", reflect.TypeOf((*MockServicesGetter)(nil).Importer), arg0)
+	return &MockServicesGetterImporterCall{Call: call}
+}
+
+// MockServicesGetterImporterCall wrap *gomock.Call
+type MockServicesGetterImporterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterImporterCall) Return(arg0 user.UUID, arg1 error) *MockServicesGetterImporterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterImporterCall) Do(f func(*http.Request) (user.UUID, error)) *MockServicesGetterImporterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterImporterCall) DoAndReturn(f func(*http.Request) (user.UUID, error)) *MockServicesGetterImporterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ModelImportService mocks base method.
+func (m *MockServicesGetter) ModelImportService(arg0 *http.Request) (ModelImportService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelImportService", arg0)
+	ret0, _ := ret[0].(ModelImportService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelImportService indicates an expected call of ModelImportService.
+func (mr *MockServicesGetterMockRecorder) ModelImportService(arg0 any) *MockServicesGetterModelImportServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelImportService", reflect.TypeOf((*MockServicesGetter)(nil).ModelImportService), arg0)
+	return &MockServicesGetterModelImportServiceCall{Call: call}
+}
+
+// MockServicesGetterModelImportServiceCall wrap *gomock.Call
+type MockServicesGetterModelImportServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterModelImportServiceCall) Return(arg0 ModelImportService, arg1 error) *MockServicesGetterModelImportServiceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterModelImportServiceCall) Do(f func(*http.Request) (ModelImportService, error)) *MockServicesGetterModelImportServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterModelImportServiceCall) DoAndReturn(f func(*http.Request) (ModelImportService, error)) *MockServicesGetterModelImportServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ModelMigrationService mocks base method.
+func (m *MockServicesGetter) ModelMigrationService(arg0 context.Context, arg1 model.UUID) (ModelMigrationService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelMigrationService", arg0, arg1)
+	ret0, _ := ret[0].(ModelMigrationService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelMigrationService indicates an expected call of ModelMigrationService.
+func (mr *MockServicesGetterMockRecorder) ModelMigrationService(arg0, arg1 any) *MockServicesGetterModelMigrationServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelMigrationService", reflect.TypeOf((*MockServicesGetter)(nil).ModelMigrationService), arg0, arg1)
+	return &MockServicesGetterModelMigrationServiceCall{Call: call}
+}
+
+// MockServicesGetterModelMigrationServiceCall wrap *gomock.Call
+type MockServicesGetterModelMigrationServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterModelMigrationServiceCall) Return(arg0 ModelMigrationService, arg1 error) *MockServicesGetterModelMigrationServiceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterModelMigrationServiceCall) Do(f func(context.Context, model.UUID) (ModelMigrationService, error)) *MockServicesGetterModelMigrationServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterModelMigrationServiceCall) DoAndReturn(f func(context.Context, model.UUID) (ModelMigrationService, error)) *MockServicesGetterModelMigrationServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ObjectStore mocks base method.
+func (m *MockServicesGetter) ObjectStore(arg0 context.Context, arg1 model.UUID) (ObjectStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStore", arg0, arg1)
+	ret0, _ := ret[0].(ObjectStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectStore indicates an expected call of ObjectStore.
+func (mr *MockServicesGetterMockRecorder) ObjectStore(arg0, arg1 any) *MockServicesGetterObjectStoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockServicesGetter)(nil).ObjectStore), arg0, arg1)
+	return &MockServicesGetterObjectStoreCall{Call: call}
+}
+
+// MockServicesGetterObjectStoreCall wrap *gomock.Call
+type MockServicesGetterObjectStoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterObjectStoreCall) Return(arg0 ObjectStore, arg1 error) *MockServicesGetterObjectStoreCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterObjectStoreCall) Do(f func(context.Context, model.UUID) (ObjectStore, error)) *MockServicesGetterObjectStoreCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterObjectStoreCall) DoAndReturn(f func(context.Context, model.UUID) (ObjectStore, error)) *MockServicesGetterObjectStoreCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemovalService mocks base method.
+func (m *MockServicesGetter) RemovalService(arg0 context.Context, arg1 model.UUID) (RemovalService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovalService", arg0, arg1)
+	ret0, _ := ret[0].(RemovalService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovalService indicates an expected call of RemovalService.
+func (mr *MockServicesGetterMockRecorder) RemovalService(arg0, arg1 any) *MockServicesGetterRemovalServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovalService", reflect.TypeOf((*MockServicesGetter)(nil).RemovalService), arg0, arg1)
+	return &MockServicesGetterRemovalServiceCall{Call: call}
+}
+
+// MockServicesGetterRemovalServiceCall wrap *gomock.Call
+type MockServicesGetterRemovalServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterRemovalServiceCall) Return(arg0 RemovalService, arg1 error) *MockServicesGetterRemovalServiceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterRemovalServiceCall) Do(f func(context.Context, model.UUID) (RemovalService, error)) *MockServicesGetterRemovalServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterRemovalServiceCall) DoAndReturn(f func(context.Context, model.UUID) (RemovalService, error)) *MockServicesGetterRemovalServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelImportService is a mock of ModelImportService interface.
+type MockModelImportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelImportServiceMockRecorder
+}
+
+// MockModelImportServiceMockRecorder is the mock recorder for MockModelImportService.
+type MockModelImportServiceMockRecorder struct {
+	mock *MockModelImportService
+}
+
+// NewMockModelImportService creates a new mock instance.
+func NewMockModelImportService(ctrl *gomock.Controller) *MockModelImportService {
+	mock := &MockModelImportService{ctrl: ctrl}
+	mock.recorder = &MockModelImportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelImportService) EXPECT() *MockModelImportServiceMockRecorder {
+	return m.recorder
+}
+
+// ActivateModel mocks base method.
+func (m *MockModelImportService) ActivateModel(arg0 context.Context, arg1 model.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateModel indicates an expected call of ActivateModel.
+func (mr *MockModelImportServiceMockRecorder) ActivateModel(arg0, arg1 any) *MockModelImportServiceActivateModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateModel", reflect.TypeOf((*MockModelImportService)(nil).ActivateModel), arg0, arg1)
+	return &MockModelImportServiceActivateModelCall{Call: call}
+}
+
+// MockModelImportServiceActivateModelCall wrap *gomock.Call
+type MockModelImportServiceActivateModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelImportServiceActivateModelCall) Return(arg0 error) *MockModelImportServiceActivateModelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelImportServiceActivateModelCall) Do(f func(context.Context, model.UUID) error) *MockModelImportServiceActivateModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelImportServiceActivateModelCall) DoAndReturn(f func(context.Context, model.UUID) error) *MockModelImportServiceActivateModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportModel mocks base method.
+func (m *MockModelImportService) ImportModel(arg0 context.Context, arg1 model0.ModelImportArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportModel indicates an expected call of ImportModel.
+func (mr *MockModelImportServiceMockRecorder) ImportModel(arg0, arg1 any) *MockModelImportServiceImportModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportModel", reflect.TypeOf((*MockModelImportService)(nil).ImportModel), arg0, arg1)
+	return &MockModelImportServiceImportModelCall{Call: call}
+}
+
+// MockModelImportServiceImportModelCall wrap *gomock.Call
+type MockModelImportServiceImportModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelImportServiceImportModelCall) Return(arg0 error) *MockModelImportServiceImportModelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelImportServiceImportModelCall) Do(f func(context.Context, model0.ModelImportArgs) error) *MockModelImportServiceImportModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelImportServiceImportModelCall) DoAndReturn(f func(context.Context, model0.ModelImportArgs) error) *MockModelImportServiceImportModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockImportService is a mock of ImportService interface.
+type MockImportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportServiceMockRecorder
+}
+
+// MockImportServiceMockRecorder is the mock recorder for MockImportService.
+type MockImportServiceMockRecorder struct {
+	mock *MockImportService
+}
+
+// NewMockImportService creates a new mock instance.
+func NewMockImportService(ctrl *gomock.Controller) *MockImportService {
+	mock := &MockImportService{ctrl: ctrl}
+	mock.recorder = &MockImportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportService) EXPECT() *MockImportServiceMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method.
+func (m *MockImportService) Import(arg0 context.Context, arg1 []byte, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockImportServiceMockRecorder) Import(arg0, arg1, arg2 any) *MockImportServiceImportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockImportService)(nil).Import), arg0, arg1, arg2)
+	return &MockImportServiceImportCall{Call: call}
+}
+
+// MockImportServiceImportCall wrap *gomock.Call
+type MockImportServiceImportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImportServiceImportCall) Return(arg0 error) *MockImportServiceImportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImportServiceImportCall) Do(f func(context.Context, []byte, string) error) *MockImportServiceImportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImportServiceImportCall) DoAndReturn(f func(context.Context, []byte, string) error) *MockImportServiceImportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelMigrationService is a mock of ModelMigrationService interface.
+type MockModelMigrationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelMigrationServiceMockRecorder
+}
+
+// MockModelMigrationServiceMockRecorder is the mock recorder for MockModelMigrationService.
+type MockModelMigrationServiceMockRecorder struct {
+	mock *MockModelMigrationService
+}
+
+// NewMockModelMigrationService creates a new mock instance.
+func NewMockModelMigrationService(ctrl *gomock.Controller) *MockModelMigrationService {
+	mock := &MockModelMigrationService{ctrl: ctrl}
+	mock.recorder = &MockModelMigrationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelMigrationService) EXPECT() *MockModelMigrationServiceMockRecorder {
+	return m.recorder
+}
+
+// ActivateImport mocks base method.
+func (m *MockModelMigrationService) ActivateImport(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateImport", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateImport indicates an expected call of ActivateImport.
+func (mr *MockModelMigrationServiceMockRecorder) ActivateImport(arg0 any) *MockModelMigrationServiceActivateImportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateImport", reflect.TypeOf((*MockModelMigrationService)(nil).ActivateImport), arg0)
+	return &MockModelMigrationServiceActivateImportCall{Call: call}
+}
+
+// MockModelMigrationServiceActivateImportCall wrap *gomock.Call
+type MockModelMigrationServiceActivateImportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelMigrationServiceActivateImportCall) Return(arg0 error) *MockModelMigrationServiceActivateImportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelMigrationServiceActivateImportCall) Do(f func(context.Context) error) *MockModelMigrationServiceActivateImportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelMigrationServiceActivateImportCall) DoAndReturn(f func(context.Context) error) *MockModelMigrationServiceActivateImportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRemovalService is a mock of RemovalService interface.
+type MockRemovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovalServiceMockRecorder
+}
+
+// MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
+type MockRemovalServiceMockRecorder struct {
+	mock *MockRemovalService
+}
+
+// NewMockRemovalService creates a new mock instance.
+func NewMockRemovalService(ctrl *gomock.Controller) *MockRemovalService {
+	mock := &MockRemovalService{ctrl: ctrl}
+	mock.recorder = &MockRemovalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
+	return m.recorder
+}
+
+// RemoveMigratingModel mocks base method.
+func (m *MockRemovalService) RemoveMigratingModel(arg0 context.Context, arg1 model.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMigratingModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMigratingModel indicates an expected call of RemoveMigratingModel.
+func (mr *MockRemovalServiceMockRecorder) RemoveMigratingModel(arg0, arg1 any) *MockRemovalServiceRemoveMigratingModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMigratingModel", reflect.TypeOf((*MockRemovalService)(nil).RemoveMigratingModel), arg0, arg1)
+	return &MockRemovalServiceRemoveMigratingModelCall{Call: call}
+}
+
+// MockRemovalServiceRemoveMigratingModelCall wrap *gomock.Call
+type MockRemovalServiceRemoveMigratingModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceRemoveMigratingModelCall) Return(arg0 error) *MockRemovalServiceRemoveMigratingModelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceRemoveMigratingModelCall) Do(f func(context.Context, model.UUID) error) *MockRemovalServiceRemoveMigratingModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceRemoveMigratingModelCall) DoAndReturn(f func(context.Context, model.UUID) error) *MockRemovalServiceRemoveMigratingModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockObjectStore is a mock of ObjectStore interface.
+type MockObjectStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStoreMockRecorder
+}
+
+// MockObjectStoreMockRecorder is the mock recorder for MockObjectStore.
+type MockObjectStoreMockRecorder struct {
+	mock *MockObjectStore
+}
+
+// NewMockObjectStore creates a new mock instance.
+func NewMockObjectStore(ctrl *gomock.Controller) *MockObjectStore {
+	mock := &MockObjectStore{ctrl: ctrl}
+	mock.recorder = &MockObjectStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStore) EXPECT() *MockObjectStoreMockRecorder {
+	return m.recorder
+}
+
+// PutAndCheckHash mocks base method.
+func (m *MockObjectStore) PutAndCheckHash(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 int64, arg4 string) (objectstore.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAndCheckHash", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(objectstore.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAndCheckHash indicates an expected call of PutAndCheckHash.
+func (mr *MockObjectStoreMockRecorder) PutAndCheckHash(arg0, arg1, arg2, arg3, arg4 any) *MockObjectStorePutAndCheckHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAndCheckHash", reflect.TypeOf((*MockObjectStore)(nil).PutAndCheckHash), arg0, arg1, arg2, arg3, arg4)
+	return &MockObjectStorePutAndCheckHashCall{Call: call}
+}
+
+// MockObjectStorePutAndCheckHashCall wrap *gomock.Call
+type MockObjectStorePutAndCheckHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStorePutAndCheckHashCall) Return(arg0 objectstore.UUID, arg1 error) *MockObjectStorePutAndCheckHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStorePutAndCheckHashCall) Do(f func(context.Context, string, io.Reader, int64, string) (objectstore.UUID, error)) *MockObjectStorePutAndCheckHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStorePutAndCheckHashCall) DoAndReturn(f func(context.Context, string, io.Reader, int64, string) (objectstore.UUID, error)) *MockObjectStorePutAndCheckHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
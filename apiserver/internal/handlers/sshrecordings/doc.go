@@ -0,0 +1,6 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshrecordings provides the handler for listing and downloading the
+// recordings of SSH sessions proxied by the controller.
+package sshrecordings
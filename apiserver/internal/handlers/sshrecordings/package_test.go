@@ -0,0 +1,6 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshrecordings
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshrecordings -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/sshrecordings ServicesGetter,RecordingService
@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/handlers/sshrecordings (interfaces: ServicesGetter,RecordingService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package sshrecordings -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/sshrecordings ServicesGetter,RecordingService
+//
+
+// Package sshrecordings is a generated GoMock package.
+package sshrecordings
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+	reflect "reflect"
+
+	sshrecording "github.com/juju/juju/domain/sshrecording"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServicesGetter is a mock of ServicesGetter interface.
+type MockServicesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServicesGetterMockRecorder
+}
+
+// MockServicesGetterMockRecorder is the mock recorder for MockServicesGetter.
+type MockServicesGetterMockRecorder struct {
+	mock *MockServicesGetter
+}
+
+// NewMockServicesGetter creates a new mock instance.
+func NewMockServicesGetter(ctrl *gomock.Controller) *MockServicesGetter {
+	mock := &MockServicesGetter{ctrl: ctrl}
+	mock.recorder = &MockServicesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServicesGetter) EXPECT() *MockServicesGetterMockRecorder {
+	return m.recorder
+}
+
+// RecordingService mocks base method.
+func (m *MockServicesGetter) RecordingService(arg0 *http.Request) (RecordingService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordingService", arg0)
+	ret0, _ := ret[0].(RecordingService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordingService indicates an expected call of RecordingService.
+func (mr *MockServicesGetterMockRecorder) RecordingService(arg0 any) *MockServicesGetterRecordingServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordingService", reflect.TypeOf((*MockServicesGetter)(nil).RecordingService), arg0)
+	return &MockServicesGetterRecordingServiceCall{Call: call}
+}
+
+// MockServicesGetterRecordingServiceCall wrap *gomock.Call
+type MockServicesGetterRecordingServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicesGetterRecordingServiceCall) Return(arg0 RecordingService, arg1 error) *MockServicesGetterRecordingServiceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicesGetterRecordingServiceCall) Do(f func(*http.Request) (RecordingService, error)) *MockServicesGetterRecordingServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicesGetterRecordingServiceCall) DoAndReturn(f func(*http.Request) (RecordingService, error)) *MockServicesGetterRecordingServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRecordingService is a mock of RecordingService interface.
+type MockRecordingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordingServiceMockRecorder
+}
+
+// MockRecordingServiceMockRecorder is the mock recorder for MockRecordingService.
+type MockRecordingServiceMockRecorder struct {
+	mock *MockRecordingService
+}
+
+// NewMockRecordingService creates a new mock instance.
+func NewMockRecordingService(ctrl *gomock.Controller) *MockRecordingService {
+	mock := &MockRecordingService{ctrl: ctrl}
+	mock.recorder = &MockRecordingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordingService) EXPECT() *MockRecordingServiceMockRecorder {
+	return m.recorder
+}
+
+// ListRecordings mocks base method.
+func (m *MockRecordingService) ListRecordings(arg0 context.Context, arg1 string) ([]sshrecording.Recording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecordings", arg0, arg1)
+	ret0, _ := ret[0].([]sshrecording.Recording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecordings indicates an expected call of ListRecordings.
+func (mr *MockRecordingServiceMockRecorder) ListRecordings(arg0, arg1 any) *MockRecordingServiceListRecordingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecordings", reflect.TypeOf((*MockRecordingService)(nil).ListRecordings), arg0, arg1)
+	return &MockRecordingServiceListRecordingsCall{Call: call}
+}
+
+// MockRecordingServiceListRecordingsCall wrap *gomock.Call
+type MockRecordingServiceListRecordingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecordingServiceListRecordingsCall) Return(arg0 []sshrecording.Recording, arg1 error) *MockRecordingServiceListRecordingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecordingServiceListRecordingsCall) Do(f func(context.Context, string) ([]sshrecording.Recording, error)) *MockRecordingServiceListRecordingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecordingServiceListRecordingsCall) DoAndReturn(f func(context.Context, string) ([]sshrecording.Recording, error)) *MockRecordingServiceListRecordingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OpenRecording mocks base method.
+func (m *MockRecordingService) OpenRecording(arg0 context.Context, arg1 string) (io.ReadCloser, sshrecording.Recording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenRecording", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(sshrecording.Recording)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenRecording indicates an expected call of OpenRecording.
+func (mr *MockRecordingServiceMockRecorder) OpenRecording(arg0, arg1 any) *MockRecordingServiceOpenRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenRecording", reflect.TypeOf((*MockRecordingService)(nil).OpenRecording), arg0, arg1)
+	return &MockRecordingServiceOpenRecordingCall{Call: call}
+}
+
+// MockRecordingServiceOpenRecordingCall wrap *gomock.Call
+type MockRecordingServiceOpenRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecordingServiceOpenRecordingCall) Return(arg0 io.ReadCloser, arg1 sshrecording.Recording, arg2 error) *MockRecordingServiceOpenRecordingCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecordingServiceOpenRecordingCall) Do(f func(context.Context, string) (io.ReadCloser, sshrecording.Recording, error)) *MockRecordingServiceOpenRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecordingServiceOpenRecordingCall) DoAndReturn(f func(context.Context, string) (io.ReadCloser, sshrecording.Recording, error)) *MockRecordingServiceOpenRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshrecordings
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/sshrecording"
+	sshrecordingerrors "github.com/juju/juju/domain/sshrecording/errors"
+	"github.com/juju/juju/internal/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/rpc/params"
+)
+
+var logger = internallogger.GetLogger("juju.apiserver.sshrecordings")
+
+// ContentTypeAsciicast is the content type of a recording.
+const ContentTypeAsciicast = "application/x-asciicast"
+
+// RecordingService provides access to the recorded SSH sessions.
+type RecordingService interface {
+	// ListRecordings returns the recordings of the given user, or of every
+	// user if the user is empty.
+	ListRecordings(ctx context.Context, user string) ([]sshrecording.Recording, error)
+
+	// OpenRecording returns the content of the recording with the given
+	// UUID, which the caller must close.
+	OpenRecording(ctx context.Context, uuid string) (io.ReadCloser, sshrecording.Recording, error)
+}
+
+// ServicesGetter returns the services needed to serve the recordings.
+type ServicesGetter interface {
+	// RecordingService returns the SSH session recording service.
+	RecordingService(*http.Request) (RecordingService, error)
+}
+
+// SSHRecordingsHandler implements the http.Handler interface for listing
+// and downloading SSH session recordings.
+type SSHRecordingsHandler struct {
+	servicesGetter ServicesGetter
+}
+
+// NewSSHRecordingsHandler returns a new SSHRecordingsHandler.
+func NewSSHRecordingsHandler(servicesGetter ServicesGetter) *SSHRecordingsHandler {
+	return &SSHRecordingsHandler{
+		servicesGetter: servicesGetter,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *SSHRecordingsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		var err error
+		if uuid := r.URL.Query().Get(":uuid"); uuid != "" {
+			err = h.serveRecording(w, r, uuid)
+		} else {
+			err = h.serveList(w, r)
+		}
+		if err != nil {
+			if err := internalhttp.SendError(w, errors.Errorf("cannot get ssh recordings: %w", err), logger); err != nil {
+				logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+			}
+		}
+	default:
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+	}
+}
+
+// serveList sends the recordings, optionally filtered by the user query
+// parameter.
+func (h *SSHRecordingsHandler) serveList(w http.ResponseWriter, r *http.Request) error {
+	service, err := h.servicesGetter.RecordingService(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	recordings, err := service.ListRecordings(r.Context(), r.URL.Query().Get("user"))
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	result := params.SSHRecordingsResult{
+		Recordings: make([]params.SSHRecording, len(recordings)),
+	}
+	for i, rec := range recordings {
+		result.Recordings[i] = params.SSHRecording{
+			UUID:      rec.UUID,
+			User:      rec.User,
+			Target:    rec.Target,
+			Size:      rec.Size,
+			StartedAt: rec.StartedAt,
+			EndedAt:   rec.EndedAt,
+		}
+	}
+	return internalhttp.SendStatusAndJSON(w, http.StatusOK, result)
+}
+
+// serveRecording streams the content of a single recording.
+func (h *SSHRecordingsHandler) serveRecording(w http.ResponseWriter, r *http.Request, uuid string) error {
+	service, err := h.servicesGetter.RecordingService(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	reader, rec, err := service.OpenRecording(r.Context(), uuid)
+	if errors.Is(err, sshrecordingerrors.RecordingNotFound) {
+		return errors.Errorf("recording %q %w", uuid, coreerrors.NotFound)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	defer reader.Close()
+
+	w.Header().Set("Content-Type", ContentTypeAsciicast)
+	w.Header().Set("Content-Length", fmt.Sprint(rec.Size))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", rec.UUID+".cast"))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, reader); err != nil {
+		// The response has started, so the error can only be logged.
+		logger.Errorf(r.Context(), "sending recording %q: %v", uuid, err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshrecordings
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/domain/sshrecording"
+	sshrecordingerrors "github.com/juju/juju/domain/sshrecording/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	listRoute      = "/ssh-recordings"
+	recordingRoute = "/ssh-recordings/:uuid"
+)
+
+type sshRecordingsHandlerSuite struct {
+	servicesGetter   *MockServicesGetter
+	recordingService *MockRecordingService
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestSSHRecordingsHandlerSuite(t *stdtesting.T) {
+	tc.Run(t, &sshRecordingsHandlerSuite{})
+}
+
+func (s *sshRecordingsHandlerSuite) SetUpTest(c *tc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *sshRecordingsHandlerSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *sshRecordingsHandlerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.servicesGetter = NewMockServicesGetter(ctrl)
+	s.recordingService = NewMockRecordingService(ctrl)
+	s.servicesGetter.EXPECT().RecordingService(gomock.Any()).Return(s.recordingService, nil).AnyTimes()
+
+	c.Cleanup(func() {
+		s.servicesGetter = nil
+		s.recordingService = nil
+	})
+
+	return ctrl
+}
+
+func (s *sshRecordingsHandlerSuite) addHandlers(c *tc.C, method string) {
+	handler := NewSSHRecordingsHandler(s.servicesGetter)
+	s.mux.AddHandler(method, listRoute, handler)
+	s.mux.AddHandler(method, recordingRoute, handler)
+	c.Cleanup(func() {
+		s.mux.RemoveHandler(method, listRoute)
+		s.mux.RemoveHandler(method, recordingRoute)
+	})
+}
+
+func (s *sshRecordingsHandlerSuite) TestServeMethodNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandlers(c, "POST")
+
+	resp, err := http.Post(s.srv.URL+"/ssh-recordings", "application/octet-stream", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotImplemented)
+}
+
+func (s *sshRecordingsHandlerSuite) TestServeList(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandlers(c, "GET")
+
+	started := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	s.recordingService.EXPECT().ListRecordings(gomock.Any(), "bob").Return([]sshrecording.Recording{{
+		UUID:      "deadbeef",
+		User:      "bob",
+		Target:    "0.machine.juju.local",
+		Size:      42,
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+	}}, nil)
+
+	resp, err := http.Get(s.srv.URL + "/ssh-recordings?user=bob")
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+
+	var result params.SSHRecordingsResult
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.SSHRecordingsResult{
+		Recordings: []params.SSHRecording{{
+			UUID:      "deadbeef",
+			User:      "bob",
+			Target:    "0.machine.juju.local",
+			Size:      42,
+			StartedAt: started,
+			EndedAt:   started.Add(time.Minute),
+		}},
+	})
+}
+
+func (s *sshRecordingsHandlerSuite) TestServeRecording(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandlers(c, "GET")
+
+	content := `{"version":2,"width":80,"height":24}` + "\n"
+	s.recordingService.EXPECT().OpenRecording(gomock.Any(), "deadbeef").Return(
+		io.NopCloser(strings.NewReader(content)),
+		sshrecording.Recording{UUID: "deadbeef", Size: int64(len(content))},
+		nil,
+	)
+
+	resp, err := http.Get(s.srv.URL + "/ssh-recordings/deadbeef")
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), tc.Equals, ContentTypeAsciicast)
+	c.Check(resp.Header.Get("Content-Disposition"), tc.Equals, `attachment; filename="deadbeef.cast"`)
+
+	body, err := io.ReadAll(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(body), tc.Equals, content)
+}
+
+func (s *sshRecordingsHandlerSuite) TestServeRecordingNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.addHandlers(c, "GET")
+
+	s.recordingService.EXPECT().OpenRecording(gomock.Any(), "deadbeef").Return(
+		nil, sshrecording.Recording{}, sshrecordingerrors.RecordingNotFound,
+	)
+
+	resp, err := http.Get(s.srv.URL + "/ssh-recordings/deadbeef")
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotFound)
+
+	var result params.ErrorResult
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error.Message, tc.Equals, `cannot get ssh recordings: recording "deadbeef" not found`)
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/ratelimit"
+	"github.com/prometheus/client_golang/prometheus"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc"
+)
+
+// maxAPICallBuckets is the number of token buckets held by the API call rate
+// limiter before full buckets are discarded. A full bucket is
+// indistinguishable from a new one, so discarding it doesn't change the
+// rate limiting applied.
+const maxAPICallBuckets = 10000
+
+// apiCallKey identifies the token bucket used for an API call.
+type apiCallKey struct {
+	user   string
+	facade string
+	method string
+}
+
+// apiCallRateLimiter rate limits the API calls made by users, with a token
+// bucket for each user, facade and method, so that one user repeatedly
+// making an expensive call doesn't degrade the controller for everyone
+// else. The same limits apply to every user, facade and method.
+type apiCallRateLimiter struct {
+	clock   clock.Clock
+	limited *prometheus.CounterVec
+
+	mu      sync.Mutex
+	max     int
+	rate    time.Duration
+	buckets map[apiCallKey]*apiCallBucket
+}
+
+// apiCallBucket is a token bucket along with the time it was created, which
+// is when its first tick starts.
+type apiCallBucket struct {
+	*ratelimit.Bucket
+	start time.Time
+}
+
+// nextTokenIn returns how long it is until the next token is added to the
+// bucket. The bucket adds a token at the end of each tick of its fill
+// interval, counted from when it was created.
+func (b *apiCallBucket) nextTokenIn(now time.Time, rate time.Duration) time.Duration {
+	elapsed := now.Sub(b.start)
+	return rate - elapsed%rate
+}
+
+// newAPICallRateLimiter returns a new API call rate limiter. Calls which are
+// rejected are counted by the limited counter, labelled by facade and
+// method. The limiter is disabled until it is configured with a max greater
+// than zero.
+func newAPICallRateLimiter(clock clock.Clock, limited *prometheus.CounterVec) *apiCallRateLimiter {
+	return &apiCallRateLimiter{
+		clock:   clock,
+		limited: limited,
+		buckets: make(map[apiCallKey]*apiCallBucket),
+	}
+}
+
+// configure sets the size of each token bucket, and the rate at which
+// tokens are added to it. A max of zero disables rate limiting. Changing
+// the configuration discards all existing buckets.
+func (l *apiCallRateLimiter) configure(max int, rate time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if max == l.max && rate == l.rate {
+		return
+	}
+	l.max = max
+	l.rate = rate
+	l.buckets = make(map[apiCallKey]*apiCallBucket)
+}
+
+// config returns the current size of each token bucket, and the rate at
+// which tokens are added to it.
+func (l *apiCallRateLimiter) config() (int, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.max, l.rate
+}
+
+// take takes a token from the bucket for the specified user, facade and
+// method. If there are no tokens available, a RateLimitExceededError is
+// returned, telling the client how long it is until a token is added to
+// the bucket.
+func (l *apiCallRateLimiter) take(user, facade, method string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.max <= 0 {
+		return nil
+	}
+
+	key := apiCallKey{user: user, facade: facade, method: method}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxAPICallBuckets {
+			l.pruneFullBuckets()
+		}
+		bucket = &apiCallBucket{
+			Bucket: ratelimit.NewBucketWithClock(l.rate, int64(l.max), rateClock{Clock: l.clock}),
+			start:  l.clock.Now(),
+		}
+		l.buckets[key] = bucket
+	}
+
+	// Try to take one token, but don't wait any time for it.
+	if _, ok := bucket.TakeMaxDuration(1, 0); ok {
+		return nil
+	}
+	if l.limited != nil {
+		l.limited.WithLabelValues(facade, method).Inc()
+	}
+	return &apiservererrors.RateLimitExceededError{
+		RetryAfter: bucket.nextTokenIn(l.clock.Now(), l.rate),
+	}
+}
+
+// pruneFullBuckets discards the buckets which have all their tokens
+// available. It must be called with the mutex held.
+func (l *apiCallRateLimiter) pruneFullBuckets() {
+	for key, bucket := range l.buckets {
+		if bucket.Available() >= bucket.Capacity() {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// notRateLimitedFacades are the facades whose calls are never rate limited,
+// as they are cheap and needed to keep the connection alive.
+var notRateLimitedFacades = map[string]bool{
+	"Pinger": true,
+}
+
+// rateLimitUserCalls wraps the API root so that every call made by the
+// specified user takes a token from the limiter.
+func rateLimitUserCalls(root rpc.Root, limiter *apiCallRateLimiter, user string) *restrictedRoot {
+	return restrictRoot(root, func(facadeName, methodName string) error {
+		if notRateLimitedFacades[facadeName] {
+			return nil
+		}
+		return limiter.take(user, facadeName, methodName)
+	})
+}
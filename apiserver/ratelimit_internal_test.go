@@ -0,0 +1,135 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type apiCallRateLimiterSuite struct {
+	testhelpers.IsolationSuite
+
+	clock     *testclock.Clock
+	collector *Collector
+	limiter   *apiCallRateLimiter
+}
+
+func TestAPICallRateLimiterSuite(t *stdtesting.T) {
+	tc.Run(t, &apiCallRateLimiterSuite{})
+}
+
+func (s *apiCallRateLimiterSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testclock.NewClock(time.Now())
+	s.collector = NewMetricsCollector()
+	s.limiter = newAPICallRateLimiter(s.clock, s.collector.RateLimitedRequests)
+}
+
+func (s *apiCallRateLimiterSuite) TestDisabledByDefault(c *tc.C) {
+	for range 100 {
+		c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	}
+	max, rate := s.limiter.config()
+	c.Check(max, tc.Equals, 0)
+	c.Check(rate, tc.Equals, time.Duration(0))
+}
+
+func (s *apiCallRateLimiterSuite) TestLimitsCalls(c *tc.C) {
+	s.limiter.configure(2, time.Second)
+
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+
+	err := s.limiter.take("fred", "Client", "FullStatus")
+	c.Assert(err, tc.DeepEquals, &apiservererrors.RateLimitExceededError{RetryAfter: time.Second})
+	c.Check(testutil.ToFloat64(s.collector.RateLimitedRequests.WithLabelValues("Client", "FullStatus")), tc.Equals, 1.0)
+
+	// Tokens are added back at the configured rate.
+	s.clock.Advance(time.Second)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.NotNil)
+}
+
+func (s *apiCallRateLimiterSuite) TestRetryAfterNextToken(c *tc.C) {
+	s.limiter.configure(1, 10*time.Second)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+
+	// The client is told to retry when the next token is added, not after
+	// a whole fill interval.
+	s.clock.Advance(7 * time.Second)
+	err := s.limiter.take("fred", "Client", "FullStatus")
+	c.Assert(err, tc.DeepEquals, &apiservererrors.RateLimitExceededError{RetryAfter: 3 * time.Second})
+
+	s.clock.Advance(3 * time.Second)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+
+	s.clock.Advance(time.Second)
+	err = s.limiter.take("fred", "Client", "FullStatus")
+	c.Assert(err, tc.DeepEquals, &apiservererrors.RateLimitExceededError{RetryAfter: 9 * time.Second})
+}
+
+func (s *apiCallRateLimiterSuite) TestLimitsPerUserAndMethod(c *tc.C) {
+	s.limiter.configure(1, time.Second)
+
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.NotNil)
+
+	c.Check(s.limiter.take("mary", "Client", "FullStatus"), tc.ErrorIsNil)
+	c.Check(s.limiter.take("fred", "Client", "WatchAll"), tc.ErrorIsNil)
+	c.Check(s.limiter.take("fred", "Application", "FullStatus"), tc.ErrorIsNil)
+}
+
+func (s *apiCallRateLimiterSuite) TestConfigureResetsBuckets(c *tc.C) {
+	s.limiter.configure(1, time.Second)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.NotNil)
+
+	s.limiter.configure(2, time.Minute)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	err := s.limiter.take("fred", "Client", "FullStatus")
+	c.Assert(err, tc.DeepEquals, &apiservererrors.RateLimitExceededError{RetryAfter: time.Minute})
+
+	max, rate := s.limiter.config()
+	c.Check(max, tc.Equals, 2)
+	c.Check(rate, tc.Equals, time.Minute)
+}
+
+func (s *apiCallRateLimiterSuite) TestPruneFullBuckets(c *tc.C) {
+	s.limiter.configure(1, time.Second)
+	c.Assert(s.limiter.take("fred", "Client", "FullStatus"), tc.ErrorIsNil)
+	c.Assert(s.limiter.take("mary", "Client", "FullStatus"), tc.ErrorIsNil)
+
+	s.clock.Advance(time.Second)
+	c.Assert(s.limiter.take("mary", "Client", "FullStatus"), tc.ErrorIsNil)
+
+	s.limiter.mu.Lock()
+	s.limiter.pruneFullBuckets()
+	s.limiter.mu.Unlock()
+
+	c.Check(s.limiter.buckets, tc.HasLen, 1)
+	c.Check(s.limiter.buckets[apiCallKey{user: "mary", facade: "Client", method: "FullStatus"}], tc.NotNil)
+}
+
+func (s *apiCallRateLimiterSuite) TestPingerNotLimited(c *tc.C) {
+	s.limiter.configure(1, time.Second)
+	root := rateLimitUserCalls(nil, s.limiter, "fred")
+
+	for range 3 {
+		err := root.check("Pinger", "Ping")
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	c.Assert(root.check("Client", "FullStatus"), tc.ErrorIsNil)
+	err := root.check("Client", "FullStatus")
+	c.Assert(errors.HasType[*apiservererrors.RateLimitExceededError](err), tc.IsTrue)
+}
@@ -77,6 +77,7 @@ var commonModelFacadeNames = set.NewStrings(
 	"Upgrader",
 	"VolumeAttachmentsWatcher",
 	"RemoteRelationWatcher",
+	"Removals",
 	"SSHClient",
 )
 
@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/modelmigration"
 	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/internal/worker/watcherregistry"
@@ -474,7 +475,8 @@ func newAPIRoot(
 
 // restrictAPIRoot calls restrictAPIRootDuringMaintenance, and
 // then restricts the result further to the controller or model
-// facades, depending on the type of login.
+// facades, depending on the type of login. Calls made by users are
+// rate limited.
 func restrictAPIRoot(
 	srv *Server,
 	apiRoot rpc.Root,
@@ -501,6 +503,9 @@ func restrictAPIRoot(
 			apiRoot = restrictRoot(apiRoot, caasModelFacadesOnly)
 		}
 	}
+	if userTag, ok := auth.tag.(names.UserTag); ok {
+		apiRoot = rateLimitUserCalls(apiRoot, srv.apiCallRateLimit, userTag.Id())
+	}
 	return apiRoot, nil
 }
 
@@ -886,6 +891,7 @@ func (ctx *facadeContext) ModelImporter() facade.ModelImporter {
 			storageService := domainServices.Storage()
 			return storageService.GetStorageRegistry(ctx)
 		}),
+		encryption.NewFileKeyStore(ctx.r.shared.dataDir),
 		ctx.ControllerUUID(),
 		ctx.Logger(),
 		ctx.r.clock,
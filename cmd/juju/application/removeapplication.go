@@ -18,6 +18,7 @@ import (
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/rpc/params"
 )
@@ -233,9 +234,28 @@ func (c *removeApplicationCommand) performDryRun(
 	}
 	ctx.Warningf(removeApplicationMsgPrefix)
 	_ = c.logResults(ctx, results)
+	if !c.Force && planRequiresForce(results, func(r params.DestroyApplicationResult) *params.RemovalPlan {
+		if r.Info == nil {
+			return nil
+		}
+		return r.Info.Plan
+	}) {
+		ctx.Infof("\nThis will require `--force`")
+	}
 	return nil
 }
 
+// planRequiresForce returns true if the removal plan of any result requires
+// force.
+func planRequiresForce[T any](results []T, plan func(T) *params.RemovalPlan) bool {
+	for _, result := range results {
+		if p := plan(result); p != nil && common.RemovalPlanRequiresForce(*p) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *removeApplicationCommand) logErrors(ctx *cmd.Context, results []params.DestroyApplicationResult) error {
 	return c.log(ctx, results, true)
 }
@@ -289,6 +309,10 @@ func (c *removeApplicationCommand) logRemovedApplication(
 	info *params.DestroyApplicationInfo,
 ) {
 	_, _ = fmt.Fprintf(ctx.Stdout, "will remove application %s\n", name)
+	if info.Plan != nil {
+		common.WriteRemovalPlan(ctx.Stdout, *info.Plan, "application", name)
+		return
+	}
 	for _, entity := range info.DestroyedUnits {
 		unitTag, err := names.ParseUnitTag(entity.Tag)
 		if err != nil {
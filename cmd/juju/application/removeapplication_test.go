@@ -136,6 +136,37 @@ will remove application real-app
 `[1:])
 }
 
+func (s *removeApplicationSuite) TestRemoveApplicationDryRunPlan(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.mockApi.EXPECT().DestroyApplications(gomock.Any(), apiapplication.DestroyApplicationsParams{
+		Applications: []string{"real-app"},
+		DryRun:       true,
+	}).Return([]params.DestroyApplicationResult{{
+		Info: &params.DestroyApplicationInfo{
+			Plan: &params.RemovalPlan{
+				Applications: []params.PlannedRemoval{{Name: "real-app"}},
+				Units:        []params.PlannedRemoval{{Name: "real-app/0", ForceRequired: "unit is in an error state"}},
+				Relations:    []params.PlannedRemoval{{Name: "real-app:db other:db"}},
+				Machines:     []params.PlannedRemoval{{Name: "0"}},
+				Offers:       []params.PlannedRemoval{{Name: "real-offer"}},
+			},
+		},
+	}}, nil)
+
+	ctx, err := s.runRemoveApplication(c, "real-app", "--dry-run")
+
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, `
+will remove application real-app
+- will remove unit real-app/0 (requires --force: unit is in an error state)
+- will remove relation real-app:db other:db
+- will remove machine 0
+- will remove offer real-offer
+`[1:])
+	c.Assert(cmdtesting.Stderr(ctx), tc.Contains, "This will require `--force`")
+}
+
 func (s *removeApplicationSuite) TestRemoveApplicationDryRunOldFacade(c *tc.C) {
 	s.facadeVersion = 15
 	defer s.setup(c).Finish()
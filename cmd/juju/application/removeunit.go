@@ -18,6 +18,7 @@ import (
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/rpc/params"
@@ -314,6 +315,14 @@ func (c *removeUnitCommand) performDryRun(ctx *cmd.Context, client RemoveApplica
 	}
 	ctx.Warningf(removeUnitMsgPrefix)
 	_ = c.logResults(ctx, results)
+	if !c.Force && planRequiresForce(results, func(r params.DestroyUnitResult) *params.RemovalPlan {
+		if r.Info == nil {
+			return nil
+		}
+		return r.Info.Plan
+	}) {
+		ctx.Infof("\nThis will require `--force`")
+	}
 	return nil
 }
 
@@ -362,6 +371,10 @@ func (c *removeUnitCommand) logResult(
 
 func (c *removeUnitCommand) logRemovedUnit(ctx *cmd.Context, name string, info *params.DestroyUnitInfo) {
 	_, _ = fmt.Fprintf(ctx.Stdout, "will remove unit %s\n", name)
+	if info.Plan != nil {
+		common.WriteRemovalPlan(ctx.Stdout, *info.Plan, "unit", name)
+		return
+	}
 	for _, entity := range info.DestroyedStorage {
 		storageTag, err := names.ParseStorageTag(entity.Tag)
 		if err != nil {
@@ -6,6 +6,7 @@ package application
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
@@ -24,6 +25,11 @@ const showApplicationDoc = `
 The command takes deployed application names or aliases as an argument.
 
 The command does an exact search. It does not support wildcards.
+
+The --hook-stats option adds, for each hook, action and commands
+run by the units of the application, how many times it ran, how
+often it failed, and how long it took on average and at most.
+These are aggregated over the recent hook history of each unit.
 `
 
 const showApplicationExamples = `
@@ -33,6 +39,10 @@ const showApplicationExamples = `
     juju show-application myapplication
 
 where ` + "`myapplication`" + ` is the application name alias; see ` + "`juju help deploy`" + ` for more information.
+
+To show how long the hooks of an application take and how often they fail:
+
+    juju show-application mysql --hook-stats
 `
 
 // NewShowApplicationCommand returns a command that displays applications info.
@@ -50,6 +60,7 @@ type showApplicationCommand struct {
 
 	out        cmd.Output
 	apps       []string
+	hookStats  bool
 	newAPIFunc func(ctx context.Context) (ApplicationsInfoAPI, error)
 }
 
@@ -91,12 +102,14 @@ func (c *showApplicationCommand) Init(args []string) error {
 func (c *showApplicationCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	c.out.AddFlags(f, "yaml", cmd.DefaultFormatters.Formatters())
+	f.BoolVar(&c.hookStats, "hook-stats", false, "Show statistics aggregated over the hook history of the application's units")
 }
 
 // ApplicationsInfoAPI defines the API methods that show-application command uses.
 type ApplicationsInfoAPI interface {
 	Close() error
 	ApplicationsInfo(context.Context, []names.ApplicationTag) ([]params.ApplicationInfoResult, error)
+	ApplicationsHookStats(context.Context, []string) ([]application.ApplicationHookStats, error)
 }
 
 func (c *showApplicationCommand) newApplicationAPI(ctx context.Context) (ApplicationsInfoAPI, error) {
@@ -141,9 +154,59 @@ func (c *showApplicationCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.hookStats {
+		if err := c.addHookStats(ctx, client, output); err != nil {
+			return err
+		}
+	}
 	return c.out.Write(ctx, output)
 }
 
+func (c *showApplicationCommand) addHookStats(
+	ctx context.Context, client ApplicationsInfoAPI, output map[string]ApplicationInfo,
+) error {
+	results, err := client.ApplicationsHookStats(ctx, c.apps)
+	if errors.Is(err, errors.NotImplemented) {
+		return errors.New("hook stats are not supported by this controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	var errorStrings []string
+	for i, result := range results {
+		if result.Error != nil {
+			errorStrings = append(errorStrings, result.Error.Error())
+			continue
+		}
+		info, ok := output[c.apps[i]]
+		if !ok {
+			continue
+		}
+		info.HookStats = formatHookStats(result.Stats)
+		output[c.apps[i]] = info
+	}
+	if len(errorStrings) > 0 {
+		return errors.New(strings.Join(errorStrings, "\n"))
+	}
+	return nil
+}
+
+func formatHookStats(all []params.HookStats) []HookStats {
+	stats := make([]HookStats, len(all))
+	for i, one := range all {
+		stats[i] = HookStats{
+			Kind:         one.Kind,
+			Name:         one.Name,
+			Count:        one.Count,
+			Failures:     one.Failures,
+			MeanDuration: one.MeanDuration.String(),
+			MaxDuration:  one.MaxDuration.String(),
+			LastRun:      one.LastRun.UTC().Format(time.RFC3339),
+		}
+	}
+	return stats
+}
+
 func (c *showApplicationCommand) getApplicationTags() ([]names.ApplicationTag, error) {
 	tags := make([]names.ApplicationTag, len(c.apps))
 	for i, one := range c.apps {
@@ -184,6 +247,22 @@ type ApplicationInfo struct {
 	Remote           bool                       `yaml:"remote" json:"remote"`
 	Life             string                     `yaml:"life,omitempty" json:"life,omitempty"`
 	EndpointBindings map[string]string          `yaml:"endpoint-bindings,omitempty" json:"endpoint-bindings,omitempty"`
+
+	// HookStats is only shown when requested.
+	HookStats []HookStats `yaml:"hook-stats,omitempty" json:"hook-stats,omitempty"`
+}
+
+// HookStats defines the serialization behaviour of the statistics for a
+// hook, action or commands aggregated over the hook history of the units
+// of an application.
+type HookStats struct {
+	Kind         string `yaml:"kind" json:"kind"`
+	Name         string `yaml:"name,omitempty" json:"name,omitempty"`
+	Count        int    `yaml:"count" json:"count"`
+	Failures     int    `yaml:"failures" json:"failures"`
+	MeanDuration string `yaml:"mean-duration" json:"mean-duration"`
+	MaxDuration  string `yaml:"max-duration" json:"max-duration"`
+	LastRun      string `yaml:"last-run" json:"last-run"`
 }
 
 // ExposedEndpoint defines the serialization behavior of the expose settings
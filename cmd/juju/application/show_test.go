@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
+	apiapplication "github.com/juju/juju/api/client/application"
 	"github.com/juju/juju/api/jujuclient"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/cmd/cmdtesting"
@@ -198,6 +200,56 @@ wordpress:
 	})
 }
 
+func (s *ShowSuite) TestShowHookStats(c *tc.C) {
+	s.mockAPI.applicationsInfoFunc = func([]names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
+		return []params.ApplicationInfoResult{
+			{Result: s.createTestApplicationInfo("wordpress", "")},
+		}, nil
+	}
+	s.mockAPI.applicationsHookStatsFunc = func(apps []string) ([]apiapplication.ApplicationHookStats, error) {
+		c.Check(apps, tc.DeepEquals, []string{"wordpress"})
+		return []apiapplication.ApplicationHookStats{{
+			Stats: []params.HookStats{{
+				Kind:         "hook",
+				Name:         "config-changed",
+				Count:        4,
+				Failures:     1,
+				MeanDuration: 2500 * time.Millisecond,
+				MaxDuration:  5 * time.Second,
+				LastRun:      time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			}},
+		}}, nil
+	}
+	s.assertRunShow(c, showTest{
+		args: []string{"wordpress", "--hook-stats"},
+		stdout: `
+wordpress:
+  charm: charm-wordpress
+  base: ubuntu@12.10
+  channel: development
+  constraints:
+    arch: amd64
+    cores: 1
+    mem: 4096
+    root-disk: 8192
+  principal: true
+  exposed: false
+  remote: false
+  life: alive
+  endpoint-bindings:
+    juju-info: myspace
+  hook-stats:
+  - kind: hook
+    name: config-changed
+    count: 4
+    failures: 1
+    mean-duration: 2.5s
+    max-duration: 5s
+    last-run: "2026-01-02T03:04:05Z"
+`[1:],
+	})
+}
+
 func (s *ShowSuite) TestShowJSON(c *tc.C) {
 	s.mockAPI.applicationsInfoFunc = func([]names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
 		return []params.ApplicationInfoResult{
@@ -268,7 +320,8 @@ wordpress:
 }
 
 type mockShowAPI struct {
-	applicationsInfoFunc func([]names.ApplicationTag) ([]params.ApplicationInfoResult, error)
+	applicationsInfoFunc      func([]names.ApplicationTag) ([]params.ApplicationInfoResult, error)
+	applicationsHookStatsFunc func([]string) ([]apiapplication.ApplicationHookStats, error)
 }
 
 func (s mockShowAPI) Close() error {
@@ -278,3 +331,7 @@ func (s mockShowAPI) Close() error {
 func (s mockShowAPI) ApplicationsInfo(ctx context.Context, tags []names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
 	return s.applicationsInfoFunc(tags)
 }
+
+func (s mockShowAPI) ApplicationsHookStats(ctx context.Context, apps []string) ([]apiapplication.ApplicationHookStats, error) {
+	return s.applicationsHookStatsFunc(apps)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"maps"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
@@ -17,6 +18,7 @@ import (
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/internal/naturalsort"
+	"github.com/juju/juju/rpc/params"
 )
 
 const showUnitDoc = `
@@ -24,6 +26,10 @@ The command takes deployed unit names as an argument.
 
 Optionally, relation data for only a specified endpoint
 or related unit may be shown, or just the application data.
+
+The --hook-history option adds the most recent hooks, actions
+and commands run by each unit, with when they started, how
+long they took and their exit code.
 `
 
 const showUnitExamples = `
@@ -46,6 +52,10 @@ To show only the relation data for a specific endpoint:
 To show only the relation data for a specific related unit:
 
     juju show-unit mysql/0 --related-unit wordpress/2
+
+To show the hook history of a unit:
+
+    juju show-unit mysql/0 --hook-history
 `
 
 // NewShowUnitCommand returns a command that displays unit info.
@@ -65,6 +75,7 @@ type showUnitCommand struct {
 	endpoint    string
 	relatedUnit string
 	appOnly     bool
+	hookHistory bool
 
 	newAPIFunc func(ctx context.Context) (UnitsInfoAPI, error)
 }
@@ -117,12 +128,14 @@ func (c *showUnitCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.endpoint, "endpoint", "", "Only show relation data for the specified endpoint")
 	f.StringVar(&c.relatedUnit, "related-unit", "", "Only show relation data for the specified unit")
 	f.BoolVar(&c.appOnly, "app", false, "Only show application relation data")
+	f.BoolVar(&c.hookHistory, "hook-history", false, "Show the hook history of the unit")
 }
 
 // UnitsInfoAPI defines the API methods that show-unit command uses.
 type UnitsInfoAPI interface {
 	Close() error
 	UnitsInfo(context.Context, []names.UnitTag) ([]application.UnitInfo, error)
+	UnitsHookHistory(context.Context, []names.UnitTag) ([]application.UnitHookHistory, error)
 }
 
 func (c *showUnitCommand) newUnitAPI(ctx context.Context) (UnitsInfoAPI, error) {
@@ -172,9 +185,59 @@ func (c *showUnitCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.hookHistory {
+		if err := c.addHookHistory(ctx, client, tags, output); err != nil {
+			return err
+		}
+	}
 	return c.out.Write(ctx, output)
 }
 
+func (c *showUnitCommand) addHookHistory(
+	ctx context.Context, client UnitsInfoAPI, tags []names.UnitTag, output map[string]UnitInfo,
+) error {
+	results, err := client.UnitsHookHistory(ctx, tags)
+	if errors.Is(err, errors.NotImplemented) {
+		return errors.New("hook history is not supported by this controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	var errorStrings []string
+	for i, result := range results {
+		if result.Error != nil {
+			errorStrings = append(errorStrings, result.Error.Error())
+			continue
+		}
+		info, ok := output[tags[i].Id()]
+		if !ok {
+			continue
+		}
+		info.HookHistory = formatHookHistory(result.Entries)
+		output[tags[i].Id()] = info
+	}
+	if len(errorStrings) > 0 {
+		return errors.New(strings.Join(errorStrings, "\n"))
+	}
+	return nil
+}
+
+func formatHookHistory(entries []params.HookHistoryEntry) []HookHistoryEntry {
+	history := make([]HookHistoryEntry, len(entries))
+	for i, entry := range entries {
+		history[i] = HookHistoryEntry{
+			Kind:       entry.Kind,
+			Name:       entry.Name,
+			RelationId: entry.RelationId,
+			Started:    entry.Started.UTC().Format(time.RFC3339),
+			Duration:   entry.Duration.String(),
+			ExitCode:   entry.ExitCode,
+			Error:      entry.Error,
+		}
+	}
+	return history
+}
+
 func (c *showUnitCommand) getUnitTags() ([]names.UnitTag, error) {
 	tags := make([]names.UnitTag, len(c.units))
 	for i, one := range c.units {
@@ -230,6 +293,21 @@ type UnitInfo struct {
 	// The following are for CAAS models.
 	ProviderId string `yaml:"provider-id,omitempty" json:"provider-id,omitempty"`
 	Address    string `yaml:"address,omitempty" json:"address,omitempty"`
+
+	// HookHistory is only shown when requested.
+	HookHistory []HookHistoryEntry `yaml:"hook-history,omitempty" json:"hook-history,omitempty"`
+}
+
+// HookHistoryEntry defines the serialization behaviour of a hook, action or
+// commands execution in the hook history of a unit.
+type HookHistoryEntry struct {
+	Kind       string `yaml:"kind" json:"kind"`
+	Name       string `yaml:"name,omitempty" json:"name,omitempty"`
+	RelationId *int   `yaml:"relation-id,omitempty" json:"relation-id,omitempty"`
+	Started    string `yaml:"started" json:"started"`
+	Duration   string `yaml:"duration" json:"duration"`
+	ExitCode   int    `yaml:"exit-code" json:"exit-code"`
+	Error      string `yaml:"error,omitempty" json:"error,omitempty"`
 }
 
 func (c *showUnitCommand) createUnitInfo(details application.UnitInfo) (names.UnitTag, UnitInfo, error) {
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/juju/juju/cmd/juju/application"
 	"github.com/juju/juju/core/life"
 	jujutesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
 )
 
 type ShowUnitSuite struct {
@@ -215,6 +218,70 @@ wordpress/0:
 	})
 }
 
+func (s *ShowUnitSuite) TestShowHookHistory(c *tc.C) {
+	s.mockAPI.unitsInfoFunc = func([]names.UnitTag) ([]apiapplication.UnitInfo, error) {
+		return []apiapplication.UnitInfo{{
+			Tag:   "unit-wordpress-0",
+			Charm: "charm-wordpress",
+		}}, nil
+	}
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.mockAPI.unitsHookHistoryFunc = func(tags []names.UnitTag) ([]apiapplication.UnitHookHistory, error) {
+		c.Check(tags, tc.DeepEquals, []names.UnitTag{names.NewUnitTag("wordpress/0")})
+		return []apiapplication.UnitHookHistory{{
+			Entries: []params.HookHistoryEntry{{
+				Kind:     "hook",
+				Name:     "install",
+				Started:  started,
+				Duration: 12 * time.Second,
+			}, {
+				Kind:       "hook",
+				Name:       "db-relation-changed",
+				RelationId: new(3),
+				Started:    started.Add(time.Minute),
+				Duration:   1500 * time.Millisecond,
+				ExitCode:   1,
+				Error:      "exit status 1",
+			}},
+		}}, nil
+	}
+	s.assertRunShow(c, showUnitTest{
+		args: []string{"wordpress/0", "--hook-history"},
+		stdout: `
+wordpress/0:
+  opened-ports: []
+  charm: charm-wordpress
+  leader: false
+  hook-history:
+  - kind: hook
+    name: install
+    started: "2026-01-02T03:04:05Z"
+    duration: 12s
+    exit-code: 0
+  - kind: hook
+    name: db-relation-changed
+    relation-id: 3
+    started: "2026-01-02T03:05:05Z"
+    duration: 1.5s
+    exit-code: 1
+    error: exit status 1
+`[1:],
+	})
+}
+
+func (s *ShowUnitSuite) TestShowHookHistoryNotSupported(c *tc.C) {
+	s.mockAPI.unitsInfoFunc = func([]names.UnitTag) ([]apiapplication.UnitInfo, error) {
+		return []apiapplication.UnitInfo{{Tag: "unit-wordpress-0"}}, nil
+	}
+	s.mockAPI.unitsHookHistoryFunc = func([]names.UnitTag) ([]apiapplication.UnitHookHistory, error) {
+		return nil, jujuerrors.NotImplementedf("unit hook history")
+	}
+	s.assertRunShow(c, showUnitTest{
+		args: []string{"wordpress/0", "--hook-history"},
+		err:  "hook history is not supported by this controller",
+	})
+}
+
 func (s *ShowUnitSuite) TestShowAppOnly(c *tc.C) {
 	s.mockAPI.unitsInfoFunc = func([]names.UnitTag) ([]apiapplication.UnitInfo, error) {
 		return []apiapplication.UnitInfo{
@@ -397,7 +464,8 @@ wordpress/0:
 }
 
 type mockShowUnitAPI struct {
-	unitsInfoFunc func([]names.UnitTag) ([]apiapplication.UnitInfo, error)
+	unitsInfoFunc        func([]names.UnitTag) ([]apiapplication.UnitInfo, error)
+	unitsHookHistoryFunc func([]names.UnitTag) ([]apiapplication.UnitHookHistory, error)
 }
 
 func (s mockShowUnitAPI) Close() error {
@@ -407,3 +475,7 @@ func (s mockShowUnitAPI) Close() error {
 func (s mockShowUnitAPI) UnitsInfo(ctx context.Context, tags []names.UnitTag) ([]apiapplication.UnitInfo, error) {
 	return s.unitsInfoFunc(tags)
 }
+
+func (s mockShowUnitAPI) UnitsHookHistory(ctx context.Context, tags []names.UnitTag) ([]apiapplication.UnitHookHistory, error) {
+	return s.unitsHookHistoryFunc(tags)
+}
@@ -25,9 +25,18 @@ import (
 type APIClient interface {
 	io.Closer
 	// Create sends an RPC request to create a new backup.
-	Create(nctx context.Context, otes string, noDownload bool) (*params.BackupsMetadataResult, error)
+	Create(ctx context.Context, notes string, noDownload bool) (*params.BackupsMetadataResult, error)
 	// Download pulls the backup archive file.
 	Download(ctx context.Context, filename string) (io.ReadCloser, error)
+	// List returns the metadata of all backups stored on the controller.
+	List(ctx context.Context) (*params.BackupsListResult, error)
+	// Info returns the metadata of the identified backup.
+	Info(ctx context.Context, id string) (*params.BackupsMetadataResult, error)
+	// Remove deletes the identified backups from the controller.
+	Remove(ctx context.Context, ids ...string) ([]params.ErrorResult, error)
+	// Restore validates, and unless dryRun is set restores, the
+	// identified backup.
+	Restore(ctx context.Context, id string, dryRun bool) (*params.BackupsRestoreResult, error)
 }
 
 // CommandBase is the base type for backups sub-commands.
@@ -37,3 +37,27 @@ func NewDownloadCommandForTest(store jujuclient.ClientStore) (cmd.Command, *Down
 	c.SetClientStore(store)
 	return modelcmd.Wrap(c), &DownloadCommand{c}
 }
+
+func NewListCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &listCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+func NewShowCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &showCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+func NewRemoveCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &removeCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+func NewRestoreCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &restoreCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
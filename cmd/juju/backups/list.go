@@ -0,0 +1,150 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+const listDoc = `
+Lists the backup archives stored on the controller.
+
+Backup archives are identified by their filename, which can be passed to
+` + "`juju show-backup`" + `, ` + "`juju download-backup`" + `, ` + "`juju remove-backup`" + ` and
+` + "`juju restore-backup`" + `.
+`
+
+const listExamples = `
+    juju backups
+    juju backups --format yaml
+`
+
+// NewListCommand returns a command used to list backups.
+func NewListCommand() cmd.Command {
+	return modelcmd.Wrap(&listCommand{})
+}
+
+// listCommand is the sub-command for listing backups on the controller.
+type listCommand struct {
+	CommandBase
+	out cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *listCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "backups",
+		Purpose:  "List backups stored on the controller.",
+		Doc:      listDoc,
+		Aliases:  []string{"list-backups"},
+		Examples: listExamples,
+		SeeAlso: []string{
+			"create-backup",
+			"show-backup",
+			"download-backup",
+			"remove-backup",
+			"restore-backup",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatBackupsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	return cmd.CheckEmpty(args)
+}
+
+type backupDetails struct {
+	Filename       string    `json:"filename" yaml:"filename"`
+	ID             string    `json:"id" yaml:"id"`
+	Started        time.Time `json:"started" yaml:"started"`
+	Finished       time.Time `json:"finished" yaml:"finished"`
+	Size           int64     `json:"size" yaml:"size"`
+	Checksum       string    `json:"checksum" yaml:"checksum"`
+	JujuVersion    string    `json:"juju-version" yaml:"juju-version"`
+	ControllerUUID string    `json:"controller-uuid" yaml:"controller-uuid"`
+	HANodes        int64     `json:"ha-nodes,omitempty" yaml:"ha-nodes,omitempty"`
+	Notes          string    `json:"notes,omitempty" yaml:"notes,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *listCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	result, err := client.List(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(result.List) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No backups to display.")
+		return nil
+	}
+
+	details := make([]backupDetails, len(result.List))
+	for i, meta := range result.List {
+		details[i] = newBackupDetails(meta)
+	}
+	return c.out.Write(ctx, details)
+}
+
+func newBackupDetails(meta params.BackupsMetadataResult) backupDetails {
+	return backupDetails{
+		Filename:       meta.Filename,
+		ID:             meta.ID,
+		Started:        meta.Started,
+		Finished:       meta.Finished,
+		Size:           meta.Size,
+		Checksum:       meta.Checksum,
+		JujuVersion:    meta.Version.String(),
+		ControllerUUID: meta.ControllerUUID,
+		HANodes:        meta.HANodes,
+		Notes:          meta.Notes,
+	}
+}
+
+func formatBackupsTabular(writer io.Writer, value any) error {
+	backups, ok := value.([]backupDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", backups, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.SetColumnAlignRight(2)
+
+	w.Println("Filename", "Started", "Size", "Version", "Notes")
+	for _, b := range backups {
+		w.Println(b.Filename, b.Started.UTC().Format(time.RFC3339), b.Size, b.JujuVersion, b.Notes)
+	}
+	return tw.Flush()
+}
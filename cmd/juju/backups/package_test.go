@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/backups"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/semversion"
 	jujutesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
 	"github.com/juju/juju/juju/osenv"
@@ -138,9 +139,11 @@ func (s *BaseBackupsSuite) checkArchive(c *tc.C) {
 // TODO (hml) 2018-05-01
 // Replace this fakeAPIClient with MockAPIClient for all tests.
 type fakeAPIClient struct {
-	metaresult *params.BackupsMetadataResult
-	archive    io.ReadCloser
-	err        error
+	metaresult    *params.BackupsMetadataResult
+	archive       io.ReadCloser
+	removeResults []params.ErrorResult
+	restoreErr    *params.Error
+	err           error
 
 	calls []string
 	args  []string
@@ -183,6 +186,50 @@ func (c *fakeAPIClient) Download(_ context.Context, id string) (io.ReadCloser, e
 	return c.archive, nil
 }
 
+func (c *fakeAPIClient) List(context.Context) (*params.BackupsListResult, error) {
+	c.calls = append(c.calls, "List")
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &params.BackupsListResult{List: []params.BackupsMetadataResult{*c.metaresult}}, nil
+}
+
+func (c *fakeAPIClient) Info(_ context.Context, id string) (*params.BackupsMetadataResult, error) {
+	c.calls = append(c.calls, "Info")
+	c.args = append(c.args, id)
+	c.idArg = id
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.metaresult, nil
+}
+
+func (c *fakeAPIClient) Remove(_ context.Context, ids ...string) ([]params.ErrorResult, error) {
+	c.calls = append(c.calls, "Remove")
+	c.args = append(c.args, ids...)
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.removeResults != nil {
+		return c.removeResults, nil
+	}
+	return make([]params.ErrorResult, len(ids)), nil
+}
+
+func (c *fakeAPIClient) Restore(_ context.Context, id string, dryRun bool) (*params.BackupsRestoreResult, error) {
+	c.calls = append(c.calls, "Restore")
+	c.args = append(c.args, id, fmt.Sprintf("%t", dryRun))
+	c.idArg = id
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &params.BackupsRestoreResult{
+		Metadata:          *c.metaresult,
+		ControllerVersion: semversion.MustParse("4.0.1"),
+		Error:             c.restoreErr,
+	}, nil
+}
+
 func (c *fakeAPIClient) Close() error {
 	return nil
 }
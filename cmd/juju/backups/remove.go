@@ -0,0 +1,96 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const removeDoc = `
+Removes one or more backup archives from the controller.
+
+Download a backup with ` + "`juju download-backup`" + ` before removing it if a
+local copy is required.
+`
+
+const removeExamples = `
+    juju remove-backup juju-backup-20260101-120000.tar.gz
+`
+
+// NewRemoveCommand returns a command used to remove backups.
+func NewRemoveCommand() cmd.Command {
+	return modelcmd.Wrap(&removeCommand{})
+}
+
+// removeCommand is the sub-command for removing backups from the
+// controller.
+type removeCommand struct {
+	CommandBase
+	// IDs are the backups to remove.
+	IDs []string
+}
+
+// Info implements Command.Info.
+func (c *removeCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-backup",
+		Args:     "<backup filename> [...]",
+		Purpose:  "Remove backups from the controller.",
+		Doc:      removeDoc,
+		Examples: removeExamples,
+		SeeAlso: []string{
+			"backups",
+			"download-backup",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("missing backup filename")
+	}
+	c.IDs = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *removeCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	results, err := client.Remove(ctx, c.IDs...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var failed bool
+	for i, result := range results {
+		if result.Error != nil {
+			cmd.WriteError(ctx.Stderr, errors.Annotatef(result.Error, "removing backup %q", c.IDs[i]))
+			failed = true
+			continue
+		}
+		if !c.quiet {
+			ctx.Infof("Removed backup %q", c.IDs[i])
+		}
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removeSuite struct {
+	BaseBackupsSuite
+	command cmd.Command
+}
+
+func TestRemoveSuite(t *testing.T) {
+	tc.Run(t, &removeSuite{})
+}
+
+func (s *removeSuite) SetUpTest(c *tc.C) {
+	s.BaseBackupsSuite.SetUpTest(c)
+	s.command = backups.NewRemoveCommandForTest(s.store)
+}
+
+func (s *removeSuite) TestOkay(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.command, "one", "two")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckCalls(c, "Remove")
+	client.CheckArgs(c, "one", "two")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+Removed backup "one"
+Removed backup "two"
+`[1:])
+}
+
+func (s *removeSuite) TestPartialFailure(c *tc.C) {
+	client := s.setSuccess()
+	client.removeResults = []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: `backup "two" not found`, Code: params.CodeNotFound}},
+	}
+	ctx, err := cmdtesting.RunCommand(c, s.command, "one", "two")
+	c.Assert(err, tc.Equals, cmd.ErrSilent)
+
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+Removed backup "one"
+ERROR removing backup "two": backup "two" not found
+`[1:])
+}
+
+func (s *removeSuite) TestMissingFilename(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.command)
+	c.Check(err, tc.ErrorMatches, "missing backup filename")
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const restoreDoc = `
+Restores the controller from a backup archive stored on the controller.
+
+The backup must have been taken from the same controller, using the same
+major and minor version of Juju as the controller is now running, and must
+not be newer than the controller.
+
+The controller databases and object store are replaced while they are not
+in use, so the restore is staged and applied by the controller agent when it
+next restarts. Restoring is only supported on a controller with a single
+node.
+
+Use ` + "`--dry-run`" + ` to check that the backup is compatible with the
+controller without restoring it.
+`
+
+const restoreExamples = `
+    juju restore-backup juju-backup-20260101-120000.tar.gz --dry-run
+    juju restore-backup juju-backup-20260101-120000.tar.gz
+`
+
+// NewRestoreCommand returns a command used to restore a backup.
+func NewRestoreCommand() cmd.Command {
+	return modelcmd.Wrap(&restoreCommand{})
+}
+
+// restoreCommand is the sub-command for restoring a backup.
+type restoreCommand struct {
+	CommandBase
+	// ID is the backup to restore.
+	ID string
+	// DryRun only validates the backup against the controller.
+	DryRun bool
+}
+
+// Info implements Command.Info.
+func (c *restoreCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "restore-backup",
+		Args:     "<backup filename>",
+		Purpose:  "Restore the controller from a backup.",
+		Doc:      restoreDoc,
+		Examples: restoreExamples,
+		SeeAlso: []string{
+			"backups",
+			"create-backup",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *restoreCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	f.BoolVar(&c.DryRun, "dry-run", false, "Validate the backup without restoring it")
+}
+
+// Init implements Command.Init.
+func (c *restoreCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("missing backup filename")
+	}
+	id, args := args[0], args[1:]
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.ID = id
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *restoreCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	result, err := client.Restore(ctx, c.ID, c.DryRun)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if c.verbose {
+		fmt.Fprint(ctx.Stdout, c.metadata(&result.Metadata))
+	}
+	if result.Error != nil {
+		return errors.Annotatef(result.Error, "cannot restore backup %q", c.ID)
+	}
+
+	if c.DryRun {
+		ctx.Infof("Backup %q can be restored into this controller (juju %s).", c.ID, result.ControllerVersion)
+		return nil
+	}
+	ctx.Infof("Restore of backup %q staged; it will be applied when the controller agent restarts.", c.ID)
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+type restoreSuite struct {
+	BaseBackupsSuite
+	command cmd.Command
+}
+
+func TestRestoreSuite(t *testing.T) {
+	tc.Run(t, &restoreSuite{})
+}
+
+func (s *restoreSuite) SetUpTest(c *tc.C) {
+	s.BaseBackupsSuite.SetUpTest(c)
+	s.command = backups.NewRestoreCommandForTest(s.store)
+}
+
+func (s *restoreSuite) TestDryRun(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.command, "backup-filename", "--dry-run")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckCalls(c, "Restore")
+	client.CheckArgs(c, "backup-filename", "true")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals,
+		"Backup \"backup-filename\" can be restored into this controller (juju 4.0.1).\n")
+}
+
+func (s *restoreSuite) TestDryRunIncompatible(c *tc.C) {
+	client := s.setSuccess()
+	client.restoreErr = &params.Error{Message: "backup taken with juju 3.6.0 cannot be restored into a juju 4.0 controller"}
+	_, err := cmdtesting.RunCommand(c, s.command, "backup-filename", "--dry-run")
+	c.Check(err, tc.ErrorMatches,
+		`cannot restore backup "backup-filename": backup taken with juju 3.6.0 cannot be restored into a juju 4.0 controller`)
+}
+
+func (s *restoreSuite) TestRestore(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.command, "backup-filename")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckArgs(c, "backup-filename", "false")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Restore of backup \"backup-filename\" staged; it will be applied when the controller agent restarts.\n")
+}
+
+func (s *restoreSuite) TestMissingFilename(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.command)
+	c.Check(err, tc.ErrorMatches, "missing backup filename")
+}
+
+func (s *restoreSuite) TestError(c *tc.C) {
+	s.setFailure("failed!")
+	_, err := cmdtesting.RunCommand(c, s.command, "backup-filename")
+	c.Check(errors.Cause(err), tc.ErrorMatches, "failed!")
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const showDoc = `
+Shows the metadata of a backup archive stored on the controller.
+`
+
+const showExamples = `
+    juju show-backup juju-backup-20260101-120000.tar.gz
+`
+
+// NewShowCommand returns a command used to show a backup's metadata.
+func NewShowCommand() cmd.Command {
+	return modelcmd.Wrap(&showCommand{})
+}
+
+// showCommand is the sub-command for showing the metadata of a backup.
+type showCommand struct {
+	CommandBase
+	// ID is the backup to show.
+	ID string
+}
+
+// Info implements Command.Info.
+func (c *showCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "show-backup",
+		Args:     "<backup filename>",
+		Purpose:  "Show the metadata of a backup.",
+		Doc:      showDoc,
+		Examples: showExamples,
+		SeeAlso: []string{
+			"backups",
+			"download-backup",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *showCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("missing backup filename")
+	}
+	id, args := args[0], args[1:]
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.ID = id
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *showCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	result, err := client.Info(ctx, c.ID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	fmt.Fprint(ctx.Stdout, c.metadata(result))
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+)
+
+type showSuite struct {
+	BaseBackupsSuite
+	command cmd.Command
+}
+
+func TestShowSuite(t *testing.T) {
+	tc.Run(t, &showSuite{})
+}
+
+func (s *showSuite) SetUpTest(c *tc.C) {
+	s.BaseBackupsSuite.SetUpTest(c)
+	s.command = backups.NewShowCommandForTest(s.store)
+}
+
+func (s *showSuite) TestOkay(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.command, "backup-filename")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.Check(c, "backup-filename", "", "Info")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, MetaResultString[:len(MetaResultString)-1])
+}
+
+func (s *showSuite) TestMissingFilename(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.command)
+	c.Check(err, tc.ErrorMatches, "missing backup filename")
+}
+
+func (s *showSuite) TestError(c *tc.C) {
+	s.setFailure("failed!")
+	_, err := cmdtesting.RunCommand(c, s.command, "backup-filename")
+	c.Check(errors.Cause(err), tc.ErrorMatches, "failed!")
+}
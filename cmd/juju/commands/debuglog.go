@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
 	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/output"
 )
 
 // defaultLineCount is the default number of lines to
@@ -87,6 +88,18 @@ The ` + "`--lines`" + ` and ` + "`--limit`" + ` options control the number of lo
 
 The ` + "`--replay`" + ` option displays log lines starting from the beginning.
 
+The ` + "`--since`" + ` and ` + "`--until`" + ` options only display log lines logged within
+a time range. Each takes an RFC3339 timestamp, a date and time or a time of day
+in the local time zone (e.g. ` + "`2024-05-01 02:00`" + ` or ` + "`02:00`" + `), or a duration before
+now (e.g. ` + "`90m`" + `). When either is set, all matching lines are displayed unless
+` + "`--lines`" + ` or ` + "`--limit`" + ` is given. The ` + "`--until`" + ` option implies ` + "`--no-tail`" + `.
+
+The ` + "`--offset`" + ` option skips that many matching lines before displaying any,
+so that a large result can be paged through together with ` + "`--limit`" + `.
+
+The ` + "`--histogram`" + ` option displays the number of matching log lines at each
+level instead of the lines themselves.
+
 Behavior when combining ` + "`--replay`" + ` with other options:
 * ` + "`--replay`" + ` and ` + "`--limit`" + ` prints the specified number of lines from the beginning of the log.
 * ` + "`--replay`" + ` and ` + "`--lines`" + ` is invalid as it causes confusion by skipping logs between the replayed lines and the current tailing point.
@@ -97,6 +110,8 @@ Given the above, the following flag combinations are incompatible and cannot be
 * ` + "`--no-tail`" + ` and ` + "`--lines (-n)`" + `
 * ` + "`--limit`" + ` and ` + "`--lines (-n)`" + `
 * ` + "`--replay`" + ` and ` + "`--lines (-n)`" + `
+* ` + "`--until`" + ` and ` + "`--tail`" + ` or ` + "`--lines (-n)`" + `
+* ` + "`--histogram`" + ` and ` + "`--tail`" + `, ` + "`--lines (-n)`" + `, ` + "`--limit`" + `, ` + "`--replay`" + ` or ` + "`--offset`" + `
 `
 
 const usageDebugLogExamples = `
@@ -174,6 +189,18 @@ filter and append:
         --include-module juju.worker.uniter \
         --include wordpress/0
 
+Show the errors logged by the ` + "`mysql/0`" + ` unit between 02:00 and 03:00 today:
+
+    juju debug-log --include mysql/0 --level ERROR --since 02:00 --until 03:00
+
+Show the second page of 100 messages logged in the last hour:
+
+    juju debug-log --since 1h --limit 100 --offset 100
+
+Count the messages at each level logged by machine 0 in the last day:
+
+    juju debug-log --include machine-0 --since 24h --histogram
+
 Show all messages from the ` + "`juju.worker.uniter`" + ` module, except those sent from
 ` + "`machine-3`" + ` or ` + "`machine-4`" + `, and then stop:
 
@@ -225,6 +252,11 @@ type debugLogCommand struct {
 	color       bool
 	backLogFlag *intValue
 	limitFlag   *intValue
+	offsetFlag  *intValue
+
+	since     string
+	until     string
+	histogram bool
 
 	retry      bool
 	retryDelay time.Duration
@@ -261,6 +293,14 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 
 	f.BoolVar(&c.params.Replay, "replay", false, "Show the entire log and continue to append new ones")
 
+	f.StringVar(&c.since, "since", "", "Only show log messages logged at or after this time")
+	f.StringVar(&c.until, "until", "", "Only show log messages logged at or before this time, and then exit")
+
+	c.offsetFlag = newIntValue(&c.params.Offset)
+	f.Var(c.offsetFlag, "offset", "Skip this many log messages before showing any")
+
+	f.BoolVar(&c.histogram, "histogram", false, "Show the number of log messages at each level and exit")
+
 	f.BoolVar(&c.noTail, "no-tail", false, "Show existing log messages and then exit")
 	f.BoolVar(&c.tail, "tail", false, "Show existing log messages and continue to append new ones")
 	f.BoolVar(&c.color, "color", false, "Force use of ANSI color codes")
@@ -309,13 +349,35 @@ func (c *debugLogCommand) Init(args []string) error {
 	if c.retryDelay < 0 {
 		return errors.NotValidf("negative retry delay")
 	}
+	if err := c.initTimeRange(); err != nil {
+		return err
+	}
+	if c.histogram {
+		for _, flag := range []struct {
+			name string
+			set  bool
+		}{
+			{"--tail", c.tail},
+			{"--lines", c.backLogFlag.IsSet()},
+			{"--limit", c.limitFlag.IsSet()},
+			{"--replay", c.params.Replay},
+			{"--offset", c.offsetFlag.IsSet()},
+		} {
+			if flag.set {
+				return errors.NotValidf("setting --histogram and %s", flag.name)
+			}
+		}
+	}
 	if c.limitFlag.IsSet() {
 		c.noTail = true
 	}
 	if c.backLogFlag.IsSet() {
 		c.tail = true
 	}
-	if !c.backLogFlag.IsSet() && !c.limitFlag.IsSet() && !c.params.Replay {
+	// A time range selects the lines to show, so only default to showing
+	// the most recent lines without one.
+	timeRange := !c.params.StartTime.IsZero() || !c.params.EndTime.IsZero()
+	if !c.backLogFlag.IsSet() && !c.limitFlag.IsSet() && !c.params.Replay && !timeRange && !c.histogram {
 		*c.backLogFlag.value = defaultLineCount
 	}
 	if c.utc {
@@ -356,6 +418,81 @@ func (c *debugLogCommand) Init(args []string) error {
 	return cmd.CheckEmpty(args)
 }
 
+func (c *debugLogCommand) initTimeRange() error {
+	now := time.Now()
+	tz := c.tz
+	if c.utc {
+		tz = time.UTC
+	} else if tz == nil {
+		tz = time.Local
+	}
+	if c.since != "" {
+		since, err := parseLogTime(c.since, now, tz)
+		if err != nil {
+			return errors.Annotate(err, "--since")
+		}
+		c.params.StartTime = since
+	}
+	if c.until == "" {
+		return nil
+	}
+	if c.tail {
+		return errors.NotValidf("setting --tail and --until")
+	}
+	if c.backLogFlag.IsSet() {
+		return errors.NotValidf("setting --until and --lines")
+	}
+	until, err := parseLogTime(c.until, now, tz)
+	if err != nil {
+		return errors.Annotate(err, "--until")
+	}
+	if until.Before(c.params.StartTime) {
+		return errors.NotValidf("--until before --since")
+	}
+	c.params.EndTime = until
+	c.noTail = true
+	return nil
+}
+
+// logTimeLayouts are the layouts accepted by --since and --until, in
+// addition to a duration before now.
+var logTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// logTimeOfDayLayouts are the layouts of a time today accepted by --since
+// and --until.
+var logTimeOfDayLayouts = []string{
+	"15:04:05",
+	"15:04",
+}
+
+// parseLogTime parses a time given to --since or --until. Times without a
+// zone are in the given location.
+func parseLogTime(value string, now time.Time, tz *time.Location) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return time.Time{}, errors.NotValidf("negative duration %q", value)
+		}
+		return now.Add(-d), nil
+	}
+	for _, layout := range logTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, tz); err == nil {
+			return t, nil
+		}
+	}
+	for _, layout := range logTimeOfDayLayouts {
+		if t, err := time.ParseInLocation(layout, value, tz); err == nil {
+			y, m, d := now.In(tz).Date()
+			return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, tz), nil
+		}
+	}
+	return time.Time{}, errors.NotValidf("time %q", value)
+}
+
 func (c *debugLogCommand) parseEntity(entity string) string {
 	tag, err := names.ParseTag(entity)
 	switch {
@@ -382,6 +519,9 @@ type DebugLogAPI interface {
 	// WatchDebugLog streams debug log messages according to the specified
 	// parameters.
 	WatchDebugLog(ctx context.Context, params common.DebugLogParams) (<-chan common.LogMessage, error)
+	// DebugLogHistogram returns the number of debug log messages matching
+	// the specified parameters at each level.
+	DebugLogHistogram(ctx context.Context, params common.DebugLogParams) (map[string]int, error)
 	// Close closes the API client.
 	Close() error
 }
@@ -514,6 +654,10 @@ func (c *debugLogCommand) Run(ctx *cmd.Context) error {
 		}
 	}()
 
+	if c.histogram {
+		return c.writeHistogram(ctx, clients)
+	}
+
 	// The default log buffer size is 1 for a single controller
 	// (stream log entries as they arrive).
 	bufferSize := 1
@@ -643,6 +787,26 @@ func (c *debugLogCommand) streamLogs(ctx context.Context, client DebugLogAPI, bu
 	return errors.Cause(err)
 }
 
+// levelHistogram holds the number of log messages at each level.
+type levelHistogram map[string]int
+
+// writeHistogram writes the number of matching log messages at each level.
+// Each controller holds the logs sent to it, so the counts of all
+// controllers are added together.
+func (c *debugLogCommand) writeHistogram(ctx *cmd.Context, clients []DebugLogAPI) error {
+	histogram := make(levelHistogram)
+	for _, client := range clients {
+		counts, err := client.DebugLogHistogram(ctx, c.params)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for level, count := range counts {
+			histogram[level] += count
+		}
+	}
+	return c.out.Write(ctx, histogram)
+}
+
 // ErrConnectionClosed is a sentinel error used to signal that the connection
 // is closed.
 var ErrConnectionClosed = errors.ConstError("connection closed")
@@ -667,6 +831,9 @@ func (c *debugLogCommand) writeText(w io.Writer, v any) error {
 			c.tw.SetColorCapable(true)
 		}
 	}
+	if histogram, ok := v.(levelHistogram); ok {
+		return c.writeHistogramText(w, histogram)
+	}
 	r, ok := v.(*corelogger.LogRecord)
 	if !ok {
 		return fmt.Errorf("expected log message of type %T, got %t", common.LogMessage{}, v)
@@ -692,6 +859,22 @@ func (c *debugLogCommand) writeText(w io.Writer, v any) error {
 	return nil
 }
 
+func (c *debugLogCommand) writeHistogramText(w io.Writer, histogram levelHistogram) error {
+	tw := output.TabWriter(w)
+	fmt.Fprintln(tw, "Level\tCount")
+	var total int
+	for level := corelogger.TRACE; level <= corelogger.CRITICAL; level++ {
+		count := histogram[level.String()]
+		total += count
+		if count == 0 {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%d\n", level, count)
+	}
+	fmt.Fprintf(tw, "Total\t%d\n", total)
+	return tw.Flush()
+}
+
 // intValue implements gnuflag.Value for an int value that can be set
 // to differentiate user input value from default value.
 type intValue struct {
@@ -176,6 +176,45 @@ func (s *DebugLogSuite) TestArgParsing(c *tc.C) {
 		}, {
 			args:     []string{"--lines", "30", "--no-tail", "--limit", "50"},
 			errMatch: `setting --no-tail and --lines not valid`,
+		}, {
+			args: []string{"--since", "2024-05-01T02:00:00Z", "--until", "2024-05-01 03:00", "--utc"},
+			expected: common.DebugLogParams{
+				StartTime: time.Date(2024, 5, 1, 2, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2024, 5, 1, 3, 0, 0, 0, time.UTC),
+			},
+		}, {
+			args: []string{"--since", "2024-05-01", "--limit", "50", "--offset", "100", "--utc"},
+			expected: common.DebugLogParams{
+				StartTime: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				Limit:     50,
+				Offset:    100,
+			},
+		}, {
+			args: []string{"--histogram", "--level", "WARNING"},
+			expected: common.DebugLogParams{
+				Level: loggo.WARNING,
+			},
+		}, {
+			args:     []string{"--since", "yesterday"},
+			errMatch: `--since: time "yesterday" not valid`,
+		}, {
+			args:     []string{"--since", "-1h"},
+			errMatch: `--since: negative duration "-1h" not valid`,
+		}, {
+			args:     []string{"--since", "2024-05-01 03:00", "--until", "2024-05-01 02:00"},
+			errMatch: `--until before --since not valid`,
+		}, {
+			args:     []string{"--until", "1h", "--tail"},
+			errMatch: `setting --tail and --until not valid`,
+		}, {
+			args:     []string{"--until", "1h", "--lines", "10"},
+			errMatch: `setting --until and --lines not valid`,
+		}, {
+			args:     []string{"--histogram", "--limit", "10"},
+			errMatch: `setting --histogram and --limit not valid`,
+		}, {
+			args:     []string{"--histogram", "--offset", "10"},
+			errMatch: `setting --histogram and --offset not valid`,
 		},
 	} {
 		c.Logf("test %v", i)
@@ -220,6 +259,73 @@ func (s *DebugLogSuite) TestParamsPassed(c *tc.C) {
 	})
 }
 
+func (s *DebugLogSuite) TestParseLogTime(c *tc.C) {
+	tz := time.FixedZone("test", 6*60*60)
+	now := time.Date(2024, 5, 1, 23, 30, 0, 0, time.UTC)
+
+	for i, test := range []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2024-04-30T02:00:00Z", time.Date(2024, 4, 30, 2, 0, 0, 0, time.UTC)},
+		{"2024-04-30 02:00:30", time.Date(2024, 4, 30, 2, 0, 30, 0, tz)},
+		{"2024-04-30 02:00", time.Date(2024, 4, 30, 2, 0, 0, 0, tz)},
+		{"2024-04-30", time.Date(2024, 4, 30, 0, 0, 0, 0, tz)},
+		// It's already the 2nd in the test time zone.
+		{"02:00", time.Date(2024, 5, 2, 2, 0, 0, 0, tz)},
+		{"02:00:30", time.Date(2024, 5, 2, 2, 0, 30, 0, tz)},
+		{"90m", now.Add(-90 * time.Minute)},
+	} {
+		c.Logf("test %d: %s", i, test.value)
+		t, err := parseLogTime(test.value, now, tz)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(t.Equal(test.expected), tc.IsTrue, tc.Commentf("got %v", t))
+	}
+}
+
+func (s *DebugLogSuite) TestHistogramOutput(c *tc.C) {
+	debugStreams := map[string]DebugLogAPI{
+		"address-666": &fakeDebugLogAPI{histogram: map[string]int{
+			"INFO":  10,
+			"ERROR": 2,
+		}},
+		"address-668": &fakeDebugLogAPI{histogram: map[string]int{
+			"INFO":    5,
+			"WARNING": 1,
+		}},
+	}
+	s.PatchValue(&getControllerDetailsClient, func(_ context.Context, _ *debugLogCommand) (ControllerDetailsAPI, error) {
+		return &fakeControllerDetailsAPI{
+			apiVersion: 3,
+			details: map[string]highavailability.ControllerDetails{
+				"0": {ControllerID: "0", APIEndpoints: []string{"address-666"}},
+				"1": {ControllerID: "1", APIEndpoints: []string{"address-668"}},
+			},
+		}, nil
+	})
+	s.PatchValue(&getDebugLogClientForAddresses, func(_ context.Context, _ *debugLogCommand, addresses []string) (DebugLogAPI, error) {
+		api, ok := debugStreams[addresses[0]]
+		c.Assert(ok, tc.IsTrue)
+		return api, nil
+	})
+
+	store := jujuclienttesting.MinimalStore()
+	ctx, err := cmdtesting.RunCommand(c, newDebugLogCommand(store), "--histogram", "--include", "mysql/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Level    Count
+INFO     15
+WARNING  1
+ERROR    2
+Total    18
+`[1:])
+	c.Check(debugStreams["address-666"].(*fakeDebugLogAPI).params.IncludeEntity, tc.DeepEquals, []string{"unit-mysql-0"})
+
+	ctx, err = cmdtesting.RunCommand(c, newDebugLogCommand(store), "--histogram", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `{"ERROR":2,"INFO":15,"WARNING":1}`+"\n")
+}
+
 func (s *DebugLogSuite) TestLogOutput(c *tc.C) {
 	// test timezone is 6 hours east of UTC
 	tz := time.FixedZone("test", 6*60*60)
@@ -461,9 +567,10 @@ func (s *DebugLogSuite) TestLogOutputWithLogs(c *tc.C) {
 }
 
 type fakeDebugLogAPI struct {
-	log    []common.LogMessage
-	params common.DebugLogParams
-	err    error
+	log       []common.LogMessage
+	histogram map[string]int
+	params    common.DebugLogParams
+	err       error
 }
 
 func (fake *fakeDebugLogAPI) WatchDebugLog(ctx context.Context, params common.DebugLogParams) (<-chan common.LogMessage, error) {
@@ -481,6 +588,14 @@ func (fake *fakeDebugLogAPI) WatchDebugLog(ctx context.Context, params common.De
 	return response, nil
 }
 
+func (fake *fakeDebugLogAPI) DebugLogHistogram(ctx context.Context, params common.DebugLogParams) (map[string]int, error) {
+	if fake.err != nil {
+		return nil, fake.err
+	}
+	fake.params = params
+	return fake.histogram, nil
+}
+
 func (fake *fakeDebugLogAPI) Close() error {
 	return nil
 }
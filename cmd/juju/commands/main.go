@@ -32,6 +32,7 @@ import (
 	"github.com/juju/juju/cmd/juju/firewall"
 	"github.com/juju/juju/cmd/juju/machine"
 	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/cmd/juju/removal"
 	"github.com/juju/juju/cmd/juju/resource"
 	"github.com/juju/juju/cmd/juju/secretbackends"
 	"github.com/juju/juju/cmd/juju/secrets"
@@ -42,6 +43,7 @@ import (
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/juju/subnet"
 	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/cmd/juju/waitfor"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/internal/featureflag"
 	internallogger "github.com/juju/juju/internal/logger"
@@ -364,6 +366,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(status.NewStatusCommand())
 	r.Register(newSwitchCommand())
 	r.Register(status.NewStatusHistoryCommand())
+	r.Register(waitfor.NewWaitForCommand())
 
 	// Error resolution and debugging commands.
 	r.Register(action.NewExecCommand(nil))
@@ -373,6 +376,10 @@ func registerCommands(r commandRegistry) {
 	r.Register(newDebugLogCommand(nil))
 	r.Register(ssh.NewDebugHooksCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
 	r.Register(ssh.NewDebugCodeCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
+	r.Register(ssh.NewCaptureHookCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
+	r.Register(ssh.NewReplayHookCommand())
+	r.Register(ssh.NewListSSHRecordingsCommand())
+	r.Register(ssh.NewReplaySSHRecordingCommand())
 
 	// Configuration commands.
 	r.Register(model.NewModelGetConstraintsCommand())
@@ -390,6 +397,10 @@ func registerCommands(r commandRegistry) {
 	// Manage backups.
 	r.Register(backups.NewCreateCommand())
 	r.Register(backups.NewDownloadCommand())
+	r.Register(backups.NewListCommand())
+	r.Register(backups.NewShowCommand())
+	r.Register(backups.NewRemoveCommand())
+	r.Register(backups.NewRestoreCommand())
 
 	// Manage authorized ssh keys.
 	r.Register(sshkeys.NewAddKeysCommand())
@@ -427,6 +438,8 @@ func registerCommands(r commandRegistry) {
 
 	r.Register(newMigrateCommand())
 	r.Register(model.NewExportBundleCommand())
+	r.Register(model.NewExportModelCommand())
+	r.Register(model.NewImportModelCommand())
 
 	if featureflag.Enabled(featureflag.DeveloperMode) {
 		r.Register(model.NewDumpCommand())
@@ -459,6 +472,11 @@ func registerCommands(r commandRegistry) {
 	r.Register(block.NewListCommand())
 	r.Register(block.NewEnableCommand())
 
+	// Manage removal jobs
+	r.Register(removal.NewListCommand())
+	r.Register(removal.NewForceCommand())
+	r.Register(removal.NewCancelCommand())
+
 	// Manage storage
 	r.Register(storage.NewAddCommand())
 	r.Register(storage.NewListCommand())
@@ -470,6 +488,9 @@ func registerCommands(r commandRegistry) {
 	r.Register(storage.NewRemoveStorageCommandWithAPI())
 	r.Register(storage.NewDetachStorageCommandWithAPI())
 	r.Register(storage.NewAttachStorageCommandWithAPI())
+	r.Register(storage.NewResizeStorageCommandWithAPI())
+	r.Register(storage.NewCreateStorageSnapshotCommandWithAPI())
+	r.Register(storage.NewSnapshotListCommand())
 	r.Register(storage.NewImportFilesystemCommand(storage.NewStorageImporter, nil))
 
 	// Manage spaces
@@ -493,6 +514,8 @@ func registerCommands(r commandRegistry) {
 	r.Register(controller.NewRegisterCommand())
 	r.Register(controller.NewUnregisterCommand(jujuclient.NewFileClientStore()))
 	r.Register(controller.NewEnableDestroyControllerCommand())
+	r.Register(controller.NewEnableHACommand())
+	r.Register(controller.NewRemoveControllerNodeCommand())
 	r.Register(controller.NewShowControllerCommand())
 	r.Register(controller.NewConfigCommand())
 
@@ -545,6 +568,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(secrets.NewRemoveSecretCommand())
 	r.Register(secrets.NewGrantSecretCommand())
 	r.Register(secrets.NewRevokeSecretCommand())
+	r.Register(secrets.NewSecretAccessLogCommand())
 
 	// Secret backends.
 	r.Register(secretbackends.NewListSecretBackendsCommand())
@@ -553,6 +577,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(secretbackends.NewRemoveSecretBackendCommand())
 	r.Register(secretbackends.NewShowSecretBackendCommand())
 	r.Register(secretbackends.NewModelSecretBackendCommand())
+	r.Register(secretbackends.NewRotateSecretEncryptionKeyCommand())
 }
 
 type cloudToCommandAdaptor struct{}
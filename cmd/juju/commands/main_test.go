@@ -215,9 +215,12 @@ var commandNames = []string{
 	"attach-resource",
 	"attach-storage",
 	"autoload-credentials",
+	"backups",
 	"bind",
 	"bootstrap",
+	"cancel-removal",
 	"cancel-task",
+	"capture-hook",
 	"change-user-password",
 	"charm-resources",
 	"clouds",
@@ -228,6 +231,7 @@ var commandNames = []string{
 	"controllers",
 	"create-backup",
 	"create-storage-pool",
+	"create-storage-snapshot",
 	"credentials",
 	"dashboard",
 	"debug-code",
@@ -249,13 +253,16 @@ var commandNames = []string{
 	"download",
 	"enable-command",
 	"enable-destroy-controller",
+	"enable-ha",
 	"enable-user",
 	"exec",
 	"export-bundle",
+	"export-model",
 	"expose",
 	"find-offers",
 	"find",
 	"firewall-rules",
+	"force-removal",
 	"grant-cloud",
 	"grant-secret",
 	"grant",
@@ -263,11 +270,13 @@ var commandNames = []string{
 	"help-action-commands",
 	"help-hook-commands",
 	"import-filesystem",
+	"import-model",
 	"import-ssh-key",
 	"info",
 	"integrate",
 	"kill-controller",
 	"list-actions",
+	"list-backups",
 	"list-charm-resources",
 	"list-clouds",
 	"list-controllers",
@@ -279,12 +288,15 @@ var commandNames = []string{
 	"list-offers",
 	"list-operations",
 	"list-regions",
+	"list-removals",
 	"list-resources",
 	"list-secret-backends",
 	"list-secrets",
 	"list-spaces",
 	"list-ssh-keys",
+	"list-ssh-recordings",
 	"list-storage-pools",
+	"list-storage-snapshots",
 	"list-storage",
 	"list-subnets",
 	"list-users",
@@ -308,7 +320,9 @@ var commandNames = []string{
 	"relate", // alias for integrate
 	"reload-spaces",
 	"remove-application",
+	"remove-backup",
 	"remove-cloud",
+	"remove-controller-node",
 	"remove-credential",
 	"remove-k8s",
 	"remove-machine",
@@ -323,18 +337,25 @@ var commandNames = []string{
 	"remove-storage",
 	"remove-unit",
 	"remove-user",
+	"removals",
 	"rename-space",
+	"replay-hook",
+	"replay-ssh-recording",
+	"resize-storage",
 	"resolve",
 	"resolved",
+	"restore-backup",
 	"resources",
 	"resume-relation",
 	"retry-provisioning",
 	"revoke-cloud",
 	"revoke-secret",
 	"revoke",
+	"rotate-secret-encryption-key",
 	"run",
 	"scale-application",
 	"scp",
+	"secret-access-log",
 	"secret-backends",
 	"secrets",
 	"set-constraints",
@@ -345,6 +366,7 @@ var commandNames = []string{
 	"set-model-constraints",
 	"show-action",
 	"show-application",
+	"show-backup",
 	"show-cloud",
 	"show-controller",
 	"show-credential",
@@ -363,9 +385,11 @@ var commandNames = []string{
 	"show-user",
 	"spaces",
 	"ssh-keys",
+	"ssh-recordings",
 	"ssh",
 	"status",
 	"storage-pools",
+	"storage-snapshots",
 	"storage",
 	"subnets",
 	"suspend-relation",
@@ -386,6 +410,7 @@ var commandNames = []string{
 	"upgrade-model",
 	"users",
 	"version",
+	"wait-for",
 	"whoami",
 }
 
@@ -0,0 +1,68 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// WriteRemovalPlan writes the entities that a removal would affect as a
+// list, noting those that can only be removed with --force. The entity being
+// removed is identified by its kind and name, and is only listed if its
+// removal requires force.
+func WriteRemovalPlan(w io.Writer, plan params.RemovalPlan, kind, name string) {
+	groups := []struct {
+		action, kind string
+		planned      []params.PlannedRemoval
+	}{
+		{"remove", "application", plan.Applications},
+		{"remove", "unit", plan.Units},
+		{"remove", "relation", plan.Relations},
+		{"remove", "machine", plan.Machines},
+		{"remove", "offer", plan.Offers},
+		{"remove", "storage", plan.DestroyedStorage},
+		{"detach", "storage", plan.DetachedStorage},
+	}
+	isRoot := func(groupKind string, p params.PlannedRemoval) bool {
+		return groupKind == kind && p.Name == name
+	}
+	for _, group := range groups {
+		for _, p := range group.planned {
+			if isRoot(group.kind, p) && p.ForceRequired != "" {
+				_, _ = fmt.Fprintf(w, "- requires --force: %s\n", p.ForceRequired)
+			}
+		}
+	}
+	for _, group := range groups {
+		for _, p := range group.planned {
+			if isRoot(group.kind, p) {
+				continue
+			}
+			_, _ = fmt.Fprintf(w, "- will %s %s %s", group.action, group.kind, p.Name)
+			if p.ForceRequired != "" {
+				_, _ = fmt.Fprintf(w, " (requires --force: %s)", p.ForceRequired)
+			}
+			_, _ = fmt.Fprintln(w)
+		}
+	}
+}
+
+// RemovalPlanRequiresForce returns true if any entity in the plan can only be
+// removed with --force.
+func RemovalPlanRequiresForce(plan params.RemovalPlan) bool {
+	for _, planned := range [][]params.PlannedRemoval{
+		plan.Applications, plan.Units, plan.Relations, plan.Machines,
+		plan.Offers, plan.DestroyedStorage, plan.DetachedStorage,
+	} {
+		for _, p := range planned {
+			if p.ForceRequired != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
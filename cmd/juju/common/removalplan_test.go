@@ -0,0 +1,56 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalPlanSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestRemovalPlanSuite(t *testing.T) {
+	tc.Run(t, &removalPlanSuite{})
+}
+
+func (s *removalPlanSuite) TestWriteRemovalPlan(c *tc.C) {
+	plan := params.RemovalPlan{
+		Machines: []params.PlannedRemoval{
+			{Name: "0", ForceRequired: "machine has units"},
+			{Name: "0/lxd/0"},
+		},
+		Units:            []params.PlannedRemoval{{Name: "foo/0", ForceRequired: "unit is in an error state"}},
+		Relations:        []params.PlannedRemoval{{Name: "foo:db bar:db"}},
+		DestroyedStorage: []params.PlannedRemoval{{Name: "logs/1"}},
+		DetachedStorage:  []params.PlannedRemoval{{Name: "data/0"}},
+	}
+
+	var buf bytes.Buffer
+	common.WriteRemovalPlan(&buf, plan, "machine", "0")
+	c.Check(buf.String(), tc.Equals, `
+- requires --force: machine has units
+- will remove unit foo/0 (requires --force: unit is in an error state)
+- will remove relation foo:db bar:db
+- will remove machine 0/lxd/0
+- will remove storage logs/1
+- will detach storage data/0
+`[1:])
+	c.Check(common.RemovalPlanRequiresForce(plan), tc.Equals, true)
+}
+
+func (s *removalPlanSuite) TestRemovalPlanRequiresForceNone(c *tc.C) {
+	plan := params.RemovalPlan{
+		Applications: []params.PlannedRemoval{{Name: "foo"}},
+		Units:        []params.PlannedRemoval{{Name: "foo/0"}},
+	}
+	c.Check(common.RemovalPlanRequiresForce(plan), tc.Equals, false)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/highavailability"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/bootstrap"
+	"github.com/juju/juju/rpc/params"
+)
+
+var usageEnableHASummary = `
+Ensure that sufficient controllers exist to provide redundancy.`[1:]
+
+var usageEnableHADetails = `
+To ensure HA, the controller needs at least three machines, each running the
+controller application and a member of the Dqlite cluster. This command adds
+units of the controller application, on new or existing machines, until the
+controller has the requested number of machines.
+
+The number of controllers must be odd, so that the Dqlite cluster can always
+reach a majority. The default, when ` + "`-n`" + ` isn't specified, is three.
+
+Placement directives are used in turn for each new controller; new machines
+are provisioned for any controllers without a directive. A directive may be
+the ID of an existing machine, or a provider-specific directive such as a
+zone.
+
+Controllers can't be removed by this command; use ` + "`juju remove-controller-node`" + `
+to remove a controller from the Dqlite cluster before removing its machine.
+
+`[1:]
+
+const usageEnableHAExamples = `
+    juju enable-ha
+    juju enable-ha -n 5
+    juju enable-ha -n 3 --to 4,5
+    juju enable-ha --to zone=us-east-1a,zone=us-east-1b
+`
+
+// NewEnableHACommand returns a command that makes the controller highly
+// available.
+func NewEnableHACommand() cmd.Command {
+	return modelcmd.WrapController(&enableHACommand{})
+}
+
+// enableHACommand makes the controller highly available.
+type enableHACommand struct {
+	modelcmd.ControllerCommandBase
+
+	api EnableHAAPI
+	out cmd.Output
+
+	numControllers int
+	placement      string
+}
+
+// EnableHAAPI defines the high availability API methods used by the
+// enable-ha command.
+type EnableHAAPI interface {
+	EnableHA(ctx context.Context, numControllers int, cons constraints.Value, placement []string) (params.ControllersChanges, error)
+	Close() error
+}
+
+// Info implements Command.Info.
+func (c *enableHACommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "enable-ha",
+		Purpose:  usageEnableHASummary,
+		Doc:      usageEnableHADetails,
+		Examples: usageEnableHAExamples,
+		SeeAlso: []string{
+			"show-controller",
+			"remove-controller-node",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *enableHACommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.IntVar(&c.numControllers, "n", 0, "Number of controllers to make available")
+	f.StringVar(&c.placement, "to", "", "The machine(s) to become controllers, or provider-specific directives, separated by commas")
+	c.out.AddFlags(f, "simple", map[string]cmd.Formatter{
+		"yaml":   cmd.FormatYaml,
+		"json":   cmd.FormatJson,
+		"simple": formatEnableHAChanges,
+	})
+}
+
+// Init implements Command.Init.
+func (c *enableHACommand) Init(args []string) error {
+	if c.numControllers < 0 || (c.numControllers > 0 && c.numControllers%2 != 1) {
+		return errors.New("must specify a number of controllers odd and non-negative")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+func (c *enableHACommand) getAPI(ctx context.Context) (EnableHAAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewModelAPIRoot(ctx, bootstrap.ControllerModelName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return highavailability.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *enableHACommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	var placement []string
+	if c.placement != "" {
+		placement = strings.Split(c.placement, ",")
+	}
+	changes, err := client.EnableHA(ctx, c.numControllers, constraints.Value{}, placement)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return c.out.Write(ctx, availabilityInfo{
+		Added:      changes.Added,
+		Maintained: changes.Maintained,
+	})
+}
+
+// availabilityInfo holds the machines affected by enable-ha.
+type availabilityInfo struct {
+	Added      []string `json:"added,omitempty" yaml:"added,flow,omitempty"`
+	Maintained []string `json:"maintained,omitempty" yaml:"maintained,flow,omitempty"`
+}
+
+func formatEnableHAChanges(w io.Writer, value any) error {
+	info, ok := value.(availabilityInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", info, value)
+	}
+	if len(info.Maintained) > 0 {
+		if _, err := fmt.Fprintf(w, "maintaining machines: %s\n", strings.Join(info.Maintained, ", ")); err != nil {
+			return err
+		}
+	}
+	if len(info.Added) > 0 {
+		if _, err := fmt.Fprintf(w, "adding machines: %s\n", strings.Join(info.Added, ", ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
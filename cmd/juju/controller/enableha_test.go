@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/controller"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/rpc/params"
+)
+
+type enableHASuite struct {
+	baseControllerSuite
+	api   *fakeEnableHAAPI
+	store *jujuclient.MemStore
+}
+
+func TestEnableHASuite(t *testing.T) {
+	tc.Run(t, &enableHASuite{})
+}
+
+func (s *enableHASuite) SetUpTest(c *tc.C) {
+	s.baseControllerSuite.SetUpTest(c)
+
+	s.api = &fakeEnableHAAPI{
+		changes: params.ControllersChanges{
+			Added:      []string{"1", "2"},
+			Maintained: []string{"0"},
+		},
+	}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "fake"
+	s.store.Controllers["fake"] = jujuclient.ControllerDetails{}
+}
+
+func (s *enableHASuite) newCommand() cmd.Command {
+	return controller.NewEnableHACommandForTest(s.api, s.store)
+}
+
+func (s *enableHASuite) TestEnableHA(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "-n", "3", "--to", "4,zone=a")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.numControllers, tc.Equals, 3)
+	c.Check(s.api.placement, tc.DeepEquals, []string{"4", "zone=a"})
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+maintaining machines: 0
+adding machines: 1, 2
+`[1:])
+}
+
+func (s *enableHASuite) TestEnableHADefault(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.numControllers, tc.Equals, 0)
+	c.Check(s.api.placement, tc.IsNil)
+}
+
+func (s *enableHASuite) TestEnableHAYaml(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+added: ["1", "2"]
+maintained: ["0"]
+`[1:])
+}
+
+func (s *enableHASuite) TestEnableHAEven(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "-n", "2")
+	c.Assert(err, tc.ErrorMatches, "must specify a number of controllers odd and non-negative")
+}
+
+func (s *enableHASuite) TestEnableHAError(c *tc.C) {
+	s.api.err = apiservererrors.ErrPerm
+	_, err := cmdtesting.RunCommand(c, s.newCommand())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+type fakeEnableHAAPI struct {
+	numControllers int
+	placement      []string
+	changes        params.ControllersChanges
+	err            error
+}
+
+func (f *fakeEnableHAAPI) EnableHA(
+	ctx context.Context, numControllers int, cons constraints.Value, placement []string,
+) (params.ControllersChanges, error) {
+	f.numControllers = numControllers
+	f.placement = placement
+	return f.changes, f.err
+}
+
+func (*fakeEnableHAAPI) Close() error {
+	return nil
+}
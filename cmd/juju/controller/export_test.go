@@ -32,14 +32,36 @@ func NewShowControllerCommandForTest(
 	testStore jujuclient.ClientStore,
 	api func(string) ControllerAccessAPI,
 	modelConfigAPI func(controllerName string) ModelConfigAPI,
+	nodesAPI func(controllerName string) ControllerNodesAPI,
 ) *showControllerCommand {
 	return &showControllerCommand{
 		store:          testStore,
 		api:            api,
 		modelConfigAPI: modelConfigAPI,
+		nodesAPI:       nodesAPI,
 	}
 }
 
+// NewEnableHACommandForTest returns an enable-ha command with the API
+// mocked out.
+func NewEnableHACommandForTest(api EnableHAAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &enableHACommand{
+		api: api,
+	}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewRemoveControllerNodeCommandForTest returns a remove-controller-node
+// command with the API mocked out.
+func NewRemoveControllerNodeCommandForTest(api RemoveControllerNodeAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &removeControllerNodeCommand{
+		api: api,
+	}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
 type AddModelCommand struct {
 	*addModelCommand
 }
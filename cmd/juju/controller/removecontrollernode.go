@@ -0,0 +1,116 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/highavailability"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/environs/bootstrap"
+)
+
+var usageRemoveControllerNodeSummary = `
+Remove a controller from the Dqlite cluster.`[1:]
+
+var usageRemoveControllerNodeDetails = `
+Removes the Dqlite node of a controller machine from the Dqlite cluster that
+holds the controller's data. The node is demoted first, so that it no longer
+takes part in the quorum, and is then removed from the cluster.
+
+To keep the cluster available, a voting node is only removed if a majority
+of the current voters remain. Use ` + "`juju show-controller --nodes`" + ` to see the
+role of each node. The last controller can't be removed.
+
+The controller machine itself isn't removed; once its node has been removed
+from the cluster, remove the machine from the controller model with
+` + "`juju remove-machine -m controller <machine>`" + `.
+
+`[1:]
+
+const usageRemoveControllerNodeExamples = `
+    juju remove-controller-node 2
+`
+
+// NewRemoveControllerNodeCommand returns a command that removes a controller
+// from the Dqlite cluster.
+func NewRemoveControllerNodeCommand() cmd.Command {
+	return modelcmd.WrapController(&removeControllerNodeCommand{})
+}
+
+// removeControllerNodeCommand removes a controller from the Dqlite cluster.
+type removeControllerNodeCommand struct {
+	modelcmd.ControllerCommandBase
+
+	api RemoveControllerNodeAPI
+
+	machineID string
+}
+
+// RemoveControllerNodeAPI defines the high availability API methods used by
+// the remove-controller-node command.
+type RemoveControllerNodeAPI interface {
+	RemoveControllerNode(ctx context.Context, machineID string) error
+	Close() error
+}
+
+// Info implements Command.Info.
+func (c *removeControllerNodeCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-controller-node",
+		Args:     "<machine>",
+		Purpose:  usageRemoveControllerNodeSummary,
+		Doc:      usageRemoveControllerNodeDetails,
+		Examples: usageRemoveControllerNodeExamples,
+		SeeAlso: []string{
+			"enable-ha",
+			"show-controller",
+			"remove-machine",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeControllerNodeCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no controller machine specified")
+	}
+	c.machineID, args = args[0], args[1:]
+	if !names.IsValidMachine(c.machineID) {
+		return errors.NotValidf("machine %q", c.machineID)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+func (c *removeControllerNodeCommand) getAPI(ctx context.Context) (RemoveControllerNodeAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewModelAPIRoot(ctx, bootstrap.ControllerModelName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return highavailability.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *removeControllerNodeCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if err := client.RemoveControllerNode(ctx, c.machineID); err != nil {
+		return errors.Annotatef(err, "removing controller node %q", c.machineID)
+	}
+	fmt.Fprintf(ctx.Stdout, "removed controller node %q from the Dqlite cluster\n", c.machineID)
+	return nil
+}
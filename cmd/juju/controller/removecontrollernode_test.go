@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/controller"
+)
+
+type removeControllerNodeSuite struct {
+	baseControllerSuite
+	api   *fakeRemoveControllerNodeAPI
+	store *jujuclient.MemStore
+}
+
+func TestRemoveControllerNodeSuite(t *testing.T) {
+	tc.Run(t, &removeControllerNodeSuite{})
+}
+
+func (s *removeControllerNodeSuite) SetUpTest(c *tc.C) {
+	s.baseControllerSuite.SetUpTest(c)
+
+	s.api = &fakeRemoveControllerNodeAPI{}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "fake"
+	s.store.Controllers["fake"] = jujuclient.ControllerDetails{}
+}
+
+func (s *removeControllerNodeSuite) newCommand() cmd.Command {
+	return controller.NewRemoveControllerNodeCommandForTest(s.api, s.store)
+}
+
+func (s *removeControllerNodeSuite) TestRemove(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.machineID, tc.Equals, "2")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "removed controller node \"2\" from the Dqlite cluster\n")
+}
+
+func (s *removeControllerNodeSuite) TestRemoveError(c *tc.C) {
+	s.api.err = errors.New("removing controller node would lose Dqlite quorum")
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "0")
+	c.Assert(err, tc.ErrorMatches, `removing controller node "0": removing controller node would lose Dqlite quorum`)
+}
+
+func (s *removeControllerNodeSuite) TestNoMachine(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand())
+	c.Assert(err, tc.ErrorMatches, "no controller machine specified")
+}
+
+func (s *removeControllerNodeSuite) TestInvalidMachine(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "controller/0")
+	c.Assert(err, tc.ErrorMatches, `machine "controller/0" not valid`)
+}
+
+func (s *removeControllerNodeSuite) TestUnrecognizedArg(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "1", "2")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["2"\]`)
+}
+
+type fakeRemoveControllerNodeAPI struct {
+	machineID string
+	err       error
+}
+
+func (f *fakeRemoveControllerNodeAPI) RemoveControllerNode(ctx context.Context, machineID string) error {
+	f.machineID = machineID
+	return f.err
+}
+
+func (*fakeRemoveControllerNodeAPI) Close() error {
+	return nil
+}
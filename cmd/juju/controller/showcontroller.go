@@ -13,6 +13,7 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/client/highavailability"
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/api/controller/controller"
 	"github.com/juju/juju/api/jujuclient"
@@ -21,6 +22,7 @@ import (
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/bootstrap"
 	"github.com/juju/juju/internal/pki"
 	"github.com/juju/juju/rpc/params"
@@ -38,6 +40,7 @@ and user login details.
 const usageShowControllerExamples = `
     juju show-controller
     juju show-controller aws google
+    juju show-controller --nodes
 `
 
 type showControllerCommand struct {
@@ -49,9 +52,11 @@ type showControllerCommand struct {
 	api   func(controllerName string) ControllerAccessAPI
 
 	modelConfigAPI func(controllerName string) ModelConfigAPI
+	nodesAPI       func(controllerName string) ControllerNodesAPI
 
 	controllerNames []string
 	showPasswords   bool
+	showNodes       bool
 }
 
 // NewShowControllerCommand returns a command to show details of the desired controllers.
@@ -86,6 +91,7 @@ func (c *showControllerCommand) Info() *cmd.Info {
 func (c *showControllerCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.CommandBase.SetFlags(f)
 	f.BoolVar(&c.showPasswords, "show-password", false, "Show password for logged in user")
+	f.BoolVar(&c.showNodes, "nodes", false, "Show the Dqlite node and role of each controller machine")
 	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
 		"yaml": cmd.FormatYaml,
 		"json": cmd.FormatJson,
@@ -113,6 +119,13 @@ type ModelConfigAPI interface {
 	Close() error
 }
 
+// ControllerNodesAPI defines a subset of the api/client/highavailability
+// Client API.
+type ControllerNodesAPI interface {
+	ControllerDetails(ctx context.Context) (map[string]highavailability.ControllerDetails, error)
+	Close() error
+}
+
 func (c *showControllerCommand) getAPI(ctx context.Context, controllerName string) (ControllerAccessAPI, error) {
 	if c.api != nil {
 		return c.api(controllerName), nil
@@ -135,6 +148,18 @@ func (c *showControllerCommand) getModelConfigAPI(ctx context.Context, controlle
 	return modelconfig.NewClient(api), nil
 }
 
+func (c *showControllerCommand) getNodesAPI(ctx context.Context, controllerName string) (ControllerNodesAPI, error) {
+	if c.nodesAPI != nil {
+		return c.nodesAPI(controllerName), nil
+	}
+	controllerModel := jujuclient.QualifyModelName(environs.AdminUser, bootstrap.ControllerModelName)
+	api, err := c.NewAPIRoot(ctx, c.store, controllerName, controllerModel)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening API connection to the controller model")
+	}
+	return highavailability.NewClient(api), nil
+}
+
 // Run implements Command.Run
 func (c *showControllerCommand) Run(ctx *cmd.Context) error {
 	controllerNames := c.controllerNames
@@ -260,6 +285,9 @@ func (c *showControllerCommand) Run(ctx *cmd.Context) error {
 
 		c.convertControllerForShow(&details, controllerName, one, access, allModels,
 			modelStatusResults, controllerVersion, agentGitCommit, identityURL)
+		if c.showNodes {
+			c.convertDqliteNodesForShow(ctx, controllerName, &details)
+		}
 		controllers[controllerName] = details
 	}
 	return c.out.Write(ctx, controllers)
@@ -365,6 +393,13 @@ type MachineDetails struct {
 
 	// InstanceID holds the cloud instance id of the machine.
 	InstanceID string `yaml:"instance-id,omitempty" json:"instance-id,omitempty"`
+
+	// DqliteNodeID holds the id of the machine's Dqlite node.
+	DqliteNodeID uint64 `yaml:"dqlite-node-id,omitempty" json:"dqlite-node-id,omitempty"`
+
+	// DqliteRole holds the role of the machine's Dqlite node in the Dqlite
+	// cluster: voter, standby or spare, or none if it isn't a member.
+	DqliteRole string `yaml:"dqlite-role,omitempty" json:"dqlite-role,omitempty"`
 }
 
 // ModelDetails holds details of a model to show.
@@ -537,3 +572,37 @@ func (c *showControllerCommand) convertMachinesForShow(
 		nodes[m.Id] = details
 	}
 }
+
+// convertDqliteNodesForShow adds the Dqlite node of each controller machine,
+// and its role in the Dqlite cluster, to the controller machines.
+func (c *showControllerCommand) convertDqliteNodesForShow(
+	ctx context.Context,
+	controllerName string,
+	controller *ShowControllerDetails,
+) {
+	client, err := c.getNodesAPI(ctx, controllerName)
+	if err != nil {
+		controller.Errors = append(controller.Errors, err.Error())
+		return
+	}
+	defer client.Close()
+
+	nodes, err := client.ControllerDetails(ctx)
+	if err != nil {
+		controller.Errors = append(controller.Errors, errors.Annotate(err, "getting controller nodes").Error())
+		return
+	}
+
+	if controller.Machines == nil {
+		controller.Machines = make(map[string]MachineDetails)
+	}
+	for id, node := range nodes {
+		details := controller.Machines[id]
+		details.DqliteNodeID = node.DqliteNodeID
+		details.DqliteRole = node.DqliteRole
+		if details.DqliteRole == "" {
+			details.DqliteRole = "none"
+		}
+		controller.Machines[id] = details
+	}
+}
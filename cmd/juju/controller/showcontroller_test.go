@@ -13,6 +13,7 @@ import (
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/client/highavailability"
 	apicontroller "github.com/juju/juju/api/controller/controller"
 	"github.com/juju/juju/api/jujuclient"
 	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
@@ -29,6 +30,7 @@ type ShowControllerSuite struct {
 	api            func(string) controller.ControllerAccessAPI
 	setAccess      func(permission.Access)
 	modelConfigAPI func(controllerName string) controller.ModelConfigAPI
+	nodesAPI       func(controllerName string) controller.ControllerNodesAPI
 }
 
 func TestShowControllerSuite(t *testing.T) {
@@ -67,6 +69,9 @@ func (s *ShowControllerSuite) SetUpTest(c *tc.C) {
 	s.modelConfigAPI = func(controllerName string) controller.ModelConfigAPI {
 		return &fakeModelConfig{}
 	}
+	s.nodesAPI = func(controllerName string) controller.ControllerNodesAPI {
+		return &fakeControllerNodes{}
+	}
 }
 
 func (s *ShowControllerSuite) TestShowOneControllerOneInStore(c *tc.C) {
@@ -526,7 +531,7 @@ mallards:
 }
 func (s *ShowControllerSuite) runShowController(c *tc.C, args ...string) (*cmd.Context, error) {
 	return cmdtesting.RunCommand(c, controller.NewShowControllerCommandForTest(
-		s.store, s.api, s.modelConfigAPI), args...)
+		s.store, s.api, s.modelConfigAPI, s.nodesAPI), args...)
 }
 
 func (s *ShowControllerSuite) assertShowControllerFailed(c *tc.C, args ...string) {
@@ -576,6 +581,48 @@ aws-test:
 	s.assertShowController(c, "aws-test")
 }
 
+func (s *ShowControllerSuite) TestShowControllerNodes(c *tc.C) {
+	_ = s.createTestClientStore(c)
+	s.expectedOutput = `
+aws-test:
+  details:
+    controller-uuid: this-is-the-aws-test-uuid
+    api-endpoints: [this-is-aws-test-of-many-api-endpoints]
+    cloud: aws
+    region: us-east-1
+    agent-version: 999.99.99
+    agent-git-commit: badf00d0badf00d0badf00d0badf00d0badf00d0
+    controller-model-version: 999.99.99
+    ca-cert: this-is-aws-test-ca-cert
+  controller-machines:
+    "0":
+      instance-id: id-0
+      dqlite-node-id: 3297041220608546238
+      dqlite-role: voter
+    "1":
+      instance-id: id-1
+      dqlite-node-id: 1244320542380462105
+      dqlite-role: spare
+    "2":
+      instance-id: id-2
+      dqlite-node-id: 7231093408127645192
+      dqlite-role: none
+    "3":
+      instance-id: id-3
+  models:
+    controller:
+      model-uuid: ghi
+      machine-count: 2
+      core-count: 4
+  current-model: prod/controller
+  account:
+    user: admin
+    access: superuser
+`[1:]
+
+	s.assertShowController(c, "aws-test", "--nodes")
+}
+
 func (s *ShowControllerSuite) TestShowControllerPrimaryModelStatusFail(c *tc.C) {
 	_ = s.createTestClientStore(c)
 	s.expectedOutput = `
@@ -676,3 +723,17 @@ func (*fakeModelConfig) ModelGet(ctx context.Context) (map[string]any, error) {
 func (*fakeModelConfig) Close() error {
 	return nil
 }
+
+type fakeControllerNodes struct{}
+
+func (*fakeControllerNodes) ControllerDetails(ctx context.Context) (map[string]highavailability.ControllerDetails, error) {
+	return map[string]highavailability.ControllerDetails{
+		"0": {ControllerID: "0", DqliteNodeID: 3297041220608546238, DqliteRole: "voter"},
+		"1": {ControllerID: "1", DqliteNodeID: 1244320542380462105, DqliteRole: "spare"},
+		"2": {ControllerID: "2", DqliteNodeID: 7231093408127645192},
+	}, nil
+}
+
+func (*fakeControllerNodes) Close() error {
+	return nil
+}
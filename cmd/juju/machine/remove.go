@@ -18,6 +18,7 @@ import (
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/rpc/params"
 )
@@ -217,6 +218,12 @@ func (c *removeCommand) runNeedsForce(results []params.DestroyMachineResult) boo
 		if result.Error != nil {
 			continue
 		}
+		if result.Info.Plan != nil {
+			if common.RemovalPlanRequiresForce(*result.Info.Plan) {
+				return true
+			}
+			continue
+		}
 		if len(result.Info.DestroyedContainers) > 0 || len(result.Info.DestroyedUnits) > 0 {
 			return true
 		}
@@ -254,6 +261,10 @@ func (c *removeCommand) logResult(ctx *cmd.Context, result params.DestroyMachine
 	if !errorOnly {
 		c.logRemovedMachine(ctx, result.Info)
 	}
+	if result.Info.Plan != nil {
+		// The plan already includes the machine's containers.
+		return nil
+	}
 	return c.log(ctx, result.Info.DestroyedContainers, errorOnly)
 }
 
@@ -264,6 +275,10 @@ func (c *removeCommand) logRemovedMachine(ctx *cmd.Context, info *params.Destroy
 	} else {
 		_, _ = fmt.Fprintf(ctx.Stdout, "will remove machine %s\n", id)
 	}
+	if info.Plan != nil {
+		common.WriteRemovalPlan(ctx.Stdout, *info.Plan, "machine", id)
+		return
+	}
 	for _, entity := range info.DestroyedUnits {
 		unitTag, err := names.ParseUnitTag(entity.Tag)
 		if err != nil {
@@ -281,6 +281,38 @@ will remove machine 2
 `[1:])
 }
 
+func (s *RemoveMachineSuite) TestRemoveOutputDryRunPlan(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.mockApi.EXPECT().DestroyMachinesWithParams(gomock.Any(), false, false, true, gomock.Any(), "1").Return([]params.DestroyMachineResult{{
+		Info: &params.DestroyMachineInfo{
+			MachineId: "1",
+			Plan: &params.RemovalPlan{
+				Machines: []params.PlannedRemoval{
+					{Name: "1", ForceRequired: "machine has containers"},
+					{Name: "1/lxd/0"},
+				},
+				Units:           []params.PlannedRemoval{{Name: "foo/0"}},
+				DetachedStorage: []params.PlannedRemoval{{Name: "data/0"}},
+			},
+			DestroyedContainers: []params.DestroyMachineResult{{
+				Info: &params.DestroyMachineInfo{MachineId: "1/lxd/0"},
+			}},
+		},
+	}}, nil)
+
+	ctx, err := s.run(c, "--dry-run", "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, `
+will remove machine 1
+- requires --force: machine has containers
+- will remove unit foo/0
+- will remove machine 1/lxd/0
+- will detach storage data/0
+`[1:])
+	c.Assert(cmdtesting.Stderr(ctx), tc.Contains, "This will require `--force`")
+}
+
 func (s *RemoveMachineSuite) TestRemovePrompt(c *tc.C) {
 	defer s.setup(c).Finish()
 
@@ -23,6 +23,7 @@ import (
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/output"
@@ -56,6 +57,7 @@ type destroyCommand struct {
 	timeout        time.Duration
 	destroyStorage bool
 	releaseStorage bool
+	dryRun         bool
 	api            DestroyModelAPI
 
 	Force  bool
@@ -91,6 +93,10 @@ consideration or respect for clean shutdown or resource cleanup. If timeout
 elapses with ` + "`--force`" + `, you may have resources left behind that will require
 manual cleanup. If ` + "`--force --timeout 0`" + ` is passed, the model is brutally
 removed with haste. It is recommended to use graceful destroy (without ` + "`--force`" + ` or ` + "`--no-wait`" + `).
+
+Use ` + "`--dry-run`" + ` to list the applications, units, relations, machines, offers
+and storage that would be removed along with the model, and which of them
+would require ` + "`--force`" + `, without removing anything.
 `
 
 const destroyExamples = `
@@ -99,6 +105,7 @@ const destroyExamples = `
     juju destroy-model --no-prompt mymodel --release-storage
     juju destroy-model --no-prompt mymodel --force
     juju destroy-model --no-prompt mymodel --force --no-wait
+    juju destroy-model mymodel --destroy-storage --dry-run
 `
 
 var destroyModelMsg = `
@@ -136,6 +143,7 @@ type DestroyModelAPI interface {
 	Close() error
 	DestroyModel(ctx context.Context, tag names.ModelTag, destroyStorage, force *bool, maxWait *time.Duration, timeout *time.Duration) error
 	ModelStatus(ctx context.Context, models ...names.ModelTag) ([]base.ModelStatus, error)
+	PlanDestroyModel(ctx context.Context, tag names.ModelTag, destroyStorage *bool) (params.RemovalPlan, error)
 }
 
 // Info implements Command.Info.
@@ -164,6 +172,7 @@ func (c *destroyCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.releaseStorage, "release-storage", false, "Release all storage instances from the model, and management of the controller, without destroying them")
 	f.BoolVar(&c.Force, "force", false, "Force destroy model ignoring any errors")
 	f.BoolVar(&c.NoWait, "no-wait", false, "Rush through model destruction without waiting for each individual step to complete")
+	f.BoolVar(&c.dryRun, "dry-run", false, "Print what this command would remove without removing")
 	c.fs = f
 }
 
@@ -273,6 +282,10 @@ func (c *destroyCommand) Run(ctx *cmd.Context) error {
 	defer func() { _ = api.Close() }()
 
 	modelTag := names.NewModelTag(modelDetails.ModelUUID)
+	if c.dryRun {
+		return c.performDryRun(ctx, api, modelTag, modelName)
+	}
+
 	modelStatus, err := getModelStatus(ctx, modelTag, api)
 	if err != nil {
 		return err
@@ -568,6 +581,25 @@ func formatDestroyModelAbortInfo(data *modelData, timeout, force bool) string {
 	return out
 }
 
+// performDryRun prints the entities that would be removed along with the
+// model, without removing anything.
+func (c *destroyCommand) performDryRun(ctx *cmd.Context, api DestroyModelAPI, modelTag names.ModelTag, modelName string) error {
+	var destroyStorage *bool
+	if c.destroyStorage || c.releaseStorage {
+		destroyStorage = &c.destroyStorage
+	}
+	plan, err := api.PlanDestroyModel(ctx, modelTag, destroyStorage)
+	if err != nil {
+		return errors.Annotate(err, "cannot plan model destruction")
+	}
+	_, _ = fmt.Fprintf(ctx.Stdout, "will destroy model %s\n", modelName)
+	common.WriteRemovalPlan(ctx.Stdout, plan, "model", modelName)
+	if !c.Force && common.RemovalPlanRequiresForce(plan) {
+		ctx.Infof("\nThis will require `--force`")
+	}
+	return nil
+}
+
 func getModelStatus(ctx context.Context, modelTag names.ModelTag, api DestroyModelAPI) (*base.ModelStatus, error) {
 	modelStatuses, err := api.ModelStatus(ctx, modelTag)
 	if err != nil {
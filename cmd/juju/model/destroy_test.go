@@ -47,6 +47,7 @@ type fakeAPI struct {
 	statusCallCount    int
 	modelInfoErr       []*params.Error
 	modelStatusPayload []base.ModelStatus
+	plan               params.RemovalPlan
 }
 
 func (f *fakeAPI) Close() error { return nil }
@@ -56,6 +57,11 @@ func (f *fakeAPI) DestroyModel(ctx context.Context, tag names.ModelTag, destroyS
 	return f.NextErr()
 }
 
+func (f *fakeAPI) PlanDestroyModel(ctx context.Context, tag names.ModelTag, destroyStorage *bool) (params.RemovalPlan, error) {
+	f.MethodCall(f, "PlanDestroyModel", tag, destroyStorage)
+	return f.plan, f.NextErr()
+}
+
 func (f *fakeAPI) ModelStatus(_ context.Context, models ...names.ModelTag) ([]base.ModelStatus, error) {
 	var err error
 	if f.statusCallCount < len(f.modelInfoErr) {
@@ -187,6 +193,29 @@ func (s *DestroySuite) TestDestroy(c *tc.C) {
 	})
 }
 
+func (s *DestroySuite) TestDestroyDryRun(c *tc.C) {
+	s.api.plan = params.RemovalPlan{
+		Applications: []params.PlannedRemoval{{Name: "mysql"}},
+		Units:        []params.PlannedRemoval{{Name: "mysql/0", ForceRequired: "unit is in an error state"}},
+		Machines:     []params.PlannedRemoval{{Name: "0", ForceRequired: "machine has units"}},
+	}
+	ctx, err := s.runDestroyCommand(c, "test2", "--dry-run", "--destroy-storage")
+	c.Assert(err, tc.ErrorIsNil)
+	checkModelExistsInStore(c, "test1:admin/test2", s.store)
+
+	destroyStorage := true
+	s.stub.CheckCalls(c, []testhelpers.StubCall{
+		{"PlanDestroyModel", []any{names.NewModelTag("test2-uuid"), &destroyStorage}},
+	})
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+will destroy model test2
+- will remove application mysql
+- will remove unit mysql/0 (requires --force: unit is in an error state)
+- will remove machine 0 (requires --force: machine has units)
+`[1:])
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "\nThis will require `--force`\n")
+}
+
 func (s *DestroySuite) TestDestroyWithPartModelUUID(c *tc.C) {
 	checkModelExistsInStore(c, "test1:admin/test2", s.store)
 	s.api.modelStatusPayload = []base.ModelStatus{{}}
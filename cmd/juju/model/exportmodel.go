@@ -0,0 +1,145 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/modelarchive"
+)
+
+// NewExportModelCommand returns a fully constructed export-model command.
+func NewExportModelCommand() cmd.Command {
+	return modelcmd.Wrap(&exportModelCommand{})
+}
+
+// ExportModelAPI specifies the API calls used by export-model.
+type ExportModelAPI interface {
+	Close() error
+	ExportModel(context.Context) (io.ReadCloser, error)
+}
+
+type exportModelCommand struct {
+	modelcmd.ModelCommandBase
+	api ExportModelAPI
+
+	filename string
+}
+
+const exportModelHelpDoc = `
+Downloads a portable archive of the model. The archive holds the model's
+database representation, as shown by dump-model, together with every
+charm, resource and agent binary blob the model references in the
+controller object store.
+
+The archive is verified once downloaded. If it is incomplete or any blob
+does not match its recorded hash, the archive is discarded.
+
+By default the archive is written to juju-model-<model uuid>.tar.gz in
+the current directory.
+`
+
+const exportModelExamples = `
+    juju export-model
+    juju export-model -m mymodel -o mymodel.tar.gz
+`
+
+// Info implements Command.
+func (c *exportModelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "export-model",
+		Purpose:  "Writes a portable archive of the model to a file.",
+		Doc:      exportModelHelpDoc,
+		Examples: exportModelExamples,
+		SeeAlso: []string{
+			"import-model",
+			"dump-model",
+		},
+	})
+}
+
+// SetFlags implements Command.
+func (c *exportModelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.filename, "o", "", "Specify an output file")
+	f.StringVar(&c.filename, "output", "", "")
+}
+
+// Init implements Command.
+func (c *exportModelCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+func (c *exportModelCommand) getAPI(ctx context.Context) (ExportModelAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.ModelCommandBase.NewAPIClient(ctx)
+}
+
+// Run implements Command.
+func (c *exportModelCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	_, modelDetails, err := c.ModelCommandBase.ModelDetails(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting model details")
+	}
+	filename := c.filename
+	if filename == "" {
+		filename = fmt.Sprintf("juju-model-%s.tar.gz", modelDetails.ModelUUID)
+	}
+	filename = ctx.AbsPath(filename)
+
+	archive, err := client.ExportModel(ctx)
+	if err != nil {
+		return errors.Annotate(err, "exporting model")
+	}
+	defer archive.Close()
+
+	// Download into a temporary file alongside the destination, so that a
+	// partial or corrupt archive never replaces an existing file.
+	tmpFile, err := os.CreateTemp(filepath.Dir(filename), ".juju-model-*.tar.gz")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	if _, err := io.Copy(tmpFile, archive); err != nil {
+		return errors.Annotate(err, "downloading model archive")
+	}
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	manifest, err := modelarchive.Verify(tmpFile)
+	if err != nil {
+		return errors.Annotate(err, "verifying model archive")
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.Rename(tmpFile.Name(), filename); err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx.Infof("Model %s exported to %s (%d objects)", modelDetails.ModelUUID, filename, len(manifest.Objects))
+	return nil
+}
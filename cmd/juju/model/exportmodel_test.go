@@ -0,0 +1,164 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/model"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/domain/export/types/v4_0_4"
+	"github.com/juju/juju/internal/modelarchive"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+)
+
+type ExportModelCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	fake  fakeExportModelClient
+	store *jujuclient.MemStore
+}
+
+func TestExportModelCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &ExportModelCommandSuite{})
+}
+
+type fakeExportModelClient struct {
+	testhelpers.Stub
+	archive []byte
+}
+
+func (f *fakeExportModelClient) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeExportModelClient) ExportModel(ctx context.Context) (io.ReadCloser, error) {
+	f.MethodCall(f, "ExportModel")
+	if err := f.NextErr(); err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(f.archive)), nil
+}
+
+type fakeObjectGetter map[string][]byte
+
+func (f fakeObjectGetter) Get(_ context.Context, path string) (io.ReadCloser, objectstore.Digest, error) {
+	return io.NopCloser(bytes.NewReader(f[path])), objectstore.Digest{}, nil
+}
+
+// newModelArchive returns a model archive holding a single charm blob.
+func newModelArchive(c *tc.C) []byte {
+	charm := []byte("charm archive")
+	sum256 := sha256.Sum256(charm)
+	sum384 := sha512.Sum384(charm)
+	export := &domainexport.ModelExport{
+		Version: "4.0.4",
+		Payload: &v4_0_4.ModelExport{
+			ObjectStoreMetadata: []v4_0_4.ObjectStoreMetadata{{
+				UUID:   "charm-uuid",
+				Sha256: hex.EncodeToString(sum256[:]),
+				Sha384: hex.EncodeToString(sum384[:]),
+				Size:   int64(len(charm)),
+			}},
+			ObjectStoreMetadataPath: []v4_0_4.ObjectStoreMetadataPath{{
+				Path:         "charms/foo",
+				MetadataUUID: "charm-uuid",
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, testing.ModelTag.Id(), export,
+		fakeObjectGetter{"charms/foo": charm}, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(err, tc.ErrorIsNil)
+	return buf.Bytes()
+}
+
+func (s *ExportModelCommandSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.fake = fakeExportModelClient{archive: newModelArchive(c)}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+	err := s.store.UpdateModel("testing", "admin/mymodel", jujuclient.ModelDetails{
+		ModelUUID: testing.ModelTag.Id(),
+		ModelType: coremodel.IAAS,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.store.Models["testing"].CurrentModel = "admin/mymodel"
+}
+
+func (s *ExportModelCommandSuite) TestExport(c *tc.C) {
+	dir := c.MkDir()
+	filename := filepath.Join(dir, "mymodel.tar.gz")
+
+	ctx, err := cmdtesting.RunCommand(c, model.NewExportModelCommandForTest(&s.fake, s.store), "-o", filename)
+	c.Assert(err, tc.ErrorIsNil)
+	s.fake.CheckCallNames(c, "ExportModel", "Close")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals,
+		"Model "+testing.ModelTag.Id()+" exported to "+filename+" (1 objects)\n")
+
+	data, err := os.ReadFile(filename)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, s.fake.archive)
+
+	// The temporary download file is removed.
+	entries, err := os.ReadDir(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 1)
+}
+
+func (s *ExportModelCommandSuite) TestExportDefaultFilename(c *tc.C) {
+	dir := c.MkDir()
+	_, err := cmdtesting.RunCommandInDir(c, model.NewExportModelCommandForTest(&s.fake, s.store), nil, dir)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = os.Stat(filepath.Join(dir, "juju-model-"+testing.ModelTag.Id()+".tar.gz"))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *ExportModelCommandSuite) TestExportCorruptArchive(c *tc.C) {
+	s.fake.archive = s.fake.archive[:len(s.fake.archive)/2]
+	dir := c.MkDir()
+	filename := filepath.Join(dir, "mymodel.tar.gz")
+
+	_, err := cmdtesting.RunCommand(c, model.NewExportModelCommandForTest(&s.fake, s.store), "-o", filename)
+	c.Check(err, tc.ErrorMatches, `verifying model archive: .*`)
+
+	entries, err := os.ReadDir(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *ExportModelCommandSuite) TestExportError(c *tc.C) {
+	s.fake.SetErrors(errors.New("permission denied"))
+
+	_, err := cmdtesting.RunCommand(c, model.NewExportModelCommandForTest(&s.fake, s.store), "-o", filepath.Join(c.MkDir(), "out"))
+	c.Check(err, tc.ErrorMatches, `exporting model: permission denied`)
+}
+
+func (s *ExportModelCommandSuite) TestInitExtraArgs(c *tc.C) {
+	err := cmdtesting.InitCommand(model.NewExportModelCommandForTest(&s.fake, s.store), []string{"foo"})
+	c.Check(err, tc.ErrorMatches, `unrecognized args: \["foo"\]`)
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/controller/controller"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/modelarchive"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewImportModelCommand returns a fully constructed import-model command.
+func NewImportModelCommand() cmd.Command {
+	return modelcmd.WrapController(&importModelCommand{})
+}
+
+// ImportModelAPI defines the controller API methods used by the import-model
+// command.
+type ImportModelAPI interface {
+	Close() error
+	ImportModel(context.Context, io.ReadSeeker) (params.ImportModelResult, error)
+}
+
+type importModelCommand struct {
+	modelcmd.ControllerCommandBase
+	api ImportModelAPI
+
+	filename string
+	dryRun   bool
+}
+
+const importModelHelpDoc = `
+Imports a model archive written by export-model into the controller.
+
+The archive is read in full and verified before anything is sent to the
+controller: the archive must be complete, and every blob must match the
+hashes recorded in its manifest. Use --dry-run to only verify the archive
+and show its contents.
+
+The verified archive is uploaded to the controller, which creates the model
+it holds with the same UUID, name and qualifier. The user running the command
+becomes an admin of the imported model. A model with the same UUID or name
+must not already exist on the controller, and an archive of a controller
+model cannot be imported.
+`
+
+const importModelExamples = `
+    juju import-model juju-model-0a1b2c3d.tar.gz
+    juju import-model --dry-run juju-model-0a1b2c3d.tar.gz
+`
+
+// Info implements Command.
+func (c *importModelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "import-model",
+		Args:     "<filename>",
+		Purpose:  "Imports a portable model archive into the controller.",
+		Doc:      importModelHelpDoc,
+		Examples: importModelExamples,
+		SeeAlso: []string{
+			"export-model",
+		},
+	})
+}
+
+// SetFlags implements Command.
+func (c *importModelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.BoolVar(&c.dryRun, "dry-run", false, "Verify the archive without importing it")
+}
+
+// Init implements Command.
+func (c *importModelCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing archive filename")
+	}
+	c.filename, args = args[0], args[1:]
+	return cmd.CheckEmpty(args)
+}
+
+func (c *importModelCommand) getAPI(ctx context.Context) (ImportModelAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return controller.NewClient(root), nil
+}
+
+// Run implements Command.
+func (c *importModelCommand) Run(ctx *cmd.Context) error {
+	f, err := os.Open(ctx.AbsPath(c.filename))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer f.Close()
+
+	manifest, err := modelarchive.Verify(f)
+	if err != nil {
+		return errors.Annotatef(err, "verifying model archive %q", c.filename)
+	}
+
+	var size int64
+	seen := make(map[string]bool)
+	for _, obj := range manifest.Objects {
+		if !seen[obj.SHA384] {
+			size += obj.Size
+			seen[obj.SHA384] = true
+		}
+	}
+	fmt.Fprintf(ctx.Stdout, "Model:          %s\n", manifest.ModelUUID)
+	fmt.Fprintf(ctx.Stdout, "Export version: %s\n", manifest.ExportVersion)
+	fmt.Fprintf(ctx.Stdout, "Created:        %s\n", manifest.Created.UTC().Format(time.RFC3339))
+	fmt.Fprintf(ctx.Stdout, "Objects:        %d (%d bytes)\n", len(manifest.Objects), size)
+
+	if c.dryRun {
+		return nil
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	result, err := client.ImportModel(ctx, f)
+	if err != nil {
+		return errors.Annotatef(err, "importing model archive %q", c.filename)
+	}
+	modelTag, err := names.ParseModelTag(result.ModelTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Imported model %q as %s/%s", modelTag.Id(), result.Qualifier, result.Name)
+	return nil
+}
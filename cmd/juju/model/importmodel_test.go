@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ImportModelCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	fake  fakeImportModelClient
+	store *jujuclient.MemStore
+}
+
+type fakeImportModelClient struct {
+	uploaded []byte
+	err      error
+}
+
+func (f *fakeImportModelClient) Close() error {
+	return nil
+}
+
+func (f *fakeImportModelClient) ImportModel(_ context.Context, archive io.ReadSeeker) (params.ImportModelResult, error) {
+	if f.err != nil {
+		return params.ImportModelResult{}, f.err
+	}
+	data, err := io.ReadAll(archive)
+	if err != nil {
+		return params.ImportModelResult{}, err
+	}
+	f.uploaded = data
+	return params.ImportModelResult{
+		ModelTag:  testing.ModelTag.String(),
+		Name:      "foo",
+		Qualifier: "prod",
+	}, nil
+}
+
+func TestImportModelCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &ImportModelCommandSuite{})
+}
+
+func (s *ImportModelCommandSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.fake = fakeImportModelClient{}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+}
+
+func (s *ImportModelCommandSuite) writeArchive(c *tc.C, data []byte) string {
+	filename := filepath.Join(c.MkDir(), "model.tar.gz")
+	err := os.WriteFile(filename, data, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	return filename
+}
+
+func (s *ImportModelCommandSuite) TestDryRun(c *tc.C) {
+	filename := s.writeArchive(c, newModelArchive(c))
+
+	ctx, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(&s.fake, s.store), "--dry-run", filename)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Model:          `[1:]+testing.ModelTag.Id()+`
+Export version: 4.0.4
+Created:        2026-01-01T00:00:00Z
+Objects:        1 (13 bytes)
+`)
+}
+
+func (s *ImportModelCommandSuite) TestImport(c *tc.C) {
+	data := newModelArchive(c)
+	filename := s.writeArchive(c, data)
+
+	ctx, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(&s.fake, s.store), filename)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.fake.uploaded, tc.DeepEquals, data)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `Imported model "`+testing.ModelTag.Id()+`" as prod/foo`+"\n")
+}
+
+func (s *ImportModelCommandSuite) TestImportError(c *tc.C) {
+	s.fake.err = errors.New("boom")
+	filename := s.writeArchive(c, newModelArchive(c))
+
+	_, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(&s.fake, s.store), filename)
+	c.Check(err, tc.ErrorMatches, `importing model archive ".*": boom`)
+}
+
+func (s *ImportModelCommandSuite) TestDryRunDoesNotImport(c *tc.C) {
+	filename := s.writeArchive(c, newModelArchive(c))
+
+	_, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(&s.fake, s.store), "--dry-run", filename)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.fake.uploaded, tc.IsNil)
+}
+
+func (s *ImportModelCommandSuite) TestCorruptArchive(c *tc.C) {
+	data := newModelArchive(c)
+	filename := s.writeArchive(c, data[:len(data)/2])
+
+	_, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(&s.fake, s.store), "--dry-run", filename)
+	c.Check(err, tc.ErrorMatches, `verifying model archive ".*": .*`)
+}
+
+func (s *ImportModelCommandSuite) TestInitMissingFilename(c *tc.C) {
+	err := cmdtesting.InitCommand(model.NewImportModelCommandForTest(&s.fake, s.store), nil)
+	c.Check(err, tc.ErrorMatches, `missing archive filename`)
+}
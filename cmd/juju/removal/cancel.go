@@ -0,0 +1,74 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const cancelCommandDoc = `
+Cancels pending removal jobs that have not yet been attempted, such as a
+removal that was scheduled for later.
+
+Cancelling a job does not restore the entity it was removing; an entity
+that is already dying stays dying. Jobs that have been attempted can not
+be cancelled.
+
+Job UUIDs are shown by ` + "`juju removals`" + `.
+`
+
+const cancelCommandExamples = `
+    juju cancel-removal 9d8a6f1e-7c3b-4b5a-8e2f-1a2b3c4d5e6f
+`
+
+// NewCancelCommand returns a command that cancels removal jobs.
+func NewCancelCommand() cmd.Command {
+	return modelcmd.Wrap(&cancelCommand{})
+}
+
+// cancelCommand cancels removal jobs that have not yet been attempted.
+type cancelCommand struct {
+	removalCommandBase
+
+	uuids []string
+}
+
+// Info implements Command.Info.
+func (c *cancelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "cancel-removal",
+		Args:     "<removal uuid> [<removal uuid>...]",
+		Purpose:  "Cancels pending removal jobs that have not started.",
+		Doc:      cancelCommandDoc,
+		Examples: cancelCommandExamples,
+		SeeAlso: []string{
+			"removals",
+			"force-removal",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *cancelCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no removal job UUIDs specified")
+	}
+	c.uuids = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *cancelCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	return errors.Trace(client.CancelRemovals(ctx, c.uuids...))
+}
@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package removal provides the commands that let operators inspect the
+// pending jobs removing entities from a model, and intervene in jobs that
+// are stuck.
+package removal
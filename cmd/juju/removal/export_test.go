@@ -0,0 +1,38 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewListCommandForTest returns a removals command using the input API and
+// clock.
+func NewListCommandForTest(store jujuclient.ClientStore, api RemovalsAPI, clock clock.Clock) cmd.Command {
+	c := &listCommand{clock: clock}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+// NewForceCommandForTest returns a force-removal command using the input API.
+func NewForceCommandForTest(store jujuclient.ClientStore, api RemovalsAPI) cmd.Command {
+	c := &forceCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+// NewCancelCommandForTest returns a cancel-removal command using the input
+// API.
+func NewCancelCommandForTest(store jujuclient.ClientStore, api RemovalsAPI) cmd.Command {
+	c := &cancelCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
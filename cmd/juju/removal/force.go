@@ -0,0 +1,77 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const forceCommandDoc = `
+Escalates pending removal jobs so that they are processed with ` + "`--force`" + `,
+as if the removal had been requested with that option.
+
+From its next attempt onwards, a forced job ignores errors that would
+otherwise stop the removal, such as a unit hook failing or the cloud
+provider failing to release a machine. This may leave resources behind
+that require manual cleanup.
+
+Job UUIDs are shown by ` + "`juju removals`" + `.
+`
+
+const forceCommandExamples = `
+    juju force-removal 9d8a6f1e-7c3b-4b5a-8e2f-1a2b3c4d5e6f
+`
+
+// NewForceCommand returns a command that escalates removal jobs to forced.
+func NewForceCommand() cmd.Command {
+	return modelcmd.Wrap(&forceCommand{})
+}
+
+// forceCommand escalates removal jobs to forced.
+type forceCommand struct {
+	removalCommandBase
+
+	uuids []string
+}
+
+// Info implements Command.Info.
+func (c *forceCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "force-removal",
+		Args:     "<removal uuid> [<removal uuid>...]",
+		Purpose:  "Forces pending removal jobs.",
+		Doc:      forceCommandDoc,
+		Examples: forceCommandExamples,
+		SeeAlso: []string{
+			"removals",
+			"cancel-removal",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *forceCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no removal job UUIDs specified")
+	}
+	c.uuids = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *forceCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	err = client.ForceRemovals(ctx, c.uuids...)
+	return block.ProcessBlockedError(err, block.BlockRemove)
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/removals"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RemovalsAPI defines the API methods used by the removal commands.
+type RemovalsAPI interface {
+	Close() error
+	ListRemovals(ctx context.Context) ([]params.RemovalJob, error)
+	ForceRemovals(ctx context.Context, uuids ...string) error
+	CancelRemovals(ctx context.Context, uuids ...string) error
+}
+
+// removalCommandBase holds what is common to the removal commands.
+type removalCommandBase struct {
+	modelcmd.ModelCommandBase
+
+	api RemovalsAPI
+}
+
+func (c *removalCommandBase) getAPI(ctx context.Context) (RemovalsAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return removals.NewClient(root), nil
+}
+
+const listCommandDoc = `
+Lists the jobs that are pending to remove entities from the model.
+
+When an application, unit, machine or other entity is removed, a removal
+job is created that is processed in the background until the entity is
+gone. Jobs that remain here for a long time are usually waiting on
+something outside of the model, such as the cloud provider releasing a
+machine, and their last error shows why.
+
+A stuck job can be escalated with ` + "`juju force-removal`" + `, and a job that has
+not yet been attempted can be cancelled with ` + "`juju cancel-removal`" + `.
+`
+
+const listCommandExamples = `
+    juju removals
+    juju removals --format yaml
+`
+
+// NewListCommand returns a command that lists the pending removal jobs for
+// a model.
+func NewListCommand() cmd.Command {
+	return modelcmd.Wrap(&listCommand{clock: clock.WallClock})
+}
+
+// listCommand lists the pending removal jobs for a model.
+type listCommand struct {
+	removalCommandBase
+
+	clock clock.Clock
+	out   cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *listCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "removals",
+		Purpose:  "Lists pending removal jobs.",
+		Doc:      listCommandDoc,
+		Aliases:  []string{"list-removals"},
+		Examples: listCommandExamples,
+		SeeAlso: []string{
+			"force-removal",
+			"cancel-removal",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatRemovalsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+type removalJobDetails struct {
+	UUID            string        `json:"uuid" yaml:"uuid"`
+	Type            string        `json:"type" yaml:"type"`
+	Entity          string        `json:"entity" yaml:"entity"`
+	EntityUUID      string        `json:"entity-uuid" yaml:"entity-uuid"`
+	Age             time.Duration `json:"age" yaml:"age"`
+	Force           bool          `json:"force" yaml:"force"`
+	ScheduledFor    time.Time     `json:"scheduled-for" yaml:"scheduled-for"`
+	Attempts        int           `json:"attempts" yaml:"attempts"`
+	LastAttemptedAt *time.Time    `json:"last-attempted-at,omitempty" yaml:"last-attempted-at,omitempty"`
+	LastError       string        `json:"last-error,omitempty" yaml:"last-error,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *listCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	jobs, err := client.ListRemovals(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(jobs) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No removals are pending.")
+		return nil
+	}
+
+	now := c.clock.Now()
+	details := make([]removalJobDetails, len(jobs))
+	for i, job := range jobs {
+		entity := job.EntityName
+		if entity == "" {
+			entity = job.EntityUUID
+		}
+		details[i] = removalJobDetails{
+			UUID:            job.UUID,
+			Type:            job.Type,
+			Entity:          entity,
+			EntityUUID:      job.EntityUUID,
+			Age:             now.Sub(job.CreatedAt).Round(time.Second),
+			Force:           job.Force,
+			ScheduledFor:    job.ScheduledFor,
+			Attempts:        job.Attempts,
+			LastAttemptedAt: job.LastAttemptedAt,
+			LastError:       job.LastError,
+		}
+	}
+	return c.out.Write(ctx, details)
+}
+
+func formatRemovalsTabular(writer io.Writer, value any) error {
+	jobs, ok := value.([]removalJobDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", jobs, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.SetColumnAlignRight(5)
+
+	w.Println("UUID", "Type", "Entity", "Age", "Force", "Attempts", "Last error")
+	for _, job := range jobs {
+		w.Println(
+			job.UUID,
+			job.Type,
+			job.Entity,
+			job.Age,
+			job.Force,
+			job.Attempts,
+			job.LastError,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal_test
+
+import (
+	"context"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/removal"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+
+	api *fakeRemovalsAPI
+}
+
+func TestRemovalSuite(t *stdtesting.T) {
+	tc.Run(t, &removalSuite{})
+}
+
+func (s *removalSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeRemovalsAPI{Stub: &testhelpers.Stub{}}
+}
+
+func (s *removalSuite) TestListEmpty(c *tc.C) {
+	command := removal.NewListCommandForTest(jujuclienttesting.MinimalStore(), s.api, testclock.NewClock(time.Now()))
+	ctx, err := cmdtesting.RunCommand(c, command)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No removals are pending.\n")
+}
+
+func (s *removalSuite) TestListTabular(c *tc.C) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.api.jobs = []params.RemovalJob{{
+		UUID:       "job-1",
+		Type:       "machine",
+		EntityUUID: "machine-uuid",
+		EntityName: "0",
+		CreatedAt:  created,
+		Attempts:   12,
+		LastError:  "instance still running",
+	}, {
+		UUID:       "job-2",
+		Type:       "relation",
+		EntityUUID: "relation-uuid",
+		Force:      true,
+		CreatedAt:  created.Add(time.Hour),
+	}}
+
+	now := created.Add(2*time.Hour + 30*time.Second)
+	command := removal.NewListCommandForTest(jujuclienttesting.MinimalStore(), s.api, testclock.NewClock(now))
+	ctx, err := cmdtesting.RunCommand(c, command)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+UUID   Type      Entity         Age      Force  Attempts  Last error
+job-1  machine   0              2h0m30s  false        12  instance still running
+job-2  relation  relation-uuid  1h0m30s  true          0  
+`[1:])
+}
+
+func (s *removalSuite) TestForce(c *tc.C) {
+	command := removal.NewForceCommandForTest(jujuclienttesting.MinimalStore(), s.api)
+	_, err := cmdtesting.RunCommand(c, command, "job-1", "job-2")
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "ForceRemovals", Args: []any{[]string{"job-1", "job-2"}}},
+		{FuncName: "Close"},
+	})
+}
+
+func (s *removalSuite) TestForceNoArgs(c *tc.C) {
+	command := removal.NewForceCommandForTest(jujuclienttesting.MinimalStore(), s.api)
+	_, err := cmdtesting.RunCommand(c, command)
+	c.Assert(err, tc.ErrorMatches, "no removal job UUIDs specified")
+}
+
+func (s *removalSuite) TestForceBlocked(c *tc.C) {
+	s.api.SetErrors(&params.Error{Code: params.CodeOperationBlocked, Message: "blocked"})
+
+	command := removal.NewForceCommandForTest(jujuclienttesting.MinimalStore(), s.api)
+	_, err := cmdtesting.RunCommand(c, command, "job-1")
+	c.Assert(err, tc.ErrorMatches, "(?s).*All operations that remove.*")
+}
+
+func (s *removalSuite) TestCancel(c *tc.C) {
+	command := removal.NewCancelCommandForTest(jujuclienttesting.MinimalStore(), s.api)
+	_, err := cmdtesting.RunCommand(c, command, "job-1")
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "CancelRemovals", Args: []any{[]string{"job-1"}}},
+		{FuncName: "Close"},
+	})
+}
+
+type fakeRemovalsAPI struct {
+	*testhelpers.Stub
+
+	jobs []params.RemovalJob
+}
+
+func (f *fakeRemovalsAPI) Close() error {
+	f.MethodCall(f, "Close")
+	return nil
+}
+
+func (f *fakeRemovalsAPI) ListRemovals(ctx context.Context) ([]params.RemovalJob, error) {
+	f.MethodCall(f, "ListRemovals")
+	return f.jobs, f.NextErr()
+}
+
+func (f *fakeRemovalsAPI) ForceRemovals(ctx context.Context, uuids ...string) error {
+	f.MethodCall(f, "ForceRemovals", uuids)
+	return f.NextErr()
+}
+
+func (f *fakeRemovalsAPI) CancelRemovals(ctx context.Context, uuids ...string) error {
+	f.MethodCall(f, "CancelRemovals", uuids)
+	return f.NextErr()
+}
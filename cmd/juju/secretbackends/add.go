@@ -57,6 +57,7 @@ const addSecretBackendsExamples = `
     juju add-secret-backend myvault vault --config /path/to/cfg.yaml
     juju add-secret-backend myvault vault token-rotate=10m --config /path/to/cfg.yaml
     juju add-secret-backend myvault vault endpoint=https://vault.io:8200 token=s.1wshwhw
+    juju add-secret-backend myfiles file path=/srv/juju-secrets key=$(head -c 32 /dev/urandom | base64)
 `
 
 // AddSecretBackendsAPI is the secrets client API.
@@ -105,6 +105,20 @@ type secretBackendDisplayDetails struct {
 	Message             string               `json:"message,omitempty" yaml:"message,omitempty"`
 	ID                  string               `json:"id,omitempty" yaml:"id,omitempty"`
 	Error               string               `json:"error,omitempty" yaml:"error,omitempty"`
+	Drains              []secretBackendDrain `json:"drains,omitempty" yaml:"drains,omitempty"`
+}
+
+// secretBackendDrain is the progress of a model's secrets being drained to
+// or from a secret backend.
+type secretBackendDrain struct {
+	Model     string    `json:"model" yaml:"model"`
+	From      string    `json:"from" yaml:"from"`
+	To        string    `json:"to" yaml:"to"`
+	Status    string    `json:"status" yaml:"status"`
+	Started   time.Time `json:"started" yaml:"started"`
+	Revisions int       `json:"revisions" yaml:"revisions"`
+	Moved     int       `json:"moved" yaml:"moved"`
+	Verified  int       `json:"verified" yaml:"verified"`
 }
 
 // Run implements cmd.Run.
@@ -157,6 +171,18 @@ func gatherSecretBackendInfo(backends []secretbackends.SecretBackend) map[string
 			info.Config = make(provider.ConfigAttrs)
 			maps.Copy(info.Config, b.Config)
 		}
+		for _, d := range b.Drains {
+			info.Drains = append(info.Drains, secretBackendDrain{
+				Model:     d.ModelName,
+				From:      d.SourceBackend,
+				To:        d.TargetBackend,
+				Status:    d.Status,
+				Started:   d.StartedAt,
+				Revisions: d.TotalRevisions,
+				Moved:     d.MovedRevisions,
+				Verified:  d.VerifiedRevisions,
+			})
+		}
 		details[info.Name] = info
 	}
 	return details
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
 	api "github.com/juju/juju/api/client/modelconfig"
 	jujucmd "github.com/juju/juju/cmd"
@@ -23,12 +24,14 @@ type modelSecretBackendCommand struct {
 	getAPIFunc func(ctx context.Context) (ModelSecretBackendAPI, error)
 
 	secretBackendName *string
+	abort             bool
 }
 
 // ModelSecretBackendAPI is the mdoel secret backend client API.
 type ModelSecretBackendAPI interface {
 	GetModelSecretBackend(ctx context.Context) (string, error)
 	SetModelSecretBackend(ctx context.Context, secretBackendName string) error
+	AbortModelSecretBackendDrain(ctx context.Context) error
 	Close() error
 }
 
@@ -50,6 +53,12 @@ func (c *modelSecretBackendCommand) secretBackendAPI(ctx context.Context) (Model
 const (
 	modelSecretBackendDoc = `
 Sets or displays the secret backend for the current model.
+
+When the secret backend is changed, the model's existing secrets are drained
+from the previous backend to the new one. The progress of the drain is shown
+by "show-secret-backend". Use --abort to stop a drain that is in progress; the
+model reverts to its previous secret backend and any secrets already drained
+are moved back to it.
 `
 	modelSecretBackendExamples = `
 Display the secret backend for the current model:
@@ -59,6 +68,10 @@ Display the secret backend for the current model:
 Set the secret backend to myVault for the current model:
 
     juju model-secret-backend myVault
+
+Abort draining secrets to the new secret backend for the current model:
+
+    juju model-secret-backend --abort
 `
 )
 
@@ -80,8 +93,20 @@ func (c *modelSecretBackendCommand) Info() *cmd.Info {
 	})
 }
 
+// SetFlags implements cmd.Command.
+func (c *modelSecretBackendCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.abort, "abort", false, "Abort draining secrets to the model's secret backend")
+}
+
 // Init implements cmd.Command.
 func (c *modelSecretBackendCommand) Init(args []string) error {
+	if c.abort {
+		if len(args) > 0 {
+			return errors.New("cannot specify a secret backend name with --abort")
+		}
+		return nil
+	}
 	if len(args) == 0 {
 		return nil
 	}
@@ -103,6 +128,17 @@ func (c *modelSecretBackendCommand) Run(ctx *cmd.Context) error {
 	}
 	defer func() { _ = api.Close() }()
 
+	if c.abort {
+		err := api.AbortModelSecretBackendDrain(ctx.Context)
+		if errors.Is(err, errors.NotSupported) {
+			return errors.New(`aborting a secret backend drain has not been implemented on the controller`)
+		} else if errors.Is(err, errors.NotFound) {
+			return errors.New("no secrets are being drained to the model's secret backend")
+		} else if errors.Is(err, secretbackenderrors.Forbidden) {
+			return errors.New("the secret backend drain has already been aborted")
+		}
+		return errors.Trace(err)
+	}
 	if c.secretBackendName == nil {
 		secretBackendName, err := api.GetModelSecretBackend(ctx.Context)
 		if errors.Is(err, errors.NotSupported) {
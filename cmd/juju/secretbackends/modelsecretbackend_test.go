@@ -108,3 +108,40 @@ func (s *modelSecretBackendCommandSuite) TestSetFailedMoreThanOneArgs(c *tc.C) {
 	_, err := cmdtesting.RunCommand(c, secretbackends.NewModelCredentialCommandForTest(s.store, s.secretsAPI), "foo", "bar")
 	c.Assert(err, tc.ErrorMatches, "cannot specify multiple secret backend names")
 }
+
+func (s *modelSecretBackendCommandSuite) TestAbort(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().AbortModelSecretBackendDrain(gomock.Any()).Return(nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewModelCredentialCommandForTest(s.store, s.secretsAPI), "--abort")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *modelSecretBackendCommandSuite) TestAbortNoDrain(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().AbortModelSecretBackendDrain(gomock.Any()).Return(errors.NotFoundf("secret backend drain"))
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewModelCredentialCommandForTest(s.store, s.secretsAPI), "--abort")
+	c.Assert(err, tc.ErrorMatches, "no secrets are being drained to the model's secret backend")
+}
+
+func (s *modelSecretBackendCommandSuite) TestAbortAlreadyAborted(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().AbortModelSecretBackendDrain(gomock.Any()).Return(secretbackenderrors.Forbidden)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewModelCredentialCommandForTest(s.store, s.secretsAPI), "--abort")
+	c.Assert(err, tc.ErrorMatches, "the secret backend drain has already been aborted")
+}
+
+func (s *modelSecretBackendCommandSuite) TestAbortWithBackendName(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewModelCredentialCommandForTest(s.store, s.secretsAPI), "--abort", "myVault")
+	c.Assert(err, tc.ErrorMatches, "cannot specify a secret backend name with --abort")
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackends
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/client/secretbackends"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+type rotateSecretEncryptionKeyCommand struct {
+	modelcmd.ControllerCommandBase
+
+	RotateSecretEncryptionKeyAPIFunc func(ctx context.Context) (RotateSecretEncryptionKeyAPI, error)
+}
+
+var rotateSecretEncryptionKeyDoc = `
+Secret content stored in the internal juju backend is encrypted with a
+per-model data key, which is itself encrypted with a controller-wide key.
+This command generates a new controller key and re-encrypts each model's data
+key with it. Secret content does not need to be re-encrypted, so secrets remain
+available throughout.
+
+The previous controller key is removed once every model has been updated. If
+any model could not be updated, the previous key is retained and the command
+can be run again to complete the rotation.
+`
+
+const rotateSecretEncryptionKeyExamples = `
+    juju rotate-secret-encryption-key
+`
+
+// RotateSecretEncryptionKeyAPI is the secret backends client API.
+type RotateSecretEncryptionKeyAPI interface {
+	RotateSecretEncryptionKey(context.Context) (secretbackends.RotateSecretEncryptionKeyResult, error)
+	Close() error
+}
+
+// NewRotateSecretEncryptionKeyCommand returns a command to rotate the
+// controller secret encryption key.
+func NewRotateSecretEncryptionKeyCommand() cmd.Command {
+	c := &rotateSecretEncryptionKeyCommand{}
+	c.RotateSecretEncryptionKeyAPIFunc = c.secretBackendsAPI
+
+	return modelcmd.WrapController(c)
+}
+
+func (c *rotateSecretEncryptionKeyCommand) secretBackendsAPI(ctx context.Context) (RotateSecretEncryptionKeyAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return secretbackends.NewClient(root), nil
+}
+
+// Info implements cmd.Info.
+func (c *rotateSecretEncryptionKeyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rotate-secret-encryption-key",
+		Purpose:  "Rotates the key used to encrypt secrets stored in the controller.",
+		Doc:      rotateSecretEncryptionKeyDoc,
+		Examples: rotateSecretEncryptionKeyExamples,
+		SeeAlso: []string{
+			"secret-backends",
+		},
+	})
+}
+
+// Init implements cmd.Init.
+func (c *rotateSecretEncryptionKeyCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Run.
+func (c *rotateSecretEncryptionKeyCommand) Run(ctxt *cmd.Context) error {
+	api, err := c.RotateSecretEncryptionKeyAPIFunc(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	result, err := api.RotateSecretEncryptionKey(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(result.FailedModels) == 0 {
+		ctxt.Infof("secret encryption key rotated to %s", result.KeyUUID)
+		return nil
+	}
+
+	modelUUIDs := make([]string, 0, len(result.FailedModels))
+	for modelUUID := range result.FailedModels {
+		modelUUIDs = append(modelUUIDs, modelUUID)
+	}
+	sort.Strings(modelUUIDs)
+	for _, modelUUID := range modelUUIDs {
+		cmd.WriteError(ctxt.Stderr, errors.Annotatef(result.FailedModels[modelUUID], "model %s", modelUUID))
+	}
+	fmt.Fprintf(ctxt.Stderr,
+		"secret encryption key rotated to %s, but %d model(s) still use the previous key; run the command again to complete the rotation\n",
+		result.KeyUUID, len(modelUUIDs))
+	return cmd.ErrSilent
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackends_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apisecretbackends "github.com/juju/juju/api/client/secretbackends"
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/secretbackends"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type RotateKeySuite struct {
+	testhelpers.IsolationSuite
+	store     *jujuclient.MemStore
+	rotateAPI *secretbackends.MockRotateSecretEncryptionKeyAPI
+}
+
+func TestRotateKeySuite(t *testing.T) {
+	tc.Run(t, &RotateKeySuite{})
+}
+
+func (s *RotateKeySuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *RotateKeySuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.rotateAPI = secretbackends.NewMockRotateSecretEncryptionKeyAPI(ctrl)
+
+	return ctrl
+}
+
+func (s *RotateKeySuite) TestRotateInitError(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.rotateAPI), "extra")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *RotateKeySuite) TestRotate(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.rotateAPI.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(apisecretbackends.RotateSecretEncryptionKeyResult{
+		KeyUUID:            "key-uuid",
+		RetiredKeysRemoved: true,
+	}, nil)
+	s.rotateAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.rotateAPI))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "secret encryption key rotated to key-uuid\n")
+}
+
+func (s *RotateKeySuite) TestRotateModelFailures(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.rotateAPI.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(apisecretbackends.RotateSecretEncryptionKeyResult{
+		KeyUUID: "key-uuid",
+		FailedModels: map[string]error{
+			"model-b": errors.New("boom"),
+			"model-a": errors.New("bang"),
+		},
+	}, nil)
+	s.rotateAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.rotateAPI))
+	c.Assert(err, tc.Equals, cmd.ErrSilent)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+ERROR model model-a: bang
+ERROR model model-b: boom
+secret encryption key rotated to key-uuid, but 2 model(s) still use the previous key; run the command again to complete the rotation
+`[1:])
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secretbackends (interfaces: ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI)
+// Source: github.com/juju/juju/cmd/juju/secretbackends (interfaces: ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI,RotateSecretEncryptionKeyAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package secretbackends -destination secretbackendsapi_mock_test.go github.com/juju/juju/cmd/juju/secretbackends ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI
+//	mockgen -typed -package secretbackends -destination secretbackendsapi_mock_test.go github.com/juju/juju/cmd/juju/secretbackends ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI,RotateSecretEncryptionKeyAPI
 //
 
 // Package secretbackends is a generated GoMock package.
@@ -437,6 +437,44 @@ func (m *MockModelSecretBackendAPI) EXPECT() *MockModelSecretBackendAPIMockRecor
 	return m.recorder
 }
 
+// AbortModelSecretBackendDrain mocks base method.
+func (m *MockModelSecretBackendAPI) AbortModelSecretBackendDrain(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortModelSecretBackendDrain", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortModelSecretBackendDrain indicates an expected call of AbortModelSecretBackendDrain.
+func (mr *MockModelSecretBackendAPIMockRecorder) AbortModelSecretBackendDrain(arg0 any) *MockModelSecretBackendAPIAbortModelSecretBackendDrainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortModelSecretBackendDrain", reflect.TypeOf((*MockModelSecretBackendAPI)(nil).AbortModelSecretBackendDrain), arg0)
+	return &MockModelSecretBackendAPIAbortModelSecretBackendDrainCall{Call: call}
+}
+
+// MockModelSecretBackendAPIAbortModelSecretBackendDrainCall wrap *gomock.Call
+type MockModelSecretBackendAPIAbortModelSecretBackendDrainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelSecretBackendAPIAbortModelSecretBackendDrainCall) Return(arg0 error) *MockModelSecretBackendAPIAbortModelSecretBackendDrainCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelSecretBackendAPIAbortModelSecretBackendDrainCall) Do(f func(context.Context) error) *MockModelSecretBackendAPIAbortModelSecretBackendDrainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelSecretBackendAPIAbortModelSecretBackendDrainCall) DoAndReturn(f func(context.Context) error) *MockModelSecretBackendAPIAbortModelSecretBackendDrainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Close mocks base method.
 func (m *MockModelSecretBackendAPI) Close() error {
 	m.ctrl.T.Helper()
@@ -551,3 +589,103 @@ func (c *MockModelSecretBackendAPISetModelSecretBackendCall) DoAndReturn(f func(
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockRotateSecretEncryptionKeyAPI is a mock of RotateSecretEncryptionKeyAPI interface.
+type MockRotateSecretEncryptionKeyAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRotateSecretEncryptionKeyAPIMockRecorder
+}
+
+// MockRotateSecretEncryptionKeyAPIMockRecorder is the mock recorder for MockRotateSecretEncryptionKeyAPI.
+type MockRotateSecretEncryptionKeyAPIMockRecorder struct {
+	mock *MockRotateSecretEncryptionKeyAPI
+}
+
+// NewMockRotateSecretEncryptionKeyAPI creates a new mock instance.
+func NewMockRotateSecretEncryptionKeyAPI(ctrl *gomock.Controller) *MockRotateSecretEncryptionKeyAPI {
+	mock := &MockRotateSecretEncryptionKeyAPI{ctrl: ctrl}
+	mock.recorder = &MockRotateSecretEncryptionKeyAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRotateSecretEncryptionKeyAPI) EXPECT() *MockRotateSecretEncryptionKeyAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockRotateSecretEncryptionKeyAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockRotateSecretEncryptionKeyAPIMockRecorder) Close() *MockRotateSecretEncryptionKeyAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRotateSecretEncryptionKeyAPI)(nil).Close))
+	return &MockRotateSecretEncryptionKeyAPICloseCall{Call: call}
+}
+
+// MockRotateSecretEncryptionKeyAPICloseCall wrap *gomock.Call
+type MockRotateSecretEncryptionKeyAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRotateSecretEncryptionKeyAPICloseCall) Return(arg0 error) *MockRotateSecretEncryptionKeyAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRotateSecretEncryptionKeyAPICloseCall) Do(f func() error) *MockRotateSecretEncryptionKeyAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRotateSecretEncryptionKeyAPICloseCall) DoAndReturn(f func() error) *MockRotateSecretEncryptionKeyAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RotateSecretEncryptionKey mocks base method.
+func (m *MockRotateSecretEncryptionKeyAPI) RotateSecretEncryptionKey(arg0 context.Context) (secretbackends.RotateSecretEncryptionKeyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecretEncryptionKey", arg0)
+	ret0, _ := ret[0].(secretbackends.RotateSecretEncryptionKeyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSecretEncryptionKey indicates an expected call of RotateSecretEncryptionKey.
+func (mr *MockRotateSecretEncryptionKeyAPIMockRecorder) RotateSecretEncryptionKey(arg0 any) *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecretEncryptionKey", reflect.TypeOf((*MockRotateSecretEncryptionKeyAPI)(nil).RotateSecretEncryptionKey), arg0)
+	return &MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall{Call: call}
+}
+
+// MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall wrap *gomock.Call
+type MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall) Return(arg0 secretbackends.RotateSecretEncryptionKeyResult, arg1 error) *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall) Do(f func(context.Context) (secretbackends.RotateSecretEncryptionKeyResult, error)) *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall) DoAndReturn(f func(context.Context) (secretbackends.RotateSecretEncryptionKeyResult, error)) *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -27,6 +27,10 @@ type showSecretBackendCommand struct {
 
 var showSecretBackendsDoc = `
 Displays the specified secret backend.
+
+If any model's secrets are being drained to or from the backend, the progress
+of each drain is shown: the number of secret revisions to move, how many have
+been moved, and how many have been verified in the new backend.
 `
 
 const showSecretBackendsExamples = `
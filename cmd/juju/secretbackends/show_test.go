@@ -76,3 +76,50 @@ myvault:
   id: vault-id
 `[1:])
 }
+
+func (s *ShowSuite) TestShowYAMLWithDrains(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	started := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	s.secretBackendsAPI.EXPECT().ListSecretBackends(gomock.Any(), []string{"myvault"}, false).Return(
+		[]apisecretbackends.SecretBackend{{
+			ID:          "vault-id",
+			Name:        "myvault",
+			BackendType: "vault",
+			NumSecrets:  2,
+			Status:      status.Active,
+			Drains: []apisecretbackends.SecretBackendDrain{{
+				ModelUUID:         "model-uuid",
+				ModelName:         "mymodel",
+				SourceBackend:     "internal",
+				TargetBackend:     "myvault",
+				Status:            "draining",
+				StartedAt:         started,
+				TotalRevisions:    5,
+				MovedRevisions:    2,
+				VerifiedRevisions: 2,
+			}},
+		}}, nil)
+
+	s.secretBackendsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secretbackends.NewShowCommandForTest(s.store, s.secretBackendsAPI), "myvault")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, `
+myvault:
+  backend: vault
+  secrets: 2
+  status: active
+  id: vault-id
+  drains:
+  - model: mymodel
+    from: internal
+    to: myvault
+    status: draining
+    started: 2026-10-18T09:30:00Z
+    revisions: 5
+    moved: 2
+    verified: 2
+`[1:])
+}
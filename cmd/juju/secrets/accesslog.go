@@ -0,0 +1,223 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+type secretAccessLogCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+
+	secretsAPIFunc func(ctx context.Context) (SecretAccessLogAPI, error)
+	uri            *coresecrets.URI
+	name           string
+	sinceValue     string
+	since          *time.Time
+	limit          uint
+}
+
+var secretAccessLogDoc = `
+Displays the audit trail of a secret: each read of its content, and each
+grant or revoke of access to it, oldest first.
+
+Reads are recorded against the unit or user which read the content, along
+with the revision read and, if the content is held in an external secret
+backend, the ID of that backend. Grants and revokes are recorded against
+the unit or user which made the change, along with the application, unit
+or model whose access was changed.
+
+Use --since to only show entries recorded at or after a time, given as a
+duration before now (e.g. 24h), a date or an RFC3339 time. Use --limit to
+only show the most recent entries.
+
+The log is retained by the model after the secret is removed, until it is
+pruned according to the max-secret-access-log-age and
+max-secret-access-log-entries model config. Only controller and model
+admins can view it.
+`
+
+const secretAccessLogExamples = `
+    juju secret-access-log my-secret
+    juju secret-access-log 9m4e2mr0ui3e8a215n4g
+    juju secret-access-log secret:9m4e2mr0ui3e8a215n4g --format yaml
+    juju secret-access-log my-secret --since 24h
+    juju secret-access-log my-secret --since 2026-10-01 --limit 50
+`
+
+// SecretAccessLogAPI is the secrets client API.
+type SecretAccessLogAPI interface {
+	SecretAccessLog(
+		ctx context.Context, uri *coresecrets.URI, name string, since *time.Time, limit int,
+	) ([]apisecrets.SecretAccessLogEntry, error)
+	Close() error
+}
+
+// NewSecretAccessLogCommand returns a command to show the access log of a secret.
+func NewSecretAccessLogCommand() cmd.Command {
+	c := &secretAccessLogCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+
+	return modelcmd.Wrap(c)
+}
+
+func (c *secretAccessLogCommand) secretsAPI(ctx context.Context) (SecretAccessLogAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+// Info implements cmd.Info.
+func (c *secretAccessLogCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "secret-access-log",
+		Args:     "<ID>|<name>",
+		Purpose:  "Shows the reads of, and changes to access to, a secret.",
+		Doc:      secretAccessLogDoc,
+		Examples: secretAccessLogExamples,
+		SeeAlso: []string{
+			"show-secret",
+			"grant-secret",
+			"revoke-secret",
+		},
+	})
+}
+
+// SetFlags implements cmd.SetFlags.
+func (c *secretAccessLogCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSecretAccessLogTabular,
+	})
+	f.StringVar(&c.sinceValue, "since", "", "Only show entries recorded at or after this time")
+	f.UintVar(&c.limit, "limit", 0, "Only show this many of the most recent entries")
+}
+
+// Init implements cmd.Init.
+func (c *secretAccessLogCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.Errorf("secret ID is required")
+	}
+	uri, err := coresecrets.ParseURI(args[0])
+	if err != nil {
+		c.name = args[0]
+	}
+	c.uri = uri
+	if c.sinceValue != "" {
+		since, err := parseSince(c.sinceValue, time.Now())
+		if err != nil {
+			return errors.Annotate(err, "--since")
+		}
+		c.since = &since
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// sinceLayouts are the layouts accepted by --since, in addition to a
+// duration before now. Times without a zone are in UTC.
+var sinceLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseSince parses the time given to --since.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return time.Time{}, errors.NotValidf("negative duration %q", value)
+		}
+		return now.Add(-d), nil
+	}
+	for _, layout := range sinceLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.NotValidf("time %q", value)
+}
+
+type secretAccessLogEntry struct {
+	Time     time.Time `json:"time" yaml:"time"`
+	Action   string    `json:"action" yaml:"action"`
+	Revision *int      `json:"revision,omitempty" yaml:"revision,omitempty"`
+	Accessor string    `json:"accessor" yaml:"accessor"`
+	Subject  string    `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Backend  string    `json:"backend,omitempty" yaml:"backend,omitempty"`
+}
+
+// Run implements cmd.Run.
+func (c *secretAccessLogCommand) Run(ctxt *cmd.Context) error {
+	api, err := c.secretsAPIFunc(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	entries, err := api.SecretAccessLog(ctxt, c.uri, c.name, c.since, int(c.limit))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	result := make([]secretAccessLogEntry, len(entries))
+	for i, e := range entries {
+		result[i] = secretAccessLogEntry{
+			Time:     e.Time,
+			Action:   e.Action,
+			Revision: e.Revision,
+			Accessor: e.Accessor.String(),
+			Backend:  e.BackendID,
+		}
+		if e.Subject != nil {
+			result[i].Subject = e.Subject.String()
+		}
+	}
+	return c.out.Write(ctxt, result)
+}
+
+// formatSecretAccessLogTabular writes a tabular summary of a secret access log.
+func formatSecretAccessLogTabular(writer io.Writer, value any) error {
+	entries, ok := value.([]secretAccessLogEntry)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", entries, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.SetColumnAlignRight(2)
+
+	w.Println("Time", "Action", "Revision", "Accessor", "Subject", "Backend")
+	for _, e := range entries {
+		var revision any = "-"
+		if e.Revision != nil {
+			revision = *e.Revision
+		}
+		subject := e.Subject
+		if subject == "" {
+			subject = "-"
+		}
+		backend := e.Backend
+		if backend == "" {
+			backend = "-"
+		}
+		w.Print(e.Time.UTC().Format(time.RFC3339), e.Action, revision, e.Accessor, subject, backend)
+		w.Println()
+	}
+	return tw.Flush()
+}
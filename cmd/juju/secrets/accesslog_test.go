@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"context"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type AccessLogSuite struct {
+	testhelpers.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockSecretAccessLogAPI
+}
+
+func TestAccessLogSuite(t *stdtesting.T) {
+	tc.Run(t, &AccessLogSuite{})
+}
+
+func (s *AccessLogSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *AccessLogSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.secretsAPI = mocks.NewMockSecretAccessLogAPI(ctrl)
+
+	return ctrl
+}
+
+func (s *AccessLogSuite) entries() []apisecrets.SecretAccessLogEntry {
+	when := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	return []apisecrets.SecretAccessLogEntry{{
+		Action:   "grant",
+		Accessor: names.NewUserTag("fred"),
+		Subject:  names.NewApplicationTag("gitlab"),
+		Time:     when,
+	}, {
+		Action:    "read",
+		Revision:  new(2),
+		Accessor:  names.NewUnitTag("gitlab/0"),
+		BackendID: "backend-id",
+		Time:      when.Add(time.Minute),
+	}}
+}
+
+func (s *AccessLogSuite) TestInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI))
+	c.Assert(err, tc.ErrorMatches, "secret ID is required")
+	_, err = cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "my-secret", "extra")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "my-secret", "--since", "yesterday")
+	c.Assert(err, tc.ErrorMatches, `--since: time "yesterday" not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "my-secret", "--since", "-1h")
+	c.Assert(err, tc.ErrorMatches, `--since: negative duration "-1h" not valid`)
+}
+
+func (s *AccessLogSuite) TestAccessLogSinceAndLimit(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	since := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), nil, "my-secret", &since, 1).Return(s.entries()[1:], nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI),
+		"my-secret", "--since", "2026-10-18T09:00:00Z", "--limit", "1", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, `[{"time":"2026-10-18T09:31:00Z","action":"read","revision":2,"accessor":"unit-gitlab-0","backend":"backend-id"}]`+"\n")
+}
+
+func (s *AccessLogSuite) TestAccessLogSinceDuration(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	start := time.Now()
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), nil, "my-secret", gomock.Any(), 0).
+		DoAndReturn(func(_ context.Context, _ *coresecrets.URI, _ string, since *time.Time, _ int) ([]apisecrets.SecretAccessLogEntry, error) {
+			c.Assert(since, tc.NotNil)
+			c.Check(since.Before(start.Add(-time.Hour)), tc.IsFalse)
+			c.Check(since.After(time.Now().Add(-time.Hour)), tc.IsFalse)
+			return nil, nil
+		})
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI),
+		"my-secret", "--since", "1h")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *AccessLogSuite) TestAccessLogTabular(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), uri, "", nil, 0).Return(s.entries(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), uri.ID)
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, `
+Time                  Action  Revision  Accessor       Subject             Backend
+2026-10-18T09:30:00Z  grant          -  user-fred      application-gitlab  -           
+2026-10-18T09:31:00Z  read           2  unit-gitlab-0  -                   backend-id  
+`[1:])
+}
+
+func (s *AccessLogSuite) TestAccessLogYAMLByName(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), nil, "my-secret", nil, 0).Return(s.entries(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewSecretAccessLogCommandForTest(s.store, s.secretsAPI), "my-secret", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, `
+- time: 2026-10-18T09:30:00Z
+  action: grant
+  accessor: user-fred
+  subject: application-gitlab
+- time: 2026-10-18T09:31:00Z
+  action: read
+  revision: 2
+  accessor: unit-gitlab-0
+  backend: backend-id
+`[1:])
+}
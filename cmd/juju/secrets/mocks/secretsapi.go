@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
+// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,SecretAccessLogAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,SecretAccessLogAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	secrets "github.com/juju/juju/api/client/secrets"
 	secrets0 "github.com/juju/juju/core/secrets"
@@ -554,3 +555,103 @@ func (c *MockRemoveSecretsAPIRemoveSecretCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSecretAccessLogAPI is a mock of SecretAccessLogAPI interface.
+type MockSecretAccessLogAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretAccessLogAPIMockRecorder
+}
+
+// MockSecretAccessLogAPIMockRecorder is the mock recorder for MockSecretAccessLogAPI.
+type MockSecretAccessLogAPIMockRecorder struct {
+	mock *MockSecretAccessLogAPI
+}
+
+// NewMockSecretAccessLogAPI creates a new mock instance.
+func NewMockSecretAccessLogAPI(ctrl *gomock.Controller) *MockSecretAccessLogAPI {
+	mock := &MockSecretAccessLogAPI{ctrl: ctrl}
+	mock.recorder = &MockSecretAccessLogAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretAccessLogAPI) EXPECT() *MockSecretAccessLogAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSecretAccessLogAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSecretAccessLogAPIMockRecorder) Close() *MockSecretAccessLogAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSecretAccessLogAPI)(nil).Close))
+	return &MockSecretAccessLogAPICloseCall{Call: call}
+}
+
+// MockSecretAccessLogAPICloseCall wrap *gomock.Call
+type MockSecretAccessLogAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAccessLogAPICloseCall) Return(arg0 error) *MockSecretAccessLogAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAccessLogAPICloseCall) Do(f func() error) *MockSecretAccessLogAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAccessLogAPICloseCall) DoAndReturn(f func() error) *MockSecretAccessLogAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SecretAccessLog mocks base method.
+func (m *MockSecretAccessLogAPI) SecretAccessLog(arg0 context.Context, arg1 *secrets0.URI, arg2 string, arg3 *time.Time, arg4 int) ([]secrets.SecretAccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretAccessLog", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]secrets.SecretAccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretAccessLog indicates an expected call of SecretAccessLog.
+func (mr *MockSecretAccessLogAPIMockRecorder) SecretAccessLog(arg0, arg1, arg2, arg3, arg4 any) *MockSecretAccessLogAPISecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretAccessLog", reflect.TypeOf((*MockSecretAccessLogAPI)(nil).SecretAccessLog), arg0, arg1, arg2, arg3, arg4)
+	return &MockSecretAccessLogAPISecretAccessLogCall{Call: call}
+}
+
+// MockSecretAccessLogAPISecretAccessLogCall wrap *gomock.Call
+type MockSecretAccessLogAPISecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAccessLogAPISecretAccessLogCall) Return(arg0 []secrets.SecretAccessLogEntry, arg1 error) *MockSecretAccessLogAPISecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAccessLogAPISecretAccessLogCall) Do(f func(context.Context, *secrets0.URI, string, *time.Time, int) ([]secrets.SecretAccessLogEntry, error)) *MockSecretAccessLogAPISecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAccessLogAPISecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, *time.Time, int) ([]secrets.SecretAccessLogEntry, error)) *MockSecretAccessLogAPISecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/juju/juju/api/jujuclient"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,SecretAccessLogAPI
 
 // NewAddCommandForTest returns a secrets command for testing.
 func NewAddCommandForTest(store jujuclient.ClientStore, api AddSecretsAPI) *addSecretCommand {
@@ -73,3 +73,12 @@ func NewShowCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecr
 	c.SetClientStore(store)
 	return c
 }
+
+// NewSecretAccessLogCommandForTest returns a secret-access-log command for testing.
+func NewSecretAccessLogCommandForTest(store jujuclient.ClientStore, api SecretAccessLogAPI) *secretAccessLogCommand {
+	c := &secretAccessLogCommand{
+		secretsAPIFunc: func(ctx context.Context) (SecretAccessLogAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/retry"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/network/ssh"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+)
+
+func NewCaptureHookCommand(hostChecker ssh.ReachableChecker, retryStrategy retry.CallArgs, publicKeyRetryStrategy retry.CallArgs) cmd.Command {
+	c := new(captureHookCommand)
+	c.hostChecker = hostChecker
+	c.retryStrategy = retryStrategy
+	c.publicKeyRetryStrategy = publicKeyRetryStrategy
+	return modelcmd.Wrap(c)
+}
+
+// captureHookCommand connects via SSH to a running unit, and waits for the
+// execution context of the next matching hook or action to be captured.
+type captureHookCommand struct {
+	debugHooksCommand
+	output string
+}
+
+const captureHookDoc = `
+The command waits for the next matching hook or action to run on the unit,
+and downloads a bundle holding the context it ran in: its environment, the
+application config, relation data, leadership, secrets metadata and goal
+state, amongst others. The hook or action itself runs as normal.
+
+The bundle can then be used with ` + "`juju replay-hook`" + ` to run the hook
+again, away from the controller, with the hook tools answering from the
+bundle. Secret contents and resources are not captured.
+
+Only a single hook or action is captured per invocation.
+
+Valid unit identifiers are:
+- a standard unit ID, such as ` + "`mysql/0`" + ` or;
+- leader syntax of the form ` + "`<application>/leader`" + `, such as ` + "`mysql/leader`" + `.
+
+If no hook or action is specified, the next hook or action is captured.
+
+See ` + "`juju help ssh`" + ` for information about SSH related options
+accepted by the ` + "`capture-hook`" + ` command.
+`
+
+const usageCaptureHookExamples = `
+Capture the next hook or action of unit ` + "`mysql/0`" + `:
+
+    juju capture-hook mysql/0
+
+Capture the next ` + "`config-changed`" + ` hook of the leader of ` + "`mysql`" + ` to a file:
+
+    juju capture-hook -o config-changed.json mysql/leader config-changed
+`
+
+func (c *captureHookCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "capture-hook",
+		Args:     "<unit name> [hook or action names]",
+		Purpose:  "Capture the execution context of a hook or action for local replay.",
+		Doc:      captureHookDoc,
+		Examples: usageCaptureHookExamples,
+		SeeAlso: []string{
+			"replay-hook",
+			"debug-hooks",
+		},
+	})
+}
+
+func (c *captureHookCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.debugHooksCommand.SetFlags(f)
+	f.StringVar(&c.output, "o", "", "Write the bundle to this file, instead of <unit>-<hook>.json")
+	f.StringVar(&c.output, "output", "", "")
+}
+
+func (c *captureHookCommand) Init(args []string) error {
+	return c.debugHooksCommand.Init(args)
+}
+
+// Run ensures c.Target is a unit, and resolves its address, and connects to
+// it via SSH to request the capture and download the captured bundle.
+func (c *captureHookCommand) Run(ctx *cmd.Context) error {
+	if err := c.initAPIs(ctx); err != nil {
+		return err
+	}
+	defer c.closeAPIs()
+
+	if err := c.validateHooksOrActions(ctx); err != nil {
+		return err
+	}
+	unitName, err := c.provider.maybeResolveLeaderUnit(ctx, c.provider.getTarget())
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// The bundle is downloaded to a temporary file, so that an interrupted
+	// capture does not leave a partial bundle behind.
+	dir := ctx.Dir
+	if c.output != "" {
+		dir = filepath.Dir(ctx.AbsPath(c.output))
+	}
+	f, err := os.CreateTemp(dir, ".capture-hook-*")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	clientScript := capture.ClientScript(capture.NewHookCapture(unitName), c.hooks)
+	b64Script := base64.StdEncoding.EncodeToString([]byte(clientScript))
+	innercmd := fmt.Sprintf(`F=$(mktemp); echo %s | base64 -d > $F; chmod +x $F; exec $F`, b64Script)
+	c.provider.setArgs([]string{fmt.Sprintf(c.decideEntryPoint(ctx), innercmd)})
+
+	sshCtx := *ctx
+	sshCtx.Stdout = f
+	err = c.sshCommand.Run(&sshCtx)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	bundle, err := capture.ReadBundle(f.Name())
+	if err != nil {
+		return errors.Annotate(err, "downloading hook capture bundle")
+	}
+	output := c.output
+	if output == "" {
+		output = fmt.Sprintf("%s-%s.json", strings.ReplaceAll(bundle.Unit, "/", "-"), bundle.Hook)
+	}
+	output = filepath.Join(dir, filepath.Base(output))
+	if err := os.Rename(f.Name(), output); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Captured %s of unit %s to %s", bundle.Hook, bundle.Unit, output)
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apicharm "github.com/juju/juju/api/common/charm"
+	"github.com/juju/juju/api/common/charms"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+)
+
+func TestCaptureHookSuite(t *testing.T) {
+	tc.Run(t, &CaptureHookSuite{})
+}
+
+type CaptureHookSuite struct {
+	SSHMachineSuite
+}
+
+// fakeCaptureSSH records its arguments, and writes a captured bundle to
+// stdout as the capture-hook client script would.
+const fakeCaptureSSH = `#!/bin/bash
+echo "$@" > $0.args
+echo '{"version": 1, "unit": "mysql/0", "hook": "install", "context-id": "mysql/0-install-1"}'
+`
+
+func (s *CaptureHookSuite) writeFakeSSH(c *tc.C, script string) {
+	err := os.WriteFile(filepath.Join(s.binDir, "ssh"), []byte(script), 0777)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *CaptureHookSuite) newCommand(c *tc.C, ctrl *gomock.Controller) *captureHookCommand {
+	ssh, app, status := s.setupModel(ctrl, false, false, nil, nil, "mysql/0")
+	app.EXPECT().GetCharmURLOrigin(gomock.Any(), "mysql").Return(charm.MustParseURL("mysql"), apicharm.Origin{}, nil)
+
+	charmAPI := mocks.NewMockCharmAPI(ctrl)
+	chInfo := &charms.CharmInfo{Meta: &meta, Actions: &actions}
+	charmAPI.EXPECT().CharmInfo(gomock.Any(), "ch:mysql").Return(chInfo, nil)
+	charmAPI.EXPECT().Close().Return(nil)
+
+	s.setHostChecker(validAddresses("0.public"))
+	return NewCaptureHookCommandForTest(app, ssh, status, charmAPI, s.hostChecker, baseTestingRetryStrategy, baseTestingRetryStrategy)
+}
+
+func (s *CaptureHookSuite) TestCaptureHook(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.writeFakeSSH(c, fakeCaptureSSH)
+
+	ctx, err := cmdtesting.RunCommand(c, modelcmd.Wrap(s.newCommand(c, ctrl)), "mysql/0", "install")
+	c.Assert(err, tc.ErrorIsNil)
+
+	output := filepath.Join(ctx.Dir, "mysql-0-install.json")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Captured install of unit mysql/0 to "+output+"\n")
+	bundle, err := capture.ReadBundle(output)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bundle.ContextId, tc.Equals, "mysql/0-install-1")
+
+	// The client script requesting the capture of the install hook is
+	// run on the unit.
+	args, err := os.ReadFile(filepath.Join(s.binDir, "ssh.args"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(args), tc.Matches, `(?s).*ubuntu@0\.public exec sudo .+`)
+	b64Script := regexp.MustCompile(`echo ([A-Za-z0-9+/]+=*) \| base64`).FindStringSubmatch(string(args))
+	c.Assert(b64Script, tc.HasLen, 2)
+	script, err := base64.StdEncoding.DecodeString(b64Script[1])
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(script), tc.Equals, capture.ClientScript(capture.NewHookCapture("mysql/0"), []string{"install"}))
+}
+
+func (s *CaptureHookSuite) TestCaptureHookOutput(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.writeFakeSSH(c, fakeCaptureSSH)
+
+	output := filepath.Join(c.MkDir(), "captured.json")
+	_, err := cmdtesting.RunCommand(c, modelcmd.Wrap(s.newCommand(c, ctrl)), "-o", output, "mysql/0", "install")
+	c.Assert(err, tc.ErrorIsNil)
+
+	bundle, err := capture.ReadBundle(output)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bundle.Hook, tc.Equals, "install")
+
+	// Nothing but the bundle is left behind.
+	entries, err := os.ReadDir(filepath.Dir(output))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 1)
+}
+
+func (s *CaptureHookSuite) TestCaptureHookInvalidBundle(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.writeFakeSSH(c, "#!/bin/bash\necho interrupted\n")
+
+	dir := c.MkDir()
+	_, err := cmdtesting.RunCommand(c, modelcmd.Wrap(s.newCommand(c, ctrl)), "-o", filepath.Join(dir, "captured.json"), "mysql/0", "install")
+	c.Assert(err, tc.ErrorMatches, `downloading hook capture bundle: parsing hook capture bundle .*`)
+
+	entries, err := os.ReadDir(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
@@ -7,15 +7,20 @@ import (
 	"context"
 	"net/url"
 
+	"github.com/juju/clock"
+	"github.com/juju/errors"
 	"github.com/juju/retry"
 
 	"github.com/juju/juju/api/jujuclient"
 	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/environs/cloudspec"
 	jujussh "github.com/juju/juju/internal/network/ssh"
 	k8sexec "github.com/juju/juju/internal/provider/kubernetes/exec"
 	"github.com/juju/juju/internal/uuid"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
 )
 
 type (
@@ -191,6 +196,36 @@ func NewDebugHooksCommandForTest(
 	return c
 }
 
+func NewCaptureHookCommandForTest(
+	applicationAPI ApplicationAPI,
+	sshClient SSHClientAPI,
+	statusClient StatusClientAPI,
+	charmAPI CharmAPI,
+	hostChecker jujussh.ReachableChecker,
+	retryStrategy retry.CallArgs,
+	publicKeyRetryStrategy retry.CallArgs,
+) *captureHookCommand {
+	c := &captureHookCommand{
+		debugHooksCommand: *NewDebugHooksCommandForTest(
+			applicationAPI, sshClient, statusClient, charmAPI,
+			hostChecker, retryStrategy, publicKeyRetryStrategy,
+		),
+	}
+	c.SetClientStore(clientStore())
+	return c
+}
+
+func NewReplayHookCommandForTest(
+	replay func(context.Context, capture.ReplayArgs) ([]string, error),
+	lookPath func(string) (string, error),
+) *replayHookCommand {
+	return &replayHookCommand{
+		replay:     replay,
+		lookPath:   lookPath,
+		executable: func() (string, error) { return "", errors.New("no executable") },
+	}
+}
+
 func NewDebugCodeCommandForTest(
 	applicationAPI ApplicationAPI,
 	sshClient SSHClientAPI,
@@ -220,3 +255,17 @@ func NewDebugCodeCommandForTest(
 	c.SetClientStore(clientStore())
 	return c
 }
+
+func NewListSSHRecordingsCommandForTest(api SSHRecordingsAPI) cmd.Command {
+	c := &listSSHRecordingsCommand{}
+	c.api = api
+	c.SetClientStore(clientStore())
+	return modelcmd.WrapController(c)
+}
+
+func NewReplaySSHRecordingCommandForTest(api SSHRecordingsAPI, clock clock.Clock) cmd.Command {
+	c := &replaySSHRecordingCommand{clock: clock}
+	c.api = api
+	c.SetClientStore(clientStore())
+	return modelcmd.WrapController(c)
+}
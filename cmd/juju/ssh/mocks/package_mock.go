@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/ssh (interfaces: Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand)
+// Source: github.com/juju/juju/cmd/juju/ssh (interfaces: Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand,SSHRecordingsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/package_mock.go github.com/juju/juju/cmd/juju/ssh Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand
+//	mockgen -typed -package mocks -destination mocks/package_mock.go github.com/juju/juju/cmd/juju/ssh Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand,SSHRecordingsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -1663,3 +1663,142 @@ func (c *MockModelCommandNewControllerAPIRootCall) DoAndReturn(f func(context.Co
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSSHRecordingsAPI is a mock of SSHRecordingsAPI interface.
+type MockSSHRecordingsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHRecordingsAPIMockRecorder
+}
+
+// MockSSHRecordingsAPIMockRecorder is the mock recorder for MockSSHRecordingsAPI.
+type MockSSHRecordingsAPIMockRecorder struct {
+	mock *MockSSHRecordingsAPI
+}
+
+// NewMockSSHRecordingsAPI creates a new mock instance.
+func NewMockSSHRecordingsAPI(ctrl *gomock.Controller) *MockSSHRecordingsAPI {
+	mock := &MockSSHRecordingsAPI{ctrl: ctrl}
+	mock.recorder = &MockSSHRecordingsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHRecordingsAPI) EXPECT() *MockSSHRecordingsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSSHRecordingsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSSHRecordingsAPIMockRecorder) Close() *MockSSHRecordingsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSSHRecordingsAPI)(nil).Close))
+	return &MockSSHRecordingsAPICloseCall{Call: call}
+}
+
+// MockSSHRecordingsAPICloseCall wrap *gomock.Call
+type MockSSHRecordingsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHRecordingsAPICloseCall) Return(arg0 error) *MockSSHRecordingsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHRecordingsAPICloseCall) Do(f func() error) *MockSSHRecordingsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHRecordingsAPICloseCall) DoAndReturn(f func() error) *MockSSHRecordingsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockSSHRecordingsAPI) List(arg0 context.Context, arg1 string) ([]params.SSHRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]params.SSHRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSSHRecordingsAPIMockRecorder) List(arg0, arg1 any) *MockSSHRecordingsAPIListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSSHRecordingsAPI)(nil).List), arg0, arg1)
+	return &MockSSHRecordingsAPIListCall{Call: call}
+}
+
+// MockSSHRecordingsAPIListCall wrap *gomock.Call
+type MockSSHRecordingsAPIListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHRecordingsAPIListCall) Return(arg0 []params.SSHRecording, arg1 error) *MockSSHRecordingsAPIListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHRecordingsAPIListCall) Do(f func(context.Context, string) ([]params.SSHRecording, error)) *MockSSHRecordingsAPIListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHRecordingsAPIListCall) DoAndReturn(f func(context.Context, string) ([]params.SSHRecording, error)) *MockSSHRecordingsAPIListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Open mocks base method.
+func (m *MockSSHRecordingsAPI) Open(arg0 context.Context, arg1 string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockSSHRecordingsAPIMockRecorder) Open(arg0, arg1 any) *MockSSHRecordingsAPIOpenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockSSHRecordingsAPI)(nil).Open), arg0, arg1)
+	return &MockSSHRecordingsAPIOpenCall{Call: call}
+}
+
+// MockSSHRecordingsAPIOpenCall wrap *gomock.Call
+type MockSSHRecordingsAPIOpenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHRecordingsAPIOpenCall) Return(arg0 io.ReadCloser, arg1 error) *MockSSHRecordingsAPIOpenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHRecordingsAPIOpenCall) Do(f func(context.Context, string) (io.ReadCloser, error)) *MockSSHRecordingsAPIOpenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHRecordingsAPIOpenCall) DoAndReturn(f func(context.Context, string) (io.ReadCloser, error)) *MockSSHRecordingsAPIOpenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,317 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/client/sshrecordings"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/asciicast"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SSHRecordingsAPI defines the API used to list and download the
+// recordings of SSH sessions proxied by the controller.
+type SSHRecordingsAPI interface {
+	List(ctx context.Context, user string) ([]params.SSHRecording, error)
+	Open(ctx context.Context, uuid string) (io.ReadCloser, error)
+	Close() error
+}
+
+type sshRecordingsAPI struct {
+	*sshrecordings.Client
+	root api.Connection
+}
+
+// Close closes the connection the recordings are read from.
+func (a sshRecordingsAPI) Close() error {
+	return a.root.Close()
+}
+
+// sshRecordingsCommandBase holds what is common to the commands working
+// with SSH session recordings.
+type sshRecordingsCommandBase struct {
+	modelcmd.ControllerCommandBase
+
+	api SSHRecordingsAPI
+}
+
+func (c *sshRecordingsCommandBase) getAPI(ctx context.Context) (SSHRecordingsAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sshRecordingsAPI{
+		Client: sshrecordings.NewClient(root),
+		root:   root,
+	}, nil
+}
+
+const listRecordingsDoc = `
+Lists the recorded ` + "`juju ssh`" + ` sessions proxied by the controller.
+
+Sessions are only recorded while the ` + "`ssh-session-recording`" + ` controller
+configuration key is enabled. Each recording is identified by a UUID, which
+can be passed to ` + "`juju replay-ssh-recording`" + ` to play the session back.
+`
+
+const listRecordingsExamples = `
+    juju ssh-recordings
+    juju ssh-recordings --user bob --format yaml
+`
+
+// NewListSSHRecordingsCommand returns a command to list SSH session
+// recordings.
+func NewListSSHRecordingsCommand() cmd.Command {
+	return modelcmd.WrapController(&listSSHRecordingsCommand{})
+}
+
+// listSSHRecordingsCommand lists the SSH session recordings stored by the
+// controller.
+type listSSHRecordingsCommand struct {
+	sshRecordingsCommandBase
+
+	out  cmd.Output
+	user string
+}
+
+// Info implements Command.Info.
+func (c *listSSHRecordingsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "ssh-recordings",
+		Purpose:  "Lists recorded SSH sessions.",
+		Doc:      listRecordingsDoc,
+		Aliases:  []string{"list-ssh-recordings"},
+		Examples: listRecordingsExamples,
+		SeeAlso: []string{
+			"ssh",
+			"replay-ssh-recording",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listSSHRecordingsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.user, "user", "", "Only list the sessions of this user")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSSHRecordingsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listSSHRecordingsCommand) Init(args []string) error {
+	if c.user != "" && !names.IsValidUser(c.user) {
+		return errors.NotValidf("user %q", c.user)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+type sshRecordingDetails struct {
+	UUID     string        `json:"uuid" yaml:"uuid"`
+	User     string        `json:"user" yaml:"user"`
+	Target   string        `json:"target" yaml:"target"`
+	Started  time.Time     `json:"started" yaml:"started"`
+	Ended    time.Time     `json:"ended" yaml:"ended"`
+	Duration time.Duration `json:"duration" yaml:"duration"`
+	Size     int64         `json:"size" yaml:"size"`
+}
+
+// Run implements Command.Run.
+func (c *listSSHRecordingsCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	recordings, err := client.List(ctx, c.user)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(recordings) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No SSH session recordings to display.")
+		return nil
+	}
+
+	details := make([]sshRecordingDetails, len(recordings))
+	for i, rec := range recordings {
+		details[i] = sshRecordingDetails{
+			UUID:     rec.UUID,
+			User:     rec.User,
+			Target:   rec.Target,
+			Started:  rec.StartedAt,
+			Ended:    rec.EndedAt,
+			Duration: rec.EndedAt.Sub(rec.StartedAt),
+			Size:     rec.Size,
+		}
+	}
+	return c.out.Write(ctx, details)
+}
+
+func formatSSHRecordingsTabular(writer io.Writer, value any) error {
+	recordings, ok := value.([]sshRecordingDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", recordings, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.SetColumnAlignRight(5)
+
+	w.Println("UUID", "User", "Target", "Started", "Duration", "Size")
+	for _, rec := range recordings {
+		w.Println(
+			rec.UUID,
+			rec.User,
+			rec.Target,
+			rec.Started.UTC().Format(time.RFC3339),
+			rec.Duration.Round(time.Second),
+			rec.Size,
+		)
+	}
+	return tw.Flush()
+}
+
+const replayRecordingDoc = `
+Plays back a recorded ` + "`juju ssh`" + ` session in the terminal, with the timing
+of the original session. Only what was shown on the user's terminal is
+played back.
+
+The --speed option speeds up or slows down the playback; a speed of 0 writes
+the whole session without any delay. Long pauses in the session can be
+shortened with --max-idle.
+
+With --output, the recording is saved to a file in the asciicast v2 format
+instead of being played back, so that it can be archived or viewed with
+other asciicast players.
+`
+
+const replayRecordingExamples = `
+    juju replay-ssh-recording 2c9f0d8a-4b2e-4ad6-8b53-6a3f7f2f4e1b
+    juju replay-ssh-recording 2c9f0d8a-4b2e-4ad6-8b53-6a3f7f2f4e1b --speed 2 --max-idle 2s
+    juju replay-ssh-recording 2c9f0d8a-4b2e-4ad6-8b53-6a3f7f2f4e1b -o session.cast
+`
+
+// NewReplaySSHRecordingCommand returns a command to play back an SSH
+// session recording.
+func NewReplaySSHRecordingCommand() cmd.Command {
+	return modelcmd.WrapController(&replaySSHRecordingCommand{
+		clock: clock.WallClock,
+	})
+}
+
+// replaySSHRecordingCommand plays back an SSH session recording.
+type replaySSHRecordingCommand struct {
+	sshRecordingsCommandBase
+
+	clock clock.Clock
+
+	uuid    string
+	speed   float64
+	maxIdle time.Duration
+	output  string
+}
+
+// Info implements Command.Info.
+func (c *replaySSHRecordingCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "replay-ssh-recording",
+		Args:     "<uuid>",
+		Purpose:  "Plays back a recorded SSH session.",
+		Doc:      replayRecordingDoc,
+		Examples: replayRecordingExamples,
+		SeeAlso: []string{
+			"ssh",
+			"ssh-recordings",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *replaySSHRecordingCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.Float64Var(&c.speed, "speed", 1, "Playback speed, 0 plays the session without delay")
+	f.DurationVar(&c.maxIdle, "max-idle", 0, "Limit pauses in the playback to this duration")
+	f.StringVar(&c.output, "o", "", "Save the recording to this file instead of playing it back")
+	f.StringVar(&c.output, "output", "", "")
+}
+
+// Init implements Command.Init.
+func (c *replaySSHRecordingCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no recording UUID specified")
+	}
+	c.uuid, args = args[0], args[1:]
+	if c.speed < 0 {
+		return errors.NotValidf("negative speed %v", c.speed)
+	}
+	if c.maxIdle < 0 {
+		return errors.NotValidf("negative max idle %v", c.maxIdle)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *replaySSHRecordingCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	rdr, err := client.Open(ctx, c.uuid)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = rdr.Close() }()
+
+	if c.output != "" {
+		return c.save(ctx, rdr)
+	}
+
+	recording, err := asciicast.NewReader(rdr)
+	if err != nil {
+		return errors.Annotate(err, "reading recording")
+	}
+	return asciicast.Play(ctx, recording, ctx.Stdout, c.clock, asciicast.PlayOptions{
+		Speed:   c.speed,
+		MaxIdle: c.maxIdle,
+	})
+}
+
+func (c *replaySSHRecordingCommand) save(ctx *cmd.Context, rdr io.Reader) error {
+	path := ctx.AbsPath(c.output)
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := io.Copy(f, rdr); err != nil {
+		_ = f.Close()
+		return errors.Annotate(err, "saving recording")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Recording saved to %s", path)
+	return nil
+}
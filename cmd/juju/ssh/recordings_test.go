@@ -0,0 +1,135 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/ssh"
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/rpc/params"
+)
+
+const testRecording = `{"version":2,"width":80,"height":24}
+[0.5,"o","$ "]
+[1.0,"i","ls\r"]
+[1.5,"o","ls\r\nfoo\r\n"]
+`
+
+type sshRecordingsSuite struct {
+	api *mocks.MockSSHRecordingsAPI
+}
+
+func TestSSHRecordingsSuite(t *testing.T) {
+	tc.Run(t, &sshRecordingsSuite{})
+}
+
+func (s *sshRecordingsSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = mocks.NewMockSSHRecordingsAPI(ctrl)
+	s.api.EXPECT().Close().Return(nil).AnyTimes()
+	c.Cleanup(func() {
+		s.api = nil
+	})
+	return ctrl
+}
+
+func (s *sshRecordingsSuite) TestList(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	started := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	s.api.EXPECT().List(gomock.Any(), "bob").Return([]params.SSHRecording{{
+		UUID:      "deadbeef",
+		User:      "bob",
+		Target:    "0.machine.juju.local",
+		Size:      42,
+		StartedAt: started,
+		EndedAt:   started.Add(90 * time.Second),
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSSHRecordingsCommandForTest(s.api), "--user", "bob")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+UUID      User  Target                Started               Duration  Size
+deadbeef  bob   0.machine.juju.local  2026-10-18T09:00:00Z  1m30s     42
+`[1:])
+}
+
+func (s *sshRecordingsSuite) TestListEmpty(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().List(gomock.Any(), "").Return(nil, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSSHRecordingsCommandForTest(s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No SSH session recordings to display.\n")
+}
+
+func (s *sshRecordingsSuite) TestListInvalidUser(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewListSSHRecordingsCommandForTest(s.api), "--user", "not valid")
+	c.Check(err, tc.ErrorMatches, `user "not valid" not valid`)
+}
+
+func (s *sshRecordingsSuite) TestReplay(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().Open(gomock.Any(), "deadbeef").Return(io.NopCloser(strings.NewReader(testRecording)), nil)
+
+	cmd := ssh.NewReplaySSHRecordingCommandForTest(s.api, testclock.NewClock(time.Now()))
+	ctx, err := cmdtesting.RunCommand(c, cmd, "deadbeef", "--speed", "0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "$ ls\r\nfoo\r\n")
+}
+
+func (s *sshRecordingsSuite) TestReplaySave(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().Open(gomock.Any(), "deadbeef").Return(io.NopCloser(strings.NewReader(testRecording)), nil)
+
+	path := filepath.Join(c.MkDir(), "session.cast")
+	cmd := ssh.NewReplaySSHRecordingCommandForTest(s.api, testclock.NewClock(time.Now()))
+	ctx, err := cmdtesting.RunCommand(c, cmd, "deadbeef", "-o", path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Recording saved to "+path+"\n")
+
+	data, err := os.ReadFile(path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, testRecording)
+}
+
+func (s *sshRecordingsSuite) TestReplayInit(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: nil,
+		err:  "no recording UUID specified",
+	}, {
+		args: []string{"deadbeef", "extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}, {
+		args: []string{"deadbeef", "--speed", "-1"},
+		err:  "negative speed -1 not valid",
+	}} {
+		cmd := ssh.NewReplaySSHRecordingCommandForTest(s.api, testclock.NewClock(time.Now()))
+		_, err := cmdtesting.RunCommand(c, cmd, test.args...)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
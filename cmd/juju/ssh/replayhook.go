@@ -0,0 +1,145 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/utils/v4"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+)
+
+func NewReplayHookCommand() cmd.Command {
+	return &replayHookCommand{
+		replay:     capture.Replay,
+		lookPath:   exec.LookPath,
+		executable: os.Executable,
+	}
+}
+
+// replayHookCommand runs a hook captured with capture-hook locally, with the
+// hook tools answering from the captured bundle.
+type replayHookCommand struct {
+	cmd.CommandBase
+
+	replay     func(context.Context, capture.ReplayArgs) ([]string, error)
+	lookPath   func(string) (string, error)
+	executable func() (string, error)
+
+	bundlePath string
+	charmDir   string
+	jujucPath  string
+}
+
+const replayHookDoc = `
+Runs a hook or action captured with ` + "`juju capture-hook`" + ` from a local copy
+of the charm, without a controller. The hook runs with the environment it
+was captured with, and the hook tools it runs, such as ` + "`config-get`" + ` and
+` + "`relation-get`" + `, answer from the captured bundle.
+
+Changes made through the hook tools, such as ` + "`relation-set`" + ` or
+` + "`status-set`" + `, are visible to later hook tools in the same run, and are
+listed once the hook completes, but are never sent anywhere. Hook tools
+which need data which is not captured, such as ` + "`secret-get`" + ` and
+` + "`resource-get`" + `, fail.
+
+The hook tools are run by the ` + "`jujuc`" + ` binary, which is looked for next
+to the ` + "`juju`" + ` binary and then in the ` + "`PATH`" + `, unless ` + "`--jujuc`" + ` is
+specified. Messages logged with ` + "`juju-log`" + ` are shown with ` + "`--debug`" + `.
+`
+
+const usageReplayHookExamples = `
+Replay a captured hook using the charm in the current directory:
+
+    juju replay-hook mysql-0-config-changed.json
+
+Replay a captured hook using the charm in ` + "`./mysql`" + `:
+
+    juju replay-hook mysql-0-config-changed.json --charm-dir ./mysql
+`
+
+func (c *replayHookCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "replay-hook",
+		Args:     "<bundle file>",
+		Purpose:  "Replay a captured hook or action locally.",
+		Doc:      replayHookDoc,
+		Examples: usageReplayHookExamples,
+		SeeAlso: []string{
+			"capture-hook",
+			"debug-hooks",
+		},
+	})
+}
+
+func (c *replayHookCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	f.StringVar(&c.charmDir, "charm-dir", ".", "The directory holding the charm to run the hook from")
+	f.StringVar(&c.jujucPath, "jujuc", "", "The path of the jujuc binary to run the hook tools with")
+}
+
+func (c *replayHookCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no bundle file specified")
+	}
+	c.bundlePath, args = args[0], args[1:]
+	return cmd.CheckEmpty(args)
+}
+
+func (c *replayHookCommand) Run(ctx *cmd.Context) error {
+	bundle, err := capture.ReadBundle(ctx.AbsPath(c.bundlePath))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	jujucPath, err := c.findJujuc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	changes, err := c.replay(ctx, capture.ReplayArgs{
+		Bundle:    bundle,
+		CharmDir:  ctx.AbsPath(c.charmDir),
+		JujucPath: jujucPath,
+		Stdout:    ctx.Stdout,
+		Stderr:    ctx.Stderr,
+		Logger:    internallogger.GetLogger("unit"),
+	})
+	if len(changes) > 0 {
+		ctx.Infof("Changes made by %s:", bundle.Hook)
+		for _, change := range changes {
+			ctx.Infof("  %s", change)
+		}
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return utils.NewRcPassthroughError(exitErr.ExitCode())
+	}
+	return errors.Trace(err)
+}
+
+func (c *replayHookCommand) findJujuc(ctx *cmd.Context) (string, error) {
+	if c.jujucPath != "" {
+		return ctx.AbsPath(c.jujucPath), nil
+	}
+	if executable, err := c.executable(); err == nil {
+		path := filepath.Join(filepath.Dir(executable), "jujuc")
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	path, err := c.lookPath("jujuc")
+	if err != nil {
+		return "", errors.NotFoundf("jujuc binary; specify it with --jujuc")
+	}
+	return path, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+)
+
+func TestReplayHookSuite(t *testing.T) {
+	tc.Run(t, &ReplayHookSuite{})
+}
+
+type ReplayHookSuite struct {
+	bundlePath string
+}
+
+func (s *ReplayHookSuite) SetUpTest(c *tc.C) {
+	data, err := (&capture.Bundle{
+		Version: capture.BundleVersion,
+		Unit:    "mysql/0",
+		Hook:    "install",
+	}).Marshal()
+	c.Assert(err, tc.ErrorIsNil)
+	s.bundlePath = filepath.Join(c.MkDir(), "mysql-0-install.json")
+	err = os.WriteFile(s.bundlePath, data, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func lookPathJujuc(name string) (string, error) {
+	return "/usr/bin/" + name, nil
+}
+
+func (s *ReplayHookSuite) TestInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, NewReplayHookCommandForTest(nil, lookPathJujuc))
+	c.Assert(err, tc.ErrorMatches, "no bundle file specified")
+
+	_, err = cmdtesting.RunCommand(c, NewReplayHookCommandForTest(nil, lookPathJujuc), "a.json", "b.json")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["b.json"\]`)
+}
+
+func (s *ReplayHookSuite) TestReplayHook(c *tc.C) {
+	charmDir := c.MkDir()
+	var args capture.ReplayArgs
+	replay := func(_ context.Context, a capture.ReplayArgs) ([]string, error) {
+		args = a
+		return []string{"status-set active \"ready\""}, nil
+	}
+
+	ctx, err := cmdtesting.RunCommand(c, NewReplayHookCommandForTest(replay, lookPathJujuc),
+		s.bundlePath, "--charm-dir", charmDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(args.Bundle.Unit, tc.Equals, "mysql/0")
+	c.Check(args.CharmDir, tc.Equals, charmDir)
+	c.Check(args.JujucPath, tc.Equals, "/usr/bin/jujuc")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Changes made by install:\n  status-set active \"ready\"\n")
+}
+
+func (s *ReplayHookSuite) TestReplayHookJujucFlag(c *tc.C) {
+	var jujucPath string
+	replay := func(_ context.Context, a capture.ReplayArgs) ([]string, error) {
+		jujucPath = a.JujucPath
+		return nil, nil
+	}
+	lookPath := func(string) (string, error) {
+		return "", errors.New("not expected")
+	}
+
+	ctx, err := cmdtesting.RunCommand(c, NewReplayHookCommandForTest(replay, lookPath),
+		s.bundlePath, "--jujuc", "/opt/juju/jujuc")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(jujucPath, tc.Equals, "/opt/juju/jujuc")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "")
+}
+
+func (s *ReplayHookSuite) TestReplayHookNoJujuc(c *tc.C) {
+	lookPath := func(string) (string, error) {
+		return "", exec.ErrNotFound
+	}
+	_, err := cmdtesting.RunCommand(c, NewReplayHookCommandForTest(nil, lookPath), s.bundlePath)
+	c.Assert(err, tc.ErrorMatches, "jujuc binary; specify it with --jujuc not found")
+}
+
+func (s *ReplayHookSuite) TestReplayHookFails(c *tc.C) {
+	replay := func(context.Context, capture.ReplayArgs) ([]string, error) {
+		return nil, exec.Command("/bin/sh", "-c", "exit 3").Run()
+	}
+	_, err := cmdtesting.RunCommand(c, NewReplayHookCommandForTest(replay, lookPathJujuc), s.bundlePath)
+	c.Assert(err, tc.DeepEquals, utils.NewRcPassthroughError(3))
+}
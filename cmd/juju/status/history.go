@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -44,6 +45,10 @@ type HistoryAPI interface {
 	// and kind, filtered according to the provided filter.
 	StatusHistory(ctx context.Context, kind status.HistoryKind, tag names.Tag, filter status.StatusHistoryFilter) (status.History, error)
 
+	// QueryStatusHistory returns the status history of all the entities in
+	// the model matching the query.
+	QueryStatusHistory(ctx context.Context, query status.StatusHistoryQuery) ([]status.HistoryEntry, error)
+
 	// Close closes the API client.
 	Close() error
 }
@@ -56,9 +61,19 @@ type statusHistoryCommand struct {
 	backlogSize     int
 	backlogSizeDays int
 	backlogDate     string
+	backlogToDate   string
 	isoTime         bool
 	entityName      string
 	date            time.Time
+	toDate          time.Time
+
+	// all is true when querying the status history of all the entities in
+	// the model.
+	all         bool
+	application string
+	statuses    string
+	message     string
+	kinds       []status.HistoryKind
 }
 
 var statusHistoryDoc = fmt.Sprintf(`
@@ -71,6 +86,17 @@ The statuses are available for the following types.
  and sorted by time of occurrence.
 
  The default is unit.
+
+With --all, the status history of all the entities in the model is
+queried instead of a single entity. The query can be narrowed with
+--type (which accepts a comma separated list), --application, --status,
+--message (a regular expression) and a time window given by --days,
+--from-date and --to-date. Without --type, statuses of every type are
+shown.
+
+The timeline format shows the statuses in the order they occurred,
+relative to the first one, together with the time since the previous
+status of the same entity. This is useful to reconstruct an incident.
 `, supportedHistoryKindDescs())
 
 const statusHistoryExamples = `
@@ -101,12 +127,22 @@ Show the status history for the specified machine:
 Show the status history for the model:
 
     juju show-status-log --type model
+
+Show every error status of the units of an application in a time window:
+
+    juju show-status-log --all --application mysql --type unit --status error \
+        --from-date 2024-05-01T10:00:00Z --to-date 2024-05-01T11:00:00Z
+
+Show a timeline of the statuses of all machines and units which mention a
+hook failure:
+
+    juju show-status-log --all --type juju-machine,unit --message "hook failed" --format timeline
 `
 
 func (c *statusHistoryCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "show-status-log",
-		Args:     "<entity name>",
+		Args:     "[<entity name>]",
 		Purpose:  "Output past statuses for the specified entity.",
 		Doc:      statusHistoryDoc,
 		Examples: statusHistoryExamples,
@@ -139,20 +175,36 @@ func supportedHistoryKindDescs() string {
 
 func (c *statusHistoryCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
-	f.StringVar(&c.outputContent, "type", "unit", fmt.Sprintf("Type of statuses to be displayed [%v]", supportedHistoryKindTypes()))
-	f.IntVar(&c.backlogSize, "n", 0, "Returns the last N logs (cannot be combined with --days or --date)")
-	f.IntVar(&c.backlogSizeDays, "days", 0, "Returns the logs for the past <days> days (cannot be combined with -n or --date)")
-	f.StringVar(&c.backlogDate, "from-date", "", "Returns logs for any date after the passed one, the expected date format is YYYY-MM-DD (cannot be combined with -n or --days)")
+	f.StringVar(&c.outputContent, "type", "", fmt.Sprintf("Type of statuses to be displayed [%v] (default unit, or all types with --all)", supportedHistoryKindTypes()))
+	f.IntVar(&c.backlogSize, "n", 0, "Returns the last N logs (cannot be combined with --days or --date without --all)")
+	f.IntVar(&c.backlogSizeDays, "days", 0, "Returns the logs for the past <days> days (cannot be combined with -n or --date without --all)")
+	f.StringVar(&c.backlogDate, "from-date", "", "Returns logs for any date after the passed one, the expected date format is YYYY-MM-DD or RFC3339 (cannot be combined with -n or --days without --all)")
+	f.StringVar(&c.backlogToDate, "to-date", "", "Returns logs for any date before the passed one, the expected date format is YYYY-MM-DD or RFC3339 (requires --all)")
 	f.BoolVar(&c.isoTime, "utc", false, "Display time as UTC in RFC3339 format")
+	f.BoolVar(&c.all, "all", false, "Query the status history of all the entities in the model")
+	f.StringVar(&c.application, "application", "", "Only show statuses of the application and its units (requires --all)")
+	f.StringVar(&c.statuses, "status", "", "Only show the comma separated status values (requires --all)")
+	f.StringVar(&c.message, "message", "", "Only show statuses with a message matching the regular expression (requires --all)")
 
 	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": c.formatTabular,
+		"yaml":     cmd.FormatYaml,
+		"json":     cmd.FormatJson,
+		"tabular":  c.formatTabular,
+		"timeline": c.formatTimeline,
 	})
 }
 
 func (c *statusHistoryCommand) Init(args []string) error {
+	if c.all {
+		return c.initQuery(args)
+	}
+	if c.application != "" || c.statuses != "" || c.message != "" || c.backlogToDate != "" {
+		return errors.Errorf("--application, --status, --message and --to-date require --all")
+	}
+	if c.outputContent == "" {
+		c.outputContent = status.KindUnit.String()
+	}
+
 	switch {
 	case len(args) > 1:
 		return errors.Errorf("unexpected arguments after entity name.")
@@ -163,16 +215,8 @@ func (c *statusHistoryCommand) Init(args []string) error {
 	default:
 		c.entityName = args[0]
 	}
-	// If use of ISO time not specified on command line,
-	// check env var.
-	if !c.isoTime {
-		var err error
-		envVarValue := os.Getenv(osenv.JujuStatusIsoTimeEnvKey)
-		if envVarValue != "" {
-			if c.isoTime, err = strconv.ParseBool(envVarValue); err != nil {
-				return errors.Annotatef(err, "invalid %s env var, expected true|false", osenv.JujuStatusIsoTimeEnvKey)
-			}
-		}
+	if err := c.initISOTime(); err != nil {
+		return errors.Trace(err)
 	}
 	emptyDate := c.backlogDate == ""
 	emptySize := c.backlogSize == 0
@@ -185,7 +229,7 @@ func (c *statusHistoryCommand) Init(args []string) error {
 	}
 	if c.backlogDate != "" {
 		var err error
-		c.date, err = time.Parse("2006-01-02", c.backlogDate)
+		c.date, err = parseHistoryDate(c.backlogDate)
 		if err != nil {
 			return errors.Annotate(err, "parsing backlog date")
 		}
@@ -198,6 +242,91 @@ func (c *statusHistoryCommand) Init(args []string) error {
 	return errors.Errorf("unexpected status type %q", c.outputContent)
 }
 
+// initQuery validates the arguments when querying the status history of all
+// the entities in the model.
+func (c *statusHistoryCommand) initQuery(args []string) error {
+	if len(args) > 0 {
+		return errors.Errorf("entity name cannot be specified with --all, use --application or --type to narrow the query")
+	}
+	if err := c.initISOTime(); err != nil {
+		return errors.Trace(err)
+	}
+	if c.backlogSizeDays != 0 && c.backlogDate != "" {
+		return errors.Errorf("backlog date and backlog days back cannot be specified together")
+	}
+	if c.backlogSize == 0 && c.backlogSizeDays == 0 && c.backlogDate == "" && c.backlogToDate == "" {
+		c.backlogSize = 20
+	}
+
+	var err error
+	if c.backlogDate != "" {
+		if c.date, err = parseHistoryDate(c.backlogDate); err != nil {
+			return errors.Annotate(err, "parsing backlog date")
+		}
+	}
+	if c.backlogToDate != "" {
+		if c.toDate, err = parseHistoryDate(c.backlogToDate); err != nil {
+			return errors.Annotate(err, "parsing backlog to date")
+		}
+	}
+	if !c.date.IsZero() && !c.toDate.IsZero() && !c.date.Before(c.toDate) {
+		return errors.Errorf("--from-date must be before --to-date")
+	}
+
+	c.kinds = nil
+	for _, k := range splitList(c.outputContent) {
+		kind := status.HistoryKind(k)
+		if !kind.Valid() {
+			return errors.Errorf("unexpected status type %q", k)
+		}
+		c.kinds = append(c.kinds, kind)
+	}
+	if c.application != "" && !names.IsValidApplication(c.application) {
+		return errors.Errorf("%q is not a valid name for an application", c.application)
+	}
+	if _, err := regexp.Compile(c.message); err != nil {
+		return errors.Annotate(err, "parsing message pattern")
+	}
+	return nil
+}
+
+// initISOTime checks the environment for the use of ISO time, if it is not
+// specified on the command line.
+func (c *statusHistoryCommand) initISOTime() error {
+	if c.isoTime {
+		return nil
+	}
+	envVarValue := os.Getenv(osenv.JujuStatusIsoTimeEnvKey)
+	if envVarValue == "" {
+		return nil
+	}
+	var err error
+	if c.isoTime, err = strconv.ParseBool(envVarValue); err != nil {
+		return errors.Annotatef(err, "invalid %s env var, expected true|false", osenv.JujuStatusIsoTimeEnvKey)
+	}
+	return nil
+}
+
+// parseHistoryDate parses a date given either as YYYY-MM-DD or in RFC3339
+// format.
+func parseHistoryDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// splitList splits a comma separated list, ignoring empty values.
+func splitList(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 // DetailedStatus holds status info about a machine or unit agent.
 type DetailedStatus struct {
 	Status  status.Status      `yaml:"status,omitempty" json:"status,omitempty"`
@@ -210,7 +339,20 @@ type DetailedStatus struct {
 // History holds the status results.
 type History []DetailedStatus
 
+// EntityStatus holds status info about an entity in the model.
+type EntityStatus struct {
+	Entity         string `yaml:"entity" json:"entity"`
+	DetailedStatus `yaml:",inline"`
+}
+
+// ModelHistory holds the status results of a query across the model.
+type ModelHistory []EntityStatus
+
 func (c *statusHistoryCommand) Run(ctx *cmd.Context) error {
+	if c.all {
+		return c.runQuery(ctx)
+	}
+
 	kind := status.HistoryKind(c.outputContent)
 	var delta *time.Duration
 
@@ -289,34 +431,129 @@ func (c *statusHistoryCommand) Run(ctx *cmd.Context) error {
 
 	history := make(History, len(statuses))
 	for i, h := range statuses {
-		history[i] = DetailedStatus{
-			Status:  h.Status,
-			Message: h.Info,
-			Data:    h.Data,
-			Since:   h.Since,
-			Kind:    h.Kind,
-		}
+		history[i] = newDetailedStatus(h)
 	}
 	sort.Slice(history, func(i, j int) bool {
-		a, b := history[i], history[j]
-		if a.Since == nil && b.Since == nil {
-			return a.Status.String() < b.Status.String()
-		} else if a.Since == nil {
-			return false
-		} else if b.Since == nil {
-			return true
+		return statusBefore(history[i], history[j])
+	})
+	return c.out.Write(ctx, history)
+}
+
+// runQuery queries the status history of all the entities in the model on
+// every controller, and merges the results.
+func (c *statusHistoryCommand) runQuery(ctx *cmd.Context) error {
+	query := status.StatusHistoryQuery{
+		Kinds:       c.kinds,
+		Application: c.application,
+		Message:     c.message,
+		Size:        c.backlogSize,
+	}
+	for _, s := range splitList(c.statuses) {
+		query.Statuses = append(query.Statuses, status.Status(s))
+	}
+	if c.backlogSizeDays != 0 {
+		from := time.Now().Add(-time.Duration(c.backlogSizeDays*24) * time.Hour)
+		query.From = &from
+	}
+	if !c.date.IsZero() {
+		query.From = &c.date
+	}
+	if !c.toDate.IsZero() {
+		query.To = &c.toDate
+	}
+
+	clients, compat, err := c.getStatusHistoryClients(ctx, ctx)
+	if err != nil {
+		return err
+	} else if len(clients) == 0 {
+		return errors.New("no controller status-history clients available; is bootstrap still in progress?")
+	}
+	defer func() {
+		for _, client := range clients {
+			_ = client.Close()
+		}
+	}()
+
+	var history ModelHistory
+	for _, client := range clients {
+		entries, err := client.QueryStatusHistory(ctx, query)
+		if errors.Is(err, errors.NotSupported) || (err != nil && compat) {
+			return errors.Trace(err)
+		} else if err != nil {
+			// Display any error, but continue to print the statuses
+			// returned by other controllers.
+			fmt.Fprintf(ctx.Stderr, "%v\n", err)
+		}
+		for _, entry := range entries {
+			history = append(history, EntityStatus{
+				Entity:         entry.ID,
+				DetailedStatus: newDetailedStatus(entry.DetailedStatus),
+			})
 		}
-		return a.Since.Before(*b.Since)
+	}
+
+	if len(history) == 0 {
+		return errors.Errorf("no status history available")
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return statusBefore(history[i].DetailedStatus, history[j].DetailedStatus)
 	})
+	// Each controller returns up to the requested size, only keep the most
+	// recent across all of them.
+	if size := query.Size; size > 0 && len(history) > size {
+		history = history[len(history)-size:]
+	}
 	return c.out.Write(ctx, history)
 }
 
+func newDetailedStatus(s status.DetailedStatus) DetailedStatus {
+	return DetailedStatus{
+		Status:  s.Status,
+		Message: s.Info,
+		Data:    s.Data,
+		Since:   s.Since,
+		Kind:    s.Kind,
+	}
+}
+
+// statusBefore orders statuses by time, with those without a time last.
+func statusBefore(a, b DetailedStatus) bool {
+	if a.Since == nil && b.Since == nil {
+		return a.Status.String() < b.Status.String()
+	} else if a.Since == nil {
+		return false
+	} else if b.Since == nil {
+		return true
+	}
+	return a.Since.Before(*b.Since)
+}
+
 func (c *statusHistoryCommand) formatTabular(writer io.Writer, value any) error {
-	h, ok := value.(History)
-	if !ok {
+	switch h := value.(type) {
+	case History:
+		c.writeTabular(writer, h)
+	case ModelHistory:
+		c.writeModelTabular(writer, h)
+	default:
 		return errors.Errorf("expected value of type %T, got %T", History{}, value)
 	}
-	c.writeTabular(writer, h)
+	return nil
+}
+
+func (c *statusHistoryCommand) formatTimeline(writer io.Writer, value any) error {
+	switch h := value.(type) {
+	case History:
+		history := make(ModelHistory, len(h))
+		for i, s := range h {
+			history[i] = EntityStatus{Entity: c.entityName, DetailedStatus: s}
+		}
+		c.writeTimeline(writer, history)
+	case ModelHistory:
+		c.writeTimeline(writer, h)
+	default:
+		return errors.Errorf("expected value of type %T, got %T", ModelHistory{}, value)
+	}
 	return nil
 }
 
@@ -333,6 +570,66 @@ func (c *statusHistoryCommand) writeTabular(writer io.Writer, statuses History)
 	tw.Flush()
 }
 
+func (c *statusHistoryCommand) writeModelTabular(writer io.Writer, statuses ModelHistory) {
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+
+	w.Println("Time", "Entity", "Type", "Status", "Message")
+	for _, v := range statuses {
+		w.Print(common.FormatTime(v.Since, c.isoTime), v.Entity, v.Kind)
+		w.PrintStatus(v.Status)
+		w.Println(v.Message)
+	}
+	tw.Flush()
+}
+
+// writeTimeline writes the statuses in the order they occurred, with the
+// offset from the first status and the time since the previous status of
+// the same entity and type.
+func (c *statusHistoryCommand) writeTimeline(writer io.Writer, statuses ModelHistory) {
+	var first, last *time.Time
+	for _, v := range statuses {
+		if v.Since == nil {
+			continue
+		}
+		if first == nil {
+			first = v.Since
+		}
+		last = v.Since
+	}
+	if first != nil {
+		fmt.Fprintf(writer, "Timeline from %s to %s (%s)\n\n",
+			common.FormatTime(first, c.isoTime), common.FormatTime(last, c.isoTime), last.Sub(*first))
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+
+	type lane struct {
+		entity string
+		kind   status.HistoryKind
+	}
+	previous := make(map[lane]time.Time)
+
+	w.Println("Offset", "Time", "Entity", "Type", "Status", "Since previous", "Message")
+	for _, v := range statuses {
+		var offset, sincePrevious string
+		if v.Since != nil {
+			offset = "+" + v.Since.Sub(*first).String()
+			key := lane{entity: v.Entity, kind: v.Kind}
+			if prev, ok := previous[key]; ok {
+				sincePrevious = v.Since.Sub(prev).String()
+			}
+			previous[key] = *v.Since
+		}
+		w.Print(offset, common.FormatTime(v.Since, c.isoTime), v.Entity, v.Kind)
+		w.PrintStatus(v.Status)
+		w.Print(sincePrevious)
+		w.Println(v.Message)
+	}
+	tw.Flush()
+}
+
 type warningLogger interface {
 	Warningf(format string, args ...any)
 }
@@ -237,6 +237,161 @@ func (s *StatusHistorySuite) TestClientCompatibility(c *tc.C) {
 	c.Check(cmdtesting.Stdout(ctx), tc.Equals, expected)
 }
 
+func (s *StatusHistorySuite) TestQueryTabular(c *tc.C) {
+	s.queryClients()
+
+	expected := `
+Time                  Entity   Type          Status  Message
+2017-11-28 12:34:56Z  mysql/0  workload      error   hook failed: install
+2017-11-28 12:35:56Z  0        juju-machine  error   hook failed: start
+2017-11-28 12:36:56Z  mysql/1  workload      error   hook failed: install
+2017-11-28 12:38:56Z  mysql/0  workload      active  
+`[1:]
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "--all", "--utc")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, expected)
+}
+
+func (s *StatusHistorySuite) TestQueryTimeline(c *tc.C) {
+	s.queryClients()
+
+	expected := `
+Timeline from 2017-11-28 12:34:56Z to 2017-11-28 12:38:56Z (4m0s)
+
+Offset  Time                  Entity   Type          Status  Since previous  Message
++0s     2017-11-28 12:34:56Z  mysql/0  workload      error                   hook failed: install
++1m0s   2017-11-28 12:35:56Z  0        juju-machine  error                   hook failed: start
++2m0s   2017-11-28 12:36:56Z  mysql/1  workload      error                   hook failed: install
++4m0s   2017-11-28 12:38:56Z  mysql/0  workload      active  4m0s            
+`[1:]
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "--all", "--utc", "--format", "timeline")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, expected)
+}
+
+func (s *StatusHistorySuite) TestQueryYaml(c *tc.C) {
+	s.queryClients()
+
+	expected := `
+- entity: mysql/0
+  status: error
+  message: 'hook failed: install'
+  since: 2017-11-28T12:34:56Z
+  type: workload
+- entity: "0"
+  status: error
+  message: 'hook failed: start'
+  since: 2017-11-28T12:35:56Z
+  type: juju-machine
+- entity: mysql/1
+  status: error
+  message: 'hook failed: install'
+  since: 2017-11-28T12:36:56Z
+  type: workload
+- entity: mysql/0
+  status: active
+  since: 2017-11-28T12:38:56Z
+  type: workload
+`[1:]
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "--all", "--utc", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, expected)
+}
+
+func (s *StatusHistorySuite) TestQuerySizeAcrossClients(c *tc.C) {
+	s.queryClients()
+
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "--all", "--utc", "-n", "2", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `[{"entity":"mysql/1","status":"error","message":"hook failed: install","since":"2017-11-28T12:36:56Z","type":"workload"},{"entity":"mysql/0","status":"active","since":"2017-11-28T12:38:56Z","type":"workload"}]`+"\n")
+}
+
+func (s *StatusHistorySuite) TestQueryArgs(c *tc.C) {
+	fake := &fakeHistoryAPI{
+		entries: []status.HistoryEntry{{
+			ID:             "mysql/0",
+			DetailedStatus: status.DetailedStatus{Kind: status.KindWorkload, Status: status.Error, Since: s.next()},
+		}},
+	}
+	s.clients = []HistoryAPI{fake}
+
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "--all",
+		"--type", "unit,juju-machine",
+		"--application", "mysql",
+		"--status", "error,blocked",
+		"--message", "hook failed",
+		"--from-date", "2017-11-28",
+		"--to-date", "2017-11-28T13:00:00Z",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	from := time.Date(2017, 11, 28, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 11, 28, 13, 0, 0, 0, time.UTC)
+	c.Check(fake.query, tc.DeepEquals, status.StatusHistoryQuery{
+		Kinds:       []status.HistoryKind{status.KindUnit, status.KindMachine},
+		Application: "mysql",
+		Statuses:    []status.Status{status.Error, status.Blocked},
+		Message:     "hook failed",
+		From:        &from,
+		To:          &to,
+	})
+}
+
+func (s *StatusHistorySuite) TestQueryInitErrors(c *tc.C) {
+	tests := []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--all", "mysql/0"},
+		err:  "entity name cannot be specified with --all.*",
+	}, {
+		args: []string{"mysql/0", "--status", "error"},
+		err:  "--application, --status, --message and --to-date require --all",
+	}, {
+		args: []string{"--all", "--type", "unit,bogus"},
+		err:  `unexpected status type "bogus"`,
+	}, {
+		args: []string{"--all", "--application", "mysql/0"},
+		err:  `"mysql/0" is not a valid name for an application`,
+	}, {
+		args: []string{"--all", "--message", "("},
+		err:  "parsing message pattern: .*",
+	}, {
+		args: []string{"--all", "--from-date", "2017-11-29", "--to-date", "2017-11-28"},
+		err:  "--from-date must be before --to-date",
+	}}
+	for _, test := range tests {
+		c.Logf("args %v", test.args)
+		_, err := cmdtesting.RunCommand(c, s.newCommand(), test.args...)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *StatusHistorySuite) queryClients() {
+	a, b, cc, _, d := s.next(), s.next(), s.next(), s.next(), s.next()
+	s.clients = []HistoryAPI{
+		&fakeHistoryAPI{
+			entries: []status.HistoryEntry{{
+				ID:             "mysql/0",
+				DetailedStatus: status.DetailedStatus{Kind: status.KindWorkload, Status: status.Error, Info: "hook failed: install", Since: a},
+			}, {
+				ID:             "mysql/0",
+				DetailedStatus: status.DetailedStatus{Kind: status.KindWorkload, Status: status.Active, Since: d},
+			}},
+		},
+		&fakeHistoryAPI{
+			entries: []status.HistoryEntry{{
+				ID:             "0",
+				DetailedStatus: status.DetailedStatus{Kind: status.KindMachine, Status: status.Error, Info: "hook failed: start", Since: b},
+			}, {
+				ID:             "mysql/1",
+				DetailedStatus: status.DetailedStatus{Kind: status.KindWorkload, Status: status.Error, Info: "hook failed: install", Since: cc},
+			}},
+		},
+	}
+}
+
 func (s *StatusHistorySuite) singularClient() {
 	s.clients = []HistoryAPI{
 		s.singularHistoryAPI(),
@@ -355,6 +510,8 @@ func (s *StatusHistorySuite) multipleClients() {
 type fakeHistoryAPI struct {
 	err     error
 	history status.History
+	entries []status.HistoryEntry
+	query   status.StatusHistoryQuery
 }
 
 func (*fakeHistoryAPI) Close() error {
@@ -365,6 +522,11 @@ func (f *fakeHistoryAPI) StatusHistory(ctx context.Context, kind status.HistoryK
 	return f.history, f.err
 }
 
+func (f *fakeHistoryAPI) QueryStatusHistory(ctx context.Context, query status.StatusHistoryQuery) ([]status.HistoryEntry, error) {
+	f.query = query
+	return f.entries, f.err
+}
+
 type fakeControllerDetailsAPI struct {
 	details    map[string]highavailability.ControllerDetails
 	apiVersion int
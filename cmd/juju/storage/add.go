@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
 
 	jujucmd "github.com/juju/juju/cmd"
@@ -76,6 +77,10 @@ by the charm, which can specify a maximum number of storage instances per unit.
 positive number, followed by a size suffix.  Valid suffixes include M, G, T,
 and P.  Defaults to "1024M", or the which can specify a minimum size required
 by the charm.
+
+With ` + "`--from-snapshot`" + `, the new storage instances are created from a
+storage snapshot, as output by ` + "`juju storage-snapshots`" + `. The pool and
+size default to those of the storage the snapshot was taken of.
 `
 
 	addCommandExamples = `
@@ -97,6 +102,10 @@ using the default count (` + "`1`" + `) and storage pool
 (e.g., on AWS, the ` + "`ebs`" + ` pool; equivalent to spelling out ` + "`pgdata=ebs,100G,1`)" + `:
 
     juju deploy postgresql --storage pgdata=100G
+
+Add ` + "`pgdata`" + ` storage to unit ` + "`postgresql/1`" + ` restored from snapshot ` + "`3`" + `:
+
+    juju add-storage postgresql/1 pgdata --from-snapshot 3
 `
 
 	addCommandAgs = `<unit> <storage-directive>`
@@ -112,6 +121,16 @@ type addCommand struct {
 	// defined in charm storage metadata.
 	storageDirectives map[string]storage.Directive
 	newAPIFunc        func(ctx context.Context) (StorageAddAPI, error)
+
+	// fromSnapshot is the id of the storage snapshot to create the new
+	// storage instances from, if any.
+	fromSnapshot string
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *addCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.fromSnapshot, "from-snapshot", "", "Create the storage from the specified storage snapshot")
 }
 
 // Init implements Command.Init.
@@ -127,7 +146,13 @@ func (c *addCommand) Init(args []string) (err error) {
 	c.unitTag = names.NewUnitTag(u)
 
 	c.storageDirectives, err = storage.ParseDirectivesMap(args[1:], false)
-	return
+	if err != nil {
+		return err
+	}
+	if c.fromSnapshot != "" && len(c.storageDirectives) != 1 {
+		return errors.New("--from-snapshot requires a single storage directive")
+	}
+	return nil
 }
 
 // Info implements Command.Info.
@@ -142,6 +167,7 @@ func (c *addCommand) Info() *cmd.Info {
 			"import-filesystem",
 			"storage",
 			"storage-pools",
+			"storage-snapshots",
 		},
 	})
 }
@@ -215,7 +241,7 @@ func (c *addCommand) createStorageAddParams() []params.StorageAddParams {
 	all := make([]params.StorageAddParams, 0, len(c.storageDirectives))
 	for one, directive := range c.storageDirectives {
 		d := directive
-		all = append(all, params.StorageAddParams{
+		arg := params.StorageAddParams{
 			UnitTag:     c.unitTag.String(),
 			StorageName: one,
 			Directives: params.StorageDirectives{
@@ -223,7 +249,14 @@ func (c *addCommand) createStorageAddParams() []params.StorageAddParams {
 				SizeMiB: &d.Size,
 				Count:   &d.Count,
 			},
-		})
+			SnapshotId: c.fromSnapshot,
+		}
+		if c.fromSnapshot != "" && d.Size == 0 {
+			// Leave the size unset so that it defaults to the size of
+			// the snapshot.
+			arg.Directives.SizeMiB = nil
+		}
+		all = append(all, arg)
 	}
 
 	// For consistency and because we are coming from a map,
@@ -120,6 +120,33 @@ added storage foo/1 to tst/123
 	}
 }
 
+func (s *addSuite) TestAddFromSnapshot(c *tc.C) {
+	var added []params.StorageAddParams
+	addToUnit := s.mockAPI.addToUnitFunc
+	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+		added = storages
+		return addToUnit(storages)
+	}
+
+	_, err := s.runAdd(c, "tst/123", "data", "--from-snapshot", "3")
+	c.Assert(err, tc.ErrorIsNil)
+	count := uint64(1)
+	c.Check(added, tc.DeepEquals, []params.StorageAddParams{{
+		UnitTag:     "unit-tst-123",
+		StorageName: "data",
+		Directives: params.StorageDirectives{
+			Count: &count,
+		},
+		SnapshotId: "3",
+	}})
+}
+
+func (s *addSuite) TestAddFromSnapshotMultipleDirectives(c *tc.C) {
+	s.args = []string{"tst/123", "data", "logs", "--from-snapshot", "3"}
+	expectedErr := "--from-snapshot requires a single storage directive"
+	s.assertAddErrorOutput(c, expectedErr, visibleErrorMessage(expectedErr))
+}
+
 func (s *addSuite) TestAddOperationAborted(c *tc.C) {
 	s.args = []string{"tst/123", "data=676"}
 	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
@@ -0,0 +1,130 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/utils/v4"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewResizeStorageCommandWithAPI returns a command
+// used to resize storage instances.
+func NewResizeStorageCommandWithAPI() cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.newStorageResizerCloser = func(ctx context.Context) (StorageResizerCloser, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	resizeStorageCommandDoc = `
+Grows an existing storage instance to a new size. Specify a
+storage ID, as output by ` + "`juju storage`" + `, and the new size.
+
+The size is a number followed by an optional unit: M, G, T, P
+or E. If no unit is given, the size is in MiB.
+
+The storage provisioner grows the backing volume or filesystem
+in place. Storage can only be grown; shrinking is not supported.
+Storage providers which cannot resize their storage will report
+an error status on the volume or filesystem.
+`
+	resizeStorageCommandExamples = `
+Grow the storage ` + "`pgdata/0`" + ` to 50GiB:
+
+    juju resize-storage pgdata/0 50G
+
+`
+	resizeStorageCommandArgs = `<storage> <size>`
+)
+
+// resizeStorageCommand grows storage instances.
+type resizeStorageCommand struct {
+	StorageCommandBase
+	newStorageResizerCloser NewStorageResizerCloserFunc
+	storageId               string
+	sizeMiB                 uint64
+}
+
+// Init implements Command.Init.
+func (c *resizeStorageCommand) Init(args []string) error {
+	if len(args) != 2 {
+		return errors.New("resize-storage requires a storage ID and a size")
+	}
+	if !names.IsValidStorage(args[0]) {
+		return errors.NotValidf("storage ID %q", args[0])
+	}
+	sizeMiB, err := utils.ParseSize(args[1])
+	if err != nil {
+		return errors.Annotate(err, "invalid size")
+	}
+	if sizeMiB == 0 {
+		return errors.New("size must be greater than zero")
+	}
+	c.storageId = args[0]
+	c.sizeMiB = sizeMiB
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *resizeStorageCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "resize-storage",
+		Purpose:  "Grows an existing storage instance.",
+		Doc:      resizeStorageCommandDoc,
+		Args:     resizeStorageCommandArgs,
+		Examples: resizeStorageCommandExamples,
+		SeeAlso: []string{
+			"storage",
+			"show-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *resizeStorageCommand) Run(ctx *cmd.Context) error {
+	resizer, err := c.newStorageResizerCloser(ctx)
+	if err != nil {
+		return err
+	}
+	defer resizer.Close()
+
+	if err := resizer.Resize(ctx, c.storageId, c.sizeMiB); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "resize storage")
+		}
+		return block.ProcessBlockedError(
+			errors.Annotatef(err, "could not resize storage %s", c.storageId), block.BlockChange,
+		)
+	}
+	ctx.Infof("resizing %s to %dMiB", c.storageId, c.sizeMiB)
+	return nil
+}
+
+// NewStorageResizerCloserFunc is the type of a function that returns a
+// StorageResizerCloser.
+type NewStorageResizerCloserFunc func(ctx context.Context) (StorageResizerCloser, error)
+
+// StorageResizerCloser extends StorageResizer with a Closer method.
+type StorageResizerCloser interface {
+	StorageResizer
+	Close() error
+}
+
+// StorageResizer defines an interface for growing the storage instance
+// with the specified ID to a new size in MiB.
+type StorageResizer interface {
+	Resize(ctx context.Context, storageId string, sizeMiB uint64) error
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ResizeStorageSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestResizeStorageSuite(t *testing.T) {
+	tc.Run(t, &ResizeStorageSuite{})
+}
+
+func (s *ResizeStorageSuite) TestResize(c *tc.C) {
+	var fake fakeStorageResizer
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "50G")
+	c.Assert(err, tc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewStorageResizerCloser", "Resize", "Close")
+	fake.CheckCall(c, 1, "Resize", "pgdata/0", uint64(50*1024))
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "resizing pgdata/0 to 51200MiB\n")
+}
+
+func (s *ResizeStorageSuite) TestResizeNoUnit(c *tc.C) {
+	var fake fakeStorageResizer
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "2048")
+	c.Assert(err, tc.ErrorIsNil)
+	fake.CheckCall(c, 1, "Resize", "pgdata/0", uint64(2048))
+}
+
+func (s *ResizeStorageSuite) TestResizeError(c *tc.C) {
+	var fake fakeStorageResizer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeNotSupported, Message: `storage "pgdata/0" cannot be shrunk`})
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "1G")
+	c.Assert(err, tc.ErrorMatches, `could not resize storage pgdata/0: storage "pgdata/0" cannot be shrunk`)
+	fake.CheckCallNames(c, "NewStorageResizerCloser", "Resize", "Close")
+}
+
+func (s *ResizeStorageSuite) TestResizeUnauthorizedError(c *tc.C) {
+	var fake fakeStorageResizer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "1G")
+	c.Assert(err, tc.ErrorMatches, "could not resize storage pgdata/0: nope")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, `
+You do not have permission to resize storage.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *ResizeStorageSuite) TestResizeBlocked(c *tc.C) {
+	var fake fakeStorageResizer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "1G")
+	c.Assert(err.Error(), tc.Contains, `could not resize storage pgdata/0: nope`)
+	c.Assert(err.Error(), tc.Contains, `All operations that change model have been disabled for the current model.`)
+}
+
+func (s *ResizeStorageSuite) TestResizeInitErrors(c *tc.C) {
+	s.testResizeInitError(c, []string{}, "resize-storage requires a storage ID and a size")
+	s.testResizeInitError(c, []string{"pgdata/0"}, "resize-storage requires a storage ID and a size")
+	s.testResizeInitError(c, []string{"pgdata/0", "1G", "2G"}, "resize-storage requires a storage ID and a size")
+	s.testResizeInitError(c, []string{"pgdata", "1G"}, `storage ID "pgdata" not valid`)
+	s.testResizeInitError(c, []string{"pgdata/0", "lots"}, `invalid size: .*`)
+	s.testResizeInitError(c, []string{"pgdata/0", "0"}, "size must be greater than zero")
+}
+
+func (s *ResizeStorageSuite) testResizeInitError(c *tc.C, args []string, expect string) {
+	cmd := storage.NewResizeStorageCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, args...)
+	c.Assert(err, tc.ErrorMatches, expect)
+}
+
+type fakeStorageResizer struct {
+	testhelpers.Stub
+}
+
+func (f *fakeStorageResizer) new(ctx context.Context) (storage.StorageResizerCloser, error) {
+	f.MethodCall(f, "NewStorageResizerCloser")
+	return f, f.NextErr()
+}
+
+func (f *fakeStorageResizer) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeStorageResizer) Resize(ctx context.Context, storageId string, sizeMiB uint64) error {
+	f.MethodCall(f, "Resize", storageId, sizeMiB)
+	return f.NextErr()
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewCreateStorageSnapshotCommandWithAPI returns a command
+// used to take snapshots of storage instances.
+func NewCreateStorageSnapshotCommandWithAPI() cmd.Command {
+	cmd := &createStorageSnapshotCommand{}
+	cmd.newStorageSnapshotterCloser = func(ctx context.Context) (StorageSnapshotterCloser, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	createStorageSnapshotCommandDoc = `
+Takes a point-in-time snapshot of an existing storage instance.
+Specify a storage ID, as output by ` + "`juju storage`" + `.
+
+The storage provisioner takes a snapshot of the volume backing
+the storage instance. If the storage is attached to a unit, the
+unit runs its ` + "`storage-snapshot-pre`" + ` hook before the snapshot
+is taken, so that the charm can quiesce its data, and its
+` + "`storage-snapshot-post`" + ` hook afterwards.
+
+The progress of the snapshot can be followed with
+` + "`juju storage-snapshots`" + `. Once available, the snapshot can be
+used to create new storage with ` + "`juju add-storage --from-snapshot`" + `.
+`
+	createStorageSnapshotCommandExamples = `
+Take a snapshot of the storage ` + "`pgdata/0`" + `:
+
+    juju create-storage-snapshot pgdata/0
+
+`
+	createStorageSnapshotCommandArgs = `<storage>`
+)
+
+// createStorageSnapshotCommand takes snapshots of storage instances.
+type createStorageSnapshotCommand struct {
+	StorageCommandBase
+	newStorageSnapshotterCloser NewStorageSnapshotterCloserFunc
+	storageId                   string
+}
+
+// Init implements Command.Init.
+func (c *createStorageSnapshotCommand) Init(args []string) error {
+	if len(args) != 1 {
+		return errors.New("create-storage-snapshot requires a storage ID")
+	}
+	if !names.IsValidStorage(args[0]) {
+		return errors.NotValidf("storage ID %q", args[0])
+	}
+	c.storageId = args[0]
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *createStorageSnapshotCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "create-storage-snapshot",
+		Purpose:  "Takes a snapshot of an existing storage instance.",
+		Doc:      createStorageSnapshotCommandDoc,
+		Args:     createStorageSnapshotCommandArgs,
+		Examples: createStorageSnapshotCommandExamples,
+		SeeAlso: []string{
+			"storage",
+			"storage-snapshots",
+			"add-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *createStorageSnapshotCommand) Run(ctx *cmd.Context) error {
+	snapshotter, err := c.newStorageSnapshotterCloser(ctx)
+	if err != nil {
+		return err
+	}
+	defer snapshotter.Close()
+
+	id, err := snapshotter.CreateSnapshot(ctx, c.storageId)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "create storage snapshots")
+		}
+		return block.ProcessBlockedError(
+			errors.Annotatef(err, "could not create snapshot of storage %s", c.storageId), block.BlockChange,
+		)
+	}
+	ctx.Infof("creating snapshot %s of %s", id, c.storageId)
+	return nil
+}
+
+// NewStorageSnapshotterCloserFunc is the type of a function that returns a
+// StorageSnapshotterCloser.
+type NewStorageSnapshotterCloserFunc func(ctx context.Context) (StorageSnapshotterCloser, error)
+
+// StorageSnapshotterCloser extends StorageSnapshotter with a Closer method.
+type StorageSnapshotterCloser interface {
+	StorageSnapshotter
+	Close() error
+}
+
+// StorageSnapshotter defines an interface for taking a snapshot of the
+// storage instance with the specified ID.
+type StorageSnapshotter interface {
+	CreateSnapshot(ctx context.Context, storageId string) (string, error)
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type CreateStorageSnapshotSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestCreateStorageSnapshotSuite(t *testing.T) {
+	tc.Run(t, &CreateStorageSnapshotSuite{})
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshot(c *tc.C) {
+	fake := fakeStorageSnapshotter{id: "3"}
+	cmd := storage.NewCreateStorageSnapshotCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "pgdata/0")
+	c.Assert(err, tc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewStorageSnapshotterCloser", "CreateSnapshot", "Close")
+	fake.CheckCall(c, 1, "CreateSnapshot", "pgdata/0")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "creating snapshot 3 of pgdata/0\n")
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshotError(c *tc.C) {
+	var fake fakeStorageSnapshotter
+	fake.SetErrors(nil, &params.Error{Code: params.CodeNotSupported, Message: `storage "pgdata/0" is not backed by a provisioned volume`})
+	cmd := storage.NewCreateStorageSnapshotCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "pgdata/0")
+	c.Assert(err, tc.ErrorMatches, `could not create snapshot of storage pgdata/0: storage "pgdata/0" is not backed by a provisioned volume`)
+	fake.CheckCallNames(c, "NewStorageSnapshotterCloser", "CreateSnapshot", "Close")
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshotUnauthorizedError(c *tc.C) {
+	var fake fakeStorageSnapshotter
+	fake.SetErrors(nil, &params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	cmd := storage.NewCreateStorageSnapshotCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "pgdata/0")
+	c.Assert(err, tc.ErrorMatches, "could not create snapshot of storage pgdata/0: nope")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, `
+You do not have permission to create storage snapshots.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshotBlocked(c *tc.C) {
+	var fake fakeStorageSnapshotter
+	fake.SetErrors(nil, &params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+	cmd := storage.NewCreateStorageSnapshotCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "pgdata/0")
+	c.Assert(err.Error(), tc.Contains, `could not create snapshot of storage pgdata/0: nope`)
+	c.Assert(err.Error(), tc.Contains, `All operations that change model have been disabled for the current model.`)
+}
+
+func (s *CreateStorageSnapshotSuite) TestCreateSnapshotInitErrors(c *tc.C) {
+	s.testCreateSnapshotInitError(c, []string{}, "create-storage-snapshot requires a storage ID")
+	s.testCreateSnapshotInitError(c, []string{"pgdata/0", "pgdata/1"}, "create-storage-snapshot requires a storage ID")
+	s.testCreateSnapshotInitError(c, []string{"pgdata"}, `storage ID "pgdata" not valid`)
+}
+
+func (s *CreateStorageSnapshotSuite) testCreateSnapshotInitError(c *tc.C, args []string, expect string) {
+	cmd := storage.NewCreateStorageSnapshotCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, args...)
+	c.Assert(err, tc.ErrorMatches, expect)
+}
+
+type fakeStorageSnapshotter struct {
+	testhelpers.Stub
+	id string
+}
+
+func (f *fakeStorageSnapshotter) new(ctx context.Context) (storage.StorageSnapshotterCloser, error) {
+	f.MethodCall(f, "NewStorageSnapshotterCloser")
+	return f, f.NextErr()
+}
+
+func (f *fakeStorageSnapshotter) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeStorageSnapshotter) CreateSnapshot(ctx context.Context, storageId string) (string, error) {
+	f.MethodCall(f, "CreateSnapshot", storageId)
+	return f.id, f.NextErr()
+}
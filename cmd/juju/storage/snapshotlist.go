@@ -0,0 +1,140 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SnapshotInfo defines the serialization behaviour of the storage snapshot
+// information.
+type SnapshotInfo struct {
+	Storage string    `yaml:"storage" json:"storage"`
+	Kind    string    `yaml:"kind" json:"kind"`
+	Pool    string    `yaml:"pool,omitempty" json:"pool,omitempty"`
+	Unit    string    `yaml:"unit,omitempty" json:"unit,omitempty"`
+	Status  string    `yaml:"status" json:"status"`
+	Message string    `yaml:"message,omitempty" json:"message,omitempty"`
+	Size    uint64    `yaml:"size,omitempty" json:"size,omitempty"`
+	Created time.Time `yaml:"created" json:"created"`
+}
+
+func formatSnapshotInfo(all []params.StorageSnapshotDetails) map[string]SnapshotInfo {
+	output := make(map[string]SnapshotInfo, len(all))
+	for _, one := range all {
+		info := SnapshotInfo{
+			Kind:    one.Kind.String(),
+			Pool:    one.Pool,
+			Status:  one.Status,
+			Message: one.Message,
+			Size:    one.SizeMiB,
+			Created: one.Created,
+		}
+		if tag, err := names.ParseStorageTag(one.StorageTag); err == nil {
+			info.Storage = tag.Id()
+		}
+		if tag, err := names.ParseUnitTag(one.UnitTag); err == nil {
+			info.Unit = tag.Id()
+		}
+		output[one.Id] = info
+	}
+	return output
+}
+
+const snapshotListCommandDoc = `
+Lists the storage snapshots in the model, as taken with
+` + "`juju create-storage-snapshot`" + `.
+
+A snapshot is ` + "`available`" + ` once it has been taken and any unit
+the storage was attached to has run its ` + "`storage-snapshot-post`" + `
+hook. Available snapshots can be used to create new storage with
+` + "`juju add-storage --from-snapshot`" + `.
+`
+
+const snapshotListCommandExample = `
+List all storage snapshots:
+
+    juju storage-snapshots
+
+List all storage snapshots in YAML format:
+
+    juju storage-snapshots --format yaml
+`
+
+// NewSnapshotListCommand returns a command that lists storage snapshots on a
+// model.
+func NewSnapshotListCommand() cmd.Command {
+	cmd := &snapshotListCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (SnapshotListAPI, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// snapshotListCommand lists storage snapshots.
+type snapshotListCommand struct {
+	StorageCommandBase
+	newAPIFunc func(ctx context.Context) (SnapshotListAPI, error)
+	out        cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *snapshotListCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "storage-snapshots",
+		Purpose:  "List storage snapshots.",
+		Doc:      snapshotListCommandDoc,
+		Aliases:  []string{"list-storage-snapshots"},
+		Examples: snapshotListCommandExample,
+		SeeAlso: []string{
+			"create-storage-snapshot",
+			"add-storage",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *snapshotListCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSnapshotListTabular,
+	})
+}
+
+// Run implements Command.Run.
+func (c *snapshotListCommand) Run(ctx *cmd.Context) (err error) {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+	result, err := api.ListSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	if len(result) == 0 {
+		ctx.Infof("No storage snapshots to display.")
+		return nil
+	}
+	output := formatSnapshotInfo(result)
+	return c.out.Write(ctx, output)
+}
+
+// SnapshotListAPI defines the API methods that the storage snapshot list
+// command uses.
+type SnapshotListAPI interface {
+	Close() error
+	ListSnapshots(ctx context.Context) ([]params.StorageSnapshotDetails, error)
+}
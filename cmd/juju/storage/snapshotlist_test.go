@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	goyaml "gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/rpc/params"
+)
+
+type snapshotListSuite struct {
+	SubStorageSuite
+	mockAPI *mockSnapshotListAPI
+}
+
+func TestSnapshotListSuite(t *testing.T) {
+	tc.Run(t, &snapshotListSuite{})
+}
+
+func (s *snapshotListSuite) SetUpTest(c *tc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.mockAPI = &mockSnapshotListAPI{
+		snapshots: []params.StorageSnapshotDetails{
+			{
+				Id:         "10",
+				StorageTag: "storage-pgdata-1",
+				Kind:       params.StorageKindBlock,
+				Pool:       "ebs",
+				Status:     "error",
+				Message:    "boom",
+				Created:    created,
+			},
+			{
+				Id:         "2",
+				StorageTag: "storage-pgdata-0",
+				Kind:       params.StorageKindBlock,
+				Pool:       "ebs",
+				UnitTag:    "unit-postgresql-0",
+				Status:     "available",
+				SizeMiB:    10240,
+				Created:    created,
+			},
+		},
+	}
+}
+
+func (s *snapshotListSuite) runSnapshotList(c *tc.C, args ...string) (*cmd.Context, error) {
+	args = append(args, []string{"-m", "controller"}...)
+	return cmdtesting.RunCommand(c, storage.NewSnapshotListCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *snapshotListSuite) TestSnapshotListEmpty(c *tc.C) {
+	s.mockAPI.snapshots = nil
+	ctx, err := s.runSnapshotList(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No storage snapshots to display.\n")
+}
+
+func (s *snapshotListSuite) TestSnapshotListTabular(c *tc.C) {
+	ctx, err := s.runSnapshotList(c)
+	c.Assert(err, tc.ErrorIsNil)
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	since := common.FormatTime(&created, false)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, fmt.Sprintf(`
+Snapshot  Storage   Unit          Pool  Size    Status     %-[2]*[3]s  Message
+2         pgdata/0  postgresql/0  ebs   10 GiB  available  %[1]s  
+10        pgdata/1                ebs           error      %[1]s  boom
+`[1:], since, len(since), "Created"))
+}
+
+func (s *snapshotListSuite) TestSnapshotListYAML(c *tc.C) {
+	s.assertUnmarshalledOutput(c, goyaml.Unmarshal, "--format", "yaml")
+}
+
+func (s *snapshotListSuite) TestSnapshotListJSON(c *tc.C) {
+	s.assertUnmarshalledOutput(c, json.Unmarshal, "--format", "json")
+}
+
+func (s *snapshotListSuite) assertUnmarshalledOutput(c *tc.C, unmarshall unmarshaller, args ...string) {
+	ctx, err := s.runSnapshotList(c, args...)
+	c.Assert(err, tc.ErrorIsNil)
+	var result map[string]storage.SnapshotInfo
+	err = unmarshall([]byte(cmdtesting.Stdout(ctx)), &result)
+	c.Assert(err, tc.ErrorIsNil)
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	c.Assert(result, tc.HasLen, 2)
+	c.Check(result["2"].Created.Equal(created), tc.IsTrue)
+	c.Check(result["10"].Created.Equal(created), tc.IsTrue)
+	result["2"] = withCreated(result["2"], created)
+	result["10"] = withCreated(result["10"], created)
+	c.Check(result, tc.DeepEquals, map[string]storage.SnapshotInfo{
+		"2": {
+			Storage: "pgdata/0",
+			Kind:    "block",
+			Pool:    "ebs",
+			Unit:    "postgresql/0",
+			Status:  "available",
+			Size:    10240,
+			Created: created,
+		},
+		"10": {
+			Storage: "pgdata/1",
+			Kind:    "block",
+			Pool:    "ebs",
+			Status:  "error",
+			Message: "boom",
+			Created: created,
+		},
+	})
+}
+
+// withCreated returns info with its created time replaced, so that times
+// decoded with a different location compare equal.
+func withCreated(info storage.SnapshotInfo, created time.Time) storage.SnapshotInfo {
+	info.Created = created
+	return info
+}
+
+type mockSnapshotListAPI struct {
+	snapshots []params.StorageSnapshotDetails
+}
+
+func (s mockSnapshotListAPI) Close() error {
+	return nil
+}
+
+func (s mockSnapshotListAPI) ListSnapshots(ctx context.Context) ([]params.StorageSnapshotDetails, error) {
+	return s.snapshots, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/naturalsort"
+)
+
+// formatSnapshotListTabular returns a tabular summary of storage snapshots
+// or errors out if parameter is not a map of SnapshotInfo.
+func formatSnapshotListTabular(writer io.Writer, value any) error {
+	snapshots, ok := value.(map[string]SnapshotInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", snapshots, value)
+	}
+	formatSnapshotsTabular(writer, snapshots)
+	return nil
+}
+
+// formatSnapshotsTabular returns a tabular summary of storage snapshots.
+func formatSnapshotsTabular(writer io.Writer, snapshots map[string]SnapshotInfo) {
+	tw := output.TabWriter(writer)
+	print := func(values ...string) {
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+
+	print("Snapshot", "Storage", "Unit", "Pool", "Size", "Status", "Created", "Message")
+
+	ids := make([]string, 0, len(snapshots))
+	for id := range snapshots {
+		ids = append(ids, id)
+	}
+	naturalsort.Sort(ids)
+	for _, id := range ids {
+		snapshot := snapshots[id]
+		var size string
+		if snapshot.Size > 0 {
+			size = humanize.IBytes(snapshot.Size * humanize.MiByte)
+		}
+		print(
+			id, snapshot.Storage, snapshot.Unit, snapshot.Pool, size,
+			snapshot.Status, common.FormatTime(&snapshot.Created, false),
+			snapshot.Message,
+		)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// entity holds the fields of a model, application, unit or machine that a
+// query is evaluated against.
+type entity struct {
+	name   string
+	fields map[string]string
+}
+
+// entityKind describes how to find the entities of one kind in the status
+// of a model.
+type entityKind struct {
+	// name is the name of the kind, as used by the wait-for subcommand.
+	name string
+	// fields lists the fields that queries can reference, in the order
+	// they're documented.
+	fields []string
+	// defaultQuery is the query used if none is specified.
+	defaultQuery string
+	// entities returns the entities of the kind, keyed by name.
+	entities func(*params.FullStatus) map[string]entity
+}
+
+var modelKind = entityKind{
+	name: "model",
+	fields: []string{
+		"name", "type", "status", "message", "version", "available-version",
+		"region", "applications", "machines", "units",
+	},
+	defaultQuery: `status=="available"`,
+	entities: func(status *params.FullStatus) map[string]entity {
+		var units int
+		for _, app := range status.Applications {
+			units += len(app.Units)
+		}
+		model := status.Model
+		return map[string]entity{
+			model.Name: {
+				name: model.Name,
+				fields: map[string]string{
+					"name":              model.Name,
+					"type":              model.Type,
+					"status":            model.ModelStatus.Status,
+					"message":           model.ModelStatus.Info,
+					"version":           model.Version,
+					"available-version": model.AvailableVersion,
+					"region":            model.CloudRegion,
+					"applications":      strconv.Itoa(len(status.Applications)),
+					"machines":          strconv.Itoa(len(status.Machines)),
+					"units":             strconv.Itoa(units),
+				},
+			},
+		}
+	},
+}
+
+var applicationKind = entityKind{
+	name: "application",
+	fields: []string{
+		"name", "life", "status", "message", "charm", "charm-channel",
+		"charm-rev", "exposed", "scale", "units", "workload-version", "base",
+	},
+	defaultQuery: `life=="alive" && status=="active"`,
+	entities: func(status *params.FullStatus) map[string]entity {
+		entities := make(map[string]entity, len(status.Applications))
+		for name, app := range status.Applications {
+			entities[name] = entity{
+				name: name,
+				fields: map[string]string{
+					"name":             name,
+					"life":             string(app.Life),
+					"status":           app.Status.Status,
+					"message":          app.Status.Info,
+					"charm":            app.Charm,
+					"charm-channel":    app.CharmChannel,
+					"charm-rev":        strconv.Itoa(app.CharmRev),
+					"exposed":          strconv.FormatBool(app.Exposed),
+					"scale":            strconv.Itoa(app.Scale),
+					"units":            strconv.Itoa(len(app.Units)),
+					"workload-version": app.WorkloadVersion,
+					"base":             formatBase(app.Base),
+				},
+			}
+		}
+		return entities
+	},
+}
+
+var unitKind = entityKind{
+	name: "unit",
+	fields: []string{
+		"name", "application", "life", "workload-status", "workload-message",
+		"agent-status", "agent-message", "machine", "leader", "address",
+	},
+	defaultQuery: `life=="alive" && workload-status=="active" && agent-status=="idle"`,
+	entities: func(status *params.FullStatus) map[string]entity {
+		entities := make(map[string]entity)
+		var add func(string, map[string]params.UnitStatus)
+		add = func(appName string, units map[string]params.UnitStatus) {
+			for name, unit := range units {
+				entities[name] = entity{
+					name: name,
+					fields: map[string]string{
+						"name":             name,
+						"application":      unitApplication(name, appName),
+						"life":             string(unit.AgentStatus.Life),
+						"workload-status":  unit.WorkloadStatus.Status,
+						"workload-message": unit.WorkloadStatus.Info,
+						"agent-status":     unit.AgentStatus.Status,
+						"agent-message":    unit.AgentStatus.Info,
+						"machine":          unit.Machine,
+						"leader":           strconv.FormatBool(unit.Leader),
+						"address":          unit.PublicAddress,
+					},
+				}
+				add("", unit.Subordinates)
+			}
+		}
+		for appName, app := range status.Applications {
+			add(appName, app.Units)
+		}
+		return entities
+	},
+}
+
+var machineKind = entityKind{
+	name: "machine",
+	fields: []string{
+		"name", "life", "status", "message", "instance-status",
+		"instance-message", "instance-id", "hostname", "dns-name", "base",
+	},
+	defaultQuery: `life=="alive" && status=="started"`,
+	entities: func(status *params.FullStatus) map[string]entity {
+		entities := make(map[string]entity)
+		var add func(map[string]params.MachineStatus)
+		add = func(machines map[string]params.MachineStatus) {
+			for id, machine := range machines {
+				entities[id] = entity{
+					name: id,
+					fields: map[string]string{
+						"name":             id,
+						"life":             string(machine.AgentStatus.Life),
+						"status":           machine.AgentStatus.Status,
+						"message":          machine.AgentStatus.Info,
+						"instance-status":  machine.InstanceStatus.Status,
+						"instance-message": machine.InstanceStatus.Info,
+						"instance-id":      string(machine.InstanceId),
+						"hostname":         machine.Hostname,
+						"dns-name":         machine.DNSName,
+						"base":             formatBase(machine.Base),
+					},
+				}
+				add(machine.Containers)
+			}
+		}
+		add(status.Machines)
+		return entities
+	},
+}
+
+// kindFields returns the documentation of the fields of an entity kind.
+func (k entityKind) kindFields() string {
+	return strings.Join(k.fields, ", ")
+}
+
+// sortedNames returns the names of the input entities in order.
+func sortedNames(entities map[string]entity) []string {
+	names := make([]string, 0, len(entities))
+	for name := range entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// unitApplication returns the application of a unit. Subordinate units are
+// reported without their application, so it's taken from the unit name.
+func unitApplication(unitName, appName string) string {
+	if appName != "" {
+		return appName
+	}
+	app, _, _ := strings.Cut(unitName, "/")
+	return app
+}
+
+func formatBase(base params.Base) string {
+	if base.Name == "" {
+		return ""
+	}
+	return base.Name + "@" + base.Channel
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewWaitForCommandForTest returns a wait-for command for the input kind of
+// entity, using the api and clock provided.
+func NewWaitForCommandForTest(kind string, api StatusAPI, clock clock.Clock) cmd.Command {
+	kinds := map[string]entityKind{
+		modelKind.name:       modelKind,
+		applicationKind.name: applicationKind,
+		unitKind.name:        unitKind,
+		machineKind.name:     machineKind,
+	}
+	c := &waitForCommand{
+		kind:  kinds[kind],
+		api:   api,
+		clock: clock,
+	}
+	c.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(c)
+}
@@ -0,0 +1,401 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/juju/errors"
+)
+
+// Query is a parsed wait-for condition. A query is made up of comparisons
+// between a field of an entity and a value, combined with "&&", "||" and
+// "!", and grouped with parentheses:
+//
+//	life=="alive" && (workload-status=="active" || workload-status=="idle")
+//
+// Values may be quoted strings, numbers or bare words. Fields are compared
+// numerically when both sides are numbers, and as strings otherwise.
+type Query struct {
+	source string
+	root   node
+}
+
+// Mismatch describes a comparison in a query that an entity doesn't
+// satisfy, along with the value the entity actually has.
+type Mismatch struct {
+	// Want is the unmet condition.
+	Want string
+	// Field is the field compared by the condition, empty if the condition
+	// isn't a single comparison.
+	Field string
+	// Got is the current value of the field.
+	Got string
+}
+
+// ParseQuery parses the input wait-for condition.
+func ParseQuery(source string) (Query, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return Query{}, errors.Trace(err)
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return Query{}, errors.Annotatef(err, "parsing query %q", source)
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return Query{}, errors.Errorf("parsing query %q: unexpected %q at offset %d", source, tok.text, tok.pos)
+	}
+	return Query{source: source, root: root}, nil
+}
+
+// String returns the source of the query.
+func (q Query) String() string {
+	return q.source
+}
+
+// Fields returns the names of the fields referenced by the query.
+func (q Query) Fields() []string {
+	var fields []string
+	q.root.fields(func(name string) {
+		for _, f := range fields {
+			if f == name {
+				return
+			}
+		}
+		fields = append(fields, name)
+	})
+	return fields
+}
+
+// Evaluate evaluates the query against the input fields of an entity. If
+// the query isn't satisfied, the comparisons that caused it to fail are
+// returned. An error is returned if the query references a field that isn't
+// in the input, or orders values that aren't numbers.
+func (q Query) Evaluate(fields map[string]string) (bool, []Mismatch, error) {
+	return q.root.eval(fields)
+}
+
+type node interface {
+	eval(fields map[string]string) (bool, []Mismatch, error)
+	fields(func(string))
+	String() string
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n andNode) eval(fields map[string]string) (bool, []Mismatch, error) {
+	lok, lmis, err := n.left.eval(fields)
+	if err != nil {
+		return false, nil, err
+	}
+	rok, rmis, err := n.right.eval(fields)
+	if err != nil {
+		return false, nil, err
+	}
+	return lok && rok, append(lmis, rmis...), nil
+}
+
+func (n andNode) fields(f func(string)) {
+	n.left.fields(f)
+	n.right.fields(f)
+}
+
+func (n andNode) String() string {
+	return fmt.Sprintf("%s && %s", n.left, n.right)
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n orNode) eval(fields map[string]string) (bool, []Mismatch, error) {
+	lok, lmis, err := n.left.eval(fields)
+	if err != nil {
+		return false, nil, err
+	}
+	rok, rmis, err := n.right.eval(fields)
+	if err != nil {
+		return false, nil, err
+	}
+	if lok || rok {
+		return true, nil, nil
+	}
+	return false, append(lmis, rmis...), nil
+}
+
+func (n orNode) fields(f func(string)) {
+	n.left.fields(f)
+	n.right.fields(f)
+}
+
+func (n orNode) String() string {
+	return fmt.Sprintf("(%s || %s)", n.left, n.right)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(fields map[string]string) (bool, []Mismatch, error) {
+	ok, _, err := n.operand.eval(fields)
+	if err != nil {
+		return false, nil, err
+	}
+	if ok {
+		return false, []Mismatch{{Want: n.String()}}, nil
+	}
+	return true, nil, nil
+}
+
+func (n notNode) fields(f func(string)) {
+	n.operand.fields(f)
+}
+
+func (n notNode) String() string {
+	return fmt.Sprintf("!(%s)", n.operand)
+}
+
+type compareNode struct {
+	field string
+	op    string
+	value string
+}
+
+func (n compareNode) eval(fields map[string]string) (bool, []Mismatch, error) {
+	got, ok := fields[n.field]
+	if !ok {
+		return false, nil, errors.NotValidf("field %q", n.field)
+	}
+	ok, err := compare(got, n.op, n.value)
+	if err != nil {
+		return false, nil, errors.Annotatef(err, "evaluating %s", n)
+	}
+	if ok {
+		return true, nil, nil
+	}
+	return false, []Mismatch{{Want: n.String(), Field: n.field, Got: got}}, nil
+}
+
+func (n compareNode) fields(f func(string)) {
+	f(n.field)
+}
+
+func (n compareNode) String() string {
+	return fmt.Sprintf("%s %s %q", n.field, n.op, n.value)
+}
+
+func compare(got, op, want string) (bool, error) {
+	gotNum, gotErr := strconv.ParseFloat(got, 64)
+	wantNum, wantErr := strconv.ParseFloat(want, 64)
+	if gotErr == nil && wantErr == nil {
+		switch op {
+		case "==":
+			return gotNum == wantNum, nil
+		case "!=":
+			return gotNum != wantNum, nil
+		case "<":
+			return gotNum < wantNum, nil
+		case "<=":
+			return gotNum <= wantNum, nil
+		case ">":
+			return gotNum > wantNum, nil
+		case ">=":
+			return gotNum >= wantNum, nil
+		}
+	}
+	switch op {
+	case "==":
+		return got == want, nil
+	case "!=":
+		return got != want, nil
+	}
+	return false, errors.NotValidf("ordering non-numeric values %q and %q", got, want)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOp
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func lex(source string) ([]token, error) {
+	var tokens []token
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == '&' || r == '|':
+			if i+1 >= len(runes) || runes[i+1] != r {
+				return nil, errors.Errorf("unexpected %q at offset %d", string(r), i)
+			}
+			kind := tokenAnd
+			if r == '|' {
+				kind = tokenOr
+			}
+			tokens = append(tokens, token{kind: kind, text: string(runes[i : i+2]), pos: i})
+			i += 2
+		case r == '=' || r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenOp, text: string(runes[i : i+2]), pos: i})
+				i += 2
+				continue
+			}
+			switch r {
+			case '!':
+				tokens = append(tokens, token{kind: tokenNot, text: "!", pos: i})
+			case '<', '>':
+				tokens = append(tokens, token{kind: tokenOp, text: string(r), pos: i})
+			default:
+				return nil, errors.Errorf("unexpected %q at offset %d, expected \"==\"", string(r), i)
+			}
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			var value strings.Builder
+			for ; end < len(runes) && runes[end] != r; end++ {
+				if runes[end] == '\\' && end+1 < len(runes) {
+					end++
+				}
+				value.WriteRune(runes[end])
+			}
+			if end >= len(runes) {
+				return nil, errors.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value.String(), pos: i})
+			i = end + 1
+		case isWordRune(r):
+			end := i
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+			text := string(runes[i:end])
+			kind := tokenIdent
+			if _, err := strconv.ParseFloat(text, 64); err == nil {
+				kind = tokenNumber
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: i})
+			i = end
+		default:
+			return nil, errors.Errorf("unexpected %q at offset %d", string(r), i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of query", pos: len(runes)}), nil
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.' || r == '/'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNot:
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	case tokenLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, errors.Errorf("unexpected %q at offset %d, expected \")\"", closing.text, closing.pos)
+		}
+		return inner, nil
+	case tokenIdent:
+		op := p.next()
+		if op.kind != tokenOp {
+			return nil, errors.Errorf("unexpected %q at offset %d, expected a comparison", op.text, op.pos)
+		}
+		value := p.next()
+		switch value.kind {
+		case tokenIdent, tokenString, tokenNumber:
+		default:
+			return nil, errors.Errorf("unexpected %q at offset %d, expected a value", value.text, value.pos)
+		}
+		return compareNode{field: tok.text, op: op.text, value: value.text}, nil
+	}
+	return nil, errors.Errorf("unexpected %q at offset %d, expected a field", tok.text, tok.pos)
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/waitfor"
+)
+
+type querySuite struct{}
+
+func TestQuerySuite(t *testing.T) {
+	tc.Run(t, &querySuite{})
+}
+
+var unitFields = map[string]string{
+	"name":            "mysql/0",
+	"life":            "alive",
+	"workload-status": "waiting",
+	"agent-status":    "idle",
+	"leader":          "true",
+	"units":           "3",
+}
+
+func (s *querySuite) TestEvaluate(c *tc.C) {
+	tests := []struct {
+		query string
+		ok    bool
+	}{
+		{`life=="alive"`, true},
+		{`life == 'alive'`, true},
+		{`life==alive`, true},
+		{`life!="alive"`, false},
+		{`life=="alive" && workload-status=="active"`, false},
+		{`life=="alive" && (workload-status=="active" || workload-status=="waiting")`, true},
+		{`!(workload-status=="active")`, true},
+		{`!workload-status=="waiting" || leader==true`, true},
+		{`name=="mysql/0"`, true},
+		{`units>=3 && units<4`, true},
+		{`units>3`, false},
+		{`units==3.0`, true},
+	}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.query)
+		query, err := waitfor.ParseQuery(test.query)
+		c.Assert(err, tc.ErrorIsNil)
+		ok, _, err := query.Evaluate(unitFields)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(ok, tc.Equals, test.ok)
+	}
+}
+
+func (s *querySuite) TestEvaluateMismatches(c *tc.C) {
+	query, err := waitfor.ParseQuery(`life=="alive" && workload-status=="active" && !(leader==true)`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	ok, mismatches, err := query.Evaluate(unitFields)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ok, tc.IsFalse)
+	c.Check(mismatches, tc.DeepEquals, []waitfor.Mismatch{{
+		Want:  `workload-status == "active"`,
+		Field: "workload-status",
+		Got:   "waiting",
+	}, {
+		Want: `!(leader == "true")`,
+	}})
+}
+
+func (s *querySuite) TestEvaluateSatisfiedHasNoMismatches(c *tc.C) {
+	query, err := waitfor.ParseQuery(`workload-status=="active" || agent-status=="idle"`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	ok, mismatches, err := query.Evaluate(unitFields)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ok, tc.IsTrue)
+	c.Check(mismatches, tc.HasLen, 0)
+}
+
+func (s *querySuite) TestEvaluateUnknownField(c *tc.C) {
+	query, err := waitfor.ParseQuery(`colour=="blue"`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = query.Evaluate(unitFields)
+	c.Check(err, tc.Satisfies, errors.IsNotValid)
+}
+
+func (s *querySuite) TestEvaluateOrderingStrings(c *tc.C) {
+	query, err := waitfor.ParseQuery(`life>"alive"`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = query.Evaluate(unitFields)
+	c.Check(err, tc.ErrorMatches, `evaluating life > "alive": ordering non-numeric values "alive" and "alive" not valid`)
+}
+
+func (s *querySuite) TestFields(c *tc.C) {
+	query, err := waitfor.ParseQuery(`life=="alive" && (status=="active" || !(life=="dying"))`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(query.Fields(), tc.DeepEquals, []string{"life", "status"})
+}
+
+func (s *querySuite) TestParseErrors(c *tc.C) {
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{``, `parsing query "": unexpected "end of query" at offset 0, expected a field`},
+		{`life=alive`, `unexpected "=" at offset 4, expected "=="`},
+		{`life&alive`, `unexpected "&" at offset 4`},
+		{`life=="alive`, `unterminated string at offset 6`},
+		{`life`, `parsing query "life": unexpected "end of query" at offset 4, expected a comparison`},
+		{`life==`, `parsing query "life==": unexpected "end of query" at offset 6, expected a value`},
+		{`(life=="alive"`, `parsing query .*: unexpected "end of query" at offset 14, expected "\)"`},
+		{`life=="alive" status=="active"`, `parsing query .*: unexpected "status" at offset 14`},
+		{`"alive"==life`, `parsing query .*: unexpected "alive" at offset 0, expected a field`},
+	}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.query)
+		_, err := waitfor.ParseQuery(test.query)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/client"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+var usageWaitForSummary = `
+Wait for an entity to reach a specified state.`[1:]
+
+var usageWaitForDetails = `
+The wait-for command blocks until the model, or its applications, units or
+machines, satisfy a query over their status. If the query isn't satisfied
+before the timeout expires, the command exits with an error and reports the
+conditions that were unmet.
+
+Queries compare the fields of an entity with values, and combine the
+comparisons with "&&", "||" and "!". For the fields of each kind of entity,
+see the help of the corresponding subcommand.
+`[1:]
+
+const usageWaitForExamples = `
+    juju wait-for model --query='status=="available" && units>=3'
+    juju wait-for application mysql --timeout=20m
+    juju wait-for unit 'mysql/*' --query='life=="alive" && workload-status=="active"'
+    juju wait-for machine 0 --query='instance-status=="running"'
+`
+
+// NewWaitForCommand returns a command that waits for the entities of a
+// model to satisfy a query.
+func NewWaitForCommand() cmd.Command {
+	waitFor := jujucmd.NewSuperCommand(cmd.SuperCommandParams{
+		Name:        "wait-for",
+		UsagePrefix: "juju",
+		Purpose:     usageWaitForSummary,
+		Doc:         usageWaitForDetails,
+		Examples:    usageWaitForExamples,
+	})
+	for _, kind := range []entityKind{modelKind, applicationKind, unitKind, machineKind} {
+		waitFor.Register(newWaitForKindCommand(kind))
+	}
+	return waitFor
+}
+
+func newWaitForKindCommand(kind entityKind) cmd.Command {
+	return modelcmd.Wrap(&waitForCommand{
+		kind:  kind,
+		clock: clock.WallClock,
+	})
+}
+
+// StatusAPI defines the client API methods used by the wait-for commands.
+type StatusAPI interface {
+	Status(ctx context.Context, args *client.StatusArgs) (*params.FullStatus, error)
+	Close() error
+}
+
+// waitForCommand waits for the entities of one kind to satisfy a query.
+type waitForCommand struct {
+	modelcmd.ModelCommandBase
+
+	api   StatusAPI
+	clock clock.Clock
+	kind  entityKind
+
+	patterns []string
+	rawQuery string
+	query    Query
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// Info implements Command.Info.
+func (c *waitForCommand) Info() *cmd.Info {
+	info := &cmd.Info{
+		Name:    c.kind.name,
+		Purpose: fmt.Sprintf("Wait for %s to reach a specified state.", c.description()),
+		Doc: fmt.Sprintf(`
+Waits until %s satisfies a query, or until the timeout expires.
+The default query is:
+
+    %s
+
+The fields available to the query are:
+
+    %s
+`[1:], c.description(), c.kind.defaultQuery, c.kind.kindFields()),
+		SeeAlso: []string{"status"},
+	}
+	if c.kind.name != modelKind.name {
+		info.Args = "[<name>...]"
+		info.Doc += fmt.Sprintf(`
+Names may contain wildcards, and every %[1]s matching a name must satisfy the
+query. If no names are given, every %[1]s in the model must satisfy the query.
+`, c.kind.name)
+	}
+	return jujucmd.Info(info)
+}
+
+func (c *waitForCommand) description() string {
+	if c.kind.name == modelKind.name {
+		return "the model"
+	}
+	return "the " + c.kind.name + "s of the model"
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *waitForCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.rawQuery, "query", c.kind.defaultQuery, "The query to wait for")
+	f.DurationVar(&c.timeout, "timeout", 10*time.Minute, "How long to wait before failing")
+	f.DurationVar(&c.interval, "interval", 5*time.Second, "How often to check the status of the model")
+}
+
+// Init implements Command.Init.
+func (c *waitForCommand) Init(args []string) error {
+	if c.kind.name == modelKind.name {
+		if err := cmd.CheckEmpty(args); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for _, pattern := range args {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.NotValidf("%s name %q", c.kind.name, pattern)
+		}
+	}
+	c.patterns = args
+
+	if c.timeout <= 0 {
+		return errors.NotValidf("timeout %v", c.timeout)
+	}
+	if c.interval <= 0 {
+		return errors.NotValidf("interval %v", c.interval)
+	}
+
+	query, err := ParseQuery(c.rawQuery)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, field := range query.Fields() {
+		if !c.hasField(field) {
+			return errors.NotValidf("%s field %q in query, expected one of %s", c.kind.name, field, c.kind.kindFields())
+		}
+	}
+	c.query = query
+	return nil
+}
+
+func (c *waitForCommand) hasField(field string) bool {
+	for _, f := range c.kind.fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *waitForCommand) getAPI(ctx context.Context) (StatusAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewAPIClient(ctx)
+}
+
+// Run implements Command.Run.
+func (c *waitForCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	timeout := c.clock.After(c.timeout)
+	for {
+		status, err := api.Status(ctx, &client.StatusArgs{})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		result, err := c.evaluate(status)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(result.unmet) == 0 {
+			ctx.Infof("%s satisfied %s", c.summarise(result.total), c.query)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-timeout:
+			writeUnmet(ctx.Stderr, c.kind.name, result.unmet)
+			return errors.Errorf(
+				"timed out after %v waiting for %d of %s to satisfy %s",
+				c.timeout, len(result.unmet), c.summarise(result.total), c.query,
+			)
+		case <-c.clock.After(c.interval):
+		}
+	}
+}
+
+func (c *waitForCommand) summarise(total int) string {
+	if total == 1 {
+		return fmt.Sprintf("1 %s", c.kind.name)
+	}
+	return fmt.Sprintf("%d %ss", total, c.kind.name)
+}
+
+// unmet describes an entity that doesn't satisfy the query.
+type unmet struct {
+	name       string
+	missing    bool
+	mismatches []Mismatch
+}
+
+type evaluation struct {
+	total int
+	unmet []unmet
+}
+
+// evaluate evaluates the query against the entities selected by the
+// command's patterns.
+func (c *waitForCommand) evaluate(status *params.FullStatus) (evaluation, error) {
+	entities := c.kind.entities(status)
+
+	var (
+		result   evaluation
+		selected []string
+	)
+	if len(c.patterns) == 0 {
+		selected = sortedNames(entities)
+		if len(selected) == 0 {
+			result.total++
+			result.unmet = append(result.unmet, unmet{name: "*", missing: true})
+		}
+	}
+	seen := make(map[string]bool)
+	for _, pattern := range c.patterns {
+		var matched bool
+		for _, name := range sortedNames(entities) {
+			if ok, _ := path.Match(pattern, name); !ok {
+				continue
+			}
+			matched = true
+			if !seen[name] {
+				seen[name] = true
+				selected = append(selected, name)
+			}
+		}
+		if !matched {
+			result.total++
+			result.unmet = append(result.unmet, unmet{name: pattern, missing: true})
+		}
+	}
+
+	for _, name := range selected {
+		result.total++
+		ok, mismatches, err := c.query.Evaluate(entities[name].fields)
+		if err != nil {
+			return evaluation{}, errors.Annotatef(err, "evaluating %s %q", c.kind.name, name)
+		}
+		if !ok {
+			result.unmet = append(result.unmet, unmet{name: name, mismatches: mismatches})
+		}
+	}
+	return result, nil
+}
+
+// writeUnmet writes the unmet conditions of each entity as a diff, with the
+// wanted conditions prefixed by "-" and the actual values by "+".
+func writeUnmet(w io.Writer, kind string, entities []unmet) {
+	for _, entity := range entities {
+		fmt.Fprintf(w, "%s %q:\n", kind, entity.name)
+		if entity.missing {
+			fmt.Fprintf(w, "  - %s exists\n", kind)
+			fmt.Fprintf(w, "  + not found\n")
+			continue
+		}
+		for _, mismatch := range entity.mismatches {
+			fmt.Fprintf(w, "  - %s\n", mismatch.Want)
+			if mismatch.Field != "" {
+				fmt.Fprintf(w, "  + %s == %q\n", mismatch.Field, mismatch.Got)
+			}
+		}
+	}
+}
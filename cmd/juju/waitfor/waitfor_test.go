@@ -0,0 +1,211 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/waitfor"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/rpc/params"
+)
+
+type waitForSuite struct {
+	api   *fakeStatusAPI
+	clock testclock.AdvanceableClock
+}
+
+func TestWaitForSuite(t *testing.T) {
+	tc.Run(t, &waitForSuite{})
+}
+
+func (s *waitForSuite) SetUpTest(c *tc.C) {
+	s.api = &fakeStatusAPI{}
+	s.clock = testclock.NewDilatedWallClock(time.Millisecond)
+}
+
+func (s *waitForSuite) run(c *tc.C, kind string, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, waitfor.NewWaitForCommandForTest(kind, s.api, s.clock), args...)
+}
+
+func unit(workload string) params.UnitStatus {
+	return params.UnitStatus{
+		AgentStatus:    params.DetailedStatus{Status: "idle", Life: life.Alive},
+		WorkloadStatus: params.DetailedStatus{Status: workload},
+		Machine:        "0",
+	}
+}
+
+func fullStatus(units map[string]params.UnitStatus) *params.FullStatus {
+	return &params.FullStatus{
+		Model: params.ModelStatusInfo{
+			Name:        "default",
+			Type:        "iaas",
+			ModelStatus: params.DetailedStatus{Status: "available"},
+		},
+		Machines: map[string]params.MachineStatus{
+			"0": {
+				Id:          "0",
+				AgentStatus: params.DetailedStatus{Status: "started", Life: life.Alive},
+				Containers: map[string]params.MachineStatus{
+					"0/lxd/0": {
+						Id:          "0/lxd/0",
+						AgentStatus: params.DetailedStatus{Status: "pending", Life: life.Alive},
+					},
+				},
+			},
+		},
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": {
+				Life:   life.Alive,
+				Status: params.DetailedStatus{Status: "active"},
+				Units:  units,
+			},
+		},
+	}
+}
+
+func (s *waitForSuite) TestWaitForUnits(c *tc.C) {
+	s.api.statuses = []*params.FullStatus{
+		fullStatus(map[string]params.UnitStatus{
+			"mysql/0": unit("active"),
+			"mysql/1": unit("waiting"),
+		}),
+		fullStatus(map[string]params.UnitStatus{
+			"mysql/0": unit("active"),
+			"mysql/1": unit("active"),
+		}),
+	}
+
+	ctx, err := s.run(c, "unit", "mysql/*", "--interval", "1s")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.calls, tc.Equals, 2)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals,
+		`2 units satisfied life=="alive" && workload-status=="active" && agent-status=="idle"`+"\n")
+}
+
+func (s *waitForSuite) TestWaitForTimeout(c *tc.C) {
+	s.api.statuses = []*params.FullStatus{
+		fullStatus(map[string]params.UnitStatus{
+			"mysql/0": unit("active"),
+			"mysql/1": unit("waiting"),
+		}),
+	}
+
+	ctx, err := s.run(c, "unit", "mysql/0", "mysql/1", "mysql/2",
+		"--query", `workload-status=="active"`, "--timeout", "10s", "--interval", "1s")
+	c.Assert(err, tc.ErrorMatches,
+		`timed out after 10s waiting for 2 of 3 units to satisfy workload-status=="active"`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+unit "mysql/2":
+  - unit exists
+  + not found
+unit "mysql/1":
+  - workload-status == "active"
+  + workload-status == "waiting"
+`[1:])
+}
+
+func (s *waitForSuite) TestWaitForModel(c *tc.C) {
+	s.api.statuses = []*params.FullStatus{
+		fullStatus(map[string]params.UnitStatus{"mysql/0": unit("active")}),
+	}
+
+	ctx, err := s.run(c, "model", "--query", `status=="available" && units>=1 && machines==1`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Matches, `1 model satisfied .*\n`)
+}
+
+func (s *waitForSuite) TestWaitForApplication(c *tc.C) {
+	s.api.statuses = []*params.FullStatus{
+		fullStatus(map[string]params.UnitStatus{"mysql/0": unit("active")}),
+	}
+
+	_, err := s.run(c, "application", "mysql", "--query", `life=="alive" && status=="active" && units==1`)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *waitForSuite) TestWaitForMachinesIncludesContainers(c *tc.C) {
+	s.api.statuses = []*params.FullStatus{
+		fullStatus(nil),
+	}
+
+	ctx, err := s.run(c, "machine", "--timeout", "1s", "--interval", "1s")
+	c.Assert(err, tc.ErrorMatches,
+		`timed out after 1s waiting for 1 of 2 machines to satisfy life=="alive" && status=="started"`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+machine "0/lxd/0":
+  - status == "started"
+  + status == "pending"
+`[1:])
+}
+
+func (s *waitForSuite) TestWaitForNoEntities(c *tc.C) {
+	s.api.statuses = []*params.FullStatus{
+		fullStatus(nil),
+	}
+
+	ctx, err := s.run(c, "unit", "--timeout", "1s", "--interval", "1s")
+	c.Assert(err, tc.ErrorMatches, `timed out after 1s waiting for 1 of 1 unit to satisfy .*`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+unit "*":
+  - unit exists
+  + not found
+`[1:])
+}
+
+func (s *waitForSuite) TestWaitForStatusError(c *tc.C) {
+	s.api.err = errors.New("boom")
+
+	_, err := s.run(c, "unit")
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *waitForSuite) TestInitErrors(c *tc.C) {
+	tests := []struct {
+		kind string
+		args []string
+		err  string
+	}{
+		{"model", []string{"default"}, `unrecognized args: \["default"\]`},
+		{"unit", []string{"mysql/["}, `unit name "mysql/\[" not valid`},
+		{"unit", []string{"--query", `status=="active"`}, `unit field "status" in query, expected one of .* not valid`},
+		{"unit", []string{"--query", `life=`}, `unexpected "=" at offset 4, expected "=="`},
+		{"unit", []string{"--timeout", "0s"}, `timeout 0s not valid`},
+		{"unit", []string{"--interval", "-1s"}, `interval -1s not valid`},
+	}
+	for i, test := range tests {
+		c.Logf("test %d: %s %v", i, test.kind, test.args)
+		_, err := s.run(c, test.kind, test.args...)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+type fakeStatusAPI struct {
+	statuses []*params.FullStatus
+	err      error
+	calls    int
+}
+
+func (f *fakeStatusAPI) Status(_ context.Context, _ *client.StatusArgs) (*params.FullStatus, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	status := f.statuses[min(f.calls, len(f.statuses)-1)]
+	f.calls++
+	return status, nil
+}
+
+func (f *fakeStatusAPI) Close() error {
+	return nil
+}
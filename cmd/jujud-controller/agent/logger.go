@@ -12,10 +12,14 @@ import (
 
 	"github.com/juju/clock"
 	"github.com/juju/lumberjack/v2"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/agent"
+	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/paths"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/logforward"
+	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/internal/logsink"
 )
 
@@ -48,6 +52,30 @@ func PrimeLogSink(cfg agent.Config) (*logsink.LogSink, error) {
 	return logsink.NewLogSink(logger, batchSize, flushInterval, clock.WallClock), nil
 }
 
+// NewLogForwarder wraps the log sink with a router that forwards log records
+// to the destinations configured for each model. Records are buffered in the
+// agent's data directory while a destination is unavailable.
+func NewLogForwarder(cfg agent.Config, sink corelogger.LogSink, registerer prometheus.Registerer) (*logforward.Router, error) {
+	metrics := logforward.NewMetricsCollector()
+	if err := registerer.Register(metrics); err != nil {
+		return nil, errors.Errorf("registering log forward metrics: %w", err)
+	}
+	router, err := logforward.NewRouter(logforward.RouterConfig{
+		Sink:          sink,
+		BufferDir:     filepath.Join(cfg.DataDir(), "logforward"),
+		MaxBufferSize: logforward.DefaultMaxBufferSize,
+		Metrics:       metrics,
+		NewSender:     logforward.NewSender,
+		Clock:         clock.WallClock,
+		Logger:        internallogger.GetLogger("juju.logforward"),
+	})
+	if err != nil {
+		registerer.Unregister(metrics)
+		return nil, errors.Capture(err)
+	}
+	return router, nil
+}
+
 func isChownPermError(err error) bool {
 	return errors.Is(err, fs.ErrPermission) ||
 		errors.HasType[user.UnknownUserError](err) ||
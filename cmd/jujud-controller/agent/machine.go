@@ -56,6 +56,7 @@ import (
 	"github.com/juju/juju/internal/container/broker"
 	internaldependency "github.com/juju/juju/internal/dependency"
 	"github.com/juju/juju/internal/flightrecorder"
+	"github.com/juju/juju/internal/logforward"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/internal/pki"
 	k8sconstants "github.com/juju/juju/internal/provider/kubernetes/constants"
@@ -65,6 +66,7 @@ import (
 	"github.com/juju/juju/internal/upgrades"
 	"github.com/juju/juju/internal/upgradesteps"
 	internalworker "github.com/juju/juju/internal/worker"
+	"github.com/juju/juju/internal/worker/changestream"
 	"github.com/juju/juju/internal/worker/dbaccessor"
 	"github.com/juju/juju/internal/worker/deployer"
 	workerflightrecorder "github.com/juju/juju/internal/worker/flightrecorder"
@@ -353,6 +355,10 @@ type MachineAgent struct {
 	loopDeviceManager  looputil.LoopDeviceManager
 	prometheusRegistry *prometheus.Registry
 
+	// logForwarder forwards the log records received by this controller
+	// to the destinations configured for each model.
+	logForwarder *logforward.Router
+
 	// To allow for testing in legacy tests (brittle integration tests), we
 	// need to override these.
 	preUpgradeSteps PreUpgradeStepsFunc
@@ -444,9 +450,18 @@ func (a *MachineAgent) Run(ctx *cmd.Context) (err error) {
 	}
 	defer logSink.Close()
 
+	// Wrap the log sink so that records can be forwarded to the
+	// destinations configured for each model.
+	logForwarder, err := NewLogForwarder(a.CurrentConfig(), logSink, a.prometheusRegistry)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer logForwarder.Close()
+	a.logForwarder = logForwarder
+
 	// Add the log sink to the default logger context.
 	if err := loggo.DefaultContext().AddWriter("logsink", corelogger.NewTaggedRedirectWriter(
-		logSink,
+		logForwarder,
 		a.Tag().String(),
 		a.CurrentConfig().Model().Id(),
 	)); err != nil {
@@ -491,7 +506,7 @@ func (a *MachineAgent) Run(ctx *cmd.Context) (err error) {
 	a.upgradeDBLock = internalupgrade.NewLock(agentConfig, jujuversion.Current)
 	a.upgradeStepsLock = internalupgrade.NewLock(agentConfig, jujuversion.Current)
 
-	createEngine := a.makeEngineCreator(agentName, agentConfig.UpgradedToVersion(), logSink)
+	createEngine := a.makeEngineCreator(agentName, agentConfig.UpgradedToVersion(), logForwarder)
 	if err := a.createJujudSymlinks(agentConfig.DataDir()); err != nil {
 		return err
 	}
@@ -520,11 +535,23 @@ func (a *MachineAgent) makeEngineCreator(
 		engineConfigFunc := agentengine.DependencyEngineConfig
 		metrics := agentengine.NewMetrics()
 		controllerMetricsSink := metrics.ForModel(agentConfig.Model())
+
+		// The flight recorder observes the starts of the manifolds in the
+		// engine, so that restart storms can trigger a capture.
+		clock := clock.WallClock
+		flightRecorder := workerflightrecorder.New(
+			flightrecorder.NewRecorder(clock), "", internallogger.GetLogger("juju.flightrecorder"),
+			workerflightrecorder.WithClock(clock),
+			workerflightrecorder.WithTriggeredDir(filepath.Join(agentConfig.DataDir(), "flightrecordings")),
+		)
 		eng, err := dependency.NewEngine(engineConfigFunc(
-			controllerMetricsSink,
+			workerflightrecorder.NewEngineMetrics(controllerMetricsSink, flightRecorder),
 			internaldependency.WrapLogger(internallogger.GetLogger("juju.worker.dependency")),
 		))
 		if err != nil {
+			if err := worker.Stop(flightRecorder); err != nil {
+				logger.Errorf(context.TODO(), "while stopping flight recorder: %v", err)
+			}
 			return nil, err
 		}
 		updateAgentConfLogging := func(loggingConfig string) error {
@@ -534,13 +561,14 @@ func (a *MachineAgent) makeEngineCreator(
 			})
 		}
 
+		// The change stream inspector outlives the change stream worker, so
+		// that the introspection worker can reach whichever one is running.
+		changeStreamInspector := changestream.NewInspector()
+
 		registerIntrospectionHandlers := func(handle func(path string, h http.Handler)) {
 			handle("/metrics/", promhttp.HandlerFor(a.prometheusRegistry, promhttp.HandlerOpts{}))
 		}
 
-		clock := clock.WallClock
-		flightRecorder := workerflightrecorder.New(flightrecorder.NewRecorder(clock), "", internallogger.GetLogger("juju.flightrecorder"))
-
 		manifoldsCfg := machine.ManifoldsConfig{
 			PreviousAgentVersion:              previousAgentVersion,
 			AgentName:                         agentName,
@@ -558,6 +586,7 @@ func (a *MachineAgent) makeEngineCreator(
 			NewDeployContext:                  deployer.NewNestedContext,
 			Clock:                             clock,
 			FlightRecorder:                    flightRecorder,
+			ChangeStreamInspector:             changeStreamInspector,
 			ValidateMigration:                 a.validateMigration,
 			PrometheusRegisterer:              a.prometheusRegistry,
 			UpdateLoggerConfig:                updateAgentConfLogging,
@@ -602,6 +631,7 @@ func (a *MachineAgent) makeEngineCreator(
 			MachineLock:        a.machineLock,
 			PrometheusGatherer: a.prometheusRegistry,
 			FlightRecorder:     flightRecorder,
+			ChangeStream:       changeStreamInspector,
 			WorkerFunc:         introspection.NewWorker,
 			Clock:              clock,
 			Logger:             logger.Child("introspection"),
@@ -722,11 +752,13 @@ func (a *MachineAgent) startModelWorkers(cfg modelworkermanager.NewModelConfig)
 		NewContainerBrokerFunc:        newCAASBroker,
 		NewMigrationMaster:            migrationmaster.NewWorker,
 		OperationPrunerInterval:       24 * time.Hour,
+		SecretAccessLogPrunerInterval: time.Hour,
 		DomainServices:                cfg.DomainServices,
 		ProviderServicesGetter:        cfg.ProviderServicesGetter,
 		LeaseManager:                  cfg.LeaseManager,
 		HTTPClientGetter:              cfg.HTTPClientGetter,
 		APIRemoteRelationClientGetter: cfg.APIRemoteRelationClientGetter,
+		LogForwarder:                  a.logForwarder,
 	}
 	if wrench.IsActive("charmrevision", "shortinterval") {
 		interval := 10 * time.Second
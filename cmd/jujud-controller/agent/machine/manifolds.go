@@ -103,7 +103,9 @@ import (
 	"github.com/juju/juju/internal/worker/objectstore"
 	"github.com/juju/juju/internal/worker/objectstoredrainer"
 	"github.com/juju/juju/internal/worker/objectstorefacade"
+	"github.com/juju/juju/internal/worker/objectstoregc"
 	"github.com/juju/juju/internal/worker/objectstores3caller"
+	"github.com/juju/juju/internal/worker/objectstorescrubber"
 	"github.com/juju/juju/internal/worker/objectstoreservices"
 	"github.com/juju/juju/internal/worker/providerservices"
 	"github.com/juju/juju/internal/worker/providertracker"
@@ -199,6 +201,10 @@ type ManifoldsConfig struct {
 	// FlightRecorder is used to record significant events.
 	FlightRecorder flightrecorder.FlightRecorderWorker
 
+	// ChangeStreamInspector gives the introspection worker access to the
+	// change events recorded by the change stream.
+	ChangeStreamInspector *changestream.Inspector
+
 	// ValidateMigration is called by the migrationminion during the
 	// migration process to check that the agent will be ok when
 	// connected to the new target controller.
@@ -617,6 +623,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			LogSinkName:                 logSinkName,
 			Logger:                      internallogger.GetLogger("juju.worker.services"),
 			Clock:                       config.Clock,
+			DataDir:                     agentConfig.DataDir(),
 			LogDir:                      agentConfig.LogDir(),
 			NewWorker:                   workerdomainservices.NewWorker,
 			NewDomainServicesGetter:     workerdomainservices.NewDomainServicesGetter,
@@ -654,6 +661,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			PrometheusRegisterer: config.PrometheusRegisterer,
 			NewWatchableDB:       changestream.NewWatchableDB,
 			NewMetricsCollector:  changestream.NewMetricsCollector,
+			Inspector:            config.ChangeStreamInspector,
 		}),
 
 		changeStreamPrunerName: ifPrimaryController(changestreampruner.Manifold(changestreampruner.ManifoldConfig{
@@ -744,6 +752,19 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewServerWrapperWorker:     sshserver.NewServerWrapperWorker,
 			NewServerWorker:            sshserver.NewServerWorker,
 			GetControllerConfigService: sshserver.GetControllerConfigService,
+			GetRecordingService:        sshserver.GetRecordingService,
+			Clock:                      config.Clock,
+		})),
+
+		// The flight recorder triggers worker sets the conditions which
+		// cause the flight recorder to capture automatically, from the
+		// controller config.
+		flightRecorderTriggersName: ifController(workerflightrecorder.TriggersManifold(workerflightrecorder.TriggersManifoldConfig{
+			FlightRecorderName:         flightRecorderName,
+			DomainServicesName:         domainServicesName,
+			GetControllerConfigService: workerflightrecorder.GetControllerConfigService,
+			NewWorker:                  workerflightrecorder.NewTriggersWorker,
+			Logger:                     internallogger.GetLogger("juju.worker.flightrecorder"),
 		})),
 
 		// The objectstore draining workers collaborate to run draining of blobs
@@ -787,6 +808,33 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			IsBootstrapController:      internalbootstrap.IsBootstrapController,
 		})),
 
+		// The object store garbage collector shares file object store
+		// content across namespaces and removes blobs that are no longer
+		// referenced by any namespace.
+		objectStoreGCName: ifDatabaseUpgradeComplete(objectstoregc.Manifold(objectstoregc.ManifoldConfig{
+			AgentName:               agentName,
+			ObjectStoreServicesName: objectStoreServicesName,
+			Clock:                   config.Clock,
+			Logger:                  internallogger.GetLogger("juju.worker.objectstoregc"),
+			GetNamespaceMetadata:    objectstoregc.GetNamespaceMetadata,
+			NewWorker:               objectstoregc.NewWorker,
+			Interval:                time.Hour,
+			GracePeriod:             time.Hour,
+		})),
+
+		// The object store scrubber periodically verifies every object held
+		// by the object store against its metadata. It goes through the
+		// object store facade, so that it never runs whilst draining.
+		objectStoreScrubberName: ifDatabaseUpgradeComplete(objectstorescrubber.Manifold(objectstorescrubber.ManifoldConfig{
+			ObjectStoreName:         objectStoreFacadeName,
+			ObjectStoreServicesName: objectStoreServicesName,
+			Clock:                   config.Clock,
+			Logger:                  internallogger.GetLogger("juju.worker.objectstorescrubber"),
+			GetControllerService:    objectstorescrubber.GetControllerService,
+			NewWorker:               objectstorescrubber.NewWorker,
+			Interval:                24 * time.Hour,
+		})),
+
 		// The objectstore facade is a thin wrapper around the objectstore
 		// worker. It guards against any objectstore operations while the
 		// draining is in progress.
@@ -1030,6 +1078,7 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewDBWorker:               config.NewDBWorkerFunc,
 			NewMetricsCollector:       dbaccessor.NewMetricsCollector,
 			NewNodeManager:            dbaccessor.IAASNodeManager,
+			FlightRecorder:            config.FlightRecorder,
 		})),
 
 		// The diskmanager worker periodically lists block devices on the
@@ -1266,6 +1315,7 @@ func CAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewDBWorker:               config.NewDBWorkerFunc,
 			NewMetricsCollector:       dbaccessor.NewMetricsCollector,
 			NewNodeManager:            dbaccessor.CAASNodeManager,
+			FlightRecorder:            config.FlightRecorder,
 		})),
 	})
 }
@@ -1419,6 +1469,8 @@ const (
 	objectStoreFortressName       = "object-store-fortress"
 	objectStoreFacadeName         = "object-store-facade"
 	objectStoreDrainerName        = "object-store-drainer"
+	objectStoreGCName             = "object-store-gc"
+	objectStoreScrubberName       = "object-store-scrubber"
 	providerDomainServicesName    = "provider-services"
 	providerTrackerName           = "provider-tracker"
 	proxyConfigUpdater            = "proxy-config-updater"
@@ -1426,6 +1478,7 @@ const (
 	rebootName                    = "reboot-executor"
 	secretBackendRotateName       = "secret-backend-rotate"
 	sshServerName                 = "ssh-server"
+	flightRecorderTriggersName    = "flight-recorder-triggers"
 	machineConverterName          = "machine-converter"
 	storageProvisionerName        = "storage-provisioner"
 	storageRegistryName           = "storage-registry"
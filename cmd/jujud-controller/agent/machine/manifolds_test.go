@@ -93,6 +93,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"external-controller-updater",
 			"file-notify-watcher",
 			"flight-recorder",
+			"flight-recorder-triggers",
 			"host-key-reporter",
 			"http-client",
 			"http-server-args",
@@ -118,6 +119,8 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"object-store-fortress",
 			"object-store-facade",
 			"object-store-drainer",
+			"object-store-gc",
+			"object-store-scrubber",
 			"object-store-s3-caller",
 			"object-store-services",
 			"object-store",
@@ -184,6 +187,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"external-controller-updater",
 			"file-notify-watcher",
 			"flight-recorder",
+			"flight-recorder-triggers",
 			"http-client",
 			"http-server-args",
 			"http-server",
@@ -203,6 +207,8 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"object-store-fortress",
 			"object-store-facade",
 			"object-store-drainer",
+			"object-store-gc",
+			"object-store-scrubber",
 			"object-store-s3-caller",
 			"object-store-services",
 			"object-store",
@@ -280,6 +286,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"domain-services",
 		"file-notify-watcher",
 		"flight-recorder",
+		"flight-recorder-triggers",
 		"global-clock-updater",
 		"http-client",
 		"http-server-args",
@@ -300,6 +307,8 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"object-store-fortress",
 		"object-store-facade",
 		"object-store-drainer",
+		"object-store-gc",
+		"object-store-scrubber",
 		"object-store-s3-caller",
 		"object-store-services",
 		"object-store",
@@ -352,6 +361,7 @@ func (*ManifoldsSuite) TestSingularGuardsUsed(c *tc.C) {
 		"controller-agent-config",
 		"db-accessor",
 		"file-notify-watcher",
+		"flight-recorder-triggers",
 		"is-primary-controller-flag",
 		"jwt-parser",
 		"query-logger",
@@ -980,6 +990,35 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"flight-recorder": {},
 
+	"flight-recorder-triggers": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"flight-recorder",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"host-key-reporter": {
 		"agent",
 		"api-caller",
@@ -1358,6 +1397,43 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-database-gate",
 	},
 
+	"object-store-gc": {
+		"agent",
+		"change-stream",
+		"controller-agent-config",
+		"db-accessor",
+		"file-notify-watcher",
+		"is-controller-flag",
+		"object-store-services",
+		"query-logger",
+		"state-config-watcher",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
+	"object-store-scrubber": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"object-store",
+		"query-logger",
+		"state-config-watcher",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"object-store-services": {
 		"agent",
 		"change-stream",
@@ -2073,6 +2149,35 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"flight-recorder": {},
 
+	"flight-recorder-triggers": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"flight-recorder",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"trace": {
 		"agent",
 	},
@@ -2393,6 +2498,43 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"upgrade-database-gate",
 	},
 
+	"object-store-gc": {
+		"agent",
+		"change-stream",
+		"controller-agent-config",
+		"db-accessor",
+		"file-notify-watcher",
+		"is-controller-flag",
+		"object-store-services",
+		"query-logger",
+		"state-config-watcher",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
+	"object-store-scrubber": {
+		"agent",
+		"api-remote-caller",
+		"change-stream",
+		"clock",
+		"controller-agent-config",
+		"db-accessor",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"lease-manager",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"object-store",
+		"query-logger",
+		"state-config-watcher",
+		"trace",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+	},
+
 	"object-store-services": {
 		"agent",
 		"change-stream",
@@ -43,6 +43,7 @@ import (
 	"github.com/juju/juju/internal/worker/firewaller"
 	"github.com/juju/juju/internal/worker/fortress"
 	"github.com/juju/juju/internal/worker/instancepoller"
+	"github.com/juju/juju/internal/worker/logforwarder"
 	"github.com/juju/juju/internal/worker/logger"
 	"github.com/juju/juju/internal/worker/migrationflag"
 	"github.com/juju/juju/internal/worker/migrationmaster"
@@ -55,6 +56,7 @@ import (
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/offererrelations"
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/offererunitrelations"
 	"github.com/juju/juju/internal/worker/removal"
+	"github.com/juju/juju/internal/worker/secretaccesslogpruner"
 	"github.com/juju/juju/internal/worker/secretsdrainworker"
 	"github.com/juju/juju/internal/worker/secretspruner"
 	"github.com/juju/juju/internal/worker/singular"
@@ -113,6 +115,10 @@ type ManifoldsConfig struct {
 	// OperationPrunerInterval determines how often the operations are pruned
 	OperationPrunerInterval time.Duration
 
+	// SecretAccessLogPrunerInterval determines how often the secret access
+	// log is pruned.
+	SecretAccessLogPrunerInterval time.Duration
+
 	// ProviderServicesGetter is used to access the provider service.
 	ProviderServicesGetter modelworkermanager.ProviderServicesGetter
 
@@ -128,6 +134,10 @@ type ManifoldsConfig struct {
 	// APIRemoteRelationClientGetter is used to get a remote relation client
 	// for a given namespace.
 	APIRemoteRelationClientGetter apiremoterelationcaller.APIRemoteCallerGetter
+
+	// LogForwarder forwards the log records received by this controller
+	// to the destinations configured for the model.
+	LogForwarder logforwarder.Router
 }
 
 // commonManifolds returns a set of interdependent dependency manifolds that will
@@ -219,6 +229,18 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Logger:        config.LoggingContext.GetLogger("juju.worker.logger"),
 		})),
 
+		// The log forwarder runs on every controller, not just the one
+		// responsible for the model, as each controller forwards the log
+		// records it receives.
+		logForwarderName: logforwarder.Manifold(logforwarder.ManifoldConfig{
+			DomainServicesName:    domainServicesName,
+			ModelUUID:             modelUUID,
+			Router:                config.LogForwarder,
+			GetModelConfigService: logforwarder.GetModelConfigService,
+			NewWorker:             logforwarder.NewWorker,
+			Logger:                config.LoggingContext.GetLogger("juju.worker.logforwarder"),
+		}),
+
 		// All other manifolds should depend on at least one of these, which
 		// handle all the tasks that are safe and sane
 		// to run in *all* controller machines.
@@ -395,6 +417,14 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Clock:              config.Clock,
 		}))),
 
+		// The secretAccessLogPruner is the worker that prunes the secret
+		// access log based on the age and number of its entries periodically.
+		secretAccessLogPrunerName: ifResponsible(ifNotMigrating(secretaccesslogpruner.Manifold(secretaccesslogpruner.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			PruneInterval:      config.SecretAccessLogPrunerInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretaccesslogpruner"),
+		}))),
+
 		changeStreamPrunerName: ifResponsible(ifNotMigrating(changestreampruner.Manifold(changestreampruner.ManifoldConfig{
 			DomainServiceName:      domainServicesName,
 			Clock:                  config.Clock,
@@ -623,6 +653,7 @@ const (
 	operationPrunerName          = "operation-pruner"
 	leaseManagerName             = "lease-manager"
 	loggingConfigUpdaterName     = "logging-config-updater"
+	logForwarderName             = "log-forwarder"
 	machineUndertakerName        = "machine-undertaker"
 	providerServiceFactoriesName = "provider-service-factories"
 	providerTrackerName          = "provider-tracker"
@@ -638,8 +669,9 @@ const (
 	caasmodelconfigmanagerName     = "caas-model-config-manager"
 	caasApplicationProvisionerName = "caas-application-provisioner"
 
-	secretsPrunerName      = "secrets-pruner"
-	userSecretsDrainWorker = "user-secrets-drain-worker"
+	secretAccessLogPrunerName = "secret-access-log-pruner"
+	secretsPrunerName         = "secrets-pruner"
+	userSecretsDrainWorker    = "user-secrets-drain-worker"
 
 	validCredentialFlagName = "valid-credential-flag"
 )
@@ -53,6 +53,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"instance-poller",
 		"is-responsible-flag",
 		"lease-manager",
+		"log-forwarder",
 		"logging-config-updater",
 		"log-sink",
 		"migration-fortress",
@@ -64,6 +65,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relation-consumer",
 		"removal",
+		"secret-access-log-pruner",
 		"secrets-pruner",
 		"storage-provisioner",
 		"user-secrets-drain-worker",
@@ -99,6 +101,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"http-client",
 		"is-responsible-flag",
 		"lease-manager",
+		"log-forwarder",
 		"log-sink",
 		"logging-config-updater",
 		"migration-fortress",
@@ -110,6 +113,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relation-consumer",
 		"removal",
+		"secret-access-log-pruner",
 		"secrets-pruner",
 		"storage-provisioner",
 		"user-secrets-drain-worker",
@@ -134,6 +138,9 @@ func (s *ManifoldsSuite) TestFlagDependencies(c *tc.C) {
 		"log-sink",
 		"http-client",
 		"valid-credential-flag",
+		// The log forwarder runs on all controller agents, as each
+		// forwards the log records it receives.
+		"log-forwarder",
 	)
 	manifolds := model.IAASManifolds(model.ManifoldsConfig{
 		Agent:          &mockAgent{},
@@ -333,6 +340,10 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"log-forwarder": {
+		"domain-services",
+	},
+
 	"log-sink": {},
 
 	"migration-fortress": {
@@ -377,6 +388,17 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"secret-access-log-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"provider-service-factories": {},
 
 	"remote-relation-consumer": {
@@ -566,6 +588,10 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"log-forwarder": {
+		"domain-services",
+	},
+
 	"log-sink": {},
 
 	"migration-fortress": {
@@ -610,6 +636,17 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"secret-access-log-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"provider-service-factories": {},
 
 	"remote-relation-consumer": {
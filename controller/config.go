@@ -19,6 +19,8 @@ import (
 	"github.com/juju/utils/v4"
 	"gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/core/admission"
+	"github.com/juju/juju/core/flightrecorder"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/configschema"
@@ -52,6 +54,17 @@ const (
 	// the token bucket, in milliseconds (ms).
 	AgentRateLimitRate = "agent-ratelimit-rate"
 
+	// APIRateLimitMax is the maximum size of the token buckets used to
+	// ratelimit the API calls made by each user, per facade method.
+	// Use a value of 0 to disable the limit. The same limit applies to
+	// every user, facade and method; there are no per-user or per-method
+	// overrides.
+	APIRateLimitMax = "api-ratelimit-max"
+
+	// APIRateLimitRate is the interval at which a new token is added to
+	// each of the API call token buckets.
+	APIRateLimitRate = "api-ratelimit-rate"
+
 	// AuditingEnabled determines whether the controller will record
 	// auditing information.
 	AuditingEnabled = "auditing-enabled"
@@ -291,6 +304,19 @@ const (
 	// connections to the controller.
 	SSHMaxConcurrentConnections = "ssh-max-concurrent-connections"
 
+	// SSHSessionRecording enables the recording of SSH sessions proxied by
+	// the embedded SSH server.
+	SSHSessionRecording = "ssh-session-recording"
+
+	// FlightRecorderTriggers holds the conditions which cause the controller
+	// agents to capture a flight recording automatically.
+	FlightRecorderTriggers = "flight-recorder-triggers"
+
+	// AdmissionPolicy holds the rules, in YAML, which can deny deployments
+	// and changes to the config, constraints and exposure of applications
+	// and models, beyond what model permissions allow.
+	AdmissionPolicy = "admission-policy"
+
 	// IdleConnectionTimeout is the time between the controller resetting all idle connections.
 	IdleConnectionTimeout = "idle-connection-timeout"
 
@@ -313,6 +339,18 @@ const (
 	// DefaultSSHServerPort is the default port used for the embedded SSH server.
 	DefaultSSHServerPort = 17022
 
+	// DefaultSSHSessionRecording is the default value for whether SSH
+	// sessions proxied by the controller are recorded.
+	DefaultSSHSessionRecording = false
+
+	// DefaultFlightRecorderTriggers is the default value for the flight
+	// recorder triggers, which is to not capture automatically.
+	DefaultFlightRecorderTriggers = ""
+
+	// DefaultAdmissionPolicy is the default admission policy, which admits
+	// all changes.
+	DefaultAdmissionPolicy = ""
+
 	// DefaultApplicationResourceDownloadLimit allows unlimited
 	// resource download requests initiated by a unit agent per application.
 	DefaultApplicationResourceDownloadLimit = 0
@@ -329,6 +367,13 @@ const (
 	// second. A token is added to the ratelimit token bucket every 250ms.
 	DefaultAgentRateLimitRate = 250 * time.Millisecond
 
+	// DefaultAPIRateLimitMax disables the ratelimiting of user API calls.
+	DefaultAPIRateLimitMax = 0
+
+	// DefaultAPIRateLimitRate allows a user to make ten calls to each
+	// facade method every second, once their bucket is empty.
+	DefaultAPIRateLimitRate = 100 * time.Millisecond
+
 	// DefaultAuditingEnabled contains the default value for the
 	// AuditingEnabled config value.
 	DefaultAuditingEnabled = true
@@ -484,6 +529,8 @@ var (
 		AllowModelAccessKey,
 		AgentRateLimitMax,
 		AgentRateLimitRate,
+		APIRateLimitMax,
+		APIRateLimitRate,
 		APIPort,
 		IdleConnectionTimeout,
 		HTTPServerReadTimeout,
@@ -540,6 +587,9 @@ var (
 		JujudControllerSnapSource,
 		SSHMaxConcurrentConnections,
 		SSHServerPort,
+		SSHSessionRecording,
+		FlightRecorderTriggers,
+		AdmissionPolicy,
 	}
 
 	// For backwards compatibility, we must include "anything" and
@@ -560,6 +610,8 @@ var (
 		AgentLogfileMaxSize,
 		AgentRateLimitMax,
 		AgentRateLimitRate,
+		APIRateLimitMax,
+		APIRateLimitRate,
 		IdleConnectionTimeout,
 		HTTPServerReadTimeout,
 		HTTPServerWriteTimeout,
@@ -599,6 +651,9 @@ var (
 		ObjectStoreS3StaticSecret,
 		ObjectStoreS3StaticSession,
 		SSHMaxConcurrentConnections,
+		SSHSessionRecording,
+		FlightRecorderTriggers,
+		AdmissionPolicy,
 	)
 
 	methodNameRE = regexp.MustCompile(`[[:alpha:]][[:alnum:]]*\.[[:alpha:]][[:alnum:]]*`)
@@ -798,6 +853,27 @@ func (c Config) AgentRateLimitRate() time.Duration {
 	return c.durationOrDefault(AgentRateLimitRate, DefaultAgentRateLimitRate)
 }
 
+// APIRateLimitMax is the size of the token buckets that are used to rate
+// limit the API calls made by each user, per facade method. A value of 0
+// means that user API calls are not rate limited.
+func (c Config) APIRateLimitMax() int {
+	switch v := c[APIRateLimitMax].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		// nil type shows up here
+	}
+	return DefaultAPIRateLimitMax
+}
+
+// APIRateLimitRate is the time taken to add a token into each of the token
+// buckets that are used to rate limit user API calls.
+func (c Config) APIRateLimitRate() time.Duration {
+	return c.durationOrDefault(APIRateLimitRate, DefaultAPIRateLimitRate)
+}
+
 // AuditingEnabled returns whether or not auditing has been enabled
 // for the environment. The default is false.
 func (c Config) AuditingEnabled() bool {
@@ -1133,6 +1209,26 @@ func (c Config) SSHMaxConcurrentConnections() int {
 	return c.intOrDefault(SSHMaxConcurrentConnections, DefaultSSHMaxConcurrentConnections)
 }
 
+// SSHSessionRecording returns true if SSH sessions proxied by the
+// controller are recorded.
+func (c Config) SSHSessionRecording() bool {
+	return c.boolOrDefault(SSHSessionRecording, DefaultSSHSessionRecording)
+}
+
+// FlightRecorderTriggers returns the conditions which cause the controller
+// agents to capture a flight recording automatically.
+func (c Config) FlightRecorderTriggers() flightrecorder.Triggers {
+	triggers, _ := flightrecorder.ParseTriggers(c.asString(FlightRecorderTriggers))
+	return triggers
+}
+
+// AdmissionPolicy returns the rules which can deny changes to models. An
+// invalid policy is never stored, so parse errors are ignored.
+func (c Config) AdmissionPolicy() admission.Policy {
+	policy, _ := admission.Parse(c.asString(AdmissionPolicy))
+	return policy
+}
+
 // Validate ensures that config is a valid configuration.
 func Validate(c Config) error {
 	if v, ok := c[IdentityPublicKey].(string); ok {
@@ -1210,6 +1306,26 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[APIRateLimitMax].(int); ok {
+		if v < 0 {
+			return errors.NotValidf("negative %s (%d)", APIRateLimitMax, v)
+		}
+	}
+
+	if v, err := parseDuration(c, APIRateLimitRate); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "parsing %s in configuration", APIRateLimitRate)
+	} else if err == nil {
+		if v == 0 {
+			return errors.Errorf("%s cannot be zero", APIRateLimitRate)
+		}
+		if v < 0 {
+			return errors.Errorf("%s cannot be negative", APIRateLimitRate)
+		}
+		if v > time.Minute {
+			return errors.Errorf("%s must be between 0..1m", APIRateLimitRate)
+		}
+	}
+
 	if v, err := parseDuration(c, MaxDebugLogDuration); err != nil && !errors.Is(err, errors.NotFound) {
 		return errors.Annotatef(err, "parsing %s in configuration", MaxDebugLogDuration)
 	} else if err == nil {
@@ -1386,6 +1502,18 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[FlightRecorderTriggers].(string); ok {
+		if _, err := flightrecorder.ParseTriggers(v); err != nil {
+			return errors.NotValidf("%s value %q: %v", FlightRecorderTriggers, v, err)
+		}
+	}
+
+	if v, ok := c[AdmissionPolicy].(string); ok {
+		if _, err := admission.Parse(v); err != nil {
+			return errors.NewNotValid(err, fmt.Sprintf("invalid %s", AdmissionPolicy))
+		}
+	}
+
 	if v, ok := c[JujudControllerSnapSource].(string); ok {
 		switch v {
 		case "legacy": // TODO(jujud-controller-snap): remove once jujud-controller snap is fully implemented.
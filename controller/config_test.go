@@ -16,6 +16,8 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/admission"
+	"github.com/juju/juju/core/flightrecorder"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/docker"
@@ -270,6 +272,24 @@ var newConfigTests = []struct {
 		controller.AgentRateLimitRate: "4h",
 	},
 	expectError: `agent-ratelimit-rate must be between 0..1m`,
+}, {
+	about: "api-ratelimit-max negative",
+	config: controller.Config{
+		controller.APIRateLimitMax: "-5",
+	},
+	expectError: `negative api-ratelimit-max \(-5\) not valid`,
+}, {
+	about: "api-ratelimit-rate zero",
+	config: controller.Config{
+		controller.APIRateLimitRate: "0s",
+	},
+	expectError: `api-ratelimit-rate cannot be zero`,
+}, {
+	about: "api-ratelimit-rate too large",
+	config: controller.Config{
+		controller.APIRateLimitRate: "4h",
+	},
+	expectError: `api-ratelimit-rate must be between 0..1m`,
 }, {
 	about: "max-charm-state-size non-int",
 	config: controller.Config{
@@ -779,6 +799,8 @@ func (s *ConfigSuite) TestDefaults(c *tc.C) {
 	c.Assert(cfg.QueryTracingThreshold(), tc.Equals, controller.DefaultQueryTracingThreshold)
 	c.Assert(cfg.SSHServerPort(), tc.Equals, controller.DefaultSSHServerPort)
 	c.Assert(cfg.SSHMaxConcurrentConnections(), tc.Equals, controller.DefaultSSHMaxConcurrentConnections)
+	c.Assert(cfg.SSHSessionRecording(), tc.Equals, controller.DefaultSSHSessionRecording)
+	c.Assert(cfg.FlightRecorderTriggers().Enabled(), tc.IsFalse)
 }
 
 func (s *ConfigSuite) TestAgentLogfile(c *tc.C) {
@@ -857,6 +879,27 @@ func (s *ConfigSuite) TestAgentRateLimitRate(c *tc.C) {
 	c.Assert(cfg.AgentRateLimitRate(), tc.Equals, 500*time.Millisecond)
 }
 
+func (s *ConfigSuite) TestAPIRateLimit(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.APIRateLimitMax(), tc.Equals, controller.DefaultAPIRateLimitMax)
+	c.Assert(cfg.APIRateLimitRate(), tc.Equals, controller.DefaultAPIRateLimitRate)
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			"api-ratelimit-max":  "20",
+			"api-ratelimit-rate": "500ms",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.APIRateLimitMax(), tc.Equals, 20)
+	c.Assert(cfg.APIRateLimitRate(), tc.Equals, 500*time.Millisecond)
+}
+
 func (s *ConfigSuite) TestMigrationMinionWaitMax(c *tc.C) {
 	cfg, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
@@ -1002,6 +1045,85 @@ func (s *ConfigSuite) TestSSHServerConcurrentConnections(c *tc.C) {
 	c.Assert(cfg.SSHMaxConcurrentConnections(), tc.Equals, 10)
 }
 
+func (s *ConfigSuite) TestSSHSessionRecording(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.SSHSessionRecording: true,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.SSHSessionRecording(), tc.IsTrue)
+}
+
+func (s *ConfigSuite) TestFlightRecorderTriggers(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.FlightRecorderTriggers: "manifold-restarts=5/1m,txn-latency=2s",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.FlightRecorderTriggers(), tc.DeepEquals, flightrecorder.Triggers{
+		ManifoldRestarts: 5,
+		RestartWindow:    time.Minute,
+		TxnLatency:       2 * time.Second,
+	})
+}
+
+func (s *ConfigSuite) TestFlightRecorderTriggersInvalid(c *tc.C) {
+	_, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.FlightRecorderTriggers: "disk-full=1s",
+		},
+	)
+	c.Assert(err, tc.ErrorMatches, `flight-recorder-triggers value "disk-full=1s": unknown trigger "disk-full" not valid`)
+}
+
+func (s *ConfigSuite) TestAdmissionPolicy(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.AdmissionPolicy: `
+- name: no-expose-on-production
+  operations: [expose]
+  match:
+    model: ["prod-*"]
+  reason: applications cannot be exposed on production models
+`,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.AdmissionPolicy(), tc.DeepEquals, admission.Policy{{
+		Name:       "no-expose-on-production",
+		Operations: []admission.Operation{admission.Expose},
+		Match:      map[admission.Attribute][]string{admission.ModelAttribute: {"prod-*"}},
+		Reason:     "applications cannot be exposed on production models",
+	}})
+}
+
+func (s *ConfigSuite) TestAdmissionPolicyDefault(c *tc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]any{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.AdmissionPolicy(), tc.HasLen, 0)
+}
+
+func (s *ConfigSuite) TestAdmissionPolicyInvalid(c *tc.C) {
+	_, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			controller.AdmissionPolicy: `[{name: foo, operations: [destroy]}]`,
+		},
+	)
+	c.Assert(err, tc.ErrorMatches, `invalid admission-policy: validating admission policy: rule "foo": operation "destroy" not valid`)
+}
+
 func (s *ConfigSuite) TestObjectStoreType(c *tc.C) {
 	backendType := "file"
 	cfg, err := controller.NewConfig(
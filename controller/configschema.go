@@ -14,6 +14,8 @@ import (
 var configChecker = schema.FieldMap(schema.Fields{
 	AgentRateLimitMax:                  schema.ForceInt(),
 	AgentRateLimitRate:                 schema.TimeDurationString(),
+	APIRateLimitMax:                    schema.ForceInt(),
+	APIRateLimitRate:                   schema.TimeDurationString(),
 	AuditingEnabled:                    schema.Bool(),
 	AuditLogCaptureArgs:                schema.Bool(),
 	AuditLogMaxSize:                    schema.String(),
@@ -69,9 +71,14 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          schema.String(),
 	SSHServerPort:                      schema.ForceInt(),
 	SSHMaxConcurrentConnections:        schema.ForceInt(),
+	SSHSessionRecording:                schema.Bool(),
+	FlightRecorderTriggers:             schema.String(),
+	AdmissionPolicy:                    schema.String(),
 }, schema.Defaults{
 	AgentRateLimitMax:                  schema.Omit,
 	AgentRateLimitRate:                 schema.Omit,
+	APIRateLimitMax:                    schema.Omit,
+	APIRateLimitRate:                   schema.Omit,
 	APIPort:                            DefaultAPIPort,
 	ControllerName:                     schema.Omit,
 	AuditingEnabled:                    DefaultAuditingEnabled,
@@ -127,6 +134,9 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          DefaultJujudControllerSnapSource,
 	SSHServerPort:                      DefaultSSHServerPort,
 	SSHMaxConcurrentConnections:        DefaultSSHMaxConcurrentConnections,
+	SSHSessionRecording:                DefaultSSHSessionRecording,
+	FlightRecorderTriggers:             DefaultFlightRecorderTriggers,
+	AdmissionPolicy:                    DefaultAdmissionPolicy,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -148,6 +158,14 @@ var ConfigSchema = configschema.Fields{
 		Description: "The time taken to add a new token to the ratelimit bucket",
 		Type:        configschema.Tstring,
 	},
+	APIRateLimitMax: {
+		Description: "The maximum size of the token buckets used to ratelimit the API calls of each user, per facade method, applied alike to every user and method; 0 disables the limit",
+		Type:        configschema.Tint,
+	},
+	APIRateLimitRate: {
+		Description: "The time taken to add a new token to each API call ratelimit bucket",
+		Type:        configschema.Tstring,
+	},
 	AuditingEnabled: {
 		Description: "Determines if the controller records auditing information",
 		Type:        configschema.Tbool,
@@ -377,4 +395,17 @@ same database.`[1:],
 		Type:        configschema.Tint,
 		Description: `The maximum number of concurrent ssh connections to the controller`,
 	},
+	SSHSessionRecording: {
+		Type:        configschema.Tbool,
+		Description: `Whether ssh sessions proxied by the controller are recorded`,
+	},
+	FlightRecorderTriggers: {
+		Type: configschema.Tstring,
+		Description: `Conditions which cause the controller agents to capture a flight recording automatically,
+e.g. "manifold-restarts=5/1m,txn-latency=2s,api-latency=10s"`,
+	},
+	AdmissionPolicy: {
+		Type:        configschema.Tstring,
+		Description: `Rules, in YAML, which can deny deployments and changes to the config, constraints and exposure of applications and models`,
+	},
 }
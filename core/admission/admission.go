@@ -0,0 +1,317 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package admission provides the admission policy, a set of rules
+// configured on the controller which can deny changes to models, such as
+// deployments and config changes, beyond what model permissions allow.
+package admission
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/core/constraints"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// PolicyDenied is returned when a request is denied by a rule of the
+	// admission policy.
+	PolicyDenied = errors.ConstError("denied by admission policy")
+)
+
+// Operation identifies a kind of change to a model which is subject to the
+// admission policy.
+type Operation string
+
+const (
+	// Deploy is the deployment of an application.
+	Deploy Operation = "deploy"
+
+	// SetConfig is a change to the config of an application.
+	SetConfig Operation = "set-config"
+
+	// SetModelConfig is a change to the config of a model.
+	SetModelConfig Operation = "set-model-config"
+
+	// SetConstraints is a change to the constraints of an application or
+	// a model.
+	SetConstraints Operation = "set-constraints"
+
+	// Expose is the exposing of an application.
+	Expose Operation = "expose"
+
+	// AddMachine is the addition of a machine to a model.
+	AddMachine Operation = "add-machine"
+)
+
+// Validate returns an error if the operation is not known.
+func (o Operation) Validate() error {
+	switch o {
+	case Deploy, SetConfig, SetModelConfig, SetConstraints, Expose, AddMachine:
+		return nil
+	}
+	return errors.Errorf("operation %q %w", o, coreerrors.NotValid)
+}
+
+// Attribute is the name of a property of a request which a rule can match.
+type Attribute string
+
+const (
+	// UserAttribute is the name of the user making the request.
+	UserAttribute Attribute = "user"
+
+	// ModelAttribute is the name of the model being changed.
+	ModelAttribute Attribute = "model"
+
+	// ApplicationAttribute is the name of the application being changed.
+	ApplicationAttribute Attribute = "application"
+
+	// CharmAttribute is the name of the charm being deployed.
+	CharmAttribute Attribute = "charm"
+
+	// CharmSourceAttribute is the source of the charm being deployed, e.g.
+	// "charm-hub" or "local".
+	CharmSourceAttribute Attribute = "charm-source"
+
+	// CharmChannelAttribute is the channel of the charm being deployed,
+	// e.g. "latest/stable". It is only known when the controller resolves
+	// the charm in its repository.
+	CharmChannelAttribute Attribute = "charm-channel"
+
+	// PublisherAttribute is the username of the publisher of the charm
+	// being deployed in its repository. It is only known when the
+	// controller resolves the charm in its repository, so local charms and
+	// charms added by the client have no publisher.
+	PublisherAttribute Attribute = "publisher"
+
+	// ConfigKeyAttribute holds the config keys being set.
+	ConfigKeyAttribute Attribute = "config-key"
+
+	// ConstraintAttribute holds the constraints being set, each in the
+	// form "key=value".
+	ConstraintAttribute Attribute = "constraint"
+)
+
+// Validate returns an error if the attribute is not known.
+func (a Attribute) Validate() error {
+	switch a {
+	case UserAttribute, ModelAttribute, ApplicationAttribute, CharmAttribute,
+		CharmSourceAttribute, CharmChannelAttribute, PublisherAttribute,
+		ConfigKeyAttribute, ConstraintAttribute:
+		return nil
+	}
+	return errors.Errorf("attribute %q %w", a, coreerrors.NotValid)
+}
+
+// Request describes a change to a model to be checked against the
+// admission policy. Fields which don't apply to the operation are left
+// empty.
+type Request struct {
+	Operation    Operation
+	User         string
+	Model        string
+	Application  string
+	Charm        string
+	CharmSource  string
+	CharmChannel string
+	Publisher    string
+	ConfigKeys   []string
+	Constraints  []string
+}
+
+// ConstraintValues returns the constraints as they are matched by the
+// constraint attribute, each in the form "key=value", with sizes in
+// megabytes, e.g. "mem=2048M".
+func ConstraintValues(cons constraints.Value) []string {
+	return strings.Fields(cons.String())
+}
+
+// values returns the values of the attribute in the request. Unset
+// attributes have no values.
+func (r Request) values(attr Attribute) []string {
+	var value string
+	switch attr {
+	case UserAttribute:
+		value = r.User
+	case ModelAttribute:
+		value = r.Model
+	case ApplicationAttribute:
+		value = r.Application
+	case CharmAttribute:
+		value = r.Charm
+	case CharmSourceAttribute:
+		value = r.CharmSource
+	case CharmChannelAttribute:
+		value = r.CharmChannel
+	case PublisherAttribute:
+		value = r.Publisher
+	case ConfigKeyAttribute:
+		return r.ConfigKeys
+	case ConstraintAttribute:
+		return r.Constraints
+	}
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+// Rule is a single rule of an admission policy.
+//
+// A rule applies to a request if the request's operation is one of the
+// rule's operations (or the rule has no operations), and for every
+// attribute in Match, at least one of the request's values for that
+// attribute matches one of the patterns.
+//
+// A request to which the rule applies is denied if the rule has no Require
+// attributes, or if for any attribute in Require, the request has no values
+// for that attribute or any of its values don't match one of the patterns.
+// Unset attributes never match, and never satisfy a requirement, so a
+// request which can't be fully described fails closed.
+//
+// Patterns use shell glob syntax, as in [path.Match].
+type Rule struct {
+	Name       string                 `yaml:"name"`
+	Operations []Operation            `yaml:"operations,omitempty"`
+	Match      map[Attribute][]string `yaml:"match,omitempty"`
+	Require    map[Attribute][]string `yaml:"require,omitempty"`
+	Reason     string                 `yaml:"reason,omitempty"`
+}
+
+// Validate returns an error if the rule is not valid.
+func (r Rule) Validate() error {
+	if r.Name == "" {
+		return errors.Errorf("rule name %w", coreerrors.NotValid)
+	}
+	for _, op := range r.Operations {
+		if err := op.Validate(); err != nil {
+			return errors.Errorf("rule %q: %w", r.Name, err)
+		}
+	}
+	for _, attrs := range []map[Attribute][]string{r.Match, r.Require} {
+		for attr, patterns := range attrs {
+			if err := attr.Validate(); err != nil {
+				return errors.Errorf("rule %q: %w", r.Name, err)
+			}
+			if len(patterns) == 0 {
+				return errors.Errorf("rule %q: attribute %q has no patterns %w", r.Name, attr, coreerrors.NotValid)
+			}
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return errors.Errorf("rule %q: attribute %q pattern %q: %w", r.Name, attr, pattern, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// applies returns true if the rule applies to the request.
+func (r Rule) applies(req Request) bool {
+	if len(r.Operations) > 0 && !slices.Contains(r.Operations, req.Operation) {
+		return false
+	}
+	for attr, patterns := range r.Match {
+		matched := false
+		for _, value := range req.values(attr) {
+			if matchAny(patterns, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// denies returns true if the rule denies the request.
+func (r Rule) denies(req Request) bool {
+	if !r.applies(req) {
+		return false
+	}
+	if len(r.Require) == 0 {
+		return true
+	}
+	for attr, patterns := range r.Require {
+		values := req.values(attr)
+		if len(values) == 0 {
+			return true
+		}
+		for _, value := range values {
+			if !matchAny(patterns, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Policy is an ordered set of admission rules. The zero value admits all
+// requests.
+type Policy []Rule
+
+// Parse parses an admission policy from YAML. An empty string is an empty
+// policy, which admits all requests.
+func Parse(s string) (Policy, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var policy Policy
+	if err := yaml.UnmarshalStrict([]byte(s), &policy); err != nil {
+		return nil, errors.Errorf("parsing admission policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, errors.Errorf("validating admission policy: %w", err)
+	}
+	return policy, nil
+}
+
+// Validate returns an error if any rule of the policy is not valid, or if
+// rule names are not unique.
+func (p Policy) Validate() error {
+	names := make(map[string]bool)
+	for _, rule := range p {
+		if err := rule.Validate(); err != nil {
+			return errors.Capture(err)
+		}
+		if names[rule.Name] {
+			return errors.Errorf("rule %q is defined more than once", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+	return nil
+}
+
+// Admit checks the request against each rule of the policy in turn. If a
+// rule denies the request, an error satisfying [PolicyDenied] and
+// [coreerrors.Forbidden] is returned, holding the rule's reason.
+func (p Policy) Admit(req Request) error {
+	for _, rule := range p {
+		if !rule.denies(req) {
+			continue
+		}
+		var reason string
+		if rule.Reason != "" {
+			reason = ": " + rule.Reason
+		}
+		return errors.Errorf("operation %q %w rule %q%s",
+			req.Operation, PolicyDenied, rule.Name, reason).Add(coreerrors.Forbidden)
+	}
+	return nil
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
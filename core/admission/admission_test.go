@@ -0,0 +1,170 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/admission"
+	"github.com/juju/juju/core/constraints"
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type admissionSuite struct{}
+
+func TestAdmissionSuite(t *testing.T) {
+	tc.Run(t, &admissionSuite{})
+}
+
+const testPolicy = `
+- name: no-expose-on-production
+  operations: [expose]
+  match:
+    model: ["prod-*"]
+  reason: applications cannot be exposed on production models
+- name: approved-charms
+  operations: [deploy]
+  require:
+    charm-source: [charm-hub]
+    charm: [mysql, "postgresql*"]
+  reason: only approved charms can be deployed
+- name: trusted-publishers
+  operations: [deploy]
+  match:
+    charm-source: [charm-hub]
+  require:
+    publisher: [canonical, "data-*"]
+- name: no-trust
+  operations: [set-config]
+  match:
+    config-key: [trust]
+- name: small-machines
+  operations: [add-machine, set-constraints]
+  require:
+    constraint: ["arch=*", "mem=[1-4]???M"]
+`
+
+func (s *admissionSuite) TestParseEmpty(c *tc.C) {
+	policy, err := admission.Parse(" \n")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.HasLen, 0)
+	c.Check(policy.Admit(admission.Request{Operation: admission.Expose}), tc.ErrorIsNil)
+}
+
+func (s *admissionSuite) TestParse(c *tc.C) {
+	policy, err := admission.Parse(testPolicy)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(policy, tc.HasLen, 5)
+	c.Check(policy[0], tc.DeepEquals, admission.Rule{
+		Name:       "no-expose-on-production",
+		Operations: []admission.Operation{admission.Expose},
+		Match:      map[admission.Attribute][]string{admission.ModelAttribute: {"prod-*"}},
+		Reason:     "applications cannot be exposed on production models",
+	})
+}
+
+func (s *admissionSuite) TestParseInvalid(c *tc.C) {
+	tests := []struct {
+		policy string
+		err    string
+	}{{
+		policy: `{name: foo}`,
+		err:    `(?s)parsing admission policy: .*cannot unmarshal.*`,
+	}, {
+		policy: `[{name: foo, bar: baz}]`,
+		err:    `(?s)parsing admission policy: .*field bar not found.*`,
+	}, {
+		policy: `[{operations: [expose]}]`,
+		err:    `validating admission policy: rule name not valid`,
+	}, {
+		policy: `[{name: foo, operations: [destroy]}]`,
+		err:    `validating admission policy: rule "foo": operation "destroy" not valid`,
+	}, {
+		policy: `[{name: foo, match: {colour: [red]}}]`,
+		err:    `validating admission policy: rule "foo": attribute "colour" not valid`,
+	}, {
+		policy: `[{name: foo, require: {model: []}}]`,
+		err:    `validating admission policy: rule "foo": attribute "model" has no patterns not valid`,
+	}, {
+		policy: `[{name: foo, match: {model: ["[a-"]}}]`,
+		err:    `validating admission policy: rule "foo": attribute "model" pattern "\[a-": syntax error in pattern`,
+	}, {
+		policy: `[{name: foo}, {name: foo}]`,
+		err:    `validating admission policy: rule "foo" is defined more than once`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.policy)
+		_, err := admission.Parse(test.policy)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *admissionSuite) TestConstraintValues(c *tc.C) {
+	cons := constraints.MustParse("arch=amd64 mem=2G tags=foo,bar")
+	c.Check(admission.ConstraintValues(cons), tc.DeepEquals, []string{"arch=amd64", "mem=2048M", "tags=foo,bar"})
+	c.Check(admission.ConstraintValues(constraints.Value{}), tc.HasLen, 0)
+}
+
+func (s *admissionSuite) TestAdmit(c *tc.C) {
+	policy, err := admission.Parse(testPolicy)
+	c.Assert(err, tc.ErrorIsNil)
+
+	tests := []struct {
+		req admission.Request
+		err string
+	}{{
+		req: admission.Request{Operation: admission.Expose, Model: "prod-db", Application: "mysql"},
+		err: `operation "expose" denied by admission policy rule "no-expose-on-production": applications cannot be exposed on production models`,
+	}, {
+		req: admission.Request{Operation: admission.Expose, Model: "staging", Application: "mysql"},
+	}, {
+		req: admission.Request{Operation: admission.Deploy, Model: "prod-db", Charm: "mysql", CharmSource: "charm-hub", Publisher: "canonical"},
+	}, {
+		req: admission.Request{Operation: admission.Deploy, Charm: "postgresql-k8s", CharmSource: "charm-hub", Publisher: "data-platform"},
+	}, {
+		req: admission.Request{Operation: admission.Deploy, Charm: "mysql", CharmSource: "charm-hub", Publisher: "someone"},
+		err: `operation "deploy" denied by admission policy rule "trusted-publishers"`,
+	}, {
+		req: admission.Request{Operation: admission.Deploy, Charm: "mysql", CharmSource: "charm-hub"},
+		err: `operation "deploy" denied by admission policy rule "trusted-publishers"`,
+	}, {
+		req: admission.Request{Operation: admission.Deploy, Charm: "mysql", CharmSource: "local"},
+		err: `operation "deploy" denied by admission policy rule "approved-charms": only approved charms can be deployed`,
+	}, {
+		req: admission.Request{Operation: admission.Deploy, Charm: "wordpress", CharmSource: "charm-hub", Publisher: "canonical"},
+		err: `operation "deploy" denied by admission policy rule "approved-charms": .*`,
+	}, {
+		req: admission.Request{Operation: admission.SetConfig, ConfigKeys: []string{"port", "trust"}},
+		err: `operation "set-config" denied by admission policy rule "no-trust"`,
+	}, {
+		req: admission.Request{Operation: admission.SetConfig, ConfigKeys: []string{"port"}},
+	}, {
+		req: admission.Request{Operation: admission.Deploy, Charm: "mysql"},
+		err: `operation "deploy" denied by admission policy rule "approved-charms": .*`,
+	}, {
+		req: admission.Request{Operation: admission.Deploy, CharmSource: "charm-hub"},
+		err: `operation "deploy" denied by admission policy rule "approved-charms": .*`,
+	}, {
+		req: admission.Request{Operation: admission.AddMachine},
+		err: `operation "add-machine" denied by admission policy rule "small-machines"`,
+	}, {
+		req: admission.Request{Operation: admission.AddMachine, Constraints: []string{"arch=amd64", "mem=2048M"}},
+	}, {
+		req: admission.Request{Operation: admission.SetConstraints, Constraints: []string{"mem=16384M"}},
+		err: `operation "set-constraints" denied by admission policy rule "small-machines"`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %+v", i, test.req)
+		err := policy.Admit(test.req)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+			continue
+		}
+		c.Check(err, tc.ErrorMatches, test.err)
+		c.Check(err, tc.ErrorIs, admission.PolicyDenied)
+		c.Check(err, tc.ErrorIs, coreerrors.Forbidden)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+// HookTimeoutConfigOptionName is the option name used to override the model's
+// hook-timeout for the units of an application in application configuration.
+const HookTimeoutConfigOptionName = "hook-timeout"
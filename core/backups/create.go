@@ -0,0 +1,273 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	jujutar "github.com/juju/utils/v4/tar"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// objectStoreDir is the directory, relative to the data directory,
+	// holding the file backed object store of each namespace.
+	objectStoreDir = "objectstore"
+
+	// dumpExtension is the extension of the database dumps in an archive.
+	dumpExtension = ".jsonl"
+)
+
+// DumpFunc writes the dump of the database with the given namespace to w.
+type DumpFunc func(ctx context.Context, namespace string, w io.Writer) error
+
+// CreateArgs holds the arguments for creating a backup archive.
+type CreateArgs struct {
+	// Paths locates the backup directory, in which the archive is stored,
+	// and the data directory, from which the object store files are read.
+	Paths Paths
+
+	// Metadata describes the backup. It is completed and stored in the
+	// archive.
+	Metadata *Metadata
+
+	// Namespaces are the database namespaces to dump into the archive.
+	Namespaces []string
+
+	// Dump writes the dump of each database namespace.
+	Dump DumpFunc
+}
+
+// CreateArchive creates a backup archive in the backup directory holding the
+// dumps of the requested databases, and the files of the object store of
+// every namespace. Objects held in an object store other than the file
+// backed one are not included.
+// The following errors may be returned:
+// - [coreerrors.AlreadyExists] when an archive with the same name exists.
+func CreateArchive(ctx context.Context, args CreateArgs) (ArchiveFile, error) {
+	backupDir := args.Paths.BackupDir
+	if err := os.MkdirAll(backupDir, 0700); err != nil {
+		return ArchiveFile{}, errors.Errorf("creating backup directory: %w", err)
+	}
+	filename := args.Metadata.Started.Format(FilenameTemplate)
+	if _, err := os.Stat(filepath.Join(backupDir, filename)); err == nil {
+		return ArchiveFile{}, errors.Errorf("backup %q %w", filename, coreerrors.AlreadyExists)
+	}
+
+	// The archive is assembled in the backup directory, where there is room
+	// for it, under names that are not listed as backups.
+	workDir, err := os.MkdirTemp(backupDir, ".create-")
+	if err != nil {
+		return ArchiveFile{}, errors.Errorf("creating workspace dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(workDir) }()
+
+	var files []archiveEntry
+	for _, namespace := range args.Namespaces {
+		dumpPath := filepath.Join(workDir, namespace+dumpExtension)
+		if err := dumpToFile(ctx, args.Dump, namespace, dumpPath); err != nil {
+			return ArchiveFile{}, errors.Errorf("dumping database %q: %w", namespace, err)
+		}
+		files = append(files, archiveEntry{
+			name: path.Join(contentDir, dbDumpDir, namespace+dumpExtension),
+			path: dumpPath,
+		})
+	}
+
+	bundlePath := filepath.Join(workDir, filesBundle)
+	if err := bundleObjectStore(args.Paths.DataDir, bundlePath); err != nil {
+		return ArchiveFile{}, errors.Errorf("bundling object store: %w", err)
+	}
+	files = append(files, archiveEntry{
+		name: path.Join(contentDir, filesBundle),
+		path: bundlePath,
+	})
+
+	finished := time.Now().UTC()
+	args.Metadata.Finished = &finished
+
+	archivePath := filepath.Join(workDir, filename)
+	if err := writeArchive(archivePath, args.Metadata, files); err != nil {
+		return ArchiveFile{}, errors.Errorf("writing backup archive: %w", err)
+	}
+	if err := completeMetadata(archivePath, args.Metadata); err != nil {
+		return ArchiveFile{}, errors.Capture(err)
+	}
+	if err := os.Rename(archivePath, filepath.Join(backupDir, filename)); err != nil {
+		return ArchiveFile{}, errors.Capture(err)
+	}
+	return ArchiveFile{
+		Filename: filename,
+		Metadata: args.Metadata,
+	}, nil
+}
+
+// archiveEntry is a file added to the content directory of an archive.
+type archiveEntry struct {
+	name string
+	path string
+}
+
+func dumpToFile(ctx context.Context, dump DumpFunc, namespace, target string) error {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := dump(ctx, namespace, f); err != nil {
+		_ = f.Close()
+		return errors.Capture(err)
+	}
+	return errors.Capture(f.Close())
+}
+
+// bundleObjectStore writes a tar file holding the object store directory of
+// every namespace, with paths relative to the data directory. The shared
+// blob directory only holds links to the namespace files, so it is skipped.
+func bundleObjectStore(dataDir, target string) error {
+	root := filepath.Join(dataDir, objectStoreDir)
+	entries, err := os.ReadDir(root)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Capture(err)
+	}
+	var dirs []string
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		dirs = append(dirs, filepath.Join(root, entry.Name()))
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := jujutar.TarFiles(dirs, f, dataDir+string(os.PathSeparator)); err != nil {
+		_ = f.Close()
+		return errors.Capture(err)
+	}
+	return errors.Capture(f.Close())
+}
+
+// writeArchive writes the compressed archive. The metadata is written first,
+// so that it can be read without reading through the rest of the archive.
+func writeArchive(target string, meta *Metadata, files []archiveEntry) (err error) {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = errors.Capture(closeErr)
+		}
+	}()
+
+	gzw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gzw)
+
+	now := time.Now().UTC()
+	for _, dir := range []string{contentDir, path.Join(contentDir, dbDumpDir)} {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     dir + "/",
+			Typeflag: tar.TypeDir,
+			Mode:     0700,
+			ModTime:  now,
+		}); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	metaFile, err := meta.AsJSONBuffer()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	metaContent, err := io.ReadAll(metaFile)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    path.Join(contentDir, metadataFile),
+		Mode:    0600,
+		Size:    int64(len(metaContent)),
+		ModTime: now,
+	}); err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := tw.Write(metaContent); err != nil {
+		return errors.Capture(err)
+	}
+
+	for _, file := range files {
+		if err := addFileToArchive(tw, file); err != nil {
+			return errors.Errorf("adding %q: %w", file.name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(gzw.Close())
+}
+
+func addFileToArchive(tw *tar.Writer, file archiveEntry) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    file.name,
+		Mode:    0600,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}); err != nil {
+		return errors.Capture(err)
+	}
+	_, err = io.Copy(tw, f)
+	return errors.Capture(err)
+}
+
+// completeMetadata records the size and checksum of the written archive in
+// the metadata returned to the caller.
+func completeMetadata(archivePath string, meta *Metadata) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	hasher := sha1.New()
+	size, err := io.Copy(hasher, f)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	checksum := base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+	if err := meta.SetFileInfo(size, checksum, checksumFormat); err != nil {
+		return errors.Capture(err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	stored := fileTimestamp(info)
+	meta.SetStored(&stored)
+	return nil
+}
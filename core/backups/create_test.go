@@ -0,0 +1,276 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type createSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestCreateSuite(t *stdtesting.T) {
+	tc.Run(t, &createSuite{})
+}
+
+func (s *createSuite) writeFile(c *tc.C, path, content string) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(path, []byte(content), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *createSuite) readFile(c *tc.C, path string) string {
+	data, err := os.ReadFile(path)
+	c.Assert(err, tc.ErrorIsNil)
+	return string(data)
+}
+
+func (s *createSuite) create(c *tc.C, dataDir string) backups.ArchiveFile {
+	meta := backups.NewMetadata()
+	meta.Started = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	meta.Notes = "my backup"
+	meta.Controller.UUID = "controller-uuid"
+
+	archive, err := backups.CreateArchive(c.Context(), backups.CreateArgs{
+		Paths: backups.Paths{
+			BackupDir: backups.BackupDir(dataDir),
+			DataDir:   dataDir,
+		},
+		Metadata:   meta,
+		Namespaces: []string{"controller", "model-uuid"},
+		Dump: func(_ context.Context, namespace string, w io.Writer) error {
+			_, err := fmt.Fprintf(w, "dump of %s", namespace)
+			return err
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return archive
+}
+
+func (s *createSuite) TestCreateAndRestoreArchive(c *tc.C) {
+	dataDir := c.MkDir()
+	s.writeFile(c, filepath.Join(dataDir, "objectstore", "controller", "abc"), "controller object")
+	s.writeFile(c, filepath.Join(dataDir, "objectstore", "model-uuid", "def"), "model object")
+	s.writeFile(c, filepath.Join(dataDir, "objectstore", ".blobs", "abc"), "controller object")
+
+	archive := s.create(c, dataDir)
+	c.Check(archive.Filename, tc.Equals, "juju-backup-20260102-030405.tar.gz")
+	c.Check(archive.Metadata.Finished, tc.NotNil)
+	c.Check(archive.Metadata.Size(), tc.Not(tc.Equals), int64(0))
+	c.Check(archive.Metadata.Checksum(), tc.Not(tc.Equals), "")
+
+	// The archive is listed in the backup directory, and nothing else is
+	// left behind.
+	archives, err := backups.ListArchives(backups.BackupDir(dataDir))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(archives, tc.HasLen, 1)
+	c.Check(archives[0].Metadata.Notes, tc.Equals, "my backup")
+	c.Check(archives[0].Metadata.Controller.UUID, tc.Equals, "controller-uuid")
+	entries, err := os.ReadDir(backups.BackupDir(dataDir))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 1)
+
+	// Restore into a controller whose object store has moved on since.
+	targetDir := c.MkDir()
+	s.writeFile(c, filepath.Join(targetDir, "objectstore", "controller", "abc"), "changed")
+	s.writeFile(c, filepath.Join(targetDir, "objectstore", "controller", "new"), "new object")
+	s.writeFile(c, filepath.Join(targetDir, "objectstore", "other-model", "ghi"), "other")
+	s.writeFile(c, filepath.Join(targetDir, "objectstore", ".blobs", "xyz"), "blob")
+
+	dbs := &fakeDatabases{content: map[string]string{
+		"controller": "current controller",
+	}}
+	archivePath := filepath.Join(backups.BackupDir(dataDir), archive.Filename)
+	err = backups.RestoreArchive(c.Context(), dbs.restoreArgs(archivePath, targetDir))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(dbs.content, tc.DeepEquals, map[string]string{
+		"controller": "dump of controller",
+		"model-uuid": "dump of model-uuid",
+	})
+
+	c.Check(s.readFile(c, filepath.Join(targetDir, "objectstore", "controller", "abc")), tc.Equals, "controller object")
+	c.Check(s.readFile(c, filepath.Join(targetDir, "objectstore", "model-uuid", "def")), tc.Equals, "model object")
+	for _, name := range []string{"controller/new", "other-model"} {
+		_, err = os.Stat(filepath.Join(targetDir, "objectstore", name))
+		c.Check(os.IsNotExist(err), tc.IsTrue, tc.Commentf("%s", name))
+	}
+	// The shared blobs are neither backed up nor removed.
+	c.Check(s.readFile(c, filepath.Join(targetDir, "objectstore", ".blobs", "xyz")), tc.Equals, "blob")
+	_, err = os.Stat(filepath.Join(targetDir, "objectstore", ".blobs", "abc"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *createSuite) TestRestoreArchiveCheckFails(c *tc.C) {
+	dataDir := c.MkDir()
+	archive := s.create(c, dataDir)
+
+	targetDir := c.MkDir()
+	s.writeFile(c, filepath.Join(targetDir, "objectstore", "controller", "new"), "new object")
+
+	dbs := &fakeDatabases{
+		content:   map[string]string{"controller": "current controller"},
+		failCheck: "model-uuid",
+	}
+	archivePath := filepath.Join(backups.BackupDir(dataDir), archive.Filename)
+	err := backups.RestoreArchive(c.Context(), dbs.restoreArgs(archivePath, targetDir))
+	c.Assert(err, tc.ErrorMatches, `checking database "model-uuid": bad dump`)
+
+	// Nothing is replaced, and nothing is left behind.
+	c.Check(dbs.content, tc.DeepEquals, map[string]string{"controller": "current controller"})
+	c.Check(s.readFile(c, filepath.Join(targetDir, "objectstore", "controller", "new")), tc.Equals, "new object")
+	entries, err := os.ReadDir(targetDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 1)
+}
+
+func (s *createSuite) TestRestoreArchiveLoadFailsRollsBack(c *tc.C) {
+	dataDir := c.MkDir()
+	s.writeFile(c, filepath.Join(dataDir, "objectstore", "controller", "abc"), "controller object")
+	archive := s.create(c, dataDir)
+
+	targetDir := c.MkDir()
+	s.writeFile(c, filepath.Join(targetDir, "objectstore", "controller", "new"), "new object")
+
+	dbs := &fakeDatabases{
+		content: map[string]string{
+			"controller": "current controller",
+			"model-uuid": "current model",
+		},
+		failLoad: "model-uuid",
+	}
+	archivePath := filepath.Join(backups.BackupDir(dataDir), archive.Filename)
+	err := backups.RestoreArchive(c.Context(), dbs.restoreArgs(archivePath, targetDir))
+	c.Assert(err, tc.ErrorMatches, `restoring database "model-uuid": boom`)
+
+	// The controller database, which was restored, is put back, and the
+	// object store is left as it was.
+	c.Check(dbs.content, tc.DeepEquals, map[string]string{
+		"controller": "current controller",
+		"model-uuid": "current model",
+	})
+	c.Check(s.readFile(c, filepath.Join(targetDir, "objectstore", "controller", "new")), tc.Equals, "new object")
+	_, err = os.Stat(filepath.Join(targetDir, "objectstore", "controller", "abc"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *createSuite) TestCreateArchiveAlreadyExists(c *tc.C) {
+	dataDir := c.MkDir()
+	s.create(c, dataDir)
+
+	meta := backups.NewMetadata()
+	meta.Started = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	_, err := backups.CreateArchive(c.Context(), backups.CreateArgs{
+		Paths:    backups.Paths{BackupDir: backups.BackupDir(dataDir), DataDir: dataDir},
+		Metadata: meta,
+	})
+	c.Check(err, tc.ErrorIs, coreerrors.AlreadyExists)
+}
+
+func (s *createSuite) TestCreateArchiveDumpError(c *tc.C) {
+	dataDir := c.MkDir()
+
+	meta := backups.NewMetadata()
+	_, err := backups.CreateArchive(c.Context(), backups.CreateArgs{
+		Paths:      backups.Paths{BackupDir: backups.BackupDir(dataDir), DataDir: dataDir},
+		Metadata:   meta,
+		Namespaces: []string{"controller"},
+		Dump: func(context.Context, string, io.Writer) error {
+			return fmt.Errorf("boom")
+		},
+	})
+	c.Check(err, tc.ErrorMatches, `dumping database "controller": boom`)
+
+	entries, err := os.ReadDir(backups.BackupDir(dataDir))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *createSuite) TestStageRestore(c *tc.C) {
+	dataDir := c.MkDir()
+	archive := s.create(c, dataDir)
+	dir := backups.BackupDir(dataDir)
+
+	_, err := backups.PendingRestore(dir)
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+
+	err = backups.StageRestore(dir, archive.Filename)
+	c.Assert(err, tc.ErrorIsNil)
+
+	path, err := backups.PendingRestore(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(path, tc.Equals, filepath.Join(dir, archive.Filename))
+
+	// The staged restore is not listed as a backup.
+	archives, err := backups.ListArchives(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(archives, tc.HasLen, 1)
+
+	err = backups.ClearPendingRestore(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = backups.PendingRestore(dir)
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+	err = backups.ClearPendingRestore(dir)
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *createSuite) TestStageRestoreNotFound(c *tc.C) {
+	err := backups.StageRestore(c.MkDir(), "juju-backup-20260101-000000.tar.gz")
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+// fakeDatabases holds the content of the database of each namespace, which
+// is dumped and loaded as is.
+type fakeDatabases struct {
+	content map[string]string
+
+	// failCheck is the namespace whose dump in the archive fails the check.
+	failCheck string
+
+	// failLoad is the namespace whose dump in the archive fails to load.
+	failLoad string
+}
+
+func (d *fakeDatabases) restoreArgs(archivePath, dataDir string) backups.RestoreArgs {
+	return backups.RestoreArgs{
+		ArchivePath: archivePath,
+		DataDir:     dataDir,
+		Check: func(_ context.Context, namespace string, r io.Reader) error {
+			if namespace == d.failCheck {
+				return fmt.Errorf("bad dump")
+			}
+			_, err := io.ReadAll(r)
+			return err
+		},
+		Dump: func(_ context.Context, namespace string, w io.Writer) error {
+			_, err := io.WriteString(w, d.content[namespace])
+			return err
+		},
+		Load: func(_ context.Context, namespace string, r io.Reader) error {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			if namespace == d.failLoad && strings.HasPrefix(string(data), "dump of") {
+				return fmt.Errorf("boom")
+			}
+			d.content[namespace] = string(data)
+			return nil
+		},
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"math"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/juju/utils/v4/filestorage"
@@ -28,6 +29,16 @@ const (
 	FilenameTemplate = FilenamePrefix + "20060102-150405.tar.gz"
 )
 
+// backupDirName is the name of the directory, relative to the data
+// directory, in which backup archives are stored on a controller.
+const backupDirName = "backups"
+
+// BackupDir returns the directory in which backup archives are stored on a
+// controller with the given data directory.
+func BackupDir(dataDir string) string {
+	return filepath.Join(dataDir, backupDirName)
+}
+
 // Paths holds the paths that backups needs.
 type Paths struct {
 	BackupDir string
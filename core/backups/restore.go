@@ -0,0 +1,489 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/juju/utils/v4/tar"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/internal/errors"
+)
+
+// pendingRestoreFile is the file, within the backup directory, naming the
+// archive to be restored when the controller agent next starts.
+const pendingRestoreFile = ".restore-pending"
+
+// ReadArchiveMetadata returns the metadata stored in the compressed backup
+// archive read from r. Only the archive entries up to and including the
+// metadata file are read.
+func ReadArchiveMetadata(r io.Reader) (*Metadata, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Errorf("while uncompressing archive file: %w", err)
+	}
+	defer func() { _ = gzr.Close() }()
+
+	paths := NewCanonicalArchivePaths()
+	_, metaFile, err := tar.FindFile(gzr, paths.MetadataFile)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	meta, err := NewMetadataJSONReader(metaFile)
+	return meta, errors.Capture(err)
+}
+
+// ArchiveFile describes a backup archive stored in a backup directory.
+type ArchiveFile struct {
+	// Filename is the name of the archive file, relative to the backup
+	// directory.
+	Filename string
+
+	// Metadata is the metadata read from the archive.
+	Metadata *Metadata
+}
+
+// ListArchives returns the backup archives found in the given directory,
+// sorted by filename. Files that do not carry the backup filename prefix
+// are ignored. A missing directory results in an empty list.
+func ListArchives(dir string) ([]ArchiveFile, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var archives []ArchiveFile
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), FilenamePrefix) {
+			continue
+		}
+		meta, err := readArchiveFileMetadata(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, errors.Errorf("reading backup %q: %w", entry.Name(), err)
+		}
+		archives = append(archives, ArchiveFile{
+			Filename: entry.Name(),
+			Metadata: meta,
+		})
+	}
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].Filename < archives[j].Filename
+	})
+	return archives, nil
+}
+
+// ArchivePath returns the path of the named archive within the backup
+// directory. The following errors may be returned:
+// - [coreerrors.NotValid] when the filename is not a plain backup filename.
+func ArchivePath(dir, filename string) (string, error) {
+	if filename == "" || filename != filepath.Base(filename) ||
+		!strings.HasPrefix(filename, FilenamePrefix) {
+		return "", errors.Errorf("backup filename %q %w", filename, coreerrors.NotValid)
+	}
+	return filepath.Join(dir, filename), nil
+}
+
+// OpenArchive returns the metadata for the named archive within the backup
+// directory. The following errors may be returned:
+// - [coreerrors.NotValid] when the filename is not a plain backup filename.
+// - [coreerrors.NotFound] when the archive does not exist.
+func OpenArchive(dir, filename string) (ArchiveFile, error) {
+	path, err := ArchivePath(dir, filename)
+	if err != nil {
+		return ArchiveFile{}, errors.Capture(err)
+	}
+	meta, err := readArchiveFileMetadata(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ArchiveFile{}, errors.Errorf("backup %q %w", filename, coreerrors.NotFound)
+	} else if err != nil {
+		return ArchiveFile{}, errors.Capture(err)
+	}
+	return ArchiveFile{
+		Filename: filename,
+		Metadata: meta,
+	}, nil
+}
+
+// RemoveArchive removes the named archive from the backup directory.
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the filename is not a plain backup filename.
+// - [coreerrors.NotFound] when the archive does not exist.
+func RemoveArchive(dir, filename string) error {
+	path, err := ArchivePath(dir, filename)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return errors.Errorf("backup %q %w", filename, coreerrors.NotFound)
+	}
+	return errors.Capture(err)
+}
+
+func readArchiveFileMetadata(path string) (*Metadata, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	meta, err := ReadArchiveMetadata(f)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// The archive on disk is the source of truth for the size and
+	// timestamp of the backup.
+	if fi, err := f.Stat(); err == nil {
+		stored := fileTimestamp(fi)
+		meta.SetStored(&stored)
+	}
+	return meta, nil
+}
+
+// ValidateRestore checks that the backup described by meta can be restored
+// into the controller identified by controllerUUID, running the given
+// version of juju. The backup must have been taken from the same controller
+// using the same major and minor version of juju, and must not be newer
+// than the running controller.
+// The following errors may be returned:
+// - [coreerrors.NotSupported] when the backup format is not supported.
+// - [coreerrors.NotValid] when the backup is not compatible with the
+// controller.
+func ValidateRestore(meta *Metadata, controllerUUID string, current semversion.Number) error {
+	if meta.FormatVersion != currentFormatVersion {
+		return errors.Errorf("backup format %d %w", meta.FormatVersion, coreerrors.NotSupported)
+	}
+	if meta.Controller.UUID != controllerUUID {
+		return errors.Errorf(
+			"backup of controller %q cannot be restored into controller %q %w",
+			meta.Controller.UUID, controllerUUID, coreerrors.NotValid)
+	}
+
+	version := meta.Origin.Version
+	if version.Major != current.Major || version.Minor != current.Minor {
+		return errors.Errorf(
+			"backup taken with juju %s cannot be restored into a juju %d.%d controller %w",
+			version, current.Major, current.Minor, coreerrors.NotValid)
+	}
+	if current.Compare(version) < 0 {
+		return errors.Errorf(
+			"backup taken with juju %s is newer than the controller version %s %w",
+			version, current, coreerrors.NotValid)
+	}
+	return nil
+}
+
+// LoadFunc replaces the database with the given namespace with the dump read
+// from r.
+type LoadFunc func(ctx context.Context, namespace string, r io.Reader) error
+
+// RestoreArgs holds the arguments for restoring a backup archive.
+type RestoreArgs struct {
+	// ArchivePath is the path of the backup archive to restore.
+	ArchivePath string
+
+	// DataDir is the data directory holding the object store files, which
+	// are replaced by those in the archive.
+	DataDir string
+
+	// Check replays the dump of each database in the archive without
+	// changing the database, so that a dump which can't be loaded is found
+	// before any database is replaced.
+	Check LoadFunc
+
+	// Dump writes the dump of each database before it is replaced, so that
+	// it can be put back if the restore fails.
+	Dump DumpFunc
+
+	// Load replaces each database with the dump read from the archive.
+	Load LoadFunc
+}
+
+// RestoreArchive replaces the databases and object store files of the
+// controller with those held in the backup archive. Every database dump in
+// the archive is checked, and the files bundle unpacked into a staging
+// directory, before anything is replaced. The databases are then loaded
+// from their dumps, after which the object store of every namespace under
+// the data directory is swapped for the staged one.
+//
+// If the restore fails once something has been replaced, the databases
+// already loaded are put back from the dumps taken of them beforehand, and
+// the object stores are moved back, so that the controller is left either
+// fully restored or as it was.
+//
+// The restore must only be run while the controller's databases are not in
+// use.
+func RestoreArchive(ctx context.Context, args RestoreArgs) error {
+	f, err := os.Open(args.ArchivePath)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	ws, err := NewArchiveWorkspaceReader(f)
+	if err != nil {
+		if ws != nil {
+			_ = ws.Close()
+		}
+		return errors.Errorf("unpacking backup archive: %w", err)
+	}
+	defer func() { _ = ws.Close() }()
+
+	if _, err := ws.Metadata(); err != nil {
+		return errors.Errorf("reading backup metadata: %w", err)
+	}
+
+	namespaces, err := archiveDumps(ws.DBDumpDir)
+	if err != nil {
+		return errors.Errorf("reading database dumps: %w", err)
+	}
+	for _, namespace := range namespaces {
+		if err := loadDump(ctx, dumpPath(ws.DBDumpDir, namespace), namespace, args.Check); err != nil {
+			return errors.Errorf("checking database %q: %w", namespace, err)
+		}
+	}
+
+	// The files are staged within the data directory, so that they can be
+	// renamed into place.
+	staging, err := os.MkdirTemp(args.DataDir, ".restore-")
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = os.RemoveAll(staging) }()
+	if err := ws.UnpackFilesBundle(staging); err != nil {
+		return errors.Errorf("unpacking object store: %w", err)
+	}
+	if err := checkStagedFiles(staging); err != nil {
+		return errors.Errorf("unpacking object store: %w", err)
+	}
+
+	rollbackDir := filepath.Join(ws.RootDir, "rollback")
+	if err := os.Mkdir(rollbackDir, 0700); err != nil {
+		return errors.Capture(err)
+	}
+	for _, namespace := range namespaces {
+		if err := dumpToFile(ctx, args.Dump, namespace, dumpPath(rollbackDir, namespace)); err != nil {
+			return errors.Errorf("dumping database %q: %w", namespace, err)
+		}
+	}
+
+	var loaded []string
+	for _, namespace := range namespaces {
+		if err := loadDump(ctx, dumpPath(ws.DBDumpDir, namespace), namespace, args.Load); err != nil {
+			err = errors.Errorf("restoring database %q: %w", namespace, err)
+			return rollbackDatabases(ctx, err, rollbackDir, loaded, args.Load)
+		}
+		loaded = append(loaded, namespace)
+	}
+
+	if err := swapObjectStores(args.DataDir, staging); err != nil {
+		err = errors.Errorf("restoring object store: %w", err)
+		return rollbackDatabases(ctx, err, rollbackDir, loaded, args.Load)
+	}
+	return nil
+}
+
+// archiveDumps returns the namespaces of the database dumps in the dump
+// directory of an archive.
+func archiveDumps(dumpDir string) ([]string, error) {
+	dumps, err := os.ReadDir(dumpDir)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var namespaces []string
+	for _, dump := range dumps {
+		namespace, ok := strings.CutSuffix(dump.Name(), dumpExtension)
+		if dump.IsDir() || !ok {
+			continue
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	return namespaces, nil
+}
+
+func dumpPath(dir, namespace string) string {
+	return filepath.Join(dir, namespace+dumpExtension)
+}
+
+func loadDump(ctx context.Context, path, namespace string, load LoadFunc) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+	return errors.Capture(load(ctx, namespace, f))
+}
+
+// rollbackDatabases puts back the databases already loaded from the archive
+// using the dumps taken before they were replaced, and returns the error
+// that failed the restore. If a database can't be put back, the controller
+// is left partially restored, which the returned error says.
+func rollbackDatabases(ctx context.Context, restoreErr error, rollbackDir string, loaded []string, load LoadFunc) error {
+	for _, namespace := range loaded {
+		if err := loadDump(ctx, dumpPath(rollbackDir, namespace), namespace, load); err != nil {
+			return errors.Errorf("%w; rolling back database %q, leaving it partially restored: %w", restoreErr, namespace, err)
+		}
+	}
+	return restoreErr
+}
+
+// checkStagedFiles checks that the files bundle unpacked into the staging
+// directory holds nothing but the object store directories.
+func checkStagedFiles(staging string) error {
+	entries, err := os.ReadDir(staging)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != objectStoreDir || !entry.IsDir() {
+			return errors.Errorf("unexpected %q in files bundle %w", entry.Name(), coreerrors.NotValid)
+		}
+	}
+	return nil
+}
+
+// swapObjectStores replaces the object store directory of every namespace
+// with the one unpacked into the staging directory, so that objects created
+// since the backup do not survive the restore. The current directories are
+// moved aside into the staging directory before the staged ones are renamed
+// into place, and are moved back if any rename fails. The shared blobs are
+// left for the object store to collect once nothing links to them.
+func swapObjectStores(dataDir, staging string) error {
+	root := filepath.Join(dataDir, objectStoreDir)
+	stagedRoot := filepath.Join(staging, objectStoreDir)
+	previousRoot := filepath.Join(staging, "previous")
+
+	current, err := namespaceDirs(root)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	staged, err := namespaceDirs(stagedRoot)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return errors.Capture(err)
+	}
+	if err := os.Mkdir(previousRoot, 0700); err != nil {
+		return errors.Capture(err)
+	}
+
+	var moved, placed []string
+	undo := func(swapErr error) error {
+		for _, name := range placed {
+			if err := os.Rename(filepath.Join(root, name), filepath.Join(stagedRoot, name)); err != nil {
+				return errors.Errorf("%w; moving back %q: %w", swapErr, name, err)
+			}
+		}
+		for _, name := range moved {
+			if err := os.Rename(filepath.Join(previousRoot, name), filepath.Join(root, name)); err != nil {
+				return errors.Errorf("%w; moving back %q: %w", swapErr, name, err)
+			}
+		}
+		return swapErr
+	}
+	for _, name := range current {
+		if err := os.Rename(filepath.Join(root, name), filepath.Join(previousRoot, name)); err != nil {
+			return undo(errors.Capture(err))
+		}
+		moved = append(moved, name)
+	}
+	for _, name := range staged {
+		if err := os.Rename(filepath.Join(stagedRoot, name), filepath.Join(root, name)); err != nil {
+			return undo(errors.Capture(err))
+		}
+		placed = append(placed, name)
+	}
+	return nil
+}
+
+// namespaceDirs returns the names of the namespace directories in an object
+// store directory, skipping the shared blob directory.
+func namespaceDirs(root string) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	return names, nil
+}
+
+// StageRestore records that the named archive in the backup directory is to
+// be restored by the controller agent the next time it starts.
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the filename is not a plain backup filename.
+// - [coreerrors.NotFound] when the archive does not exist.
+func StageRestore(dir, filename string) error {
+	path, err := ArchivePath(dir, filename)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return errors.Errorf("backup %q %w", filename, coreerrors.NotFound)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+
+	// Write the marker atomically, so that the agent never reads a
+	// partially written filename.
+	tmp, err := os.CreateTemp(dir, ".restore-")
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.WriteString(filename); err != nil {
+		_ = tmp.Close()
+		return errors.Capture(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(os.Rename(tmp.Name(), filepath.Join(dir, pendingRestoreFile)))
+}
+
+// PendingRestore returns the path of the archive staged for restore in the
+// backup directory.
+// The following errors may be returned:
+// - [coreerrors.NotFound] when no restore is staged.
+// - [coreerrors.NotValid] when the staged filename is not a plain backup
+// filename.
+func PendingRestore(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, pendingRestoreFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", errors.Errorf("pending restore %w", coreerrors.NotFound)
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+	path, err := ArchivePath(dir, strings.TrimSpace(string(data)))
+	return path, errors.Capture(err)
+}
+
+// ClearPendingRestore removes the record of a staged restore from the backup
+// directory, if there is one.
+func ClearPendingRestore(dir string) error {
+	err := os.Remove(filepath.Join(dir, pendingRestoreFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return errors.Capture(err)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/backups"
+	bt "github.com/juju/juju/core/backups/testing"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type restoreSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestRestoreSuite(t *stdtesting.T) {
+	tc.Run(t, &restoreSuite{})
+}
+
+func (s *restoreSuite) newMetadata(c *tc.C) *backups.Metadata {
+	meta := backups.NewMetadata()
+	meta.SetID("20260101-000000.deadbeef")
+	meta.Origin = backups.Origin{
+		Model:    "deadbeef",
+		Machine:  "0",
+		Hostname: "myhost",
+		Version:  semversion.MustParse("4.0.1"),
+		Base:     "ubuntu@24.04",
+	}
+	meta.Controller = backups.ControllerMetadata{
+		UUID:      "controller-uuid",
+		MachineID: "0",
+		HANodes:   1,
+	}
+	err := meta.MarkComplete(10, "123af2cef")
+	c.Assert(err, tc.ErrorIsNil)
+	return meta
+}
+
+func (s *restoreSuite) writeArchive(c *tc.C, dir, filename string, meta *backups.Metadata) {
+	archive, err := bt.NewArchive(meta, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(filepath.Join(dir, filename), archive.Bytes(), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *restoreSuite) TestReadArchiveMetadata(c *tc.C) {
+	archive, err := bt.NewArchive(s.newMetadata(c), nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	meta, err := backups.ReadArchiveMetadata(archive)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(meta.ID(), tc.Equals, "20260101-000000.deadbeef")
+	c.Check(meta.Controller.UUID, tc.Equals, "controller-uuid")
+	c.Check(meta.Origin.Version, tc.Equals, semversion.MustParse("4.0.1"))
+}
+
+func (s *restoreSuite) TestListArchives(c *tc.C) {
+	dir := c.MkDir()
+	meta := s.newMetadata(c)
+	s.writeArchive(c, dir, "juju-backup-20260102-000000.tar.gz", meta)
+	s.writeArchive(c, dir, "juju-backup-20260101-000000.tar.gz", meta)
+	err := os.WriteFile(filepath.Join(dir, "unrelated.txt"), []byte("foo"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	archives, err := backups.ListArchives(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(archives, tc.HasLen, 2)
+	c.Check(archives[0].Filename, tc.Equals, "juju-backup-20260101-000000.tar.gz")
+	c.Check(archives[1].Filename, tc.Equals, "juju-backup-20260102-000000.tar.gz")
+	c.Check(archives[0].Metadata.ID(), tc.Equals, "20260101-000000.deadbeef")
+	c.Check(archives[0].Metadata.Stored(), tc.NotNil)
+}
+
+func (s *restoreSuite) TestListArchivesMissingDir(c *tc.C) {
+	archives, err := backups.ListArchives(filepath.Join(c.MkDir(), "missing"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(archives, tc.HasLen, 0)
+}
+
+func (s *restoreSuite) TestOpenArchive(c *tc.C) {
+	dir := c.MkDir()
+	s.writeArchive(c, dir, "juju-backup-20260101-000000.tar.gz", s.newMetadata(c))
+
+	archive, err := backups.OpenArchive(dir, "juju-backup-20260101-000000.tar.gz")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(archive.Filename, tc.Equals, "juju-backup-20260101-000000.tar.gz")
+	c.Check(archive.Metadata.Controller.UUID, tc.Equals, "controller-uuid")
+}
+
+func (s *restoreSuite) TestOpenArchiveNotFound(c *tc.C) {
+	_, err := backups.OpenArchive(c.MkDir(), "juju-backup-20260101-000000.tar.gz")
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *restoreSuite) TestOpenArchiveNotValid(c *tc.C) {
+	dir := c.MkDir()
+	for _, filename := range []string{
+		"",
+		"../juju-backup-20260101-000000.tar.gz",
+		"/etc/passwd",
+		"backup.tar.gz",
+	} {
+		_, err := backups.OpenArchive(dir, filename)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid, tc.Commentf("filename %q", filename))
+	}
+}
+
+func (s *restoreSuite) TestRemoveArchive(c *tc.C) {
+	dir := c.MkDir()
+	s.writeArchive(c, dir, "juju-backup-20260101-000000.tar.gz", s.newMetadata(c))
+
+	err := backups.RemoveArchive(dir, "juju-backup-20260101-000000.tar.gz")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = os.Stat(filepath.Join(dir, "juju-backup-20260101-000000.tar.gz"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+
+	err = backups.RemoveArchive(dir, "juju-backup-20260101-000000.tar.gz")
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *restoreSuite) TestValidateRestore(c *tc.C) {
+	meta := s.newMetadata(c)
+	err := backups.ValidateRestore(meta, "controller-uuid", semversion.MustParse("4.0.1"))
+	c.Check(err, tc.ErrorIsNil)
+
+	err = backups.ValidateRestore(meta, "controller-uuid", semversion.MustParse("4.0.3"))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *restoreSuite) TestValidateRestoreFormatVersion(c *tc.C) {
+	meta := s.newMetadata(c)
+	meta.FormatVersion = 0
+	err := backups.ValidateRestore(meta, "controller-uuid", semversion.MustParse("4.0.1"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *restoreSuite) TestValidateRestoreDifferentController(c *tc.C) {
+	meta := s.newMetadata(c)
+	err := backups.ValidateRestore(meta, "other-uuid", semversion.MustParse("4.0.1"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `backup of controller "controller-uuid" cannot be restored into controller "other-uuid".*`)
+}
+
+func (s *restoreSuite) TestValidateRestoreDifferentMinorVersion(c *tc.C) {
+	meta := s.newMetadata(c)
+	err := backups.ValidateRestore(meta, "controller-uuid", semversion.MustParse("4.1.0"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `backup taken with juju 4.0.1 cannot be restored into a juju 4.1 controller.*`)
+}
+
+func (s *restoreSuite) TestValidateRestoreNewerBackup(c *tc.C) {
+	meta := s.newMetadata(c)
+	err := backups.ValidateRestore(meta, "controller-uuid", semversion.MustParse("4.0.0"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `backup taken with juju 4.0.1 is newer than the controller version 4.0.0.*`)
+}
@@ -53,6 +53,11 @@ type Origin struct {
 	Channel  *charm.Channel
 	Platform Platform
 
+	// Publisher is the username of the publisher of the charm in the
+	// repository it was resolved from. It is only set on origins returned
+	// by resolving a charm, and is not persisted.
+	Publisher string
+
 	// InstanceKey is an optional unique string associated with the application.
 	// To assist with keeping KPI data in charmhub, it must be the same for every
 	// charmhub Refresh action for the Refresh api endpoint related to an
@@ -66,6 +66,17 @@ type ClusterDescriber interface {
 	ClusterDetails(context.Context) ([]ClusterNodeInfo, error)
 }
 
+// ClusterManager describes the ability to get cluster details and to change
+// the membership of the cluster.
+type ClusterManager interface {
+	ClusterDescriber
+
+	// RemoveClusterNode demotes the Dqlite node with the input ID to a spare,
+	// so that it no longer takes part in the quorum, and then removes it from
+	// the cluster.
+	RemoveClusterNode(ctx context.Context, id uint64) error
+}
+
 // NodeRole describes the role of a dqlite node.
 type NodeRole string
 
@@ -55,6 +55,32 @@ type FlightRecorder interface {
 
 	// Enabled returns whether the recorder is currently recording.
 	Enabled() bool
+
+	// Observer is used to report observations which may trigger a capture
+	// without an operator asking for one.
+	Observer
+}
+
+// Source identifies what an observation reported to an Observer is about.
+type Source string
+
+const (
+	// SourceTxnLatency is the time taken to run a database transaction.
+	SourceTxnLatency Source = "txn-latency"
+	// SourceAPILatency is the time taken to serve an API request.
+	SourceAPILatency Source = "api-latency"
+)
+
+// Observer is notified of observations which may indicate an anomaly worth
+// capturing a flight recording of. Implementations must be cheap to call,
+// as observations are reported on hot paths.
+type Observer interface {
+	// ObserveLatency reports the time taken by an operation.
+	ObserveLatency(source Source, latency time.Duration)
+
+	// ObserveStart reports the start of the named dependency engine
+	// manifold.
+	ObserveStart(name string)
 }
 
 // FlightRecorderWorker is the interface for a flight recorder worker.
@@ -85,3 +111,9 @@ func (n NoopRecorder) Capture(Kind) error {
 func (n NoopRecorder) Enabled() bool {
 	return false
 }
+
+// ObserveLatency is a no-op.
+func (n NoopRecorder) ObserveLatency(Source, time.Duration) {}
+
+// ObserveStart is a no-op.
+func (n NoopRecorder) ObserveStart(string) {}
@@ -0,0 +1,134 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package flightrecorder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	triggerManifoldRestarts = "manifold-restarts"
+	triggerTxnLatency       = "txn-latency"
+	triggerAPILatency       = "api-latency"
+)
+
+// Triggers holds the conditions which cause a flight recording to be
+// captured automatically. The zero value has no triggers.
+//
+// Triggers are written as a comma separated list, for example:
+//
+//	manifold-restarts=5/1m,txn-latency=2s,api-latency=10s
+//
+// A manifold-restarts trigger fires when any single dependency engine
+// manifold is started the given number of times within the given window.
+// Latency triggers fire when a single operation takes longer than the given
+// duration.
+type Triggers struct {
+	// ManifoldRestarts is the number of starts of a single manifold within
+	// RestartWindow which triggers a capture. Zero disables the trigger.
+	ManifoldRestarts int
+	// RestartWindow is the window over which manifold starts are counted.
+	RestartWindow time.Duration
+
+	// TxnLatency is the database transaction latency above which a capture
+	// is triggered. Zero disables the trigger.
+	TxnLatency time.Duration
+
+	// APILatency is the API request latency above which a capture is
+	// triggered. Zero disables the trigger.
+	APILatency time.Duration
+}
+
+// ParseTriggers parses triggers written in the format described by
+// Triggers. An empty string results in no triggers.
+func ParseTriggers(s string) (Triggers, error) {
+	var triggers Triggers
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return Triggers{}, fmt.Errorf("trigger %q: expected <name>=<value>", field)
+		}
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+
+		var err error
+		switch name {
+		case triggerManifoldRestarts:
+			triggers.ManifoldRestarts, triggers.RestartWindow, err = parseRestarts(value)
+		case triggerTxnLatency:
+			triggers.TxnLatency, err = parseLatency(value)
+		case triggerAPILatency:
+			triggers.APILatency, err = parseLatency(value)
+		default:
+			return Triggers{}, fmt.Errorf("unknown trigger %q", name)
+		}
+		if err != nil {
+			return Triggers{}, fmt.Errorf("trigger %q: %w", name, err)
+		}
+	}
+	return triggers, nil
+}
+
+func parseRestarts(value string) (int, time.Duration, error) {
+	countStr, windowStr, ok := strings.Cut(value, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected <count>/<window>, got %q", value)
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count < 2 {
+		return 0, 0, fmt.Errorf("count %q must be an integer of at least 2", countStr)
+	}
+	window, err := time.ParseDuration(windowStr)
+	if err != nil || window <= 0 {
+		return 0, 0, fmt.Errorf("window %q must be a positive duration", windowStr)
+	}
+	return count, window, nil
+}
+
+func parseLatency(value string) (time.Duration, error) {
+	latency, err := time.ParseDuration(value)
+	if err != nil || latency <= 0 {
+		return 0, fmt.Errorf("latency %q must be a positive duration", value)
+	}
+	return latency, nil
+}
+
+// Enabled returns true if any trigger is set.
+func (t Triggers) Enabled() bool {
+	return t.ManifoldRestarts > 0 || t.TxnLatency > 0 || t.APILatency > 0
+}
+
+// Latency returns the latency threshold for the given source, or zero if
+// there is none.
+func (t Triggers) Latency(source Source) time.Duration {
+	switch source {
+	case SourceTxnLatency:
+		return t.TxnLatency
+	case SourceAPILatency:
+		return t.APILatency
+	default:
+		return 0
+	}
+}
+
+// String returns the triggers in the format accepted by ParseTriggers.
+func (t Triggers) String() string {
+	var fields []string
+	if t.ManifoldRestarts > 0 {
+		fields = append(fields, fmt.Sprintf("%s=%d/%s", triggerManifoldRestarts, t.ManifoldRestarts, t.RestartWindow))
+	}
+	if t.TxnLatency > 0 {
+		fields = append(fields, fmt.Sprintf("%s=%s", triggerTxnLatency, t.TxnLatency))
+	}
+	if t.APILatency > 0 {
+		fields = append(fields, fmt.Sprintf("%s=%s", triggerAPILatency, t.APILatency))
+	}
+	return strings.Join(fields, ",")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package flightrecorder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+)
+
+func TestTriggers(t *testing.T) {
+	tc.Run(t, &triggersSuite{})
+}
+
+type triggersSuite struct{}
+
+func (s *triggersSuite) TestParseTriggers(c *tc.C) {
+	triggers, err := ParseTriggers("manifold-restarts=5/1m, txn-latency=2s,api-latency=10s")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(triggers, tc.DeepEquals, Triggers{
+		ManifoldRestarts: 5,
+		RestartWindow:    time.Minute,
+		TxnLatency:       2 * time.Second,
+		APILatency:       10 * time.Second,
+	})
+	c.Check(triggers.Enabled(), tc.IsTrue)
+	c.Check(triggers.Latency(SourceTxnLatency), tc.Equals, 2*time.Second)
+	c.Check(triggers.Latency(SourceAPILatency), tc.Equals, 10*time.Second)
+	c.Check(triggers.String(), tc.Equals, "manifold-restarts=5/1m0s,txn-latency=2s,api-latency=10s")
+
+	roundTrip, err := ParseTriggers(triggers.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(roundTrip, tc.DeepEquals, triggers)
+}
+
+func (s *triggersSuite) TestParseTriggersEmpty(c *tc.C) {
+	triggers, err := ParseTriggers("")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(triggers, tc.DeepEquals, Triggers{})
+	c.Check(triggers.Enabled(), tc.IsFalse)
+	c.Check(triggers.String(), tc.Equals, "")
+}
+
+func (s *triggersSuite) TestParseTriggersInvalid(c *tc.C) {
+	tests := []struct {
+		input string
+		err   string
+	}{{
+		input: "txn-latency",
+		err:   `trigger "txn-latency": expected <name>=<value>`,
+	}, {
+		input: "disk-full=1s",
+		err:   `unknown trigger "disk-full"`,
+	}, {
+		input: "txn-latency=soon",
+		err:   `trigger "txn-latency": latency "soon" must be a positive duration`,
+	}, {
+		input: "api-latency=-1s",
+		err:   `trigger "api-latency": latency "-1s" must be a positive duration`,
+	}, {
+		input: "manifold-restarts=5",
+		err:   `trigger "manifold-restarts": expected <count>/<window>, got "5"`,
+	}, {
+		input: "manifold-restarts=1/1m",
+		err:   `trigger "manifold-restarts": count "1" must be an integer of at least 2`,
+	}, {
+		input: "manifold-restarts=5/0s",
+		err:   `trigger "manifold-restarts": window "0s" must be a positive duration`,
+	}}
+	for _, test := range tests {
+		c.Logf("input %q", test.input)
+		_, err := ParseTriggers(test.input)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
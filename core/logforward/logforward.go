@@ -0,0 +1,166 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package logforward provides the targets to which the log records of a
+// model are forwarded, as set in the log-forward-targets model config.
+package logforward
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Kind identifies the protocol used to forward log records to a destination.
+type Kind string
+
+const (
+	// KindSyslog forwards records as RFC5424 syslog messages over a TCP
+	// stream, using octet counting framing (RFC6587).
+	KindSyslog Kind = "syslog"
+
+	// KindOTLP forwards records to an OpenTelemetry collector using the
+	// OTLP/HTTP logs protocol with JSON encoding.
+	KindOTLP Kind = "otlp"
+
+	// KindLoki forwards records to the Loki push API.
+	KindLoki Kind = "loki"
+)
+
+const (
+	defaultSyslogTCPPort = "601"
+	defaultSyslogTLSPort = "6514"
+	defaultOTLPPath      = "/v1/logs"
+	defaultLokiPath      = "/loki/api/v1/push"
+)
+
+// Target is a destination to which log records are forwarded.
+type Target struct {
+	// Kind is the protocol used to talk to the destination.
+	Kind Kind
+
+	// TLS is true if the connection to the destination is secured with TLS.
+	TLS bool
+
+	// URL is the location of the destination. For syslog targets only the
+	// host and port are used.
+	URL *url.URL
+}
+
+// String returns the target as it is written in model config, with any
+// password redacted.
+func (t Target) String() string {
+	if t.URL == nil {
+		return ""
+	}
+	return t.URL.Redacted()
+}
+
+// Address returns the host:port of a syslog destination, filling in the
+// default port if one was not given.
+func (t Target) Address() string {
+	if t.URL.Port() != "" {
+		return t.URL.Host
+	}
+	port := defaultSyslogTCPPort
+	if t.TLS {
+		port = defaultSyslogTLSPort
+	}
+	return net.JoinHostPort(t.URL.Hostname(), port)
+}
+
+// Endpoint returns the HTTP URL to which records are posted for OTLP and
+// Loki targets. The default path for the protocol is used if the target
+// doesn't specify one.
+func (t Target) Endpoint() string {
+	u := *t.URL
+	u.Scheme = "http"
+	if t.TLS {
+		u.Scheme = "https"
+	}
+	u.User = nil
+	if u.Path == "" || u.Path == "/" {
+		switch t.Kind {
+		case KindOTLP:
+			u.Path = defaultOTLPPath
+		case KindLoki:
+			u.Path = defaultLokiPath
+		}
+	}
+	return u.String()
+}
+
+// ParseTarget parses a single forwarding target. The scheme of the URL
+// selects the protocol:
+//
+//	syslog://host[:port]           RFC5424 syslog over TCP (default port 601)
+//	syslog+tcp://host[:port]       as above
+//	syslog+tls://host[:port]       RFC5424 syslog over TLS (default port 6514)
+//	otlp+http://host[:port][/path] OTLP/HTTP logs (default path /v1/logs)
+//	otlp+https://host[:port][/path]
+//	loki+http://host[:port][/path] Loki push API (default path /loki/api/v1/push)
+//	loki+https://host[:port][/path]
+//
+// HTTP based targets may include user information, which is sent as basic
+// authentication credentials.
+func ParseTarget(s string) (Target, error) {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return Target{}, errors.Errorf("log forward target %q: %w", s, err).Add(coreerrors.NotValid)
+	}
+	if u.Hostname() == "" {
+		return Target{}, errors.Errorf("log forward target %q: missing host", s).Add(coreerrors.NotValid)
+	}
+
+	var target Target
+	switch u.Scheme {
+	case "syslog", "syslog+tcp":
+		target = Target{Kind: KindSyslog}
+	case "syslog+tls":
+		target = Target{Kind: KindSyslog, TLS: true}
+	case "otlp+http":
+		target = Target{Kind: KindOTLP}
+	case "otlp+https":
+		target = Target{Kind: KindOTLP, TLS: true}
+	case "loki+http":
+		target = Target{Kind: KindLoki}
+	case "loki+https":
+		target = Target{Kind: KindLoki, TLS: true}
+	default:
+		return Target{}, errors.Errorf("log forward target %q: unknown scheme %q", s, u.Scheme).Add(coreerrors.NotValid)
+	}
+	if target.Kind == KindSyslog && (u.User != nil || (u.Path != "" && u.Path != "/")) {
+		return Target{}, errors.Errorf("log forward target %q: syslog targets only accept a host and port", s).Add(coreerrors.NotValid)
+	}
+	target.URL = u
+	return target, nil
+}
+
+// ParseTargets parses a comma separated list of forwarding targets, as
+// found in the log-forward-targets model config. Duplicate targets are
+// removed.
+func ParseTargets(s string) ([]Target, error) {
+	var (
+		targets []Target
+		seen    = make(map[string]bool)
+	)
+	for part := range strings.SplitSeq(s, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		target, err := ParseTarget(part)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		key := target.URL.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
@@ -164,3 +164,37 @@ type ObjectStoreRemover interface {
 	// when the object store has been drained and is no longer needed.
 	RemoveAll(ctx context.Context) error
 }
+
+// ScrubResult describes the outcome of verifying every object held in a
+// namespace of the object store against its recorded metadata.
+type ScrubResult struct {
+	// Checked is the number of objects that were verified.
+	Checked int
+
+	// Bytes is the number of bytes read whilst verifying the objects.
+	Bytes int64
+
+	// Missing is the number of objects that have metadata, but are not held
+	// by the object store.
+	Missing int
+
+	// Corrupt holds the SHA384 hashes of the objects whose content did not
+	// match their metadata.
+	Corrupt []string
+
+	// Repaired holds the SHA384 hashes of the corrupt objects that were
+	// restored from another copy.
+	Repaired []string
+}
+
+// ObjectStoreScrubber is an interface that provides a method to verify the
+// integrity of the data for the namespaced model.
+//
+// It is typically implemented by object stores that support the Scrub
+// method.
+type ObjectStoreScrubber interface {
+	// Scrub re-hashes every object in the namespace and compares it against
+	// the recorded metadata. Objects that do not match are repaired where
+	// another copy is available.
+	Scrub(ctx context.Context) (ScrubResult, error)
+}
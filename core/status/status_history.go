@@ -4,6 +4,8 @@
 package status
 
 import (
+	"regexp"
+	"slices"
 	"time"
 
 	"github.com/juju/collections/set"
@@ -44,6 +46,68 @@ func (f *StatusHistoryFilter) Validate() error {
 	return nil
 }
 
+// StatusHistoryQuery holds arguments to query the status history of all the
+// entities in a model.
+type StatusHistoryQuery struct {
+	// Kinds restricts the query to the given kinds of status. An empty
+	// slice matches every kind. KindUnit matches both the unit agent and
+	// workload statuses.
+	Kinds []HistoryKind
+	// Application restricts the query to the given application and its
+	// units.
+	Application string
+	// Statuses restricts the query to the given status values. An empty
+	// slice matches every status.
+	Statuses []Status
+	// From indicates the earliest time from which statuses are expected.
+	From *time.Time
+	// To indicates the time before which statuses are expected.
+	To *time.Time
+	// Message is a regular expression the status message must match.
+	Message string
+	// Size indicates how many of the most recent results are expected at
+	// most.
+	Size int
+}
+
+// Validate checks that the query is well formed.
+func (q StatusHistoryQuery) Validate() error {
+	for _, kind := range q.Kinds {
+		if !kind.Valid() {
+			return errors.Errorf("status history kind %q %w", kind, coreerrors.NotValid)
+		}
+	}
+	if q.From != nil && q.To != nil && !q.From.Before(*q.To) {
+		return errors.Errorf("from time must be before to time %w", coreerrors.NotValid)
+	}
+	if q.Size < 0 {
+		return errors.Errorf("negative size %w", coreerrors.NotValid)
+	}
+	if _, err := regexp.Compile(q.Message); err != nil {
+		return errors.Errorf("message pattern %q: %w", q.Message, err).Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// MatchesKind returns true if the query matches statuses of the given kind.
+func (q StatusHistoryQuery) MatchesKind(kind HistoryKind) bool {
+	if len(q.Kinds) == 0 || slices.Contains(q.Kinds, kind) {
+		return true
+	}
+	if kind == KindUnitAgent || kind == KindWorkload {
+		return slices.Contains(q.Kinds, KindUnit)
+	}
+	return false
+}
+
+// HistoryEntry holds a status history entry of an entity in a model.
+type HistoryEntry struct {
+	// ID identifies the entity within its kind, for example the unit name
+	// or the machine id.
+	ID string
+	DetailedStatus
+}
+
 // InstanceStatusHistoryGetter instances can fetch their instance status history.
 type InstanceStatusHistoryGetter interface {
 	InstanceStatusHistory(filter StatusHistoryFilter) ([]StatusInfo, error)
@@ -0,0 +1,73 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/status"
+)
+
+type StatusHistoryQuerySuite struct{}
+
+func TestStatusHistoryQuerySuite(t *testing.T) {
+	tc.Run(t, &StatusHistoryQuerySuite{})
+}
+
+func (s *StatusHistoryQuerySuite) TestValidate(c *tc.C) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	valid := status.StatusHistoryQuery{
+		Kinds:    []status.HistoryKind{status.KindUnit, status.KindMachine},
+		Statuses: []status.Status{status.Error},
+		From:     &earlier,
+		To:       &now,
+		Message:  "hook failed: .*",
+		Size:     10,
+	}
+	c.Check(valid.Validate(), tc.ErrorIsNil)
+	c.Check(status.StatusHistoryQuery{}.Validate(), tc.ErrorIsNil)
+
+	tests := []struct {
+		query status.StatusHistoryQuery
+		err   string
+	}{{
+		query: status.StatusHistoryQuery{Kinds: []status.HistoryKind{"bogus"}},
+		err:   `status history kind "bogus" not valid`,
+	}, {
+		query: status.StatusHistoryQuery{From: &now, To: &earlier},
+		err:   `from time must be before to time not valid`,
+	}, {
+		query: status.StatusHistoryQuery{Size: -1},
+		err:   `negative size not valid`,
+	}, {
+		query: status.StatusHistoryQuery{Message: "("},
+		err:   `message pattern "\(": .*`,
+	}}
+	for _, test := range tests {
+		err := test.query.Validate()
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *StatusHistoryQuerySuite) TestMatchesKind(c *tc.C) {
+	all := status.StatusHistoryQuery{}
+	c.Check(all.MatchesKind(status.KindMachine), tc.IsTrue)
+
+	units := status.StatusHistoryQuery{Kinds: []status.HistoryKind{status.KindUnit}}
+	c.Check(units.MatchesKind(status.KindUnit), tc.IsTrue)
+	c.Check(units.MatchesKind(status.KindUnitAgent), tc.IsTrue)
+	c.Check(units.MatchesKind(status.KindWorkload), tc.IsTrue)
+	c.Check(units.MatchesKind(status.KindMachine), tc.IsFalse)
+
+	workload := status.StatusHistoryQuery{Kinds: []status.HistoryKind{status.KindWorkload}}
+	c.Check(workload.MatchesKind(status.KindWorkload), tc.IsTrue)
+	c.Check(workload.MatchesKind(status.KindUnitAgent), tc.IsFalse)
+}
@@ -188,3 +188,20 @@ type UnitStorageRefreshArgs struct {
 	// charm specified in [RefreshCharmUUID].
 	RefreshStorageDirectives []StorageDirective
 }
+
+// StorageSnapshotRestoreInfo describes the parts of a storage snapshot that
+// are required to create new storage from the snapshot.
+type StorageSnapshotRestoreInfo struct {
+	// Kind is the kind of the storage instance the snapshot was taken of.
+	Kind domainstorage.StorageKind
+
+	// SizeMiB is the size of the snapshot.
+	SizeMiB uint64
+
+	// Status is the current status of the snapshot.
+	Status domainstorage.StorageSnapshotStatus
+
+	// StoragePoolUUID is the storage pool of the storage instance the snapshot
+	// was taken of.
+	StoragePoolUUID domainstorage.StoragePoolUUID
+}
@@ -4,6 +4,8 @@
 package internal
 
 import (
+	"time"
+
 	"github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
@@ -33,6 +35,10 @@ type IAASUnitContext struct {
 	// OpenedMachinePortRangesByEndpoint contains the opened machine port ranges
 	// by endpoint for a unit context.
 	OpenedMachinePortRangesByEndpoint map[unit.Name]network.GroupedPortRanges
+	// HookTimeout is how long a hook of the unit may run before it is killed.
+	// It is the application override if one is set, otherwise the model
+	// config. A zero value means that hooks are not timed out.
+	HookTimeout time.Duration
 }
 
 // CAASUnitContext contains the CAAS context information required for the
@@ -47,6 +53,10 @@ type CAASUnitContext struct {
 	// OpenedPortRangesByEndpoint contains the opened port ranges by endpoint
 	// for a unit context.
 	OpenedPortRangesByEndpoint map[unit.Name]network.GroupedPortRanges
+	// HookTimeout is how long a hook of the unit may run before it is killed.
+	// It is the application override if one is set, otherwise the model
+	// config. A zero value means that hooks are not timed out.
+	HookTimeout time.Duration
 }
 
 // UpdateUnitCharmArg contains information required for changing the charm used
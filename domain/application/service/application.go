@@ -7,6 +7,7 @@ import (
 	"context"
 	"maps"
 	"strconv"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/collections/transform"
@@ -1375,6 +1376,7 @@ func (s *Service) GetApplicationAndCharmConfig(ctx context.Context, appUUID core
 		CharmConfig:       decodedCharmConfig,
 		ApplicationConfig: applicationConfig,
 		Trust:             settings.Trust,
+		HookTimeout:       settings.HookTimeout,
 		Principal:         !subordinate,
 	}, nil
 }
@@ -1432,11 +1434,16 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appUUID coreappli
 		return errors.Capture(err)
 	}
 
-	// Grab the application settings, which is currently just the trust setting.
+	// Grab the application settings, which are the trust setting and the
+	// hook timeout override.
 	trust, err := getTrustSettingFromConfig(newConfig)
 	if err != nil {
 		return errors.Capture(err)
 	}
+	hookTimeout, err := getHookTimeoutSettingFromConfig(newConfig)
+	if err != nil {
+		return errors.Capture(err)
+	}
 
 	// Everything else from the newConfig is just application config. Treat it
 	// as such.
@@ -1458,7 +1465,8 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appUUID coreappli
 	}
 
 	return s.st.UpdateApplicationConfigAndSettings(ctx, appUUID, encodedConfig, application.UpdateApplicationSettingsArg{
-		Trust: trust,
+		Trust:       trust,
+		HookTimeout: hookTimeout,
 	})
 }
 
@@ -1781,6 +1789,24 @@ func getTrustSettingFromConfig(cfg map[string]string) (*bool, error) {
 	return &b, nil
 }
 
+func getHookTimeoutSettingFromConfig(cfg map[string]string) (*time.Duration, error) {
+	value, ok := cfg[coreapplication.HookTimeoutConfigOptionName]
+	if !ok {
+		// The hook timeout is not included, so we should not update it.
+		return nil, nil
+	}
+	delete(cfg, coreapplication.HookTimeoutConfigOptionName)
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, errors.Errorf("%w: parsing hook timeout: %w", applicationerrors.InvalidApplicationConfig, err)
+	}
+	if timeout < 0 {
+		return nil, errors.Errorf("%w: negative hook timeout %v", applicationerrors.InvalidApplicationConfig, timeout)
+	}
+	return &timeout, nil
+}
+
 func validateSecretConfig(chCfg internalcharm.ConfigSpec, cfg internalcharm.Config) error {
 	for name, value := range cfg {
 		option, ok := chCfg.Options[name]
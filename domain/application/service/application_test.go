@@ -803,6 +803,49 @@ func (s *applicationServiceSuite) TestUpdateApplicationConfigInvalidTrustType(c
 	c.Assert(err, tc.ErrorMatches, `.*parsing trust setting.*`)
 }
 
+func (s *applicationServiceSuite) TestUpdateApplicationConfigHookTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+
+	s.state.EXPECT().GetCharmConfigByApplicationUUID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{
+			"foo": {
+				Type:    applicationcharm.OptionString,
+				Default: "baz",
+			},
+		},
+	}, nil)
+	s.state.EXPECT().UpdateApplicationConfigAndSettings(gomock.Any(), appUUID, map[string]application.AddApplicationConfig{
+		"foo": {
+			Type:  applicationcharm.OptionString,
+			Value: "bar",
+		},
+	}, application.UpdateApplicationSettingsArg{
+		HookTimeout: new(15 * time.Minute),
+	}).Return(nil)
+
+	err := s.service.UpdateApplicationConfig(c.Context(), appUUID, map[string]string{
+		"hook-timeout": "15m",
+		"foo":          "bar",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigInvalidHookTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+
+	s.state.EXPECT().GetCharmConfigByApplicationUUID(gomock.Any(), appUUID).Return("", applicationcharm.Config{}, nil)
+
+	err := s.service.UpdateApplicationConfig(c.Context(), appUUID, map[string]string{
+		"hook-timeout": "-1m",
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidApplicationConfig)
+	c.Check(err, tc.ErrorMatches, `.*negative hook timeout -1m0s`)
+}
+
 func (s *applicationServiceSuite) TestUpdateApplicationConfigNoConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -3408,6 +3408,45 @@ func (c *MockStateGetSpaceUUIDByNameCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// GetStorageSnapshotRestoreInfo mocks base method.
+func (m *MockState) GetStorageSnapshotRestoreInfo(arg0 context.Context, arg1 storage0.StorageSnapshotUUID) (internal.StorageSnapshotRestoreInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotRestoreInfo", arg0, arg1)
+	ret0, _ := ret[0].(internal.StorageSnapshotRestoreInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotRestoreInfo indicates an expected call of GetStorageSnapshotRestoreInfo.
+func (mr *MockStateMockRecorder) GetStorageSnapshotRestoreInfo(arg0, arg1 any) *MockStateGetStorageSnapshotRestoreInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotRestoreInfo", reflect.TypeOf((*MockState)(nil).GetStorageSnapshotRestoreInfo), arg0, arg1)
+	return &MockStateGetStorageSnapshotRestoreInfoCall{Call: call}
+}
+
+// MockStateGetStorageSnapshotRestoreInfoCall wrap *gomock.Call
+type MockStateGetStorageSnapshotRestoreInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageSnapshotRestoreInfoCall) Return(arg0 internal.StorageSnapshotRestoreInfo, arg1 error) *MockStateGetStorageSnapshotRestoreInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageSnapshotRestoreInfoCall) Do(f func(context.Context, storage0.StorageSnapshotUUID) (internal.StorageSnapshotRestoreInfo, error)) *MockStateGetStorageSnapshotRestoreInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageSnapshotRestoreInfoCall) DoAndReturn(f func(context.Context, storage0.StorageSnapshotUUID) (internal.StorageSnapshotRestoreInfo, error)) *MockStateGetStorageSnapshotRestoreInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitK8sPodInfo mocks base method.
 func (m *MockState) GetUnitK8sPodInfo(arg0 context.Context, arg1 unit.Name) (application0.K8sPodInfo, error) {
 	m.ctrl.T.Helper()
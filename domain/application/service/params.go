@@ -4,6 +4,8 @@
 package service
 
 import (
+	"time"
+
 	coreapplication "github.com/juju/juju/core/application"
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/constraints"
@@ -275,6 +277,7 @@ type ApplicationConfig struct {
 	CharmConfig       internalcharm.ConfigSpec
 	ApplicationConfig internalcharm.Config
 	Trust             bool
+	HookTimeout       *time.Duration
 	CharmName         string
 	Principal         bool
 }
@@ -30,6 +30,7 @@ import (
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	applicationinternal "github.com/juju/juju/domain/application/internal"
 	"github.com/juju/juju/domain/application/service/storage"
 	"github.com/juju/juju/domain/constraints"
 	"github.com/juju/juju/domain/deployment"
@@ -1073,6 +1074,24 @@ func (s *ProviderService) populateAddStorageArgs(
 	}
 
 	storageDirective := unitStorageDirective
+	if arg.SnapshotUUID != nil {
+		// Storage created from a snapshot defaults to the pool and size of
+		// the storage the snapshot was taken of.
+		snapshot, err := s.getStorageSnapshotForRestore(
+			ctx, *arg.SnapshotUUID, charmStorage.Type,
+		)
+		if err != nil {
+			return domainstorage.UnitAddStorageArg{}, errors.Capture(err)
+		}
+		storageDirective.PoolUUID = snapshot.StoragePoolUUID
+		storageDirective.Size = max(storageDirective.Size, snapshot.SizeMiB)
+		if arg.SizeMiB != nil && *arg.SizeMiB < snapshot.SizeMiB {
+			return domainstorage.UnitAddStorageArg{}, errors.Errorf(
+				"storage size %dMiB is smaller than snapshot size %dMiB",
+				*arg.SizeMiB, snapshot.SizeMiB,
+			).Add(coreerrors.NotValid)
+		}
+	}
 	if arg.StoragePoolUUID != nil {
 		storageDirective.PoolUUID = *arg.StoragePoolUUID
 	}
@@ -1102,6 +1121,17 @@ func (s *ProviderService) populateAddStorageArgs(
 	if err != nil {
 		return domainstorage.UnitAddStorageArg{}, errors.Capture(err)
 	}
+	if arg.SnapshotUUID != nil {
+		for _, inst := range args.StorageInstances {
+			if inst.Volume == nil {
+				return domainstorage.UnitAddStorageArg{}, errors.Errorf(
+					"storage pool for %q does not provision volumes to restore snapshot %q to",
+					storageName, *arg.SnapshotUUID,
+				).Add(coreerrors.NotSupported)
+			}
+			inst.Volume.SnapshotUUID = arg.SnapshotUUID
+		}
+	}
 	// Record the max allowed count precondition.
 	// This will be checked inside the transaction.
 	args.CountLessThanEqual = uint32(math.MaxUint32)
@@ -1111,6 +1141,48 @@ func (s *ProviderService) populateAddStorageArgs(
 	return args, nil
 }
 
+// getStorageSnapshotForRestore returns the storage snapshot that new storage
+// of the supplied charm storage type is to be created from, checking that the
+// snapshot can be restored to storage of that type.
+func (s *ProviderService) getStorageSnapshotForRestore(
+	ctx context.Context,
+	uuid domainstorage.StorageSnapshotUUID,
+	storageType internalcharm.StorageType,
+) (applicationinternal.StorageSnapshotRestoreInfo, error) {
+	if err := uuid.Validate(); err != nil {
+		return applicationinternal.StorageSnapshotRestoreInfo{}, errors.Errorf(
+			"storage snapshot uuid: %w", err,
+		).Add(coreerrors.NotValid)
+	}
+
+	snapshot, err := s.st.GetStorageSnapshotRestoreInfo(ctx, uuid)
+	if err != nil {
+		return applicationinternal.StorageSnapshotRestoreInfo{}, errors.Errorf(
+			"getting storage snapshot %q: %w", uuid, err,
+		)
+	}
+	if snapshot.Status != domainstorage.StorageSnapshotStatusAvailable {
+		return applicationinternal.StorageSnapshotRestoreInfo{}, errors.Errorf(
+			"storage snapshot %q is %s", uuid, snapshot.Status,
+		).Add(storageerrors.StorageSnapshotNotAvailable)
+	}
+
+	var wantKind domainstorage.StorageKind
+	switch storageType {
+	case internalcharm.StorageBlock:
+		wantKind = domainstorage.StorageKindBlock
+	case internalcharm.StorageFilesystem:
+		wantKind = domainstorage.StorageKindFilesystem
+	}
+	if snapshot.Kind != wantKind {
+		return applicationinternal.StorageSnapshotRestoreInfo{}, errors.Errorf(
+			"storage snapshot %q cannot be restored to %s storage",
+			uuid, storageType,
+		).Add(coreerrors.NotValid)
+	}
+	return snapshot, nil
+}
+
 // AddStorageForIAASUnit adds storage instances to the given IAAS unit.
 // The following error types can be expected:
 // - [github.com/juju/juju/core/storage.InvalidStorageName]: when the storage
@@ -1123,6 +1195,10 @@ func (s *ProviderService) populateAddStorageArgs(
 // when storage name is not defined in charm metadata.
 // - [github.com/juju/juju/domain/application/errors.StorageCountLimitExceeded]
 // when the requested storage falls outside of the bounds defined by the charm.
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]: when
+// the storage snapshot to create the storage from does not exist.
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotAvailable]:
+// when the storage snapshot to create the storage from has not been taken.
 func (s *ProviderService) AddStorageForIAASUnit(
 	ctx context.Context, storageName corestorage.Name, unitUUID coreunit.UUID,
 	count uint32, arg domainstorage.AddUnitStorageOverride,
@@ -1170,6 +1246,10 @@ func (s *ProviderService) AddStorageForIAASUnit(
 // when storage name is not defined in charm metadata.
 // - [github.com/juju/juju/domain/application/errors.StorageCountLimitExceeded]
 // when the requested storage falls outside of the bounds defined by the charm.
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]: when
+// the storage snapshot to create the storage from does not exist.
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotAvailable]:
+// when the storage snapshot to create the storage from has not been taken.
 func (s *ProviderService) AddStorageForCAASUnit(
 	ctx context.Context, storageName corestorage.Name, unitUUID coreunit.UUID,
 	count uint32, arg domainstorage.AddUnitStorageOverride,
@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/juju/proxy"
 
@@ -228,6 +229,13 @@ type UnitState interface {
 	// - [github.com/juju/juju/domain/application/errors.StorageNameNotSupported]: when storage name is not defined in charm metadata.
 	GetCharmStorageAndInstanceCountByUnitUUID(ctx context.Context, unitUUID coreunit.UUID, storageName corestorage.Name) (internalcharm.Storage, uint32, error)
 
+	// GetStorageSnapshotRestoreInfo returns the parts of the storage snapshot
+	// required to create new storage from the snapshot.
+	// The following error types can be expected:
+	// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]:
+	// when the storage snapshot does not exist.
+	GetStorageSnapshotRestoreInfo(ctx context.Context, uuid domainstorage.StorageSnapshotUUID) (applicationinternal.StorageSnapshotRestoreInfo, error)
+
 	// AddStorageForCAASUnit adds storage instances to given unit as specified.
 	// The specified storage name is used to retrieve existing storage instances.
 	// Combination of existing storage instances and anticipated additional storage
@@ -889,6 +897,7 @@ type IAASUnitContext struct {
 	JujuProxySettings                 proxy.Settings
 	PrivateAddress                    *string
 	OpenedMachinePortRangesByEndpoint map[coreunit.Name]network.GroupedPortRanges
+	HookTimeout                       time.Duration
 }
 
 // GetIAASUnitContext returns IAAS context information required for the
@@ -926,6 +935,7 @@ func (s *ProviderService) GetIAASUnitContext(ctx context.Context, unitName coreu
 		JujuProxySettings:                 encodeProxySettings(result.JujuProxySettings),
 		PrivateAddress:                    privateAddress,
 		OpenedMachinePortRangesByEndpoint: result.OpenedMachinePortRangesByEndpoint,
+		HookTimeout:                       result.HookTimeout,
 	}, nil
 }
 
@@ -955,6 +965,7 @@ type CAASUnitContext struct {
 	LegacyProxySettings        proxy.Settings
 	JujuProxySettings          proxy.Settings
 	OpenedPortRangesByEndpoint map[coreunit.Name]network.GroupedPortRanges
+	HookTimeout                time.Duration
 }
 
 // GetCAASUnitContext returns CAAS context information required for the
@@ -986,6 +997,7 @@ func (s *ProviderService) GetCAASUnitContext(ctx context.Context, unitName coreu
 		LegacyProxySettings:        encodeProxySettings(result.LegacyProxySettings),
 		JujuProxySettings:          encodeProxySettings(result.JujuProxySettings),
 		OpenedPortRangesByEndpoint: result.OpenedPortRangesByEndpoint,
+		HookTimeout:                result.HookTimeout,
 	}, nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/collections/transform"
@@ -2292,7 +2293,8 @@ func (st *State) GetApplicationConfigAndSettings(ctx context.Context, appID core
 		}
 	}
 	return result, application.ApplicationSettings{
-		Trust: settings.Trust,
+		Trust:       settings.Trust,
+		HookTimeout: decodeHookTimeout(settings.HookTimeoutSeconds),
 	}, nil
 }
 
@@ -2391,6 +2393,15 @@ WHERE  application_uuid = $entityUUID.uuid;`
 	return settings.Trust, nil
 }
 
+// upsertHookTimeoutQuery sets the hook timeout override of an application,
+// leaving its other settings untouched.
+const upsertHookTimeoutQuery = `
+INSERT INTO application_setting (*)
+VALUES ($setApplicationHookTimeout.*)
+ON CONFLICT(application_uuid) DO UPDATE SET
+    hook_timeout_seconds = excluded.hook_timeout_seconds;
+`
+
 // UpdateApplicationConfigAndSettings updates the application config attributes
 // using the configuration.
 func (st *State) UpdateApplicationConfigAndSettings(
@@ -2427,6 +2438,10 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 	if err != nil {
 		return errors.Errorf("preparing upsert settings query: %w", err)
 	}
+	upsertHookTimeoutStmt, err := st.Prepare(upsertHookTimeoutQuery, setApplicationHookTimeout{})
+	if err != nil {
+		return errors.Errorf("preparing upsert hook timeout query: %w", err)
+	}
 
 	upserts := make([]setApplicationConfig, 0, len(config))
 	for k, cfgVal := range config {
@@ -2462,6 +2477,15 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 			}
 		}
 
+		if settings.HookTimeout != nil {
+			if err := tx.Query(ctx, upsertHookTimeoutStmt, setApplicationHookTimeout{
+				ApplicationUUID:    appID.String(),
+				HookTimeoutSeconds: encodeHookTimeout(settings.HookTimeout),
+			}).Run(); err != nil {
+				return errors.Errorf("upserting hook timeout: %w", err)
+			}
+		}
+
 		if err := st.updateConfigHash(ctx, tx, ident); err != nil {
 			return errors.Errorf("refreshing config hash: %w", err)
 		}
@@ -2518,12 +2542,17 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 	if err != nil {
 		return errors.Errorf("preparing query for application config: %w", err)
 	}
+	hookTimeoutStmt, err := st.Prepare(upsertHookTimeoutQuery, setApplicationHookTimeout{})
+	if err != nil {
+		return errors.Errorf("preparing query for application hook timeout: %w", err)
+	}
 
 	removals := make(sqlair.S, len(keys))
 	for i, k := range keys {
 		removals[i] = k
 	}
 	removeTrust := slices.Contains(keys, "trust")
+	removeHookTimeout := slices.Contains(keys, coreapplication.HookTimeoutConfigOptionName)
 
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		if err := tx.Query(ctx, appStmt, ident).Get(&ident); errors.Is(err, sqlair.ErrNoRows) {
@@ -2538,15 +2567,22 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 			return errors.Errorf("deleting config: %w", err)
 		}
 
-		if !removeTrust {
-			return nil
+		if removeTrust {
+			if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
+				ApplicationUUID: ident.UUID,
+				Trust:           false,
+			}).Run(); err != nil {
+				return errors.Errorf("deleting setting: %w", err)
+			}
 		}
 
-		if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
-			ApplicationUUID: ident.UUID,
-			Trust:           false,
-		}).Run(); err != nil {
-			return errors.Errorf("deleting setting: %w", err)
+		if removeHookTimeout {
+			// A NULL hook timeout means that the model config applies.
+			if err := tx.Query(ctx, hookTimeoutStmt, setApplicationHookTimeout{
+				ApplicationUUID: ident.UUID,
+			}).Run(); err != nil {
+				return errors.Errorf("deleting hook timeout setting: %w", err)
+			}
 		}
 
 		return nil
@@ -3647,6 +3683,25 @@ func hashConfigAndSettings(config []applicationConfig, settings applicationSetti
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
+// encodeHookTimeout converts a hook timeout override to the whole seconds
+// stored in the application_setting table.
+func encodeHookTimeout(timeout *time.Duration) sql.Null[int64] {
+	if timeout == nil {
+		return sql.Null[int64]{}
+	}
+	return sql.Null[int64]{V: int64(*timeout / time.Second), Valid: true}
+}
+
+// decodeHookTimeout converts the hook timeout seconds stored in the
+// application_setting table to a hook timeout override.
+func decodeHookTimeout(seconds sql.Null[int64]) *time.Duration {
+	if !seconds.Valid {
+		return nil
+	}
+	timeout := time.Duration(seconds.V) * time.Second
+	return &timeout
+}
+
 func decodePlatform(channel string, os, arch sql.Null[int64]) (deployment.Platform, error) {
 	osType, err := decodeOSType(os)
 	if err != nil {
@@ -2890,6 +2890,37 @@ func (s *applicationStateSuite) TestUpdateApplicationConfigAndSettingsupdatesTru
 	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{Trust: true})
 }
 
+func (s *applicationStateSuite) TestUpdateApplicationConfigAndSettingsUpdatesHookTimeout(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.UpdateApplicationConfigAndSettings(c.Context(), id, map[string]application.AddApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			HookTimeout: new(10 * time.Minute),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, settings, err := s.state.GetApplicationConfigAndSettings(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{
+		HookTimeout: new(10 * time.Minute),
+	})
+
+	// Updating the trust setting must leave the hook timeout untouched.
+
+	err = s.state.UpdateApplicationConfigAndSettings(c.Context(), id, map[string]application.AddApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			Trust: new(true),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, settings, err = s.state.GetApplicationConfigAndSettings(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{
+		Trust:       true,
+		HookTimeout: new(10 * time.Minute),
+	})
+}
+
 func (s *applicationStateSuite) TestUnsetApplicationConfigKeys(c *tc.C) {
 	id := s.createIAASApplication(c, "foo", life.Alive)
 
@@ -2951,6 +2982,28 @@ func (s *applicationStateSuite) TestUnsetApplicationConfigKeysIncludingTrust(c *
 	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{})
 }
 
+func (s *applicationStateSuite) TestUnsetApplicationConfigKeysIncludingHookTimeout(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.UpdateApplicationConfigAndSettings(c.Context(), id,
+		map[string]application.AddApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			Trust:       new(true),
+			HookTimeout: new(time.Hour),
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.UnsetApplicationConfigKeys(c.Context(), id, []string{"hook-timeout"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, settings, err := s.state.GetApplicationConfigAndSettings(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{
+		Trust: true,
+	})
+}
+
 func (s *applicationStateSuite) TestUnsetApplicationConfigKeysIgnoredKeys(c *tc.C) {
 	id := s.createIAASApplication(c, "foo", life.Alive)
 
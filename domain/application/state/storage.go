@@ -1641,3 +1641,52 @@ func (st *State) attachStorage(
 //	}
 //	return nil
 // }
+
+// GetStorageSnapshotRestoreInfo returns the parts of the storage snapshot
+// required to create new storage from the snapshot.
+//
+// The following errors may be returned:
+// - [storageerrors.StorageSnapshotNotFound] when no storage snapshot exists
+// for the supplied uuid.
+func (st *State) GetStorageSnapshotRestoreInfo(
+	ctx context.Context, uuid domainstorage.StorageSnapshotUUID,
+) (internal.StorageSnapshotRestoreInfo, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return internal.StorageSnapshotRestoreInfo{}, errors.Capture(err)
+	}
+
+	var (
+		input = entityUUID{UUID: uuid.String()}
+		dbVal storageSnapshotRestoreInfo
+	)
+	stmt, err := st.Prepare(`
+SELECT &storageSnapshotRestoreInfo.*
+FROM   storage_snapshot
+WHERE  uuid = $entityUUID.uuid`,
+		input, dbVal,
+	)
+	if err != nil {
+		return internal.StorageSnapshotRestoreInfo{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, input).Get(&dbVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage snapshot %q does not exist", uuid,
+			).Add(storageerrors.StorageSnapshotNotFound)
+		}
+		return err
+	})
+	if err != nil {
+		return internal.StorageSnapshotRestoreInfo{}, errors.Capture(err)
+	}
+
+	return internal.StorageSnapshotRestoreInfo{
+		Kind:            domainstorage.StorageKind(dbVal.StorageKindID),
+		SizeMiB:         uint64(dbVal.SizeMiB.Int64),
+		Status:          domainstorage.StorageSnapshotStatus(dbVal.StatusID),
+		StoragePoolUUID: domainstorage.StoragePoolUUID(dbVal.StoragePoolUUID),
+	}, nil
+}
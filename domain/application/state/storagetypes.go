@@ -82,10 +82,11 @@ type insertStorageInstanceAttachment struct {
 // insertStorageVolume represents the set of values required for inserting a
 // new storage volume into the model.
 type insertStorageVolume struct {
-	LifeID           int    `db:"life_id"`
-	UUID             string `db:"uuid"`
-	VolumeID         string `db:"volume_id"`
-	ProvisionScopeID int    `db:"provision_scope_id"`
+	LifeID           int            `db:"life_id"`
+	UUID             string         `db:"uuid"`
+	VolumeID         string         `db:"volume_id"`
+	ProvisionScopeID int            `db:"provision_scope_id"`
+	SnapshotUUID     sql.NullString `db:"snapshot_uuid"`
 }
 
 // insertStorageVolumeAttachment represents the set of values required for
@@ -114,6 +115,15 @@ type insertStorageVolumeStatus struct {
 	UpdateAt   time.Time `db:"updated_at"`
 }
 
+// storageSnapshotRestoreInfo represents the values of a storage snapshot
+// required to create new storage from the snapshot.
+type storageSnapshotRestoreInfo struct {
+	StorageKindID   int           `db:"storage_kind_id"`
+	StoragePoolUUID string        `db:"storage_pool_uuid"`
+	StatusID        int           `db:"status_id"`
+	SizeMiB         sql.NullInt64 `db:"size_mib"`
+}
+
 // storageProviderIDs  represents a list of provider ids that have been given to
 // either a volume or filesystem in the model.
 type storageProviderIDs []string
@@ -762,7 +762,8 @@ type setApplicationConfig struct {
 }
 
 type applicationSettings struct {
-	Trust bool `db:"trust"`
+	Trust              bool            `db:"trust"`
+	HookTimeoutSeconds sql.Null[int64] `db:"hook_timeout_seconds"`
 }
 
 type setApplicationSettings struct {
@@ -770,6 +771,11 @@ type setApplicationSettings struct {
 	Trust           bool   `db:"trust"`
 }
 
+type setApplicationHookTimeout struct {
+	ApplicationUUID    string          `db:"application_uuid"`
+	HookTimeoutSeconds sql.Null[int64] `db:"hook_timeout_seconds"`
+}
+
 type applicationConfigHash struct {
 	ApplicationUUID coreapplication.UUID `db:"application_uuid"`
 	SHA256          string               `db:"sha256"`
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/collections/transform"
@@ -2091,6 +2092,7 @@ func (st *State) GetIAASUnitContext(ctx context.Context, unitName string) (appli
 		legacyProxySettings, jujuProxySettings applicationinternal.ProxySettings
 		machineOpenedPortRanges                []unitEndpointOpenedPortRange
 		unitAddress                            *string
+		hookTimeout                            time.Duration
 	)
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		netNodeUUID, err := st.getNonDeadUnitNetNodeByUnitName(ctx, tx, unitName)
@@ -2118,6 +2120,11 @@ func (st *State) GetIAASUnitContext(ctx context.Context, unitName string) (appli
 			return errors.Errorf("getting private address for unit: %w", err)
 		}
 
+		hookTimeout, err = st.getUnitHookTimeout(ctx, tx, unitName)
+		if err != nil {
+			return errors.Errorf("getting hook timeout for unit: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -2137,6 +2144,7 @@ func (st *State) GetIAASUnitContext(ctx context.Context, unitName string) (appli
 		JujuProxySettings:                 jujuProxySettings,
 		OpenedMachinePortRangesByEndpoint: decoded.ByUnitByEndpoint(),
 		PrivateAddress:                    unitAddress,
+		HookTimeout:                       hookTimeout,
 	}, nil
 }
 
@@ -2154,6 +2162,7 @@ func (st *State) GetCAASUnitContext(ctx context.Context, unitName string) (appli
 	var (
 		legacyProxySettings, jujuProxySettings applicationinternal.ProxySettings
 		unitOpenedPortRanges                   []unitEndpointOpenedPortRange
+		hookTimeout                            time.Duration
 	)
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		if err := st.checkUnitNotDeadByName(ctx, tx, unitName); err != nil {
@@ -2175,6 +2184,11 @@ func (st *State) GetCAASUnitContext(ctx context.Context, unitName string) (appli
 			return errors.Errorf("getting machine opened port ranges: %w", err)
 		}
 
+		hookTimeout, err = st.getUnitHookTimeout(ctx, tx, unitName)
+		if err != nil {
+			return errors.Errorf("getting hook timeout for unit: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -2192,6 +2206,7 @@ func (st *State) GetCAASUnitContext(ctx context.Context, unitName string) (appli
 		LegacyProxySettings:        legacyProxySettings,
 		JujuProxySettings:          jujuProxySettings,
 		OpenedPortRangesByEndpoint: decoded.ByUnitByEndpoint(),
+		HookTimeout:                hookTimeout,
 	}, nil
 }
 
@@ -2269,6 +2284,56 @@ WHERE key IN ('juju-http-proxy', 'juju-https-proxy', 'juju-ftp-proxy', 'juju-no-
 	return proxySettings, nil
 }
 
+// getUnitHookTimeout returns how long a hook of the unit may run. The hook
+// timeout override of the unit's application takes precedence over the
+// hook-timeout model config.
+func (st *State) getUnitHookTimeout(ctx context.Context, tx *sqlair.TX, name string) (time.Duration, error) {
+	type modelConfig struct {
+		Value string `db:"value"`
+	}
+
+	ident := unitName{Name: name}
+	appStmt, err := st.Prepare(`
+SELECT aset.hook_timeout_seconds AS &applicationSettings.hook_timeout_seconds
+FROM   unit AS u
+JOIN   application_setting AS aset ON aset.application_uuid = u.application_uuid
+WHERE  u.name = $unitName.name
+`, applicationSettings{}, ident)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	modelStmt, err := st.Prepare(`
+SELECT &modelConfig.value
+FROM   model_config
+WHERE  key = 'hook-timeout'
+`, modelConfig{})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var settings applicationSettings
+	err = tx.Query(ctx, appStmt, ident).Get(&settings)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return 0, errors.Errorf("querying application hook timeout: %w", err)
+	}
+	if timeout := decodeHookTimeout(settings.HookTimeoutSeconds); timeout != nil {
+		return *timeout, nil
+	}
+
+	var config modelConfig
+	err = tx.Query(ctx, modelStmt).Get(&config)
+	if errors.Is(err, sqlair.ErrNoRows) || (err == nil && config.Value == "") {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Errorf("querying model hook timeout: %w", err)
+	}
+	timeout, err := time.ParseDuration(config.Value)
+	if err != nil {
+		return 0, errors.Errorf("parsing model hook timeout %q: %w", config.Value, err)
+	}
+	return timeout, nil
+}
+
 type unitEndpointOpenedPortRange struct {
 	UnitName coreunit.Name `db:"unit_name"`
 	Protocol string        `db:"protocol"`
@@ -2093,6 +2093,38 @@ func (s *unitStateSuite) TestGetIAASUnitContext(c *tc.C) {
 	c.Check(result.OpenedMachinePortRangesByEndpoint, tc.NotNil)
 }
 
+func (s *unitStateSuite) TestGetIAASUnitContextHookTimeout(c *tc.C) {
+	// Arrange: Create an IAAS unit and set the model hook timeout.
+	appID, unitUUIDs := s.createIAASApplicationWithNUnits(c, "foo", life.Alive, 1)
+	unitName, err := s.state.GetUnitNameForUUID(c.Context(), unitUUIDs[0])
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "INSERT INTO model_config (key, value) VALUES ('hook-timeout', '30m')")
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act: Get the IAAS unit context
+	result, err := s.state.GetIAASUnitContext(c.Context(), unitName.String())
+
+	// Assert: The model hook timeout applies.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.HookTimeout, tc.Equals, 30*time.Minute)
+
+	// Arrange: Override the hook timeout for the application.
+	err = s.state.UpdateApplicationConfigAndSettings(c.Context(), appID, nil, application.UpdateApplicationSettingsArg{
+		HookTimeout: new(5 * time.Minute),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act: Get the IAAS unit context
+	result, err = s.state.GetIAASUnitContext(c.Context(), unitName.String())
+
+	// Assert: The application override takes precedence.
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.HookTimeout, tc.Equals, 5*time.Minute)
+}
+
 func (s *unitStateSuite) TestGetIAASUnitContextNotFound(c *tc.C) {
 	// Act & Assert: Try to get context for non-existent unit
 	_, err := s.state.GetIAASUnitContext(c.Context(), "nonexistent/0")
@@ -1381,11 +1381,19 @@ func (st *InsertIAASUnitState) makeInsertUnitVolumeArgs(
 	statusTime := st.clock.Now().UTC()
 	for i, argIndex := range argIndexes {
 		instArg := args[argIndex]
+		var snapshotUUID sql.NullString
+		if instArg.Volume.SnapshotUUID != nil {
+			snapshotUUID = sql.NullString{
+				String: instArg.Volume.SnapshotUUID.String(),
+				Valid:  true,
+			}
+		}
 		vRval = append(vRval, insertStorageVolume{
 			VolumeID:         fmt.Sprintf("%d", fsIDS[i]),
 			LifeID:           int(life.Alive),
 			UUID:             instArg.Volume.UUID.String(),
 			ProvisionScopeID: int(instArg.Volume.ProvisionScope),
+			SnapshotUUID:     snapshotUUID,
 		})
 		vInstanceRval = append(vInstanceRval, insertStorageVolumeInstance{
 			StorageInstanceUUID: instArg.UUID.String(),
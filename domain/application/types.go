@@ -4,6 +4,8 @@
 package application
 
 import (
+	"time"
+
 	"github.com/juju/collections/set"
 
 	"github.com/juju/juju/core/application"
@@ -370,12 +372,16 @@ type AddApplicationConfig struct {
 // ApplicationSettings contains the settings for an application.
 type ApplicationSettings struct {
 	Trust bool
+	// HookTimeout overrides the hook-timeout model config for the units of
+	// the application. A nil value means the model config applies.
+	HookTimeout *time.Duration
 }
 
 // UpdateApplicationSettingsArg is the argument used to update an application's
 // settings
 type UpdateApplicationSettingsArg struct {
-	Trust *bool
+	Trust       *bool
+	HookTimeout *time.Duration
 }
 
 // ExposedEndpoint encapsulates the expose-related details of a particular
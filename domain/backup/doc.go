@@ -0,0 +1,8 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package backup provides the dumps of the controller and model databases
+// from which controller backups are made. A dump holds the schema and content
+// of a database as SQL statements, and is replayed into the database by the
+// controller agent when a backup is restored.
+package backup
@@ -0,0 +1,39 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State describes retrieval of the dump of a database.
+type State interface {
+	// DumpDatabase writes the schema and content of the database to w.
+	DumpDatabase(ctx context.Context, w io.Writer) error
+}
+
+// Service provides the dump of a controller or model database for backups.
+type Service struct {
+	st State
+}
+
+// NewService returns a new Service for dumping the database.
+func NewService(st State) *Service {
+	return &Service{
+		st: st,
+	}
+}
+
+// DumpDatabase writes the schema and content of the database to w, in the
+// form replayed into the database when a backup is restored.
+func (s *Service) DumpDatabase(ctx context.Context, w io.Writer) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return errors.Capture(s.st.DumpDatabase(ctx, w))
+}
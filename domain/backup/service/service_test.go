@@ -0,0 +1,53 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+type serviceSuite struct {
+	state *MockState
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	return ctrl
+}
+
+func (s *serviceSuite) TestDumpDatabase(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DumpDatabase(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w io.Writer) error {
+		_, err := w.Write([]byte("dump"))
+		return err
+	})
+
+	var buf bytes.Buffer
+	err := NewService(s.state).DumpDatabase(c.Context(), &buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(buf.String(), tc.Equals, "dump")
+}
+
+func (s *serviceSuite) TestDumpDatabaseError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DumpDatabase(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	err := NewService(s.state).DumpDatabase(c.Context(), io.Discard)
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/backup/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/backup/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// DumpDatabase mocks base method.
+func (m *MockState) DumpDatabase(arg0 context.Context, arg1 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpDatabase", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DumpDatabase indicates an expected call of DumpDatabase.
+func (mr *MockStateMockRecorder) DumpDatabase(arg0, arg1 any) *MockStateDumpDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpDatabase", reflect.TypeOf((*MockState)(nil).DumpDatabase), arg0, arg1)
+	return &MockStateDumpDatabaseCall{Call: call}
+}
+
+// MockStateDumpDatabaseCall wrap *gomock.Call
+type MockStateDumpDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDumpDatabaseCall) Return(arg0 error) *MockStateDumpDatabaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDumpDatabaseCall) Do(f func(context.Context, io.Writer) error) *MockStateDumpDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDumpDatabaseCall) DoAndReturn(f func(context.Context, io.Writer) error) *MockStateDumpDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State provides the dump of a controller or model database.
+//
+// Unlike other domain state, the dump reads the database schema and content
+// without sqlair, so State uses the underlying transaction runner rather than
+// the domain state base.
+type State struct {
+	getDB coredatabase.TxnRunnerFactory
+}
+
+// NewState returns a new state reference.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		getDB: factory,
+	}
+}
+
+// DumpDatabase writes the schema and content of the database to w, as read
+// in a single transaction.
+func (st *State) DumpDatabase(ctx context.Context, w io.Writer) error {
+	db, err := st.getDB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var buf bytes.Buffer
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// The transaction may be retried, so only the dump read by the
+		// attempt that succeeds is kept.
+		buf.Reset()
+		return database.DumpDB(ctx, tx, &buf)
+	})
+	if err != nil {
+		return errors.Errorf("dumping database: %w", err)
+	}
+	_, err = io.Copy(w, &buf)
+	return errors.Capture(err)
+}
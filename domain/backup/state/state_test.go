@@ -0,0 +1,42 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/database"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) TestDumpDatabase(c *tc.C) {
+	_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO model_config (key, value) VALUES ('name', 'foo')`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var dump bytes.Buffer
+	err = NewState(s.TxnRunnerFactory()).DumpDatabase(c.Context(), &dump)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The dump recreates the model database.
+	_, target := s.OpenDB(c)
+	err = database.LoadDB(c.Context(), target, &dump)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var value string
+	err = target.QueryRowContext(c.Context(), "SELECT value FROM model_config WHERE key = 'name'").Scan(&value)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value, tc.Equals, "foo")
+}
@@ -21,4 +21,12 @@ const (
 	// EmptyAPIAddresses describes an error that occurs when no API addresses
 	// are found.
 	EmptyAPIAddresses = errors.ConstError("no API addresses found")
+
+	// LastControllerNode describes an error that occurs when removing the
+	// only remaining controller node is attempted.
+	LastControllerNode = errors.ConstError("cannot remove the last controller node")
+
+	// QuorumLoss describes an error that occurs when removing a controller
+	// node would leave the Dqlite cluster without a majority of its voters.
+	QuorumLoss = errors.ConstError("removing controller node would lose Dqlite quorum")
 )
@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/controllernode"
+	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ClusterState describes retrieval and persistence methods for the
+// membership of controller nodes in the Dqlite cluster.
+type ClusterState interface {
+	// GetControllerNodes returns the controller ID and Dqlite node ID of
+	// every controller node.
+	GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error)
+
+	// DeleteDqliteNodes removes controller nodes from the controller_node
+	// table.
+	DeleteDqliteNodes(ctx context.Context, delete []string) error
+}
+
+// ClusterService provides the API for managing the membership of controller
+// nodes in the Dqlite cluster.
+type ClusterService struct {
+	st      ClusterState
+	cluster database.ClusterManager
+	logger  logger.Logger
+}
+
+// NewClusterService returns a new ClusterService.
+func NewClusterService(st ClusterState, cluster database.ClusterManager, logger logger.Logger) *ClusterService {
+	return &ClusterService{
+		st:      st,
+		cluster: cluster,
+		logger:  logger,
+	}
+}
+
+// GetClusterNodes returns every controller node, ordered by controller ID,
+// along with the role of its Dqlite node in the cluster.
+//
+// The following errors can be expected:
+//   - [controllernodeerrors.EmptyControllerIDs] if there are no controller
+//     nodes.
+func (s *ClusterService) GetClusterNodes(ctx context.Context) ([]controllernode.ClusterNode, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	controllerNodes, err := s.st.GetControllerNodes(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting controller nodes: %w", err)
+	}
+
+	members, err := s.cluster.ClusterDetails(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting cluster details: %w", err)
+	}
+	roles := make(map[uint64]database.NodeRole, len(members))
+	for _, member := range members {
+		roles[member.ID] = member.Role
+	}
+
+	nodes := make([]controllernode.ClusterNode, len(controllerNodes))
+	for i, node := range controllerNodes {
+		nodes[i] = controllernode.ClusterNode{
+			ControllerNode: node,
+			Role:           roles[node.DqliteNodeID],
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ControllerID < nodes[j].ControllerID
+	})
+	return nodes, nil
+}
+
+// RemoveControllerNode removes the Dqlite node of the input controller from
+// the Dqlite cluster, and then removes the controller node. The node is first
+// demoted, so that it no longer takes part in the quorum.
+//
+// A voting node is only removed if a majority of the current voters remain,
+// so that the demotion can be committed even if the node being removed is
+// unreachable. A node that isn't a member of the cluster is just removed
+// from the controller nodes.
+//
+// The following errors can be expected:
+//   - [controllernodeerrors.NotFound] if the controller node doesn't exist.
+//   - [controllernodeerrors.LastControllerNode] if the controller node is the
+//     only one.
+//   - [controllernodeerrors.QuorumLoss] if removing the node would leave the
+//     cluster without a majority of its voters.
+func (s *ClusterService) RemoveControllerNode(ctx context.Context, controllerID string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	nodes, err := s.GetClusterNodes(ctx)
+	if errors.Is(err, controllernodeerrors.EmptyControllerIDs) {
+		return errors.Errorf("controller node %q %w", controllerID, controllernodeerrors.NotFound)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+
+	var (
+		target *controllernode.ClusterNode
+		voters int
+	)
+	for i, node := range nodes {
+		if node.ControllerID == controllerID {
+			target = &nodes[i]
+		}
+		if node.Role == database.Voter {
+			voters++
+		}
+	}
+	if target == nil {
+		return errors.Errorf("controller node %q %w", controllerID, controllernodeerrors.NotFound)
+	}
+	if len(nodes) == 1 {
+		return errors.Errorf("controller node %q: %w", controllerID, controllernodeerrors.LastControllerNode)
+	}
+	if target.Role == database.Voter && voters-1 <= voters/2 {
+		return errors.Errorf(
+			"controller node %q is one of %d voters: %w",
+			controllerID, voters, controllernodeerrors.QuorumLoss,
+		)
+	}
+
+	if target.InCluster() {
+		s.logger.Infof(ctx, "removing Dqlite node %d of controller %q from the cluster", target.DqliteNodeID, controllerID)
+		if err := s.cluster.RemoveClusterNode(ctx, target.DqliteNodeID); err != nil {
+			return errors.Errorf("removing Dqlite node of controller %q from the cluster: %w", controllerID, err)
+		}
+	}
+
+	if err := s.st.DeleteDqliteNodes(ctx, []string{controllerID}); err != nil {
+		return errors.Errorf("deleting controller node %q: %w", controllerID, err)
+	}
+	return nil
+}
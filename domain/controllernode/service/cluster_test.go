@@ -0,0 +1,145 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain/controllernode"
+	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type clusterServiceSuite struct {
+	testhelpers.IsolationSuite
+
+	state   *MockClusterState
+	cluster *MockClusterManager
+}
+
+func TestClusterServiceSuite(t *testing.T) {
+	tc.Run(t, &clusterServiceSuite{})
+}
+
+func (s *clusterServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockClusterState(ctrl)
+	s.cluster = NewMockClusterManager(ctrl)
+
+	c.Cleanup(func() {
+		s.state = nil
+		s.cluster = nil
+	})
+
+	return ctrl
+}
+
+func (s *clusterServiceSuite) service(c *tc.C) *ClusterService {
+	return NewClusterService(s.state, s.cluster, loggertesting.WrapCheckLog(c))
+}
+
+func (s *clusterServiceSuite) expectCluster(roles ...database.NodeRole) {
+	nodes := make([]controllernode.ControllerNode, len(roles))
+	var members []database.ClusterNodeInfo
+	for i, role := range roles {
+		nodes[i] = controllernode.ControllerNode{
+			ControllerID: strconv.Itoa(i),
+			DqliteNodeID: uint64(100 + i),
+		}
+		if role != "" {
+			members = append(members, database.ClusterNodeInfo{
+				ID:   uint64(100 + i),
+				Role: role,
+			})
+		}
+	}
+	s.state.EXPECT().GetControllerNodes(gomock.Any()).Return(nodes, nil)
+	s.cluster.EXPECT().ClusterDetails(gomock.Any()).Return(members, nil)
+}
+
+func (s *clusterServiceSuite) TestGetClusterNodes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectCluster(database.Voter, database.Spare, "")
+
+	nodes, err := s.service(c).GetClusterNodes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(nodes, tc.DeepEquals, []controllernode.ClusterNode{{
+		ControllerNode: controllernode.ControllerNode{ControllerID: "0", DqliteNodeID: 100},
+		Role:           database.Voter,
+	}, {
+		ControllerNode: controllernode.ControllerNode{ControllerID: "1", DqliteNodeID: 101},
+		Role:           database.Spare,
+	}, {
+		ControllerNode: controllernode.ControllerNode{ControllerID: "2", DqliteNodeID: 102},
+	}})
+}
+
+func (s *clusterServiceSuite) TestRemoveControllerNodeVoter(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectCluster(database.Voter, database.Voter, database.Voter)
+	gomock.InOrder(
+		s.cluster.EXPECT().RemoveClusterNode(gomock.Any(), uint64(102)).Return(nil),
+		s.state.EXPECT().DeleteDqliteNodes(gomock.Any(), []string{"2"}).Return(nil),
+	)
+
+	err := s.service(c).RemoveControllerNode(c.Context(), "2")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *clusterServiceSuite) TestRemoveControllerNodeSpare(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectCluster(database.Voter, database.Spare)
+	s.cluster.EXPECT().RemoveClusterNode(gomock.Any(), uint64(101)).Return(nil)
+	s.state.EXPECT().DeleteDqliteNodes(gomock.Any(), []string{"1"}).Return(nil)
+
+	err := s.service(c).RemoveControllerNode(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *clusterServiceSuite) TestRemoveControllerNodeNotInCluster(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectCluster(database.Voter, "")
+	s.state.EXPECT().DeleteDqliteNodes(gomock.Any(), []string{"1"}).Return(nil)
+
+	err := s.service(c).RemoveControllerNode(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *clusterServiceSuite) TestRemoveControllerNodeQuorumLoss(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectCluster(database.Voter, database.Voter, database.Spare)
+
+	err := s.service(c).RemoveControllerNode(c.Context(), "0")
+	c.Assert(err, tc.ErrorIs, controllernodeerrors.QuorumLoss)
+}
+
+func (s *clusterServiceSuite) TestRemoveControllerNodeLast(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectCluster(database.Voter)
+
+	err := s.service(c).RemoveControllerNode(c.Context(), "0")
+	c.Assert(err, tc.ErrorIs, controllernodeerrors.LastControllerNode)
+}
+
+func (s *clusterServiceSuite) TestRemoveControllerNodeNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectCluster(database.Voter, database.Voter, database.Voter)
+
+	err := s.service(c).RemoveControllerNode(c.Context(), "7")
+	c.Assert(err, tc.ErrorIs, controllernodeerrors.NotFound)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/core/database (interfaces: ClusterManager)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination database_mock_test.go github.com/juju/juju/core/database ClusterManager
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/juju/juju/core/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClusterManager is a mock of ClusterManager interface.
+type MockClusterManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterManagerMockRecorder
+}
+
+// MockClusterManagerMockRecorder is the mock recorder for MockClusterManager.
+type MockClusterManagerMockRecorder struct {
+	mock *MockClusterManager
+}
+
+// NewMockClusterManager creates a new mock instance.
+func NewMockClusterManager(ctrl *gomock.Controller) *MockClusterManager {
+	mock := &MockClusterManager{ctrl: ctrl}
+	mock.recorder = &MockClusterManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterManager) EXPECT() *MockClusterManagerMockRecorder {
+	return m.recorder
+}
+
+// ClusterDetails mocks base method.
+func (m *MockClusterManager) ClusterDetails(arg0 context.Context) ([]database.ClusterNodeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterDetails", arg0)
+	ret0, _ := ret[0].([]database.ClusterNodeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterDetails indicates an expected call of ClusterDetails.
+func (mr *MockClusterManagerMockRecorder) ClusterDetails(arg0 any) *MockClusterManagerClusterDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterDetails", reflect.TypeOf((*MockClusterManager)(nil).ClusterDetails), arg0)
+	return &MockClusterManagerClusterDetailsCall{Call: call}
+}
+
+// MockClusterManagerClusterDetailsCall wrap *gomock.Call
+type MockClusterManagerClusterDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterManagerClusterDetailsCall) Return(arg0 []database.ClusterNodeInfo, arg1 error) *MockClusterManagerClusterDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterManagerClusterDetailsCall) Do(f func(context.Context) ([]database.ClusterNodeInfo, error)) *MockClusterManagerClusterDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterManagerClusterDetailsCall) DoAndReturn(f func(context.Context) ([]database.ClusterNodeInfo, error)) *MockClusterManagerClusterDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveClusterNode mocks base method.
+func (m *MockClusterManager) RemoveClusterNode(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveClusterNode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveClusterNode indicates an expected call of RemoveClusterNode.
+func (mr *MockClusterManagerMockRecorder) RemoveClusterNode(arg0, arg1 any) *MockClusterManagerRemoveClusterNodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveClusterNode", reflect.TypeOf((*MockClusterManager)(nil).RemoveClusterNode), arg0, arg1)
+	return &MockClusterManagerRemoveClusterNodeCall{Call: call}
+}
+
+// MockClusterManagerRemoveClusterNodeCall wrap *gomock.Call
+type MockClusterManagerRemoveClusterNodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterManagerRemoveClusterNodeCall) Return(arg0 error) *MockClusterManagerRemoveClusterNodeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterManagerRemoveClusterNodeCall) Do(f func(context.Context, uint64) error) *MockClusterManagerRemoveClusterNodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterManagerRemoveClusterNodeCall) DoAndReturn(f func(context.Context, uint64) error) *MockClusterManagerRemoveClusterNodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/controllernode/service (interfaces: State,WatcherFactory)
+// Source: github.com/juju/juju/domain/controllernode/service (interfaces: State,WatcherFactory,ClusterState)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/controllernode/service State,WatcherFactory
+//	mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/controllernode/service State,WatcherFactory,ClusterState
 //
 
 // Package service is a generated GoMock package.
@@ -532,3 +532,103 @@ func (c *MockWatcherFactoryNewNotifyWatcherCall) DoAndReturn(f func(context.Cont
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockClusterState is a mock of ClusterState interface.
+type MockClusterState struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterStateMockRecorder
+}
+
+// MockClusterStateMockRecorder is the mock recorder for MockClusterState.
+type MockClusterStateMockRecorder struct {
+	mock *MockClusterState
+}
+
+// NewMockClusterState creates a new mock instance.
+func NewMockClusterState(ctrl *gomock.Controller) *MockClusterState {
+	mock := &MockClusterState{ctrl: ctrl}
+	mock.recorder = &MockClusterStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterState) EXPECT() *MockClusterStateMockRecorder {
+	return m.recorder
+}
+
+// DeleteDqliteNodes mocks base method.
+func (m *MockClusterState) DeleteDqliteNodes(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDqliteNodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDqliteNodes indicates an expected call of DeleteDqliteNodes.
+func (mr *MockClusterStateMockRecorder) DeleteDqliteNodes(arg0, arg1 any) *MockClusterStateDeleteDqliteNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDqliteNodes", reflect.TypeOf((*MockClusterState)(nil).DeleteDqliteNodes), arg0, arg1)
+	return &MockClusterStateDeleteDqliteNodesCall{Call: call}
+}
+
+// MockClusterStateDeleteDqliteNodesCall wrap *gomock.Call
+type MockClusterStateDeleteDqliteNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterStateDeleteDqliteNodesCall) Return(arg0 error) *MockClusterStateDeleteDqliteNodesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterStateDeleteDqliteNodesCall) Do(f func(context.Context, []string) error) *MockClusterStateDeleteDqliteNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterStateDeleteDqliteNodesCall) DoAndReturn(f func(context.Context, []string) error) *MockClusterStateDeleteDqliteNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetControllerNodes mocks base method.
+func (m *MockClusterState) GetControllerNodes(arg0 context.Context) ([]controllernode.ControllerNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetControllerNodes", arg0)
+	ret0, _ := ret[0].([]controllernode.ControllerNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetControllerNodes indicates an expected call of GetControllerNodes.
+func (mr *MockClusterStateMockRecorder) GetControllerNodes(arg0 any) *MockClusterStateGetControllerNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerNodes", reflect.TypeOf((*MockClusterState)(nil).GetControllerNodes), arg0)
+	return &MockClusterStateGetControllerNodesCall{Call: call}
+}
+
+// MockClusterStateGetControllerNodesCall wrap *gomock.Call
+type MockClusterStateGetControllerNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterStateGetControllerNodesCall) Return(arg0 []controllernode.ControllerNode, arg1 error) *MockClusterStateGetControllerNodesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterStateGetControllerNodesCall) Do(f func(context.Context) ([]controllernode.ControllerNode, error)) *MockClusterStateGetControllerNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterStateGetControllerNodesCall) DoAndReturn(f func(context.Context) ([]controllernode.ControllerNode, error)) *MockClusterStateGetControllerNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
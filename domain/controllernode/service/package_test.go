@@ -3,4 +3,5 @@
 
 package service
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/controllernode/service State,WatcherFactory
+//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/controllernode/service State,WatcherFactory,ClusterState
+//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination database_mock_test.go github.com/juju/juju/core/database ClusterManager
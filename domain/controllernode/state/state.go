@@ -489,6 +489,49 @@ FROM controller_node
 	return res, nil
 }
 
+// GetControllerNodes returns the controller ID and Dqlite node ID of every
+// controller node.
+//
+// The following errors can be expected:
+//   - [controllernodeerrors.EmptyControllerIDs] if there are no controller
+//     nodes.
+func (st *State) GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &dbControllerDqliteNode.*
+FROM   controller_node
+`, dbControllerDqliteNode{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var nodes []dbControllerDqliteNode
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&nodes)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return controllernodeerrors.EmptyControllerIDs
+		} else if err != nil {
+			return errors.Errorf("getting controller nodes: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	res := make([]controllernode.ControllerNode, len(nodes))
+	for i, n := range nodes {
+		res[i] = controllernode.ControllerNode{
+			ControllerID: n.ControllerID,
+			DqliteNodeID: n.DqliteNodeID,
+		}
+	}
+	return res, nil
+}
+
 func (st *State) getAllAPIAddressesForClients(ctx context.Context, tx *sqlair.TX) ([]controllerAPIAddress, error) {
 	stmt, err := st.Prepare(`
 SELECT &controllerAPIAddress.* 
@@ -611,6 +611,32 @@ func (s *stateSuite) TestGetControllerIDsEmpty(c *tc.C) {
 	c.Check(controllerIDs, tc.HasLen, 0)
 }
 
+func (s *stateSuite) TestGetControllerNodes(c *tc.C) {
+	for i := range 2 {
+		controllerID := strconv.Itoa(i)
+		nodeID := uint64(15237855465837235027) + uint64(i)
+
+		err := s.state.AddDqliteNode(c.Context(), controllerID, nodeID, "10.0.0."+controllerID)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	nodes, err := s.state.GetControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(nodes, tc.SameContents, []controllernode.ControllerNode{{
+		ControllerID: "0",
+		DqliteNodeID: 15237855465837235027,
+	}, {
+		ControllerID: "1",
+		DqliteNodeID: 15237855465837235028,
+	}})
+}
+
+func (s *stateSuite) TestGetControllerNodesEmpty(c *tc.C) {
+	nodes, err := s.state.GetControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIs, controllernodeerrors.EmptyControllerIDs)
+	c.Check(nodes, tc.HasLen, 0)
+}
+
 func (s *stateSuite) TestGetAPIAddressesForAgents(c *tc.C) {
 	// Arrange: 2 controller nodes
 	controllerID1 := "1"
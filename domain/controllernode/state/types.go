@@ -16,6 +16,13 @@ type dbControllerNode struct {
 	DqliteBindAddress string `db:"dqlite_bind_address"`
 }
 
+// dbControllerDqliteNode is the database representation of a controller
+// ID and its Dqlite node ID.
+type dbControllerDqliteNode struct {
+	ControllerID string `db:"controller_id"`
+	DqliteNodeID uint64 `db:"dqlite_node_id"`
+}
+
 type dbControllerNodeCount struct {
 	Count int `db:"count"`
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/collections/set"
 
+	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/logger"
@@ -218,3 +219,26 @@ func (addrs APIAddresses) ToNoProxyString() string {
 	}
 	return strings.Join(noProxySet.SortedValues(), ",")
 }
+
+// ControllerNode identifies the Dqlite node of a controller.
+type ControllerNode struct {
+	// ControllerID is the ID of the controller.
+	ControllerID string
+	// DqliteNodeID is the ID of the controller's Dqlite node.
+	DqliteNodeID uint64
+}
+
+// ClusterNode describes the membership of a controller's Dqlite node in the
+// Dqlite cluster.
+type ClusterNode struct {
+	ControllerNode
+
+	// Role is the role of the node in the cluster. It is empty if the node
+	// isn't a member of the cluster.
+	Role database.NodeRole
+}
+
+// InCluster returns true if the node is a member of the Dqlite cluster.
+func (n ClusterNode) InCluster() bool {
+	return n.Role != ""
+}
@@ -336,7 +336,7 @@ func (s *importSuite) setupCoordinatorScopeAndService(c *tc.C) (*coremodelmigrat
 	scope := coremodelmigration.NewScope(controllerFactory, modelFactory, nil, nil, modelUUID)
 	srv := service.NewService(
 		controllerstate.NewState(controllerFactory, loggertesting.WrapCheckLog(c)),
-		modelstate.NewState(modelFactory, modelUUID, clock.WallClock, loggertesting.WrapCheckLog(c)),
+		modelstate.NewState(modelFactory, modelUUID, nil, clock.WallClock, loggertesting.WrapCheckLog(c)),
 		nil,
 		clock.WallClock,
 		loggertesting.WrapCheckLog(c),
@@ -72,7 +72,7 @@ func (i *importOperation) Name() string {
 // Setup implements Operation.
 func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	i.importService = service.NewMigrationService(
-		modelstate.NewState(scope.ModelDB(), "", i.clock, i.logger),
+		modelstate.NewState(scope.ModelDB(), "", nil, i.clock, i.logger),
 		i.logger,
 	)
 	return nil
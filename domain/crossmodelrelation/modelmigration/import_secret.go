@@ -58,7 +58,7 @@ func (i *importSecretOperation) Name() string {
 // Setup implements Operation.
 func (i *importSecretOperation) Setup(scope modelmigration.Scope) error {
 	i.importService = service.NewMigrationService(
-		modelstate.NewState(scope.ModelDB(), "", i.clock, i.logger),
+		modelstate.NewState(scope.ModelDB(), "", nil, i.clock, i.logger),
 		i.logger,
 	)
 	return nil
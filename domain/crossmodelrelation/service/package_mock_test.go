@@ -343,6 +343,44 @@ func (c *MockModelStateAddRemoteApplicationOffererCall) DoAndReturn(f func(conte
 	return c
 }
 
+// AddSecretAccessLogEntry mocks base method.
+func (m *MockModelState) AddSecretAccessLogEntry(arg0 context.Context, arg1 *secrets.URI, arg2 secret.SecretAccessLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretAccessLogEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecretAccessLogEntry indicates an expected call of AddSecretAccessLogEntry.
+func (mr *MockModelStateMockRecorder) AddSecretAccessLogEntry(arg0, arg1, arg2 any) *MockModelStateAddSecretAccessLogEntryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretAccessLogEntry", reflect.TypeOf((*MockModelState)(nil).AddSecretAccessLogEntry), arg0, arg1, arg2)
+	return &MockModelStateAddSecretAccessLogEntryCall{Call: call}
+}
+
+// MockModelStateAddSecretAccessLogEntryCall wrap *gomock.Call
+type MockModelStateAddSecretAccessLogEntryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateAddSecretAccessLogEntryCall) Return(arg0 error) *MockModelStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateAddSecretAccessLogEntryCall) Do(f func(context.Context, *secrets.URI, secret.SecretAccessLogEntry) error) *MockModelStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateAddSecretAccessLogEntryCall) DoAndReturn(f func(context.Context, *secrets.URI, secret.SecretAccessLogEntry) error) *MockModelStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateOffer mocks base method.
 func (m *MockModelState) CreateOffer(arg0 context.Context, arg1 crossmodelrelation.CreateOfferArgs) error {
 	m.ctrl.T.Helper()
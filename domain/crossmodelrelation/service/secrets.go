@@ -46,6 +46,9 @@ type ModelSecretsState interface {
 	GetSecretValue(ctx context.Context, uri *secrets.URI, revision int) (secrets.SecretData, *secrets.ValueRef, error)
 	// GetSecretAccess returns the access to the secret for the specified accessor.
 	GetSecretAccess(ctx context.Context, uri *secrets.URI, params domainsecret.AccessParams) (string, error)
+	// AddSecretAccessLogEntry records an access to the specified secret in the
+	// secret access log.
+	AddSecretAccessLogEntry(ctx context.Context, uri *secrets.URI, entry domainsecret.SecretAccessLogEntry) error
 }
 
 // UpdateRemoteConsumedRevision returns the latest revision for the specified secret,
@@ -132,7 +135,8 @@ func (s *Service) canRead(ctx context.Context, uri *secrets.URI, consumer domain
 }
 
 // ProcessRemoteConsumerGetSecret returns the content of a remotely consumed secret,
-// and the latest secret revision.
+// and the latest secret revision. The read is recorded in the secret access log
+// against the consuming application.
 // The following errors may be returned:
 // - [secreterrors.PermissionDenied] if the consumer does not have permission to read the secret
 // - [secreterrors.SecretNotFound] if the secret does not exist
@@ -171,7 +175,26 @@ func (s *Service) ProcessRemoteConsumerGetSecret(
 	}
 
 	data, valueRef, err := s.modelState.GetSecretValue(ctx, uri, wantRevision)
-	return secrets.NewSecretValue(data), valueRef, latestRevision, err
+	if err != nil {
+		return nil, nil, 0, errors.Capture(err)
+	}
+	backendID := ""
+	if valueRef != nil {
+		backendID = valueRef.BackendID
+	}
+	if err := s.modelState.AddSecretAccessLogEntry(ctx, uri, domainsecret.SecretAccessLogEntry{
+		Action:   domainsecret.SecretAccessRead,
+		Revision: &wantRevision,
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.RemoteApplicationAccessor,
+			ID:   appName,
+		},
+		BackendID: backendID,
+		Time:      s.clock.Now().UTC(),
+	}); err != nil {
+		return nil, nil, 0, errors.Capture(err)
+	}
+	return secrets.NewSecretValue(data), valueRef, latestRevision, nil
 }
 
 func (s *Service) updateConsumedRevision(ctx context.Context, consumer unit.Name, uri *secrets.URI, refresh bool) (int, error) {
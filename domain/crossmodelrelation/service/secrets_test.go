@@ -3,6 +3,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/juju/tc"
@@ -108,6 +109,26 @@ func (s *secretsServiceSuite) TestUpdateRemoteConsumedRevisionFirstTimeRefresh(c
 	c.Assert(got, tc.Equals, 666)
 }
 
+// expectSecretReadRecorded expects a read of the secret revision by the
+// consuming application to be recorded in the secret access log.
+func (s *secretsServiceSuite) expectSecretReadRecorded(
+	c *tc.C, uri *coresecrets.URI, appName string, revision int, backendID string,
+) {
+	s.modelState.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *coresecrets.URI, entry secret.SecretAccessLogEntry) error {
+			c.Check(entry.Action, tc.Equals, secret.SecretAccessRead)
+			c.Check(entry.Revision, tc.DeepEquals, &revision)
+			c.Check(entry.Accessor, tc.Equals, secret.SecretAccessor{
+				Kind: secret.RemoteApplicationAccessor,
+				ID:   appName,
+			})
+			c.Check(entry.Subject, tc.IsNil)
+			c.Check(entry.BackendID, tc.Equals, backendID)
+			c.Check(entry.Time.IsZero(), tc.IsFalse)
+			return nil
+		})
+}
+
 func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretNoPeekOrRefresh(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -120,6 +141,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretNoPeekOrRefresh(
 		SubjectID:     consumer.Application(),
 	}).Return(secret.RoleView.String(), nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.expectSecretReadRecorded(c, uri, consumer.Application(), 666, "")
 
 	service := s.service(c)
 
@@ -148,6 +170,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretPeek(c *tc.C) {
 			CurrentRevision: 665,
 		}, 666, nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.expectSecretReadRecorded(c, uri, consumer.Application(), 666, "")
 
 	service := s.service(c)
 
@@ -177,6 +200,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretRefresh(c *tc.C)
 			Label:           "foo",
 		}, 666, nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.expectSecretReadRecorded(c, uri, consumer.Application(), 666, "")
 	s.modelState.EXPECT().SaveSecretRemoteConsumer(gomock.Any(), uri, consumer.String(), coresecrets.SecretConsumerMetadata{
 		CurrentRevision: 666,
 		Label:           "foo",
@@ -210,6 +234,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretNoConsumerExists
 	s.modelState.EXPECT().GetSecretRemoteConsumer(gomock.Any(), uri, consumer.String()).
 		Return(nil, 666, secreterrors.SecretConsumerNotFound)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(nil, ref, nil)
+	s.expectSecretReadRecorded(c, uri, consumer.Application(), 666, "backend-id")
 	s.modelState.EXPECT().SaveSecretRemoteConsumer(gomock.Any(), uri, consumer.String(), coresecrets.SecretConsumerMetadata{
 		CurrentRevision: 666,
 	})
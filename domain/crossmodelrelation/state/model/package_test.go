@@ -40,7 +40,7 @@ type baseSuite struct {
 
 func (s *baseSuite) SetUpTest(c *tc.C) {
 	s.ModelSuite.SetUpTest(c)
-	s.state = NewState(s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), clock.WallClock, loggertesting.WrapCheckLog(c))
+	s.state = NewState(s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, clock.WallClock, loggertesting.WrapCheckLog(c))
 	s.relationCount = 0
 
 	c.Cleanup(func() {
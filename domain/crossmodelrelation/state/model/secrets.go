@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/canonical/sqlair"
 
@@ -15,7 +16,10 @@ import (
 	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+	internaluuid "github.com/juju/juju/internal/uuid"
 )
 
 type (
@@ -564,6 +568,14 @@ WHERE  name=$unit.name`, u)
 	return u.UUID, errors.Capture(err)
 }
 
+// KeyEncryptionKeyGetter provides the controller key-encryption keys with
+// which the model's secret data key is wrapped.
+type KeyEncryptionKeyGetter interface {
+	// GetSecretEncryptionKey returns the key-encryption key with the input
+	// UUID.
+	GetSecretEncryptionKey(ctx context.Context, keyUUID string) (secretbackend.EncryptionKey, error)
+}
+
 // GetSecretValue returns the contents - either data or value reference - of a
 // given secret revision, returning an error satisfying
 // [secreterrors.SecretRevisionNotFound] if the secret revision does not exist.
@@ -602,9 +614,17 @@ AND    rev.revision = $secretRevision.revision`
 
 	want := secretRevision{SecretID: uri.ID, Revision: revision}
 
+	dataKeyStmt, err := st.Prepare(`
+SELECT &secretDataKey.*
+FROM   secret_data_key`, secretDataKey{})
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+
 	var (
 		secretValueContent secretValues
 		secretValueRefs    []secretValueRef
+		dataKey            secretDataKey
 	)
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		err := tx.Query(ctx, contentQueryStmt, want).GetAll(&secretValueContent)
@@ -613,6 +633,13 @@ AND    rev.revision = $secretRevision.revision`
 		}
 		// Do we have content from the db?
 		if len(secretValueContent) > 0 {
+			// The content is encrypted with the model's data key.
+			err = tx.Query(ctx, dataKeyStmt).Get(&dataKey)
+			if errors.Is(err, sqlair.ErrNoRows) {
+				return errors.Errorf("no data key to decrypt secret value for %q revision %d", uri, revision)
+			} else if err != nil {
+				return errors.Errorf("retrieving secret data key: %w", err)
+			}
 			return nil
 		}
 
@@ -631,7 +658,19 @@ AND    rev.revision = $secretRevision.revision`
 
 	// Compose and return any secret content from the db.
 	if len(secretValueContent) > 0 {
-		return secretValueContent.toSecretData(), nil, nil
+		kek, err := st.keys.GetSecretEncryptionKey(ctx, dataKey.KeyEncryptionKeyUUID)
+		if err != nil {
+			return nil, nil, errors.Errorf("getting secret encryption key %q: %w", dataKey.KeyEncryptionKeyUUID, err)
+		}
+		key, err := encryption.UnwrapKey(kek.Material, dataKey.WrappedKey)
+		if err != nil {
+			return nil, nil, errors.Errorf("unwrapping secret data key %q: %w", dataKey.UUID, err)
+		}
+		content, err := secretValueContent.toSecretData(key)
+		if err != nil {
+			return nil, nil, errors.Errorf("reading secret value for %q revision %d: %w", uri, revision, err)
+		}
+		return content, nil, nil
 	}
 
 	// Process any value reference.
@@ -650,6 +689,52 @@ AND    rev.revision = $secretRevision.revision`
 	}, nil
 }
 
+// AddSecretAccessLogEntry records an access to the specified secret in the
+// secret access log.
+func (st *State) AddSecretAccessLogEntry(
+	ctx context.Context, uri *coresecrets.URI, entry domainsecret.SecretAccessLogEntry,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	entryUUID, err := internaluuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	row := secretAccessLogEntry{
+		UUID:         entryUUID.String(),
+		SecretID:     uri.ID,
+		ActionID:     int(entry.Action),
+		AccessorKind: string(entry.Accessor.Kind),
+		AccessorID:   entry.Accessor.ID,
+		CreatedAt:    entry.Time.UTC(),
+	}
+	if entry.Revision != nil {
+		row.Revision = sql.NullInt64{Int64: int64(*entry.Revision), Valid: true}
+	}
+	if entry.Subject != nil {
+		row.SubjectKind = sql.NullString{String: string(entry.Subject.Kind), Valid: true}
+		row.SubjectID = sql.NullString{String: entry.Subject.ID, Valid: true}
+	}
+	if entry.BackendID != "" {
+		row.BackendID = sql.NullString{String: entry.BackendID, Valid: true}
+	}
+
+	stmt, err := st.Prepare(`INSERT INTO secret_access_log (*) VALUES ($secretAccessLogEntry.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, row).Run()
+	})
+	if err != nil {
+		return errors.Errorf("recording access to secret %q: %w", uri.ID, err)
+	}
+	return nil
+}
+
 // GetSecretAccess returns the access to the secret for the specified accessor.
 // It returns an error satisfying [secreterrors.SecretNotFound]
 // if the secret is not found.
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/core/database"
@@ -21,19 +22,64 @@ import (
 	"github.com/juju/juju/domain/life"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	coretesting "github.com/juju/juju/internal/testing"
 	internaluuid "github.com/juju/juju/internal/uuid"
 )
 
 type modelSecretsSuite struct {
 	baseSuite
+
+	kek     secretbackend.EncryptionKey
+	dataKey []byte
 }
 
 func TestModelSecretsSuite(t *testing.T) {
 	tc.Run(t, &modelSecretsSuite{})
 }
 
+func (s *modelSecretsSuite) SetUpTest(c *tc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	kekMaterial, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	s.kek = secretbackend.EncryptionKey{
+		UUID:     internaluuid.MustNewUUID().String(),
+		Material: kekMaterial,
+	}
+	s.dataKey, err = encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := encryption.WrapKey(s.kek.Material, s.dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().ExecContext(c.Context(),
+		`INSERT INTO secret_data_key (uuid, wrapped_key, key_encryption_key_uuid) VALUES (?, ?, ?)`,
+		internaluuid.MustNewUUID().String(), wrapped, s.kek.UUID,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.state = NewState(s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), s, clock.WallClock, loggertesting.WrapCheckLog(c))
+}
+
+// GetSecretEncryptionKey is part of the [KeyEncryptionKeyGetter] interface.
+func (s *modelSecretsSuite) GetSecretEncryptionKey(_ context.Context, keyUUID string) (secretbackend.EncryptionKey, error) {
+	if keyUUID != s.kek.UUID {
+		return secretbackend.EncryptionKey{}, backenderrors.EncryptionKeyNotFound
+	}
+	return s.kek, nil
+}
+
+// encrypt returns the input secret value encrypted with the model data key.
+func (s *modelSecretsSuite) encrypt(c *tc.C, value string) string {
+	ciphertext, err := encryption.Encrypt(s.dataKey, []byte(value))
+	c.Assert(err, tc.ErrorIsNil)
+	return ciphertext
+}
+
 func getRevUUID(c *tc.C, db *sql.DB, uri *coresecrets.URI, rev int) string {
 	var uuid string
 	row := db.QueryRowContext(c.Context(), `
@@ -127,7 +173,7 @@ func (s *modelSecretsSuite) createSecret(c *tc.C, uri *coresecrets.URI, content
 		for k, v := range content {
 			_, err = tx.ExecContext(ctx,
 				`INSERT INTO secret_content (revision_uuid, name, content) VALUES (?, ?, ?)`,
-				revisionUUID, k, v,
+				revisionUUID, k, s.encrypt(c, v),
 			)
 			if err != nil {
 				return err
@@ -165,7 +211,7 @@ VALUES (?, ?, (SELECT MAX(revision)+1 FROM secret_revision WHERE secret_id=?), ?
 		for k, v := range content {
 			_, err = tx.ExecContext(ctx,
 				`INSERT INTO secret_content (revision_uuid, name, content) VALUES (?, ?, ?)`,
-				revisionUUID, k, v,
+				revisionUUID, k, s.encrypt(c, v),
 			)
 			if err != nil {
 				return err
@@ -644,3 +690,38 @@ func (s *modelSecretsSuite) TestGetSecretValueRevisionNotFound(c *tc.C) {
 	_, _, err := s.state.GetSecretValue(c.Context(), uri, 666)
 	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
 }
+
+func (s *modelSecretsSuite) TestAddSecretAccessLogEntry(c *tc.C) {
+	uri := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	err := s.state.AddSecretAccessLogEntry(c.Context(), uri, domainsecret.SecretAccessLogEntry{
+		Action:    domainsecret.SecretAccessRead,
+		Revision:  new(2),
+		Accessor:  domainsecret.SecretAccessor{Kind: domainsecret.RemoteApplicationAccessor, ID: "remote-app"},
+		BackendID: "backend-id",
+		Time:      now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var (
+		revision                            int
+		actionID                            int
+		accessorKind, accessorID, backendID string
+		subjectKind                         sql.NullString
+		createdAt                           time.Time
+	)
+	row := s.DB().QueryRowContext(c.Context(), `
+SELECT revision, action_id, accessor_kind, accessor_id, subject_kind, backend_id, created_at
+FROM   secret_access_log
+WHERE  secret_id = ?`, uri.ID)
+	err = row.Scan(&revision, &actionID, &accessorKind, &accessorID, &subjectKind, &backendID, &createdAt)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revision, tc.Equals, 2)
+	c.Check(actionID, tc.Equals, int(domainsecret.SecretAccessRead))
+	c.Check(accessorKind, tc.Equals, "remote-application")
+	c.Check(accessorID, tc.Equals, "remote-app")
+	c.Check(subjectKind.Valid, tc.IsFalse)
+	c.Check(backendID, tc.Equals, "backend-id")
+	c.Check(createdAt.Equal(now), tc.IsTrue)
+}
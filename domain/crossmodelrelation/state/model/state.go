@@ -23,15 +23,21 @@ import (
 type State struct {
 	*domain.StateBase
 	modelUUID string
+	keys      KeyEncryptionKeyGetter
 	clock     clock.Clock
 	logger    logger.Logger
 }
 
-// NewState returns a new state reference.
-func NewState(factory database.TxnRunnerFactory, modelUUID model.UUID, clock clock.Clock, logger logger.Logger) *State {
+// NewState returns a new state reference. The input key getter provides the
+// controller key-encryption keys needed to decrypt secret content.
+func NewState(
+	factory database.TxnRunnerFactory, modelUUID model.UUID, keys KeyEncryptionKeyGetter,
+	clock clock.Clock, logger logger.Logger,
+) *State {
 	return &State{
 		StateBase: domain.NewStateBase(factory),
 		modelUUID: modelUUID.String(),
+		keys:      keys,
 		clock:     clock,
 		logger:    logger,
 	}
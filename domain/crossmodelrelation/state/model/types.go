@@ -17,6 +17,8 @@ import (
 	"github.com/juju/juju/domain/crossmodelrelation"
 	"github.com/juju/juju/domain/life"
 	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
 )
 
 type uuids []string
@@ -420,12 +422,38 @@ type secretRevision struct {
 
 type secretValues []secretContent
 
-func (rows secretValues) toSecretData() coresecrets.SecretData {
+// toSecretData decrypts the content rows with the input data key.
+func (rows secretValues) toSecretData(dataKey []byte) (coresecrets.SecretData, error) {
 	result := make(coresecrets.SecretData)
 	for _, row := range rows {
-		result[row.Name] = row.Content
+		plaintext, err := encryption.Decrypt(dataKey, row.Content)
+		if err != nil {
+			return nil, errors.Errorf("decrypting secret content %q: %w", row.Name, err)
+		}
+		result[row.Name] = string(plaintext)
 	}
-	return result
+	return result, nil
+}
+
+// secretAccessLogEntry represents a row in the secret_access_log table.
+type secretAccessLogEntry struct {
+	UUID         string         `db:"uuid"`
+	SecretID     string         `db:"secret_id"`
+	Revision     sql.NullInt64  `db:"revision"`
+	ActionID     int            `db:"action_id"`
+	AccessorKind string         `db:"accessor_kind"`
+	AccessorID   string         `db:"accessor_id"`
+	SubjectKind  sql.NullString `db:"subject_kind"`
+	SubjectID    sql.NullString `db:"subject_id"`
+	BackendID    sql.NullString `db:"backend_id"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+// secretDataKey represents the row in the secret_data_key table.
+type secretDataKey struct {
+	UUID                 string `db:"uuid"`
+	WrappedKey           string `db:"wrapped_key"`
+	KeyEncryptionKeyUUID string `db:"key_encryption_key_uuid"`
 }
 
 type relationSuspended struct {
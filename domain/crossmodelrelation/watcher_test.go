@@ -180,7 +180,7 @@ func (s *watcherSuite) setupService(c *tc.C, factory domain.WatchableDBFactory)
 	}
 
 	controllerState := controllerstate.NewState(controllerDB, loggertesting.WrapCheckLog(c))
-	modelState := modelstate.NewState(modelDB, coremodel.UUID(s.modelUUID), clock.WallClock, loggertesting.WrapCheckLog(c))
+	modelState := modelstate.NewState(modelDB, coremodel.UUID(s.modelUUID), nil, clock.WallClock, loggertesting.WrapCheckLog(c))
 
 	return service.NewWatchableService(
 		controllerState,
@@ -94,6 +94,13 @@ const (
 	StorageAttached  Kind = "storage-attached"
 	StorageDetaching Kind = "storage-detaching"
 
+	// These hooks run either side of a snapshot being taken of the
+	// associated storage, so that the charm can quiesce and resume writes
+	// to it. Like the other storage hooks, the hook file names are prefixed
+	// by the storage name; for example, "data-storage-snapshot-pre".
+	StorageSnapshotPre  Kind = "storage-snapshot-pre"
+	StorageSnapshotPost Kind = "storage-snapshot-post"
+
 	// These hooks require an associated workload/container, and the name of the workload/container
 	// whose change triggered the hook. The hook file names that these
 	// kinds represent will be prefixed by the workload/container name; for example,
@@ -141,6 +148,8 @@ func RelationHooks() []Kind {
 var storageHooks = []Kind{
 	StorageAttached,
 	StorageDetaching,
+	StorageSnapshotPre,
+	StorageSnapshotPost,
 }
 
 // StorageHooks returns all known storage hook kinds.
@@ -176,7 +185,7 @@ func (kind Kind) IsRelation() bool {
 // IsStorage returns whether the Kind represents a storage hook.
 func (kind Kind) IsStorage() bool {
 	switch kind {
-	case StorageAttached, StorageDetaching:
+	case StorageAttached, StorageDetaching, StorageSnapshotPre, StorageSnapshotPost:
 		return true
 	}
 	return false
@@ -314,6 +314,7 @@ func (c *CharmHubRepository) resolveWithPreferredChannel(ctx context.Context, ch
 		Revision:    &revision,
 		Platform:    chSuggestedOrigin.Platform,
 		InstanceKey: requestedOrigin.InstanceKey,
+		Publisher:   response.Entity.Publisher["username"],
 	}
 
 	outputOrigin, err := sanitiseCharmOrigin(resOrigin, requestedOrigin)
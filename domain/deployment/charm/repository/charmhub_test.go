@@ -91,7 +91,8 @@ func (s *charmHubRepositorySuite) TestResolveForDeploy(c *tc.C) {
 			OS:           "ubuntu",
 			Channel:      "20.04",
 		},
-		Channel: &channel,
+		Channel:   &channel,
+		Publisher: "wordpress-charmers",
 	})
 	c.Assert(resolvedData.Platform, tc.SameContents, []corecharm.Platform{{OS: "ubuntu", Channel: "20.04", Architecture: "amd64"}})
 	c.Assert(resolvedData.EssentialMetadata.DownloadInfo, tc.DeepEquals, corecharm.DownloadInfo{
@@ -149,7 +150,8 @@ func (s *charmHubRepositorySuite) TestResolveForUpgrade(c *tc.C) {
 			OS:           "ubuntu",
 			Channel:      "20.04",
 		},
-		Channel: &channel,
+		Channel:   &channel,
+		Publisher: "wordpress-charmers",
 	})
 	c.Assert(resolvedData.Platform, tc.SameContents, []corecharm.Platform{{OS: "ubuntu", Channel: "20.04", Architecture: "amd64"}})
 }
@@ -216,6 +218,7 @@ func (s *charmHubRepositorySuite) TestResolveWithChannel(c *tc.C) {
 	expected := s.expectedCURL(curl, 16, arch.DefaultArchitecture)
 
 	c.Assert(resolvedData.URL, tc.DeepEquals, expected)
+	origin.Publisher = "wordpress-charmers"
 	c.Assert(resolvedData.Origin, tc.DeepEquals, origin)
 	c.Assert(resolvedData.Platform, tc.SameContents, []corecharm.Platform{{OS: "ubuntu", Channel: "20.04", Architecture: "amd64"}})
 }
@@ -252,6 +255,7 @@ func (s *charmHubRepositorySuite) TestResolveWithoutBase(c *tc.C) {
 	expected := s.expectedCURL(curl, 16, arch.DefaultArchitecture)
 
 	c.Assert(resolvedData.URL, tc.DeepEquals, expected)
+	origin.Publisher = "wordpress-charmers"
 	c.Assert(resolvedData.Origin, tc.DeepEquals, origin)
 	c.Assert(resolvedData.Platform, tc.SameContents, []corecharm.Platform{})
 }
@@ -306,6 +310,7 @@ func (s *charmHubRepositorySuite) TestResolveForDeployWithRevisionSuccess(c *tc.
 	expected := s.expectedCURL(curl, 16, arch.DefaultArchitecture)
 
 	c.Check(obtainedData.URL, tc.DeepEquals, expected)
+	expectedOrigin.Publisher = "wordpress-charmers"
 	c.Check(obtainedData.EssentialMetadata.ResolvedOrigin, tc.DeepEquals, expectedOrigin)
 	c.Check(obtainedData.EssentialMetadata.DownloadInfo, tc.DeepEquals, corecharm.DownloadInfo{
 		CharmhubIdentifier: "charmCHARMcharmCHARMcharmCHARM01",
@@ -489,6 +494,7 @@ func (s *charmHubRepositorySuite) TestResolveInvalidPlatformError(c *tc.C) {
 	expected := s.expectedCURL(curl, 16, arch.DefaultArchitecture)
 
 	c.Assert(resolvedData.URL, tc.DeepEquals, expected)
+	origin.Publisher = "wordpress-charmers"
 	c.Assert(resolvedData.Origin, tc.DeepEquals, origin)
 	c.Assert(resolvedData.Platform, tc.SameContents, []corecharm.Platform{{OS: "ubuntu", Channel: "20.04", Architecture: "amd64"}})
 }
@@ -802,6 +808,10 @@ func (s *charmHubRepositorySuite) expectCharmRefresh(c *tc.C, cfg charmhub.Refre
 				ID:       "charmCHARMcharmCHARMcharmCHARM01",
 				Name:     "wordpress",
 				Revision: 16,
+				Publisher: map[string]string{
+					"display-name": "WordPress Charmers",
+					"username":     "wordpress-charmers",
+				},
 				Download: transport.Download{
 					HashSHA256: hash,
 					HashSHA384: "SHA384 hash",
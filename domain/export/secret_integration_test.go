@@ -0,0 +1,113 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package export_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/juju/tc"
+	"gopkg.in/yaml.v2"
+
+	coredatabase "github.com/juju/juju/core/database"
+	coresecrets "github.com/juju/juju/core/secrets"
+	exportservice "github.com/juju/juju/domain/export/service"
+	exportstate "github.com/juju/juju/domain/export/state/model"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secretstate "github.com/juju/juju/domain/secret/state"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
+	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
+	jujutesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// secretExportSuite exports a model from one controller and imports it into
+// another, each with its own key-encryption keys.
+type secretExportSuite struct {
+	schematesting.ControllerModelSuite
+}
+
+func TestSecretExportSuite(t *testing.T) {
+	tc.Run(t, &secretExportSuite{})
+}
+
+func (s *secretExportSuite) TestExportImportSecretAcrossControllers(c *tc.C) {
+	modelUUID := uuid.MustNewUUID().String()
+	logger := loggertesting.WrapCheckLog(c)
+
+	// Create a secret on the source controller.
+	sourceKeys, _ := s.addControllerKey(c, s.ControllerTxnRunner())
+	sourceModel := s.ModelTxnRunner(c, modelUUID)
+	err := sourceModel.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type)
+VALUES (?, ?, 'test', 'prod', 'iaas', 'lxd', 'lxd')`,
+			modelUUID, jujutesting.ControllerTag.Id())
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	uri := coresecrets.NewURI()
+	data := coresecrets.SecretData{"password": "s3cret"}
+	err = secretstate.NewState(runnerFactory(sourceModel), sourceKeys, logger).CreateUserSecret(
+		c.Context(), 1, uri, domainsecret.UpsertSecretParams{
+			Data:       data,
+			RevisionID: new(uuid.MustNewUUID().String()),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	modelExport, err := exportservice.NewService(
+		exportstate.NewState(runnerFactory(sourceModel)), sourceKeys,
+	).Export(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	archived, err := yaml.Marshal(modelExport)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Import the model into a target controller that holds none of the
+	// source controller's keys.
+	targetController, _ := s.OpenDBForNamespace(c, "target-controller", true)
+	s.ApplyDDLForRunner(c, targetController)
+	targetKeys, targetKeyUUID := s.addControllerKey(c, targetController)
+	targetModel := s.ModelTxnRunner(c, "target-"+modelUUID)
+
+	err = exportservice.NewService(
+		exportstate.NewState(runnerFactory(targetModel)), targetKeys,
+	).Import(c.Context(), archived, "target-controller-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+
+	var kekUUID string
+	err = targetModel.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT key_encryption_key_uuid FROM secret_data_key").Scan(&kekUUID)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(kekUUID, tc.Equals, targetKeyUUID)
+
+	got, _, err := secretstate.NewState(runnerFactory(targetModel), targetKeys, logger).GetSecretValue(c.Context(), uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, data)
+}
+
+// addControllerKey adds an active key-encryption key to the controller
+// database behind the input runner, holding its material in a key store of
+// the controller's own, returning the service from which it is read and the
+// key's UUID.
+func (s *secretExportSuite) addControllerKey(c *tc.C, runner coredatabase.TxnRunner) (*secretbackendservice.EncryptionKeyService, string) {
+	keys := secretbackendservice.NewEncryptionKeyService(
+		secretbackendstate.NewState(runnerFactory(runner), loggertesting.WrapCheckLog(c)),
+		encryption.NewFileKeyStore(c.MkDir()),
+	)
+	keyUUID, err := keys.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	return keys, keyUUID
+}
+
+func runnerFactory(runner coredatabase.TxnRunner) coredatabase.TxnRunnerFactory {
+	return func(context.Context) (coredatabase.TxnRunner, error) {
+		return runner, nil
+	}
+}
@@ -8,6 +8,9 @@ package service
 import (
 	"context"
 
+	"gopkg.in/yaml.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/trace"
 	domainexport "github.com/juju/juju/domain/export"
 	"github.com/juju/juju/domain/export/types/v4_0_4"
@@ -18,6 +21,10 @@ import (
 type State interface {
 	// Export exports all model data for version 4.0.4.
 	Export(ctx context.Context) (*v4_0_4.ModelExport, error)
+
+	// Import loads all model data for version 4.0.4 into the
+	// model database.
+	Import(ctx context.Context, modelExport *v4_0_4.ModelExport) error
 }
 
 // Export exports all model data for version 4.0.4.
@@ -30,8 +37,99 @@ func (s *Service) Export(ctx context.Context) (*domainexport.ModelExport, error)
 		return nil, errors.Errorf("exporting model data for version 4.0.4: %w", err)
 	}
 
+	// Secret data keys are wrapped by keys that only the exporting
+	// controller holds, so they are exported unwrapped.
+	var dataKeys map[string]string
+	for i, row := range payload.SecretDataKey {
+		dataKey, err := s.unwrapSecretDataKey(ctx, row.KeyEncryptionKeyUUID, row.WrappedKey)
+		if err != nil {
+			return nil, errors.Errorf("exporting secret data key %q: %w", row.UUID, err)
+		}
+		if dataKeys == nil {
+			dataKeys = make(map[string]string)
+		}
+		dataKeys[row.UUID] = dataKey
+		payload.SecretDataKey[i].WrappedKey = ""
+		payload.SecretDataKey[i].KeyEncryptionKeyUUID = ""
+	}
+
 	return &domainexport.ModelExport{
-		Version: "4.0.4",
-		Payload: payload,
+		Version:        "4.0.4",
+		Payload:        payload,
+		SecretDataKeys: dataKeys,
 	}, nil
 }
+
+// Import loads a model export, serialised as it is in a model archive, into
+// the model database. The model is recorded as being managed by the
+// controller with the input UUID, rather than the controller from which it was
+// exported, and its secret data keys are wrapped with this controller's active
+// key-encryption key.
+// The following errors may be returned:
+// - [coreerrors.NotSupported] when the export was not written for version
+// 4.0.4.
+// - [coreerrors.NotValid] when the export cannot be read.
+func (s *Service) Import(ctx context.Context, data []byte, controllerUUID string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	var header struct {
+		Version string `yaml:"version"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return errors.Errorf("reading model export version: %w", err).Add(coreerrors.NotValid)
+	}
+	if header.Version != "4.0.4" {
+		return errors.Errorf("importing model export version %q", header.Version).Add(coreerrors.NotSupported)
+	}
+
+	var modelExport struct {
+		Payload        v4_0_4.ModelExport `yaml:"payload"`
+		SecretDataKeys map[string]string  `yaml:"secret_data_keys"`
+	}
+	if err := yaml.Unmarshal(data, &modelExport); err != nil {
+		return errors.Errorf("reading model export: %w", err).Add(coreerrors.NotValid)
+	}
+	for i := range modelExport.Payload.Model {
+		modelExport.Payload.Model[i].ControllerUUID = controllerUUID
+	}
+	if err := s.wrapSecretDataKeys(ctx, &modelExport.Payload, modelExport.SecretDataKeys); err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := s.st.Import(ctx, &modelExport.Payload); err != nil {
+		return errors.Errorf("importing model data for version 4.0.4: %w", err)
+	}
+	return nil
+}
+
+// wrapSecretDataKeys wraps the exported secret data keys with the active
+// key-encryption key, so that they are written wrapped as the model is
+// imported. The key is referenced before the model data is imported, so that
+// it is retained if it is retired before the import completes.
+func (s *Service) wrapSecretDataKeys(ctx context.Context, payload *v4_0_4.ModelExport, dataKeys map[string]string) error {
+	if len(payload.SecretDataKey) == 0 {
+		return nil
+	}
+	if len(payload.Model) != 1 {
+		return errors.Errorf("model export has %d models", len(payload.Model)).Add(coreerrors.NotValid)
+	}
+
+	active, err := s.keys.ReserveActiveSecretEncryptionKey(ctx, payload.Model[0].UUID)
+	if err != nil {
+		return errors.Errorf("getting active secret encryption key: %w", err)
+	}
+	for i, row := range payload.SecretDataKey {
+		dataKey, ok := dataKeys[row.UUID]
+		if !ok {
+			return errors.Errorf("secret data key %q not exported", row.UUID).Add(coreerrors.NotValid)
+		}
+		wrapped, err := wrapSecretDataKey(active, dataKey)
+		if err != nil {
+			return errors.Errorf("importing secret data key %q: %w", row.UUID, err)
+		}
+		payload.SecretDataKey[i].WrappedKey = wrapped
+		payload.SecretDataKey[i].KeyEncryptionKeyUUID = active.UUID
+	}
+	return nil
+}
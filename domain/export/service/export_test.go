@@ -7,12 +7,18 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 
 	"github.com/juju/tc"
+	"gopkg.in/yaml.v2"
 
+	coreerrors "github.com/juju/juju/core/errors"
+	domainexport "github.com/juju/juju/domain/export"
 	"github.com/juju/juju/domain/export/types/v4_0_4"
+	"github.com/juju/juju/domain/secretbackend"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
 )
 
 type exportServiceSuiteV4_0_4 struct{}
@@ -28,7 +34,7 @@ func (s *exportServiceSuiteV4_0_4) TestExport(c *tc.C) {
 		export: func(context.Context) (*v4_0_4.ModelExport, error) {
 			return expectedPayload, nil
 		},
-	})
+	}, &stubKeysV4_0_4{})
 
 	modelExport, err := svc.Export(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
@@ -41,16 +47,182 @@ func (s *exportServiceSuiteV4_0_4) TestExportError(c *tc.C) {
 		export: func(context.Context) (*v4_0_4.ModelExport, error) {
 			return nil, errors.New("boom")
 		},
-	})
+	}, &stubKeysV4_0_4{})
 
 	_, err := svc.Export(c.Context())
 	c.Assert(err, tc.ErrorMatches, "exporting model data for version 4.0.4: boom")
 }
 
+func (s *exportServiceSuiteV4_0_4) TestImport(c *tc.C) {
+	data, err := yaml.Marshal(domainexport.ModelExport{
+		Version: "4.0.4",
+		Payload: &v4_0_4.ModelExport{
+			Model: []v4_0_4.Model{{
+				UUID:           "model-uuid",
+				ControllerUUID: "source-controller-uuid",
+				Name:           "foo",
+			}},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var imported *v4_0_4.ModelExport
+	svc := NewService(&stubStateV4_0_4{
+		importFn: func(_ context.Context, modelExport *v4_0_4.ModelExport) error {
+			imported = modelExport
+			return nil
+		},
+	}, &stubKeysV4_0_4{})
+
+	err = svc.Import(c.Context(), data, "target-controller-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(imported.Model, tc.DeepEquals, []v4_0_4.Model{{
+		UUID:           "model-uuid",
+		ControllerUUID: "target-controller-uuid",
+		Name:           "foo",
+	}})
+}
+
+func (s *exportServiceSuiteV4_0_4) TestExportUnwrapsSecretDataKey(c *tc.C) {
+	kek := newEncryptionKeyV4_0_4(c, "source-kek")
+	dataKey, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := encryption.WrapKey(kek.Material, dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	svc := NewService(&stubStateV4_0_4{
+		export: func(context.Context) (*v4_0_4.ModelExport, error) {
+			return &v4_0_4.ModelExport{
+				SecretDataKey: []v4_0_4.SecretDataKey{{
+					UUID:                 "data-key-uuid",
+					WrappedKey:           wrapped,
+					KeyEncryptionKeyUUID: kek.UUID,
+				}},
+			}, nil
+		},
+	}, &stubKeysV4_0_4{keys: []secretbackend.EncryptionKey{kek}})
+
+	modelExport, err := svc.Export(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(modelExport.SecretDataKeys, tc.DeepEquals, map[string]string{
+		"data-key-uuid": base64.StdEncoding.EncodeToString(dataKey),
+	})
+	payload := modelExport.Payload.(*v4_0_4.ModelExport)
+	c.Check(payload.SecretDataKey, tc.DeepEquals, []v4_0_4.SecretDataKey{{
+		UUID: "data-key-uuid",
+	}})
+}
+
+func (s *exportServiceSuiteV4_0_4) TestImportWrapsSecretDataKey(c *tc.C) {
+	dataKey, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	data, err := yaml.Marshal(domainexport.ModelExport{
+		Version: "4.0.4",
+		Payload: &v4_0_4.ModelExport{
+			Model: []v4_0_4.Model{{
+				UUID: "model-uuid",
+			}},
+			SecretDataKey: []v4_0_4.SecretDataKey{{
+				UUID: "data-key-uuid",
+			}},
+		},
+		SecretDataKeys: map[string]string{
+			"data-key-uuid": base64.StdEncoding.EncodeToString(dataKey),
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	kek := newEncryptionKeyV4_0_4(c, "target-kek")
+	keys := &stubKeysV4_0_4{keys: []secretbackend.EncryptionKey{kek}}
+	var imported *v4_0_4.ModelExport
+	svc := NewService(&stubStateV4_0_4{
+		importFn: func(_ context.Context, modelExport *v4_0_4.ModelExport) error {
+			imported = modelExport
+			return nil
+		},
+	}, keys)
+
+	err = svc.Import(c.Context(), data, "target-controller-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys.reserved, tc.DeepEquals, []string{"model-uuid"})
+	c.Assert(imported.SecretDataKey, tc.HasLen, 1)
+	c.Check(imported.SecretDataKey[0].KeyEncryptionKeyUUID, tc.Equals, kek.UUID)
+	unwrapped, err := encryption.UnwrapKey(kek.Material, imported.SecretDataKey[0].WrappedKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+}
+
+func (s *exportServiceSuiteV4_0_4) TestImportMissingSecretDataKey(c *tc.C) {
+	data, err := yaml.Marshal(domainexport.ModelExport{
+		Version: "4.0.4",
+		Payload: &v4_0_4.ModelExport{
+			Model: []v4_0_4.Model{{
+				UUID: "model-uuid",
+			}},
+			SecretDataKey: []v4_0_4.SecretDataKey{{
+				UUID: "data-key-uuid",
+			}},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	kek := newEncryptionKeyV4_0_4(c, "target-kek")
+	svc := NewService(&stubStateV4_0_4{}, &stubKeysV4_0_4{keys: []secretbackend.EncryptionKey{kek}})
+
+	err = svc.Import(c.Context(), data, "target-controller-uuid")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *exportServiceSuiteV4_0_4) TestImportUnsupportedVersion(c *tc.C) {
+	data, err := yaml.Marshal(domainexport.ModelExport{
+		Version: "1.2.3",
+		Payload: &v4_0_4.ModelExport{},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	svc := NewService(&stubStateV4_0_4{}, &stubKeysV4_0_4{})
+
+	err = svc.Import(c.Context(), data, "target-controller-uuid")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
 type stubStateV4_0_4 struct {
-	export func(context.Context) (*v4_0_4.ModelExport, error)
+	export   func(context.Context) (*v4_0_4.ModelExport, error)
+	importFn func(context.Context, *v4_0_4.ModelExport) error
 }
 
 func (s *stubStateV4_0_4) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 	return s.export(ctx)
 }
+
+func (s *stubStateV4_0_4) Import(ctx context.Context, modelExport *v4_0_4.ModelExport) error {
+	return s.importFn(ctx, modelExport)
+}
+
+type stubKeysV4_0_4 struct {
+	keys     []secretbackend.EncryptionKey
+	reserved []string
+}
+
+func (s *stubKeysV4_0_4) ReserveActiveSecretEncryptionKey(_ context.Context, modelUUID string) (secretbackend.EncryptionKey, error) {
+	s.reserved = append(s.reserved, modelUUID)
+	return s.keys[0], nil
+}
+
+func (s *stubKeysV4_0_4) GetSecretEncryptionKey(_ context.Context, keyUUID string) (secretbackend.EncryptionKey, error) {
+	for _, key := range s.keys {
+		if key.UUID == keyUUID {
+			return key, nil
+		}
+	}
+	return secretbackend.EncryptionKey{}, errors.Errorf("key %q not found", keyUUID)
+}
+
+func newEncryptionKeyV4_0_4(c *tc.C, keyUUID string) secretbackend.EncryptionKey {
+	material, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	return secretbackend.EncryptionKey{
+		UUID:     keyUUID,
+		Material: material,
+	}
+}
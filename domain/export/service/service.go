@@ -3,14 +3,67 @@
 
 package service
 
+import (
+	"context"
+	"encoding/base64"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+)
+
+// KeyEncryptionKeyGetter provides the controller key-encryption keys with
+// which model secret data keys are wrapped.
+type KeyEncryptionKeyGetter interface {
+	// ReserveActiveSecretEncryptionKey returns the key-encryption key used
+	// to wrap new data keys, recording that the model with the input UUID
+	// references it.
+	ReserveActiveSecretEncryptionKey(ctx context.Context, modelUUID string) (secretbackend.EncryptionKey, error)
+
+	// GetSecretEncryptionKey returns the key-encryption key with the input
+	// UUID.
+	GetSecretEncryptionKey(ctx context.Context, keyUUID string) (secretbackend.EncryptionKey, error)
+}
+
 // Service provides the API for exporting model data.
 type Service struct {
-	st State
+	st   State
+	keys KeyEncryptionKeyGetter
 }
 
 // NewService returns a new service reference wrapping the input state.
-func NewService(st State) *Service {
+func NewService(st State, keys KeyEncryptionKeyGetter) *Service {
 	return &Service{
-		st: st,
+		st:   st,
+		keys: keys,
+	}
+}
+
+// unwrapSecretDataKey returns the base64 encoded plaintext of the input data
+// key, wrapped by the key-encryption key with the input UUID.
+func (s *Service) unwrapSecretDataKey(ctx context.Context, kekUUID, wrapped string) (string, error) {
+	kek, err := s.keys.GetSecretEncryptionKey(ctx, kekUUID)
+	if err != nil {
+		return "", errors.Errorf("getting secret encryption key %q: %w", kekUUID, err)
+	}
+	dataKey, err := encryption.UnwrapKey(kek.Material, wrapped)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return base64.StdEncoding.EncodeToString(dataKey), nil
+}
+
+// wrapSecretDataKey wraps the input base64 encoded data key with the input
+// key-encryption key.
+func wrapSecretDataKey(kek secretbackend.EncryptionKey, encoded string) (string, error) {
+	dataKey, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", errors.Errorf("decoding secret data key: %w", err).Add(coreerrors.NotValid)
+	}
+	wrapped, err := encryption.WrapKey(kek.Material, dataKey)
+	if err != nil {
+		return "", errors.Errorf("wrapping secret data key: %w", err).Add(coreerrors.NotValid)
 	}
+	return wrapped, nil
 }
@@ -705,6 +705,10 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing Removal statement: %w", err)
 	}
+	stmtRemovalStatus, err := sqlair.Prepare(`SELECT &RemovalStatus.* FROM "removal_status"`, v4_0_4.RemovalStatus{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing RemovalStatus statement: %w", err)
+	}
 	stmtRemovalType, err := sqlair.Prepare(`SELECT &RemovalType.* FROM "removal_type"`, v4_0_4.RemovalType{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing RemovalType statement: %w", err)
@@ -753,6 +757,14 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing Secret statement: %w", err)
 	}
+	stmtSecretAccessAction, err := sqlair.Prepare(`SELECT &SecretAccessAction.* FROM "secret_access_action"`, v4_0_4.SecretAccessAction{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretAccessAction statement: %w", err)
+	}
+	stmtSecretAccessLog, err := sqlair.Prepare(`SELECT &SecretAccessLog.* FROM "secret_access_log"`, v4_0_4.SecretAccessLog{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretAccessLog statement: %w", err)
+	}
 	stmtSecretApplicationOwner, err := sqlair.Prepare(`SELECT &SecretApplicationOwner.* FROM "secret_application_owner"`, v4_0_4.SecretApplicationOwner{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretApplicationOwner statement: %w", err)
@@ -761,6 +773,10 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretContent statement: %w", err)
 	}
+	stmtSecretDataKey, err := sqlair.Prepare(`SELECT &SecretDataKey.* FROM "secret_data_key"`, v4_0_4.SecretDataKey{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretDataKey statement: %w", err)
+	}
 	stmtSecretDeletedValueRef, err := sqlair.Prepare(`SELECT &SecretDeletedValueRef.* FROM "secret_deleted_value_ref"`, v4_0_4.SecretDeletedValueRef{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretDeletedValueRef statement: %w", err)
@@ -889,6 +905,14 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing StorageProvisionScope statement: %w", err)
 	}
+	stmtStorageSnapshot, err := sqlair.Prepare(`SELECT &StorageSnapshot.* FROM "storage_snapshot"`, v4_0_4.StorageSnapshot{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing StorageSnapshot statement: %w", err)
+	}
+	stmtStorageSnapshotStatusValue, err := sqlair.Prepare(`SELECT &StorageSnapshotStatusValue.* FROM "storage_snapshot_status_value"`, v4_0_4.StorageSnapshotStatusValue{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing StorageSnapshotStatusValue statement: %w", err)
+	}
 	stmtStorageUnitOwner, err := sqlair.Prepare(`SELECT &StorageUnitOwner.* FROM "storage_unit_owner"`, v4_0_4.StorageUnitOwner{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing StorageUnitOwner statement: %w", err)
@@ -945,6 +969,14 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing UnitAgentVersion statement: %w", err)
 	}
+	stmtUnitHookHistory, err := sqlair.Prepare(`SELECT &UnitHookHistory.* FROM "unit_hook_history"`, v4_0_4.UnitHookHistory{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing UnitHookHistory statement: %w", err)
+	}
+	stmtUnitHookHistoryKind, err := sqlair.Prepare(`SELECT &UnitHookHistoryKind.* FROM "unit_hook_history_kind"`, v4_0_4.UnitHookHistoryKind{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing UnitHookHistoryKind statement: %w", err)
+	}
 	stmtUnitPrincipal, err := sqlair.Prepare(`SELECT &UnitPrincipal.* FROM "unit_principal"`, v4_0_4.UnitPrincipal{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing UnitPrincipal statement: %w", err)
@@ -1509,6 +1541,9 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 		if err := tx.Query(ctx, stmtRemoval).GetAll(&modelExport.Removal); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying Removal (table removal): %w", err)
 		}
+		if err := tx.Query(ctx, stmtRemovalStatus).GetAll(&modelExport.RemovalStatus); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying RemovalStatus (table removal_status): %w", err)
+		}
 		if err := tx.Query(ctx, stmtRemovalType).GetAll(&modelExport.RemovalType); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying RemovalType (table removal_type): %w", err)
 		}
@@ -1545,12 +1580,21 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 		if err := tx.Query(ctx, stmtSecret).GetAll(&modelExport.Secret); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying Secret (table secret): %w", err)
 		}
+		if err := tx.Query(ctx, stmtSecretAccessAction).GetAll(&modelExport.SecretAccessAction); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretAccessAction (table secret_access_action): %w", err)
+		}
+		if err := tx.Query(ctx, stmtSecretAccessLog).GetAll(&modelExport.SecretAccessLog); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretAccessLog (table secret_access_log): %w", err)
+		}
 		if err := tx.Query(ctx, stmtSecretApplicationOwner).GetAll(&modelExport.SecretApplicationOwner); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretApplicationOwner (table secret_application_owner): %w", err)
 		}
 		if err := tx.Query(ctx, stmtSecretContent).GetAll(&modelExport.SecretContent); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretContent (table secret_content): %w", err)
 		}
+		if err := tx.Query(ctx, stmtSecretDataKey).GetAll(&modelExport.SecretDataKey); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretDataKey (table secret_data_key): %w", err)
+		}
 		if err := tx.Query(ctx, stmtSecretDeletedValueRef).GetAll(&modelExport.SecretDeletedValueRef); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretDeletedValueRef (table secret_deleted_value_ref): %w", err)
 		}
@@ -1647,6 +1691,12 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 		if err := tx.Query(ctx, stmtStorageProvisionScope).GetAll(&modelExport.StorageProvisionScope); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying StorageProvisionScope (table storage_provision_scope): %w", err)
 		}
+		if err := tx.Query(ctx, stmtStorageSnapshot).GetAll(&modelExport.StorageSnapshot); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying StorageSnapshot (table storage_snapshot): %w", err)
+		}
+		if err := tx.Query(ctx, stmtStorageSnapshotStatusValue).GetAll(&modelExport.StorageSnapshotStatusValue); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying StorageSnapshotStatusValue (table storage_snapshot_status_value): %w", err)
+		}
 		if err := tx.Query(ctx, stmtStorageUnitOwner).GetAll(&modelExport.StorageUnitOwner); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying StorageUnitOwner (table storage_unit_owner): %w", err)
 		}
@@ -1689,6 +1739,12 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 		if err := tx.Query(ctx, stmtUnitAgentVersion).GetAll(&modelExport.UnitAgentVersion); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying UnitAgentVersion (table unit_agent_version): %w", err)
 		}
+		if err := tx.Query(ctx, stmtUnitHookHistory).GetAll(&modelExport.UnitHookHistory); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying UnitHookHistory (table unit_hook_history): %w", err)
+		}
+		if err := tx.Query(ctx, stmtUnitHookHistoryKind).GetAll(&modelExport.UnitHookHistoryKind); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying UnitHookHistoryKind (table unit_hook_history_kind): %w", err)
+		}
 		if err := tx.Query(ctx, stmtUnitPrincipal).GetAll(&modelExport.UnitPrincipal); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying UnitPrincipal (table unit_principal): %w", err)
 		}
@@ -1729,3 +1785,2245 @@ func (st *State) Export(ctx context.Context) (*v4_0_4.ModelExport, error) {
 
 	return &modelExport, nil
 }
+
+// Import loads all model data for version 4.0.4 into the model
+// database, which must not yet hold the data of a model. Rows already written
+// to the lookup tables as the schema was applied are left in place. Foreign
+// keys are only checked once every row has been loaded, so that the tables
+// can be loaded in any order.
+func (st *State) Import(ctx context.Context, modelExport *v4_0_4.ModelExport) error {
+	stmtDeferForeignKeys, err := sqlair.Prepare(`PRAGMA defer_foreign_keys = ON`)
+	if err != nil {
+		return fmt.Errorf("preparing defer foreign keys statement: %w", err)
+	}
+	stmtInsertAgentBinaryStore, err := sqlair.Prepare(`INSERT INTO "agent_binary_store" (*) VALUES ($AgentBinaryStore.*) ON CONFLICT DO NOTHING`, v4_0_4.AgentBinaryStore{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AgentBinaryStore statement: %w", err)
+	}
+	stmtInsertAgentStream, err := sqlair.Prepare(`INSERT INTO "agent_stream" (*) VALUES ($AgentStream.*) ON CONFLICT DO NOTHING`, v4_0_4.AgentStream{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AgentStream statement: %w", err)
+	}
+	stmtInsertAgentVersion, err := sqlair.Prepare(`INSERT INTO "agent_version" (*) VALUES ($AgentVersion.*) ON CONFLICT DO NOTHING`, v4_0_4.AgentVersion{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AgentVersion statement: %w", err)
+	}
+	stmtInsertAnnotationApplication, err := sqlair.Prepare(`INSERT INTO "annotation_application" (*) VALUES ($AnnotationApplication.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationApplication{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationApplication statement: %w", err)
+	}
+	stmtInsertAnnotationCharm, err := sqlair.Prepare(`INSERT INTO "annotation_charm" (*) VALUES ($AnnotationCharm.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationCharm{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationCharm statement: %w", err)
+	}
+	stmtInsertAnnotationMachine, err := sqlair.Prepare(`INSERT INTO "annotation_machine" (*) VALUES ($AnnotationMachine.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationMachine{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationMachine statement: %w", err)
+	}
+	stmtInsertAnnotationModel, err := sqlair.Prepare(`INSERT INTO "annotation_model" (*) VALUES ($AnnotationModel.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationModel{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationModel statement: %w", err)
+	}
+	stmtInsertAnnotationStorageFilesystem, err := sqlair.Prepare(`INSERT INTO "annotation_storage_filesystem" (*) VALUES ($AnnotationStorageFilesystem.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationStorageFilesystem{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationStorageFilesystem statement: %w", err)
+	}
+	stmtInsertAnnotationStorageInstance, err := sqlair.Prepare(`INSERT INTO "annotation_storage_instance" (*) VALUES ($AnnotationStorageInstance.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationStorageInstance{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationStorageInstance statement: %w", err)
+	}
+	stmtInsertAnnotationStorageVolume, err := sqlair.Prepare(`INSERT INTO "annotation_storage_volume" (*) VALUES ($AnnotationStorageVolume.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationStorageVolume{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationStorageVolume statement: %w", err)
+	}
+	stmtInsertAnnotationUnit, err := sqlair.Prepare(`INSERT INTO "annotation_unit" (*) VALUES ($AnnotationUnit.*) ON CONFLICT DO NOTHING`, v4_0_4.AnnotationUnit{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AnnotationUnit statement: %w", err)
+	}
+	stmtInsertApplication, err := sqlair.Prepare(`INSERT INTO "application" (*) VALUES ($Application.*) ON CONFLICT DO NOTHING`, v4_0_4.Application{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Application statement: %w", err)
+	}
+	stmtInsertApplicationAgent, err := sqlair.Prepare(`INSERT INTO "application_agent" (*) VALUES ($ApplicationAgent.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationAgent{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationAgent statement: %w", err)
+	}
+	stmtInsertApplicationChannel, err := sqlair.Prepare(`INSERT INTO "application_channel" (*) VALUES ($ApplicationChannel.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationChannel{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationChannel statement: %w", err)
+	}
+	stmtInsertApplicationConfig, err := sqlair.Prepare(`INSERT INTO "application_config" (*) VALUES ($ApplicationConfig.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationConfig{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationConfig statement: %w", err)
+	}
+	stmtInsertApplicationConfigHash, err := sqlair.Prepare(`INSERT INTO "application_config_hash" (*) VALUES ($ApplicationConfigHash.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationConfigHash{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationConfigHash statement: %w", err)
+	}
+	stmtInsertApplicationConstraint, err := sqlair.Prepare(`INSERT INTO "application_constraint" (*) VALUES ($ApplicationConstraint.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationConstraint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationConstraint statement: %w", err)
+	}
+	stmtInsertApplicationController, err := sqlair.Prepare(`INSERT INTO "application_controller" (*) VALUES ($ApplicationController.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationController{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationController statement: %w", err)
+	}
+	stmtInsertApplicationEndpoint, err := sqlair.Prepare(`INSERT INTO "application_endpoint" (*) VALUES ($ApplicationEndpoint.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationEndpoint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationEndpoint statement: %w", err)
+	}
+	stmtInsertApplicationExposedEndpointCidr, err := sqlair.Prepare(`INSERT INTO "application_exposed_endpoint_cidr" (*) VALUES ($ApplicationExposedEndpointCidr.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationExposedEndpointCidr{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationExposedEndpointCidr statement: %w", err)
+	}
+	stmtInsertApplicationExposedEndpointSpace, err := sqlair.Prepare(`INSERT INTO "application_exposed_endpoint_space" (*) VALUES ($ApplicationExposedEndpointSpace.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationExposedEndpointSpace{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationExposedEndpointSpace statement: %w", err)
+	}
+	stmtInsertApplicationExtraEndpoint, err := sqlair.Prepare(`INSERT INTO "application_extra_endpoint" (*) VALUES ($ApplicationExtraEndpoint.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationExtraEndpoint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationExtraEndpoint statement: %w", err)
+	}
+	stmtInsertApplicationK8sResourcesManaged, err := sqlair.Prepare(`INSERT INTO "application_k8s_resources_managed" (*) VALUES ($ApplicationK8sResourcesManaged.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationK8sResourcesManaged{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationK8sResourcesManaged statement: %w", err)
+	}
+	stmtInsertApplicationPlatform, err := sqlair.Prepare(`INSERT INTO "application_platform" (*) VALUES ($ApplicationPlatform.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationPlatform{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationPlatform statement: %w", err)
+	}
+	stmtInsertApplicationRemoteConsumer, err := sqlair.Prepare(`INSERT INTO "application_remote_consumer" (*) VALUES ($ApplicationRemoteConsumer.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationRemoteConsumer{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationRemoteConsumer statement: %w", err)
+	}
+	stmtInsertApplicationRemoteOfferer, err := sqlair.Prepare(`INSERT INTO "application_remote_offerer" (*) VALUES ($ApplicationRemoteOfferer.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationRemoteOfferer{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationRemoteOfferer statement: %w", err)
+	}
+	stmtInsertApplicationRemoteOffererRelationMacaroon, err := sqlair.Prepare(`INSERT INTO "application_remote_offerer_relation_macaroon" (*) VALUES ($ApplicationRemoteOffererRelationMacaroon.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationRemoteOffererRelationMacaroon{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationRemoteOffererRelationMacaroon statement: %w", err)
+	}
+	stmtInsertApplicationRemoteOffererStatus, err := sqlair.Prepare(`INSERT INTO "application_remote_offerer_status" (*) VALUES ($ApplicationRemoteOffererStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationRemoteOffererStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationRemoteOffererStatus statement: %w", err)
+	}
+	stmtInsertApplicationResource, err := sqlair.Prepare(`INSERT INTO "application_resource" (*) VALUES ($ApplicationResource.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationResource{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationResource statement: %w", err)
+	}
+	stmtInsertApplicationScale, err := sqlair.Prepare(`INSERT INTO "application_scale" (*) VALUES ($ApplicationScale.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationScale{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationScale statement: %w", err)
+	}
+	stmtInsertApplicationSetting, err := sqlair.Prepare(`INSERT INTO "application_setting" (*) VALUES ($ApplicationSetting.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationSetting{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationSetting statement: %w", err)
+	}
+	stmtInsertApplicationStatus, err := sqlair.Prepare(`INSERT INTO "application_status" (*) VALUES ($ApplicationStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationStatus statement: %w", err)
+	}
+	stmtInsertApplicationStorageDirective, err := sqlair.Prepare(`INSERT INTO "application_storage_directive" (*) VALUES ($ApplicationStorageDirective.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationStorageDirective{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationStorageDirective statement: %w", err)
+	}
+	stmtInsertApplicationWorkloadVersion, err := sqlair.Prepare(`INSERT INTO "application_workload_version" (*) VALUES ($ApplicationWorkloadVersion.*) ON CONFLICT DO NOTHING`, v4_0_4.ApplicationWorkloadVersion{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ApplicationWorkloadVersion statement: %w", err)
+	}
+	stmtInsertArchitecture, err := sqlair.Prepare(`INSERT INTO "architecture" (*) VALUES ($Architecture.*) ON CONFLICT DO NOTHING`, v4_0_4.Architecture{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Architecture statement: %w", err)
+	}
+	stmtInsertAvailabilityZone, err := sqlair.Prepare(`INSERT INTO "availability_zone" (*) VALUES ($AvailabilityZone.*) ON CONFLICT DO NOTHING`, v4_0_4.AvailabilityZone{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AvailabilityZone statement: %w", err)
+	}
+	stmtInsertAvailabilityZoneSubnet, err := sqlair.Prepare(`INSERT INTO "availability_zone_subnet" (*) VALUES ($AvailabilityZoneSubnet.*) ON CONFLICT DO NOTHING`, v4_0_4.AvailabilityZoneSubnet{})
+	if err != nil {
+		return fmt.Errorf("preparing insert AvailabilityZoneSubnet statement: %w", err)
+	}
+	stmtInsertBlockCommand, err := sqlair.Prepare(`INSERT INTO "block_command" (*) VALUES ($BlockCommand.*) ON CONFLICT DO NOTHING`, v4_0_4.BlockCommand{})
+	if err != nil {
+		return fmt.Errorf("preparing insert BlockCommand statement: %w", err)
+	}
+	stmtInsertBlockCommandType, err := sqlair.Prepare(`INSERT INTO "block_command_type" (*) VALUES ($BlockCommandType.*) ON CONFLICT DO NOTHING`, v4_0_4.BlockCommandType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert BlockCommandType statement: %w", err)
+	}
+	stmtInsertBlockDevice, err := sqlair.Prepare(`INSERT INTO "block_device" (*) VALUES ($BlockDevice.*) ON CONFLICT DO NOTHING`, v4_0_4.BlockDevice{})
+	if err != nil {
+		return fmt.Errorf("preparing insert BlockDevice statement: %w", err)
+	}
+	stmtInsertBlockDeviceLinkDevice, err := sqlair.Prepare(`INSERT INTO "block_device_link_device" (*) VALUES ($BlockDeviceLinkDevice.*) ON CONFLICT DO NOTHING`, v4_0_4.BlockDeviceLinkDevice{})
+	if err != nil {
+		return fmt.Errorf("preparing insert BlockDeviceLinkDevice statement: %w", err)
+	}
+	stmtInsertChangeLogEditType, err := sqlair.Prepare(`INSERT INTO "change_log_edit_type" (*) VALUES ($ChangeLogEditType.*) ON CONFLICT DO NOTHING`, v4_0_4.ChangeLogEditType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ChangeLogEditType statement: %w", err)
+	}
+	stmtInsertChangeLogNamespace, err := sqlair.Prepare(`INSERT INTO "change_log_namespace" (*) VALUES ($ChangeLogNamespace.*) ON CONFLICT DO NOTHING`, v4_0_4.ChangeLogNamespace{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ChangeLogNamespace statement: %w", err)
+	}
+	stmtInsertCharm, err := sqlair.Prepare(`INSERT INTO "charm" (*) VALUES ($Charm.*) ON CONFLICT DO NOTHING`, v4_0_4.Charm{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Charm statement: %w", err)
+	}
+	stmtInsertCharmAction, err := sqlair.Prepare(`INSERT INTO "charm_action" (*) VALUES ($CharmAction.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmAction{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmAction statement: %w", err)
+	}
+	stmtInsertCharmCategory, err := sqlair.Prepare(`INSERT INTO "charm_category" (*) VALUES ($CharmCategory.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmCategory{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmCategory statement: %w", err)
+	}
+	stmtInsertCharmConfig, err := sqlair.Prepare(`INSERT INTO "charm_config" (*) VALUES ($CharmConfig.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmConfig{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmConfig statement: %w", err)
+	}
+	stmtInsertCharmConfigType, err := sqlair.Prepare(`INSERT INTO "charm_config_type" (*) VALUES ($CharmConfigType.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmConfigType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmConfigType statement: %w", err)
+	}
+	stmtInsertCharmContainer, err := sqlair.Prepare(`INSERT INTO "charm_container" (*) VALUES ($CharmContainer.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmContainer{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmContainer statement: %w", err)
+	}
+	stmtInsertCharmContainerMount, err := sqlair.Prepare(`INSERT INTO "charm_container_mount" (*) VALUES ($CharmContainerMount.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmContainerMount{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmContainerMount statement: %w", err)
+	}
+	stmtInsertCharmDevice, err := sqlair.Prepare(`INSERT INTO "charm_device" (*) VALUES ($CharmDevice.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmDevice{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmDevice statement: %w", err)
+	}
+	stmtInsertCharmDownloadInfo, err := sqlair.Prepare(`INSERT INTO "charm_download_info" (*) VALUES ($CharmDownloadInfo.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmDownloadInfo{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmDownloadInfo statement: %w", err)
+	}
+	stmtInsertCharmExtraBinding, err := sqlair.Prepare(`INSERT INTO "charm_extra_binding" (*) VALUES ($CharmExtraBinding.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmExtraBinding{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmExtraBinding statement: %w", err)
+	}
+	stmtInsertCharmHash, err := sqlair.Prepare(`INSERT INTO "charm_hash" (*) VALUES ($CharmHash.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmHash{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmHash statement: %w", err)
+	}
+	stmtInsertCharmManifestBase, err := sqlair.Prepare(`INSERT INTO "charm_manifest_base" (*) VALUES ($CharmManifestBase.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmManifestBase{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmManifestBase statement: %w", err)
+	}
+	stmtInsertCharmMetadata, err := sqlair.Prepare(`INSERT INTO "charm_metadata" (*) VALUES ($CharmMetadata.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmMetadata{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmMetadata statement: %w", err)
+	}
+	stmtInsertCharmProvenance, err := sqlair.Prepare(`INSERT INTO "charm_provenance" (*) VALUES ($CharmProvenance.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmProvenance{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmProvenance statement: %w", err)
+	}
+	stmtInsertCharmRelation, err := sqlair.Prepare(`INSERT INTO "charm_relation" (*) VALUES ($CharmRelation.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmRelation{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmRelation statement: %w", err)
+	}
+	stmtInsertCharmRelationRole, err := sqlair.Prepare(`INSERT INTO "charm_relation_role" (*) VALUES ($CharmRelationRole.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmRelationRole{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmRelationRole statement: %w", err)
+	}
+	stmtInsertCharmRelationScope, err := sqlair.Prepare(`INSERT INTO "charm_relation_scope" (*) VALUES ($CharmRelationScope.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmRelationScope{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmRelationScope statement: %w", err)
+	}
+	stmtInsertCharmResource, err := sqlair.Prepare(`INSERT INTO "charm_resource" (*) VALUES ($CharmResource.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmResource{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmResource statement: %w", err)
+	}
+	stmtInsertCharmResourceKind, err := sqlair.Prepare(`INSERT INTO "charm_resource_kind" (*) VALUES ($CharmResourceKind.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmResourceKind{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmResourceKind statement: %w", err)
+	}
+	stmtInsertCharmRunAsKind, err := sqlair.Prepare(`INSERT INTO "charm_run_as_kind" (*) VALUES ($CharmRunAsKind.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmRunAsKind{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmRunAsKind statement: %w", err)
+	}
+	stmtInsertCharmSource, err := sqlair.Prepare(`INSERT INTO "charm_source" (*) VALUES ($CharmSource.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmSource{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmSource statement: %w", err)
+	}
+	stmtInsertCharmStorage, err := sqlair.Prepare(`INSERT INTO "charm_storage" (*) VALUES ($CharmStorage.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmStorage{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmStorage statement: %w", err)
+	}
+	stmtInsertCharmStorageKind, err := sqlair.Prepare(`INSERT INTO "charm_storage_kind" (*) VALUES ($CharmStorageKind.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmStorageKind{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmStorageKind statement: %w", err)
+	}
+	stmtInsertCharmStorageProperty, err := sqlair.Prepare(`INSERT INTO "charm_storage_property" (*) VALUES ($CharmStorageProperty.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmStorageProperty{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmStorageProperty statement: %w", err)
+	}
+	stmtInsertCharmTag, err := sqlair.Prepare(`INSERT INTO "charm_tag" (*) VALUES ($CharmTag.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmTag{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmTag statement: %w", err)
+	}
+	stmtInsertCharmTerm, err := sqlair.Prepare(`INSERT INTO "charm_term" (*) VALUES ($CharmTerm.*) ON CONFLICT DO NOTHING`, v4_0_4.CharmTerm{})
+	if err != nil {
+		return fmt.Errorf("preparing insert CharmTerm statement: %w", err)
+	}
+	stmtInsertConstraint, err := sqlair.Prepare(`INSERT INTO "constraint" (*) VALUES ($Constraint.*) ON CONFLICT DO NOTHING`, v4_0_4.Constraint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Constraint statement: %w", err)
+	}
+	stmtInsertConstraintSpace, err := sqlair.Prepare(`INSERT INTO "constraint_space" (*) VALUES ($ConstraintSpace.*) ON CONFLICT DO NOTHING`, v4_0_4.ConstraintSpace{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ConstraintSpace statement: %w", err)
+	}
+	stmtInsertConstraintTag, err := sqlair.Prepare(`INSERT INTO "constraint_tag" (*) VALUES ($ConstraintTag.*) ON CONFLICT DO NOTHING`, v4_0_4.ConstraintTag{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ConstraintTag statement: %w", err)
+	}
+	stmtInsertConstraintZone, err := sqlair.Prepare(`INSERT INTO "constraint_zone" (*) VALUES ($ConstraintZone.*) ON CONFLICT DO NOTHING`, v4_0_4.ConstraintZone{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ConstraintZone statement: %w", err)
+	}
+	stmtInsertContainerType, err := sqlair.Prepare(`INSERT INTO "container_type" (*) VALUES ($ContainerType.*) ON CONFLICT DO NOTHING`, v4_0_4.ContainerType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ContainerType statement: %w", err)
+	}
+	stmtInsertDeviceConstraint, err := sqlair.Prepare(`INSERT INTO "device_constraint" (*) VALUES ($DeviceConstraint.*) ON CONFLICT DO NOTHING`, v4_0_4.DeviceConstraint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert DeviceConstraint statement: %w", err)
+	}
+	stmtInsertDeviceConstraintAttribute, err := sqlair.Prepare(`INSERT INTO "device_constraint_attribute" (*) VALUES ($DeviceConstraintAttribute.*) ON CONFLICT DO NOTHING`, v4_0_4.DeviceConstraintAttribute{})
+	if err != nil {
+		return fmt.Errorf("preparing insert DeviceConstraintAttribute statement: %w", err)
+	}
+	stmtInsertFqdnAddress, err := sqlair.Prepare(`INSERT INTO "fqdn_address" (*) VALUES ($FqdnAddress.*) ON CONFLICT DO NOTHING`, v4_0_4.FqdnAddress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert FqdnAddress statement: %w", err)
+	}
+	stmtInsertHashKind, err := sqlair.Prepare(`INSERT INTO "hash_kind" (*) VALUES ($HashKind.*) ON CONFLICT DO NOTHING`, v4_0_4.HashKind{})
+	if err != nil {
+		return fmt.Errorf("preparing insert HashKind statement: %w", err)
+	}
+	stmtInsertHostnameAddress, err := sqlair.Prepare(`INSERT INTO "hostname_address" (*) VALUES ($HostnameAddress.*) ON CONFLICT DO NOTHING`, v4_0_4.HostnameAddress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert HostnameAddress statement: %w", err)
+	}
+	stmtInsertInstanceTag, err := sqlair.Prepare(`INSERT INTO "instance_tag" (*) VALUES ($InstanceTag.*) ON CONFLICT DO NOTHING`, v4_0_4.InstanceTag{})
+	if err != nil {
+		return fmt.Errorf("preparing insert InstanceTag statement: %w", err)
+	}
+	stmtInsertIpAddress, err := sqlair.Prepare(`INSERT INTO "ip_address" (*) VALUES ($IpAddress.*) ON CONFLICT DO NOTHING`, v4_0_4.IpAddress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert IpAddress statement: %w", err)
+	}
+	stmtInsertIpAddressConfigType, err := sqlair.Prepare(`INSERT INTO "ip_address_config_type" (*) VALUES ($IpAddressConfigType.*) ON CONFLICT DO NOTHING`, v4_0_4.IpAddressConfigType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert IpAddressConfigType statement: %w", err)
+	}
+	stmtInsertIpAddressOrigin, err := sqlair.Prepare(`INSERT INTO "ip_address_origin" (*) VALUES ($IpAddressOrigin.*) ON CONFLICT DO NOTHING`, v4_0_4.IpAddressOrigin{})
+	if err != nil {
+		return fmt.Errorf("preparing insert IpAddressOrigin statement: %w", err)
+	}
+	stmtInsertIpAddressScope, err := sqlair.Prepare(`INSERT INTO "ip_address_scope" (*) VALUES ($IpAddressScope.*) ON CONFLICT DO NOTHING`, v4_0_4.IpAddressScope{})
+	if err != nil {
+		return fmt.Errorf("preparing insert IpAddressScope statement: %w", err)
+	}
+	stmtInsertIpAddressType, err := sqlair.Prepare(`INSERT INTO "ip_address_type" (*) VALUES ($IpAddressType.*) ON CONFLICT DO NOTHING`, v4_0_4.IpAddressType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert IpAddressType statement: %w", err)
+	}
+	stmtInsertK8sPod, err := sqlair.Prepare(`INSERT INTO "k8s_pod" (*) VALUES ($K8sPod.*) ON CONFLICT DO NOTHING`, v4_0_4.K8sPod{})
+	if err != nil {
+		return fmt.Errorf("preparing insert K8sPod statement: %w", err)
+	}
+	stmtInsertK8sPodPort, err := sqlair.Prepare(`INSERT INTO "k8s_pod_port" (*) VALUES ($K8sPodPort.*) ON CONFLICT DO NOTHING`, v4_0_4.K8sPodPort{})
+	if err != nil {
+		return fmt.Errorf("preparing insert K8sPodPort statement: %w", err)
+	}
+	stmtInsertK8sPodStatus, err := sqlair.Prepare(`INSERT INTO "k8s_pod_status" (*) VALUES ($K8sPodStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.K8sPodStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert K8sPodStatus statement: %w", err)
+	}
+	stmtInsertK8sPodStatusValue, err := sqlair.Prepare(`INSERT INTO "k8s_pod_status_value" (*) VALUES ($K8sPodStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.K8sPodStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert K8sPodStatusValue statement: %w", err)
+	}
+	stmtInsertK8sService, err := sqlair.Prepare(`INSERT INTO "k8s_service" (*) VALUES ($K8sService.*) ON CONFLICT DO NOTHING`, v4_0_4.K8sService{})
+	if err != nil {
+		return fmt.Errorf("preparing insert K8sService statement: %w", err)
+	}
+	stmtInsertLife, err := sqlair.Prepare(`INSERT INTO "life" (*) VALUES ($Life.*) ON CONFLICT DO NOTHING`, v4_0_4.Life{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Life statement: %w", err)
+	}
+	stmtInsertLinkLayerDevice, err := sqlair.Prepare(`INSERT INTO "link_layer_device" (*) VALUES ($LinkLayerDevice.*) ON CONFLICT DO NOTHING`, v4_0_4.LinkLayerDevice{})
+	if err != nil {
+		return fmt.Errorf("preparing insert LinkLayerDevice statement: %w", err)
+	}
+	stmtInsertLinkLayerDeviceDnsAddress, err := sqlair.Prepare(`INSERT INTO "link_layer_device_dns_address" (*) VALUES ($LinkLayerDeviceDnsAddress.*) ON CONFLICT DO NOTHING`, v4_0_4.LinkLayerDeviceDnsAddress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert LinkLayerDeviceDnsAddress statement: %w", err)
+	}
+	stmtInsertLinkLayerDeviceDnsDomain, err := sqlair.Prepare(`INSERT INTO "link_layer_device_dns_domain" (*) VALUES ($LinkLayerDeviceDnsDomain.*) ON CONFLICT DO NOTHING`, v4_0_4.LinkLayerDeviceDnsDomain{})
+	if err != nil {
+		return fmt.Errorf("preparing insert LinkLayerDeviceDnsDomain statement: %w", err)
+	}
+	stmtInsertLinkLayerDeviceParent, err := sqlair.Prepare(`INSERT INTO "link_layer_device_parent" (*) VALUES ($LinkLayerDeviceParent.*) ON CONFLICT DO NOTHING`, v4_0_4.LinkLayerDeviceParent{})
+	if err != nil {
+		return fmt.Errorf("preparing insert LinkLayerDeviceParent statement: %w", err)
+	}
+	stmtInsertLinkLayerDeviceRoute, err := sqlair.Prepare(`INSERT INTO "link_layer_device_route" (*) VALUES ($LinkLayerDeviceRoute.*) ON CONFLICT DO NOTHING`, v4_0_4.LinkLayerDeviceRoute{})
+	if err != nil {
+		return fmt.Errorf("preparing insert LinkLayerDeviceRoute statement: %w", err)
+	}
+	stmtInsertLinkLayerDeviceType, err := sqlair.Prepare(`INSERT INTO "link_layer_device_type" (*) VALUES ($LinkLayerDeviceType.*) ON CONFLICT DO NOTHING`, v4_0_4.LinkLayerDeviceType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert LinkLayerDeviceType statement: %w", err)
+	}
+	stmtInsertMachine, err := sqlair.Prepare(`INSERT INTO "machine" (*) VALUES ($Machine.*) ON CONFLICT DO NOTHING`, v4_0_4.Machine{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Machine statement: %w", err)
+	}
+	stmtInsertMachineAgentPresence, err := sqlair.Prepare(`INSERT INTO "machine_agent_presence" (*) VALUES ($MachineAgentPresence.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineAgentPresence{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineAgentPresence statement: %w", err)
+	}
+	stmtInsertMachineAgentVersion, err := sqlair.Prepare(`INSERT INTO "machine_agent_version" (*) VALUES ($MachineAgentVersion.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineAgentVersion{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineAgentVersion statement: %w", err)
+	}
+	stmtInsertMachineCloudInstance, err := sqlair.Prepare(`INSERT INTO "machine_cloud_instance" (*) VALUES ($MachineCloudInstance.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineCloudInstance{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineCloudInstance statement: %w", err)
+	}
+	stmtInsertMachineCloudInstanceStatus, err := sqlair.Prepare(`INSERT INTO "machine_cloud_instance_status" (*) VALUES ($MachineCloudInstanceStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineCloudInstanceStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineCloudInstanceStatus statement: %w", err)
+	}
+	stmtInsertMachineCloudInstanceStatusValue, err := sqlair.Prepare(`INSERT INTO "machine_cloud_instance_status_value" (*) VALUES ($MachineCloudInstanceStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineCloudInstanceStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineCloudInstanceStatusValue statement: %w", err)
+	}
+	stmtInsertMachineConstraint, err := sqlair.Prepare(`INSERT INTO "machine_constraint" (*) VALUES ($MachineConstraint.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineConstraint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineConstraint statement: %w", err)
+	}
+	stmtInsertMachineContainerType, err := sqlair.Prepare(`INSERT INTO "machine_container_type" (*) VALUES ($MachineContainerType.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineContainerType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineContainerType statement: %w", err)
+	}
+	stmtInsertMachineFilesystem, err := sqlair.Prepare(`INSERT INTO "machine_filesystem" (*) VALUES ($MachineFilesystem.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineFilesystem{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineFilesystem statement: %w", err)
+	}
+	stmtInsertMachineLxdProfile, err := sqlair.Prepare(`INSERT INTO "machine_lxd_profile" (*) VALUES ($MachineLxdProfile.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineLxdProfile{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineLxdProfile statement: %w", err)
+	}
+	stmtInsertMachineManual, err := sqlair.Prepare(`INSERT INTO "machine_manual" (*) VALUES ($MachineManual.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineManual{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineManual statement: %w", err)
+	}
+	stmtInsertMachineParent, err := sqlair.Prepare(`INSERT INTO "machine_parent" (*) VALUES ($MachineParent.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineParent{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineParent statement: %w", err)
+	}
+	stmtInsertMachinePlacement, err := sqlair.Prepare(`INSERT INTO "machine_placement" (*) VALUES ($MachinePlacement.*) ON CONFLICT DO NOTHING`, v4_0_4.MachinePlacement{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachinePlacement statement: %w", err)
+	}
+	stmtInsertMachinePlacementScope, err := sqlair.Prepare(`INSERT INTO "machine_placement_scope" (*) VALUES ($MachinePlacementScope.*) ON CONFLICT DO NOTHING`, v4_0_4.MachinePlacementScope{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachinePlacementScope statement: %w", err)
+	}
+	stmtInsertMachinePlatform, err := sqlair.Prepare(`INSERT INTO "machine_platform" (*) VALUES ($MachinePlatform.*) ON CONFLICT DO NOTHING`, v4_0_4.MachinePlatform{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachinePlatform statement: %w", err)
+	}
+	stmtInsertMachineRequiresReboot, err := sqlair.Prepare(`INSERT INTO "machine_requires_reboot" (*) VALUES ($MachineRequiresReboot.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineRequiresReboot{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineRequiresReboot statement: %w", err)
+	}
+	stmtInsertMachineSshHostKey, err := sqlair.Prepare(`INSERT INTO "machine_ssh_host_key" (*) VALUES ($MachineSshHostKey.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineSshHostKey{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineSshHostKey statement: %w", err)
+	}
+	stmtInsertMachineStatus, err := sqlair.Prepare(`INSERT INTO "machine_status" (*) VALUES ($MachineStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineStatus statement: %w", err)
+	}
+	stmtInsertMachineStatusValue, err := sqlair.Prepare(`INSERT INTO "machine_status_value" (*) VALUES ($MachineStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineStatusValue statement: %w", err)
+	}
+	stmtInsertMachineVolume, err := sqlair.Prepare(`INSERT INTO "machine_volume" (*) VALUES ($MachineVolume.*) ON CONFLICT DO NOTHING`, v4_0_4.MachineVolume{})
+	if err != nil {
+		return fmt.Errorf("preparing insert MachineVolume statement: %w", err)
+	}
+	stmtInsertModel, err := sqlair.Prepare(`INSERT INTO "model" (*) VALUES ($Model.*) ON CONFLICT DO NOTHING`, v4_0_4.Model{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Model statement: %w", err)
+	}
+	stmtInsertModelAgent, err := sqlair.Prepare(`INSERT INTO "model_agent" (*) VALUES ($ModelAgent.*) ON CONFLICT DO NOTHING`, v4_0_4.ModelAgent{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ModelAgent statement: %w", err)
+	}
+	stmtInsertModelConfig, err := sqlair.Prepare(`INSERT INTO "model_config" (*) VALUES ($ModelConfig.*) ON CONFLICT DO NOTHING`, v4_0_4.ModelConfig{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ModelConfig statement: %w", err)
+	}
+	stmtInsertModelConstraint, err := sqlair.Prepare(`INSERT INTO "model_constraint" (*) VALUES ($ModelConstraint.*) ON CONFLICT DO NOTHING`, v4_0_4.ModelConstraint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ModelConstraint statement: %w", err)
+	}
+	stmtInsertModelLife, err := sqlair.Prepare(`INSERT INTO "model_life" (*) VALUES ($ModelLife.*) ON CONFLICT DO NOTHING`, v4_0_4.ModelLife{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ModelLife statement: %w", err)
+	}
+	stmtInsertModelStoragePool, err := sqlair.Prepare(`INSERT INTO "model_storage_pool" (*) VALUES ($ModelStoragePool.*) ON CONFLICT DO NOTHING`, v4_0_4.ModelStoragePool{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ModelStoragePool statement: %w", err)
+	}
+	stmtInsertNetNode, err := sqlair.Prepare(`INSERT INTO "net_node" (*) VALUES ($NetNode.*) ON CONFLICT DO NOTHING`, v4_0_4.NetNode{})
+	if err != nil {
+		return fmt.Errorf("preparing insert NetNode statement: %w", err)
+	}
+	stmtInsertNetNodeFqdnAddress, err := sqlair.Prepare(`INSERT INTO "net_node_fqdn_address" (*) VALUES ($NetNodeFqdnAddress.*) ON CONFLICT DO NOTHING`, v4_0_4.NetNodeFqdnAddress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert NetNodeFqdnAddress statement: %w", err)
+	}
+	stmtInsertNetNodeHostnameAddress, err := sqlair.Prepare(`INSERT INTO "net_node_hostname_address" (*) VALUES ($NetNodeHostnameAddress.*) ON CONFLICT DO NOTHING`, v4_0_4.NetNodeHostnameAddress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert NetNodeHostnameAddress statement: %w", err)
+	}
+	stmtInsertNetworkAddressScope, err := sqlair.Prepare(`INSERT INTO "network_address_scope" (*) VALUES ($NetworkAddressScope.*) ON CONFLICT DO NOTHING`, v4_0_4.NetworkAddressScope{})
+	if err != nil {
+		return fmt.Errorf("preparing insert NetworkAddressScope statement: %w", err)
+	}
+	stmtInsertObjectStoreMetadata, err := sqlair.Prepare(`INSERT INTO "object_store_metadata" (*) VALUES ($ObjectStoreMetadata.*) ON CONFLICT DO NOTHING`, v4_0_4.ObjectStoreMetadata{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ObjectStoreMetadata statement: %w", err)
+	}
+	stmtInsertObjectStoreMetadataPath, err := sqlair.Prepare(`INSERT INTO "object_store_metadata_path" (*) VALUES ($ObjectStoreMetadataPath.*) ON CONFLICT DO NOTHING`, v4_0_4.ObjectStoreMetadataPath{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ObjectStoreMetadataPath statement: %w", err)
+	}
+	stmtInsertOffer, err := sqlair.Prepare(`INSERT INTO "offer" (*) VALUES ($Offer.*) ON CONFLICT DO NOTHING`, v4_0_4.Offer{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Offer statement: %w", err)
+	}
+	stmtInsertOfferConnection, err := sqlair.Prepare(`INSERT INTO "offer_connection" (*) VALUES ($OfferConnection.*) ON CONFLICT DO NOTHING`, v4_0_4.OfferConnection{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OfferConnection statement: %w", err)
+	}
+	stmtInsertOfferEndpoint, err := sqlair.Prepare(`INSERT INTO "offer_endpoint" (*) VALUES ($OfferEndpoint.*) ON CONFLICT DO NOTHING`, v4_0_4.OfferEndpoint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OfferEndpoint statement: %w", err)
+	}
+	stmtInsertOperation, err := sqlair.Prepare(`INSERT INTO "operation" (*) VALUES ($Operation.*) ON CONFLICT DO NOTHING`, v4_0_4.Operation{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Operation statement: %w", err)
+	}
+	stmtInsertOperationAction, err := sqlair.Prepare(`INSERT INTO "operation_action" (*) VALUES ($OperationAction.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationAction{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationAction statement: %w", err)
+	}
+	stmtInsertOperationMachineTask, err := sqlair.Prepare(`INSERT INTO "operation_machine_task" (*) VALUES ($OperationMachineTask.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationMachineTask{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationMachineTask statement: %w", err)
+	}
+	stmtInsertOperationParameter, err := sqlair.Prepare(`INSERT INTO "operation_parameter" (*) VALUES ($OperationParameter.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationParameter{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationParameter statement: %w", err)
+	}
+	stmtInsertOperationTask, err := sqlair.Prepare(`INSERT INTO "operation_task" (*) VALUES ($OperationTask.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationTask{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationTask statement: %w", err)
+	}
+	stmtInsertOperationTaskLog, err := sqlair.Prepare(`INSERT INTO "operation_task_log" (*) VALUES ($OperationTaskLog.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationTaskLog{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationTaskLog statement: %w", err)
+	}
+	stmtInsertOperationTaskOutput, err := sqlair.Prepare(`INSERT INTO "operation_task_output" (*) VALUES ($OperationTaskOutput.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationTaskOutput{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationTaskOutput statement: %w", err)
+	}
+	stmtInsertOperationTaskStatus, err := sqlair.Prepare(`INSERT INTO "operation_task_status" (*) VALUES ($OperationTaskStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationTaskStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationTaskStatus statement: %w", err)
+	}
+	stmtInsertOperationTaskStatusValue, err := sqlair.Prepare(`INSERT INTO "operation_task_status_value" (*) VALUES ($OperationTaskStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationTaskStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationTaskStatusValue statement: %w", err)
+	}
+	stmtInsertOperationUnitTask, err := sqlair.Prepare(`INSERT INTO "operation_unit_task" (*) VALUES ($OperationUnitTask.*) ON CONFLICT DO NOTHING`, v4_0_4.OperationUnitTask{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperationUnitTask statement: %w", err)
+	}
+	stmtInsertOperatorStatus, err := sqlair.Prepare(`INSERT INTO "operator_status" (*) VALUES ($OperatorStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.OperatorStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert OperatorStatus statement: %w", err)
+	}
+	stmtInsertOs, err := sqlair.Prepare(`INSERT INTO "os" (*) VALUES ($Os.*) ON CONFLICT DO NOTHING`, v4_0_4.Os{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Os statement: %w", err)
+	}
+	stmtInsertPasswordHashAlgorithm, err := sqlair.Prepare(`INSERT INTO "password_hash_algorithm" (*) VALUES ($PasswordHashAlgorithm.*) ON CONFLICT DO NOTHING`, v4_0_4.PasswordHashAlgorithm{})
+	if err != nil {
+		return fmt.Errorf("preparing insert PasswordHashAlgorithm statement: %w", err)
+	}
+	stmtInsertPendingApplicationResource, err := sqlair.Prepare(`INSERT INTO "pending_application_resource" (*) VALUES ($PendingApplicationResource.*) ON CONFLICT DO NOTHING`, v4_0_4.PendingApplicationResource{})
+	if err != nil {
+		return fmt.Errorf("preparing insert PendingApplicationResource statement: %w", err)
+	}
+	stmtInsertPortRange, err := sqlair.Prepare(`INSERT INTO "port_range" (*) VALUES ($PortRange.*) ON CONFLICT DO NOTHING`, v4_0_4.PortRange{})
+	if err != nil {
+		return fmt.Errorf("preparing insert PortRange statement: %w", err)
+	}
+	stmtInsertProtocol, err := sqlair.Prepare(`INSERT INTO "protocol" (*) VALUES ($Protocol.*) ON CONFLICT DO NOTHING`, v4_0_4.Protocol{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Protocol statement: %w", err)
+	}
+	stmtInsertProviderIpAddress, err := sqlair.Prepare(`INSERT INTO "provider_ip_address" (*) VALUES ($ProviderIpAddress.*) ON CONFLICT DO NOTHING`, v4_0_4.ProviderIpAddress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ProviderIpAddress statement: %w", err)
+	}
+	stmtInsertProviderLinkLayerDevice, err := sqlair.Prepare(`INSERT INTO "provider_link_layer_device" (*) VALUES ($ProviderLinkLayerDevice.*) ON CONFLICT DO NOTHING`, v4_0_4.ProviderLinkLayerDevice{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ProviderLinkLayerDevice statement: %w", err)
+	}
+	stmtInsertProviderNetwork, err := sqlair.Prepare(`INSERT INTO "provider_network" (*) VALUES ($ProviderNetwork.*) ON CONFLICT DO NOTHING`, v4_0_4.ProviderNetwork{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ProviderNetwork statement: %w", err)
+	}
+	stmtInsertProviderNetworkSubnet, err := sqlair.Prepare(`INSERT INTO "provider_network_subnet" (*) VALUES ($ProviderNetworkSubnet.*) ON CONFLICT DO NOTHING`, v4_0_4.ProviderNetworkSubnet{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ProviderNetworkSubnet statement: %w", err)
+	}
+	stmtInsertProviderSpace, err := sqlair.Prepare(`INSERT INTO "provider_space" (*) VALUES ($ProviderSpace.*) ON CONFLICT DO NOTHING`, v4_0_4.ProviderSpace{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ProviderSpace statement: %w", err)
+	}
+	stmtInsertProviderSubnet, err := sqlair.Prepare(`INSERT INTO "provider_subnet" (*) VALUES ($ProviderSubnet.*) ON CONFLICT DO NOTHING`, v4_0_4.ProviderSubnet{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ProviderSubnet statement: %w", err)
+	}
+	stmtInsertRelation, err := sqlair.Prepare(`INSERT INTO "relation" (*) VALUES ($Relation.*) ON CONFLICT DO NOTHING`, v4_0_4.Relation{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Relation statement: %w", err)
+	}
+	stmtInsertRelationApplicationSetting, err := sqlair.Prepare(`INSERT INTO "relation_application_setting" (*) VALUES ($RelationApplicationSetting.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationApplicationSetting{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationApplicationSetting statement: %w", err)
+	}
+	stmtInsertRelationApplicationSettingsHash, err := sqlair.Prepare(`INSERT INTO "relation_application_settings_hash" (*) VALUES ($RelationApplicationSettingsHash.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationApplicationSettingsHash{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationApplicationSettingsHash statement: %w", err)
+	}
+	stmtInsertRelationEndpoint, err := sqlair.Prepare(`INSERT INTO "relation_endpoint" (*) VALUES ($RelationEndpoint.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationEndpoint{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationEndpoint statement: %w", err)
+	}
+	stmtInsertRelationNetworkEgress, err := sqlair.Prepare(`INSERT INTO "relation_network_egress" (*) VALUES ($RelationNetworkEgress.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationNetworkEgress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationNetworkEgress statement: %w", err)
+	}
+	stmtInsertRelationNetworkIngress, err := sqlair.Prepare(`INSERT INTO "relation_network_ingress" (*) VALUES ($RelationNetworkIngress.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationNetworkIngress{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationNetworkIngress statement: %w", err)
+	}
+	stmtInsertRelationStatus, err := sqlair.Prepare(`INSERT INTO "relation_status" (*) VALUES ($RelationStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationStatus statement: %w", err)
+	}
+	stmtInsertRelationStatusType, err := sqlair.Prepare(`INSERT INTO "relation_status_type" (*) VALUES ($RelationStatusType.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationStatusType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationStatusType statement: %w", err)
+	}
+	stmtInsertRelationUnit, err := sqlair.Prepare(`INSERT INTO "relation_unit" (*) VALUES ($RelationUnit.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationUnit{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationUnit statement: %w", err)
+	}
+	stmtInsertRelationUnitSetting, err := sqlair.Prepare(`INSERT INTO "relation_unit_setting" (*) VALUES ($RelationUnitSetting.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationUnitSetting{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationUnitSetting statement: %w", err)
+	}
+	stmtInsertRelationUnitSettingArchive, err := sqlair.Prepare(`INSERT INTO "relation_unit_setting_archive" (*) VALUES ($RelationUnitSettingArchive.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationUnitSettingArchive{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationUnitSettingArchive statement: %w", err)
+	}
+	stmtInsertRelationUnitSettingsHash, err := sqlair.Prepare(`INSERT INTO "relation_unit_settings_hash" (*) VALUES ($RelationUnitSettingsHash.*) ON CONFLICT DO NOTHING`, v4_0_4.RelationUnitSettingsHash{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RelationUnitSettingsHash statement: %w", err)
+	}
+	stmtInsertRemoval, err := sqlair.Prepare(`INSERT INTO "removal" (*) VALUES ($Removal.*) ON CONFLICT DO NOTHING`, v4_0_4.Removal{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Removal statement: %w", err)
+	}
+	stmtInsertRemovalStatus, err := sqlair.Prepare(`INSERT INTO "removal_status" (*) VALUES ($RemovalStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.RemovalStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RemovalStatus statement: %w", err)
+	}
+	stmtInsertRemovalType, err := sqlair.Prepare(`INSERT INTO "removal_type" (*) VALUES ($RemovalType.*) ON CONFLICT DO NOTHING`, v4_0_4.RemovalType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert RemovalType statement: %w", err)
+	}
+	stmtInsertResolveMode, err := sqlair.Prepare(`INSERT INTO "resolve_mode" (*) VALUES ($ResolveMode.*) ON CONFLICT DO NOTHING`, v4_0_4.ResolveMode{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResolveMode statement: %w", err)
+	}
+	stmtInsertResource, err := sqlair.Prepare(`INSERT INTO "resource" (*) VALUES ($Resource.*) ON CONFLICT DO NOTHING`, v4_0_4.Resource{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Resource statement: %w", err)
+	}
+	stmtInsertResourceContainerImageMetadataStore, err := sqlair.Prepare(`INSERT INTO "resource_container_image_metadata_store" (*) VALUES ($ResourceContainerImageMetadataStore.*) ON CONFLICT DO NOTHING`, v4_0_4.ResourceContainerImageMetadataStore{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResourceContainerImageMetadataStore statement: %w", err)
+	}
+	stmtInsertResourceFileStore, err := sqlair.Prepare(`INSERT INTO "resource_file_store" (*) VALUES ($ResourceFileStore.*) ON CONFLICT DO NOTHING`, v4_0_4.ResourceFileStore{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResourceFileStore statement: %w", err)
+	}
+	stmtInsertResourceImageStore, err := sqlair.Prepare(`INSERT INTO "resource_image_store" (*) VALUES ($ResourceImageStore.*) ON CONFLICT DO NOTHING`, v4_0_4.ResourceImageStore{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResourceImageStore statement: %w", err)
+	}
+	stmtInsertResourceOriginType, err := sqlair.Prepare(`INSERT INTO "resource_origin_type" (*) VALUES ($ResourceOriginType.*) ON CONFLICT DO NOTHING`, v4_0_4.ResourceOriginType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResourceOriginType statement: %w", err)
+	}
+	stmtInsertResourceRetrievedBy, err := sqlair.Prepare(`INSERT INTO "resource_retrieved_by" (*) VALUES ($ResourceRetrievedBy.*) ON CONFLICT DO NOTHING`, v4_0_4.ResourceRetrievedBy{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResourceRetrievedBy statement: %w", err)
+	}
+	stmtInsertResourceRetrievedByType, err := sqlair.Prepare(`INSERT INTO "resource_retrieved_by_type" (*) VALUES ($ResourceRetrievedByType.*) ON CONFLICT DO NOTHING`, v4_0_4.ResourceRetrievedByType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResourceRetrievedByType statement: %w", err)
+	}
+	stmtInsertResourceState, err := sqlair.Prepare(`INSERT INTO "resource_state" (*) VALUES ($ResourceState.*) ON CONFLICT DO NOTHING`, v4_0_4.ResourceState{})
+	if err != nil {
+		return fmt.Errorf("preparing insert ResourceState statement: %w", err)
+	}
+	stmtInsertSchema, err := sqlair.Prepare(`INSERT INTO "schema" (*) VALUES ($Schema.*) ON CONFLICT DO NOTHING`, v4_0_4.Schema{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Schema statement: %w", err)
+	}
+	stmtInsertSecret, err := sqlair.Prepare(`INSERT INTO "secret" (*) VALUES ($Secret.*) ON CONFLICT DO NOTHING`, v4_0_4.Secret{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Secret statement: %w", err)
+	}
+	stmtInsertSecretAccessAction, err := sqlair.Prepare(`INSERT INTO "secret_access_action" (*) VALUES ($SecretAccessAction.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretAccessAction{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretAccessAction statement: %w", err)
+	}
+	stmtInsertSecretAccessLog, err := sqlair.Prepare(`INSERT INTO "secret_access_log" (*) VALUES ($SecretAccessLog.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretAccessLog{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretAccessLog statement: %w", err)
+	}
+	stmtInsertSecretApplicationOwner, err := sqlair.Prepare(`INSERT INTO "secret_application_owner" (*) VALUES ($SecretApplicationOwner.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretApplicationOwner{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretApplicationOwner statement: %w", err)
+	}
+	stmtInsertSecretContent, err := sqlair.Prepare(`INSERT INTO "secret_content" (*) VALUES ($SecretContent.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretContent{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretContent statement: %w", err)
+	}
+	stmtInsertSecretDataKey, err := sqlair.Prepare(`INSERT INTO "secret_data_key" (*) VALUES ($SecretDataKey.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretDataKey{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretDataKey statement: %w", err)
+	}
+	stmtInsertSecretDeletedValueRef, err := sqlair.Prepare(`INSERT INTO "secret_deleted_value_ref" (*) VALUES ($SecretDeletedValueRef.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretDeletedValueRef{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretDeletedValueRef statement: %w", err)
+	}
+	stmtInsertSecretGrantScopeType, err := sqlair.Prepare(`INSERT INTO "secret_grant_scope_type" (*) VALUES ($SecretGrantScopeType.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretGrantScopeType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretGrantScopeType statement: %w", err)
+	}
+	stmtInsertSecretGrantSubjectType, err := sqlair.Prepare(`INSERT INTO "secret_grant_subject_type" (*) VALUES ($SecretGrantSubjectType.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretGrantSubjectType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretGrantSubjectType statement: %w", err)
+	}
+	stmtInsertSecretMetadata, err := sqlair.Prepare(`INSERT INTO "secret_metadata" (*) VALUES ($SecretMetadata.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretMetadata{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretMetadata statement: %w", err)
+	}
+	stmtInsertSecretModelOwner, err := sqlair.Prepare(`INSERT INTO "secret_model_owner" (*) VALUES ($SecretModelOwner.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretModelOwner{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretModelOwner statement: %w", err)
+	}
+	stmtInsertSecretPermission, err := sqlair.Prepare(`INSERT INTO "secret_permission" (*) VALUES ($SecretPermission.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretPermission{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretPermission statement: %w", err)
+	}
+	stmtInsertSecretReference, err := sqlair.Prepare(`INSERT INTO "secret_reference" (*) VALUES ($SecretReference.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretReference{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretReference statement: %w", err)
+	}
+	stmtInsertSecretRemoteUnitConsumer, err := sqlair.Prepare(`INSERT INTO "secret_remote_unit_consumer" (*) VALUES ($SecretRemoteUnitConsumer.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretRemoteUnitConsumer{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretRemoteUnitConsumer statement: %w", err)
+	}
+	stmtInsertSecretRevision, err := sqlair.Prepare(`INSERT INTO "secret_revision" (*) VALUES ($SecretRevision.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretRevision{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretRevision statement: %w", err)
+	}
+	stmtInsertSecretRevisionExpire, err := sqlair.Prepare(`INSERT INTO "secret_revision_expire" (*) VALUES ($SecretRevisionExpire.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretRevisionExpire{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretRevisionExpire statement: %w", err)
+	}
+	stmtInsertSecretRevisionObsolete, err := sqlair.Prepare(`INSERT INTO "secret_revision_obsolete" (*) VALUES ($SecretRevisionObsolete.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretRevisionObsolete{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretRevisionObsolete statement: %w", err)
+	}
+	stmtInsertSecretRole, err := sqlair.Prepare(`INSERT INTO "secret_role" (*) VALUES ($SecretRole.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretRole{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretRole statement: %w", err)
+	}
+	stmtInsertSecretRotatePolicy, err := sqlair.Prepare(`INSERT INTO "secret_rotate_policy" (*) VALUES ($SecretRotatePolicy.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretRotatePolicy{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretRotatePolicy statement: %w", err)
+	}
+	stmtInsertSecretRotation, err := sqlair.Prepare(`INSERT INTO "secret_rotation" (*) VALUES ($SecretRotation.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretRotation{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretRotation statement: %w", err)
+	}
+	stmtInsertSecretUnitConsumer, err := sqlair.Prepare(`INSERT INTO "secret_unit_consumer" (*) VALUES ($SecretUnitConsumer.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretUnitConsumer{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretUnitConsumer statement: %w", err)
+	}
+	stmtInsertSecretUnitOwner, err := sqlair.Prepare(`INSERT INTO "secret_unit_owner" (*) VALUES ($SecretUnitOwner.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretUnitOwner{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretUnitOwner statement: %w", err)
+	}
+	stmtInsertSecretValueRef, err := sqlair.Prepare(`INSERT INTO "secret_value_ref" (*) VALUES ($SecretValueRef.*) ON CONFLICT DO NOTHING`, v4_0_4.SecretValueRef{})
+	if err != nil {
+		return fmt.Errorf("preparing insert SecretValueRef statement: %w", err)
+	}
+	stmtInsertSequence, err := sqlair.Prepare(`INSERT INTO "sequence" (*) VALUES ($Sequence.*) ON CONFLICT DO NOTHING`, v4_0_4.Sequence{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Sequence statement: %w", err)
+	}
+	stmtInsertSpace, err := sqlair.Prepare(`INSERT INTO "space" (*) VALUES ($Space.*) ON CONFLICT DO NOTHING`, v4_0_4.Space{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Space statement: %w", err)
+	}
+	stmtInsertStorageAttachment, err := sqlair.Prepare(`INSERT INTO "storage_attachment" (*) VALUES ($StorageAttachment.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageAttachment{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageAttachment statement: %w", err)
+	}
+	stmtInsertStorageFilesystem, err := sqlair.Prepare(`INSERT INTO "storage_filesystem" (*) VALUES ($StorageFilesystem.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageFilesystem{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageFilesystem statement: %w", err)
+	}
+	stmtInsertStorageFilesystemAttachment, err := sqlair.Prepare(`INSERT INTO "storage_filesystem_attachment" (*) VALUES ($StorageFilesystemAttachment.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageFilesystemAttachment{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageFilesystemAttachment statement: %w", err)
+	}
+	stmtInsertStorageFilesystemStatus, err := sqlair.Prepare(`INSERT INTO "storage_filesystem_status" (*) VALUES ($StorageFilesystemStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageFilesystemStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageFilesystemStatus statement: %w", err)
+	}
+	stmtInsertStorageFilesystemStatusValue, err := sqlair.Prepare(`INSERT INTO "storage_filesystem_status_value" (*) VALUES ($StorageFilesystemStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageFilesystemStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageFilesystemStatusValue statement: %w", err)
+	}
+	stmtInsertStorageInstance, err := sqlair.Prepare(`INSERT INTO "storage_instance" (*) VALUES ($StorageInstance.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageInstance{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageInstance statement: %w", err)
+	}
+	stmtInsertStorageInstanceFilesystem, err := sqlair.Prepare(`INSERT INTO "storage_instance_filesystem" (*) VALUES ($StorageInstanceFilesystem.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageInstanceFilesystem{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageInstanceFilesystem statement: %w", err)
+	}
+	stmtInsertStorageInstanceVolume, err := sqlair.Prepare(`INSERT INTO "storage_instance_volume" (*) VALUES ($StorageInstanceVolume.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageInstanceVolume{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageInstanceVolume statement: %w", err)
+	}
+	stmtInsertStorageKind, err := sqlair.Prepare(`INSERT INTO "storage_kind" (*) VALUES ($StorageKind.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageKind{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageKind statement: %w", err)
+	}
+	stmtInsertStoragePool, err := sqlair.Prepare(`INSERT INTO "storage_pool" (*) VALUES ($StoragePool.*) ON CONFLICT DO NOTHING`, v4_0_4.StoragePool{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StoragePool statement: %w", err)
+	}
+	stmtInsertStoragePoolAttribute, err := sqlair.Prepare(`INSERT INTO "storage_pool_attribute" (*) VALUES ($StoragePoolAttribute.*) ON CONFLICT DO NOTHING`, v4_0_4.StoragePoolAttribute{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StoragePoolAttribute statement: %w", err)
+	}
+	stmtInsertStoragePoolOrigin, err := sqlair.Prepare(`INSERT INTO "storage_pool_origin" (*) VALUES ($StoragePoolOrigin.*) ON CONFLICT DO NOTHING`, v4_0_4.StoragePoolOrigin{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StoragePoolOrigin statement: %w", err)
+	}
+	stmtInsertStorageProvisionScope, err := sqlair.Prepare(`INSERT INTO "storage_provision_scope" (*) VALUES ($StorageProvisionScope.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageProvisionScope{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageProvisionScope statement: %w", err)
+	}
+	stmtInsertStorageSnapshot, err := sqlair.Prepare(`INSERT INTO "storage_snapshot" (*) VALUES ($StorageSnapshot.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageSnapshot{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageSnapshot statement: %w", err)
+	}
+	stmtInsertStorageSnapshotStatusValue, err := sqlair.Prepare(`INSERT INTO "storage_snapshot_status_value" (*) VALUES ($StorageSnapshotStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageSnapshotStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageSnapshotStatusValue statement: %w", err)
+	}
+	stmtInsertStorageUnitOwner, err := sqlair.Prepare(`INSERT INTO "storage_unit_owner" (*) VALUES ($StorageUnitOwner.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageUnitOwner{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageUnitOwner statement: %w", err)
+	}
+	stmtInsertStorageVolume, err := sqlair.Prepare(`INSERT INTO "storage_volume" (*) VALUES ($StorageVolume.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageVolume{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageVolume statement: %w", err)
+	}
+	stmtInsertStorageVolumeAttachment, err := sqlair.Prepare(`INSERT INTO "storage_volume_attachment" (*) VALUES ($StorageVolumeAttachment.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageVolumeAttachment{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageVolumeAttachment statement: %w", err)
+	}
+	stmtInsertStorageVolumeAttachmentPlan, err := sqlair.Prepare(`INSERT INTO "storage_volume_attachment_plan" (*) VALUES ($StorageVolumeAttachmentPlan.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageVolumeAttachmentPlan{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageVolumeAttachmentPlan statement: %w", err)
+	}
+	stmtInsertStorageVolumeAttachmentPlanAttr, err := sqlair.Prepare(`INSERT INTO "storage_volume_attachment_plan_attr" (*) VALUES ($StorageVolumeAttachmentPlanAttr.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageVolumeAttachmentPlanAttr{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageVolumeAttachmentPlanAttr statement: %w", err)
+	}
+	stmtInsertStorageVolumeDeviceType, err := sqlair.Prepare(`INSERT INTO "storage_volume_device_type" (*) VALUES ($StorageVolumeDeviceType.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageVolumeDeviceType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageVolumeDeviceType statement: %w", err)
+	}
+	stmtInsertStorageVolumeStatus, err := sqlair.Prepare(`INSERT INTO "storage_volume_status" (*) VALUES ($StorageVolumeStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageVolumeStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageVolumeStatus statement: %w", err)
+	}
+	stmtInsertStorageVolumeStatusValue, err := sqlair.Prepare(`INSERT INTO "storage_volume_status_value" (*) VALUES ($StorageVolumeStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.StorageVolumeStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert StorageVolumeStatusValue statement: %w", err)
+	}
+	stmtInsertSubnet, err := sqlair.Prepare(`INSERT INTO "subnet" (*) VALUES ($Subnet.*) ON CONFLICT DO NOTHING`, v4_0_4.Subnet{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Subnet statement: %w", err)
+	}
+	stmtInsertUnit, err := sqlair.Prepare(`INSERT INTO "unit" (*) VALUES ($Unit.*) ON CONFLICT DO NOTHING`, v4_0_4.Unit{})
+	if err != nil {
+		return fmt.Errorf("preparing insert Unit statement: %w", err)
+	}
+	stmtInsertUnitAgentPresence, err := sqlair.Prepare(`INSERT INTO "unit_agent_presence" (*) VALUES ($UnitAgentPresence.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitAgentPresence{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitAgentPresence statement: %w", err)
+	}
+	stmtInsertUnitAgentStatus, err := sqlair.Prepare(`INSERT INTO "unit_agent_status" (*) VALUES ($UnitAgentStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitAgentStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitAgentStatus statement: %w", err)
+	}
+	stmtInsertUnitAgentStatusValue, err := sqlair.Prepare(`INSERT INTO "unit_agent_status_value" (*) VALUES ($UnitAgentStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitAgentStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitAgentStatusValue statement: %w", err)
+	}
+	stmtInsertUnitAgentVersion, err := sqlair.Prepare(`INSERT INTO "unit_agent_version" (*) VALUES ($UnitAgentVersion.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitAgentVersion{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitAgentVersion statement: %w", err)
+	}
+	stmtInsertUnitHookHistory, err := sqlair.Prepare(`INSERT INTO "unit_hook_history" (*) VALUES ($UnitHookHistory.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitHookHistory{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitHookHistory statement: %w", err)
+	}
+	stmtInsertUnitHookHistoryKind, err := sqlair.Prepare(`INSERT INTO "unit_hook_history_kind" (*) VALUES ($UnitHookHistoryKind.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitHookHistoryKind{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitHookHistoryKind statement: %w", err)
+	}
+	stmtInsertUnitPrincipal, err := sqlair.Prepare(`INSERT INTO "unit_principal" (*) VALUES ($UnitPrincipal.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitPrincipal{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitPrincipal statement: %w", err)
+	}
+	stmtInsertUnitResolved, err := sqlair.Prepare(`INSERT INTO "unit_resolved" (*) VALUES ($UnitResolved.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitResolved{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitResolved statement: %w", err)
+	}
+	stmtInsertUnitResource, err := sqlair.Prepare(`INSERT INTO "unit_resource" (*) VALUES ($UnitResource.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitResource{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitResource statement: %w", err)
+	}
+	stmtInsertUnitState, err := sqlair.Prepare(`INSERT INTO "unit_state" (*) VALUES ($UnitState.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitState{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitState statement: %w", err)
+	}
+	stmtInsertUnitStateCharm, err := sqlair.Prepare(`INSERT INTO "unit_state_charm" (*) VALUES ($UnitStateCharm.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitStateCharm{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitStateCharm statement: %w", err)
+	}
+	stmtInsertUnitStateRelation, err := sqlair.Prepare(`INSERT INTO "unit_state_relation" (*) VALUES ($UnitStateRelation.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitStateRelation{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitStateRelation statement: %w", err)
+	}
+	stmtInsertUnitStorageDirective, err := sqlair.Prepare(`INSERT INTO "unit_storage_directive" (*) VALUES ($UnitStorageDirective.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitStorageDirective{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitStorageDirective statement: %w", err)
+	}
+	stmtInsertUnitWorkloadStatus, err := sqlair.Prepare(`INSERT INTO "unit_workload_status" (*) VALUES ($UnitWorkloadStatus.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitWorkloadStatus{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitWorkloadStatus statement: %w", err)
+	}
+	stmtInsertUnitWorkloadVersion, err := sqlair.Prepare(`INSERT INTO "unit_workload_version" (*) VALUES ($UnitWorkloadVersion.*) ON CONFLICT DO NOTHING`, v4_0_4.UnitWorkloadVersion{})
+	if err != nil {
+		return fmt.Errorf("preparing insert UnitWorkloadVersion statement: %w", err)
+	}
+	stmtInsertVirtualPortType, err := sqlair.Prepare(`INSERT INTO "virtual_port_type" (*) VALUES ($VirtualPortType.*) ON CONFLICT DO NOTHING`, v4_0_4.VirtualPortType{})
+	if err != nil {
+		return fmt.Errorf("preparing insert VirtualPortType statement: %w", err)
+	}
+	stmtInsertWorkloadStatusValue, err := sqlair.Prepare(`INSERT INTO "workload_status_value" (*) VALUES ($WorkloadStatusValue.*) ON CONFLICT DO NOTHING`, v4_0_4.WorkloadStatusValue{})
+	if err != nil {
+		return fmt.Errorf("preparing insert WorkloadStatusValue statement: %w", err)
+	}
+
+	db, err := st.DB(ctx)
+	if err != nil {
+		return fmt.Errorf("getting db: %w", err)
+	}
+
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmtDeferForeignKeys).Run(); err != nil {
+			return fmt.Errorf("deferring foreign keys: %w", err)
+		}
+		for _, row := range modelExport.AgentBinaryStore {
+			if err := tx.Query(ctx, stmtInsertAgentBinaryStore, row).Run(); err != nil {
+				return fmt.Errorf("inserting AgentBinaryStore (table agent_binary_store): %w", err)
+			}
+		}
+		for _, row := range modelExport.AgentStream {
+			if err := tx.Query(ctx, stmtInsertAgentStream, row).Run(); err != nil {
+				return fmt.Errorf("inserting AgentStream (table agent_stream): %w", err)
+			}
+		}
+		for _, row := range modelExport.AgentVersion {
+			if err := tx.Query(ctx, stmtInsertAgentVersion, row).Run(); err != nil {
+				return fmt.Errorf("inserting AgentVersion (table agent_version): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationApplication {
+			if err := tx.Query(ctx, stmtInsertAnnotationApplication, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationApplication (table annotation_application): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationCharm {
+			if err := tx.Query(ctx, stmtInsertAnnotationCharm, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationCharm (table annotation_charm): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationMachine {
+			if err := tx.Query(ctx, stmtInsertAnnotationMachine, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationMachine (table annotation_machine): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationModel {
+			if err := tx.Query(ctx, stmtInsertAnnotationModel, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationModel (table annotation_model): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationStorageFilesystem {
+			if err := tx.Query(ctx, stmtInsertAnnotationStorageFilesystem, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationStorageFilesystem (table annotation_storage_filesystem): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationStorageInstance {
+			if err := tx.Query(ctx, stmtInsertAnnotationStorageInstance, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationStorageInstance (table annotation_storage_instance): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationStorageVolume {
+			if err := tx.Query(ctx, stmtInsertAnnotationStorageVolume, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationStorageVolume (table annotation_storage_volume): %w", err)
+			}
+		}
+		for _, row := range modelExport.AnnotationUnit {
+			if err := tx.Query(ctx, stmtInsertAnnotationUnit, row).Run(); err != nil {
+				return fmt.Errorf("inserting AnnotationUnit (table annotation_unit): %w", err)
+			}
+		}
+		for _, row := range modelExport.Application {
+			if err := tx.Query(ctx, stmtInsertApplication, row).Run(); err != nil {
+				return fmt.Errorf("inserting Application (table application): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationAgent {
+			if err := tx.Query(ctx, stmtInsertApplicationAgent, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationAgent (table application_agent): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationChannel {
+			if err := tx.Query(ctx, stmtInsertApplicationChannel, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationChannel (table application_channel): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationConfig {
+			if err := tx.Query(ctx, stmtInsertApplicationConfig, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationConfig (table application_config): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationConfigHash {
+			if err := tx.Query(ctx, stmtInsertApplicationConfigHash, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationConfigHash (table application_config_hash): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationConstraint {
+			if err := tx.Query(ctx, stmtInsertApplicationConstraint, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationConstraint (table application_constraint): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationController {
+			if err := tx.Query(ctx, stmtInsertApplicationController, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationController (table application_controller): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationEndpoint {
+			if err := tx.Query(ctx, stmtInsertApplicationEndpoint, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationEndpoint (table application_endpoint): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationExposedEndpointCidr {
+			if err := tx.Query(ctx, stmtInsertApplicationExposedEndpointCidr, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationExposedEndpointCidr (table application_exposed_endpoint_cidr): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationExposedEndpointSpace {
+			if err := tx.Query(ctx, stmtInsertApplicationExposedEndpointSpace, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationExposedEndpointSpace (table application_exposed_endpoint_space): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationExtraEndpoint {
+			if err := tx.Query(ctx, stmtInsertApplicationExtraEndpoint, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationExtraEndpoint (table application_extra_endpoint): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationK8sResourcesManaged {
+			if err := tx.Query(ctx, stmtInsertApplicationK8sResourcesManaged, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationK8sResourcesManaged (table application_k8s_resources_managed): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationPlatform {
+			if err := tx.Query(ctx, stmtInsertApplicationPlatform, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationPlatform (table application_platform): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationRemoteConsumer {
+			if err := tx.Query(ctx, stmtInsertApplicationRemoteConsumer, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationRemoteConsumer (table application_remote_consumer): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationRemoteOfferer {
+			if err := tx.Query(ctx, stmtInsertApplicationRemoteOfferer, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationRemoteOfferer (table application_remote_offerer): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationRemoteOffererRelationMacaroon {
+			if err := tx.Query(ctx, stmtInsertApplicationRemoteOffererRelationMacaroon, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationRemoteOffererRelationMacaroon (table application_remote_offerer_relation_macaroon): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationRemoteOffererStatus {
+			if err := tx.Query(ctx, stmtInsertApplicationRemoteOffererStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationRemoteOffererStatus (table application_remote_offerer_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationResource {
+			if err := tx.Query(ctx, stmtInsertApplicationResource, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationResource (table application_resource): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationScale {
+			if err := tx.Query(ctx, stmtInsertApplicationScale, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationScale (table application_scale): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationSetting {
+			if err := tx.Query(ctx, stmtInsertApplicationSetting, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationSetting (table application_setting): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationStatus {
+			if err := tx.Query(ctx, stmtInsertApplicationStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationStatus (table application_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationStorageDirective {
+			if err := tx.Query(ctx, stmtInsertApplicationStorageDirective, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationStorageDirective (table application_storage_directive): %w", err)
+			}
+		}
+		for _, row := range modelExport.ApplicationWorkloadVersion {
+			if err := tx.Query(ctx, stmtInsertApplicationWorkloadVersion, row).Run(); err != nil {
+				return fmt.Errorf("inserting ApplicationWorkloadVersion (table application_workload_version): %w", err)
+			}
+		}
+		for _, row := range modelExport.Architecture {
+			if err := tx.Query(ctx, stmtInsertArchitecture, row).Run(); err != nil {
+				return fmt.Errorf("inserting Architecture (table architecture): %w", err)
+			}
+		}
+		for _, row := range modelExport.AvailabilityZone {
+			if err := tx.Query(ctx, stmtInsertAvailabilityZone, row).Run(); err != nil {
+				return fmt.Errorf("inserting AvailabilityZone (table availability_zone): %w", err)
+			}
+		}
+		for _, row := range modelExport.AvailabilityZoneSubnet {
+			if err := tx.Query(ctx, stmtInsertAvailabilityZoneSubnet, row).Run(); err != nil {
+				return fmt.Errorf("inserting AvailabilityZoneSubnet (table availability_zone_subnet): %w", err)
+			}
+		}
+		for _, row := range modelExport.BlockCommand {
+			if err := tx.Query(ctx, stmtInsertBlockCommand, row).Run(); err != nil {
+				return fmt.Errorf("inserting BlockCommand (table block_command): %w", err)
+			}
+		}
+		for _, row := range modelExport.BlockCommandType {
+			if err := tx.Query(ctx, stmtInsertBlockCommandType, row).Run(); err != nil {
+				return fmt.Errorf("inserting BlockCommandType (table block_command_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.BlockDevice {
+			if err := tx.Query(ctx, stmtInsertBlockDevice, row).Run(); err != nil {
+				return fmt.Errorf("inserting BlockDevice (table block_device): %w", err)
+			}
+		}
+		for _, row := range modelExport.BlockDeviceLinkDevice {
+			if err := tx.Query(ctx, stmtInsertBlockDeviceLinkDevice, row).Run(); err != nil {
+				return fmt.Errorf("inserting BlockDeviceLinkDevice (table block_device_link_device): %w", err)
+			}
+		}
+		for _, row := range modelExport.ChangeLogEditType {
+			if err := tx.Query(ctx, stmtInsertChangeLogEditType, row).Run(); err != nil {
+				return fmt.Errorf("inserting ChangeLogEditType (table change_log_edit_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.ChangeLogNamespace {
+			if err := tx.Query(ctx, stmtInsertChangeLogNamespace, row).Run(); err != nil {
+				return fmt.Errorf("inserting ChangeLogNamespace (table change_log_namespace): %w", err)
+			}
+		}
+		for _, row := range modelExport.Charm {
+			if err := tx.Query(ctx, stmtInsertCharm, row).Run(); err != nil {
+				return fmt.Errorf("inserting Charm (table charm): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmAction {
+			if err := tx.Query(ctx, stmtInsertCharmAction, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmAction (table charm_action): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmCategory {
+			if err := tx.Query(ctx, stmtInsertCharmCategory, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmCategory (table charm_category): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmConfig {
+			if err := tx.Query(ctx, stmtInsertCharmConfig, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmConfig (table charm_config): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmConfigType {
+			if err := tx.Query(ctx, stmtInsertCharmConfigType, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmConfigType (table charm_config_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmContainer {
+			if err := tx.Query(ctx, stmtInsertCharmContainer, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmContainer (table charm_container): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmContainerMount {
+			if err := tx.Query(ctx, stmtInsertCharmContainerMount, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmContainerMount (table charm_container_mount): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmDevice {
+			if err := tx.Query(ctx, stmtInsertCharmDevice, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmDevice (table charm_device): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmDownloadInfo {
+			if err := tx.Query(ctx, stmtInsertCharmDownloadInfo, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmDownloadInfo (table charm_download_info): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmExtraBinding {
+			if err := tx.Query(ctx, stmtInsertCharmExtraBinding, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmExtraBinding (table charm_extra_binding): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmHash {
+			if err := tx.Query(ctx, stmtInsertCharmHash, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmHash (table charm_hash): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmManifestBase {
+			if err := tx.Query(ctx, stmtInsertCharmManifestBase, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmManifestBase (table charm_manifest_base): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmMetadata {
+			if err := tx.Query(ctx, stmtInsertCharmMetadata, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmMetadata (table charm_metadata): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmProvenance {
+			if err := tx.Query(ctx, stmtInsertCharmProvenance, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmProvenance (table charm_provenance): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmRelation {
+			if err := tx.Query(ctx, stmtInsertCharmRelation, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmRelation (table charm_relation): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmRelationRole {
+			if err := tx.Query(ctx, stmtInsertCharmRelationRole, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmRelationRole (table charm_relation_role): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmRelationScope {
+			if err := tx.Query(ctx, stmtInsertCharmRelationScope, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmRelationScope (table charm_relation_scope): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmResource {
+			if err := tx.Query(ctx, stmtInsertCharmResource, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmResource (table charm_resource): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmResourceKind {
+			if err := tx.Query(ctx, stmtInsertCharmResourceKind, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmResourceKind (table charm_resource_kind): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmRunAsKind {
+			if err := tx.Query(ctx, stmtInsertCharmRunAsKind, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmRunAsKind (table charm_run_as_kind): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmSource {
+			if err := tx.Query(ctx, stmtInsertCharmSource, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmSource (table charm_source): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmStorage {
+			if err := tx.Query(ctx, stmtInsertCharmStorage, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmStorage (table charm_storage): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmStorageKind {
+			if err := tx.Query(ctx, stmtInsertCharmStorageKind, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmStorageKind (table charm_storage_kind): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmStorageProperty {
+			if err := tx.Query(ctx, stmtInsertCharmStorageProperty, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmStorageProperty (table charm_storage_property): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmTag {
+			if err := tx.Query(ctx, stmtInsertCharmTag, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmTag (table charm_tag): %w", err)
+			}
+		}
+		for _, row := range modelExport.CharmTerm {
+			if err := tx.Query(ctx, stmtInsertCharmTerm, row).Run(); err != nil {
+				return fmt.Errorf("inserting CharmTerm (table charm_term): %w", err)
+			}
+		}
+		for _, row := range modelExport.Constraint {
+			if err := tx.Query(ctx, stmtInsertConstraint, row).Run(); err != nil {
+				return fmt.Errorf("inserting Constraint (table constraint): %w", err)
+			}
+		}
+		for _, row := range modelExport.ConstraintSpace {
+			if err := tx.Query(ctx, stmtInsertConstraintSpace, row).Run(); err != nil {
+				return fmt.Errorf("inserting ConstraintSpace (table constraint_space): %w", err)
+			}
+		}
+		for _, row := range modelExport.ConstraintTag {
+			if err := tx.Query(ctx, stmtInsertConstraintTag, row).Run(); err != nil {
+				return fmt.Errorf("inserting ConstraintTag (table constraint_tag): %w", err)
+			}
+		}
+		for _, row := range modelExport.ConstraintZone {
+			if err := tx.Query(ctx, stmtInsertConstraintZone, row).Run(); err != nil {
+				return fmt.Errorf("inserting ConstraintZone (table constraint_zone): %w", err)
+			}
+		}
+		for _, row := range modelExport.ContainerType {
+			if err := tx.Query(ctx, stmtInsertContainerType, row).Run(); err != nil {
+				return fmt.Errorf("inserting ContainerType (table container_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.DeviceConstraint {
+			if err := tx.Query(ctx, stmtInsertDeviceConstraint, row).Run(); err != nil {
+				return fmt.Errorf("inserting DeviceConstraint (table device_constraint): %w", err)
+			}
+		}
+		for _, row := range modelExport.DeviceConstraintAttribute {
+			if err := tx.Query(ctx, stmtInsertDeviceConstraintAttribute, row).Run(); err != nil {
+				return fmt.Errorf("inserting DeviceConstraintAttribute (table device_constraint_attribute): %w", err)
+			}
+		}
+		for _, row := range modelExport.FqdnAddress {
+			if err := tx.Query(ctx, stmtInsertFqdnAddress, row).Run(); err != nil {
+				return fmt.Errorf("inserting FqdnAddress (table fqdn_address): %w", err)
+			}
+		}
+		for _, row := range modelExport.HashKind {
+			if err := tx.Query(ctx, stmtInsertHashKind, row).Run(); err != nil {
+				return fmt.Errorf("inserting HashKind (table hash_kind): %w", err)
+			}
+		}
+		for _, row := range modelExport.HostnameAddress {
+			if err := tx.Query(ctx, stmtInsertHostnameAddress, row).Run(); err != nil {
+				return fmt.Errorf("inserting HostnameAddress (table hostname_address): %w", err)
+			}
+		}
+		for _, row := range modelExport.InstanceTag {
+			if err := tx.Query(ctx, stmtInsertInstanceTag, row).Run(); err != nil {
+				return fmt.Errorf("inserting InstanceTag (table instance_tag): %w", err)
+			}
+		}
+		for _, row := range modelExport.IpAddress {
+			if err := tx.Query(ctx, stmtInsertIpAddress, row).Run(); err != nil {
+				return fmt.Errorf("inserting IpAddress (table ip_address): %w", err)
+			}
+		}
+		for _, row := range modelExport.IpAddressConfigType {
+			if err := tx.Query(ctx, stmtInsertIpAddressConfigType, row).Run(); err != nil {
+				return fmt.Errorf("inserting IpAddressConfigType (table ip_address_config_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.IpAddressOrigin {
+			if err := tx.Query(ctx, stmtInsertIpAddressOrigin, row).Run(); err != nil {
+				return fmt.Errorf("inserting IpAddressOrigin (table ip_address_origin): %w", err)
+			}
+		}
+		for _, row := range modelExport.IpAddressScope {
+			if err := tx.Query(ctx, stmtInsertIpAddressScope, row).Run(); err != nil {
+				return fmt.Errorf("inserting IpAddressScope (table ip_address_scope): %w", err)
+			}
+		}
+		for _, row := range modelExport.IpAddressType {
+			if err := tx.Query(ctx, stmtInsertIpAddressType, row).Run(); err != nil {
+				return fmt.Errorf("inserting IpAddressType (table ip_address_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.K8sPod {
+			if err := tx.Query(ctx, stmtInsertK8sPod, row).Run(); err != nil {
+				return fmt.Errorf("inserting K8sPod (table k8s_pod): %w", err)
+			}
+		}
+		for _, row := range modelExport.K8sPodPort {
+			if err := tx.Query(ctx, stmtInsertK8sPodPort, row).Run(); err != nil {
+				return fmt.Errorf("inserting K8sPodPort (table k8s_pod_port): %w", err)
+			}
+		}
+		for _, row := range modelExport.K8sPodStatus {
+			if err := tx.Query(ctx, stmtInsertK8sPodStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting K8sPodStatus (table k8s_pod_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.K8sPodStatusValue {
+			if err := tx.Query(ctx, stmtInsertK8sPodStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting K8sPodStatusValue (table k8s_pod_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.K8sService {
+			if err := tx.Query(ctx, stmtInsertK8sService, row).Run(); err != nil {
+				return fmt.Errorf("inserting K8sService (table k8s_service): %w", err)
+			}
+		}
+		for _, row := range modelExport.Life {
+			if err := tx.Query(ctx, stmtInsertLife, row).Run(); err != nil {
+				return fmt.Errorf("inserting Life (table life): %w", err)
+			}
+		}
+		for _, row := range modelExport.LinkLayerDevice {
+			if err := tx.Query(ctx, stmtInsertLinkLayerDevice, row).Run(); err != nil {
+				return fmt.Errorf("inserting LinkLayerDevice (table link_layer_device): %w", err)
+			}
+		}
+		for _, row := range modelExport.LinkLayerDeviceDnsAddress {
+			if err := tx.Query(ctx, stmtInsertLinkLayerDeviceDnsAddress, row).Run(); err != nil {
+				return fmt.Errorf("inserting LinkLayerDeviceDnsAddress (table link_layer_device_dns_address): %w", err)
+			}
+		}
+		for _, row := range modelExport.LinkLayerDeviceDnsDomain {
+			if err := tx.Query(ctx, stmtInsertLinkLayerDeviceDnsDomain, row).Run(); err != nil {
+				return fmt.Errorf("inserting LinkLayerDeviceDnsDomain (table link_layer_device_dns_domain): %w", err)
+			}
+		}
+		for _, row := range modelExport.LinkLayerDeviceParent {
+			if err := tx.Query(ctx, stmtInsertLinkLayerDeviceParent, row).Run(); err != nil {
+				return fmt.Errorf("inserting LinkLayerDeviceParent (table link_layer_device_parent): %w", err)
+			}
+		}
+		for _, row := range modelExport.LinkLayerDeviceRoute {
+			if err := tx.Query(ctx, stmtInsertLinkLayerDeviceRoute, row).Run(); err != nil {
+				return fmt.Errorf("inserting LinkLayerDeviceRoute (table link_layer_device_route): %w", err)
+			}
+		}
+		for _, row := range modelExport.LinkLayerDeviceType {
+			if err := tx.Query(ctx, stmtInsertLinkLayerDeviceType, row).Run(); err != nil {
+				return fmt.Errorf("inserting LinkLayerDeviceType (table link_layer_device_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.Machine {
+			if err := tx.Query(ctx, stmtInsertMachine, row).Run(); err != nil {
+				return fmt.Errorf("inserting Machine (table machine): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineAgentPresence {
+			if err := tx.Query(ctx, stmtInsertMachineAgentPresence, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineAgentPresence (table machine_agent_presence): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineAgentVersion {
+			if err := tx.Query(ctx, stmtInsertMachineAgentVersion, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineAgentVersion (table machine_agent_version): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineCloudInstance {
+			if err := tx.Query(ctx, stmtInsertMachineCloudInstance, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineCloudInstance (table machine_cloud_instance): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineCloudInstanceStatus {
+			if err := tx.Query(ctx, stmtInsertMachineCloudInstanceStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineCloudInstanceStatus (table machine_cloud_instance_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineCloudInstanceStatusValue {
+			if err := tx.Query(ctx, stmtInsertMachineCloudInstanceStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineCloudInstanceStatusValue (table machine_cloud_instance_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineConstraint {
+			if err := tx.Query(ctx, stmtInsertMachineConstraint, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineConstraint (table machine_constraint): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineContainerType {
+			if err := tx.Query(ctx, stmtInsertMachineContainerType, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineContainerType (table machine_container_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineFilesystem {
+			if err := tx.Query(ctx, stmtInsertMachineFilesystem, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineFilesystem (table machine_filesystem): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineLxdProfile {
+			if err := tx.Query(ctx, stmtInsertMachineLxdProfile, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineLxdProfile (table machine_lxd_profile): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineManual {
+			if err := tx.Query(ctx, stmtInsertMachineManual, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineManual (table machine_manual): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineParent {
+			if err := tx.Query(ctx, stmtInsertMachineParent, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineParent (table machine_parent): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachinePlacement {
+			if err := tx.Query(ctx, stmtInsertMachinePlacement, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachinePlacement (table machine_placement): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachinePlacementScope {
+			if err := tx.Query(ctx, stmtInsertMachinePlacementScope, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachinePlacementScope (table machine_placement_scope): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachinePlatform {
+			if err := tx.Query(ctx, stmtInsertMachinePlatform, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachinePlatform (table machine_platform): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineRequiresReboot {
+			if err := tx.Query(ctx, stmtInsertMachineRequiresReboot, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineRequiresReboot (table machine_requires_reboot): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineSshHostKey {
+			if err := tx.Query(ctx, stmtInsertMachineSshHostKey, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineSshHostKey (table machine_ssh_host_key): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineStatus {
+			if err := tx.Query(ctx, stmtInsertMachineStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineStatus (table machine_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineStatusValue {
+			if err := tx.Query(ctx, stmtInsertMachineStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineStatusValue (table machine_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.MachineVolume {
+			if err := tx.Query(ctx, stmtInsertMachineVolume, row).Run(); err != nil {
+				return fmt.Errorf("inserting MachineVolume (table machine_volume): %w", err)
+			}
+		}
+		for _, row := range modelExport.Model {
+			if err := tx.Query(ctx, stmtInsertModel, row).Run(); err != nil {
+				return fmt.Errorf("inserting Model (table model): %w", err)
+			}
+		}
+		for _, row := range modelExport.ModelAgent {
+			if err := tx.Query(ctx, stmtInsertModelAgent, row).Run(); err != nil {
+				return fmt.Errorf("inserting ModelAgent (table model_agent): %w", err)
+			}
+		}
+		for _, row := range modelExport.ModelConfig {
+			if err := tx.Query(ctx, stmtInsertModelConfig, row).Run(); err != nil {
+				return fmt.Errorf("inserting ModelConfig (table model_config): %w", err)
+			}
+		}
+		for _, row := range modelExport.ModelConstraint {
+			if err := tx.Query(ctx, stmtInsertModelConstraint, row).Run(); err != nil {
+				return fmt.Errorf("inserting ModelConstraint (table model_constraint): %w", err)
+			}
+		}
+		for _, row := range modelExport.ModelLife {
+			if err := tx.Query(ctx, stmtInsertModelLife, row).Run(); err != nil {
+				return fmt.Errorf("inserting ModelLife (table model_life): %w", err)
+			}
+		}
+		for _, row := range modelExport.ModelStoragePool {
+			if err := tx.Query(ctx, stmtInsertModelStoragePool, row).Run(); err != nil {
+				return fmt.Errorf("inserting ModelStoragePool (table model_storage_pool): %w", err)
+			}
+		}
+		for _, row := range modelExport.NetNode {
+			if err := tx.Query(ctx, stmtInsertNetNode, row).Run(); err != nil {
+				return fmt.Errorf("inserting NetNode (table net_node): %w", err)
+			}
+		}
+		for _, row := range modelExport.NetNodeFqdnAddress {
+			if err := tx.Query(ctx, stmtInsertNetNodeFqdnAddress, row).Run(); err != nil {
+				return fmt.Errorf("inserting NetNodeFqdnAddress (table net_node_fqdn_address): %w", err)
+			}
+		}
+		for _, row := range modelExport.NetNodeHostnameAddress {
+			if err := tx.Query(ctx, stmtInsertNetNodeHostnameAddress, row).Run(); err != nil {
+				return fmt.Errorf("inserting NetNodeHostnameAddress (table net_node_hostname_address): %w", err)
+			}
+		}
+		for _, row := range modelExport.NetworkAddressScope {
+			if err := tx.Query(ctx, stmtInsertNetworkAddressScope, row).Run(); err != nil {
+				return fmt.Errorf("inserting NetworkAddressScope (table network_address_scope): %w", err)
+			}
+		}
+		for _, row := range modelExport.ObjectStoreMetadata {
+			if err := tx.Query(ctx, stmtInsertObjectStoreMetadata, row).Run(); err != nil {
+				return fmt.Errorf("inserting ObjectStoreMetadata (table object_store_metadata): %w", err)
+			}
+		}
+		for _, row := range modelExport.ObjectStoreMetadataPath {
+			if err := tx.Query(ctx, stmtInsertObjectStoreMetadataPath, row).Run(); err != nil {
+				return fmt.Errorf("inserting ObjectStoreMetadataPath (table object_store_metadata_path): %w", err)
+			}
+		}
+		for _, row := range modelExport.Offer {
+			if err := tx.Query(ctx, stmtInsertOffer, row).Run(); err != nil {
+				return fmt.Errorf("inserting Offer (table offer): %w", err)
+			}
+		}
+		for _, row := range modelExport.OfferConnection {
+			if err := tx.Query(ctx, stmtInsertOfferConnection, row).Run(); err != nil {
+				return fmt.Errorf("inserting OfferConnection (table offer_connection): %w", err)
+			}
+		}
+		for _, row := range modelExport.OfferEndpoint {
+			if err := tx.Query(ctx, stmtInsertOfferEndpoint, row).Run(); err != nil {
+				return fmt.Errorf("inserting OfferEndpoint (table offer_endpoint): %w", err)
+			}
+		}
+		for _, row := range modelExport.Operation {
+			if err := tx.Query(ctx, stmtInsertOperation, row).Run(); err != nil {
+				return fmt.Errorf("inserting Operation (table operation): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationAction {
+			if err := tx.Query(ctx, stmtInsertOperationAction, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationAction (table operation_action): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationMachineTask {
+			if err := tx.Query(ctx, stmtInsertOperationMachineTask, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationMachineTask (table operation_machine_task): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationParameter {
+			if err := tx.Query(ctx, stmtInsertOperationParameter, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationParameter (table operation_parameter): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationTask {
+			if err := tx.Query(ctx, stmtInsertOperationTask, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationTask (table operation_task): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationTaskLog {
+			if err := tx.Query(ctx, stmtInsertOperationTaskLog, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationTaskLog (table operation_task_log): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationTaskOutput {
+			if err := tx.Query(ctx, stmtInsertOperationTaskOutput, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationTaskOutput (table operation_task_output): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationTaskStatus {
+			if err := tx.Query(ctx, stmtInsertOperationTaskStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationTaskStatus (table operation_task_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationTaskStatusValue {
+			if err := tx.Query(ctx, stmtInsertOperationTaskStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationTaskStatusValue (table operation_task_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperationUnitTask {
+			if err := tx.Query(ctx, stmtInsertOperationUnitTask, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperationUnitTask (table operation_unit_task): %w", err)
+			}
+		}
+		for _, row := range modelExport.OperatorStatus {
+			if err := tx.Query(ctx, stmtInsertOperatorStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting OperatorStatus (table operator_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.Os {
+			if err := tx.Query(ctx, stmtInsertOs, row).Run(); err != nil {
+				return fmt.Errorf("inserting Os (table os): %w", err)
+			}
+		}
+		for _, row := range modelExport.PasswordHashAlgorithm {
+			if err := tx.Query(ctx, stmtInsertPasswordHashAlgorithm, row).Run(); err != nil {
+				return fmt.Errorf("inserting PasswordHashAlgorithm (table password_hash_algorithm): %w", err)
+			}
+		}
+		for _, row := range modelExport.PendingApplicationResource {
+			if err := tx.Query(ctx, stmtInsertPendingApplicationResource, row).Run(); err != nil {
+				return fmt.Errorf("inserting PendingApplicationResource (table pending_application_resource): %w", err)
+			}
+		}
+		for _, row := range modelExport.PortRange {
+			if err := tx.Query(ctx, stmtInsertPortRange, row).Run(); err != nil {
+				return fmt.Errorf("inserting PortRange (table port_range): %w", err)
+			}
+		}
+		for _, row := range modelExport.Protocol {
+			if err := tx.Query(ctx, stmtInsertProtocol, row).Run(); err != nil {
+				return fmt.Errorf("inserting Protocol (table protocol): %w", err)
+			}
+		}
+		for _, row := range modelExport.ProviderIpAddress {
+			if err := tx.Query(ctx, stmtInsertProviderIpAddress, row).Run(); err != nil {
+				return fmt.Errorf("inserting ProviderIpAddress (table provider_ip_address): %w", err)
+			}
+		}
+		for _, row := range modelExport.ProviderLinkLayerDevice {
+			if err := tx.Query(ctx, stmtInsertProviderLinkLayerDevice, row).Run(); err != nil {
+				return fmt.Errorf("inserting ProviderLinkLayerDevice (table provider_link_layer_device): %w", err)
+			}
+		}
+		for _, row := range modelExport.ProviderNetwork {
+			if err := tx.Query(ctx, stmtInsertProviderNetwork, row).Run(); err != nil {
+				return fmt.Errorf("inserting ProviderNetwork (table provider_network): %w", err)
+			}
+		}
+		for _, row := range modelExport.ProviderNetworkSubnet {
+			if err := tx.Query(ctx, stmtInsertProviderNetworkSubnet, row).Run(); err != nil {
+				return fmt.Errorf("inserting ProviderNetworkSubnet (table provider_network_subnet): %w", err)
+			}
+		}
+		for _, row := range modelExport.ProviderSpace {
+			if err := tx.Query(ctx, stmtInsertProviderSpace, row).Run(); err != nil {
+				return fmt.Errorf("inserting ProviderSpace (table provider_space): %w", err)
+			}
+		}
+		for _, row := range modelExport.ProviderSubnet {
+			if err := tx.Query(ctx, stmtInsertProviderSubnet, row).Run(); err != nil {
+				return fmt.Errorf("inserting ProviderSubnet (table provider_subnet): %w", err)
+			}
+		}
+		for _, row := range modelExport.Relation {
+			if err := tx.Query(ctx, stmtInsertRelation, row).Run(); err != nil {
+				return fmt.Errorf("inserting Relation (table relation): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationApplicationSetting {
+			if err := tx.Query(ctx, stmtInsertRelationApplicationSetting, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationApplicationSetting (table relation_application_setting): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationApplicationSettingsHash {
+			if err := tx.Query(ctx, stmtInsertRelationApplicationSettingsHash, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationApplicationSettingsHash (table relation_application_settings_hash): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationEndpoint {
+			if err := tx.Query(ctx, stmtInsertRelationEndpoint, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationEndpoint (table relation_endpoint): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationNetworkEgress {
+			if err := tx.Query(ctx, stmtInsertRelationNetworkEgress, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationNetworkEgress (table relation_network_egress): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationNetworkIngress {
+			if err := tx.Query(ctx, stmtInsertRelationNetworkIngress, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationNetworkIngress (table relation_network_ingress): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationStatus {
+			if err := tx.Query(ctx, stmtInsertRelationStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationStatus (table relation_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationStatusType {
+			if err := tx.Query(ctx, stmtInsertRelationStatusType, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationStatusType (table relation_status_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationUnit {
+			if err := tx.Query(ctx, stmtInsertRelationUnit, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationUnit (table relation_unit): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationUnitSetting {
+			if err := tx.Query(ctx, stmtInsertRelationUnitSetting, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationUnitSetting (table relation_unit_setting): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationUnitSettingArchive {
+			if err := tx.Query(ctx, stmtInsertRelationUnitSettingArchive, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationUnitSettingArchive (table relation_unit_setting_archive): %w", err)
+			}
+		}
+		for _, row := range modelExport.RelationUnitSettingsHash {
+			if err := tx.Query(ctx, stmtInsertRelationUnitSettingsHash, row).Run(); err != nil {
+				return fmt.Errorf("inserting RelationUnitSettingsHash (table relation_unit_settings_hash): %w", err)
+			}
+		}
+		for _, row := range modelExport.Removal {
+			if err := tx.Query(ctx, stmtInsertRemoval, row).Run(); err != nil {
+				return fmt.Errorf("inserting Removal (table removal): %w", err)
+			}
+		}
+		for _, row := range modelExport.RemovalStatus {
+			if err := tx.Query(ctx, stmtInsertRemovalStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting RemovalStatus (table removal_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.RemovalType {
+			if err := tx.Query(ctx, stmtInsertRemovalType, row).Run(); err != nil {
+				return fmt.Errorf("inserting RemovalType (table removal_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResolveMode {
+			if err := tx.Query(ctx, stmtInsertResolveMode, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResolveMode (table resolve_mode): %w", err)
+			}
+		}
+		for _, row := range modelExport.Resource {
+			if err := tx.Query(ctx, stmtInsertResource, row).Run(); err != nil {
+				return fmt.Errorf("inserting Resource (table resource): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResourceContainerImageMetadataStore {
+			if err := tx.Query(ctx, stmtInsertResourceContainerImageMetadataStore, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResourceContainerImageMetadataStore (table resource_container_image_metadata_store): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResourceFileStore {
+			if err := tx.Query(ctx, stmtInsertResourceFileStore, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResourceFileStore (table resource_file_store): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResourceImageStore {
+			if err := tx.Query(ctx, stmtInsertResourceImageStore, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResourceImageStore (table resource_image_store): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResourceOriginType {
+			if err := tx.Query(ctx, stmtInsertResourceOriginType, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResourceOriginType (table resource_origin_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResourceRetrievedBy {
+			if err := tx.Query(ctx, stmtInsertResourceRetrievedBy, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResourceRetrievedBy (table resource_retrieved_by): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResourceRetrievedByType {
+			if err := tx.Query(ctx, stmtInsertResourceRetrievedByType, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResourceRetrievedByType (table resource_retrieved_by_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.ResourceState {
+			if err := tx.Query(ctx, stmtInsertResourceState, row).Run(); err != nil {
+				return fmt.Errorf("inserting ResourceState (table resource_state): %w", err)
+			}
+		}
+		for _, row := range modelExport.Schema {
+			if err := tx.Query(ctx, stmtInsertSchema, row).Run(); err != nil {
+				return fmt.Errorf("inserting Schema (table schema): %w", err)
+			}
+		}
+		for _, row := range modelExport.Secret {
+			if err := tx.Query(ctx, stmtInsertSecret, row).Run(); err != nil {
+				return fmt.Errorf("inserting Secret (table secret): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretAccessAction {
+			if err := tx.Query(ctx, stmtInsertSecretAccessAction, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretAccessAction (table secret_access_action): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretAccessLog {
+			if err := tx.Query(ctx, stmtInsertSecretAccessLog, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretAccessLog (table secret_access_log): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretApplicationOwner {
+			if err := tx.Query(ctx, stmtInsertSecretApplicationOwner, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretApplicationOwner (table secret_application_owner): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretContent {
+			if err := tx.Query(ctx, stmtInsertSecretContent, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretContent (table secret_content): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretDataKey {
+			if err := tx.Query(ctx, stmtInsertSecretDataKey, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretDataKey (table secret_data_key): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretDeletedValueRef {
+			if err := tx.Query(ctx, stmtInsertSecretDeletedValueRef, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretDeletedValueRef (table secret_deleted_value_ref): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretGrantScopeType {
+			if err := tx.Query(ctx, stmtInsertSecretGrantScopeType, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretGrantScopeType (table secret_grant_scope_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretGrantSubjectType {
+			if err := tx.Query(ctx, stmtInsertSecretGrantSubjectType, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretGrantSubjectType (table secret_grant_subject_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretMetadata {
+			if err := tx.Query(ctx, stmtInsertSecretMetadata, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretMetadata (table secret_metadata): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretModelOwner {
+			if err := tx.Query(ctx, stmtInsertSecretModelOwner, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretModelOwner (table secret_model_owner): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretPermission {
+			if err := tx.Query(ctx, stmtInsertSecretPermission, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretPermission (table secret_permission): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretReference {
+			if err := tx.Query(ctx, stmtInsertSecretReference, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretReference (table secret_reference): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretRemoteUnitConsumer {
+			if err := tx.Query(ctx, stmtInsertSecretRemoteUnitConsumer, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretRemoteUnitConsumer (table secret_remote_unit_consumer): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretRevision {
+			if err := tx.Query(ctx, stmtInsertSecretRevision, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretRevision (table secret_revision): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretRevisionExpire {
+			if err := tx.Query(ctx, stmtInsertSecretRevisionExpire, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretRevisionExpire (table secret_revision_expire): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretRevisionObsolete {
+			if err := tx.Query(ctx, stmtInsertSecretRevisionObsolete, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretRevisionObsolete (table secret_revision_obsolete): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretRole {
+			if err := tx.Query(ctx, stmtInsertSecretRole, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretRole (table secret_role): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretRotatePolicy {
+			if err := tx.Query(ctx, stmtInsertSecretRotatePolicy, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretRotatePolicy (table secret_rotate_policy): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretRotation {
+			if err := tx.Query(ctx, stmtInsertSecretRotation, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretRotation (table secret_rotation): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretUnitConsumer {
+			if err := tx.Query(ctx, stmtInsertSecretUnitConsumer, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretUnitConsumer (table secret_unit_consumer): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretUnitOwner {
+			if err := tx.Query(ctx, stmtInsertSecretUnitOwner, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretUnitOwner (table secret_unit_owner): %w", err)
+			}
+		}
+		for _, row := range modelExport.SecretValueRef {
+			if err := tx.Query(ctx, stmtInsertSecretValueRef, row).Run(); err != nil {
+				return fmt.Errorf("inserting SecretValueRef (table secret_value_ref): %w", err)
+			}
+		}
+		for _, row := range modelExport.Sequence {
+			if err := tx.Query(ctx, stmtInsertSequence, row).Run(); err != nil {
+				return fmt.Errorf("inserting Sequence (table sequence): %w", err)
+			}
+		}
+		for _, row := range modelExport.Space {
+			if err := tx.Query(ctx, stmtInsertSpace, row).Run(); err != nil {
+				return fmt.Errorf("inserting Space (table space): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageAttachment {
+			if err := tx.Query(ctx, stmtInsertStorageAttachment, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageAttachment (table storage_attachment): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageFilesystem {
+			if err := tx.Query(ctx, stmtInsertStorageFilesystem, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageFilesystem (table storage_filesystem): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageFilesystemAttachment {
+			if err := tx.Query(ctx, stmtInsertStorageFilesystemAttachment, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageFilesystemAttachment (table storage_filesystem_attachment): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageFilesystemStatus {
+			if err := tx.Query(ctx, stmtInsertStorageFilesystemStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageFilesystemStatus (table storage_filesystem_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageFilesystemStatusValue {
+			if err := tx.Query(ctx, stmtInsertStorageFilesystemStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageFilesystemStatusValue (table storage_filesystem_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageInstance {
+			if err := tx.Query(ctx, stmtInsertStorageInstance, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageInstance (table storage_instance): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageInstanceFilesystem {
+			if err := tx.Query(ctx, stmtInsertStorageInstanceFilesystem, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageInstanceFilesystem (table storage_instance_filesystem): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageInstanceVolume {
+			if err := tx.Query(ctx, stmtInsertStorageInstanceVolume, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageInstanceVolume (table storage_instance_volume): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageKind {
+			if err := tx.Query(ctx, stmtInsertStorageKind, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageKind (table storage_kind): %w", err)
+			}
+		}
+		for _, row := range modelExport.StoragePool {
+			if err := tx.Query(ctx, stmtInsertStoragePool, row).Run(); err != nil {
+				return fmt.Errorf("inserting StoragePool (table storage_pool): %w", err)
+			}
+		}
+		for _, row := range modelExport.StoragePoolAttribute {
+			if err := tx.Query(ctx, stmtInsertStoragePoolAttribute, row).Run(); err != nil {
+				return fmt.Errorf("inserting StoragePoolAttribute (table storage_pool_attribute): %w", err)
+			}
+		}
+		for _, row := range modelExport.StoragePoolOrigin {
+			if err := tx.Query(ctx, stmtInsertStoragePoolOrigin, row).Run(); err != nil {
+				return fmt.Errorf("inserting StoragePoolOrigin (table storage_pool_origin): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageProvisionScope {
+			if err := tx.Query(ctx, stmtInsertStorageProvisionScope, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageProvisionScope (table storage_provision_scope): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageSnapshot {
+			if err := tx.Query(ctx, stmtInsertStorageSnapshot, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageSnapshot (table storage_snapshot): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageSnapshotStatusValue {
+			if err := tx.Query(ctx, stmtInsertStorageSnapshotStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageSnapshotStatusValue (table storage_snapshot_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageUnitOwner {
+			if err := tx.Query(ctx, stmtInsertStorageUnitOwner, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageUnitOwner (table storage_unit_owner): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageVolume {
+			if err := tx.Query(ctx, stmtInsertStorageVolume, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageVolume (table storage_volume): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageVolumeAttachment {
+			if err := tx.Query(ctx, stmtInsertStorageVolumeAttachment, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageVolumeAttachment (table storage_volume_attachment): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageVolumeAttachmentPlan {
+			if err := tx.Query(ctx, stmtInsertStorageVolumeAttachmentPlan, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageVolumeAttachmentPlan (table storage_volume_attachment_plan): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageVolumeAttachmentPlanAttr {
+			if err := tx.Query(ctx, stmtInsertStorageVolumeAttachmentPlanAttr, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageVolumeAttachmentPlanAttr (table storage_volume_attachment_plan_attr): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageVolumeDeviceType {
+			if err := tx.Query(ctx, stmtInsertStorageVolumeDeviceType, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageVolumeDeviceType (table storage_volume_device_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageVolumeStatus {
+			if err := tx.Query(ctx, stmtInsertStorageVolumeStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageVolumeStatus (table storage_volume_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.StorageVolumeStatusValue {
+			if err := tx.Query(ctx, stmtInsertStorageVolumeStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting StorageVolumeStatusValue (table storage_volume_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.Subnet {
+			if err := tx.Query(ctx, stmtInsertSubnet, row).Run(); err != nil {
+				return fmt.Errorf("inserting Subnet (table subnet): %w", err)
+			}
+		}
+		for _, row := range modelExport.Unit {
+			if err := tx.Query(ctx, stmtInsertUnit, row).Run(); err != nil {
+				return fmt.Errorf("inserting Unit (table unit): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitAgentPresence {
+			if err := tx.Query(ctx, stmtInsertUnitAgentPresence, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitAgentPresence (table unit_agent_presence): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitAgentStatus {
+			if err := tx.Query(ctx, stmtInsertUnitAgentStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitAgentStatus (table unit_agent_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitAgentStatusValue {
+			if err := tx.Query(ctx, stmtInsertUnitAgentStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitAgentStatusValue (table unit_agent_status_value): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitAgentVersion {
+			if err := tx.Query(ctx, stmtInsertUnitAgentVersion, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitAgentVersion (table unit_agent_version): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitHookHistory {
+			if err := tx.Query(ctx, stmtInsertUnitHookHistory, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitHookHistory (table unit_hook_history): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitHookHistoryKind {
+			if err := tx.Query(ctx, stmtInsertUnitHookHistoryKind, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitHookHistoryKind (table unit_hook_history_kind): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitPrincipal {
+			if err := tx.Query(ctx, stmtInsertUnitPrincipal, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitPrincipal (table unit_principal): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitResolved {
+			if err := tx.Query(ctx, stmtInsertUnitResolved, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitResolved (table unit_resolved): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitResource {
+			if err := tx.Query(ctx, stmtInsertUnitResource, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitResource (table unit_resource): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitState {
+			if err := tx.Query(ctx, stmtInsertUnitState, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitState (table unit_state): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitStateCharm {
+			if err := tx.Query(ctx, stmtInsertUnitStateCharm, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitStateCharm (table unit_state_charm): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitStateRelation {
+			if err := tx.Query(ctx, stmtInsertUnitStateRelation, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitStateRelation (table unit_state_relation): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitStorageDirective {
+			if err := tx.Query(ctx, stmtInsertUnitStorageDirective, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitStorageDirective (table unit_storage_directive): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitWorkloadStatus {
+			if err := tx.Query(ctx, stmtInsertUnitWorkloadStatus, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitWorkloadStatus (table unit_workload_status): %w", err)
+			}
+		}
+		for _, row := range modelExport.UnitWorkloadVersion {
+			if err := tx.Query(ctx, stmtInsertUnitWorkloadVersion, row).Run(); err != nil {
+				return fmt.Errorf("inserting UnitWorkloadVersion (table unit_workload_version): %w", err)
+			}
+		}
+		for _, row := range modelExport.VirtualPortType {
+			if err := tx.Query(ctx, stmtInsertVirtualPortType, row).Run(); err != nil {
+				return fmt.Errorf("inserting VirtualPortType (table virtual_port_type): %w", err)
+			}
+		}
+		for _, row := range modelExport.WorkloadStatusValue {
+			if err := tx.Query(ctx, stmtInsertWorkloadStatusValue, row).Run(); err != nil {
+				return fmt.Errorf("inserting WorkloadStatusValue (table workload_status_value): %w", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("loading model data: %w", err)
+	}
+
+	return nil
+}
@@ -4,10 +4,13 @@
 package model
 
 import (
+	"context"
 	"testing"
 
 	"github.com/juju/tc"
 
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain/schema"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 )
 
@@ -24,3 +27,32 @@ func (s *exportStateSuiteV4_0_4) TestExportRuns(c *tc.C) {
 	_, err := st.Export(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *exportStateSuiteV4_0_4) TestImport(c *tc.C) {
+	_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type)
+VALUES (?, 'controller-uuid', 'foo', 'prod', 'iaas', 'lxd', 'lxd');
+INSERT INTO model_config (key, value) VALUES ('logging-config', '<root>=INFO');
+`, s.ModelUUID())
+	c.Assert(err, tc.ErrorIsNil)
+
+	exported, err := NewState(s.TxnRunnerFactory()).Export(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	runner, _ := s.OpenDB(c)
+	s.ApplyDDLForRunner(c, &schematesting.SchemaApplier{
+		Schema: schema.ModelDDL(),
+	}, runner)
+	target := NewState(func(context.Context) (coredatabase.TxnRunner, error) {
+		return runner, nil
+	})
+
+	err = target.Import(c.Context(), exported)
+	c.Assert(err, tc.ErrorIsNil)
+
+	imported, err := target.Export(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(imported.Model, tc.DeepEquals, exported.Model)
+	c.Check(imported.ModelConfig, tc.DeepEquals, exported.ModelConfig)
+	c.Check(imported.Architecture, tc.DeepEquals, exported.Architecture)
+}
@@ -13,4 +13,11 @@ type ModelExport struct {
 	// Payload is export struct specific to the version,
 	// populated with a model's data.
 	Payload any `json:"payload" yaml:"payload"`
+
+	// SecretDataKeys holds the model's secret data keys, base64 encoded and
+	// keyed by data key UUID. They are exported unwrapped, as the
+	// key-encryption keys with which they are wrapped are held by the
+	// exporting controller. The importing controller wraps them with its own
+	// active key.
+	SecretDataKeys map[string]string `json:"secret_data_keys,omitempty" yaml:"secret_data_keys,omitempty"`
 }
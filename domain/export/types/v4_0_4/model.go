@@ -198,8 +198,9 @@ type ApplicationScale struct {
 }
 
 type ApplicationSetting struct {
-	ApplicationUUID string `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
-	Trust           *bool  `db:"trust" json:"trust" yaml:"trust"`
+	ApplicationUUID    string `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
+	Trust              *bool  `db:"trust" json:"trust" yaml:"trust"`
+	HookTimeoutSeconds *int64 `db:"hook_timeout_seconds" json:"hook_timeout_seconds" yaml:"hook_timeout_seconds"`
 }
 
 type ApplicationStatus struct {
@@ -1117,6 +1118,14 @@ type Removal struct {
 	Force         int64     `db:"force" json:"force" yaml:"force"`
 	ScheduledFor  time.Time `db:"scheduled_for" json:"scheduled_for" yaml:"scheduled_for"`
 	Arg           *string   `db:"arg" json:"arg" yaml:"arg"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at" yaml:"created_at"`
+}
+
+type RemovalStatus struct {
+	RemovalUUID     string     `db:"removal_uuid" json:"removal_uuid" yaml:"removal_uuid"`
+	Attempts        int64      `db:"attempts" json:"attempts" yaml:"attempts"`
+	LastAttemptedAt *time.Time `db:"last_attempted_at" json:"last_attempted_at" yaml:"last_attempted_at"`
+	LastError       *string    `db:"last_error" json:"last_error" yaml:"last_error"`
 }
 
 type RemovalType struct {
@@ -1193,6 +1202,24 @@ type Secret struct {
 	ID string `db:"id" json:"id" yaml:"id"`
 }
 
+type SecretAccessAction struct {
+	ID     *int64  `db:"id" json:"id" yaml:"id"`
+	Action *string `db:"action" json:"action" yaml:"action"`
+}
+
+type SecretAccessLog struct {
+	UUID         string    `db:"uuid" json:"uuid" yaml:"uuid"`
+	SecretID     string    `db:"secret_id" json:"secret_id" yaml:"secret_id"`
+	Revision     *int64    `db:"revision" json:"revision" yaml:"revision"`
+	ActionID     int64     `db:"action_id" json:"action_id" yaml:"action_id"`
+	AccessorKind string    `db:"accessor_kind" json:"accessor_kind" yaml:"accessor_kind"`
+	AccessorID   string    `db:"accessor_id" json:"accessor_id" yaml:"accessor_id"`
+	SubjectKind  *string   `db:"subject_kind" json:"subject_kind" yaml:"subject_kind"`
+	SubjectID    *string   `db:"subject_id" json:"subject_id" yaml:"subject_id"`
+	BackendID    *string   `db:"backend_id" json:"backend_id" yaml:"backend_id"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at" yaml:"created_at"`
+}
+
 type SecretApplicationOwner struct {
 	SecretID        string  `db:"secret_id" json:"secret_id" yaml:"secret_id"`
 	ApplicationUUID string  `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
@@ -1205,6 +1232,13 @@ type SecretContent struct {
 	Content      string `db:"content" json:"content" yaml:"content"`
 }
 
+type SecretDataKey struct {
+	UUID                 string    `db:"uuid" json:"uuid" yaml:"uuid"`
+	WrappedKey           string    `db:"wrapped_key" json:"wrapped_key" yaml:"wrapped_key"`
+	KeyEncryptionKeyUUID string    `db:"key_encryption_key_uuid" json:"key_encryption_key_uuid" yaml:"key_encryption_key_uuid"`
+	CreatedAt            time.Time `db:"created_at" json:"created_at" yaml:"created_at"`
+}
+
 type SecretDeletedValueRef struct {
 	RevisionUUID string `db:"revision_uuid" json:"revision_uuid" yaml:"revision_uuid"`
 	BackendUUID  string `db:"backend_uuid" json:"backend_uuid" yaml:"backend_uuid"`
@@ -1413,6 +1447,29 @@ type StorageProvisionScope struct {
 	Scope string `db:"scope" json:"scope" yaml:"scope"`
 }
 
+type StorageSnapshot struct {
+	UUID                string    `db:"uuid" json:"uuid" yaml:"uuid"`
+	SnapshotID          string    `db:"snapshot_id" json:"snapshot_id" yaml:"snapshot_id"`
+	StorageInstanceUUID string    `db:"storage_instance_uuid" json:"storage_instance_uuid" yaml:"storage_instance_uuid"`
+	StorageID           string    `db:"storage_id" json:"storage_id" yaml:"storage_id"`
+	StorageName         string    `db:"storage_name" json:"storage_name" yaml:"storage_name"`
+	StorageKindID       int64     `db:"storage_kind_id" json:"storage_kind_id" yaml:"storage_kind_id"`
+	StoragePoolUUID     string    `db:"storage_pool_uuid" json:"storage_pool_uuid" yaml:"storage_pool_uuid"`
+	StorageVolumeUUID   string    `db:"storage_volume_uuid" json:"storage_volume_uuid" yaml:"storage_volume_uuid"`
+	UnitUUID            *string   `db:"unit_uuid" json:"unit_uuid" yaml:"unit_uuid"`
+	StatusID            int64     `db:"status_id" json:"status_id" yaml:"status_id"`
+	Message             *string   `db:"message" json:"message" yaml:"message"`
+	ProviderID          *string   `db:"provider_id" json:"provider_id" yaml:"provider_id"`
+	SizeMib             *int64    `db:"size_mib" json:"size_mib" yaml:"size_mib"`
+	CreatedAt           time.Time `db:"created_at" json:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time `db:"updated_at" json:"updated_at" yaml:"updated_at"`
+}
+
+type StorageSnapshotStatusValue struct {
+	ID     *int64 `db:"id" json:"id" yaml:"id"`
+	Status string `db:"status" json:"status" yaml:"status"`
+}
+
 type StorageUnitOwner struct {
 	StorageInstanceUUID string `db:"storage_instance_uuid" json:"storage_instance_uuid" yaml:"storage_instance_uuid"`
 	UnitUUID            string `db:"unit_uuid" json:"unit_uuid" yaml:"unit_uuid"`
@@ -1429,6 +1486,7 @@ type StorageVolume struct {
 	Wwn                 *string `db:"wwn" json:"wwn" yaml:"wwn"`
 	Persistent          *bool   `db:"persistent" json:"persistent" yaml:"persistent"`
 	ObliterateOnCleanup *bool   `db:"obliterate_on_cleanup" json:"obliterate_on_cleanup" yaml:"obliterate_on_cleanup"`
+	SnapshotUUID        *string `db:"snapshot_uuid" json:"snapshot_uuid" yaml:"snapshot_uuid"`
 }
 
 type StorageVolumeAttachment struct {
@@ -1517,6 +1575,23 @@ type UnitAgentVersion struct {
 	ArchitectureID int64  `db:"architecture_id" json:"architecture_id" yaml:"architecture_id"`
 }
 
+type UnitHookHistory struct {
+	UUID       string    `db:"uuid" json:"uuid" yaml:"uuid"`
+	UnitUUID   string    `db:"unit_uuid" json:"unit_uuid" yaml:"unit_uuid"`
+	KindID     int64     `db:"kind_id" json:"kind_id" yaml:"kind_id"`
+	Name       string    `db:"name" json:"name" yaml:"name"`
+	RelationID *int64    `db:"relation_id" json:"relation_id" yaml:"relation_id"`
+	StartedAt  time.Time `db:"started_at" json:"started_at" yaml:"started_at"`
+	DurationMs int64     `db:"duration_ms" json:"duration_ms" yaml:"duration_ms"`
+	ExitCode   int64     `db:"exit_code" json:"exit_code" yaml:"exit_code"`
+	Error      *string   `db:"error" json:"error" yaml:"error"`
+}
+
+type UnitHookHistoryKind struct {
+	ID   *int64 `db:"id" json:"id" yaml:"id"`
+	Kind string `db:"kind" json:"kind" yaml:"kind"`
+}
+
 type UnitPrincipal struct {
 	UnitUUID      string `db:"unit_uuid" json:"unit_uuid" yaml:"unit_uuid"`
 	PrincipalUUID string `db:"principal_uuid" json:"principal_uuid" yaml:"principal_uuid"`
@@ -1757,6 +1832,7 @@ type ModelExport struct {
 	RelationUnitSettingArchive               []RelationUnitSettingArchive               `json:"relation_unit_setting_archive" yaml:"relation_unit_setting_archive"`
 	RelationUnitSettingsHash                 []RelationUnitSettingsHash                 `json:"relation_unit_settings_hash" yaml:"relation_unit_settings_hash"`
 	Removal                                  []Removal                                  `json:"removal" yaml:"removal"`
+	RemovalStatus                            []RemovalStatus                            `json:"removal_status" yaml:"removal_status"`
 	RemovalType                              []RemovalType                              `json:"removal_type" yaml:"removal_type"`
 	ResolveMode                              []ResolveMode                              `json:"resolve_mode" yaml:"resolve_mode"`
 	Resource                                 []Resource                                 `json:"resource" yaml:"resource"`
@@ -1769,8 +1845,11 @@ type ModelExport struct {
 	ResourceState                            []ResourceState                            `json:"resource_state" yaml:"resource_state"`
 	Schema                                   []Schema                                   `json:"schema" yaml:"schema"`
 	Secret                                   []Secret                                   `json:"secret" yaml:"secret"`
+	SecretAccessAction                       []SecretAccessAction                       `json:"secret_access_action" yaml:"secret_access_action"`
+	SecretAccessLog                          []SecretAccessLog                          `json:"secret_access_log" yaml:"secret_access_log"`
 	SecretApplicationOwner                   []SecretApplicationOwner                   `json:"secret_application_owner" yaml:"secret_application_owner"`
 	SecretContent                            []SecretContent                            `json:"secret_content" yaml:"secret_content"`
+	SecretDataKey                            []SecretDataKey                            `json:"secret_data_key" yaml:"secret_data_key"`
 	SecretDeletedValueRef                    []SecretDeletedValueRef                    `json:"secret_deleted_value_ref" yaml:"secret_deleted_value_ref"`
 	SecretGrantScopeType                     []SecretGrantScopeType                     `json:"secret_grant_scope_type" yaml:"secret_grant_scope_type"`
 	SecretGrantSubjectType                   []SecretGrantSubjectType                   `json:"secret_grant_subject_type" yaml:"secret_grant_subject_type"`
@@ -1803,6 +1882,8 @@ type ModelExport struct {
 	StoragePoolAttribute                     []StoragePoolAttribute                     `json:"storage_pool_attribute" yaml:"storage_pool_attribute"`
 	StoragePoolOrigin                        []StoragePoolOrigin                        `json:"storage_pool_origin" yaml:"storage_pool_origin"`
 	StorageProvisionScope                    []StorageProvisionScope                    `json:"storage_provision_scope" yaml:"storage_provision_scope"`
+	StorageSnapshot                          []StorageSnapshot                          `json:"storage_snapshot" yaml:"storage_snapshot"`
+	StorageSnapshotStatusValue               []StorageSnapshotStatusValue               `json:"storage_snapshot_status_value" yaml:"storage_snapshot_status_value"`
 	StorageUnitOwner                         []StorageUnitOwner                         `json:"storage_unit_owner" yaml:"storage_unit_owner"`
 	StorageVolume                            []StorageVolume                            `json:"storage_volume" yaml:"storage_volume"`
 	StorageVolumeAttachment                  []StorageVolumeAttachment                  `json:"storage_volume_attachment" yaml:"storage_volume_attachment"`
@@ -1817,6 +1898,8 @@ type ModelExport struct {
 	UnitAgentStatus                          []UnitAgentStatus                          `json:"unit_agent_status" yaml:"unit_agent_status"`
 	UnitAgentStatusValue                     []UnitAgentStatusValue                     `json:"unit_agent_status_value" yaml:"unit_agent_status_value"`
 	UnitAgentVersion                         []UnitAgentVersion                         `json:"unit_agent_version" yaml:"unit_agent_version"`
+	UnitHookHistory                          []UnitHookHistory                          `json:"unit_hook_history" yaml:"unit_hook_history"`
+	UnitHookHistoryKind                      []UnitHookHistoryKind                      `json:"unit_hook_history_kind" yaml:"unit_hook_history_kind"`
 	UnitPrincipal                            []UnitPrincipal                            `json:"unit_principal" yaml:"unit_principal"`
 	UnitResolved                             []UnitResolved                             `json:"unit_resolved" yaml:"unit_resolved"`
 	UnitResource                             []UnitResource                             `json:"unit_resource" yaml:"unit_resource"`
@@ -12,3 +12,9 @@ package export
 var ExportVersions = []string{
 	"4.0.4",
 }
+
+// ImportVersion returns the only export version that can be imported, which
+// is the version of the exports written by this controller.
+func ImportVersion() string {
+	return ExportVersions[len(ExportVersions)-1]
+}
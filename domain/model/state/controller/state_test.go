@@ -39,6 +39,7 @@ import (
 	"github.com/juju/juju/domain/secretbackend/bootstrap"
 	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/uuid"
@@ -162,7 +163,7 @@ func (m *stateSuite) SetUpTest(c *tc.C) {
 	)
 	c.Assert(err, tc.ErrorIsNil)
 
-	err = bootstrap.CreateDefaultBackends(coremodel.IAAS)(c.Context(), m.ControllerTxnRunner(), m.TxnRunner())
+	err = bootstrap.CreateDefaultBackends(coremodel.IAAS, encryption.NewFileKeyStore(c.MkDir()))(c.Context(), m.ControllerTxnRunner(), m.TxnRunner())
 	c.Assert(err, tc.ErrorIsNil)
 }
 
@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/domain/secretbackend/bootstrap"
 	changestreamtesting "github.com/juju/juju/internal/changestream/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
@@ -99,7 +100,7 @@ func insertModelDependencies(c *tc.C, dbTxnRunnerFactory database.TxnRunnerFacto
 	)
 	c.Assert(err, tc.ErrorIsNil)
 
-	err = bootstrap.CreateDefaultBackends(coremodel.IAAS)(c.Context(), dbTxnRunner, dbTxnRunner)
+	err = bootstrap.CreateDefaultBackends(coremodel.IAAS, encryption.NewFileKeyStore(c.MkDir()))(c.Context(), dbTxnRunner, dbTxnRunner)
 	c.Assert(err, tc.ErrorIsNil)
 
 	modelUUID := tc.Must0(c, coremodel.NewUUID)
@@ -32,6 +32,7 @@ import (
 	relation "github.com/juju/juju/domain/relation/modelmigration"
 	resource "github.com/juju/juju/domain/resource/modelmigration"
 	secret "github.com/juju/juju/domain/secret/modelmigration"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	sequence "github.com/juju/juju/domain/sequence/modelmigration"
 	status "github.com/juju/juju/domain/status/modelmigration"
 	storage "github.com/juju/juju/domain/storage/modelmigration"
@@ -52,6 +53,7 @@ func ImportOperations(
 	modelDefaultsProvider modelconfigservice.ModelDefaultsProvider,
 	storageRegistryGetter corestorage.ModelStorageRegistryGetter,
 	configGetter providertracker.EphemeralProviderConfigGetter,
+	secretEncryptionKeys secretbackendservice.KeyStore,
 	clock clock.Clock,
 	logger logger.Logger,
 ) {
@@ -97,7 +99,7 @@ func ImportOperations(
 	status.RegisterImport(coordinator, clock, logger.Child("status"))
 	resource.RegisterImport(coordinator, clock, logger.Child("resource"))
 	port.RegisterImport(coordinator, logger.Child("port"))
-	secret.RegisterImport(coordinator, logger.Child("secret"))
+	secret.RegisterImport(coordinator, secretEncryptionKeys, logger.Child("secret"))
 	crossmodelrelation.RegisterImportSecret(coordinator, clock, logger.Child("remotesecret"))
 	cloudimagemetadata.RegisterImport(coordinator, logger.Child("cloudimagemetadata"), clock)
 	unitstate.RegisterImport(coordinator, logger.Child("unitstate"))
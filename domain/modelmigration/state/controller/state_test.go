@@ -29,6 +29,7 @@ import (
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	"github.com/juju/juju/domain/secretbackend/bootstrap"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -142,7 +143,7 @@ func (s *stateSuite) SetUpTest(c *tc.C) {
 	)
 	c.Assert(err, tc.ErrorIsNil)
 
-	err = bootstrap.CreateDefaultBackends(coremodel.IAAS)(c.Context(), s.ControllerTxnRunner(), s.TxnRunner())
+	err = bootstrap.CreateDefaultBackends(coremodel.IAAS, encryption.NewFileKeyStore(c.MkDir()))(c.Context(), s.ControllerTxnRunner(), s.TxnRunner())
 	c.Assert(err, tc.ErrorIsNil)
 
 	s.createControllerModel(c, s.controllerModelUUID, s.userUUID)
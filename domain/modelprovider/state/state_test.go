@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/domain/secretbackend/bootstrap"
 	"github.com/juju/juju/internal/changestream/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 )
 
@@ -63,7 +64,7 @@ var (
 func (s *stateSuite) setupModel(c *tc.C) coremodel.UUID {
 	ctx := c.Context()
 
-	err := bootstrap.CreateDefaultBackends(coremodel.IAAS)(ctx, s.ControllerTxnRunner(), s.TxnRunner())
+	err := bootstrap.CreateDefaultBackends(coremodel.IAAS, encryption.NewFileKeyStore(c.MkDir()))(ctx, s.ControllerTxnRunner(), s.TxnRunner())
 	c.Assert(err, tc.ErrorIsNil)
 
 	userName, err := user.NewName("test-usertest")
@@ -18,6 +18,13 @@ const (
 	// removal job are invalid in some way.
 	RemovalJobArgsInvalid = errors.ConstError("removal job args invalid")
 
+	// RemovalJobNotFound indicates that a removal job does not exist.
+	RemovalJobNotFound = errors.ConstError("removal job not found")
+
+	// RemovalJobStarted indicates that a removal job can not be cancelled
+	// because execution of it has already been attempted.
+	RemovalJobStarted = errors.ConstError("removal job already started")
+
 	// RemovalModelRemoved indicates that a model removal job was
 	// attempted, but the model was already removed. This should cause the
 	// removal worker to remove itself.
@@ -0,0 +1,124 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// JobState describes methods for recording the execution of removal jobs,
+// and for operators to inspect and intervene in them.
+type JobState interface {
+	// GetAllJobStatuses returns all removal jobs along with their execution
+	// history.
+	GetAllJobStatuses(ctx context.Context) ([]removal.JobStatus, error)
+
+	// RecordJobAttempt increments the number of attempts for the removal job
+	// with the input UUID, and sets the time of the latest attempt.
+	RecordJobAttempt(ctx context.Context, jUUID string, attemptedAt time.Time) error
+
+	// RecordJobResult sets the error returned by the most recent execution
+	// of the removal job with the input UUID.
+	RecordJobResult(ctx context.Context, jUUID string, errMsg string) error
+
+	// ForceJob sets the force flag on the removal job with the input UUID.
+	ForceJob(ctx context.Context, jUUID string) error
+
+	// CancelJob deletes the removal job with the input UUID, provided that
+	// its execution has not been attempted.
+	CancelJob(ctx context.Context, jUUID string) error
+}
+
+// GetAllJobStatuses returns all removal jobs along with the number of times
+// that each has been attempted and the error from its latest attempt.
+func (s *Service) GetAllJobStatuses(ctx context.Context) ([]removal.JobStatus, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	jobs, err := s.modelState.GetAllJobStatuses(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return jobs, nil
+}
+
+// RunJob records an attempt to execute the input removal job, executes it
+// via [Service.ExecuteJob], then records the outcome. If the job was
+// cancelled after it was retrieved, it is not executed.
+func (s *Service) RunJob(ctx context.Context, job removal.Job) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	err := s.modelState.RecordJobAttempt(ctx, job.UUID.String(), s.clock.Now().UTC())
+	if errors.Is(err, removalerrors.RemovalJobNotFound) {
+		s.logger.Infof(ctx, "removal job for %s %q no longer exists", job.RemovalType, job.EntityUUID)
+		return nil
+	} else if err != nil {
+		return errors.Errorf("recording attempt of removal job %q: %w", job.UUID, err)
+	}
+
+	execErr := s.ExecuteJob(ctx, job)
+
+	// The model database is being torn down,
+	// so there is nowhere to record the outcome.
+	if errors.Is(execErr, removalerrors.RemovalModelRemoved) {
+		return execErr
+	}
+
+	var errMsg string
+	if execErr != nil {
+		errMsg = execErr.Error()
+	}
+	if err := s.modelState.RecordJobResult(ctx, job.UUID.String(), errMsg); err != nil {
+		s.logger.Warningf(ctx, "recording result of removal job %q: %v", job.UUID, err)
+	}
+	return execErr
+}
+
+// ForceJob escalates the removal job with the input UUID so that it is
+// executed with force from its next attempt onwards.
+// The following errors may be returned:
+// - [coreerrors.NotValid] if the job UUID is not valid.
+// - [removalerrors.RemovalJobNotFound] if the job does not exist.
+func (s *Service) ForceJob(ctx context.Context, jobUUID removal.UUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := jobUUID.Validate(); err != nil {
+		return errors.Errorf("validating removal job UUID: %w", err).Add(coreerrors.NotValid)
+	}
+
+	if err := s.modelState.ForceJob(ctx, jobUUID.String()); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// CancelJob deletes the removal job with the input UUID if it has not yet
+// been attempted. The entity that the job was to remove retains its current
+// life.
+// The following errors may be returned:
+// - [coreerrors.NotValid] if the job UUID is not valid.
+// - [removalerrors.RemovalJobNotFound] if the job does not exist.
+// - [removalerrors.RemovalJobStarted] if the job has been attempted.
+func (s *Service) CancelJob(ctx context.Context, jobUUID removal.UUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := jobUUID.Validate(); err != nil {
+		return errors.Errorf("validating removal job UUID: %w", err).Add(coreerrors.NotValid)
+	}
+
+	if err := s.modelState.CancelJob(ctx, jobUUID.String()); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+)
+
+type jobSuite struct {
+	baseSuite
+}
+
+func TestJobSuite(t *testing.T) {
+	tc.Run(t, &jobSuite{})
+}
+
+func (s *jobSuite) TestRunJobRecordsAttemptAndError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	j := newRelationJob(c)
+	j.RemovalType = 500
+
+	now := time.Now()
+	s.clock.EXPECT().Now().Return(now)
+
+	exp := s.modelState.EXPECT()
+	exp.RecordJobAttempt(gomock.Any(), j.UUID.String(), now.UTC()).Return(nil)
+	exp.RecordJobResult(gomock.Any(), j.UUID.String(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, errMsg string) error {
+			c.Check(errMsg, tc.Contains, "not supported")
+			return nil
+		})
+
+	err := s.newService(c).RunJob(c.Context(), j)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobTypeNotSupported)
+}
+
+func (s *jobSuite) TestRunJobCancelled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	j := newRelationJob(c)
+
+	now := time.Now()
+	s.clock.EXPECT().Now().Return(now)
+	s.modelState.EXPECT().RecordJobAttempt(gomock.Any(), j.UUID.String(), now.UTC()).Return(
+		removalerrors.RemovalJobNotFound)
+
+	err := s.newService(c).RunJob(c.Context(), j)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *jobSuite) TestForceJob(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := tc.Must(c, removal.NewUUID)
+	s.modelState.EXPECT().ForceJob(gomock.Any(), jUUID.String()).Return(nil)
+
+	err := s.newService(c).ForceJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *jobSuite) TestForceJobInvalidUUID(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.newService(c).ForceJob(c.Context(), "not-a-uuid")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *jobSuite) TestCancelJobStarted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := tc.Must(c, removal.NewUUID)
+	s.modelState.EXPECT().CancelJob(gomock.Any(), jUUID.String()).Return(removalerrors.RemovalJobStarted)
+
+	err := s.newService(c).CancelJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobStarted)
+}
@@ -576,6 +576,44 @@ func (c *MockModelDBStateApplicationScheduleRemovalCall) DoAndReturn(f func(cont
 	return c
 }
 
+// CancelJob mocks base method.
+func (m *MockModelDBState) CancelJob(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockModelDBStateMockRecorder) CancelJob(arg0, arg1 any) *MockModelDBStateCancelJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockModelDBState)(nil).CancelJob), arg0, arg1)
+	return &MockModelDBStateCancelJobCall{Call: call}
+}
+
+// MockModelDBStateCancelJobCall wrap *gomock.Call
+type MockModelDBStateCancelJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateCancelJobCall) Return(arg0 error) *MockModelDBStateCancelJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateCancelJobCall) Do(f func(context.Context, string) error) *MockModelDBStateCancelJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateCancelJobCall) DoAndReturn(f func(context.Context, string) error) *MockModelDBStateCancelJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CheckStorageInstanceHasNoChildren mocks base method.
 func (m *MockModelDBState) CheckStorageInstanceHasNoChildren(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2263,6 +2301,83 @@ func (c *MockModelDBStateFilesystemScheduleRemovalCall) DoAndReturn(f func(conte
 	return c
 }
 
+// ForceJob mocks base method.
+func (m *MockModelDBState) ForceJob(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceJob indicates an expected call of ForceJob.
+func (mr *MockModelDBStateMockRecorder) ForceJob(arg0, arg1 any) *MockModelDBStateForceJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceJob", reflect.TypeOf((*MockModelDBState)(nil).ForceJob), arg0, arg1)
+	return &MockModelDBStateForceJobCall{Call: call}
+}
+
+// MockModelDBStateForceJobCall wrap *gomock.Call
+type MockModelDBStateForceJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateForceJobCall) Return(arg0 error) *MockModelDBStateForceJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateForceJobCall) Do(f func(context.Context, string) error) *MockModelDBStateForceJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateForceJobCall) DoAndReturn(f func(context.Context, string) error) *MockModelDBStateForceJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllJobStatuses mocks base method.
+func (m *MockModelDBState) GetAllJobStatuses(arg0 context.Context) ([]removal.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllJobStatuses", arg0)
+	ret0, _ := ret[0].([]removal.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllJobStatuses indicates an expected call of GetAllJobStatuses.
+func (mr *MockModelDBStateMockRecorder) GetAllJobStatuses(arg0 any) *MockModelDBStateGetAllJobStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllJobStatuses", reflect.TypeOf((*MockModelDBState)(nil).GetAllJobStatuses), arg0)
+	return &MockModelDBStateGetAllJobStatusesCall{Call: call}
+}
+
+// MockModelDBStateGetAllJobStatusesCall wrap *gomock.Call
+type MockModelDBStateGetAllJobStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetAllJobStatusesCall) Return(arg0 []removal.JobStatus, arg1 error) *MockModelDBStateGetAllJobStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetAllJobStatusesCall) Do(f func(context.Context) ([]removal.JobStatus, error)) *MockModelDBStateGetAllJobStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetAllJobStatusesCall) DoAndReturn(f func(context.Context) ([]removal.JobStatus, error)) *MockModelDBStateGetAllJobStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAllJobs mocks base method.
 func (m *MockModelDBState) GetAllJobs(arg0 context.Context) ([]removal.Job, error) {
 	m.ctrl.T.Helper()
@@ -4163,6 +4278,238 @@ func (c *MockModelDBStateOfferExistsCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// PlanApplicationRemoval mocks base method.
+func (m *MockModelDBState) PlanApplicationRemoval(arg0 context.Context, arg1 string, arg2 bool) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanApplicationRemoval", arg0, arg1, arg2)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanApplicationRemoval indicates an expected call of PlanApplicationRemoval.
+func (mr *MockModelDBStateMockRecorder) PlanApplicationRemoval(arg0, arg1, arg2 any) *MockModelDBStatePlanApplicationRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanApplicationRemoval", reflect.TypeOf((*MockModelDBState)(nil).PlanApplicationRemoval), arg0, arg1, arg2)
+	return &MockModelDBStatePlanApplicationRemovalCall{Call: call}
+}
+
+// MockModelDBStatePlanApplicationRemovalCall wrap *gomock.Call
+type MockModelDBStatePlanApplicationRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStatePlanApplicationRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockModelDBStatePlanApplicationRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStatePlanApplicationRemovalCall) Do(f func(context.Context, string, bool) (removal.Plan, error)) *MockModelDBStatePlanApplicationRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStatePlanApplicationRemovalCall) DoAndReturn(f func(context.Context, string, bool) (removal.Plan, error)) *MockModelDBStatePlanApplicationRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PlanMachineRemoval mocks base method.
+func (m *MockModelDBState) PlanMachineRemoval(arg0 context.Context, arg1 string) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanMachineRemoval", arg0, arg1)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanMachineRemoval indicates an expected call of PlanMachineRemoval.
+func (mr *MockModelDBStateMockRecorder) PlanMachineRemoval(arg0, arg1 any) *MockModelDBStatePlanMachineRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanMachineRemoval", reflect.TypeOf((*MockModelDBState)(nil).PlanMachineRemoval), arg0, arg1)
+	return &MockModelDBStatePlanMachineRemovalCall{Call: call}
+}
+
+// MockModelDBStatePlanMachineRemovalCall wrap *gomock.Call
+type MockModelDBStatePlanMachineRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStatePlanMachineRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockModelDBStatePlanMachineRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStatePlanMachineRemovalCall) Do(f func(context.Context, string) (removal.Plan, error)) *MockModelDBStatePlanMachineRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStatePlanMachineRemovalCall) DoAndReturn(f func(context.Context, string) (removal.Plan, error)) *MockModelDBStatePlanMachineRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PlanModelRemoval mocks base method.
+func (m *MockModelDBState) PlanModelRemoval(arg0 context.Context, arg1 bool) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanModelRemoval", arg0, arg1)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanModelRemoval indicates an expected call of PlanModelRemoval.
+func (mr *MockModelDBStateMockRecorder) PlanModelRemoval(arg0, arg1 any) *MockModelDBStatePlanModelRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanModelRemoval", reflect.TypeOf((*MockModelDBState)(nil).PlanModelRemoval), arg0, arg1)
+	return &MockModelDBStatePlanModelRemovalCall{Call: call}
+}
+
+// MockModelDBStatePlanModelRemovalCall wrap *gomock.Call
+type MockModelDBStatePlanModelRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStatePlanModelRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockModelDBStatePlanModelRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStatePlanModelRemovalCall) Do(f func(context.Context, bool) (removal.Plan, error)) *MockModelDBStatePlanModelRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStatePlanModelRemovalCall) DoAndReturn(f func(context.Context, bool) (removal.Plan, error)) *MockModelDBStatePlanModelRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PlanUnitRemoval mocks base method.
+func (m *MockModelDBState) PlanUnitRemoval(arg0 context.Context, arg1 string, arg2 bool) (removal.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanUnitRemoval", arg0, arg1, arg2)
+	ret0, _ := ret[0].(removal.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanUnitRemoval indicates an expected call of PlanUnitRemoval.
+func (mr *MockModelDBStateMockRecorder) PlanUnitRemoval(arg0, arg1, arg2 any) *MockModelDBStatePlanUnitRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanUnitRemoval", reflect.TypeOf((*MockModelDBState)(nil).PlanUnitRemoval), arg0, arg1, arg2)
+	return &MockModelDBStatePlanUnitRemovalCall{Call: call}
+}
+
+// MockModelDBStatePlanUnitRemovalCall wrap *gomock.Call
+type MockModelDBStatePlanUnitRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStatePlanUnitRemovalCall) Return(arg0 removal.Plan, arg1 error) *MockModelDBStatePlanUnitRemovalCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStatePlanUnitRemovalCall) Do(f func(context.Context, string, bool) (removal.Plan, error)) *MockModelDBStatePlanUnitRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStatePlanUnitRemovalCall) DoAndReturn(f func(context.Context, string, bool) (removal.Plan, error)) *MockModelDBStatePlanUnitRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordJobAttempt mocks base method.
+func (m *MockModelDBState) RecordJobAttempt(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordJobAttempt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordJobAttempt indicates an expected call of RecordJobAttempt.
+func (mr *MockModelDBStateMockRecorder) RecordJobAttempt(arg0, arg1, arg2 any) *MockModelDBStateRecordJobAttemptCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordJobAttempt", reflect.TypeOf((*MockModelDBState)(nil).RecordJobAttempt), arg0, arg1, arg2)
+	return &MockModelDBStateRecordJobAttemptCall{Call: call}
+}
+
+// MockModelDBStateRecordJobAttemptCall wrap *gomock.Call
+type MockModelDBStateRecordJobAttemptCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateRecordJobAttemptCall) Return(arg0 error) *MockModelDBStateRecordJobAttemptCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateRecordJobAttemptCall) Do(f func(context.Context, string, time.Time) error) *MockModelDBStateRecordJobAttemptCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateRecordJobAttemptCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockModelDBStateRecordJobAttemptCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordJobResult mocks base method.
+func (m *MockModelDBState) RecordJobResult(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordJobResult", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordJobResult indicates an expected call of RecordJobResult.
+func (mr *MockModelDBStateMockRecorder) RecordJobResult(arg0, arg1, arg2 any) *MockModelDBStateRecordJobResultCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordJobResult", reflect.TypeOf((*MockModelDBState)(nil).RecordJobResult), arg0, arg1, arg2)
+	return &MockModelDBStateRecordJobResultCall{Call: call}
+}
+
+// MockModelDBStateRecordJobResultCall wrap *gomock.Call
+type MockModelDBStateRecordJobResultCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateRecordJobResultCall) Return(arg0 error) *MockModelDBStateRecordJobResultCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateRecordJobResultCall) Do(f func(context.Context, string, string) error) *MockModelDBStateRecordJobResultCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateRecordJobResultCall) DoAndReturn(f func(context.Context, string, string) error) *MockModelDBStateRecordJobResultCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RelationExists mocks base method.
 func (m *MockModelDBState) RelationExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,131 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/unit"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	modelerrors "github.com/juju/juju/domain/model/errors"
+	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/internal/errors"
+)
+
+// PlanState describes methods for computing what a removal would affect,
+// without performing it.
+type PlanState interface {
+	// PlanApplicationRemoval returns the entities that would be removed along
+	// with the application identified by the input UUID.
+	PlanApplicationRemoval(ctx context.Context, appUUID string, destroyStorage bool) (removal.Plan, error)
+
+	// PlanUnitRemoval returns the entities that would be removed along with
+	// the unit identified by the input UUID.
+	PlanUnitRemoval(ctx context.Context, unitUUID string, destroyStorage bool) (removal.Plan, error)
+
+	// PlanMachineRemoval returns the entities that would be removed along
+	// with the machine identified by the input UUID.
+	PlanMachineRemoval(ctx context.Context, machineUUID string) (removal.Plan, error)
+
+	// PlanModelRemoval returns every entity that would be removed along with
+	// the model.
+	PlanModelRemoval(ctx context.Context, destroyStorage bool) (removal.Plan, error)
+}
+
+// PlanApplicationRemoval returns the units, relations, machines, offers and
+// storage instances that would be removed along with the application
+// identified by the input UUID, and which of them would require force.
+// Nothing is removed.
+func (s *Service) PlanApplicationRemoval(
+	ctx context.Context, appUUID coreapplication.UUID, destroyStorage bool,
+) (removal.Plan, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.ApplicationExists(ctx, appUUID.String())
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("checking if application %q exists: %w", appUUID, err)
+	} else if !exists {
+		return removal.Plan{}, errors.Errorf("application %q does not exist", appUUID).Add(applicationerrors.ApplicationNotFound)
+	}
+
+	plan, err := s.modelState.PlanApplicationRemoval(ctx, appUUID.String(), destroyStorage)
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("planning removal of application %q: %w", appUUID, err)
+	}
+	return plan, nil
+}
+
+// PlanUnitRemoval returns the machine and storage instances that would be
+// removed along with the unit identified by the input UUID, and which of
+// them would require force. Nothing is removed.
+func (s *Service) PlanUnitRemoval(
+	ctx context.Context, unitUUID unit.UUID, destroyStorage bool,
+) (removal.Plan, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.UnitExists(ctx, unitUUID.String())
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("checking if unit exists: %w", err)
+	} else if !exists {
+		return removal.Plan{}, errors.Errorf("unit does not exist").Add(applicationerrors.UnitNotFound)
+	}
+
+	plan, err := s.modelState.PlanUnitRemoval(ctx, unitUUID.String(), destroyStorage)
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("planning removal of unit %q: %w", unitUUID, err)
+	}
+	return plan, nil
+}
+
+// PlanMachineRemoval returns the units, containers and storage instances
+// that would be removed along with the machine identified by the input UUID,
+// and which of them would require force. Nothing is removed.
+func (s *Service) PlanMachineRemoval(ctx context.Context, machineUUID machine.UUID) (removal.Plan, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.MachineExists(ctx, machineUUID.String())
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("checking if machine exists: %w", err)
+	} else if !exists {
+		return removal.Plan{}, errors.Errorf("machine does not exist").Add(machineerrors.MachineNotFound)
+	}
+
+	plan, err := s.modelState.PlanMachineRemoval(ctx, machineUUID.String())
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("planning removal of machine %q: %w", machineUUID, err)
+	}
+	return plan, nil
+}
+
+// PlanModelRemoval returns every application, unit, relation, machine, offer
+// and storage instance that would be removed along with the model identified
+// by the input UUID, and which of them would require force. Nothing is
+// removed.
+func (s *Service) PlanModelRemoval(
+	ctx context.Context, modelUUID model.UUID, destroyStorage bool,
+) (removal.Plan, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.ModelExists(ctx, modelUUID.String())
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("checking if model exists: %w", err)
+	} else if !exists {
+		return removal.Plan{}, errors.Errorf("model does not exist").Add(modelerrors.NotFound)
+	}
+
+	plan, err := s.modelState.PlanModelRemoval(ctx, destroyStorage)
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("planning removal of model %q: %w", modelUUID, err)
+	}
+	return plan, nil
+}
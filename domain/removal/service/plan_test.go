@@ -0,0 +1,84 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreapplication "github.com/juju/juju/core/application"
+	machinetesting "github.com/juju/juju/core/machine/testing"
+	unittesting "github.com/juju/juju/core/unit/testing"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/domain/removal"
+)
+
+type planSuite struct {
+	baseSuite
+}
+
+func TestPlanSuite(t *testing.T) {
+	tc.Run(t, &planSuite{})
+}
+
+func (s *planSuite) TestPlanApplicationRemoval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+	expected := removal.Plan{
+		Applications: []removal.PlannedRemoval{{UUID: appUUID.String(), Name: "app"}},
+		Units:        []removal.PlannedRemoval{{UUID: "unit-uuid", Name: "app/0"}},
+	}
+
+	exp := s.modelState.EXPECT()
+	exp.ApplicationExists(gomock.Any(), appUUID.String()).Return(true, nil)
+	exp.PlanApplicationRemoval(gomock.Any(), appUUID.String(), true).Return(expected, nil)
+
+	plan, err := s.newService(c).PlanApplicationRemoval(c.Context(), appUUID, true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(plan, tc.DeepEquals, expected)
+}
+
+func (s *planSuite) TestPlanApplicationRemovalNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+
+	s.modelState.EXPECT().ApplicationExists(gomock.Any(), appUUID.String()).Return(false, nil)
+
+	_, err := s.newService(c).PlanApplicationRemoval(c.Context(), appUUID, false)
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *planSuite) TestPlanUnitRemoval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uUUID := unittesting.GenUnitUUID(c)
+	expected := removal.Plan{
+		Units: []removal.PlannedRemoval{{UUID: uUUID.String(), Name: "app/0", ForceRequired: "unit is in an error state"}},
+	}
+
+	exp := s.modelState.EXPECT()
+	exp.UnitExists(gomock.Any(), uUUID.String()).Return(true, nil)
+	exp.PlanUnitRemoval(gomock.Any(), uUUID.String(), false).Return(expected, nil)
+
+	plan, err := s.newService(c).PlanUnitRemoval(c.Context(), uUUID, false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(plan, tc.DeepEquals, expected)
+	c.Check(plan.ForceRequired(), tc.Equals, true)
+}
+
+func (s *planSuite) TestPlanMachineRemovalNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mUUID := machinetesting.GenUUID(c)
+
+	s.modelState.EXPECT().MachineExists(gomock.Any(), mUUID.String()).Return(false, nil)
+
+	_, err := s.newService(c).PlanMachineRemoval(c.Context(), mUUID)
+	c.Assert(err, tc.ErrorIs, machineerrors.MachineNotFound)
+}
@@ -52,6 +52,8 @@ type ModelDBState interface {
 	RelationWithRemoteConsumer
 	OfferState
 	SecretModelState
+	PlanState
+	JobState
 
 	// GetAllJobs returns all removal jobs.
 	GetAllJobs(ctx context.Context) ([]removal.Job, error)
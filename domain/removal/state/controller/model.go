@@ -360,6 +360,8 @@ func (st *State) removeBasicModelData(ctx context.Context, tx *sqlair.TX, mUUID
 	tables := []string{
 		"DELETE FROM model_namespace WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM model_secret_backend WHERE model_uuid = $entityUUID.uuid",
+		"DELETE FROM secret_backend_drain_revision WHERE model_uuid = $entityUUID.uuid",
+		"DELETE FROM secret_backend_drain WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM secret_backend_reference WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM model_authorized_keys WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM model_last_login WHERE model_uuid = $entityUUID.uuid",
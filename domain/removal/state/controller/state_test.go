@@ -28,6 +28,7 @@ import (
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	"github.com/juju/juju/domain/secretbackend/bootstrap"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -148,7 +149,7 @@ func (m *baseSuite) SetUpTest(c *tc.C) {
 	)
 	c.Assert(err, tc.ErrorIsNil)
 
-	err = bootstrap.CreateDefaultBackends(coremodel.IAAS)(c.Context(), m.ControllerTxnRunner(), m.TxnRunner())
+	err = bootstrap.CreateDefaultBackends(coremodel.IAAS, encryption.NewFileKeyStore(c.MkDir()))(c.Context(), m.ControllerTxnRunner(), m.TxnRunner())
 	c.Assert(err, tc.ErrorIsNil)
 
 	err = m.TxnRunner().Txn(c.Context(), func(ctx context.Context, tx *sqlair.TX) error {
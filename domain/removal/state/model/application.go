@@ -94,6 +94,19 @@ func (st *State) EnsureApplicationNotAliveCascade(
 		return res, errors.Capture(err)
 	}
 
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		res, err = st.ensureApplicationNotAliveCascade(ctx, tx, aUUID, destroyStorage)
+		return errors.Capture(err)
+	})
+	return res, errors.Capture(err)
+}
+
+func (st *State) ensureApplicationNotAliveCascade(
+	ctx context.Context, tx *sqlair.TX, aUUID string, destroyStorage bool,
+) (internal.CascadedApplicationLives, error) {
+	var res internal.CascadedApplicationLives
+
 	applicationUUID := entityUUID{UUID: aUUID}
 	updateApplicationStmt, err := st.Prepare(`
 UPDATE application
@@ -138,58 +151,56 @@ AND    life_id < 2`, applicationUUID)
 		return res, errors.Errorf("preparing unit uuids query: %w", err)
 	}
 
-	if err := errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if err := tx.Query(ctx, updateApplicationStmt, applicationUUID).Run(); err != nil {
-			return errors.Errorf("advancing application life: %w", err)
-		}
-
-		var relationUUIDs []entityUUID
-		if err := tx.Query(
-			ctx, selectRelationUUIDsStmt, applicationUUID,
-		).GetAll(&relationUUIDs); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
-			return errors.Errorf("selecting relation UUIDs: %w", err)
-		}
-		res.RelationUUIDs = transform.Slice(relationUUIDs, func(e entityUUID) string { return e.UUID })
+	if err := tx.Query(ctx, updateApplicationStmt, applicationUUID).Run(); err != nil {
+		return res, errors.Errorf("advancing application life: %w", err)
+	}
 
-		if len(res.RelationUUIDs) > 0 {
-			if err := tx.Query(ctx, updateRelationStmt, uuids(res.RelationUUIDs)).Run(); err != nil {
-				return errors.Errorf("advancing relation life: %w", err)
-			}
-		}
+	var relationUUIDs []entityUUID
+	if err := tx.Query(
+		ctx, selectRelationUUIDsStmt, applicationUUID,
+	).GetAll(&relationUUIDs); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return res, errors.Errorf("selecting relation UUIDs: %w", err)
+	}
+	res.RelationUUIDs = transform.Slice(relationUUIDs, func(e entityUUID) string { return e.UUID })
 
-		var unitUUIDsRec []entityUUID
-		if err := tx.Query(
-			ctx, selectUnitUUIDsStmt, applicationUUID,
-		).GetAll(&unitUUIDsRec); errors.Is(err, sqlair.ErrNoRows) {
-			// If there are no units associated with the application,
-			// we can just return nil, as there is nothing to update.
-			return nil
-		} else if err != nil {
-			return errors.Errorf("selecting associated application unit lives: %w", err)
+	if len(res.RelationUUIDs) > 0 {
+		if err := tx.Query(ctx, updateRelationStmt, uuids(res.RelationUUIDs)).Run(); err != nil {
+			return res, errors.Errorf("advancing relation life: %w", err)
 		}
+	}
 
-		const checkEmptyMachine = true
-		res.UnitUUIDs = transform.Slice(unitUUIDsRec, func(e entityUUID) string { return e.UUID })
-		for _, u := range res.UnitUUIDs {
-			cascaded, err := st.ensureUnitNotAliveCascade(
-				ctx, tx, u, checkEmptyMachine, destroyStorage,
-			)
-			if err != nil {
-				return errors.Errorf("cascading unit %q life advancement: %w", u, err)
-			}
+	var unitUUIDsRec []entityUUID
+	if err := tx.Query(
+		ctx, selectUnitUUIDsStmt, applicationUUID,
+	).GetAll(&unitUUIDsRec); errors.Is(err, sqlair.ErrNoRows) {
+		// If there are no units associated with the application,
+		// there is nothing else to update.
+		return dedupeApplicationLives(res), nil
+	} else if err != nil {
+		return res, errors.Errorf("selecting associated application unit lives: %w", err)
+	}
 
-			if cascaded.MachineUUID != nil {
-				res.MachineUUIDs = append(res.MachineUUIDs, *cascaded.MachineUUID)
-			}
+	const checkEmptyMachine = true
+	res.UnitUUIDs = transform.Slice(unitUUIDsRec, func(e entityUUID) string { return e.UUID })
+	for _, u := range res.UnitUUIDs {
+		cascaded, err := st.ensureUnitNotAliveCascade(
+			ctx, tx, u, checkEmptyMachine, destroyStorage,
+		)
+		if err != nil {
+			return res, errors.Errorf("cascading unit %q life advancement: %w", u, err)
+		}
 
-			res.CascadedStorageLives = res.CascadedStorageLives.Merge(cascaded.CascadedStorageLives)
+		if cascaded.MachineUUID != nil {
+			res.MachineUUIDs = append(res.MachineUUIDs, *cascaded.MachineUUID)
 		}
 
-		return nil
-	})); err != nil {
-		return res, errors.Capture(err)
+		res.CascadedStorageLives = res.CascadedStorageLives.Merge(cascaded.CascadedStorageLives)
 	}
 
+	return dedupeApplicationLives(res), nil
+}
+
+func dedupeApplicationLives(res internal.CascadedApplicationLives) internal.CascadedApplicationLives {
 	res.RelationUUIDs = dedupeStrings(res.RelationUUIDs)
 	res.UnitUUIDs = dedupeStrings(res.UnitUUIDs)
 	res.MachineUUIDs = dedupeStrings(res.MachineUUIDs)
@@ -200,8 +211,7 @@ AND    life_id < 2`, applicationUUID)
 	res.FileSystemUUIDs = dedupeStrings(res.FileSystemUUIDs)
 	res.VolumeUUIDs = dedupeStrings(res.VolumeUUIDs)
 	res.StorageInstanceUUIDs = dedupeStrings(res.StorageInstanceUUIDs)
-
-	return res, nil
+	return res
 }
 
 func dedupeStrings(in []string) []string {
@@ -0,0 +1,262 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetAllJobStatuses returns all removal jobs along with their execution
+// history, ordered by creation time.
+func (st *State) GetAllJobStatuses(ctx context.Context) ([]removal.JobStatus, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// The entity name is resolved for the job types whose entities have
+	// one. The literal IDs correspond to rows in the removal_type table.
+	stmt, err := st.Prepare(`
+SELECT r.uuid AS &removalJobStatus.uuid,
+       r.removal_type_id AS &removalJobStatus.removal_type_id,
+       r.entity_uuid AS &removalJobStatus.entity_uuid,
+       COALESCE(u.name, a.name, m.name, si.storage_id) AS &removalJobStatus.entity_name,
+       r.force AS &removalJobStatus.force,
+       r.scheduled_for AS &removalJobStatus.scheduled_for,
+       r.arg AS &removalJobStatus.arg,
+       r.created_at AS &removalJobStatus.created_at,
+       COALESCE(rs.attempts, 0) AS &removalJobStatus.attempts,
+       rs.last_attempted_at AS &removalJobStatus.last_attempted_at,
+       rs.last_error AS &removalJobStatus.last_error
+FROM   removal AS r
+LEFT JOIN removal_status AS rs ON r.uuid = rs.removal_uuid
+LEFT JOIN unit AS u ON r.removal_type_id = 1 AND r.entity_uuid = u.uuid
+LEFT JOIN application AS a ON r.removal_type_id = 2 AND r.entity_uuid = a.uuid
+LEFT JOIN machine AS m ON r.removal_type_id = 3 AND r.entity_uuid = m.uuid
+LEFT JOIN storage_instance AS si ON r.removal_type_id = 5 AND r.entity_uuid = si.uuid
+ORDER BY r.created_at, r.uuid`, removalJobStatus{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select job statuses query: %w", err)
+	}
+
+	var dbJobs []removalJobStatus
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err = tx.Query(ctx, stmt).GetAll(&dbJobs)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("running select job statuses query: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	if len(dbJobs) == 0 {
+		return nil, nil
+	}
+
+	jobs := make([]removal.JobStatus, len(dbJobs))
+	for i, job := range dbJobs {
+		arg, err := decodeJobArg(job.Arg)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+
+		jobs[i] = removal.JobStatus{
+			Job: removal.Job{
+				UUID:         removal.UUID(job.UUID),
+				RemovalType:  removal.JobType(job.RemovalTypeID),
+				EntityUUID:   job.EntityUUID,
+				Force:        job.Force,
+				ScheduledFor: job.ScheduledFor,
+				Arg:          arg,
+			},
+			EntityName:      job.EntityName.V,
+			CreatedAt:       job.CreatedAt,
+			Attempts:        job.Attempts,
+			LastAttemptedAt: job.LastAttemptedAt.V,
+			LastError:       job.LastError.V,
+		}
+	}
+	return jobs, nil
+}
+
+// RecordJobAttempt increments the number of attempts for the removal job
+// with the input UUID, and sets the time of the latest attempt.
+// [removalerrors.RemovalJobNotFound] is returned if the job does not exist,
+// which is the case if it was cancelled since it was retrieved.
+func (st *State) RecordJobAttempt(ctx context.Context, jUUID string, attemptedAt time.Time) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	status := removalStatus{
+		RemovalUUID:     jUUID,
+		Attempts:        1,
+		LastAttemptedAt: sql.Null[time.Time]{V: attemptedAt, Valid: true},
+	}
+
+	upsertStmt, err := st.Prepare(`
+INSERT INTO removal_status (*) VALUES ($removalStatus.*)
+ON CONFLICT (removal_uuid) DO
+UPDATE SET attempts = attempts + 1, last_attempted_at = excluded.last_attempted_at
+`, status)
+	if err != nil {
+		return errors.Errorf("preparing job attempt upsert: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkJobExists(ctx, tx, jUUID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, upsertStmt, status).Run(); err != nil {
+			return errors.Errorf("recording job attempt: %w", err)
+		}
+		return nil
+	}))
+}
+
+// RecordJobResult sets the error returned by the most recent execution of
+// the removal job with the input UUID. An empty message indicates that the
+// execution did not fail. If the job no longer exists, this is a no-op.
+func (st *State) RecordJobResult(ctx context.Context, jUUID string, errMsg string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	status := removalStatus{
+		RemovalUUID: jUUID,
+		LastError:   sql.Null[string]{V: errMsg, Valid: errMsg != ""},
+	}
+
+	stmt, err := st.Prepare(`
+UPDATE removal_status
+SET    last_error = $removalStatus.last_error
+WHERE  removal_uuid = $removalStatus.removal_uuid`, status)
+	if err != nil {
+		return errors.Errorf("preparing job result update: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, status).Run(); err != nil {
+			return errors.Errorf("recording job result: %w", err)
+		}
+		return nil
+	}))
+}
+
+// ForceJob sets the force flag on the removal job with the input UUID.
+// [removalerrors.RemovalJobNotFound] is returned if the job does not exist.
+func (st *State) ForceJob(ctx context.Context, jUUID string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	jobUUID := entityUUID{UUID: jUUID}
+
+	stmt, err := st.Prepare("UPDATE removal SET force = 1 WHERE uuid = $entityUUID.uuid", jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job force update: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkJobExists(ctx, tx, jUUID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, jobUUID).Run(); err != nil {
+			return errors.Errorf("forcing removal job: %w", err)
+		}
+		return nil
+	}))
+}
+
+// CancelJob deletes the removal job with the input UUID, provided that its
+// execution has not been attempted. The life of the entity being removed is
+// not changed.
+// The following errors may be returned:
+// - [removalerrors.RemovalJobNotFound] if the job does not exist.
+// - [removalerrors.RemovalJobStarted] if the job has been attempted.
+func (st *State) CancelJob(ctx context.Context, jUUID string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	jobUUID := entityUUID{UUID: jUUID}
+
+	startedStmt, err := st.Prepare(`
+SELECT removal_uuid AS &entityUUID.uuid
+FROM   removal_status
+WHERE  removal_uuid = $entityUUID.uuid`, jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job started query: %w", err)
+	}
+
+	deleteStmt, err := st.Prepare("DELETE FROM removal WHERE uuid = $entityUUID.uuid", jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job deletion: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkJobExists(ctx, tx, jUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		var started entityUUID
+		err := tx.Query(ctx, startedStmt, jobUUID).Get(&started)
+		if err == nil {
+			return errors.Errorf("removal job %q has already been attempted", jUUID).Add(removalerrors.RemovalJobStarted)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("checking if removal job started: %w", err)
+		}
+
+		if err := tx.Query(ctx, deleteStmt, jobUUID).Run(); err != nil {
+			return errors.Errorf("deleting removal row: %w", err)
+		}
+		return nil
+	}))
+}
+
+// checkJobExists returns [removalerrors.RemovalJobNotFound] if there is no
+// removal job with the input UUID.
+func (st *State) checkJobExists(ctx context.Context, tx *sqlair.TX, jUUID string) error {
+	jobUUID := entityUUID{UUID: jUUID}
+
+	stmt, err := st.Prepare("SELECT uuid AS &entityUUID.uuid FROM removal WHERE uuid = $entityUUID.uuid", jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job exists query: %w", err)
+	}
+
+	err = tx.Query(ctx, stmt, jobUUID).Get(&jobUUID)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return errors.Errorf("removal job %q does not exist", jUUID).Add(removalerrors.RemovalJobNotFound)
+	} else if err != nil {
+		return errors.Errorf("checking if removal job exists: %w", err)
+	}
+	return nil
+}
+
+// decodeJobArg decodes the free-form JSON argument of a removal job.
+func decodeJobArg(arg sql.NullString) (map[string]any, error) {
+	if !arg.Valid || arg.String == "" {
+		return nil, nil
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(arg.String), &decoded); err != nil {
+		return nil, errors.Errorf("decoding job arg: %w", err)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type jobSuite struct {
+	baseSuite
+}
+
+func TestJobSuite(t *testing.T) {
+	tc.Run(t, &jobSuite{})
+}
+
+func (s *jobSuite) TestGetAllJobStatuses(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "app1", applicationservice.AddIAASUnitArg{})
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(unitUUIDs, tc.HasLen, 1)
+
+	jID1 := s.insertJob(c, removal.UnitJob, unitUUIDs[0].String(), false)
+	jID2 := s.insertJob(c, removal.RelationJob, "rel-1", true)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	attemptedAt := time.Now().UTC()
+	err := st.RecordJobAttempt(c.Context(), jID1.String(), attemptedAt)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordJobAttempt(c.Context(), jID1.String(), attemptedAt)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordJobResult(c.Context(), jID1.String(), "boom")
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobStatuses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 2)
+
+	byUUID := map[removal.UUID]removal.JobStatus{}
+	for _, j := range jobs {
+		byUUID[j.UUID] = j
+	}
+
+	unitJob := byUUID[jID1]
+	c.Check(unitJob.RemovalType, tc.Equals, removal.UnitJob)
+	c.Check(unitJob.EntityName, tc.Equals, "app1/0")
+	c.Check(unitJob.Force, tc.Equals, false)
+	c.Check(unitJob.Attempts, tc.Equals, 2)
+	c.Check(unitJob.LastAttemptedAt.Equal(attemptedAt), tc.Equals, true)
+	c.Check(unitJob.LastError, tc.Equals, "boom")
+	c.Check(unitJob.CreatedAt.IsZero(), tc.Equals, false)
+
+	relJob := byUUID[jID2]
+	c.Check(relJob.EntityName, tc.Equals, "")
+	c.Check(relJob.Force, tc.Equals, true)
+	c.Check(relJob.Started(), tc.Equals, false)
+	c.Check(relJob.LastAttemptedAt.IsZero(), tc.Equals, true)
+}
+
+func (s *jobSuite) TestRecordJobResultClearsError(c *tc.C) {
+	jID := s.insertJob(c, removal.RelationJob, "rel-1", false)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.RecordJobAttempt(c.Context(), jID.String(), time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordJobResult(c.Context(), jID.String(), "boom")
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordJobResult(c.Context(), jID.String(), "")
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobStatuses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 1)
+	c.Check(jobs[0].LastError, tc.Equals, "")
+}
+
+func (s *jobSuite) TestRecordJobAttemptNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.RecordJobAttempt(c.Context(), "not-today-henry", time.Now().UTC())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *jobSuite) TestForceJob(c *tc.C) {
+	jID := s.insertJob(c, removal.RelationJob, "rel-1", false)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.ForceJob(c.Context(), jID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 1)
+	c.Check(jobs[0].Force, tc.Equals, true)
+}
+
+func (s *jobSuite) TestForceJobNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.ForceJob(c.Context(), "not-today-henry")
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *jobSuite) TestCancelJob(c *tc.C) {
+	jID := s.insertJob(c, removal.RelationJob, "rel-1", false)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.CancelJob(c.Context(), jID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(jobs, tc.HasLen, 0)
+
+	err = st.RecordJobAttempt(c.Context(), jID.String(), time.Now().UTC())
+	c.Check(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *jobSuite) TestCancelJobStarted(c *tc.C) {
+	jID := s.insertJob(c, removal.RelationJob, "rel-1", false)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.RecordJobAttempt(c.Context(), jID.String(), time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelJob(c.Context(), jID.String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobStarted)
+}
+
+func (s *jobSuite) TestDeleteJobWithStatus(c *tc.C) {
+	jID := s.insertJob(c, removal.RelationJob, "rel-1", false)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.RecordJobAttempt(c.Context(), jID.String(), time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.DeleteJob(c.Context(), jID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	var count int
+	err = s.DB().QueryRow("SELECT count(*) FROM removal_status WHERE removal_uuid = ?", jID).Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (s *jobSuite) insertJob(c *tc.C, jobType removal.JobType, entityUUID string, force bool) removal.UUID {
+	jID, err := removal.NewUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().Exec(`
+INSERT INTO removal (uuid, removal_type_id, entity_uuid, force, scheduled_for)
+VALUES (?, ?, ?, ?, ?)`, jID, jobType, entityUUID, force, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	return jID
+}
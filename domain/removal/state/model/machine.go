@@ -67,6 +67,19 @@ func (st *State) EnsureMachineNotAliveCascade(
 		return cascaded, errors.Capture(err)
 	}
 
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		cascaded, err = st.ensureMachineNotAliveCascade(ctx, tx, mUUID, force)
+		return errors.Capture(err)
+	})
+	return cascaded, errors.Capture(err)
+}
+
+func (st *State) ensureMachineNotAliveCascade(
+	ctx context.Context, tx *sqlair.TX, mUUID string, force bool,
+) (internal.CascadedMachineLives, error) {
+	var cascaded internal.CascadedMachineLives
+
 	machineUUID := entityUUID{UUID: mUUID}
 	updateMachineStmt, err := st.Prepare(`
 UPDATE machine
@@ -129,103 +142,97 @@ AND    u.life_id < 2;`, machineUUID, uuids{})
 		return cascaded, errors.Errorf("preparing unit selection query: %w", err)
 	}
 
-	if err := errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		// Remove any container machines that are on the same parent machine
-		// as the input machine.
-		var machineUUIDs []entityUUID
-		err := tx.Query(ctx, selectContainerMachines, machineUUID).GetAll(&machineUUIDs)
-		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
-			return errors.Errorf("selecting container machines: %w", err)
-		}
-
-		if !force && len(machineUUIDs) > 0 {
-			return errors.Errorf(
-				"cannot set machine %q to dying without force: %w", mUUID, removalerrors.MachineHasContainers)
-		}
+	// Remove any container machines that are on the same parent machine
+	// as the input machine.
+	var machineUUIDs []entityUUID
+	err = tx.Query(ctx, selectContainerMachines, machineUUID).GetAll(&machineUUIDs)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return cascaded, errors.Errorf("selecting container machines: %w", err)
+	}
 
-		var parentUnitUUIDs []entityUUID
-		err = tx.Query(ctx, selectUnitStmt, uuids{machineUUID.UUID}).GetAll(&parentUnitUUIDs)
-		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
-			return errors.Errorf("selecting parent units: %w", err)
-		}
+	if !force && len(machineUUIDs) > 0 {
+		return cascaded, errors.Errorf(
+			"cannot set machine %q to dying without force: %w", mUUID, removalerrors.MachineHasContainers)
+	}
 
-		if !force && len(parentUnitUUIDs) > 0 {
-			return errors.Errorf(
-				"cannot set machine %q to dying without force: %w", mUUID, removalerrors.MachineHasUnits)
-		}
+	var parentUnitUUIDs []entityUUID
+	err = tx.Query(ctx, selectUnitStmt, uuids{machineUUID.UUID}).GetAll(&parentUnitUUIDs)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return cascaded, errors.Errorf("selecting parent units: %w", err)
+	}
 
-		if err := tx.Query(ctx, updateMachineStmt, machineUUID).Run(); err != nil {
-			return errors.Errorf("advancing machine life: %w", err)
-		}
+	if !force && len(parentUnitUUIDs) > 0 {
+		return cascaded, errors.Errorf(
+			"cannot set machine %q to dying without force: %w", mUUID, removalerrors.MachineHasUnits)
+	}
 
-		if err := tx.Query(ctx, updateInstanceStmt, machineUUID).Run(); err != nil {
-			return errors.Errorf("advancing machine cloud instance life: %w", err)
-		}
+	if err := tx.Query(ctx, updateMachineStmt, machineUUID).Run(); err != nil {
+		return cascaded, errors.Errorf("advancing machine life: %w", err)
+	}
 
-		var childUnitUUIDs []entityUUID
-		if len(machineUUIDs) > 0 {
-			cascaded.MachineUUIDs = transform.Slice(machineUUIDs, func(u entityUUID) string {
-				return u.UUID
-			})
+	if err := tx.Query(ctx, updateInstanceStmt, machineUUID).Run(); err != nil {
+		return cascaded, errors.Errorf("advancing machine cloud instance life: %w", err)
+	}
 
-			if err := tx.Query(ctx, updateContainerStmt, uuids(cascaded.MachineUUIDs)).Run(); err != nil {
-				return errors.Errorf("advancing container machine life: %w", err)
-			}
-			if err := tx.Query(ctx, updateContainerInstanceStmt, uuids(cascaded.MachineUUIDs)).Run(); err != nil {
-				return errors.Errorf("advancing container machine instance life: %w", err)
-			}
+	var childUnitUUIDs []entityUUID
+	if len(machineUUIDs) > 0 {
+		cascaded.MachineUUIDs = transform.Slice(machineUUIDs, func(u entityUUID) string {
+			return u.UUID
+		})
 
-			// If there are any container machines, we also need to
-			// select any units that are on those machines.
-			// Note that this is safe because:
-			// 1. The UI requires force if the machine has any containers
-			//    or units.
-			// 2. If this was cascaded from application or unit, we already
-			//    determined that only the dying unit was attached to this
-			//    machine (in which case there will be no containers).
-			err := tx.Query(ctx, selectUnitStmt, uuids(cascaded.MachineUUIDs)).GetAll(&childUnitUUIDs)
-			if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
-				return errors.Errorf("selecting container units: %w", err)
-			}
+		if err := tx.Query(ctx, updateContainerStmt, uuids(cascaded.MachineUUIDs)).Run(); err != nil {
+			return cascaded, errors.Errorf("advancing container machine life: %w", err)
 		}
-
-		cascaded.CascadedStorageInstanceLives, err = st.ensureMachineStorageInstancesNotAliveCascade(
-			ctx, tx, mUUID,
-		)
-		if err != nil {
-			return errors.Errorf("advancing machine storage entity lives: %w", err)
+		if err := tx.Query(ctx, updateContainerInstanceStmt, uuids(cascaded.MachineUUIDs)).Run(); err != nil {
+			return cascaded, errors.Errorf("advancing container machine instance life: %w", err)
 		}
 
-		// If there are no units to update, we can return early.
-		if len(parentUnitUUIDs)+len(childUnitUUIDs) == 0 {
-			return nil
+		// If there are any container machines, we also need to
+		// select any units that are on those machines.
+		// Note that this is safe because:
+		// 1. The UI requires force if the machine has any containers
+		//    or units.
+		// 2. If this was cascaded from application or unit, we already
+		//    determined that only the dying unit was attached to this
+		//    machine (in which case there will be no containers).
+		err := tx.Query(ctx, selectUnitStmt, uuids(cascaded.MachineUUIDs)).GetAll(&childUnitUUIDs)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return cascaded, errors.Errorf("selecting container units: %w", err)
 		}
+	}
 
-		const (
-			checkEmptyMachine = false
-			// N.B. storage instances that are NOT machine owned must not be
-			// removed here, since direct machine removal does not yet support
-			// passing a destroy flag. Once this is supported, it can be plumbed
-			// in to here to ensure storage attached to the units on this
-			// machine are removed.
-			destroyStorage = false
+	cascaded.CascadedStorageInstanceLives, err = st.ensureMachineStorageInstancesNotAliveCascade(
+		ctx, tx, mUUID,
+	)
+	if err != nil {
+		return cascaded, errors.Errorf("advancing machine storage entity lives: %w", err)
+	}
+
+	// If there are no units to update, we can return early.
+	if len(parentUnitUUIDs)+len(childUnitUUIDs) == 0 {
+		return cascaded, nil
+	}
+
+	const (
+		checkEmptyMachine = false
+		// N.B. storage instances that are NOT machine owned must not be
+		// removed here, since direct machine removal does not yet support
+		// passing a destroy flag. Once this is supported, it can be plumbed
+		// in to here to ensure storage attached to the units on this
+		// machine are removed.
+		destroyStorage = false
+	)
+	cascaded.UnitUUIDs = transform.Slice(append(parentUnitUUIDs, childUnitUUIDs...), func(u entityUUID) string {
+		return u.UUID
+	})
+	for _, u := range cascaded.UnitUUIDs {
+		uc, err := st.ensureUnitNotAliveCascade(
+			ctx, tx, u, checkEmptyMachine, destroyStorage,
 		)
-		cascaded.UnitUUIDs = transform.Slice(append(parentUnitUUIDs, childUnitUUIDs...), func(u entityUUID) string {
-			return u.UUID
-		})
-		for _, u := range cascaded.UnitUUIDs {
-			uc, err := st.ensureUnitNotAliveCascade(
-				ctx, tx, u, checkEmptyMachine, destroyStorage,
-			)
-			if err != nil {
-				return errors.Errorf("cascading unit %q life advancement: %w", u, err)
-			}
-			cascaded.CascadedStorageLives = cascaded.CascadedStorageLives.Merge(uc.CascadedStorageLives)
+		if err != nil {
+			return cascaded, errors.Errorf("cascading unit %q life advancement: %w", u, err)
 		}
-
-		return nil
-	})); err != nil {
-		return cascaded, errors.Capture(err)
+		cascaded.CascadedStorageLives = cascaded.CascadedStorageLives.Merge(uc.CascadedStorageLives)
 	}
 
 	return cascaded, nil
@@ -0,0 +1,580 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/canonical/sqlair"
+
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// PlanApplicationRemoval returns the entities that would be removed along
+// with the application identified by the input UUID, without removing them.
+// The plan is read from the model as it is; the entities are selected by the
+// same rules as the life advancement cascade of the application removal.
+func (st *State) PlanApplicationRemoval(
+	ctx context.Context, aUUID string, destroyStorage bool,
+) (removal.Plan, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Plan{}, errors.Capture(err)
+	}
+
+	var plan removal.Plan
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		appUUIDs := uuids{aUUID}
+		relationUUIDs, err := st.selectPlanUUIDs(ctx, tx, `
+SELECT DISTINCT re.relation_uuid AS &entityUUID.uuid
+FROM   v_relation_endpoint AS re
+JOIN   relation AS r ON re.relation_uuid = r.uuid
+WHERE  r.life_id < 2
+AND    re.application_uuid IN ($uuids[:])`, appUUIDs)
+		if err != nil {
+			return errors.Errorf("selecting application relations: %w", err)
+		}
+		unitUUIDs, err := st.selectPlanUUIDs(ctx, tx, `
+SELECT &entityUUID.uuid
+FROM   unit
+WHERE  application_uuid IN ($uuids[:])
+AND    life_id < 2`, appUUIDs)
+		if err != nil {
+			return errors.Errorf("selecting application units: %w", err)
+		}
+		machineUUIDs, err := st.planEmptiedMachines(ctx, tx, unitUUIDs)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		storageUUIDs, err := st.planDestroyedStorage(ctx, tx, unitUUIDs, machineUUIDs, destroyStorage)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		if plan.Applications, err = st.planApplications(ctx, tx, appUUIDs); err != nil {
+			return errors.Capture(err)
+		}
+		if plan.Offers, err = st.planApplicationOffers(ctx, tx, appUUIDs); err != nil {
+			return errors.Capture(err)
+		}
+		if plan.Relations, err = st.planRelations(ctx, tx, relationUUIDs); err != nil {
+			return errors.Capture(err)
+		}
+		return errors.Capture(st.planUnitsAndMachines(
+			ctx, tx, &plan, unitUUIDs, machineUUIDs, storageUUIDs,
+		))
+	})
+	return plan, errors.Capture(err)
+}
+
+// PlanUnitRemoval returns the entities that would be removed along with the
+// unit identified by the input UUID, without removing them.
+func (st *State) PlanUnitRemoval(
+	ctx context.Context, uUUID string, destroyStorage bool,
+) (removal.Plan, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Plan{}, errors.Capture(err)
+	}
+
+	var plan removal.Plan
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		unitUUIDs := []string{uUUID}
+		machineUUIDs, err := st.planEmptiedMachines(ctx, tx, unitUUIDs)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		storageUUIDs, err := st.planDestroyedStorage(ctx, tx, unitUUIDs, machineUUIDs, destroyStorage)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return errors.Capture(st.planUnitsAndMachines(
+			ctx, tx, &plan, unitUUIDs, machineUUIDs, storageUUIDs,
+		))
+	})
+	return plan, errors.Capture(err)
+}
+
+// PlanMachineRemoval returns the entities that would be removed along with
+// the machine identified by the input UUID, without removing them. If the
+// machine hosts units or containers, it is reported as requiring force.
+func (st *State) PlanMachineRemoval(ctx context.Context, mUUID string) (removal.Plan, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Plan{}, errors.Capture(err)
+	}
+
+	var plan removal.Plan
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		containerUUIDs, err := st.selectPlanUUIDs(ctx, tx, `
+SELECT mp.machine_uuid AS &entityUUID.uuid
+FROM   machine_parent AS mp
+JOIN   machine AS m ON mp.machine_uuid = m.uuid
+WHERE  mp.parent_uuid IN ($uuids[:])
+AND    m.life_id < 2`, uuids{mUUID})
+		if err != nil {
+			return errors.Errorf("selecting container machines: %w", err)
+		}
+		machineUUIDs := append([]string{mUUID}, containerUUIDs...)
+
+		// Removing the machine removes the units on it and on its
+		// containers.
+		unitUUIDs, err := st.selectPlanUUIDs(ctx, tx, `
+SELECT u.uuid AS &entityUUID.uuid
+FROM   unit AS u
+JOIN   machine AS m ON m.net_node_uuid = u.net_node_uuid
+WHERE  m.uuid IN ($uuids[:])
+AND    u.life_id < 2`, uuids(machineUUIDs))
+		if err != nil {
+			return errors.Errorf("selecting machine units: %w", err)
+		}
+
+		// Only the storage provisioned by the machine itself is destroyed,
+		// the storage of the units on it is detached.
+		storageUUIDs, err := st.planMachineStorage(ctx, tx, []string{mUUID})
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		err = st.planUnitsAndMachines(ctx, tx, &plan, unitUUIDs, machineUUIDs, storageUUIDs)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		var forceReason string
+		if len(containerUUIDs) > 0 {
+			forceReason = machineForceReason(removalerrors.MachineHasContainers)
+		} else if len(unitUUIDs) > 0 {
+			forceReason = machineForceReason(removalerrors.MachineHasUnits)
+		}
+		for i, m := range plan.Machines {
+			if m.UUID == mUUID {
+				plan.Machines[i].ForceRequired = forceReason
+			}
+		}
+		return nil
+	})
+	return plan, errors.Capture(err)
+}
+
+// PlanModelRemoval returns every entity that would be removed along with the
+// model. Storage instances are reported as destroyed if destroyStorage is
+// true, and as detached otherwise.
+func (st *State) PlanModelRemoval(ctx context.Context, destroyStorage bool) (removal.Plan, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Plan{}, errors.Capture(err)
+	}
+
+	selectApplications, err := st.Prepare(`SELECT uuid AS &entityUUID.* FROM application WHERE life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("preparing select applications query: %w", err)
+	}
+	selectUnits, err := st.Prepare(`SELECT uuid AS &entityUUID.* FROM unit WHERE life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("preparing select units query: %w", err)
+	}
+	selectRelations, err := st.Prepare(`SELECT uuid AS &entityUUID.* FROM relation WHERE life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("preparing select relations query: %w", err)
+	}
+	selectMachines, err := st.Prepare(`SELECT uuid AS &entityUUID.* FROM machine WHERE life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("preparing select machines query: %w", err)
+	}
+	selectStorage, err := st.Prepare(`SELECT uuid AS &entityUUID.* FROM storage_instance WHERE life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Plan{}, errors.Errorf("preparing select storage instances query: %w", err)
+	}
+
+	var plan removal.Plan
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var apps, units, relations, machines, storage entityUUIDs
+		for _, q := range []struct {
+			stmt *sqlair.Statement
+			dest *entityUUIDs
+		}{
+			{selectApplications, &apps},
+			{selectUnits, &units},
+			{selectRelations, &relations},
+			{selectMachines, &machines},
+			{selectStorage, &storage},
+		} {
+			if err := tx.Query(ctx, q.stmt).GetAll(q.dest); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+				return errors.Errorf("selecting model entities: %w", err)
+			}
+		}
+
+		var err error
+		if plan.Applications, err = st.planApplications(ctx, tx, apps.uuids()); err != nil {
+			return errors.Capture(err)
+		}
+		if plan.Offers, err = st.planApplicationOffers(ctx, tx, apps.uuids()); err != nil {
+			return errors.Capture(err)
+		}
+		if plan.Relations, err = st.planRelations(ctx, tx, relations.uuids()); err != nil {
+			return errors.Capture(err)
+		}
+		if plan.Units, err = st.planUnits(ctx, tx, units.uuids()); err != nil {
+			return errors.Capture(err)
+		}
+		if plan.Machines, err = st.planMachines(ctx, tx, machines.uuids()); err != nil {
+			return errors.Capture(err)
+		}
+		storageInstances, err := st.planStorageInstances(ctx, tx, storage.uuids())
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if destroyStorage {
+			plan.DestroyedStorage = storageInstances
+		} else {
+			plan.DetachedStorage = storageInstances
+		}
+		return nil
+	})
+	return plan, errors.Capture(err)
+}
+
+// selectPlanUUIDs returns the UUIDs selected by the input query, which takes
+// the input UUIDs as its argument.
+func (st *State) selectPlanUUIDs(ctx context.Context, tx *sqlair.TX, query string, in uuids) ([]string, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(query, entityUUID{}, uuids{})
+	if err != nil {
+		return nil, errors.Errorf("preparing plan query: %w", err)
+	}
+
+	var selected entityUUIDs
+	if err := tx.Query(ctx, stmt, in).GetAll(&selected); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("running plan query: %w", err)
+	}
+	return dedupeStrings(selected.uuids()), nil
+}
+
+// planEmptiedMachines returns the machines that would be left without any
+// live units once the input units are removed, and are therefore removed
+// along with them. Machines with containers are never removed this way.
+func (st *State) planEmptiedMachines(ctx context.Context, tx *sqlair.TX, unitUUIDs []string) ([]string, error) {
+	machineUUIDs, err := st.selectPlanUUIDs(ctx, tx, `
+SELECT m.uuid AS &entityUUID.uuid
+FROM   machine AS m
+JOIN   unit AS u ON u.net_node_uuid = m.net_node_uuid
+WHERE  u.uuid IN ($uuids[:])
+AND    NOT EXISTS (
+           SELECT 1
+           FROM   unit AS ou
+           WHERE  ou.net_node_uuid = m.net_node_uuid
+           AND    ou.life_id = 0
+           AND    ou.uuid NOT IN ($uuids[:])
+       )
+AND    NOT EXISTS (
+           SELECT 1
+           FROM   machine_parent AS mp
+           WHERE  mp.parent_uuid = m.uuid
+       )`, unitUUIDs)
+	if err != nil {
+		return nil, errors.Errorf("selecting emptied machines: %w", err)
+	}
+	return machineUUIDs, nil
+}
+
+// planDestroyedStorage returns the storage instances that would be destroyed
+// along with the input units and machines: the storage owned by the units if
+// destroyStorage is true, and the storage provisioned by the machines.
+func (st *State) planDestroyedStorage(
+	ctx context.Context, tx *sqlair.TX, unitUUIDs, machineUUIDs []string, destroyStorage bool,
+) ([]string, error) {
+	var storageUUIDs []string
+	if destroyStorage {
+		owned, err := st.selectPlanUUIDs(ctx, tx, `
+SELECT si.uuid AS &entityUUID.uuid
+FROM   storage_unit_owner AS so
+JOIN   storage_instance AS si ON so.storage_instance_uuid = si.uuid
+WHERE  so.unit_uuid IN ($uuids[:])
+AND    si.life_id < 2`, unitUUIDs)
+		if err != nil {
+			return nil, errors.Errorf("selecting unit owned storage instances: %w", err)
+		}
+		storageUUIDs = append(storageUUIDs, owned...)
+	}
+
+	machineStorage, err := st.planMachineStorage(ctx, tx, machineUUIDs)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return dedupeStrings(append(storageUUIDs, machineStorage...)), nil
+}
+
+// planMachineStorage returns the storage instances provisioned by the input
+// machines, which are destroyed along with them.
+func (st *State) planMachineStorage(ctx context.Context, tx *sqlair.TX, machineUUIDs []string) ([]string, error) {
+	storageUUIDs, err := st.selectPlanUUIDs(ctx, tx, `
+WITH all_instances AS (
+    SELECT iv.storage_instance_uuid AS uuid
+    FROM   storage_instance i
+    JOIN   storage_instance_volume iv ON i.uuid = iv.storage_instance_uuid
+    JOIN   machine_volume mv ON iv.storage_volume_uuid = mv.volume_uuid
+    WHERE  mv.machine_uuid IN ($uuids[:]) AND
+           i.life_id < 2
+    UNION
+    SELECT if.storage_instance_uuid AS uuid
+    FROM   storage_instance i
+    JOIN   storage_instance_filesystem if ON i.uuid = if.storage_instance_uuid
+    JOIN   machine_filesystem mf ON if.storage_filesystem_uuid = mf.filesystem_uuid
+    LEFT JOIN storage_instance_volume iv ON i.uuid = iv.storage_instance_uuid
+    WHERE  mf.machine_uuid IN ($uuids[:]) AND
+           i.life_id < 2 AND
+           iv.storage_instance_uuid IS NULL
+)
+SELECT &entityUUID.* FROM all_instances`, machineUUIDs)
+	if err != nil {
+		return nil, errors.Errorf("selecting machine storage instances: %w", err)
+	}
+	return storageUUIDs, nil
+}
+
+// planUnitsAndMachines adds the input units and machines to the plan, along
+// with the storage instances that would be destroyed or detached.
+func (st *State) planUnitsAndMachines(
+	ctx context.Context, tx *sqlair.TX, plan *removal.Plan,
+	unitUUIDs, machineUUIDs, destroyedStorageUUIDs []string,
+) error {
+	var err error
+	if plan.Units, err = st.planUnits(ctx, tx, unitUUIDs); err != nil {
+		return errors.Capture(err)
+	}
+	if plan.Machines, err = st.planMachines(ctx, tx, machineUUIDs); err != nil {
+		return errors.Capture(err)
+	}
+	if plan.DestroyedStorage, err = st.planStorageInstances(ctx, tx, destroyedStorageUUIDs); err != nil {
+		return errors.Capture(err)
+	}
+	if plan.DetachedStorage, err = st.planDetachedStorage(ctx, tx, unitUUIDs, destroyedStorageUUIDs); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+func (st *State) planApplications(ctx context.Context, tx *sqlair.TX, appUUIDs []string) ([]removal.PlannedRemoval, error) {
+	return st.planNamedEntities(ctx, tx, `
+SELECT &plannedEntity.*
+FROM   application
+WHERE  uuid IN ($uuids[:])`, appUUIDs)
+}
+
+func (st *State) planMachines(ctx context.Context, tx *sqlair.TX, machineUUIDs []string) ([]removal.PlannedRemoval, error) {
+	return st.planNamedEntities(ctx, tx, `
+SELECT &plannedEntity.*
+FROM   machine
+WHERE  uuid IN ($uuids[:])`, machineUUIDs)
+}
+
+func (st *State) planStorageInstances(ctx context.Context, tx *sqlair.TX, storageUUIDs []string) ([]removal.PlannedRemoval, error) {
+	return st.planNamedEntities(ctx, tx, `
+SELECT uuid AS &plannedEntity.uuid,
+       storage_id AS &plannedEntity.name
+FROM   storage_instance
+WHERE  uuid IN ($uuids[:])`, storageUUIDs)
+}
+
+func (st *State) planNamedEntities(
+	ctx context.Context, tx *sqlair.TX, query string, entityUUIDs []string,
+) ([]removal.PlannedRemoval, error) {
+	if len(entityUUIDs) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(query, plannedEntity{}, uuids{})
+	if err != nil {
+		return nil, errors.Errorf("preparing plan query: %w", err)
+	}
+
+	var entities []plannedEntity
+	if err := tx.Query(ctx, stmt, uuids(entityUUIDs)).GetAll(&entities); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("running plan query: %w", err)
+	}
+	planned := make([]removal.PlannedRemoval, len(entities))
+	for i, e := range entities {
+		planned[i] = removal.PlannedRemoval{UUID: e.UUID, Name: e.Name}
+	}
+	return sortPlanned(planned), nil
+}
+
+// planUnits returns the input units. Units with an agent in an error state
+// are reported as requiring force, since a failed hook prevents the unit
+// from advancing to dead until it is resolved.
+func (st *State) planUnits(ctx context.Context, tx *sqlair.TX, unitUUIDs []string) ([]removal.PlannedRemoval, error) {
+	if len(unitUUIDs) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(`
+SELECT    u.uuid AS &plannedUnit.uuid,
+          u.name AS &plannedUnit.name,
+          sv.status AS &plannedUnit.agent_status
+FROM      unit AS u
+LEFT JOIN unit_agent_status AS s ON u.uuid = s.unit_uuid
+LEFT JOIN unit_agent_status_value AS sv ON s.status_id = sv.id
+WHERE     u.uuid IN ($uuids[:])`, plannedUnit{}, uuids{})
+	if err != nil {
+		return nil, errors.Errorf("preparing plan units query: %w", err)
+	}
+
+	var units []plannedUnit
+	if err := tx.Query(ctx, stmt, uuids(unitUUIDs)).GetAll(&units); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("running plan units query: %w", err)
+	}
+	planned := make([]removal.PlannedRemoval, len(units))
+	for i, u := range units {
+		planned[i] = removal.PlannedRemoval{UUID: u.UUID, Name: u.Name}
+		if u.AgentStatus.Valid && u.AgentStatus.V == "error" {
+			planned[i].ForceRequired = "unit is in an error state"
+		}
+	}
+	return sortPlanned(planned), nil
+}
+
+// planDetachedStorage returns the storage instances attached to the input
+// units that are not in the input destroyed storage instances.
+func (st *State) planDetachedStorage(
+	ctx context.Context, tx *sqlair.TX, unitUUIDs, destroyedStorageUUIDs []string,
+) ([]removal.PlannedRemoval, error) {
+	if len(unitUUIDs) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(`
+SELECT DISTINCT si.uuid AS &plannedEntity.uuid,
+       si.storage_id AS &plannedEntity.name
+FROM   storage_attachment AS sa
+JOIN   storage_instance AS si ON sa.storage_instance_uuid = si.uuid
+WHERE  sa.unit_uuid IN ($uuids[:])`, plannedEntity{}, uuids{})
+	if err != nil {
+		return nil, errors.Errorf("preparing plan detached storage query: %w", err)
+	}
+
+	var attached []plannedEntity
+	if err := tx.Query(ctx, stmt, uuids(unitUUIDs)).GetAll(&attached); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("running plan detached storage query: %w", err)
+	}
+	var planned []removal.PlannedRemoval
+	for _, si := range attached {
+		if slices.Contains(destroyedStorageUUIDs, si.UUID) {
+			continue
+		}
+		planned = append(planned, removal.PlannedRemoval{UUID: si.UUID, Name: si.Name})
+	}
+	return sortPlanned(planned), nil
+}
+
+// planApplicationOffers returns the offers of the input applications. Offers
+// with connections are reported as requiring force.
+func (st *State) planApplicationOffers(ctx context.Context, tx *sqlair.TX, appUUIDs []string) ([]removal.PlannedRemoval, error) {
+	if len(appUUIDs) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(`
+SELECT    o.uuid AS &plannedOffer.uuid,
+          o.name AS &plannedOffer.name,
+          COUNT(DISTINCT oc.uuid) AS &plannedOffer.connections
+FROM      offer AS o
+JOIN      offer_endpoint AS oe ON o.uuid = oe.offer_uuid
+JOIN      application_endpoint AS ae ON oe.endpoint_uuid = ae.uuid
+LEFT JOIN offer_connection AS oc ON o.uuid = oc.offer_uuid
+WHERE     ae.application_uuid IN ($uuids[:])
+GROUP BY  o.uuid`, plannedOffer{}, uuids{})
+	if err != nil {
+		return nil, errors.Errorf("preparing plan offers query: %w", err)
+	}
+
+	var offers []plannedOffer
+	if err := tx.Query(ctx, stmt, uuids(appUUIDs)).GetAll(&offers); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("running plan offers query: %w", err)
+	}
+	planned := make([]removal.PlannedRemoval, len(offers))
+	for i, o := range offers {
+		planned[i] = removal.PlannedRemoval{UUID: o.UUID, Name: o.Name}
+		if o.Connections > 0 {
+			planned[i].ForceRequired = fmt.Sprintf("offer has %d connection(s)", o.Connections)
+		}
+	}
+	return sortPlanned(planned), nil
+}
+
+// planRelations returns the input relations, named by their relation keys.
+func (st *State) planRelations(ctx context.Context, tx *sqlair.TX, relationUUIDs []string) ([]removal.PlannedRemoval, error) {
+	if len(relationUUIDs) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &plannedRelationEndpoint.*
+FROM   v_relation_endpoint
+WHERE  relation_uuid IN ($uuids[:])`, plannedRelationEndpoint{}, uuids{})
+	if err != nil {
+		return nil, errors.Errorf("preparing plan relations query: %w", err)
+	}
+
+	var endpoints []plannedRelationEndpoint
+	if err := tx.Query(ctx, stmt, uuids(relationUUIDs)).GetAll(&endpoints); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("running plan relations query: %w", err)
+	}
+
+	identifiers := make(map[string][]corerelation.EndpointIdentifier)
+	for _, ep := range endpoints {
+		identifiers[ep.RelationUUID] = append(identifiers[ep.RelationUUID], corerelation.EndpointIdentifier{
+			ApplicationName: ep.ApplicationName,
+			EndpointName:    ep.EndpointName,
+			Role:            charm.RelationRole(ep.Role),
+		})
+	}
+	planned := make([]removal.PlannedRemoval, 0, len(identifiers))
+	for relationUUID, eids := range identifiers {
+		planned = append(planned, removal.PlannedRemoval{
+			UUID: relationUUID,
+			Name: relationKeyName(eids),
+		})
+	}
+	return sortPlanned(planned), nil
+}
+
+// relationKeyName returns the relation key of the input endpoints. Should
+// the endpoints not form a valid key, they are joined in order of
+// application name.
+func relationKeyName(eids []corerelation.EndpointIdentifier) string {
+	if key, err := corerelation.NewKey(eids); err == nil {
+		return key.String()
+	}
+	names := make([]string, len(eids))
+	for i, eid := range eids {
+		names[i] = eid.String()
+	}
+	slices.Sort(names)
+	return strings.Join(names, " ")
+}
+
+func machineForceReason(err error) string {
+	if errors.Is(err, removalerrors.MachineHasContainers) {
+		return "machine has containers"
+	}
+	return "machine has units"
+}
+
+func sortPlanned(planned []removal.PlannedRemoval) []removal.PlannedRemoval {
+	slices.SortFunc(planned, func(a, b removal.PlannedRemoval) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return planned
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/instance"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/domain/removal"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type planSuite struct {
+	baseSuite
+}
+
+func TestPlanSuite(t *testing.T) {
+	tc.Run(t, &planSuite{})
+}
+
+func (s *planSuite) TestPlanApplicationRemoval(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "app1",
+		applicationservice.AddIAASUnitArg{},
+		applicationservice.AddIAASUnitArg{},
+	)
+	s.createIAASApplication(c, svc, "app2")
+
+	relSvc := s.setupRelationService(c)
+	_, _, err := relSvc.AddRelation(c.Context(), "app1:foo", "app2:bar")
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	plan, err := st.PlanApplicationRemoval(c.Context(), appUUID.String(), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(plannedNames(plan.Applications), tc.DeepEquals, []string{"app1"})
+	c.Check(plannedNames(plan.Units), tc.DeepEquals, []string{"app1/0", "app1/1"})
+	c.Check(plan.Machines, tc.HasLen, 2)
+	c.Assert(plan.Relations, tc.HasLen, 1)
+	c.Check(plan.Relations[0].Name, tc.Contains, "app1:foo")
+	c.Check(plan.ForceRequired(), tc.Equals, false)
+
+	// Planning must not advance the life of anything.
+	s.checkApplicationLife(c, appUUID.String(), life.Alive)
+	unitUUIDs, machineUUIDs := s.getAllUnitAndMachineUUIDs(c)
+	for _, u := range unitUUIDs {
+		s.checkUnitLife(c, u.String(), life.Alive)
+	}
+	for _, m := range machineUUIDs {
+		s.checkMachineLife(c, m.String(), life.Alive)
+	}
+}
+
+func (s *planSuite) TestPlanApplicationRemovalUnitsSharingMachine(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "app1", applicationservice.AddIAASUnitArg{})
+	_, _, err := svc.AddIAASUnits(c.Context(), "app1", applicationservice.AddIAASUnitArg{
+		AddUnitArg: applicationservice.AddUnitArg{
+			Placement: instance.MustParsePlacement("0"),
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, machineUUIDs := s.getAllUnitAndMachineUUIDs(c)
+	machineUUIDs = removeDuplicates(machineUUIDs)
+	c.Assert(machineUUIDs, tc.HasLen, 1)
+	machineUUID := machineUUIDs[0]
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	plan, err := st.PlanApplicationRemoval(c.Context(), appUUID.String(), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The machine is emptied by removing both units, so it is removed too.
+	c.Check(plannedNames(plan.Units), tc.DeepEquals, []string{"app1/0", "app1/1"})
+	c.Assert(plan.Machines, tc.HasLen, 1)
+	c.Check(plan.Machines[0].UUID, tc.Equals, machineUUID.String())
+
+	s.checkMachineLife(c, machineUUID.String(), life.Alive)
+}
+
+func (s *planSuite) TestPlanApplicationRemovalMachineKeptForOtherUnits(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "app1", applicationservice.AddIAASUnitArg{})
+	s.createIAASApplication(c, svc, "app2", applicationservice.AddIAASUnitArg{
+		AddUnitArg: applicationservice.AddUnitArg{
+			Placement: instance.MustParsePlacement("0"),
+		},
+	})
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	plan, err := st.PlanApplicationRemoval(c.Context(), appUUID.String(), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The machine still hosts a live unit of another application.
+	c.Check(plannedNames(plan.Units), tc.DeepEquals, []string{"app1/0"})
+	c.Check(plan.Machines, tc.HasLen, 0)
+}
+
+func (s *planSuite) TestPlanApplicationRemovalNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	plan, err := st.PlanApplicationRemoval(c.Context(), "not-today-henry", false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(plan.Applications, tc.HasLen, 0)
+}
+
+func (s *planSuite) TestPlanUnitRemovalErrorRequiresForce(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "app1", applicationservice.AddIAASUnitArg{})
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(unitUUIDs, tc.HasLen, 1)
+
+	_, err := s.DB().ExecContext(c.Context(),
+		"UPDATE unit_agent_status SET status_id = 3 WHERE unit_uuid = ?", unitUUIDs[0].String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	plan, err := st.PlanUnitRemoval(c.Context(), unitUUIDs[0].String(), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(plan.Units, tc.HasLen, 1)
+	c.Check(plan.Units[0].Name, tc.Equals, "app1/0")
+	c.Check(plan.Units[0].ForceRequired, tc.Equals, "unit is in an error state")
+	c.Check(plan.Machines, tc.HasLen, 1)
+	c.Check(plan.ForceRequired(), tc.Equals, true)
+
+	s.checkUnitLife(c, unitUUIDs[0].String(), life.Alive)
+}
+
+func (s *planSuite) TestPlanMachineRemovalWithUnitsRequiresForce(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "app1", applicationservice.AddIAASUnitArg{})
+	machineUUID := s.getMachineUUIDFromApp(c, appUUID)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	plan, err := st.PlanMachineRemoval(c.Context(), machineUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(plan.Machines, tc.HasLen, 1)
+	c.Check(plan.Machines[0].ForceRequired, tc.Equals, "machine has units")
+	c.Check(plannedNames(plan.Units), tc.DeepEquals, []string{"app1/0"})
+
+	s.checkMachineLife(c, machineUUID.String(), life.Alive)
+}
+
+func (s *planSuite) TestPlanModelRemoval(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	s.createIAASApplication(c, svc, "app1", applicationservice.AddIAASUnitArg{})
+	s.createIAASApplication(c, svc, "app2")
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	plan, err := st.PlanModelRemoval(c.Context(), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(plannedNames(plan.Applications), tc.DeepEquals, []string{"app1", "app2"})
+	c.Check(plannedNames(plan.Units), tc.DeepEquals, []string{"app1/0"})
+	c.Check(plan.Machines, tc.HasLen, 1)
+	c.Check(plan.DestroyedStorage, tc.HasLen, 0)
+}
+
+func plannedNames(planned []removal.PlannedRemoval) []string {
+	names := make([]string, len(planned))
+	for i, p := range planned {
+		names[i] = p.Name
+	}
+	return names
+}
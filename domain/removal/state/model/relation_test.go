@@ -428,7 +428,7 @@ func (s *relationSuite) TestLeaveScopeSyntheticUnitsInMultipleRelations(c *tc.C)
 	c.Assert(err, tc.ErrorIsNil)
 
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 	err = cmrState.EnsureUnitsExist(c.Context(), synthAppUUID.String(), []string{"foo/0", "foo/1", "foo/2"})
 	c.Assert(err, tc.ErrorIsNil)
@@ -307,7 +307,7 @@ func (s *remoteApplicationOffererSuite) TestDeleteRemoteApplicationOffererWithUn
 	appUUID, remoteAppUUID := s.createRemoteApplicationOfferer(c, "foo")
 
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 	err := cmrState.EnsureUnitsExist(c.Context(), appUUID.String(), []string{"foo/0", "foo/1", "foo/2"})
 	c.Assert(err, tc.ErrorIsNil)
@@ -359,7 +359,7 @@ func (s *remoteApplicationOffererSuite) TestDeleteRemoteApplicationOffererWithSe
 	c.Assert(err, tc.ErrorIsNil)
 
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 	err = cmrState.EnsureUnitsExist(c.Context(), synthAppUUID.String(), []string{"foo/0"})
 	c.Assert(err, tc.ErrorIsNil)
@@ -5,7 +5,6 @@ package model
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/canonical/sqlair"
 
@@ -62,11 +61,9 @@ func (st *State) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
 
 	jobs := make([]removal.Job, len(dbJobs))
 	for i, job := range dbJobs {
-		var arg map[string]any
-		if job.Arg.Valid && job.Arg.String != "" {
-			if err := json.Unmarshal([]byte(job.Arg.String), &arg); err != nil {
-				return nil, errors.Errorf("decoding job arg: %w", err)
-			}
+		arg, err := decodeJobArg(job.Arg)
+		if err != nil {
+			return nil, errors.Capture(err)
 		}
 
 		jobs[i] = removal.Job{
@@ -92,12 +89,20 @@ func (st *State) DeleteJob(ctx context.Context, jUUID string) error {
 
 	jobUUID := entityUUID{UUID: jUUID}
 
+	statusStmt, err := st.Prepare("DELETE FROM removal_status WHERE removal_uuid=$entityUUID.uuid", jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job status deletion: %w", err)
+	}
+
 	stmt, err := st.Prepare("DELETE FROM removal WHERE uuid=$entityUUID.uuid", jobUUID)
 	if err != nil {
 		return errors.Errorf("preparing job deletion: %w", err)
 	}
 
 	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, statusStmt, jobUUID).Run(); err != nil {
+			return errors.Errorf("deleting removal status row: %w", err)
+		}
 		if err := tx.Query(ctx, stmt, jobUUID).Run(); err != nil {
 			return errors.Errorf("deleting removal row: %w", err)
 		}
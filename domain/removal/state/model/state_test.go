@@ -368,7 +368,7 @@ func (s *baseSuite) createCAASApplication(c *tc.C, svc *applicationservice.Provi
 
 func (s *baseSuite) createOffer(c *tc.C, offerName string) offer.UUID {
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 	s.createIAASApplication(c, s.setupApplicationService(c), offerName)
 	offerUUID := tc.Must(c, offer.NewUUID)
@@ -386,7 +386,7 @@ func (s *baseSuite) createOffer(c *tc.C, offerName string) offer.UUID {
 
 func (s *baseSuite) createOfferForApplication(c *tc.C, appName string, offerName string) offer.UUID {
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 	offerUUID := tc.Must(c, offer.NewUUID)
 
@@ -409,7 +409,7 @@ func (s *baseSuite) createRemoteApplicationOfferer(
 	name string,
 ) (coreapplication.UUID, coreremoteapplication.UUID) {
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 
 	ch := charm.Charm{
@@ -464,7 +464,7 @@ func (s *baseSuite) createRemoteApplicationConsumer(
 	offerUUID offer.UUID,
 ) (coreapplication.UUID, coreremoteapplication.UUID) {
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 
 	ch := charm.Charm{
@@ -557,7 +557,7 @@ func (s *baseSuite) createRelationWithRemoteOfferer(c *tc.C) (relation.UUID, cor
 	c.Assert(err, tc.ErrorIsNil)
 
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 	err = cmrState.EnsureUnitsExist(c.Context(), synthAppUUID.String(), []string{"foo/0", "foo/1", "foo/2"})
 	c.Assert(err, tc.ErrorIsNil)
@@ -588,7 +588,7 @@ func (s *baseSuite) createRelationWithRemoteConsumer(c *tc.C) (relation.UUID, co
 	c.Assert(err, tc.ErrorIsNil)
 
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 
 	err = cmrState.EnsureUnitsExist(c.Context(), synthAppUUID.String(), []string{"foo/0", "foo/1", "foo/2"})
@@ -620,7 +620,7 @@ func (s *baseSuite) createRemoteRelationBetween(c *tc.C, synthAppName, appName s
 	c.Assert(err, tc.ErrorIsNil)
 
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 
 	unit0name := fmt.Sprintf("%s/0", synthAppName)
@@ -32,6 +32,31 @@ type removalJob struct {
 	Arg sql.NullString `db:"arg"`
 }
 
+// removalJobStatus represents a record in the removal table joined with its
+// execution history from the removal_status table, and the name of the
+// entity being removed.
+type removalJobStatus struct {
+	UUID            string              `db:"uuid"`
+	RemovalTypeID   uint64              `db:"removal_type_id"`
+	EntityUUID      string              `db:"entity_uuid"`
+	EntityName      sql.Null[string]    `db:"entity_name"`
+	Force           bool                `db:"force"`
+	ScheduledFor    time.Time           `db:"scheduled_for"`
+	Arg             sql.NullString      `db:"arg"`
+	CreatedAt       time.Time           `db:"created_at"`
+	Attempts        int                 `db:"attempts"`
+	LastAttemptedAt sql.Null[time.Time] `db:"last_attempted_at"`
+	LastError       sql.Null[string]    `db:"last_error"`
+}
+
+// removalStatus represents a record in the removal_status table.
+type removalStatus struct {
+	RemovalUUID     string              `db:"removal_uuid"`
+	Attempts        int                 `db:"attempts"`
+	LastAttemptedAt sql.Null[time.Time] `db:"last_attempted_at"`
+	LastError       sql.Null[string]    `db:"last_error"`
+}
+
 // objectStoreUUID holds the UUID of an object store item.
 type objectStoreUUID struct {
 	UUID sql.Null[string] `db:"uuid"`
@@ -215,3 +240,33 @@ type storageRemoval struct {
 type dbModelType struct {
 	Type string `db:"type"`
 }
+
+// plannedEntity holds the identity of an entity in a removal plan.
+type plannedEntity struct {
+	UUID string `db:"uuid"`
+	Name string `db:"name"`
+}
+
+// plannedUnit holds the identity and agent status of a unit in a removal
+// plan.
+type plannedUnit struct {
+	UUID        string           `db:"uuid"`
+	Name        string           `db:"name"`
+	AgentStatus sql.Null[string] `db:"agent_status"`
+}
+
+// plannedOffer holds the identity of an offer in a removal plan, along with
+// its number of connections.
+type plannedOffer struct {
+	UUID        string `db:"uuid"`
+	Name        string `db:"name"`
+	Connections int    `db:"connections"`
+}
+
+// plannedRelationEndpoint holds an endpoint of a relation in a removal plan.
+type plannedRelationEndpoint struct {
+	RelationUUID    string `db:"relation_uuid"`
+	ApplicationName string `db:"application_name"`
+	EndpointName    string `db:"endpoint_name"`
+	Role            string `db:"role"`
+}
@@ -881,6 +881,7 @@ func (st *State) deleteForeignKeyUnitReferences(ctx context.Context, tx *sqlair.
 		"DELETE FROM unit_state WHERE unit_uuid = $entityUUID.uuid",
 		"DELETE FROM unit_state_charm WHERE unit_uuid = $entityUUID.uuid",
 		"DELETE FROM unit_state_relation WHERE unit_uuid = $entityUUID.uuid",
+		"DELETE FROM unit_hook_history WHERE unit_uuid = $entityUUID.uuid",
 		"DELETE FROM unit_agent_status WHERE unit_uuid = $entityUUID.uuid",
 		"DELETE FROM unit_workload_status WHERE unit_uuid = $entityUUID.uuid",
 		"DELETE FROM unit_workload_version WHERE unit_uuid = $entityUUID.uuid",
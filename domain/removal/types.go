@@ -120,6 +120,33 @@ type Job struct {
 	Arg map[string]any
 }
 
+// JobStatus describes a removal job along with its execution history.
+type JobStatus struct {
+	Job
+
+	// EntityName is the human readable identifier of the entity being
+	// removed; the name of an application, unit or machine, or the ID of a
+	// storage instance. It is empty for other entities, or if the entity no
+	// longer exists.
+	EntityName string
+	// CreatedAt is when the job was created.
+	CreatedAt time.Time
+	// Attempts is the number of times that execution of the job has been
+	// started.
+	Attempts int
+	// LastAttemptedAt is when execution of the job was last started.
+	// It is the zero time if the job has not been started.
+	LastAttemptedAt time.Time
+	// LastError is the error returned by the most recent execution of the
+	// job. It is empty if that execution did not fail.
+	LastError string
+}
+
+// Started returns true if execution of the job has been attempted.
+func (s JobStatus) Started() bool {
+	return s.Attempts > 0
+}
+
 // ModelArtifacts holds the artifacts associated with a model that is being
 // removed.
 type ModelArtifacts struct {
@@ -143,3 +170,53 @@ func (a ModelArtifacts) Empty() bool {
 		len(a.UnitUUIDs) == 0 &&
 		len(a.RelationUUIDs) == 0
 }
+
+// PlannedRemoval identifies an entity that would be removed by a removal
+// operation.
+type PlannedRemoval struct {
+	// UUID uniquely identifies the entity.
+	UUID string
+	// Name is the human readable identifier of the entity; the name of an
+	// application, unit, machine or offer, the key of a relation, or the ID
+	// of a storage instance.
+	Name string
+	// ForceRequired is the reason that the entity can only be removed with
+	// the force flag. It is empty if force is not required.
+	ForceRequired string
+}
+
+// Plan describes the entities that a removal operation would remove,
+// without removing any of them.
+type Plan struct {
+	// Applications are the applications that would be removed.
+	Applications []PlannedRemoval
+	// Units are the units that would be removed.
+	Units []PlannedRemoval
+	// Relations are the relations that would be removed.
+	Relations []PlannedRemoval
+	// Machines are the machines that would be removed.
+	Machines []PlannedRemoval
+	// Offers are the offers that would be removed.
+	Offers []PlannedRemoval
+	// DestroyedStorage are the storage instances that would be destroyed.
+	DestroyedStorage []PlannedRemoval
+	// DetachedStorage are the storage instances that would be detached from
+	// removed units, and remain in the model.
+	DetachedStorage []PlannedRemoval
+}
+
+// ForceRequired returns true if any entity in the plan can only be removed
+// with the force flag.
+func (p Plan) ForceRequired() bool {
+	for _, entities := range [][]PlannedRemoval{
+		p.Applications, p.Units, p.Relations, p.Machines, p.Offers,
+		p.DestroyedStorage, p.DetachedStorage,
+	} {
+		for _, e := range entities {
+			if e.ForceRequired != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
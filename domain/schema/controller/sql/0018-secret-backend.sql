@@ -14,7 +14,8 @@ ON secret_backend_type (type);
 INSERT INTO secret_backend_type VALUES
 (0, 'controller', 'the juju controller secret backend'),
 (1, 'kubernetes', 'the kubernetes secret backend'),
-(2, 'vault', 'the vault secret backend');
+(2, 'vault', 'the vault secret backend'),
+(3, 'file', 'the file secret backend');
 
 CREATE TABLE secret_backend_origin (
     id INT NOT NULL PRIMARY KEY,
@@ -104,3 +105,91 @@ FROM model_secret_backend AS msb
 JOIN secret_backend AS sb ON msb.secret_backend_uuid = sb.uuid
 JOIN model AS m ON msb.model_uuid = m.uuid
 JOIN model_type AS mt ON m.model_type_id = mt.id;
+
+-- secret_encryption_key holds the controller key-encryption keys. Each model
+-- encrypts the secret content held in the internal backend with its own data
+-- key, which is stored in the model database wrapped by one of these keys.
+-- Exactly one key is active and used to wrap new data keys. Keys that are no
+-- longer active are retained until no model data key references them. The key
+-- material is not held in the database, but by each controller agent, so that
+-- a database dump does not hold what is needed to unwrap the data keys.
+CREATE TABLE secret_encryption_key (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    active BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc'))
+);
+
+CREATE UNIQUE INDEX idx_singleton_active_secret_encryption_key
+ON secret_encryption_key ((1)) WHERE active = TRUE;
+
+-- secret_encryption_key_reference records the key-encryption keys with which
+-- a model's data key is, or is about to be, wrapped. A reference is added
+-- before a model writes a data key wrapped by the key, so a retired key is
+-- not removed while any model, including one still being imported, may hold
+-- a data key wrapped by it.
+CREATE TABLE secret_encryption_key_reference (
+    model_uuid TEXT NOT NULL,
+    key_encryption_key_uuid TEXT NOT NULL,
+    CONSTRAINT pk_secret_encryption_key_reference
+    PRIMARY KEY (model_uuid, key_encryption_key_uuid),
+    CONSTRAINT fk_secret_encryption_key_reference_key_encryption_key_uuid
+    FOREIGN KEY (key_encryption_key_uuid)
+    REFERENCES secret_encryption_key (uuid)
+);
+
+-- secret_backend_drain records the move of a model's secret content from one
+-- backend to another, which begins when the model's secret backend is changed.
+-- A drain that is aborted is reversed, moving content back to the source.
+CREATE TABLE secret_backend_drain_status (
+    id INT PRIMARY KEY,
+    status TEXT NOT NULL,
+    CONSTRAINT chk_empty_status
+    CHECK (status != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_backend_drain_status_status
+ON secret_backend_drain_status (status);
+
+INSERT INTO secret_backend_drain_status VALUES
+(0, 'draining'),
+(1, 'reverting');
+
+CREATE TABLE secret_backend_drain (
+    model_uuid TEXT NOT NULL PRIMARY KEY,
+    source_backend_uuid TEXT NOT NULL,
+    target_backend_uuid TEXT NOT NULL,
+    status_id INT NOT NULL,
+    started_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
+    CONSTRAINT fk_secret_backend_drain_model_uuid
+    FOREIGN KEY (model_uuid)
+    REFERENCES model (uuid),
+    CONSTRAINT fk_secret_backend_drain_source_backend_uuid
+    FOREIGN KEY (source_backend_uuid)
+    REFERENCES secret_backend (uuid),
+    CONSTRAINT fk_secret_backend_drain_target_backend_uuid
+    FOREIGN KEY (target_backend_uuid)
+    REFERENCES secret_backend (uuid),
+    CONSTRAINT fk_secret_backend_drain_status_id
+    FOREIGN KEY (status_id)
+    REFERENCES secret_backend_drain_status (id)
+);
+
+-- secret_backend_drain_revision holds the checksum of each secret revision
+-- copied to the target backend during a drain, after the content read back
+-- from the target has been verified against the source.
+CREATE TABLE secret_backend_drain_revision (
+    model_uuid TEXT NOT NULL,
+    secret_revision_uuid TEXT NOT NULL,
+    backend_uuid TEXT NOT NULL,
+    checksum TEXT NOT NULL,
+    CONSTRAINT chk_empty_checksum
+    CHECK (checksum != ''),
+    CONSTRAINT pk_secret_backend_drain_revision
+    PRIMARY KEY (model_uuid, secret_revision_uuid),
+    CONSTRAINT fk_secret_backend_drain_revision_model_uuid
+    FOREIGN KEY (model_uuid)
+    REFERENCES secret_backend_drain (model_uuid),
+    CONSTRAINT fk_secret_backend_drain_revision_backend_uuid
+    FOREIGN KEY (backend_uuid)
+    REFERENCES secret_backend (uuid)
+);
@@ -0,0 +1,18 @@
+CREATE TABLE ssh_session_recording (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    -- user_name is the name of the user that authenticated with the
+    -- controller's SSH server, rather than the user logged into on the
+    -- target.
+    user_name TEXT NOT NULL,
+    -- target is the virtual hostname of the unit, machine or container
+    -- the session was proxied to.
+    target TEXT NOT NULL,
+    -- path is the path of the recording in the controller object store.
+    path TEXT NOT NULL,
+    size INT NOT NULL,
+    started_at DATETIME NOT NULL,
+    ended_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_ssh_session_recording_user_name
+ON ssh_session_recording (user_name);
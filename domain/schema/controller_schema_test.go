@@ -192,6 +192,11 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		"secret_backend_type",
 		"secret_backend_reference",
 		"model_secret_backend",
+		"secret_encryption_key",
+		"secret_encryption_key_reference",
+		"secret_backend_drain",
+		"secret_backend_drain_revision",
+		"secret_backend_drain_status",
 
 		// macaroon bakery
 		"bakery_config",
@@ -206,6 +211,9 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 
 		// Tracing config
 		"charm_tracing_config",
+
+		// SSH session recordings
+		"ssh_session_recording",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
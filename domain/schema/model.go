@@ -29,6 +29,7 @@ import (
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/crossmodelrelation-triggers.gen.go -package=triggers -tables=application_remote_offerer,application_remote_consumer,relation_network_ingress,relation_network_egress
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/offer-triggers.gen.go -package=triggers -tables=offer
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/storage-triggers.gen.go -package=triggers -tables=storage_snapshot
 
 //go:embed model/sql/*.sql
 var modelSchemaDir embed.FS
@@ -64,6 +65,10 @@ const (
 	customNamespaceUnitWorkloadStatus
 	customNamespaceK8sPodStatus
 	customNamespaceRelationLifeSuspended
+	customNamespaceStorageFilesystemRequestedSizeModelProvisioning
+	customNamespaceStorageFilesystemRequestedSizeMachineProvisioning
+	customNamespaceStorageVolumeRequestedSizeModelProvisioning
+	customNamespaceStorageVolumeRequestedSizeMachineProvisioning
 )
 
 const (
@@ -108,6 +113,7 @@ const (
 	tableApplicationStatus
 	tableRelationNetworkIngress
 	tableRelationNetworkEgress
+	tableStorageSnapshot
 )
 
 // modelPostPatchFilesByVersion is used to categorise the post patch files
@@ -191,6 +197,7 @@ func ModelDDLForVersion(version semversion.Number) *schema.Schema {
 		triggers.ChangeLogTriggersForApplicationStatus("application_uuid", tableApplicationStatus),
 		triggers.ChangeLogTriggersForRelationNetworkIngress("relation_uuid", tableRelationNetworkIngress),
 		triggers.ChangeLogTriggersForRelationNetworkEgress("relation_uuid", tableRelationNetworkEgress),
+		triggers.ChangeLogTriggersForStorageSnapshot("uuid", tableStorageSnapshot),
 	)
 
 	// Generic triggers.
@@ -252,6 +252,75 @@ INSERT INTO storage_provision_scope (id, scope) VALUES
 (0, 'model'),
 (1, 'machine');
 
+CREATE TABLE storage_snapshot_status_value (
+    id INT PRIMARY KEY,
+    status TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_storage_snapshot_status_value
+ON storage_snapshot_status_value (status);
+
+INSERT INTO storage_snapshot_status_value VALUES
+(0, 'pending'),
+(1, 'ready'),
+(2, 'created'),
+(3, 'available'),
+(4, 'failed'),
+(5, 'error');
+
+-- storage_snapshot describes a point-in-time snapshot of the volume backing
+-- a storage instance.
+--
+-- A snapshot of storage attached to a unit starts out pending until the unit
+-- has run its storage-snapshot-pre hook, which makes it ready to be taken by
+-- the storage provisioner. Once taken it is created until the unit has run
+-- its storage-snapshot-post hook, which makes it available. A snapshot the
+-- storage provisioner could not take is failed until the unit has run its
+-- storage-snapshot-post hook, and is then an error. Snapshots of storage not
+-- attached to a unit skip the pending, created and failed statuses.
+--
+-- Snapshots outlive the storage they were taken of so that they can be
+-- restored after the storage has been removed. For this reason the storage
+-- instance, volume and unit are recorded without foreign keys.
+CREATE TABLE storage_snapshot (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    -- snapshot_id is a unique id number for the snapshot.
+    snapshot_id TEXT NOT NULL,
+    storage_instance_uuid TEXT NOT NULL,
+    storage_id TEXT NOT NULL,
+    storage_name TEXT NOT NULL,
+    storage_kind_id INT NOT NULL,
+    storage_pool_uuid TEXT NOT NULL,
+    storage_volume_uuid TEXT NOT NULL,
+    -- unit_uuid is the unit that the storage was attached to when the
+    -- snapshot was requested, or NULL when it was not attached.
+    unit_uuid TEXT,
+    status_id INT NOT NULL,
+    message TEXT,
+    provider_id TEXT,
+    size_mib INT,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    CONSTRAINT fk_storage_snapshot_storage_kind
+    FOREIGN KEY (storage_kind_id)
+    REFERENCES storage_kind (id),
+    CONSTRAINT fk_storage_snapshot_storage_pool
+    FOREIGN KEY (storage_pool_uuid)
+    REFERENCES storage_pool (uuid),
+    CONSTRAINT fk_storage_snapshot_status
+    FOREIGN KEY (status_id)
+    REFERENCES storage_snapshot_status_value (id)
+);
+
+CREATE UNIQUE INDEX idx_storage_snapshot_id
+ON storage_snapshot (snapshot_id);
+
+CREATE INDEX idx_storage_snapshot_storage_instance
+ON storage_snapshot (storage_instance_uuid);
+
+CREATE INDEX idx_storage_snapshot_unit
+ON storage_snapshot (unit_uuid);
+
 -- storage_volume describes a volume held by a storage_instance.
 --
 -- obliterate_on_cleanup can only be set when life_id is not-alive(>0), to
@@ -267,6 +336,9 @@ CREATE TABLE storage_volume (
     wwn TEXT,
     persistent BOOLEAN,
     obliterate_on_cleanup BOOLEAN,
+    -- snapshot_uuid is the snapshot that the volume is to be created from,
+    -- or NULL when the volume is to be created empty.
+    snapshot_uuid TEXT,
     CONSTRAINT chk_storage_volume_obliterate_on_cleanup_set_when_not_alive
     CHECK (obliterate_on_cleanup IS NULL OR life_id <> 0),
     CONSTRAINT fk_storage_instance_life
@@ -274,7 +346,10 @@ CREATE TABLE storage_volume (
     REFERENCES life (id),
     CONSTRAINT fk_storage_volume_provision_scope_id
     FOREIGN KEY (provision_scope_id)
-    REFERENCES storage_provision_scope (id)
+    REFERENCES storage_provision_scope (id),
+    CONSTRAINT fk_storage_volume_snapshot
+    FOREIGN KEY (snapshot_uuid)
+    REFERENCES storage_snapshot (uuid)
 );
 
 CREATE UNIQUE INDEX idx_storage_volume_id
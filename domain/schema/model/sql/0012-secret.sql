@@ -117,6 +117,22 @@ CREATE TABLE secret_content (
 CREATE INDEX idx_secret_content_revision_uuid
 ON secret_content (revision_uuid);
 
+-- secret_data_key holds the key with which the content in secret_content is
+-- encrypted. The key is stored wrapped by the controller key-encryption key
+-- identified by key_encryption_key_uuid, which lives in the controller
+-- database, so this database alone is not enough to decrypt secret content.
+CREATE TABLE secret_data_key (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    wrapped_key TEXT NOT NULL,
+    key_encryption_key_uuid TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
+    CONSTRAINT chk_empty_wrapped_key
+    CHECK (wrapped_key != '')
+);
+
+CREATE UNIQUE INDEX idx_singleton_secret_data_key
+ON secret_data_key ((1));
+
 CREATE TABLE secret_revision (
     uuid TEXT NOT NULL PRIMARY KEY,
     secret_id TEXT NOT NULL,
@@ -274,6 +290,45 @@ INSERT INTO secret_grant_scope_type VALUES
 (2, 'model'),
 (3, 'relation');
 
+CREATE TABLE secret_access_action (
+    id INT PRIMARY KEY,
+    action TEXT
+);
+
+INSERT INTO secret_access_action VALUES
+(0, 'read'),
+(1, 'grant'),
+(2, 'revoke');
+
+-- secret_access_log records reads of secret content and changes to
+-- secret access. There are deliberately no foreign keys so that the
+-- log outlives the secrets, revisions and entities it refers to.
+CREATE TABLE secret_access_log (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    secret_id TEXT NOT NULL,
+    -- revision is only set for reads.
+    revision INT,
+    action_id INT NOT NULL,
+    -- accessor is the unit, application, model or user
+    -- which read the secret or changed its access.
+    accessor_kind TEXT NOT NULL,
+    accessor_id TEXT NOT NULL,
+    -- subject is the entity whose access was granted
+    -- or revoked. It is not set for reads.
+    subject_kind TEXT,
+    subject_id TEXT,
+    -- backend_id is the external backend holding the content
+    -- which was read, or NULL if it is held in the model.
+    backend_id TEXT,
+    created_at DATETIME NOT NULL,
+    CONSTRAINT fk_secret_access_log_action
+    FOREIGN KEY (action_id)
+    REFERENCES secret_access_action (id)
+);
+
+CREATE INDEX idx_secret_access_log_secret_id_created_at
+ON secret_access_log (secret_id, created_at);
+
 CREATE TABLE secret_permission (
     secret_id TEXT NOT NULL,
     role_id INT NOT NULL,
@@ -191,6 +191,9 @@ CREATE TABLE application_constraint (
 CREATE TABLE application_setting (
     application_uuid TEXT NOT NULL PRIMARY KEY,
     trust BOOLEAN DEFAULT FALSE,
+    -- hook_timeout_seconds overrides the hook-timeout model config for the
+    -- units of the application. NULL means the model config applies.
+    hook_timeout_seconds INT CHECK (hook_timeout_seconds >= 0),
     CONSTRAINT fk_application_setting_application
     FOREIGN KEY (application_uuid)
     REFERENCES application (uuid)
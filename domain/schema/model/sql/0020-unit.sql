@@ -123,6 +123,42 @@ CREATE TABLE unit_state_relation (
     REFERENCES unit (uuid)
 );
 
+CREATE TABLE unit_hook_history_kind (
+    id INT PRIMARY KEY,
+    kind TEXT NOT NULL
+);
+
+INSERT INTO unit_hook_history_kind VALUES
+(0, 'hook'),
+(1, 'action'),
+(2, 'commands');
+
+-- unit_hook_history records the hooks, actions and commands run by a
+-- unit agent. It is bounded, with the oldest rows for a unit being
+-- removed as new ones are recorded.
+CREATE TABLE unit_hook_history (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    unit_uuid TEXT NOT NULL,
+    kind_id INT NOT NULL,
+    -- name is the hook or action name. It is empty for commands.
+    name TEXT NOT NULL,
+    -- relation_id is only set for relation hooks.
+    relation_id INT,
+    started_at DATETIME NOT NULL,
+    duration_ms INT NOT NULL,
+    exit_code INT NOT NULL,
+    error TEXT,
+    CONSTRAINT fk_unit_hook_history_unit
+    FOREIGN KEY (unit_uuid)
+    REFERENCES unit (uuid),
+    CONSTRAINT fk_unit_hook_history_kind
+    FOREIGN KEY (kind_id)
+    REFERENCES unit_hook_history_kind (id)
+);
+
+CREATE INDEX idx_unit_hook_history_unit_started_at
+ON unit_hook_history (unit_uuid, started_at);
+
 -- cloud containers belong to a k8s unit.
 CREATE TABLE k8s_pod (
     unit_uuid TEXT NOT NULL PRIMARY KEY,
@@ -37,7 +37,22 @@ CREATE TABLE removal (
     scheduled_for DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
     -- JSON for free-form job argumentation.
     arg TEXT,
+    created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
     CONSTRAINT fk_removal_type
     FOREIGN KEY (removal_type_id)
     REFERENCES removal_type (id)
 );
+
+-- removal_status records the execution history of a removal job.
+-- It is kept apart from the removal table so that recording an attempt
+-- does not notify watchers of a change to the job itself.
+-- A job without a status row has not yet been started.
+CREATE TABLE removal_status (
+    removal_uuid TEXT NOT NULL PRIMARY KEY,
+    attempts INT NOT NULL DEFAULT 0,
+    last_attempted_at DATETIME,
+    last_error TEXT,
+    CONSTRAINT fk_removal_status_removal
+    FOREIGN KEY (removal_uuid)
+    REFERENCES removal (uuid)
+);
@@ -219,7 +219,8 @@ CREATE TRIGGER trg_log_application_setting_update
 AFTER UPDATE ON application_setting FOR EACH ROW
 WHEN 
 	NEW.application_uuid != OLD.application_uuid OR
-	(NEW.trust != OLD.trust OR (NEW.trust IS NOT NULL AND OLD.trust IS NULL) OR (NEW.trust IS NULL AND OLD.trust IS NOT NULL)) 
+	(NEW.trust != OLD.trust OR (NEW.trust IS NOT NULL AND OLD.trust IS NULL) OR (NEW.trust IS NULL AND OLD.trust IS NOT NULL)) OR
+	(NEW.hook_timeout_seconds != OLD.hook_timeout_seconds OR (NEW.hook_timeout_seconds IS NOT NULL AND OLD.hook_timeout_seconds IS NULL) OR (NEW.hook_timeout_seconds IS NULL AND OLD.hook_timeout_seconds IS NOT NULL)) 
 BEGIN
     INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
     VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
@@ -0,0 +1,60 @@
+// Code generated by triggergen. DO NOT EDIT.
+
+package triggers
+
+import (
+	"fmt"
+
+	"github.com/juju/juju/core/database/schema"
+)
+
+
+// ChangeLogTriggersForStorageSnapshot generates the triggers for the
+// storage_snapshot table.
+func ChangeLogTriggersForStorageSnapshot(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for StorageSnapshot
+INSERT INTO change_log_namespace VALUES (%[2]d, 'storage_snapshot', 'StorageSnapshot changes based on %[1]s');
+
+-- insert trigger for StorageSnapshot
+CREATE TRIGGER trg_log_storage_snapshot_insert
+AFTER INSERT ON storage_snapshot FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now', 'utc'));
+END;
+
+-- update trigger for StorageSnapshot
+CREATE TRIGGER trg_log_storage_snapshot_update
+AFTER UPDATE ON storage_snapshot FOR EACH ROW
+WHEN 
+	NEW.uuid != OLD.uuid OR
+	NEW.snapshot_id != OLD.snapshot_id OR
+	NEW.storage_instance_uuid != OLD.storage_instance_uuid OR
+	NEW.storage_id != OLD.storage_id OR
+	NEW.storage_name != OLD.storage_name OR
+	NEW.storage_kind_id != OLD.storage_kind_id OR
+	NEW.storage_pool_uuid != OLD.storage_pool_uuid OR
+	NEW.storage_volume_uuid != OLD.storage_volume_uuid OR
+	(NEW.unit_uuid != OLD.unit_uuid OR (NEW.unit_uuid IS NOT NULL AND OLD.unit_uuid IS NULL) OR (NEW.unit_uuid IS NULL AND OLD.unit_uuid IS NOT NULL)) OR
+	NEW.status_id != OLD.status_id OR
+	(NEW.message != OLD.message OR (NEW.message IS NOT NULL AND OLD.message IS NULL) OR (NEW.message IS NULL AND OLD.message IS NOT NULL)) OR
+	(NEW.provider_id != OLD.provider_id OR (NEW.provider_id IS NOT NULL AND OLD.provider_id IS NULL) OR (NEW.provider_id IS NULL AND OLD.provider_id IS NOT NULL)) OR
+	(NEW.size_mib != OLD.size_mib OR (NEW.size_mib IS NOT NULL AND OLD.size_mib IS NULL) OR (NEW.size_mib IS NULL AND OLD.size_mib IS NOT NULL)) OR
+	NEW.created_at != OLD.created_at OR
+	NEW.updated_at != OLD.updated_at 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;
+-- delete trigger for StorageSnapshot
+CREATE TRIGGER trg_log_storage_snapshot_delete
+AFTER DELETE ON storage_snapshot FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;`, columnName, namespaceID))
+	}
+}
+
@@ -187,6 +187,8 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"unit_state_charm",
 		"unit_state_relation",
 		"unit_state",
+		"unit_hook_history_kind",
+		"unit_hook_history",
 		"unit_workload_status",
 		"unit_workload_version",
 		"unit",
@@ -300,6 +302,8 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"storage_pool",
 		"storage_pool_origin",
 		"storage_provision_scope",
+		"storage_snapshot",
+		"storage_snapshot_status_value",
 		"storage_unit_owner",
 		"storage_volume_attachment_plan_attr",
 		"storage_volume_attachment_plan",
@@ -319,6 +323,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_value_ref",
 		"secret_deleted_value_ref",
 		"secret_content",
+		"secret_data_key",
 		"secret_revision",
 		"secret_revision_obsolete",
 		"secret_revision_expire",
@@ -331,6 +336,8 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_role",
 		"secret_grant_subject_type",
 		"secret_grant_scope_type",
+		"secret_access_action",
+		"secret_access_log",
 
 		// Opened Ports
 		"protocol",
@@ -355,6 +362,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		// Cleanup
 		"removal_type",
 		"removal",
+		"removal_status",
 
 		// Sequence
 		"sequence",
@@ -760,6 +768,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_relation_network_egress_delete",
 		"trg_log_relation_network_egress_insert",
 		"trg_log_relation_network_egress_update",
+
+		"trg_log_storage_snapshot_delete",
+		"trg_log_storage_snapshot_insert",
+		"trg_log_storage_snapshot_update",
 	)
 
 	// These are additional triggers that are not change log triggers, but
@@ -822,6 +834,11 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 
 		"trg_log_custom_relation_life_suspended_update",
 		"trg_log_custom_relation_life_suspended_delete",
+
+		"trg_log_storage_filesystem_update_requested_size_model_provisioning",
+		"trg_log_storage_filesystem_update_requested_size_machine_provisioning",
+		"trg_log_storage_volume_update_requested_size_model_provisioning",
+		"trg_log_storage_volume_update_requested_size_machine_provisioning",
 	)
 
 	got := readEntityNames(c, s.DB(), "trigger")
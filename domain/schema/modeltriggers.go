@@ -140,9 +140,99 @@ func customModelTriggers() []func() schema.Patch {
 		relationLifeSuspended(
 			customNamespaceRelationLifeSuspended,
 		),
+
+		// Setup triggers for requested size changes of the storage instance
+		// owning a filesystem, split by who provisions the filesystem.
+		storageRequestedSizeTrigger(
+			"filesystem",
+			"filesystem_id",
+			customNamespaceStorageFilesystemRequestedSizeModelProvisioning,
+			customNamespaceStorageFilesystemRequestedSizeMachineProvisioning,
+		),
+
+		// Setup triggers for requested size changes of the storage instance
+		// owning a volume, split by who provisions the volume.
+		storageRequestedSizeTrigger(
+			"volume",
+			"volume_id",
+			customNamespaceStorageVolumeRequestedSizeModelProvisioning,
+			customNamespaceStorageVolumeRequestedSizeMachineProvisioning,
+		),
 	}
 }
 
+// storageRequestedSizeTrigger creates triggers for the requested size of
+// storage instances changing, so that the storage entity of the instance can
+// be resized by its provisioner.
+//
+// For storage entities that are model provisioned the change value used will
+// be the value of the `changeColumn` column of the storage entity. For storage
+// entities that are machine provisioned the change value used will be the
+// net_node_uuid of each of the storage entity's attachments.
+//
+// To be able to use this trigger for a storage entity, there must be a
+// storage_instance_<entity> table associating the entity with its storage
+// instance and a storage_<entity>_attachment table with a net_node_uuid
+// column.
+func storageRequestedSizeTrigger(
+	storageTable string,
+	changeColumn string,
+	modelNamespace int,
+	machineNamespace int,
+) func() schema.Patch {
+	stmt := fmt.Sprintf(`
+-- insert namespaces for storage entity requested size changes.
+INSERT INTO change_log_namespace
+VALUES (%[3]d,
+        'storage_%[1]s_requested_size_model_provisioning',
+        'requested size changes for storage %[1]s, that are model provisioned');
+
+INSERT INTO change_log_namespace
+VALUES (%[4]d,
+        'storage_%[1]s_requested_size_machine_provisioning',
+        'requested size changes for storage %[1]s, that are machine provisioned');
+
+-- update trigger for model provisioned storage entities.
+CREATE TRIGGER trg_log_storage_%[1]s_update_requested_size_model_provisioning
+AFTER UPDATE ON storage_instance
+FOR EACH ROW
+	WHEN NEW.requested_size_mib != OLD.requested_size_mib
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    SELECT 2,
+           %[3]d,
+           s.%[2]s,
+           DATETIME('now', 'utc')
+    FROM   storage_instance_%[1]s si
+    JOIN   storage_%[1]s s ON s.uuid = si.storage_%[1]s_uuid
+    WHERE  si.storage_instance_uuid = NEW.uuid
+    AND    s.provision_scope_id = 0;
+END;
+
+-- update trigger for machine provisioned storage entities.
+CREATE TRIGGER trg_log_storage_%[1]s_update_requested_size_machine_provisioning
+AFTER UPDATE ON storage_instance
+FOR EACH ROW
+	WHEN NEW.requested_size_mib != OLD.requested_size_mib
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    SELECT DISTINCT 2,
+                    %[4]d,
+                    a.net_node_uuid,
+                    DATETIME('now', 'utc')
+    FROM   storage_instance_%[1]s si
+    JOIN   storage_%[1]s s ON s.uuid = si.storage_%[1]s_uuid
+    JOIN   storage_%[1]s_attachment a ON a.storage_%[1]s_uuid = s.uuid
+    WHERE  si.storage_instance_uuid = NEW.uuid
+    AND    s.provision_scope_id = 1;
+END;
+`,
+		storageTable, changeColumn, modelNamespace, machineNamespace,
+	)
+
+	return func() schema.Patch { return schema.MakePatch(stmt) }
+}
+
 // storageAttachmentLifeMachineProvisioningTrigger creates triggers for storage
 // attachment entities in the model that get provisioned by a machine. The
 // triggers created will update the change_log for the provided namespace. The
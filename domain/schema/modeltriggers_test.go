@@ -574,6 +574,16 @@ VALUES (?, ?)`,
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *modelStorageSuite) changeStorageInstanceRequestedSize(
+	c *tc.C, storageInstanceUUID string, sizeMiB uint64,
+) {
+	_, err := s.DB().Exec(`
+UPDATE storage_instance
+SET    requested_size_mib = ?
+WHERE  uuid = ?`, sizeMiB, storageInstanceUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *modelStorageSuite) newBlockDevice(
 	c *tc.C,
 	machineUUID string,
@@ -1398,6 +1408,97 @@ func (s *modelStorageSuite) TestCustomStorageAttachmentStorageVolumeAttachmentDe
 	)
 }
 
+// TestModelVolumeRequestedSizeTrigger tests that changing the requested size
+// of a storage instance with a model provisioned volume results in a change
+// event for the volume id.
+func (s *modelStorageSuite) TestModelVolumeRequestedSizeTrigger(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	vUUID, volumeID := s.newModelVolume(c)
+	s.newStorageInstanceVolume(c, storageInstanceUUID, vUUID)
+
+	s.changeStorageInstanceRequestedSize(c, storageInstanceUUID, 200)
+	s.assertChangeEvent(
+		c, "storage_volume_requested_size_model_provisioning", volumeID,
+	)
+}
+
+// TestMachineVolumeRequestedSizeTrigger tests that changing the requested
+// size of a storage instance with a machine provisioned volume results in a
+// change event for the net node of the volume's attachment.
+func (s *modelStorageSuite) TestMachineVolumeRequestedSizeTrigger(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	vUUID, _ := s.newMachineVolume(c)
+	s.newStorageInstanceVolume(c, storageInstanceUUID, vUUID)
+	netNodeUUID := s.newNetNode(c)
+	s.newMachineVolumeAttachment(c, vUUID, netNodeUUID)
+
+	s.changeStorageInstanceRequestedSize(c, storageInstanceUUID, 200)
+	s.assertChangeEvent(
+		c, "storage_volume_requested_size_machine_provisioning", netNodeUUID,
+	)
+}
+
+// TestModelFilesystemRequestedSizeTrigger tests that changing the requested
+// size of a storage instance with a model provisioned filesystem results in a
+// change event for the filesystem id.
+func (s *modelStorageSuite) TestModelFilesystemRequestedSizeTrigger(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	fsUUID, filesystemID := s.newModelFilesystem(c)
+	s.newStorageInstanceFilesystem(c, storageInstanceUUID, fsUUID)
+
+	s.changeStorageInstanceRequestedSize(c, storageInstanceUUID, 200)
+	s.assertChangeEvent(
+		c, "storage_filesystem_requested_size_model_provisioning", filesystemID,
+	)
+}
+
+// TestMachineFilesystemRequestedSizeTrigger tests that changing the requested
+// size of a storage instance with a machine provisioned filesystem results in
+// a change event for the net node of the filesystem's attachment.
+func (s *modelStorageSuite) TestMachineFilesystemRequestedSizeTrigger(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	fsUUID, _ := s.newMachineFilesystem(c)
+	s.newStorageInstanceFilesystem(c, storageInstanceUUID, fsUUID)
+	netNodeUUID := s.newNetNode(c)
+	s.newMachineFilesystemAttachment(c, fsUUID, netNodeUUID)
+
+	s.changeStorageInstanceRequestedSize(c, storageInstanceUUID, 200)
+	s.assertChangeEvent(
+		c, "storage_filesystem_requested_size_machine_provisioning", netNodeUUID,
+	)
+}
+
+// TestRequestedSizeTriggerUnchanged tests that updating a storage instance
+// without changing the requested size results in no change events.
+func (s *modelStorageSuite) TestRequestedSizeTriggerUnchanged(c *tc.C) {
+	_, charmUUID := s.newApplication(c, "foo")
+	poolUUID := s.newStoragePool(c, "foo", "foo", nil)
+	storageInstanceUUID, _ := s.newStorageInstanceWithCharmUUID(c, charmUUID, poolUUID)
+	vUUID, volumeID := s.newModelVolume(c)
+	s.newStorageInstanceVolume(c, storageInstanceUUID, vUUID)
+
+	s.changeStorageInstanceRequestedSize(c, storageInstanceUUID, 100)
+
+	nsID := s.getNamespaceID(c, "storage_volume_requested_size_model_provisioning")
+	row := s.DB().QueryRow(`
+SELECT COUNT(*)
+FROM   change_log
+WHERE  namespace_id = ?
+AND    changed = ?`, nsID, volumeID)
+	var count int
+	err := row.Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(count, tc.Equals, 0)
+}
+
 func (s *modelStorageSuite) TestCustomStorageAttachmentStorageFilesystemAttachmentInsert(c *tc.C) {
 	appUUID, charmUUID := s.newApplication(c, "foo")
 	unitUUID, netNodeUUID := s.newUnitWithNetNode(c, "foo/0", appUUID, charmUUID)
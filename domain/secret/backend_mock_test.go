@@ -239,6 +239,44 @@ func (c *MockSecretBackendStateListSecretBackendsForModelCall) DoAndReturn(f fun
 	return c
 }
 
+// RecordSecretRevisionDrained mocks base method.
+func (m *MockSecretBackendState) RecordSecretRevisionDrained(arg0 context.Context, arg1 model.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretRevisionDrained", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretRevisionDrained indicates an expected call of RecordSecretRevisionDrained.
+func (mr *MockSecretBackendStateMockRecorder) RecordSecretRevisionDrained(arg0, arg1, arg2, arg3 any) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretRevisionDrained", reflect.TypeOf((*MockSecretBackendState)(nil).RecordSecretRevisionDrained), arg0, arg1, arg2, arg3)
+	return &MockSecretBackendStateRecordSecretRevisionDrainedCall{Call: call}
+}
+
+// MockSecretBackendStateRecordSecretRevisionDrainedCall wrap *gomock.Call
+type MockSecretBackendStateRecordSecretRevisionDrainedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateRecordSecretRevisionDrainedCall) Return(arg0 error) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateRecordSecretRevisionDrainedCall) Do(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateRecordSecretRevisionDrainedCall) DoAndReturn(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSecretBackendReference mocks base method.
 func (m *MockSecretBackendState) UpdateSecretBackendReference(arg0 context.Context, arg1 *secrets.ValueRef, arg2 model.UUID, arg3, arg4 string) (func() error, error) {
 	m.ctrl.T.Helper()
@@ -41,4 +41,8 @@ const (
 
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
+
+	// SecretContentChecksumMismatch describes an error that occurs when
+	// secret content does not match the checksum it was written with.
+	SecretContentChecksumMismatch = errors.ConstError("secret content checksum mismatch")
 )
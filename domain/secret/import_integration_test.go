@@ -23,10 +23,12 @@ import (
 	secretmodelmigration "github.com/juju/juju/domain/secret/modelmigration"
 	"github.com/juju/juju/domain/secret/service"
 	"github.com/juju/juju/domain/secret/state"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
 	domaintesting "github.com/juju/juju/domain/testing"
 	"github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 	jujutesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -34,6 +36,8 @@ import (
 type importSuite struct {
 	schematesting.ControllerSuite
 	schematesting.ModelSuite
+
+	keys *encryption.FileKeyStore
 }
 
 func TestImportSuite(t *testing.T) {
@@ -53,11 +57,24 @@ func (s *importSuite) TearDownSuite(c *tc.C) {
 func (s *importSuite) SetUpTest(c *tc.C) {
 	s.ControllerSuite.SetUpTest(c)
 	s.ModelSuite.SetUpTest(c)
+
+	// Imported secret content is encrypted with a key wrapped by the
+	// controller's active key-encryption key.
+	s.keys = encryption.NewFileKeyStore(c.MkDir())
+	_, err := s.encryptionKeyService(c).RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *importSuite) encryptionKeyService(c *tc.C) *secretbackendservice.EncryptionKeyService {
+	return secretbackendservice.NewEncryptionKeyService(
+		secretbackendstate.NewState(s.ControllerSuite.TxnRunnerFactory(), loggertesting.WrapCheckLog(c)),
+		s.keys,
+	)
 }
 
 func (s *importSuite) setupService(c *tc.C) *service.SecretService {
-	secretState := state.NewState(s.ModelSuite.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
 	secretBackendState := secretbackendstate.NewState(s.ControllerSuite.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	secretState := state.NewState(s.ModelSuite.TxnRunnerFactory(), s.encryptionKeyService(c), loggertesting.WrapCheckLog(c))
 	return service.NewSecretService(
 		secretState,
 		secretBackendState,
@@ -397,7 +414,7 @@ func (s *importSuite) TestImportSecretWithGrants(c *tc.C) {
 
 func (s *importSuite) doImport(c *tc.C, desc description.Model) {
 	coordinator := modelmigration.NewCoordinator(loggertesting.WrapCheckLog(c))
-	secretmodelmigration.RegisterImport(coordinator, loggertesting.WrapCheckLog(c))
+	secretmodelmigration.RegisterImport(coordinator, s.keys, loggertesting.WrapCheckLog(c))
 
 	err := coordinator.Perform(c.Context(), modelmigration.NewScope(s.ControllerSuite.TxnRunnerFactory(),
 		s.ModelSuite.TxnRunnerFactory(), nil, nil,
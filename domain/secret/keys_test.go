@@ -0,0 +1,45 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret_test
+
+import (
+	"context"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// testEncryptionKeys is an in-memory source of a single key-encryption key,
+// standing in for the keys stored in the controller database.
+type testEncryptionKeys struct {
+	key secretbackend.EncryptionKey
+}
+
+func newTestEncryptionKeys(c *tc.C) *testEncryptionKeys {
+	material, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	return &testEncryptionKeys{key: secretbackend.EncryptionKey{
+		UUID:     uuid.MustNewUUID().String(),
+		Material: material,
+	}}
+}
+
+func (k *testEncryptionKeys) ReserveActiveSecretEncryptionKey(context.Context, string) (secretbackend.EncryptionKey, error) {
+	return k.key, nil
+}
+
+func (k *testEncryptionKeys) ReleaseSecretEncryptionKeys(context.Context, string, string) error {
+	return nil
+}
+
+func (k *testEncryptionKeys) GetSecretEncryptionKey(_ context.Context, keyUUID string) (secretbackend.EncryptionKey, error) {
+	if keyUUID != k.key.UUID {
+		return secretbackend.EncryptionKey{}, backenderrors.EncryptionKeyNotFound
+	}
+	return k.key, nil
+}
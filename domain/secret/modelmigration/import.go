@@ -34,8 +34,11 @@ type Coordinator interface {
 }
 
 // RegisterImport registers the import operations with the given coordinator.
-func RegisterImport(coordinator Coordinator, logger logger.Logger) {
+// The key store holds the material of the controller key-encryption keys with
+// which the imported model's secret data key is wrapped.
+func RegisterImport(coordinator Coordinator, keys backendservice.KeyStore, logger logger.Logger) {
 	coordinator.Add(&importOperation{
+		keys:   keys,
 		logger: logger,
 	})
 }
@@ -58,6 +61,7 @@ type importOperation struct {
 
 	service        ImportService
 	backendService SecretBackendService
+	keys           backendservice.KeyStore
 	logger         logger.Logger
 
 	knownSecretBackends set.Strings
@@ -75,7 +79,7 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	// nil watcher factory.
 	backendstate := secretbackendstate.NewState(scope.ControllerDB(), i.logger)
 	i.service = service.NewSecretService(
-		state.NewState(scope.ModelDB(), i.logger),
+		state.NewState(scope.ModelDB(), backendservice.NewEncryptionKeyService(backendstate, i.keys), i.logger),
 		backendstate, nil, i.logger,
 	)
 	i.backendService = backendservice.NewService(
@@ -155,7 +155,10 @@ func (s *SecretService) GrantSecretAccess(ctx context.Context, uri *secrets.URI,
 		if err != nil {
 			return errors.Capture(err)
 		}
-		return s.secretState.GrantAccess(innerCtx, uri, p)
+		if err := s.secretState.GrantAccess(innerCtx, uri, p); err != nil {
+			return errors.Capture(err)
+		}
+		return s.recordSecretAccessChange(innerCtx, uri, domainsecret.SecretAccessGrant, params)
 	})
 }
 
@@ -318,7 +321,10 @@ func (s *SecretService) RevokeSecretAccess(ctx context.Context, uri *secrets.URI
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) error {
-		return s.secretState.RevokeAccess(innerCtx, uri, p)
+		if err := s.secretState.RevokeAccess(innerCtx, uri, p); err != nil {
+			return errors.Capture(err)
+		}
+		return s.recordSecretAccessChange(innerCtx, uri, domainsecret.SecretAccessRevoke, params)
 	})
 }
 
@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ListSecretAccessLog returns the reads of, and grants and revokes of access
+// to, the specified secret which match the filter, oldest first. The log is
+// retained after the secret is removed.
+func (s *SecretService) ListSecretAccessLog(
+	ctx context.Context, uri *secrets.URI, filter domainsecret.SecretAccessLogFilter,
+) ([]domainsecret.SecretAccessLogEntry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	entries, err := s.secretState.ListSecretAccessLog(ctx, uri, filter)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return entries, nil
+}
+
+// PruneSecretAccessLog removes the secret access log entries older than
+// maxAge, then the oldest entries beyond maxEntries. A zero maxAge or
+// maxEntries disables that part of the pruning.
+func (s *SecretService) PruneSecretAccessLog(ctx context.Context, maxAge time.Duration, maxEntries int) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	var before time.Time
+	if maxAge > 0 {
+		before = s.clock.Now().UTC().Add(-maxAge)
+	}
+	pruned, err := s.secretState.PruneSecretAccessLog(ctx, before, maxEntries)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if pruned > 0 {
+		s.logger.Debugf(ctx, "pruned %d secret access log entries", pruned)
+	}
+	return nil
+}
+
+// recordSecretRead records a read of the secret content in the access log.
+func (s *SecretService) recordSecretRead(
+	ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor, backendID string,
+) error {
+	return s.secretState.AddSecretAccessLogEntry(ctx, uri, domainsecret.SecretAccessLogEntry{
+		Action:    domainsecret.SecretAccessRead,
+		Revision:  &rev,
+		Accessor:  accessor,
+		BackendID: backendID,
+		Time:      s.clock.Now().UTC(),
+	})
+}
+
+// recordSecretAccessChange records a grant or revoke of access to the secret
+// in the access log.
+func (s *SecretService) recordSecretAccessChange(
+	ctx context.Context, uri *secrets.URI, action domainsecret.SecretAccessAction, params domainsecret.SecretAccessParams,
+) error {
+	accessor := params.Accessor
+	if params.User != "" {
+		accessor = domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: params.User}
+	}
+	subject := params.Subject
+	return s.secretState.AddSecretAccessLogEntry(ctx, uri, domainsecret.SecretAccessLogEntry{
+		Action:   action,
+		Accessor: accessor,
+		Subject:  &subject,
+		Time:     s.clock.Now().UTC(),
+	})
+}
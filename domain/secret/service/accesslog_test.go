@@ -0,0 +1,121 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreapplication "github.com/juju/juju/core/application"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+func (s *serviceSuite) TestRevokeSecretAccessRecordsUser(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+	s.state.EXPECT().GetApplicationUUID(c.Context(), "mysql").Return(appUUID, nil)
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     "model-uuid",
+	}).Return("manage", nil)
+	s.state.EXPECT().RevokeAccess(gomock.Any(), uri, domainsecret.RevokeParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectUUID:   appUUID.String(),
+	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, domainsecret.SecretAccessLogEntry{
+		Action:   domainsecret.SecretAccessRevoke,
+		Accessor: domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: "fred"},
+		Subject:  &domainsecret.SecretAccessor{Kind: domainsecret.ApplicationAccessor, ID: "mysql"},
+		Time:     s.clock.Now().UTC(),
+	}).Return(nil)
+
+	err := s.service.RevokeSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
+		Accessor: domainsecret.SecretAccessor{Kind: domainsecret.ModelAccessor, ID: "model-uuid"},
+		User:     "fred",
+		Subject:  domainsecret.SecretAccessor{Kind: domainsecret.ApplicationAccessor, ID: "mysql"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestGetSecretContentFromBackendRecordsRead(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.activeBackendID = "backend-id"
+	uri := coresecrets.NewURI()
+	accessor := domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: "fred"}
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, domainsecret.SecretAccessLogEntry{
+		Action:   domainsecret.SecretAccessRead,
+		Revision: new(2),
+		Accessor: accessor,
+		Time:     s.clock.Now().UTC(),
+	}).Return(nil)
+
+	data, err := s.service.GetSecretContentFromBackend(c.Context(), uri, 2, accessor)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
+
+func (s *serviceSuite) TestGetSecretValueRecordFailed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	}, nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(errors.New("boom"))
+
+	_, _, err := s.service.GetSecretValue(c.Context(), uri, 1, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *serviceSuite) TestListSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	entries := []domainsecret.SecretAccessLogEntry{{
+		Action:   domainsecret.SecretAccessRead,
+		Revision: new(1),
+		Accessor: domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/0"},
+		Time:     s.clock.Now(),
+	}}
+	filter := domainsecret.SecretAccessLogFilter{Limit: 10}
+	s.state.EXPECT().ListSecretAccessLog(gomock.Any(), uri, filter).Return(entries, nil)
+
+	result, err := s.service.ListSecretAccessLog(c.Context(), uri, filter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, entries)
+}
+
+func (s *serviceSuite) TestPruneSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().PruneSecretAccessLog(gomock.Any(), s.clock.Now().UTC().Add(-time.Hour), 100).Return(3, nil)
+
+	err := s.service.PruneSecretAccessLog(c.Context(), time.Hour, 100)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestPruneSecretAccessLogNoMaxAge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().PruneSecretAccessLog(gomock.Any(), time.Time{}, 100).Return(0, nil)
+
+	err := s.service.PruneSecretAccessLog(c.Context(), 0, 100)
+	c.Assert(err, tc.ErrorIsNil)
+}
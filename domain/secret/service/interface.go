@@ -36,6 +36,7 @@ type State interface {
 	GetLatestRevision(ctx context.Context, uri *secrets.URI) (int, error)
 	GetLatestRevisions(ctx context.Context, uris []*secrets.URI) (map[string]int, error)
 	GetSecretValue(ctx context.Context, uri *secrets.URI, revision int) (secrets.SecretData, *secrets.ValueRef, error)
+	RewrapSecretDataKey(ctx context.Context) error
 	GetSecretByURI(ctx context.Context, uri secrets.URI, revision *int) (*secrets.SecretMetadata,
 		[]*secrets.SecretRevisionMetadata, error)
 	ListSecretsByLabels(ctx context.Context, labels domainsecret.Labels, revision *int) ([]*secrets.SecretMetadata,
@@ -51,6 +52,9 @@ type State interface {
 	GrantAccess(ctx context.Context, uri *secrets.URI, params domainsecret.GrantParams) error
 	RevokeAccess(ctx context.Context, uri *secrets.URI, params domainsecret.RevokeParams) error
 	GetSecretAccess(ctx context.Context, uri *secrets.URI, params domainsecret.AccessParams) (string, error)
+	AddSecretAccessLogEntry(ctx context.Context, uri *secrets.URI, entry domainsecret.SecretAccessLogEntry) error
+	ListSecretAccessLog(ctx context.Context, uri *secrets.URI, filter domainsecret.SecretAccessLogFilter) ([]domainsecret.SecretAccessLogEntry, error)
+	PruneSecretAccessLog(ctx context.Context, before time.Time, maxEntries int) (int64, error)
 	GetSecretAccessRelationScope(ctx context.Context, uri *secrets.URI, params domainsecret.AccessParams) (string, error)
 	GetRegularRelationUUIDByEndpointIdentifiers(ctx context.Context, endpoint1, endpoint2 corerelation.EndpointIdentifier) (string, error)
 	GetRelationEndpoints(ctx context.Context, relationUUID string) ([]corerelation.EndpointIdentifier, error)
@@ -174,4 +178,9 @@ type SecretBackendState interface {
 	// GetSecretBackendNamesByUUID returns a map of backend UUID to backend name for all backends.
 	// An empty map will be returned if there are no backends.
 	GetSecretBackendNamesByUUID(ctx context.Context) (map[string]string, error)
+
+	// RecordSecretRevisionDrained records the checksum of a secret revision
+	// whose content has been verified in the target backend of the model's
+	// secret backend drain. If the model has no drain, this is a no-op.
+	RecordSecretRevisionDrained(ctx context.Context, modelUUID coremodel.UUID, revisionUUID, checksum string) error
 }
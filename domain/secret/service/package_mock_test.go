@@ -51,6 +51,44 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddSecretAccessLogEntry mocks base method.
+func (m *MockState) AddSecretAccessLogEntry(arg0 context.Context, arg1 *secrets.URI, arg2 secret.SecretAccessLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretAccessLogEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecretAccessLogEntry indicates an expected call of AddSecretAccessLogEntry.
+func (mr *MockStateMockRecorder) AddSecretAccessLogEntry(arg0, arg1, arg2 any) *MockStateAddSecretAccessLogEntryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretAccessLogEntry", reflect.TypeOf((*MockState)(nil).AddSecretAccessLogEntry), arg0, arg1, arg2)
+	return &MockStateAddSecretAccessLogEntryCall{Call: call}
+}
+
+// MockStateAddSecretAccessLogEntryCall wrap *gomock.Call
+type MockStateAddSecretAccessLogEntryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddSecretAccessLogEntryCall) Return(arg0 error) *MockStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddSecretAccessLogEntryCall) Do(f func(context.Context, *secrets.URI, secret.SecretAccessLogEntry) error) *MockStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddSecretAccessLogEntryCall) DoAndReturn(f func(context.Context, *secrets.URI, secret.SecretAccessLogEntry) error) *MockStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AllRemoteSecrets mocks base method.
 func (m *MockState) AllRemoteSecrets(arg0 context.Context) ([]secret.RemoteSecretInfo, error) {
 	m.ctrl.T.Helper()
@@ -1903,6 +1941,45 @@ func (c *MockStateListGrantedSecretsForBackendCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// ListSecretAccessLog mocks base method.
+func (m *MockState) ListSecretAccessLog(arg0 context.Context, arg1 *secrets.URI, arg2 secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secret.SecretAccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretAccessLog indicates an expected call of ListSecretAccessLog.
+func (mr *MockStateMockRecorder) ListSecretAccessLog(arg0, arg1, arg2 any) *MockStateListSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretAccessLog", reflect.TypeOf((*MockState)(nil).ListSecretAccessLog), arg0, arg1, arg2)
+	return &MockStateListSecretAccessLogCall{Call: call}
+}
+
+// MockStateListSecretAccessLogCall wrap *gomock.Call
+type MockStateListSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListSecretAccessLogCall) Return(arg0 []secret.SecretAccessLogEntry, arg1 error) *MockStateListSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListSecretAccessLogCall) Do(f func(context.Context, *secrets.URI, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockStateListSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListSecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets.URI, secret.SecretAccessLogFilter) ([]secret.SecretAccessLogEntry, error)) *MockStateListSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretsByLabels mocks base method.
 func (m *MockState) ListSecretsByLabels(arg0 context.Context, arg1 secret.Labels, arg2 *int) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -2058,6 +2135,45 @@ func (c *MockStateNamespaceForWatchSecretRevisionObsoleteCall) DoAndReturn(f fun
 	return c
 }
 
+// PruneSecretAccessLog mocks base method.
+func (m *MockState) PruneSecretAccessLog(arg0 context.Context, arg1 time.Time, arg2 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneSecretAccessLog indicates an expected call of PruneSecretAccessLog.
+func (mr *MockStateMockRecorder) PruneSecretAccessLog(arg0, arg1, arg2 any) *MockStatePruneSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSecretAccessLog", reflect.TypeOf((*MockState)(nil).PruneSecretAccessLog), arg0, arg1, arg2)
+	return &MockStatePruneSecretAccessLogCall{Call: call}
+}
+
+// MockStatePruneSecretAccessLogCall wrap *gomock.Call
+type MockStatePruneSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatePruneSecretAccessLogCall) Return(arg0 int64, arg1 error) *MockStatePruneSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatePruneSecretAccessLogCall) Do(f func(context.Context, time.Time, int) (int64, error)) *MockStatePruneSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatePruneSecretAccessLogCall) DoAndReturn(f func(context.Context, time.Time, int) (int64, error)) *MockStatePruneSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RevokeAccess mocks base method.
 func (m *MockState) RevokeAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.RevokeParams) error {
 	m.ctrl.T.Helper()
@@ -2096,6 +2212,44 @@ func (c *MockStateRevokeAccessCall) DoAndReturn(f func(context.Context, *secrets
 	return c
 }
 
+// RewrapSecretDataKey mocks base method.
+func (m *MockState) RewrapSecretDataKey(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewrapSecretDataKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RewrapSecretDataKey indicates an expected call of RewrapSecretDataKey.
+func (mr *MockStateMockRecorder) RewrapSecretDataKey(arg0 any) *MockStateRewrapSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewrapSecretDataKey", reflect.TypeOf((*MockState)(nil).RewrapSecretDataKey), arg0)
+	return &MockStateRewrapSecretDataKeyCall{Call: call}
+}
+
+// MockStateRewrapSecretDataKeyCall wrap *gomock.Call
+type MockStateRewrapSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRewrapSecretDataKeyCall) Return(arg0 error) *MockStateRewrapSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRewrapSecretDataKeyCall) Do(f func(context.Context) error) *MockStateRewrapSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRewrapSecretDataKeyCall) DoAndReturn(f func(context.Context) error) *MockStateRewrapSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveSecretConsumer mocks base method.
 func (m *MockState) SaveSecretConsumer(arg0 context.Context, arg1 *secrets.URI, arg2 unit.Name, arg3 secrets.SecretConsumerMetadata) error {
 	m.ctrl.T.Helper()
@@ -2505,6 +2659,44 @@ func (c *MockSecretBackendStateListSecretBackendsForModelCall) DoAndReturn(f fun
 	return c
 }
 
+// RecordSecretRevisionDrained mocks base method.
+func (m *MockSecretBackendState) RecordSecretRevisionDrained(arg0 context.Context, arg1 model.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretRevisionDrained", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretRevisionDrained indicates an expected call of RecordSecretRevisionDrained.
+func (mr *MockSecretBackendStateMockRecorder) RecordSecretRevisionDrained(arg0, arg1, arg2, arg3 any) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretRevisionDrained", reflect.TypeOf((*MockSecretBackendState)(nil).RecordSecretRevisionDrained), arg0, arg1, arg2, arg3)
+	return &MockSecretBackendStateRecordSecretRevisionDrainedCall{Call: call}
+}
+
+// MockSecretBackendStateRecordSecretRevisionDrainedCall wrap *gomock.Call
+type MockSecretBackendStateRecordSecretRevisionDrainedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateRecordSecretRevisionDrainedCall) Return(arg0 error) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateRecordSecretRevisionDrainedCall) Do(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateRecordSecretRevisionDrainedCall) DoAndReturn(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStateRecordSecretRevisionDrainedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSecretBackendReference mocks base method.
 func (m *MockSecretBackendState) UpdateSecretBackendReference(arg0 context.Context, arg1 *secrets.ValueRef, arg2 model.UUID, arg3, arg4 string) (func() error, error) {
 	m.ctrl.T.Helper()
@@ -42,6 +42,9 @@ type ChangeSecretBackendParams struct {
 
 	ValueRef *secrets.ValueRef
 	Data     secrets.SecretData
+	// Checksum is the checksum of the content verified in the new backend
+	// when the secret is moved as part of a secret backend drain.
+	Checksum string
 }
 
 // GrantedSecretsGetter returns the revisions on the given backend for which
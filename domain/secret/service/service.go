@@ -746,13 +746,38 @@ func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, re
 		return nil, nil, errors.Capture(err)
 	}
 	data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-	return secrets.NewSecretValue(data), ref, errors.Capture(err)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	backendID := ""
+	if ref != nil {
+		backendID = ref.BackendID
+	}
+	if err := s.recordSecretRead(ctx, uri, rev, accessor, backendID); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return secrets.NewSecretValue(data), ref, nil
+}
+
+// RewrapSecretDataKey wraps the data key with which the model's secret
+// content is encrypted using the controller's active key-encryption key.
+// The content itself is not re-encrypted, so secrets remain readable
+// throughout.
+func (s *SecretService) RewrapSecretDataKey(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.secretState.RewrapSecretDataKey(ctx); err != nil {
+		return errors.Errorf("rewrapping secret data key: %w", err)
+	}
+	return nil
 }
 
 // GetSecretContentFromBackend retrieves the content for the specified secret revision.
 // If the content is not found, it may be that the secret has been drained so it tries
 // again using the new active backend.
-func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
+// The read is recorded in the secret access log against the accessor.
+func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) (secrets.SecretValue, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
@@ -774,6 +799,9 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 			return nil, errors.Capture(err)
 		}
 		if ref == nil {
+			if err := s.recordSecretRead(ctx, uri, rev, accessor, ""); err != nil {
+				return nil, errors.Capture(err)
+			}
 			return val, nil
 		}
 
@@ -788,7 +816,13 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 			if notFound {
 				return nil, errors.Errorf("secret %s revision %d not found", uri.ID, rev).Add(secreterrors.SecretRevisionNotFound)
 			}
-			return val, errors.Capture(err)
+			if err != nil {
+				return nil, errors.Capture(err)
+			}
+			if err := s.recordSecretRead(ctx, uri, rev, accessor, backendID); err != nil {
+				return nil, errors.Capture(err)
+			}
+			return val, nil
 		}
 		lastBackendID = backendID
 		// Secret may have been drained to the active backend.
@@ -941,6 +975,9 @@ func (s *SecretService) getAppOwnedOrUnitOwnedSecretMetadata(
 // ChangeSecretBackend sets the secret backend where the specified secret revision is stored.
 // It returns [secreterrors.SecretNotFound] is there's no such secret.
 // It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+// If a checksum is supplied with the content, it returns
+// [secreterrors.SecretContentChecksumMismatch] if the content does not match
+// it, otherwise the checksum is recorded against any secret backend drain.
 func (s *SecretService) ChangeSecretBackend(
 	ctx context.Context, uri *secrets.URI, revision int, params ChangeSecretBackendParams,
 ) error {
@@ -966,7 +1003,17 @@ func (s *SecretService) ChangeSecretBackend(
 		return errors.Errorf("getting model uuid: %w", err)
 	}
 
-	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
+	if len(params.Data) > 0 && params.Checksum != "" {
+		checksum, err := secrets.NewSecretValue(params.Data).Checksum()
+		if err != nil {
+			return errors.Errorf("computing checksum for secret %q revision %d: %w", uri.ID, revision, err)
+		}
+		if checksum != params.Checksum {
+			return errors.Errorf("secret %q revision %d: %w", uri.ID, revision, secreterrors.SecretContentChecksumMismatch)
+		}
+	}
+
+	err = withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
 		rollBack, err := s.secretBackendState.UpdateSecretBackendReference(
 			innerCtx, params.ValueRef, modelID, revisionID.String(), uri.ID)
 		if err != nil {
@@ -987,6 +1034,20 @@ func (s *SecretService) ChangeSecretBackend(
 		}
 		return nil
 	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if params.Checksum == "" {
+		return nil
+	}
+	// Record the verified content so that the progress of any drain of the
+	// model's secrets to a new backend can be reported.
+	err = s.secretBackendState.RecordSecretRevisionDrained(ctx, modelID, revisionID.String(), params.Checksum)
+	if err != nil {
+		return errors.Errorf("recording drained secret %q revision %d: %w", uri.ID, revision, err)
+	}
+	return nil
 }
 
 // SecretRotated rotates the secret with the specified URI.
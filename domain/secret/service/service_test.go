@@ -939,6 +939,12 @@ func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, domainsecret.SecretAccessLogEntry{
+		Action:   domainsecret.SecretAccessRead,
+		Revision: new(666),
+		Accessor: domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/0"},
+		Time:     s.clock.Now().UTC(),
+	}).Return(nil)
 
 	data, ref, err := s.service.GetSecretValue(c.Context(), uri, 666, domainsecret.SecretAccessor{
 		Kind: domainsecret.UnitAccessor,
@@ -949,6 +955,15 @@ func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 	c.Assert(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
 }
 
+func (s *serviceSuite) TestRewrapSecretDataKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RewrapSecretDataKey(gomock.Any()).Return(nil)
+
+	err := s.service.RewrapSecretDataKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestGetSecretConsumer(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1498,6 +1513,7 @@ func (s *serviceSuite) TestGrantSecretUnitAccess(c *tc.C) {
 		SubjectUUID:   unitUUID.String(),
 		RoleID:        domainsecret.RoleManage,
 	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(nil)
 
 	err := s.service.GrantSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
 		Accessor: domainsecret.SecretAccessor{
@@ -1534,6 +1550,7 @@ func (s *serviceSuite) TestGrantSecretApplicationAccess(c *tc.C) {
 		SubjectUUID:   appUUID.String(),
 		RoleID:        domainsecret.RoleView,
 	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(nil)
 
 	err := s.service.GrantSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
 		Accessor: domainsecret.SecretAccessor{
@@ -1566,6 +1583,7 @@ func (s *serviceSuite) TestGrantSecretModelAccess(c *tc.C) {
 		SubjectTypeID: domainsecret.SubjectModel,
 		RoleID:        domainsecret.RoleManage,
 	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(nil)
 
 	err := s.service.GrantSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
 		Accessor: domainsecret.SecretAccessor{
@@ -1610,6 +1628,7 @@ func (s *serviceSuite) TestGrantSecretRelationScope(c *tc.C) {
 		SubjectUUID:   appUUID.String(),
 		RoleID:        domainsecret.RoleView,
 	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(nil)
 
 	err := s.service.GrantSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
 		Accessor: domainsecret.SecretAccessor{
@@ -1643,6 +1662,7 @@ func (s *serviceSuite) TestRevokeSecretUnitAccess(c *tc.C) {
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectUUID:   unitUUID.String(),
 	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(nil)
 
 	err := s.service.RevokeSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
 		Accessor: domainsecret.SecretAccessor{
@@ -1671,6 +1691,7 @@ func (s *serviceSuite) TestRevokeSecretApplicationAccess(c *tc.C) {
 		SubjectTypeID: domainsecret.SubjectApplication,
 		SubjectUUID:   appUUID.String(),
 	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(nil)
 
 	err := s.service.RevokeSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
 		Accessor: domainsecret.SecretAccessor{
@@ -1699,6 +1720,7 @@ func (s *serviceSuite) TestRevokeSecretModelAccess(c *tc.C) {
 		SubjectTypeID: domainsecret.SubjectApplication,
 		SubjectUUID:   appUUID.String(),
 	}).Return(nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), uri, gomock.Any()).Return(nil)
 
 	err := s.service.RevokeSecretAccess(c.Context(), uri, domainsecret.SecretAccessParams{
 		Accessor: domainsecret.SecretAccessor{
@@ -1882,6 +1904,62 @@ func (s *serviceSuite) TestChangeSecretBackendToInternalBackend(c *tc.C) {
 	c.Assert(rollbackCalled, tc.IsFalse)
 }
 
+func (s *serviceSuite) TestChangeSecretBackendRecordsDrainedChecksum(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	ctx := c.Context()
+	data := map[string]string{"foo": "YmFy"}
+	checksum, err := coresecrets.NewSecretValue(data).Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, nil, coresecrets.SecretData(data)).Return(nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String(), uri.ID).Return(func() error {
+		return nil
+	}, nil)
+	s.secretBackendState.EXPECT().RecordSecretRevisionDrained(gomock.Any(), s.modelID, s.fakeUUID.String(), checksum).Return(nil)
+
+	err = s.service.ChangeSecretBackend(ctx, uri, 1, ChangeSecretBackendParams{
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data:     data,
+		Checksum: checksum,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestChangeSecretBackendChecksumMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	ctx := c.Context()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+
+	err := s.service.ChangeSecretBackend(ctx, uri, 1, ChangeSecretBackendParams{
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data:     map[string]string{"foo": "YmFy"},
+		Checksum: "bad-checksum",
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretContentChecksumMismatch)
+}
+
 func (s *serviceSuite) TestChangeSecretBackendFailedAndRollback(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
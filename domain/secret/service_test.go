@@ -111,7 +111,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.svc = service.NewSecretService(
 		state.NewState(func(ctx context.Context) (database.TxnRunner, error) {
 			return s.ModelTxnRunner(c, s.modelUUID.String()), nil
-		}, loggertesting.WrapCheckLog(c)),
+		}, newTestEncryptionKeys(c), loggertesting.WrapCheckLog(c)),
 		s.secretBackendState,
 		nil,
 		loggertesting.WrapCheckLog(c),
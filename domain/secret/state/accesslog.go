@@ -0,0 +1,236 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// secretAccessLogEntry represents a row in the secret_access_log table.
+type secretAccessLogEntry struct {
+	UUID         string         `db:"uuid"`
+	SecretID     string         `db:"secret_id"`
+	Revision     sql.NullInt64  `db:"revision"`
+	ActionID     int            `db:"action_id"`
+	AccessorKind string         `db:"accessor_kind"`
+	AccessorID   string         `db:"accessor_id"`
+	SubjectKind  sql.NullString `db:"subject_kind"`
+	SubjectID    sql.NullString `db:"subject_id"`
+	BackendID    sql.NullString `db:"backend_id"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+// AddSecretAccessLogEntry records an access to the specified secret in the
+// secret access log. The secret need not exist, so that failed reads of
+// removed secrets can still be recorded.
+func (st State) AddSecretAccessLogEntry(
+	ctx context.Context, uri *coresecrets.URI, entry domainsecret.SecretAccessLogEntry,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	entryUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	row := secretAccessLogEntry{
+		UUID:         entryUUID.String(),
+		SecretID:     uri.ID,
+		ActionID:     int(entry.Action),
+		AccessorKind: string(entry.Accessor.Kind),
+		AccessorID:   entry.Accessor.ID,
+		CreatedAt:    entry.Time.UTC(),
+	}
+	if entry.Revision != nil {
+		row.Revision = sql.NullInt64{Int64: int64(*entry.Revision), Valid: true}
+	}
+	if entry.Subject != nil {
+		row.SubjectKind = sql.NullString{String: string(entry.Subject.Kind), Valid: true}
+		row.SubjectID = sql.NullString{String: entry.Subject.ID, Valid: true}
+	}
+	if entry.BackendID != "" {
+		row.BackendID = sql.NullString{String: entry.BackendID, Valid: true}
+	}
+
+	stmt, err := st.Prepare(`INSERT INTO secret_access_log (*) VALUES ($secretAccessLogEntry.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, row).Run()
+	})
+	if err != nil {
+		return errors.Errorf("recording access to secret %q: %w", uri.ID, err)
+	}
+	return nil
+}
+
+// secretAccessLogQuery holds the arguments to query the secret access log.
+type secretAccessLogQuery struct {
+	SecretID string    `db:"secret_id"`
+	Since    time.Time `db:"since"`
+	Limit    int       `db:"limit"`
+}
+
+// ListSecretAccessLog returns the secret access log entries for the
+// specified secret which match the filter, oldest first. Entries are
+// retained after the secret is removed.
+func (st State) ListSecretAccessLog(
+	ctx context.Context, uri *coresecrets.URI, filter domainsecret.SecretAccessLogFilter,
+) ([]domainsecret.SecretAccessLogEntry, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// The most recent entries are selected so that the limit keeps them,
+	// and are put back in order below. A negative limit is no limit.
+	query := `
+SELECT &secretAccessLogEntry.*
+FROM   secret_access_log
+WHERE  secret_id = $secretAccessLogQuery.secret_id
+AND    created_at >= $secretAccessLogQuery.since
+ORDER BY created_at DESC, rowid DESC
+LIMIT $secretAccessLogQuery.limit`
+	args := secretAccessLogQuery{
+		SecretID: uri.ID,
+		Limit:    -1,
+	}
+	if filter.Since != nil {
+		args.Since = filter.Since.UTC()
+	}
+	if filter.Limit > 0 {
+		args.Limit = filter.Limit
+	}
+	stmt, err := st.Prepare(query, secretAccessLogEntry{}, args)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretAccessLogEntry
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, args).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing access log for secret %q: %w", uri.ID, err)
+	}
+
+	slices.Reverse(rows)
+	result := make([]domainsecret.SecretAccessLogEntry, len(rows))
+	for i, row := range rows {
+		entry := domainsecret.SecretAccessLogEntry{
+			Action: domainsecret.SecretAccessAction(row.ActionID),
+			Accessor: domainsecret.SecretAccessor{
+				Kind: domainsecret.SecretAccessorKind(row.AccessorKind),
+				ID:   row.AccessorID,
+			},
+			BackendID: row.BackendID.String,
+			Time:      row.CreatedAt,
+		}
+		if row.Revision.Valid {
+			rev := int(row.Revision.Int64)
+			entry.Revision = &rev
+		}
+		if row.SubjectKind.Valid {
+			entry.Subject = &domainsecret.SecretAccessor{
+				Kind: domainsecret.SecretAccessorKind(row.SubjectKind.String),
+				ID:   row.SubjectID.String,
+			}
+		}
+		result[i] = entry
+	}
+	return result, nil
+}
+
+// secretAccessLogPrune holds the arguments to prune the secret access log.
+type secretAccessLogPrune struct {
+	Before     time.Time `db:"before"`
+	MaxEntries int       `db:"max_entries"`
+}
+
+// PruneSecretAccessLog removes the secret access log entries recorded
+// before the input time, then the oldest entries beyond maxEntries. A zero
+// time or maxEntries skips that part of the pruning. It returns the number
+// of entries removed.
+func (st State) PruneSecretAccessLog(ctx context.Context, before time.Time, maxEntries int) (int64, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	args := secretAccessLogPrune{
+		Before:     before.UTC(),
+		MaxEntries: maxEntries,
+	}
+	byAgeStmt, err := st.Prepare(`
+DELETE FROM secret_access_log
+WHERE  created_at < $secretAccessLogPrune.before`, args)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	byCountStmt, err := st.Prepare(`
+DELETE FROM secret_access_log
+WHERE  rowid NOT IN (
+    SELECT rowid
+    FROM   secret_access_log
+    ORDER BY created_at DESC, rowid DESC
+    LIMIT $secretAccessLogPrune.max_entries
+)`, args)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var pruned int64
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		pruned = 0
+		if !before.IsZero() {
+			n, err := runPrune(ctx, tx, byAgeStmt, args)
+			if err != nil {
+				return errors.Errorf("pruning entries before %s: %w", args.Before, err)
+			}
+			pruned += n
+		}
+		if maxEntries > 0 {
+			n, err := runPrune(ctx, tx, byCountStmt, args)
+			if err != nil {
+				return errors.Errorf("pruning entries beyond %d: %w", maxEntries, err)
+			}
+			pruned += n
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Errorf("pruning secret access log: %w", err)
+	}
+	return pruned, nil
+}
+
+// runPrune runs the prune statement, returning the number of rows removed.
+func runPrune(ctx context.Context, tx *sqlair.TX, stmt *sqlair.Statement, args secretAccessLogPrune) (int64, error) {
+	var outcome sqlair.Outcome
+	if err := tx.Query(ctx, stmt, args).Get(&outcome); err != nil {
+		return 0, errors.Capture(err)
+	}
+	n, err := outcome.Result().RowsAffected()
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return n, nil
+}
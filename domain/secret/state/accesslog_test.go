@@ -0,0 +1,155 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+)
+
+type accessLogSuite struct {
+	baseSuite
+}
+
+func TestAccessLogSuite(t *stdtesting.T) {
+	tc.Run(t, &accessLogSuite{})
+}
+
+func (s *accessLogSuite) TestAddAndListSecretAccessLog(c *tc.C) {
+	uri := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	read := domainsecret.SecretAccessLogEntry{
+		Action:    domainsecret.SecretAccessRead,
+		Revision:  new(2),
+		Accessor:  domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mysql/0"},
+		BackendID: "backend-id",
+		Time:      now,
+	}
+	grant := domainsecret.SecretAccessLogEntry{
+		Action:   domainsecret.SecretAccessGrant,
+		Accessor: domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: "fred"},
+		Subject:  &domainsecret.SecretAccessor{Kind: domainsecret.ApplicationAccessor, ID: "mysql"},
+		Time:     now.Add(-time.Minute),
+	}
+	err := s.state.AddSecretAccessLogEntry(c.Context(), uri, read)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.AddSecretAccessLogEntry(c.Context(), uri, grant)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Entries for other secrets are not included.
+	err = s.state.AddSecretAccessLogEntry(c.Context(), coresecrets.NewURI(), read)
+	c.Assert(err, tc.ErrorIsNil)
+
+	entries, err := s.state.ListSecretAccessLog(c.Context(), uri, domainsecret.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 2)
+	c.Check(entries[0].Time.Equal(grant.Time), tc.IsTrue)
+	entries[0].Time = grant.Time
+	c.Check(entries[0], tc.DeepEquals, grant)
+	c.Check(entries[1].Time.Equal(read.Time), tc.IsTrue)
+	entries[1].Time = read.Time
+	c.Check(entries[1], tc.DeepEquals, read)
+}
+
+func (s *accessLogSuite) TestListSecretAccessLogEmpty(c *tc.C) {
+	entries, err := s.state.ListSecretAccessLog(c.Context(), coresecrets.NewURI(), domainsecret.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *accessLogSuite) addReads(c *tc.C, uri *coresecrets.URI, times ...time.Time) {
+	for i, t := range times {
+		err := s.state.AddSecretAccessLogEntry(c.Context(), uri, domainsecret.SecretAccessLogEntry{
+			Action:   domainsecret.SecretAccessRead,
+			Revision: new(i + 1),
+			Accessor: domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mysql/0"},
+			Time:     t,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}
+}
+
+func revisions(entries []domainsecret.SecretAccessLogEntry) []int {
+	result := make([]int, len(entries))
+	for i, entry := range entries {
+		result[i] = *entry.Revision
+	}
+	return result
+}
+
+func (s *accessLogSuite) TestListSecretAccessLogFilter(c *tc.C) {
+	uri := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+	s.addReads(c, uri, now.Add(-3*time.Hour), now.Add(-2*time.Hour), now.Add(-time.Hour), now)
+
+	entries, err := s.state.ListSecretAccessLog(c.Context(), uri, domainsecret.SecretAccessLogFilter{
+		Limit: 2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revisions(entries), tc.DeepEquals, []int{3, 4})
+
+	since := now.Add(-2 * time.Hour)
+	entries, err = s.state.ListSecretAccessLog(c.Context(), uri, domainsecret.SecretAccessLogFilter{
+		Since: &since,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revisions(entries), tc.DeepEquals, []int{2, 3, 4})
+
+	entries, err = s.state.ListSecretAccessLog(c.Context(), uri, domainsecret.SecretAccessLogFilter{
+		Since: &since,
+		Limit: 1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revisions(entries), tc.DeepEquals, []int{4})
+}
+
+func (s *accessLogSuite) TestPruneSecretAccessLogByAge(c *tc.C) {
+	uri := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+	s.addReads(c, uri, now.Add(-3*time.Hour), now.Add(-2*time.Hour), now.Add(-time.Hour), now)
+
+	pruned, err := s.state.PruneSecretAccessLog(c.Context(), now.Add(-90*time.Minute), 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(2))
+
+	entries, err := s.state.ListSecretAccessLog(c.Context(), uri, domainsecret.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revisions(entries), tc.DeepEquals, []int{3, 4})
+}
+
+func (s *accessLogSuite) TestPruneSecretAccessLogByCount(c *tc.C) {
+	uri1 := coresecrets.NewURI()
+	uri2 := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+	s.addReads(c, uri1, now.Add(-3*time.Hour), now.Add(-time.Hour))
+	s.addReads(c, uri2, now.Add(-2*time.Hour), now)
+
+	// The count applies across all secrets in the model.
+	pruned, err := s.state.PruneSecretAccessLog(c.Context(), time.Time{}, 3)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(1))
+
+	entries, err := s.state.ListSecretAccessLog(c.Context(), uri1, domainsecret.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revisions(entries), tc.DeepEquals, []int{2})
+	entries, err = s.state.ListSecretAccessLog(c.Context(), uri2, domainsecret.SecretAccessLogFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(revisions(entries), tc.DeepEquals, []int{1, 2})
+}
+
+func (s *accessLogSuite) TestPruneSecretAccessLogDisabled(c *tc.C) {
+	uri := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+	s.addReads(c, uri, now.Add(-3*time.Hour), now)
+
+	pruned, err := s.state.PruneSecretAccessLog(c.Context(), time.Time{}, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(0))
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain/secretbackend"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// KeyEncryptionKeyGetter provides the controller key-encryption keys with
+// which the model's secret data key is wrapped. The controller records which
+// keys each model references so that a retired key is not removed while a
+// model's data key may still be wrapped by it.
+type KeyEncryptionKeyGetter interface {
+	// ReserveActiveSecretEncryptionKey returns the key-encryption key used
+	// to wrap new data keys, recording that the model with the input UUID
+	// references it.
+	ReserveActiveSecretEncryptionKey(ctx context.Context, modelUUID string) (secretbackend.EncryptionKey, error)
+
+	// ReleaseSecretEncryptionKeys removes the model's references to keys
+	// other than the input key and the active key.
+	ReleaseSecretEncryptionKeys(ctx context.Context, modelUUID, keyUUID string) error
+
+	// GetSecretEncryptionKey returns the key-encryption key with the input
+	// UUID.
+	GetSecretEncryptionKey(ctx context.Context, keyUUID string) (secretbackend.EncryptionKey, error)
+}
+
+// RewrapSecretDataKey wraps the model's secret data key with the active
+// controller key-encryption key, if it is currently wrapped with another.
+// Secret content is not re-encrypted, as the data key itself is unchanged.
+// If the model has no data key, this is a no-op.
+func (st State) RewrapSecretDataKey(ctx context.Context) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var (
+		modelUUID string
+		current   secretDataKey
+		found     bool
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		id, err := st.getModelUUID(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		modelUUID = id.String()
+		current, found, err = st.getSecretDataKey(ctx, tx)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	// Without a data key, any reference is left in place, as a data key
+	// wrapped by the referenced key may be in the process of being written.
+	if !found {
+		return nil
+	}
+
+	active, err := st.keys.ReserveActiveSecretEncryptionKey(ctx, modelUUID)
+	if err != nil {
+		return errors.Errorf("getting active secret encryption key: %w", err)
+	}
+	if current.KeyEncryptionKeyUUID == active.UUID {
+		return errors.Capture(st.keys.ReleaseSecretEncryptionKeys(ctx, modelUUID, active.UUID))
+	}
+
+	dataKey, err := st.unwrapSecretDataKey(ctx, current)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	wrapped, err := encryption.WrapKey(active.Material, dataKey)
+	if err != nil {
+		return errors.Errorf("wrapping secret data key: %w", err)
+	}
+
+	// Only replace the wrapping we unwrapped, so that a concurrent rewrap
+	// with a newer key is not undone.
+	rewrap := rewrappedSecretDataKey{
+		UUID:                         current.UUID,
+		WrappedKey:                   wrapped,
+		KeyEncryptionKeyUUID:         active.UUID,
+		PreviousKeyEncryptionKeyUUID: current.KeyEncryptionKeyUUID,
+	}
+	stmt, err := st.Prepare(`
+UPDATE secret_data_key
+SET    wrapped_key = $rewrappedSecretDataKey.wrapped_key,
+       key_encryption_key_uuid = $rewrappedSecretDataKey.key_encryption_key_uuid
+WHERE  uuid = $rewrappedSecretDataKey.uuid
+AND    key_encryption_key_uuid = $rewrappedSecretDataKey.previous_key_encryption_key_uuid`, rewrap)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var rewrapped bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, rewrap).Get(&outcome); err != nil {
+			return errors.Errorf("updating secret data key: %w", err)
+		}
+		rows, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		rewrapped = rows == 1
+		return nil
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	// The previous key is only released once the data key is no longer
+	// wrapped by it.
+	if !rewrapped {
+		return nil
+	}
+	return errors.Capture(st.keys.ReleaseSecretEncryptionKeys(ctx, modelUUID, active.UUID))
+}
+
+// ensureSecretDataKey returns the model's secret data key, creating and
+// storing one wrapped with the active key-encryption key if there is none.
+func (st State) ensureSecretDataKey(ctx context.Context, tx *sqlair.TX) ([]byte, error) {
+	current, found, err := st.getSecretDataKey(ctx, tx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if found {
+		return st.unwrapSecretDataKey(ctx, current)
+	}
+
+	// The key is referenced before the data key wrapped by it is written,
+	// so that it is retained if it is retired before this transaction
+	// commits.
+	modelUUID, err := st.getModelUUID(ctx, tx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	active, err := st.keys.ReserveActiveSecretEncryptionKey(ctx, modelUUID.String())
+	if err != nil {
+		return nil, errors.Errorf("getting active secret encryption key: %w", err)
+	}
+	dataKey, err := encryption.NewKey()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	wrapped, err := encryption.WrapKey(active.Material, dataKey)
+	if err != nil {
+		return nil, errors.Errorf("wrapping secret data key: %w", err)
+	}
+	keyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	row := secretDataKey{
+		UUID:                 keyUUID.String(),
+		WrappedKey:           wrapped,
+		KeyEncryptionKeyUUID: active.UUID,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO secret_data_key (uuid, wrapped_key, key_encryption_key_uuid)
+VALUES ($secretDataKey.*)`, row)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := tx.Query(ctx, stmt, row).Run(); err != nil {
+		return nil, errors.Errorf("inserting secret data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// getSecretDataKey returns the model's wrapped secret data key, and whether
+// one exists.
+func (st State) getSecretDataKey(ctx context.Context, tx *sqlair.TX) (secretDataKey, bool, error) {
+	stmt, err := st.Prepare(`
+SELECT &secretDataKey.*
+FROM   secret_data_key`, secretDataKey{})
+	if err != nil {
+		return secretDataKey{}, false, errors.Capture(err)
+	}
+
+	var row secretDataKey
+	err = tx.Query(ctx, stmt).Get(&row)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return secretDataKey{}, false, nil
+	} else if err != nil {
+		return secretDataKey{}, false, errors.Errorf("querying secret data key: %w", err)
+	}
+	return row, true, nil
+}
+
+// unwrapSecretDataKey returns the plaintext of the input wrapped data key.
+func (st State) unwrapSecretDataKey(ctx context.Context, row secretDataKey) ([]byte, error) {
+	kek, err := st.keys.GetSecretEncryptionKey(ctx, row.KeyEncryptionKeyUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting secret encryption key %q: %w", row.KeyEncryptionKeyUUID, err)
+	}
+	dataKey, err := encryption.UnwrapKey(kek.Material, row.WrappedKey)
+	if err != nil {
+		return nil, errors.Errorf("unwrapping secret data key %q: %w", row.UUID, err)
+	}
+	return dataKey, nil
+}
+
+// decryptSecretValues returns the plaintext secret data for the input
+// encrypted content rows.
+func decryptSecretValues(dataKey []byte, rows secretValues) (coresecrets.SecretData, error) {
+	result := make(coresecrets.SecretData)
+	for _, row := range rows {
+		plaintext, err := encryption.Decrypt(dataKey, row.Content)
+		if err != nil {
+			return nil, errors.Errorf("decrypting secret content %q: %w", row.Name, err)
+		}
+		result[row.Name] = string(plaintext)
+	}
+	return result, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type encryptionSuite struct {
+	baseSuite
+
+	modelUUID string
+}
+
+func TestEncryptionSuite(t *stdtesting.T) {
+	tc.Run(t, &encryptionSuite{})
+}
+
+func (s *encryptionSuite) SetUpTest(c *tc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.modelUUID = uuid.MustNewUUID().String()
+	_, err := s.DB().Exec(`
+INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type)
+VALUES (?, ?, 'test', 'prod', 'iaas', 'fluffy', 'ec2')`,
+		s.modelUUID, coretesting.ControllerTag.Id())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *encryptionSuite) createSecret(c *tc.C, data coresecrets.SecretData) *coresecrets.URI {
+	uri := coresecrets.NewURI()
+	err := s.state.CreateUserSecret(c.Context(), 1, uri, domainsecret.UpsertSecretParams{
+		Data:       data,
+		RevisionID: new(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return uri
+}
+
+func (s *encryptionSuite) TestContentStoredEncrypted(c *tc.C) {
+	s.createSecret(c, coresecrets.SecretData{"password": "s3cret"})
+
+	rows := s.queryRows(c, "SELECT name, content FROM secret_content")
+	c.Assert(rows, tc.HasLen, 1)
+	c.Check(rows[0]["name"], tc.Equals, "password")
+	c.Check(rows[0]["content"], tc.Not(tc.Equals), "s3cret")
+
+	keys := s.queryRows(c, "SELECT key_encryption_key_uuid FROM secret_data_key")
+	c.Assert(keys, tc.HasLen, 1)
+	c.Check(keys[0]["key_encryption_key_uuid"], tc.Equals, s.keys.active)
+}
+
+func (s *encryptionSuite) TestDataKeySharedAcrossSecrets(c *tc.C) {
+	first := s.createSecret(c, coresecrets.SecretData{"foo": "bar"})
+	second := s.createSecret(c, coresecrets.SecretData{"foo": "baz"})
+
+	keys := s.queryRows(c, "SELECT uuid FROM secret_data_key")
+	c.Check(keys, tc.HasLen, 1)
+
+	data, _, err := s.state.GetSecretValue(c.Context(), first, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+
+	data, _, err = s.state.GetSecretValue(c.Context(), second, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, coresecrets.SecretData{"foo": "baz"})
+}
+
+func (s *encryptionSuite) TestRewrapSecretDataKey(c *tc.C) {
+	uri := s.createSecret(c, coresecrets.SecretData{"foo": "bar"})
+	contentBefore := s.queryRows(c, "SELECT content FROM secret_content")
+	previous := s.keys.active
+
+	active := s.keys.rotate(c)
+	err := s.state.RewrapSecretDataKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	keys := s.queryRows(c, "SELECT key_encryption_key_uuid FROM secret_data_key")
+	c.Assert(keys, tc.HasLen, 1)
+	c.Check(keys[0]["key_encryption_key_uuid"], tc.Equals, active)
+
+	// Content is not re-encrypted.
+	c.Check(s.queryRows(c, "SELECT content FROM secret_content"), tc.DeepEquals, contentBefore)
+
+	// The content is readable without the retired key.
+	delete(s.keys.keys, previous)
+	data, _, err := s.state.GetSecretValue(c.Context(), uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+}
+
+func (s *encryptionSuite) TestDataKeyReferencesKeyEncryptionKey(c *tc.C) {
+	s.createSecret(c, coresecrets.SecretData{"foo": "bar"})
+
+	c.Check(s.keys.references[s.modelUUID].SortedValues(), tc.DeepEquals, []string{s.keys.active})
+}
+
+func (s *encryptionSuite) TestRewrapSecretDataKeyReleasesPreviousKey(c *tc.C) {
+	s.createSecret(c, coresecrets.SecretData{"foo": "bar"})
+
+	active := s.keys.rotate(c)
+	err := s.state.RewrapSecretDataKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.keys.references[s.modelUUID].SortedValues(), tc.DeepEquals, []string{active})
+}
+
+func (s *encryptionSuite) TestRewrapSecretDataKeyRacingFirstWrite(c *tc.C) {
+	// A first secret write has reserved the active key, but its data key
+	// is not yet committed when the key is rotated.
+	previous := s.keys.active
+	_, err := s.keys.ReserveActiveSecretEncryptionKey(c.Context(), s.modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.keys.rotate(c)
+	err = s.state.RewrapSecretDataKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The reference to the retired key is kept, so that the key is not
+	// removed from under the data key being written.
+	c.Check(s.keys.references[s.modelUUID].Contains(previous), tc.IsTrue)
+}
+
+func (s *encryptionSuite) TestRewrapSecretDataKeyNoDataKey(c *tc.C) {
+	err := s.state.RewrapSecretDataKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.queryRows(c, "SELECT uuid FROM secret_data_key"), tc.HasLen, 0)
+}
+
+func (s *encryptionSuite) TestRewrapSecretDataKeyAlreadyActive(c *tc.C) {
+	s.createSecret(c, coresecrets.SecretData{"foo": "bar"})
+	before := s.queryRows(c, "SELECT * FROM secret_data_key")
+
+	err := s.state.RewrapSecretDataKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.queryRows(c, "SELECT * FROM secret_data_key"), tc.DeepEquals, before)
+}
+
+func (s *encryptionSuite) TestGetSecretValueMissingKeyEncryptionKey(c *tc.C) {
+	uri := s.createSecret(c, coresecrets.SecretData{"foo": "bar"})
+	delete(s.keys.keys, s.keys.active)
+
+	_, _, err := s.state.GetSecretValue(c.Context(), uri, 1)
+	c.Assert(err, tc.ErrorMatches, `getting secret encryption key .*`)
+}
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 
 	"github.com/canonical/sqlair"
+	"github.com/juju/collections/set"
 	"github.com/juju/tc"
 
 	coreapplication "github.com/juju/juju/core/application"
@@ -15,23 +16,82 @@ import (
 	coreunit "github.com/juju/juju/core/unit"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/uuid"
 )
 
 type baseSuite struct {
 	schematesting.ModelSuite
 	state *State
+	keys  *testEncryptionKeys
 }
 
 func (s *baseSuite) SetUpTest(c *tc.C) {
 	s.ModelSuite.SetUpTest(c)
-	s.state = NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.keys = newTestEncryptionKeys(c)
+	s.state = NewState(s.TxnRunnerFactory(), s.keys, loggertesting.WrapCheckLog(c))
 
 	c.Cleanup(func() {
 		s.state = nil
+		s.keys = nil
 	})
 }
 
+// testEncryptionKeys is an in-memory source of key-encryption keys, standing
+// in for those stored in the controller database. It records the keys each
+// model references.
+type testEncryptionKeys struct {
+	active     string
+	keys       map[string][]byte
+	references map[string]set.Strings
+}
+
+func newTestEncryptionKeys(c *tc.C) *testEncryptionKeys {
+	k := &testEncryptionKeys{
+		keys:       make(map[string][]byte),
+		references: make(map[string]set.Strings),
+	}
+	k.rotate(c)
+	return k
+}
+
+// rotate adds a new key-encryption key and makes it the active one.
+func (k *testEncryptionKeys) rotate(c *tc.C) string {
+	material, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	k.active = uuid.MustNewUUID().String()
+	k.keys[k.active] = material
+	return k.active
+}
+
+func (k *testEncryptionKeys) ReserveActiveSecretEncryptionKey(_ context.Context, modelUUID string) (secretbackend.EncryptionKey, error) {
+	if k.references[modelUUID] == nil {
+		k.references[modelUUID] = set.NewStrings()
+	}
+	k.references[modelUUID].Add(k.active)
+	return secretbackend.EncryptionKey{UUID: k.active, Material: k.keys[k.active]}, nil
+}
+
+func (k *testEncryptionKeys) ReleaseSecretEncryptionKeys(_ context.Context, modelUUID, keyUUID string) error {
+	for _, ref := range k.references[modelUUID].Values() {
+		if ref != keyUUID && ref != k.active {
+			k.references[modelUUID].Remove(ref)
+		}
+	}
+	return nil
+}
+
+func (k *testEncryptionKeys) GetSecretEncryptionKey(_ context.Context, keyUUID string) (secretbackend.EncryptionKey, error) {
+	material, ok := k.keys[keyUUID]
+	if !ok {
+		return secretbackend.EncryptionKey{}, backenderrors.EncryptionKeyNotFound
+	}
+	return secretbackend.EncryptionKey{UUID: keyUUID, Material: material}, nil
+}
+
 // txn executes a transactional function within a database context,
 // ensuring proper error handling and assertion.
 func (s *baseSuite) txn(c *tc.C, fn func(ctx context.Context, tx *sqlair.TX) error) error {
@@ -30,20 +30,25 @@ import (
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/uuid"
 )
 
 // State represents database interactions dealing with storage pools.
 type State struct {
 	*domain.StateBase
+	keys   KeyEncryptionKeyGetter
 	logger logger.Logger
 }
 
 // NewState returns a new secretMetadata state
 // based on the input database factory method.
-func NewState(factory coredatabase.TxnRunnerFactory, logger logger.Logger) *State {
+// Secret content is encrypted with a model data key,
+// which is wrapped by the key-encryption keys from the input getter.
+func NewState(factory coredatabase.TxnRunnerFactory, keys KeyEncryptionKeyGetter, logger logger.Logger) *State {
 	return &State{
 		StateBase: domain.NewStateBase(factory),
+		keys:      keys,
 		logger:    logger,
 	}
 }
@@ -1195,11 +1200,23 @@ ON CONFLICT(revision_uuid, name) DO UPDATE SET
 	if err := tx.Query(ctx, deleteStmt, revisionUUID{UUID: revUUID}, keys).Run(); err != nil {
 		return errors.Capture(err)
 	}
+	if len(content) == 0 {
+		return nil
+	}
+
+	dataKey, err := st.ensureSecretDataKey(ctx, tx)
+	if err != nil {
+		return errors.Errorf("getting secret data key: %w", err)
+	}
 	for key, value := range content {
+		encrypted, err := encryption.Encrypt(dataKey, []byte(value))
+		if err != nil {
+			return errors.Errorf("encrypting secret content %q: %w", key, err)
+		}
 		if err := tx.Query(ctx, insertStmt, secretContent{
 			RevisionUUID: revUUID,
 			Name:         key,
-			Content:      value,
+			Content:      encrypted,
 		}).Run(); err != nil {
 			return errors.Capture(err)
 		}
@@ -2197,6 +2214,7 @@ AND    rev.revision = $secretRevision.revision`
 	var (
 		dbSecretValues    secretValues
 		dbSecretValueRefs []secretValueRef
+		dbDataKey         secretDataKey
 	)
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		err := tx.Query(ctx, contentQueryStmt, want).GetAll(&dbSecretValues)
@@ -2205,6 +2223,13 @@ AND    rev.revision = $secretRevision.revision`
 		}
 		// Do we have content from the db?
 		if len(dbSecretValues) > 0 {
+			var found bool
+			dbDataKey, found, err = st.getSecretDataKey(ctx, tx)
+			if err != nil {
+				return errors.Capture(err)
+			} else if !found {
+				return errors.Errorf("no data key to decrypt secret value for %q revision %d", uri, revision)
+			}
 			return nil
 		}
 
@@ -2223,7 +2248,14 @@ AND    rev.revision = $secretRevision.revision`
 
 	// Compose and return any secret content from the db.
 	if len(dbSecretValues) > 0 {
-		content := dbSecretValues.toSecretData()
+		dataKey, err := st.unwrapSecretDataKey(ctx, dbDataKey)
+		if err != nil {
+			return nil, nil, errors.Capture(err)
+		}
+		content, err := decryptSecretValues(dataKey, dbSecretValues)
+		if err != nil {
+			return nil, nil, errors.Errorf("reading secret value for %q revision %d: %w", uri, revision, err)
+		}
 		return content, nil, nil
 	}
 
@@ -392,14 +392,6 @@ func (rows secretRevisions) toSecretRevisions(
 
 type secretValues []secretContent
 
-func (rows secretValues) toSecretData() coresecrets.SecretData {
-	result := make(coresecrets.SecretData)
-	for _, row := range rows {
-		result[row.Name] = row.Content
-	}
-	return result
-}
-
 type secretValueRefs []secretValueRef
 
 type secretUnitConsumers []secretUnitConsumer
@@ -514,3 +506,19 @@ type count struct {
 func getRevisionID(secretID string, revision int) string {
 	return fmt.Sprintf("%s/%d", secretID, revision)
 }
+
+// secretDataKey represents the row in the secret_data_key table.
+type secretDataKey struct {
+	UUID                 string `db:"uuid"`
+	WrappedKey           string `db:"wrapped_key"`
+	KeyEncryptionKeyUUID string `db:"key_encryption_key_uuid"`
+}
+
+// rewrappedSecretDataKey is used to replace the wrapping of the data key,
+// provided that it is still wrapped with the previous key-encryption key.
+type rewrappedSecretDataKey struct {
+	UUID                         string `db:"uuid"`
+	WrappedKey                   string `db:"wrapped_key"`
+	KeyEncryptionKeyUUID         string `db:"key_encryption_key_uuid"`
+	PreviousKeyEncryptionKeyUUID string `db:"previous_key_encryption_key_uuid"`
+}
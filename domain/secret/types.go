@@ -212,6 +212,9 @@ const (
 // SecretAccessParams are used to define access to a secret.
 type SecretAccessParams struct {
 	Accessor SecretAccessor
+	// User is the user on whose behalf the accessor is acting, if any.
+	// It is recorded in the secret access log in place of the accessor.
+	User string
 
 	Scope   SecretAccessScope
 	Subject SecretAccessor
@@ -243,6 +246,15 @@ const (
 	ApplicationAccessor SecretAccessorKind = "application"
 	UnitAccessor        SecretAccessorKind = "unit"
 	ModelAccessor       SecretAccessorKind = "model"
+
+	// UserAccessor identifies a user in the secret access log.
+	// Users are never granted access to a secret directly.
+	UserAccessor SecretAccessorKind = "user"
+
+	// RemoteApplicationAccessor identifies, in the secret access log, an
+	// application in a consuming model which read a secret over a cross
+	// model relation.
+	RemoteApplicationAccessor SecretAccessorKind = "remote-application"
 )
 
 // SecretAccessScope represents the scope of a secret permission.
@@ -261,3 +273,55 @@ const (
 	RelationAccessScope    SecretAccessScopeKind = "relation"
 	ModelAccessScope       SecretAccessScopeKind = "model"
 )
+
+// SecretAccessAction represents an action recorded in the secret access log.
+type SecretAccessAction int
+
+// These represent the actions recorded in the secret access log.
+const (
+	SecretAccessRead SecretAccessAction = iota
+	SecretAccessGrant
+	SecretAccessRevoke
+)
+
+// String returns the name of the action.
+func (a SecretAccessAction) String() string {
+	switch a {
+	case SecretAccessRead:
+		return "read"
+	case SecretAccessGrant:
+		return "grant"
+	case SecretAccessRevoke:
+		return "revoke"
+	}
+	return ""
+}
+
+// SecretAccessLogFilter restricts the entries returned from the secret
+// access log.
+type SecretAccessLogFilter struct {
+	// Since, if set, excludes the entries recorded before it.
+	Since *time.Time
+	// Limit, if positive, returns only that many of the most recent entries.
+	Limit int
+}
+
+// SecretAccessLogEntry is a record of a secret content read, or of a change
+// to the access to a secret.
+type SecretAccessLogEntry struct {
+	// Action is what was done to the secret.
+	Action SecretAccessAction
+	// Revision is the revision which was read.
+	// It is only set for reads.
+	Revision *int
+	// Accessor is the entity which read the secret or changed its access.
+	Accessor SecretAccessor
+	// Subject is the entity whose access was granted or revoked.
+	// It is not set for reads.
+	Subject *SecretAccessor
+	// BackendID is the external backend holding the content which was
+	// read. It is empty if the content is held in the model.
+	BackendID string
+	// Time is when the access happened.
+	Time time.Time
+}
@@ -15,15 +15,28 @@ import (
 	"github.com/juju/juju/domain/secretbackend/state"
 	internaldatabase "github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/uuid"
 )
 
-// CreateDefaultBackends inserts the initial secret backends during bootstrap.
-func CreateDefaultBackends(modelType coremodel.ModelType) internaldatabase.BootstrapOpt {
+// KeyStore holds the material of the controller key-encryption keys.
+type KeyStore interface {
+	// Put stores the material of the key with the input UUID.
+	Put(keyUUID string, material []byte) error
+}
+
+// CreateDefaultBackends inserts the initial secret backends during bootstrap,
+// along with the key-encryption key that protects secret content stored in
+// the internal backend. The key's material is held by the input key store.
+func CreateDefaultBackends(modelType coremodel.ModelType, keys KeyStore) internaldatabase.BootstrapOpt {
 	return func(ctx context.Context, controller, model database.TxnRunner) error {
-		err := controller.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		keyUUID, err := createEncryptionKey(keys)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		err = controller.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 			err := createBackend(ctx, tx, juju.BackendName, domainsecretbackend.BackendTypeController)
 			if err != nil {
 				return errors.Capture(err)
@@ -32,7 +45,7 @@ func CreateDefaultBackends(modelType coremodel.ModelType) internaldatabase.Boots
 			if err != nil {
 				return errors.Capture(err)
 			}
-			return nil
+			return insertEncryptionKey(ctx, tx, keyUUID)
 		})
 		return errors.Capture(err)
 	}
@@ -64,3 +77,38 @@ VALUES ($SecretBackend.*)`, state.SecretBackend{})
 	}
 	return nil
 }
+
+// createEncryptionKey generates a key-encryption key and stores its material,
+// returning the key's UUID.
+func createEncryptionKey(keys KeyStore) (string, error) {
+	keyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	material, err := encryption.NewKey()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if err := keys.Put(keyUUID.String(), material); err != nil {
+		return "", errors.Errorf("cannot store secret encryption key: %w", err)
+	}
+	return keyUUID.String(), nil
+}
+
+func insertEncryptionKey(ctx context.Context, tx *sqlair.TX, keyUUID string) error {
+	insertKeyStmt, err := sqlair.Prepare(`
+INSERT INTO secret_encryption_key (uuid, active)
+VALUES ($SecretEncryptionKey.*)`, state.SecretEncryptionKey{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = tx.Query(ctx, insertKeyStmt, state.SecretEncryptionKey{
+		UUID:   keyUUID,
+		Active: true,
+	}).Run()
+	if err != nil {
+		return errors.Errorf("cannot create secret encryption key: %w", err)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	coremodel "github.com/juju/juju/core/model"
 	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/secrets/encryption"
 )
 
 type bootstrapSuite struct {
@@ -21,7 +22,7 @@ func TestBootstrapSuite(t *testing.T) {
 }
 
 func (s *bootstrapSuite) TestCreateDefaultBackendsIAAS(c *tc.C) {
-	err := CreateDefaultBackends(coremodel.IAAS)(c.Context(), s.TxnRunner(), s.NoopTxnRunner())
+	err := CreateDefaultBackends(coremodel.IAAS, encryption.NewFileKeyStore(c.MkDir()))(c.Context(), s.TxnRunner(), s.NoopTxnRunner())
 	c.Assert(err, tc.ErrorIsNil)
 
 	var (
@@ -39,7 +40,7 @@ func (s *bootstrapSuite) TestCreateDefaultBackendsIAAS(c *tc.C) {
 }
 
 func (s *bootstrapSuite) TestCreateDefaultBackendsCAAS(c *tc.C) {
-	err := CreateDefaultBackends(coremodel.CAAS)(c.Context(), s.TxnRunner(), s.NoopTxnRunner())
+	err := CreateDefaultBackends(coremodel.CAAS, encryption.NewFileKeyStore(c.MkDir()))(c.Context(), s.TxnRunner(), s.NoopTxnRunner())
 	c.Assert(err, tc.ErrorIsNil)
 
 	var (
@@ -55,3 +56,16 @@ func (s *bootstrapSuite) TestCreateDefaultBackendsCAAS(c *tc.C) {
 	c.Assert(name, tc.Equals, "kubernetes")
 	c.Assert(typeID, tc.Equals, 1)
 }
+
+func (s *bootstrapSuite) TestCreateDefaultBackendsEncryptionKey(c *tc.C) {
+	keys := encryption.NewFileKeyStore(c.MkDir())
+	err := CreateDefaultBackends(coremodel.IAAS, keys)(c.Context(), s.TxnRunner(), s.NoopTxnRunner())
+	c.Assert(err, tc.ErrorIsNil)
+
+	var keyUUID string
+	row := s.DB().QueryRow("SELECT uuid FROM secret_encryption_key WHERE active = TRUE")
+	c.Assert(row.Scan(&keyUUID), tc.ErrorIsNil)
+	material, err := keys.Get(keyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(material, tc.HasLen, encryption.KeySize)
+}
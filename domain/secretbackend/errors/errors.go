@@ -26,4 +26,12 @@ const (
 
 	// NotSupported describes an error that occurs when the secret backend is not supported.
 	NotSupported = errors.ConstError("secret backend not supported")
+
+	// EncryptionKeyNotFound describes an error that occurs when a secret
+	// encryption key does not exist.
+	EncryptionKeyNotFound = errors.ConstError("secret encryption key not found")
+
+	// DrainNotFound describes an error that occurs when a model has no
+	// secret backend drain in progress.
+	DrainNotFound = errors.ConstError("secret backend drain not found")
 )
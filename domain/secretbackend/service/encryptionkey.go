@@ -0,0 +1,171 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// EncryptionKeyState describes persistence of the controller key-encryption
+// keys that wrap model secret data keys. Only the keys' UUIDs are persisted;
+// their material is held by a [KeyStore].
+type EncryptionKeyState interface {
+	// AddSecretEncryptionKey records the key-encryption key with the input
+	// UUID and makes it the active key, retaining any previously active
+	// key.
+	AddSecretEncryptionKey(ctx context.Context, keyUUID string) error
+
+	// ReserveActiveSecretEncryptionKey returns the UUID of the key used to
+	// wrap new data keys, recording that the model with the input UUID
+	// references it.
+	ReserveActiveSecretEncryptionKey(ctx context.Context, modelUUID string) (string, error)
+
+	// ReleaseSecretEncryptionKeys removes the model's references to keys
+	// other than the input key and the active key.
+	ReleaseSecretEncryptionKeys(ctx context.Context, modelUUID, keyUUID string) error
+
+	// DeleteInactiveSecretEncryptionKeys removes the key-encryption keys
+	// other than the active one which no model references, returning the
+	// UUIDs of the removed keys and the number of inactive keys retained.
+	DeleteInactiveSecretEncryptionKeys(ctx context.Context) ([]string, int, error)
+}
+
+// KeyStore holds the material of the controller key-encryption keys outside
+// of the controller database.
+type KeyStore interface {
+	// Get returns the material of the key with the input UUID, returning an
+	// error satisfying [encryption.ErrKeyNotFound] if it is not held.
+	Get(keyUUID string) ([]byte, error)
+
+	// Put stores the material of the key with the input UUID.
+	Put(keyUUID string, material []byte) error
+
+	// Remove removes the key with the input UUID.
+	Remove(keyUUID string) error
+}
+
+// EncryptionKeyService provides the controller key-encryption keys with which
+// model secret data keys are wrapped, combining the keys recorded in the
+// controller database with their material held in the key store.
+type EncryptionKeyService struct {
+	st   EncryptionKeyState
+	keys KeyStore
+}
+
+// NewEncryptionKeyService returns a new EncryptionKeyService.
+func NewEncryptionKeyService(st EncryptionKeyState, keys KeyStore) *EncryptionKeyService {
+	return &EncryptionKeyService{
+		st:   st,
+		keys: keys,
+	}
+}
+
+// RotateSecretEncryptionKey generates a new controller key-encryption key and
+// makes it the key with which model data keys are wrapped, returning the new
+// key's UUID. Existing data keys remain readable with the previous key until
+// each model has rewrapped its data key, after which
+// [EncryptionKeyService.RemoveRetiredSecretEncryptionKeys] should be called.
+func (s *EncryptionKeyService) RotateSecretEncryptionKey(ctx context.Context) (string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	keyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	material, err := encryption.NewKey()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	// The material is stored before the key is recorded, so that an active
+	// key always has material to wrap data keys with.
+	if err := s.keys.Put(keyUUID.String(), material); err != nil {
+		return "", errors.Errorf("storing secret encryption key: %w", err)
+	}
+	if err := s.st.AddSecretEncryptionKey(ctx, keyUUID.String()); err != nil {
+		return "", errors.Errorf("adding secret encryption key: %w", err)
+	}
+	return keyUUID.String(), nil
+}
+
+// RemoveRetiredSecretEncryptionKeys deletes the key-encryption keys other
+// than the active one, returning true if none are left. A retired key is kept
+// while any model may hold a data key wrapped by it, such as a model which is
+// still being imported or one whose first secret was written while the key
+// was being rotated, and is removed by a later call once that model's data
+// key has been rewrapped.
+func (s *EncryptionKeyService) RemoveRetiredSecretEncryptionKeys(ctx context.Context) (bool, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	deleted, retained, err := s.st.DeleteInactiveSecretEncryptionKeys(ctx)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	for _, keyUUID := range deleted {
+		if err := s.keys.Remove(keyUUID); err != nil {
+			return false, errors.Errorf("removing secret encryption key %q: %w", keyUUID, err)
+		}
+	}
+	return retained == 0, nil
+}
+
+// ReserveActiveSecretEncryptionKey returns the key-encryption key used to
+// wrap new data keys, recording that the model with the input UUID references
+// it so that it is retained once retired. It returns an error satisfying
+// [secretbackenderrors.EncryptionKeyNotFound] if there is no active key, or
+// its material is not held by this controller.
+func (s *EncryptionKeyService) ReserveActiveSecretEncryptionKey(ctx context.Context, modelUUID string) (secretbackend.EncryptionKey, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	keyUUID, err := s.st.ReserveActiveSecretEncryptionKey(ctx, modelUUID)
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Capture(err)
+	}
+	return s.getKey(keyUUID)
+}
+
+// ReleaseSecretEncryptionKeys removes the references of the model with the
+// input UUID to key-encryption keys other than the input key and the active
+// key.
+func (s *EncryptionKeyService) ReleaseSecretEncryptionKeys(ctx context.Context, modelUUID, keyUUID string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.ReleaseSecretEncryptionKeys(ctx, modelUUID, keyUUID)
+}
+
+// GetSecretEncryptionKey returns the key-encryption key with the input UUID,
+// returning an error satisfying [secretbackenderrors.EncryptionKeyNotFound]
+// if its material is not held by this controller.
+func (s *EncryptionKeyService) GetSecretEncryptionKey(ctx context.Context, keyUUID string) (secretbackend.EncryptionKey, error) {
+	_, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.getKey(keyUUID)
+}
+
+func (s *EncryptionKeyService) getKey(keyUUID string) (secretbackend.EncryptionKey, error) {
+	material, err := s.keys.Get(keyUUID)
+	if errors.Is(err, encryption.ErrKeyNotFound) {
+		return secretbackend.EncryptionKey{}, errors.Errorf(
+			"secret encryption key %q: %w", keyUUID, err,
+		).Add(secretbackenderrors.EncryptionKeyNotFound)
+	} else if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Errorf("getting secret encryption key %q: %w", keyUUID, err)
+	}
+	return secretbackend.EncryptionKey{
+		UUID:     keyUUID,
+		Material: material,
+	}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type encryptionKeyServiceSuite struct {
+	state *MockEncryptionKeyState
+	keys  *encryption.FileKeyStore
+}
+
+func TestEncryptionKeyServiceSuite(t *testing.T) {
+	tc.Run(t, &encryptionKeyServiceSuite{})
+}
+
+func (s *encryptionKeyServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockEncryptionKeyState(ctrl)
+	s.keys = encryption.NewFileKeyStore(c.MkDir())
+	c.Cleanup(func() {
+		s.state = nil
+		s.keys = nil
+	})
+	return ctrl
+}
+
+func (s *encryptionKeyServiceSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var added string
+	s.state.EXPECT().AddSecretEncryptionKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, keyUUID string) error {
+			added = keyUUID
+			return nil
+		})
+
+	svc := NewEncryptionKeyService(s.state, s.keys)
+	keyUUID, err := svc.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keyUUID, tc.Equals, added)
+
+	material, err := s.keys.Get(keyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(material, tc.HasLen, encryption.KeySize)
+}
+
+func (s *encryptionKeyServiceSuite) TestRemoveRetiredSecretEncryptionKeys(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	retired := uuid.MustNewUUID().String()
+	err := s.keys.Put(retired, []byte("retired"))
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().DeleteInactiveSecretEncryptionKeys(gomock.Any()).Return([]string{retired}, 0, nil)
+
+	svc := NewEncryptionKeyService(s.state, s.keys)
+	removed, err := svc.RemoveRetiredSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(removed, tc.IsTrue)
+
+	_, err = s.keys.Get(retired)
+	c.Check(err, tc.ErrorIs, encryption.ErrKeyNotFound)
+}
+
+func (s *encryptionKeyServiceSuite) TestRemoveRetiredSecretEncryptionKeysRetained(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DeleteInactiveSecretEncryptionKeys(gomock.Any()).Return(nil, 1, nil)
+
+	svc := NewEncryptionKeyService(s.state, s.keys)
+	removed, err := svc.RemoveRetiredSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(removed, tc.IsFalse)
+}
+
+func (s *encryptionKeyServiceSuite) TestReserveActiveSecretEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	keyUUID := uuid.MustNewUUID().String()
+	err := s.keys.Put(keyUUID, []byte("material"))
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().ReserveActiveSecretEncryptionKey(gomock.Any(), "model-uuid").Return(keyUUID, nil)
+
+	svc := NewEncryptionKeyService(s.state, s.keys)
+	key, err := svc.ReserveActiveSecretEncryptionKey(c.Context(), "model-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key.UUID, tc.Equals, keyUUID)
+	c.Check(key.Material, tc.DeepEquals, []byte("material"))
+}
+
+func (s *encryptionKeyServiceSuite) TestGetSecretEncryptionKeyNotHeld(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := NewEncryptionKeyService(s.state, s.keys)
+	_, err := svc.GetSecretEncryptionKey(c.Context(), uuid.MustNewUUID().String())
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+}
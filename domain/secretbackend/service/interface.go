@@ -25,6 +25,8 @@ type State interface {
 	ListSecretBackendIDs(ctx context.Context) ([]string, error)
 	SecretBackendRotated(ctx context.Context, backendID string, next time.Time) error
 	SetModelSecretBackend(ctx context.Context, modelUUID coremodel.UUID, secretBackendName string) error
+	ListSecretBackendDrains(ctx context.Context) ([]secretbackend.Drain, error)
+	AbortSecretBackendDrain(ctx context.Context, modelUUID coremodel.UUID) error
 
 	ListSecretBackendsForModel(ctx context.Context, modelUUID coremodel.UUID, includeEmpty bool) ([]*secretbackend.SecretBackend, error)
 	GetModelSecretBackendDetails(ctx context.Context, modelUUID coremodel.UUID) (secretbackend.ModelSecretBackend, error)
@@ -35,6 +37,8 @@ type State interface {
 	InitialWatchStatementForSecretBackendRotationChanges() (string, string)
 	GetSecretBackendRotateChanges(ctx context.Context, backendIDs ...string) ([]watcher.SecretBackendRotateChange, error)
 	NamespaceForWatchModelSecretBackend() string
+
+	CountControllerNodes(ctx context.Context) (int, error)
 }
 
 // AdminBackendConfigGetterFunc returns a function that gets the
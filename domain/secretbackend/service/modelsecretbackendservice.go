@@ -86,3 +86,19 @@ func (s *ModelSecretBackendService) SetModelSecretBackend(ctx context.Context, b
 	}
 	return nil
 }
+
+// AbortSecretBackendDrain aborts the drain of the model's secret content to
+// its current secret backend, reverting the model to the backend it used
+// before and moving any content already drained back to it.
+// It returns an error satisfying [secretbackenderrors.DrainNotFound] if the
+// model has no drain, or [secretbackenderrors.Forbidden] if the drain has
+// already been aborted.
+func (s *ModelSecretBackendService) AbortSecretBackendDrain(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.st.AbortSecretBackendDrain(ctx, s.modelID); err != nil {
+		return errors.Errorf("aborting secret backend drain for %q: %w", s.modelID, err)
+	}
+	return nil
+}
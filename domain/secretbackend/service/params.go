@@ -28,3 +28,12 @@ type BackendConfigParams struct {
 	BackendIDs           []string
 	SameController       bool
 }
+
+// BackendForTokenParams are used to get a client for accessing secret content
+// on behalf of an agent holding an access token.
+type BackendForTokenParams struct {
+	Accessor  secret.SecretAccessor
+	ModelUUID coremodel.UUID
+	BackendID string
+	Token     string
+}
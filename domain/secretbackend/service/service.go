@@ -143,6 +143,45 @@ func (s *Service) DrainBackendConfigInfo(
 	return &result, nil
 }
 
+// GetBackendForToken returns a client for the specified secret backend,
+// restricted to the access granted by a token issued to the accessor in the
+// config from [Service.BackendConfigInfo]. It returns an error satisfying
+// [coreerrors.NotSupported] if agents access the backend directly.
+func (s *Service) GetBackendForToken(
+	ctx context.Context, p BackendForTokenParams,
+) (provider.SecretsBackend, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if p.Accessor.Kind != secret.UnitAccessor {
+		return nil, errors.Errorf("secret accessor kind %q %w", p.Accessor.Kind, coreerrors.NotSupported)
+	}
+	adminModelCfg, err := s.GetSecretBackendConfigForAdmin(ctx, p.ModelUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting configured secrets providers: %w", err)
+	}
+	cfg, ok := adminModelCfg.Configs[p.BackendID]
+	if !ok {
+		return nil, errors.Errorf("%w: %q", secretbackenderrors.NotFound, p.BackendID)
+	}
+	backendProvider, err := s.registry(cfg.BackendType)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	proxied, ok := backendProvider.(provider.SupportContentProxy)
+	if !ok {
+		return nil, errors.Errorf("accessing %q secret backend content through the controller %w", cfg.BackendType, coreerrors.NotSupported)
+	}
+	b, err := proxied.NewTokenBackend(&cfg, coresecrets.Accessor{
+		Kind: coresecrets.UnitAccessor,
+		ID:   p.Accessor.ID,
+	}, p.Token)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return b, nil
+}
+
 // BackendConfigInfo returns the config to create a secret backend
 // for the specified backend IDs.
 // This is called to provide config to a client like a unit agent which
@@ -334,7 +373,8 @@ func (s *Service) BackendSummaryInfoForModel(ctx context.Context, modelUUID core
 	return s.composeBackendInfoResults(backendInfos, false)
 }
 
-// BackendSummaryInfo returns a summary of the secret backends.
+// BackendSummaryInfo returns a summary of the secret backends, including the
+// progress of any drains of model secret content to or from each backend.
 // If names are specified, just those backends are included, else all.
 func (s *Service) BackendSummaryInfo(ctx context.Context, reveal bool, names ...string) ([]*SecretBackendInfo, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
@@ -356,6 +396,18 @@ func (s *Service) BackendSummaryInfo(ctx context.Context, reveal bool, names ...
 			NumSecrets: b.NumSecrets,
 		})
 	}
+
+	drains, err := s.st.ListSecretBackendDrains(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	for _, b := range backendInfos {
+		for _, d := range drains {
+			if d.SourceBackendID == b.ID || d.TargetBackendID == b.ID {
+				b.Drains = append(b.Drains, d)
+			}
+		}
+	}
 	return s.composeBackendInfoResults(backendInfos, reveal, names...)
 }
 
@@ -408,6 +460,23 @@ func pingBackend(p provider.SecretBackendProvider, cfg provider.ConfigAttrs) err
 	return b.Ping()
 }
 
+// validateControllerNodes returns an error satisfying
+// [secretbackenderrors.NotSupported] if a backend of the provider with the
+// input config cannot be used by the controller's current nodes.
+func (s *Service) validateControllerNodes(ctx context.Context, p provider.SecretBackendProvider, cfg provider.ConfigAttrs) error {
+	if _, ok := p.(provider.SupportControllerNodes); !ok {
+		return nil
+	}
+	numNodes, err := s.st.CountControllerNodes(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := provider.ValidateControllerNodes(p, cfg, numNodes); err != nil {
+		return errors.Errorf("%w: %w", secretbackenderrors.NotSupported, err)
+	}
+	return nil
+}
+
 func validateExternalBackendName(name string) error {
 	if name == provider.Auto ||
 		name == provider.Internal ||
@@ -476,6 +545,9 @@ func (s *Service) CreateSecretBackend(ctx context.Context, backend coresecrets.S
 			return errors.Errorf("%w: config for provider %q: %w", secretbackenderrors.NotValid, backend.BackendType, err)
 		}
 	}
+	if err := s.validateControllerNodes(ctx, p, backend.Config); err != nil {
+		return errors.Capture(err)
+	}
 	if err := pingBackend(p, backend.Config); err != nil {
 		return errors.Capture(err)
 	}
@@ -549,6 +621,9 @@ func (s *Service) UpdateSecretBackend(ctx context.Context, params UpdateSecretBa
 			return errors.Errorf("%w: config for provider %q: %w", secretbackenderrors.NotValid, existing.BackendType, err)
 		}
 	}
+	if err := s.validateControllerNodes(ctx, p, cfgToApply); err != nil {
+		return errors.Capture(err)
+	}
 	if !params.SkipPing {
 		if err := pingBackend(p, cfgToApply); err != nil {
 			return errors.Capture(err)
@@ -569,6 +644,30 @@ func (s *Service) UpdateSecretBackend(ctx context.Context, params UpdateSecretBa
 	return errors.Capture(err)
 }
 
+// ValidateControllerNodes returns an error satisfying
+// [secretbackenderrors.NotSupported] if any secret backend cannot be used by a
+// controller with the input number of nodes, such as a file backend whose
+// directory is not on storage shared by every node.
+func (s *Service) ValidateControllerNodes(ctx context.Context, numNodes int) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	backends, err := s.st.ListSecretBackends(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	for _, b := range backends {
+		p, err := s.registry(b.BackendType)
+		if err != nil {
+			return errors.Errorf("getting backend provider type %q: %w", b.BackendType, err)
+		}
+		if err := provider.ValidateControllerNodes(p, b.Config, numNodes); err != nil {
+			return errors.Errorf("%w: secret backend %q: %w", secretbackenderrors.NotSupported, b.Name, err)
+		}
+	}
+	return nil
+}
+
 // DeleteSecretBackend deletes a secret backend.
 func (s *Service) DeleteSecretBackend(ctx context.Context, params DeleteSecretBackendParams) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/juju/worker/v5/workertest"
 	"go.uber.org/mock/gomock"
 
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
@@ -29,7 +31,9 @@ import (
 	"github.com/juju/juju/internal/configschema"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	internalsecrets "github.com/juju/juju/internal/secrets"
 	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/file"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/secrets/provider/vault"
@@ -448,6 +452,7 @@ func (s *serviceSuite) assertBackendSummaryInfo(
 		})
 	}
 	s.mockState.EXPECT().ListSecretBackends(gomock.Any()).Return(backends, nil)
+	s.mockState.EXPECT().ListSecretBackendDrains(gomock.Any()).Return(nil, nil)
 	s.mockRegistry.EXPECT().Type().Return(vault.BackendType).AnyTimes()
 	if set.NewStrings(names...).Contains("myvault") || len(names) == 0 {
 		s.mockRegistry.EXPECT().NewBackend(&provider.ModelBackendConfig{
@@ -482,6 +487,49 @@ func (s *serviceSuite) assertBackendSummaryInfo(
 	c.Assert(info, tc.SameContents, expected)
 }
 
+func (s *serviceSuite) TestBackendSummaryInfoWithDrains(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return s.mockRegistry, nil
+		},
+	)
+
+	drain := secretbackend.Drain{
+		ModelUUID:         tc.Must0(c, coremodel.NewUUID),
+		ModelName:         "my-model",
+		SourceBackendID:   jujuBackendID,
+		SourceBackendName: juju.BackendName,
+		TargetBackendID:   k8sBackendID,
+		TargetBackendName: "my-model-local",
+		Status:            secretbackend.DrainStatusDraining,
+		TotalRevisions:    3,
+		MovedRevisions:    1,
+		VerifiedRevisions: 1,
+	}
+	s.mockState.EXPECT().ListSecretBackends(gomock.Any()).Return([]*secretbackend.SecretBackend{{
+		ID:          jujuBackendID,
+		Name:        juju.BackendName,
+		BackendType: juju.BackendType,
+	}, {
+		ID:          k8sBackendID,
+		Name:        "my-model-local",
+		BackendType: kubernetes.BackendType,
+	}, {
+		ID:          vaultBackendID,
+		Name:        "myvault",
+		BackendType: vault.BackendType,
+	}}, nil)
+	s.mockState.EXPECT().ListSecretBackendDrains(gomock.Any()).Return([]secretbackend.Drain{drain}, nil)
+
+	info, err := svc.BackendSummaryInfo(c.Context(), false, juju.BackendName, "my-model-local")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(info, tc.HasLen, 2)
+	c.Check(info[0].Drains, tc.DeepEquals, []secretbackend.Drain{drain})
+	c.Check(info[1].Drains, tc.DeepEquals, []secretbackend.Drain{drain})
+}
+
 func (s *serviceSuite) TestBackendSummaryInfoWithFilterAllCAAS(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	svc := newService(
@@ -1029,6 +1077,95 @@ func (s *serviceSuite) TestBackendConfigInfoFailedInvalidAccessor(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `secret accessor kind "application" not supported`)
 }
 
+func (s *serviceSuite) TestGetBackendForToken(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	fileProvider := file.NewProvider()
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return fileProvider, nil
+		},
+	)
+
+	backendCfg := map[string]any{
+		"path": c.MkDir(),
+		"key":  base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+	}
+	adminCfg := &provider.ModelBackendConfig{
+		ControllerUUID: jujutesting.ControllerTag.Id(),
+		ModelUUID:      jujutesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig: provider.BackendConfig{
+			BackendType: file.BackendType,
+			Config:      backendCfg,
+		},
+	}
+	err := fileProvider.Initialise(adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+
+	uri := coresecrets.NewURI()
+	owned := provider.SecretRevisions{}
+	owned.Add(uri, uri.Name(1))
+	restricted, err := fileProvider.RestrictedConfig(c.Context(), adminCfg, true, false,
+		coresecrets.Accessor{Kind: coresecrets.UnitAccessor, ID: "gitlab/0"}, owned, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	backend := secretbackend.BackendIdentifier{ID: "file-id", Name: "file"}
+	fileBackend := &secretbackend.SecretBackend{
+		ID:          "file-id",
+		Name:        "file",
+		BackendType: file.BackendType,
+		Config:      backendCfg,
+	}
+	s.expectGetSecretBackendConfigForAdminDefault("iaas", backend, fileBackend)
+	b, err := svc.GetBackendForToken(c.Context(), BackendForTokenParams{
+		Accessor:  secret.SecretAccessor{Kind: secret.UnitAccessor, ID: "gitlab/0"},
+		ModelUUID: coremodel.UUID(jujutesting.ModelTag.Id()),
+		BackendID: "file-id",
+		Token:     restricted.Config["token"].(string),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.SaveContent(c.Context(), uri, 1, coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Another unit cannot use the token.
+	s.expectGetSecretBackendConfigForAdminDefault("iaas", backend, fileBackend)
+	_, err = svc.GetBackendForToken(c.Context(), BackendForTokenParams{
+		Accessor:  secret.SecretAccessor{Kind: secret.UnitAccessor, ID: "mysql/0"},
+		ModelUUID: coremodel.UUID(jujutesting.ModelTag.Id()),
+		BackendID: "file-id",
+		Token:     restricted.Config["token"].(string),
+	})
+	c.Assert(err, tc.ErrorIs, internalsecrets.PermissionDenied)
+}
+
+func (s *serviceSuite) TestGetBackendForTokenNotSupported(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return s.mockRegistry, nil
+		},
+	)
+	backend := secretbackend.BackendIdentifier{ID: "backend-id", Name: "backend1"}
+	s.expectGetSecretBackendConfigForAdminDefault("iaas", backend, &secretbackend.SecretBackend{
+		ID:          "backend-id",
+		Name:        "backend1",
+		BackendType: "some-backend",
+	})
+	_, err := svc.GetBackendForToken(c.Context(), BackendForTokenParams{
+		Accessor:  secret.SecretAccessor{Kind: secret.UnitAccessor, ID: "gitlab/0"},
+		ModelUUID: coremodel.UUID(jujutesting.ModelTag.Id()),
+		BackendID: "backend-id",
+		Token:     "token",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
 func (s *serviceSuite) TestBackendIDs(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	backends := []string{vaultBackendID, "another-vault-id"}
@@ -1150,6 +1287,64 @@ func (s *serviceSuite) TestCreateSecretBackend(c *tc.C) {
 	})
 	c.Assert(err, tc.ErrorIsNil)
 }
+func (s *serviceSuite) TestCreateSecretBackendFileMultipleControllerNodes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return file.NewProvider(), nil
+		},
+	)
+
+	backend := coresecrets.SecretBackend{
+		ID:          "backend-uuid",
+		Name:        "myfile",
+		BackendType: file.BackendType,
+		Config: map[string]any{
+			"path": c.MkDir(),
+			"key":  base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		},
+	}
+	s.mockState.EXPECT().CountControllerNodes(gomock.Any()).Return(3, nil)
+	err := svc.CreateSecretBackend(c.Context(), backend)
+	c.Check(err, tc.ErrorIs, secretbackenderrors.NotSupported)
+	c.Check(err, tc.ErrorMatches, `secret backend not supported: file backend path ".*" on a controller with 3 nodes unless "shared-storage" is set not supported`)
+
+	// A directory on shared storage can be used by every controller node.
+	backend.Config["shared-storage"] = true
+	s.mockState.EXPECT().CountControllerNodes(gomock.Any()).Return(3, nil)
+	s.mockState.EXPECT().CreateSecretBackend(gomock.Any(), gomock.Any()).Return("backend-uuid", nil)
+	err = svc.CreateSecretBackend(c.Context(), backend)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestValidateControllerNodes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return file.NewProvider(), nil
+		},
+	)
+
+	s.mockState.EXPECT().ListSecretBackends(gomock.Any()).Return([]*secretbackend.SecretBackend{{
+		ID:          "backend-uuid",
+		Name:        "myfile",
+		BackendType: file.BackendType,
+		Config: map[string]any{
+			"path": "/var/lib/secrets",
+			"key":  base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		},
+	}}, nil).Times(2)
+
+	err := svc.ValidateControllerNodes(c.Context(), 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = svc.ValidateControllerNodes(c.Context(), 3)
+	c.Check(err, tc.ErrorIs, secretbackenderrors.NotSupported)
+	c.Check(err, tc.ErrorMatches, `secret backend not supported: secret backend "myfile": .*`)
+}
+
 func (s *serviceSuite) TestUpdateSecretBackendFailed(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -1800,3 +1995,27 @@ func (s *serviceSuite) TestGetRevisionsToDrainExternal(c *tc.C) {
 		},
 	)
 }
+
+func (s *serviceSuite) TestAbortSecretBackendDrain(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	svc := NewModelSecretBackendService(modelUUID, s.mockState)
+
+	s.mockState.EXPECT().AbortSecretBackendDrain(gomock.Any(), modelUUID).Return(nil)
+
+	err := svc.AbortSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAbortSecretBackendDrainNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	svc := NewModelSecretBackendService(modelUUID, s.mockState)
+
+	s.mockState.EXPECT().AbortSecretBackendDrain(gomock.Any(), modelUUID).Return(secretbackenderrors.DrainNotFound)
+
+	err := svc.AbortSecretBackendDrain(c.Context())
+	c.Assert(err, tc.ErrorIs, secretbackenderrors.DrainNotFound)
+}
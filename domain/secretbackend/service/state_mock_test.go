@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/secretbackend/service (interfaces: State)
+// Source: github.com/juju/juju/domain/secretbackend/service (interfaces: State,EncryptionKeyState)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/secretbackend/service State
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/secretbackend/service State,EncryptionKeyState
 //
 
 // Package service is a generated GoMock package.
@@ -43,6 +43,83 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AbortSecretBackendDrain mocks base method.
+func (m *MockState) AbortSecretBackendDrain(arg0 context.Context, arg1 model.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortSecretBackendDrain", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortSecretBackendDrain indicates an expected call of AbortSecretBackendDrain.
+func (mr *MockStateMockRecorder) AbortSecretBackendDrain(arg0, arg1 any) *MockStateAbortSecretBackendDrainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortSecretBackendDrain", reflect.TypeOf((*MockState)(nil).AbortSecretBackendDrain), arg0, arg1)
+	return &MockStateAbortSecretBackendDrainCall{Call: call}
+}
+
+// MockStateAbortSecretBackendDrainCall wrap *gomock.Call
+type MockStateAbortSecretBackendDrainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAbortSecretBackendDrainCall) Return(arg0 error) *MockStateAbortSecretBackendDrainCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAbortSecretBackendDrainCall) Do(f func(context.Context, model.UUID) error) *MockStateAbortSecretBackendDrainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAbortSecretBackendDrainCall) DoAndReturn(f func(context.Context, model.UUID) error) *MockStateAbortSecretBackendDrainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountControllerNodes mocks base method.
+func (m *MockState) CountControllerNodes(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountControllerNodes", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountControllerNodes indicates an expected call of CountControllerNodes.
+func (mr *MockStateMockRecorder) CountControllerNodes(arg0 any) *MockStateCountControllerNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountControllerNodes", reflect.TypeOf((*MockState)(nil).CountControllerNodes), arg0)
+	return &MockStateCountControllerNodesCall{Call: call}
+}
+
+// MockStateCountControllerNodesCall wrap *gomock.Call
+type MockStateCountControllerNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCountControllerNodesCall) Return(arg0 int, arg1 error) *MockStateCountControllerNodesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCountControllerNodesCall) Do(f func(context.Context) (int, error)) *MockStateCountControllerNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCountControllerNodesCall) DoAndReturn(f func(context.Context) (int, error)) *MockStateCountControllerNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateSecretBackend mocks base method.
 func (m *MockState) CreateSecretBackend(arg0 context.Context, arg1 secretbackend.CreateSecretBackendParams) (string, error) {
 	m.ctrl.T.Helper()
@@ -360,6 +437,45 @@ func (c *MockStateInitialWatchStatementForSecretBackendRotationChangesCall) DoAn
 	return c
 }
 
+// ListSecretBackendDrains mocks base method.
+func (m *MockState) ListSecretBackendDrains(arg0 context.Context) ([]secretbackend.Drain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretBackendDrains", arg0)
+	ret0, _ := ret[0].([]secretbackend.Drain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretBackendDrains indicates an expected call of ListSecretBackendDrains.
+func (mr *MockStateMockRecorder) ListSecretBackendDrains(arg0 any) *MockStateListSecretBackendDrainsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretBackendDrains", reflect.TypeOf((*MockState)(nil).ListSecretBackendDrains), arg0)
+	return &MockStateListSecretBackendDrainsCall{Call: call}
+}
+
+// MockStateListSecretBackendDrainsCall wrap *gomock.Call
+type MockStateListSecretBackendDrainsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListSecretBackendDrainsCall) Return(arg0 []secretbackend.Drain, arg1 error) *MockStateListSecretBackendDrainsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListSecretBackendDrainsCall) Do(f func(context.Context) ([]secretbackend.Drain, error)) *MockStateListSecretBackendDrainsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListSecretBackendDrainsCall) DoAndReturn(f func(context.Context) ([]secretbackend.Drain, error)) *MockStateListSecretBackendDrainsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretBackendIDs mocks base method.
 func (m *MockState) ListSecretBackendIDs(arg0 context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -629,3 +745,181 @@ func (c *MockStateUpdateSecretBackendCall) DoAndReturn(f func(context.Context, s
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockEncryptionKeyState is a mock of EncryptionKeyState interface.
+type MockEncryptionKeyState struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncryptionKeyStateMockRecorder
+}
+
+// MockEncryptionKeyStateMockRecorder is the mock recorder for MockEncryptionKeyState.
+type MockEncryptionKeyStateMockRecorder struct {
+	mock *MockEncryptionKeyState
+}
+
+// NewMockEncryptionKeyState creates a new mock instance.
+func NewMockEncryptionKeyState(ctrl *gomock.Controller) *MockEncryptionKeyState {
+	mock := &MockEncryptionKeyState{ctrl: ctrl}
+	mock.recorder = &MockEncryptionKeyStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncryptionKeyState) EXPECT() *MockEncryptionKeyStateMockRecorder {
+	return m.recorder
+}
+
+// AddSecretEncryptionKey mocks base method.
+func (m *MockEncryptionKeyState) AddSecretEncryptionKey(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecretEncryptionKey indicates an expected call of AddSecretEncryptionKey.
+func (mr *MockEncryptionKeyStateMockRecorder) AddSecretEncryptionKey(arg0, arg1 any) *MockEncryptionKeyStateAddSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretEncryptionKey", reflect.TypeOf((*MockEncryptionKeyState)(nil).AddSecretEncryptionKey), arg0, arg1)
+	return &MockEncryptionKeyStateAddSecretEncryptionKeyCall{Call: call}
+}
+
+// MockEncryptionKeyStateAddSecretEncryptionKeyCall wrap *gomock.Call
+type MockEncryptionKeyStateAddSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEncryptionKeyStateAddSecretEncryptionKeyCall) Return(arg0 error) *MockEncryptionKeyStateAddSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEncryptionKeyStateAddSecretEncryptionKeyCall) Do(f func(context.Context, string) error) *MockEncryptionKeyStateAddSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEncryptionKeyStateAddSecretEncryptionKeyCall) DoAndReturn(f func(context.Context, string) error) *MockEncryptionKeyStateAddSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteInactiveSecretEncryptionKeys mocks base method.
+func (m *MockEncryptionKeyState) DeleteInactiveSecretEncryptionKeys(arg0 context.Context) ([]string, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInactiveSecretEncryptionKeys", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeleteInactiveSecretEncryptionKeys indicates an expected call of DeleteInactiveSecretEncryptionKeys.
+func (mr *MockEncryptionKeyStateMockRecorder) DeleteInactiveSecretEncryptionKeys(arg0 any) *MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInactiveSecretEncryptionKeys", reflect.TypeOf((*MockEncryptionKeyState)(nil).DeleteInactiveSecretEncryptionKeys), arg0)
+	return &MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall{Call: call}
+}
+
+// MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall wrap *gomock.Call
+type MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall) Return(arg0 []string, arg1 int, arg2 error) *MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall) Do(f func(context.Context) ([]string, int, error)) *MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall) DoAndReturn(f func(context.Context) ([]string, int, error)) *MockEncryptionKeyStateDeleteInactiveSecretEncryptionKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReleaseSecretEncryptionKeys mocks base method.
+func (m *MockEncryptionKeyState) ReleaseSecretEncryptionKeys(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSecretEncryptionKeys", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSecretEncryptionKeys indicates an expected call of ReleaseSecretEncryptionKeys.
+func (mr *MockEncryptionKeyStateMockRecorder) ReleaseSecretEncryptionKeys(arg0, arg1, arg2 any) *MockEncryptionKeyStateReleaseSecretEncryptionKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSecretEncryptionKeys", reflect.TypeOf((*MockEncryptionKeyState)(nil).ReleaseSecretEncryptionKeys), arg0, arg1, arg2)
+	return &MockEncryptionKeyStateReleaseSecretEncryptionKeysCall{Call: call}
+}
+
+// MockEncryptionKeyStateReleaseSecretEncryptionKeysCall wrap *gomock.Call
+type MockEncryptionKeyStateReleaseSecretEncryptionKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEncryptionKeyStateReleaseSecretEncryptionKeysCall) Return(arg0 error) *MockEncryptionKeyStateReleaseSecretEncryptionKeysCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEncryptionKeyStateReleaseSecretEncryptionKeysCall) Do(f func(context.Context, string, string) error) *MockEncryptionKeyStateReleaseSecretEncryptionKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEncryptionKeyStateReleaseSecretEncryptionKeysCall) DoAndReturn(f func(context.Context, string, string) error) *MockEncryptionKeyStateReleaseSecretEncryptionKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReserveActiveSecretEncryptionKey mocks base method.
+func (m *MockEncryptionKeyState) ReserveActiveSecretEncryptionKey(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveActiveSecretEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveActiveSecretEncryptionKey indicates an expected call of ReserveActiveSecretEncryptionKey.
+func (mr *MockEncryptionKeyStateMockRecorder) ReserveActiveSecretEncryptionKey(arg0, arg1 any) *MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveActiveSecretEncryptionKey", reflect.TypeOf((*MockEncryptionKeyState)(nil).ReserveActiveSecretEncryptionKey), arg0, arg1)
+	return &MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall{Call: call}
+}
+
+// MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall wrap *gomock.Call
+type MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall) Return(arg0 string, arg1 error) *MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall) Do(f func(context.Context, string) (string, error)) *MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockEncryptionKeyStateReserveActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
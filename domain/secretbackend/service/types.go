@@ -15,6 +15,9 @@ type SecretBackendInfo struct {
 	NumSecrets int
 	Status     string
 	Message    string
+
+	// Drains are the moves of model secret content to or from the backend.
+	Drains []secretbackend.Drain
 }
 
 // UpdateSecretBackendParams is used to update a secret backend.
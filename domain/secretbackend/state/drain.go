@@ -0,0 +1,292 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// drainStatusDraining is the secret_backend_drain_status id for a drain
+	// moving content to the model's newly configured backend.
+	drainStatusDraining = 0
+	// drainStatusReverting is the secret_backend_drain_status id for an
+	// aborted drain moving content back to the original backend.
+	drainStatusReverting = 1
+)
+
+// ListSecretBackendDrains returns the secret backend drains recorded for all
+// models, along with their progress.
+func (s *State) ListSecretBackendDrains(ctx context.Context) ([]secretbackend.Drain, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT d.model_uuid AS &secretBackendDrainRow.model_uuid,
+       m.name AS &secretBackendDrainRow.model_name,
+       d.source_backend_uuid AS &secretBackendDrainRow.source_backend_uuid,
+       src.name AS &secretBackendDrainRow.source_backend_name,
+       d.target_backend_uuid AS &secretBackendDrainRow.target_backend_uuid,
+       tgt.name AS &secretBackendDrainRow.target_backend_name,
+       ds.status AS &secretBackendDrainRow.status,
+       d.started_at AS &secretBackendDrainRow.started_at,
+       COUNT(r.secret_revision_uuid) AS &secretBackendDrainRow.total_revisions,
+       COUNT(CASE WHEN r.secret_backend_uuid = d.target_backend_uuid THEN 1 END) AS &secretBackendDrainRow.moved_revisions,
+       COUNT(dr.secret_revision_uuid) AS &secretBackendDrainRow.verified_revisions
+FROM   secret_backend_drain d
+JOIN   model m ON m.uuid = d.model_uuid
+JOIN   secret_backend src ON src.uuid = d.source_backend_uuid
+JOIN   secret_backend tgt ON tgt.uuid = d.target_backend_uuid
+JOIN   secret_backend_drain_status ds ON ds.id = d.status_id
+LEFT JOIN secret_backend_reference r ON r.model_uuid = d.model_uuid
+-- Only revisions drained to, and still stored in, the target are verified.
+LEFT JOIN secret_backend_drain_revision dr
+    ON dr.model_uuid = r.model_uuid
+    AND dr.secret_revision_uuid = r.secret_revision_uuid
+    AND dr.backend_uuid = r.secret_backend_uuid
+    AND dr.backend_uuid = d.target_backend_uuid
+GROUP BY d.model_uuid
+ORDER BY m.name`, secretBackendDrainRow{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretBackendDrainRow
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing secret backend drains: %w", err)
+	}
+
+	result := make([]secretbackend.Drain, len(rows))
+	for i, row := range rows {
+		result[i] = row.toDrain()
+	}
+	return result, nil
+}
+
+// AbortSecretBackendDrain reverts the model to the secret backend it was
+// using before its current drain started, and reverses the drain so that
+// content already moved is returned to that backend.
+// It returns an error satisfying [secretbackenderrors.DrainNotFound] if the
+// model has no drain, or [secretbackenderrors.Forbidden] if the drain is
+// already being reverted.
+func (s *State) AbortSecretBackendDrain(ctx context.Context, modelUUID coremodel.UUID) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	drain := secretBackendDrain{ModelID: modelUUID}
+	getStmt, err := s.Prepare(`
+SELECT &secretBackendDrain.*
+FROM   secret_backend_drain
+WHERE  model_uuid = $secretBackendDrain.model_uuid`, drain)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	modelBackendStmt, err := s.Prepare(`
+UPDATE model_secret_backend
+SET    secret_backend_uuid = $secretBackendDrain.target_backend_uuid
+WHERE  model_uuid = $secretBackendDrain.model_uuid`, drain)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	revertStmt, err := s.Prepare(`
+UPDATE secret_backend_drain
+SET    source_backend_uuid = $secretBackendDrain.source_backend_uuid,
+       target_backend_uuid = $secretBackendDrain.target_backend_uuid,
+       status_id = $secretBackendDrain.status_id,
+       started_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')
+WHERE  model_uuid = $secretBackendDrain.model_uuid`, drain)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, getStmt, drain).Get(&drain)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("model %q: %w", modelUUID, secretbackenderrors.DrainNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting secret backend drain for model %q: %w", modelUUID, err)
+		}
+		if drain.StatusID == drainStatusReverting {
+			return errors.Errorf(
+				"secret backend drain for model %q is already being reverted", modelUUID,
+			).Add(secretbackenderrors.Forbidden)
+		}
+
+		reverted := secretBackendDrain{
+			ModelID:         modelUUID,
+			SourceBackendID: drain.TargetBackendID,
+			TargetBackendID: drain.SourceBackendID,
+			StatusID:        drainStatusReverting,
+		}
+		if err := tx.Query(ctx, modelBackendStmt, reverted).Run(); err != nil {
+			return errors.Errorf("reverting secret backend for model %q: %w", modelUUID, err)
+		}
+		if err := s.removeSecretBackendDrainRevisions(ctx, tx, modelUUID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, revertStmt, reverted).Run(); err != nil {
+			return errors.Errorf("reverting secret backend drain for model %q: %w", modelUUID, err)
+		}
+		return nil
+	})
+}
+
+// RecordSecretRevisionDrained records the checksum of a secret revision whose
+// content has been copied to, and verified in, the target backend of the
+// model's drain. If the model has no drain, this is a no-op.
+func (s *State) RecordSecretRevisionDrained(
+	ctx context.Context, modelUUID coremodel.UUID, revisionUUID, checksum string,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	input := secretBackendDrainRevision{
+		ModelID:          modelUUID,
+		SecretRevisionID: revisionUUID,
+		Checksum:         checksum,
+	}
+	stmt, err := s.Prepare(`
+INSERT INTO secret_backend_drain_revision (model_uuid, secret_revision_uuid, backend_uuid, checksum)
+SELECT d.model_uuid,
+       $secretBackendDrainRevision.secret_revision_uuid,
+       d.target_backend_uuid,
+       $secretBackendDrainRevision.checksum
+FROM   secret_backend_drain d
+WHERE  d.model_uuid = $secretBackendDrainRevision.model_uuid
+ON CONFLICT (model_uuid, secret_revision_uuid) DO UPDATE SET
+    backend_uuid = excluded.backend_uuid,
+    checksum = excluded.checksum`, input)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, input).Run()
+	})
+	if err != nil {
+		return errors.Errorf("recording drained secret revision %q: %w", revisionUUID, err)
+	}
+	return nil
+}
+
+// startSecretBackendDrain records a drain of the model's secret content from
+// its current backend to the input backend, replacing any previous drain.
+// If the model already uses the input backend, no drain is recorded.
+func (s *State) startSecretBackendDrain(
+	ctx context.Context, tx *sqlair.TX, modelUUID coremodel.UUID, targetBackendID string,
+) error {
+	current := ModelSecretBackend{ModelID: modelUUID}
+	currentStmt, err := s.Prepare(`
+SELECT secret_backend_uuid AS &ModelSecretBackend.secret_backend_uuid
+FROM   model_secret_backend
+WHERE  model_uuid = $ModelSecretBackend.uuid`, current)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = tx.Query(ctx, currentStmt, current).Get(&current)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		// The model does not exist; this is reported when its backend is
+		// updated.
+		return nil
+	} else if err != nil {
+		return errors.Errorf("getting secret backend for model %q: %w", modelUUID, err)
+	}
+	if current.SecretBackendID == targetBackendID {
+		return nil
+	}
+
+	drain := secretBackendDrain{
+		ModelID:         modelUUID,
+		SourceBackendID: current.SecretBackendID,
+		TargetBackendID: targetBackendID,
+		StatusID:        drainStatusDraining,
+	}
+	upsertStmt, err := s.Prepare(`
+INSERT INTO secret_backend_drain (model_uuid, source_backend_uuid, target_backend_uuid, status_id)
+VALUES ($secretBackendDrain.*)
+ON CONFLICT (model_uuid) DO UPDATE SET
+    source_backend_uuid = excluded.source_backend_uuid,
+    target_backend_uuid = excluded.target_backend_uuid,
+    status_id = excluded.status_id,
+    started_at = excluded.started_at`, drain)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := s.removeSecretBackendDrainRevisions(ctx, tx, modelUUID); err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, upsertStmt, drain).Run(); err != nil {
+		return errors.Errorf("recording secret backend drain for model %q: %w", modelUUID, err)
+	}
+	return nil
+}
+
+// removeSecretBackendDrainRevisions removes the drained revision records for
+// the input model.
+func (s *State) removeSecretBackendDrainRevisions(ctx context.Context, tx *sqlair.TX, modelUUID coremodel.UUID) error {
+	input := secretBackendDrain{ModelID: modelUUID}
+	stmt, err := s.Prepare(`
+DELETE FROM secret_backend_drain_revision
+WHERE  model_uuid = $secretBackendDrain.model_uuid`, input)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, stmt, input).Run(); err != nil {
+		return errors.Errorf("removing drained secret revisions for model %q: %w", modelUUID, err)
+	}
+	return nil
+}
+
+// removeSecretBackendDrainsForBackend removes any drains to or from the input
+// backend, along with their drained revision records.
+func (s *State) removeSecretBackendDrainsForBackend(ctx context.Context, tx *sqlair.TX, backendID string) error {
+	input := secretBackendDrain{SourceBackendID: backendID}
+	revisionsStmt, err := s.Prepare(`
+DELETE FROM secret_backend_drain_revision
+WHERE  model_uuid IN (
+    SELECT model_uuid
+    FROM   secret_backend_drain
+    WHERE  source_backend_uuid = $secretBackendDrain.source_backend_uuid
+    OR     target_backend_uuid = $secretBackendDrain.source_backend_uuid
+)`, input)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	drainsStmt, err := s.Prepare(`
+DELETE FROM secret_backend_drain
+WHERE  source_backend_uuid = $secretBackendDrain.source_backend_uuid
+OR     target_backend_uuid = $secretBackendDrain.source_backend_uuid`, input)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := tx.Query(ctx, revisionsStmt, input).Run(); err != nil {
+		return errors.Errorf("removing drained secret revisions for secret backend %q: %w", backendID, err)
+	}
+	if err := tx.Query(ctx, drainsStmt, input).Run(); err != nil {
+		return errors.Errorf("removing secret backend drains for secret backend %q: %w", backendID, err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/tc"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// createTargetBackend adds a vault backend named "target-backend" to drain
+// secrets to, returning its ID.
+func (s *stateSuite) createTargetBackend(c *tc.C) string {
+	backendID := uuid.MustNewUUID().String()
+	_, err := s.state.CreateSecretBackend(c.Context(), secretbackend.CreateSecretBackendParams{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   backendID,
+			Name: "target-backend",
+		},
+		BackendType: "vault",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return backendID
+}
+
+// addRevisionReference records a secret revision stored in the input
+// backend, returning the revision ID.
+func (s *stateSuite) addRevisionReference(c *tc.C, modelUUID coremodel.UUID, backendID string) string {
+	revisionID := uuid.MustNewUUID().String()
+	_, err := s.state.AddSecretBackendReference(
+		c.Context(), &secrets.ValueRef{BackendID: backendID}, modelUUID, revisionID, "secret-id")
+	c.Assert(err, tc.ErrorIsNil)
+	return revisionID
+}
+
+func (s *stateSuite) TestSetModelSecretBackendStartsDrain(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	targetID := s.createTargetBackend(c)
+	s.addRevisionReference(c, modelUUID, s.vaultBackendID)
+	s.addRevisionReference(c, modelUUID, s.vaultBackendID)
+
+	err := s.state.SetModelSecretBackend(c.Context(), modelUUID, "target-backend")
+	c.Assert(err, tc.ErrorIsNil)
+
+	drains, err := s.state.ListSecretBackendDrains(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(drains, tc.HasLen, 1)
+	c.Check(drains[0].StartedAt.IsZero(), tc.IsFalse)
+	drains[0].StartedAt = drains[0].StartedAt.UTC()
+	c.Check(drains[0], tc.DeepEquals, secretbackend.Drain{
+		ModelUUID:         modelUUID,
+		ModelName:         "my-model",
+		SourceBackendID:   s.vaultBackendID,
+		SourceBackendName: "my-backend",
+		TargetBackendID:   targetID,
+		TargetBackendName: "target-backend",
+		Status:            secretbackend.DrainStatusDraining,
+		StartedAt:         drains[0].StartedAt,
+		TotalRevisions:    2,
+	})
+}
+
+func (s *stateSuite) TestSetModelSecretBackendUnchangedNoDrain(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	err := s.state.SetModelSecretBackend(c.Context(), modelUUID, "my-backend")
+	c.Assert(err, tc.ErrorIsNil)
+
+	drains, err := s.state.ListSecretBackendDrains(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(drains, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestListSecretBackendDrainsProgress(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	targetID := s.createTargetBackend(c)
+	first := s.addRevisionReference(c, modelUUID, s.vaultBackendID)
+	s.addRevisionReference(c, modelUUID, s.vaultBackendID)
+
+	err := s.state.SetModelSecretBackend(c.Context(), modelUUID, "target-backend")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.state.UpdateSecretBackendReference(
+		c.Context(), &secrets.ValueRef{BackendID: targetID}, modelUUID, first, "secret-id")
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordSecretRevisionDrained(c.Context(), modelUUID, first, "checksum")
+	c.Assert(err, tc.ErrorIsNil)
+
+	drains, err := s.state.ListSecretBackendDrains(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(drains, tc.HasLen, 1)
+	c.Check(drains[0].Status, tc.Equals, secretbackend.DrainStatusDraining)
+	c.Check(drains[0].TotalRevisions, tc.Equals, 2)
+	c.Check(drains[0].MovedRevisions, tc.Equals, 1)
+	c.Check(drains[0].VerifiedRevisions, tc.Equals, 1)
+}
+
+func (s *stateSuite) TestListSecretBackendDrainsComplete(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	targetID := s.createTargetBackend(c)
+	revisionID := s.addRevisionReference(c, modelUUID, s.vaultBackendID)
+
+	err := s.state.SetModelSecretBackend(c.Context(), modelUUID, "target-backend")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.UpdateSecretBackendReference(
+		c.Context(), &secrets.ValueRef{BackendID: targetID}, modelUUID, revisionID, "secret-id")
+	c.Assert(err, tc.ErrorIsNil)
+
+	drains, err := s.state.ListSecretBackendDrains(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(drains, tc.HasLen, 1)
+	c.Check(drains[0].Status, tc.Equals, secretbackend.DrainStatusComplete)
+	c.Check(drains[0].MovedRevisions, tc.Equals, 1)
+	c.Check(drains[0].VerifiedRevisions, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestRecordSecretRevisionDrainedNoDrain(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	revisionID := s.addRevisionReference(c, modelUUID, s.vaultBackendID)
+
+	err := s.state.RecordSecretRevisionDrained(c.Context(), modelUUID, revisionID, "checksum")
+	c.Assert(err, tc.ErrorIsNil)
+
+	var count int
+	err = s.DB().QueryRow(`SELECT COUNT(*) FROM secret_backend_drain_revision`).Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestAbortSecretBackendDrain(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	targetID := s.createTargetBackend(c)
+	revisionID := s.addRevisionReference(c, modelUUID, s.vaultBackendID)
+
+	err := s.state.SetModelSecretBackend(c.Context(), modelUUID, "target-backend")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.UpdateSecretBackendReference(
+		c.Context(), &secrets.ValueRef{BackendID: targetID}, modelUUID, revisionID, "secret-id")
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordSecretRevisionDrained(c.Context(), modelUUID, revisionID, "checksum")
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.AbortSecretBackendDrain(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	details, err := s.state.GetModelSecretBackendDetails(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(details.SecretBackendID, tc.Equals, s.vaultBackendID)
+
+	drains, err := s.state.ListSecretBackendDrains(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(drains, tc.HasLen, 1)
+	c.Check(drains[0].SourceBackendID, tc.Equals, targetID)
+	c.Check(drains[0].TargetBackendID, tc.Equals, s.vaultBackendID)
+	c.Check(drains[0].Status, tc.Equals, secretbackend.DrainStatusReverting)
+	c.Check(drains[0].MovedRevisions, tc.Equals, 0)
+	c.Check(drains[0].VerifiedRevisions, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestAbortSecretBackendDrainAlreadyReverting(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	s.createTargetBackend(c)
+
+	err := s.state.SetModelSecretBackend(c.Context(), modelUUID, "target-backend")
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.AbortSecretBackendDrain(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.AbortSecretBackendDrain(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIs, backenderrors.Forbidden)
+}
+
+func (s *stateSuite) TestAbortSecretBackendDrainNotFound(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	err := s.state.AbortSecretBackendDrain(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIs, backenderrors.DrainNotFound)
+}
+
+func (s *stateSuite) TestDeleteSecretBackendRemovesDrains(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	s.createTargetBackend(c)
+
+	err := s.state.SetModelSecretBackend(c.Context(), modelUUID, "target-backend")
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.DeleteSecretBackend(c.Context(), secretbackend.BackendIdentifier{Name: "target-backend"}, true)
+	c.Assert(err, tc.ErrorIsNil)
+
+	drains, err := s.state.ListSecretBackendDrains(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(drains, tc.HasLen, 0)
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetActiveSecretEncryptionKey returns the UUID of the key-encryption key
+// used to wrap new model data keys, returning an error satisfying
+// [secretbackenderrors.EncryptionKeyNotFound] if there is no active key.
+func (s *State) GetActiveSecretEncryptionKey(ctx context.Context) (string, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &SecretEncryptionKey.*
+FROM   secret_encryption_key
+WHERE  active = TRUE`, SecretEncryptionKey{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var key SecretEncryptionKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).Get(&key)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.New("no active secret encryption key").Add(secretbackenderrors.EncryptionKeyNotFound)
+		} else if err != nil {
+			return errors.Errorf("querying active secret encryption key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return key.UUID, nil
+}
+
+// AddSecretEncryptionKey records the key-encryption key with the input UUID
+// and makes it the active key. The previously active key is retained so that
+// data keys wrapped with it can still be unwrapped.
+func (s *State) AddSecretEncryptionKey(ctx context.Context, keyUUID string) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	deactivateStmt, err := s.Prepare(`
+UPDATE secret_encryption_key
+SET    active = FALSE
+WHERE  active = TRUE`)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertStmt, err := s.Prepare(`
+INSERT INTO secret_encryption_key (uuid, active)
+VALUES ($SecretEncryptionKey.*)`, SecretEncryptionKey{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, deactivateStmt).Run(); err != nil {
+			return errors.Errorf("deactivating secret encryption key: %w", err)
+		}
+		err := tx.Query(ctx, insertStmt, SecretEncryptionKey{
+			UUID:   keyUUID,
+			Active: true,
+		}).Run()
+		if err != nil {
+			return errors.Errorf("inserting secret encryption key: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReserveActiveSecretEncryptionKey returns the UUID of the key-encryption key
+// used to wrap new model data keys, recording that the model with the input UUID
+// references it so that it is retained once retired. It returns an error
+// satisfying [secretbackenderrors.EncryptionKeyNotFound] if there is no
+// active key.
+func (s *State) ReserveActiveSecretEncryptionKey(ctx context.Context, modelUUID string) (string, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	activeStmt, err := s.Prepare(`
+SELECT &SecretEncryptionKey.*
+FROM   secret_encryption_key
+WHERE  active = TRUE`, SecretEncryptionKey{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	referenceStmt, err := s.Prepare(`
+INSERT INTO secret_encryption_key_reference (model_uuid, key_encryption_key_uuid)
+VALUES ($secretEncryptionKeyReference.*)
+ON CONFLICT DO NOTHING`, secretEncryptionKeyReference{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var key SecretEncryptionKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, activeStmt).Get(&key)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.New("no active secret encryption key").Add(secretbackenderrors.EncryptionKeyNotFound)
+		} else if err != nil {
+			return errors.Errorf("querying active secret encryption key: %w", err)
+		}
+
+		err = tx.Query(ctx, referenceStmt, secretEncryptionKeyReference{
+			ModelUUID:            modelUUID,
+			KeyEncryptionKeyUUID: key.UUID,
+		}).Run()
+		if err != nil {
+			return errors.Errorf("inserting secret encryption key reference: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return key.UUID, nil
+}
+
+// ReleaseSecretEncryptionKeys removes the references of the model with the
+// input UUID to key-encryption keys other than the input key and the active
+// key. It is called once the model's data key is wrapped by the input key.
+func (s *State) ReleaseSecretEncryptionKeys(ctx context.Context, modelUUID, keyUUID string) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ref := secretEncryptionKeyReference{
+		ModelUUID:            modelUUID,
+		KeyEncryptionKeyUUID: keyUUID,
+	}
+	stmt, err := s.Prepare(`
+DELETE FROM secret_encryption_key_reference
+WHERE  model_uuid = $secretEncryptionKeyReference.model_uuid
+AND    key_encryption_key_uuid != $secretEncryptionKeyReference.key_encryption_key_uuid
+AND    key_encryption_key_uuid NOT IN (
+    SELECT uuid FROM secret_encryption_key WHERE active = TRUE
+)`, ref)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, ref).Run(); err != nil {
+			return errors.Errorf("deleting secret encryption key references: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteInactiveSecretEncryptionKeys removes the key-encryption keys other
+// than the active one which no model references, returning the UUIDs of the
+// removed keys and the number of inactive keys retained because a model data
+// key may still be wrapped by them. References held by models which no longer
+// exist are removed first.
+func (s *State) DeleteInactiveSecretEncryptionKeys(ctx context.Context) ([]string, int, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+
+	deleteReferencesStmt, err := s.Prepare(`
+DELETE FROM secret_encryption_key_reference
+WHERE  model_uuid NOT IN (SELECT uuid FROM model)`)
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+
+	unreferencedStmt, err := s.Prepare(`
+SELECT &entityUUID.*
+FROM   secret_encryption_key
+WHERE  active = FALSE
+AND    uuid NOT IN (
+    SELECT key_encryption_key_uuid FROM secret_encryption_key_reference
+)`, entityUUID{})
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+
+	deleteKeysStmt, err := s.Prepare(`
+DELETE FROM secret_encryption_key
+WHERE  active = FALSE
+AND    uuid NOT IN (
+    SELECT key_encryption_key_uuid FROM secret_encryption_key_reference
+)`)
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+
+	countStmt, err := s.Prepare(`
+SELECT COUNT(*) AS &count.count
+FROM   secret_encryption_key
+WHERE  active = FALSE`, count{})
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+
+	var (
+		deleted  []string
+		retained count
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, deleteReferencesStmt).Run(); err != nil {
+			return errors.Errorf("deleting secret encryption key references of removed models: %w", err)
+		}
+		var unreferenced []entityUUID
+		err := tx.Query(ctx, unreferencedStmt).GetAll(&unreferenced)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying unreferenced secret encryption keys: %w", err)
+		}
+		deleted = make([]string, len(unreferenced))
+		for i, key := range unreferenced {
+			deleted[i] = key.UUID
+		}
+		if err := tx.Query(ctx, deleteKeysStmt).Run(); err != nil {
+			return errors.Errorf("deleting inactive secret encryption keys: %w", err)
+		}
+		if err := tx.Query(ctx, countStmt).Get(&retained); err != nil {
+			return errors.Errorf("counting retained secret encryption keys: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+	return deleted, retained.Count, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	coremodel "github.com/juju/juju/core/model"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type encryptionKeySuite struct {
+	schematesting.ControllerSuite
+
+	state *State
+}
+
+func TestEncryptionKeySuite(t *testing.T) {
+	tc.Run(t, &encryptionKeySuite{})
+}
+
+func (s *encryptionKeySuite) SetUpTest(c *tc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+}
+
+func (s *encryptionKeySuite) TestGetActiveSecretEncryptionKeyNotFound(c *tc.C) {
+	_, err := s.state.GetActiveSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+}
+
+func (s *encryptionKeySuite) TestAddSecretEncryptionKey(c *tc.C) {
+	err := s.state.AddSecretEncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIsNil)
+
+	active, err := s.state.GetActiveSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(active, tc.Equals, "key-1")
+
+	err = s.state.AddSecretEncryptionKey(c.Context(), "key-2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	active, err = s.state.GetActiveSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(active, tc.Equals, "key-2")
+
+	// Only the key UUID is recorded in the database.
+	var columns []string
+	rows, err := s.DB().Query("SELECT name FROM pragma_table_info('secret_encryption_key')")
+	c.Assert(err, tc.ErrorIsNil)
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		c.Assert(rows.Scan(&name), tc.ErrorIsNil)
+		columns = append(columns, name)
+	}
+	c.Assert(rows.Err(), tc.ErrorIsNil)
+	c.Check(columns, tc.SameContents, []string{"uuid", "active", "created_at"})
+}
+
+func (s *encryptionKeySuite) TestDeleteInactiveSecretEncryptionKeys(c *tc.C) {
+	err := s.state.AddSecretEncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.AddSecretEncryptionKey(c.Context(), "key-2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	deleted, retained, err := s.state.DeleteInactiveSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.DeepEquals, []string{"key-1"})
+	c.Check(retained, tc.Equals, 0)
+
+	active, err := s.state.GetActiveSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(active, tc.Equals, "key-2")
+}
+
+func (s *encryptionKeySuite) TestReserveActiveSecretEncryptionKeyNotFound(c *tc.C) {
+	_, err := s.state.ReserveActiveSecretEncryptionKey(c.Context(), "model-uuid")
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+}
+
+func (s *encryptionKeySuite) TestDeleteInactiveSecretEncryptionKeysRemovedModel(c *tc.C) {
+	err := s.state.AddSecretEncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.ReserveActiveSecretEncryptionKey(c.Context(), "removed-model-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.AddSecretEncryptionKey(c.Context(), "key-2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// References held by models which no longer exist do not retain keys.
+	deleted, retained, err := s.state.DeleteInactiveSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.DeepEquals, []string{"key-1"})
+	c.Check(retained, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestDeleteInactiveSecretEncryptionKeysImportingModel(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	// A model being imported is not yet activated.
+	_, err := s.DB().Exec("UPDATE model SET activated = FALSE WHERE uuid = ?", modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.AddSecretEncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.ReserveActiveSecretEncryptionKey(c.Context(), modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.AddSecretEncryptionKey(c.Context(), "key-2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	deleted, retained, err := s.state.DeleteInactiveSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.HasLen, 0)
+	c.Check(retained, tc.Equals, 1)
+}
+
+func (s *stateSuite) TestDeleteInactiveSecretEncryptionKeysRacingFirstWrite(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	// A first secret write reserves the active key before the rotation,
+	// but has not committed its data key when the rotation completes.
+	err := s.state.AddSecretEncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIsNil)
+	reserved, err := s.state.ReserveActiveSecretEncryptionKey(c.Context(), modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(reserved, tc.Equals, "key-1")
+	err = s.state.AddSecretEncryptionKey(c.Context(), "key-2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	deleted, retained, err := s.state.DeleteInactiveSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.HasLen, 0)
+	c.Check(retained, tc.Equals, 1)
+
+	// Once the data key is rewrapped with the active key, the retired key
+	// is released and can be removed.
+	_, err = s.state.ReserveActiveSecretEncryptionKey(c.Context(), modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.ReleaseSecretEncryptionKeys(c.Context(), modelUUID.String(), "key-2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	deleted, retained, err = s.state.DeleteInactiveSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.DeepEquals, []string{"key-1"})
+	c.Check(retained, tc.Equals, 0)
+}
@@ -370,6 +370,9 @@ DELETE FROM secret_backend WHERE uuid = $SecretBackend.uuid`, input)
 		if err := s.removeSecretBackendReferenceForBackend(ctx, tx, input.ID); err != nil {
 			return errors.Errorf("removing secret backend reference for %q: %w", input.ID, err)
 		}
+		if err := s.removeSecretBackendDrainsForBackend(ctx, tx, input.ID); err != nil {
+			return errors.Capture(err)
+		}
 		err = tx.Query(ctx, backendStmt, input).Run()
 		if err != nil {
 			return errors.Errorf("deleting secret backend for %q: %w", input.ID, err)
@@ -921,7 +924,8 @@ WHERE uuid = $M.uuid`, sqlair.M{}, SecretBackendRotationRow{})
 	return err
 }
 
-// SetModelSecretBackend sets the secret backend for the given model,
+// SetModelSecretBackend sets the secret backend for the given model, recording
+// a drain of the model's secret content from its previous backend,
 // returning an error satisfying [secretbackenderrors.NotFound] if the backend provided does not exist,
 // returning an error satisfying [modelerrors.NotFound] if the model provided does not exist.
 func (s *State) SetModelSecretBackend(ctx context.Context, modelUUID coremodel.UUID, secretBackendName string) error {
@@ -963,6 +967,10 @@ WHERE  model_uuid = $ModelSecretBackend.uuid`
 			return errors.Errorf("cannot get secret backend %q: %w", backendInfo.SecretBackendName, err)
 		}
 
+		if err := s.startSecretBackendDrain(ctx, tx, modelUUID, backendInfo.SecretBackendID); err != nil {
+			return errors.Capture(err)
+		}
+
 		var outcome sqlair.Outcome
 		err = tx.Query(ctx, modelBackendUpdateStmt, backendInfo).Get(&outcome)
 		if err != nil {
@@ -1007,6 +1015,29 @@ WHERE  secret_backend_uuid = $SecretBackendReference.secret_backend_uuid`, input
 	return result.Num, nil
 }
 
+// CountControllerNodes returns the number of controller nodes.
+func (s *State) CountControllerNodes(ctx context.Context) (int, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+	result := Count{}
+	stmt, err := s.Prepare(`
+SELECT COUNT(*) AS &Count.num
+FROM   controller_node`, result)
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).Get(&result)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return -1, errors.Errorf("cannot count controller nodes: %w", err)
+	}
+	return result.Num, nil
+}
+
 // AddSecretBackendReference adds a reference to the secret backend for the given secret revision, returning an error
 // satisfying [secretbackenderrors.NotFound] if the secret backend does not exist,
 // or [modelerrors.NotFound] if the model does not exist,
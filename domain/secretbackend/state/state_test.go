@@ -1842,3 +1842,22 @@ func (s *stateSuite) TestGetSecretBackendNamesByUUIDEmpty(c *tc.C) {
 	c.Assert(err, tc.IsNil)
 	c.Assert(names, tc.HasLen, 0)
 }
+
+func (s *stateSuite) TestCountControllerNodes(c *tc.C) {
+	// The bootstrap controller node is added when the database is created.
+	count, err := s.state.CountControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 1)
+
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, txn *sql.Tx) error {
+		_, err := txn.ExecContext(ctx, `
+INSERT INTO controller_node (controller_id, dqlite_node_id, dqlite_bind_address)
+VALUES ('1', '2', '10.0.0.2'), ('2', '3', '10.0.0.3')`)
+		return errors.Capture(err)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	count, err = s.state.CountControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 3)
+}
@@ -423,3 +423,81 @@ type Count struct {
 type entityUUID struct {
 	UUID string `db:"uuid"`
 }
+
+// SecretEncryptionKey represents a single row from the state database's
+// secret_encryption_key table.
+type SecretEncryptionKey struct {
+	// UUID is the unique identifier for the key.
+	UUID string `db:"uuid"`
+	// Active indicates whether the key is used to wrap new data keys.
+	Active bool `db:"active"`
+}
+
+// secretEncryptionKeyReference represents a single row from the state
+// database's secret_encryption_key_reference table.
+type secretEncryptionKeyReference struct {
+	// ModelUUID is the UUID of the referencing model.
+	ModelUUID string `db:"model_uuid"`
+	// KeyEncryptionKeyUUID is the UUID of the referenced key.
+	KeyEncryptionKeyUUID string `db:"key_encryption_key_uuid"`
+}
+
+// count is a helper struct to hold the result of a COUNT query.
+type count struct {
+	Count int `db:"count"`
+}
+
+// secretBackendDrain represents a single row from the state database's
+// secret_backend_drain table.
+type secretBackendDrain struct {
+	ModelID         coremodel.UUID `db:"model_uuid"`
+	SourceBackendID string         `db:"source_backend_uuid"`
+	TargetBackendID string         `db:"target_backend_uuid"`
+	StatusID        int            `db:"status_id"`
+}
+
+// secretBackendDrainRevision represents a single row from the state
+// database's secret_backend_drain_revision table.
+type secretBackendDrainRevision struct {
+	ModelID          coremodel.UUID `db:"model_uuid"`
+	SecretRevisionID string         `db:"secret_revision_uuid"`
+	BackendID        string         `db:"backend_uuid"`
+	Checksum         string         `db:"checksum"`
+}
+
+// secretBackendDrainRow represents a drain joined with its model and backend
+// names, and the progress of the drain.
+type secretBackendDrainRow struct {
+	ModelID           coremodel.UUID `db:"model_uuid"`
+	ModelName         string         `db:"model_name"`
+	SourceBackendID   string         `db:"source_backend_uuid"`
+	SourceBackendName string         `db:"source_backend_name"`
+	TargetBackendID   string         `db:"target_backend_uuid"`
+	TargetBackendName string         `db:"target_backend_name"`
+	Status            string         `db:"status"`
+	StartedAt         time.Time      `db:"started_at"`
+	TotalRevisions    int            `db:"total_revisions"`
+	MovedRevisions    int            `db:"moved_revisions"`
+	VerifiedRevisions int            `db:"verified_revisions"`
+}
+
+// toDrain returns the drain described by the row.
+func (r secretBackendDrainRow) toDrain() secretbackend.Drain {
+	status := secretbackend.DrainStatus(r.Status)
+	if r.MovedRevisions == r.TotalRevisions {
+		status = secretbackend.DrainStatusComplete
+	}
+	return secretbackend.Drain{
+		ModelUUID:         r.ModelID,
+		ModelName:         r.ModelName,
+		SourceBackendID:   r.SourceBackendID,
+		SourceBackendName: r.SourceBackendName,
+		TargetBackendID:   r.TargetBackendID,
+		TargetBackendName: r.TargetBackendName,
+		Status:            status,
+		StartedAt:         r.StartedAt,
+		TotalRevisions:    r.TotalRevisions,
+		MovedRevisions:    r.MovedRevisions,
+		VerifiedRevisions: r.VerifiedRevisions,
+	}
+}
@@ -4,6 +4,8 @@
 package secretbackend
 
 import (
+	"time"
+
 	coremodel "github.com/juju/juju/core/model"
 )
 
@@ -22,3 +24,63 @@ type ModelSecretBackend struct {
 	// SecretBackendName is the name of the secret backend configured for the model.
 	SecretBackendName string
 }
+
+// EncryptionKey is a controller key-encryption key. Each model encrypts the
+// secret content held in the internal backend with its own data key, which is
+// stored wrapped by an encryption key.
+type EncryptionKey struct {
+	// UUID uniquely identifies the key.
+	UUID string
+	// Material is the raw key material.
+	Material []byte
+}
+
+// DrainStatus describes the progress of a drain of secret content between
+// backends.
+type DrainStatus string
+
+const (
+	// DrainStatusDraining indicates that content is being moved to the
+	// model's newly configured secret backend.
+	DrainStatusDraining DrainStatus = "draining"
+	// DrainStatusReverting indicates that the drain was aborted and content
+	// is being moved back to the original backend.
+	DrainStatusReverting DrainStatus = "reverting"
+	// DrainStatusComplete indicates that all of the model's secret content
+	// is stored in the target backend.
+	DrainStatusComplete DrainStatus = "complete"
+)
+
+// Drain describes the move of a model's secret content from one backend to
+// another.
+type Drain struct {
+	// ModelUUID is the unique identifier for the model being drained.
+	ModelUUID coremodel.UUID
+	// ModelName is the name of the model being drained.
+	ModelName string
+	// SourceBackendID is the unique identifier of the backend content is
+	// being moved from.
+	SourceBackendID string
+	// SourceBackendName is the name of the backend content is being moved
+	// from.
+	SourceBackendName string
+	// TargetBackendID is the unique identifier of the backend content is
+	// being moved to.
+	TargetBackendID string
+	// TargetBackendName is the name of the backend content is being moved
+	// to.
+	TargetBackendName string
+	// Status is the progress of the drain.
+	Status DrainStatus
+	// StartedAt is when the drain began.
+	StartedAt time.Time
+	// TotalRevisions is the number of secret revisions in the model.
+	TotalRevisions int
+	// MovedRevisions is the number of secret revisions stored in the target
+	// backend.
+	MovedRevisions int
+	// VerifiedRevisions is the number of secret revisions copied to the
+	// target backend during the drain whose content was verified against the
+	// source.
+	VerifiedRevisions int
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/domain"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
+	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
+	"github.com/juju/juju/internal/secrets/encryption"
 )
 
 // serviceFactoryBase is the foundation for all service factories.
@@ -14,6 +17,7 @@ import (
 // backed by the controller database.
 type serviceFactoryBase struct {
 	controllerDB changestream.WatchableDBFactory
+	dataDir      string
 	logger       logger.Logger
 }
 
@@ -24,6 +28,15 @@ func (s *serviceFactoryBase) controllerWatcherFactory(childLogName string) *doma
 	)
 }
 
+// secretEncryptionKeys returns the service for the controller key-encryption
+// keys, whose material is held beneath the agent data directory.
+func (s *serviceFactoryBase) secretEncryptionKeys(log logger.Logger) *secretbackendservice.EncryptionKeyService {
+	return secretbackendservice.NewEncryptionKeyService(
+		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
+		encryption.NewFileKeyStore(s.dataDir),
+	)
+}
+
 // modelServiceFactoryBase is the foundation for model-scoped service factories.
 // It includes the ability to supply runners and watchers backed by a model
 // database in addition to those backed by the controller database.
@@ -19,6 +19,8 @@ import (
 	agentbinarystate "github.com/juju/juju/domain/agentbinary/state/controller"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
 	autocertcachestate "github.com/juju/juju/domain/autocert/state"
+	backupservice "github.com/juju/juju/domain/backup/service"
+	backupstate "github.com/juju/juju/domain/backup/state"
 	changestreamservice "github.com/juju/juju/domain/changestream/service"
 	changestreamstate "github.com/juju/juju/domain/changestream/state"
 	cloudservice "github.com/juju/juju/domain/cloud/service"
@@ -38,11 +40,14 @@ import (
 	macaroonservice "github.com/juju/juju/domain/macaroon/service"
 	macaroonstate "github.com/juju/juju/domain/macaroon/state"
 	modelservice "github.com/juju/juju/domain/model/service"
+	modelimportservice "github.com/juju/juju/domain/model/service/migration"
 	statecontroller "github.com/juju/juju/domain/model/state/controller"
 	modeldefaultsservice "github.com/juju/juju/domain/modeldefaults/service"
 	modeldefaultsstate "github.com/juju/juju/domain/modeldefaults/state"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
+	sshrecordingservice "github.com/juju/juju/domain/sshrecording/service"
+	sshrecordingstate "github.com/juju/juju/domain/sshrecording/state"
 	tracingservice "github.com/juju/juju/domain/tracing/service"
 	tracingstate "github.com/juju/juju/domain/tracing/state"
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
@@ -64,6 +69,7 @@ type ControllerServices struct {
 func NewControllerServices(
 	controllerDB changestream.WatchableDBFactory,
 	controllerObjectStoreGetter objectstore.NamespacedObjectStoreGetter,
+	dataDir string,
 	clock clock.Clock,
 	logger logger.Logger,
 	loggerContextGetter logger.LoggerContextGetter,
@@ -71,6 +77,7 @@ func NewControllerServices(
 	return &ControllerServices{
 		serviceFactoryBase: serviceFactoryBase{
 			controllerDB: controllerDB,
+			dataDir:      dataDir,
 			logger:       logger,
 		},
 		controllerObjectStore: controllerObjectStoreGetter,
@@ -118,6 +125,15 @@ func (s *ControllerServices) Model() *modelservice.WatchableService {
 	)
 }
 
+// ModelImport returns the service for creating the controller records of
+// models imported from an archive.
+func (s *ControllerServices) ModelImport() *modelimportservice.MigrationService {
+	return modelimportservice.NewMigrationService(
+		statecontroller.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.logger.Child("modelimport"),
+	)
+}
+
 // ModelDefaults returns the model defaults service.
 func (s *ControllerServices) ModelDefaults() *modeldefaultsservice.Service {
 	return modeldefaultsservice.NewService(
@@ -190,6 +206,12 @@ func (s *ControllerServices) SecretBackend() *secretbackendservice.WatchableServ
 	)
 }
 
+// SecretEncryptionKey returns the service for the controller key-encryption
+// keys that protect secret content stored in the internal backend.
+func (s *ControllerServices) SecretEncryptionKey() *secretbackendservice.EncryptionKeyService {
+	return s.secretEncryptionKeys(s.logger.Child("secretencryptionkey"))
+}
+
 func (s *ControllerServices) Macaroon() *macaroonservice.Service {
 	return macaroonservice.NewService(
 		macaroonstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
@@ -219,6 +241,14 @@ func (s *ControllerServices) ControllerChangeStream() *changestreamservice.Servi
 	)
 }
 
+// ControllerBackup returns the service which dumps the controller database
+// for backups.
+func (s *ControllerServices) ControllerBackup() *backupservice.Service {
+	return backupservice.NewService(
+		backupstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+	)
+}
+
 // Tracing returns the tracing service which provides access to tracing
 // configuration for charms.
 func (s *ControllerServices) Tracing() *tracingservice.Service {
@@ -229,6 +259,16 @@ func (s *ControllerServices) Tracing() *tracingservice.Service {
 	)
 }
 
+// SSHRecording returns the service for storing and retrieving the recordings
+// of SSH sessions proxied by the controller.
+func (s *ControllerServices) SSHRecording() *sshrecordingservice.Service {
+	return sshrecordingservice.NewService(
+		sshrecordingstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.controllerObjectStore,
+		s.logger.Child("sshrecording"),
+	)
+}
+
 type statusHistoryGetter struct {
 	loggerContextGetter logger.LoggerContextGetter
 	clock               clock.Clock
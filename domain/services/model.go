@@ -34,6 +34,8 @@ import (
 	applicationservice "github.com/juju/juju/domain/application/service"
 	applicationstorageservice "github.com/juju/juju/domain/application/service/storage"
 	applicationstate "github.com/juju/juju/domain/application/state"
+	backupservice "github.com/juju/juju/domain/backup/service"
+	backupstate "github.com/juju/juju/domain/backup/state"
 	blockcommandservice "github.com/juju/juju/domain/blockcommand/service"
 	blockcommandstate "github.com/juju/juju/domain/blockcommand/state"
 	blockdeviceservice "github.com/juju/juju/domain/blockdevice/service"
@@ -44,6 +46,8 @@ import (
 	cloudimagemetadatastate "github.com/juju/juju/domain/cloudimagemetadata/state"
 	containerimageresourcestoreservice "github.com/juju/juju/domain/containerimageresourcestore/service"
 	containerimageresourcestorestate "github.com/juju/juju/domain/containerimageresourcestore/state"
+	controllernodeservice "github.com/juju/juju/domain/controllernode/service"
+	controllernodestate "github.com/juju/juju/domain/controllernode/state"
 	controllerupgraderservice "github.com/juju/juju/domain/controllerupgrader/service"
 	controllerupgraderstate "github.com/juju/juju/domain/controllerupgrader/state"
 	crossmodelrelationservice "github.com/juju/juju/domain/crossmodelrelation/service"
@@ -132,7 +136,7 @@ type ModelServices struct {
 	storageRegistry             corestorage.ModelStorageRegistryGetter
 	publicKeyImporter           PublicKeyImporter
 	leaseManager                lease.ModelLeaseManagerGetter
-	clusterDescriber            database.ClusterDescriber
+	clusterManager              database.ClusterManager
 	simpleStreamsClient         http.HTTPClient
 	logDir                      string
 	clock                       clock.Clock
@@ -150,8 +154,9 @@ func NewModelServices(
 	storageRegistry corestorage.ModelStorageRegistryGetter,
 	publicKeyImporter PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
-	clusterDescriber database.ClusterDescriber,
+	clusterManager database.ClusterManager,
 	simpleStreamsClient http.HTTPClient,
+	dataDir string,
 	logDir string,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -160,6 +165,7 @@ func NewModelServices(
 		modelServiceFactoryBase: modelServiceFactoryBase{
 			serviceFactoryBase: serviceFactoryBase{
 				controllerDB: controllerDB,
+				dataDir:      dataDir,
 				logger:       logger,
 			},
 			modelDB: modelDB,
@@ -170,7 +176,7 @@ func NewModelServices(
 		storageRegistry:             storageRegistry,
 		publicKeyImporter:           publicKeyImporter,
 		leaseManager:                leaseManager,
-		clusterDescriber:            clusterDescriber,
+		clusterManager:              clusterManager,
 		simpleStreamsClient:         simpleStreamsClient,
 		logDir:                      logDir,
 		clock:                       clock,
@@ -306,7 +312,7 @@ func (s *ModelServices) Status() *statusservice.LeadershipService {
 		statusstatemodel.NewModelState(changestream.NewTxnRunnerFactory(s.modelDB), s.clock, logger),
 		statusstatecontroller.NewControllerState(changestream.NewTxnRunnerFactory(s.controllerDB), s.modelUUID),
 		domain.NewLeaseService(s.leaseManager),
-		s.clusterDescriber,
+		s.clusterManager,
 		s.modelWatcherFactory("status"),
 		s.modelUUID,
 		domain.NewStatusHistory(logger, s.clock),
@@ -319,6 +325,16 @@ func (s *ModelServices) Status() *statusservice.LeadershipService {
 	)
 }
 
+// ControllerCluster returns the service for managing the membership of
+// controller nodes in the Dqlite cluster.
+func (s *ModelServices) ControllerCluster() *controllernodeservice.ClusterService {
+	return controllernodeservice.NewClusterService(
+		controllernodestate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.clusterManager,
+		s.logger.Child("controllercluster"),
+	)
+}
+
 // Resolve returns the resolve service.
 func (s *ModelServices) Resolve() *resolveservice.WatchableService {
 	return resolveservice.NewWatchableService(
@@ -403,9 +419,10 @@ func (s *ModelServices) StorageProvisioning() *storageprovisioningservice.Servic
 // Secret returns the model's secret service.
 func (s *ModelServices) Secret() *secretservice.WatchableService {
 	log := s.logger.Child("secret")
+	backendState := secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log)
 	return secretservice.NewWatchableService(
-		secretstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), log),
-		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
+		secretstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.secretEncryptionKeys(log), log),
+		backendState,
 		domain.NewLeaseService(s.leaseManager),
 		s.modelWatcherFactory("secret"),
 		log,
@@ -467,6 +484,7 @@ func (s *ModelServices) ModelInfo() *modelservice.ProviderModelService {
 func (s *ModelServices) Export() *exportservice.Service {
 	return exportservice.NewService(
 		exportstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.secretEncryptionKeys(s.logger.Child("export")),
 	)
 }
 
@@ -595,7 +613,11 @@ func (s *ModelServices) CrossModelRelation() *crossmodelrelationservice.Watchabl
 	log := s.logger.Child("crossmodelrelation")
 	return crossmodelrelationservice.NewWatchableService(
 		crossmodelrelationstatecontroller.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
-		crossmodelrelationstatemodel.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.modelUUID, s.clock, log),
+		crossmodelrelationstatemodel.NewState(
+			changestream.NewTxnRunnerFactory(s.modelDB), s.modelUUID,
+			s.secretEncryptionKeys(log),
+			s.clock, log,
+		),
 		domain.NewStatusHistory(log, s.clock),
 		s.modelWatcherFactory("crossmodelrelation"),
 		s.clock,
@@ -628,6 +650,13 @@ func (s *ModelServices) ChangeStream() *changestreamservice.Service {
 	)
 }
 
+// Backup returns the service which dumps the model database for backups.
+func (s *ModelServices) Backup() *backupservice.Service {
+	return backupservice.NewService(
+		backupstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+	)
+}
+
 // ControllerUpgrader returns the service for upgrading the controller and its
 // model.
 func (s *ModelServices) ControllerUpgrader() *controllerupgraderservice.Service {
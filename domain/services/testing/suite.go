@@ -47,6 +47,7 @@ import (
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	_ "github.com/juju/juju/internal/provider/dummy"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 	"github.com/juju/juju/internal/storage"
@@ -90,6 +91,10 @@ type DomainServicesSuite struct {
 	// ProviderFactory is the provider tracker factory to use in the domain
 	// services.
 	ProviderFactory providertracker.ProviderFactory
+
+	// DataDir is the agent data directory holding the controller secret
+	// encryption keys. If not set will be set during test set up.
+	DataDir string
 }
 
 // ControllerDomainServices conveniently constructs a domain services for the
@@ -206,7 +211,7 @@ func (s *DomainServicesSuite) SeedModelDatabases(c *tc.C) {
 	}
 
 	fn := modelbootstrap.CreateGlobalModelRecord(s.ControllerModelUUID, controllerArgs)
-	c.Assert(backendbootstrap.CreateDefaultBackends(model.IAAS)(
+	c.Assert(backendbootstrap.CreateDefaultBackends(model.IAAS, encryption.NewFileKeyStore(s.DataDir))(
 		ctx, s.ControllerTxnRunner(), s.ModelTxnRunner(c, s.ControllerModelUUID.String())), tc.ErrorIsNil)
 	err = fn(ctx, s.ControllerTxnRunner(), s.NoopTxnRunner())
 	c.Assert(err, tc.ErrorIsNil)
@@ -280,6 +285,7 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, o
 			modelObjectStoreGetter(func(ctx context.Context) (objectstore.ObjectStore, error) {
 				return objectStore, nil
 			}),
+			s.DataDir,
 			clock,
 			logger,
 			loggertesting.WrapCheckLogForContextGetter(c),
@@ -304,8 +310,9 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, o
 			modelApplicationLeaseManagerGetter(func() lease.LeaseManager {
 				return leaseManager
 			}),
-			stubClusterDescriber{},
+			stubClusterManager{},
 			&http.Client{},
+			s.DataDir,
 			c.MkDir(),
 			clock,
 			logger,
@@ -354,6 +361,9 @@ func (s *DomainServicesSuite) SetUpTest(c *tc.C) {
 	if s.DefaultModelUUID == "" {
 		s.DefaultModelUUID = tc.Must0(c, model.NewUUID)
 	}
+	if s.DataDir == "" {
+		s.DataDir = c.MkDir()
+	}
 	s.SeedControllerConfig(c)
 	s.SeedAdminUser(c)
 	s.SeedCloudAndCredential(c)
@@ -561,10 +571,15 @@ func (s stubProvider) StorageProviderTypes() ([]storage.ProviderType, error) {
 	return nil, nil
 }
 
-type stubClusterDescriber struct{}
+type stubClusterManager struct{}
 
 // ClusterDetails returns the node information for Dqlite nodes configured to be
 // in the cluster.
-func (stubClusterDescriber) ClusterDetails(context.Context) ([]database.ClusterNodeInfo, error) {
+func (stubClusterManager) ClusterDetails(context.Context) ([]database.ClusterNodeInfo, error) {
 	return nil, nil
 }
+
+// RemoveClusterNode removes the Dqlite node from the cluster.
+func (stubClusterManager) RemoveClusterNode(context.Context, uint64) error {
+	return nil
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshrecording records the SSH sessions proxied by the controller's
+// SSH server. Each recording is an asciicast file held in the controller
+// object store, along with the user that opened the session and the virtual
+// hostname of its target, so that sessions can be audited and replayed.
+package sshrecording
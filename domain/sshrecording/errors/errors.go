@@ -0,0 +1,12 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// RecordingNotFound describes an error that occurs when the requested
+	// SSH session recording does not exist.
+	RecordingNotFound = errors.ConstError("ssh session recording not found")
+)
@@ -0,0 +1,153 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"io"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/sshrecording"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// recordingPathPrefix is the object store path under which recordings are
+// stored.
+const recordingPathPrefix = "ssh-recordings/"
+
+// State describes retrieval and persistence methods for SSH session
+// recordings.
+type State interface {
+	// AddRecording records the metadata of a recording held at path in the
+	// controller object store.
+	AddRecording(ctx context.Context, rec sshrecording.Recording, path string) error
+
+	// GetRecording returns the recording with the given UUID, along with its
+	// path in the controller object store.
+	GetRecording(ctx context.Context, uuid string) (sshrecording.Recording, string, error)
+
+	// ListRecordings returns the recordings of the given user, or of every
+	// user if the user is empty.
+	ListRecordings(ctx context.Context, user string) ([]sshrecording.Recording, error)
+}
+
+// Service provides the API for recording SSH sessions.
+type Service struct {
+	st                State
+	objectStoreGetter objectstore.NamespacedObjectStoreGetter
+	logger            logger.Logger
+}
+
+// NewService returns a new service reference wrapping the input state.
+// Recordings are stored in the object store returned by objectStoreGetter,
+// which is expected to be the controller object store.
+func NewService(st State, objectStoreGetter objectstore.NamespacedObjectStoreGetter, logger logger.Logger) *Service {
+	return &Service{
+		st:                st,
+		objectStoreGetter: objectStoreGetter,
+		logger:            logger,
+	}
+}
+
+// AddRecording stores the recording read from r and returns its UUID. The
+// UUID of rec is ignored, and its Size must be the number of bytes held by
+// r.
+func (s *Service) AddRecording(ctx context.Context, rec sshrecording.Recording, r io.Reader) (string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if rec.User == "" {
+		return "", errors.Errorf("empty user").Add(coreerrors.NotValid)
+	}
+	if rec.Target == "" {
+		return "", errors.Errorf("empty target").Add(coreerrors.NotValid)
+	}
+	if rec.Size < 0 {
+		return "", errors.Errorf("negative size %d", rec.Size).Add(coreerrors.NotValid)
+	}
+	if rec.EndedAt.Before(rec.StartedAt) {
+		return "", errors.Errorf("session ended before it started").Add(coreerrors.NotValid)
+	}
+
+	recUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	rec.UUID = recUUID.String()
+	path := recordingPath(rec.UUID)
+
+	store, err := s.objectStoreGetter.GetObjectStore(ctx)
+	if err != nil {
+		return "", errors.Errorf("getting object store: %w", err)
+	}
+	if _, err := store.Put(ctx, path, r, rec.Size); err != nil {
+		return "", errors.Errorf("storing recording: %w", err)
+	}
+
+	if err := s.st.AddRecording(ctx, rec, path); err != nil {
+		// Don't leave an object behind that can't be found.
+		if rmErr := store.Remove(ctx, path); rmErr != nil {
+			s.logger.Warningf(ctx, "removing recording %q: %v", path, rmErr)
+		}
+		return "", errors.Capture(err)
+	}
+	return rec.UUID, nil
+}
+
+// GetRecording returns the recording with the given UUID. If the recording
+// doesn't exist, a [sshrecordingerrors.RecordingNotFound] error is returned.
+func (s *Service) GetRecording(ctx context.Context, uuid string) (sshrecording.Recording, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	rec, _, err := s.st.GetRecording(ctx, uuid)
+	if err != nil {
+		return sshrecording.Recording{}, errors.Capture(err)
+	}
+	return rec, nil
+}
+
+// OpenRecording returns the content of the recording with the given UUID,
+// which the caller must close. If the recording doesn't exist, a
+// [sshrecordingerrors.RecordingNotFound] error is returned.
+func (s *Service) OpenRecording(ctx context.Context, uuid string) (io.ReadCloser, sshrecording.Recording, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	rec, path, err := s.st.GetRecording(ctx, uuid)
+	if err != nil {
+		return nil, sshrecording.Recording{}, errors.Capture(err)
+	}
+
+	store, err := s.objectStoreGetter.GetObjectStore(ctx)
+	if err != nil {
+		return nil, sshrecording.Recording{}, errors.Errorf("getting object store: %w", err)
+	}
+	reader, _, err := store.Get(ctx, path)
+	if err != nil {
+		return nil, sshrecording.Recording{}, errors.Errorf("reading recording %q: %w", uuid, err)
+	}
+	return reader, rec, nil
+}
+
+// ListRecordings returns the recordings of the given user, or of every user
+// if the user is empty, ordered by when the sessions started.
+func (s *Service) ListRecordings(ctx context.Context, user string) ([]sshrecording.Recording, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	recordings, err := s.st.ListRecordings(ctx, user)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return recordings, nil
+}
+
+func recordingPath(uuid string) string {
+	return recordingPathPrefix + uuid + ".cast"
+}
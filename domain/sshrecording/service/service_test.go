@@ -0,0 +1,162 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/sshrecording"
+	sshrecordingerrors "github.com/juju/juju/domain/sshrecording/errors"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type serviceSuite struct {
+	state             *MockState
+	objectStoreGetter *MockNamespacedObjectStoreGetter
+	objectStore       *MockObjectStore
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+	s.objectStoreGetter = NewMockNamespacedObjectStoreGetter(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil).AnyTimes()
+
+	c.Cleanup(func() {
+		s.state = nil
+		s.objectStoreGetter = nil
+		s.objectStore = nil
+	})
+
+	return ctrl
+}
+
+func (s *serviceSuite) newService(c *tc.C) *Service {
+	return NewService(s.state, s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+}
+
+func (s *serviceSuite) recording() sshrecording.Recording {
+	started := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	return sshrecording.Recording{
+		User:      "admin",
+		Target:    "0.8419cd78-4993-4c3a-928e-c646226beeee.juju.local",
+		Size:      5,
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+	}
+}
+
+func (s *serviceSuite) TestAddRecording(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rec := s.recording()
+
+	var path string
+	s.objectStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(5)).DoAndReturn(
+		func(_ context.Context, p string, r io.Reader, _ int64) (objectstore.UUID, error) {
+			path = p
+			data, err := io.ReadAll(r)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, "hello")
+			return "", nil
+		})
+	s.state.EXPECT().AddRecording(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, got sshrecording.Recording, p string) error {
+			c.Check(p, tc.Equals, path)
+			rec.UUID = got.UUID
+			c.Check(got, tc.DeepEquals, rec)
+			return nil
+		})
+
+	uuid, err := s.newService(c).AddRecording(c.Context(), rec, strings.NewReader("hello"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(uuid, tc.Not(tc.Equals), "")
+	c.Check(path, tc.Equals, "ssh-recordings/"+uuid+".cast")
+}
+
+func (s *serviceSuite) TestAddRecordingStateErrorRemovesObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.objectStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(5)).Return("", nil)
+	s.state.EXPECT().AddRecording(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+	s.objectStore.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.newService(c).AddRecording(c.Context(), s.recording(), strings.NewReader("hello"))
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *serviceSuite) TestAddRecordingNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.newService(c)
+
+	rec := s.recording()
+	rec.User = ""
+	_, err := svc.AddRecording(c.Context(), rec, strings.NewReader(""))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	rec = s.recording()
+	rec.Target = ""
+	_, err = svc.AddRecording(c.Context(), rec, strings.NewReader(""))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	rec = s.recording()
+	rec.EndedAt = rec.StartedAt.Add(-time.Second)
+	_, err = svc.AddRecording(c.Context(), rec, strings.NewReader(""))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestOpenRecording(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rec := s.recording()
+	rec.UUID = "deadbeef"
+	s.state.EXPECT().GetRecording(gomock.Any(), "deadbeef").Return(rec, "ssh-recordings/deadbeef.cast", nil)
+	s.objectStore.EXPECT().Get(gomock.Any(), "ssh-recordings/deadbeef.cast").Return(io.NopCloser(strings.NewReader("hello")), objectstore.Digest{}, nil)
+
+	reader, got, err := s.newService(c).OpenRecording(c.Context(), "deadbeef")
+	c.Assert(err, tc.ErrorIsNil)
+	defer reader.Close()
+	c.Check(got, tc.DeepEquals, rec)
+
+	data, err := io.ReadAll(reader)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "hello")
+}
+
+func (s *serviceSuite) TestOpenRecordingNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRecording(gomock.Any(), "deadbeef").Return(sshrecording.Recording{}, "", sshrecordingerrors.RecordingNotFound)
+
+	_, _, err := s.newService(c).OpenRecording(c.Context(), "deadbeef")
+	c.Assert(err, tc.ErrorIs, sshrecordingerrors.RecordingNotFound)
+}
+
+func (s *serviceSuite) TestListRecordings(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	recordings := []sshrecording.Recording{s.recording()}
+	s.state.EXPECT().ListRecordings(gomock.Any(), "admin").Return(recordings, nil)
+
+	got, err := s.newService(c).ListRecordings(c.Context(), "admin")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, recordings)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/sshrecording/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/sshrecording/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	sshrecording "github.com/juju/juju/domain/sshrecording"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// AddRecording mocks base method.
+func (m *MockState) AddRecording(arg0 context.Context, arg1 sshrecording.Recording, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecording", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecording indicates an expected call of AddRecording.
+func (mr *MockStateMockRecorder) AddRecording(arg0, arg1, arg2 any) *MockStateAddRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecording", reflect.TypeOf((*MockState)(nil).AddRecording), arg0, arg1, arg2)
+	return &MockStateAddRecordingCall{Call: call}
+}
+
+// MockStateAddRecordingCall wrap *gomock.Call
+type MockStateAddRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddRecordingCall) Return(arg0 error) *MockStateAddRecordingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddRecordingCall) Do(f func(context.Context, sshrecording.Recording, string) error) *MockStateAddRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddRecordingCall) DoAndReturn(f func(context.Context, sshrecording.Recording, string) error) *MockStateAddRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRecording mocks base method.
+func (m *MockState) GetRecording(arg0 context.Context, arg1 string) (sshrecording.Recording, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecording", arg0, arg1)
+	ret0, _ := ret[0].(sshrecording.Recording)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecording indicates an expected call of GetRecording.
+func (mr *MockStateMockRecorder) GetRecording(arg0, arg1 any) *MockStateGetRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecording", reflect.TypeOf((*MockState)(nil).GetRecording), arg0, arg1)
+	return &MockStateGetRecordingCall{Call: call}
+}
+
+// MockStateGetRecordingCall wrap *gomock.Call
+type MockStateGetRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRecordingCall) Return(arg0 sshrecording.Recording, arg1 string, arg2 error) *MockStateGetRecordingCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRecordingCall) Do(f func(context.Context, string) (sshrecording.Recording, string, error)) *MockStateGetRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRecordingCall) DoAndReturn(f func(context.Context, string) (sshrecording.Recording, string, error)) *MockStateGetRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRecordings mocks base method.
+func (m *MockState) ListRecordings(arg0 context.Context, arg1 string) ([]sshrecording.Recording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecordings", arg0, arg1)
+	ret0, _ := ret[0].([]sshrecording.Recording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecordings indicates an expected call of ListRecordings.
+func (mr *MockStateMockRecorder) ListRecordings(arg0, arg1 any) *MockStateListRecordingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecordings", reflect.TypeOf((*MockState)(nil).ListRecordings), arg0, arg1)
+	return &MockStateListRecordingsCall{Call: call}
+}
+
+// MockStateListRecordingsCall wrap *gomock.Call
+type MockStateListRecordingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListRecordingsCall) Return(arg0 []sshrecording.Recording, arg1 error) *MockStateListRecordingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListRecordingsCall) Do(f func(context.Context, string) ([]sshrecording.Recording, error)) *MockStateListRecordingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListRecordingsCall) DoAndReturn(f func(context.Context, string) ([]sshrecording.Recording, error)) *MockStateListRecordingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
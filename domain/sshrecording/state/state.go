@@ -0,0 +1,136 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/sshrecording"
+	sshrecordingerrors "github.com/juju/juju/domain/sshrecording/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State implements persistence for SSH session recordings.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new state reference.
+func NewState(factory database.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddRecording records the metadata of a recording held at path in the
+// controller object store.
+func (st *State) AddRecording(ctx context.Context, rec sshrecording.Recording, path string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := recording{
+		UUID:      rec.UUID,
+		UserName:  rec.User,
+		Target:    rec.Target,
+		Path:      path,
+		Size:      rec.Size,
+		StartedAt: rec.StartedAt.UTC(),
+		EndedAt:   rec.EndedAt.UTC(),
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO ssh_session_recording (*)
+VALUES ($recording.*)`, row)
+	if err != nil {
+		return errors.Errorf("preparing insert recording stmt: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, row).Run(); err != nil {
+			return errors.Errorf("inserting recording %q: %w", rec.UUID, err)
+		}
+		return nil
+	})
+}
+
+// GetRecording returns the recording with the given UUID, along with its
+// path in the controller object store. If the recording doesn't exist, a
+// [sshrecordingerrors.RecordingNotFound] error is returned.
+func (st *State) GetRecording(ctx context.Context, uuid string) (sshrecording.Recording, string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return sshrecording.Recording{}, "", errors.Capture(err)
+	}
+
+	ident := recordingUUID{UUID: uuid}
+	stmt, err := st.Prepare(`
+SELECT &recording.*
+FROM   ssh_session_recording
+WHERE  uuid = $recordingUUID.uuid`, recording{}, ident)
+	if err != nil {
+		return sshrecording.Recording{}, "", errors.Errorf("preparing select recording stmt: %w", err)
+	}
+
+	var row recording
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).Get(&row)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("recording %q: %w", uuid, sshrecordingerrors.RecordingNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return sshrecording.Recording{}, "", errors.Capture(err)
+	}
+	return row.toRecording(), row.Path, nil
+}
+
+// ListRecordings returns the recordings of the given user, or of every user
+// if the user is empty, ordered by when the sessions started.
+func (st *State) ListRecordings(ctx context.Context, user string) ([]sshrecording.Recording, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	query := `
+SELECT &recording.*
+FROM   ssh_session_recording`
+	args := []any{}
+	if user != "" {
+		query += `
+WHERE  user_name = $userName.user_name`
+		args = append(args, userName{Name: user})
+	}
+	query += `
+ORDER BY started_at, uuid`
+
+	stmt, err := st.Prepare(query, append([]any{recording{}}, args...)...)
+	if err != nil {
+		return nil, errors.Errorf("preparing select recordings stmt: %w", err)
+	}
+
+	var rows []recording
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, args...).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	recordings := make([]sshrecording.Recording, len(rows))
+	for i, row := range rows {
+		recordings[i] = row.toRecording()
+	}
+	return recordings, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/domain/sshrecording"
+	sshrecordingerrors "github.com/juju/juju/domain/sshrecording/errors"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+
+	state *State
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) SetUpTest(c *tc.C) {
+	s.ControllerSuite.SetUpTest(c)
+
+	s.state = NewState(s.TxnRunnerFactory())
+}
+
+func (s *stateSuite) TestAddGetRecording(c *tc.C) {
+	started := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	rec := sshrecording.Recording{
+		UUID:      "a1b2",
+		User:      "admin",
+		Target:    "0.8419cd78-4993-4c3a-928e-c646226beeee.juju.local",
+		Size:      1024,
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+	}
+	err := s.state.AddRecording(c.Context(), rec, "ssh-recordings/a1b2.cast")
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, path, err := s.state.GetRecording(c.Context(), "a1b2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, rec)
+	c.Check(path, tc.Equals, "ssh-recordings/a1b2.cast")
+}
+
+func (s *stateSuite) TestGetRecordingNotFound(c *tc.C) {
+	_, _, err := s.state.GetRecording(c.Context(), "missing")
+	c.Assert(err, tc.ErrorIs, sshrecordingerrors.RecordingNotFound)
+}
+
+func (s *stateSuite) TestListRecordings(c *tc.C) {
+	started := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	for i, user := range []string{"bob", "admin", "bob"} {
+		err := s.state.AddRecording(c.Context(), sshrecording.Recording{
+			UUID:      user + string(rune('0'+i)),
+			User:      user,
+			Target:    "target",
+			StartedAt: started.Add(-time.Duration(i) * time.Minute),
+			EndedAt:   started,
+		}, "path-"+string(rune('0'+i)))
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	all, err := s.state.ListRecordings(c.Context(), "")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(all, tc.HasLen, 3)
+	c.Check([]string{all[0].UUID, all[1].UUID, all[2].UUID}, tc.DeepEquals, []string{"bob2", "admin1", "bob0"})
+
+	bob, err := s.state.ListRecordings(c.Context(), "bob")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(bob, tc.HasLen, 2)
+	c.Check([]string{bob[0].UUID, bob[1].UUID}, tc.DeepEquals, []string{"bob2", "bob0"})
+
+	none, err := s.state.ListRecordings(c.Context(), "alice")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(none, tc.HasLen, 0)
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/juju/domain/sshrecording"
+)
+
+// recording represents a row of the ssh_session_recording table.
+type recording struct {
+	UUID      string    `db:"uuid"`
+	UserName  string    `db:"user_name"`
+	Target    string    `db:"target"`
+	Path      string    `db:"path"`
+	Size      int64     `db:"size"`
+	StartedAt time.Time `db:"started_at"`
+	EndedAt   time.Time `db:"ended_at"`
+}
+
+func (r recording) toRecording() sshrecording.Recording {
+	return sshrecording.Recording{
+		UUID:      r.UUID,
+		User:      r.UserName,
+		Target:    r.Target,
+		Size:      r.Size,
+		StartedAt: r.StartedAt,
+		EndedAt:   r.EndedAt,
+	}
+}
+
+// recordingUUID is used to select a recording by its UUID.
+type recordingUUID struct {
+	UUID string `db:"uuid"`
+}
+
+// userName is used to select the recordings of a user.
+type userName struct {
+	Name string `db:"user_name"`
+}
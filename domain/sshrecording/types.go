@@ -0,0 +1,28 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshrecording
+
+import "time"
+
+// Recording describes a recorded SSH session.
+type Recording struct {
+	// UUID uniquely identifies the recording.
+	UUID string
+
+	// User is the name of the user that opened the session.
+	User string
+
+	// Target is the virtual hostname of the unit, machine or container the
+	// session was proxied to.
+	Target string
+
+	// Size is the size of the recording in bytes.
+	Size int64
+
+	// StartedAt is when the session was opened.
+	StartedAt time.Time
+
+	// EndedAt is when the session was closed.
+	EndedAt time.Time
+}
@@ -5,6 +5,9 @@ package service
 
 import (
 	"context"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/juju/juju/core/status"
@@ -58,6 +61,92 @@ func (s *Service) GetStatusHistory(ctx context.Context, request StatusHistoryReq
 	return results, nil
 }
 
+// QueryStatusHistory returns the status history of all the entities in the
+// model matching the query, oldest first. If the query is not valid, an error
+// satisfying [coreerrors.NotValid] is returned.
+func (s *Service) QueryStatusHistory(ctx context.Context, query status.StatusHistoryQuery) ([]status.HistoryEntry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	// The pattern has already been validated.
+	message := regexp.MustCompile(query.Message)
+
+	reader, err := s.statusHistoryReaderFn()
+	if err != nil {
+		return nil, errors.Errorf("reading status history: %v", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	var results []status.HistoryEntry
+	if err := reader.Walk(func(record statushistory.HistoryRecord) (bool, error) {
+		// Allow the context to cancel the walk.
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		default:
+		}
+
+		if !matchesQuery(record, query, message) {
+			return false, nil
+		}
+
+		results = append(results, status.HistoryEntry{
+			ID:             record.Tag,
+			DetailedStatus: record.Status,
+		})
+
+		// If we have more than the requested limit, move the slice forward.
+		if limit := query.Size; limit > 0 && len(results) > limit {
+			results = results[1:]
+		}
+
+		return false, nil
+	}); err != nil {
+		return nil, errors.Errorf("reading status history: %w", err)
+	}
+
+	return results, nil
+}
+
+func matchesQuery(hr statushistory.HistoryRecord, query status.StatusHistoryQuery, message *regexp.Regexp) bool {
+	if !query.MatchesKind(hr.Kind) {
+		return false
+	}
+
+	if app := query.Application; app != "" {
+		switch hr.Kind {
+		case status.KindApplication, status.KindSAAS:
+			if hr.Tag != app {
+				return false
+			}
+		case status.KindUnit, status.KindUnitAgent, status.KindWorkload:
+			if !strings.HasPrefix(hr.Tag, app+"/") {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	if len(query.Statuses) > 0 && !slices.Contains(query.Statuses, hr.Status.Status) {
+		return false
+	}
+
+	// Records without a time can not be placed within a time window.
+	since := hr.Status.Since
+	if query.From != nil && (since == nil || since.Before(*query.From)) {
+		return false
+	}
+	if query.To != nil && (since == nil || !since.Before(*query.To)) {
+		return false
+	}
+
+	return message.MatchString(hr.Status.Info)
+}
+
 func matchesUnit(hr statushistory.HistoryRecord, req StatusHistoryRequest) bool {
 	switch req.Kind {
 	case status.KindUnit:
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/internal/statushistory"
 	"github.com/juju/juju/internal/testhelpers"
@@ -554,6 +555,90 @@ func (s *statusHistorySuite) TestMatchesDelta(c *tc.C) {
 	}
 }
 
+func (s *statusHistorySuite) TestQueryStatusHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	at := func(minutes int) *time.Time {
+		return new(s.now.Add(time.Duration(minutes) * time.Minute))
+	}
+	record := func(kind status.HistoryKind, id string, st status.Status, info string, since *time.Time) statushistory.HistoryRecord {
+		return statushistory.HistoryRecord{
+			Kind: kind,
+			Tag:  id,
+			Status: status.DetailedStatus{
+				Kind:   kind,
+				Status: st,
+				Info:   info,
+				Since:  since,
+			},
+		}
+	}
+	s.expectResults([]statushistory.HistoryRecord{
+		record(status.KindWorkload, "mysql/0", status.Error, "hook failed: install", at(0)),
+		record(status.KindWorkload, "mysql/1", status.Error, "hook failed: start", at(1)),
+		record(status.KindUnitAgent, "mysql/1", status.Error, "hook failed: start", at(2)),
+		record(status.KindWorkload, "mysqlrouter/0", status.Error, "hook failed: install", at(3)),
+		record(status.KindApplication, "mysql", status.Error, "hook failed: start", at(4)),
+		record(status.KindMachine, "0", status.Error, "hook failed: start", at(5)),
+		record(status.KindWorkload, "mysql/0", status.Active, "ready", at(6)),
+		record(status.KindWorkload, "mysql/0", status.Error, "hook failed: stop", at(10)),
+		record(status.KindWorkload, "mysql/2", status.Error, "hook failed: start", nil),
+	})
+
+	service := s.newService()
+	results, err := service.QueryStatusHistory(c.Context(), status.StatusHistoryQuery{
+		Kinds:       []status.HistoryKind{status.KindUnit},
+		Application: "mysql",
+		Statuses:    []status.Status{status.Error},
+		From:        at(1),
+		To:          at(10),
+		Message:     "^hook failed: (start|stop)$",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, []status.HistoryEntry{{
+		ID:             "mysql/1",
+		DetailedStatus: record(status.KindWorkload, "mysql/1", status.Error, "hook failed: start", at(1)).Status,
+	}, {
+		ID:             "mysql/1",
+		DetailedStatus: record(status.KindUnitAgent, "mysql/1", status.Error, "hook failed: start", at(2)).Status,
+	}})
+}
+
+func (s *statusHistorySuite) TestQueryStatusHistorySizeReturnsNewest(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var records []statushistory.HistoryRecord
+	for i := range 5 {
+		records = append(records, statushistory.HistoryRecord{
+			Kind: status.KindMachine,
+			Tag:  fmt.Sprint(i),
+			Status: status.DetailedStatus{
+				Kind:   status.KindMachine,
+				Status: status.Started,
+				Since:  new(s.now.Add(time.Duration(i) * time.Minute)),
+			},
+		})
+	}
+	s.expectResults(records)
+
+	service := s.newService()
+	results, err := service.QueryStatusHistory(c.Context(), status.StatusHistoryQuery{
+		Size: 2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Check(results[0].ID, tc.Equals, "3")
+	c.Check(results[1].ID, tc.Equals, "4")
+}
+
+func (s *statusHistorySuite) TestQueryStatusHistoryNotValid(c *tc.C) {
+	service := s.newService()
+	_, err := service.QueryStatusHistory(c.Context(), status.StatusHistoryQuery{
+		Message: "(",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *statusHistorySuite) expectResults(records []statushistory.HistoryRecord) {
 	s.historyReader.EXPECT().Walk(gomock.Any()).DoAndReturn(
 		func(fn func(statushistory.HistoryRecord) (bool, error)) error {
@@ -165,7 +165,7 @@ func (s *baseSuite) createIAASRemoteApplicationOfferer(
 	name string,
 ) (coreapplication.UUID, coreremoteapplication.UUID) {
 	cmrState := crossmodelrelationstate.NewState(
-		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
+		s.TxnRunnerFactory(), coremodel.UUID(s.ModelUUID()), nil, testclock.NewClock(s.now), loggertesting.WrapCheckLog(c),
 	)
 
 	ch := charm.Charm{
@@ -62,6 +62,35 @@ const (
 	// instance being operated on does not exist.
 	StorageInstanceNotFound = errors.ConstError("storage instance not found")
 
+	// StorageInstanceNotAlive describes an error that occurs when the storage
+	// instance being operated on is no longer alive.
+	StorageInstanceNotAlive = errors.ConstError("storage instance is not alive")
+
+	// StorageInstanceShrinkNotSupported describes an error that occurs when
+	// a storage instance is requested to be resized smaller than its current
+	// requested size.
+	StorageInstanceShrinkNotSupported = errors.ConstError(
+		"shrinking storage instance is not supported",
+	)
+
+	// StorageInstanceNotSnapshottable describes an error that occurs when a
+	// snapshot is requested of a storage instance that is not backed by a
+	// provisioned volume.
+	StorageInstanceNotSnapshottable = errors.ConstError(
+		"storage instance is not backed by a provisioned volume",
+	)
+
+	// StorageSnapshotNotAvailable describes an error that occurs when a
+	// storage snapshot is used to create new storage before it has been
+	// taken.
+	StorageSnapshotNotAvailable = errors.ConstError(
+		"storage snapshot is not available",
+	)
+
+	// StorageSnapshotNotFound describes an error that occurs when the storage
+	// snapshot being operated on does not exist.
+	StorageSnapshotNotFound = errors.ConstError("storage snapshot not found")
+
 	// StoragePoolAlreadyExists is used when a storage pool already exists.
 	StoragePoolAlreadyExists = errors.ConstError("storage pool already exists")
 
@@ -13,4 +13,5 @@ const (
 	FilesystemSequenceNamespace      = domainsequence.StaticNamespace("filesystem")
 	VolumeSequenceNamespace          = domainsequence.StaticNamespace("volume")
 	StorageInstanceSequenceNamespace = domainsequence.StaticNamespace("storage")
+	StorageSnapshotSequenceNamespace = domainsequence.StaticNamespace("storage_snapshot")
 )
@@ -119,3 +119,36 @@ func (s *Service) GetStorageInstanceUUIDForID(
 	// We don't have any validation that we run over storage ID's at the moment.
 	return s.st.GetStorageInstanceUUIDByID(ctx, storageID)
 }
+
+// ResizeStorageInstance requests that the storage instance be grown to
+// sizeMiB. The storage provisioner responsible for the storage instance's
+// volume or filesystem performs the resize.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound] when
+// the storage instance does not exist in the model.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive] when
+// the storage instance is not alive.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceShrinkNotSupported]
+// when sizeMiB is less than the current requested size.
+// - [coreerrors.NotValid] when the supplied storage instance uuid or size is
+// not valid.
+func (s *Service) ResizeStorageInstance(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID, sizeMiB uint64,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return errors.New(
+			"storage instance uuid is not valid",
+		).Add(coreerrors.NotValid)
+	}
+	if sizeMiB == 0 {
+		return errors.New(
+			"storage instance size must be greater than zero",
+		).Add(coreerrors.NotValid)
+	}
+
+	return s.st.ResizeStorageInstance(ctx, uuid, sizeMiB)
+}
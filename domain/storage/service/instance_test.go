@@ -505,3 +505,63 @@ func (s *instanceSuite) TestGetStorageInstanceInfoBlockVolume(c *tc.C) {
 		},
 	})
 }
+
+// TestResizeStorageInstance is a happy path test for
+// [Service.ResizeStorageInstance].
+func (s *instanceSuite) TestResizeStorageInstance(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.state.EXPECT().ResizeStorageInstance(
+		gomock.Any(), storageInstanceUUID, uint64(2048),
+	).Return(nil)
+
+	svc := NewService(
+		s.state, loggertesting.WrapCheckLog(c), clock.WallClock, s.storageRegistryGetter,
+	)
+	err := svc.ResizeStorageInstance(c.Context(), storageInstanceUUID, 2048)
+	c.Check(err, tc.ErrorIsNil)
+}
+
+// TestResizeStorageInstanceUUIDNotValid tests that resizing a storage
+// instance with an invalid uuid returns an error satisfying
+// [coreerrors.NotValid].
+func (s *instanceSuite) TestResizeStorageInstanceUUIDNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := NewService(
+		s.state, loggertesting.WrapCheckLog(c), clock.WallClock, s.storageRegistryGetter,
+	)
+	err := svc.ResizeStorageInstance(c.Context(), "", 2048)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+// TestResizeStorageInstanceZeroSize tests that resizing a storage instance
+// to zero returns an error satisfying [coreerrors.NotValid].
+func (s *instanceSuite) TestResizeStorageInstanceZeroSize(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	svc := NewService(
+		s.state, loggertesting.WrapCheckLog(c), clock.WallClock, s.storageRegistryGetter,
+	)
+	err := svc.ResizeStorageInstance(c.Context(), storageInstanceUUID, 0)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+// TestResizeStorageInstanceShrink tests that the state error is passed back
+// to the caller when the storage instance would be shrunk.
+func (s *instanceSuite) TestResizeStorageInstanceShrink(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	s.state.EXPECT().ResizeStorageInstance(
+		gomock.Any(), storageInstanceUUID, uint64(10),
+	).Return(domainstorageerrors.StorageInstanceShrinkNotSupported)
+
+	svc := NewService(
+		s.state, loggertesting.WrapCheckLog(c), clock.WallClock, s.storageRegistryGetter,
+	)
+	err := svc.ResizeStorageInstance(c.Context(), storageInstanceUUID, 10)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceShrinkNotSupported)
+}
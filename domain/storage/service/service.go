@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 
@@ -72,6 +73,50 @@ type State interface {
 	GetStorageInstanceUUIDByID(
 		ctx context.Context, storageID string,
 	) (domainstorage.StorageInstanceUUID, error)
+
+	// ResizeStorageInstance sets the requested size of the storage instance
+	// to sizeMiB.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when no storage instance exists for the supplied uuid.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive]
+	// when the storage instance is not alive.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceShrinkNotSupported]
+	// when sizeMiB is less than the current requested size.
+	ResizeStorageInstance(
+		ctx context.Context, uuid domainstorage.StorageInstanceUUID, sizeMiB uint64,
+	) error
+
+	// CreateStorageSnapshot records a new snapshot of the storage instance,
+	// returning the id allocated to the snapshot.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound]
+	// when no storage instance exists for the supplied uuid.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive]
+	// when the storage instance is not alive.
+	// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotSnapshottable]
+	// when the storage instance is not backed by a provisioned volume.
+	CreateStorageSnapshot(
+		ctx context.Context,
+		uuid domainstorage.StorageSnapshotUUID,
+		storageUUID domainstorage.StorageInstanceUUID,
+		createdAt time.Time,
+	) (string, error)
+
+	// GetStorageSnapshots returns all of the storage snapshots in the model.
+	GetStorageSnapshots(context.Context) ([]domainstorage.StorageSnapshotInfo, error)
+
+	// GetStorageSnapshotUUIDForID returns the uuid of the storage snapshot
+	// with the supplied id.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]
+	// when no storage snapshot exists for the supplied id.
+	GetStorageSnapshotUUIDForID(
+		ctx context.Context, id string,
+	) (domainstorage.StorageSnapshotUUID, error)
 }
 
 // Service defines a service for interacting with the underlying state.
@@ -81,6 +126,7 @@ type Service struct {
 	StorageService
 	VolumeService
 
+	clock  clock.Clock
 	logger logger.Logger
 	st     State
 }
@@ -109,6 +155,7 @@ func NewService(
 		VolumeService: VolumeService{
 			st: st,
 		},
+		clock:  clock,
 		logger: logger,
 		st:     st,
 	}
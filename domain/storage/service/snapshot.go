@@ -0,0 +1,76 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/internal/errors"
+)
+
+// CreateStorageSnapshot requests a point-in-time snapshot of the volume
+// backing the storage instance, returning the id of the new snapshot. The
+// storage provisioner responsible for the volume takes the snapshot. When the
+// storage instance is attached to a unit, the unit runs its
+// storage-snapshot-pre hook before the snapshot is taken and its
+// storage-snapshot-post hook afterwards.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotFound] when
+// the storage instance does not exist in the model.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotAlive] when
+// the storage instance is not alive.
+// - [github.com/juju/juju/domain/storage/errors.StorageInstanceNotSnapshottable]
+// when the storage instance is not backed by a provisioned volume.
+// - [coreerrors.NotValid] when the supplied storage instance uuid is not
+// valid.
+func (s *Service) CreateStorageSnapshot(
+	ctx context.Context, uuid domainstorage.StorageInstanceUUID,
+) (string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return "", errors.New(
+			"storage instance uuid is not valid",
+		).Add(coreerrors.NotValid)
+	}
+
+	snapshotUUID, err := domainstorage.NewStorageSnapshotUUID()
+	if err != nil {
+		return "", errors.Errorf("generating storage snapshot uuid: %w", err)
+	}
+
+	return s.st.CreateStorageSnapshot(
+		ctx, snapshotUUID, uuid, s.clock.Now().UTC(),
+	)
+}
+
+// GetStorageSnapshots returns all of the storage snapshots in the model.
+func (s *Service) GetStorageSnapshots(
+	ctx context.Context,
+) ([]domainstorage.StorageSnapshotInfo, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.GetStorageSnapshots(ctx)
+}
+
+// GetStorageSnapshotUUIDForID returns the uuid of the storage snapshot with
+// the supplied id.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound] when
+// no storage snapshot exists for the supplied id.
+func (s *Service) GetStorageSnapshotUUIDForID(
+	ctx context.Context, id string,
+) (domainstorage.StorageSnapshotUUID, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.GetStorageSnapshotUUIDForID(ctx, id)
+}
@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	machine "github.com/juju/juju/core/machine"
 	unit "github.com/juju/juju/core/unit"
@@ -161,6 +162,45 @@ func (c *MockStateCreateStoragePoolCall) DoAndReturn(f func(context.Context, int
 	return c
 }
 
+// CreateStorageSnapshot mocks base method.
+func (m *MockState) CreateStorageSnapshot(arg0 context.Context, arg1 storage.StorageSnapshotUUID, arg2 storage.StorageInstanceUUID, arg3 time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageSnapshot indicates an expected call of CreateStorageSnapshot.
+func (mr *MockStateMockRecorder) CreateStorageSnapshot(arg0, arg1, arg2, arg3 any) *MockStateCreateStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageSnapshot", reflect.TypeOf((*MockState)(nil).CreateStorageSnapshot), arg0, arg1, arg2, arg3)
+	return &MockStateCreateStorageSnapshotCall{Call: call}
+}
+
+// MockStateCreateStorageSnapshotCall wrap *gomock.Call
+type MockStateCreateStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCreateStorageSnapshotCall) Return(arg0 string, arg1 error) *MockStateCreateStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCreateStorageSnapshotCall) Do(f func(context.Context, storage.StorageSnapshotUUID, storage.StorageInstanceUUID, time.Time) (string, error)) *MockStateCreateStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCreateStorageSnapshotCall) DoAndReturn(f func(context.Context, storage.StorageSnapshotUUID, storage.StorageInstanceUUID, time.Time) (string, error)) *MockStateCreateStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteStoragePool mocks base method.
 func (m *MockState) DeleteStoragePool(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -550,6 +590,84 @@ func (c *MockStateGetStorageResourceTagInfoForModelCall) DoAndReturn(f func(cont
 	return c
 }
 
+// GetStorageSnapshotUUIDForID mocks base method.
+func (m *MockState) GetStorageSnapshotUUIDForID(arg0 context.Context, arg1 string) (storage.StorageSnapshotUUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotUUIDForID", arg0, arg1)
+	ret0, _ := ret[0].(storage.StorageSnapshotUUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotUUIDForID indicates an expected call of GetStorageSnapshotUUIDForID.
+func (mr *MockStateMockRecorder) GetStorageSnapshotUUIDForID(arg0, arg1 any) *MockStateGetStorageSnapshotUUIDForIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotUUIDForID", reflect.TypeOf((*MockState)(nil).GetStorageSnapshotUUIDForID), arg0, arg1)
+	return &MockStateGetStorageSnapshotUUIDForIDCall{Call: call}
+}
+
+// MockStateGetStorageSnapshotUUIDForIDCall wrap *gomock.Call
+type MockStateGetStorageSnapshotUUIDForIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageSnapshotUUIDForIDCall) Return(arg0 storage.StorageSnapshotUUID, arg1 error) *MockStateGetStorageSnapshotUUIDForIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageSnapshotUUIDForIDCall) Do(f func(context.Context, string) (storage.StorageSnapshotUUID, error)) *MockStateGetStorageSnapshotUUIDForIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageSnapshotUUIDForIDCall) DoAndReturn(f func(context.Context, string) (storage.StorageSnapshotUUID, error)) *MockStateGetStorageSnapshotUUIDForIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageSnapshots mocks base method.
+func (m *MockState) GetStorageSnapshots(arg0 context.Context) ([]storage.StorageSnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshots", arg0)
+	ret0, _ := ret[0].([]storage.StorageSnapshotInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshots indicates an expected call of GetStorageSnapshots.
+func (mr *MockStateMockRecorder) GetStorageSnapshots(arg0 any) *MockStateGetStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshots", reflect.TypeOf((*MockState)(nil).GetStorageSnapshots), arg0)
+	return &MockStateGetStorageSnapshotsCall{Call: call}
+}
+
+// MockStateGetStorageSnapshotsCall wrap *gomock.Call
+type MockStateGetStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageSnapshotsCall) Return(arg0 []storage.StorageSnapshotInfo, arg1 error) *MockStateGetStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageSnapshotsCall) Do(f func(context.Context) ([]storage.StorageSnapshotInfo, error)) *MockStateGetStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageSnapshotsCall) DoAndReturn(f func(context.Context) ([]storage.StorageSnapshotInfo, error)) *MockStateGetStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetVolumeUUIDsByMachines mocks base method.
 func (m *MockState) GetVolumeUUIDsByMachines(arg0 context.Context, arg1 []machine.UUID) ([]storage.VolumeUUID, error) {
 	m.ctrl.T.Helper()
@@ -783,6 +901,44 @@ func (c *MockStateReplaceStoragePoolCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// ResizeStorageInstance mocks base method.
+func (m *MockState) ResizeStorageInstance(arg0 context.Context, arg1 storage.StorageInstanceUUID, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeStorageInstance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeStorageInstance indicates an expected call of ResizeStorageInstance.
+func (mr *MockStateMockRecorder) ResizeStorageInstance(arg0, arg1, arg2 any) *MockStateResizeStorageInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeStorageInstance", reflect.TypeOf((*MockState)(nil).ResizeStorageInstance), arg0, arg1, arg2)
+	return &MockStateResizeStorageInstanceCall{Call: call}
+}
+
+// MockStateResizeStorageInstanceCall wrap *gomock.Call
+type MockStateResizeStorageInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateResizeStorageInstanceCall) Return(arg0 error) *MockStateResizeStorageInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateResizeStorageInstanceCall) Do(f func(context.Context, storage.StorageInstanceUUID, uint64) error) *MockStateResizeStorageInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateResizeStorageInstanceCall) DoAndReturn(f func(context.Context, storage.StorageInstanceUUID, uint64) error) *MockStateResizeStorageInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockStoragePoolState is a mock of StoragePoolState interface.
 type MockStoragePoolState struct {
 	ctrl     *gomock.Controller
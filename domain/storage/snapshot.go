@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"time"
+)
+
+// StorageSnapshotStatus describes where a storage snapshot is in its
+// lifecycle. The values of this type line up with the
+// storage_snapshot_status_value table in the model database.
+type StorageSnapshotStatus int
+
+// StorageSnapshotUUID uniquely identifies a storage snapshot in the model.
+type StorageSnapshotUUID baseUUID
+
+const (
+	// StorageSnapshotStatusPending indicates that the snapshot is waiting for
+	// the unit the storage is attached to to run its storage-snapshot-pre
+	// hook.
+	StorageSnapshotStatusPending StorageSnapshotStatus = iota
+
+	// StorageSnapshotStatusReady indicates that the snapshot is ready to be
+	// taken by the storage provisioner.
+	StorageSnapshotStatusReady
+
+	// StorageSnapshotStatusCreated indicates that the snapshot has been taken
+	// and is waiting for the unit the storage is attached to to run its
+	// storage-snapshot-post hook.
+	StorageSnapshotStatusCreated
+
+	// StorageSnapshotStatusAvailable indicates that the snapshot has been
+	// taken and can be used to create new storage.
+	StorageSnapshotStatusAvailable
+
+	// StorageSnapshotStatusFailed indicates that the snapshot could not be
+	// taken and is waiting for the unit the storage is attached to to run its
+	// storage-snapshot-post hook.
+	StorageSnapshotStatusFailed
+
+	// StorageSnapshotStatusError indicates that the snapshot could not be
+	// taken.
+	StorageSnapshotStatusError
+)
+
+// StorageSnapshotInfo describes a single storage snapshot in the model.
+type StorageSnapshotInfo struct {
+	// UUID is the unique identifier of the snapshot.
+	UUID StorageSnapshotUUID
+
+	// ID is the user facing id of the snapshot.
+	ID string
+
+	// StorageID is the id of the storage instance the snapshot was taken of.
+	StorageID string
+
+	// StorageName is the charm storage name of the storage instance the
+	// snapshot was taken of.
+	StorageName string
+
+	// Kind is the kind of the storage instance the snapshot was taken of.
+	Kind StorageKind
+
+	// PoolName is the name of the storage pool of the storage instance the
+	// snapshot was taken of.
+	PoolName string
+
+	// UnitName is the name of the unit the storage was attached to when the
+	// snapshot was requested. It is empty when the storage was not attached.
+	UnitName string
+
+	// Status is the current status of the snapshot.
+	Status StorageSnapshotStatus
+
+	// Message is an optional message explaining the status of the snapshot.
+	Message string
+
+	// SizeMiB is the size of the snapshot. It is zero until the snapshot
+	// has been taken.
+	SizeMiB uint64
+
+	// CreatedAt is the time the snapshot was requested.
+	CreatedAt time.Time
+}
+
+// NewStorageSnapshotUUID creates a new, valid storage snapshot identifier.
+func NewStorageSnapshotUUID() (StorageSnapshotUUID, error) {
+	u, err := newUUID()
+	return StorageSnapshotUUID(u), err
+}
+
+// String returns the string representation of this [StorageSnapshotUUID].
+// This function satisfies the [fmt.Stringer] interface.
+func (u StorageSnapshotUUID) String() string {
+	return baseUUID(u).String()
+}
+
+// Validate returns an error if the [StorageSnapshotUUID] is not valid.
+func (u StorageSnapshotUUID) Validate() error {
+	return baseUUID(u).validate()
+}
+
+// String returns the string representation of [StorageSnapshotStatus]. The
+// value returned matches the status recorded in the model database.
+//
+// If the value of [StorageSnapshotStatus] is not known a zero value string
+// will be returned.
+func (s StorageSnapshotStatus) String() string {
+	switch s {
+	case StorageSnapshotStatusPending:
+		return "pending"
+	case StorageSnapshotStatusReady:
+		return "ready"
+	case StorageSnapshotStatusCreated:
+		return "created"
+	case StorageSnapshotStatusAvailable:
+		return "available"
+	case StorageSnapshotStatusFailed:
+		return "failed"
+	case StorageSnapshotStatusError:
+		return "error"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+// storageSnapshotUUIDSuite is a suite of tests for asserting the behaviour of
+// [StorageSnapshotUUID].
+type storageSnapshotUUIDSuite struct{}
+
+// storageSnapshotStatusSuite is a test suite for asserting assumptions about
+// [StorageSnapshotStatus] and making sure that it is aligned with the Juju
+// model database.
+type storageSnapshotStatusSuite struct {
+	schematesting.ModelSuite
+}
+
+// TestStorageSnapshotUUIDSuite runs all of the tests contained within
+// [storageSnapshotUUIDSuite].
+func TestStorageSnapshotUUIDSuite(t *testing.T) {
+	tc.Run(t, storageSnapshotUUIDSuite{})
+}
+
+// TestStorageSnapshotStatusSuite runs all of the tests in the
+// [storageSnapshotStatusSuite].
+func TestStorageSnapshotStatusSuite(t *testing.T) {
+	tc.Run(t, &storageSnapshotStatusSuite{})
+}
+
+// TestNew tests that constructing a new [StorageSnapshotUUID] succeeds with no
+// errors and the end result is valid.
+func (storageSnapshotUUIDSuite) TestNew(c *tc.C) {
+	u, err := NewStorageSnapshotUUID()
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(u.Validate(), tc.ErrorIsNil)
+}
+
+// TestStringer asserts the [fmt.Stringer] interface of [StorageSnapshotUUID]
+// by making sure the correct string representation of the uuid is returned to
+// the caller.
+func (storageSnapshotUUIDSuite) TestStringer(c *tc.C) {
+	const validUUID = "0de7ed80-bfcf-49b1-876a-31462e940ca1"
+	c.Check(StorageSnapshotUUID(validUUID).String(), tc.Equals, validUUID)
+}
+
+// TestValidate asserts that a valid uuid passes validation with no errors.
+func (storageSnapshotUUIDSuite) TestValidate(c *tc.C) {
+	const validUUID = "0de7ed80-bfcf-49b1-876a-31462e940ca1"
+	c.Check(StorageSnapshotUUID(validUUID).Validate(), tc.ErrorIsNil)
+}
+
+// TestValidateFail asserts that a bad uuid fails validation.
+func (storageSnapshotUUIDSuite) TestValidateFail(c *tc.C) {
+	c.Check(StorageSnapshotUUID("invalid").Validate(), tc.NotNil)
+}
+
+// TestStorageSnapshotStatusValuesAlignedToDB asserts that the storage
+// snapshot status values that exist in the database schema align with the
+// enum values defined in this package.
+//
+// If this test fails it indicates that either a new value has been added to
+// the schema and a new enum needs to be created or a value has been modified
+// or removed that will result in a breaking change.
+func (s *storageSnapshotStatusSuite) TestStorageSnapshotStatusValuesAlignedToDB(c *tc.C) {
+	rows, err := s.DB().QueryContext(
+		c.Context(),
+		"SELECT id, status FROM storage_snapshot_status_value",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rows.Close() }()
+
+	dbValues := map[StorageSnapshotStatus]string{}
+	for rows.Next() {
+		var (
+			id     int
+			status string
+		)
+
+		c.Assert(rows.Scan(&id, &status), tc.ErrorIsNil)
+		dbValues[StorageSnapshotStatus(id)] = status
+	}
+
+	expected := map[StorageSnapshotStatus]string{}
+	for _, v := range []StorageSnapshotStatus{
+		StorageSnapshotStatusPending,
+		StorageSnapshotStatusReady,
+		StorageSnapshotStatusCreated,
+		StorageSnapshotStatusAvailable,
+		StorageSnapshotStatusFailed,
+		StorageSnapshotStatusError,
+	} {
+		expected[v] = v.String()
+	}
+	c.Check(dbValues, tc.DeepEquals, expected)
+}
+
+// TestStringerForUnknownValue asserts that [StorageSnapshotStatus.String]
+// returns a zero value string when the value is not known.
+func (s *storageSnapshotStatusSuite) TestStringerForUnknownValue(c *tc.C) {
+	c.Check(StorageSnapshotStatus(-1).String(), tc.Equals, "")
+}
@@ -287,6 +287,82 @@ WHERE  storage_id = $storageInstanceID.storage_id`,
 	return domainstorage.StorageInstanceUUID(dbVal.UUID), nil
 }
 
+// ResizeStorageInstance sets the requested size of the storage instance to
+// sizeMiB. Setting the requested size to the current requested size is a
+// no-op.
+//
+// The following errors may be returned:
+// - [domainstorageerrors.StorageInstanceNotFound] when no storage instance
+// exists for the supplied uuid.
+// - [domainstorageerrors.StorageInstanceNotAlive] when the storage instance
+// is not alive.
+// - [domainstorageerrors.StorageInstanceShrinkNotSupported] when sizeMiB is
+// less than the current requested size of the storage instance.
+func (s *State) ResizeStorageInstance(
+	ctx context.Context,
+	uuid domainstorage.StorageInstanceUUID,
+	sizeMiB uint64,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var (
+		input = entityUUID{UUID: uuid.String()}
+		dbVal storageInstanceRequestedSize
+	)
+
+	selectStmt, err := s.Prepare(`
+SELECT &storageInstanceRequestedSize.*
+FROM   storage_instance
+WHERE  uuid = $entityUUID.uuid`,
+		input, dbVal,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	updateStmt, err := s.Prepare(`
+UPDATE storage_instance
+SET    requested_size_mib = $storageInstanceRequestedSize.requested_size_mib
+WHERE  uuid = $storageInstanceRequestedSize.uuid`,
+		dbVal,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, selectStmt, input).Get(&dbVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage instance %q does not exist", uuid,
+			).Add(domainstorageerrors.StorageInstanceNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		if domainlife.Life(dbVal.LifeID) != domainlife.Alive {
+			return errors.Errorf(
+				"storage instance %q is not alive", uuid,
+			).Add(domainstorageerrors.StorageInstanceNotAlive)
+		}
+		if sizeMiB < dbVal.RequestedSizeMiB {
+			return errors.Errorf(
+				"storage instance %q cannot be resized from %dMiB to %dMiB",
+				uuid, dbVal.RequestedSizeMiB, sizeMiB,
+			).Add(domainstorageerrors.StorageInstanceShrinkNotSupported)
+		}
+		if sizeMiB == dbVal.RequestedSizeMiB {
+			return nil
+		}
+
+		dbVal.RequestedSizeMiB = sizeMiB
+		return tx.Query(ctx, updateStmt, dbVal).Run()
+	})
+}
+
 // GetStorageInstanceUUIDsByIDs retrieves the UUIDs of storage instances by
 // their IDs.
 func (s *State) GetStorageInstanceUUIDsByIDs(
@@ -51,6 +51,74 @@ func (s *instanceSuite) TestGetStorageInstanceUUIDByIDNotFound(c *tc.C) {
 	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotFound)
 }
 
+// TestResizeStorageInstance tests that resizing a storage instance updates
+// its requested size.
+func (s *instanceSuite) TestResizeStorageInstance(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "token",
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.ResizeStorageInstance(c.Context(), uuid, 2048)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var size uint64
+	err = s.DB().QueryRowContext(
+		c.Context(),
+		"SELECT requested_size_mib FROM storage_instance WHERE uuid = ?",
+		uuid.String(),
+	).Scan(&size)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(size, tc.Equals, uint64(2048))
+}
+
+// TestResizeStorageInstanceShrink tests that resizing a storage instance to
+// less than its requested size returns an error satisfying
+// [domainstorageerrors.StorageInstanceShrinkNotSupported].
+func (s *instanceSuite) TestResizeStorageInstanceShrink(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "token",
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.ResizeStorageInstance(c.Context(), uuid, 50)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceShrinkNotSupported)
+}
+
+// TestResizeStorageInstanceNotAlive tests that resizing a storage instance
+// that is not alive returns an error satisfying
+// [domainstorageerrors.StorageInstanceNotAlive].
+func (s *instanceSuite) TestResizeStorageInstanceNotAlive(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "token",
+	)
+	_, err := s.DB().Exec(
+		"UPDATE storage_instance SET life_id = 1 WHERE uuid = ?", uuid.String(),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory())
+	err = st.ResizeStorageInstance(c.Context(), uuid, 2048)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotAlive)
+}
+
+// TestResizeStorageInstanceNotFound tests that resizing a storage instance
+// that does not exist returns an error satisfying
+// [domainstorageerrors.StorageInstanceNotFound].
+func (s *instanceSuite) TestResizeStorageInstanceNotFound(c *tc.C) {
+	uuid := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.ResizeStorageInstance(c.Context(), uuid, 2048)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotFound)
+}
+
 func (s *instanceSuite) TestGetStorageInstanceUUIDsByIDs(c *tc.C) {
 	charmUUID := s.newCharm(c)
 	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
@@ -0,0 +1,271 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	domainlife "github.com/juju/juju/domain/life"
+	sequencestate "github.com/juju/juju/domain/sequence/state"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// CreateStorageSnapshot records a new snapshot of the storage instance,
+// returning the id allocated to the snapshot.
+//
+// When the storage instance is attached to a unit the snapshot is recorded
+// as pending, so that the unit can run its storage-snapshot-pre hook before
+// the snapshot is taken. Otherwise the snapshot is immediately ready to be
+// taken by the storage provisioner.
+//
+// The following errors may be returned:
+// - [domainstorageerrors.StorageInstanceNotFound] when no storage instance
+// exists for the supplied uuid.
+// - [domainstorageerrors.StorageInstanceNotAlive] when the storage instance
+// is not alive.
+// - [domainstorageerrors.StorageInstanceNotSnapshottable] when the storage
+// instance is not backed by a provisioned volume.
+func (s *State) CreateStorageSnapshot(
+	ctx context.Context,
+	uuid domainstorage.StorageSnapshotUUID,
+	storageUUID domainstorage.StorageInstanceUUID,
+	createdAt time.Time,
+) (string, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var (
+		input     = entityUUID{UUID: storageUUID.String()}
+		sourceVal storageSnapshotSource
+		unitVal   unitUUID
+	)
+
+	sourceStmt, err := s.Prepare(`
+SELECT &storageSnapshotSource.*
+FROM (
+    SELECT    si.uuid,
+              si.storage_id,
+              si.storage_name,
+              si.storage_kind_id,
+              si.storage_pool_uuid,
+              si.life_id,
+              sv.uuid AS storage_volume_uuid,
+              sv.provider_id AS storage_volume_provider_id
+    FROM      storage_instance si
+    LEFT JOIN storage_instance_volume siv ON si.uuid = siv.storage_instance_uuid
+    LEFT JOIN storage_volume sv ON siv.storage_volume_uuid = sv.uuid
+    WHERE     si.uuid = $entityUUID.uuid
+)`,
+		input, sourceVal,
+	)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	unitStmt, err := s.Prepare(`
+SELECT sa.unit_uuid AS &unitUUID.uuid
+FROM   storage_attachment sa
+WHERE  sa.storage_instance_uuid = $entityUUID.uuid
+AND    sa.life_id = 0
+LIMIT  1`,
+		input, unitVal,
+	)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	insertStmt, err := s.Prepare(`
+INSERT INTO storage_snapshot (*) VALUES ($insertStorageSnapshot.*)`,
+		insertStorageSnapshot{},
+	)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var snapshotID string
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, sourceStmt, input).Get(&sourceVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage instance %q does not exist", storageUUID,
+			).Add(domainstorageerrors.StorageInstanceNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		if domainlife.Life(sourceVal.LifeID) != domainlife.Alive {
+			return errors.Errorf(
+				"storage instance %q is not alive", storageUUID,
+			).Add(domainstorageerrors.StorageInstanceNotAlive)
+		}
+		if !sourceVal.VolumeUUID.Valid ||
+			!sourceVal.VolumeProviderID.Valid ||
+			sourceVal.VolumeProviderID.String == "" {
+			return errors.Errorf(
+				"storage instance %q is not backed by a provisioned volume",
+				storageUUID,
+			).Add(domainstorageerrors.StorageInstanceNotSnapshottable)
+		}
+
+		status := domainstorage.StorageSnapshotStatusReady
+		var unit sql.NullString
+		err = tx.Query(ctx, unitStmt, input).Get(&unitVal)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"getting unit attached to storage instance %q: %w",
+				storageUUID, err,
+			)
+		} else if err == nil {
+			// The unit must quiesce the storage in its
+			// storage-snapshot-pre hook before the snapshot is taken.
+			status = domainstorage.StorageSnapshotStatusPending
+			unit = sql.NullString{String: unitVal.UUID, Valid: true}
+		}
+
+		id, err := sequencestate.NextValue(
+			ctx, s, tx, domainstorage.StorageSnapshotSequenceNamespace,
+		)
+		if err != nil {
+			return errors.Errorf("getting next storage snapshot id: %w", err)
+		}
+		snapshotID = strconv.FormatUint(id, 10)
+
+		return tx.Query(ctx, insertStmt, insertStorageSnapshot{
+			UUID:                uuid.String(),
+			SnapshotID:          snapshotID,
+			StorageInstanceUUID: sourceVal.UUID,
+			StorageID:           sourceVal.StorageID,
+			StorageName:         sourceVal.StorageName,
+			StorageKindID:       sourceVal.StorageKindID,
+			StoragePoolUUID:     sourceVal.StoragePoolUUID,
+			StorageVolumeUUID:   sourceVal.VolumeUUID.String,
+			UnitUUID:            unit,
+			StatusID:            int(status),
+			CreatedAt:           createdAt,
+			UpdatedAt:           createdAt,
+		}).Run()
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return snapshotID, nil
+}
+
+// GetStorageSnapshots returns all of the storage snapshots in the model,
+// ordered by the time they were requested.
+func (s *State) GetStorageSnapshots(
+	ctx context.Context,
+) ([]domainstorage.StorageSnapshotInfo, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &storageSnapshotInfo.*
+FROM (
+    SELECT    ss.uuid,
+              ss.snapshot_id,
+              ss.storage_id,
+              ss.storage_name,
+              ss.storage_kind_id,
+              sp.name AS pool_name,
+              u.name AS unit_name,
+              ss.status_id,
+              ss.message,
+              ss.size_mib,
+              ss.created_at
+    FROM      storage_snapshot ss
+    JOIN      storage_pool sp ON ss.storage_pool_uuid = sp.uuid
+    LEFT JOIN unit u ON ss.unit_uuid = u.uuid
+)
+ORDER BY created_at, CAST(snapshot_id AS INTEGER)`,
+		storageSnapshotInfo{},
+	)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var dbVals []storageSnapshotInfo
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&dbVals)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rval := make([]domainstorage.StorageSnapshotInfo, 0, len(dbVals))
+	for _, v := range dbVals {
+		rval = append(rval, domainstorage.StorageSnapshotInfo{
+			UUID:        domainstorage.StorageSnapshotUUID(v.UUID),
+			ID:          v.SnapshotID,
+			StorageID:   v.StorageID,
+			StorageName: v.StorageName,
+			Kind:        domainstorage.StorageKind(v.KindID),
+			PoolName:    v.PoolName,
+			UnitName:    v.UnitName.String,
+			Status:      domainstorage.StorageSnapshotStatus(v.StatusID),
+			Message:     v.Message.String,
+			SizeMiB:     uint64(v.SizeMiB.Int64),
+			CreatedAt:   v.CreatedAt,
+		})
+	}
+	return rval, nil
+}
+
+// GetStorageSnapshotUUIDForID returns the uuid of the storage snapshot with
+// the supplied id.
+//
+// The following errors may be returned:
+// - [domainstorageerrors.StorageSnapshotNotFound] when no storage snapshot
+// exists for the supplied id.
+func (s *State) GetStorageSnapshotUUIDForID(
+	ctx context.Context, id string,
+) (domainstorage.StorageSnapshotUUID, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var (
+		input = storageSnapshotID{ID: id}
+		dbVal entityUUID
+	)
+	stmt, err := s.Prepare(`
+SELECT &entityUUID.*
+FROM   storage_snapshot
+WHERE  snapshot_id = $storageSnapshotID.snapshot_id`,
+		input, dbVal,
+	)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, input).Get(&dbVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage snapshot %q does not exist", id,
+			).Add(domainstorageerrors.StorageSnapshotNotFound)
+		}
+		return err
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return domainstorage.StorageSnapshotUUID(dbVal.UUID), nil
+}
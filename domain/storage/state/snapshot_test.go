@@ -0,0 +1,197 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreunit "github.com/juju/juju/core/unit"
+	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageerrors "github.com/juju/juju/domain/storage/errors"
+)
+
+// snapshotSuite is a test suite for asserting storage snapshot based
+// interfaces in this package.
+type snapshotSuite struct {
+	baseSuite
+}
+
+// TestSnapshotSuite runs the tests contained within [snapshotSuite].
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+// newProvisionedBlockStorageInstance creates a new block storage instance
+// backed by a model volume that has been provisioned with the supplied
+// provider id.
+func (s *snapshotSuite) newProvisionedBlockStorageInstance(
+	c *tc.C, providerID string,
+) (domainstorage.StorageInstanceUUID, string) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	uuid, id := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+	volumeUUID := s.newModelVolume(c, uuid)
+	_, err := s.DB().Exec(
+		"UPDATE storage_volume SET provider_id = ? WHERE uuid = ?",
+		providerID, volumeUUID.String(),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	return uuid, id
+}
+
+// TestCreateStorageSnapshotNotAttached tests that a snapshot of storage that
+// is not attached to a unit is immediately ready to be taken.
+func (s *snapshotSuite) TestCreateStorageSnapshotNotAttached(c *tc.C) {
+	storageUUID, storageID := s.newProvisionedBlockStorageInstance(c, "vol-0")
+	snapshotUUID := tc.Must(c, domainstorage.NewStorageSnapshotUUID)
+	now := time.Now().UTC()
+
+	st := NewState(s.TxnRunnerFactory())
+	id, err := st.CreateStorageSnapshot(c.Context(), snapshotUUID, storageUUID, now)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "0")
+
+	snapshots, err := st.GetStorageSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshots, tc.DeepEquals, []domainstorage.StorageSnapshotInfo{{
+		UUID:        snapshotUUID,
+		ID:          "0",
+		StorageID:   storageID,
+		StorageName: "data",
+		Kind:        domainstorage.StorageKindBlock,
+		PoolName:    "pool1",
+		Status:      domainstorage.StorageSnapshotStatusReady,
+		CreatedAt:   now,
+	}})
+}
+
+// TestCreateStorageSnapshotAttached tests that a snapshot of storage that is
+// attached to a unit waits for the unit's storage-snapshot-pre hook.
+func (s *snapshotSuite) TestCreateStorageSnapshotAttached(c *tc.C) {
+	storageUUID, _ := s.newProvisionedBlockStorageInstance(c, "vol-0")
+	unitUUID := s.newUnit(c)
+	s.newStorageAttachment(c, storageUUID, unitUUID)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.CreateStorageSnapshot(
+		c.Context(),
+		tc.Must(c, domainstorage.NewStorageSnapshotUUID),
+		storageUUID,
+		time.Now().UTC(),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var unitName coreunit.Name
+	err = s.DB().QueryRowContext(
+		c.Context(),
+		"SELECT name FROM unit WHERE uuid = ?",
+		unitUUID.String(),
+	).Scan(&unitName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	snapshots, err := st.GetStorageSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(snapshots, tc.HasLen, 1)
+	c.Check(snapshots[0].Status, tc.Equals, domainstorage.StorageSnapshotStatusPending)
+	c.Check(snapshots[0].UnitName, tc.Equals, unitName.String())
+}
+
+// TestCreateStorageSnapshotSequence tests that each snapshot is allocated the
+// next id in sequence and that snapshots are listed in the order requested.
+func (s *snapshotSuite) TestCreateStorageSnapshotSequence(c *tc.C) {
+	storageUUID, _ := s.newProvisionedBlockStorageInstance(c, "vol-0")
+	now := time.Now().UTC()
+
+	st := NewState(s.TxnRunnerFactory())
+	for i := range 3 {
+		_, err := st.CreateStorageSnapshot(
+			c.Context(),
+			tc.Must(c, domainstorage.NewStorageSnapshotUUID),
+			storageUUID,
+			now.Add(time.Duration(i)*time.Second),
+		)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	snapshots, err := st.GetStorageSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(snapshots, tc.HasLen, 3)
+	for i, snapshot := range snapshots {
+		c.Check(snapshot.ID, tc.Equals, []string{"0", "1", "2"}[i])
+	}
+
+	uuid, err := st.GetStorageSnapshotUUIDForID(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(uuid, tc.Equals, snapshots[1].UUID)
+}
+
+// TestCreateStorageSnapshotNotProvisioned tests that requesting a snapshot of
+// storage whose volume has not been provisioned returns an error satisfying
+// [domainstorageerrors.StorageInstanceNotSnapshottable].
+func (s *snapshotSuite) TestCreateStorageSnapshotNotProvisioned(c *tc.C) {
+	charmUUID := s.newCharm(c)
+	poolUUID := s.newStoragePool(c, "pool1", "myprovider", nil)
+	storageUUID, _ := s.newBlockStorageInstanceForCharmWithPool(
+		c, charmUUID, poolUUID, "data",
+	)
+	s.newModelVolume(c, storageUUID)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.CreateStorageSnapshot(
+		c.Context(),
+		tc.Must(c, domainstorage.NewStorageSnapshotUUID),
+		storageUUID,
+		time.Now().UTC(),
+	)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotSnapshottable)
+}
+
+// TestCreateStorageSnapshotNotAlive tests that requesting a snapshot of
+// storage that is not alive returns an error satisfying
+// [domainstorageerrors.StorageInstanceNotAlive].
+func (s *snapshotSuite) TestCreateStorageSnapshotNotAlive(c *tc.C) {
+	storageUUID, _ := s.newProvisionedBlockStorageInstance(c, "vol-0")
+	_, err := s.DB().Exec(
+		"UPDATE storage_instance SET life_id = 1 WHERE uuid = ?",
+		storageUUID.String(),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err = st.CreateStorageSnapshot(
+		c.Context(),
+		tc.Must(c, domainstorage.NewStorageSnapshotUUID),
+		storageUUID,
+		time.Now().UTC(),
+	)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotAlive)
+}
+
+// TestCreateStorageSnapshotNotFound tests that requesting a snapshot of
+// storage that does not exist returns an error satisfying
+// [domainstorageerrors.StorageInstanceNotFound].
+func (s *snapshotSuite) TestCreateStorageSnapshotNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.CreateStorageSnapshot(
+		c.Context(),
+		tc.Must(c, domainstorage.NewStorageSnapshotUUID),
+		tc.Must(c, domainstorage.NewStorageInstanceUUID),
+		time.Now().UTC(),
+	)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageInstanceNotFound)
+}
+
+// TestGetStorageSnapshotUUIDForIDNotFound tests that getting the uuid of a
+// snapshot that does not exist returns an error satisfying
+// [domainstorageerrors.StorageSnapshotNotFound].
+func (s *snapshotSuite) TestGetStorageSnapshotUUIDForIDNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.GetStorageSnapshotUUIDForID(c.Context(), "42")
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StorageSnapshotNotFound)
+}
@@ -67,6 +67,14 @@ type storageInstanceUUIDAndID struct {
 
 type storageInstanceIDs []string
 
+// storageInstanceRequestedSize represents the life and requested size of a
+// storage instance in the storage_instance table.
+type storageInstanceRequestedSize struct {
+	UUID             string `db:"uuid"`
+	LifeID           int    `db:"life_id"`
+	RequestedSizeMiB uint64 `db:"requested_size_mib"`
+}
+
 // dbModelStoragePool represents a single row from the model_storage_pool table.
 type dbModelStoragePool struct {
 	StoragePoolUUID string `db:"storage_pool_uuid"`
@@ -291,3 +299,55 @@ type insertStorageVolumeStatus struct {
 	Message           string    `db:"message"`
 	UpdatedAt         time.Time `db:"updated_at"`
 }
+
+// storageSnapshotSource represents the values of a storage instance, and the
+// volume backing it, required to record a snapshot of the storage instance.
+type storageSnapshotSource struct {
+	UUID             string         `db:"uuid"`
+	StorageID        string         `db:"storage_id"`
+	StorageName      string         `db:"storage_name"`
+	StorageKindID    int            `db:"storage_kind_id"`
+	StoragePoolUUID  string         `db:"storage_pool_uuid"`
+	LifeID           int            `db:"life_id"`
+	VolumeUUID       sql.NullString `db:"storage_volume_uuid"`
+	VolumeProviderID sql.NullString `db:"storage_volume_provider_id"`
+}
+
+// insertStorageSnapshot represents the values required for inserting a new
+// row into the storage_snapshot table.
+type insertStorageSnapshot struct {
+	UUID                string         `db:"uuid"`
+	SnapshotID          string         `db:"snapshot_id"`
+	StorageInstanceUUID string         `db:"storage_instance_uuid"`
+	StorageID           string         `db:"storage_id"`
+	StorageName         string         `db:"storage_name"`
+	StorageKindID       int            `db:"storage_kind_id"`
+	StoragePoolUUID     string         `db:"storage_pool_uuid"`
+	StorageVolumeUUID   string         `db:"storage_volume_uuid"`
+	UnitUUID            sql.NullString `db:"unit_uuid"`
+	StatusID            int            `db:"status_id"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+}
+
+// storageSnapshotInfo represents a single storage snapshot in the model along
+// with the names of the storage pool and unit it refers to.
+type storageSnapshotInfo struct {
+	UUID        string         `db:"uuid"`
+	SnapshotID  string         `db:"snapshot_id"`
+	StorageID   string         `db:"storage_id"`
+	StorageName string         `db:"storage_name"`
+	KindID      int            `db:"storage_kind_id"`
+	PoolName    string         `db:"pool_name"`
+	UnitName    sql.NullString `db:"unit_name"`
+	StatusID    int            `db:"status_id"`
+	Message     sql.NullString `db:"message"`
+	SizeMiB     sql.NullInt64  `db:"size_mib"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
+
+// storageSnapshotID represents the snapshot_id column for a row in the
+// storage_snapshot table.
+type storageSnapshotID struct {
+	ID string `db:"snapshot_id"`
+}
@@ -278,6 +278,10 @@ type AddUnitStorageOverride struct {
 
 	// SizeMiB is the size of the storage instance, in MiB.
 	SizeMiB *uint64
+
+	// SnapshotUUID is the storage snapshot that the new storage instances
+	// are to be created from.
+	SnapshotUUID *StorageSnapshotUUID
 }
 
 // UnitAddStorageArg represents the arguments required to add storage to a
@@ -466,6 +470,10 @@ type CreateUnitStorageVolumeArg struct {
 	// ProvisionScope describes the provision scope to assign to the newly
 	// created volume.
 	ProvisionScope ProvisionScope
+
+	// SnapshotUUID, if set, is the storage snapshot that the volume is to be
+	// created from.
+	SnapshotUUID *StorageSnapshotUUID
 }
 
 // CreateUnitStorageVolumeAttachmentArg describes a volume attachment that
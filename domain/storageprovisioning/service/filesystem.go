@@ -179,6 +179,28 @@ type FilesystemState interface {
 	// model provisioned filesystems in the model.
 	InitialWatchStatementModelProvisionedFilesystems() (string, string, eventsource.NamespaceQuery)
 
+	// InitialWatchStatementMachineProvisionedFilesystemResizes returns both
+	// the namespace for watching requested size changes of filesystems that
+	// are machine provisioned and the initial query for getting the set of
+	// filesystems provisioned by the supplied net node that are smaller than
+	// their requested size.
+	InitialWatchStatementMachineProvisionedFilesystemResizes(
+		domainnetwork.NetNodeUUID,
+	) (string, eventsource.NamespaceQuery)
+
+	// InitialWatchStatementModelProvisionedFilesystemResizes returns both the
+	// namespace for watching requested size changes of filesystems that are
+	// model provisioned and the initial query for getting the set of model
+	// provisioned filesystems that are smaller than their requested size.
+	InitialWatchStatementModelProvisionedFilesystemResizes() (string, eventsource.NamespaceQuery)
+
+	// GetFilesystemIDsPendingResizeForNetNode returns the ids of the machine
+	// provisioned filesystems to be provisioned by the machine owning the
+	// supplied net node that are smaller than their requested size.
+	GetFilesystemIDsPendingResizeForNetNode(
+		context.Context, domainnetwork.NetNodeUUID,
+	) ([]string, error)
+
 	// InitialWatchStatementMachineProvisionedFilesystemAttachments returns
 	// both the namespace for watching filesystem attachment life changes where
 	// the filesystem attachment is machine provisioned and the initial query
@@ -793,6 +815,67 @@ func (s *Service) WatchMachineProvisionedFilesystems(
 	return w, nil
 }
 
+// WatchModelProvisionedFilesystemResizes returns a watcher that emits
+// filesystem IDs, whenever the requested size of a model provisioned
+// filesystem changes. The initial event contains the filesystems that are
+// smaller than their requested size.
+func (s *Service) WatchModelProvisionedFilesystemResizes(
+	ctx context.Context,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	ns, initialQuery := s.st.InitialWatchStatementModelProvisionedFilesystemResizes()
+	return s.watcherFactory.NewNamespaceWatcher(
+		ctx,
+		initialQuery,
+		"model provisioned filesystem resize watcher",
+		eventsource.NamespaceFilter(ns, corechangestream.All))
+}
+
+// WatchMachineProvisionedFilesystemResizes returns a watcher that emits the
+// IDs of the given machine's provisioned filesystems that are smaller than
+// their requested size, whenever the requested size of one of the filesystems
+// changes.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the provided machine uuid is not valid.
+// - [machineerrors.MachineNotFound] when no machine exists for the provided
+// machine UUUID.
+func (s *Service) WatchMachineProvisionedFilesystemResizes(
+	ctx context.Context, machineUUID coremachine.UUID,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := machineUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	netNodeUUID, err := s.st.GetMachineNetNodeUUID(ctx, machineUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ns, initialQuery := s.st.InitialWatchStatementMachineProvisionedFilesystemResizes(netNodeUUID)
+	mapper := func(ctx context.Context, _ []corechangestream.ChangeEvent) ([]string, error) {
+		return s.st.GetFilesystemIDsPendingResizeForNetNode(ctx, netNodeUUID)
+	}
+	filter := eventsource.PredicateFilter(
+		ns, corechangestream.All, eventsource.EqualsPredicate(netNodeUUID.String()),
+	)
+
+	w, err := s.watcherFactory.NewNamespaceMapperWatcher(
+		ctx,
+		initialQuery,
+		fmt.Sprintf("machine provisioned filesystem resize watcher for %q", machineUUID),
+		mapper, filter)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return w, nil
+}
+
 // WatchModelProvisionedFilesystemAttachments returns a watcher that emits
 // filesystem attachment UUIDs, whenever a model provisioned filsystem
 // attachment's life changes.
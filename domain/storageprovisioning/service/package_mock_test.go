@@ -556,6 +556,45 @@ func (c *MockStateGetFilesystemAttachmentUUIDForFilesystemNetNodeCall) DoAndRetu
 	return c
 }
 
+// GetFilesystemIDsPendingResizeForNetNode mocks base method.
+func (m *MockState) GetFilesystemIDsPendingResizeForNetNode(arg0 context.Context, arg1 network.NetNodeUUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesystemIDsPendingResizeForNetNode", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesystemIDsPendingResizeForNetNode indicates an expected call of GetFilesystemIDsPendingResizeForNetNode.
+func (mr *MockStateMockRecorder) GetFilesystemIDsPendingResizeForNetNode(arg0, arg1 any) *MockStateGetFilesystemIDsPendingResizeForNetNodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesystemIDsPendingResizeForNetNode", reflect.TypeOf((*MockState)(nil).GetFilesystemIDsPendingResizeForNetNode), arg0, arg1)
+	return &MockStateGetFilesystemIDsPendingResizeForNetNodeCall{Call: call}
+}
+
+// MockStateGetFilesystemIDsPendingResizeForNetNodeCall wrap *gomock.Call
+type MockStateGetFilesystemIDsPendingResizeForNetNodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetFilesystemIDsPendingResizeForNetNodeCall) Return(arg0 []string, arg1 error) *MockStateGetFilesystemIDsPendingResizeForNetNodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetFilesystemIDsPendingResizeForNetNodeCall) Do(f func(context.Context, network.NetNodeUUID) ([]string, error)) *MockStateGetFilesystemIDsPendingResizeForNetNodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetFilesystemIDsPendingResizeForNetNodeCall) DoAndReturn(f func(context.Context, network.NetNodeUUID) ([]string, error)) *MockStateGetFilesystemIDsPendingResizeForNetNodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetFilesystemLife mocks base method.
 func (m *MockState) GetFilesystemLife(arg0 context.Context, arg1 storage.FilesystemUUID) (life.Life, error) {
 	m.ctrl.T.Helper()
@@ -907,6 +946,45 @@ func (c *MockStateGetMachineNetNodeUUIDCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetModelProvisionedStorageSnapshotIDsReady mocks base method.
+func (m *MockState) GetModelProvisionedStorageSnapshotIDsReady(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelProvisionedStorageSnapshotIDsReady", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelProvisionedStorageSnapshotIDsReady indicates an expected call of GetModelProvisionedStorageSnapshotIDsReady.
+func (mr *MockStateMockRecorder) GetModelProvisionedStorageSnapshotIDsReady(arg0 any) *MockStateGetModelProvisionedStorageSnapshotIDsReadyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelProvisionedStorageSnapshotIDsReady", reflect.TypeOf((*MockState)(nil).GetModelProvisionedStorageSnapshotIDsReady), arg0)
+	return &MockStateGetModelProvisionedStorageSnapshotIDsReadyCall{Call: call}
+}
+
+// MockStateGetModelProvisionedStorageSnapshotIDsReadyCall wrap *gomock.Call
+type MockStateGetModelProvisionedStorageSnapshotIDsReadyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetModelProvisionedStorageSnapshotIDsReadyCall) Return(arg0 []string, arg1 error) *MockStateGetModelProvisionedStorageSnapshotIDsReadyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetModelProvisionedStorageSnapshotIDsReadyCall) Do(f func(context.Context) ([]string, error)) *MockStateGetModelProvisionedStorageSnapshotIDsReadyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetModelProvisionedStorageSnapshotIDsReadyCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockStateGetModelProvisionedStorageSnapshotIDsReadyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProvisionedFilesystemAttachmentsForApplication mocks base method.
 func (m *MockState) GetProvisionedFilesystemAttachmentsForApplication(ctx context.Context, uuid application.UUID) (map[string][]storageprovisioning.ProvisionedFilesystemAttachment, error) {
 	m.ctrl.T.Helper()
@@ -1258,6 +1336,123 @@ func (c *MockStateGetStorageResourceTagInfoForModelCall) DoAndReturn(f func(cont
 	return c
 }
 
+// GetStorageSnapshotHookInfoForUnit mocks base method.
+func (m *MockState) GetStorageSnapshotHookInfoForUnit(arg0 context.Context, arg1 unit.UUID) ([]storageprovisioning.StorageSnapshotHookInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotHookInfoForUnit", arg0, arg1)
+	ret0, _ := ret[0].([]storageprovisioning.StorageSnapshotHookInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotHookInfoForUnit indicates an expected call of GetStorageSnapshotHookInfoForUnit.
+func (mr *MockStateMockRecorder) GetStorageSnapshotHookInfoForUnit(arg0, arg1 any) *MockStateGetStorageSnapshotHookInfoForUnitCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotHookInfoForUnit", reflect.TypeOf((*MockState)(nil).GetStorageSnapshotHookInfoForUnit), arg0, arg1)
+	return &MockStateGetStorageSnapshotHookInfoForUnitCall{Call: call}
+}
+
+// MockStateGetStorageSnapshotHookInfoForUnitCall wrap *gomock.Call
+type MockStateGetStorageSnapshotHookInfoForUnitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageSnapshotHookInfoForUnitCall) Return(arg0 []storageprovisioning.StorageSnapshotHookInfo, arg1 error) *MockStateGetStorageSnapshotHookInfoForUnitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageSnapshotHookInfoForUnitCall) Do(f func(context.Context, unit.UUID) ([]storageprovisioning.StorageSnapshotHookInfo, error)) *MockStateGetStorageSnapshotHookInfoForUnitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageSnapshotHookInfoForUnitCall) DoAndReturn(f func(context.Context, unit.UUID) ([]storageprovisioning.StorageSnapshotHookInfo, error)) *MockStateGetStorageSnapshotHookInfoForUnitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageSnapshotIDsReadyForNetNode mocks base method.
+func (m *MockState) GetStorageSnapshotIDsReadyForNetNode(arg0 context.Context, arg1 network.NetNodeUUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotIDsReadyForNetNode", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotIDsReadyForNetNode indicates an expected call of GetStorageSnapshotIDsReadyForNetNode.
+func (mr *MockStateMockRecorder) GetStorageSnapshotIDsReadyForNetNode(arg0, arg1 any) *MockStateGetStorageSnapshotIDsReadyForNetNodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotIDsReadyForNetNode", reflect.TypeOf((*MockState)(nil).GetStorageSnapshotIDsReadyForNetNode), arg0, arg1)
+	return &MockStateGetStorageSnapshotIDsReadyForNetNodeCall{Call: call}
+}
+
+// MockStateGetStorageSnapshotIDsReadyForNetNodeCall wrap *gomock.Call
+type MockStateGetStorageSnapshotIDsReadyForNetNodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageSnapshotIDsReadyForNetNodeCall) Return(arg0 []string, arg1 error) *MockStateGetStorageSnapshotIDsReadyForNetNodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageSnapshotIDsReadyForNetNodeCall) Do(f func(context.Context, network.NetNodeUUID) ([]string, error)) *MockStateGetStorageSnapshotIDsReadyForNetNodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageSnapshotIDsReadyForNetNodeCall) DoAndReturn(f func(context.Context, network.NetNodeUUID) ([]string, error)) *MockStateGetStorageSnapshotIDsReadyForNetNodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageSnapshotParams mocks base method.
+func (m *MockState) GetStorageSnapshotParams(arg0 context.Context, arg1 string) (storageprovisioning.StorageSnapshotParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshotParams", arg0, arg1)
+	ret0, _ := ret[0].(storageprovisioning.StorageSnapshotParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshotParams indicates an expected call of GetStorageSnapshotParams.
+func (mr *MockStateMockRecorder) GetStorageSnapshotParams(arg0, arg1 any) *MockStateGetStorageSnapshotParamsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshotParams", reflect.TypeOf((*MockState)(nil).GetStorageSnapshotParams), arg0, arg1)
+	return &MockStateGetStorageSnapshotParamsCall{Call: call}
+}
+
+// MockStateGetStorageSnapshotParamsCall wrap *gomock.Call
+type MockStateGetStorageSnapshotParamsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageSnapshotParamsCall) Return(arg0 storageprovisioning.StorageSnapshotParams, arg1 error) *MockStateGetStorageSnapshotParamsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageSnapshotParamsCall) Do(f func(context.Context, string) (storageprovisioning.StorageSnapshotParams, error)) *MockStateGetStorageSnapshotParamsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageSnapshotParamsCall) DoAndReturn(f func(context.Context, string) (storageprovisioning.StorageSnapshotParams, error)) *MockStateGetStorageSnapshotParamsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitNetNodeUUID mocks base method.
 func (m *MockState) GetUnitNetNodeUUID(arg0 context.Context, arg1 unit.UUID) (network.NetNodeUUID, error) {
 	m.ctrl.T.Helper()
@@ -1687,6 +1882,45 @@ func (c *MockStateGetVolumeAttachmentUUIDForVolumeNetNodeCall) DoAndReturn(f fun
 	return c
 }
 
+// GetVolumeIDsPendingResizeForNetNode mocks base method.
+func (m *MockState) GetVolumeIDsPendingResizeForNetNode(arg0 context.Context, arg1 network.NetNodeUUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeIDsPendingResizeForNetNode", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeIDsPendingResizeForNetNode indicates an expected call of GetVolumeIDsPendingResizeForNetNode.
+func (mr *MockStateMockRecorder) GetVolumeIDsPendingResizeForNetNode(arg0, arg1 any) *MockStateGetVolumeIDsPendingResizeForNetNodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeIDsPendingResizeForNetNode", reflect.TypeOf((*MockState)(nil).GetVolumeIDsPendingResizeForNetNode), arg0, arg1)
+	return &MockStateGetVolumeIDsPendingResizeForNetNodeCall{Call: call}
+}
+
+// MockStateGetVolumeIDsPendingResizeForNetNodeCall wrap *gomock.Call
+type MockStateGetVolumeIDsPendingResizeForNetNodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetVolumeIDsPendingResizeForNetNodeCall) Return(arg0 []string, arg1 error) *MockStateGetVolumeIDsPendingResizeForNetNodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetVolumeIDsPendingResizeForNetNodeCall) Do(f func(context.Context, network.NetNodeUUID) ([]string, error)) *MockStateGetVolumeIDsPendingResizeForNetNodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetVolumeIDsPendingResizeForNetNodeCall) DoAndReturn(f func(context.Context, network.NetNodeUUID) ([]string, error)) *MockStateGetVolumeIDsPendingResizeForNetNodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetVolumeLife mocks base method.
 func (m *MockState) GetVolumeLife(arg0 context.Context, arg1 storage.VolumeUUID) (life.Life, error) {
 	m.ctrl.T.Helper()
@@ -1960,6 +2194,45 @@ func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemAttachmentsCa
 	return c
 }
 
+// InitialWatchStatementMachineProvisionedFilesystemResizes mocks base method.
+func (m *MockState) InitialWatchStatementMachineProvisionedFilesystemResizes(arg0 network.NetNodeUUID) (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementMachineProvisionedFilesystemResizes", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementMachineProvisionedFilesystemResizes indicates an expected call of InitialWatchStatementMachineProvisionedFilesystemResizes.
+func (mr *MockStateMockRecorder) InitialWatchStatementMachineProvisionedFilesystemResizes(arg0 any) *MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementMachineProvisionedFilesystemResizes", reflect.TypeOf((*MockState)(nil).InitialWatchStatementMachineProvisionedFilesystemResizes), arg0)
+	return &MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall{Call: call}
+}
+
+// MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall wrap *gomock.Call
+type MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall) Do(f func(network.NetNodeUUID) (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall) DoAndReturn(f func(network.NetNodeUUID) (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementMachineProvisionedFilesystemResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementMachineProvisionedFilesystems mocks base method.
 func (m *MockState) InitialWatchStatementMachineProvisionedFilesystems(netNodeUUID network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life]) {
 	m.ctrl.T.Helper()
@@ -1999,6 +2272,45 @@ func (c *MockStateInitialWatchStatementMachineProvisionedFilesystemsCall) DoAndR
 	return c
 }
 
+// InitialWatchStatementMachineProvisionedStorageSnapshots mocks base method.
+func (m *MockState) InitialWatchStatementMachineProvisionedStorageSnapshots(arg0 network.NetNodeUUID) (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementMachineProvisionedStorageSnapshots", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementMachineProvisionedStorageSnapshots indicates an expected call of InitialWatchStatementMachineProvisionedStorageSnapshots.
+func (mr *MockStateMockRecorder) InitialWatchStatementMachineProvisionedStorageSnapshots(arg0 any) *MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementMachineProvisionedStorageSnapshots", reflect.TypeOf((*MockState)(nil).InitialWatchStatementMachineProvisionedStorageSnapshots), arg0)
+	return &MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall{Call: call}
+}
+
+// MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall wrap *gomock.Call
+type MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall) Do(f func(network.NetNodeUUID) (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall) DoAndReturn(f func(network.NetNodeUUID) (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementMachineProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementMachineProvisionedVolumeAttachments mocks base method.
 func (m *MockState) InitialWatchStatementMachineProvisionedVolumeAttachments(arg0 network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life]) {
 	m.ctrl.T.Helper()
@@ -2038,6 +2350,45 @@ func (c *MockStateInitialWatchStatementMachineProvisionedVolumeAttachmentsCall)
 	return c
 }
 
+// InitialWatchStatementMachineProvisionedVolumeResizes mocks base method.
+func (m *MockState) InitialWatchStatementMachineProvisionedVolumeResizes(arg0 network.NetNodeUUID) (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementMachineProvisionedVolumeResizes", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementMachineProvisionedVolumeResizes indicates an expected call of InitialWatchStatementMachineProvisionedVolumeResizes.
+func (mr *MockStateMockRecorder) InitialWatchStatementMachineProvisionedVolumeResizes(arg0 any) *MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementMachineProvisionedVolumeResizes", reflect.TypeOf((*MockState)(nil).InitialWatchStatementMachineProvisionedVolumeResizes), arg0)
+	return &MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall{Call: call}
+}
+
+// MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall wrap *gomock.Call
+type MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall) Do(f func(network.NetNodeUUID) (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall) DoAndReturn(f func(network.NetNodeUUID) (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementMachineProvisionedVolumeResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementMachineProvisionedVolumes mocks base method.
 func (m *MockState) InitialWatchStatementMachineProvisionedVolumes(arg0 network.NetNodeUUID) (string, eventsource.Query[map[string]life.Life]) {
 	m.ctrl.T.Helper()
@@ -2117,6 +2468,45 @@ func (c *MockStateInitialWatchStatementModelProvisionedFilesystemAttachmentsCall
 	return c
 }
 
+// InitialWatchStatementModelProvisionedFilesystemResizes mocks base method.
+func (m *MockState) InitialWatchStatementModelProvisionedFilesystemResizes() (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementModelProvisionedFilesystemResizes")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementModelProvisionedFilesystemResizes indicates an expected call of InitialWatchStatementModelProvisionedFilesystemResizes.
+func (mr *MockStateMockRecorder) InitialWatchStatementModelProvisionedFilesystemResizes() *MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementModelProvisionedFilesystemResizes", reflect.TypeOf((*MockState)(nil).InitialWatchStatementModelProvisionedFilesystemResizes))
+	return &MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall{Call: call}
+}
+
+// MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall wrap *gomock.Call
+type MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall) Do(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall) DoAndReturn(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementModelProvisionedFilesystemResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementModelProvisionedFilesystems mocks base method.
 func (m *MockState) InitialWatchStatementModelProvisionedFilesystems() (string, string, eventsource.NamespaceQuery) {
 	m.ctrl.T.Helper()
@@ -2157,6 +2547,45 @@ func (c *MockStateInitialWatchStatementModelProvisionedFilesystemsCall) DoAndRet
 	return c
 }
 
+// InitialWatchStatementModelProvisionedStorageSnapshots mocks base method.
+func (m *MockState) InitialWatchStatementModelProvisionedStorageSnapshots() (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementModelProvisionedStorageSnapshots")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementModelProvisionedStorageSnapshots indicates an expected call of InitialWatchStatementModelProvisionedStorageSnapshots.
+func (mr *MockStateMockRecorder) InitialWatchStatementModelProvisionedStorageSnapshots() *MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementModelProvisionedStorageSnapshots", reflect.TypeOf((*MockState)(nil).InitialWatchStatementModelProvisionedStorageSnapshots))
+	return &MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall{Call: call}
+}
+
+// MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall wrap *gomock.Call
+type MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall) Do(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall) DoAndReturn(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementModelProvisionedStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementModelProvisionedVolumeAttachments mocks base method.
 func (m *MockState) InitialWatchStatementModelProvisionedVolumeAttachments() (string, eventsource.NamespaceQuery) {
 	m.ctrl.T.Helper()
@@ -2196,6 +2625,45 @@ func (c *MockStateInitialWatchStatementModelProvisionedVolumeAttachmentsCall) Do
 	return c
 }
 
+// InitialWatchStatementModelProvisionedVolumeResizes mocks base method.
+func (m *MockState) InitialWatchStatementModelProvisionedVolumeResizes() (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementModelProvisionedVolumeResizes")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementModelProvisionedVolumeResizes indicates an expected call of InitialWatchStatementModelProvisionedVolumeResizes.
+func (mr *MockStateMockRecorder) InitialWatchStatementModelProvisionedVolumeResizes() *MockStateInitialWatchStatementModelProvisionedVolumeResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementModelProvisionedVolumeResizes", reflect.TypeOf((*MockState)(nil).InitialWatchStatementModelProvisionedVolumeResizes))
+	return &MockStateInitialWatchStatementModelProvisionedVolumeResizesCall{Call: call}
+}
+
+// MockStateInitialWatchStatementModelProvisionedVolumeResizesCall wrap *gomock.Call
+type MockStateInitialWatchStatementModelProvisionedVolumeResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementModelProvisionedVolumeResizesCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementModelProvisionedVolumeResizesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementModelProvisionedVolumeResizesCall) Do(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementModelProvisionedVolumeResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementModelProvisionedVolumeResizesCall) DoAndReturn(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementModelProvisionedVolumeResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementModelProvisionedVolumes mocks base method.
 func (m *MockState) InitialWatchStatementModelProvisionedVolumes() (string, eventsource.NamespaceQuery) {
 	m.ctrl.T.Helper()
@@ -2312,6 +2780,44 @@ func (c *MockStateNamespaceForStorageAttachmentCall) DoAndReturn(f func() string
 	return c
 }
 
+// NamespaceForStorageSnapshots mocks base method.
+func (m *MockState) NamespaceForStorageSnapshots() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForStorageSnapshots")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForStorageSnapshots indicates an expected call of NamespaceForStorageSnapshots.
+func (mr *MockStateMockRecorder) NamespaceForStorageSnapshots() *MockStateNamespaceForStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForStorageSnapshots", reflect.TypeOf((*MockState)(nil).NamespaceForStorageSnapshots))
+	return &MockStateNamespaceForStorageSnapshotsCall{Call: call}
+}
+
+// MockStateNamespaceForStorageSnapshotsCall wrap *gomock.Call
+type MockStateNamespaceForStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateNamespaceForStorageSnapshotsCall) Return(arg0 string) *MockStateNamespaceForStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateNamespaceForStorageSnapshotsCall) Do(f func() string) *MockStateNamespaceForStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateNamespaceForStorageSnapshotsCall) DoAndReturn(f func() string) *MockStateNamespaceForStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NamespaceForWatchMachineCloudInstance mocks base method.
 func (m *MockState) NamespaceForWatchMachineCloudInstance() string {
 	m.ctrl.T.Helper()
@@ -2426,6 +2932,120 @@ func (c *MockStateSetFilesystemProvisionedInfoCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// SetStorageSnapshotCreated mocks base method.
+func (m *MockState) SetStorageSnapshotCreated(ctx context.Context, id, providerID string, sizeMiB uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotCreated", ctx, id, providerID, sizeMiB)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotCreated indicates an expected call of SetStorageSnapshotCreated.
+func (mr *MockStateMockRecorder) SetStorageSnapshotCreated(ctx, id, providerID, sizeMiB any) *MockStateSetStorageSnapshotCreatedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotCreated", reflect.TypeOf((*MockState)(nil).SetStorageSnapshotCreated), ctx, id, providerID, sizeMiB)
+	return &MockStateSetStorageSnapshotCreatedCall{Call: call}
+}
+
+// MockStateSetStorageSnapshotCreatedCall wrap *gomock.Call
+type MockStateSetStorageSnapshotCreatedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetStorageSnapshotCreatedCall) Return(arg0 error) *MockStateSetStorageSnapshotCreatedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetStorageSnapshotCreatedCall) Do(f func(context.Context, string, string, uint64) error) *MockStateSetStorageSnapshotCreatedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetStorageSnapshotCreatedCall) DoAndReturn(f func(context.Context, string, string, uint64) error) *MockStateSetStorageSnapshotCreatedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetStorageSnapshotFailed mocks base method.
+func (m *MockState) SetStorageSnapshotFailed(ctx context.Context, id, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotFailed", ctx, id, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotFailed indicates an expected call of SetStorageSnapshotFailed.
+func (mr *MockStateMockRecorder) SetStorageSnapshotFailed(ctx, id, message any) *MockStateSetStorageSnapshotFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotFailed", reflect.TypeOf((*MockState)(nil).SetStorageSnapshotFailed), ctx, id, message)
+	return &MockStateSetStorageSnapshotFailedCall{Call: call}
+}
+
+// MockStateSetStorageSnapshotFailedCall wrap *gomock.Call
+type MockStateSetStorageSnapshotFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetStorageSnapshotFailedCall) Return(arg0 error) *MockStateSetStorageSnapshotFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetStorageSnapshotFailedCall) Do(f func(context.Context, string, string) error) *MockStateSetStorageSnapshotFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetStorageSnapshotFailedCall) DoAndReturn(f func(context.Context, string, string) error) *MockStateSetStorageSnapshotFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetStorageSnapshotHookRun mocks base method.
+func (m *MockState) SetStorageSnapshotHookRun(ctx context.Context, unitUUID unit.UUID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotHookRun", ctx, unitUUID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotHookRun indicates an expected call of SetStorageSnapshotHookRun.
+func (mr *MockStateMockRecorder) SetStorageSnapshotHookRun(ctx, unitUUID, id any) *MockStateSetStorageSnapshotHookRunCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotHookRun", reflect.TypeOf((*MockState)(nil).SetStorageSnapshotHookRun), ctx, unitUUID, id)
+	return &MockStateSetStorageSnapshotHookRunCall{Call: call}
+}
+
+// MockStateSetStorageSnapshotHookRunCall wrap *gomock.Call
+type MockStateSetStorageSnapshotHookRunCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetStorageSnapshotHookRunCall) Return(arg0 error) *MockStateSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetStorageSnapshotHookRunCall) Do(f func(context.Context, unit.UUID, string) error) *MockStateSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetStorageSnapshotHookRunCall) DoAndReturn(f func(context.Context, unit.UUID, string) error) *MockStateSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetVolumeAttachmentPlanProvisionedBlockDevice mocks base method.
 func (m *MockState) SetVolumeAttachmentPlanProvisionedBlockDevice(ctx context.Context, uuid storage.VolumeAttachmentPlanUUID, blockDeviceUUID blockdevice.BlockDeviceUUID) error {
 	m.ctrl.T.Helper()
@@ -40,6 +40,7 @@ type Service struct {
 type State interface {
 	CharmState
 	FilesystemState
+	SnapshotState
 	VolumeState
 
 	// CheckMachineIsDead checks to see if a machine is dead, returning true
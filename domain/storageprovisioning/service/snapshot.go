@@ -0,0 +1,275 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	corechangestream "github.com/juju/juju/core/changestream"
+	coremachine "github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/trace"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	domainnetwork "github.com/juju/juju/domain/network"
+	"github.com/juju/juju/domain/storageprovisioning"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SnapshotState defines the interface required for performing storage
+// snapshot provisioning operations in the model.
+type SnapshotState interface {
+	// NamespaceForStorageSnapshots returns the change stream namespace for
+	// watching changes to the storage snapshots in the model.
+	NamespaceForStorageSnapshots() string
+
+	// InitialWatchStatementModelProvisionedStorageSnapshots returns both the
+	// namespace for watching storage snapshot changes and the initial query
+	// for getting the ids of all snapshots of model provisioned volumes that
+	// are ready to be taken.
+	InitialWatchStatementModelProvisionedStorageSnapshots() (string, eventsource.NamespaceQuery)
+
+	// GetModelProvisionedStorageSnapshotIDsReady returns the ids of the
+	// snapshots of model provisioned volumes that are ready to be taken.
+	GetModelProvisionedStorageSnapshotIDsReady(context.Context) ([]string, error)
+
+	// InitialWatchStatementMachineProvisionedStorageSnapshots returns both
+	// the namespace for watching storage snapshot changes and the initial
+	// query for getting the ids of all snapshots of volumes provisioned by
+	// the machine connected to the supplied net node that are ready to be
+	// taken.
+	InitialWatchStatementMachineProvisionedStorageSnapshots(
+		domainnetwork.NetNodeUUID,
+	) (string, eventsource.NamespaceQuery)
+
+	// GetStorageSnapshotIDsReadyForNetNode returns the ids of the snapshots
+	// of volumes provisioned by the machine owning the supplied net node that
+	// are ready to be taken.
+	GetStorageSnapshotIDsReadyForNetNode(
+		context.Context, domainnetwork.NetNodeUUID,
+	) ([]string, error)
+
+	// GetStorageSnapshotParams returns the parameters required to take the
+	// storage snapshot with the supplied id.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]
+	// when no storage snapshot exists for the supplied id.
+	GetStorageSnapshotParams(
+		context.Context, string,
+	) (storageprovisioning.StorageSnapshotParams, error)
+
+	// SetStorageSnapshotCreated records that the storage snapshot with the
+	// supplied id has been taken by the storage provider.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]
+	// when no storage snapshot exists for the supplied id.
+	SetStorageSnapshotCreated(
+		ctx context.Context, id string, providerID string, sizeMiB uint64,
+	) error
+
+	// SetStorageSnapshotFailed records that the storage provider failed to
+	// take the storage snapshot with the supplied id.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]
+	// when no storage snapshot exists for the supplied id.
+	SetStorageSnapshotFailed(
+		ctx context.Context, id string, message string,
+	) error
+
+	// GetStorageSnapshotHookInfoForUnit returns the storage snapshots that
+	// are waiting on the supplied unit to run one of its storage snapshot
+	// hooks.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/application/errors.UnitNotFound] when
+	// no unit exists for the supplied unit uuid.
+	GetStorageSnapshotHookInfoForUnit(
+		context.Context, coreunit.UUID,
+	) ([]storageprovisioning.StorageSnapshotHookInfo, error)
+
+	// SetStorageSnapshotHookRun records that the supplied unit has run the
+	// storage snapshot hook the snapshot was waiting on.
+	//
+	// The following errors may be returned:
+	// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound]
+	// when no storage snapshot exists for the supplied id.
+	SetStorageSnapshotHookRun(
+		ctx context.Context, unitUUID coreunit.UUID, id string,
+	) error
+}
+
+// WatchModelProvisionedStorageSnapshots returns a watcher that emits the ids
+// of the snapshots of model provisioned volumes that are ready to be taken,
+// whenever a storage snapshot in the model changes.
+func (s *Service) WatchModelProvisionedStorageSnapshots(
+	ctx context.Context,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	ns, initialQuery := s.st.InitialWatchStatementModelProvisionedStorageSnapshots()
+	mapper := func(ctx context.Context, _ []corechangestream.ChangeEvent) ([]string, error) {
+		return s.st.GetModelProvisionedStorageSnapshotIDsReady(ctx)
+	}
+	return s.watcherFactory.NewNamespaceMapperWatcher(
+		ctx,
+		initialQuery,
+		"model provisioned storage snapshot watcher",
+		mapper,
+		eventsource.NamespaceFilter(ns, corechangestream.All),
+	)
+}
+
+// WatchMachineProvisionedStorageSnapshots returns a watcher that emits the
+// ids of the snapshots of the given machine's provisioned volumes that are
+// ready to be taken, whenever a storage snapshot in the model changes.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the provided machine uuid is not valid.
+// - [machineerrors.MachineNotFound] when no machine exists for the provided
+// machine UUUID.
+func (s *Service) WatchMachineProvisionedStorageSnapshots(
+	ctx context.Context, machineUUID coremachine.UUID,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := machineUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	netNodeUUID, err := s.st.GetMachineNetNodeUUID(ctx, machineUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ns, initialQuery := s.st.InitialWatchStatementMachineProvisionedStorageSnapshots(netNodeUUID)
+	mapper := func(ctx context.Context, _ []corechangestream.ChangeEvent) ([]string, error) {
+		return s.st.GetStorageSnapshotIDsReadyForNetNode(ctx, netNodeUUID)
+	}
+	return s.watcherFactory.NewNamespaceMapperWatcher(
+		ctx,
+		initialQuery,
+		fmt.Sprintf("machine provisioned storage snapshot watcher for %q", machineUUID),
+		mapper,
+		eventsource.NamespaceFilter(ns, corechangestream.All),
+	)
+}
+
+// GetStorageSnapshotParams returns the parameters required to take the
+// storage snapshot with the supplied id.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound] when
+// no storage snapshot exists for the supplied id.
+func (s *Service) GetStorageSnapshotParams(
+	ctx context.Context, id string,
+) (storageprovisioning.StorageSnapshotParams, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.GetStorageSnapshotParams(ctx, id)
+}
+
+// SetStorageSnapshotCreated records that the storage snapshot with the
+// supplied id has been taken by the storage provider, with the provider's id
+// for the snapshot and its size.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound] when
+// no storage snapshot exists for the supplied id.
+func (s *Service) SetStorageSnapshotCreated(
+	ctx context.Context, id string, providerID string, sizeMiB uint64,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.SetStorageSnapshotCreated(ctx, id, providerID, sizeMiB)
+}
+
+// SetStorageSnapshotFailed records that the storage provider failed to take
+// the storage snapshot with the supplied id.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound] when
+// no storage snapshot exists for the supplied id.
+func (s *Service) SetStorageSnapshotFailed(
+	ctx context.Context, id string, message string,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.SetStorageSnapshotFailed(ctx, id, message)
+}
+
+// WatchStorageSnapshotsForUnit returns a watcher that notifies whenever a
+// storage snapshot in the model changes, so that the unit can check for
+// snapshots waiting on its storage snapshot hooks.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/core/errors.NotValid] when the provided unit uuid
+// is not valid.
+func (s *Service) WatchStorageSnapshotsForUnit(
+	ctx context.Context, unitUUID coreunit.UUID,
+) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := unitUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		fmt.Sprintf("storage snapshot watcher for unit %q", unitUUID),
+		eventsource.NamespaceFilter(
+			s.st.NamespaceForStorageSnapshots(), corechangestream.All,
+		),
+	)
+}
+
+// GetStorageSnapshotHookInfoForUnit returns the storage snapshots that are
+// waiting on the supplied unit to run one of its storage snapshot hooks.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/core/errors.NotValid] when the provided unit uuid
+// is not valid.
+// - [github.com/juju/juju/domain/application/errors.UnitNotFound] when no
+// unit exists for the supplied unit uuid.
+func (s *Service) GetStorageSnapshotHookInfoForUnit(
+	ctx context.Context, unitUUID coreunit.UUID,
+) ([]storageprovisioning.StorageSnapshotHookInfo, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := unitUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return s.st.GetStorageSnapshotHookInfoForUnit(ctx, unitUUID)
+}
+
+// SetStorageSnapshotHookRun records that the supplied unit has run the
+// storage snapshot hook the snapshot with the supplied id was waiting on.
+//
+// The following errors may be returned:
+// - [github.com/juju/juju/core/errors.NotValid] when the provided unit uuid
+// is not valid.
+// - [github.com/juju/juju/domain/storage/errors.StorageSnapshotNotFound] when
+// no storage snapshot exists for the supplied id.
+func (s *Service) SetStorageSnapshotHookRun(
+	ctx context.Context, unitUUID coreunit.UUID, id string,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := unitUUID.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+
+	return s.st.SetStorageSnapshotHookRun(ctx, unitUUID, id)
+}
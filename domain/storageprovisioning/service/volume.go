@@ -269,6 +269,28 @@ type VolumeState interface {
 	// that are model provisioned.
 	InitialWatchStatementModelProvisionedVolumes() (string, eventsource.NamespaceQuery)
 
+	// InitialWatchStatementMachineProvisionedVolumeResizes returns both the
+	// namespace for watching requested size changes of volumes that are
+	// machine provisioned and the initial query for getting the set of
+	// volumes provisioned by the supplied net node that are smaller than
+	// their requested size.
+	InitialWatchStatementMachineProvisionedVolumeResizes(
+		domainnetwork.NetNodeUUID,
+	) (string, eventsource.NamespaceQuery)
+
+	// InitialWatchStatementModelProvisionedVolumeResizes returns both the
+	// namespace for watching requested size changes of volumes that are
+	// model provisioned and the initial query for getting the set of model
+	// provisioned volumes that are smaller than their requested size.
+	InitialWatchStatementModelProvisionedVolumeResizes() (string, eventsource.NamespaceQuery)
+
+	// GetVolumeIDsPendingResizeForNetNode returns the ids of the machine
+	// provisioned volumes to be provisioned by the machine owning the
+	// supplied net node that are smaller than their requested size.
+	GetVolumeIDsPendingResizeForNetNode(
+		context.Context, domainnetwork.NetNodeUUID,
+	) ([]string, error)
+
 	// InitialWatchStatementMachineProvisionedVolumeAttachments returns
 	// both the namespace for watching volume attachment life changes where
 	// the volume attachment is machine provisioned and the initial query for
@@ -882,6 +904,66 @@ func (s *Service) WatchMachineProvisionedVolumes(
 	return w, nil
 }
 
+// WatchModelProvisionedVolumeResizes returns a watcher that emits volume IDs,
+// whenever the requested size of a model provisioned volume changes. The
+// initial event contains the volumes that are smaller than their requested
+// size.
+func (s *Service) WatchModelProvisionedVolumeResizes(
+	ctx context.Context,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	ns, initialQuery := s.st.InitialWatchStatementModelProvisionedVolumeResizes()
+	return s.watcherFactory.NewNamespaceWatcher(
+		ctx,
+		initialQuery,
+		"model provisioned volume resize watcher",
+		eventsource.NamespaceFilter(ns, corechangestream.All))
+}
+
+// WatchMachineProvisionedVolumeResizes returns a watcher that emits the IDs
+// of the given machine's provisioned volumes that are smaller than their
+// requested size, whenever the requested size of one of the volumes changes.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the provided machine uuid is not valid.
+// - [machineerrors.MachineNotFound] when no machine exists for the provided
+// machine UUUID.
+func (s *Service) WatchMachineProvisionedVolumeResizes(
+	ctx context.Context, machineUUID coremachine.UUID,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := machineUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	netNodeUUID, err := s.st.GetMachineNetNodeUUID(ctx, machineUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ns, initialQuery := s.st.InitialWatchStatementMachineProvisionedVolumeResizes(netNodeUUID)
+	mapper := func(ctx context.Context, _ []corechangestream.ChangeEvent) ([]string, error) {
+		return s.st.GetVolumeIDsPendingResizeForNetNode(ctx, netNodeUUID)
+	}
+	filter := eventsource.PredicateFilter(
+		ns, corechangestream.All, eventsource.EqualsPredicate(netNodeUUID.String()),
+	)
+
+	w, err := s.watcherFactory.NewNamespaceMapperWatcher(
+		ctx,
+		initialQuery,
+		fmt.Sprintf("machine provisioned volume resize watcher for %q", machineUUID),
+		mapper, filter)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return w, nil
+}
+
 // WatchModelProvisionedVolumeAttachments returns a watcher that emits volume
 // attachment UUIDs, whenever a model provisioned volume attachment's life
 // changes.
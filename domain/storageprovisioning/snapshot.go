@@ -0,0 +1,40 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioning
+
+import (
+	domainstorage "github.com/juju/juju/domain/storage"
+)
+
+// StorageSnapshotParams defines the set of parameters that a caller needs to
+// know in order to take a snapshot of a volume.
+type StorageSnapshotParams struct {
+	// Provider is the name of the provider that provisioned the volume.
+	Provider string
+
+	// Status is the current status of the snapshot.
+	Status domainstorage.StorageSnapshotStatus
+
+	// VolumeID is the unique id given to the volume in the controller. This
+	// is not the volume uuid.
+	VolumeID string
+
+	// VolumeProviderID is the ID of the volume from the storage provider.
+	VolumeProviderID string
+}
+
+// StorageSnapshotHookInfo describes a storage snapshot that is waiting on a
+// unit to run one of its storage snapshot hooks.
+type StorageSnapshotHookInfo struct {
+	// ID is the user facing id of the snapshot.
+	ID string
+
+	// StorageID is the id of the storage instance the snapshot is of.
+	StorageID string
+
+	// Status is the current status of the snapshot. A pending snapshot is
+	// waiting on the storage-snapshot-pre hook, while a created or failed
+	// snapshot is waiting on the storage-snapshot-post hook.
+	Status domainstorage.StorageSnapshotStatus
+}
@@ -1424,3 +1424,109 @@ WHERE  u.application_uuid = $entityUUID.uuid AND sfa.provider_id <> ''`,
 
 	return existingAttachments.toProvisionedFilesystemAttachment()
 }
+
+// InitialWatchStatementModelProvisionedFilesystemResizes returns both the
+// namespace for watching requested size changes of filesystems that are model
+// provisioned. On top of this the initial query for getting all model
+// provisioned filesystems that are smaller than their requested size is
+// returned.
+func (st *State) InitialWatchStatementModelProvisionedFilesystemResizes() (
+	string, eventsource.NamespaceQuery,
+) {
+	query := func(ctx context.Context, db database.TxnRunner) ([]string, error) {
+		stmt, err := st.Prepare(`
+SELECT sf.filesystem_id AS &filesystemID.*
+FROM   storage_filesystem sf
+JOIN   storage_instance_filesystem sif ON sif.storage_filesystem_uuid = sf.uuid
+JOIN   storage_instance si ON si.uuid = sif.storage_instance_uuid
+WHERE  sf.provision_scope_id = 0
+AND    sf.life_id = 0
+AND    sf.size_mib < si.requested_size_mib
+`, filesystemID{})
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		var fsIDs []filesystemID
+		err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+			err := tx.Query(ctx, stmt).GetAll(&fsIDs)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		rval := make([]string, 0, len(fsIDs))
+		for _, v := range fsIDs {
+			rval = append(rval, v.ID)
+		}
+		return rval, nil
+	}
+	return "storage_filesystem_requested_size_model_provisioning", query
+}
+
+// InitialWatchStatementMachineProvisionedFilesystemResizes returns both the
+// namespace for watching requested size changes of filesystems that are
+// machine provisioned. On top of this the initial query for getting all
+// filesystems to be provisioned by the machine connected to the supplied net
+// node that are smaller than their requested size is returned.
+func (st *State) InitialWatchStatementMachineProvisionedFilesystemResizes(
+	netNodeUUID domainnetwork.NetNodeUUID,
+) (string, eventsource.NamespaceQuery) {
+	query := func(ctx context.Context, db database.TxnRunner) ([]string, error) {
+		return st.getFilesystemIDsPendingResizeForNetNode(ctx, db, netNodeUUID)
+	}
+	return "storage_filesystem_requested_size_machine_provisioning", query
+}
+
+// GetFilesystemIDsPendingResizeForNetNode returns the ids of the machine
+// provisioned filesystems to be provisioned by the machine owning the supplied
+// net node that are smaller than their requested size.
+func (st *State) GetFilesystemIDsPendingResizeForNetNode(
+	ctx context.Context, netNodeUUID domainnetwork.NetNodeUUID,
+) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return st.getFilesystemIDsPendingResizeForNetNode(ctx, db, netNodeUUID)
+}
+
+func (st *State) getFilesystemIDsPendingResizeForNetNode(
+	ctx context.Context,
+	db domain.TxnRunner,
+	uuid domainnetwork.NetNodeUUID,
+) ([]string, error) {
+	netNodeInput := netNodeUUID{UUID: uuid.String()}
+	stmt, err := st.Prepare(`
+SELECT DISTINCT sf.filesystem_id AS &filesystemID.*
+FROM            storage_filesystem sf
+JOIN            storage_filesystem_attachment sfa ON sf.uuid = sfa.storage_filesystem_uuid
+JOIN            storage_instance_filesystem sif ON sif.storage_filesystem_uuid = sf.uuid
+JOIN            storage_instance si ON si.uuid = sif.storage_instance_uuid
+WHERE           sf.provision_scope_id = 1
+AND             sf.life_id = 0
+AND             sf.size_mib < si.requested_size_mib
+AND             sfa.net_node_uuid = $netNodeUUID.uuid
+`, filesystemID{}, netNodeInput)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var fsIDs []filesystemID
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, netNodeInput).GetAll(&fsIDs)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	rval := make([]string, 0, len(fsIDs))
+	for _, v := range fsIDs {
+		rval = append(rval, v.ID)
+	}
+	return rval, nil
+}
@@ -0,0 +1,459 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/database"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	domainnetwork "github.com/juju/juju/domain/network"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/domain/storageprovisioning"
+	"github.com/juju/juju/internal/errors"
+)
+
+// NamespaceForStorageSnapshots returns the change stream namespace for
+// watching changes to the storage snapshots in the model.
+func (st *State) NamespaceForStorageSnapshots() string {
+	return "storage_snapshot"
+}
+
+// InitialWatchStatementModelProvisionedStorageSnapshots returns both the
+// namespace for watching storage snapshot changes and the initial query for
+// getting the ids of all snapshots of model provisioned volumes that are
+// ready to be taken.
+func (st *State) InitialWatchStatementModelProvisionedStorageSnapshots() (
+	string, eventsource.NamespaceQuery,
+) {
+	query := func(ctx context.Context, db database.TxnRunner) ([]string, error) {
+		return st.getModelProvisionedStorageSnapshotIDsReady(ctx, db)
+	}
+	return st.NamespaceForStorageSnapshots(), query
+}
+
+// GetModelProvisionedStorageSnapshotIDsReady returns the ids of the
+// snapshots of model provisioned volumes that are ready to be taken.
+func (st *State) GetModelProvisionedStorageSnapshotIDsReady(
+	ctx context.Context,
+) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return st.getModelProvisionedStorageSnapshotIDsReady(ctx, db)
+}
+
+func (st *State) getModelProvisionedStorageSnapshotIDsReady(
+	ctx context.Context, db domain.TxnRunner,
+) ([]string, error) {
+	stmt, err := st.Prepare(`
+SELECT ss.snapshot_id AS &storageSnapshotID.*
+FROM   storage_snapshot ss
+JOIN   storage_volume sv ON ss.storage_volume_uuid = sv.uuid
+WHERE  ss.status_id = 1
+AND    sv.provision_scope_id = 0
+`, storageSnapshotID{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var ids []storageSnapshotID
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&ids)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rval := make([]string, 0, len(ids))
+	for _, v := range ids {
+		rval = append(rval, v.ID)
+	}
+	return rval, nil
+}
+
+// InitialWatchStatementMachineProvisionedStorageSnapshots returns both the
+// namespace for watching storage snapshot changes and the initial query for
+// getting the ids of all snapshots of volumes provisioned by the machine
+// connected to the supplied net node that are ready to be taken.
+func (st *State) InitialWatchStatementMachineProvisionedStorageSnapshots(
+	netNodeUUID domainnetwork.NetNodeUUID,
+) (string, eventsource.NamespaceQuery) {
+	query := func(ctx context.Context, db database.TxnRunner) ([]string, error) {
+		return st.getStorageSnapshotIDsReadyForNetNode(ctx, db, netNodeUUID)
+	}
+	return st.NamespaceForStorageSnapshots(), query
+}
+
+// GetStorageSnapshotIDsReadyForNetNode returns the ids of the snapshots of
+// volumes provisioned by the machine owning the supplied net node that are
+// ready to be taken.
+func (st *State) GetStorageSnapshotIDsReadyForNetNode(
+	ctx context.Context, netNodeUUID domainnetwork.NetNodeUUID,
+) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return st.getStorageSnapshotIDsReadyForNetNode(ctx, db, netNodeUUID)
+}
+
+func (st *State) getStorageSnapshotIDsReadyForNetNode(
+	ctx context.Context,
+	db domain.TxnRunner,
+	uuid domainnetwork.NetNodeUUID,
+) ([]string, error) {
+	netNodeInput := netNodeUUID{UUID: uuid.String()}
+	stmt, err := st.Prepare(`
+SELECT DISTINCT ss.snapshot_id AS &storageSnapshotID.*
+FROM            storage_snapshot ss
+JOIN            storage_volume sv ON ss.storage_volume_uuid = sv.uuid
+JOIN            storage_volume_attachment sva ON sv.uuid = sva.storage_volume_uuid
+WHERE           ss.status_id = 1
+AND             sv.provision_scope_id = 1
+AND             sva.net_node_uuid = $netNodeUUID.uuid
+`, storageSnapshotID{}, netNodeInput)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var ids []storageSnapshotID
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, netNodeInput).GetAll(&ids)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rval := make([]string, 0, len(ids))
+	for _, v := range ids {
+		rval = append(rval, v.ID)
+	}
+	return rval, nil
+}
+
+// GetStorageSnapshotParams returns the parameters required to take the
+// storage snapshot with the supplied id.
+//
+// The following errors may be returned:
+// - [storageerrors.StorageSnapshotNotFound] when no storage snapshot exists
+// for the supplied id.
+func (st *State) GetStorageSnapshotParams(
+	ctx context.Context, id string,
+) (storageprovisioning.StorageSnapshotParams, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return storageprovisioning.StorageSnapshotParams{}, errors.Capture(err)
+	}
+
+	var (
+		input    = storageSnapshotID{ID: id}
+		paramVal storageSnapshotParams
+	)
+	stmt, err := st.Prepare(`
+SELECT &storageSnapshotParams.* FROM (
+    SELECT ss.status_id,
+           sp.type,
+           sv.volume_id,
+           sv.provider_id
+    FROM   storage_snapshot ss
+    JOIN   storage_pool sp ON ss.storage_pool_uuid = sp.uuid
+    JOIN   storage_volume sv ON ss.storage_volume_uuid = sv.uuid
+    WHERE  ss.snapshot_id = $storageSnapshotID.snapshot_id
+)`,
+		input, paramVal,
+	)
+	if err != nil {
+		return storageprovisioning.StorageSnapshotParams{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, input).Get(&paramVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage snapshot %q does not exist", id,
+			).Add(storageerrors.StorageSnapshotNotFound)
+		}
+		return err
+	})
+	if err != nil {
+		return storageprovisioning.StorageSnapshotParams{}, errors.Capture(err)
+	}
+
+	return storageprovisioning.StorageSnapshotParams{
+		Provider:         paramVal.Type,
+		Status:           domainstorage.StorageSnapshotStatus(paramVal.StatusID),
+		VolumeID:         paramVal.VolumeID,
+		VolumeProviderID: paramVal.VolumeProviderID,
+	}, nil
+}
+
+// SetStorageSnapshotCreated records that the storage snapshot with the
+// supplied id has been taken by the storage provider. A snapshot of storage
+// attached to a unit becomes created, waiting on the unit's
+// storage-snapshot-post hook, otherwise it becomes available. Snapshots that
+// are not ready to be taken are left unchanged.
+//
+// The following errors may be returned:
+// - [storageerrors.StorageSnapshotNotFound] when no storage snapshot exists
+// for the supplied id.
+func (st *State) SetStorageSnapshotCreated(
+	ctx context.Context, id string, providerID string, sizeMiB uint64,
+) error {
+	return st.setStorageSnapshotTaken(ctx, id, func(
+		current storageSnapshotStatus,
+	) setStorageSnapshotStatus {
+		status := domainstorage.StorageSnapshotStatusAvailable
+		if current.UnitUUID.Valid {
+			status = domainstorage.StorageSnapshotStatusCreated
+		}
+		return setStorageSnapshotStatus{
+			StatusID:   int(status),
+			ProviderID: sql.NullString{String: providerID, Valid: true},
+			SizeMiB:    sql.NullInt64{Int64: int64(sizeMiB), Valid: true},
+		}
+	})
+}
+
+// SetStorageSnapshotFailed records that the storage provider failed to take
+// the storage snapshot with the supplied id. A snapshot of storage attached
+// to a unit becomes failed, waiting on the unit's storage-snapshot-post hook,
+// otherwise it becomes an error. Snapshots that are not ready to be taken are
+// left unchanged.
+//
+// The following errors may be returned:
+// - [storageerrors.StorageSnapshotNotFound] when no storage snapshot exists
+// for the supplied id.
+func (st *State) SetStorageSnapshotFailed(
+	ctx context.Context, id string, message string,
+) error {
+	return st.setStorageSnapshotTaken(ctx, id, func(
+		current storageSnapshotStatus,
+	) setStorageSnapshotStatus {
+		status := domainstorage.StorageSnapshotStatusError
+		if current.UnitUUID.Valid {
+			status = domainstorage.StorageSnapshotStatusFailed
+		}
+		return setStorageSnapshotStatus{
+			StatusID: int(status),
+			Message:  sql.NullString{String: message, Valid: true},
+		}
+	})
+}
+
+// setStorageSnapshotTaken updates a ready storage snapshot with the values
+// returned from the supplied func.
+func (st *State) setStorageSnapshotTaken(
+	ctx context.Context,
+	id string,
+	update func(storageSnapshotStatus) setStorageSnapshotStatus,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var (
+		input     = storageSnapshotID{ID: id}
+		statusVal storageSnapshotStatus
+	)
+	statusStmt, err := st.Prepare(`
+SELECT &storageSnapshotStatus.*
+FROM   storage_snapshot
+WHERE  snapshot_id = $storageSnapshotID.snapshot_id`,
+		input, statusVal,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE storage_snapshot
+SET    status_id = $setStorageSnapshotStatus.status_id,
+       message = $setStorageSnapshotStatus.message,
+       provider_id = $setStorageSnapshotStatus.provider_id,
+       size_mib = $setStorageSnapshotStatus.size_mib,
+       updated_at = $setStorageSnapshotStatus.updated_at
+WHERE  uuid = $setStorageSnapshotStatus.uuid`,
+		setStorageSnapshotStatus{},
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, statusStmt, input).Get(&statusVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage snapshot %q does not exist", id,
+			).Add(storageerrors.StorageSnapshotNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		if domainstorage.StorageSnapshotStatus(statusVal.StatusID) !=
+			domainstorage.StorageSnapshotStatusReady {
+			// The snapshot has already been taken, nothing to do.
+			return nil
+		}
+
+		updateVal := update(statusVal)
+		updateVal.UUID = statusVal.UUID
+		updateVal.UpdatedAt = time.Now().UTC()
+		return tx.Query(ctx, updateStmt, updateVal).Run()
+	})
+}
+
+// GetStorageSnapshotHookInfoForUnit returns the storage snapshots that are
+// waiting on the supplied unit to run one of its storage snapshot hooks.
+//
+// The following errors may be returned:
+// - [applicationerrors.UnitNotFound] when no unit exists for the supplied
+// unit uuid.
+func (st *State) GetStorageSnapshotHookInfoForUnit(
+	ctx context.Context, uuid coreunit.UUID,
+) ([]storageprovisioning.StorageSnapshotHookInfo, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	input := unitUUID{UUID: uuid.String()}
+	stmt, err := st.Prepare(`
+SELECT &storageSnapshotHookInfo.*
+FROM   storage_snapshot
+WHERE  unit_uuid = $unitUUID.uuid
+AND    status_id IN (0, 2, 4)
+ORDER BY created_at, CAST(snapshot_id AS INTEGER)`,
+		input, storageSnapshotHookInfo{},
+	)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var dbVals []storageSnapshotHookInfo
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkUnitExists(ctx, tx, uuid)
+		if err != nil {
+			return errors.Errorf("checking unit %q exists: %w", uuid, err)
+		}
+		if !exists {
+			return errors.Errorf(
+				"unit %q does not exist", uuid,
+			).Add(applicationerrors.UnitNotFound)
+		}
+
+		err = tx.Query(ctx, stmt, input).GetAll(&dbVals)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rval := make([]storageprovisioning.StorageSnapshotHookInfo, 0, len(dbVals))
+	for _, v := range dbVals {
+		rval = append(rval, storageprovisioning.StorageSnapshotHookInfo{
+			ID:        v.ID,
+			StorageID: v.StorageID,
+			Status:    domainstorage.StorageSnapshotStatus(v.StatusID),
+		})
+	}
+	return rval, nil
+}
+
+// SetStorageSnapshotHookRun records that the supplied unit has run the
+// storage snapshot hook the snapshot was waiting on. A pending snapshot
+// becomes ready to be taken, a created snapshot becomes available and a
+// failed snapshot becomes an error. Snapshots not waiting on the unit are
+// left unchanged.
+//
+// The following errors may be returned:
+// - [storageerrors.StorageSnapshotNotFound] when no storage snapshot exists
+// for the supplied id.
+func (st *State) SetStorageSnapshotHookRun(
+	ctx context.Context, uuid coreunit.UUID, id string,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var (
+		input     = storageSnapshotID{ID: id}
+		statusVal storageSnapshotStatus
+	)
+	statusStmt, err := st.Prepare(`
+SELECT &storageSnapshotStatus.*
+FROM   storage_snapshot
+WHERE  snapshot_id = $storageSnapshotID.snapshot_id`,
+		input, statusVal,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE storage_snapshot
+SET    status_id = $setStorageSnapshotStatus.status_id,
+       updated_at = $setStorageSnapshotStatus.updated_at
+WHERE  uuid = $setStorageSnapshotStatus.uuid`,
+		setStorageSnapshotStatus{},
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, statusStmt, input).Get(&statusVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"storage snapshot %q does not exist", id,
+			).Add(storageerrors.StorageSnapshotNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		if statusVal.UnitUUID.String != uuid.String() {
+			return nil
+		}
+
+		var next domainstorage.StorageSnapshotStatus
+		switch domainstorage.StorageSnapshotStatus(statusVal.StatusID) {
+		case domainstorage.StorageSnapshotStatusPending:
+			next = domainstorage.StorageSnapshotStatusReady
+		case domainstorage.StorageSnapshotStatusCreated:
+			next = domainstorage.StorageSnapshotStatusAvailable
+		case domainstorage.StorageSnapshotStatusFailed:
+			next = domainstorage.StorageSnapshotStatusError
+		default:
+			return nil
+		}
+
+		return tx.Query(ctx, updateStmt, setStorageSnapshotStatus{
+			UUID:      statusVal.UUID,
+			StatusID:  int(next),
+			UpdatedAt: time.Now().UTC(),
+		}).Run()
+	})
+}
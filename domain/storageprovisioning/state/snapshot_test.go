@@ -0,0 +1,336 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreunit "github.com/juju/juju/core/unit"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/domain/storageprovisioning"
+)
+
+// snapshotSuite provides a set of tests for asserting the state interface for
+// storage snapshots in the model.
+type snapshotSuite struct {
+	baseSuite
+}
+
+// TestSnapshotSuite runs the tests contained within [snapshotSuite].
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+// newStorageSnapshot creates a new storage snapshot of the supplied volume.
+// When unitUUID is not empty the snapshot is recorded as waiting on the unit.
+// Returned is the id of the new snapshot and the id of the storage instance
+// the snapshot is of.
+func (s *snapshotSuite) newStorageSnapshot(
+	c *tc.C,
+	volumeUUID domainstorage.VolumeUUID,
+	unitUUID coreunit.UUID,
+	status domainstorage.StorageSnapshotStatus,
+) (string, string) {
+	snapshotUUID := tc.Must(c, domainstorage.NewStorageSnapshotUUID)
+	poolUUID := s.newStoragePool(c, "pool-"+snapshotUUID.String(), "myprovider", nil)
+	storageUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	storageID := "data/" + strconv.FormatUint(s.nextStorageSequenceNumber(c), 10)
+	snapshotID := strconv.FormatUint(s.nextStorageSequenceNumber(c), 10)
+
+	var unit sql.NullString
+	if unitUUID != "" {
+		unit = sql.NullString{String: unitUUID.String(), Valid: true}
+	}
+
+	now := time.Now().UTC()
+	_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO storage_snapshot (uuid, snapshot_id, storage_instance_uuid,
+                              storage_id, storage_name, storage_kind_id,
+                              storage_pool_uuid, storage_volume_uuid,
+                              unit_uuid, status_id, created_at, updated_at)
+VALUES (?, ?, ?, ?, 'data', 0, ?, ?, ?, ?, ?, ?)
+`,
+		snapshotUUID.String(), snapshotID, storageUUID.String(), storageID,
+		poolUUID, volumeUUID.String(), unit, int(status), now, now,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	return snapshotID, storageID
+}
+
+// newUnit creates a new unit in the model, returning its uuid.
+func (s *snapshotSuite) newUnit(c *tc.C) coreunit.UUID {
+	appUUID, _ := s.newApplication(c, "foo")
+	unitUUID, _ := s.newUnitWithNetNode(c, "foo/0", appUUID, s.newNetNode(c))
+	return unitUUID
+}
+
+// getStorageSnapshotStatus returns the current status of the storage
+// snapshot.
+func (s *snapshotSuite) getStorageSnapshotStatus(
+	c *tc.C, id string,
+) domainstorage.StorageSnapshotStatus {
+	var status int
+	err := s.DB().QueryRowContext(
+		c.Context(),
+		"SELECT status_id FROM storage_snapshot WHERE snapshot_id = ?",
+		id,
+	).Scan(&status)
+	c.Assert(err, tc.ErrorIsNil)
+	return domainstorage.StorageSnapshotStatus(status)
+}
+
+// TestGetModelProvisionedStorageSnapshotIDsReady tests that only the ready
+// snapshots of model provisioned volumes are returned.
+func (s *snapshotSuite) TestGetModelProvisionedStorageSnapshotIDsReady(c *tc.C) {
+	modelVolumeUUID, _ := s.newModelVolume(c)
+	readyID, _ := s.newStorageSnapshot(
+		c, modelVolumeUUID, "", domainstorage.StorageSnapshotStatusReady,
+	)
+	s.newStorageSnapshot(
+		c, modelVolumeUUID, "", domainstorage.StorageSnapshotStatusAvailable,
+	)
+	machineVolumeUUID, _ := s.newMachineVolume(c)
+	s.newStorageSnapshot(
+		c, machineVolumeUUID, "", domainstorage.StorageSnapshotStatusReady,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	ids, err := st.GetModelProvisionedStorageSnapshotIDsReady(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []string{readyID})
+}
+
+// TestGetStorageSnapshotIDsReadyForNetNode tests that only the ready
+// snapshots of volumes provisioned by the machine owning the net node are
+// returned.
+func (s *snapshotSuite) TestGetStorageSnapshotIDsReadyForNetNode(c *tc.C) {
+	netNodeUUID := s.newNetNode(c)
+	otherNetNodeUUID := s.newNetNode(c)
+
+	volumeUUID, _ := s.newMachineVolume(c)
+	s.newMachineVolumeAttachment(c, volumeUUID, netNodeUUID)
+	readyID, _ := s.newStorageSnapshot(
+		c, volumeUUID, "", domainstorage.StorageSnapshotStatusReady,
+	)
+	otherVolumeUUID, _ := s.newMachineVolume(c)
+	s.newMachineVolumeAttachment(c, otherVolumeUUID, otherNetNodeUUID)
+	s.newStorageSnapshot(
+		c, otherVolumeUUID, "", domainstorage.StorageSnapshotStatusReady,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	ids, err := st.GetStorageSnapshotIDsReadyForNetNode(c.Context(), netNodeUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []string{readyID})
+}
+
+// TestGetStorageSnapshotParams tests getting the parameters for taking a
+// storage snapshot.
+func (s *snapshotSuite) TestGetStorageSnapshotParams(c *tc.C) {
+	volumeUUID, volumeID := s.newModelVolume(c)
+	s.changeVolumeProviderID(c, volumeUUID, "vol-123")
+	id, _ := s.newStorageSnapshot(
+		c, volumeUUID, "", domainstorage.StorageSnapshotStatusReady,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	params, err := st.GetStorageSnapshotParams(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(params, tc.DeepEquals, storageprovisioning.StorageSnapshotParams{
+		Provider:         "myprovider",
+		Status:           domainstorage.StorageSnapshotStatusReady,
+		VolumeID:         volumeID,
+		VolumeProviderID: "vol-123",
+	})
+}
+
+// TestGetStorageSnapshotParamsNotFound tests that getting the parameters for
+// a snapshot that does not exist returns an error satisfying
+// [storageerrors.StorageSnapshotNotFound].
+func (s *snapshotSuite) TestGetStorageSnapshotParamsNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.GetStorageSnapshotParams(c.Context(), "42")
+	c.Check(err, tc.ErrorIs, storageerrors.StorageSnapshotNotFound)
+}
+
+// TestSetStorageSnapshotCreatedNotAttached tests that a snapshot of storage
+// not attached to a unit is available once it has been taken.
+func (s *snapshotSuite) TestSetStorageSnapshotCreatedNotAttached(c *tc.C) {
+	volumeUUID, _ := s.newModelVolume(c)
+	id, _ := s.newStorageSnapshot(
+		c, volumeUUID, "", domainstorage.StorageSnapshotStatusReady,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetStorageSnapshotCreated(c.Context(), id, "snap-1", 1024)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var (
+		providerID string
+		sizeMiB    uint64
+	)
+	err = s.DB().QueryRowContext(
+		c.Context(),
+		"SELECT provider_id, size_mib FROM storage_snapshot WHERE snapshot_id = ?",
+		id,
+	).Scan(&providerID, &sizeMiB)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(providerID, tc.Equals, "snap-1")
+	c.Check(sizeMiB, tc.Equals, uint64(1024))
+	c.Check(
+		s.getStorageSnapshotStatus(c, id),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusAvailable,
+	)
+}
+
+// TestSetStorageSnapshotCreatedAttached tests that a snapshot of storage
+// attached to a unit waits on the unit's storage-snapshot-post hook once it
+// has been taken.
+func (s *snapshotSuite) TestSetStorageSnapshotCreatedAttached(c *tc.C) {
+	volumeUUID, _ := s.newModelVolume(c)
+	id, _ := s.newStorageSnapshot(
+		c, volumeUUID, s.newUnit(c), domainstorage.StorageSnapshotStatusReady,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetStorageSnapshotCreated(c.Context(), id, "snap-1", 1024)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(
+		s.getStorageSnapshotStatus(c, id),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusCreated,
+	)
+}
+
+// TestSetStorageSnapshotCreatedNotReady tests that a snapshot that is not
+// ready to be taken is left unchanged.
+func (s *snapshotSuite) TestSetStorageSnapshotCreatedNotReady(c *tc.C) {
+	volumeUUID, _ := s.newModelVolume(c)
+	id, _ := s.newStorageSnapshot(
+		c, volumeUUID, s.newUnit(c), domainstorage.StorageSnapshotStatusPending,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetStorageSnapshotCreated(c.Context(), id, "snap-1", 1024)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(
+		s.getStorageSnapshotStatus(c, id),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusPending,
+	)
+}
+
+// TestSetStorageSnapshotFailed tests that a snapshot the provider could not
+// take is failed when attached to a unit and an error otherwise.
+func (s *snapshotSuite) TestSetStorageSnapshotFailed(c *tc.C) {
+	volumeUUID, _ := s.newModelVolume(c)
+	attachedID, _ := s.newStorageSnapshot(
+		c, volumeUUID, s.newUnit(c), domainstorage.StorageSnapshotStatusReady,
+	)
+	detachedID, _ := s.newStorageSnapshot(
+		c, volumeUUID, "", domainstorage.StorageSnapshotStatusReady,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetStorageSnapshotFailed(c.Context(), attachedID, "boom")
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetStorageSnapshotFailed(c.Context(), detachedID, "boom")
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(
+		s.getStorageSnapshotStatus(c, attachedID),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusFailed,
+	)
+	c.Check(
+		s.getStorageSnapshotStatus(c, detachedID),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusError,
+	)
+}
+
+// TestSetStorageSnapshotFailedNotFound tests that failing a snapshot that
+// does not exist returns an error satisfying
+// [storageerrors.StorageSnapshotNotFound].
+func (s *snapshotSuite) TestSetStorageSnapshotFailedNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetStorageSnapshotFailed(c.Context(), "42", "boom")
+	c.Check(err, tc.ErrorIs, storageerrors.StorageSnapshotNotFound)
+}
+
+// TestStorageSnapshotHooks tests the lifecycle of a snapshot of storage
+// attached to a unit as the unit runs its storage snapshot hooks.
+func (s *snapshotSuite) TestStorageSnapshotHooks(c *tc.C) {
+	volumeUUID, _ := s.newModelVolume(c)
+	unitUUID := s.newUnit(c)
+	id, storageID := s.newStorageSnapshot(
+		c, volumeUUID, unitUUID, domainstorage.StorageSnapshotStatusPending,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	infos, err := st.GetStorageSnapshotHookInfoForUnit(c.Context(), unitUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(infos, tc.DeepEquals, []storageprovisioning.StorageSnapshotHookInfo{{
+		ID:        id,
+		StorageID: storageID,
+		Status:    domainstorage.StorageSnapshotStatusPending,
+	}})
+
+	err = st.SetStorageSnapshotHookRun(c.Context(), unitUUID, id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(
+		s.getStorageSnapshotStatus(c, id),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusReady,
+	)
+
+	// A ready snapshot is waiting on the provisioner, not the unit.
+	infos, err = st.GetStorageSnapshotHookInfoForUnit(c.Context(), unitUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(infos, tc.HasLen, 0)
+
+	err = st.SetStorageSnapshotCreated(c.Context(), id, "snap-1", 1024)
+	c.Assert(err, tc.ErrorIsNil)
+
+	infos, err = st.GetStorageSnapshotHookInfoForUnit(c.Context(), unitUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(infos, tc.HasLen, 1)
+	c.Check(infos[0].Status, tc.Equals, domainstorage.StorageSnapshotStatusCreated)
+
+	err = st.SetStorageSnapshotHookRun(c.Context(), unitUUID, id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(
+		s.getStorageSnapshotStatus(c, id),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusAvailable,
+	)
+}
+
+// TestSetStorageSnapshotHookRunOtherUnit tests that a unit running a hook for
+// a snapshot it is not responsible for leaves the snapshot unchanged.
+func (s *snapshotSuite) TestSetStorageSnapshotHookRunOtherUnit(c *tc.C) {
+	volumeUUID, _ := s.newModelVolume(c)
+	id, _ := s.newStorageSnapshot(
+		c, volumeUUID, s.newUnit(c), domainstorage.StorageSnapshotStatusPending,
+	)
+
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetStorageSnapshotHookRun(
+		c.Context(), tc.Must(c, coreunit.NewUUID), id,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(
+		s.getStorageSnapshotStatus(c, id),
+		tc.Equals,
+		domainstorage.StorageSnapshotStatusPending,
+	)
+}
@@ -5,6 +5,7 @@ package state
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/juju/juju/domain/life"
 	"github.com/juju/juju/domain/storageprovisioning"
@@ -356,6 +357,7 @@ type volumeProvisioningParams struct {
 	Type                 string `db:"type"`
 	RequestedSizeMiB     uint64 `db:"requested_size_mib"`
 	VolumeAttachmentUUID string `db:"volume_attachment_uuid"`
+	SnapshotProviderID   string `db:"snapshot_provider_id"`
 }
 
 // volumeRemovalParams represents the removal params for a volume from the model
@@ -474,3 +476,44 @@ func (rows existingFilesystemAttachmentRows) toProvisionedFilesystemAttachment()
 	}
 	return attachmentsByStorage, nil
 }
+
+// storageSnapshotID represents the user facing id of a storage snapshot.
+type storageSnapshotID struct {
+	ID string `db:"snapshot_id"`
+}
+
+// storageSnapshotParams represents the parameters required to take a storage
+// snapshot.
+type storageSnapshotParams struct {
+	StatusID         int    `db:"status_id"`
+	Type             string `db:"type"`
+	VolumeID         string `db:"volume_id"`
+	VolumeProviderID string `db:"provider_id"`
+}
+
+// storageSnapshotStatus represents the status of a storage snapshot and the
+// unit the snapshot is waiting on, if any.
+type storageSnapshotStatus struct {
+	UUID     string         `db:"uuid"`
+	UnitUUID sql.NullString `db:"unit_uuid"`
+	StatusID int            `db:"status_id"`
+}
+
+// setStorageSnapshotStatus represents the values set on a storage snapshot
+// when its status changes.
+type setStorageSnapshotStatus struct {
+	UUID       string         `db:"uuid"`
+	StatusID   int            `db:"status_id"`
+	Message    sql.NullString `db:"message"`
+	ProviderID sql.NullString `db:"provider_id"`
+	SizeMiB    sql.NullInt64  `db:"size_mib"`
+	UpdatedAt  time.Time      `db:"updated_at"`
+}
+
+// storageSnapshotHookInfo represents a storage snapshot waiting on a unit to
+// run one of its storage snapshot hooks.
+type storageSnapshotHookInfo struct {
+	ID        string `db:"snapshot_id"`
+	StorageID string `db:"storage_id"`
+	StatusID  int    `db:"status_id"`
+}
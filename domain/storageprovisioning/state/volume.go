@@ -1302,12 +1302,14 @@ SELECT &volumeProvisioningParams.* FROM (
     SELECT    sv.volume_id,
               si.requested_size_mib,
               sp.type,
-              sva.uuid AS volume_attachment_uuid
+              sva.uuid AS volume_attachment_uuid,
+              ss.provider_id AS snapshot_provider_id
     FROM      storage_volume sv
     JOIN      storage_instance_volume siv ON siv.storage_volume_uuid = sv.uuid
     JOIN      storage_instance si ON siv.storage_instance_uuid = si.uuid
     JOIN      storage_pool sp ON si.storage_pool_uuid = sp.uuid
     LEFT JOIN storage_volume_attachment sva ON sva.storage_volume_uuid = sv.uuid
+    LEFT JOIN storage_snapshot ss ON sv.snapshot_uuid = ss.uuid
     WHERE  sv.uuid = $volumeUUID.uuid
 )
 `,
@@ -1376,10 +1378,11 @@ WHERE  sv.uuid = $volumeUUID.uuid
 	}
 
 	retVal := storageprovisioning.VolumeParams{
-		Attributes: attributesRval,
-		ID:         paramsVal.VolumeID,
-		Provider:   paramsVal.Type,
-		SizeMiB:    paramsVal.RequestedSizeMiB,
+		Attributes:         attributesRval,
+		ID:                 paramsVal.VolumeID,
+		Provider:           paramsVal.Type,
+		SizeMiB:            paramsVal.RequestedSizeMiB,
+		SnapshotProviderID: paramsVal.SnapshotProviderID,
 	}
 	if paramsVal.VolumeAttachmentUUID != "" {
 		vaUUID := domainstorage.VolumeAttachmentUUID(
@@ -2066,3 +2069,108 @@ func (st *State) InitialWatchStatementVolumeAttachmentPlans(
 	}
 	return "storage_volume_attachment_plan_life_machine_provisioning", query
 }
+
+// InitialWatchStatementModelProvisionedVolumeResizes returns both the
+// namespace for watching requested size changes of volumes that are model
+// provisioned. On top of this the initial query for getting all model
+// provisioned volumes that are smaller than their requested size is returned.
+func (st *State) InitialWatchStatementModelProvisionedVolumeResizes() (
+	string, eventsource.NamespaceQuery,
+) {
+	query := func(ctx context.Context, db database.TxnRunner) ([]string, error) {
+		stmt, err := st.Prepare(`
+SELECT sv.volume_id AS &volumeID.*
+FROM   storage_volume sv
+JOIN   storage_instance_volume siv ON siv.storage_volume_uuid = sv.uuid
+JOIN   storage_instance si ON si.uuid = siv.storage_instance_uuid
+WHERE  sv.provision_scope_id = 0
+AND    sv.life_id = 0
+AND    sv.size_mib < si.requested_size_mib
+`, volumeID{})
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		var volIDs []volumeID
+		err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+			err := tx.Query(ctx, stmt).GetAll(&volIDs)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		rval := make([]string, 0, len(volIDs))
+		for _, v := range volIDs {
+			rval = append(rval, v.ID)
+		}
+		return rval, nil
+	}
+	return "storage_volume_requested_size_model_provisioning", query
+}
+
+// InitialWatchStatementMachineProvisionedVolumeResizes returns both the
+// namespace for watching requested size changes of volumes that are machine
+// provisioned. On top of this the initial query for getting all volumes to be
+// provisioned by the machine connected to the supplied net node that are
+// smaller than their requested size is returned.
+func (st *State) InitialWatchStatementMachineProvisionedVolumeResizes(
+	netNodeUUID domainnetwork.NetNodeUUID,
+) (string, eventsource.NamespaceQuery) {
+	query := func(ctx context.Context, db database.TxnRunner) ([]string, error) {
+		return st.getVolumeIDsPendingResizeForNetNode(ctx, db, netNodeUUID)
+	}
+	return "storage_volume_requested_size_machine_provisioning", query
+}
+
+// GetVolumeIDsPendingResizeForNetNode returns the ids of the machine
+// provisioned volumes to be provisioned by the machine owning the supplied
+// net node that are smaller than their requested size.
+func (st *State) GetVolumeIDsPendingResizeForNetNode(
+	ctx context.Context, netNodeUUID domainnetwork.NetNodeUUID,
+) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return st.getVolumeIDsPendingResizeForNetNode(ctx, db, netNodeUUID)
+}
+
+func (st *State) getVolumeIDsPendingResizeForNetNode(
+	ctx context.Context,
+	db domain.TxnRunner,
+	uuid domainnetwork.NetNodeUUID,
+) ([]string, error) {
+	netNodeInput := netNodeUUID{UUID: uuid.String()}
+	stmt, err := st.Prepare(`
+SELECT DISTINCT sv.volume_id AS &volumeID.*
+FROM            storage_volume sv
+JOIN            storage_volume_attachment sva ON sv.uuid = sva.storage_volume_uuid
+JOIN            storage_instance_volume siv ON siv.storage_volume_uuid = sv.uuid
+JOIN            storage_instance si ON si.uuid = siv.storage_instance_uuid
+WHERE           sv.provision_scope_id = 1
+AND             sv.life_id = 0
+AND             sv.size_mib < si.requested_size_mib
+AND             sva.net_node_uuid = $netNodeUUID.uuid
+`, volumeID{}, netNodeInput)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var volIDs []volumeID
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, netNodeInput).GetAll(&volIDs)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	rval := make([]string, 0, len(volIDs))
+	for _, v := range volIDs {
+		rval = append(rval, v.ID)
+	}
+	return rval, nil
+}
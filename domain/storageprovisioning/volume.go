@@ -189,6 +189,10 @@ type VolumeParams struct {
 	Provider             string
 	SizeMiB              uint64
 	VolumeAttachmentUUID *domainstorage.VolumeAttachmentUUID
+
+	// SnapshotProviderID is the provider id of the snapshot the volume is to
+	// be restored from. It is empty when the volume is not being restored.
+	SnapshotProviderID string
 }
 
 // VolumeRemovalParams defines the set of parameters that a caller needs to
@@ -609,6 +609,141 @@ func (s *watcherSuite) TestWatchModelProvisionedVolumes(c *tc.C) {
 	harness.Run(c, []string{})
 }
 
+// TestWatchModelProvisionedVolumeResizes asserts the watcher behaviour for
+// requested size changes of model provisioned volumes through both the service
+// and state layers.
+func (s *watcherSuite) TestWatchModelProvisionedVolumeResizes(c *tc.C) {
+	svc := s.setupService(c)
+
+	// A volume that is already smaller than its requested size is reported
+	// in the initial event.
+	siOneUUID, _ := s.newStorageInstance(c)
+	vOneUUID, vOneID := s.newModelVolume(c)
+	s.newStorageInstanceVolume(c, siOneUUID, domainstorage.VolumeUUID(vOneUUID))
+	s.changeVolumeSizeMiB(c, vOneUUID, 50)
+
+	siTwoUUID, _ := s.newStorageInstance(c)
+	vTwoUUID, vTwoID := s.newModelVolume(c)
+	s.newStorageInstanceVolume(c, siTwoUUID, domainstorage.VolumeUUID(vTwoUUID))
+	s.changeVolumeSizeMiB(c, vTwoUUID, 100)
+
+	watcher, err := svc.WatchModelProvisionedVolumeResizes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that raising the requested size of a volume's storage instance
+	// is reported in the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		s.changeStorageInstanceRequestedSize(c, siTwoUUID.String(), 200)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.Check(
+			watchertest.StringSliceAssert(vTwoID),
+		)
+	})
+
+	// Assert that changing the provisioned size of a volume does not produce
+	// a change in the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		s.changeVolumeSizeMiB(c, vTwoUUID, 200)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.AssertNoChange()
+	})
+
+	harness.Run(c, []string{vOneID})
+}
+
+// TestWatchMachineProvisionedVolumeResizes asserts the watcher behaviour for
+// requested size changes of machine provisioned volumes through both the
+// service and state layers.
+func (s *watcherSuite) TestWatchMachineProvisionedVolumeResizes(c *tc.C) {
+	svc := s.setupService(c)
+
+	machineUUID := s.newMachine(c)
+	otherMachineUUID := s.newMachine(c)
+
+	siOneUUID, _ := s.newStorageInstance(c)
+	vOneUUID, vOneID := s.newMachineVolume(c)
+	s.newStorageInstanceVolume(c, siOneUUID, vOneUUID)
+	s.newMachineVolumeAttachmentForMachine(c, vOneUUID.String(), machineUUID)
+	s.changeVolumeSizeMiB(c, vOneUUID.String(), 100)
+
+	siTwoUUID, _ := s.newStorageInstance(c)
+	vTwoUUID, _ := s.newMachineVolume(c)
+	s.newStorageInstanceVolume(c, siTwoUUID, vTwoUUID)
+	s.newMachineVolumeAttachmentForMachine(c, vTwoUUID.String(), otherMachineUUID)
+	s.changeVolumeSizeMiB(c, vTwoUUID.String(), 100)
+
+	watcher, err := svc.WatchMachineProvisionedVolumeResizes(
+		c.Context(), coremachine.UUID(machineUUID),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that raising the requested size of a volume attached to the
+	// machine is reported in the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		s.changeStorageInstanceRequestedSize(c, siOneUUID.String(), 200)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.Check(
+			watchertest.StringSliceAssert(vOneID),
+		)
+	})
+
+	// Assert that raising the requested size of a volume attached to another
+	// machine does not produce a change in the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		s.changeStorageInstanceRequestedSize(c, siTwoUUID.String(), 200)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.AssertNoChange()
+	})
+
+	harness.Run(c, []string{})
+}
+
+// TestWatchMachineProvisionedFilesystemResizes asserts the watcher behaviour
+// for requested size changes of machine provisioned filesystems through both
+// the service and state layers.
+func (s *watcherSuite) TestWatchMachineProvisionedFilesystemResizes(c *tc.C) {
+	svc := s.setupService(c)
+
+	machineUUID := s.newMachine(c)
+
+	siUUID, _ := s.newStorageInstance(c)
+	fsUUID, fsID := s.newMachineFilesystem(c)
+	s.newStorageInstanceFilesystem(c, siUUID, fsUUID)
+	s.newMachineFilesystemAttachmentForMachine(c, fsUUID.String(), machineUUID)
+	s.changeFilesystemSizeMiB(c, fsUUID.String())
+
+	watcher, err := svc.WatchMachineProvisionedFilesystemResizes(
+		c.Context(), coremachine.UUID(machineUUID),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that lowering the requested size below the provisioned size
+	// fires the watcher, but without any filesystems needing a resize.
+	harness.AddTest(c, func(c *tc.C) {
+		s.changeStorageInstanceRequestedSize(c, siUUID.String(), 9000)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.AssertNoChange()
+	})
+
+	// Assert that raising the requested size of a filesystem attached to
+	// the machine above the provisioned size is reported in the watcher.
+	harness.AddTest(c, func(c *tc.C) {
+		s.changeStorageInstanceRequestedSize(c, siUUID.String(), 10000)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.Check(
+			watchertest.StringSliceAssert(fsID),
+		)
+	})
+
+	harness.Run(c, []string{})
+}
+
 // TestWatchVolumeAttachmentPlans asserts the watcher behaviour for volume
 // attachment plans through both the service and state layers.
 func (s *watcherSuite) TestWatchVolumeAttachmentPlans(c *tc.C) {
@@ -1081,6 +1216,46 @@ WHERE  uuid = ?
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+// changeVolumeSizeMiB is a utility function for changing the provisioned size
+// of a volume.
+func (s *watcherSuite) changeVolumeSizeMiB(
+	c *tc.C, uuid string, sizeMiB uint64,
+) {
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(
+			ctx,
+			`
+UPDATE storage_volume
+SET    size_mib = ?
+WHERE  uuid = ?
+`,
+			sizeMiB, uuid,
+		)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// changeStorageInstanceRequestedSize is a utility function for changing the
+// requested size of a storage instance.
+func (s *watcherSuite) changeStorageInstanceRequestedSize(
+	c *tc.C, uuid string, sizeMiB uint64,
+) {
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(
+			ctx,
+			`
+UPDATE storage_instance
+SET    requested_size_mib = ?
+WHERE  uuid = ?
+`,
+			sizeMiB, uuid,
+		)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 // changeFilesystemAttachmentProviderID is a utility function for changing the
 // provider id of a filesystem attachment to a value chosen by this func. The
 // purpose of this is to help test changing the provider id, which the watcher is
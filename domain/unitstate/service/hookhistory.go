@@ -0,0 +1,125 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/juju/juju/core/trace"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/unitstate"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RecordHookHistory adds the input entries to the hook history of the unit
+// with the input name. Only the most recent [unitstate.MaxHookHistory]
+// entries are retained.
+// If no unit with the name exists, a [applicationerrors.UnitNotFound] error
+// is returned.
+func (s *Service) RecordHookHistory(
+	ctx context.Context, name coreunit.Name, entries []unitstate.HookHistoryEntry,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := name.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	if len(entries) > unitstate.MaxHookHistory {
+		entries = entries[len(entries)-unitstate.MaxHookHistory:]
+	}
+
+	return s.st.AddUnitHookHistory(ctx, name.String(), entries, unitstate.MaxHookHistory)
+}
+
+// GetHookHistory returns the hook history of the unit with the input name,
+// oldest first.
+// If no unit with the name exists, a [applicationerrors.UnitNotFound] error
+// is returned.
+func (s *Service) GetHookHistory(ctx context.Context, name coreunit.Name) ([]unitstate.HookHistoryEntry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := name.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return s.st.GetUnitHookHistory(ctx, name.String())
+}
+
+// GetApplicationHookStats returns statistics for each hook, action and
+// commands run by the units of the application with the input name,
+// aggregated over the hook history retained for those units. The stats are
+// ordered by kind and then name.
+// If no application with the name exists, a
+// [applicationerrors.ApplicationNotFound] error is returned.
+func (s *Service) GetApplicationHookStats(ctx context.Context, name string) ([]unitstate.HookStats, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !application.IsValidApplicationName(name) {
+		return nil, applicationerrors.ApplicationNameNotValid
+	}
+
+	history, err := s.st.GetApplicationHookHistory(ctx, name)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return aggregateHookHistory(history), nil
+}
+
+type hookStatsKey struct {
+	kind unitstate.HookHistoryKind
+	name string
+}
+
+// aggregateHookHistory returns the stats for each distinct hook, action and
+// commands in the input history.
+func aggregateHookHistory(history []unitstate.HookHistoryEntry) []unitstate.HookStats {
+	var (
+		keys   []hookStatsKey
+		stats  = make(map[hookStatsKey]*unitstate.HookStats)
+		totals = make(map[hookStatsKey]time.Duration)
+	)
+	for _, entry := range history {
+		key := hookStatsKey{kind: entry.Kind, name: entry.Name}
+		st, ok := stats[key]
+		if !ok {
+			st = &unitstate.HookStats{Kind: entry.Kind, Name: entry.Name}
+			stats[key] = st
+			keys = append(keys, key)
+		}
+		st.Count++
+		if entry.Failed() {
+			st.Failures++
+		}
+		totals[key] += entry.Duration
+		st.MaxDuration = max(st.MaxDuration, entry.Duration)
+		if entry.Started.After(st.LastRun) {
+			st.LastRun = entry.Started
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b hookStatsKey) int {
+		if a.kind != b.kind {
+			return cmp.Compare(a.kind, b.kind)
+		}
+		return cmp.Compare(a.name, b.name)
+	})
+	result := make([]unitstate.HookStats, len(keys))
+	for i, key := range keys {
+		st := stats[key]
+		st.MeanDuration = totals[key] / time.Duration(st.Count)
+		result[i] = *st
+	}
+	return result
+}
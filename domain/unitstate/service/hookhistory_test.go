@@ -0,0 +1,124 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	unittesting "github.com/juju/juju/core/unit/testing"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/unitstate"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func (s *serviceSuite) TestRecordHookHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := unittesting.GenNewName(c, "unit/0")
+	entries := []unitstate.HookHistoryEntry{{
+		Kind:     unitstate.HookHistoryHook,
+		Name:     "install",
+		Started:  time.Now(),
+		Duration: time.Second,
+	}}
+	s.st.EXPECT().AddUnitHookHistory(gomock.Any(), name.String(), entries, unitstate.MaxHookHistory)
+
+	err := NewService(s.st, loggertesting.WrapCheckLog(c)).RecordHookHistory(c.Context(), name, entries)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRecordHookHistoryTruncates(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := unittesting.GenNewName(c, "unit/0")
+	entries := make([]unitstate.HookHistoryEntry, unitstate.MaxHookHistory+10)
+	for i := range entries {
+		entries[i] = unitstate.HookHistoryEntry{
+			Kind: unitstate.HookHistoryHook,
+			Name: "update-status",
+		}
+	}
+	s.st.EXPECT().AddUnitHookHistory(
+		gomock.Any(), name.String(), entries[10:], unitstate.MaxHookHistory,
+	)
+
+	err := NewService(s.st, loggertesting.WrapCheckLog(c)).RecordHookHistory(c.Context(), name, entries)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestGetHookHistoryUnitNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := unittesting.GenNewName(c, "unit/0")
+	s.st.EXPECT().GetUnitHookHistory(gomock.Any(), name.String()).Return(
+		nil, applicationerrors.UnitNotFound)
+
+	_, err := NewService(s.st, loggertesting.WrapCheckLog(c)).GetHookHistory(c.Context(), name)
+	c.Assert(err, tc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *serviceSuite) TestGetApplicationHookStats(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.st.EXPECT().GetApplicationHookHistory(gomock.Any(), "app").Return([]unitstate.HookHistoryEntry{{
+		Kind:     unitstate.HookHistoryHook,
+		Name:     "config-changed",
+		Started:  started,
+		Duration: time.Second,
+	}, {
+		Kind:     unitstate.HookHistoryAction,
+		Name:     "backup",
+		Started:  started.Add(time.Minute),
+		Duration: time.Minute,
+	}, {
+		Kind:     unitstate.HookHistoryHook,
+		Name:     "config-changed",
+		Started:  started.Add(2 * time.Minute),
+		Duration: 3 * time.Second,
+		ExitCode: 1,
+		Error:    "exit status 1",
+	}, {
+		Kind:     unitstate.HookHistoryHook,
+		Name:     "start",
+		Started:  started.Add(3 * time.Minute),
+		Duration: 2 * time.Second,
+	}}, nil)
+
+	stats, err := NewService(s.st, loggertesting.WrapCheckLog(c)).GetApplicationHookStats(c.Context(), "app")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(stats, tc.DeepEquals, []unitstate.HookStats{{
+		Kind:         unitstate.HookHistoryHook,
+		Name:         "config-changed",
+		Count:        2,
+		Failures:     1,
+		MeanDuration: 2 * time.Second,
+		MaxDuration:  3 * time.Second,
+		LastRun:      started.Add(2 * time.Minute),
+	}, {
+		Kind:         unitstate.HookHistoryHook,
+		Name:         "start",
+		Count:        1,
+		MeanDuration: 2 * time.Second,
+		MaxDuration:  2 * time.Second,
+		LastRun:      started.Add(3 * time.Minute),
+	}, {
+		Kind:         unitstate.HookHistoryAction,
+		Name:         "backup",
+		Count:        1,
+		MeanDuration: time.Minute,
+		MaxDuration:  time.Minute,
+		LastRun:      started.Add(time.Minute),
+	}})
+}
+
+func (s *serviceSuite) TestGetApplicationHookStatsNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewService(s.st, loggertesting.WrapCheckLog(c)).GetApplicationHookStats(c.Context(), "!!!")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
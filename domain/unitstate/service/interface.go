@@ -16,6 +16,7 @@ import (
 type State interface {
 	CommitHookState
 	UnitStateState
+	HookHistoryState
 }
 
 // CommitHookState defines a persistence layer interface for commit hook changes.
@@ -66,3 +67,26 @@ type UnitStateState interface {
 	// based on its populated values.
 	SetUnitState(context.Context, unitstate.UnitState) error
 }
+
+// HookHistoryState defines a persistence layer interface for recording and
+// retrieving the hook history of units.
+type HookHistoryState interface {
+	// AddUnitHookHistory records the input entries in the hook history of
+	// the unit with the input name, and then removes all but the most recent
+	// limit entries from that history.
+	// If no unit with the name exists, a [applicationerrors.UnitNotFound]
+	// error is returned.
+	AddUnitHookHistory(ctx context.Context, name string, entries []unitstate.HookHistoryEntry, limit int) error
+
+	// GetUnitHookHistory returns the hook history of the unit with the input
+	// name, oldest first.
+	// If no unit with the name exists, a [applicationerrors.UnitNotFound]
+	// error is returned.
+	GetUnitHookHistory(ctx context.Context, name string) ([]unitstate.HookHistoryEntry, error)
+
+	// GetApplicationHookHistory returns the hook history of all the units of
+	// the application with the input name, oldest first.
+	// If no application with the name exists, a
+	// [applicationerrors.ApplicationNotFound] error is returned.
+	GetApplicationHookHistory(ctx context.Context, name string) ([]unitstate.HookHistoryEntry, error)
+}
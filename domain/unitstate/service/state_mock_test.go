@@ -43,6 +43,44 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddUnitHookHistory mocks base method.
+func (m *MockState) AddUnitHookHistory(arg0 context.Context, arg1 string, arg2 []unitstate.HookHistoryEntry, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUnitHookHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUnitHookHistory indicates an expected call of AddUnitHookHistory.
+func (mr *MockStateMockRecorder) AddUnitHookHistory(arg0, arg1, arg2, arg3 any) *MockStateAddUnitHookHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUnitHookHistory", reflect.TypeOf((*MockState)(nil).AddUnitHookHistory), arg0, arg1, arg2, arg3)
+	return &MockStateAddUnitHookHistoryCall{Call: call}
+}
+
+// MockStateAddUnitHookHistoryCall wrap *gomock.Call
+type MockStateAddUnitHookHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddUnitHookHistoryCall) Return(arg0 error) *MockStateAddUnitHookHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddUnitHookHistoryCall) Do(f func(context.Context, string, []unitstate.HookHistoryEntry, int) error) *MockStateAddUnitHookHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddUnitHookHistoryCall) DoAndReturn(f func(context.Context, string, []unitstate.HookHistoryEntry, int) error) *MockStateAddUnitHookHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CommitHookChanges mocks base method.
 func (m *MockState) CommitHookChanges(arg0 context.Context, arg1 internal.CommitHookChangesArg) error {
 	m.ctrl.T.Helper()
@@ -81,6 +119,45 @@ func (c *MockStateCommitHookChangesCall) DoAndReturn(f func(context.Context, int
 	return c
 }
 
+// GetApplicationHookHistory mocks base method.
+func (m *MockState) GetApplicationHookHistory(arg0 context.Context, arg1 string) ([]unitstate.HookHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationHookHistory", arg0, arg1)
+	ret0, _ := ret[0].([]unitstate.HookHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationHookHistory indicates an expected call of GetApplicationHookHistory.
+func (mr *MockStateMockRecorder) GetApplicationHookHistory(arg0, arg1 any) *MockStateGetApplicationHookHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationHookHistory", reflect.TypeOf((*MockState)(nil).GetApplicationHookHistory), arg0, arg1)
+	return &MockStateGetApplicationHookHistoryCall{Call: call}
+}
+
+// MockStateGetApplicationHookHistoryCall wrap *gomock.Call
+type MockStateGetApplicationHookHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationHookHistoryCall) Return(arg0 []unitstate.HookHistoryEntry, arg1 error) *MockStateGetApplicationHookHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationHookHistoryCall) Do(f func(context.Context, string) ([]unitstate.HookHistoryEntry, error)) *MockStateGetApplicationHookHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationHookHistoryCall) DoAndReturn(f func(context.Context, string) ([]unitstate.HookHistoryEntry, error)) *MockStateGetApplicationHookHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetPeerRelationUUIDByEndpointIdentifiers mocks base method.
 func (m *MockState) GetPeerRelationUUIDByEndpointIdentifiers(arg0 context.Context, arg1 relation.EndpointIdentifier) (relation.UUID, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +236,45 @@ func (c *MockStateGetRegularRelationUUIDByEndpointIdentifiersCall) DoAndReturn(f
 	return c
 }
 
+// GetUnitHookHistory mocks base method.
+func (m *MockState) GetUnitHookHistory(arg0 context.Context, arg1 string) ([]unitstate.HookHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitHookHistory", arg0, arg1)
+	ret0, _ := ret[0].([]unitstate.HookHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitHookHistory indicates an expected call of GetUnitHookHistory.
+func (mr *MockStateMockRecorder) GetUnitHookHistory(arg0, arg1 any) *MockStateGetUnitHookHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitHookHistory", reflect.TypeOf((*MockState)(nil).GetUnitHookHistory), arg0, arg1)
+	return &MockStateGetUnitHookHistoryCall{Call: call}
+}
+
+// MockStateGetUnitHookHistoryCall wrap *gomock.Call
+type MockStateGetUnitHookHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUnitHookHistoryCall) Return(arg0 []unitstate.HookHistoryEntry, arg1 error) *MockStateGetUnitHookHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUnitHookHistoryCall) Do(f func(context.Context, string) ([]unitstate.HookHistoryEntry, error)) *MockStateGetUnitHookHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUnitHookHistoryCall) DoAndReturn(f func(context.Context, string) ([]unitstate.HookHistoryEntry, error)) *MockStateGetUnitHookHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitState mocks base method.
 func (m *MockState) GetUnitState(arg0 context.Context, arg1 string) (unitstate.RetrievedUnitState, error) {
 	m.ctrl.T.Helper()
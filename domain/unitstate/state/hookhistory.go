@@ -0,0 +1,227 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/unitstate"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// hookHistoryEntry represents a row in the unit_hook_history table.
+type hookHistoryEntry struct {
+	UUID       string         `db:"uuid"`
+	UnitUUID   string         `db:"unit_uuid"`
+	KindID     int            `db:"kind_id"`
+	Name       string         `db:"name"`
+	RelationID sql.NullInt64  `db:"relation_id"`
+	StartedAt  time.Time      `db:"started_at"`
+	DurationMS int64          `db:"duration_ms"`
+	ExitCode   int            `db:"exit_code"`
+	Error      sql.NullString `db:"error"`
+}
+
+// hookHistoryLimit is the number of hook history entries to retain.
+type hookHistoryLimit struct {
+	Limit int `db:"limit"`
+}
+
+// applicationName identifies an application.
+type applicationName struct {
+	Name string `db:"name"`
+}
+
+// AddUnitHookHistory records the input entries in the hook history of the
+// unit with the input name, and then removes all but the most recent limit
+// entries from that history.
+// If no unit with the name exists, a [applicationerrors.UnitNotFound] error
+// is returned.
+func (st *State) AddUnitHookHistory(
+	ctx context.Context, name string, entries []unitstate.HookHistoryEntry, limit int,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertStmt, err := st.Prepare(`INSERT INTO unit_hook_history (*) VALUES ($hookHistoryEntry.*)`, hookHistoryEntry{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	pruneStmt, err := st.Prepare(`
+DELETE FROM unit_hook_history
+WHERE  unit_uuid = $entityUUID.uuid
+AND    uuid NOT IN (
+    SELECT   uuid
+    FROM     unit_hook_history
+    WHERE    unit_uuid = $entityUUID.uuid
+    ORDER BY started_at DESC, rowid DESC
+    LIMIT    $hookHistoryLimit.limit
+)`, entityUUID{}, hookHistoryLimit{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	rows := make([]hookHistoryEntry, len(entries))
+	for i, entry := range entries {
+		entryUUID, err := uuid.NewUUID()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		rows[i] = hookHistoryEntry{
+			UUID:       entryUUID.String(),
+			KindID:     int(entry.Kind),
+			Name:       entry.Name,
+			StartedAt:  entry.Started.UTC(),
+			DurationMS: entry.Duration.Milliseconds(),
+			ExitCode:   entry.ExitCode,
+		}
+		if entry.RelationID != nil {
+			rows[i].RelationID = sql.NullInt64{Int64: int64(*entry.RelationID), Valid: true}
+		}
+		if entry.Error != "" {
+			rows[i].Error = sql.NullString{String: entry.Error, Valid: true}
+		}
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		id, err := st.getUnitUUIDForName(ctx, tx, name)
+		if err != nil {
+			return errors.Errorf("getting unit UUID for %q: %w", name, err)
+		}
+
+		for _, row := range rows {
+			row.UnitUUID = id.UUID
+			if err := tx.Query(ctx, insertStmt, row).Run(); err != nil {
+				return errors.Errorf("inserting hook history entry: %w", err)
+			}
+		}
+
+		if err := tx.Query(ctx, pruneStmt, id, hookHistoryLimit{Limit: limit}).Run(); err != nil {
+			return errors.Errorf("pruning hook history: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("recording hook history for %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetUnitHookHistory returns the hook history of the unit with the input
+// name, oldest first.
+// If no unit with the name exists, a [applicationerrors.UnitNotFound] error
+// is returned.
+func (st *State) GetUnitHookHistory(ctx context.Context, name string) ([]unitstate.HookHistoryEntry, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &hookHistoryEntry.*
+FROM   unit_hook_history
+WHERE  unit_uuid = $entityUUID.uuid
+ORDER BY started_at, rowid`, hookHistoryEntry{}, entityUUID{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []hookHistoryEntry
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		id, err := st.getUnitUUIDForName(ctx, tx, name)
+		if err != nil {
+			return errors.Errorf("getting unit UUID for %q: %w", name, err)
+		}
+
+		err = tx.Query(ctx, stmt, id).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting hook history: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return decodeHookHistory(rows), nil
+}
+
+// GetApplicationHookHistory returns the hook history of all the units of the
+// application with the input name, oldest first.
+// If no application with the name exists, a
+// [applicationerrors.ApplicationNotFound] error is returned.
+func (st *State) GetApplicationHookHistory(ctx context.Context, name string) ([]unitstate.HookHistoryEntry, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	appName := applicationName{Name: name}
+	appStmt, err := st.Prepare(`
+SELECT &entityUUID.uuid
+FROM   application
+WHERE  name = $applicationName.name`, entityUUID{}, appName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT h.* AS &hookHistoryEntry.*
+FROM   unit_hook_history AS h
+JOIN   unit AS u ON h.unit_uuid = u.uuid
+WHERE  u.application_uuid = $entityUUID.uuid
+ORDER BY h.started_at, h.rowid`, hookHistoryEntry{}, entityUUID{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []hookHistoryEntry
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var appUUID entityUUID
+		err := tx.Query(ctx, appStmt, appName).Get(&appUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("application %q not found", name).Add(applicationerrors.ApplicationNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting application UUID for %q: %w", name, err)
+		}
+
+		err = tx.Query(ctx, stmt, appUUID).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting hook history: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return decodeHookHistory(rows), nil
+}
+
+func decodeHookHistory(rows []hookHistoryEntry) []unitstate.HookHistoryEntry {
+	result := make([]unitstate.HookHistoryEntry, len(rows))
+	for i, row := range rows {
+		entry := unitstate.HookHistoryEntry{
+			Kind:     unitstate.HookHistoryKind(row.KindID),
+			Name:     row.Name,
+			Started:  row.StartedAt,
+			Duration: time.Duration(row.DurationMS) * time.Millisecond,
+			ExitCode: row.ExitCode,
+			Error:    row.Error.String,
+		}
+		if row.RelationID.Valid {
+			relationID := int(row.RelationID.Int64)
+			entry.RelationID = &relationID
+		}
+		result[i] = entry
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/unitstate"
+)
+
+type hookHistorySuite struct {
+	baseSuite
+}
+
+func TestHookHistorySuite(t *stdtesting.T) {
+	tc.Run(t, &hookHistorySuite{})
+}
+
+func (s *hookHistorySuite) TestAddAndGetUnitHookHistory(c *tc.C) {
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	entries := []unitstate.HookHistoryEntry{{
+		Kind:     unitstate.HookHistoryHook,
+		Name:     "install",
+		Started:  started,
+		Duration: 3 * time.Second,
+	}, {
+		Kind:       unitstate.HookHistoryHook,
+		Name:       "db-relation-changed",
+		RelationID: new(1),
+		Started:    started.Add(time.Minute),
+		Duration:   250 * time.Millisecond,
+		ExitCode:   1,
+		Error:      "exit status 1",
+	}, {
+		Kind:     unitstate.HookHistoryAction,
+		Name:     "backup",
+		Started:  started.Add(2 * time.Minute),
+		Duration: time.Minute,
+	}}
+
+	err := s.state.AddUnitHookHistory(c.Context(), s.unitName, entries, unitstate.MaxHookHistory)
+	c.Assert(err, tc.ErrorIsNil)
+
+	history, err := s.state.GetUnitHookHistory(c.Context(), s.unitName)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(history, tc.DeepEquals, entries)
+}
+
+func (s *hookHistorySuite) TestAddUnitHookHistoryPrunesOldest(c *tc.C) {
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := range 5 {
+		err := s.state.AddUnitHookHistory(c.Context(), s.unitName, []unitstate.HookHistoryEntry{{
+			Kind:    unitstate.HookHistoryHook,
+			Name:    "update-status",
+			Started: started.Add(time.Duration(i) * time.Minute),
+		}}, 3)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	history, err := s.state.GetUnitHookHistory(c.Context(), s.unitName)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(history, tc.HasLen, 3)
+	c.Check(history[0].Started, tc.Equals, started.Add(2*time.Minute))
+	c.Check(history[2].Started, tc.Equals, started.Add(4*time.Minute))
+}
+
+func (s *hookHistorySuite) TestGetUnitHookHistoryEmpty(c *tc.C) {
+	history, err := s.state.GetUnitHookHistory(c.Context(), s.unitName)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(history, tc.HasLen, 0)
+}
+
+func (s *hookHistorySuite) TestUnitHookHistoryUnitNotFound(c *tc.C) {
+	err := s.state.AddUnitHookHistory(c.Context(), "missing/0", []unitstate.HookHistoryEntry{{
+		Kind: unitstate.HookHistoryCommands,
+	}}, unitstate.MaxHookHistory)
+	c.Check(err, tc.ErrorIs, applicationerrors.UnitNotFound)
+
+	_, err = s.state.GetUnitHookHistory(c.Context(), "missing/0")
+	c.Check(err, tc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *hookHistorySuite) TestGetApplicationHookHistory(c *tc.C) {
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	entries := []unitstate.HookHistoryEntry{{
+		Kind:     unitstate.HookHistoryHook,
+		Name:     "config-changed",
+		Started:  started,
+		Duration: time.Second,
+	}, {
+		Kind:     unitstate.HookHistoryCommands,
+		Started:  started.Add(time.Minute),
+		ExitCode: 2,
+	}}
+	err := s.state.AddUnitHookHistory(c.Context(), s.unitName, entries, unitstate.MaxHookHistory)
+	c.Assert(err, tc.ErrorIsNil)
+
+	history, err := s.state.GetApplicationHookHistory(c.Context(), "app")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(history, tc.DeepEquals, entries)
+}
+
+func (s *hookHistorySuite) TestGetApplicationHookHistoryNotFound(c *tc.C) {
+	_, err := s.state.GetApplicationHookHistory(c.Context(), "missing")
+	c.Check(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
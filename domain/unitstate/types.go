@@ -4,6 +4,8 @@
 package unitstate
 
 import (
+	"time"
+
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/secrets"
@@ -279,3 +281,88 @@ func (c CommitHookChangesArg) verifyNoPortRangeConflicts() error {
 	}
 	return errors.Errorf("%s: %w", errors.Join(conflicts...), porterrors.PortRangeConflict)
 }
+
+// MaxHookHistory is the number of entries retained in the hook history of
+// each unit. Older entries are removed as new ones are recorded.
+const MaxHookHistory = 100
+
+// HookHistoryKind identifies the kind of operation recorded in a unit's
+// hook history.
+type HookHistoryKind int
+
+// These represent the kinds of operation recorded in a unit's hook history.
+const (
+	HookHistoryHook HookHistoryKind = iota
+	HookHistoryAction
+	HookHistoryCommands
+)
+
+// String returns the name of the kind.
+func (k HookHistoryKind) String() string {
+	switch k {
+	case HookHistoryHook:
+		return "hook"
+	case HookHistoryAction:
+		return "action"
+	case HookHistoryCommands:
+		return "commands"
+	}
+	return ""
+}
+
+// ParseHookHistoryKind returns the hook history kind with the input name.
+func ParseHookHistoryKind(kind string) (HookHistoryKind, error) {
+	switch kind {
+	case "hook":
+		return HookHistoryHook, nil
+	case "action":
+		return HookHistoryAction, nil
+	case "commands":
+		return HookHistoryCommands, nil
+	}
+	return 0, errors.Errorf("hook history kind %q not valid", kind)
+}
+
+// HookHistoryEntry is a record of a single hook, action or commands
+// execution by a unit agent.
+type HookHistoryEntry struct {
+	// Kind is the kind of operation which was run.
+	Kind HookHistoryKind
+	// Name is the hook or action name. It is empty for commands.
+	Name string
+	// RelationID is the ID of the relation for relation hooks.
+	RelationID *int
+	// Started is when the execution started.
+	Started time.Time
+	// Duration is how long the execution took.
+	Duration time.Duration
+	// ExitCode is the exit code of the process, or -1 if the process
+	// could not be started or was killed.
+	ExitCode int
+	// Error is the error the execution failed with, if any.
+	Error string
+}
+
+// Failed returns true if the execution failed.
+func (e HookHistoryEntry) Failed() bool {
+	return e.ExitCode != 0 || e.Error != ""
+}
+
+// HookStats aggregates the hook history entries of the units of an
+// application for a single hook, action or commands.
+type HookStats struct {
+	// Kind is the kind of operation the stats are for.
+	Kind HookHistoryKind
+	// Name is the hook or action name. It is empty for commands.
+	Name string
+	// Count is the number of recorded executions.
+	Count int
+	// Failures is the number of recorded executions which failed.
+	Failures int
+	// MeanDuration is the mean duration of the recorded executions.
+	MeanDuration time.Duration
+	// MaxDuration is the longest duration of the recorded executions.
+	MaxDuration time.Duration
+	// LastRun is when the most recent recorded execution started.
+	LastRun time.Time
+}
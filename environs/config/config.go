@@ -5,6 +5,7 @@ package config
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"maps"
 	"net"
@@ -25,6 +26,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/logforward"
 	coremodelconfig "github.com/juju/juju/core/modelconfig"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
@@ -211,6 +213,15 @@ const (
 	// automatically retry a hook that has failed
 	AutomaticallyRetryHooks = "automatically-retry-hooks"
 
+	// HookTimeoutKey is how long the uniter lets a hook run before it is
+	// killed and reported as timed out. A zero value disables the timeout.
+	HookTimeoutKey = "hook-timeout"
+
+	// AutomaticallyRetryTimedOutHooksKey determines whether the uniter
+	// will automatically retry a hook that has timed out, even when
+	// automatically-retry-hooks is disabled.
+	AutomaticallyRetryTimedOutHooksKey = "automatically-retry-timed-out-hooks"
+
 	// EnableOSRefreshUpdateKey determines whether newly provisioned instances
 	// should run their respective OS's update capability.
 	EnableOSRefreshUpdateKey = "enable-os-refresh-update"
@@ -242,6 +253,14 @@ const (
 	// grow to before it is pruned, eg "5M"
 	MaxActionResultsSize = "max-action-results-size"
 
+	// MaxSecretAccessLogAge is the maximum age of secret access log entries
+	// to keep when pruning, eg "2160h".
+	MaxSecretAccessLogAge = "max-secret-access-log-age"
+
+	// MaxSecretAccessLogEntries is the maximum number of secret access log
+	// entries the model keeps before the oldest are pruned.
+	MaxSecretAccessLogEntries = "max-secret-access-log-entries"
+
 	// UpdateStatusHookInterval is how often to run the update-status hook.
 	UpdateStatusHookInterval = "update-status-hook-interval"
 
@@ -304,6 +323,14 @@ const (
 
 	// LoggingConfigKey is used to specify the logging backend configuration.
 	LoggingConfigKey = "logging-config"
+
+	// LogForwardTargetsKey is a comma separated list of destinations to
+	// which the log records of the model are forwarded.
+	LogForwardTargetsKey = "log-forward-targets"
+
+	// LogForwardCACertKey is a PEM encoded CA certificate used to verify
+	// TLS connections to log forwarding destinations.
+	LogForwardCACertKey = "log-forward-ca-cert"
 )
 
 // GetDefaultSupportedLTSBase returns the DefaultSupportedLTSBase.
@@ -424,6 +451,10 @@ const (
 	// UpdateStatusHookInterval
 	DefaultUpdateStatusHookInterval = "5m"
 
+	// DefaultHookTimeout is the default value for HookTimeoutKey, which
+	// lets hooks run for as long as they need.
+	DefaultHookTimeout = "0s"
+
 	// DefaultActionResultsAge is the default for the age of the results for an
 	// action.
 	DefaultActionResultsAge = "336h" // 2 weeks
@@ -431,6 +462,14 @@ const (
 	// DefaultActionResultsSize is the default size of the action results.
 	DefaultActionResultsSize = "5G"
 
+	// DefaultSecretAccessLogAge is the default for the age of secret access
+	// log entries.
+	DefaultSecretAccessLogAge = "2160h" // 90 days
+
+	// DefaultSecretAccessLogEntries is the default for the number of secret
+	// access log entries.
+	DefaultSecretAccessLogEntries = 100000
+
 	// DefaultLxdSnapChannel is the default lxd snap channel to install on host vms.
 	DefaultLxdSnapChannel = "5.0/stable"
 
@@ -471,6 +510,8 @@ var defaultConfigValues = map[string]any{
 	NumContainerProvisionWorkersKey: 4,
 	ResourceTagsKey:                 "",
 	LoggingConfigKey:                "",
+	LogForwardTargetsKey:            "",
+	LogForwardCACertKey:             "",
 	AutomaticallyRetryHooks:         true,
 	EnableOSRefreshUpdateKey:        true,
 	EnableOSUpgradeKey:              true,
@@ -486,6 +527,9 @@ var defaultConfigValues = map[string]any{
 	BackupDirKey:                    "",
 	LXDSnapChannel:                  DefaultLxdSnapChannel,
 
+	HookTimeoutKey:                     DefaultHookTimeout,
+	AutomaticallyRetryTimedOutHooksKey: false,
+
 	CharmHubURLKey: charmhub.DefaultServerURL,
 
 	// Image and agent streams and URLs.
@@ -523,6 +567,10 @@ var defaultConfigValues = map[string]any{
 	MaxActionResultsAge:  DefaultActionResultsAge,
 	MaxActionResultsSize: DefaultActionResultsSize,
 
+	// Secret access log settings
+	MaxSecretAccessLogAge:     DefaultSecretAccessLogAge,
+	MaxSecretAccessLogEntries: DefaultSecretAccessLogEntries,
+
 	// Model firewall settings
 	SSHAllowKey:         "0.0.0.0/0,::/0",
 	SAASIngressAllowKey: "0.0.0.0/0,::/0",
@@ -660,6 +708,18 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[LogForwardTargetsKey].(string); ok {
+		if _, err := logforward.ParseTargets(v); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if v, ok := cfg.defined[LogForwardCACertKey].(string); ok && v != "" {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(v)) {
+			return errors.NotValidf("log forward CA certificate")
+		}
+	}
+
 	if uuid := cfg.UUID(); !utils.IsValidUUIDString(uuid) {
 		return errors.Errorf("uuid: expected UUID, got string(%q)", uuid)
 	}
@@ -681,6 +741,16 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[MaxSecretAccessLogAge].(string); ok {
+		if _, err := time.ParseDuration(v); err != nil {
+			return errors.Annotate(err, "invalid max secret access log age in model configuration")
+		}
+	}
+
+	if v, ok := cfg.defined[MaxSecretAccessLogEntries].(int); ok && v < 0 {
+		return errors.NotValidf("negative max secret access log entries %d in model configuration", v)
+	}
+
 	if v, ok := cfg.defined[UpdateStatusHookInterval].(string); ok {
 		duration, err := time.ParseDuration(v)
 		if err != nil {
@@ -694,6 +764,16 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[HookTimeoutKey].(string); ok {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid hook timeout in model configuration")
+		}
+		if duration < 0 {
+			return errors.NotValidf("negative hook timeout %v", duration)
+		}
+	}
+
 	if v, ok := cfg.defined[EgressSubnets].(string); ok && v != "" {
 		cidrs := strings.SplitSeq(v, ",")
 		for cidr := range cidrs {
@@ -1263,6 +1343,20 @@ func (c *Config) LoggingConfig() string {
 	return c.asString(LoggingConfigKey)
 }
 
+// LogForwardTargets returns the destinations to which the log records of
+// the model are forwarded.
+func (c *Config) LogForwardTargets() []logforward.Target {
+	// The targets are checked when the config is validated.
+	targets, _ := logforward.ParseTargets(c.asString(LogForwardTargetsKey))
+	return targets
+}
+
+// LogForwardCACert returns the PEM encoded CA certificate used to verify
+// TLS connections to log forwarding destinations.
+func (c *Config) LogForwardCACert() string {
+	return c.asString(LogForwardCACertKey)
+}
+
 // BackupDir returns the configuration string for the temporary files
 // backup.
 func (c *Config) BackupDir() string {
@@ -1279,6 +1373,21 @@ func (c *Config) AutomaticallyRetryHooks() bool {
 	return val
 }
 
+// AutomaticallyRetryTimedOutHooks returns whether we should automatically
+// retry hooks that have timed out, even if AutomaticallyRetryHooks is false.
+func (c *Config) AutomaticallyRetryTimedOutHooks() bool {
+	val, _ := c.defined[AutomaticallyRetryTimedOutHooksKey].(bool)
+	return val
+}
+
+// HookTimeout returns how long a hook may run before the uniter kills it.
+// A zero duration means that hooks are never timed out.
+func (c *Config) HookTimeout() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.asString(HookTimeoutKey))
+	return val
+}
+
 // TransmitVendorMetrics returns whether the controller sends charm-collected metrics
 // in this model for anonymized aggregate analytics. By default this should be true.
 func (c *Config) TransmitVendorMetrics() bool {
@@ -1523,6 +1632,21 @@ func (c *Config) MaxActionResultsSizeMB() uint {
 	return uint(val)
 }
 
+// MaxSecretAccessLogAge returns the maximum age of secret access log
+// entries. Zero means entries are not pruned by age.
+func (c *Config) MaxSecretAccessLogAge() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.mustString(MaxSecretAccessLogAge))
+	return val
+}
+
+// MaxSecretAccessLogEntries returns the maximum number of secret access log
+// entries kept by the model. Zero means entries are not pruned by number.
+func (c *Config) MaxSecretAccessLogEntries() int {
+	value, _ := c.defined[MaxSecretAccessLogEntries].(int)
+	return value
+}
+
 // UpdateStatusHookInterval is how often to run the charm
 // update-status hook.
 func (c *Config) UpdateStatusHookInterval() time.Duration {
@@ -1645,6 +1769,8 @@ var alwaysOptional = schema.Defaults{
 	SAASIngressAllowKey: schema.Omit,
 
 	"logging-config":                schema.Omit,
+	LogForwardTargetsKey:            schema.Omit,
+	LogForwardCACertKey:             schema.Omit,
 	NumProvisionWorkersKey:          schema.Omit,
 	NumContainerProvisionWorkersKey: schema.Omit,
 	HTTPProxyKey:                    schema.Omit,
@@ -1684,6 +1810,8 @@ var alwaysOptional = schema.Defaults{
 	ContainerNetworkingMethodKey:    schema.Omit,
 	MaxActionResultsAge:             schema.Omit,
 	MaxActionResultsSize:            schema.Omit,
+	MaxSecretAccessLogAge:           schema.Omit,
+	MaxSecretAccessLogEntries:       schema.Omit,
 	UpdateStatusHookInterval:        schema.Omit,
 	EgressSubnets:                   schema.Omit,
 	CloudInitUserDataKey:            schema.Omit,
@@ -1693,6 +1821,9 @@ var alwaysOptional = schema.Defaults{
 	LXDSnapChannel:                  schema.Omit,
 	CharmHubURLKey:                  schema.Omit,
 
+	HookTimeoutKey:                     schema.Omit,
+	AutomaticallyRetryTimedOutHooksKey: schema.Omit,
+
 	AgentMetadataURLKey:                       schema.Omit,
 	ImageStreamKey:                            schema.Omit,
 	ImageMetadataURLKey:                       schema.Omit,
@@ -541,6 +541,34 @@ var configTests = []configTest{
 			"saas-ingress-allow": "blah",
 		}),
 		err: `cidr "blah" not valid`,
+	}, {
+		about:       "Invalid log-forward-targets",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"log-forward-targets": "syslog://logs.example.com,gopher://logs.example.com",
+		}),
+		err: `log forward target "gopher://logs.example.com": unknown scheme "gopher"`,
+	}, {
+		about:       "Invalid log-forward-ca-cert",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"log-forward-ca-cert": "not a certificate",
+		}),
+		err: `log forward CA certificate not valid`,
+	}, {
+		about:       "Invalid hook-timeout",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout": "forever",
+		}),
+		err: `invalid hook timeout in model configuration: time: invalid duration "forever"`,
+	}, {
+		about:       "Negative hook-timeout",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout": "-5m",
+		}),
+		err: `negative hook timeout -5m0s not valid`,
 	},
 }
 
@@ -1336,6 +1364,33 @@ func (s *ConfigSuite) TestUpdateStatusHookIntervalConfigValue(c *tc.C) {
 	c.Assert(cfg.UpdateStatusHookInterval(), tc.Equals, 30*time.Minute)
 }
 
+func (s *ConfigSuite) TestSecretAccessLogConfigDefault(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Check(cfg.MaxSecretAccessLogAge(), tc.Equals, 90*24*time.Hour)
+	c.Check(cfg.MaxSecretAccessLogEntries(), tc.Equals, 100000)
+}
+
+func (s *ConfigSuite) TestSecretAccessLogConfigValue(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"max-secret-access-log-age":     "24h",
+		"max-secret-access-log-entries": 500,
+	})
+	c.Check(cfg.MaxSecretAccessLogAge(), tc.Equals, 24*time.Hour)
+	c.Check(cfg.MaxSecretAccessLogEntries(), tc.Equals, 500)
+}
+
+func (s *ConfigSuite) TestSecretAccessLogConfigInvalid(c *tc.C) {
+	_, err := config.New(config.UseDefaults, testing.FakeConfig().Merge(testing.Attrs{
+		"max-secret-access-log-age": "forever",
+	}))
+	c.Check(err, tc.ErrorMatches, `invalid max secret access log age in model configuration: .*`)
+
+	_, err = config.New(config.UseDefaults, testing.FakeConfig().Merge(testing.Attrs{
+		"max-secret-access-log-entries": -1,
+	}))
+	c.Check(err, tc.ErrorMatches, `negative max secret access log entries -1 in model configuration not valid`)
+}
+
 func (s *ConfigSuite) TestEgressSubnets(c *tc.C) {
 	cfg := newTestConfig(c, testing.Attrs{
 		"egress-subnets": "10.0.0.1/32, 192.168.1.1/16",
@@ -1439,6 +1494,35 @@ func (s *ConfigSuite) TestLXDSnapChannelConfig(c *tc.C) {
 	c.Assert(config.LXDSnapChannel(), tc.Equals, "latest/candidate")
 }
 
+func (s *ConfigSuite) TestLogForwardConfig(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Check(cfg.LogForwardTargets(), tc.HasLen, 0)
+	c.Check(cfg.LogForwardCACert(), tc.Equals, "")
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"log-forward-targets": "syslog+tls://logs.example.com,loki+http://loki:3100",
+		"log-forward-ca-cert": testing.CACert,
+	})
+	targets := cfg.LogForwardTargets()
+	c.Assert(targets, tc.HasLen, 2)
+	c.Check(targets[0].String(), tc.Equals, "syslog+tls://logs.example.com")
+	c.Check(targets[1].String(), tc.Equals, "loki+http://loki:3100")
+	c.Check(cfg.LogForwardCACert(), tc.Equals, testing.CACert)
+}
+
+func (s *ConfigSuite) TestHookTimeoutConfig(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Check(cfg.HookTimeout(), tc.Equals, time.Duration(0))
+	c.Check(cfg.AutomaticallyRetryTimedOutHooks(), tc.IsFalse)
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"hook-timeout":                        "30m",
+		"automatically-retry-timed-out-hooks": true,
+	})
+	c.Check(cfg.HookTimeout(), tc.Equals, 30*time.Minute)
+	c.Check(cfg.AutomaticallyRetryTimedOutHooks(), tc.IsTrue)
+}
+
 func (s *ConfigSuite) TestTelemetryConfig(c *tc.C) {
 	cfg := newTestConfig(c, testing.Attrs{})
 	c.Assert(cfg.Telemetry(), tc.IsTrue)
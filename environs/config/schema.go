@@ -327,6 +327,33 @@ upgrades.
 		Type:        configschema.Tbool,
 		Group:       configschema.EnvironGroup,
 	},
+	LogForwardTargetsKey: {
+		Description: `A comma-separated list of destinations to which the log records of the model are forwarded`,
+		Documentation: "Each destination is a URL whose scheme selects the protocol:\n" +
+			" - `syslog://host[:port]` or `syslog+tcp://host[:port]` - RFC5424 syslog over TCP (default port 601)\n" +
+			" - `syslog+tls://host[:port]` - RFC5424 syslog over TLS (default port 6514)\n" +
+			" - `otlp+http://host[:port][/path]` or `otlp+https://...` - OTLP/HTTP logs (default path `/v1/logs`)\n" +
+			" - `loki+http://host[:port][/path]` or `loki+https://...` - Loki push API (default path `/loki/api/v1/push`)\n" +
+			"\n" +
+			"OTLP and Loki destinations may include `user:password@`, which is sent as basic\n" +
+			"authentication credentials.\n" +
+			"\n" +
+			"Records are forwarded by the controller that receives them. While a destination\n" +
+			"is unavailable, its records are buffered on the controller's disk and sent once\n" +
+			"it is reachable again. Set this on the controller model to forward the logs of\n" +
+			"the controller itself.\n" +
+			"\n" +
+			"Examples:\n" +
+			"\n" +
+			"\tjuju model-config log-forward-targets=\"syslog+tls://logs.example.com,loki+https://loki.example.com\"",
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
+	LogForwardCACertKey: {
+		Description: `A PEM encoded CA certificate used to verify TLS connections to log forwarding destinations, instead of the system certificates`,
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
 	"logging-config": {
 		Description: `The configuration string to use when configuring Juju agent logging (see [this link](https://pkg.go.dev/github.com/juju/loggo#ParseConfigString) for details)`,
 		Documentation: "The logging config can be set to a (list of semicolon-separated)\n" +
@@ -512,6 +539,28 @@ manually using:
 		Type:  configschema.Tbool,
 		Group: configschema.EnvironGroup,
 	},
+	HookTimeoutKey: {
+		Description: `How long a hook may run before the uniter kills it and reports it as timed out (0 disables the timeout)`,
+		Documentation: `
+The value is a duration, such as 30m or 1h. When a hook runs for longer than
+this, the uniter kills the hook process and all of its children, and sets the
+unit's status to "error" with a "hook timed out" message. This stops a hung
+hook from blocking every other operation on the unit.
+
+The timeout can be overridden for a single application with:
+
+	juju config <application> hook-timeout=<duration>
+
+Juju actions and juju exec commands are not affected by this setting.
+`,
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
+	AutomaticallyRetryTimedOutHooksKey: {
+		Description: `Determines whether the uniter should automatically retry hooks that timed out, even if automatically-retry-hooks is false`,
+		Type:        configschema.Tbool,
+		Group:       configschema.EnvironGroup,
+	},
 	TransmitVendorMetricsKey: {
 		Description: "Determines whether metrics declared by charms deployed into this model are sent for anonymized aggregate analytics",
 		Type:        configschema.Tbool,
@@ -537,6 +586,16 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	MaxSecretAccessLogAge: {
+		Description: "The maximum age for secret access log entries before they are pruned, in human-readable time format (0 disables pruning by age)",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	MaxSecretAccessLogEntries: {
+		Description: "The maximum number of secret access log entries kept by the model before the oldest are pruned (0 disables pruning by number)",
+		Type:        configschema.Tint,
+		Group:       configschema.EnvironGroup,
+	},
 	UpdateStatusHookInterval: {
 		Description: "How often to run the charm update-status hook, in human-readable time format (default 5m, range 1-60m)",
 		Type:        configschema.Tstring,
@@ -48,6 +48,17 @@ func (r *txnRunner) Dying() <-chan struct{} {
 	return nil
 }
 
+// importExcludedTables are the tables whose content is not loaded when a
+// model export is imported. Their rows are either written by triggers as the
+// rest of the export is loaded, or only describe the controller from which
+// the model was exported.
+var importExcludedTables = map[string]bool{
+	"change_log":             true,
+	"change_log_witness":     true,
+	"model_migrating":        true,
+	"object_store_placement": true,
+}
+
 func main() {
 	fmt.Printf("Juju version: %s\n", version.Current)
 
@@ -119,7 +130,20 @@ func generate(ctx context.Context, runner *txnRunner) error {
 		return err
 	}
 
-	if err := writeStateModelVersionFile(versionToken, semanticVersion, usedTableNames, structNames); err != nil {
+	var importTableNames, importStructNames []string
+	for i, tableName := range usedTableNames {
+		if importExcludedTables[tableName] {
+			continue
+		}
+		importTableNames = append(importTableNames, tableName)
+		importStructNames = append(importStructNames, structNames[i])
+	}
+
+	if err := writeStateModelVersionFile(
+		versionToken, semanticVersion,
+		usedTableNames, structNames,
+		importTableNames, importStructNames,
+	); err != nil {
 		return err
 	}
 
@@ -343,6 +367,8 @@ func writeStateModelVersionFile(
 	semanticVersion string,
 	tableNames []string,
 	structNames []string,
+	importTableNames []string,
+	importStructNames []string,
 ) error {
 	_, filename, _, _ := runtime.Caller(0)
 	currentDir := filepath.Dir(filename)
@@ -361,15 +387,19 @@ func writeStateModelVersionFile(
 	}
 
 	data := struct {
-		VersionToken    string
-		SemanticVersion string
-		TableNames      []string
-		StructNames     []string
+		VersionToken      string
+		SemanticVersion   string
+		TableNames        []string
+		StructNames       []string
+		ImportTableNames  []string
+		ImportStructNames []string
 	}{
-		VersionToken:    versionToken,
-		SemanticVersion: semanticVersion,
-		TableNames:      tableNames,
-		StructNames:     structNames,
+		VersionToken:      versionToken,
+		SemanticVersion:   semanticVersion,
+		TableNames:        tableNames,
+		StructNames:       structNames,
+		ImportTableNames:  importTableNames,
+		ImportStructNames: importStructNames,
 	}
 
 	t := template.Must(template.New("state").Parse(string(tmplBytes)))
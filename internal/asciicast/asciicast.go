@@ -0,0 +1,348 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package asciicast reads and writes terminal session recordings in the
+// asciicast v2 format. A recording is a newline delimited JSON stream: a
+// header object, followed by one event per line. Each event is an array of
+// the time elapsed since the start of the recording in seconds, the event
+// type and the event data.
+//
+// Recordings written by this package can be played back with any asciicast
+// v2 player, as well as with Play.
+package asciicast
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/juju/clock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// Version is the version of the asciicast format written by this
+	// package.
+	Version = 2
+
+	// maxLineSize is the maximum size of a single line of a recording.
+	maxLineSize = 16 * 1024 * 1024
+)
+
+// EventType is the type of a recorded event.
+type EventType string
+
+const (
+	// Output is data written to the terminal.
+	Output EventType = "o"
+	// Input is data read from the terminal.
+	Input EventType = "i"
+	// Resize is a change of the terminal size. The data of a resize event
+	// is formatted as "<width>x<height>".
+	Resize EventType = "r"
+)
+
+// Header describes a recording. It is the first line of every recording.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Event is a single recorded event.
+type Event struct {
+	// Time is the time elapsed since the start of the recording.
+	Time time.Duration
+	// Type is the type of the event.
+	Type EventType
+	// Data holds the event data.
+	Data string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{
+		json.Number(strconv.FormatFloat(e.Time.Seconds(), 'f', 6, 64)),
+		e.Type,
+		e.Data,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return errors.Capture(err)
+	}
+	if len(raw) != 3 {
+		return errors.Errorf("expected 3 event fields, got %d", len(raw)).Add(coreerrors.NotValid)
+	}
+	var seconds float64
+	if err := json.Unmarshal(raw[0], &seconds); err != nil {
+		return errors.Errorf("event time: %w", err)
+	}
+	if err := json.Unmarshal(raw[1], &e.Type); err != nil {
+		return errors.Errorf("event type: %w", err)
+	}
+	if err := json.Unmarshal(raw[2], &e.Data); err != nil {
+		return errors.Errorf("event data: %w", err)
+	}
+	e.Time = time.Duration(seconds * float64(time.Second))
+	return nil
+}
+
+// Writer writes the events of a recording. It is safe for concurrent use.
+type Writer struct {
+	clock   clock.Clock
+	started time.Time
+
+	mu      sync.Mutex
+	w       io.Writer
+	pending map[EventType][]byte
+	err     error
+}
+
+// NewWriter writes the header to w and returns a Writer for the events of
+// the recording. The version and timestamp of the header are set by the
+// writer.
+func NewWriter(w io.Writer, header Header, clock clock.Clock) (*Writer, error) {
+	started := clock.Now()
+	header.Version = Version
+	header.Timestamp = started.Unix()
+
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return nil, errors.Errorf("writing header: %w", err)
+	}
+	return &Writer{
+		clock:   clock,
+		started: started,
+		w:       w,
+		pending: make(map[EventType][]byte),
+	}, nil
+}
+
+// Output returns an io.Writer that records everything written to it as
+// output events.
+func (w *Writer) Output() io.Writer {
+	return streamWriter{w: w, eventType: Output}
+}
+
+// Input returns an io.Writer that records everything written to it as input
+// events.
+func (w *Writer) Input() io.Writer {
+	return streamWriter{w: w, eventType: Input}
+}
+
+// Resize records a change of the terminal size.
+func (w *Writer) Resize(width, height int) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.writeEvent(Resize, fmt.Sprintf("%dx%d", width, height))
+}
+
+// Elapsed returns the time elapsed since the start of the recording.
+func (w *Writer) Elapsed() time.Duration {
+	return w.clock.Now().Sub(w.started)
+}
+
+// Close flushes any incomplete UTF-8 sequences held back from earlier
+// writes. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, eventType := range []EventType{Output, Input} {
+		if pending := w.pending[eventType]; len(pending) > 0 {
+			delete(w.pending, eventType)
+			if err := w.writeEvent(eventType, string(pending)); err != nil {
+				return err
+			}
+		}
+	}
+	return w.err
+}
+
+func (w *Writer) write(eventType EventType, p []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Event data is a JSON string, so an UTF-8 sequence split across two
+	// writes is held back until the rest of it arrives, rather than being
+	// recorded as two invalid characters.
+	data := append(w.pending[eventType], p...)
+	cut := incompleteSuffix(data)
+	w.pending[eventType] = append([]byte(nil), data[len(data)-cut:]...)
+	data = data[:len(data)-cut]
+	if len(data) == 0 {
+		return w.err
+	}
+	return w.writeEvent(eventType, string(data))
+}
+
+func (w *Writer) writeEvent(eventType EventType, data string) error {
+	if w.err != nil {
+		return w.err
+	}
+	line, err := json.Marshal(Event{
+		Time: w.Elapsed(),
+		Type: eventType,
+		Data: data,
+	})
+	if err != nil {
+		w.err = errors.Capture(err)
+		return w.err
+	}
+	if _, err := w.w.Write(append(line, '\n')); err != nil {
+		w.err = errors.Errorf("writing event: %w", err)
+	}
+	return w.err
+}
+
+// incompleteSuffix returns the length of the trailing bytes of p that form
+// the start of an UTF-8 sequence that is not yet complete.
+func incompleteSuffix(p []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(p); i++ {
+		b := p[len(p)-i]
+		if utf8.RuneStart(b) {
+			if !utf8.FullRune(p[len(p)-i:]) {
+				return i
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+type streamWriter struct {
+	w         *Writer
+	eventType EventType
+}
+
+// Write implements io.Writer.
+func (s streamWriter) Write(p []byte) (int, error) {
+	if err := s.w.write(s.eventType, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Reader reads the events of a recording.
+type Reader struct {
+	header  Header
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewReader reads the header of the recording held by r and returns a
+// Reader for its events.
+func NewReader(r io.Reader) (*Reader, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, errors.Errorf("reading header: %w", err)
+		}
+		return nil, errors.Errorf("missing header").Add(coreerrors.NotValid)
+	}
+
+	var header Header
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, errors.Errorf("parsing header: %w", err).Add(coreerrors.NotValid)
+	}
+	if header.Version != Version {
+		return nil, errors.Errorf("asciicast version %d", header.Version).Add(coreerrors.NotSupported)
+	}
+	return &Reader{
+		header:  header,
+		scanner: scanner,
+		line:    1,
+	}, nil
+}
+
+// Header returns the header of the recording.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next event of the recording. It returns io.EOF once all
+// of the events have been read.
+func (r *Reader) Next() (Event, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return Event{}, errors.Errorf("parsing event on line %d: %w", r.line, err).Add(coreerrors.NotValid)
+		}
+		return event, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return Event{}, errors.Errorf("reading events: %w", err)
+	}
+	return Event{}, io.EOF
+}
+
+// PlayOptions controls the playback of a recording.
+type PlayOptions struct {
+	// Speed is the playback speed; 2 plays the recording twice as fast as
+	// it was recorded. A speed of zero plays the recording without any
+	// delay between events.
+	Speed float64
+
+	// MaxIdle caps the delay between two events, so that long pauses in
+	// the recording are skipped. Zero means no limit.
+	MaxIdle time.Duration
+}
+
+// Play writes the output events of the recording to w, waiting between the
+// events as they were recorded. Input and resize events are skipped.
+func Play(ctx context.Context, r *Reader, w io.Writer, clock clock.Clock, opts PlayOptions) error {
+	var last time.Duration
+	for {
+		event, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if event.Type != Output {
+			continue
+		}
+
+		delay := event.Time - last
+		last = event.Time
+		if opts.MaxIdle > 0 && delay > opts.MaxIdle {
+			delay = opts.MaxIdle
+		}
+		if opts.Speed > 0 && delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-clock.After(time.Duration(float64(delay) / opts.Speed)):
+			}
+		}
+
+		if _, err := io.WriteString(w, event.Data); err != nil {
+			return errors.Capture(err)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package asciicast
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coretesting "github.com/juju/juju/core/testing"
+)
+
+type asciicastSuite struct{}
+
+func TestAsciicastSuite(t *testing.T) {
+	tc.Run(t, &asciicastSuite{})
+}
+
+func (s *asciicastSuite) TestRoundTrip(c *tc.C) {
+	clock := testclock.NewClock(time.Unix(1700000000, 0))
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{
+		Width:  80,
+		Height: 24,
+		Title:  "admin@0.machine.model",
+	}, clock)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = io.WriteString(w.Output(), "$ ")
+	c.Assert(err, tc.ErrorIsNil)
+	clock.Advance(1500 * time.Millisecond)
+	_, err = io.WriteString(w.Input(), "ls\r")
+	c.Assert(err, tc.ErrorIsNil)
+	err = w.Resize(100, 40)
+	c.Assert(err, tc.ErrorIsNil)
+	clock.Advance(time.Second)
+	_, err = io.WriteString(w.Output(), "foo\r\n")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(w.Close(), tc.ErrorIsNil)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	c.Assert(lines, tc.HasLen, 5)
+	c.Check(lines[0], tc.Equals, `{"version":2,"width":80,"height":24,"timestamp":1700000000,"title":"admin@0.machine.model"}`)
+	c.Check(lines[1], tc.Equals, `[0.000000,"o","$ "]`)
+	c.Check(lines[2], tc.Equals, `[1.500000,"i","ls\r"]`)
+
+	r, err := NewReader(&buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(r.Header(), tc.DeepEquals, Header{
+		Version:   Version,
+		Width:     80,
+		Height:    24,
+		Timestamp: 1700000000,
+		Title:     "admin@0.machine.model",
+	})
+
+	var events []Event
+	for {
+		event, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		events = append(events, event)
+	}
+	c.Check(events, tc.DeepEquals, []Event{
+		{Time: 0, Type: Output, Data: "$ "},
+		{Time: 1500 * time.Millisecond, Type: Input, Data: "ls\r"},
+		{Time: 1500 * time.Millisecond, Type: Resize, Data: "100x40"},
+		{Time: 2500 * time.Millisecond, Type: Output, Data: "foo\r\n"},
+	})
+}
+
+func (s *asciicastSuite) TestSplitUTF8Sequence(c *tc.C) {
+	clock := testclock.NewClock(time.Now())
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{}, clock)
+	c.Assert(err, tc.ErrorIsNil)
+
+	euro := []byte("€")
+	_, err = w.Output().Write(append([]byte("a"), euro[:1]...))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = w.Output().Write(euro[1:])
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(w.Close(), tc.ErrorIsNil)
+
+	r, err := NewReader(&buf)
+	c.Assert(err, tc.ErrorIsNil)
+
+	event, err := r.Next()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(event.Data, tc.Equals, "a")
+	event, err = r.Next()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(event.Data, tc.Equals, "€")
+	_, err = r.Next()
+	c.Check(err, tc.Equals, io.EOF)
+}
+
+func (s *asciicastSuite) TestReaderInvalidHeader(c *tc.C) {
+	_, err := NewReader(strings.NewReader(""))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	_, err = NewReader(strings.NewReader("not json\n"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	_, err = NewReader(strings.NewReader(`{"version":1}` + "\n"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *asciicastSuite) TestReaderInvalidEvent(c *tc.C) {
+	r, err := NewReader(strings.NewReader(`{"version":2}` + "\n" + `[1.0,"o"]` + "\n"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = r.Next()
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `parsing event on line 2: .*`)
+}
+
+func (s *asciicastSuite) TestPlay(c *tc.C) {
+	recording := strings.Join([]string{
+		`{"version":2,"width":80,"height":24}`,
+		`[0.5,"o","a"]`,
+		`[1.0,"i","x"]`,
+		`[2.5,"o","b"]`,
+		`[600.0,"o","c"]`,
+	}, "\n")
+	r, err := NewReader(strings.NewReader(recording))
+	c.Assert(err, tc.ErrorIsNil)
+
+	clock := testclock.NewClock(time.Now())
+	out := &bytes.Buffer{}
+	done := make(chan error, 1)
+	go func() {
+		done <- Play(c.Context(), r, out, clock, PlayOptions{
+			Speed:   2,
+			MaxIdle: 10 * time.Second,
+		})
+	}()
+
+	// Delays are halved by the playback speed, and the long pause before
+	// the last event is capped by MaxIdle.
+	c.Assert(clock.WaitAdvance(250*time.Millisecond, coretesting.LongWait, 1), tc.ErrorIsNil)
+	c.Assert(clock.WaitAdvance(time.Second, coretesting.LongWait, 1), tc.ErrorIsNil)
+	c.Assert(clock.WaitAdvance(5*time.Second, coretesting.LongWait, 1), tc.ErrorIsNil)
+
+	select {
+	case err := <-done:
+		c.Assert(err, tc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for playback")
+	}
+	c.Check(out.String(), tc.Equals, "abc")
+}
+
+func (s *asciicastSuite) TestPlayNoDelay(c *tc.C) {
+	r, err := NewReader(strings.NewReader(`{"version":2}` + "\n" + `[5,"o","a"]` + "\n" + `[10,"o","b"]`))
+	c.Assert(err, tc.ErrorIsNil)
+
+	var out bytes.Buffer
+	err = Play(c.Context(), r, &out, testclock.NewClock(time.Now()), PlayOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(out.String(), tc.Equals, "ab")
+}
@@ -73,6 +73,14 @@ type EventMultiplexer struct {
 	subscriptionsCount uint64
 	dispatchErrorCount uint64
 
+	// termCount is the number of terms received from the stream. It's only
+	// accessed from the loop.
+	termCount uint64
+
+	// journal records the most recent events, and the subscriptions they
+	// matched, for introspection.
+	journal *journal
+
 	// subscriptionCh is a channel used to request new subscriptions.
 	// This is used to sync subscription additions into the loop.
 	subscriptionCh chan requestSubscription
@@ -104,6 +112,8 @@ func New(
 		subscriptionsCount: 0,
 		dispatchErrorCount: 0,
 
+		journal: newJournal(DefaultJournalSize),
+
 		subscriptionCh: make(chan requestSubscription),
 
 		reportsCh: make(chan reportRequest),
@@ -152,6 +162,13 @@ func (e *EventMultiplexer) Wait() error {
 	return e.catacomb.Wait()
 }
 
+// Events returns the most recent change events received by the event
+// queue that match the query, oldest first, along with the subscriptions
+// that each was dispatched to.
+func (e *EventMultiplexer) Events(query EventQuery) []RecordedEvent {
+	return e.journal.query(query)
+}
+
 // Report returns the current state of the event queue.
 // This is used by the engine report.
 func (e *EventMultiplexer) Report(ctx context.Context) map[string]any {
@@ -231,9 +248,13 @@ func (e *EventMultiplexer) loop() error {
 				return nil
 			}
 
+			e.termCount++
+			received := e.clock.Now()
+
 			changeSet := make(map[*subscription]ChangeSet)
 			for _, change := range term.Changes() {
 				subs := e.gatherSubscriptions(ctx, change)
+				e.journal.record(e.termCount, received, change, subs)
 				if len(subs) == 0 {
 					continue
 				}
@@ -249,7 +270,9 @@ func (e *EventMultiplexer) loop() error {
 				continue
 			}
 
-			begin := e.clock.Now()
+			// The time the term was received is the start of the dispatch.
+			begin := received
+
 			// Dispatch the set of changes, but do not cause the worker to
 			// exit. Just log out the error and then mark the term as done.
 			// There isn't anything we can do in this case.
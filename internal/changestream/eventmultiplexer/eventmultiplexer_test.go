@@ -213,6 +213,8 @@ func (s *eventMultiplexerSuite) TestTopicDoesNotMatch(c *tc.C) {
 
 	s.metrics.EXPECT().SubscriptionsInc()
 
+	s.clock.EXPECT().Now()
+
 	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c), 0)
 	c.Assert(err, tc.ErrorIsNil)
 	defer workertest.DirtyKill(c, queue)
@@ -291,6 +293,82 @@ func (s *eventMultiplexerSuite) TestTopicMatchesOne(c *tc.C) {
 	workertest.CleanKill(c, queue)
 }
 
+func (s *eventMultiplexerSuite) TestEventsRecordsMatchedSubscriptions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+
+	s.metrics.EXPECT().SubscriptionsInc().Times(3)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.clock.EXPECT().Now().Return(now).MinTimes(1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c), 0)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, queue)
+
+	_, err = queue.Subscribe("watch-foo", changestream.Namespace("foo", changestreamtesting.Create))
+	c.Assert(err, tc.ErrorIsNil)
+	sub1, err := queue.Subscribe("watch-topic", changestream.Namespace("topic", changestreamtesting.Create))
+	c.Assert(err, tc.ErrorIsNil)
+	sub2, err := queue.Subscribe("watch-topic-again", changestream.Namespace("topic", changestreamtesting.All))
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectTerm(c, changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "topic",
+		changed: "1",
+	}, changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "bar",
+		changed: "2",
+	})
+	done := s.dispatchTerm(c, terms)
+	for _, sub := range []changestream.Subscription{sub1, sub2} {
+		select {
+		case <-sub.Changes():
+		case <-time.After(testing.ShortWait):
+			c.Fatal("timed out waiting for event")
+		}
+	}
+	select {
+	case <-done:
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for term")
+	}
+
+	events := queue.Events(EventQuery{})
+	c.Assert(events, tc.DeepEquals, []RecordedEvent{{
+		ID:        1,
+		Term:      1,
+		Time:      now,
+		Type:      changestreamtesting.Create,
+		Namespace: "topic",
+		Changed:   "1",
+		Subscriptions: []MatchedSubscription{
+			{ID: 2, Summary: "watch-topic"},
+			{ID: 3, Summary: "watch-topic-again"},
+		},
+	}, {
+		ID:        2,
+		Term:      1,
+		Time:      now,
+		Type:      changestreamtesting.Create,
+		Namespace: "bar",
+		Changed:   "2",
+	}})
+
+	events = queue.Events(EventQuery{Namespace: "bar"})
+	c.Assert(events, tc.HasLen, 1)
+	c.Check(events[0].ID, tc.Equals, uint64(2))
+
+	workertest.CleanKill(c, queue)
+}
+
 func (s *eventMultiplexerSuite) TestSubscriptionDoneWhenEventQueueKilled(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -0,0 +1,209 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package eventmultiplexer
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/changestream"
+)
+
+// DefaultJournalSize is the number of change events retained by the
+// event multiplexer for introspection.
+const DefaultJournalSize = 512
+
+// RecordedEvent is a change event received by the event multiplexer, along
+// with the subscriptions that it was dispatched to.
+type RecordedEvent struct {
+	// ID is a sequence number for the event. It increases with every event
+	// received by the event multiplexer.
+	ID uint64 `json:"id"`
+
+	// Term is the sequence number of the term that held the event. Events
+	// of the same term are dispatched together.
+	Term uint64 `json:"term"`
+
+	// Time is the time at which the term was received.
+	Time time.Time `json:"time"`
+
+	Type      changestream.ChangeType `json:"type"`
+	Namespace string                  `json:"namespace"`
+	Changed   string                  `json:"changed"`
+
+	// Subscriptions holds the subscriptions that matched the event, in
+	// order of subscription. It's empty if no subscription matched.
+	Subscriptions []MatchedSubscription `json:"subscriptions,omitempty"`
+}
+
+// MatchedSubscription identifies a subscription that matched an event.
+type MatchedSubscription struct {
+	ID      uint64 `json:"id"`
+	Summary string `json:"summary"`
+}
+
+// ChangeEvent returns the recorded event as a change event, so that it can
+// be dispatched again.
+func (e RecordedEvent) ChangeEvent() changestream.ChangeEvent {
+	return recordedChange{
+		changeType: e.Type,
+		namespace:  e.Namespace,
+		changed:    e.Changed,
+	}
+}
+
+// EventQuery selects the recorded events to return.
+type EventQuery struct {
+	// Namespace, if set, limits the events to those of the namespace
+	// (table).
+	Namespace string
+
+	// After, if set, limits the events to those with a greater ID. It
+	// allows the events to be followed.
+	After uint64
+
+	// Since and Until, if set, limit the events to those received within
+	// the (inclusive) time range.
+	Since, Until time.Time
+
+	// Limit, if positive, limits the events to the most recent that match.
+	Limit int
+}
+
+// ReadRecordedEvents reads the events written as JSON, one per line, by
+// the introspection export of the change stream.
+func ReadRecordedEvents(r io.Reader) ([]RecordedEvent, error) {
+	var events []RecordedEvent
+	decoder := json.NewDecoder(r)
+	for {
+		var event RecordedEvent
+		if err := decoder.Decode(&event); errors.Is(err, io.EOF) {
+			return events, nil
+		} else if err != nil {
+			return nil, errors.Annotatef(err, "reading event %d", len(events)+1)
+		}
+		events = append(events, event)
+	}
+}
+
+// journal is a bounded, in-memory record of the most recent events
+// received by the event multiplexer. It's written by the multiplexer loop
+// and read by introspection, so it's guarded by its own lock rather than
+// being serialized through the loop.
+type journal struct {
+	mu     sync.Mutex
+	size   int
+	events []RecordedEvent
+	next   int
+	lastID uint64
+}
+
+func newJournal(size int) *journal {
+	return &journal{
+		size: size,
+	}
+}
+
+// record adds the event to the journal, replacing the oldest event once the
+// journal is full. The events slice only grows as events are recorded, so
+// that an idle namespace costs nothing.
+func (j *journal) record(term uint64, now time.Time, change changestream.ChangeEvent, subs []*subscription) {
+	var matched []MatchedSubscription
+	if len(subs) > 0 {
+		matched = make([]MatchedSubscription, len(subs))
+		for i, sub := range subs {
+			matched[i] = MatchedSubscription{
+				ID:      sub.id,
+				Summary: sub.summary,
+			}
+		}
+		slices.SortFunc(matched, func(a, b MatchedSubscription) int {
+			switch {
+			case a.ID < b.ID:
+				return -1
+			case a.ID > b.ID:
+				return 1
+			}
+			return 0
+		})
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.lastID++
+	event := RecordedEvent{
+		ID:            j.lastID,
+		Term:          term,
+		Time:          now,
+		Type:          change.Type(),
+		Namespace:     change.Namespace(),
+		Changed:       change.Changed(),
+		Subscriptions: matched,
+	}
+	if len(j.events) < j.size {
+		j.events = append(j.events, event)
+		return
+	}
+	j.events[j.next] = event
+	j.next = (j.next + 1) % j.size
+}
+
+// query returns the recorded events matching the query, oldest first.
+func (j *journal) query(q EventQuery) []RecordedEvent {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var results []RecordedEvent
+	for i := range j.events {
+		event := j.events[(j.next+i)%len(j.events)]
+		if event.ID <= q.After {
+			continue
+		}
+		if q.Namespace != "" && event.Namespace != q.Namespace {
+			continue
+		}
+		if !q.Since.IsZero() && event.Time.Before(q.Since) {
+			continue
+		}
+		if !q.Until.IsZero() && event.Time.After(q.Until) {
+			continue
+		}
+		results = append(results, event)
+	}
+	if q.Limit > 0 && len(results) > q.Limit {
+		results = results[len(results)-q.Limit:]
+	}
+	return results
+}
+
+// recordedChange is a change event recreated from a recorded event.
+type recordedChange struct {
+	changeType changestream.ChangeType
+	namespace  string
+	changed    string
+}
+
+// Type returns the type of change (create, update, delete).
+func (c recordedChange) Type() changestream.ChangeType {
+	return c.changeType
+}
+
+// Namespace returns the namespace of the change. This is normally the
+// table name.
+func (c recordedChange) Namespace() string {
+	return c.namespace
+}
+
+// Changed returns the changed value of event. This logically can be
+// the primary key of the row that was changed or the field of the change
+// that was changed.
+func (c recordedChange) Changed() string {
+	return c.changed
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package eventmultiplexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	changestreamtesting "github.com/juju/juju/core/changestream/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type journalSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestJournalSuite(t *stdtesting.T) {
+	tc.Run(t, &journalSuite{})
+}
+
+func (s *journalSuite) TestRecordWrapsAround(c *tc.C) {
+	j := newJournal(3)
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 5 {
+		j.record(uint64(i+1), base.Add(time.Duration(i)*time.Minute), changeEvent{
+			ctype:   changestreamtesting.Update,
+			ns:      "topic",
+			changed: fmt.Sprint(i),
+		}, nil)
+	}
+
+	events := j.query(EventQuery{})
+	c.Assert(events, tc.HasLen, 3)
+	c.Check(events[0].ID, tc.Equals, uint64(3))
+	c.Check(events[1].ID, tc.Equals, uint64(4))
+	c.Check(events[2].ID, tc.Equals, uint64(5))
+	c.Check(events[2].Changed, tc.Equals, "4")
+}
+
+func (s *journalSuite) TestQuery(c *tc.C) {
+	j := newJournal(DefaultJournalSize)
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	subs := []*subscription{
+		{id: 7, summary: "later"},
+		{id: 3, summary: "earlier"},
+	}
+	for i := range 10 {
+		ns := "foo"
+		if i%2 == 1 {
+			ns = "bar"
+		}
+		j.record(uint64(i/2+1), base.Add(time.Duration(i)*time.Minute), changeEvent{
+			ctype:   changestreamtesting.Create,
+			ns:      ns,
+			changed: fmt.Sprint(i),
+		}, subs)
+	}
+
+	events := j.query(EventQuery{Namespace: "bar", Limit: 2})
+	c.Assert(events, tc.HasLen, 2)
+	c.Check(events[0].Changed, tc.Equals, "7")
+	c.Check(events[1].Changed, tc.Equals, "9")
+	c.Check(events[1].Term, tc.Equals, uint64(5))
+	c.Check(events[1].Subscriptions, tc.DeepEquals, []MatchedSubscription{
+		{ID: 3, Summary: "earlier"},
+		{ID: 7, Summary: "later"},
+	})
+
+	events = j.query(EventQuery{After: 8})
+	c.Assert(events, tc.HasLen, 2)
+	c.Check(events[0].ID, tc.Equals, uint64(9))
+
+	events = j.query(EventQuery{
+		Since: base.Add(2 * time.Minute),
+		Until: base.Add(4 * time.Minute),
+	})
+	c.Assert(events, tc.HasLen, 3)
+	c.Check(events[0].Changed, tc.Equals, "2")
+	c.Check(events[2].Changed, tc.Equals, "4")
+}
+
+func (s *journalSuite) TestReadRecordedEvents(c *tc.C) {
+	j := newJournal(DefaultJournalSize)
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	j.record(1, now, changeEvent{ctype: changestreamtesting.Create, ns: "foo", changed: "1"}, nil)
+	j.record(1, now, changeEvent{ctype: changestreamtesting.Delete, ns: "bar", changed: "2"}, nil)
+	expected := j.query(EventQuery{})
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range expected {
+		c.Assert(encoder.Encode(event), tc.ErrorIsNil)
+	}
+
+	events, err := ReadRecordedEvents(&buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(events, tc.DeepEquals, expected)
+
+	change := events[1].ChangeEvent()
+	c.Check(change.Type(), tc.Equals, changestreamtesting.Delete)
+	c.Check(change.Namespace(), tc.Equals, "bar")
+	c.Check(change.Changed(), tc.Equals, "2")
+}
+
+func (s *journalSuite) TestReadRecordedEventsInvalid(c *tc.C) {
+	_, err := ReadRecordedEvents(bytes.NewBufferString(`{"id":1}` + "\n" + `{"id":`))
+	c.Assert(err, tc.ErrorMatches, `reading event 2: .*`)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"context"
+	"os"
+
+	"github.com/juju/tc"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/internal/changestream/eventmultiplexer"
+)
+
+// ReplayStream is a stream for the event multiplexer that replays change
+// events exported from the change stream of an agent, so that watcher bugs
+// can be reproduced in tests. The events are grouped into the terms in
+// which they were originally received.
+type ReplayStream struct {
+	tomb  tomb.Tomb
+	terms chan changestream.Term
+}
+
+// NewReplayStream returns a ReplayStream. No events are replayed until
+// Replay is called, giving the test time to subscribe.
+func NewReplayStream() *ReplayStream {
+	s := &ReplayStream{
+		terms: make(chan changestream.Term),
+	}
+	s.tomb.Go(func() error {
+		<-s.tomb.Dying()
+		return tomb.ErrDying
+	})
+	return s
+}
+
+// ReadReplayEvents reads the events exported by the change stream
+// introspection endpoint (format=json) from the file at the given path.
+func ReadReplayEvents(c *tc.C, path string) []eventmultiplexer.RecordedEvent {
+	f, err := os.Open(path)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() {
+		_ = f.Close()
+	}()
+
+	events, err := eventmultiplexer.ReadRecordedEvents(f)
+	c.Assert(err, tc.ErrorIsNil)
+	return events
+}
+
+// Replay sends the events to the event multiplexer, a term at a time,
+// waiting for each term to be done before sending the next.
+func (s *ReplayStream) Replay(ctx context.Context, events []eventmultiplexer.RecordedEvent) error {
+	for len(events) > 0 {
+		term := &replayTerm{
+			done: make(chan bool, 1),
+		}
+		n := 0
+		for ; n < len(events) && events[n].Term == events[0].Term; n++ {
+			term.changes = append(term.changes, events[n].ChangeEvent())
+		}
+		events = events[n:]
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+		case s.terms <- term:
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+		case <-term.done:
+		}
+	}
+	return nil
+}
+
+// Terms returns a channel from which the replayed terms are received.
+func (s *ReplayStream) Terms() <-chan changestream.Term {
+	return s.terms
+}
+
+// Dying returns a channel that is closed when the stream is dying.
+func (s *ReplayStream) Dying() <-chan struct{} {
+	return s.tomb.Dying()
+}
+
+// Kill stops the stream.
+func (s *ReplayStream) Kill() {
+	s.tomb.Kill(nil)
+}
+
+// Wait waits for the stream to stop.
+func (s *ReplayStream) Wait() error {
+	return s.tomb.Wait()
+}
+
+// replayTerm is a term of replayed change events.
+type replayTerm struct {
+	changes []changestream.ChangeEvent
+	done    chan bool
+}
+
+// Changes returns the changes that are part of the term.
+func (t *replayTerm) Changes() []changestream.ChangeEvent {
+	return t.changes
+}
+
+// Done signals that the term has been completed.
+func (t *replayTerm) Done(empty bool, abort <-chan struct{}) {
+	select {
+	case t.done <- empty:
+	case <-abort:
+	}
+}
@@ -27,6 +27,21 @@ func (c *Client) Leader(ctx context.Context) (*dqlite.NodeInfo, error) {
 	return nil, nil
 }
 
+// Assign returns an error, as dqlite is not available.
+func (c *Client) Assign(context.Context, uint64, dqlite.NodeRole) error {
+	return errors.Errorf("dqlite is not available")
+}
+
+// Remove returns an error, as dqlite is not available.
+func (c *Client) Remove(context.Context, uint64) error {
+	return errors.Errorf("dqlite is not available")
+}
+
+// Close returns no error, as dqlite is not available.
+func (c *Client) Close() error {
+	return nil
+}
+
 // FindLeader returns no leader and no error, as dqlite is not available.
 func FindLeader(ctx context.Context, store NodeStore, opts ...Option) (*Client, error) {
 	return nil, nil
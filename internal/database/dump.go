@@ -0,0 +1,301 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// sequenceTable is the table in which SQLite records the last value used for
+// each AUTOINCREMENT column. It is created by SQLite itself, so only its
+// content is dumped.
+const sequenceTable = "sqlite_sequence"
+
+// dumpStatement is a single SQL statement in a database dump.
+type dumpStatement struct {
+	SQL string `json:"sql"`
+}
+
+// schemaObject is a table, index, view or trigger in a database schema.
+type schemaObject struct {
+	kind string
+	name string
+	sql  string
+}
+
+// DumpDB writes the schema and content of the database read through the input
+// transaction to w, as SQL statements that LoadDB replays to recreate it.
+// Each statement is written as a single line of JSON, so that statements
+// spanning several lines are read back unambiguously.
+//
+// Tables are created and filled before any indexes, views and triggers are
+// created, so that triggers do not fire as the content is loaded.
+func DumpDB(ctx context.Context, tx *sql.Tx, w io.Writer) error {
+	objects, err := schemaObjects(ctx, tx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	enc := json.NewEncoder(w)
+	write := func(stmt string) error {
+		return errors.Trace(enc.Encode(dumpStatement{SQL: stmt}))
+	}
+
+	var tables []string
+	for _, obj := range objects {
+		if obj.kind != "table" {
+			continue
+		}
+		if err := write(obj.sql); err != nil {
+			return errors.Trace(err)
+		}
+		tables = append(tables, obj.name)
+	}
+
+	for _, table := range tables {
+		if err := dumpTableContent(ctx, tx, table, write); err != nil {
+			return errors.Annotatef(err, "dumping content of table %q", table)
+		}
+	}
+
+	// Loading rows into a table with an AUTOINCREMENT column advances its
+	// sequence, so the sequences are replaced once the rows are loaded.
+	hasSequence, err := tableExists(ctx, tx, sequenceTable)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if hasSequence {
+		if err := write("DELETE FROM " + sequenceTable); err != nil {
+			return errors.Trace(err)
+		}
+		if err := dumpTableContent(ctx, tx, sequenceTable, write); err != nil {
+			return errors.Annotatef(err, "dumping content of table %q", sequenceTable)
+		}
+	}
+
+	for _, obj := range objects {
+		if obj.kind == "table" {
+			continue
+		}
+		if err := write(obj.sql); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// LoadDB replaces the schema and content of the input database with those
+// read from a dump written by DumpDB. Foreign keys are not enforced while the
+// dump is loaded, but are checked before the load is committed.
+func LoadDB(ctx context.Context, db *sql.DB, r io.Reader) error {
+	return errors.Trace(replayDump(ctx, db, r, true))
+}
+
+// CheckDump replays the dump read from r into the input database, as LoadDB
+// does, but rolls the load back rather than committing it. It reports
+// whether the dump can be loaded without changing the database.
+func CheckDump(ctx context.Context, db *sql.DB, r io.Reader) error {
+	return errors.Trace(replayDump(ctx, db, r, false))
+}
+
+func replayDump(ctx context.Context, db *sql.DB, r io.Reader, commit bool) error {
+	// The foreign keys pragma is set per connection, and cannot be changed
+	// within a transaction.
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return errors.Annotate(err, "disabling foreign keys")
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "PRAGMA foreign_keys = ON")
+	}()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := loadDump(ctx, tx, r); err != nil || !commit {
+		_ = tx.Rollback()
+		return errors.Trace(err)
+	}
+	return errors.Trace(tx.Commit())
+}
+
+func loadDump(ctx context.Context, tx *sql.Tx, r io.Reader) error {
+	if err := dropSchemaObjects(ctx, tx); err != nil {
+		return errors.Annotate(err, "removing existing schema")
+	}
+
+	dec := json.NewDecoder(r)
+	for {
+		var stmt dumpStatement
+		if err := dec.Decode(&stmt); err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Annotate(err, "reading database dump")
+		}
+		if _, err := tx.ExecContext(ctx, stmt.SQL); err != nil {
+			return errors.Annotatef(err, "running %q", stmt.SQL)
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return errors.Annotate(err, "checking foreign keys")
+	}
+	defer func() { _ = rows.Close() }()
+	if rows.Next() {
+		var (
+			table, parent string
+			rowID         sql.NullInt64
+			fkID          int
+		)
+		if err := rows.Scan(&table, &rowID, &parent, &fkID); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Errorf("row %d of table %q references a missing row of table %q", rowID.Int64, table, parent)
+	}
+	return errors.Trace(rows.Err())
+}
+
+// dropSchemaObjects removes every table, view and trigger from the database,
+// along with the indexes of the tables.
+func dropSchemaObjects(ctx context.Context, tx *sql.Tx) error {
+	objects, err := schemaObjects(ctx, tx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, kind := range []string{"trigger", "view", "table"} {
+		for _, obj := range objects {
+			if obj.kind != kind {
+				continue
+			}
+			stmt := fmt.Sprintf("DROP %s IF EXISTS %s", strings.ToUpper(kind), quoteIdentifier(obj.name))
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return errors.Annotatef(err, "dropping %s %q", kind, obj.name)
+			}
+		}
+	}
+
+	hasSequence, err := tableExists(ctx, tx, sequenceTable)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if hasSequence {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+sequenceTable); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// schemaObjects returns the schema objects defined in the database, in the
+// order in which they were created. Objects created by SQLite itself, such
+// as the indexes backing unique constraints, are not included.
+func schemaObjects(ctx context.Context, tx *sql.Tx) ([]schemaObject, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT type, name, sql
+FROM   sqlite_master
+WHERE  sql IS NOT NULL
+AND    name NOT LIKE 'sqlite_%'
+ORDER BY rowid`)
+	if err != nil {
+		return nil, errors.Annotate(err, "querying schema")
+	}
+	defer func() { _ = rows.Close() }()
+
+	var objects []schemaObject
+	for rows.Next() {
+		var obj schemaObject
+		if err := rows.Scan(&obj.kind, &obj.name, &obj.sql); err != nil {
+			return nil, errors.Trace(err)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, errors.Trace(rows.Err())
+}
+
+// dumpTableContent writes an INSERT statement for each row of the input
+// table. The values are rendered as SQL literals by SQLite's quote function,
+// which preserves their storage class.
+func dumpTableContent(ctx context.Context, tx *sql.Tx, table string, write func(string) error) error {
+	columns, err := tableColumns(ctx, tx, table)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, len(columns))
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = quoteIdentifier(column)
+		values[i] = fmt.Sprintf("quote(%s)", quoteIdentifier(column))
+	}
+	prefix := fmt.Sprintf("INSERT INTO %s (%s) VALUES (", quoteIdentifier(table), strings.Join(quoted, ", "))
+	query := fmt.Sprintf("SELECT %s || %s || ')' FROM %s",
+		quoteLiteral(prefix), strings.Join(values, " || ', ' || "), quoteIdentifier(table))
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var stmt string
+		if err := rows.Scan(&stmt); err != nil {
+			return errors.Trace(err)
+		}
+		if err := write(stmt); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(rows.Err())
+}
+
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, errors.Trace(err)
+		}
+		columns = append(columns, column)
+	}
+	return columns, errors.Trace(rows.Err())
+}
+
+func tableExists(ctx context.Context, tx *sql.Tx, table string) (bool, error) {
+	var count int
+	err := tx.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count)
+	return count > 0, errors.Trace(err)
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteLiteral(value string) string {
+	return `'` + strings.ReplaceAll(value, `'`, `''`) + `'`
+}
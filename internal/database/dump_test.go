@@ -0,0 +1,179 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/database/testing"
+)
+
+type dumpSuite struct {
+	testing.DqliteSuite
+}
+
+func TestDumpSuite(t *stdtesting.T) {
+	tc.Run(t, &dumpSuite{})
+}
+
+const dumpSchema = `
+CREATE TABLE band (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    rating REAL,
+    logo BLOB
+);
+CREATE TABLE album (
+    name TEXT NOT NULL PRIMARY KEY,
+    band_id INT NOT NULL,
+    CONSTRAINT fk_album_band FOREIGN KEY (band_id) REFERENCES band (id)
+);
+CREATE TABLE change_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    album TEXT NOT NULL
+);
+CREATE INDEX idx_album_band ON album (band_id);
+CREATE VIEW v_album AS
+SELECT a.name, b.name AS band FROM album AS a JOIN band AS b ON a.band_id = b.id;
+CREATE TRIGGER trg_album_insert AFTER INSERT ON album
+BEGIN
+    INSERT INTO change_log (album) VALUES (NEW.name);
+END;
+`
+
+func (s *dumpSuite) populate(c *tc.C, db *sql.DB) {
+	_, err := db.ExecContext(c.Context(), dumpSchema)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = db.ExecContext(c.Context(), `
+INSERT INTO band (name, rating, logo) VALUES ('Blood Incantation', 4.5, X'00ff10');
+INSERT INTO band (name, rating, logo) VALUES ('Band''s "Name"
+on two lines', NULL, NULL);
+INSERT INTO album (name, band_id) VALUES ('Hidden History', 1);
+INSERT INTO album (name, band_id) VALUES ('Starspawn', 1);`)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *dumpSuite) dump(c *tc.C, db *sql.DB) []byte {
+	var buf bytes.Buffer
+	err := StdTxn(c.Context(), db, func(ctx context.Context, tx *sql.Tx) error {
+		return DumpDB(ctx, tx, &buf)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return buf.Bytes()
+}
+
+func (s *dumpSuite) TestDumpAndLoad(c *tc.C) {
+	_, source := s.OpenDB(c)
+	s.populate(c, source)
+	dump := s.dump(c, source)
+
+	// The target has a schema of its own, which is replaced.
+	_, target := s.OpenDB(c)
+	_, err := target.ExecContext(c.Context(), `
+CREATE TABLE band (id INT PRIMARY KEY, other TEXT);
+CREATE TABLE stale (id INT PRIMARY KEY);
+INSERT INTO band VALUES (7, 'gone');`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = LoadDB(c.Context(), target, bytes.NewReader(dump))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The loaded database dumps identically to the source.
+	c.Check(string(s.dump(c, target)), tc.Equals, string(dump))
+
+	var (
+		name   string
+		rating sql.NullFloat64
+		logo   []byte
+	)
+	err = target.QueryRowContext(c.Context(), "SELECT name, rating, logo FROM band WHERE id = 1").Scan(&name, &rating, &logo)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(name, tc.Equals, "Blood Incantation")
+	c.Check(rating.Float64, tc.Equals, 4.5)
+	c.Check(logo, tc.DeepEquals, []byte{0x00, 0xff, 0x10})
+
+	err = target.QueryRowContext(c.Context(), "SELECT name, rating FROM band WHERE id = 2").Scan(&name, &rating)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(name, tc.Equals, "Band's \"Name\"\non two lines")
+	c.Check(rating.Valid, tc.IsFalse)
+
+	var count int
+	err = target.QueryRowContext(c.Context(), "SELECT COUNT(*) FROM sqlite_master WHERE name = 'stale'").Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+
+	// The trigger did not fire as the content was loaded, but does now.
+	err = target.QueryRowContext(c.Context(), "SELECT COUNT(*) FROM change_log").Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 2)
+	_, err = target.ExecContext(c.Context(), "INSERT INTO album (name, band_id) VALUES ('Timewave Zero', 1)")
+	c.Assert(err, tc.ErrorIsNil)
+	var id int
+	err = target.QueryRowContext(c.Context(), "SELECT id FROM change_log WHERE album = 'Timewave Zero'").Scan(&id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, 3)
+
+	// Autoincrement sequences continue from where the source left off.
+	_, err = target.ExecContext(c.Context(), "INSERT INTO band (name) VALUES ('Tomb Mold')")
+	c.Assert(err, tc.ErrorIsNil)
+	err = target.QueryRowContext(c.Context(), "SELECT id FROM band WHERE name = 'Tomb Mold'").Scan(&id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, 3)
+
+	// Foreign keys are enforced once the load is complete.
+	_, err = target.ExecContext(c.Context(), "INSERT INTO album (name, band_id) VALUES ('Orphan', 99)")
+	c.Check(err, tc.ErrorMatches, ".*FOREIGN KEY constraint failed.*")
+}
+
+func (s *dumpSuite) TestLoadForeignKeyViolation(c *tc.C) {
+	_, source := s.OpenDB(c)
+	s.populate(c, source)
+	dump := s.dump(c, source)
+	dump = append(dump, []byte(`{"sql": "INSERT INTO album (name, band_id) VALUES ('Orphan', 99)"}`+"\n")...)
+
+	_, target := s.OpenDB(c)
+	_, err := target.ExecContext(c.Context(), "CREATE TABLE existing (id INT PRIMARY KEY)")
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = LoadDB(c.Context(), target, bytes.NewReader(dump))
+	c.Assert(err, tc.ErrorMatches, `row .* of table "album" references a missing row of table "band"`)
+
+	// Nothing is changed by a failed load.
+	var count int
+	err = target.QueryRowContext(c.Context(), "SELECT COUNT(*) FROM sqlite_master WHERE name = 'existing'").Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 1)
+}
+
+func (s *dumpSuite) TestCheckDump(c *tc.C) {
+	_, source := s.OpenDB(c)
+	s.populate(c, source)
+	dump := s.dump(c, source)
+
+	_, target := s.OpenDB(c)
+	_, err := target.ExecContext(c.Context(), "CREATE TABLE existing (id INT PRIMARY KEY)")
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = CheckDump(c.Context(), target, bytes.NewReader(dump))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A dump which can't be loaded is reported.
+	bad := append(dump, []byte(`{"sql": "INSERT INTO missing VALUES (1)"}`+"\n")...)
+	err = CheckDump(c.Context(), target, bytes.NewReader(bad))
+	c.Assert(err, tc.ErrorMatches, `running "INSERT INTO missing VALUES \(1\)": .*no such table: missing`)
+
+	// The database is left as it was.
+	var count int
+	err = target.QueryRowContext(c.Context(), "SELECT COUNT(*) FROM sqlite_master WHERE name = 'band'").Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+	err = target.QueryRowContext(c.Context(), "SELECT COUNT(*) FROM sqlite_master WHERE name = 'existing'").Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 1)
+}
@@ -3,7 +3,10 @@
 
 package txn
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // contextKey is a type used for context keys in this package.
 type contextKey string
@@ -15,6 +18,10 @@ const (
 	// txnIDKey is the context key used to store the transaction id on the
 	// context.
 	txnIDKey contextKey = "txnID"
+
+	// latencyObserverKey is the context key used to store the latency
+	// observer.
+	latencyObserverKey contextKey = "latencyObserver"
 )
 
 // MetricErrorType is the type of error that should be recorded.
@@ -54,3 +61,29 @@ type noopsMetrics struct{}
 func (noopsMetrics) RecordSuccess() {}
 
 func (noopsMetrics) RecordError(MetricErrorType) {}
+
+// LatencyObserver is notified of the time taken to run each transaction
+// attempt.
+type LatencyObserver interface {
+	// ObserveTxnLatency records the time taken to run a transaction attempt.
+	ObserveTxnLatency(time.Duration)
+}
+
+// WithLatencyObserver returns a new context with the given latency observer.
+func WithLatencyObserver(ctx context.Context, observer LatencyObserver) context.Context {
+	return context.WithValue(ctx, latencyObserverKey, observer)
+}
+
+// LatencyObserverFromContext returns the latency observer from the given
+// context. If no observer is found, then a noop observer is returned.
+func LatencyObserverFromContext(ctx context.Context) LatencyObserver {
+	observer, _ := ctx.Value(latencyObserverKey).(LatencyObserver)
+	if observer == nil {
+		return noopLatencyObserver{}
+	}
+	return observer
+}
+
+type noopLatencyObserver struct{}
+
+func (noopLatencyObserver) ObserveTxnLatency(time.Duration) {}
@@ -71,10 +71,18 @@ func WithRetryStrategy(retryStrategy RetryStrategy) Option {
 	}
 }
 
+// WithClock defines the clock used to measure the latency of transactions.
+func WithClock(clock clock.Clock) Option {
+	return func(o *option) {
+		o.clock = clock
+	}
+}
+
 type option struct {
 	timeout       time.Duration
 	logger        logger.Logger
 	retryStrategy RetryStrategy
+	clock         clock.Clock
 }
 
 func newOptions() *option {
@@ -83,6 +91,7 @@ func newOptions() *option {
 		timeout:       DefaultTimeout,
 		logger:        logger,
 		retryStrategy: DefaultRetryStrategy(clock.WallClock, logger),
+		clock:         clock.WallClock,
 	}
 }
 
@@ -91,6 +100,7 @@ func newOptions() *option {
 // should take longer than the default timeout.
 // Transient errors are retried based on the defined retry strategy.
 type RetryingTxnRunner struct {
+	clock         clock.Clock
 	timeout       time.Duration
 	logger        logger.Logger
 	retryStrategy RetryStrategy
@@ -115,6 +125,7 @@ func NewRetryingTxnRunner(opts ...Option) *RetryingTxnRunner {
 	}
 
 	return &RetryingTxnRunner{
+		clock:         o.clock,
 		timeout:       o.timeout,
 		logger:        o.logger,
 		retryStrategy: o.retryStrategy,
@@ -309,7 +320,9 @@ func (t *RetryingTxnRunner) run(ctx context.Context, fn func(context.Context) er
 		queryable = ltrace
 	}
 
+	begin := t.clock.Now()
 	err = fn(ctx)
+	LatencyObserverFromContext(ctx).ObserveTxnLatency(t.clock.Now().Sub(begin))
 	if err == nil {
 		return nil
 	}
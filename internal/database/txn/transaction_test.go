@@ -12,6 +12,7 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/mattn/go-sqlite3"
@@ -48,6 +49,29 @@ func (s *transactionRunnerSuite) TestTxn(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+type latencyRecorder struct {
+	latencies []time.Duration
+}
+
+func (r *latencyRecorder) ObserveTxnLatency(latency time.Duration) {
+	r.latencies = append(r.latencies, latency)
+}
+
+func (s *transactionRunnerSuite) TestTxnObservesLatency(c *tc.C) {
+	clock := testclock.NewClock(time.Now())
+	runner := txn.NewRetryingTxnRunner(txn.WithClock(clock))
+
+	observer := &latencyRecorder{}
+	ctx := txn.WithLatencyObserver(c.Context(), observer)
+
+	err := runner.StdTxn(ctx, s.DB(), func(ctx context.Context, tx *sql.Tx) error {
+		clock.Advance(3 * time.Second)
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(observer.latencies, tc.DeepEquals, []time.Duration{3 * time.Second})
+}
+
 type logRecorder struct {
 	logger.Logger
 
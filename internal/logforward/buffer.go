@@ -0,0 +1,203 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// diskBuffer is a file of JSON encoded records, one per line, which holds
+// records while their destination is unavailable. Records are appended to
+// the end of the file and read back from the front in order. Once every
+// record has been read back the file is truncated.
+//
+// The read position is not persisted, so records which were read back but
+// not yet truncated when the agent stopped are forwarded again when it
+// restarts.
+type diskBuffer struct {
+	file    *os.File
+	maxSize int64
+
+	// offset is the position of the first unread record, size is the
+	// position of the end of the file.
+	offset int64
+	size   int64
+
+	// count is the number of unread records.
+	count int
+}
+
+// openDiskBuffer opens the buffer at path, creating it if needed. Records
+// left in an existing buffer are kept so that they can be forwarded. The
+// buffer never grows beyond maxSize bytes.
+func openDiskBuffer(path string, maxSize int64) (*diskBuffer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Capture(err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// Count the complete records in the file. A trailing partial record,
+	// left by an interrupted write, is discarded.
+	var (
+		count int
+		size  int64
+	)
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			_ = file.Close()
+			return nil, errors.Capture(err)
+		}
+		count++
+		size += int64(len(line))
+	}
+	if err := file.Truncate(size); err != nil {
+		_ = file.Close()
+		return nil, errors.Capture(err)
+	}
+
+	return &diskBuffer{
+		file:    file,
+		maxSize: maxSize,
+		size:    size,
+		count:   count,
+	}, nil
+}
+
+// Len returns the number of unread records in the buffer.
+func (b *diskBuffer) Len() int {
+	return b.count
+}
+
+// Size returns the number of bytes used by the buffer on disk.
+func (b *diskBuffer) Size() int64 {
+	return b.size
+}
+
+// Append writes the records to the end of the buffer. Records which don't
+// fit within the maximum size of the buffer are dropped, and the number of
+// dropped records is returned.
+func (b *diskBuffer) Append(records []logger.LogRecord) (int, error) {
+	// Reclaim the space of read records once they take up half the buffer,
+	// so a buffer which is never completely drained doesn't fill up.
+	if b.offset > 0 && b.offset >= b.maxSize/2 {
+		if err := b.compact(); err != nil {
+			return 0, errors.Capture(err)
+		}
+	}
+
+	var (
+		buf     bytes.Buffer
+		written int
+	)
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return 0, errors.Capture(err)
+		}
+		if b.size+int64(buf.Len()+len(data)+1) > b.maxSize {
+			break
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+		written++
+	}
+	if written == 0 {
+		return len(records), nil
+	}
+
+	if _, err := b.file.WriteAt(buf.Bytes(), b.size); err != nil {
+		return 0, errors.Capture(err)
+	}
+	b.size += int64(buf.Len())
+	b.count += written
+	return len(records) - written, nil
+}
+
+// Peek returns up to n records from the front of the buffer, along with
+// the number of lines and bytes they occupy, to be passed to Discard once
+// they have been dealt with. Lines which can't be decoded are skipped.
+func (b *diskBuffer) Peek(n int) ([]logger.LogRecord, int, int64, error) {
+	var (
+		records []logger.LogRecord
+		lines   int
+		read    int64
+	)
+	reader := bufio.NewReader(io.NewSectionReader(b.file, b.offset, b.size-b.offset))
+	for lines < n {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, 0, 0, errors.Capture(err)
+		}
+		lines++
+		read += int64(len(line))
+
+		var record logger.LogRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, lines, read, nil
+}
+
+// Discard removes lines occupying the given number of bytes from the front
+// of the buffer.
+func (b *diskBuffer) Discard(lines int, read int64) error {
+	b.offset += read
+	b.count -= lines
+	if b.offset < b.size {
+		return nil
+	}
+	if err := b.file.Truncate(0); err != nil {
+		return errors.Capture(err)
+	}
+	b.offset, b.size, b.count = 0, 0, 0
+	return nil
+}
+
+// Close closes the buffer, leaving any unread records on disk.
+func (b *diskBuffer) Close() error {
+	if err := b.compact(); err != nil {
+		_ = b.file.Close()
+		return errors.Capture(err)
+	}
+	return b.file.Close()
+}
+
+// compact moves the unread records to the front of the file, releasing the
+// space used by the records which have been read.
+func (b *diskBuffer) compact() error {
+	if b.offset == 0 {
+		return nil
+	}
+	remaining := b.size - b.offset
+	if _, err := io.Copy(
+		io.NewOffsetWriter(b.file, 0),
+		io.NewSectionReader(b.file, b.offset, remaining),
+	); err != nil {
+		return errors.Capture(err)
+	}
+	if err := b.file.Truncate(remaining); err != nil {
+		return errors.Capture(err)
+	}
+	b.offset, b.size = 0, remaining
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/logger"
+)
+
+type bufferSuite struct{}
+
+func TestBufferSuite(t *testing.T) {
+	tc.Run(t, &bufferSuite{})
+}
+
+func (s *bufferSuite) TestAppendPeekDiscard(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "model", "target.buf")
+	buffer, err := openDiskBuffer(path, 1024*1024)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = buffer.Close() }()
+
+	records := makeRecords(5)
+	dropped, err := buffer.Append(records)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(dropped, tc.Equals, 0)
+	c.Check(buffer.Len(), tc.Equals, 5)
+
+	read, lines, n, err := buffer.Peek(3)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(read, tc.DeepEquals, records[:3])
+	c.Check(lines, tc.Equals, 3)
+
+	c.Assert(buffer.Discard(lines, n), tc.ErrorIsNil)
+	c.Check(buffer.Len(), tc.Equals, 2)
+
+	read, lines, n, err = buffer.Peek(3)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(read, tc.DeepEquals, records[3:])
+
+	// Once every record has been read back, the file is emptied.
+	c.Assert(buffer.Discard(lines, n), tc.ErrorIsNil)
+	c.Check(buffer.Len(), tc.Equals, 0)
+	c.Check(buffer.Size(), tc.Equals, int64(0))
+	info, err := os.Stat(path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.Size(), tc.Equals, int64(0))
+}
+
+func (s *bufferSuite) TestAppendDropsWhenFull(c *tc.C) {
+	buffer, err := openDiskBuffer(filepath.Join(c.MkDir(), "target.buf"), 300)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = buffer.Close() }()
+
+	dropped, err := buffer.Append(makeRecords(10))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(dropped > 0, tc.IsTrue)
+	c.Check(buffer.Len(), tc.Equals, 10-dropped)
+	c.Check(buffer.Size() <= 300, tc.IsTrue)
+}
+
+func (s *bufferSuite) TestReopenKeepsRecords(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "target.buf")
+	buffer, err := openDiskBuffer(path, 1024*1024)
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(4)
+	_, err = buffer.Append(records)
+	c.Assert(err, tc.ErrorIsNil)
+	_, lines, n, err := buffer.Peek(1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(buffer.Discard(lines, n), tc.ErrorIsNil)
+	c.Assert(buffer.Close(), tc.ErrorIsNil)
+
+	// Simulate a write interrupted part way through a record.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = f.WriteString(`{"partial":`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(f.Close(), tc.ErrorIsNil)
+
+	buffer, err = openDiskBuffer(path, 1024*1024)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = buffer.Close() }()
+
+	c.Check(buffer.Len(), tc.Equals, 3)
+	read, _, _, err := buffer.Peek(10)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(read, tc.DeepEquals, records[1:])
+}
+
+func makeRecords(n int) []logger.LogRecord {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	records := make([]logger.LogRecord, n)
+	for i := range records {
+		records[i] = logger.LogRecord{
+			Time:      now.Add(time.Duration(i) * time.Second),
+			ModelUUID: "model-uuid",
+			Entity:    "machine-0",
+			Level:     logger.INFO,
+			Module:    "juju.worker",
+			Message:   fmt.Sprintf("message %d", i),
+		}
+	}
+	return records
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"gopkg.in/tomb.v2"
+
+	corelogforward "github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// queueSize is the number of batches of records held in memory before
+	// records are dropped.
+	queueSize = 1024
+
+	// drainBatchSize is the maximum number of buffered records sent to the
+	// destination at once.
+	drainBatchSize = 512
+
+	// sendTimeout is the maximum time to wait for a destination to accept a
+	// batch of records.
+	sendTimeout = 30 * time.Second
+
+	// minRetryDelay and maxRetryDelay bound the delay between attempts to
+	// deliver buffered records to an unavailable destination.
+	minRetryDelay = time.Second
+	maxRetryDelay = time.Minute
+)
+
+// forwarderConfig holds the configuration of a forwarder.
+type forwarderConfig struct {
+	Target  corelogforward.Target
+	Sender  Sender
+	Buffer  *diskBuffer
+	Metrics targetMetrics
+	Clock   clock.Clock
+	Logger  logger.Logger
+}
+
+// forwarder delivers the records of a model to a single destination.
+// Records are queued in memory and sent in the order they are received.
+// While the destination is unavailable, records are spilled to a disk
+// buffer, which is drained once the destination is reachable again.
+type forwarder struct {
+	tomb   tomb.Tomb
+	config forwarderConfig
+	queue  chan []logger.LogRecord
+}
+
+func newForwarder(config forwarderConfig) *forwarder {
+	f := &forwarder{
+		config: config,
+		queue:  make(chan []logger.LogRecord, queueSize),
+	}
+	f.updateBufferMetrics()
+	f.tomb.Go(f.loop)
+	return f
+}
+
+// Forward queues the records to be sent to the destination. It never
+// blocks; if the queue is full the records are dropped.
+func (f *forwarder) Forward(records []logger.LogRecord) {
+	select {
+	case f.queue <- records:
+		f.config.Metrics.recordsQueued.Add(float64(len(records)))
+	default:
+		f.config.Metrics.recordsDropped.Add(float64(len(records)))
+	}
+}
+
+// Kill is part of the worker.Worker interface.
+func (f *forwarder) Kill() {
+	f.tomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (f *forwarder) Wait() error {
+	return f.tomb.Wait()
+}
+
+func (f *forwarder) loop() error {
+	defer func() {
+		_ = f.config.Sender.Close()
+		if err := f.config.Buffer.Close(); err != nil {
+			f.config.Logger.Warningf(context.Background(), "closing log forward buffer for %s: %v", f.config.Target, err)
+		}
+	}()
+
+	var (
+		retry <-chan time.Time
+		delay time.Duration
+	)
+	// Records left in the buffer by a previous run are sent first.
+	if f.config.Buffer.Len() > 0 {
+		retry = f.config.Clock.After(0)
+	}
+
+	for {
+		select {
+		case <-f.tomb.Dying():
+			// Keep anything still queued, so it is sent when the
+			// forwarder is started again.
+			f.spillQueue()
+			return tomb.ErrDying
+
+		case records := <-f.queue:
+			f.config.Metrics.recordsQueued.Sub(float64(len(records)))
+			if retry != nil {
+				f.spill(records)
+				continue
+			}
+			if err := f.send(records); err != nil {
+				f.config.Logger.Warningf(context.Background(), "log forward target %s unavailable, buffering records: %v", f.config.Target, err)
+				f.spill(records)
+				delay = minRetryDelay
+				retry = f.config.Clock.After(delay)
+			}
+
+		case <-retry:
+			if err := f.drain(); err != nil {
+				delay = min(max(delay*2, minRetryDelay), maxRetryDelay)
+				retry = f.config.Clock.After(delay)
+				continue
+			}
+			if f.tomb.Alive() {
+				f.config.Logger.Infof(context.Background(), "log forward target %s available, buffered records sent", f.config.Target)
+			}
+			retry = nil
+		}
+	}
+}
+
+// send delivers the records to the destination.
+func (f *forwarder) send(records []logger.LogRecord) error {
+	ctx, cancel := context.WithTimeout(f.tomb.Context(context.Background()), sendTimeout)
+	defer cancel()
+
+	if err := f.config.Sender.Send(ctx, records); err != nil {
+		f.config.Metrics.sendErrors.Inc()
+		return errors.Capture(err)
+	}
+	f.config.Metrics.recordsForwarded.Add(float64(len(records)))
+	return nil
+}
+
+// drain sends the buffered records to the destination, until the buffer
+// is empty, a send fails or the forwarder is stopped.
+func (f *forwarder) drain() error {
+	for f.config.Buffer.Len() > 0 && f.tomb.Alive() {
+		records, lines, read, err := f.config.Buffer.Peek(drainBatchSize)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if len(records) > 0 {
+			if err := f.send(records); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		if err := f.config.Buffer.Discard(lines, read); err != nil {
+			return errors.Capture(err)
+		}
+		f.updateBufferMetrics()
+	}
+	return nil
+}
+
+// spill appends the records to the disk buffer.
+func (f *forwarder) spill(records []logger.LogRecord) {
+	dropped, err := f.config.Buffer.Append(records)
+	if err != nil {
+		f.config.Logger.Errorf(context.Background(), "buffering records for log forward target %s: %v", f.config.Target, err)
+		dropped = len(records)
+	}
+	if dropped > 0 {
+		f.config.Metrics.recordsDropped.Add(float64(dropped))
+	}
+	f.updateBufferMetrics()
+}
+
+// spillQueue moves any records left in the queue to the disk buffer.
+func (f *forwarder) spillQueue() {
+	for {
+		select {
+		case records := <-f.queue:
+			f.config.Metrics.recordsQueued.Sub(float64(len(records)))
+			f.spill(records)
+		default:
+			return
+		}
+	}
+}
+
+func (f *forwarder) updateBufferMetrics() {
+	f.config.Metrics.recordsBuffered.Set(float64(f.config.Buffer.Len()))
+	f.config.Metrics.bufferBytes.Set(float64(f.config.Buffer.Size()))
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/juju/juju/core/logger"
+)
+
+// lokiRequest is the JSON encoding of a Loki push request.
+type lokiRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// encodeLoki encodes the records as a Loki push request. Records are
+// grouped into streams by model, entity and level, which keeps the number
+// of label combinations low; the module and location are carried in the
+// log line.
+func encodeLoki(records []logger.LogRecord) ([]byte, error) {
+	var (
+		req     lokiRequest
+		streams = make(map[[3]string]int)
+	)
+	for _, record := range records {
+		level := strings.ToLower(record.Level.String())
+		key := [3]string{record.ModelUUID, record.Entity, level}
+		idx, ok := streams[key]
+		if !ok {
+			idx = len(req.Streams)
+			streams[key] = idx
+			req.Streams = append(req.Streams, lokiStream{
+				Stream: map[string]string{
+					"juju_model_uuid": record.ModelUUID,
+					"juju_entity":     record.Entity,
+					"level":           level,
+				},
+			})
+		}
+
+		line := record.Module + " "
+		if record.Location != "" {
+			line += record.Location + " "
+		}
+		line += record.Message
+		req.Streams[idx].Values = append(req.Streams[idx].Values, [2]string{
+			strconv.FormatInt(record.Time.UnixNano(), 10),
+			line,
+		})
+	}
+	return json.Marshal(req)
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	corelogforward "github.com/juju/juju/core/logforward"
+)
+
+const (
+	logForwardMetricsNamespace   = "juju"
+	logForwardSubsystemNamespace = "log_forward"
+)
+
+var (
+	labelNames = []string{"model", "target"}
+)
+
+// Collector defines a prometheus collector for log forwarding.
+type Collector struct {
+	RecordsForwarded *prometheus.CounterVec
+	RecordsDropped   *prometheus.CounterVec
+	SendErrors       *prometheus.CounterVec
+	RecordsQueued    *prometheus.GaugeVec
+	RecordsBuffered  *prometheus.GaugeVec
+	BufferBytes      *prometheus.GaugeVec
+}
+
+// NewMetricsCollector returns a new Collector.
+func NewMetricsCollector() *Collector {
+	return &Collector{
+		RecordsForwarded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: logForwardMetricsNamespace,
+			Subsystem: logForwardSubsystemNamespace,
+			Name:      "records_forwarded_total",
+			Help:      "Total number of log records delivered to a destination.",
+		}, labelNames),
+		RecordsDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: logForwardMetricsNamespace,
+			Subsystem: logForwardSubsystemNamespace,
+			Name:      "records_dropped_total",
+			Help:      "Total number of log records dropped because the queue or disk buffer was full.",
+		}, labelNames),
+		SendErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: logForwardMetricsNamespace,
+			Subsystem: logForwardSubsystemNamespace,
+			Name:      "send_errors_total",
+			Help:      "Total number of failed attempts to deliver log records to a destination.",
+		}, labelNames),
+		RecordsQueued: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: logForwardMetricsNamespace,
+			Subsystem: logForwardSubsystemNamespace,
+			Name:      "records_queued",
+			Help:      "Number of log records waiting in memory to be forwarded.",
+		}, labelNames),
+		RecordsBuffered: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: logForwardMetricsNamespace,
+			Subsystem: logForwardSubsystemNamespace,
+			Name:      "records_buffered",
+			Help:      "Number of log records buffered on disk while a destination is unavailable.",
+		}, labelNames),
+		BufferBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: logForwardMetricsNamespace,
+			Subsystem: logForwardSubsystemNamespace,
+			Name:      "buffer_bytes",
+			Help:      "Size in bytes of the disk buffer of a destination.",
+		}, labelNames),
+	}
+}
+
+// Describe is part of the prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.RecordsForwarded.Describe(ch)
+	c.RecordsDropped.Describe(ch)
+	c.SendErrors.Describe(ch)
+	c.RecordsQueued.Describe(ch)
+	c.RecordsBuffered.Describe(ch)
+	c.BufferBytes.Describe(ch)
+}
+
+// Collect is part of the prometheus.Collector interface.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.RecordsForwarded.Collect(ch)
+	c.RecordsDropped.Collect(ch)
+	c.SendErrors.Collect(ch)
+	c.RecordsQueued.Collect(ch)
+	c.RecordsBuffered.Collect(ch)
+	c.BufferBytes.Collect(ch)
+}
+
+// forTarget returns the metrics of a single target of a model.
+func (c *Collector) forTarget(modelUUID string, target corelogforward.Target) targetMetrics {
+	labels := prometheus.Labels{"model": modelUUID, "target": target.String()}
+	return targetMetrics{
+		collector:        c,
+		labels:           labels,
+		recordsForwarded: c.RecordsForwarded.With(labels),
+		recordsDropped:   c.RecordsDropped.With(labels),
+		sendErrors:       c.SendErrors.With(labels),
+		recordsQueued:    c.RecordsQueued.With(labels),
+		recordsBuffered:  c.RecordsBuffered.With(labels),
+		bufferBytes:      c.BufferBytes.With(labels),
+	}
+}
+
+// targetMetrics holds the metrics of a single target of a model.
+type targetMetrics struct {
+	collector *Collector
+	labels    prometheus.Labels
+
+	recordsForwarded prometheus.Counter
+	recordsDropped   prometheus.Counter
+	sendErrors       prometheus.Counter
+	recordsQueued    prometheus.Gauge
+	recordsBuffered  prometheus.Gauge
+	bufferBytes      prometheus.Gauge
+}
+
+// delete removes the metrics of the target from the collector.
+func (m targetMetrics) delete() {
+	m.collector.RecordsForwarded.Delete(m.labels)
+	m.collector.RecordsDropped.Delete(m.labels)
+	m.collector.SendErrors.Delete(m.labels)
+	m.collector.RecordsQueued.Delete(m.labels)
+	m.collector.RecordsBuffered.Delete(m.labels)
+	m.collector.BufferBytes.Delete(m.labels)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/juju/juju/core/logger"
+)
+
+// The following types are the subset of the OTLP/HTTP JSON encoding of
+// ExportLogsServiceRequest used to forward records.
+
+type otlpRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           otlpValue       `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// encodeOTLP encodes the records as an OTLP ExportLogsServiceRequest.
+// Records are grouped into a resource per model.
+func encodeOTLP(records []logger.LogRecord) ([]byte, error) {
+	var (
+		req    otlpRequest
+		models = make(map[string]int)
+	)
+	for _, record := range records {
+		idx, ok := models[record.ModelUUID]
+		if !ok {
+			idx = len(req.ResourceLogs)
+			models[record.ModelUUID] = idx
+			req.ResourceLogs = append(req.ResourceLogs, otlpResourceLogs{
+				Resource: otlpResource{
+					Attributes: []otlpAttribute{
+						otlpAttr("service.name", "juju"),
+						otlpAttr("juju.model.uuid", record.ModelUUID),
+					},
+				},
+				ScopeLogs: []otlpScopeLogs{{
+					Scope: otlpScope{Name: "juju"},
+				}},
+			})
+		}
+
+		attrs := []otlpAttribute{
+			otlpAttr("juju.entity", record.Entity),
+			otlpAttr("juju.module", record.Module),
+		}
+		if record.Location != "" {
+			attrs = append(attrs, otlpAttr("juju.location", record.Location))
+		}
+		labels := make([]string, 0, len(record.Labels))
+		for name := range record.Labels {
+			labels = append(labels, name)
+		}
+		sort.Strings(labels)
+		for _, name := range labels {
+			attrs = append(attrs, otlpAttr("juju.label."+name, record.Labels[name]))
+		}
+
+		scope := &req.ResourceLogs[idx].ScopeLogs[0]
+		scope.LogRecords = append(scope.LogRecords, otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(record.Time.UnixNano(), 10),
+			SeverityNumber: otlpSeverity(record.Level),
+			SeverityText:   record.Level.String(),
+			Body:           otlpValue{StringValue: record.Message},
+			Attributes:     attrs,
+		})
+	}
+	return json.Marshal(req)
+}
+
+func otlpAttr(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: value}}
+}
+
+// otlpSeverity maps a log level to the first severity number of the
+// matching OTLP severity range.
+func otlpSeverity(level logger.Level) int {
+	switch level {
+	case logger.TRACE:
+		return 1
+	case logger.DEBUG:
+		return 5
+	case logger.INFO:
+		return 9
+	case logger.WARNING:
+		return 13
+	case logger.ERROR:
+		return 17
+	case logger.CRITICAL:
+		return 21
+	default:
+		return 0
+	}
+}
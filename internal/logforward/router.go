@@ -0,0 +1,322 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/juju/clock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corelogforward "github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// DefaultMaxBufferSize is the default maximum size of the disk buffer
+	// of each destination.
+	DefaultMaxBufferSize = 64 * 1024 * 1024
+)
+
+// RouterConfig holds the configuration of a Router.
+type RouterConfig struct {
+	// Sink is the log sink to which every record is written before being
+	// forwarded.
+	Sink logger.LogSink
+
+	// BufferDir is the directory holding the disk buffers of the
+	// destinations.
+	BufferDir string
+
+	// MaxBufferSize is the maximum size in bytes of the disk buffer of
+	// each destination.
+	MaxBufferSize int64
+
+	// Metrics collects the forwarding metrics.
+	Metrics *Collector
+
+	// NewSender returns a sender for a target.
+	NewSender func(target corelogforward.Target, caCert string) (Sender, error)
+
+	Clock  clock.Clock
+	Logger logger.Logger
+}
+
+// Validate validates the router configuration.
+func (c RouterConfig) Validate() error {
+	if c.Sink == nil {
+		return errors.New("nil Sink not valid").Add(coreerrors.NotValid)
+	}
+	if c.BufferDir == "" {
+		return errors.New("empty BufferDir not valid").Add(coreerrors.NotValid)
+	}
+	if c.MaxBufferSize <= 0 {
+		return errors.New("non-positive MaxBufferSize not valid").Add(coreerrors.NotValid)
+	}
+	if c.Metrics == nil {
+		return errors.New("nil Metrics not valid").Add(coreerrors.NotValid)
+	}
+	if c.NewSender == nil {
+		return errors.New("nil NewSender not valid").Add(coreerrors.NotValid)
+	}
+	if c.Clock == nil {
+		return errors.New("nil Clock not valid").Add(coreerrors.NotValid)
+	}
+	if c.Logger == nil {
+		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// Router is a log sink which writes records to an underlying sink, and
+// forwards them to the destinations configured for the model the records
+// belong to.
+type Router struct {
+	config RouterConfig
+
+	mu     sync.RWMutex
+	models map[string]*modelRoute
+}
+
+// modelRoute holds the forwarders of a model.
+type modelRoute struct {
+	caCert     string
+	forwarders map[string]*routeForwarder
+}
+
+func (r *modelRoute) forward(records []logger.LogRecord) {
+	for _, f := range r.forwarders {
+		f.Forward(records)
+	}
+}
+
+type routeForwarder struct {
+	*forwarder
+	bufferPath string
+}
+
+// NewRouter returns a new Router. No records are forwarded until targets
+// are set for a model.
+func NewRouter(config RouterConfig) (*Router, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &Router{
+		config: config,
+		models: make(map[string]*modelRoute),
+	}, nil
+}
+
+// Log writes the records to the underlying sink and queues them to be
+// forwarded. Forwarding never blocks the caller.
+func (r *Router) Log(records []logger.LogRecord) error {
+	err := r.config.Sink.Log(records)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.models) == 0 || len(records) == 0 {
+		return err
+	}
+
+	// Records are almost always written in batches belonging to a single
+	// model, so avoid splitting the batch unless it is needed.
+	if allSameModel(records) {
+		if route, ok := r.models[records[0].ModelUUID]; ok {
+			route.forward(records)
+		}
+		return err
+	}
+	byModel := make(map[string][]logger.LogRecord)
+	for _, record := range records {
+		if _, ok := r.models[record.ModelUUID]; ok {
+			byModel[record.ModelUUID] = append(byModel[record.ModelUUID], record)
+		}
+	}
+	for modelUUID, modelRecords := range byModel {
+		r.models[modelUUID].forward(modelRecords)
+	}
+	return err
+}
+
+// SetTargets sets the destinations to which the records of a model are
+// forwarded. Forwarders are started for new targets, and those of targets
+// which are no longer present are stopped and their disk buffers removed.
+// If caCert is not empty, it is used to verify TLS destinations.
+func (r *Router) SetTargets(modelUUID string, targets []corelogforward.Target, caCert string) error {
+	// Senders are created up front so that an invalid configuration leaves
+	// the current forwarders running.
+	senders := make(map[string]Sender)
+	for _, target := range targets {
+		key := target.URL.String()
+		if _, ok := senders[key]; ok {
+			continue
+		}
+		sender, err := r.config.NewSender(target, caCert)
+		if err != nil {
+			for _, s := range senders {
+				_ = s.Close()
+			}
+			return errors.Errorf("creating sender for log forward target %s: %w", target, err)
+		}
+		senders[key] = sender
+	}
+
+	// Forwarders are stopped without holding the lock, as they may log
+	// while stopping, which would otherwise deadlock. They must be stopped
+	// before any replacement is started, as it opens the same buffer.
+	stopped, removed := r.detachForwarders(modelUUID, senders, caCert)
+	for _, f := range stopped {
+		f.stop()
+	}
+	for _, f := range removed {
+		f.stop()
+		if err := os.Remove(f.bufferPath); err != nil && !os.IsNotExist(err) {
+			r.config.Logger.Warningf(context.Background(), "removing log forward buffer %q: %v", f.bufferPath, err)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	route, ok := r.models[modelUUID]
+	if !ok {
+		route = &modelRoute{
+			caCert:     caCert,
+			forwarders: make(map[string]*routeForwarder),
+		}
+	}
+
+	var startErr error
+	for _, target := range targets {
+		key := target.URL.String()
+		sender, ok := senders[key]
+		if !ok {
+			continue
+		}
+		delete(senders, key)
+		if _, ok := route.forwarders[key]; ok {
+			_ = sender.Close()
+			continue
+		}
+
+		bufferPath := filepath.Join(r.config.BufferDir, modelUUID, bufferName(key))
+		buffer, err := openDiskBuffer(bufferPath, r.config.MaxBufferSize)
+		if err != nil {
+			_ = sender.Close()
+			startErr = errors.Errorf("opening buffer for log forward target %s: %w", target, err)
+			continue
+		}
+		route.forwarders[key] = &routeForwarder{
+			forwarder: newForwarder(forwarderConfig{
+				Target:  target,
+				Sender:  sender,
+				Buffer:  buffer,
+				Metrics: r.config.Metrics.forTarget(modelUUID, target),
+				Clock:   r.config.Clock,
+				Logger:  r.config.Logger,
+			}),
+			bufferPath: bufferPath,
+		}
+	}
+
+	if len(route.forwarders) == 0 {
+		delete(r.models, modelUUID)
+	} else {
+		r.models[modelUUID] = route
+	}
+	return startErr
+}
+
+// detachForwarders removes the forwarders of a model which need to be
+// stopped from the router. Forwarders whose target is not in keep are
+// returned as removed. If the CA certificate has changed, every remaining
+// forwarder is returned as stopped, so that it is restarted with new
+// connections.
+func (r *Router) detachForwarders(modelUUID string, keep map[string]Sender, caCert string) (stopped, removed []*routeForwarder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	route, ok := r.models[modelUUID]
+	if !ok {
+		return nil, nil
+	}
+	for key, f := range route.forwarders {
+		if _, ok := keep[key]; !ok {
+			removed = append(removed, f)
+			delete(route.forwarders, key)
+		} else if route.caCert != caCert {
+			stopped = append(stopped, f)
+			delete(route.forwarders, key)
+		}
+	}
+	route.caCert = caCert
+	if len(route.forwarders) == 0 {
+		delete(r.models, modelUUID)
+	}
+	return stopped, removed
+}
+
+// RemoveModel stops forwarding the records of a model. Unlike setting no
+// targets, the disk buffers of the model are kept, so that buffered
+// records are sent if forwarding is resumed.
+func (r *Router) RemoveModel(modelUUID string) {
+	r.mu.Lock()
+	route, ok := r.models[modelUUID]
+	delete(r.models, modelUUID)
+	r.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	for _, f := range route.forwarders {
+		f.stop()
+	}
+}
+
+// Close stops forwarding records for every model, keeping their disk
+// buffers. It does not close the underlying sink.
+func (r *Router) Close() error {
+	r.mu.Lock()
+	models := r.models
+	r.models = make(map[string]*modelRoute)
+	r.mu.Unlock()
+
+	for _, route := range models {
+		for _, f := range route.forwarders {
+			f.stop()
+		}
+	}
+	return nil
+}
+
+// stop stops the forwarder and removes its metrics.
+func (f *routeForwarder) stop() {
+	f.Kill()
+	_ = f.Wait()
+	f.config.Metrics.delete()
+}
+
+// bufferName returns the file name of the disk buffer of a target. The
+// name is derived from the target, so a buffer is picked up again when the
+// same target is configured.
+func bufferName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8]) + ".buf"
+}
+
+func allSameModel(records []logger.LogRecord) bool {
+	for _, record := range records[1:] {
+		if record.ModelUUID != records[0].ModelUUID {
+			return false
+		}
+	}
+	return true
+}
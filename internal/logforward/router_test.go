@@ -0,0 +1,274 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/goleak"
+
+	corelogforward "github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type routerSuite struct {
+	testhelpers.IsolationSuite
+
+	clock     *testclock.Clock
+	sink      *recordingSink
+	sender    *fakeSender
+	metrics   *Collector
+	bufferDir string
+}
+
+func TestRouterSuite(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	tc.Run(t, &routerSuite{})
+}
+
+func (s *routerSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.clock = testclock.NewClock(time.Now())
+	s.sink = &recordingSink{}
+	s.sender = &fakeSender{sent: make(chan []logger.LogRecord, 10)}
+	s.metrics = NewMetricsCollector()
+	s.bufferDir = c.MkDir()
+}
+
+func (s *routerSuite) TestValidate(c *tc.C) {
+	cfg := s.config(c)
+	cfg.Sink = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil Sink not valid")
+
+	cfg = s.config(c)
+	cfg.BufferDir = ""
+	c.Check(cfg.Validate(), tc.ErrorMatches, "empty BufferDir not valid")
+
+	cfg = s.config(c)
+	cfg.MaxBufferSize = 0
+	c.Check(cfg.Validate(), tc.ErrorMatches, "non-positive MaxBufferSize not valid")
+
+	cfg = s.config(c)
+	cfg.NewSender = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil NewSender not valid")
+}
+
+func (s *routerSuite) TestLogWithoutTargets(c *tc.C) {
+	router := s.newRouter(c)
+
+	records := makeRecords(2)
+	c.Assert(router.Log(records), tc.ErrorIsNil)
+	c.Check(s.sink.records(), tc.DeepEquals, records)
+}
+
+func (s *routerSuite) TestForwardsRecordsOfModel(c *tc.C) {
+	router := s.newRouter(c)
+	target := s.target(c, "syslog://logs.example.com")
+	c.Assert(router.SetTargets("model-uuid", []corelogforward.Target{target}, ""), tc.ErrorIsNil)
+
+	records := makeRecords(2)
+	other := logger.LogRecord{ModelUUID: "other-uuid", Message: "not forwarded"}
+	all := []logger.LogRecord{records[0], other, records[1]}
+	c.Assert(router.Log(all), tc.ErrorIsNil)
+
+	// Every record is written to the sink, only those of the model are
+	// forwarded.
+	c.Check(s.sink.records(), tc.DeepEquals, all)
+	c.Check(s.waitSent(c), tc.DeepEquals, records)
+
+	labels := map[string]string{"model": "model-uuid", "target": "syslog://logs.example.com"}
+	c.Check(testutil.ToFloat64(s.metrics.RecordsForwarded.With(labels)), tc.Equals, float64(2))
+}
+
+func (s *routerSuite) TestBuffersWhileTargetUnavailable(c *tc.C) {
+	router := s.newRouter(c)
+	target := s.target(c, "loki+http://loki")
+	c.Assert(router.SetTargets("model-uuid", []corelogforward.Target{target}, ""), tc.ErrorIsNil)
+	labels := map[string]string{"model": "model-uuid", "target": "loki+http://loki"}
+
+	s.sender.setErr(errors.New("connection refused"))
+	records := makeRecords(3)
+	c.Assert(router.Log(records[:1]), tc.ErrorIsNil)
+
+	// Wait for the retry to be scheduled; the record is now on disk and
+	// further records are buffered behind it.
+	c.Assert(s.clock.WaitAdvance(0, testhelpers.LongWait, 1), tc.ErrorIsNil)
+	c.Assert(router.Log(records[1:]), tc.ErrorIsNil)
+	s.waitForGauge(c, s.metrics.RecordsBuffered.With(labels), 3)
+	c.Check(testutil.ToFloat64(s.metrics.SendErrors.With(labels)), tc.Equals, float64(1))
+
+	// Once the target is reachable, the buffered records are sent in
+	// order.
+	s.sender.setErr(nil)
+	s.clock.Advance(minRetryDelay)
+	c.Check(s.waitSent(c), tc.DeepEquals, records)
+	s.waitForGauge(c, s.metrics.RecordsBuffered.With(labels), 0)
+	c.Check(testutil.ToFloat64(s.metrics.BufferBytes.With(labels)), tc.Equals, float64(0))
+}
+
+func (s *routerSuite) TestRemoveModelKeepsBuffer(c *tc.C) {
+	router := s.newRouter(c)
+	target := s.target(c, "otlp+http://collector")
+	c.Assert(router.SetTargets("model-uuid", []corelogforward.Target{target}, ""), tc.ErrorIsNil)
+
+	s.sender.setErr(errors.New("connection refused"))
+	records := makeRecords(1)
+	c.Assert(router.Log(records), tc.ErrorIsNil)
+	c.Assert(s.clock.WaitAdvance(0, testhelpers.LongWait, 1), tc.ErrorIsNil)
+
+	router.RemoveModel("model-uuid")
+	path := filepath.Join(s.bufferDir, "model-uuid", bufferName(target.URL.String()))
+	_, err := os.Stat(path)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Forwarding again picks up the buffered record.
+	s.sender.setErr(nil)
+	c.Assert(router.SetTargets("model-uuid", []corelogforward.Target{target}, ""), tc.ErrorIsNil)
+	c.Assert(s.clock.WaitAdvance(0, testhelpers.LongWait, 1), tc.ErrorIsNil)
+	c.Check(s.waitSent(c), tc.DeepEquals, records)
+
+	// Removing the target discards its buffer.
+	c.Assert(router.SetTargets("model-uuid", nil, ""), tc.ErrorIsNil)
+	_, err = os.Stat(path)
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+
+	// With no targets left, records are no longer forwarded.
+	c.Assert(router.Log(makeRecords(1)), tc.ErrorIsNil)
+	select {
+	case records := <-s.sender.sent:
+		c.Fatalf("unexpected records sent: %v", records)
+	case <-time.After(testhelpers.ShortWait):
+	}
+}
+
+func (s *routerSuite) TestSetTargetsInvalidSenderKeepsForwarders(c *tc.C) {
+	router := s.newRouter(c)
+	target := s.target(c, "syslog://logs.example.com")
+	c.Assert(router.SetTargets("model-uuid", []corelogforward.Target{target}, ""), tc.ErrorIsNil)
+
+	router.config.NewSender = func(corelogforward.Target, string) (Sender, error) {
+		return nil, errors.New("bad certificate")
+	}
+	err := router.SetTargets("model-uuid", []corelogforward.Target{target}, "not a cert")
+	c.Assert(err, tc.ErrorMatches, `creating sender for log forward target syslog://logs.example.com: bad certificate`)
+
+	records := makeRecords(1)
+	c.Assert(router.Log(records), tc.ErrorIsNil)
+	c.Check(s.waitSent(c), tc.DeepEquals, records)
+}
+
+func (s *routerSuite) newRouter(c *tc.C) *Router {
+	router, err := NewRouter(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Cleanup(func() { _ = router.Close() })
+	return router
+}
+
+func (s *routerSuite) config(c *tc.C) RouterConfig {
+	return RouterConfig{
+		Sink:          s.sink,
+		BufferDir:     s.bufferDir,
+		MaxBufferSize: DefaultMaxBufferSize,
+		Metrics:       s.metrics,
+		NewSender: func(corelogforward.Target, string) (Sender, error) {
+			return s.sender, nil
+		},
+		Clock:  s.clock,
+		Logger: loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *routerSuite) target(c *tc.C, input string) corelogforward.Target {
+	target, err := corelogforward.ParseTarget(input)
+	c.Assert(err, tc.ErrorIsNil)
+	return target
+}
+
+// waitSent waits for records to be sent, collecting them until no more
+// arrive.
+func (s *routerSuite) waitSent(c *tc.C) []logger.LogRecord {
+	var sent []logger.LogRecord
+	select {
+	case records := <-s.sender.sent:
+		sent = append(sent, records...)
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for records to be sent")
+	}
+	for {
+		select {
+		case records := <-s.sender.sent:
+			sent = append(sent, records...)
+		case <-time.After(testhelpers.ShortWait):
+			return sent
+		}
+	}
+}
+
+func (s *routerSuite) waitForGauge(c *tc.C, gauge prometheus.Gauge, expected float64) {
+	timeout := time.After(testhelpers.LongWait)
+	for testutil.ToFloat64(gauge) != expected {
+		select {
+		case <-timeout:
+			c.Fatalf("timed out waiting for gauge to be %v, got %v", expected, testutil.ToFloat64(gauge))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+type recordingSink struct {
+	mu     sync.Mutex
+	logged []logger.LogRecord
+}
+
+func (s *recordingSink) Log(records []logger.LogRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logged = append(s.logged, records...)
+	return nil
+}
+
+func (s *recordingSink) records() []logger.LogRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logged
+}
+
+type fakeSender struct {
+	mu   sync.Mutex
+	err  error
+	sent chan []logger.LogRecord
+}
+
+func (s *fakeSender) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *fakeSender) Send(_ context.Context, records []logger.LogRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.sent <- records
+	return nil
+}
+
+func (s *fakeSender) Close() error {
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	corehttp "github.com/juju/juju/core/http"
+	corelogforward "github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	jujuhttp "github.com/juju/juju/internal/http"
+)
+
+const (
+	// dialTimeout is the maximum time to wait to connect to a destination.
+	dialTimeout = 10 * time.Second
+
+	// maxErrorBody is the maximum number of bytes of a failed HTTP response
+	// body that are included in the returned error.
+	maxErrorBody = 512
+)
+
+// Sender writes batches of log records to a destination.
+type Sender interface {
+	// Send writes the records to the destination. An error indicates that
+	// the destination may not have received any of the records.
+	Send(ctx context.Context, records []logger.LogRecord) error
+
+	// Close releases any resources held by the sender.
+	Close() error
+}
+
+// NewSender returns a Sender which writes records to the target. If caCert
+// is not empty, it is a PEM encoded certificate that is used to verify the
+// destination of TLS targets instead of the system roots.
+func NewSender(target corelogforward.Target, caCert string) (Sender, error) {
+	switch target.Kind {
+	case corelogforward.KindSyslog:
+		return newSyslogSender(target, caCert)
+	case corelogforward.KindOTLP:
+		return newHTTPSender(target, caCert, encodeOTLP), nil
+	case corelogforward.KindLoki:
+		return newHTTPSender(target, caCert, encodeLoki), nil
+	default:
+		return nil, errors.Errorf("log forward target kind %q not supported", target.Kind)
+	}
+}
+
+// httpSender posts JSON encoded batches of records to an HTTP endpoint.
+type httpSender struct {
+	endpoint string
+	user     *url.Userinfo
+	client   corehttp.HTTPClient
+	encode   func([]logger.LogRecord) ([]byte, error)
+}
+
+func newHTTPSender(target corelogforward.Target, caCert string, encode func([]logger.LogRecord) ([]byte, error)) *httpSender {
+	var options []jujuhttp.Option
+	if caCert != "" {
+		options = append(options, jujuhttp.WithCACertificates(caCert))
+	}
+	return &httpSender{
+		endpoint: target.Endpoint(),
+		user:     target.URL.User,
+		client:   jujuhttp.NewClient(options...),
+		encode:   encode,
+	}
+}
+
+// Send is part of the Sender interface.
+func (s *httpSender) Send(ctx context.Context, records []logger.LogRecord) error {
+	body, err := s.encode(records)
+	if err != nil {
+		return errors.Errorf("encoding log records: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.user != nil {
+		password, _ := s.user.Password()
+		req.SetBasicAuth(s.user.Username(), password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return errors.Errorf("posting log records to %s: %s: %s", s.endpoint, resp.Status, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// Close is part of the Sender interface.
+func (s *httpSender) Close() error {
+	return nil
+}
+
+// certPool returns a certificate pool containing the PEM encoded caCert.
+func certPool(caCert string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(caCert)) {
+		return nil, errors.New("no certificates found in log forward CA certificate")
+	}
+	return pool, nil
+}
+
+// truncate returns s cut down to at most n bytes.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	corelogforward "github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/logger"
+)
+
+type senderSuite struct{}
+
+func TestSenderSuite(t *testing.T) {
+	tc.Run(t, &senderSuite{})
+}
+
+func (s *senderSuite) TestFormatSyslog(c *tc.C) {
+	msg := formatSyslog(logger.LogRecord{
+		Time:      time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC),
+		ModelUUID: "model-uuid",
+		Entity:    "unit-mysql-0",
+		Level:     logger.WARNING,
+		Module:    "juju.worker.uniter",
+		Location:  "uniter.go:42",
+		Message:   "hook failed",
+		Labels:    logger.Labels{"domain": "app [1]"},
+	})
+	c.Check(msg, tc.Equals, `<12>1 2026-01-02T03:04:05.000006Z unit-mysql-0 juju - juju.worker.uniter `+
+		`[juju@32473 model="model-uuid" location="uniter.go:42" domain="app [1\]"] hook failed`)
+
+	msg = formatSyslog(logger.LogRecord{
+		Time:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Level:   logger.DEBUG,
+		Message: "no entity",
+	})
+	c.Check(msg, tc.Equals, `<15>1 2026-01-02T03:04:05.000000Z - juju - - [juju@32473] no entity`)
+}
+
+func (s *senderSuite) TestSyslogSender(c *tc.C) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = listener.Close() }()
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		received <- readFrames(conn, 2)
+	}()
+
+	target, err := corelogforward.ParseTarget("syslog://" + listener.Addr().String())
+	c.Assert(err, tc.ErrorIsNil)
+	sender, err := NewSender(target, "")
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = sender.Close() }()
+
+	records := makeRecords(2)
+	err = sender.Send(c.Context(), records)
+	c.Assert(err, tc.ErrorIsNil)
+
+	select {
+	case frames := <-received:
+		c.Assert(frames, tc.HasLen, 2)
+		c.Check(frames[0], tc.Equals, formatSyslog(records[0]))
+		c.Check(frames[1], tc.Equals, formatSyslog(records[1]))
+	case <-time.After(10 * time.Second):
+		c.Fatalf("timed out waiting for syslog messages")
+	}
+}
+
+func (s *senderSuite) TestOTLPSender(c *tc.C) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, http.MethodPost)
+		c.Check(r.URL.Path, tc.Equals, "/v1/logs")
+		c.Check(r.Header.Get("Content-Type"), tc.Equals, "application/json")
+		c.Check(json.NewDecoder(r.Body).Decode(&body), tc.ErrorIsNil)
+	}))
+	defer server.Close()
+
+	target, err := corelogforward.ParseTarget(strings.Replace(server.URL, "http://", "otlp+http://", 1))
+	c.Assert(err, tc.ErrorIsNil)
+	sender, err := NewSender(target, "")
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(1)
+	err = sender.Send(c.Context(), records)
+	c.Assert(err, tc.ErrorIsNil)
+
+	resourceLogs := body["resourceLogs"].([]any)
+	c.Assert(resourceLogs, tc.HasLen, 1)
+	resource := resourceLogs[0].(map[string]any)
+	c.Check(resource["resource"], tc.DeepEquals, map[string]any{
+		"attributes": []any{
+			map[string]any{"key": "service.name", "value": map[string]any{"stringValue": "juju"}},
+			map[string]any{"key": "juju.model.uuid", "value": map[string]any{"stringValue": "model-uuid"}},
+		},
+	})
+	logRecords := resource["scopeLogs"].([]any)[0].(map[string]any)["logRecords"].([]any)
+	c.Check(logRecords, tc.DeepEquals, []any{
+		map[string]any{
+			"timeUnixNano":   strconv.FormatInt(records[0].Time.UnixNano(), 10),
+			"severityNumber": float64(9),
+			"severityText":   "INFO",
+			"body":           map[string]any{"stringValue": "message 0"},
+			"attributes": []any{
+				map[string]any{"key": "juju.entity", "value": map[string]any{"stringValue": "machine-0"}},
+				map[string]any{"key": "juju.module", "value": map[string]any{"stringValue": "juju.worker"}},
+			},
+		},
+	})
+}
+
+func (s *senderSuite) TestLokiSender(c *tc.C) {
+	var body lokiRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, tc.Equals, "/loki/api/v1/push")
+		user, password, ok := r.BasicAuth()
+		c.Check(ok, tc.IsTrue)
+		c.Check(user, tc.Equals, "user")
+		c.Check(password, tc.Equals, "secret")
+		c.Check(json.NewDecoder(r.Body).Decode(&body), tc.ErrorIsNil)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	target, err := corelogforward.ParseTarget(strings.Replace(server.URL, "http://", "loki+http://user:secret@", 1))
+	c.Assert(err, tc.ErrorIsNil)
+	sender, err := NewSender(target, "")
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := makeRecords(2)
+	records[1].Level = logger.ERROR
+	err = sender.Send(c.Context(), records)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(body, tc.DeepEquals, lokiRequest{
+		Streams: []lokiStream{{
+			Stream: map[string]string{"juju_model_uuid": "model-uuid", "juju_entity": "machine-0", "level": "info"},
+			Values: [][2]string{{strconv.FormatInt(records[0].Time.UnixNano(), 10), "juju.worker message 0"}},
+		}, {
+			Stream: map[string]string{"juju_model_uuid": "model-uuid", "juju_entity": "machine-0", "level": "error"},
+			Values: [][2]string{{strconv.FormatInt(records[1].Time.UnixNano(), 10), "juju.worker message 1"}},
+		}},
+	})
+}
+
+func (s *senderSuite) TestHTTPSenderError(c *tc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "too many streams", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	target, err := corelogforward.ParseTarget(strings.Replace(server.URL, "http://", "loki+http://", 1))
+	c.Assert(err, tc.ErrorIsNil)
+	sender, err := NewSender(target, "")
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = sender.Send(c.Context(), makeRecords(1))
+	c.Check(err, tc.ErrorMatches, `posting log records to .*: 429 Too Many Requests: too many streams`)
+}
+
+// readFrames reads n octet counted syslog frames from r.
+func readFrames(r io.Reader, n int) []string {
+	var frames []string
+	reader := bufio.NewReader(r)
+	for range n {
+		size, err := reader.ReadString(' ')
+		if err != nil {
+			return frames
+		}
+		length, err := strconv.Atoi(strings.TrimSpace(size))
+		if err != nil {
+			return frames
+		}
+		frame := make([]byte, length)
+		if _, err := io.ReadFull(reader, frame); err != nil {
+			return frames
+		}
+		frames = append(frames, string(frame))
+	}
+	return frames
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforward
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	corelogforward "github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	jujuhttp "github.com/juju/juju/internal/http"
+)
+
+const (
+	// syslogFacility is the facility of the forwarded messages, "user-level
+	// messages" as defined by RFC5424.
+	syslogFacility = 1
+
+	// syslogAppName is the APP-NAME of the forwarded messages.
+	syslogAppName = "juju"
+
+	// syslogSDID is the structured data ID carrying juju specific fields.
+	// It uses the private enterprise number reserved for documentation by
+	// RFC5612.
+	syslogSDID = "juju@32473"
+)
+
+// syslogSender writes records as RFC5424 messages over a TCP or TLS stream,
+// framed with octet counting as described in RFC6587. The connection is
+// established lazily and re-established after a write error.
+type syslogSender struct {
+	dial func(context.Context) (net.Conn, error)
+	conn net.Conn
+}
+
+func newSyslogSender(target corelogforward.Target, caCert string) (*syslogSender, error) {
+	address := target.Address()
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	if !target.TLS {
+		return &syslogSender{
+			dial: func(ctx context.Context) (net.Conn, error) {
+				return dialer.DialContext(ctx, "tcp", address)
+			},
+		}, nil
+	}
+
+	tlsConfig := jujuhttp.SecureTLSConfig()
+	tlsConfig.ServerName = target.URL.Hostname()
+	if caCert != "" {
+		pool, err := certPool(caCert)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	tlsDialer := &tls.Dialer{
+		NetDialer: dialer,
+		Config:    tlsConfig,
+	}
+	return &syslogSender{
+		dial: func(ctx context.Context) (net.Conn, error) {
+			return tlsDialer.DialContext(ctx, "tcp", address)
+		},
+	}, nil
+}
+
+// Send is part of the Sender interface.
+func (s *syslogSender) Send(ctx context.Context, records []logger.LogRecord) error {
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		s.conn = conn
+	}
+
+	var buf bytes.Buffer
+	for _, record := range records {
+		msg := formatSyslog(record)
+		fmt.Fprintf(&buf, "%d %s", len(msg), msg)
+	}
+
+	// A zero deadline, when the context has none, clears any previous one.
+	deadline, _ := ctx.Deadline()
+	if err := s.conn.SetWriteDeadline(deadline); err != nil {
+		s.reset()
+		return errors.Capture(err)
+	}
+	if _, err := s.conn.Write(buf.Bytes()); err != nil {
+		s.reset()
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// Close is part of the Sender interface.
+func (s *syslogSender) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+func (s *syslogSender) reset() {
+	_ = s.conn.Close()
+	s.conn = nil
+}
+
+// formatSyslog formats the record as an RFC5424 syslog message.
+func formatSyslog(record logger.LogRecord) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%d>1 %s %s %s - %s ",
+		syslogFacility*8+syslogSeverity(record.Level),
+		record.Time.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		syslogHeaderField(record.Entity, 255),
+		syslogAppName,
+		syslogHeaderField(record.Module, 32),
+	)
+
+	b.WriteString("[" + syslogSDID)
+	writeSyslogParam(&b, "model", record.ModelUUID)
+	writeSyslogParam(&b, "location", record.Location)
+	labels := make([]string, 0, len(record.Labels))
+	for name := range record.Labels {
+		labels = append(labels, name)
+	}
+	sort.Strings(labels)
+	for _, name := range labels {
+		if name == "" {
+			continue
+		}
+		writeSyslogParam(&b, syslogParamName(name), record.Labels[name])
+	}
+	b.WriteString("] ")
+
+	b.WriteString(record.Message)
+	return b.String()
+}
+
+// syslogSeverity maps a log level to a syslog severity.
+func syslogSeverity(level logger.Level) int {
+	switch level {
+	case logger.CRITICAL:
+		return 2
+	case logger.ERROR:
+		return 3
+	case logger.WARNING:
+		return 4
+	case logger.INFO:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// syslogHeaderField returns s as a valid header field of at most n
+// characters; fields may only contain printable US-ASCII characters and
+// the nil value "-" is used when empty.
+func syslogHeaderField(s string, n int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, s)
+	if s == "" {
+		return "-"
+	}
+	return truncate(s, n)
+}
+
+// syslogParamName returns name as a valid SD-NAME.
+func syslogParamName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, name)
+	return truncate(name, 32)
+}
+
+func writeSyslogParam(b *strings.Builder, name, value string) {
+	if value == "" {
+		return
+	}
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+	fmt.Fprintf(b, ` %s="%s"`, name, value)
+}
@@ -0,0 +1,361 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logtailer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+
+	corelogger "github.com/juju/juju/core/logger"
+)
+
+const (
+	// maxBlockRecords is the number of records of a model summarised by an
+	// index block.
+	maxBlockRecords = 512
+
+	// maxBlockSpan bounds the size of the part of the log file covered by
+	// an index block, so that the records of quiet models, interleaved
+	// with those of busy ones, don't make a block expensive to read.
+	maxBlockSpan = 4 << 20
+)
+
+// LogStore indexes the records of a log file by model, time and entity,
+// so that queries read only the parts of the file that can hold matching
+// records.
+//
+// The index is held in memory. It's brought up to date with the file
+// before every query, and rebuilt when the file is rotated.
+type LogStore struct {
+	path string
+
+	mu      sync.Mutex
+	info    os.FileInfo
+	indexed int64
+	models  map[string]*modelIndex
+}
+
+// NewLogStore returns a LogStore for the log file at the given path.
+func NewLogStore(path string) *LogStore {
+	return &LogStore{
+		path:   path,
+		models: make(map[string]*modelIndex),
+	}
+}
+
+// modelIndex holds the index blocks of a model, in file order.
+type modelIndex struct {
+	blocks []*indexBlock
+}
+
+// indexBlock summarises a run of records of a model.
+type indexBlock struct {
+	// start and end delimit the part of the file holding the records.
+	start, end int64
+
+	minTime, maxTime time.Time
+	count            int
+
+	// entities holds the number of records logged by each entity at each
+	// level.
+	entities map[string]*levelCounts
+}
+
+// levelCounts holds a number of records for each level.
+type levelCounts [corelogger.CRITICAL + 1]int
+
+// from returns the number of records at the given level or above.
+func (c *levelCounts) from(level corelogger.Level) int {
+	var n int
+	for l := level; l <= corelogger.CRITICAL; l++ {
+		n += c[l]
+	}
+	return n
+}
+
+// indexEntry holds the fields of a log record that are indexed.
+type indexEntry struct {
+	ModelUUID string    `json:"model-uuid"`
+	Time      time.Time `json:"timestamp"`
+	Entity    string    `json:"entity"`
+	Level     string    `json:"level"`
+}
+
+// span is a part of the log file, from start up to end.
+type span struct {
+	start, end int64
+}
+
+// Histogram returns the number of records matching the params at each
+// level. Where every record of an index block is known to match, the
+// counts are taken from the index rather than the file.
+func (s *LogStore) Histogram(ctx context.Context, modelUUID string, params LogTailerParams) (map[corelogger.Level]int, error) {
+	filter, err := newRecordFilter(modelUUID, params)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	type scan struct {
+		modelUUID string
+		span      span
+	}
+	var scans []scan
+	counts := make(map[corelogger.Level]int)
+
+	s.mu.Lock()
+	if err := s.update(); err != nil {
+		s.mu.Unlock()
+		return nil, errors.Trace(err)
+	}
+	for uuid, model := range s.models {
+		if !params.Firehose && uuid != modelUUID {
+			continue
+		}
+		for _, block := range model.blocks {
+			if !filter.matchBlock(block) {
+				continue
+			}
+			if filter.matchesContent() || !filter.coversBlock(block) {
+				scans = append(scans, scan{modelUUID: uuid, span: span{start: block.start, end: block.end}})
+				continue
+			}
+			for entity, entityCounts := range block.entities {
+				if !filter.includeEntityName(entity) {
+					continue
+				}
+				for level := params.MinLevel; level <= corelogger.CRITICAL; level++ {
+					if entityCounts[level] > 0 {
+						counts[level] += entityCounts[level]
+					}
+				}
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	if len(scans) == 0 {
+		return counts, nil
+	}
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, errors.Annotatef(err, "opening file %q", s.path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	for _, scan := range scans {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		// Blocks of different models may cover the same part of the
+		// file, so only the records of the block's model are counted.
+		err := readSpan(f, scan.span, func(rec corelogger.LogRecord) error {
+			if rec.ModelUUID == scan.modelUUID && filter.includeRecord(rec) {
+				counts[rec.Level]++
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return counts, nil
+}
+
+// spans returns the parts of the log file that can hold records matching
+// the filter, in file order, along with the offset up to which the file has
+// been indexed.
+func (s *LogStore) spans(filter *recordFilter) ([]span, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.update(); err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+
+	var spans []span
+	for uuid, model := range s.models {
+		if !filter.params.Firehose && uuid != filter.modelUUID {
+			continue
+		}
+		for _, block := range model.blocks {
+			if filter.matchBlock(block) {
+				spans = append(spans, span{start: block.start, end: block.end})
+			}
+		}
+	}
+	return mergeSpans(spans), s.indexed, nil
+}
+
+// update indexes any complete records written to the log file since the
+// last update. Callers must hold the lock.
+func (s *LogStore) update() error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return errors.Annotatef(err, "opening file %q", s.path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if s.info == nil || !os.SameFile(s.info, info) || info.Size() < s.indexed {
+		// The file is new, or has been rotated or truncated.
+		s.info = info
+		s.indexed = 0
+		s.models = make(map[string]*modelIndex)
+	}
+	if info.Size() == s.indexed {
+		return nil
+	}
+
+	if _, err := f.Seek(s.indexed, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	reader := bufio.NewReaderSize(f, 64*1024)
+	offset := s.indexed
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A partial line is indexed once it's complete.
+			break
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		s.indexLine(offset, line)
+		offset += int64(len(line))
+	}
+	s.indexed = offset
+	return nil
+}
+
+// indexLine adds the record on the line at the given offset to the index
+// of its model. Lines that don't hold a record are skipped.
+func (s *LogStore) indexLine(offset int64, line []byte) {
+	if len(line) <= 1 {
+		return
+	}
+	var entry indexEntry
+	if err := json.Unmarshal(line, &entry); err != nil {
+		logger.Tracef(context.Background(), "skipping log line at offset %d: %v", offset, err)
+		return
+	}
+	level, _ := corelogger.ParseLevelFromString(entry.Level)
+	end := offset + int64(len(line))
+
+	model, ok := s.models[entry.ModelUUID]
+	if !ok {
+		model = &modelIndex{}
+		s.models[entry.ModelUUID] = model
+	}
+
+	var block *indexBlock
+	if n := len(model.blocks); n > 0 {
+		last := model.blocks[n-1]
+		if last.count < maxBlockRecords && end-last.start <= maxBlockSpan {
+			block = last
+		}
+	}
+	if block == nil {
+		block = &indexBlock{
+			start:    offset,
+			minTime:  entry.Time,
+			maxTime:  entry.Time,
+			entities: make(map[string]*levelCounts),
+		}
+		model.blocks = append(model.blocks, block)
+	}
+
+	block.end = end
+	block.count++
+	if entry.Time.Before(block.minTime) {
+		block.minTime = entry.Time
+	}
+	if entry.Time.After(block.maxTime) {
+		block.maxTime = entry.Time
+	}
+	counts, ok := block.entities[entry.Entity]
+	if !ok {
+		counts = &levelCounts{}
+		block.entities[entry.Entity] = counts
+	}
+	counts[level]++
+}
+
+// matchBlock reports whether the block can hold records matching the
+// filter.
+func (f *recordFilter) matchBlock(block *indexBlock) bool {
+	if block.maxTime.Before(f.params.StartTime) {
+		return false
+	}
+	if !f.params.EndTime.IsZero() && block.minTime.After(f.params.EndTime) {
+		return false
+	}
+	for entity, counts := range block.entities {
+		if f.includeEntityName(entity) && counts.from(f.params.MinLevel) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// coversBlock reports whether every record in the block is within the time
+// range of the filter.
+func (f *recordFilter) coversBlock(block *indexBlock) bool {
+	return f.includeTime(block.minTime) && f.includeTime(block.maxTime)
+}
+
+// mergeSpans sorts the spans and joins those that overlap.
+func mergeSpans(spans []span) []span {
+	slices.SortFunc(spans, func(a, b span) int {
+		switch {
+		case a.start < b.start:
+			return -1
+		case a.start > b.start:
+			return 1
+		}
+		return 0
+	})
+	var merged []span
+	for _, sp := range spans {
+		if n := len(merged); n > 0 && sp.start <= merged[n-1].end {
+			merged[n-1].end = max(merged[n-1].end, sp.end)
+			continue
+		}
+		merged = append(merged, sp)
+	}
+	return merged
+}
+
+// readSpan calls fn with each record in the span of the file.
+func readSpan(f *os.File, sp span, fn func(corelogger.LogRecord) error) error {
+	reader := bufio.NewReaderSize(io.NewSectionReader(f, sp.start, sp.end-sp.start), 64*1024)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 1 {
+			var rec corelogger.LogRecord
+			if jsonErr := json.Unmarshal(line, &rec); jsonErr == nil {
+				if err := fn(rec); err != nil {
+					return err
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
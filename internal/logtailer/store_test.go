@@ -0,0 +1,296 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logtailer_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/logtailer"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+const (
+	modelA = "aaaaaaaa-0bad-400d-8000-4b1d0d06f00d"
+	modelB = "bbbbbbbb-0bad-400d-8000-4b1d0d06f00d"
+)
+
+type StoreSuite struct {
+	testhelpers.IsolationSuite
+
+	logFile string
+	base    time.Time
+}
+
+func TestStoreSuite(t *testing.T) {
+	tc.Run(t, &StoreSuite{})
+}
+
+func (s *StoreSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.logFile = filepath.Join(c.MkDir(), "logsink.log")
+	s.base = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *StoreSuite) TestTimeRangeAndEntity(c *tc.C) {
+	// Two models log a record a minute for five hours, alternating
+	// between a unit and a machine, with every tenth record an error.
+	s.writeMinutely(c, 5*60)
+	store := logtailer.NewLogStore(s.logFile)
+
+	records := s.collect(c, store, modelA, logtailer.LogTailerParams{
+		NoTail:        true,
+		StartTime:     s.base.Add(2 * time.Hour),
+		EndTime:       s.base.Add(3 * time.Hour),
+		MinLevel:      corelogger.ERROR,
+		IncludeEntity: []string{"unit-mysql-*"},
+	})
+	// Both ends of the range are inclusive.
+	c.Assert(records, tc.HasLen, 7)
+	for _, rec := range records {
+		c.Check(rec.ModelUUID, tc.Equals, modelA)
+		c.Check(rec.Entity, tc.Equals, "unit-mysql-0")
+		c.Check(rec.Level, tc.Equals, corelogger.ERROR)
+		c.Check(rec.Time.Before(s.base.Add(2*time.Hour)), tc.IsFalse)
+		c.Check(rec.Time.After(s.base.Add(3*time.Hour)), tc.IsFalse)
+	}
+	c.Check(records[0].Time, tc.Equals, s.base.Add(2*time.Hour))
+	c.Check(records[6].Time, tc.Equals, s.base.Add(3*time.Hour))
+}
+
+func (s *StoreSuite) TestPagination(c *tc.C) {
+	s.writeMinutely(c, 60)
+	store := logtailer.NewLogStore(s.logFile)
+
+	page := func(offset int) []corelogger.LogRecord {
+		records := s.collect(c, store, modelB, logtailer.LogTailerParams{
+			NoTail:       true,
+			FromTheStart: true,
+			Offset:       offset,
+		})
+		return records[:min(len(records), 25)]
+	}
+
+	first := page(0)
+	second := page(25)
+	third := page(50)
+	c.Assert(first, tc.HasLen, 25)
+	c.Assert(second, tc.HasLen, 25)
+	c.Assert(third, tc.HasLen, 10)
+	c.Check(first[0].Time, tc.Equals, s.base)
+	c.Check(second[0].Time, tc.Equals, s.base.Add(25*time.Minute))
+	c.Check(third[9].Time, tc.Equals, s.base.Add(59*time.Minute))
+}
+
+func (s *StoreSuite) TestBacklogWithOffset(c *tc.C) {
+	s.writeMinutely(c, 60)
+	store := logtailer.NewLogStore(s.logFile)
+
+	records := s.collect(c, store, modelA, logtailer.LogTailerParams{
+		NoTail:        true,
+		InitialLines:  3,
+		Offset:        2,
+		IncludeEntity: []string{"machine-0"},
+	})
+	c.Assert(records, tc.HasLen, 3)
+	// The machine logs every other minute; the two most recent of its
+	// records are skipped.
+	c.Check(records[0].Time, tc.Equals, s.base.Add(51*time.Minute))
+	c.Check(records[1].Time, tc.Equals, s.base.Add(53*time.Minute))
+	c.Check(records[2].Time, tc.Equals, s.base.Add(55*time.Minute))
+}
+
+func (s *StoreSuite) TestFirehose(c *tc.C) {
+	s.writeMinutely(c, 10)
+	store := logtailer.NewLogStore(s.logFile)
+
+	records := s.collect(c, store, "", logtailer.LogTailerParams{
+		NoTail:   true,
+		Firehose: true,
+		MinLevel: corelogger.ERROR,
+	})
+	c.Assert(records, tc.HasLen, 2)
+	c.Check(records[0].ModelUUID, tc.Equals, modelA)
+	c.Check(records[1].ModelUUID, tc.Equals, modelB)
+}
+
+func (s *StoreSuite) TestIndexFollowsFile(c *tc.C) {
+	s.writeMinutely(c, 10)
+	store := logtailer.NewLogStore(s.logFile)
+	params := logtailer.LogTailerParams{
+		NoTail:       true,
+		FromTheStart: true,
+	}
+	c.Assert(s.collect(c, store, modelA, params), tc.HasLen, 10)
+
+	// Records appended to the file are indexed by the next query.
+	s.writeRecords(c, corelogger.LogRecord{
+		ModelUUID: modelA,
+		Time:      s.base.Add(time.Hour),
+		Entity:    "unit-mysql-0",
+		Level:     corelogger.INFO,
+		Message:   "appended",
+	})
+	records := s.collect(c, store, modelA, params)
+	c.Assert(records, tc.HasLen, 11)
+	c.Check(records[10].Message, tc.Equals, "appended")
+
+	// When the file is rotated, the index is rebuilt from the new one.
+	c.Assert(os.Rename(s.logFile, s.logFile+".1"), tc.ErrorIsNil)
+	s.writeRecords(c, corelogger.LogRecord{
+		ModelUUID: modelA,
+		Time:      s.base.Add(2 * time.Hour),
+		Entity:    "unit-mysql-0",
+		Level:     corelogger.INFO,
+		Message:   "rotated",
+	})
+	records = s.collect(c, store, modelA, params)
+	c.Assert(records, tc.HasLen, 1)
+	c.Check(records[0].Message, tc.Equals, "rotated")
+}
+
+func (s *StoreSuite) TestTailAfterIndexedRecords(c *tc.C) {
+	s.writeMinutely(c, 10)
+	store := logtailer.NewLogStore(s.logFile)
+
+	tailer, err := logtailer.NewIndexedLogTailer(modelA, store, logtailer.LogTailerParams{
+		InitialLines: 2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, tailer)
+
+	records := s.receive(c, tailer, 2)
+	c.Check(records[1].Time, tc.Equals, s.base.Add(9*time.Minute))
+
+	s.writeRecords(c, corelogger.LogRecord{
+		ModelUUID: modelA,
+		Time:      s.base.Add(time.Hour),
+		Entity:    "unit-mysql-0",
+		Level:     corelogger.INFO,
+		Message:   "new",
+	})
+	records = s.receive(c, tailer, 1)
+	c.Check(records[0].Message, tc.Equals, "new")
+}
+
+func (s *StoreSuite) TestHistogram(c *tc.C) {
+	s.writeMinutely(c, 5*60)
+	store := logtailer.NewLogStore(s.logFile)
+
+	counts, err := store.Histogram(c.Context(), modelA, logtailer.LogTailerParams{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(counts, tc.DeepEquals, map[corelogger.Level]int{
+		corelogger.INFO:  270,
+		corelogger.ERROR: 30,
+	})
+
+	// A time range that doesn't align with the index blocks.
+	counts, err = store.Histogram(c.Context(), modelA, logtailer.LogTailerParams{
+		StartTime:     s.base.Add(2 * time.Hour),
+		EndTime:       s.base.Add(3*time.Hour - time.Second),
+		IncludeEntity: []string{"unit-mysql-0"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(counts, tc.DeepEquals, map[corelogger.Level]int{
+		corelogger.INFO:  24,
+		corelogger.ERROR: 6,
+	})
+
+	// Filtering on the content of records.
+	counts, err = store.Histogram(c.Context(), "", logtailer.LogTailerParams{
+		Firehose:      true,
+		MinLevel:      corelogger.WARNING,
+		IncludeModule: []string{"juju.worker"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(counts, tc.DeepEquals, map[corelogger.Level]int{
+		corelogger.ERROR: 60,
+	})
+}
+
+// writeMinutely writes count records a minute apart for each of two
+// models. The records alternate between a unit and a machine, and every
+// tenth is an error.
+func (s *StoreSuite) writeMinutely(c *tc.C, count int) {
+	var records []corelogger.LogRecord
+	for i := range count {
+		for _, modelUUID := range []string{modelA, modelB} {
+			rec := corelogger.LogRecord{
+				ModelUUID: modelUUID,
+				Time:      s.base.Add(time.Duration(i) * time.Minute),
+				Entity:    "unit-mysql-0",
+				Level:     corelogger.INFO,
+				Module:    "juju.worker.uniter",
+				Location:  "uniter.go:1",
+				Message:   fmt.Sprintf("record %d", i),
+			}
+			if i%2 == 1 {
+				rec.Entity = "machine-0"
+				rec.Module = "juju.api"
+			}
+			if i%10 == 0 {
+				rec.Level = corelogger.ERROR
+			}
+			records = append(records, rec)
+		}
+	}
+	s.writeRecords(c, records...)
+}
+
+func (s *StoreSuite) writeRecords(c *tc.C, records ...corelogger.LogRecord) {
+	f, err := os.OpenFile(s.logFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() {
+		_ = f.Close()
+	}()
+	encoder := json.NewEncoder(f)
+	for _, rec := range records {
+		c.Assert(encoder.Encode(rec), tc.ErrorIsNil)
+	}
+}
+
+func (s *StoreSuite) collect(c *tc.C, store *logtailer.LogStore, modelUUID string, params logtailer.LogTailerParams) []corelogger.LogRecord {
+	tailer, err := logtailer.NewIndexedLogTailer(modelUUID, store, params)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var records []corelogger.LogRecord
+	timeout := time.After(testhelpers.LongWait)
+	for {
+		select {
+		case rec, ok := <-tailer.Logs():
+			if !ok {
+				c.Assert(tailer.Wait(), tc.ErrorIsNil)
+				return records
+			}
+			records = append(records, rec)
+		case <-timeout:
+			c.Fatalf("timed out waiting for tailer to finish")
+		}
+	}
+}
+
+func (s *StoreSuite) receive(c *tc.C, tailer logtailer.LogTailer, count int) []corelogger.LogRecord {
+	var records []corelogger.LogRecord
+	timeout := time.After(testhelpers.LongWait)
+	for len(records) < count {
+		select {
+		case rec, ok := <-tailer.Logs():
+			if !ok {
+				c.Fatalf("tailer died unexpectedly: %v", tailer.Wait())
+			}
+			records = append(records, rec)
+		case <-timeout:
+			c.Fatalf("timed out waiting for logs (received %d)", len(records))
+		}
+	}
+	return records
+}
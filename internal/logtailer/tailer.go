@@ -56,6 +56,14 @@ type LogTailerParams struct {
 	IncludeLabels map[string]string
 	ExcludeLabels map[string]string
 	FromTheStart  bool
+
+	// EndTime, if set, excludes records logged after it.
+	EndTime time.Time
+
+	// Offset skips this many matching records before any are returned. It
+	// counts from the oldest record when reading forward, and from the most
+	// recent one when returning the last InitialLines records.
+	Offset int
 }
 
 // maxInitialLines limits the number of documents we will load into memory
@@ -68,12 +76,36 @@ func NewLogTailer(
 	modelUUID string,
 	logFile string, params LogTailerParams,
 ) (LogTailer, error) {
+	return newLogTailer(modelUUID, logFile, nil, params)
+}
+
+// NewIndexedLogTailer returns a LogTailer which uses the index of the store
+// to read only the parts of its log file that can hold matching records,
+// before following the file for new ones.
+func NewIndexedLogTailer(
+	modelUUID string,
+	store *LogStore, params LogTailerParams,
+) (LogTailer, error) {
+	return newLogTailer(modelUUID, store.path, store, params)
+}
+
+func newLogTailer(
+	modelUUID string,
+	logFile string, store *LogStore, params LogTailerParams,
+) (LogTailer, error) {
+	filter, err := newRecordFilter(modelUUID, params)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	t := &logTailer{
 		modelUUID:       modelUUID,
 		params:          params,
+		filter:          filter,
+		skip:            params.Offset,
 		logCh:           make(chan corelogger.LogRecord),
 		maxInitialLines: maxInitialLines,
 		logFile:         logFile,
+		store:           store,
 	}
 	t.tomb.Go(func() error {
 		defer close(t.logCh)
@@ -87,11 +119,14 @@ type logTailer struct {
 	tomb            tomb.Tomb
 	modelUUID       string
 	params          LogTailerParams
+	filter          *recordFilter
+	skip            int
 	logCh           chan corelogger.LogRecord
 	lastTime        time.Time
 	maxInitialLines int
 
 	logFile string
+	store   *LogStore
 }
 
 // Logs implements the LogTailer interface.
@@ -115,6 +150,17 @@ func (t *logTailer) Wait() error {
 }
 
 func (t *logTailer) loop() error {
+	if t.store != nil {
+		seekOffset, err := t.processIndexed()
+		if err != nil {
+			return err
+		}
+		return t.tailFile(&tail.SeekInfo{
+			Offset: seekOffset,
+			Whence: io.SeekStart,
+		})
+	}
+
 	var seekTo *tail.SeekInfo
 	if t.params.InitialLines > 0 && !t.params.FromTheStart {
 		seekOffset, err := t.processInitialLines()
@@ -130,9 +176,8 @@ func (t *logTailer) loop() error {
 }
 
 func (t *logTailer) processInitialLines() (int64, error) {
-	if t.params.InitialLines > t.maxInitialLines {
-		return -1, errors.Errorf("too many lines requested (%d) maximum is %d",
-			t.params.InitialLines, maxInitialLines)
+	if err := t.checkInitialLines(); err != nil {
+		return -1, err
 	}
 
 	f, err := os.Open(t.logFile)
@@ -176,7 +221,7 @@ func (t *logTailer) processInitialLines() (int64, error) {
 		}
 		failures = 0
 
-		if !t.includeRecord(rec) {
+		if !t.filter.includeRecord(rec) {
 			continue
 		}
 		select {
@@ -184,6 +229,10 @@ func (t *logTailer) processInitialLines() (int64, error) {
 			return -1, tomb.ErrDying
 		default:
 		}
+		if t.skip > 0 {
+			t.skip--
+			continue
+		}
 		cur--
 		queue[cur] = rec
 		if cur == 0 {
@@ -201,14 +250,144 @@ func (t *logTailer) processInitialLines() (int64, error) {
 	// contents, and then return them in the correct order.
 	queue = queue[cur:]
 	for _, rec := range queue {
+		if err := t.send(rec); err != nil {
+			return -1, err
+		}
+	}
+	// The offset only applies to the records already logged.
+	t.skip = 0
+	return seekTo, nil
+}
+
+// processIndexed returns the matching records already logged, reading only
+// the parts of the log file the store's index selects. It returns the
+// offset from which the file should be tailed for new records.
+func (t *logTailer) processIndexed() (int64, error) {
+	backlog := t.params.InitialLines > 0 && !t.params.FromTheStart
+	if backlog {
+		if err := t.checkInitialLines(); err != nil {
+			return -1, err
+		}
+	}
+
+	spans, seekTo, err := t.store.spans(t.filter)
+	if err != nil {
+		return -1, errors.Trace(err)
+	}
+
+	f, err := os.Open(t.logFile)
+	if err != nil {
+		return -1, errors.Annotatef(err, "opening file %q", t.logFile)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	if backlog {
+		err = t.processIndexedBacklog(f, spans)
+	} else {
+		err = t.processIndexedForward(f, spans)
+	}
+	if err != nil {
+		return -1, err
+	}
+	// The offset only applies to the records already logged.
+	t.skip = 0
+	return seekTo, nil
+}
+
+func (t *logTailer) processIndexedForward(f *os.File, spans []span) error {
+	for _, sp := range spans {
+		err := readSpan(f, sp, func(rec corelogger.LogRecord) error {
+			if !t.filter.includeRecord(rec) {
+				return nil
+			}
+			return t.send(rec)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processIndexedBacklog returns the last InitialLines matching records,
+// reading spans from the end of the file until enough have been found.
+func (t *logTailer) processIndexedBacklog(f *os.File, spans []span) error {
+	need := t.params.InitialLines + t.skip
+
+	var (
+		found [][]corelogger.LogRecord
+		total int
+	)
+	for i := len(spans) - 1; i >= 0 && total < need; i-- {
 		select {
 		case <-t.tomb.Dying():
-			return -1, tomb.ErrDying
-		case t.logCh <- rec:
-			t.lastTime = rec.Time
+			return tomb.ErrDying
+		default:
+		}
+
+		// Only the last matching records of the span are kept.
+		keep := need - total
+		var matched []corelogger.LogRecord
+		err := readSpan(f, spans[i], func(rec corelogger.LogRecord) error {
+			if !t.filter.includeRecord(rec) {
+				return nil
+			}
+			matched = append(matched, rec)
+			if len(matched) > keep {
+				matched = matched[1:]
+			}
+			return nil
+		})
+		if err != nil {
+			return errors.Trace(err)
 		}
+		found = append(found, matched)
+		total += len(matched)
 	}
-	return seekTo, nil
+
+	// The spans were read from the end of the file, so return the records
+	// of the last one read first, leaving out the most recent ones to be
+	// skipped.
+	remaining := total - t.skip
+	t.skip = 0
+	for i := len(found) - 1; i >= 0 && remaining > 0; i-- {
+		for _, rec := range found[i] {
+			if remaining == 0 {
+				break
+			}
+			if err := t.send(rec); err != nil {
+				return err
+			}
+			remaining--
+		}
+	}
+	return nil
+}
+
+func (t *logTailer) checkInitialLines() error {
+	if t.params.InitialLines > t.maxInitialLines {
+		return errors.Errorf("too many lines requested (%d) maximum is %d",
+			t.params.InitialLines, maxInitialLines)
+	}
+	return nil
+}
+
+// send returns the record to the client, unless it's one of those to be
+// skipped.
+func (t *logTailer) send(rec corelogger.LogRecord) error {
+	if t.skip > 0 {
+		t.skip--
+		return nil
+	}
+	select {
+	case <-t.tomb.Dying():
+		return tomb.ErrDying
+	case t.logCh <- rec:
+		t.lastTime = rec.Time
+	}
+	return nil
 }
 
 func (t *logTailer) tailFile(seekTo *tail.SeekInfo) (err error) {
@@ -269,80 +448,122 @@ func (t *logTailer) tailFile(seekTo *tail.SeekInfo) (err error) {
 			}
 			failures = 0
 
-			if !t.includeRecord(rec) {
+			if !t.filter.includeRecord(rec) {
 				continue
 			}
-			select {
-			case <-t.tomb.Dying():
-				return tomb.ErrDying
-			case t.logCh <- rec:
+			if err := t.send(rec); err != nil {
+				return err
 			}
 		}
 	}
 }
 
-func (t *logTailer) includeRecord(rec corelogger.LogRecord) bool {
+// recordFilter decides which records are returned by a tailer. The entity
+// and module patterns are compiled once, rather than for every record.
+type recordFilter struct {
+	modelUUID string
+	params    LogTailerParams
+
+	includeEntity *regexp.Regexp
+	excludeEntity *regexp.Regexp
+	includeModule *regexp.Regexp
+	excludeModule *regexp.Regexp
+}
+
+func newRecordFilter(modelUUID string, params LogTailerParams) (*recordFilter, error) {
+	f := &recordFilter{
+		modelUUID: modelUUID,
+		params:    params,
+	}
+	var err error
+	if len(params.IncludeEntity) > 0 {
+		if f.includeEntity, err = regexp.Compile(makeEntityPattern(params.IncludeEntity)); err != nil {
+			return nil, errors.Annotate(err, "compiling include entity pattern")
+		}
+	}
+	if len(params.ExcludeEntity) > 0 {
+		if f.excludeEntity, err = regexp.Compile(makeEntityPattern(params.ExcludeEntity)); err != nil {
+			return nil, errors.Annotate(err, "compiling exclude entity pattern")
+		}
+	}
+	if len(params.IncludeModule) > 0 {
+		if f.includeModule, err = regexp.Compile(makeModulePattern(params.IncludeModule)); err != nil {
+			return nil, errors.Annotate(err, "compiling include module pattern")
+		}
+	}
+	if len(params.ExcludeModule) > 0 {
+		if f.excludeModule, err = regexp.Compile(makeModulePattern(params.ExcludeModule)); err != nil {
+			return nil, errors.Annotate(err, "compiling exclude module pattern")
+		}
+	}
+	return f, nil
+}
+
+func (f *recordFilter) includeRecord(rec corelogger.LogRecord) bool {
 	// If it's not firehose we need to check the model UUID.
-	if !t.params.Firehose && rec.ModelUUID != t.modelUUID {
+	if !f.params.Firehose && rec.ModelUUID != f.modelUUID {
 		return false
 	}
-	if rec.Time.Before(t.params.StartTime) {
+	if !f.includeTime(rec.Time) {
 		return false
 	}
-	if rec.Level < t.params.MinLevel {
+	if rec.Level < f.params.MinLevel {
 		return false
 	}
-	if len(t.params.IncludeEntity) > 0 {
-		match, err := regexp.MatchString(makeEntityPattern(t.params.IncludeEntity), rec.Entity)
-		if !match || err != nil {
-			return false
-		}
+	if !f.includeEntityName(rec.Entity) {
+		return false
 	}
-	if len(t.params.ExcludeEntity) > 0 {
-		match, err := regexp.MatchString(makeEntityPattern(t.params.ExcludeEntity), rec.Entity)
-		if match || err != nil {
-			return false
-		}
+	if f.includeModule != nil && !f.includeModule.MatchString(rec.Module) {
+		return false
 	}
-	if len(t.params.IncludeModule) > 0 {
-		match, err := regexp.MatchString(makeModulePattern(t.params.IncludeModule), rec.Module)
-		if !match || err != nil {
-			return false
-		}
+	if f.excludeModule != nil && f.excludeModule.MatchString(rec.Module) {
+		return false
 	}
-	if len(t.params.ExcludeModule) > 0 {
-		match, err := regexp.MatchString(makeModulePattern(t.params.ExcludeModule), rec.Module)
-		if match || err != nil {
-			return false
-		}
+	if len(f.params.IncludeLabels) > 0 && !anyLabelMatches(f.params.IncludeLabels, rec.Labels) {
+		return false
 	}
-	if len(t.params.IncludeLabels) > 0 {
-		anyMatch := false
-		for k, v := range t.params.IncludeLabels {
-			if val, ok := rec.Labels[k]; ok && v == val {
-				anyMatch = true
-				break
-			}
-		}
-		if !anyMatch {
-			return false
-		}
+	if len(f.params.ExcludeLabels) > 0 && anyLabelMatches(f.params.ExcludeLabels, rec.Labels) {
+		return false
 	}
-	if len(t.params.ExcludeLabels) > 0 {
-		anyMatch := false
-		for k, v := range t.params.IncludeLabels {
-			if val, ok := rec.Labels[k]; ok && v == val {
-				anyMatch = true
-				break
-			}
-		}
-		if anyMatch {
-			return false
-		}
+	return true
+}
+
+func (f *recordFilter) includeTime(t time.Time) bool {
+	if t.Before(f.params.StartTime) {
+		return false
+	}
+	if !f.params.EndTime.IsZero() && t.After(f.params.EndTime) {
+		return false
+	}
+	return true
+}
+
+func (f *recordFilter) includeEntityName(entity string) bool {
+	if f.includeEntity != nil && !f.includeEntity.MatchString(entity) {
+		return false
+	}
+	if f.excludeEntity != nil && f.excludeEntity.MatchString(entity) {
+		return false
 	}
 	return true
 }
 
+// matchesContent reports whether the filter looks at more of a record than
+// its model, time, level and entity, which are all the index records.
+func (f *recordFilter) matchesContent() bool {
+	return f.includeModule != nil || f.excludeModule != nil ||
+		len(f.params.IncludeLabels) > 0 || len(f.params.ExcludeLabels) > 0
+}
+
+func anyLabelMatches(want, labels map[string]string) bool {
+	for k, v := range want {
+		if val, ok := labels[k]; ok && v == val {
+			return true
+		}
+	}
+	return false
+}
+
 func makeEntityPattern(entities []string) string {
 	var patterns []string
 	for _, entity := range entities {
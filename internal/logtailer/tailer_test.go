@@ -444,6 +444,48 @@ func (s *LogFilterSuite) TestIncludeExcludeModule(c *tc.C) {
 	s.checkLogTailerFiltering(c, params, writeLogs, assert)
 }
 
+func (s *LogFilterSuite) TestExcludeLabels(c *tc.C) {
+	prod := &corelogger.LogRecord{Labels: map[string]string{"env": "prod"}}
+	dev := &corelogger.LogRecord{Labels: map[string]string{"env": "dev"}}
+	unlabelled := &corelogger.LogRecord{}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, prod)
+		s.writeLogs(c, logFile, 1, dev)
+		s.writeLogs(c, logFile, 1, unlabelled)
+		s.writeLogs(c, logFile, 1, dev)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		ExcludeLabels: map[string]string{"env": "dev"},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, prod, unlabelled)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
+func (s *LogFilterSuite) TestIncludeExcludeLabels(c *tc.C) {
+	foo := &corelogger.LogRecord{Labels: map[string]string{"app": "foo"}}
+	bar := &corelogger.LogRecord{Labels: map[string]string{"app": "bar"}}
+	barDev := &corelogger.LogRecord{Labels: map[string]string{"app": "bar", "env": "dev"}}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, foo)
+		s.writeLogs(c, logFile, 1, bar)
+		s.writeLogs(c, logFile, 1, barDev)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		IncludeLabels: map[string]string{"app": "bar"},
+		ExcludeLabels: map[string]string{"env": "dev"},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, bar)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
 func (s *LogFilterSuite) checkLogTailerFiltering(
 	c *tc.C,
 	params logtailer.LogTailerParams,
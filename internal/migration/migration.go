@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/domain/modeldefaults"
 	migrations "github.com/juju/juju/domain/modelmigration"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	internalerrors "github.com/juju/juju/internal/errors"
@@ -59,6 +60,7 @@ type ConfigSchemaSourceProvider = func(environs.CloudService) config.ConfigSchem
 type ModelImporter struct {
 	domainServices        services.DomainServicesGetter
 	storageRegistryGetter corestorage.ModelStorageRegistryGetter
+	secretEncryptionKeys  secretbackendservice.KeyStore
 
 	controllerUUID string
 	scope          modelmigration.ScopeForModel
@@ -73,6 +75,7 @@ func NewModelImporter(
 	scope modelmigration.ScopeForModel,
 	domainServices services.DomainServicesGetter,
 	storageRegistryGetter corestorage.ModelStorageRegistryGetter,
+	secretEncryptionKeys secretbackendservice.KeyStore,
 	controllerUUID string,
 	logger corelogger.Logger,
 	clock clock.Clock,
@@ -82,6 +85,7 @@ func NewModelImporter(
 		controllerUUID:        controllerUUID,
 		domainServices:        domainServices,
 		storageRegistryGetter: storageRegistryGetter,
+		secretEncryptionKeys:  secretEncryptionKeys,
 		logger:                logger,
 		clock:                 clock,
 	}
@@ -118,6 +122,7 @@ func (i *ModelImporter) ImportModel(ctx context.Context, bytes []byte) error {
 		modelDefaultsProvider,
 		i.storageRegistryGetter,
 		configGetter,
+		i.secretEncryptionKeys,
 		i.clock,
 		i.logger)
 	if err := coordinator.Perform(ctx, i.scope(modelUUID), model); err != nil {
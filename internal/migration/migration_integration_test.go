@@ -15,6 +15,7 @@ import (
 	corestorage "github.com/juju/juju/core/storage"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/storage"
 	jujutesting "github.com/juju/juju/internal/testing"
 )
@@ -55,6 +56,7 @@ func (s *ExportImportSuite) exportImport(c *tc.C, leaders map[string]string) {
 		corestorage.ConstModelStorageRegistry(func() storage.ProviderRegistry {
 			return &storage.StaticProviderRegistry{}
 		}),
+		encryption.NewFileKeyStore(c.MkDir()),
 		"controller-uuid",
 		loggertesting.WrapCheckLog(c),
 		clock.WallClock,
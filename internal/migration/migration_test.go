@@ -28,6 +28,7 @@ import (
 	"github.com/juju/juju/domain/deployment/charm"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/secrets/encryption"
 	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/tools"
@@ -62,6 +63,7 @@ func (s *ImportSuite) TestBadBytes(c *tc.C) {
 		corestorage.ConstModelStorageRegistry(func() storage.ProviderRegistry {
 			return nil
 		}),
+		encryption.NewFileKeyStore(c.MkDir()),
 		"controller-uuid",
 		loggertesting.WrapCheckLog(c),
 		clock.WallClock,
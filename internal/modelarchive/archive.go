@@ -0,0 +1,334 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package modelarchive reads and writes portable model archives. A model
+// archive is a gzipped tarball holding a manifest, the model export produced
+// by the export domain, and every object store blob referenced by the
+// export. Blobs are stored by their SHA384 hash, so that an object referenced
+// by several paths is only stored once.
+package modelarchive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// FormatVersion is the version of the archive layout written by this
+	// package.
+	FormatVersion = 1
+
+	// ManifestFile is the name of the manifest within the archive.
+	ManifestFile = "manifest.yaml"
+
+	// ModelFile is the name of the model export within the archive.
+	ModelFile = "model.yaml"
+
+	// ObjectsDir is the directory holding object store blobs within the
+	// archive.
+	ObjectsDir = "objects"
+)
+
+// Manifest describes the contents of a model archive.
+type Manifest struct {
+	// FormatVersion is the version of the archive layout.
+	FormatVersion int `yaml:"format-version"`
+
+	// ExportVersion is the version of the model export held in the archive.
+	ExportVersion string `yaml:"export-version"`
+
+	// ModelUUID is the UUID of the exported model.
+	ModelUUID string `yaml:"model-uuid"`
+
+	// Created is the time the archive was written.
+	Created time.Time `yaml:"created"`
+
+	// Objects describes the object store blobs held in the archive.
+	Objects []Object `yaml:"objects,omitempty"`
+}
+
+// Object describes an object store blob held in a model archive.
+type Object struct {
+	// Path is the object store path of the blob.
+	Path string `yaml:"path"`
+
+	// SHA256 is the hex encoded SHA256 hash of the blob.
+	SHA256 string `yaml:"sha256"`
+
+	// SHA384 is the hex encoded SHA384 hash of the blob. It is also the name
+	// of the blob within the objects directory.
+	SHA384 string `yaml:"sha384"`
+
+	// Size is the size of the blob in bytes.
+	Size int64 `yaml:"size"`
+}
+
+// ObjectGetter provides read access to the objects referenced by a model
+// export.
+type ObjectGetter interface {
+	// Get returns a reader for the object at the given path.
+	Get(context.Context, string) (io.ReadCloser, objectstore.Digest, error)
+}
+
+// Write writes a model archive for the given model export to w. Every object
+// referenced by the export is read from the object getter and checked
+// against the hashes recorded in the export.
+func Write(
+	ctx context.Context,
+	w io.Writer,
+	modelUUID string,
+	export *domainexport.ModelExport,
+	objects ObjectGetter,
+	now time.Time,
+) error {
+	model, err := yaml.Marshal(export)
+	if err != nil {
+		return errors.Errorf("marshalling model export: %w", err)
+	}
+	referenced, err := exportObjects(model)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	manifest, err := yaml.Marshal(Manifest{
+		FormatVersion: FormatVersion,
+		ExportVersion: export.Version,
+		ModelUUID:     modelUUID,
+		Created:       now.UTC(),
+		Objects:       referenced,
+	})
+	if err != nil {
+		return errors.Errorf("marshalling manifest: %w", err)
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	if err := writeFile(tw, ManifestFile, manifest, now); err != nil {
+		return errors.Capture(err)
+	}
+	if err := writeFile(tw, ModelFile, model, now); err != nil {
+		return errors.Capture(err)
+	}
+
+	written := make(map[string]bool)
+	for _, obj := range referenced {
+		if written[obj.SHA384] {
+			continue
+		}
+		if err := writeObject(ctx, tw, obj, objects, now); err != nil {
+			return errors.Errorf("writing object %q: %w", obj.Path, err)
+		}
+		written[obj.SHA384] = true
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(gzw.Close())
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte, now time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: now,
+	}); err != nil {
+		return errors.Errorf("writing %s header: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
+
+func writeObject(ctx context.Context, tw *tar.Writer, obj Object, objects ObjectGetter, now time.Time) error {
+	reader, _, err := objects.Get(ctx, obj.Path)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer reader.Close()
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    path.Join(ObjectsDir, obj.SHA384),
+		Mode:    0600,
+		Size:    obj.Size,
+		ModTime: now,
+	}); err != nil {
+		return errors.Capture(err)
+	}
+
+	// The tar writer refuses to write more than the declared size, so only
+	// a short read needs to be checked for explicitly.
+	hasher := sha512.New384()
+	n, err := io.Copy(tw, io.TeeReader(io.LimitReader(reader, obj.Size), hasher))
+	if err != nil {
+		return errors.Capture(err)
+	} else if n != obj.Size {
+		return errors.Errorf("expected %d bytes, read %d", obj.Size, n)
+	}
+	if sum := hex.EncodeToString(hasher.Sum(nil)); sum != obj.SHA384 {
+		return errors.Errorf("expected sha384 %q, got %q", obj.SHA384, sum)
+	}
+	return nil
+}
+
+// exportObjects returns the objects referenced by the serialised model
+// export, sorted by path. The object store tables share the same layout
+// across export versions, so they are read without knowledge of the
+// version specific payload type.
+func exportObjects(model []byte) ([]Object, error) {
+	var doc struct {
+		Payload struct {
+			Metadata []struct {
+				UUID   string `yaml:"uuid"`
+				SHA256 string `yaml:"sha_256"`
+				SHA384 string `yaml:"sha_384"`
+				Size   int64  `yaml:"size"`
+			} `yaml:"object_store_metadata"`
+			Paths []struct {
+				Path         string `yaml:"path"`
+				MetadataUUID string `yaml:"metadata_uuid"`
+			} `yaml:"object_store_metadata_path"`
+		} `yaml:"payload"`
+	}
+	if err := yaml.Unmarshal(model, &doc); err != nil {
+		return nil, errors.Errorf("reading object store metadata from model export: %w", err)
+	}
+
+	metadata := make(map[string]Object)
+	for _, m := range doc.Payload.Metadata {
+		metadata[m.UUID] = Object{
+			SHA256: m.SHA256,
+			SHA384: m.SHA384,
+			Size:   m.Size,
+		}
+	}
+	var objects []Object
+	for _, p := range doc.Payload.Paths {
+		obj, ok := metadata[p.MetadataUUID]
+		if !ok {
+			return nil, errors.Errorf(
+				"object store path %q references unknown metadata %q %w",
+				p.Path, p.MetadataUUID, coreerrors.NotValid)
+		}
+		obj.Path = p.Path
+		objects = append(objects, obj)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Path < objects[j].Path
+	})
+	return objects, nil
+}
+
+// Verify reads the model archive from r in full, checking that it is
+// complete and that every blob matches the hashes recorded in the manifest.
+// The manifest of the archive is returned.
+// The following errors may be returned:
+// - [coreerrors.NotSupported] when the archive format is not supported.
+// - [coreerrors.NotValid] when the archive is incomplete or corrupt.
+func Verify(r io.Reader) (*Manifest, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Errorf("uncompressing model archive: %w", err).Add(coreerrors.NotValid)
+	}
+	defer func() { _ = gzr.Close() }()
+	tr := tar.NewReader(gzr)
+
+	manifest, err := readManifest(tr)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	expected := make(map[string]Object)
+	for _, obj := range manifest.Objects {
+		expected[path.Join(ObjectsDir, obj.SHA384)] = obj
+	}
+	var haveModel bool
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Errorf("reading model archive: %w", err).Add(coreerrors.NotValid)
+		}
+
+		if hdr.Name == ModelFile {
+			haveModel = true
+			continue
+		}
+		obj, ok := expected[hdr.Name]
+		if !ok {
+			return nil, errors.Errorf("unexpected file %q in model archive %w", hdr.Name, coreerrors.NotValid)
+		}
+		if err := verifyObject(tr, obj); err != nil {
+			return nil, errors.Errorf("object %q: %w", obj.Path, err)
+		}
+		delete(expected, hdr.Name)
+	}
+
+	if !haveModel {
+		return nil, errors.Errorf("model archive missing %s %w", ModelFile, coreerrors.NotValid)
+	}
+	for _, obj := range expected {
+		return nil, errors.Errorf("model archive missing object %q %w", obj.Path, coreerrors.NotValid)
+	}
+	return manifest, nil
+}
+
+func readManifest(tr *tar.Reader) (*Manifest, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, errors.Errorf("reading model archive: %w", err)
+	}
+	if hdr.Name != ManifestFile {
+		return nil, errors.Errorf("model archive does not start with %s %w", ManifestFile, coreerrors.NotValid)
+	}
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, errors.Errorf("reading %s: %w", ManifestFile, err)
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, errors.Errorf("parsing %s: %w", ManifestFile, err)
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return nil, errors.Errorf("model archive format %d %w", manifest.FormatVersion, coreerrors.NotSupported)
+	}
+	return &manifest, nil
+}
+
+func verifyObject(r io.Reader, obj Object) error {
+	sha256Hasher := sha256.New()
+	sha384Hasher := sha512.New384()
+	n, err := io.Copy(io.MultiWriter(sha256Hasher, sha384Hasher), r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if n != obj.Size {
+		return errors.Errorf("expected %d bytes, found %d %w", obj.Size, n, coreerrors.NotValid)
+	}
+	if sum := hex.EncodeToString(sha256Hasher.Sum(nil)); sum != obj.SHA256 {
+		return errors.Errorf("sha256 mismatch %w", coreerrors.NotValid)
+	}
+	if sum := hex.EncodeToString(sha384Hasher.Sum(nil)); sum != obj.SHA384 {
+		return errors.Errorf("sha384 mismatch %w", coreerrors.NotValid)
+	}
+	return nil
+}
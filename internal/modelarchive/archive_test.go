@@ -0,0 +1,199 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelarchive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/domain/export/types/v4_0_4"
+	"github.com/juju/juju/internal/modelarchive"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type archiveSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestArchiveSuite(t *stdtesting.T) {
+	tc.Run(t, &archiveSuite{})
+}
+
+type fakeObjects map[string][]byte
+
+func (f fakeObjects) Get(_ context.Context, path string) (io.ReadCloser, objectstore.Digest, error) {
+	data, ok := f[path]
+	if !ok {
+		return nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), objectstore.Digest{Size: int64(len(data))}, nil
+}
+
+func digest(data []byte) (string, string) {
+	sum256 := sha256.Sum256(data)
+	sum384 := sha512.Sum384(data)
+	return hex.EncodeToString(sum256[:]), hex.EncodeToString(sum384[:])
+}
+
+func (s *archiveSuite) newExport() (*domainexport.ModelExport, fakeObjects) {
+	charm := []byte("charm archive")
+	charm256, charm384 := digest(charm)
+	tools := []byte("agent binary")
+	tools256, tools384 := digest(tools)
+
+	return &domainexport.ModelExport{
+		Version: "4.0.4",
+		Payload: &v4_0_4.ModelExport{
+			ObjectStoreMetadata: []v4_0_4.ObjectStoreMetadata{{
+				UUID:   "charm-uuid",
+				Sha256: charm256,
+				Sha384: charm384,
+				Size:   int64(len(charm)),
+			}, {
+				UUID:   "tools-uuid",
+				Sha256: tools256,
+				Sha384: tools384,
+				Size:   int64(len(tools)),
+			}},
+			ObjectStoreMetadataPath: []v4_0_4.ObjectStoreMetadataPath{{
+				Path:         "charms/foo",
+				MetadataUUID: "charm-uuid",
+			}, {
+				Path:         "charms/foo-alias",
+				MetadataUUID: "charm-uuid",
+			}, {
+				Path:         "tools/4.0.4-ubuntu-amd64",
+				MetadataUUID: "tools-uuid",
+			}},
+		},
+	}, fakeObjects{
+		"charms/foo":               charm,
+		"charms/foo-alias":         charm,
+		"tools/4.0.4-ubuntu-amd64": tools,
+	}
+}
+
+func (s *archiveSuite) TestWriteAndVerify(c *tc.C) {
+	export, objects := s.newExport()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	manifest, err := modelarchive.Verify(&buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(manifest.FormatVersion, tc.Equals, modelarchive.FormatVersion)
+	c.Check(manifest.ExportVersion, tc.Equals, "4.0.4")
+	c.Check(manifest.ModelUUID, tc.Equals, "model-uuid")
+	c.Check(manifest.Created.Equal(now), tc.IsTrue)
+	c.Assert(manifest.Objects, tc.HasLen, 3)
+	c.Check(manifest.Objects[0].Path, tc.Equals, "charms/foo")
+	c.Check(manifest.Objects[1].Path, tc.Equals, "charms/foo-alias")
+	c.Check(manifest.Objects[1].SHA384, tc.Equals, manifest.Objects[0].SHA384)
+	c.Check(manifest.Objects[2].Path, tc.Equals, "tools/4.0.4-ubuntu-amd64")
+}
+
+func (s *archiveSuite) TestWriteStoresSharedBlobOnce(c *tc.C) {
+	export, objects := s.newExport()
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	gzr, err := gzip.NewReader(&buf)
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		names = append(names, hdr.Name)
+	}
+	c.Check(names, tc.HasLen, 4)
+	c.Check(names[0], tc.Equals, modelarchive.ManifestFile)
+	c.Check(names[1], tc.Equals, modelarchive.ModelFile)
+}
+
+func (s *archiveSuite) TestWriteCorruptObject(c *tc.C) {
+	export, objects := s.newExport()
+	objects["tools/4.0.4-ubuntu-amd64"] = []byte("agent binarx")
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, time.Now())
+	c.Check(err, tc.ErrorMatches, `writing object "tools/4.0.4-ubuntu-amd64": expected sha384 .*`)
+}
+
+func (s *archiveSuite) TestWriteMissingObject(c *tc.C) {
+	export, objects := s.newExport()
+	delete(objects, "charms/foo")
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, time.Now())
+	c.Check(err, tc.ErrorIs, objectstoreerrors.ObjectNotFound)
+}
+
+func (s *archiveSuite) TestVerifyTruncated(c *tc.C) {
+	export, objects := s.newExport()
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Rewrite the archive without its last object.
+	gzr, err := gzip.NewReader(&buf)
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+
+	var truncated bytes.Buffer
+	gzw := gzip.NewWriter(&truncated)
+	tw := tar.NewWriter(gzw)
+	for i := 0; i < 3; i++ {
+		hdr, err := tr.Next()
+		c.Assert(err, tc.ErrorIsNil)
+		err = tw.WriteHeader(hdr)
+		c.Assert(err, tc.ErrorIsNil)
+		_, err = io.Copy(tw, tr)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	c.Assert(tw.Close(), tc.ErrorIsNil)
+	c.Assert(gzw.Close(), tc.ErrorIsNil)
+
+	_, err = modelarchive.Verify(&truncated)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `model archive missing object .*`)
+}
+
+func (s *archiveSuite) TestVerifyUnsupportedFormat(c *tc.C) {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	manifest := []byte("format-version: 99\n")
+	err := tw.WriteHeader(&tar.Header{Name: modelarchive.ManifestFile, Mode: 0600, Size: int64(len(manifest))})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = tw.Write(manifest)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(tw.Close(), tc.ErrorIsNil)
+	c.Assert(gzw.Close(), tc.ErrorIsNil)
+
+	_, err = modelarchive.Verify(&buf)
+	c.Check(err, tc.ErrorIs, coreerrors.NotSupported)
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelarchive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"path"
+
+	"gopkg.in/yaml.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Model describes the model held in a model archive.
+type Model struct {
+	// UUID is the UUID of the model.
+	UUID string
+
+	// Name is the name of the model.
+	Name string
+
+	// Qualifier disambiguates the model name.
+	Qualifier string
+
+	// Cloud is the name of the cloud hosting the model.
+	Cloud string
+
+	// CloudRegion is the name of the cloud region hosting the model. It is
+	// empty when the model is not hosted in a region.
+	CloudRegion string
+
+	// CredentialOwner is the owner of the cloud credential used by the
+	// model. It is empty when the model has no credential.
+	CredentialOwner string
+
+	// CredentialName is the name of the cloud credential used by the model.
+	// It is empty when the model has no credential.
+	CredentialName string
+
+	// IsControllerModel is true when the model is the model hosting a
+	// controller.
+	IsControllerModel bool
+}
+
+// Reader reads a model archive written by Write, in the order in which it
+// was written. The archive is not verified as it is read, so it should be
+// checked with Verify first.
+type Reader struct {
+	gzr *gzip.Reader
+	tr  *tar.Reader
+
+	manifest *Manifest
+	export   []byte
+	model    Model
+	objects  map[string]Object
+}
+
+// NewReader returns a Reader for the model archive read from r, having read
+// the manifest and the model export from the start of the archive.
+// The following errors may be returned:
+// - [coreerrors.NotSupported] when the archive format is not supported.
+// - [coreerrors.NotValid] when the archive is not laid out as written by
+// Write, or does not describe exactly one model.
+func NewReader(r io.Reader) (*Reader, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Errorf("uncompressing model archive: %w", err).Add(coreerrors.NotValid)
+	}
+	tr := tar.NewReader(gzr)
+
+	manifest, err := readManifest(tr)
+	if err != nil {
+		_ = gzr.Close()
+		return nil, errors.Capture(err)
+	}
+
+	hdr, err := tr.Next()
+	if err != nil {
+		_ = gzr.Close()
+		return nil, errors.Errorf("reading model archive: %w", err).Add(coreerrors.NotValid)
+	} else if hdr.Name != ModelFile {
+		_ = gzr.Close()
+		return nil, errors.Errorf("model archive missing %s after %s %w", ModelFile, ManifestFile, coreerrors.NotValid)
+	}
+	export, err := io.ReadAll(tr)
+	if err != nil {
+		_ = gzr.Close()
+		return nil, errors.Errorf("reading %s: %w", ModelFile, err)
+	}
+	model, err := exportModel(export)
+	if err != nil {
+		_ = gzr.Close()
+		return nil, errors.Capture(err)
+	}
+	if model.UUID != manifest.ModelUUID {
+		_ = gzr.Close()
+		return nil, errors.Errorf(
+			"model archive manifest is for model %q, export for model %q %w",
+			manifest.ModelUUID, model.UUID, coreerrors.NotValid)
+	}
+
+	objects := make(map[string]Object)
+	for _, obj := range manifest.Objects {
+		name := path.Join(ObjectsDir, obj.SHA384)
+		if _, ok := objects[name]; !ok {
+			objects[name] = obj
+		}
+	}
+	return &Reader{
+		gzr:      gzr,
+		tr:       tr,
+		manifest: manifest,
+		export:   export,
+		model:    model,
+		objects:  objects,
+	}, nil
+}
+
+// Manifest returns the manifest of the archive.
+func (r *Reader) Manifest() *Manifest {
+	return r.manifest
+}
+
+// Model returns the description of the model held in the archive.
+func (r *Reader) Model() Model {
+	return r.model
+}
+
+// ModelExport returns the serialised model export held in the archive.
+func (r *Reader) ModelExport() []byte {
+	return r.export
+}
+
+// NextObject advances to the next object store blob in the archive. It
+// returns the description of the blob, as recorded for the first of its
+// paths in the manifest, and a reader for its content which is valid until
+// the next call. [io.EOF] is returned once every blob has been read.
+func (r *Reader) NextObject() (Object, io.Reader, error) {
+	hdr, err := r.tr.Next()
+	if err == io.EOF {
+		return Object{}, nil, io.EOF
+	} else if err != nil {
+		return Object{}, nil, errors.Errorf("reading model archive: %w", err).Add(coreerrors.NotValid)
+	}
+	obj, ok := r.objects[hdr.Name]
+	if !ok {
+		return Object{}, nil, errors.Errorf("unexpected file %q in model archive %w", hdr.Name, coreerrors.NotValid)
+	}
+	return obj, io.LimitReader(r.tr, obj.Size), nil
+}
+
+// Close releases the resources held by the reader. It does not close the
+// underlying reader.
+func (r *Reader) Close() error {
+	return r.gzr.Close()
+}
+
+// exportModel returns the description of the model in the serialised model
+// export. The model table, like the object store tables, shares the same
+// layout across export versions.
+func exportModel(export []byte) (Model, error) {
+	var doc struct {
+		Payload struct {
+			Model []struct {
+				UUID              string  `yaml:"uuid"`
+				Name              string  `yaml:"name"`
+				Qualifier         string  `yaml:"qualifier"`
+				Cloud             string  `yaml:"cloud"`
+				CloudRegion       *string `yaml:"cloud_region"`
+				CredentialOwner   *string `yaml:"credential_owner"`
+				CredentialName    *string `yaml:"credential_name"`
+				IsControllerModel *bool   `yaml:"is_controller_model"`
+			} `yaml:"model"`
+		} `yaml:"payload"`
+	}
+	if err := yaml.Unmarshal(export, &doc); err != nil {
+		return Model{}, errors.Errorf("reading model from model export: %w", err).Add(coreerrors.NotValid)
+	}
+	if n := len(doc.Payload.Model); n != 1 {
+		return Model{}, errors.Errorf("model export holds %d models %w", n, coreerrors.NotValid)
+	}
+
+	m := doc.Payload.Model[0]
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return Model{
+		UUID:              m.UUID,
+		Name:              m.Name,
+		Qualifier:         m.Qualifier,
+		Cloud:             m.Cloud,
+		CloudRegion:       deref(m.CloudRegion),
+		CredentialOwner:   deref(m.CredentialOwner),
+		CredentialName:    deref(m.CredentialName),
+		IsControllerModel: m.IsControllerModel != nil && *m.IsControllerModel,
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelarchive_test
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/juju/tc"
+	"gopkg.in/yaml.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/domain/export/types/v4_0_4"
+	"github.com/juju/juju/internal/modelarchive"
+)
+
+func (s *archiveSuite) addModel(export *domainexport.ModelExport, uuid string) {
+	region := "default"
+	export.Payload.(*v4_0_4.ModelExport).Model = []v4_0_4.Model{{
+		UUID:           uuid,
+		ControllerUUID: "controller-uuid",
+		Name:           "foo",
+		Qualifier:      "prod",
+		Type:           "iaas",
+		Cloud:          "lxd",
+		CloudType:      "lxd",
+		CloudRegion:    &region,
+	}}
+}
+
+func (s *archiveSuite) TestRead(c *tc.C) {
+	export, objects := s.newExport()
+	s.addModel(export, "model-uuid")
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	r, err := modelarchive.NewReader(&buf)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = r.Close() }()
+
+	c.Check(r.Manifest().ModelUUID, tc.Equals, "model-uuid")
+	c.Check(r.Model(), tc.DeepEquals, modelarchive.Model{
+		UUID:        "model-uuid",
+		Name:        "foo",
+		Qualifier:   "prod",
+		Cloud:       "lxd",
+		CloudRegion: "default",
+	})
+	expected, err := yaml.Marshal(export)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(r.ModelExport()), tc.Equals, string(expected))
+
+	read := make(map[string]string)
+	for {
+		obj, content, err := r.NextObject()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		data, err := io.ReadAll(content)
+		c.Assert(err, tc.ErrorIsNil)
+		read[obj.Path] = string(data)
+	}
+	c.Check(read, tc.DeepEquals, map[string]string{
+		"charms/foo":               "charm archive",
+		"tools/4.0.4-ubuntu-amd64": "agent binary",
+	})
+}
+
+func (s *archiveSuite) TestReadModelMismatch(c *tc.C) {
+	export, objects := s.newExport()
+	s.addModel(export, "other-uuid")
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = modelarchive.NewReader(&buf)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *archiveSuite) TestReadNoModel(c *tc.C) {
+	export, objects := s.newExport()
+
+	var buf bytes.Buffer
+	err := modelarchive.Write(c.Context(), &buf, "model-uuid", export, objects, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = modelarchive.NewReader(&buf)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `model export holds 0 models .*`)
+}
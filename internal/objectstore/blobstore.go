@@ -0,0 +1,321 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+// defaultBlobDirectory is the directory, relative to the file object store
+// root, holding the controller wide content addressed blobs. The leading dot
+// ensures it can never collide with a namespace.
+const defaultBlobDirectory = ".blobs"
+
+// fileHashRegexp matches the hex encoded SHA384 hash used to name blobs.
+var fileHashRegexp = regexp.MustCompile(`^[0-9a-f]{96}$`)
+
+// NamespaceMetadata provides the object store metadata of every namespace
+// sharing the blob store.
+type NamespaceMetadata interface {
+	// Namespaces returns the namespaces of the object store.
+	Namespaces(ctx context.Context) ([]string, error)
+
+	// ListMetadata returns the object store metadata of the namespace.
+	ListMetadata(ctx context.Context, namespace string) ([]objectstore.Metadata, error)
+}
+
+// BlobStore is the controller wide content addressed layer of the file object
+// store. Every blob is stored once, named by its SHA384 hash, and each
+// namespace that stores the same content holds a hard link to that blob.
+//
+// The number of namespaces referencing a blob is the number of namespaces
+// whose object store metadata holds its hash. Once no namespace references a
+// blob, it can be reclaimed with Collect.
+type BlobStore struct {
+	rootDir string
+	path    string
+	clock   clock.Clock
+	logger  logger.Logger
+}
+
+// NewBlobStore returns a BlobStore for the file object stores under the given
+// root directory.
+func NewBlobStore(rootDir string, clock clock.Clock, logger logger.Logger) *BlobStore {
+	return &BlobStore{
+		rootDir: rootDir,
+		path:    filepath.Join(rootDir, defaultFileDirectory, defaultBlobDirectory),
+		clock:   clock,
+		logger:  logger,
+	}
+}
+
+// link makes the temporary file available at target, sharing the content
+// with every other namespace that stores the same hash. If the file system
+// does not support hard links, the temporary file is moved to target,
+// leaving the content undeduplicated.
+func (b *BlobStore) link(ctx context.Context, tmpFileName, hash string, size int64, target string) error {
+	if err := os.MkdirAll(b.path, 0755); err != nil {
+		return errors.Errorf("creating blob directory: %w", err)
+	}
+
+	blobPath := b.blobPath(hash)
+	var moved bool
+	if info, err := os.Stat(blobPath); err == nil {
+		if info.Size() != size {
+			return errors.Errorf("blob encoded as %q: %w", hash, objectstoreerrors.ObjectAlreadyExists)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return errors.Capture(err)
+	} else if err := os.Rename(tmpFileName, blobPath); err != nil {
+		b.logger.Debugf(ctx, "cannot move %q to blob store, storing it unshared: %v", hash, err)
+		return errors.Capture(os.Rename(tmpFileName, target))
+	} else {
+		moved = true
+	}
+
+	err := os.Link(blobPath, target)
+	if err == nil || errors.Is(err, os.ErrExist) {
+		if !moved {
+			// The content was already held, so the temporary copy is no
+			// longer needed.
+			_ = os.Remove(tmpFileName)
+		}
+		return nil
+	}
+	b.logger.Debugf(ctx, "cannot link blob %q, storing it unshared: %v", hash, err)
+
+	// Take the content back out of the blob store if it was only just put
+	// there. This fails if the blob was collected in the meantime, which
+	// the grace period of Collect makes very unlikely.
+	if moved {
+		return errors.Capture(os.Rename(blobPath, target))
+	}
+	return errors.Capture(os.Rename(tmpFileName, target))
+}
+
+// References returns the number of namespaces whose object store metadata
+// references each blob, keyed by the SHA384 hash of the blob.
+func (b *BlobStore) References(ctx context.Context, metadata NamespaceMetadata) (map[string]int, error) {
+	namespaces, err := metadata.Namespaces(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting namespaces: %w", err)
+	}
+
+	references := make(map[string]int)
+	for _, namespace := range namespaces {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Capture(err)
+		}
+
+		objects, err := metadata.ListMetadata(ctx, namespace)
+		if err != nil {
+			return nil, errors.Errorf("listing metadata of namespace %q: %w", namespace, err)
+		}
+
+		// Many paths within a namespace can share the same content, but
+		// they only count as a single reference.
+		seen := make(map[string]bool, len(objects))
+		for _, object := range objects {
+			if seen[object.SHA384] {
+				continue
+			}
+			seen[object.SHA384] = true
+			references[object.SHA384]++
+		}
+	}
+	return references, nil
+}
+
+// DeduplicateResult describes the outcome of a deduplication pass.
+type DeduplicateResult struct {
+	// Files is the number of namespaced files that were replaced by a link
+	// to an existing blob.
+	Files int
+
+	// Bytes is the number of bytes reclaimed by replacing files.
+	Bytes int64
+}
+
+// Deduplicate moves namespaced files that are not yet shared into the blob
+// store. Files whose content is already held by the blob store are replaced
+// by a link to the blob, reclaiming their space.
+func (b *BlobStore) Deduplicate(ctx context.Context) (DeduplicateResult, error) {
+	var result DeduplicateResult
+
+	root := filepath.Join(b.rootDir, defaultFileDirectory)
+	namespaces, err := os.ReadDir(root)
+	if errors.Is(err, os.ErrNotExist) {
+		return result, nil
+	} else if err != nil {
+		return result, errors.Capture(err)
+	}
+	if err := os.MkdirAll(b.path, 0755); err != nil {
+		return result, errors.Errorf("creating blob directory: %w", err)
+	}
+
+	for _, namespace := range namespaces {
+		if !namespace.IsDir() || namespace.Name() == defaultBlobDirectory {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return result, errors.Capture(err)
+		}
+
+		nsPath := filepath.Join(root, namespace.Name())
+		entries, err := os.ReadDir(nsPath)
+		if err != nil {
+			return result, errors.Capture(err)
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() || !fileHashRegexp.MatchString(entry.Name()) {
+				continue
+			}
+			reclaimed, err := b.deduplicateFile(nsPath, entry.Name())
+			if err != nil {
+				b.logger.Infof(ctx, "cannot deduplicate %q in namespace %q: %v", entry.Name(), namespace.Name(), err)
+				continue
+			}
+			if reclaimed > 0 {
+				result.Files++
+				result.Bytes += reclaimed
+			}
+		}
+	}
+	return result, nil
+}
+
+// deduplicateFile shares a single namespaced file with the blob store,
+// returning the number of bytes reclaimed.
+func (b *BlobStore) deduplicateFile(nsPath, hash string) (int64, error) {
+	filePath := filepath.Join(nsPath, hash)
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	blobPath := b.blobPath(hash)
+	blobInfo, err := os.Stat(blobPath)
+	if errors.Is(err, os.ErrNotExist) {
+		// The blob store has not seen this content yet; adopt the file.
+		return 0, errors.Capture(os.Link(filePath, blobPath))
+	} else if err != nil {
+		return 0, errors.Capture(err)
+	}
+	if os.SameFile(blobInfo, info) {
+		// Already shared.
+		return 0, nil
+	}
+	if blobInfo.Size() != info.Size() {
+		return 0, errors.Errorf("blob size %d does not match file size %d", blobInfo.Size(), info.Size())
+	}
+
+	// Replace the file with a link to the blob. The link is created in the
+	// namespace tmp directory first, so that the file is swapped atomically.
+	tmpFile, err := os.CreateTemp(filepath.Join(nsPath, defaultTempDirectoryName), "dedup")
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	tmpName := tmpFile.Name()
+	_ = tmpFile.Close()
+	_ = os.Remove(tmpName)
+	if err := os.Link(blobPath, tmpName); err != nil {
+		return 0, errors.Capture(err)
+	}
+	if err := os.Rename(tmpName, filePath); err != nil {
+		_ = os.Remove(tmpName)
+		return 0, errors.Capture(err)
+	}
+	return info.Size(), nil
+}
+
+// CollectResult describes the outcome of a garbage collection pass.
+type CollectResult struct {
+	// Blobs is the number of blobs removed.
+	Blobs int
+
+	// Bytes is the number of bytes reclaimed.
+	Bytes int64
+}
+
+// Collect removes every blob that is no longer referenced by the object store
+// metadata of any namespace. Blobs modified within the grace period are kept,
+// so that a blob that has been stored but whose metadata has not yet been
+// written is never removed. Removing a blob never affects the content of a
+// namespace, as each namespace holds its own link to the content.
+func (b *BlobStore) Collect(ctx context.Context, metadata NamespaceMetadata, grace time.Duration) (CollectResult, error) {
+	var result CollectResult
+
+	entries, err := os.ReadDir(b.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return result, nil
+	} else if err != nil {
+		return result, errors.Capture(err)
+	}
+
+	// Any failure to read the metadata of a namespace fails the whole pass,
+	// as a blob can only be removed once every namespace is known not to
+	// reference it.
+	references, err := b.References(ctx, metadata)
+	if err != nil {
+		return result, errors.Capture(err)
+	}
+
+	cutoff := b.clock.Now().Add(-grace)
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return result, errors.Capture(err)
+		}
+
+		if references[entry.Name()] > 0 {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+
+		if err := os.Remove(b.blobPath(entry.Name())); err != nil {
+			b.logger.Infof(ctx, "cannot remove unreferenced blob %q: %v", entry.Name(), err)
+			continue
+		}
+		b.logger.Debugf(ctx, "removed unreferenced blob %q", entry.Name())
+		result.Blobs++
+		result.Bytes += info.Size()
+	}
+	return result, nil
+}
+
+// discard removes the blob with the given hash if it holds the same content
+// as the given file. This is used to stop corrupt content from being shared
+// with any further namespaces.
+func (b *BlobStore) discard(hash string, info os.FileInfo) error {
+	blobInfo, err := os.Stat(b.blobPath(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	if !os.SameFile(blobInfo, info) {
+		return nil
+	}
+	return errors.Capture(os.Remove(b.blobPath(hash)))
+}
+
+func (b *BlobStore) blobPath(hash string) string {
+	return filepath.Join(b.path, hash)
+}
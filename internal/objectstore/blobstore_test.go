@@ -0,0 +1,240 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+//go:build !windows
+
+package objectstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+type blobStoreSuite struct {
+	baseSuite
+
+	rootDir string
+	clock   *testclock.Clock
+	blobs   *BlobStore
+}
+
+func TestBlobStoreSuite(t *testing.T) {
+	tc.Run(t, &blobStoreSuite{})
+}
+
+func (s *blobStoreSuite) SetUpTest(c *tc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.rootDir = c.MkDir()
+	s.clock = testclock.NewClock(time.Now())
+	s.blobs = NewBlobStore(s.rootDir, s.clock, loggertesting.WrapCheckLog(c))
+}
+
+// fakeMetadata holds the object store metadata of each namespace.
+type fakeMetadata struct {
+	objects map[string][]objectstore.Metadata
+	err     error
+}
+
+func (f fakeMetadata) Namespaces(context.Context) ([]string, error) {
+	var namespaces []string
+	for namespace := range f.objects {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+func (f fakeMetadata) ListMetadata(_ context.Context, namespace string) ([]objectstore.Metadata, error) {
+	return f.objects[namespace], f.err
+}
+
+func (s *blobStoreSuite) namespacePath(namespace string) string {
+	return basePath(s.rootDir, namespace)
+}
+
+// writeTmpFile writes contents to a temporary file within the namespace,
+// returning the file name, hash and size.
+func (s *blobStoreSuite) writeTmpFile(c *tc.C, namespace, contents string) (string, string, int64) {
+	tmpDir := filepath.Join(s.namespacePath(namespace), defaultTempDirectoryName)
+	err := os.MkdirAll(tmpDir, 0755)
+	c.Assert(err, tc.ErrorIsNil)
+
+	f, err := os.CreateTemp(tmpDir, "tmp")
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+	_, err = f.WriteString(contents)
+	c.Assert(err, tc.ErrorIsNil)
+
+	return f.Name(), s.calculateHexSHA384(c, contents), int64(len(contents))
+}
+
+func (s *blobStoreSuite) link(c *tc.C, namespace, contents string) string {
+	tmpFile, hash, size := s.writeTmpFile(c, namespace, contents)
+	err := s.blobs.link(c.Context(), tmpFile, hash, size, filepath.Join(s.namespacePath(namespace), hash))
+	c.Assert(err, tc.ErrorIsNil)
+	return hash
+}
+
+// checkShared checks that the namespace's file is a link to the blob.
+func (s *blobStoreSuite) checkShared(c *tc.C, namespace, hash string) {
+	blobInfo, err := os.Stat(s.blobs.blobPath(hash))
+	c.Assert(err, tc.ErrorIsNil)
+	info, err := os.Stat(filepath.Join(s.namespacePath(namespace), hash))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(os.SameFile(blobInfo, info), tc.IsTrue)
+}
+
+func (s *blobStoreSuite) TestLinkSharesContent(c *tc.C) {
+	hash := s.link(c, "foo", "some content")
+	other := s.link(c, "bar", "some content")
+	c.Assert(other, tc.Equals, hash)
+
+	for _, namespace := range []string{"foo", "bar"} {
+		content, err := os.ReadFile(filepath.Join(s.namespacePath(namespace), hash))
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(string(content), tc.Equals, "some content")
+		s.checkShared(c, namespace, hash)
+	}
+
+	// The temporary files are consumed.
+	for _, namespace := range []string{"foo", "bar"} {
+		entries, err := os.ReadDir(filepath.Join(s.namespacePath(namespace), defaultTempDirectoryName))
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(entries, tc.HasLen, 0)
+	}
+}
+
+func (s *blobStoreSuite) TestLinkSizeMismatch(c *tc.C) {
+	hash := s.link(c, "foo", "some content")
+
+	tmpFile, _, _ := s.writeTmpFile(c, "bar", "other content")
+	err := s.blobs.link(c.Context(), tmpFile, hash, 13, filepath.Join(s.namespacePath("bar"), hash))
+	c.Check(err, tc.ErrorIs, objectstoreerrors.ObjectAlreadyExists)
+}
+
+func (s *blobStoreSuite) TestReferences(c *tc.C) {
+	refs, err := s.blobs.References(c.Context(), fakeMetadata{
+		objects: map[string][]objectstore.Metadata{
+			"foo": {
+				{Path: "charms/a", SHA384: "shared"},
+				{Path: "charms/b", SHA384: "shared"},
+				{Path: "charms/c", SHA384: "foo-only"},
+			},
+			"bar": {
+				{Path: "charms/a", SHA384: "shared"},
+			},
+			"baz": nil,
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Paths sharing content within a namespace count as a single
+	// reference.
+	c.Check(refs, tc.DeepEquals, map[string]int{
+		"shared":   2,
+		"foo-only": 1,
+	})
+}
+
+func (s *blobStoreSuite) TestReferencesMetadataError(c *tc.C) {
+	_, err := s.blobs.References(c.Context(), fakeMetadata{
+		objects: map[string][]objectstore.Metadata{"foo": nil},
+		err:     errors.New("boom"),
+	})
+	c.Check(err, tc.ErrorMatches, `listing metadata of namespace "foo": boom`)
+}
+
+func (s *blobStoreSuite) TestCollect(c *tc.C) {
+	shared := s.link(c, "foo", "shared content")
+	_ = s.link(c, "bar", "shared content")
+	unshared := s.link(c, "foo", "unshared content")
+
+	// Only bar still references the shared content; nothing references
+	// the unshared content, even though foo's file has not been pruned.
+	metadata := fakeMetadata{
+		objects: map[string][]objectstore.Metadata{
+			"foo": nil,
+			"bar": {{Path: "charms/shared", SHA384: shared}},
+		},
+	}
+
+	// Within the grace period nothing is collected.
+	result, err := s.blobs.Collect(c.Context(), metadata, time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, CollectResult{})
+
+	s.clock.Advance(2 * time.Hour)
+	result, err = s.blobs.Collect(c.Context(), metadata, time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, CollectResult{Blobs: 1, Bytes: int64(len("unshared content"))})
+
+	_, err = os.Stat(s.blobs.blobPath(unshared))
+	c.Check(err, tc.ErrorIs, os.ErrNotExist)
+	s.checkShared(c, "bar", shared)
+
+	// Removing the blob leaves the content of the namespace untouched.
+	content, err := os.ReadFile(filepath.Join(s.namespacePath("foo"), unshared))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(content), tc.Equals, "unshared content")
+}
+
+func (s *blobStoreSuite) TestCollectMetadataError(c *tc.C) {
+	hash := s.link(c, "foo", "some content")
+	s.clock.Advance(2 * time.Hour)
+
+	_, err := s.blobs.Collect(c.Context(), fakeMetadata{
+		objects: map[string][]objectstore.Metadata{"foo": nil},
+		err:     errors.New("boom"),
+	}, time.Hour)
+	c.Check(err, tc.ErrorMatches, `listing metadata of namespace "foo": boom`)
+
+	// Nothing is removed unless every namespace could be read.
+	s.checkShared(c, "foo", hash)
+}
+
+func (s *blobStoreSuite) TestCollectMissingDirectory(c *tc.C) {
+	result, err := s.blobs.Collect(c.Context(), fakeMetadata{}, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, CollectResult{})
+}
+
+func (s *blobStoreSuite) TestDeduplicate(c *tc.C) {
+	// Lay out namespaces as written before the blob store existed.
+	for _, namespace := range []string{"foo", "bar", "baz"} {
+		err := os.MkdirAll(filepath.Join(s.namespacePath(namespace), defaultTempDirectoryName), 0755)
+		c.Assert(err, tc.ErrorIsNil)
+		s.createFile(c, s.namespacePath(namespace), "blob", "duplicated content")
+	}
+	hash := s.calculateHexSHA384(c, "duplicated content")
+
+	result, err := s.blobs.Deduplicate(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The first namespace seeds the blob store, the other two are replaced
+	// by links.
+	c.Check(result, tc.Equals, DeduplicateResult{Files: 2, Bytes: 2 * int64(len("duplicated content"))})
+	for _, namespace := range []string{"foo", "bar", "baz"} {
+		content, err := os.ReadFile(filepath.Join(s.namespacePath(namespace), hash))
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(string(content), tc.Equals, "duplicated content")
+		s.checkShared(c, namespace, hash)
+	}
+
+	// A second pass has nothing left to do.
+	result, err = s.blobs.Deduplicate(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, DeduplicateResult{})
+}
@@ -32,6 +32,7 @@ type TrackedObjectStore interface {
 	worker.Worker
 	objectstore.ObjectStore
 	objectstore.ObjectStoreRemover
+	objectstore.ObjectStoreScrubber
 	Report(ctx context.Context) map[string]any
 }
 
@@ -111,6 +111,7 @@ type fileObjectStore struct {
 	controllerNodeID string
 
 	fs              fs.FS
+	blobs           *BlobStore
 	remoteRetriever RemoteRetriever
 	remoteRunner    *worker.Runner
 	namespace       string
@@ -152,6 +153,7 @@ func NewFileObjectStore(cfg FileObjectStoreConfig) (TrackedObjectStore, error) {
 		controllerNodeID: cfg.ControllerNodeID,
 
 		fs:              os.DirFS(path),
+		blobs:           NewBlobStore(cfg.RootDir, cfg.Clock, cfg.Logger),
 		remoteRetriever: cfg.RemoteRetriever,
 		remoteRunner:    runner,
 
@@ -823,7 +825,7 @@ func (t *fileObjectStore) put(
 	return uuid, nil
 }
 
-func (t *fileObjectStore) persistTmpFile(_ context.Context, tmpFileName, hash string, size int64) error {
+func (t *fileObjectStore) persistTmpFile(ctx context.Context, tmpFileName, hash string, size int64) error {
 	filePath := t.filePath(hash)
 
 	// Check to see if the file already exists with the same name.
@@ -840,8 +842,9 @@ func (t *fileObjectStore) persistTmpFile(_ context.Context, tmpFileName, hash st
 		return errors.Capture(err)
 	}
 
-	// Swap out the temporary file for the real one.
-	if err := os.Rename(tmpFileName, filePath); err != nil {
+	// Swap out the temporary file for the real one, sharing the content with
+	// any other namespace that holds the same blob.
+	if err := t.blobs.link(ctx, tmpFileName, hash, size, filePath); err != nil {
 		return errors.Capture(err)
 	}
 
@@ -114,6 +114,12 @@ func (c *remoteFileObjectStore) RemoveAll(ctx context.Context) error {
 	return c.objectStore.RemoveAll(ctx)
 }
 
+// Scrub verifies every object held in the namespace against its metadata,
+// repairing corrupt objects from another controller.
+func (c *remoteFileObjectStore) Scrub(ctx context.Context) (objectstore.ScrubResult, error) {
+	return c.objectStore.Scrub(ctx)
+}
+
 // Report returns a map of internal state for the remoteFileObjectStore.
 func (c *remoteFileObjectStore) Report(ctx context.Context) map[string]any {
 	report := make(map[string]any)
@@ -0,0 +1,205 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	jujuerrors "github.com/juju/errors"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+)
+
+// defaultQuarantineDirectoryName is the directory, relative to the namespace,
+// where corrupt files are moved to. They are kept for inspection, and are
+// never removed automatically.
+const defaultQuarantineDirectoryName = "quarantine"
+
+// verifyContent reads the content from the reader and reports whether it
+// matches the size and hashes of the metadata. The number of bytes read is
+// returned.
+func verifyContent(r io.Reader, metadata objectstore.Metadata) (int64, bool, error) {
+	hash384 := sha512.New384()
+	hash256 := sha256.New()
+	size, err := io.Copy(io.MultiWriter(hash384, hash256), r)
+	if err != nil {
+		return size, false, errors.Capture(err)
+	}
+	valid := size == metadata.Size &&
+		hex.EncodeToString(hash384.Sum(nil)) == metadata.SHA384 &&
+		hex.EncodeToString(hash256.Sum(nil)) == metadata.SHA256
+	return size, valid, nil
+}
+
+// uniqueByHash returns the metadata with only the first entry for each file
+// hash, as multiple paths can refer to the same content.
+func uniqueByHash(metadata []objectstore.Metadata) []objectstore.Metadata {
+	seen := make(map[string]struct{}, len(metadata))
+	var result []objectstore.Metadata
+	for _, m := range metadata {
+		hash := SelectFileHash(m)
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		result = append(result, m)
+	}
+	return result
+}
+
+// Scrub re-hashes every file held in the namespace, comparing it against the
+// recorded metadata. Files that don't match are moved to the quarantine
+// directory and retrieved again from another controller.
+func (t *fileObjectStore) Scrub(ctx context.Context) (objectstore.ScrubResult, error) {
+	var result objectstore.ScrubResult
+
+	metadata, err := t.metadataService.ListMetadata(ctx)
+	if err != nil {
+		return result, errors.Errorf("listing metadata: %w", err)
+	}
+
+	for _, m := range uniqueByHash(metadata) {
+		hash := SelectFileHash(m)
+
+		var corrupt bool
+		err := t.withLock(ctx, hash, func(ctx context.Context) error {
+			var err error
+			corrupt, err = t.scrubFile(ctx, m, &result)
+			return err
+		})
+		if errors.Is(err, ErrFileLocked) {
+			// The file is being written or removed, it will be verified on
+			// the next pass.
+			continue
+		} else if err != nil {
+			return result, errors.Errorf("scrubbing %q encoded as %q: %w", m.Path, hash, err)
+		}
+		if !corrupt {
+			continue
+		}
+		result.Corrupt = append(result.Corrupt, hash)
+
+		// The corrupt file has been quarantined, so retrieving it falls back
+		// to another controller. The retrieved content is verified before it
+		// is persisted.
+		reader, _, err := t.remoteGetWithMetadata(ctx, m)
+		if err != nil {
+			t.logger.Warningf(ctx, "cannot repair %q encoded as %q: %v", m.Path, hash, err)
+			continue
+		}
+		_ = reader.Close()
+
+		t.logger.Infof(ctx, "repaired %q encoded as %q from another controller", m.Path, hash)
+		result.Repaired = append(result.Repaired, hash)
+	}
+	return result, nil
+}
+
+// scrubFile verifies a single file, quarantining it if it doesn't match the
+// metadata. It reports whether the file was corrupt.
+func (t *fileObjectStore) scrubFile(ctx context.Context, metadata objectstore.Metadata, result *objectstore.ScrubResult) (bool, error) {
+	hash := SelectFileHash(metadata)
+
+	file, err := os.Open(t.filePath(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		result.Missing++
+		return false, nil
+	} else if err != nil {
+		return false, errors.Capture(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	size, valid, err := verifyContent(file, metadata)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	result.Checked++
+	result.Bytes += size
+	if valid {
+		return false, nil
+	}
+
+	t.logger.Errorf(ctx, "file %q encoded as %q does not match its metadata, quarantining", metadata.Path, hash)
+	if err := t.quarantine(hash, info); err != nil {
+		return false, errors.Errorf("quarantining: %w", err)
+	}
+	return true, nil
+}
+
+// quarantine moves the file out of the namespace, so that it is no longer
+// served.
+func (t *fileObjectStore) quarantine(hash string, info os.FileInfo) error {
+	dir := filepath.Join(t.path, defaultQuarantineDirectoryName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Capture(err)
+	}
+
+	target := filepath.Join(dir, fmt.Sprintf("%s.%d", hash, t.clock.Now().UnixNano()))
+	if err := os.Rename(t.filePath(hash), target); err != nil {
+		return errors.Capture(err)
+	}
+
+	// The corrupt content may be shared with other namespaces through the
+	// blob store. Drop the blob, so that the repaired content isn't linked
+	// back to it.
+	return errors.Capture(t.blobs.discard(hash, info))
+}
+
+// Scrub re-hashes every object held in the namespace, comparing it against
+// the recorded metadata. The bucket is shared by every controller, so there
+// is no other copy to repair a corrupt object from; they are only reported.
+func (t *s3ObjectStore) Scrub(ctx context.Context) (objectstore.ScrubResult, error) {
+	var result objectstore.ScrubResult
+
+	metadata, err := t.metadataService.ListMetadata(ctx)
+	if err != nil {
+		return result, errors.Errorf("listing metadata: %w", err)
+	}
+
+	for _, m := range uniqueByHash(metadata) {
+		hash := SelectFileHash(m)
+
+		var (
+			size  int64
+			valid bool
+		)
+		err := t.client.Session(ctx, func(ctx context.Context, s objectstore.Session) error {
+			reader, _, _, err := s.GetObject(ctx, t.rootBucket, t.filePath(hash))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = reader.Close() }()
+
+			size, valid, err = verifyContent(reader, m)
+			return err
+		})
+		if errors.Is(err, jujuerrors.NotFound) {
+			result.Missing++
+			continue
+		} else if err != nil {
+			return result, errors.Errorf("scrubbing %q encoded as %q: %w", m.Path, hash, err)
+		}
+
+		result.Checked++
+		result.Bytes += size
+		if !valid {
+			t.logger.Errorf(ctx, "object %q encoded as %q does not match its metadata", m.Path, hash)
+			result.Corrupt = append(result.Corrupt, hash)
+		}
+	}
+	return result, nil
+}
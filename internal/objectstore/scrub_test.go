@@ -0,0 +1,190 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/objectstore/remote"
+)
+
+func (s *fileObjectStoreSuite) TestScrubValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	namespace := s.filePath(path, "inferi")
+
+	size, hash384, hash256 := s.createFile(c, namespace, "foo", "some content")
+
+	ch := s.expectWatch()
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   size,
+	}, {
+		Path:   "bar",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   size,
+	}}, nil)
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+
+	store := s.newFileObjectStore(c, path)
+	defer workertest.DirtyKill(c, store)
+
+	s.expectStartup(c, ch)
+
+	result, err := store.Scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, objectstore.ScrubResult{
+		Checked: 1,
+		Bytes:   size,
+	})
+
+	workertest.CleanKill(c, store)
+}
+
+func (s *fileObjectStoreSuite) TestScrubMissing(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	ch := s.expectWatch()
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		Path:   "foo",
+		SHA384: "blah",
+		SHA256: "blah256",
+		Size:   12,
+	}}, nil)
+	s.expectClaim("blah", 1)
+	s.expectRelease("blah", 1)
+
+	store := s.newFileObjectStore(c, path)
+	defer workertest.DirtyKill(c, store)
+
+	s.expectStartup(c, ch)
+
+	result, err := store.Scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, objectstore.ScrubResult{
+		Missing: 1,
+	})
+
+	workertest.CleanKill(c, store)
+}
+
+func (s *fileObjectStoreSuite) TestScrubCorruptRepaired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	namespace := s.filePath(path, "inferi")
+
+	hash384 := s.calculateHexSHA384(c, "some content")
+	hash256 := s.calculateHexSHA256(c, "some content")
+	s.writeCorruptFile(c, namespace, hash384, "some c0ntent")
+
+	reader := newCloseTrackingReader("some content")
+
+	ch := s.expectWatch()
+	metadata := objectstore.Metadata{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   12,
+	}
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{metadata}, nil)
+	s.service.EXPECT().GetControllerIDHints(gomock.Any(), hash384).Return([]string{"2"}, nil)
+	s.remote.EXPECT().Retrieve(gomock.Any(), hash256, []string{"2"}).Return(reader, 12, nil)
+	s.service.EXPECT().AddControllerIDHint(gomock.Any(), hash384, "1")
+
+	// Once to verify the file, and once to persist the repaired file.
+	s.expectClaim(hash384, 2)
+	s.expectRelease(hash384, 2)
+
+	store := s.newFileObjectStore(c, path)
+	defer workertest.DirtyKill(c, store)
+
+	s.expectStartup(c, ch)
+
+	result, err := store.Scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, objectstore.ScrubResult{
+		Checked:  1,
+		Bytes:    12,
+		Corrupt:  []string{hash384},
+		Repaired: []string{hash384},
+	})
+	s.expectRemoteReaderClosed(c, reader)
+
+	content, err := os.ReadFile(filepath.Join(namespace, hash384))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(content), tc.Equals, "some content")
+
+	// The corrupt file is kept in quarantine.
+	entries, err := os.ReadDir(filepath.Join(namespace, defaultQuarantineDirectoryName))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 1)
+	content, err = os.ReadFile(filepath.Join(namespace, defaultQuarantineDirectoryName, entries[0].Name()))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(content), tc.Equals, "some c0ntent")
+
+	workertest.CleanKill(c, store)
+}
+
+func (s *fileObjectStoreSuite) TestScrubCorruptNotRepaired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	namespace := s.filePath(path, "inferi")
+
+	hash384 := s.calculateHexSHA384(c, "some content")
+	hash256 := s.calculateHexSHA256(c, "some content")
+	s.writeCorruptFile(c, namespace, hash384, "truncated")
+
+	ch := s.expectWatch()
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   12,
+	}}, nil)
+	s.service.EXPECT().GetControllerIDHints(gomock.Any(), hash384).Return([]string{}, nil)
+	s.remote.EXPECT().Retrieve(gomock.Any(), hash256, []string{}).Return(nil, -1, remote.NoRemoteConnections)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+
+	store := s.newFileObjectStore(c, path)
+	defer workertest.DirtyKill(c, store)
+
+	s.expectStartup(c, ch)
+
+	result, err := store.Scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, objectstore.ScrubResult{
+		Checked: 1,
+		Bytes:   int64(len("truncated")),
+		Corrupt: []string{hash384},
+	})
+
+	// The corrupt file is no longer served.
+	s.expectFileDoesNotExist(c, path, hash384)
+
+	workertest.CleanKill(c, store)
+}
+
+func (s *fileObjectStoreSuite) writeCorruptFile(c *tc.C, namespace, hash, contents string) {
+	err := os.MkdirAll(filepath.Join(namespace, defaultTempDirectoryName), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(filepath.Join(namespace, hash), []byte(contents), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -88,6 +88,7 @@ const (
 	deviceInUse        = "InvalidDevice.InUse"
 	attachmentNotFound = "InvalidAttachment.NotFound"
 	volumeNotFound     = "InvalidVolume.NotFound"
+	snapshotNotFound   = "InvalidSnapshot.NotFound"
 	incorrectState     = "IncorrectState"
 )
 
@@ -368,7 +369,11 @@ type ebsVolumeSource struct {
 	modelUUID string
 }
 
-var _ storage.VolumeSource = (*ebsVolumeSource)(nil)
+var (
+	_ storage.VolumeSource      = (*ebsVolumeSource)(nil)
+	_ storage.VolumeResizer     = (*ebsVolumeSource)(nil)
+	_ storage.VolumeSnapshotter = (*ebsVolumeSource)(nil)
+)
 
 // parseVolumeOptions uses storage volume parameters to make a struct used to create volumes.
 func parseVolumeOptions(size uint64, attrs map[string]any) (_ ec2.CreateVolumeInput, _ error) {
@@ -479,6 +484,9 @@ func (v *ebsVolumeSource) createVolume(ctx context.Context, p storage.VolumePara
 	if inst.Placement != nil {
 		vol.AvailabilityZone = inst.Placement.AvailabilityZone
 	}
+	if p.SnapshotId != "" {
+		vol.SnapshotId = aws.String(p.SnapshotId)
+	}
 
 	// Tag.
 	resourceTags := make(map[string]string)
@@ -603,6 +611,105 @@ func (v *ebsVolumeSource) ReleaseVolumes(ctx context.Context, volIds []string) (
 	return errs, nil
 }
 
+// ResizeVolumes is specified on the storage.VolumeResizer interface.
+func (v *ebsVolumeSource) ResizeVolumes(ctx context.Context, params []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		info, err := resizeVolume(ctx, v.env.ec2Client, p)
+		if err != nil {
+			results[i].Error = errors.Trace(v.env.HandleCredentialError(ctx, err))
+			continue
+		}
+		results[i].VolumeInfo = info
+	}
+	return results, nil
+}
+
+func resizeVolume(ctx context.Context, client Client, p storage.ResizeVolumeParams) (*storage.VolumeInfo, error) {
+	volume, err := describeVolume(ctx, client, p.VolumeId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	size := mibToGib(p.Size)
+	current := uint64(aws.ToInt32(volume.Size))
+	if current < size {
+		// The volume may be used at its new size as soon as the
+		// modification enters the "optimizing" state, so there
+		// is no need to wait for it to complete.
+		resp, err := client.ModifyVolume(ctx, &ec2.ModifyVolumeInput{
+			VolumeId: aws.String(p.VolumeId),
+			Size:     aws.Int32(int32(size)),
+		})
+		if err != nil {
+			return nil, errors.Annotatef(err, "modifying volume %q", p.VolumeId)
+		}
+		if resp.VolumeModification != nil {
+			current = uint64(aws.ToInt32(resp.VolumeModification.TargetSize))
+		} else {
+			current = size
+		}
+	}
+	return &storage.VolumeInfo{
+		VolumeId:   p.VolumeId,
+		Size:       gibToMib(current),
+		Persistent: true,
+	}, nil
+}
+
+// CreateVolumeSnapshots is specified on the storage.VolumeSnapshotter
+// interface.
+func (v *ebsVolumeSource) CreateVolumeSnapshots(ctx context.Context, params []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	results := make([]storage.CreateVolumeSnapshotsResult, len(params))
+	for i, p := range params {
+		snapshot, err := v.createVolumeSnapshot(ctx, p)
+		if err != nil {
+			results[i].Error = errors.Trace(v.env.HandleCredentialError(ctx, err))
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (v *ebsVolumeSource) createVolumeSnapshot(ctx context.Context, p storage.VolumeSnapshotParams) (*storage.VolumeSnapshot, error) {
+	resourceTags := make(map[string]string)
+	maps.Copy(resourceTags, p.ResourceTags)
+	resourceTags[tagName] = fmt.Sprintf("%s-snapshot-%s", resourceName(p.Volume, v.envName), p.Id)
+
+	// EBS snapshots are point-in-time as soon as CreateSnapshot returns,
+	// even though the snapshot remains pending while its blocks are
+	// copied, so there is no need to wait for it to complete.
+	resp, err := v.env.ec2Client.CreateSnapshot(ctx, &ec2.CreateSnapshotInput{
+		VolumeId:    aws.String(p.VolumeId),
+		Description: aws.String(fmt.Sprintf("juju snapshot %s of volume %s", p.Id, p.Volume.Id())),
+		TagSpecifications: []types.TagSpecification{
+			CreateTagSpecification(types.ResourceTypeSnapshot, resourceTags),
+		},
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "creating snapshot of volume %q", p.VolumeId)
+	}
+	return &storage.VolumeSnapshot{
+		SnapshotId: aws.ToString(resp.SnapshotId),
+		Size:       gibToMib(uint64(aws.ToInt32(resp.VolumeSize))),
+	}, nil
+}
+
+// DestroyVolumeSnapshots is specified on the storage.VolumeSnapshotter
+// interface.
+func (v *ebsVolumeSource) DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		_, err := v.env.ec2Client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(snapshotId),
+		})
+		if err != nil && ec2ErrCode(err) != snapshotNotFound {
+			results[i] = errors.Annotatef(v.env.HandleCredentialError(ctx, err), "deleting snapshot %q", snapshotId)
+		}
+	}
+	return results, nil
+}
+
 func foreachVolume(ctx context.Context, client Client, volIds []string, f func(context.Context, Client, string) error) []error {
 	var wg sync.WaitGroup
 	wg.Add(len(volIds))
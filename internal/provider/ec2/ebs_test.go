@@ -626,6 +626,168 @@ func (s *ebsSuite) TestDescribeVolumesCredentialError(c *tc.C) {
 	c.Assert(results, tc.IsNil)
 }
 
+func (s *ebsSuite) TestResizeVolumes(c *tc.C) {
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+		Size:     12000,
+	}, {
+		// vol-1 is already big enough.
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "vol-1",
+		Size:     10240,
+	}, {
+		Tag:      names.NewVolumeTag("42"),
+		VolumeId: "vol-42",
+		Size:     10240,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Assert(results[0], tc.DeepEquals, storage.ResizeVolumesResult{
+		VolumeInfo: &storage.VolumeInfo{
+			Size:       12288,
+			VolumeId:   "vol-0",
+			Persistent: true,
+		},
+	})
+	c.Assert(results[1], tc.DeepEquals, storage.ResizeVolumesResult{
+		VolumeInfo: &storage.VolumeInfo{
+			Size:       20480,
+			VolumeId:   "vol-1",
+			Persistent: true,
+		},
+	})
+	c.Assert(results[2].Error, tc.ErrorMatches, "vol-42 not found")
+
+	vols, err := vs.DescribeVolumes(c.Context(), []string{"vol-0"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(vols[0].VolumeInfo.Size, tc.Equals, uint64(12288))
+}
+
+func (s *ebsSuite) TestResizeVolumesCredentialError(c *tc.C) {
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	s.srv.ec2srv.SetAPIError("ModifyVolume", &smithy.GenericAPIError{Code: "Blocked"})
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+		Size:     12000,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results[0].Error, tc.ErrorIs, common.ErrorCredentialNotValid)
+}
+
+func (s *ebsSuite) TestCreateVolumeSnapshots(c *tc.C) {
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	results, err := vs.(storage.VolumeSnapshotter).CreateVolumeSnapshots(c.Context(), []storage.VolumeSnapshotParams{{
+		Id:       "0",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+		ResourceTags: map[string]string{
+			tags.JujuModel: s.modelConfig.UUID(),
+		},
+	}, {
+		Id:       "1",
+		Volume:   names.NewVolumeTag("42"),
+		VolumeId: "vol-42",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Assert(results[0], tc.DeepEquals, storage.CreateVolumeSnapshotsResult{
+		Snapshot: &storage.VolumeSnapshot{
+			SnapshotId: "snap-0",
+			Size:       10240,
+		},
+	})
+	c.Assert(results[1].Error, tc.ErrorMatches, ".*vol-42 not found")
+
+	snapshots := s.srv.ec2srv.Snapshots()
+	c.Assert(snapshots, tc.HasLen, 1)
+	c.Check(aws.ToString(snapshots[0].VolumeId), tc.Equals, "vol-0")
+	c.Check(snapshots[0].Tags, tc.SameContents, []types.Tag{{
+		Key:   aws.String(tags.JujuModel),
+		Value: aws.String(s.modelConfig.UUID()),
+	}, {
+		Key:   aws.String("Name"),
+		Value: aws.String("juju-testmodel-volume-0-snapshot-0"),
+	}})
+}
+
+func (s *ebsSuite) TestCreateVolumeFromSnapshot(c *tc.C) {
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	snapshots, err := vs.(storage.VolumeSnapshotter).CreateVolumeSnapshots(c.Context(), []storage.VolumeSnapshotParams{{
+		Id:       "0",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(snapshots[0].Error, tc.ErrorIsNil)
+
+	params := s.createVolumesParams(c, "")
+	results, err := vs.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("6"),
+		Size:       20 * 1024,
+		Provider:   ec2.EBS_ProviderType,
+		Attachment: params[0].Attachment,
+		SnapshotId: snapshots[0].Snapshot.SnapshotId,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+
+	ec2Client := ec2.StorageEC2(vs)
+	resp, err := ec2Client.DescribeVolumes(c.Context(), &awsec2.DescribeVolumesInput{
+		VolumeIds: []string{results[0].Volume.VolumeId},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(resp.Volumes, tc.HasLen, 1)
+	c.Check(aws.ToString(resp.Volumes[0].SnapshotId), tc.Equals, "snap-0")
+	c.Check(aws.ToInt32(resp.Volumes[0].Size), tc.Equals, int32(20))
+}
+
+func (s *ebsSuite) TestDestroyVolumeSnapshots(c *tc.C) {
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	snapshotter := vs.(storage.VolumeSnapshotter)
+	_, err := snapshotter.CreateVolumeSnapshots(c.Context(), []storage.VolumeSnapshotParams{{
+		Id:       "0",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Destroying a snapshot that does not exist is not an error.
+	errs, err := snapshotter.DestroyVolumeSnapshots(c.Context(), []string{"snap-0", "snap-42"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.DeepEquals, []error{nil, nil})
+	c.Assert(s.srv.ec2srv.Snapshots(), tc.HasLen, 0)
+}
+
+func (s *ebsSuite) TestCreateVolumeSnapshotsCredentialError(c *tc.C) {
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	s.srv.ec2srv.SetAPIError("CreateSnapshot", &smithy.GenericAPIError{Code: "Blocked"})
+
+	results, err := vs.(storage.VolumeSnapshotter).CreateVolumeSnapshots(c.Context(), []storage.VolumeSnapshotParams{{
+		Id:       "0",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results[0].Error, tc.ErrorIs, common.ErrorCredentialNotValid)
+}
+
 func (s *ebsSuite) TestListVolumes(c *tc.C) {
 	vs := s.volumeSource(c, nil)
 	s.assertCreateVolumes(c, vs, "")
@@ -34,9 +34,11 @@ const (
         "ec2:AttachVolume",
         "ec2:AuthorizeSecurityGroupIngress",
         "ec2:CreateSecurityGroup",
+        "ec2:CreateSnapshot",
         "ec2:CreateTags",
         "ec2:CreateVolume",
         "ec2:DeleteSecurityGroup",
+        "ec2:DeleteSnapshot",
         "ec2:DeleteVolume",
         "ec2:DescribeAccountAttributes",
         "ec2:DescribeAvailabilityZones",
@@ -53,6 +55,7 @@ const (
         "ec2:DescribeVpcs",
         "ec2:DetachVolume",
 	"ec2:ModifyNetworkInterfaceAttribute",
+        "ec2:ModifyVolume",
         "ec2:RevokeSecurityGroupIngress",
         "ec2:RunInstances",
         "ec2:TerminateInstances"
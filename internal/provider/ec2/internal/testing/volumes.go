@@ -34,6 +34,18 @@ func (srv *Server) CreateVolume(ctx context.Context, in *ec2.CreateVolumeInput,
 	if in.Size != nil {
 		volume.Size = in.Size
 	}
+	if in.SnapshotId != nil {
+		snapshot, ok := srv.snapshots[aws.ToString(in.SnapshotId)]
+		if !ok {
+			return nil, apiError("InvalidSnapshot.NotFound", "Snapshot %s not found", aws.ToString(in.SnapshotId))
+		}
+		if in.Size == nil {
+			volume.Size = snapshot.VolumeSize
+		} else if aws.ToInt32(in.Size) < aws.ToInt32(snapshot.VolumeSize) {
+			return nil, apiError("InvalidParameterValue", "volume size %d is smaller than snapshot size %d", aws.ToInt32(in.Size), aws.ToInt32(snapshot.VolumeSize))
+		}
+		volume.SnapshotId = in.SnapshotId
+	}
 	volume.Encrypted = in.Encrypted
 	volume.Iops = in.Iops
 	volume.KmsKeyId = in.KmsKeyId
@@ -41,6 +53,7 @@ func (srv *Server) CreateVolume(ctx context.Context, in *ec2.CreateVolumeInput,
 
 	return &ec2.CreateVolumeOutput{
 		Attachments:      nil,
+		SnapshotId:       volume.SnapshotId,
 		AvailabilityZone: volume.AvailabilityZone,
 		Encrypted:        volume.Encrypted,
 		Iops:             volume.Iops,
@@ -116,6 +129,105 @@ func (srv *Server) DescribeVolumes(ctx context.Context, in *ec2.DescribeVolumesI
 	return result, nil
 }
 
+// ModifyVolume implements ec2.Client.
+func (srv *Server) ModifyVolume(ctx context.Context, in *ec2.ModifyVolumeInput, opts ...func(*ec2.Options)) (*ec2.ModifyVolumeOutput, error) {
+	srv.volumeMutatingCalls.next()
+
+	if err, ok := srv.apiCallErrors["ModifyVolume"]; ok {
+		return nil, err
+	}
+
+	v, err := srv.volume(aws.ToString(in.VolumeId))
+	if err != nil {
+		return nil, err
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	original := aws.ToInt32(v.Size)
+	if in.Size != nil {
+		if aws.ToInt32(in.Size) < original {
+			return nil, apiError("InvalidParameterValue", "new size %d is smaller than current size %d", aws.ToInt32(in.Size), original)
+		}
+		v.Size = in.Size
+	}
+	return &ec2.ModifyVolumeOutput{
+		VolumeModification: &types.VolumeModification{
+			VolumeId:          v.VolumeId,
+			ModificationState: types.VolumeModificationStateOptimizing,
+			OriginalSize:      aws.Int32(original),
+			TargetSize:        v.Size,
+		},
+	}, nil
+}
+
+// CreateSnapshot implements ec2.Client.
+func (srv *Server) CreateSnapshot(ctx context.Context, in *ec2.CreateSnapshotInput, opts ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error) {
+	srv.volumeMutatingCalls.next()
+
+	if err, ok := srv.apiCallErrors["CreateSnapshot"]; ok {
+		return nil, err
+	}
+
+	v, err := srv.volume(aws.ToString(in.VolumeId))
+	if err != nil {
+		return nil, err
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	snapshot := &types.Snapshot{
+		SnapshotId:  aws.String(fmt.Sprintf("snap-%d", srv.snapshotId.next())),
+		VolumeId:    v.VolumeId,
+		VolumeSize:  v.Size,
+		Description: in.Description,
+		State:       types.SnapshotStatePending,
+		StartTime:   aws.Time(time.Now()),
+		Tags:        tagSpecForType(types.ResourceTypeSnapshot, in.TagSpecifications).Tags,
+	}
+	srv.snapshots[aws.ToString(snapshot.SnapshotId)] = snapshot
+	return &ec2.CreateSnapshotOutput{
+		SnapshotId:  snapshot.SnapshotId,
+		VolumeId:    snapshot.VolumeId,
+		VolumeSize:  snapshot.VolumeSize,
+		Description: snapshot.Description,
+		State:       snapshot.State,
+		StartTime:   snapshot.StartTime,
+		Tags:        snapshot.Tags,
+	}, nil
+}
+
+// DeleteSnapshot implements ec2.Client.
+func (srv *Server) DeleteSnapshot(ctx context.Context, in *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error) {
+	srv.volumeMutatingCalls.next()
+
+	if err, ok := srv.apiCallErrors["DeleteSnapshot"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	snapshotId := aws.ToString(in.SnapshotId)
+	if _, ok := srv.snapshots[snapshotId]; !ok {
+		return nil, apiError("InvalidSnapshot.NotFound", "Snapshot %s not found", snapshotId)
+	}
+	delete(srv.snapshots, snapshotId)
+	return &ec2.DeleteSnapshotOutput{}, nil
+}
+
+// Snapshots returns the snapshots known to the server.
+func (srv *Server) Snapshots() []types.Snapshot {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	result := make([]types.Snapshot, 0, len(srv.snapshots))
+	for _, snapshot := range srv.snapshots {
+		result = append(result, *snapshot)
+	}
+	return result
+}
+
 // SetCreateRootDisks records whether or not the server should create
 // root disks for each instance created. It defaults to false.
 func (srv *Server) SetCreateRootDisks(create bool) {
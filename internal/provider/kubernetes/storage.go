@@ -464,7 +464,10 @@ type filesystemSource struct {
 	client *kubernetesClient
 }
 
-var _ jujustorage.FilesystemSource = (*filesystemSource)(nil)
+var (
+	_ jujustorage.FilesystemSource  = (*filesystemSource)(nil)
+	_ jujustorage.FilesystemResizer = (*filesystemSource)(nil)
+)
 
 // ValidateFilesystemParams is specified on the jujustorage.FilesystemSource interface.
 func (v *filesystemSource) ValidateFilesystemParams(
@@ -542,6 +545,78 @@ func (v *filesystemSource) getPersistentVolume(
 	return fs, nil
 }
 
+// ResizeFilesystems is specified on the jujustorage.FilesystemResizer
+// interface.
+//
+// Kubernetes filesystems are grown by raising the storage request of the
+// PersistentVolumeClaim bound to the filesystem's PersistentVolume. The
+// volume is then expanded by Kubernetes, which requires the claim's
+// StorageClass to allow volume expansion.
+func (v *filesystemSource) ResizeFilesystems(
+	ctx context.Context,
+	params []jujustorage.ResizeFilesystemParams,
+) ([]jujustorage.ResizeFilesystemsResult, error) {
+	results := make([]jujustorage.ResizeFilesystemsResult, 0, len(params))
+	for _, param := range params {
+		var result jujustorage.ResizeFilesystemsResult
+		if err := v.expandPersistentVolumeClaim(ctx, param.FilesystemId, param.Size); err != nil {
+			result.Error = errors.Errorf(
+				"resizing kubernetes filesystem %q with PersistentVolume %q: %w",
+				param.Tag.Id(), param.FilesystemId, err,
+			)
+		} else {
+			result.FilesystemInfo = &jujustorage.FilesystemInfo{
+				ProviderId: param.FilesystemId,
+				Size:       param.Size,
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (v *filesystemSource) expandPersistentVolumeClaim(
+	ctx context.Context, pvName string, sizeMiB uint64,
+) error {
+	client := v.client.client()
+	pv, err := client.CoreV1().PersistentVolumes().Get(ctx, pvName, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.New(
+			"kubernetes PersistentVolume not found",
+		).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return errors.Errorf("getting kubernetes PersistentVolume: %w", err)
+	}
+	if pv.Spec.ClaimRef == nil {
+		return errors.New(
+			"kubernetes PersistentVolume is not bound to a claim",
+		).Add(coreerrors.NotProvisioned)
+	}
+
+	pvcAPI := client.CoreV1().PersistentVolumeClaims(pv.Spec.ClaimRef.Namespace)
+	pvc, err := pvcAPI.Get(ctx, pv.Spec.ClaimRef.Name, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.New(
+			"kubernetes PersistentVolumeClaim not found",
+		).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return errors.Errorf("getting kubernetes PersistentVolumeClaim: %w", err)
+	}
+
+	size := resource.MustParse(fmt.Sprintf("%dMi", sizeMiB))
+	if current, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]; ok && current.Cmp(size) >= 0 {
+		return nil
+	}
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = core.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[core.ResourceStorage] = size
+	if _, err := pvcAPI.Update(ctx, pvc, v1.UpdateOptions{}); err != nil {
+		return errors.Errorf("updating kubernetes PersistentVolumeClaim %q: %w", pvc.Name, err)
+	}
+	return nil
+}
+
 func quantityAsMibiBytes(q resource.Quantity) uint64 {
 	return uint64(q.MilliValue()) / 1000 / 1024 / 1024
 }
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/mock/gomock"
 	core "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -119,6 +120,79 @@ func (s *storageSuite) TestDestroyFilesystemsNotFoundIgnored(c *tc.C) {
 	c.Assert(errs, tc.DeepEquals, []error{nil})
 }
 
+func (s *storageSuite) TestResizeFilesystems(c *tc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	pvc := &core.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{Name: "data-0", Namespace: s.getNamespace()},
+		Spec: core.PersistentVolumeClaimSpec{
+			Resources: core.VolumeResourceRequirements{
+				Requests: core.ResourceList{
+					core.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+	expanded := *pvc
+	expanded.Spec.Resources.Requests = core.ResourceList{
+		core.ResourceStorage: resource.MustParse("2048Mi"),
+	}
+	gomock.InOrder(
+		s.mockPersistentVolumes.EXPECT().Get(gomock.Any(), "vol-1", v1.GetOptions{}).
+			Return(&core.PersistentVolume{
+				ObjectMeta: v1.ObjectMeta{Name: "vol-1"},
+				Spec: core.PersistentVolumeSpec{
+					ClaimRef: &core.ObjectReference{Name: "data-0", Namespace: s.getNamespace()},
+				},
+			}, nil),
+		s.mockPersistentVolumeClaims.EXPECT().Get(gomock.Any(), "data-0", v1.GetOptions{}).
+			Return(pvc, nil),
+		s.mockPersistentVolumeClaims.EXPECT().Update(gomock.Any(), &expanded, v1.UpdateOptions{}).
+			Return(&expanded, nil),
+	)
+
+	p := s.k8sProvider()
+	fs, err := p.FilesystemSource(&storage.Config{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	results, err := fs.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:          names.NewFilesystemTag("1"),
+		FilesystemId: "vol-1",
+		Size:         2048,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, []storage.ResizeFilesystemsResult{{
+		FilesystemInfo: &storage.FilesystemInfo{
+			ProviderId: "vol-1",
+			Size:       2048,
+		},
+	}})
+}
+
+func (s *storageSuite) TestResizeFilesystemsNotBound(c *tc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	s.mockPersistentVolumes.EXPECT().Get(gomock.Any(), "vol-1", v1.GetOptions{}).
+		Return(&core.PersistentVolume{
+			ObjectMeta: v1.ObjectMeta{Name: "vol-1"},
+		}, nil)
+
+	p := s.k8sProvider()
+	fs, err := p.FilesystemSource(&storage.Config{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	results, err := fs.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:          names.NewFilesystemTag("1"),
+		FilesystemId: "vol-1",
+		Size:         2048,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIs, coreerrors.NotProvisioned)
+}
+
 func (s *storageSuite) TestValidateStorageProvider(c *tc.C) {
 	ctrl := s.setupController(c)
 	defer ctrl.Finish()
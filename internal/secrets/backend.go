@@ -36,6 +36,22 @@ func getBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, erro
 	return p.NewBackend(cfg)
 }
 
+// newBackend returns a client for the specified backend. Backends which
+// agents cannot access themselves are accessed through the controller,
+// if the Juju API client supports it.
+func (c *secretsClient) newBackend(backendID string, cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+	p, err := provider.Provider(cfg.BackendType)
+	if err != nil {
+		return GetBackend(cfg)
+	}
+	proxied, ok := p.(provider.SupportContentProxy)
+	if !ok {
+		return GetBackend(cfg)
+	}
+	proxy, _ := c.jujuAPI.(provider.ContentProxy)
+	return proxied.NewProxiedBackend(cfg, backendID, proxy)
+}
+
 // NewClient returns a new secret client configured to use the specified
 // secret backend as a content backend.
 func NewClient(jujuAPI JujuAPIClient) (*secretsClient, error) {
@@ -52,7 +68,11 @@ func (c *secretsClient) GetBackend(ctx context.Context, backendID *string, forDr
 		if err != nil {
 			return nil, "", errors.Trace(err)
 		}
-		b, err := GetBackend(cfg)
+		id := activeID
+		if backendID != nil {
+			id = *backendID
+		}
+		b, err := c.newBackend(id, cfg)
 		if err != nil {
 			return nil, "", errors.Trace(err)
 		}
@@ -70,7 +90,7 @@ func (c *secretsClient) GetBackend(ctx context.Context, backendID *string, forDr
 	if !ok {
 		return nil, "", errors.Errorf("secret backend %q missing from config", want)
 	}
-	b, err := GetBackend(&cfg)
+	b, err := c.newBackend(want, &cfg)
 	return b, info.ActiveID, errors.Trace(err)
 }
 
@@ -90,7 +110,7 @@ func (c *secretsClient) GetContent(ctx context.Context, uri *secrets.URI, label
 		}
 
 		backendID := content.ValueRef.BackendID
-		backend, err := GetBackend(backendCfg)
+		backend, err := c.newBackend(backendID, backendCfg)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -160,7 +180,7 @@ func (c *secretsClient) DeleteContent(ctx context.Context, uri *secrets.URI, rev
 		}
 
 		backendID := content.ValueRef.BackendID
-		backend, err := GetBackend(backendCfg)
+		backend, err := c.newBackend(backendID, backendCfg)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -4,6 +4,7 @@
 package secrets_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/juju/collections/set"
@@ -15,6 +16,7 @@ import (
 	"github.com/juju/juju/internal/secrets"
 	"github.com/juju/juju/internal/secrets/mocks"
 	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
 	"github.com/juju/juju/internal/testhelpers"
 )
 
@@ -413,3 +415,68 @@ func (s *backendSuite) TestGetRevisionContent(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(val, tc.Equals, secretValue)
 }
+
+// proxyingAPIClient is a Juju API client which also accesses backend
+// content on behalf of the agent.
+type proxyingAPIClient struct {
+	*mocks.MockJujuAPIClient
+	calls []string
+}
+
+func (p *proxyingAPIClient) GetBackendContent(_ context.Context, backendID, token, revisionId string) (coresecrets.SecretValue, error) {
+	p.calls = append(p.calls, "get "+backendID+" "+token+" "+revisionId)
+	return coresecrets.NewSecretValue(map[string]string{"foo": "bar"}), nil
+}
+
+func (p *proxyingAPIClient) SaveBackendContent(_ context.Context, backendID, token string, uri *coresecrets.URI, revision int, _ coresecrets.SecretValue) (string, error) {
+	p.calls = append(p.calls, "save "+backendID+" "+token+" "+uri.Name(revision))
+	return uri.Name(revision), nil
+}
+
+func (p *proxyingAPIClient) DeleteBackendContent(_ context.Context, backendID, token, revisionId string) error {
+	p.calls = append(p.calls, "delete "+backendID+" "+token+" "+revisionId)
+	return nil
+}
+
+func (s *backendSuite) TestContentThroughController(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	jujuapi := &proxyingAPIClient{MockJujuAPIClient: mocks.NewMockJujuAPIClient(ctrl)}
+	client, err := secrets.NewClient(jujuapi)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Agents are only given an access token for the file backend, which
+	// the controller checks when accessing content on their behalf.
+	cfg := provider.ModelBackendConfig{
+		ModelUUID: "model-uuid",
+		BackendConfig: provider.BackendConfig{
+			BackendType: "file",
+			Config:      provider.ConfigAttrs{"token": "token"},
+		},
+	}
+	uri := coresecrets.NewURI()
+	jujuapi.EXPECT().GetContentInfo(gomock.Any(), uri, "label", true, false).Return(
+		&secrets.ContentParams{ValueRef: &coresecrets.ValueRef{
+			BackendID:  "file-id",
+			RevisionID: "rev-id",
+		}}, &cfg, false, nil,
+	)
+	jujuapi.EXPECT().GetSecretBackendConfig(gomock.Any(), nil).Return(&provider.ModelBackendConfigInfo{
+		ActiveID: "file-id",
+		Configs:  map[string]provider.ModelBackendConfig{"file-id": cfg},
+	}, nil)
+
+	val, err := client.GetContent(c.Context(), uri, "label", true, false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "bar"})
+
+	ref, err := client.SaveContent(c.Context(), uri, 1, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ref, tc.DeepEquals, coresecrets.ValueRef{BackendID: "file-id", RevisionID: uri.Name(1)})
+
+	c.Assert(jujuapi.calls, tc.DeepEquals, []string{
+		"get file-id token rev-id",
+		"save file-id token " + uri.Name(1),
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package encryption provides the envelope encryption used to protect secret
+// content stored by the internal juju secret backend.
+//
+// Secret values are encrypted with a per-model data key. The data key is
+// itself encrypted (wrapped) with a controller key-encryption key, so that
+// the model database never holds the material needed to decrypt its own
+// secret content. Rotating the key-encryption key only requires the data
+// keys to be rewrapped; secret content is left untouched.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// KeySize is the size in bytes of both data keys and key-encryption keys.
+// Keys of this size select AES-256.
+const KeySize = 32
+
+// ErrDecrypt is returned when a ciphertext cannot be decrypted with the
+// supplied key, either because the key is wrong or the ciphertext has been
+// tampered with.
+const ErrDecrypt = errors.ConstError("decrypting ciphertext")
+
+// NewKey returns a new random key suitable for use as either a data key or
+// a key-encryption key.
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Errorf("generating key: %w", err)
+	}
+	return key, nil
+}
+
+// Encrypt seals the input plaintext with the input key using AES-GCM and
+// returns the base64 encoded nonce and ciphertext.
+func Encrypt(key, plaintext []byte) (string, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Errorf("generating nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens the input base64 encoded ciphertext, as produced by
+// [Encrypt], with the input key. [ErrDecrypt] is returned if the ciphertext
+// was not sealed with the key or has been modified.
+func Decrypt(key []byte, ciphertext string) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, errors.Errorf("decoding ciphertext: %w", err).Add(ErrDecrypt)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.Errorf("ciphertext too short").Add(ErrDecrypt)
+	}
+
+	nonce, sealed := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, errors.Errorf("opening ciphertext: %w", err).Add(ErrDecrypt)
+	}
+	return plaintext, nil
+}
+
+// WrapKey encrypts the input data key with the input key-encryption key.
+func WrapKey(kek, dataKey []byte) (string, error) {
+	if len(dataKey) != KeySize {
+		return "", errors.Errorf("data key must be %d bytes, got %d", KeySize, len(dataKey))
+	}
+	return Encrypt(kek, dataKey)
+}
+
+// UnwrapKey decrypts the input wrapped data key, as produced by [WrapKey],
+// with the input key-encryption key.
+func UnwrapKey(kek []byte, wrapped string) ([]byte, error) {
+	dataKey, err := Decrypt(kek, wrapped)
+	if err != nil {
+		return nil, errors.Errorf("unwrapping data key: %w", err)
+	}
+	if len(dataKey) != KeySize {
+		return nil, errors.Errorf("unwrapped data key must be %d bytes, got %d", KeySize, len(dataKey))
+	}
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, errors.Errorf("key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Errorf("creating cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Errorf("creating GCM: %w", err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/secrets/encryption"
+)
+
+type encryptionSuite struct{}
+
+func TestEncryptionSuite(t *testing.T) {
+	tc.Run(t, &encryptionSuite{})
+}
+
+func (s *encryptionSuite) TestEncryptDecrypt(c *tc.C) {
+	key, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(key, tc.HasLen, encryption.KeySize)
+
+	ciphertext, err := encryption.Encrypt(key, []byte("s3cret"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ciphertext, tc.Not(tc.Contains), "s3cret")
+
+	plaintext, err := encryption.Decrypt(key, ciphertext)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(plaintext), tc.Equals, "s3cret")
+}
+
+func (s *encryptionSuite) TestEncryptUsesFreshNonce(c *tc.C) {
+	key, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	first, err := encryption.Encrypt(key, []byte("s3cret"))
+	c.Assert(err, tc.ErrorIsNil)
+	second, err := encryption.Encrypt(key, []byte("s3cret"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(first, tc.Not(tc.Equals), second)
+}
+
+func (s *encryptionSuite) TestDecryptWrongKey(c *tc.C) {
+	key, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	other, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	ciphertext, err := encryption.Encrypt(key, []byte("s3cret"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = encryption.Decrypt(other, ciphertext)
+	c.Check(err, tc.ErrorIs, encryption.ErrDecrypt)
+}
+
+func (s *encryptionSuite) TestDecryptInvalidCiphertext(c *tc.C) {
+	key, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = encryption.Decrypt(key, "not base64!")
+	c.Check(err, tc.ErrorIs, encryption.ErrDecrypt)
+
+	_, err = encryption.Decrypt(key, "c2hvcnQ=")
+	c.Check(err, tc.ErrorIs, encryption.ErrDecrypt)
+}
+
+func (s *encryptionSuite) TestInvalidKeySize(c *tc.C) {
+	_, err := encryption.Encrypt([]byte("short"), []byte("s3cret"))
+	c.Check(err, tc.ErrorMatches, "key must be 32 bytes, got 5")
+}
+
+func (s *encryptionSuite) TestWrapUnwrapKey(c *tc.C) {
+	kek, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	dataKey, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	wrapped, err := encryption.WrapKey(kek, dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	unwrapped, err := encryption.UnwrapKey(kek, wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+}
+
+func (s *encryptionSuite) TestUnwrapKeyWrongKey(c *tc.C) {
+	kek, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	other, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	dataKey, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	wrapped, err := encryption.WrapKey(kek, dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = encryption.UnwrapKey(other, wrapped)
+	c.Check(err, tc.ErrorIs, encryption.ErrDecrypt)
+}
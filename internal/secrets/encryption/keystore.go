@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// ErrKeyNotFound is returned when a key-encryption key is not held by a
+// key store.
+const ErrKeyNotFound = errors.ConstError("key-encryption key not found")
+
+// keyStoreDir is the directory, within the agent data directory, holding
+// the controller key-encryption keys.
+const keyStoreDir = "secret-encryption-keys"
+
+// FileKeyStore holds key-encryption key material in files readable only by
+// the controller agent, so that the material is not stored alongside the
+// data keys it protects in the controller database. Each controller node
+// holds its own copy of the keys.
+type FileKeyStore struct {
+	dir string
+}
+
+// NewFileKeyStore returns a key store holding keys beneath the input agent
+// data directory.
+func NewFileKeyStore(dataDir string) *FileKeyStore {
+	return &FileKeyStore{
+		dir: filepath.Join(dataDir, keyStoreDir),
+	}
+}
+
+// Get returns the material of the key with the input UUID, returning an
+// error satisfying [ErrKeyNotFound] if the key is not held.
+func (s *FileKeyStore) Get(keyUUID string) ([]byte, error) {
+	path, err := s.path(keyUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	encoded, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Errorf("key %q not found in %q", keyUUID, s.dir).Add(ErrKeyNotFound)
+	} else if err != nil {
+		return nil, errors.Errorf("reading key %q: %w", keyUUID, err)
+	}
+	material, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, errors.Errorf("decoding key %q: %w", keyUUID, err)
+	}
+	return material, nil
+}
+
+// Put stores the material of the key with the input UUID.
+func (s *FileKeyStore) Put(keyUUID string, material []byte) error {
+	path, err := s.path(keyUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return errors.Errorf("creating key directory: %w", err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(material)
+	if err := utils.AtomicWriteFile(path, []byte(encoded), 0600); err != nil {
+		return errors.Errorf("writing key %q: %w", keyUUID, err)
+	}
+	return nil
+}
+
+// Remove removes the key with the input UUID. Removing a key which is not
+// held is not an error.
+func (s *FileKeyStore) Remove(keyUUID string) error {
+	path, err := s.path(keyUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Errorf("removing key %q: %w", keyUUID, err)
+	}
+	return nil
+}
+
+// path returns the path of the file holding the key with the input UUID.
+// Only UUIDs are accepted, so that the path cannot escape the key directory.
+func (s *FileKeyStore) path(keyUUID string) (string, error) {
+	if !uuid.IsValidUUIDString(keyUUID) {
+		return "", errors.Errorf("key UUID %q not valid", keyUUID)
+	}
+	return filepath.Join(s.dir, keyUUID), nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryption_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type keyStoreSuite struct{}
+
+func TestKeyStoreSuite(t *testing.T) {
+	tc.Run(t, &keyStoreSuite{})
+}
+
+func (s *keyStoreSuite) TestPutGet(c *tc.C) {
+	dataDir := c.MkDir()
+	store := encryption.NewFileKeyStore(dataDir)
+	keyUUID := uuid.MustNewUUID().String()
+	material, err := encryption.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = store.Put(keyUUID, material)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := store.Get(keyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, material)
+
+	info, err := os.Stat(filepath.Join(dataDir, "secret-encryption-keys", keyUUID))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.Mode().Perm(), tc.Equals, os.FileMode(0600))
+}
+
+func (s *keyStoreSuite) TestGetNotFound(c *tc.C) {
+	store := encryption.NewFileKeyStore(c.MkDir())
+
+	_, err := store.Get(uuid.MustNewUUID().String())
+	c.Assert(err, tc.ErrorIs, encryption.ErrKeyNotFound)
+}
+
+func (s *keyStoreSuite) TestRemove(c *tc.C) {
+	store := encryption.NewFileKeyStore(c.MkDir())
+	keyUUID := uuid.MustNewUUID().String()
+	err := store.Put(keyUUID, []byte("material"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = store.Remove(keyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = store.Get(keyUUID)
+	c.Check(err, tc.ErrorIs, encryption.ErrKeyNotFound)
+
+	// Removing a key which is not held is not an error.
+	err = store.Remove(keyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *keyStoreSuite) TestInvalidKeyUUID(c *tc.C) {
+	store := encryption.NewFileKeyStore(c.MkDir())
+
+	err := store.Put("../escape", []byte("material"))
+	c.Assert(err, tc.ErrorMatches, `key UUID "../escape" not valid`)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/juju/internal/secrets/provider"
 	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/file"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/secrets/provider/vault"
@@ -29,6 +30,7 @@ func (s *allSuite) TestInit(c *tc.C) {
 		juju.BackendType,
 		kubernetes.BackendType,
 		vault.BackendType,
+		file.BackendType,
 	} {
 		p, err := provider.Provider(name)
 		c.Check(err, tc.ErrorIsNil)
@@ -0,0 +1,180 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	jujusecrets "github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/encryption"
+)
+
+type fileBackend struct {
+	rootPath string
+	dir      string
+	modelKey []byte
+	// grant is nil for admin clients.
+	grant *accessGrant
+}
+
+// newFileBackend returns a client for the input model's secrets. A client
+// created without a model, as when the backend is being validated, can only be
+// pinged.
+func newFileBackend(rootPath, modelUUID string, modelKey []byte, grant *accessGrant) (*fileBackend, error) {
+	if modelUUID == "" {
+		return &fileBackend{rootPath: rootPath, grant: grant}, nil
+	}
+	dir, err := modelDir(rootPath, modelUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &fileBackend{
+		rootPath: rootPath,
+		dir:      dir,
+		modelKey: modelKey,
+		grant:    grant,
+	}, nil
+}
+
+// GetContent implements SecretsBackend.
+func (b *fileBackend) GetContent(_ context.Context, revisionId string) (secrets.SecretValue, error) {
+	secretID, err := secretIDFromRevision(revisionId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := b.checkAccess(secretID, (*accessGrant).canRead); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	ciphertext, err := os.ReadFile(filepath.Join(b.dir, revisionId))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "reading secret %q", revisionId)
+	}
+	plaintext, err := encryption.Decrypt(b.modelKey, string(ciphertext))
+	if err != nil {
+		return nil, errors.Annotatef(err, "decrypting secret %q", revisionId)
+	}
+	var val map[string]string
+	if err := json.Unmarshal(plaintext, &val); err != nil {
+		return nil, errors.Annotatef(err, "parsing secret %q", revisionId)
+	}
+	return secrets.NewSecretValue(val), nil
+}
+
+// DeleteContent implements SecretsBackend.
+func (b *fileBackend) DeleteContent(_ context.Context, revisionId string) error {
+	secretID, err := secretIDFromRevision(revisionId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := b.checkAccess(secretID, (*accessGrant).owns); err != nil {
+		return errors.Trace(err)
+	}
+
+	err = os.Remove(filepath.Join(b.dir, revisionId))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	}
+	return errors.Annotatef(err, "deleting secret %q", revisionId)
+}
+
+// SaveContent implements SecretsBackend.
+func (b *fileBackend) SaveContent(_ context.Context, uri *secrets.URI, revision int, value secrets.SecretValue) (string, error) {
+	revisionId := uri.Name(revision)
+	path := filepath.Join(b.dir, revisionId)
+
+	// Any client may create new content, but only those permitted to
+	// update the secret may overwrite existing content.
+	if _, err := os.Stat(path); err == nil {
+		if err := b.checkAccess(uri.ID, (*accessGrant).canUpdate); err != nil {
+			return "", errors.Trace(err)
+		}
+	} else if err := b.checkAccess(uri.ID, nil); err != nil {
+		return "", errors.Trace(err)
+	}
+
+	plaintext, err := json.Marshal(value.EncodedValues())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	ciphertext, err := encryption.Encrypt(b.modelKey, plaintext)
+	if err != nil {
+		return "", errors.Annotatef(err, "encrypting secret content for %q", revisionId)
+	}
+
+	// Write to a temporary file first so readers never see partial content.
+	tmp, err := os.CreateTemp(b.dir, "."+revisionId+"-*")
+	if err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.WriteString(ciphertext); err != nil {
+		_ = tmp.Close()
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	return revisionId, nil
+}
+
+// Ping implements SecretsBackend.
+func (b *fileBackend) Ping() error {
+	info, err := os.Stat(b.rootPath)
+	if err != nil {
+		return errors.Annotate(err, "backend not reachable")
+	}
+	if !info.IsDir() {
+		return errors.Errorf("backend path %q is not a directory", b.rootPath)
+	}
+	if b.grant != nil && !Now().Before(b.grant.Expires) {
+		return errors.New("auth token invalid: token expired")
+	}
+	return nil
+}
+
+// checkAccess returns an error satisfying [jujusecrets.PermissionDenied] if
+// the client's access token has expired or, when allowed is not nil, does not
+// permit the access to the input secret. Clients without a model cannot
+// access any secret.
+func (b *fileBackend) checkAccess(secretID string, allowed func(*accessGrant, string) bool) error {
+	if b.dir == "" {
+		return errors.NotValidf("file backend client without a model")
+	}
+	if b.grant == nil {
+		return nil
+	}
+	if !Now().Before(b.grant.Expires) {
+		return errors.WithType(errors.New("secret access token expired"), jujusecrets.PermissionDenied)
+	}
+	if allowed != nil && !allowed(b.grant, secretID) {
+		return errors.WithType(
+			errors.Errorf("access to secret %q not permitted", secretID), jujusecrets.PermissionDenied)
+	}
+	return nil
+}
+
+// secretIDFromRevision returns the ID of the secret for the input revision
+// ID, as generated by [secrets.URI.Name].
+func secretIDFromRevision(revisionId string) (string, error) {
+	i := strings.LastIndex(revisionId, "-")
+	if i <= 0 || filepath.Base(revisionId) != revisionId || strings.HasPrefix(revisionId, ".") {
+		return "", errors.NotValidf("secret revision ID %q", revisionId)
+	}
+	return revisionId[:i], nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	coreconfig "github.com/juju/juju/core/config"
+	"github.com/juju/juju/internal/configschema"
+	"github.com/juju/juju/internal/secrets/encryption"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+const (
+	// PathKey is the directory in which secret content is stored.
+	PathKey = "path"
+	// KeyKey is the base64 encoded root key from which model keys are
+	// derived.
+	KeyKey = "key"
+	// SharedStorageKey is whether the directory is on storage shared by
+	// every controller node.
+	SharedStorageKey = "shared-storage"
+
+	// ModelKeyKey is the base64 encoded key for a single model, issued to
+	// controller side clients in place of the root key.
+	ModelKeyKey = "model-key"
+	// TokenKey is the signed access token issued to agents, which they
+	// present to the controller to access secret content.
+	TokenKey = "token"
+)
+
+var configSchema = configschema.Fields{
+	PathKey: {
+		Description: "The absolute path of the directory in which to store secrets.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+		Mandatory:   true,
+	},
+	KeyKey: {
+		Description: "The base64 encoded 32 byte key used to encrypt secrets.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+		Mandatory:   true,
+		Secret:      true,
+	},
+	SharedStorageKey: {
+		Description: "Whether the path is on storage shared by every controller node, as is required by a controller with more than one node.",
+		Type:        configschema.Tbool,
+	},
+}
+
+var configDefaults = schema.Defaults{
+	SharedStorageKey: schema.Omit,
+}
+
+type backendConfig struct {
+	validAttrs map[string]any
+}
+
+func (c *backendConfig) path() string {
+	return c.validAttrs[PathKey].(string)
+}
+
+func (c *backendConfig) key() ([]byte, error) {
+	return decodeKey(c.validAttrs[KeyKey].(string))
+}
+
+func (c *backendConfig) sharedStorage() bool {
+	v, _ := c.validAttrs[SharedStorageKey].(bool)
+	return v
+}
+
+// ConfigSchema implements SecretBackendProvider.
+func (p fileProvider) ConfigSchema() configschema.Fields {
+	return configSchema
+}
+
+// ConfigDefaults implements SecretBackendProvider.
+func (p fileProvider) ConfigDefaults() schema.Defaults {
+	return schema.Defaults{}
+}
+
+// ValidateConfig implements SecretBackendProvider.
+func (p fileProvider) ValidateConfig(oldCfg, newCfg provider.ConfigAttrs, tokenRotateInterval *time.Duration) error {
+	newValidCfg, err := newConfig(newCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !filepath.IsAbs(newValidCfg.path()) {
+		return errors.NotValidf("relative file backend path %q", newValidCfg.path())
+	}
+	if _, err := newValidCfg.key(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if oldCfg == nil {
+		return nil
+	}
+	oldValidCfg, err := newConfig(oldCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for n, field := range configSchema {
+		if !field.Immutable {
+			continue
+		}
+		if oldValidCfg.validAttrs[n] != newValidCfg.validAttrs[n] {
+			return errors.Errorf("cannot change immutable field %q", n)
+		}
+	}
+	return nil
+}
+
+// ValidateControllerNodes implements [provider.SupportControllerNodes]. Each
+// controller node reads and writes the backend's directory itself, so a
+// controller with more than one node can only use a directory on storage
+// shared by every node.
+func (p fileProvider) ValidateControllerNodes(cfg provider.ConfigAttrs, numNodes int) error {
+	if numNodes <= 1 {
+		return nil
+	}
+	validCfg, err := newConfig(cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !validCfg.sharedStorage() {
+		return errors.NotSupportedf(
+			"file backend path %q on a controller with %d nodes unless %q is set", validCfg.path(), numNodes, SharedStorageKey)
+	}
+	return nil
+}
+
+func newConfig(attrs map[string]any) (*backendConfig, error) {
+	cfg, err := coreconfig.NewConfig(attrs, configSchema, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &backendConfig{cfg.Attributes()}, nil
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.NotValidf("file backend key encoding")
+	}
+	if len(key) != encryption.KeySize {
+		return nil, errors.NotValidf("file backend key of %d bytes, expected %d", len(key), encryption.KeySize)
+	}
+	return key, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/file"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type configSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestConfigSuite(t *testing.T) {
+	tc.Run(t, &configSuite{})
+}
+
+func (s *configSuite) TestValidateConfig(c *tc.C) {
+	p, err := provider.Provider(file.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, tc.IsTrue)
+
+	key := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	otherKey := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	for _, t := range []struct {
+		cfg    map[string]any
+		oldCfg map[string]any
+		err    string
+	}{{
+		cfg: map[string]any{"key": key},
+		err: "path: expected string, got nothing",
+	}, {
+		cfg: map[string]any{"path": "/var/lib/secrets"},
+		err: "key: expected string, got nothing",
+	}, {
+		cfg: map[string]any{"path": "secrets", "key": key},
+		err: `relative file backend path "secrets" not valid`,
+	}, {
+		cfg: map[string]any{"path": "/var/lib/secrets", "key": "not base64!"},
+		err: `file backend key encoding not valid`,
+	}, {
+		cfg: map[string]any{"path": "/var/lib/secrets", "key": base64.StdEncoding.EncodeToString([]byte("short"))},
+		err: `file backend key of 5 bytes, expected 32 not valid`,
+	}, {
+		cfg:    map[string]any{"path": "/var/lib/secrets", "key": otherKey},
+		oldCfg: map[string]any{"path": "/var/lib/secrets", "key": key},
+		err:    `cannot change immutable field "key"`,
+	}} {
+		err = configValidator.ValidateConfig(t.oldCfg, t.cfg, nil)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+
+	err = configValidator.ValidateConfig(nil, map[string]any{"path": "/var/lib/secrets", "key": key}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package file provides a secrets backend which stores encrypted secret
+// content in a directory, intended for edge deployments and testing where
+// running an external secret store such as Vault is not practical.
+//
+// The directory must be available to the controller. A controller with more
+// than one node can only use the backend if the directory is on storage shared
+// by every node, such as a network mount, which is declared with the
+// shared-storage config attribute. Agents never access it.
+//
+// Content is encrypted with a key derived per model from the backend's root
+// key. Agents are only given an access token listing the secrets they may
+// manage or read, in the same way as the policies issued by Vault. The token
+// is signed with a key known only to the controller, which checks it and
+// accesses content on the agent's behalf.
+package file
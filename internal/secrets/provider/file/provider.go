@@ -0,0 +1,214 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	jujusecrets "github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+const (
+	// BackendType is the type of the file secrets backend.
+	BackendType = "file"
+
+	// tokenTTL is how long an issued access token is valid for.
+	tokenTTL = 10 * time.Minute
+)
+
+// Now is patched for testing.
+var Now = time.Now
+
+// NewProvider returns a file secrets provider.
+func NewProvider() provider.SecretBackendProvider {
+	return fileProvider{}
+}
+
+type fileProvider struct {
+}
+
+func (p fileProvider) Type() string {
+	return BackendType
+}
+
+// Initialise creates the directory holding the model's secrets.
+func (p fileProvider) Initialise(cfg *provider.ModelBackendConfig) error {
+	validCfg, err := newConfig(cfg.Config)
+	if err != nil {
+		return errors.Annotatef(err, "invalid file backend config")
+	}
+	dir, err := modelDir(validCfg.path(), cfg.ModelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.MkdirAll(dir, 0700))
+}
+
+// CleanupModel deletes all secrets associated with the model.
+func (p fileProvider) CleanupModel(_ context.Context, cfg *provider.ModelBackendConfig) error {
+	validCfg, err := newConfig(cfg.Config)
+	if err != nil {
+		return errors.Annotatef(err, "invalid file backend config")
+	}
+	dir, err := modelDir(validCfg.path(), cfg.ModelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.RemoveAll(dir))
+}
+
+// CleanupSecrets is a no-op since access is granted by tokens which expire,
+// rather than by policies stored in the backend.
+func (p fileProvider) CleanupSecrets(context.Context, *provider.ModelBackendConfig, secrets.Accessor, provider.SecretRevisions) error {
+	return nil
+}
+
+// RestrictedConfig returns the config needed to create a
+// secrets backend client restricted to manage the specified
+// owned secrets and read shared secrets for the given accessor.
+// Model accessors are controller side clients and are given the
+// model's key. Agents are only given an access token, signed with
+// a key they cannot derive, which they present to the controller
+// to access content on their behalf.
+func (p fileProvider) RestrictedConfig(
+	_ context.Context, adminCfg *provider.ModelBackendConfig, _, forDrain bool, accessor secrets.Accessor, owned provider.SecretRevisions, read provider.SecretRevisions,
+) (*provider.BackendConfig, error) {
+	validCfg, err := newConfig(adminCfg.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file backend config")
+	}
+	rootKey, err := validCfg.key()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if accessor.Kind == secrets.ModelAccessor {
+		return &provider.BackendConfig{
+			BackendType: BackendType,
+			Config: provider.ConfigAttrs{
+				PathKey:     validCfg.path(),
+				ModelKeyKey: base64.StdEncoding.EncodeToString(deriveModelKey(rootKey, adminCfg.ModelUUID)),
+			},
+		}, nil
+	}
+
+	grant := accessGrant{
+		ModelUUID: adminCfg.ModelUUID,
+		Accessor:  accessor.String(),
+		Expires:   Now().UTC().Add(tokenTTL),
+		// The drain worker may need to update content it saved before
+		// being restarted.
+		Update: forDrain,
+	}
+	for id := range owned {
+		grant.Owned = append(grant.Owned, id)
+	}
+	for id := range read {
+		grant.Read = append(grant.Read, id)
+	}
+	token, err := signToken(deriveTokenKey(rootKey), grant)
+	if err != nil {
+		return nil, errors.Annotate(err, "creating secret access token")
+	}
+	return &provider.BackendConfig{
+		BackendType: BackendType,
+		Config: provider.ConfigAttrs{
+			TokenKey: token,
+		},
+	}, nil
+}
+
+// NewBackend returns a file backed secrets backend client. An admin config
+// holding the root key, or a config holding a model's key, gives full access
+// to the model's secrets. Agents holding just an access token need a client
+// from NewProxiedBackend instead.
+func (p fileProvider) NewBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+	if _, ok := cfg.Config[KeyKey]; ok {
+		validCfg, err := newConfig(cfg.Config)
+		if err != nil {
+			return nil, errors.Annotatef(err, "invalid file backend config")
+		}
+		rootKey, err := validCfg.key()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return newFileBackend(validCfg.path(), cfg.ModelUUID, deriveModelKey(rootKey, cfg.ModelUUID), nil)
+	}
+
+	path, _ := cfg.Config[PathKey].(string)
+	encodedKey, _ := cfg.Config[ModelKeyKey].(string)
+	if path == "" || encodedKey == "" {
+		if _, ok := cfg.Config[TokenKey]; ok {
+			return nil, errors.NotSupportedf("file backend access other than through the controller")
+		}
+		return nil, errors.NotValidf("file backend config missing path or key")
+	}
+	modelKey, err := decodeKey(encodedKey)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newFileBackend(path, cfg.ModelUUID, modelKey, nil)
+}
+
+// NewProxiedBackend implements [provider.SupportContentProxy]. Clients
+// holding an access token have the controller access content on their behalf
+// through the proxy; other configs are used directly.
+func (p fileProvider) NewProxiedBackend(
+	cfg *provider.ModelBackendConfig, backendID string, proxy provider.ContentProxy,
+) (provider.SecretsBackend, error) {
+	token, _ := cfg.Config[TokenKey].(string)
+	if token == "" {
+		return p.NewBackend(cfg)
+	}
+	if proxy == nil {
+		return nil, errors.NotSupportedf("file backend access without a controller proxy")
+	}
+	return &proxiedBackend{
+		backendID: backendID,
+		token:     token,
+		proxy:     proxy,
+	}, nil
+}
+
+// NewTokenBackend implements [provider.SupportContentProxy]. It verifies the
+// access token was issued to the accessor by the controller, and returns a
+// client restricted to the secrets the token grants access to.
+func (p fileProvider) NewTokenBackend(
+	adminCfg *provider.ModelBackendConfig, accessor secrets.Accessor, token string,
+) (provider.SecretsBackend, error) {
+	validCfg, err := newConfig(adminCfg.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file backend config")
+	}
+	rootKey, err := validCfg.key()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	grant, err := parseToken(deriveTokenKey(rootKey), token)
+	if err != nil {
+		return nil, errors.WithType(err, jujusecrets.PermissionDenied)
+	}
+	if grant.Accessor != accessor.String() {
+		return nil, errors.WithType(
+			errors.Errorf("file backend access token issued to %q", grant.Accessor), jujusecrets.PermissionDenied)
+	}
+	// The token may have been issued by another model on this controller,
+	// as happens when secrets are consumed across models.
+	return newFileBackend(validCfg.path(), grant.ModelUUID, deriveModelKey(rootKey, grant.ModelUUID), grant)
+}
+
+// modelDir returns the directory holding the input model's secrets.
+func modelDir(path, modelUUID string) (string, error) {
+	if modelUUID == "" || filepath.Base(modelUUID) != modelUUID || modelUUID == ".." {
+		return "", errors.NotValidf("model UUID %q", modelUUID)
+	}
+	return filepath.Join(path, modelUUID), nil
+}
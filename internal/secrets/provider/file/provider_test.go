@@ -0,0 +1,408 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/file"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type providerSuite struct {
+	testhelpers.IsolationSuite
+
+	path     string
+	now      time.Time
+	p        provider.SecretBackendProvider
+	adminCfg *provider.ModelBackendConfig
+}
+
+func TestProviderSuite(t *testing.T) {
+	tc.Run(t, &providerSuite{})
+}
+
+func (s *providerSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.now = time.Now()
+	s.PatchValue(&file.Now, func() time.Time { return s.now })
+
+	var err error
+	s.p, err = provider.Provider(file.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.path = c.MkDir()
+	s.adminCfg = &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig: provider.BackendConfig{
+			BackendType: file.BackendType,
+			Config: map[string]any{
+				"path": s.path,
+				"key":  base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+			},
+		},
+	}
+	err = s.p.Initialise(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) restrictedBackend(
+	c *tc.C, forDrain bool, accessor coresecrets.Accessor, owned, read provider.SecretRevisions,
+) provider.SecretsBackend {
+	cfg, err := s.p.RestrictedConfig(c.Context(), s.adminCfg, true, forDrain, accessor, owned, read)
+	c.Assert(err, tc.ErrorIsNil)
+	// Agents are given nothing but the access token.
+	c.Assert(cfg.Config, tc.HasLen, 1)
+	c.Assert(cfg.Config["token"], tc.Not(tc.Equals), "")
+
+	b, err := s.p.(provider.SupportContentProxy).NewProxiedBackend(&provider.ModelBackendConfig{
+		ControllerUUID: s.adminCfg.ControllerUUID,
+		ModelUUID:      s.adminCfg.ModelUUID,
+		ModelName:      s.adminCfg.ModelName,
+		BackendConfig:  *cfg,
+	}, "backend-id", &controllerProxy{p: s.p, adminCfg: s.adminCfg, accessor: accessor})
+	c.Assert(err, tc.ErrorIsNil)
+	return b
+}
+
+// controllerProxy accesses content for an agent as the controller does,
+// checking the token the agent presents.
+type controllerProxy struct {
+	p        provider.SecretBackendProvider
+	adminCfg *provider.ModelBackendConfig
+	accessor coresecrets.Accessor
+}
+
+func (p *controllerProxy) backend(backendID, token string) (provider.SecretsBackend, error) {
+	if backendID != "backend-id" {
+		return nil, errors.NotFoundf("secret backend %q", backendID)
+	}
+	return p.p.(provider.SupportContentProxy).NewTokenBackend(p.adminCfg, p.accessor, token)
+}
+
+func (p *controllerProxy) GetBackendContent(ctx context.Context, backendID, token, revisionId string) (coresecrets.SecretValue, error) {
+	b, err := p.backend(backendID, token)
+	if err != nil {
+		return nil, err
+	}
+	return b.GetContent(ctx, revisionId)
+}
+
+func (p *controllerProxy) SaveBackendContent(
+	ctx context.Context, backendID, token string, uri *coresecrets.URI, revision int, value coresecrets.SecretValue,
+) (string, error) {
+	b, err := p.backend(backendID, token)
+	if err != nil {
+		return "", err
+	}
+	return b.SaveContent(ctx, uri, revision, value)
+}
+
+func (p *controllerProxy) DeleteBackendContent(ctx context.Context, backendID, token, revisionId string) error {
+	b, err := p.backend(backendID, token)
+	if err != nil {
+		return err
+	}
+	return b.DeleteContent(ctx, revisionId)
+}
+
+// forgeToken signs a grant as an agent holding the input key might.
+func forgeToken(c *tc.C, key []byte, grant map[string]any) string {
+	payload, err := json.Marshal(grant)
+	c.Assert(err, tc.ErrorIsNil)
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("token:" + encoded))
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func unitAccessor() coresecrets.Accessor {
+	return coresecrets.Accessor{Kind: coresecrets.UnitAccessor, ID: "gitlab/0"}
+}
+
+func (s *providerSuite) TestSaveGetDeleteContent(c *tc.C) {
+	b, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(b.Ping(), tc.ErrorIsNil)
+
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionID, err := b.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(revisionID, tc.Equals, uri.Name(1))
+
+	// The content is encrypted at rest.
+	stored, err := os.ReadFile(filepath.Join(s.path, s.adminCfg.ModelUUID, revisionID))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(strings.Contains(string(stored), "YmFy"), tc.IsFalse)
+
+	got, err := b.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got.EncodedValues(), tc.DeepEquals, value.EncodedValues())
+
+	err = b.DeleteContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+	err = b.DeleteContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *providerSuite) TestRestrictedOwner(c *tc.C) {
+	uri := coresecrets.NewURI()
+	owned := provider.SecretRevisions{}
+	owned.Add(uri, uri.Name(1))
+	b := s.restrictedBackend(c, false, unitAccessor(), owned, nil)
+
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionID, err := b.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+	// Owners may update existing content.
+	_, err = b.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := b.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got.EncodedValues(), tc.DeepEquals, value.EncodedValues())
+
+	err = b.DeleteContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestRestrictedReader(c *tc.C) {
+	admin, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionID, err := admin.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	read := provider.SecretRevisions{uri.ID: set.NewStrings(revisionID)}
+	b := s.restrictedBackend(c, false, unitAccessor(), nil, read)
+
+	_, err = b.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+	err = b.DeleteContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+}
+
+func (s *providerSuite) TestRestrictedNoAccess(c *tc.C) {
+	admin, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionID, err := admin.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	b := s.restrictedBackend(c, false, unitAccessor(), nil, nil)
+	_, err = b.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+
+	// New secrets can always be created.
+	_, err = b.SaveContent(c.Context(), coresecrets.NewURI(), 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestRestrictedModelAdminReadsAll(c *tc.C) {
+	admin, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionID, err := admin.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Model accessors are controller side clients, and are given the
+	// model's key rather than the root key.
+	cfg, err := s.p.RestrictedConfig(c.Context(), s.adminCfg, true, false,
+		coresecrets.Accessor{Kind: coresecrets.ModelAccessor, ID: s.adminCfg.ModelUUID}, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.Config["key"], tc.IsNil)
+	c.Assert(cfg.Config["model-key"], tc.Not(tc.Equals), "")
+
+	b, err := s.p.NewBackend(&provider.ModelBackendConfig{
+		ModelUUID:     s.adminCfg.ModelUUID,
+		BackendConfig: *cfg,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestPingWithoutModel(c *tc.C) {
+	// The backend is pinged without a model when it is added.
+	b, err := s.p.NewBackend(&provider.ModelBackendConfig{
+		BackendConfig: s.adminCfg.BackendConfig,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(b.Ping(), tc.ErrorIsNil)
+
+	_, err = b.SaveContent(c.Context(), coresecrets.NewURI(), 1, coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorMatches, "file backend client without a model not valid")
+}
+
+func (s *providerSuite) TestValidateControllerNodes(c *tc.C) {
+	p, ok := s.p.(provider.SupportControllerNodes)
+	c.Assert(ok, tc.IsTrue)
+	cfg := s.adminCfg.Config
+
+	err := p.ValidateControllerNodes(cfg, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = p.ValidateControllerNodes(cfg, 3)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+	c.Assert(err, tc.ErrorMatches, `file backend path ".*" on a controller with 3 nodes unless "shared-storage" is set not supported`)
+
+	cfg = provider.ConfigAttrs{
+		"path":           s.path,
+		"key":            cfg["key"],
+		"shared-storage": "true",
+	}
+	err = p.ValidateControllerNodes(cfg, 3)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestRestrictedForDrainUpdates(c *tc.C) {
+	admin, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	_, err = admin.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	b := s.restrictedBackend(c, true, unitAccessor(), nil, nil)
+	_, err = b.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestRestrictedTokenExpired(c *tc.C) {
+	uri := coresecrets.NewURI()
+	owned := provider.SecretRevisions{}
+	owned.Add(uri, uri.Name(1))
+	b := s.restrictedBackend(c, false, unitAccessor(), owned, nil)
+
+	s.now = s.now.Add(time.Hour)
+	_, err := b.SaveContent(c.Context(), uri, 1, coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+}
+
+func (s *providerSuite) TestRestrictedTokenTampered(c *tc.C) {
+	cfg, err := s.p.RestrictedConfig(c.Context(), s.adminCfg, true, false, unitAccessor(), nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	token := cfg.Config["token"].(string)
+	payload, signature, _ := strings.Cut(token, ".")
+	_, err = s.p.(provider.SupportContentProxy).NewTokenBackend(s.adminCfg, unitAccessor(), payload+"x."+signature)
+	c.Assert(err, tc.ErrorMatches, "file backend access token signature not valid")
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+}
+
+func (s *providerSuite) TestRestrictedTokenOtherAccessor(c *tc.C) {
+	cfg, err := s.p.RestrictedConfig(c.Context(), s.adminCfg, true, false, unitAccessor(), nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	other := coresecrets.Accessor{Kind: coresecrets.UnitAccessor, ID: "mysql/0"}
+	_, err = s.p.(provider.SupportContentProxy).NewTokenBackend(s.adminCfg, other, cfg.Config["token"].(string))
+	c.Assert(err, tc.ErrorMatches, `file backend access token issued to "unit-gitlab-0"`)
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+}
+
+func (s *providerSuite) TestRestrictedTokenNotUsableDirectly(c *tc.C) {
+	cfg, err := s.p.RestrictedConfig(c.Context(), s.adminCfg, true, false, unitAccessor(), nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.p.NewBackend(&provider.ModelBackendConfig{
+		ModelUUID:     s.adminCfg.ModelUUID,
+		BackendConfig: *cfg,
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *providerSuite) TestForgedGrantRejected(c *tc.C) {
+	admin, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	uri := coresecrets.NewURI()
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionID, err := admin.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A unit which has not been granted the secret grants itself access,
+	// signing the grant with the only key it could plausibly hold: the
+	// model's key, as once issued to agents.
+	rootKey := []byte("0123456789abcdef0123456789abcdef")
+	mac := hmac.New(sha256.New, rootKey)
+	mac.Write([]byte("model:" + s.adminCfg.ModelUUID))
+	modelKey := mac.Sum(nil)
+	forged := forgeToken(c, modelKey, map[string]any{
+		"model-uuid": s.adminCfg.ModelUUID,
+		"accessor":   unitAccessor().String(),
+		"expires":    s.now.Add(time.Hour),
+		"update":     true,
+		"owned":      []string{uri.ID},
+		"read":       []string{uri.ID},
+	})
+
+	proxy := &controllerProxy{p: s.p, adminCfg: s.adminCfg, accessor: unitAccessor()}
+	b, err := s.p.(provider.SupportContentProxy).NewProxiedBackend(&provider.ModelBackendConfig{
+		ModelUUID: s.adminCfg.ModelUUID,
+		BackendConfig: provider.BackendConfig{
+			BackendType: file.BackendType,
+			Config:      provider.ConfigAttrs{"token": forged},
+		},
+	}, "backend-id", proxy)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = b.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+	_, err = b.SaveContent(c.Context(), uri, 1, coresecrets.NewSecretValue(map[string]string{"foo": "YmF6"}))
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+	err = b.DeleteContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIs, secrets.PermissionDenied)
+
+	got, err := admin.GetContent(c.Context(), revisionID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got.EncodedValues(), tc.DeepEquals, value.EncodedValues())
+}
+
+func (s *providerSuite) TestCleanupModel(c *tc.C) {
+	b, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.SaveContent(c.Context(), coresecrets.NewURI(), 1, coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.p.CleanupModel(c.Context(), s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = os.Stat(filepath.Join(s.path, s.adminCfg.ModelUUID))
+	c.Assert(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *providerSuite) TestPingMissingPath(c *tc.C) {
+	s.adminCfg.Config["path"] = filepath.Join(s.path, "missing")
+	b, err := s.p.NewBackend(s.adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(b.Ping(), tc.ErrorMatches, "backend not reachable: .*")
+}
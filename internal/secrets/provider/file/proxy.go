@@ -0,0 +1,45 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+// proxiedBackend is the secrets backend client used by agents, which have
+// the controller access content on their behalf. The controller checks the
+// access token, so agents never need the backend's keys or directory.
+type proxiedBackend struct {
+	backendID string
+	token     string
+	proxy     provider.ContentProxy
+}
+
+// GetContent implements SecretsBackend.
+func (b *proxiedBackend) GetContent(ctx context.Context, revisionId string) (secrets.SecretValue, error) {
+	val, err := b.proxy.GetBackendContent(ctx, b.backendID, b.token, revisionId)
+	return val, errors.Trace(err)
+}
+
+// SaveContent implements SecretsBackend.
+func (b *proxiedBackend) SaveContent(ctx context.Context, uri *secrets.URI, revision int, value secrets.SecretValue) (string, error) {
+	revisionId, err := b.proxy.SaveBackendContent(ctx, b.backendID, b.token, uri, revision, value)
+	return revisionId, errors.Trace(err)
+}
+
+// DeleteContent implements SecretsBackend.
+func (b *proxiedBackend) DeleteContent(ctx context.Context, revisionId string) error {
+	return errors.Trace(b.proxy.DeleteBackendContent(ctx, b.backendID, b.token, revisionId))
+}
+
+// Ping implements SecretsBackend. The backend is only reachable by the
+// controller, which checks it on each access.
+func (b *proxiedBackend) Ping() error {
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// accessGrant describes the secrets an agent's access token permits it to
+// use, mirroring the policies issued by the Vault backend.
+type accessGrant struct {
+	ModelUUID string `json:"model-uuid"`
+	// Accessor is the agent the token was issued to.
+	Accessor string    `json:"accessor"`
+	Expires  time.Time `json:"expires"`
+	// Update permits overwriting any secret content, as needed when
+	// draining secrets.
+	Update bool `json:"update,omitempty"`
+	// Owned are the IDs of secrets which may be managed.
+	Owned []string `json:"owned,omitempty"`
+	// Read are the IDs of secrets which may be read.
+	Read []string `json:"read,omitempty"`
+}
+
+func (g *accessGrant) canRead(secretID string) bool {
+	return g.owns(secretID) || slices.Contains(g.Read, secretID)
+}
+
+func (g *accessGrant) canUpdate(secretID string) bool {
+	return g.Update || g.owns(secretID)
+}
+
+func (g *accessGrant) owns(secretID string) bool {
+	return slices.Contains(g.Owned, secretID)
+}
+
+// deriveModelKey returns the key used to encrypt the input model's secrets.
+func deriveModelKey(rootKey []byte, modelUUID string) []byte {
+	mac := hmac.New(sha256.New, rootKey)
+	mac.Write([]byte("model:" + modelUUID))
+	return mac.Sum(nil)
+}
+
+// deriveTokenKey returns the key used to sign access tokens. Only the
+// controller holds the root key, so agents cannot mint their own tokens.
+func deriveTokenKey(rootKey []byte) []byte {
+	mac := hmac.New(sha256.New, rootKey)
+	mac.Write([]byte("token"))
+	return mac.Sum(nil)
+}
+
+// signToken returns an access token for the input grant, signed with the
+// token key.
+func signToken(tokenKey []byte, grant accessGrant) (string, error) {
+	payload, err := json.Marshal(grant)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(tokenSignature(tokenKey, encoded)), nil
+}
+
+// parseToken verifies the input access token against the token key and
+// returns the grant it carries.
+func parseToken(tokenKey []byte, token string) (*accessGrant, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errors.NotValidf("file backend access token")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, tokenSignature(tokenKey, encoded)) {
+		return nil, errors.NotValidf("file backend access token signature")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.NotValidf("file backend access token encoding")
+	}
+	var grant accessGrant
+	if err := json.Unmarshal(payload, &grant); err != nil {
+		return nil, errors.Annotate(err, "parsing file backend access token")
+	}
+	return &grant, nil
+}
+
+func tokenSignature(tokenKey []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, tokenKey)
+	mac.Write([]byte("token:" + payload))
+	return mac.Sum(nil)
+}
@@ -100,3 +100,46 @@ func HasAuthRefresh(p SecretBackendProvider) bool {
 	_, ok := p.(SupportAuthRefresh)
 	return ok
 }
+
+// SupportContentProxy is implemented by providers whose restricted clients
+// cannot access the backend themselves, and instead have the controller
+// access secret content on their behalf.
+type SupportContentProxy interface {
+	// NewProxiedBackend returns a secrets backend client for the restricted
+	// config which accesses content of the specified backend through the
+	// proxy.
+	NewProxiedBackend(cfg *ModelBackendConfig, backendID string, proxy ContentProxy) (SecretsBackend, error)
+
+	// NewTokenBackend returns a secrets backend client using the admin
+	// config, restricted to the access granted to the accessor by the token
+	// carried in a restricted config.
+	NewTokenBackend(adminCfg *ModelBackendConfig, accessor secrets.Accessor, token string) (SecretsBackend, error)
+}
+
+// SupportControllerNodes is implemented by providers whose backends are held
+// by a controller node, so can only be used by a controller with more than one
+// node in some configurations.
+type SupportControllerNodes interface {
+	// ValidateControllerNodes returns an error if a backend with the input
+	// config cannot be used by a controller with the input number of nodes.
+	ValidateControllerNodes(cfg ConfigAttrs, numNodes int) error
+}
+
+// ValidateControllerNodes returns an error if a backend of the provider with
+// the input config cannot be used by a controller with the input number of
+// nodes.
+func ValidateControllerNodes(p SecretBackendProvider, cfg ConfigAttrs, numNodes int) error {
+	v, ok := p.(SupportControllerNodes)
+	if !ok {
+		return nil
+	}
+	return v.ValidateControllerNodes(cfg, numNodes)
+}
+
+// ContentProxy accesses secret content held in a backend on behalf of a
+// restricted client, checking the access token presented by the client.
+type ContentProxy interface {
+	GetBackendContent(ctx context.Context, backendID, token, revisionId string) (secrets.SecretValue, error)
+	SaveBackendContent(ctx context.Context, backendID, token string, uri *secrets.URI, revision int, value secrets.SecretValue) (string, error)
+	DeleteBackendContent(ctx context.Context, backendID, token, revisionId string) error
+}
@@ -14,6 +14,7 @@ import (
 	annotationService "github.com/juju/juju/domain/annotation/service"
 	applicationservice "github.com/juju/juju/domain/application/service"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
+	backupservice "github.com/juju/juju/domain/backup/service"
 	blockcommandservice "github.com/juju/juju/domain/blockcommand/service"
 	blockdeviceservice "github.com/juju/juju/domain/blockdevice/service"
 	changestreamservice "github.com/juju/juju/domain/changestream/service"
@@ -33,6 +34,7 @@ import (
 	macaroonservice "github.com/juju/juju/domain/macaroon/service"
 	machineservice "github.com/juju/juju/domain/machine/service"
 	modelservice "github.com/juju/juju/domain/model/service"
+	modelimportservice "github.com/juju/juju/domain/model/service/migration"
 	modelserviceobjectstore "github.com/juju/juju/domain/model/service/objectstore"
 	modelserviceprovider "github.com/juju/juju/domain/model/service/provider"
 	modelagentservice "github.com/juju/juju/domain/modelagent/service"
@@ -51,6 +53,7 @@ import (
 	resourceservice "github.com/juju/juju/domain/resource/service"
 	secretservice "github.com/juju/juju/domain/secret/service"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
+	sshrecordingservice "github.com/juju/juju/domain/sshrecording/service"
 	statusservice "github.com/juju/juju/domain/status/service"
 	storageservice "github.com/juju/juju/domain/storage/service"
 	storageprovisioningservice "github.com/juju/juju/domain/storageprovisioning/service"
@@ -73,6 +76,9 @@ type ControllerDomainServices interface {
 	ControllerNode() *controllernodeservice.WatchableService
 	// Model returns the model service.
 	Model() *modelservice.WatchableService
+	// ModelImport returns the service for creating the controller records
+	// of models imported from an archive.
+	ModelImport() *modelimportservice.MigrationService
 	//ModelDefaults returns the modeldefaults service.
 	ModelDefaults() *modeldefaultsservice.Service
 	// ExternalController returns the external controller service.
@@ -92,12 +98,21 @@ type ControllerDomainServices interface {
 	Access() *accessservice.Service
 	// SecretBackend returns the secret backend service.
 	SecretBackend() *secretbackendservice.WatchableService
+	// SecretEncryptionKey returns the service for the controller
+	// key-encryption keys.
+	SecretEncryptionKey() *secretbackendservice.EncryptionKeyService
 	// Macaroon returns the macaroon bakery backend service
 	Macaroon() *macaroonservice.Service
 	// ControllerChangeStream returns the global controller change stream.
 	ControllerChangeStream() *changestreamservice.Service
+	// ControllerBackup returns the service for dumping the controller
+	// database for backups.
+	ControllerBackup() *backupservice.Service
 	// Tracing returns the service for accessing tracing configuration.
 	Tracing() *tracingservice.Service
+	// SSHRecording returns the service for recordings of SSH sessions
+	// proxied by the controller.
+	SSHRecording() *sshrecordingservice.Service
 }
 
 // ModelDomainServices provides access to the services required by the
@@ -127,6 +142,9 @@ type ModelDomainServices interface {
 	Application() *applicationservice.WatchableService
 	// Status returns the application status service.
 	Status() *statusservice.LeadershipService
+	// ControllerCluster returns the service for managing the membership of
+	// controller nodes in the Dqlite cluster.
+	ControllerCluster() *controllernodeservice.ClusterService
 	// Resolve returns the resolve service.
 	Resolve() *resolveservice.WatchableService
 	// KeyManager returns the key manager service.
@@ -182,6 +200,8 @@ type ModelDomainServices interface {
 	ModelProvider() *modelproviderservice.Service
 	// ChangeStream returns the model change stream.
 	ChangeStream() *changestreamservice.Service
+	// Backup returns the service for dumping the model database for backups.
+	Backup() *backupservice.Service
 	// Export returns the service for accessing model exports.
 	Export() *exportservice.Service
 }
@@ -242,6 +242,47 @@ type VolumeImporter interface {
 	) (VolumeInfo, error)
 }
 
+// VolumeResizer provides an interface for growing volumes in place. A
+// VolumeSource whose volumes can be grown after they are created
+// implements VolumeResizer.
+type VolumeResizer interface {
+	// ResizeVolumes grows the volumes with the specified parameters to at
+	// least the requested size, returning the updated volume information.
+	// Volumes are never shrunk.
+	//
+	// ResizeVolumes must be idempotent; it may be called for a volume
+	// which has already been grown.
+	ResizeVolumes(ctx context.Context, params []ResizeVolumeParams) ([]ResizeVolumesResult, error)
+}
+
+// FilesystemResizer provides an interface for growing filesystems in
+// place. A FilesystemSource whose filesystems can be grown after they are
+// created implements FilesystemResizer.
+type FilesystemResizer interface {
+	// ResizeFilesystems grows the filesystems with the specified
+	// parameters to at least the requested size, returning the updated
+	// filesystem information. Filesystems are never shrunk, and attached
+	// filesystems are grown without being detached.
+	//
+	// ResizeFilesystems must be idempotent; it may be called for a
+	// filesystem which has already been grown.
+	ResizeFilesystems(ctx context.Context, params []ResizeFilesystemParams) ([]ResizeFilesystemsResult, error)
+}
+
+// VolumeSnapshotter provides an interface for taking point-in-time
+// snapshots of volumes, and for destroying those snapshots. A VolumeSource
+// that can snapshot its volumes, and create new volumes from those
+// snapshots, implements VolumeSnapshotter.
+type VolumeSnapshotter interface {
+	// CreateVolumeSnapshots takes snapshots of the volumes with the
+	// specified parameters.
+	CreateVolumeSnapshots(ctx context.Context, params []VolumeSnapshotParams) ([]CreateVolumeSnapshotsResult, error)
+
+	// DestroyVolumeSnapshots destroys the snapshots with the specified
+	// provider snapshot IDs.
+	DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error)
+}
+
 // VolumeParams is a fully specified set of parameters for volume creation,
 // derived from one or more of user-specified storage directives, a
 // storage pool definition, and charm storage metadata.
@@ -276,6 +317,12 @@ type VolumeParams struct {
 	// once the instance is created there are still unprovisioned volumes,
 	// the dynamic storage provisioner will take care of creating them.
 	Attachment *VolumeAttachmentParams
+
+	// SnapshotId is the unique provider-supplied ID of a volume snapshot
+	// that the volume should be created from, or empty if the volume
+	// should be created empty. Only volume sources that implement
+	// VolumeSnapshotter support creating volumes from snapshots.
+	SnapshotId string
 }
 
 // VolumeAttachmentParams is a set of parameters for volume attachment or
@@ -374,6 +421,65 @@ type FilesystemAttachmentParams struct {
 	Path string
 }
 
+// ResizeVolumeParams is a set of parameters for growing a volume.
+type ResizeVolumeParams struct {
+	// Tag is a unique tag assigned by Juju for the volume.
+	Tag names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// Size is the minimum size of the volume in MiB.
+	Size uint64
+}
+
+// ResizeFilesystemParams is a set of parameters for growing a filesystem.
+type ResizeFilesystemParams struct {
+	// Tag is a unique tag assigned by Juju for the filesystem.
+	Tag names.FilesystemTag
+
+	// Volume is the tag of the volume that backs the filesystem, if any.
+	Volume names.VolumeTag
+
+	// FilesystemId is the unique provider-supplied ID for the filesystem.
+	FilesystemId string
+
+	// Size is the minimum size of the filesystem in MiB.
+	Size uint64
+
+	// Attachments are the attachments of the filesystem to the machine
+	// the filesystem is being grown on, for sources which grow mounted
+	// filesystems.
+	Attachments []FilesystemAttachmentParams
+}
+
+// VolumeSnapshotParams is a set of parameters for taking a snapshot of a
+// volume.
+type VolumeSnapshotParams struct {
+	// Id is a unique ID assigned by Juju for the requested snapshot.
+	Id string
+
+	// Volume is the tag of the volume to take a snapshot of.
+	Volume names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// ResourceTags is a set of tags to set on the created snapshot, if the
+	// storage provider supports tags.
+	ResourceTags map[string]string
+}
+
+// VolumeSnapshot describes a point-in-time snapshot of a volume.
+type VolumeSnapshot struct {
+	// SnapshotId is the unique provider-supplied ID for the snapshot.
+	SnapshotId string
+
+	// Size is the size of the snapshotted volume, in MiB. A volume created
+	// from the snapshot must be at least this size.
+	Size uint64
+}
+
 // CreateVolumesResult contains the result of a VolumeSource.CreateVolumes call
 // for one volume. Volume and VolumeAttachment should only be used if Error is
 // nil.
@@ -411,6 +517,29 @@ type AttachFilesystemsResult struct {
 	Error                error
 }
 
+// ResizeVolumesResult contains the result of a VolumeResizer.ResizeVolumes
+// call for one volume. VolumeInfo should only be used if Error is nil.
+type ResizeVolumesResult struct {
+	VolumeInfo *VolumeInfo
+	Error      error
+}
+
+// ResizeFilesystemsResult contains the result of a
+// FilesystemResizer.ResizeFilesystems call for one filesystem.
+// FilesystemInfo should only be used if Error is nil.
+type ResizeFilesystemsResult struct {
+	FilesystemInfo *FilesystemInfo
+	Error          error
+}
+
+// CreateVolumeSnapshotsResult contains the result of a
+// VolumeSnapshotter.CreateVolumeSnapshots call for one volume. Snapshot
+// should only be used if Error is nil.
+type CreateVolumeSnapshotsResult struct {
+	Snapshot *VolumeSnapshot
+	Error    error
+}
+
 // String returns the string representation of [ProviderType]. This implements
 // the [fmt.Stringer] interface.
 func (p ProviderType) String() string {
@@ -125,7 +125,15 @@ type loopVolumeSource struct {
 	storageDir string
 }
 
-var _ storage.VolumeSource = (*loopVolumeSource)(nil)
+var (
+	_ storage.VolumeSource      = (*loopVolumeSource)(nil)
+	_ storage.VolumeResizer     = (*loopVolumeSource)(nil)
+	_ storage.VolumeSnapshotter = (*loopVolumeSource)(nil)
+)
+
+// loopSnapshotDir is the directory, relative to the storage directory,
+// that loop volume snapshots are stored in.
+const loopSnapshotDir = "snapshots"
 
 // CreateVolumes is defined on the VolumeSource interface.
 func (lvs *loopVolumeSource) CreateVolumes(ctx context.Context, args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
@@ -148,6 +156,15 @@ func (lvs *loopVolumeSource) createVolume(
 	if err := ensureDir(lvs.dirFuncs, filepath.Dir(loopFilePath)); err != nil {
 		return storage.Volume{}, errors.Trace(err)
 	}
+	if params.SnapshotId != "" {
+		snapshotFilePath, err := lvs.snapshotFilePath(params.SnapshotId)
+		if err != nil {
+			return storage.Volume{}, errors.Trace(err)
+		}
+		if err := copyBlockFile(ctx, lvs.run, snapshotFilePath, loopFilePath); err != nil {
+			return storage.Volume{}, errors.Annotate(err, "could not restore snapshot")
+		}
+	}
 	if err := createBlockFile(ctx, lvs.run, loopFilePath, params.Size); err != nil {
 		return storage.Volume{}, errors.Annotate(err, "could not create block file")
 	}
@@ -164,6 +181,13 @@ func (lvs *loopVolumeSource) volumeFilePath(tag names.VolumeTag) string {
 	return filepath.Join(lvs.storageDir, tag.String())
 }
 
+func (lvs *loopVolumeSource) snapshotFilePath(snapshotId string) (string, error) {
+	if snapshotId == "" || filepath.Base(snapshotId) != snapshotId {
+		return "", errors.NotValidf("loop snapshot ID %q", snapshotId)
+	}
+	return filepath.Join(lvs.storageDir, loopSnapshotDir, snapshotId), nil
+}
+
 // ListVolumes is defined on the VolumeSource interface.
 func (lvs *loopVolumeSource) ListVolumes(ctx context.Context) ([]string, error) {
 	// TODO(axw) implement this when we need it.
@@ -275,6 +299,124 @@ func (lvs *loopVolumeSource) detachVolume(ctx context.Context, tag names.VolumeT
 	return nil
 }
 
+// ResizeVolumes is defined on the VolumeResizer interface.
+func (lvs *loopVolumeSource) ResizeVolumes(ctx context.Context, args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		info, err := lvs.resizeVolume(ctx, arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.Tag.Id())
+			continue
+		}
+		results[i].VolumeInfo = info
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) resizeVolume(
+	ctx context.Context, arg storage.ResizeVolumeParams,
+) (*storage.VolumeInfo, error) {
+	loopFilePath := lvs.volumeFilePath(arg.Tag)
+	// fallocate only ever grows the file, so growing the backing
+	// file to a size it already has is a no-op.
+	if err := createBlockFile(ctx, lvs.run, loopFilePath, arg.Size); err != nil {
+		return nil, errors.Trace(err)
+	}
+	deviceNames, err := associatedLoopDevices(ctx, lvs.run, loopFilePath)
+	if err != nil {
+		return nil, errors.Annotate(err, "locating loop device")
+	}
+	for _, deviceName := range deviceNames {
+		if err := refreshLoopDeviceCapacity(ctx, lvs.run, deviceName); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return &storage.VolumeInfo{
+		VolumeId: arg.Tag.String(),
+		Size:     arg.Size,
+	}, nil
+}
+
+// CreateVolumeSnapshots is defined on the VolumeSnapshotter interface.
+//
+// Loop volume snapshots are sparse copies of the volume's backing file,
+// kept in the storage directory of the machine the volume is on. They can
+// only be restored on that machine.
+func (lvs *loopVolumeSource) CreateVolumeSnapshots(
+	ctx context.Context, args []storage.VolumeSnapshotParams,
+) ([]storage.CreateVolumeSnapshotsResult, error) {
+	results := make([]storage.CreateVolumeSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := lvs.createVolumeSnapshot(ctx, arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "creating snapshot of volume %s", arg.Volume.Id())
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) createVolumeSnapshot(
+	ctx context.Context, arg storage.VolumeSnapshotParams,
+) (*storage.VolumeSnapshot, error) {
+	snapshotId := "snapshot-" + arg.Id
+	snapshotFilePath, err := lvs.snapshotFilePath(snapshotId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := ensureDir(lvs.dirFuncs, filepath.Dir(snapshotFilePath)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	loopFilePath := lvs.volumeFilePath(arg.Volume)
+	info, err := os.Stat(loopFilePath)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading loop backing file size")
+	}
+	if err := copyBlockFile(ctx, lvs.run, loopFilePath, snapshotFilePath); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &storage.VolumeSnapshot{
+		SnapshotId: snapshotId,
+		Size:       uint64(info.Size()) / (1024 * 1024),
+	}, nil
+}
+
+// DestroyVolumeSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		if err := lvs.destroyVolumeSnapshot(snapshotId); err != nil {
+			results[i] = errors.Annotatef(err, "destroying snapshot %q", snapshotId)
+		}
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) destroyVolumeSnapshot(snapshotId string) error {
+	snapshotFilePath, err := lvs.snapshotFilePath(snapshotId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = os.Remove(snapshotFilePath)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Annotate(err, "removing snapshot file")
+	}
+	return nil
+}
+
+// copyBlockFile makes a sparse copy of the block file at srcPath to
+// dstPath, replacing anything already at dstPath.
+func copyBlockFile(
+	ctx context.Context, run RunCommandFunc, srcPath, dstPath string,
+) error {
+	_, err := run(ctx, "cp", "--sparse=always", srcPath, dstPath)
+	if err != nil {
+		return errors.Annotatef(err, "copying %q to %q", srcPath, dstPath)
+	}
+	return nil
+}
+
 // createBlockFile creates a file at the specified path, with the
 // given size in mebibytes.
 func createBlockFile(
@@ -331,6 +473,18 @@ func detachLoopDevice(
 	return err
 }
 
+// refreshLoopDeviceCapacity makes the loop device with the specified
+// name pick up a change in the size of its backing file.
+func refreshLoopDeviceCapacity(
+	ctx context.Context, run RunCommandFunc, deviceName string,
+) error {
+	_, err := run(ctx, "losetup", "-c", path.Join("/dev", deviceName))
+	if err != nil {
+		return errors.Annotatef(err, "refreshing capacity of loop device %q", deviceName)
+	}
+	return nil
+}
+
 // associatedLoopDevices returns the device names of the loop devices
 // associated with the specified file path.
 func associatedLoopDevices(ctx context.Context, run RunCommandFunc, filePath string) ([]string, error) {
@@ -272,3 +272,143 @@ func (s *loopSuite) TestDetachVolumesDetachFails(c *tc.C) {
 	_, err = os.Stat(fileName)
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *loopSuite) TestResizeVolumes(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+	cmd := s.commands.expect("losetup", "-j", fileName)
+	cmd.respond("/dev/loop0: foo\n", nil)
+	s.commands.expect("losetup", "-c", "/dev/loop0")
+
+	resizer, ok := source.(storage.VolumeResizer)
+	c.Assert(ok, tc.IsTrue)
+	results, err := resizer.ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Assert(results[0].VolumeInfo, tc.DeepEquals, &storage.VolumeInfo{
+		VolumeId: "volume-0",
+		Size:     4,
+	})
+}
+
+func (s *loopSuite) TestResizeVolumesAllocateFails(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	cmd := s.commands.expect("fallocate", "-l", "4MiB", fileName)
+	cmd.respond("", errors.New("no space left on device"))
+
+	results, err := source.(storage.VolumeResizer).ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorMatches, `resizing volume 0: allocating loop backing file .*: no space left on device`)
+	c.Assert(results[0].VolumeInfo, tc.IsNil)
+}
+
+func (s *loopSuite) TestCreateVolumesFromSnapshot(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	s.commands.expect("cp", "--sparse=always", filepath.Join(s.storageDir, "snapshots", "snapshot-1"), fileName)
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		Size:       4,
+		SnapshotId: "snapshot-1",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Assert(results[0].Volume, tc.DeepEquals, &storage.Volume{
+		names.NewVolumeTag("0"),
+		storage.VolumeInfo{
+			VolumeId: "volume-0",
+			Size:     4,
+		},
+	})
+}
+
+func (s *loopSuite) TestCreateVolumesFromSnapshotInvalidId(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		Size:       4,
+		SnapshotId: "../volume-1",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorMatches, `creating volume: loop snapshot ID "../volume-1" not valid`)
+}
+
+func (s *loopSuite) TestCreateVolumeSnapshots(c *tc.C) {
+	source, dirFuncs := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := os.WriteFile(fileName, nil, 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.Truncate(fileName, 2*1024*1024)
+	c.Assert(err, tc.ErrorIsNil)
+	s.commands.expect("cp", "--sparse=always", fileName, filepath.Join(s.storageDir, "snapshots", "snapshot-1"))
+
+	snapshotter, ok := source.(storage.VolumeSnapshotter)
+	c.Assert(ok, tc.IsTrue)
+	results, err := snapshotter.CreateVolumeSnapshots(c.Context(), []storage.VolumeSnapshotParams{{
+		Id:       "1",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Assert(results[0].Snapshot, tc.DeepEquals, &storage.VolumeSnapshot{
+		SnapshotId: "snapshot-1",
+		Size:       2,
+	})
+	c.Assert(dirFuncs.Dirs.Contains(filepath.Join(s.storageDir, "snapshots")), tc.IsTrue)
+}
+
+func (s *loopSuite) TestCreateVolumeSnapshotsCopyFails(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := os.WriteFile(fileName, nil, 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	cmd := s.commands.expect("cp", "--sparse=always", fileName, filepath.Join(s.storageDir, "snapshots", "snapshot-1"))
+	cmd.respond("", errors.New("no space left on device"))
+
+	results, err := source.(storage.VolumeSnapshotter).CreateVolumeSnapshots(c.Context(), []storage.VolumeSnapshotParams{{
+		Id:       "1",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorMatches, `creating snapshot of volume 0: copying .*: no space left on device`)
+	c.Assert(results[0].Snapshot, tc.IsNil)
+}
+
+func (s *loopSuite) TestDestroyVolumeSnapshots(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotDir := filepath.Join(s.storageDir, "snapshots")
+	err := os.MkdirAll(snapshotDir, 0755)
+	c.Assert(err, tc.ErrorIsNil)
+	fileName := filepath.Join(snapshotDir, "snapshot-1")
+	err = os.WriteFile(fileName, nil, 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	errs, err := source.(storage.VolumeSnapshotter).DestroyVolumeSnapshots(
+		c.Context(), []string{"snapshot-1", "snapshot-2"},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.DeepEquals, []error{nil, nil})
+	_, err = os.Stat(fileName)
+	c.Assert(err, tc.Satisfies, os.IsNotExist)
+}
@@ -38,6 +38,11 @@ type managedFilesystemSource struct {
 	filesystems        map[names.FilesystemTag]storage.Filesystem
 }
 
+var (
+	_ storage.FilesystemSource  = (*managedFilesystemSource)(nil)
+	_ storage.FilesystemResizer = (*managedFilesystemSource)(nil)
+)
+
 // NewManagedFilesystemSource returns a storage.FilesystemSource that manages
 // filesystems on block devices on the host machine.
 //
@@ -120,6 +125,54 @@ func (s *managedFilesystemSource) createFilesystem(
 	}, nil
 }
 
+// ResizeFilesystems is defined on storage.FilesystemResizer.
+//
+// The backing volume must already have been grown, and the new size
+// observed in its block device, before the filesystem can be grown.
+// Filesystems are grown online, without unmounting them.
+func (s *managedFilesystemSource) ResizeFilesystems(ctx context.Context, args []storage.ResizeFilesystemParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		info, err := s.resizeFilesystem(ctx, arg)
+		if err != nil {
+			results[i].Error = err
+			continue
+		}
+		results[i].FilesystemInfo = info
+	}
+	return results, nil
+}
+
+func (s *managedFilesystemSource) resizeFilesystem(
+	ctx context.Context,
+	arg storage.ResizeFilesystemParams,
+) (*storage.FilesystemInfo, error) {
+	blockDevice, err := s.backingVolumeBlockDevice(arg.Volume)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if blockDevice.SizeMiB < arg.Size {
+		return nil, errors.Errorf(
+			"backing-volume %s is not yet big enough (%dM < %dM)",
+			arg.Volume.Id(), blockDevice.SizeMiB, arg.Size,
+		)
+	}
+	devicePath := devicePath(blockDevice)
+	if isDiskDevice(devicePath) {
+		if err := growPartition(ctx, s.run, devicePath); err != nil {
+			return nil, errors.Trace(err)
+		}
+		devicePath = partitionDevicePath(devicePath)
+	}
+	if err := growFilesystem(ctx, s.run, devicePath); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &storage.FilesystemInfo{
+		ProviderId: arg.Tag.String(),
+		Size:       blockDevice.SizeMiB,
+	}, nil
+}
+
 // DestroyFilesystems is defined on storage.FilesystemSource.
 func (s *managedFilesystemSource) DestroyFilesystems(ctx context.Context, filesystemIds []string) ([]error, error) {
 	// DestroyFilesystems is a no-op; there is nothing to destroy,
@@ -212,6 +265,32 @@ func createPartition(ctx context.Context, run RunCommandFunc, devicePath string)
 	return nil
 }
 
+// growPartition grows the single partition (1) on the disk with the
+// specified device path to fill the disk.
+func growPartition(ctx context.Context, run RunCommandFunc, devicePath string) error {
+	logger.Debugf(ctx, "growing partition on %q", devicePath)
+	if _, err := run(ctx, "growpart", devicePath, "1"); err != nil {
+		// growpart exits non-zero if the partition already fills
+		// the disk, which is what we want.
+		if strings.Contains(err.Error(), "NOCHANGE") {
+			return nil
+		}
+		return errors.Annotate(err, "growpart failed")
+	}
+	return nil
+}
+
+// growFilesystem grows the filesystem on the specified device to fill
+// the device. The filesystem may be mounted.
+func growFilesystem(ctx context.Context, run RunCommandFunc, devicePath string) error {
+	logger.Debugf(ctx, "attempting to grow filesystem on %q", devicePath)
+	if _, err := run(ctx, "resize2fs", devicePath); err != nil {
+		return errors.Annotate(err, "resize2fs failed")
+	}
+	logger.Infof(ctx, "grew filesystem on %q", devicePath)
+	return nil
+}
+
 func createFilesystem(
 	ctx context.Context,
 	run RunCommandFunc,
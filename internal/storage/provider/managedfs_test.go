@@ -4,6 +4,7 @@
 package provider_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -127,6 +128,85 @@ func mountInfoLine(id, parent int, root, mountPoint, source string) string {
 	return fmt.Sprintf("%d %d 8:1 %s %s rw,relatime shared:1 - ext4 %s rw,errors=remount-ro", id, parent, root, mountPoint, source)
 }
 
+func (s *managedfsSuite) TestResizeFilesystems(c *tc.C) {
+	source := s.initSource(c)
+	// The partition on sda is grown to fill the disk before the
+	// filesystem on the partition is grown.
+	s.commands.expect("growpart", "/dev/sda", "1")
+	s.commands.expect("resize2fs", "/dev/sda1")
+	// xvdf1 has no partition table to grow.
+	s.commands.expect("resize2fs", "/dev/xvdf1")
+
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: "sda",
+		SizeMiB:    4,
+	}
+	s.blockDevices[names.NewVolumeTag("1")] = blockdevice.BlockDevice{
+		DeviceName: "xvdf1",
+		SizeMiB:    6,
+	}
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+		Size:   4,
+	}, {
+		Tag:    names.NewFilesystemTag("0/1"),
+		Volume: names.NewVolumeTag("1"),
+		Size:   5,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, []storage.ResizeFilesystemsResult{{
+		FilesystemInfo: &storage.FilesystemInfo{
+			ProviderId: "filesystem-0-0",
+			Size:       4,
+		},
+	}, {
+		FilesystemInfo: &storage.FilesystemInfo{
+			ProviderId: "filesystem-0-1",
+			Size:       6,
+		},
+	}})
+}
+
+func (s *managedfsSuite) TestResizeFilesystemsPartitionUnchanged(c *tc.C) {
+	source := s.initSource(c)
+	cmd := s.commands.expect("growpart", "/dev/sda", "1")
+	cmd.respond("", errors.New("NOCHANGE: partition 1 is size 8192. it cannot be grown"))
+	s.commands.expect("resize2fs", "/dev/sda1")
+
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: "sda",
+		SizeMiB:    4,
+	}
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+		Size:   4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+}
+
+func (s *managedfsSuite) TestResizeFilesystemsVolumeNotGrown(c *tc.C) {
+	source := s.initSource(c)
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: "sda",
+		SizeMiB:    2,
+	}
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+		Size:   4,
+	}, {
+		Tag:    names.NewFilesystemTag("0/1"),
+		Volume: names.NewVolumeTag("1"),
+		Size:   4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results[0].Error, tc.ErrorMatches, `backing-volume 0 is not yet big enough \(2M < 4M\)`)
+	c.Assert(results[1].Error, tc.ErrorMatches, "backing-volume 1 is not yet attached")
+}
+
 func (s *managedfsSuite) TestAttachFilesystems(c *tc.C) {
 	nonRelatedFstabEntry := "/dev/foo /mount/point stuff"
 	err := os.WriteFile(filepath.Join(s.fakeEtcDir, "fstab"), []byte(nonRelatedFstabEntry), 0644)
@@ -161,7 +161,10 @@ func ensureEmptyDir(d dirFuncs, path string) error {
 	return nil
 }
 
-var _ storage.FilesystemSource = (*rootfsFilesystemSource)(nil)
+var (
+	_ storage.FilesystemSource  = (*rootfsFilesystemSource)(nil)
+	_ storage.FilesystemResizer = (*rootfsFilesystemSource)(nil)
+)
 
 // ValidateFilesystemParams is defined on the FilesystemSource interface.
 func (s *rootfsFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
@@ -217,6 +220,31 @@ func (s *rootfsFilesystemSource) createFilesystem(
 	}, nil
 }
 
+// ResizeFilesystems is defined on the FilesystemResizer interface.
+//
+// Filesystems in the root filesystem can use all of the space in the
+// storage directory, so resizing only checks that the requested size
+// is available.
+func (s *rootfsFilesystemSource) ResizeFilesystems(ctx context.Context, args []storage.ResizeFilesystemParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		sizeInMiB, err := s.dirFuncs.calculateSize(ctx, s.storageDir)
+		if err != nil {
+			results[i].Error = errors.Trace(err)
+			continue
+		}
+		if sizeInMiB < arg.Size {
+			results[i].Error = errors.Errorf("filesystem is not big enough (%dM < %dM)", sizeInMiB, arg.Size)
+			continue
+		}
+		results[i].FilesystemInfo = &storage.FilesystemInfo{
+			ProviderId: arg.Tag.Id(),
+			Size:       sizeInMiB,
+		}
+	}
+	return results, nil
+}
+
 // DestroyFilesystems is defined on the FilesystemSource interface.
 func (s *rootfsFilesystemSource) DestroyFilesystems(ctx context.Context, filesystemIds []string) ([]error, error) {
 	// DestroyFilesystems is a no-op; we leave the storage directory
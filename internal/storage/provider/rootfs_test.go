@@ -160,6 +160,39 @@ func (s *rootfsSuite) TestCreateFilesystemsNotEnoughSpace(c *tc.C) {
 	c.Assert(results[0].Error, tc.ErrorMatches, "filesystem is not big enough \\(2M < 4M\\)")
 }
 
+func (s *rootfsSuite) TestResizeFilesystems(c *tc.C) {
+	source := s.rootfsFilesystemSource(c)
+	cmd := s.commands.expect("df", "--output=size", s.storageDir)
+	cmd.respond("1K-blocks\n8192", nil)
+
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:          names.NewFilesystemTag("6"),
+		FilesystemId: "6",
+		Size:         4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, []storage.ResizeFilesystemsResult{{
+		FilesystemInfo: &storage.FilesystemInfo{
+			ProviderId: "6",
+			Size:       8,
+		},
+	}})
+}
+
+func (s *rootfsSuite) TestResizeFilesystemsNotEnoughSpace(c *tc.C) {
+	source := s.rootfsFilesystemSource(c)
+	cmd := s.commands.expect("df", "--output=size", s.storageDir)
+	cmd.respond("1K-blocks\n2048", nil)
+
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:          names.NewFilesystemTag("6"),
+		FilesystemId: "6",
+		Size:         4,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results[0].Error, tc.ErrorMatches, "filesystem is not big enough \\(2M < 4M\\)")
+}
+
 func (s *rootfsSuite) TestCreateFilesystemsInvalidPath(c *tc.C) {
 	source := s.rootfsFilesystemSource(c)
 	cmd := s.commands.expect("df", "--output=size", s.storageDir)
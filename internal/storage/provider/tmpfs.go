@@ -127,7 +127,10 @@ type tmpfsFilesystemSource struct {
 	storageDir string
 }
 
-var _ storage.FilesystemSource = (*tmpfsFilesystemSource)(nil)
+var (
+	_ storage.FilesystemSource  = (*tmpfsFilesystemSource)(nil)
+	_ storage.FilesystemResizer = (*tmpfsFilesystemSource)(nil)
+)
 
 // ValidateFilesystemParams is defined on the FilesystemSource interface.
 func (s *tmpfsFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
@@ -157,17 +160,9 @@ func (s *tmpfsFilesystemSource) createFilesystem(params storage.FilesystemParams
 	if err := s.ValidateFilesystemParams(params); err != nil {
 		return nil, errors.Trace(err)
 	}
-	// Align size to the page size in MiB.
-	sizeInMiB := params.Size
-	pageSizeInMiB := uint64(getpagesize()) / (1024 * 1024)
-	if pageSizeInMiB > 0 {
-		x := (sizeInMiB + pageSizeInMiB - 1)
-		sizeInMiB = x - x%pageSizeInMiB
-	}
-
 	info := storage.FilesystemInfo{
 		ProviderId: params.Tag.String(),
-		Size:       sizeInMiB,
+		Size:       alignToPageSize(params.Size),
 	}
 
 	// Creating the mount is the responsibility of AttachFilesystems.
@@ -181,6 +176,70 @@ func (s *tmpfsFilesystemSource) createFilesystem(params storage.FilesystemParams
 	return &storage.Filesystem{params.Tag, params.Volume, info}, nil
 }
 
+// alignToPageSize aligns the size to the page size in MiB.
+func alignToPageSize(sizeInMiB uint64) uint64 {
+	pageSizeInMiB := uint64(getpagesize()) / (1024 * 1024)
+	if pageSizeInMiB > 0 {
+		x := (sizeInMiB + pageSizeInMiB - 1)
+		sizeInMiB = x - x%pageSizeInMiB
+	}
+	return sizeInMiB
+}
+
+// ResizeFilesystems is defined on the FilesystemResizer interface.
+func (s *tmpfsFilesystemSource) ResizeFilesystems(ctx context.Context, args []storage.ResizeFilesystemParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		info, err := s.resizeFilesystem(ctx, arg)
+		if err != nil {
+			results[i].Error = err
+			continue
+		}
+		results[i].FilesystemInfo = info
+	}
+	return results, nil
+}
+
+func (s *tmpfsFilesystemSource) resizeFilesystem(
+	ctx context.Context, arg storage.ResizeFilesystemParams,
+) (*storage.FilesystemInfo, error) {
+	info := storage.FilesystemInfo{
+		ProviderId: arg.Tag.String(),
+		Size:       alignToPageSize(arg.Size),
+	}
+	// Record the new size first, so that the filesystem is mounted
+	// with the new size if it is attached again later.
+	if err := s.overwriteFilesystemInfo(arg.Tag, info); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// tmpfs can be resized while mounted, by remounting it
+	// with a different size option.
+	etcDir := s.dirFuncs.etcDir()
+	for _, attachment := range arg.Attachments {
+		if attachment.Path == "" {
+			continue
+		}
+		options := fmt.Sprintf("size=%dm", info.Size)
+		if attachment.ReadOnly {
+			options += ",ro"
+		}
+		if _, err := s.run(
+			ctx,
+			"mount", "-o", "remount,"+options, attachment.Path,
+		); err != nil {
+			return nil, errors.Annotatef(err, "cannot remount tmpfs at %q", attachment.Path)
+		}
+		if err := removeFstabEntry(etcDir, attachment.Path); err != nil {
+			return nil, errors.Annotate(err, "updating /etc/fstab failed")
+		}
+		if err := ensureFstabEntry(etcDir, arg.Tag.String(), attachment.Path, "tmpfs", options+",nofail"); err != nil {
+			return nil, errors.Annotate(err, "updating /etc/fstab failed")
+		}
+	}
+	return &info, nil
+}
+
 // DestroyFilesystems is defined on the FilesystemSource interface.
 func (s *tmpfsFilesystemSource) DestroyFilesystems(ctx context.Context, filesystemIds []string) ([]error, error) {
 	// DestroyFilesystems is a no-op; there is nothing to destroy,
@@ -277,6 +336,11 @@ func (s *tmpfsFilesystemSource) writeFilesystemInfo(tag names.FilesystemTag, inf
 	if _, err := os.Stat(filename); err == nil {
 		return errors.Errorf("filesystem %v already exists", tag.Id())
 	}
+	return s.overwriteFilesystemInfo(tag, info)
+}
+
+func (s *tmpfsFilesystemSource) overwriteFilesystemInfo(tag names.FilesystemTag, info storage.FilesystemInfo) error {
+	filename := s.filesystemInfoFile(tag)
 	if err := ensureDir(s.dirFuncs, filepath.Dir(filename)); err != nil {
 		return errors.Trace(err)
 	}
@@ -239,6 +239,79 @@ func (s *tmpfsSuite) TestAttachFilesystemsMountReadOnly(c *tc.C) {
 	c.Assert(string(data), tc.Equals, "filesystem-1 /var/lib/juju/storage/fs/foo tmpfs size=1024m,ro,nofail\n")
 }
 
+func (s *tmpfsSuite) TestResizeFilesystems(c *tc.C) {
+	source := s.tmpfsFilesystemSource(c)
+	_, err := source.CreateFilesystems(c.Context(), []storage.FilesystemParams{{
+		Tag:  names.NewFilesystemTag("1"),
+		Size: 1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(
+		filepath.Join(s.fakeEtcDir, "fstab"),
+		[]byte("filesystem-1 /var/lib/juju/storage/fs/foo tmpfs size=1024m,ro,nofail\n"),
+		0644,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.commands.expect("mount", "-o", "remount,size=2048m,ro", "/var/lib/juju/storage/fs/foo")
+
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:          names.NewFilesystemTag("1"),
+		FilesystemId: "filesystem-1",
+		Size:         2048,
+		Attachments: []storage.FilesystemAttachmentParams{{
+			Filesystem: names.NewFilesystemTag("1"),
+			Path:       "/var/lib/juju/storage/fs/foo",
+			AttachmentParams: storage.AttachmentParams{
+				Machine:  names.NewMachineTag("2"),
+				ReadOnly: true,
+			},
+		}},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, []storage.ResizeFilesystemsResult{{
+		FilesystemInfo: &storage.FilesystemInfo{
+			ProviderId: "filesystem-1",
+			Size:       2048,
+		},
+	}})
+	data, err := os.ReadFile(filepath.Join(s.fakeEtcDir, "fstab"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(string(data), tc.Equals, "filesystem-1 /var/lib/juju/storage/fs/foo tmpfs size=2048m,ro,nofail\n")
+
+	// The new size is used when the filesystem is next mounted.
+	s.commands.expect("mount", "-t", "tmpfs", "filesystem-1", "/var/lib/juju/storage/fs/bar", "-o", "size=2048m")
+	attachResults, err := source.AttachFilesystems(c.Context(), []storage.FilesystemAttachmentParams{{
+		Filesystem: names.NewFilesystemTag("1"),
+		Path:       "/var/lib/juju/storage/fs/bar",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(attachResults[0].Error, tc.ErrorIsNil)
+}
+
+func (s *tmpfsSuite) TestResizeFilesystemsRemountFails(c *tc.C) {
+	source := s.tmpfsFilesystemSource(c)
+	_, err := source.CreateFilesystems(c.Context(), []storage.FilesystemParams{{
+		Tag:  names.NewFilesystemTag("1"),
+		Size: 1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+
+	cmd := s.commands.expect("mount", "-o", "remount,size=2048m", "/var/lib/juju/storage/fs/foo")
+	cmd.respond("", errors.New("mount failed"))
+
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Tag:  names.NewFilesystemTag("1"),
+		Size: 2048,
+		Attachments: []storage.FilesystemAttachmentParams{{
+			Filesystem: names.NewFilesystemTag("1"),
+			Path:       "/var/lib/juju/storage/fs/foo",
+		}},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results[0].Error, tc.ErrorMatches, `cannot remount tmpfs at "/var/lib/juju/storage/fs/foo": mount failed`)
+}
+
 func (s *tmpfsSuite) TestAttachFilesystemsMountFails(c *tc.C) {
 	source := s.tmpfsFilesystemSource(c)
 	_, err := source.CreateFilesystems(c.Context(), []storage.FilesystemParams{{
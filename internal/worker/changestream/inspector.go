@@ -0,0 +1,62 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import (
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/changestream/eventmultiplexer"
+)
+
+// Inspector gives the agent introspection access to the change events
+// recorded by the change stream. The inspector is created by the agent,
+// outside of the dependency engine, and the change stream worker is attached
+// to it whilst it's running.
+type Inspector struct {
+	mu     sync.Mutex
+	worker *changeStreamWorker
+}
+
+// NewInspector returns an Inspector with no change stream worker attached.
+func NewInspector() *Inspector {
+	return &Inspector{}
+}
+
+// Events returns the most recent change events received for the given
+// namespace (database) that match the query. A NotFound error is returned if
+// the change stream isn't running, or isn't watching the namespace.
+func (i *Inspector) Events(namespace string, query eventmultiplexer.EventQuery) ([]eventmultiplexer.RecordedEvent, error) {
+	i.mu.Lock()
+	w := i.worker
+	i.mu.Unlock()
+
+	if w == nil {
+		return nil, errors.NotFoundf("change stream")
+	}
+	events, err := w.Events(namespace, query)
+	return events, errors.Trace(err)
+}
+
+// attach attaches the worker to the inspector, returning a func that
+// detaches it again. It's safe to call on a nil inspector.
+func (i *Inspector) attach(w *changeStreamWorker) func() {
+	if i == nil {
+		return func() {}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.worker = w
+
+	return func() {
+		i.mu.Lock()
+		defer i.mu.Unlock()
+		// A replacement worker may have been attached in the meantime.
+		if i.worker == w {
+			i.worker = nil
+		}
+	}
+}
@@ -39,6 +39,10 @@ type ManifoldConfig struct {
 	NewMetricsCollector  MetricsCollectorFn
 	PrometheusRegisterer prometheus.Registerer
 	NewWatchableDB       WatchableDBFn
+
+	// Inspector, if set, is given access to the running worker so that the
+	// agent can introspect the change stream.
+	Inspector *Inspector
 }
 
 func (cfg ManifoldConfig) Validate() error {
@@ -122,7 +126,10 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				config.PrometheusRegisterer.Unregister(metricsCollector)
 				return nil, errors.Trace(err)
 			}
+			detach := config.Inspector.attach(w)
 			return common.NewCleanupWorker(w, func() {
+				detach()
+
 				// Clean up the metrics for the worker, so the next time a
 				// worker is created we can safely register the metrics again.
 				config.PrometheusRegisterer.Unregister(metricsCollector)
@@ -17,6 +17,7 @@ import (
 	sqlair "github.com/canonical/sqlair"
 	changestream "github.com/juju/juju/core/changestream"
 	database "github.com/juju/juju/core/database"
+	eventmultiplexer "github.com/juju/juju/internal/changestream/eventmultiplexer"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -143,6 +144,44 @@ func (c *MockWatchableDBWorkerDyingCall) DoAndReturn(f func() <-chan struct{}) *
 	return c
 }
 
+// Events mocks base method.
+func (m *MockWatchableDBWorker) Events(arg0 eventmultiplexer.EventQuery) []eventmultiplexer.RecordedEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events", arg0)
+	ret0, _ := ret[0].([]eventmultiplexer.RecordedEvent)
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockWatchableDBWorkerMockRecorder) Events(arg0 any) *MockWatchableDBWorkerEventsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockWatchableDBWorker)(nil).Events), arg0)
+	return &MockWatchableDBWorkerEventsCall{Call: call}
+}
+
+// MockWatchableDBWorkerEventsCall wrap *gomock.Call
+type MockWatchableDBWorkerEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWatchableDBWorkerEventsCall) Return(arg0 []eventmultiplexer.RecordedEvent) *MockWatchableDBWorkerEventsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWatchableDBWorkerEventsCall) Do(f func(eventmultiplexer.EventQuery) []eventmultiplexer.RecordedEvent) *MockWatchableDBWorkerEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWatchableDBWorkerEventsCall) DoAndReturn(f func(eventmultiplexer.EventQuery) []eventmultiplexer.RecordedEvent) *MockWatchableDBWorkerEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Kill mocks base method.
 func (m *MockWatchableDBWorker) Kill() {
 	m.ctrl.T.Helper()
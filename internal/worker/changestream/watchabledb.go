@@ -25,6 +25,11 @@ import (
 type WatchableDBWorker interface {
 	worker.Worker
 	changestream.WatchableDB
+
+	// Events returns the most recent change events received for the
+	// database that match the query, along with the subscriptions that each
+	// was dispatched to.
+	Events(query eventmultiplexer.EventQuery) []eventmultiplexer.RecordedEvent
 }
 
 // WatchableDB is a worker that is responsible for managing the lifecycle
@@ -110,6 +115,12 @@ func (w *WatchableDB) Subscribe(summary string, opts ...changestream.Subscriptio
 	return w.mux.Subscribe(summary, opts...)
 }
 
+// Events returns the most recent change events received by the stream
+// muxer that match the query.
+func (w *WatchableDB) Events(query eventmultiplexer.EventQuery) []eventmultiplexer.RecordedEvent {
+	return w.mux.Events(query)
+}
+
 // Report returns the report from the stream muxer.
 func (w *WatchableDB) Report(ctx context.Context) map[string]any {
 	return w.mux.Report(ctx)
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/changestream/eventmultiplexer"
 	internalworker "github.com/juju/juju/internal/worker"
 	"github.com/juju/juju/internal/worker/filenotifywatcher"
 )
@@ -201,6 +202,19 @@ func (w *changeStreamWorker) GetWatchableDB(ctx context.Context, namespace strin
 	return mux.(WatchableDBWorker), nil
 }
 
+// Events returns the most recent change events received for the given
+// namespace that match the query. Unlike GetWatchableDB, it doesn't start
+// the change stream for a namespace that isn't already being watched.
+func (w *changeStreamWorker) Events(namespace string, query eventmultiplexer.EventQuery) ([]eventmultiplexer.RecordedEvent, error) {
+	mux, err := w.workerFromCache(namespace)
+	if err != nil {
+		return nil, errors.Trace(err)
+	} else if mux == nil {
+		return nil, errors.NotFoundf("change stream for namespace %q", namespace)
+	}
+	return mux.Events(query), nil
+}
+
 func (w *changeStreamWorker) workerFromCache(namespace string) (WatchableDBWorker, error) {
 	// If the worker already exists, return the existing worker early.
 	if mux, err := w.runner.Worker(namespace, w.catacomb.Dying()); err == nil {
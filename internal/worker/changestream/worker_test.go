@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/changestream/eventmultiplexer"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testing"
 )
@@ -248,6 +249,59 @@ func (s *workerSuite) TestEventSourceCalledWithError(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, coredatabase.ErrDBNotFound)
 }
 
+func (s *workerSuite) TestInspectorEvents(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+
+	done := make(chan struct{})
+
+	events := []eventmultiplexer.RecordedEvent{{
+		ID:        1,
+		Term:      1,
+		Namespace: "application",
+		Changed:   "foo",
+	}}
+	query := eventmultiplexer.EventQuery{Namespace: "application"}
+
+	s.dbGetter.EXPECT().GetDB(gomock.Any(), "controller").Return(s.TxnRunner(), nil)
+	s.watchableDBWorker.EXPECT().Events(query).Return(events)
+	s.watchableDBWorker.EXPECT().Kill().AnyTimes()
+	s.watchableDBWorker.EXPECT().Wait().DoAndReturn(func() error {
+		select {
+		case <-done:
+		case <-time.After(testing.LongWait):
+			c.Fatal("timed out waiting for Wait to be called")
+		}
+		return nil
+	})
+
+	inspector := NewInspector()
+	_, err := inspector.Events("controller", query)
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+
+	w := s.newWorker(c, 1)
+	defer workertest.CleanKill(c, w)
+	detach := inspector.attach(w.(*changeStreamWorker))
+
+	// Inspecting a namespace doesn't start watching it.
+	_, err = inspector.Events("controller", query)
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+
+	_, err = w.(changestream.WatchableDBGetter).GetWatchableDB(c.Context(), "controller")
+	c.Assert(err, tc.ErrorIsNil)
+
+	obtained, err := inspector.Events("controller", query)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, events)
+
+	detach()
+	_, err = inspector.Events("controller", query)
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+
+	close(done)
+}
+
 func (s *workerSuite) newWorker(c *tc.C, attempts int) worker.Worker {
 	cfg := WorkerConfig{
 		AgentTag:          "agent-tag",
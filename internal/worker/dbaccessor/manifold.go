@@ -15,6 +15,7 @@ import (
 
 	"github.com/juju/juju/agent"
 	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/flightrecorder"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/database/app"
@@ -44,6 +45,10 @@ type ManifoldConfig struct {
 	NewDBWorker               NewDBWorkerFunc
 	NewNodeManager            NewNodeManagerFunc
 	NewMetricsCollector       func() *Collector
+
+	// FlightRecorder is notified of the latency of transactions, so that
+	// slow transactions can trigger a flight recording. It is optional.
+	FlightRecorder flightrecorder.Observer
 }
 
 func (cfg ManifoldConfig) Validate() error {
@@ -134,6 +139,8 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				NewDBWorker:             config.NewDBWorker,
 				ControllerConfigWatcher: controllerConfigWatcher,
 				ClusterConfig:           controllerConf,
+				FlightRecorder:          config.FlightRecorder,
+				DataDir:                 agentConfig.DataDir(),
 			}
 
 			w, err := NewWorker(cfg)
@@ -173,8 +180,13 @@ func dbAccessorOutput(in worker.Worker, out any) error {
 			nodeManager: w.cfg.NodeManager,
 		}
 		*out = target
+	case *coredatabase.ClusterManager:
+		var target coredatabase.ClusterManager = &clusterDetailer{
+			nodeManager: w.cfg.NodeManager,
+		}
+		*out = target
 	default:
-		return errors.Errorf("expected output of *database.DBGetter or *database.DBDeleter, got %T", out)
+		return errors.Errorf("expected output of *database.DBGetter, *database.DBDeleter, *database.ClusterDescriber or *database.ClusterManager, got %T", out)
 	}
 	return nil
 }
@@ -215,6 +227,27 @@ func (c *clusterDetailer) ClusterDetails(ctx context.Context) ([]coredatabase.Cl
 	return result, nil
 }
 
+// RemoveClusterNode demotes the Dqlite node with the input ID to a spare,
+// so that it no longer takes part in the quorum, and then removes it from the
+// cluster. The changes are made via the leader of the cluster.
+func (c *clusterDetailer) RemoveClusterNode(ctx context.Context, id uint64) error {
+	client, err := c.nodeManager.LeaderClient(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting leader client")
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if err := client.Assign(ctx, id, dqlite.Spare); err != nil {
+		return errors.Annotatef(err, "demoting Dqlite node %d", id)
+	}
+	if err := client.Remove(ctx, id); err != nil {
+		return errors.Annotatef(err, "removing Dqlite node %d", id)
+	}
+	return nil
+}
+
 func convertNodeRole(role dqlite.NodeRole) coredatabase.NodeRole {
 	switch role {
 	case dqlite.Voter:
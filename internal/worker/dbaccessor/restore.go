@@ -0,0 +1,107 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dbaccessor
+
+import (
+	"context"
+	"database/sql"
+	"io"
+
+	"github.com/juju/errors"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+)
+
+// restoreBackup applies a backup staged for restore by the Backups facade,
+// replacing the databases and object store of the controller. It is called
+// once the Dqlite node is running, but before any database is opened for use,
+// so that nothing observes the databases as they are replaced.
+//
+// The staged restore is only cleared once it has been applied. If it can't
+// be applied, the controller is left as it was and the error is returned,
+// so that the controller does not start without the state it was asked to
+// restore. The restore is attempted again when the worker restarts, until
+// it succeeds or the pending restore is removed from the backup directory.
+func (w *dbWorker) restoreBackup(ctx context.Context) error {
+	if w.cfg.DataDir == "" {
+		return nil
+	}
+	backupDir := corebackups.BackupDir(w.cfg.DataDir)
+	archivePath, err := corebackups.PendingRestore(backupDir)
+	if errors.Is(err, coreerrors.NotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Annotate(err, "reading pending restore")
+	}
+
+	// The restore replaces the database of this node only, so the other
+	// members of a cluster would diverge from it.
+	client, err := w.dbApp.Client(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	members, err := client.Cluster(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(members) > 1 {
+		return errors.Errorf("cannot restore backup %q into a cluster of %d nodes", archivePath, len(members))
+	}
+
+	w.cfg.Logger.Infof(ctx, "restoring backup %q", archivePath)
+	if err := corebackups.RestoreArchive(ctx, corebackups.RestoreArgs{
+		ArchivePath: archivePath,
+		DataDir:     w.cfg.DataDir,
+		Check:       w.checkDatabase,
+		Dump:        w.dumpDatabase,
+		Load:        w.loadDatabase,
+	}); err != nil {
+		return errors.Annotatef(err, "restoring backup %q", archivePath)
+	}
+	if err := corebackups.ClearPendingRestore(backupDir); err != nil {
+		return errors.Annotate(err, "clearing pending restore")
+	}
+	w.cfg.Logger.Infof(ctx, "restored backup %q", archivePath)
+	return nil
+}
+
+// checkDatabase checks that the database with the given namespace can be
+// replaced with the dump read from r, without changing it.
+func (w *dbWorker) checkDatabase(ctx context.Context, namespace string, r io.Reader) error {
+	db, err := w.dbApp.Open(ctx, namespace)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = db.Close() }()
+
+	return errors.Trace(internaldatabase.CheckDump(ctx, db, r))
+}
+
+// dumpDatabase writes the dump of the database with the given namespace
+// to w.
+func (w *dbWorker) dumpDatabase(ctx context.Context, namespace string, wr io.Writer) error {
+	db, err := w.dbApp.Open(ctx, namespace)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = db.Close() }()
+
+	return errors.Trace(internaldatabase.StdTxn(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+		return internaldatabase.DumpDB(ctx, tx, wr)
+	}))
+}
+
+// loadDatabase replaces the database with the given namespace with the dump
+// read from r.
+func (w *dbWorker) loadDatabase(ctx context.Context, namespace string, r io.Reader) error {
+	db, err := w.dbApp.Open(ctx, namespace)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = db.Close() }()
+
+	return errors.Trace(internaldatabase.LoadDB(ctx, db, r))
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dbaccessor
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/database/dqlite"
+)
+
+type restoreSuite struct {
+	dbBaseSuite
+
+	dataDir string
+}
+
+func TestRestoreSuite(t *stdtesting.T) {
+	tc.Run(t, &restoreSuite{})
+}
+
+func (s *restoreSuite) SetUpTest(c *tc.C) {
+	s.dbBaseSuite.SetUpTest(c)
+	s.dataDir = c.MkDir()
+}
+
+// stageBackup creates a backup of the controller database and an object, and
+// stages it for restore.
+func (s *restoreSuite) stageBackup(c *tc.C) {
+	_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO controller_config (key, value) VALUES ('restored', 'yes')`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	objectPath := filepath.Join(s.dataDir, "objectstore", "controller", "abc")
+	err = os.MkdirAll(filepath.Dir(objectPath), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(objectPath, []byte("object"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	meta := corebackups.NewMetadata()
+	meta.Started = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	archive, err := corebackups.CreateArchive(c.Context(), corebackups.CreateArgs{
+		Paths: corebackups.Paths{
+			BackupDir: corebackups.BackupDir(s.dataDir),
+			DataDir:   s.dataDir,
+		},
+		Metadata:   meta,
+		Namespaces: []string{"controller"},
+		Dump: func(ctx context.Context, _ string, w io.Writer) error {
+			return s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+				return internaldatabase.DumpDB(ctx, tx, w)
+			})
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The object is removed after the backup, and is brought back by the
+	// restore.
+	err = os.Remove(objectPath)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = corebackups.StageRestore(corebackups.BackupDir(s.dataDir), archive.Filename)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *restoreSuite) newWorker() *dbWorker {
+	return &dbWorker{
+		cfg: WorkerConfig{
+			Logger:  s.logger,
+			DataDir: s.dataDir,
+		},
+		dbApp: s.dbApp,
+	}
+}
+
+func (s *restoreSuite) TestRestoreBackup(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.stageBackup(c)
+
+	// The controller database is restored into a database of its own, so
+	// that it can be compared with the one backed up.
+	s.dbApp.EXPECT().Client(gomock.Any()).Return(s.client, nil)
+	s.client.EXPECT().Cluster(gomock.Any()).Return([]dqlite.NodeInfo{{ID: 1}}, nil)
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").DoAndReturn(func(ctx context.Context, _ string) (*sql.DB, error) {
+		return s.DBApp().Open(ctx, "restored")
+	}).Times(3)
+
+	err := s.newWorker().restoreBackup(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, target := s.OpenDBForNamespace(c, "restored", true)
+	var value string
+	err = target.QueryRowContext(c.Context(), "SELECT value FROM controller_config WHERE key = 'restored'").Scan(&value)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value, tc.Equals, "yes")
+
+	data, err := os.ReadFile(filepath.Join(s.dataDir, "objectstore", "controller", "abc"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "object")
+
+	_, err = corebackups.PendingRestore(corebackups.BackupDir(s.dataDir))
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *restoreSuite) TestRestoreBackupNothingStaged(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.newWorker().restoreBackup(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *restoreSuite) TestRestoreBackupCluster(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.stageBackup(c)
+
+	s.dbApp.EXPECT().Client(gomock.Any()).Return(s.client, nil)
+	s.client.EXPECT().Cluster(gomock.Any()).Return([]dqlite.NodeInfo{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+
+	err := s.newWorker().restoreBackup(c.Context())
+	c.Assert(err, tc.ErrorMatches, `cannot restore backup ".*" into a cluster of 3 nodes`)
+
+	// Nothing is restored, and the restore stays staged.
+	_, err = os.Stat(filepath.Join(s.dataDir, "objectstore", "controller", "abc"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+	_, err = corebackups.PendingRestore(corebackups.BackupDir(s.dataDir))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *restoreSuite) TestRestoreBackupCheckFails(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.stageBackup(c)
+
+	s.dbApp.EXPECT().Client(gomock.Any()).Return(s.client, nil)
+	s.client.EXPECT().Cluster(gomock.Any()).Return([]dqlite.NodeInfo{{ID: 1}}, nil)
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").Return(nil, errors.New("boom"))
+
+	err := s.newWorker().restoreBackup(c.Context())
+	c.Assert(err, tc.ErrorMatches, `restoring backup ".*": checking database "controller": boom`)
+
+	// Nothing is restored, and the restore stays staged so that it is
+	// attempted again.
+	_, err = os.Stat(filepath.Join(s.dataDir, "objectstore", "controller", "abc"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+	_, err = corebackups.PendingRestore(corebackups.BackupDir(s.dataDir))
+	c.Check(err, tc.ErrorIsNil)
+}
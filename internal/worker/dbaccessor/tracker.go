@@ -18,6 +18,7 @@ import (
 
 	corecontext "github.com/juju/juju/core/context"
 	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/flightrecorder"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/domain/schema"
 	"github.com/juju/juju/internal/database"
@@ -79,6 +80,28 @@ func WithMetricsCollector(metrics *Collector) TrackedDBWorkerOption {
 	}
 }
 
+// WithFlightRecorder sets the flight recorder notified of the latency of
+// transactions, so that slow transactions can trigger a flight recording.
+func WithFlightRecorder(observer flightrecorder.Observer) TrackedDBWorkerOption {
+	return func(w *trackedDBWorker) {
+		if observer == nil {
+			return
+		}
+		w.txnLatencyObserver = txnLatencyObserver{observer: observer}
+	}
+}
+
+// txnLatencyObserver reports the latency of transactions to the flight
+// recorder.
+type txnLatencyObserver struct {
+	observer flightrecorder.Observer
+}
+
+// ObserveTxnLatency is part of the txn.LatencyObserver interface.
+func (o txnLatencyObserver) ObserveTxnLatency(latency time.Duration) {
+	o.observer.ObserveLatency(flightrecorder.SourceTxnLatency, latency)
+}
+
 type trackedDBWorker struct {
 	internalStates chan string
 	tomb           tomb.Tomb
@@ -94,6 +117,8 @@ type trackedDBWorker struct {
 	metrics      *Collector
 	dbTxnMetrics txn.Metrics
 
+	txnLatencyObserver txn.LatencyObserver
+
 	pingDBFunc func(context.Context, *sql.DB) error
 
 	report *report
@@ -214,7 +239,7 @@ func (w *trackedDBWorker) Txn(ctx context.Context, fn func(context.Context, *sql
 		// now have the correct reason for the death of the transaction. Either
 		// the tomb died or the context was cancelled.
 		ctx = corecontext.WithSourceableError(w.tomb.Context(ctx), w)
-		return errors.Trace(database.Txn(ctx, db, fn))
+		return errors.Trace(database.Txn(w.withLatencyObserver(ctx), db, fn))
 	})
 }
 
@@ -230,7 +255,7 @@ func (w *trackedDBWorker) StdTxn(ctx context.Context, fn func(context.Context, *
 		// now have the correct reason for the death of the transaction. Either
 		// the tomb died or the context was cancelled.
 		ctx = corecontext.WithSourceableError(w.tomb.Context(ctx), w)
-		return errors.Trace(database.StdTxn(ctx, db.PlainDB(), fn))
+		return errors.Trace(database.StdTxn(w.withLatencyObserver(ctx), db.PlainDB(), fn))
 	})
 }
 
@@ -283,6 +308,15 @@ func (w *trackedDBWorker) run(ctx context.Context, fn func(*sqlair.DB) error) er
 	})
 }
 
+// withLatencyObserver injects the latency observer, if any, into the context
+// for the txn.
+func (w *trackedDBWorker) withLatencyObserver(ctx context.Context) context.Context {
+	if w.txnLatencyObserver == nil {
+		return ctx
+	}
+	return txn.WithLatencyObserver(ctx, w.txnLatencyObserver)
+}
+
 // meterDBOpResults decrements the active DB operation count,
 // and records the result and duration of the completed operation.
 func (w *trackedDBWorker) meterDBOpResult(begin time.Time, err error) {
@@ -22,6 +22,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/flightrecorder"
 )
 
 // Ensure that the trackedDBWorker is a killableWorker.
@@ -164,6 +165,36 @@ func (s *trackedDBWorkerSuite) TestWorkerStdTxnIsNotNil(c *tc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *trackedDBWorkerSuite) TestWorkerTxnObservedByFlightRecorder(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+	defer s.expectTimer(0)()
+
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").Return(s.DB(), nil)
+
+	observer := &latencyObserver{}
+	w, err := newTrackedDBWorker(c.Context(),
+		s.states,
+		s.dbApp, "controller",
+		WithClock(s.clock),
+		WithLogger(s.logger),
+		WithPingDBFunc(defaultPingDBFunc),
+		WithMetricsCollector(NewMetricsCollector()),
+		WithFlightRecorder(observer),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	err = w.StdTxn(c.Context(), func(_ context.Context, tx *sql.Tx) error {
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(observer.sources, tc.DeepEquals, []flightrecorder.Source{flightrecorder.SourceTxnLatency})
+
+	workertest.CleanKill(c, w)
+}
+
 func (s *trackedDBWorkerSuite) TestWorkerAttemptsToVerifyDB(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -517,3 +548,12 @@ func (checker *hasKeysChecker[T]) Check(params []any, names []string) (result bo
 	}
 	return true, ""
 }
+
+type latencyObserver struct {
+	flightrecorder.Observer
+	sources []flightrecorder.Source
+}
+
+func (o *latencyObserver) ObserveLatency(source flightrecorder.Source, _ time.Duration) {
+	o.sources = append(o.sources, source)
+}
@@ -16,11 +16,13 @@ import (
 	"github.com/juju/worker/v5/dependency"
 
 	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/flightrecorder"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/domain/controllernode/service"
 	"github.com/juju/juju/domain/controllernode/state"
 	internaldatabase "github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/database/app"
+	dqliteclient "github.com/juju/juju/internal/database/client"
 	"github.com/juju/juju/internal/database/dqlite"
 	internalerrors "github.com/juju/juju/internal/errors"
 	internalworker "github.com/juju/juju/internal/worker"
@@ -68,6 +70,10 @@ type NodeManager interface {
 	// Dqlite nodes configured to be in the cluster.
 	ClusterServers(context.Context) ([]dqlite.NodeInfo, error)
 
+	// LeaderClient returns a Dqlite client that is connected to the leader
+	// of the Dqlite cluster.
+	LeaderClient(context.Context) (*dqliteclient.Client, error)
+
 	//SetClusterServers reconfigures the Dqlite cluster members.
 	SetClusterServers(context.Context, []dqlite.NodeInfo) error
 
@@ -168,6 +174,15 @@ type WorkerConfig struct {
 
 	// ClusterConfig supplies bind addresses used for Dqlite clustering.
 	ClusterConfig ClusterConfig
+
+	// FlightRecorder is notified of the latency of transactions, so that
+	// slow transactions can trigger a flight recording. It is optional.
+	FlightRecorder flightrecorder.Observer
+
+	// DataDir is the data directory of the controller agent, holding the
+	// backups staged for restore and the file backed object store. It is
+	// optional; without it, staged restores are not applied.
+	DataDir string
 }
 
 // Validate ensures that the config values are valid.
@@ -613,6 +628,11 @@ func (w *dbWorker) initialiseDqlite(ctx context.Context, options ...app.Option)
 		return errors.Trace(err)
 	}
 
+	// Apply any backup staged for restore before the databases are used.
+	if err := w.restoreBackup(ctx); err != nil {
+		return errors.Annotate(err, "restoring backup")
+	}
+
 	// Open up the default controller database.
 	// Other database namespaces are opened lazily via GetDB calls.
 	// We don't need to apply the database schema here as the
@@ -730,6 +750,7 @@ func (w *dbWorker) openDatabase(ctx context.Context, namespace string) error {
 			WithClock(w.cfg.Clock),
 			WithLogger(w.cfg.Logger.Child(database.ShortNamespace(namespace))),
 			WithMetricsCollector(w.cfg.MetricsCollector),
+			WithFlightRecorder(w.cfg.FlightRecorder),
 		)
 	})
 	if errors.Is(err, errors.AlreadyExists) {
@@ -37,6 +37,7 @@ type ManifoldConfig struct {
 	HTTPClientName              string
 	LeaseManagerName            string
 	LogSinkName                 string
+	DataDir                     string
 	LogDir                      string
 	Logger                      logger.Logger
 	Clock                       clock.Clock
@@ -56,9 +57,10 @@ type DomainServicesGetterFn func(
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.Manager,
-	coredatabase.ClusterDescriber,
+	coredatabase.ClusterManager,
 	corehttp.HTTPClient,
 	string,
+	string,
 	clock.Clock,
 	logger.LoggerContextGetter,
 ) services.DomainServicesGetter
@@ -68,6 +70,7 @@ type DomainServicesGetterFn func(
 type ControllerDomainServicesFn func(
 	changestream.WatchableDBGetter,
 	objectstore.NamespacedObjectStoreGetter,
+	string,
 	clock.Clock,
 	logger.Logger,
 	logger.LoggerContextGetter,
@@ -83,9 +86,10 @@ type ModelDomainServicesFn func(
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.ModelLeaseManagerGetter,
-	coredatabase.ClusterDescriber,
+	coredatabase.ClusterManager,
 	corehttp.HTTPClient,
 	string,
+	string,
 	clock.Clock,
 	logger.Logger,
 ) services.ModelDomainServices
@@ -128,6 +132,9 @@ func (config ManifoldConfig) Validate() error {
 	if config.NewModelDomainServices == nil {
 		return errors.NotValidf("nil NewModelDomainServices")
 	}
+	if config.DataDir == "" {
+		return errors.NotValidf("empty DataDir")
+	}
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
@@ -170,8 +177,8 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		return nil, errors.Trace(err)
 	}
 
-	var clusterDescriber coredatabase.ClusterDescriber
-	if err := getter.Get(config.DBAccessorName, &clusterDescriber); err != nil {
+	var clusterManager coredatabase.ClusterManager
+	if err := getter.Get(config.DBAccessorName, &clusterManager); err != nil {
 		return nil, errors.Trace(err)
 	}
 
@@ -217,13 +224,14 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 
 	return config.NewWorker(Config{
 		DBGetter:                    dbGetter,
-		ClusterDescriber:            clusterDescriber,
+		ClusterManager:              clusterManager,
 		ProviderFactory:             providerFactory,
 		ObjectStoreGetter:           objectStoreGetter,
 		StorageRegistryGetter:       storageRegistryGetter,
 		PublicKeyImporter:           sshimporter.NewImporter(sshImporterClient),
 		LeaseManager:                leaseManager,
 		LoggerContextGetter:         loggerContextGetter,
+		DataDir:                     config.DataDir,
 		LogDir:                      config.LogDir,
 		Logger:                      config.Logger,
 		Clock:                       config.Clock,
@@ -260,6 +268,7 @@ func (config ManifoldConfig) output(in worker.Worker, out any) error {
 func NewControllerDomainServices(
 	dbGetter changestream.WatchableDBGetter,
 	controllerObjectStoreGetter objectstore.NamespacedObjectStoreGetter,
+	dataDir string,
 	clock clock.Clock,
 	logger logger.Logger,
 	loggerContextGetter logger.LoggerContextGetter,
@@ -267,6 +276,7 @@ func NewControllerDomainServices(
 	return domainservices.NewControllerServices(
 		changestream.NewWatchableDBFactoryForNamespace(dbGetter.GetWatchableDB, coredatabase.ControllerNS),
 		controllerObjectStoreGetter,
+		dataDir,
 		clock,
 		logger,
 		loggerContextGetter,
@@ -284,8 +294,9 @@ func NewProviderTrackerModelDomainServices(
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
-	clusterDescriber coredatabase.ClusterDescriber,
+	clusterManager coredatabase.ClusterManager,
 	simpleStreamsHTTPClient corehttp.HTTPClient,
+	dataDir string,
 	logDir string,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -300,8 +311,9 @@ func NewProviderTrackerModelDomainServices(
 		storageRegistry,
 		publicKeyImporter,
 		leaseManager,
-		clusterDescriber,
+		clusterManager,
 		simpleStreamsHTTPClient,
+		dataDir,
 		logDir,
 		clock,
 		logger,
@@ -318,8 +330,9 @@ func NewDomainServicesGetter(
 	storageRegistryGetter storage.StorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.Manager,
-	clusterDescriber coredatabase.ClusterDescriber,
+	clusterManager coredatabase.ClusterManager,
 	simpleStreamsHTTPClient corehttp.HTTPClient,
+	dataDir string,
 	logDir string,
 	clock clock.Clock,
 	loggerContextGetter logger.LoggerContextGetter,
@@ -333,8 +346,9 @@ func NewDomainServicesGetter(
 		storageRegistryGetter:   storageRegistryGetter,
 		publicKeyImporter:       publicKeyImporter,
 		leaseManager:            leaseManager,
-		clusterDescriber:        clusterDescriber,
+		clusterManager:          clusterManager,
 		simpleStreamsHTTPClient: simpleStreamsHTTPClient,
+		dataDir:                 dataDir,
 		logDir:                  logDir,
 		clock:                   clock,
 		loggerContextGetter:     loggerContextGetter,
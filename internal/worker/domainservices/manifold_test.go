@@ -93,6 +93,10 @@ func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
 	cfg.NewModelDomainServices = nil
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig(c)
+	cfg.DataDir = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig(c)
 	cfg.LogDir = ""
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
@@ -109,7 +113,7 @@ func (s *manifoldSuite) TestStart(c *tc.C) {
 	s.httpClientGetter.EXPECT().GetHTTPClient(gomock.Any(), corehttp.SimpleStreamPurpose).Return(s.simpleStreamClient, nil)
 
 	getter := map[string]any{
-		"dbaccessor":      s.clusterDescriber,
+		"dbaccessor":      s.clusterManager,
 		"changestream":    s.dbGetter,
 		"providerfactory": s.providerFactory,
 		"objectstore":     s.objectStoreGetter,
@@ -133,6 +137,7 @@ func (s *manifoldSuite) TestStart(c *tc.C) {
 		NewDomainServicesGetter:     NewDomainServicesGetter,
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
+		DataDir:                     c.MkDir(),
 		LogDir:                      c.MkDir(),
 		Clock:                       s.clock,
 	})
@@ -148,7 +153,7 @@ func (s *manifoldSuite) TestOutputControllerDomainServices(c *tc.C) {
 
 	w, err := NewWorker(Config{
 		DBGetter:                    s.dbGetter,
-		ClusterDescriber:            s.clusterDescriber,
+		ClusterManager:              s.clusterManager,
 		Logger:                      s.logger,
 		LoggerContextGetter:         s.loggerContextGetter,
 		ProviderFactory:             s.providerFactory,
@@ -159,6 +164,7 @@ func (s *manifoldSuite) TestOutputControllerDomainServices(c *tc.C) {
 		NewDomainServicesGetter:     NewDomainServicesGetter,
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
+		DataDir:                     c.MkDir(),
 		LogDir:                      c.MkDir(),
 		Clock:                       s.clock,
 		SimpleStreamsClient:         s.httpClient,
@@ -178,7 +184,7 @@ func (s *manifoldSuite) TestOutputDomainServicesGetter(c *tc.C) {
 
 	w, err := NewWorker(Config{
 		DBGetter:                    s.dbGetter,
-		ClusterDescriber:            s.clusterDescriber,
+		ClusterManager:              s.clusterManager,
 		Logger:                      s.logger,
 		LoggerContextGetter:         s.loggerContextGetter,
 		ProviderFactory:             s.providerFactory,
@@ -190,6 +196,7 @@ func (s *manifoldSuite) TestOutputDomainServicesGetter(c *tc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		SimpleStreamsClient:         s.httpClient,
+		DataDir:                     c.MkDir(),
 		LogDir:                      c.MkDir(),
 		Clock:                       s.clock,
 	})
@@ -208,7 +215,7 @@ func (s *manifoldSuite) TestOutputInvalid(c *tc.C) {
 
 	w, err := NewWorker(Config{
 		DBGetter:                    s.dbGetter,
-		ClusterDescriber:            s.clusterDescriber,
+		ClusterManager:              s.clusterManager,
 		Logger:                      s.logger,
 		LoggerContextGetter:         s.loggerContextGetter,
 		ProviderFactory:             s.providerFactory,
@@ -220,6 +227,7 @@ func (s *manifoldSuite) TestOutputInvalid(c *tc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		SimpleStreamsClient:         s.httpClient,
+		DataDir:                     c.MkDir(),
 		LogDir:                      c.MkDir(),
 		Clock:                       s.clock,
 	})
@@ -234,7 +242,7 @@ func (s *manifoldSuite) TestOutputInvalid(c *tc.C) {
 }
 
 func (s *manifoldSuite) TestNewControllerDomainServices(c *tc.C) {
-	factory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, s.clock, s.logger, s.loggerContextGetter)
+	factory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, c.MkDir(), s.clock, s.logger, s.loggerContextGetter)
 	c.Assert(factory, tc.NotNil)
 }
 
@@ -247,9 +255,10 @@ func (s *manifoldSuite) TestNewModelDomainServices(c *tc.C) {
 		s.modelStorageRegistryGetter,
 		s.publicKeyImporter,
 		s.modelLeaseManagerGetter,
-		s.clusterDescriber,
+		s.clusterManager,
 		s.httpClient,
 		c.MkDir(),
+		c.MkDir(),
 		s.clock,
 		s.logger,
 	)
@@ -262,7 +271,7 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *tc.C) {
 	s.loggerContextGetter.EXPECT().GetLoggerContext(gomock.Any(), coremodel.UUID("model")).Return(s.loggerContext, nil)
 	s.loggerContext.EXPECT().GetLogger("juju.services").Return(s.logger)
 
-	ctrlFactory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, s.clock, s.logger, s.loggerContextGetter)
+	ctrlFactory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, c.MkDir(), s.clock, s.logger, s.loggerContextGetter)
 	factory := NewDomainServicesGetter(
 		ctrlFactory,
 		s.dbGetter,
@@ -272,9 +281,10 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *tc.C) {
 		s.storageRegistryGetter,
 		s.publicKeyImporter,
 		s.leaseManager,
-		s.clusterDescriber,
+		s.clusterManager,
 		s.httpClient,
 		c.MkDir(),
+		c.MkDir(),
 		s.clock,
 		s.loggerContextGetter,
 	)
@@ -295,6 +305,7 @@ func (s *manifoldSuite) getConfig(c *tc.C) ManifoldConfig {
 		HTTPClientName:      "httpclient",
 		LeaseManagerName:    "leasemanager",
 		LogSinkName:         "logsink",
+		DataDir:             c.MkDir(),
 		LogDir:              c.MkDir(),
 		Clock:               s.clock,
 		Logger:              s.logger,
@@ -316,9 +327,10 @@ func noopDomainServicesGetter(
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.Manager,
-	database.ClusterDescriber,
+	database.ClusterManager,
 	corehttp.HTTPClient,
 	string,
+	string,
 	clock.Clock,
 	logger.LoggerContextGetter,
 ) services.DomainServicesGetter {
@@ -328,6 +340,7 @@ func noopDomainServicesGetter(
 func noopControllerDomainServices(
 	changestream.WatchableDBGetter,
 	objectstore.NamespacedObjectStoreGetter,
+	string,
 	clock.Clock,
 	logger.Logger,
 	logger.LoggerContextGetter,
@@ -344,9 +357,10 @@ func noopModelDomainServices(
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.ModelLeaseManagerGetter,
-	database.ClusterDescriber,
+	database.ClusterManager,
 	corehttp.HTTPClient,
 	string,
+	string,
 	clock.Clock,
 	logger.Logger,
 ) services.ModelDomainServices {
@@ -27,7 +27,7 @@ import (
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination domainservices_mock_test.go github.com/juju/juju/internal/services ControllerDomainServices,ModelDomainServices,DomainServices,DomainServicesGetter
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination changestream_mock_test.go github.com/juju/juju/core/changestream WatchableDBGetter
-//go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination database_mock_test.go github.com/juju/juju/core/database ClusterDescriber
+//go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination database_mock_test.go github.com/juju/juju/core/database ClusterManager
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination providertracker_mock_test.go github.com/juju/juju/core/providertracker Provider,ProviderFactory
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStore,ObjectStoreGetter,ModelObjectStoreGetter
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination storage_mock_test.go github.com/juju/juju/core/storage StorageRegistryGetter,ModelStorageRegistryGetter
@@ -64,7 +64,7 @@ type baseSuite struct {
 	httpClient         *MockHTTPClient
 	simpleStreamClient *MockHTTPClient
 
-	clusterDescriber *MockClusterDescriber
+	clusterManager *MockClusterManager
 
 	leaseManager            *MockManager
 	leaseManagerGetter      *MockLeaseManagerGetter
@@ -102,7 +102,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.httpClient = NewMockHTTPClient(ctrl)
 	s.simpleStreamClient = NewMockHTTPClient(ctrl)
 
-	s.clusterDescriber = NewMockClusterDescriber(ctrl)
+	s.clusterManager = NewMockClusterManager(ctrl)
 
 	s.leaseManager = NewMockManager(ctrl)
 	s.leaseManagerGetter = NewMockLeaseManagerGetter(ctrl)
@@ -137,7 +137,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.httpClient = nil
 		s.simpleStreamClient = nil
 
-		s.clusterDescriber = nil
+		s.clusterManager = nil
 
 		s.leaseManager = nil
 		s.leaseManagerGetter = nil
@@ -160,8 +160,9 @@ func NewModelDomainServices(
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
-	clusterDescriber coredatabase.ClusterDescriber,
+	clusterManager coredatabase.ClusterManager,
 	simpleStreamsClient corehttp.HTTPClient,
+	dataDir string,
 	logDir string,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -176,8 +177,9 @@ func NewModelDomainServices(
 		storageRegistry,
 		publicKeyImporter,
 		leaseManager,
-		clusterDescriber,
+		clusterManager,
 		simpleStreamsClient,
+		dataDir,
 		logDir,
 		clock,
 		logger,
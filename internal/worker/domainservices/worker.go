@@ -31,8 +31,8 @@ type Config struct {
 	// DBGetter supplies WatchableDB implementations by namespace.
 	DBGetter changestream.WatchableDBGetter
 
-	// ClusterDescriber is used to describe the cluster.
-	ClusterDescriber coredatabase.ClusterDescriber
+	// ClusterManager is used to describe and manage the cluster.
+	ClusterManager coredatabase.ClusterManager
 
 	// ProviderFactory is used to get provider instances.
 	ProviderFactory providertracker.ProviderFactory
@@ -52,6 +52,10 @@ type Config struct {
 	// LoggerContextGetter is used to get the logger context per model.
 	LoggerContextGetter logger.LoggerContextGetter
 
+	// DataDir is the agent data directory, beneath which the controller
+	// secret encryption keys are held.
+	DataDir string
+
 	// LogDir is the directory where logs are stored.
 	LogDir string
 
@@ -79,8 +83,8 @@ func (config Config) Validate() error {
 	if config.DBGetter == nil {
 		return errors.NotValidf("nil DBGetter")
 	}
-	if config.ClusterDescriber == nil {
-		return errors.NotValidf("nil ClusterDescriber")
+	if config.ClusterManager == nil {
+		return errors.NotValidf("nil ClusterManager")
 	}
 	if config.ProviderFactory == nil {
 		return errors.NotValidf("nil ProviderServices")
@@ -112,6 +116,9 @@ func (config Config) Validate() error {
 	if config.NewModelDomainServices == nil {
 		return errors.NotValidf("nil NewModelDomainServices")
 	}
+	if config.DataDir == "" {
+		return errors.NotValidf("empty DataDir")
+	}
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
@@ -136,6 +143,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 	ctrlFactory := config.NewControllerDomainServices(
 		config.DBGetter,
 		controllerObjectStoreGetter,
+		config.DataDir,
 		config.Clock,
 		config.Logger,
 		config.LoggerContextGetter,
@@ -151,8 +159,9 @@ func NewWorker(config Config) (worker.Worker, error) {
 			config.StorageRegistryGetter,
 			config.PublicKeyImporter,
 			config.LeaseManager,
-			config.ClusterDescriber,
+			config.ClusterManager,
 			config.SimpleStreamsClient,
+			config.DataDir,
 			config.LogDir,
 			config.Clock,
 			config.LoggerContextGetter,
@@ -218,8 +227,9 @@ type domainServicesGetter struct {
 	storageRegistryGetter   storage.StorageRegistryGetter
 	publicKeyImporter       domainservices.PublicKeyImporter
 	leaseManager            lease.Manager
-	clusterDescriber        coredatabase.ClusterDescriber
+	clusterManager          coredatabase.ClusterManager
 	simpleStreamsHTTPClient http.HTTPClient
+	dataDir                 string
 	logDir                  string
 	clock                   clock.Clock
 	loggerContextGetter     logger.LoggerContextGetter
@@ -254,8 +264,9 @@ func (s *domainServicesGetter) ServicesForModel(ctx context.Context, modelUUID c
 				modelUUID: modelUUID,
 				manager:   s.leaseManager,
 			},
-			s.clusterDescriber,
+			s.clusterManager,
 			s.simpleStreamsHTTPClient,
+			s.dataDir,
 			s.logDir,
 			s.clock,
 			loggerContext.GetLogger("juju.services"),
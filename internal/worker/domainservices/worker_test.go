@@ -84,7 +84,11 @@ func (s *workerSuite) TestValidateConfig(c *tc.C) {
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
 	cfg = s.getConfig(c)
-	cfg.ClusterDescriber = nil
+	cfg.ClusterManager = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.DataDir = ""
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
 	cfg = s.getConfig(c)
@@ -108,7 +112,8 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 		StorageRegistryGetter: s.storageRegistryGetter,
 		PublicKeyImporter:     s.publicKeyImporter,
 		LeaseManager:          s.leaseManager,
-		ClusterDescriber:      s.clusterDescriber,
+		ClusterManager:        s.clusterManager,
+		DataDir:               c.MkDir(),
 		LogDir:                c.MkDir(),
 		Clock:                 s.clock,
 		SimpleStreamsClient:   s.simpleStreamClient,
@@ -123,9 +128,10 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 			storage.StorageRegistryGetter,
 			domainservices.PublicKeyImporter,
 			lease.Manager,
-			database.ClusterDescriber,
+			database.ClusterManager,
 			corehttp.HTTPClient,
 			string,
+			string,
 			clock.Clock,
 			logger.LoggerContextGetter,
 		) services.DomainServicesGetter {
@@ -134,6 +140,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 		NewControllerDomainServices: func(
 			changestream.WatchableDBGetter,
 			objectstore.NamespacedObjectStoreGetter,
+			string,
 			clock.Clock,
 			logger.Logger,
 			logger.LoggerContextGetter,
@@ -149,9 +156,10 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 			storage.ModelStorageRegistryGetter,
 			domainservices.PublicKeyImporter,
 			lease.ModelLeaseManagerGetter,
-			database.ClusterDescriber,
+			database.ClusterManager,
 			corehttp.HTTPClient,
 			string,
+			string,
 			clock.Clock,
 			logger.Logger,
 		) services.ModelDomainServices {
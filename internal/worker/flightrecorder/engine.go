@@ -0,0 +1,31 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package flightrecorder
+
+import (
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/core/flightrecorder"
+)
+
+// NewEngineMetrics returns dependency engine metrics which, as well as
+// recording to metrics, report the start of every manifold to the observer,
+// so that restart storms can trigger a flight recording.
+func NewEngineMetrics(metrics dependency.Metrics, observer flightrecorder.Observer) dependency.Metrics {
+	return engineMetrics{
+		Metrics:  metrics,
+		observer: observer,
+	}
+}
+
+type engineMetrics struct {
+	dependency.Metrics
+	observer flightrecorder.Observer
+}
+
+// RecordStart is part of the dependency.Metrics interface.
+func (m engineMetrics) RecordStart(name string) {
+	m.Metrics.RecordStart(name)
+	m.observer.ObserveStart(name)
+}
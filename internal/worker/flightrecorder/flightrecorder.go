@@ -6,8 +6,16 @@ package flightrecorder
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/juju/clock"
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/flightrecorder"
@@ -30,18 +38,73 @@ type FileRecorder interface {
 	Enabled() bool
 }
 
+const (
+	// defaultMaxTriggeredCaptures is the number of triggered captures kept
+	// on disk. Older captures are removed as new ones are written.
+	defaultMaxTriggeredCaptures = 10
+
+	// defaultTriggerCooldown is the minimum time between two triggered
+	// captures, so that a sustained anomaly doesn't fill the disk or slow
+	// the agent down further.
+	defaultTriggerCooldown = 5 * time.Minute
+
+	// triggeredDir is the directory, relative to the recording path, which
+	// holds the triggered captures.
+	triggeredDir = "triggered"
+
+	// captureFilePrefix is the prefix of the files written by the
+	// FileRecorder.
+	captureFilePrefix = "flightrecording-"
+)
+
+// Option configures the flight recorder worker.
+type Option func(*FlightRecorder)
+
+// WithClock sets the clock used to count manifold starts and to rate limit
+// triggered captures.
+func WithClock(clock clock.Clock) Option {
+	return func(w *FlightRecorder) {
+		w.clock = clock
+	}
+}
+
+// WithMaxTriggeredCaptures sets the number of triggered captures kept on
+// disk.
+func WithMaxTriggeredCaptures(n int) Option {
+	return func(w *FlightRecorder) {
+		w.maxTriggeredCaptures = n
+	}
+}
+
+// WithTriggeredDir sets the directory holding the triggered captures. By
+// default they are held in a directory below the recording path.
+func WithTriggeredDir(dir string) Option {
+	return func(w *FlightRecorder) {
+		w.triggeredDir = dir
+	}
+}
+
+// WithTriggerCooldown sets the minimum time between two triggered captures.
+func WithTriggerCooldown(d time.Duration) Option {
+	return func(w *FlightRecorder) {
+		w.triggerCooldown = d
+	}
+}
+
 type requestType int
 
 const (
 	requestTypeStart requestType = iota
 	requestTypeStop
 	requestTypeEnabled
+	requestTypeSetTriggers
 )
 
 type request struct {
 	Type     requestType
 	Kind     flightrecorder.Kind
 	Duration time.Duration
+	Triggers flightrecorder.Triggers
 	Result   chan response
 }
 
@@ -56,8 +119,12 @@ type response struct {
 }
 
 type report struct {
-	Enabled bool
-	Kind    flightrecorder.Kind
+	Enabled           bool
+	Kind              flightrecorder.Kind
+	Triggers          flightrecorder.Triggers
+	TriggeredCaptures int
+	LastTrigger       string
+	LastTriggered     time.Time
 }
 
 // FlightRecorder is the flight recorder worker.
@@ -67,11 +134,17 @@ type report struct {
 //
 // The worker is also sequenced into a serialized request loop, so that
 // it delivers predictable results when faced with concurrent requests.
+//
+// Once triggers are set, the recorder runs continuously and a recording is
+// captured whenever an observation matches a trigger. Triggered captures
+// are written to their own directory, which only keeps the most recent
+// ones.
 type FlightRecorder struct {
 	tomb tomb.Tomb
 
 	path     string
 	recorder FileRecorder
+	clock    clock.Clock
 
 	currentKind    flightrecorder.Kind
 	requests       chan request
@@ -79,14 +152,33 @@ type FlightRecorder struct {
 
 	reports chan chan report
 
+	// recording is true while an operator requested recording is in
+	// progress. It is only used when triggers are set, as the recorder is
+	// then running regardless.
+	recording bool
+
+	// triggers is read on every observation, so it's not owned by the loop.
+	triggers  atomic.Pointer[flightrecorder.Triggers]
+	startsMu  sync.Mutex
+	starts    map[string][]time.Time
+	triggered chan string
+
+	triggeredDir         string
+	maxTriggeredCaptures int
+	triggerCooldown      time.Duration
+	triggeredCaptures    int
+	lastTrigger          string
+	lastTriggered        time.Time
+
 	logger logger.Logger
 }
 
 // New creates a new flight recorder worker.
-func New(recorder FileRecorder, path string, logger logger.Logger) *FlightRecorder {
+func New(recorder FileRecorder, path string, logger logger.Logger, opts ...Option) *FlightRecorder {
 	w := &FlightRecorder{
 		recorder:    recorder,
 		path:        path,
+		clock:       clock.WallClock,
 		currentKind: flightrecorder.KindAll,
 
 		requests: make(chan request),
@@ -97,8 +189,19 @@ func New(recorder FileRecorder, path string, logger logger.Logger) *FlightRecord
 		// to decide what to do.
 		captureRequest: make(chan captureRequest, 1),
 
+		// Only hold on to one trigger, any others that fire while it is
+		// being captured are part of the same incident.
+		triggered: make(chan string, 1),
+		starts:    make(map[string][]time.Time),
+
+		maxTriggeredCaptures: defaultMaxTriggeredCaptures,
+		triggerCooldown:      defaultTriggerCooldown,
+
 		logger: logger,
 	}
+	for _, opt := range opts {
+		opt(w)
+	}
 
 	w.tomb.Go(w.loop)
 
@@ -213,6 +316,80 @@ func (w *FlightRecorder) Enabled() bool {
 	}
 }
 
+// SetTriggers sets the conditions which cause a recording to be captured
+// automatically, replacing any previous ones. Setting triggers keeps the
+// recorder running until the triggers are cleared.
+func (w *FlightRecorder) SetTriggers(triggers flightrecorder.Triggers) error {
+	result := make(chan response, 1)
+	req := request{
+		Type:     requestTypeSetTriggers,
+		Triggers: triggers,
+		Result:   result,
+	}
+
+	select {
+	case <-w.tomb.Dying():
+		return errors.New("worker is stopping")
+	case w.requests <- req:
+	}
+
+	select {
+	case <-w.tomb.Dying():
+		return errors.New("worker is stopping")
+	case response := <-result:
+		return response.Error
+	}
+}
+
+// ObserveLatency implements flightrecorder.Observer. A capture is triggered
+// if the latency is above the threshold set for the source.
+func (w *FlightRecorder) ObserveLatency(source flightrecorder.Source, latency time.Duration) {
+	triggers := w.triggers.Load()
+	if triggers == nil {
+		return
+	}
+	threshold := triggers.Latency(source)
+	if threshold <= 0 || latency <= threshold {
+		return
+	}
+	w.trigger(fmt.Sprintf("%s of %v above %v", source, latency, threshold))
+}
+
+// ObserveStart implements flightrecorder.Observer. A capture is triggered if
+// the manifold has been started too many times within the restart window.
+func (w *FlightRecorder) ObserveStart(name string) {
+	triggers := w.triggers.Load()
+	if triggers == nil || triggers.ManifoldRestarts <= 0 {
+		return
+	}
+
+	now := w.clock.Now()
+	cutoff := now.Add(-triggers.RestartWindow)
+
+	w.startsMu.Lock()
+	starts := w.starts[name]
+	for len(starts) > 0 && !starts[0].After(cutoff) {
+		starts = starts[1:]
+	}
+	starts = append(starts, now)
+	if len(starts) < triggers.ManifoldRestarts {
+		w.starts[name] = starts
+		w.startsMu.Unlock()
+		return
+	}
+	delete(w.starts, name)
+	w.startsMu.Unlock()
+
+	w.trigger(fmt.Sprintf("manifold %q started %d times within %v", name, len(starts), triggers.RestartWindow))
+}
+
+func (w *FlightRecorder) trigger(reason string) {
+	select {
+	case w.triggered <- reason:
+	default:
+	}
+}
+
 // Report returns a map of internal state for introspection.
 func (w *FlightRecorder) Report(ctx context.Context) map[string]any {
 	ctx = w.tomb.Context(ctx)
@@ -230,10 +407,19 @@ func (w *FlightRecorder) Report(ctx context.Context) map[string]any {
 	case <-w.tomb.Dying():
 		return map[string]any{"error": w.tomb.Err().Error()}
 	case r := <-ch:
-		return map[string]any{
+		report := map[string]any{
 			"enabled": r.Enabled,
 			"kind":    r.Kind,
 		}
+		if r.Triggers.Enabled() {
+			report["triggers"] = r.Triggers.String()
+			report["triggered-captures"] = r.TriggeredCaptures
+		}
+		if r.LastTrigger != "" {
+			report["last-trigger"] = r.LastTrigger
+			report["last-triggered"] = r.LastTriggered
+		}
+		return report
 	}
 }
 
@@ -259,6 +445,11 @@ func (w *FlightRecorder) loop() error {
 				err = w.stopRecording(ctx)
 			case requestTypeEnabled:
 				enabled = w.recorder.Enabled()
+				if w.triggersEnabled() {
+					enabled = enabled && w.recording
+				}
+			case requestTypeSetTriggers:
+				err = w.setTriggers(ctx, req.Triggers)
 			default:
 				err = errors.New("unknown request type")
 			}
@@ -278,14 +469,24 @@ func (w *FlightRecorder) loop() error {
 			case req.Result <- response{Error: err}:
 			}
 
+		case reason := <-w.triggered:
+			w.captureTriggered(ctx, reason)
+
 		case res := <-w.reports:
+			r := report{
+				Enabled:           w.recorder.Enabled(),
+				Kind:              w.currentKind,
+				TriggeredCaptures: w.triggeredCaptures,
+				LastTrigger:       w.lastTrigger,
+				LastTriggered:     w.lastTriggered,
+			}
+			if triggers := w.triggers.Load(); triggers != nil {
+				r.Triggers = *triggers
+			}
 			select {
 			case <-w.tomb.Dying():
 				return tomb.ErrDying
-			case res <- report{
-				Enabled: w.recorder.Enabled(),
-				Kind:    w.currentKind,
-			}:
+			case res <- r:
 			}
 		}
 	}
@@ -295,6 +496,7 @@ func (w *FlightRecorder) startRecording(ctx context.Context, kind flightrecorder
 	w.logger.Debugf(ctx, "starting flight recording for kind %q", kind)
 
 	w.currentKind = kind
+	w.recording = true
 
 	if duration < 0 {
 		duration = 0
@@ -306,6 +508,12 @@ func (w *FlightRecorder) startRecording(ctx context.Context, kind flightrecorder
 func (w *FlightRecorder) stopRecording(ctx context.Context) error {
 	w.logger.Debugf(ctx, "stopping flight recording")
 
+	w.recording = false
+
+	// Keep the recorder running for the triggers.
+	if w.triggersEnabled() {
+		return nil
+	}
 	return w.recorder.Stop()
 }
 
@@ -315,12 +523,17 @@ func (w *FlightRecorder) captureRecording(ctx context.Context, kind flightrecord
 		return nil
 	}
 
-	path := w.path
-	if path == "" {
-		path = "/tmp"
+	// The recorder keeps running for the triggers, only capture if an
+	// operator asked for a recording.
+	triggersEnabled := w.triggersEnabled()
+	if triggersEnabled && !w.recording {
+		return nil
 	}
 
-	path, err := w.recorder.Capture(path)
+	path, err := w.recorder.Capture(w.capturePath())
+	if triggersEnabled {
+		w.ensureRunning(ctx)
+	}
 	if err != nil {
 		return err
 	} else if path == "" {
@@ -331,3 +544,134 @@ func (w *FlightRecorder) captureRecording(ctx context.Context, kind flightrecord
 
 	return nil
 }
+
+func (w *FlightRecorder) setTriggers(ctx context.Context, triggers flightrecorder.Triggers) error {
+	w.startsMu.Lock()
+	w.starts = make(map[string][]time.Time)
+	w.startsMu.Unlock()
+
+	if !triggers.Enabled() {
+		wasEnabled := w.triggersEnabled()
+		w.triggers.Store(nil)
+		if wasEnabled {
+			w.logger.Infof(ctx, "flight recorder triggers cleared")
+		}
+		if wasEnabled && !w.recording && w.recorder.Enabled() {
+			return w.recorder.Stop()
+		}
+		return nil
+	}
+
+	// An operator recording may have ended while the triggers were not set.
+	w.recording = w.recording && w.recorder.Enabled()
+	w.triggers.Store(&triggers)
+	w.logger.Infof(ctx, "flight recorder triggers set to %q", triggers.String())
+
+	if !w.recorder.Enabled() {
+		return w.recorder.Start(0)
+	}
+	return nil
+}
+
+func (w *FlightRecorder) triggersEnabled() bool {
+	triggers := w.triggers.Load()
+	return triggers != nil && triggers.Enabled()
+}
+
+// ensureRunning restarts the recorder for the triggers once a capture has
+// stopped it, which also ends any operator recording.
+func (w *FlightRecorder) ensureRunning(ctx context.Context) {
+	if w.recorder.Enabled() {
+		return
+	}
+	w.recording = false
+	if err := w.recorder.Start(0); err != nil {
+		w.logger.Errorf(ctx, "restarting flight recorder for triggers: %v", err)
+	}
+}
+
+func (w *FlightRecorder) captureTriggered(ctx context.Context, reason string) {
+	if !w.triggersEnabled() {
+		return
+	}
+
+	now := w.clock.Now()
+	if !w.lastTriggered.IsZero() && now.Sub(w.lastTriggered) < w.triggerCooldown {
+		w.logger.Debugf(ctx, "skipping flight recording triggered by %s, last capture was at %v", reason, w.lastTriggered)
+		return
+	}
+	w.lastTriggered = now
+	w.lastTrigger = reason
+
+	defer w.ensureRunning(ctx)
+
+	dir := w.triggeredDir
+	if dir == "" {
+		dir = filepath.Join(w.capturePath(), triggeredDir)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		w.logger.Errorf(ctx, "creating directory for flight recording triggered by %s: %v", reason, err)
+		return
+	}
+	path, err := w.recorder.Capture(dir)
+	if err != nil {
+		w.logger.Errorf(ctx, "capturing flight recording triggered by %s: %v", reason, err)
+		return
+	} else if path == "" {
+		return
+	}
+	w.triggeredCaptures++
+
+	w.logger.Warningf(ctx, "flight recording triggered by %s captured into %q", reason, path)
+
+	if err := w.rotate(dir); err != nil {
+		w.logger.Errorf(ctx, "removing old triggered flight recordings: %v", err)
+	}
+}
+
+// rotate removes the oldest captures in dir, keeping the most recent ones.
+func (w *FlightRecorder) rotate(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	type capture struct {
+		name    string
+		modTime time.Time
+	}
+	var captures []capture
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasPrefix(entry.Name(), captureFilePrefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		captures = append(captures, capture{name: entry.Name(), modTime: info.ModTime()})
+	}
+	if len(captures) <= w.maxTriggeredCaptures {
+		return nil
+	}
+
+	sort.Slice(captures, func(i, j int) bool {
+		if captures[i].modTime.Equal(captures[j].modTime) {
+			return captures[i].name < captures[j].name
+		}
+		return captures[i].modTime.Before(captures[j].modTime)
+	})
+	for _, c := range captures[:len(captures)-w.maxTriggeredCaptures] {
+		if err := os.Remove(filepath.Join(dir, c.name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *FlightRecorder) capturePath() string {
+	if w.path == "" {
+		return "/tmp"
+	}
+	return w.path
+}
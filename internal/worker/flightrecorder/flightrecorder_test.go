@@ -4,9 +4,13 @@
 package flightrecorder
 
 import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5/workertest"
 	"go.uber.org/goleak"
@@ -14,6 +18,7 @@ import (
 
 	"github.com/juju/juju/core/flightrecorder"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
 )
 
 func TestFlightRecorderWorker(t *testing.T) {
@@ -114,3 +119,228 @@ func (s *flightRecorderSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+func (s *flightRecorderSuite) TestSetTriggersStartsRecorder(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	enabled := s.expectRecorderState()
+	s.recorder.EXPECT().Start(time.Duration(0)).DoAndReturn(func(time.Duration) error {
+		enabled.Store(true)
+		return nil
+	})
+	s.recorder.EXPECT().Stop().DoAndReturn(func() error {
+		enabled.Store(false)
+		return nil
+	}).Times(2)
+
+	recorder := New(s.recorder, c.MkDir(), loggertesting.WrapCheckLog(c))
+	defer workertest.DirtyKill(c, recorder)
+
+	err := recorder.SetTriggers(flightrecorder.Triggers{APILatency: time.Second})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The recorder only runs for the triggers, so it isn't reported as an
+	// operator recording.
+	c.Check(recorder.Enabled(), tc.IsFalse)
+
+	// Clearing the triggers stops the recorder.
+	err = recorder.SetTriggers(flightrecorder.Triggers{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	workertest.CleanKill(c, recorder)
+}
+
+func (s *flightRecorderSuite) TestCaptureSkippedWithoutOperatorRecording(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	enabled := s.expectRecorderState()
+	s.recorder.EXPECT().Start(time.Duration(0)).DoAndReturn(func(time.Duration) error {
+		enabled.Store(true)
+		return nil
+	})
+	s.recorder.EXPECT().Stop().Return(nil)
+
+	recorder := New(s.recorder, c.MkDir(), loggertesting.WrapCheckLog(c))
+	defer workertest.DirtyKill(c, recorder)
+
+	err := recorder.SetTriggers(flightrecorder.Triggers{APILatency: time.Second})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Requests and errors are not captured while the recorder only runs for
+	// the triggers.
+	err = recorder.Capture(flightrecorder.KindRequest)
+	c.Assert(err, tc.ErrorIsNil)
+
+	workertest.CleanKill(c, recorder)
+}
+
+func (s *flightRecorderSuite) TestTriggeredByLatency(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	enabled := s.expectRecorderState()
+	s.recorder.EXPECT().Start(time.Duration(0)).DoAndReturn(func(time.Duration) error {
+		enabled.Store(true)
+		return nil
+	})
+	captured := make(chan struct{})
+	s.recorder.EXPECT().Capture(filepath.Join(dir, "triggered")).DoAndReturn(func(path string) (string, error) {
+		enabled.Store(false)
+		return filepath.Join(path, "flightrecording-1"), nil
+	})
+	s.recorder.EXPECT().Start(time.Duration(0)).DoAndReturn(func(time.Duration) error {
+		enabled.Store(true)
+		close(captured)
+		return nil
+	})
+	s.recorder.EXPECT().Stop().Return(nil)
+
+	recorder := New(s.recorder, dir, loggertesting.WrapCheckLog(c))
+	defer workertest.DirtyKill(c, recorder)
+
+	err := recorder.SetTriggers(flightrecorder.Triggers{TxnLatency: time.Second})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Observations below the threshold, or of other sources, are ignored.
+	recorder.ObserveLatency(flightrecorder.SourceTxnLatency, time.Second)
+	recorder.ObserveLatency(flightrecorder.SourceAPILatency, time.Hour)
+	recorder.ObserveLatency(flightrecorder.SourceTxnLatency, 2*time.Second)
+
+	select {
+	case <-captured:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for triggered capture")
+	}
+
+	report := recorder.Report(c.Context())
+	c.Check(report["triggers"], tc.Equals, "txn-latency=1s")
+	c.Check(report["triggered-captures"], tc.Equals, 1)
+	c.Check(report["last-trigger"], tc.Equals, "txn-latency of 2s above 1s")
+
+	workertest.CleanKill(c, recorder)
+}
+
+func (s *flightRecorderSuite) TestTriggeredByManifoldRestarts(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	clock := testclock.NewClock(time.Now())
+	enabled := s.expectRecorderState()
+	s.recorder.EXPECT().Start(time.Duration(0)).DoAndReturn(func(time.Duration) error {
+		enabled.Store(true)
+		return nil
+	})
+	captured := make(chan struct{})
+	s.recorder.EXPECT().Capture(filepath.Join(dir, "triggered")).DoAndReturn(func(path string) (string, error) {
+		close(captured)
+		return filepath.Join(path, "flightrecording-1"), nil
+	})
+	s.recorder.EXPECT().Stop().Return(nil)
+
+	recorder := New(s.recorder, dir, loggertesting.WrapCheckLog(c), WithClock(clock))
+	defer workertest.DirtyKill(c, recorder)
+
+	err := recorder.SetTriggers(flightrecorder.Triggers{
+		ManifoldRestarts: 3,
+		RestartWindow:    time.Minute,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Starts outside of the window are not counted.
+	recorder.ObserveStart("api-server")
+	recorder.ObserveStart("api-server")
+	clock.Advance(2 * time.Minute)
+	recorder.ObserveStart("api-server")
+	recorder.ObserveStart("db-accessor")
+	recorder.ObserveStart("api-server")
+	recorder.ObserveStart("api-server")
+
+	select {
+	case <-captured:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for triggered capture")
+	}
+
+	report := recorder.Report(c.Context())
+	c.Check(report["last-trigger"], tc.Equals, `manifold "api-server" started 3 times within 1m0s`)
+
+	workertest.CleanKill(c, recorder)
+}
+
+func (s *flightRecorderSuite) TestTriggerCooldown(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	clock := testclock.NewClock(time.Now())
+	enabled := s.expectRecorderState()
+	s.recorder.EXPECT().Start(time.Duration(0)).DoAndReturn(func(time.Duration) error {
+		enabled.Store(true)
+		return nil
+	})
+	captures := make(chan struct{}, 2)
+	s.recorder.EXPECT().Capture(filepath.Join(dir, "triggered")).DoAndReturn(func(path string) (string, error) {
+		captures <- struct{}{}
+		return filepath.Join(path, "flightrecording-1"), nil
+	}).Times(2)
+	s.recorder.EXPECT().Stop().Return(nil)
+
+	recorder := New(s.recorder, dir, loggertesting.WrapCheckLog(c), WithClock(clock), WithTriggerCooldown(time.Minute))
+	defer workertest.DirtyKill(c, recorder)
+
+	err := recorder.SetTriggers(flightrecorder.Triggers{APILatency: time.Second})
+	c.Assert(err, tc.ErrorIsNil)
+
+	waitCapture := func() {
+		select {
+		case <-captures:
+		case <-time.After(testhelpers.LongWait):
+			c.Fatalf("timed out waiting for triggered capture")
+		}
+	}
+
+	recorder.ObserveLatency(flightrecorder.SourceAPILatency, time.Hour)
+	waitCapture()
+
+	// A trigger within the cooldown is dropped.
+	recorder.ObserveLatency(flightrecorder.SourceAPILatency, time.Hour)
+	report := recorder.Report(c.Context())
+	c.Check(report["triggered-captures"], tc.Equals, 1)
+
+	clock.Advance(time.Minute)
+	recorder.ObserveLatency(flightrecorder.SourceAPILatency, time.Hour)
+	waitCapture()
+
+	workertest.CleanKill(c, recorder)
+}
+
+func (s *flightRecorderSuite) TestRotate(c *tc.C) {
+	dir := c.MkDir()
+	now := time.Now()
+	for i, name := range []string{"flightrecording-c", "flightrecording-a", "flightrecording-b", "other"} {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, nil, 0600)
+		c.Assert(err, tc.ErrorIsNil)
+		err = os.Chtimes(path, now, now.Add(time.Duration(i)*time.Second))
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	w := &FlightRecorder{maxTriggeredCaptures: 2}
+	err := w.rotate(dir)
+	c.Assert(err, tc.ErrorIsNil)
+
+	entries, err := os.ReadDir(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	c.Check(names, tc.SameContents, []string{"flightrecording-a", "flightrecording-b", "other"})
+}
+
+// expectRecorderState makes the mock recorder report whether it is enabled
+// from the returned value, which the other expectations update.
+func (s *flightRecorderSuite) expectRecorderState() *atomic.Bool {
+	var enabled atomic.Bool
+	s.recorder.EXPECT().Enabled().DoAndReturn(enabled.Load).AnyTimes()
+	return &enabled
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/dependency"
 
+	coredependency "github.com/juju/juju/core/dependency"
 	"github.com/juju/juju/core/flightrecorder"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/services"
 )
 
 // Manifold returns a dependency manifold for the flight recorder worker.
@@ -29,6 +32,12 @@ func Manifold(flightRecorder flightrecorder.FlightRecorderWorker) dependency.Man
 				*out = recorder
 			case *flightrecorder.FlightRecorder:
 				*out = recorder
+			case *TriggerSetter:
+				setter, ok := recorder.(TriggerSetter)
+				if !ok {
+					return errors.NotValidf("expected TriggerSetter, got %T", in)
+				}
+				*out = setter
 			default:
 				return errors.NotValidf("expected *flightrecorder.FlightRecorderWorker, got %T", out)
 			}
@@ -36,3 +45,79 @@ func Manifold(flightRecorder flightrecorder.FlightRecorderWorker) dependency.Man
 		},
 	}
 }
+
+// GetControllerConfigService is a helper function that gets the controller
+// config service from the manifold.
+func GetControllerConfigService(getter dependency.Getter, name string) (ControllerConfigService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) ControllerConfigService {
+		return factory.ControllerConfig()
+	})
+}
+
+// TriggersManifoldConfig holds the information necessary to run the flight
+// recorder triggers worker in a dependency.Engine.
+type TriggersManifoldConfig struct {
+	// FlightRecorderName is the name of the flight recorder worker.
+	FlightRecorderName string
+	// DomainServicesName is the name of the domain services worker.
+	DomainServicesName string
+	// GetControllerConfigService is used to get the controller config
+	// service from the manifold.
+	GetControllerConfigService func(getter dependency.Getter, name string) (ControllerConfigService, error)
+	// NewWorker creates the triggers worker.
+	NewWorker func(TriggersWorkerConfig) (worker.Worker, error)
+	// Logger is the logger to use for the worker.
+	Logger logger.Logger
+}
+
+// Validate validates the manifold configuration.
+func (config TriggersManifoldConfig) Validate() error {
+	if config.FlightRecorderName == "" {
+		return errors.NotValidf("empty FlightRecorderName")
+	}
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.GetControllerConfigService == nil {
+		return errors.NotValidf("nil GetControllerConfigService")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// TriggersManifold returns a dependency manifold that sets the triggers of
+// the flight recorder from the controller config. The manifold has no
+// outputs.
+func TriggersManifold(config TriggersManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.FlightRecorderName,
+			config.DomainServicesName,
+		},
+		Start: func(_ context.Context, getter dependency.Getter) (worker.Worker, error) {
+			if err := config.Validate(); err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			var recorder TriggerSetter
+			if err := getter.Get(config.FlightRecorderName, &recorder); err != nil {
+				return nil, errors.Trace(err)
+			}
+			controllerConfigService, err := config.GetControllerConfigService(getter, config.DomainServicesName)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			return config.NewWorker(TriggersWorkerConfig{
+				Recorder:                recorder,
+				ControllerConfigService: controllerConfigService,
+				Logger:                  config.Logger,
+			})
+		},
+	}
+}
@@ -4,3 +4,4 @@
 package flightrecorder
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package flightrecorder -destination recorder_mock_test.go github.com/juju/juju/internal/worker/flightrecorder FileRecorder
+//go:generate go run go.uber.org/mock/mockgen -typed -package flightrecorder -destination service_mock_test.go github.com/juju/juju/internal/worker/flightrecorder ControllerConfigService,TriggerSetter
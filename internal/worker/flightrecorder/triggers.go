@@ -0,0 +1,147 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package flightrecorder
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/flightrecorder"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+)
+
+// TriggerSetter sets the conditions which cause a recording to be captured
+// automatically.
+type TriggerSetter interface {
+	// SetTriggers replaces the triggers of the flight recorder.
+	SetTriggers(flightrecorder.Triggers) error
+}
+
+// ControllerConfigService is the interface that the triggers worker uses to
+// get the controller configuration.
+type ControllerConfigService interface {
+	// WatchControllerConfig returns a watcher that returns keys for any changes
+	// to controller config.
+	WatchControllerConfig(context.Context) (watcher.StringsWatcher, error)
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(context.Context) (controller.Config, error)
+}
+
+// TriggersWorkerConfig holds the configuration for the triggers worker.
+type TriggersWorkerConfig struct {
+	Recorder                TriggerSetter
+	ControllerConfigService ControllerConfigService
+	Logger                  logger.Logger
+}
+
+// Validate validates the triggers worker configuration.
+func (c TriggersWorkerConfig) Validate() error {
+	if c.Recorder == nil {
+		return errors.NotValidf("nil Recorder")
+	}
+	if c.ControllerConfigService == nil {
+		return errors.NotValidf("nil ControllerConfigService")
+	}
+	if c.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// triggersWorker keeps the triggers of the flight recorder in line with the
+// flight-recorder-triggers controller config.
+type triggersWorker struct {
+	catacomb catacomb.Catacomb
+	config   TriggersWorkerConfig
+}
+
+// NewTriggersWorker returns a worker which sets the triggers of the flight
+// recorder whenever the flight-recorder-triggers controller config changes.
+func NewTriggersWorker(config TriggersWorkerConfig) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &triggersWorker{
+		config: config,
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "flight-recorder-triggers",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Kill implements worker.Worker.
+func (w *triggersWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait implements worker.Worker.
+func (w *triggersWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *triggersWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	// Watch for changes then acquire the latest controller configuration
+	// so that no change is missed.
+	configWatcher, err := w.config.ControllerConfigService.WatchControllerConfig(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(configWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	current, err := w.setTriggers(ctx, flightrecorder.Triggers{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-configWatcher.Changes():
+			if !ok {
+				return errors.New("controller config watcher closed")
+			}
+			if current, err = w.setTriggers(ctx, current); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// setTriggers reads the triggers from the controller config and sets them on
+// the recorder if they differ from the current ones.
+func (w *triggersWorker) setTriggers(ctx context.Context, current flightrecorder.Triggers) (flightrecorder.Triggers, error) {
+	config, err := w.config.ControllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return current, errors.Trace(err)
+	}
+	triggers := config.FlightRecorderTriggers()
+	if triggers == current {
+		return current, nil
+	}
+	if err := w.config.Recorder.SetTriggers(triggers); err != nil {
+		return current, errors.Annotate(err, "setting flight recorder triggers")
+	}
+	if triggers.Enabled() {
+		w.config.Logger.Infof(ctx, "flight recorder triggers set to %q", triggers.String())
+	} else {
+		w.config.Logger.Infof(ctx, "flight recorder triggers cleared")
+	}
+	return triggers, nil
+}
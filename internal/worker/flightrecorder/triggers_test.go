@@ -0,0 +1,125 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package flightrecorder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/flightrecorder"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type triggersSuite struct {
+	service  *MockControllerConfigService
+	recorder *MockTriggerSetter
+}
+
+func TestTriggersSuite(t *testing.T) {
+	tc.Run(t, &triggersSuite{})
+}
+
+func (s *triggersSuite) TestValidate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.config(c)
+	cfg.Recorder = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil Recorder not valid")
+
+	cfg = s.config(c)
+	cfg.ControllerConfigService = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil ControllerConfigService not valid")
+
+	cfg = s.config(c)
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil Logger not valid")
+}
+
+func (s *triggersSuite) TestSetTriggersFromConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan []string)
+	s.service.EXPECT().WatchControllerConfig(gomock.Any()).DoAndReturn(func(context.Context) (watcher.StringsWatcher, error) {
+		return watchertest.NewMockStringsWatcher(ch), nil
+	})
+
+	// The initial config has no triggers, so nothing is set.
+	gomock.InOrder(
+		s.service.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil),
+		s.service.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+			controller.FlightRecorderTriggers: "txn-latency=2s",
+		}, nil),
+		s.service.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+			controller.FlightRecorderTriggers: "txn-latency=2s",
+		}, nil),
+		s.service.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil),
+	)
+
+	set := make(chan flightrecorder.Triggers)
+	s.recorder.EXPECT().SetTriggers(gomock.Any()).DoAndReturn(func(triggers flightrecorder.Triggers) error {
+		set <- triggers
+		return nil
+	}).Times(2)
+
+	w, err := NewTriggersWorker(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	sendChange := func() {
+		select {
+		case ch <- []string{controller.FlightRecorderTriggers}:
+		case <-time.After(testhelpers.LongWait):
+			c.Fatalf("timed out sending change")
+		}
+	}
+	waitSet := func() flightrecorder.Triggers {
+		select {
+		case triggers := <-set:
+			return triggers
+		case <-time.After(testhelpers.LongWait):
+			c.Fatalf("timed out waiting for triggers")
+		}
+		return flightrecorder.Triggers{}
+	}
+
+	sendChange()
+	c.Check(waitSet(), tc.DeepEquals, flightrecorder.Triggers{TxnLatency: 2 * time.Second})
+
+	// An unrelated change leaves the triggers alone, clearing them sets
+	// them again.
+	sendChange()
+	sendChange()
+	c.Check(waitSet(), tc.DeepEquals, flightrecorder.Triggers{})
+}
+
+func (s *triggersSuite) config(c *tc.C) TriggersWorkerConfig {
+	return TriggersWorkerConfig{
+		Recorder:                s.recorder,
+		ControllerConfigService: s.service,
+		Logger:                  loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *triggersSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.service = NewMockControllerConfigService(ctrl)
+	s.recorder = NewMockTriggerSetter(ctrl)
+
+	c.Cleanup(func() {
+		s.service = nil
+		s.recorder = nil
+	})
+
+	return ctrl
+}
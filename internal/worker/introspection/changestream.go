@@ -0,0 +1,180 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package introspection
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/internal/changestream/eventmultiplexer"
+)
+
+// ChangeStream provides access to the change events recorded by the change
+// stream of the agent.
+type ChangeStream interface {
+	// Events returns the most recent change events received for the given
+	// namespace (database) that match the query.
+	Events(namespace string, query eventmultiplexer.EventQuery) ([]eventmultiplexer.RecordedEvent, error)
+}
+
+// changeStreamFollowInterval is the interval at which new events are
+// polled for when following the change stream.
+const changeStreamFollowInterval = time.Second
+
+type changeStreamHandler struct {
+	changeStream ChangeStream
+}
+
+// ServeHTTP is part of the http.Handler interface.
+//
+// The namespace query parameter, which is required, identifies the database
+// (a model UUID, or "controller"). The events can be narrowed with:
+//   - table: the change log namespace, normally a table name.
+//   - after: only events with a greater id.
+//   - since, until: RFC3339 times bounding when the events were received.
+//   - limit: only the most recent events.
+//
+// By default the events are written as text, one per line. With format=json
+// each event is written as a JSON document per line, which can be read back
+// with eventmultiplexer.ReadRecordedEvents to replay the events in tests.
+// With follow=true, new events are written as they're received until the
+// request is cancelled.
+func (h changeStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.changeStream == nil {
+		http.Error(w, "missing change stream", http.StatusNotFound)
+		return
+	}
+
+	q := r.URL.Query()
+	namespace := q.Get("namespace")
+	if namespace == "" {
+		http.Error(w, "missing namespace", http.StatusBadRequest)
+		return
+	}
+	query, err := parseEventQuery(q.Get("table"), q.Get("after"), q.Get("since"), q.Get("until"), q.Get("limit"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var write func(io.Writer, eventmultiplexer.RecordedEvent) error
+	switch format := q.Get("format"); format {
+	case "", "text":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		write = writeEventText
+	case "json":
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		write = writeEventJSON
+	default:
+		http.Error(w, fmt.Sprintf("unknown format %q", format), http.StatusBadRequest)
+		return
+	}
+	follow := q.Get("follow") == "true"
+
+	events, err := h.changeStream.Events(namespace, query)
+	if errors.Is(err, errors.NotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	writeEvents := func(events []eventmultiplexer.RecordedEvent) error {
+		for _, event := range events {
+			if err := write(w, event); err != nil {
+				return err
+			}
+			query.After = event.ID
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+	if err := writeEvents(events); err != nil || !follow {
+		return
+	}
+
+	// The limit only applies to the events already received.
+	query.Limit = 0
+	ticker := time.NewTicker(changeStreamFollowInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+		events, err := h.changeStream.Events(namespace, query)
+		if err != nil {
+			// The change stream has gone away; there's no way to report
+			// the error once the events have been written.
+			return
+		}
+		if err := writeEvents(events); err != nil {
+			return
+		}
+	}
+}
+
+func parseEventQuery(table, after, since, until, limit string) (eventmultiplexer.EventQuery, error) {
+	query := eventmultiplexer.EventQuery{
+		Namespace: table,
+	}
+	var err error
+	if after != "" {
+		if query.After, err = strconv.ParseUint(after, 10, 64); err != nil {
+			return query, errors.Errorf("invalid after %q", after)
+		}
+	}
+	if since != "" {
+		if query.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			return query, errors.Errorf("invalid since %q", since)
+		}
+	}
+	if until != "" {
+		if query.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			return query, errors.Errorf("invalid until %q", until)
+		}
+	}
+	if limit != "" {
+		if query.Limit, err = strconv.Atoi(limit); err != nil || query.Limit < 0 {
+			return query, errors.Errorf("invalid limit %q", limit)
+		}
+	}
+	return query, nil
+}
+
+func writeEventText(w io.Writer, event eventmultiplexer.RecordedEvent) error {
+	changeType := "changed"
+	if event.Type&changestream.Deleted != 0 {
+		changeType = "deleted"
+	}
+	subs := make([]string, len(event.Subscriptions))
+	for i, sub := range event.Subscriptions {
+		subs[i] = fmt.Sprintf("%d:%s", sub.ID, sub.Summary)
+	}
+	matched := "-"
+	if len(subs) > 0 {
+		matched = strings.Join(subs, ", ")
+	}
+	_, err := fmt.Fprintf(w, "%d term=%d %s %s %s %q -> %s\n",
+		event.ID, event.Term, event.Time.UTC().Format(time.RFC3339Nano),
+		changeType, event.Namespace, event.Changed, matched,
+	)
+	return err
+}
+
+func writeEventJSON(w io.Writer, event eventmultiplexer.RecordedEvent) error {
+	return json.NewEncoder(w).Encode(event)
+}
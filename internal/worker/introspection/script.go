@@ -150,6 +150,10 @@ juju_metrics () {
   juju_agent metrics
 }
 
+juju_object_store_scrub_report () {
+  juju_agent depengine?manifold=object-store-scrubber
+}
+
 juju_machine_lock () {
   for agent in $(ls /var/lib/juju/agents); do
     juju_agent machinelock --agent=$agent 2> /dev/null
@@ -236,6 +240,27 @@ juju_flightrecorder_capture () {
   juju_agent flightrecorder/capture?kind=$kind
 }
 
+juju_changestream_events () {
+  namespace=${1:-"controller"}
+  table=${2:-}
+  limit=${3:-"100"}
+  juju_agent "changestream/events?namespace=$namespace&table=$table&limit=$limit"
+}
+
+juju_changestream_tail () {
+  namespace=${1:-"controller"}
+  table=${2:-}
+  juju_agent "changestream/events?namespace=$namespace&table=$table&limit=10&follow=true"
+}
+
+juju_changestream_export () {
+  namespace=${1:-"controller"}
+  table=${2:-}
+  since=${3:-}
+  until=${4:-}
+  juju_agent "changestream/events?namespace=$namespace&table=$table&since=$since&until=$until&format=json"
+}
+
 # This asks for the command of the current pid.
 # Can't use $0 nor $SHELL due to this being wrong in various situations.
 shell=$(ps -p "$$" -o comm --no-headers)
@@ -250,6 +275,7 @@ if [ "$shell" = "bash" ]; then
   export -f juju_heap_profile
   export -f juju_engine_report
   export -f juju_metrics
+  export -f juju_object_store_scrub_report
   export -f juju_machine_lock
   export -f juju_unit_status
   export -f juju_db_repl
@@ -257,5 +283,8 @@ if [ "$shell" = "bash" ]; then
   export -f juju_flightrecorder_start
   export -f juju_flightrecorder_stop
   export -f juju_flightrecorder_capture
+  export -f juju_changestream_events
+  export -f juju_changestream_tail
+  export -f juju_changestream_export
 fi
 `
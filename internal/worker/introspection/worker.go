@@ -12,6 +12,7 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"gopkg.in/tomb.v2"
@@ -48,6 +49,7 @@ type Config struct {
 	MachineLock        machinelock.Lock
 	PrometheusGatherer prometheus.Gatherer
 	FlightRecorder     flightrecorder.FlightRecorder
+	ChangeStream       ChangeStream
 }
 
 // Validate checks the config values to assert they are valid to create the worker.
@@ -74,6 +76,7 @@ type socketListener struct {
 
 	prometheusGatherer prometheus.Gatherer
 	flightRecorder     flightrecorder.FlightRecorder
+	changeStream       ChangeStream
 
 	done chan struct{}
 }
@@ -104,6 +107,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 		machineLock:        config.MachineLock,
 		prometheusGatherer: config.PrometheusGatherer,
 		flightRecorder:     config.FlightRecorder,
+		changeStream:       config.ChangeStream,
 		done:               make(chan struct{}),
 	}
 	w.tomb.Go(w.serve)
@@ -182,6 +186,9 @@ func (w *socketListener) RegisterHTTPHandlers(
 	handle("/flightrecorder/start", introspectionflightrecorder.StartHandler(w.flightRecorder))
 	handle("/flightrecorder/stop", introspectionflightrecorder.StopHandler(w.flightRecorder))
 	handle("/flightrecorder/capture", introspectionflightrecorder.CaptureHandler(w.flightRecorder))
+
+	// Change stream.
+	handle("/changestream/events", changeStreamHandler{changeStream: w.changeStream})
 }
 
 type notSupportedHandler struct {
@@ -203,7 +210,21 @@ func (h depengineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "missing dependency engine reporter", http.StatusNotFound)
 		return
 	}
-	bytes, err := yaml.Marshal(h.reporter.Report(r.Context()))
+	report := h.reporter.Report(r.Context())
+
+	// Optionally narrow the report down to a single manifold.
+	name := r.URL.Query().Get("manifold")
+	if name != "" {
+		manifolds, _ := report[dependency.KeyManifolds].(map[string]any)
+		manifold, ok := manifolds[name]
+		if !ok {
+			http.Error(w, fmt.Sprintf("manifold %q not found", name), http.StatusNotFound)
+			return
+		}
+		report = map[string]any{name: manifold}
+	}
+
+	bytes, err := yaml.Marshal(report)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
 		return
@@ -4,6 +4,7 @@
 package introspection_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -14,16 +15,20 @@ import (
 	"path"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/workertest"
 	"github.com/prometheus/client_golang/prometheus"
 
+	changestreamtesting "github.com/juju/juju/core/changestream/testing"
 	"github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/flightrecorder"
+	"github.com/juju/juju/internal/changestream/eventmultiplexer"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/worker/introspection"
 	"github.com/juju/juju/juju/sockets"
@@ -86,6 +91,7 @@ type introspectionSuite struct {
 	depEngine      introspection.DependencyEngine
 	gatherer       prometheus.Gatherer
 	flightRecorder flightrecorder.FlightRecorder
+	changeStream   introspection.ChangeStream
 }
 
 func TestIntrospectionSuite(t *testing.T) {
@@ -101,6 +107,7 @@ func (s *introspectionSuite) SetUpTest(c *tc.C) {
 	s.worker = nil
 	s.gatherer = newPrometheusGatherer()
 	s.flightRecorder = flightRecorder{}
+	s.changeStream = nil
 	s.startWorker(c)
 }
 
@@ -111,6 +118,7 @@ func (s *introspectionSuite) startWorker(c *tc.C) {
 		DepEngine:          s.depEngine,
 		PrometheusGatherer: s.gatherer,
 		FlightRecorder:     s.flightRecorder,
+		ChangeStream:       s.changeStream,
 	})
 	c.Assert(err, tc.ErrorIsNil)
 	s.worker = w
@@ -205,6 +213,41 @@ Dependency Engine Report
 working: true`[1:])
 }
 
+func (s *introspectionSuite) TestEngineReporterManifold(c *tc.C) {
+	workertest.CleanKill(c, s.worker)
+	s.depEngine = &depEngine{
+		values: map[string]any{
+			"manifolds": map[string]any{
+				"foo": map[string]any{"state": "started"},
+				"bar": map[string]any{"state": "stopped"},
+			},
+		},
+	}
+	s.startWorker(c)
+	response := s.call(c, "/depengine?manifold=foo")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusOK)
+	s.assertBody(c, response, `
+Dependency Engine Report
+
+foo:
+  state: started`[1:])
+}
+
+func (s *introspectionSuite) TestEngineReporterManifoldNotFound(c *tc.C) {
+	workertest.CleanKill(c, s.worker)
+	s.depEngine = &depEngine{
+		values: map[string]any{
+			"manifolds": map[string]any{},
+		},
+	}
+	s.startWorker(c)
+	response := s.call(c, "/depengine?manifold=foo")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, `manifold "foo" not found`)
+}
+
 func (s *introspectionSuite) TestPrometheusMetrics(c *tc.C) {
 	response := s.call(c, "/metrics")
 	defer response.Body.Close()
@@ -215,6 +258,148 @@ func (s *introspectionSuite) TestPrometheusMetrics(c *tc.C) {
 	s.assertContains(c, body, "tau 6.283185")
 }
 
+func (s *introspectionSuite) TestMissingChangeStream(c *tc.C) {
+	response := s.call(c, "/changestream/events?namespace=controller")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, "missing change stream")
+}
+
+func (s *introspectionSuite) TestChangeStreamEvents(c *tc.C) {
+	events := s.startChangeStream(c)
+
+	response := s.call(c, "/changestream/events?namespace=controller&table=application&limit=1")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(s.body(c, response), tc.Equals,
+		`3 term=2 2026-03-01T00:01:00Z deleted application "bar" -> -`+"\n")
+
+	response = s.call(c, "/changestream/events?namespace=controller")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(s.body(c, response), tc.Equals, `
+1 term=1 2026-03-01T00:00:00Z changed application "foo" -> 2:application watcher, 5:unit watcher
+2 term=1 2026-03-01T00:00:00Z changed unit "foo/0" -> 5:unit watcher
+3 term=2 2026-03-01T00:01:00Z deleted application "bar" -> -
+`[1:])
+
+	c.Check(events.queries, tc.DeepEquals, []eventmultiplexer.EventQuery{
+		{Namespace: "application", Limit: 1},
+		{},
+	})
+}
+
+func (s *introspectionSuite) TestChangeStreamExport(c *tc.C) {
+	events := s.startChangeStream(c)
+
+	response := s.call(c, "/changestream/events?namespace=controller&since=2026-03-01T00:00:00Z&until=2026-03-01T00:00:30Z&format=json")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusOK)
+
+	exported, err := eventmultiplexer.ReadRecordedEvents(response.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(exported, tc.DeepEquals, events.events)
+	c.Check(events.queries, tc.DeepEquals, []eventmultiplexer.EventQuery{{
+		Since: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2026, 3, 1, 0, 0, 30, 0, time.UTC),
+	}})
+}
+
+func (s *introspectionSuite) TestChangeStreamFollow(c *tc.C) {
+	events := s.startChangeStream(c)
+
+	response := s.call(c, "/changestream/events?namespace=controller&limit=1&follow=true")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusOK)
+
+	reader := bufio.NewReader(response.Body)
+	line, err := reader.ReadString('\n')
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(line, tc.Matches, `3 term=2 .*\n`)
+
+	events.add(eventmultiplexer.RecordedEvent{
+		ID:        4,
+		Term:      3,
+		Time:      time.Date(2026, 3, 1, 0, 2, 0, 0, time.UTC),
+		Namespace: "unit",
+		Changed:   "foo/1",
+	})
+	line, err = reader.ReadString('\n')
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(line, tc.Matches, `4 term=3 .* unit "foo/1" -> -\n`)
+
+	// New events are polled for after the last one written.
+	queries := events.recordedQueries()
+	c.Assert(len(queries) >= 2, tc.IsTrue)
+	c.Check(queries[0], tc.DeepEquals, eventmultiplexer.EventQuery{Limit: 1})
+	c.Check(queries[1], tc.DeepEquals, eventmultiplexer.EventQuery{After: 3})
+}
+
+func (s *introspectionSuite) TestChangeStreamBadRequest(c *tc.C) {
+	s.startChangeStream(c)
+
+	for path, expected := range map[string]string{
+		"/changestream/events":                               "missing namespace",
+		"/changestream/events?namespace=controller&after=x":  `invalid after "x"`,
+		"/changestream/events?namespace=controller&since=x":  `invalid since "x"`,
+		"/changestream/events?namespace=controller&limit=x":  `invalid limit "x"`,
+		"/changestream/events?namespace=controller&format=x": `unknown format "x"`,
+	} {
+		response := s.call(c, path)
+		c.Check(response.StatusCode, tc.Equals, http.StatusBadRequest, tc.Commentf(path))
+		c.Check(s.body(c, response), tc.Equals, expected+"\n", tc.Commentf(path))
+		response.Body.Close()
+	}
+}
+
+func (s *introspectionSuite) TestChangeStreamNamespaceNotFound(c *tc.C) {
+	s.startChangeStream(c)
+
+	response := s.call(c, "/changestream/events?namespace=deadbeef")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, `change stream for namespace "deadbeef" not found`)
+}
+
+func (s *introspectionSuite) startChangeStream(c *tc.C) *changeStream {
+	workertest.CleanKill(c, s.worker)
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	stream := &changeStream{
+		events: []eventmultiplexer.RecordedEvent{{
+			ID:        1,
+			Term:      1,
+			Time:      base,
+			Type:      changestreamtesting.Create,
+			Namespace: "application",
+			Changed:   "foo",
+			Subscriptions: []eventmultiplexer.MatchedSubscription{
+				{ID: 2, Summary: "application watcher"},
+				{ID: 5, Summary: "unit watcher"},
+			},
+		}, {
+			ID:        2,
+			Term:      1,
+			Time:      base,
+			Type:      changestreamtesting.Update,
+			Namespace: "unit",
+			Changed:   "foo/0",
+			Subscriptions: []eventmultiplexer.MatchedSubscription{
+				{ID: 5, Summary: "unit watcher"},
+			},
+		}, {
+			ID:        3,
+			Term:      2,
+			Time:      base.Add(time.Minute),
+			Type:      changestreamtesting.Delete,
+			Namespace: "application",
+			Changed:   "bar",
+		}},
+	}
+	s.changeStream = stream
+	s.startWorker(c)
+	return stream
+}
+
 type depEngine struct {
 	values map[string]any
 }
@@ -252,3 +437,44 @@ func unixSocketHTTPTransport(socketPath string) *http.Transport {
 type flightRecorder struct {
 	flightrecorder.FlightRecorder
 }
+
+// changeStream is a fake change stream that filters its events by table and
+// applies the limit, recording the queries made.
+type changeStream struct {
+	mu      sync.Mutex
+	events  []eventmultiplexer.RecordedEvent
+	queries []eventmultiplexer.EventQuery
+}
+
+func (s *changeStream) Events(namespace string, query eventmultiplexer.EventQuery) ([]eventmultiplexer.RecordedEvent, error) {
+	if namespace != "controller" {
+		return nil, jujuerrors.NotFoundf("change stream for namespace %q", namespace)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries = append(s.queries, query)
+
+	var results []eventmultiplexer.RecordedEvent
+	for _, event := range s.events {
+		if event.ID > query.After && (query.Namespace == "" || event.Namespace == query.Namespace) {
+			results = append(results, event)
+		}
+	}
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[len(results)-query.Limit:]
+	}
+	return results, nil
+}
+
+func (s *changeStream) add(event eventmultiplexer.RecordedEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+func (s *changeStream) recordedQueries() []eventmultiplexer.EventQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]eventmultiplexer.EventQuery(nil), s.queries...)
+}
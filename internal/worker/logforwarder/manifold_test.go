@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/core/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type manifoldSuite struct{}
+
+func TestManifoldSuite(t *testing.T) {
+	tc.Run(t, &manifoldSuite{})
+}
+
+func (s *manifoldSuite) TestValidate(c *tc.C) {
+	cfg := validManifoldConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	cfg.DomainServicesName = ""
+	s.checkNotValid(c, cfg, "empty DomainServicesName not valid")
+
+	cfg = validManifoldConfig(c)
+	cfg.ModelUUID = ""
+	s.checkNotValid(c, cfg, "empty ModelUUID not valid")
+
+	cfg = validManifoldConfig(c)
+	cfg.Router = nil
+	s.checkNotValid(c, cfg, "nil Router not valid")
+
+	cfg = validManifoldConfig(c)
+	cfg.GetModelConfigService = nil
+	s.checkNotValid(c, cfg, "nil GetModelConfigService not valid")
+
+	cfg = validManifoldConfig(c)
+	cfg.NewWorker = nil
+	s.checkNotValid(c, cfg, "nil NewWorker not valid")
+
+	cfg = validManifoldConfig(c)
+	cfg.Logger = nil
+	s.checkNotValid(c, cfg, "nil Logger not valid")
+}
+
+func (s *manifoldSuite) TestStartSuccess(c *tc.C) {
+	cfg := validManifoldConfig(c)
+	cfg.NewWorker = func(cfg Config) (worker.Worker, error) {
+		if err := cfg.Validate(); err != nil {
+			return nil, err
+		}
+		return noWorker{}, nil
+	}
+
+	w, err := Manifold(cfg).Start(c.Context(), noGetter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(w, tc.NotNil)
+}
+
+func (s *manifoldSuite) checkNotValid(c *tc.C, cfg ManifoldConfig, expect string) {
+	err := cfg.Validate()
+	c.Check(err, tc.ErrorMatches, expect)
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+}
+
+func validManifoldConfig(c *tc.C) ManifoldConfig {
+	return ManifoldConfig{
+		DomainServicesName: "domain-services",
+		ModelUUID:          modelUUID,
+		Router:             noRouter{},
+		GetModelConfigService: func(dependency.Getter, string) (ModelConfigService, error) {
+			return noService{}, nil
+		},
+		NewWorker: func(Config) (worker.Worker, error) { return noWorker{}, nil },
+		Logger:    loggertesting.WrapCheckLog(c),
+	}
+}
+
+type noGetter struct {
+	dependency.Getter
+}
+
+type noService struct {
+	ModelConfigService
+}
+
+type noRouter struct {
+	Router
+}
+
+type noWorker struct {
+	worker.Worker
+}
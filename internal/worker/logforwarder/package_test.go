@@ -0,0 +1,6 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package logforwarder -destination package_mocks_test.go github.com/juju/juju/internal/worker/logforwarder ModelConfigService,Router
@@ -0,0 +1,167 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ModelConfigService provides access to the model configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
+
+	// Watch returns a watcher that returns keys for any changes to model
+	// config.
+	Watch(context.Context) (watcher.StringsWatcher, error)
+}
+
+// Router routes the log records of models to forwarding destinations.
+type Router interface {
+	// SetTargets sets the destinations to which the records of a model are
+	// forwarded.
+	SetTargets(modelUUID string, targets []logforward.Target, caCert string) error
+
+	// RemoveModel stops forwarding the records of a model.
+	RemoveModel(modelUUID string)
+}
+
+// Config holds configuration required to run the log forwarder worker.
+type Config struct {
+	// ModelUUID is the model whose records are forwarded.
+	ModelUUID model.UUID
+
+	// ModelConfigService supplies the forwarding configuration of the
+	// model.
+	ModelConfigService ModelConfigService
+
+	// Router forwards the records of the model.
+	Router Router
+
+	// Logger logs stuff.
+	Logger logger.Logger
+}
+
+// Validate ensures that the configuration is
+// correctly populated for worker operation.
+func (config Config) Validate() error {
+	if config.ModelUUID == "" {
+		return errors.New("empty ModelUUID not valid").Add(coreerrors.NotValid)
+	}
+	if config.ModelConfigService == nil {
+		return errors.New("nil ModelConfigService not valid").Add(coreerrors.NotValid)
+	}
+	if config.Router == nil {
+		return errors.New("nil Router not valid").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// forwarderWorker keeps the destinations the router forwards the records
+// of a model to in line with the model's log-forward-targets config.
+type forwarderWorker struct {
+	catacomb catacomb.Catacomb
+	cfg      Config
+}
+
+// NewWorker returns a worker which sets the forwarding destinations of a
+// model whenever its log forwarding config changes. Forwarding for the
+// model stops when the worker stops.
+func NewWorker(cfg Config) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w := &forwarderWorker{
+		cfg: cfg,
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "log-forwarder",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *forwarderWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *forwarderWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *forwarderWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+	defer w.cfg.Router.RemoveModel(w.cfg.ModelUUID.String())
+
+	configWatcher, err := w.cfg.ModelConfigService.Watch(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(configWatcher); err != nil {
+		return errors.Capture(err)
+	}
+
+	initial := true
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case keys, ok := <-configWatcher.Changes():
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+			if !initial &&
+				!slices.Contains(keys, config.LogForwardTargetsKey) &&
+				!slices.Contains(keys, config.LogForwardCACertKey) {
+				continue
+			}
+			initial = false
+			if err := w.setTargets(ctx); err != nil {
+				return errors.Capture(err)
+			}
+		}
+	}
+}
+
+// setTargets reads the forwarding config of the model and sets it on the
+// router.
+func (w *forwarderWorker) setTargets(ctx context.Context) error {
+	cfg, err := w.cfg.ModelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting model config: %w", err)
+	}
+
+	targets := cfg.LogForwardTargets()
+	// A failure to start forwarding to a destination doesn't stop the
+	// worker, as restarting it won't help until the config is changed.
+	if err := w.cfg.Router.SetTargets(w.cfg.ModelUUID.String(), targets, cfg.LogForwardCACert()); err != nil {
+		w.cfg.Logger.Errorf(ctx, "setting log forward targets: %v", err)
+		return nil
+	}
+	if len(targets) > 0 {
+		w.cfg.Logger.Infof(ctx, "forwarding logs to %d destination(s)", len(targets))
+	}
+	return nil
+}
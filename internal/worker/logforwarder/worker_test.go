@@ -0,0 +1,199 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logforward"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+const modelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+type workerSuite struct {
+	service *MockModelConfigService
+	router  *MockRouter
+}
+
+func TestWorkerSuite(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	tc.Run(t, &workerSuite{})
+}
+
+func (s *workerSuite) TestValidate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.config(c)
+	cfg.ModelUUID = ""
+	s.checkNotValid(c, cfg, "empty ModelUUID not valid")
+
+	cfg = s.config(c)
+	cfg.ModelConfigService = nil
+	s.checkNotValid(c, cfg, "nil ModelConfigService not valid")
+
+	cfg = s.config(c)
+	cfg.Router = nil
+	s.checkNotValid(c, cfg, "nil Router not valid")
+
+	cfg = s.config(c)
+	cfg.Logger = nil
+	s.checkNotValid(c, cfg, "nil Logger not valid")
+}
+
+func (s *workerSuite) TestSetTargetsOnChange(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan []string)
+	s.service.EXPECT().Watch(gomock.Any()).DoAndReturn(func(context.Context) (watcher.StringsWatcher, error) {
+		return watchertest.NewMockStringsWatcher(ch), nil
+	})
+
+	gomock.InOrder(
+		s.service.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, nil), nil),
+		s.service.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, coretesting.Attrs{
+			config.LogForwardTargetsKey: "syslog://logs.example.com",
+			config.LogForwardCACertKey:  coretesting.CACert,
+		}), nil),
+	)
+
+	type call struct {
+		targets []logforward.Target
+		caCert  string
+	}
+	calls := make(chan call)
+	s.router.EXPECT().SetTargets(modelUUID, gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, targets []logforward.Target, caCert string) error {
+		calls <- call{targets: targets, caCert: caCert}
+		return nil
+	}).Times(2)
+	removed := make(chan struct{})
+	s.router.EXPECT().RemoveModel(modelUUID).Do(func(string) {
+		close(removed)
+	})
+
+	w, err := NewWorker(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	send := func(keys ...string) {
+		select {
+		case ch <- keys:
+		case <-time.After(testhelpers.LongWait):
+			c.Fatalf("timed out sending change")
+		}
+	}
+	wait := func() call {
+		select {
+		case call := <-calls:
+			return call
+		case <-time.After(testhelpers.LongWait):
+			c.Fatalf("timed out waiting for targets to be set")
+		}
+		return call{}
+	}
+
+	// The initial event always sets the targets.
+	send("name")
+	call0 := wait()
+	c.Check(call0.targets, tc.HasLen, 0)
+	c.Check(call0.caCert, tc.Equals, "")
+
+	// Unrelated changes are ignored.
+	send("ssh-allow")
+	send(config.LogForwardTargetsKey)
+	call1 := wait()
+	c.Assert(call1.targets, tc.HasLen, 1)
+	c.Check(call1.targets[0].String(), tc.Equals, "syslog://logs.example.com")
+	c.Check(call1.caCert, tc.Equals, coretesting.CACert)
+
+	// Forwarding for the model stops with the worker.
+	workertest.CleanKill(c, w)
+	select {
+	case <-removed:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for model to be removed")
+	}
+}
+
+func (s *workerSuite) TestSetTargetsErrorDoesNotKillWorker(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan []string)
+	s.service.EXPECT().Watch(gomock.Any()).DoAndReturn(func(context.Context) (watcher.StringsWatcher, error) {
+		return watchertest.NewMockStringsWatcher(ch), nil
+	})
+	s.service.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, nil), nil)
+
+	done := make(chan struct{})
+	s.router.EXPECT().SetTargets(modelUUID, gomock.Any(), gomock.Any()).DoAndReturn(func(string, []logforward.Target, string) error {
+		close(done)
+		return errors.New("disk full")
+	})
+	s.router.EXPECT().RemoveModel(modelUUID)
+
+	w, err := NewWorker(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	select {
+	case ch <- []string{"name"}:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out sending change")
+	}
+	select {
+	case <-done:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for targets to be set")
+	}
+	workertest.CheckAlive(c, w)
+	workertest.CleanKill(c, w)
+}
+
+func (s *workerSuite) config(c *tc.C) Config {
+	return Config{
+		ModelUUID:          modelUUID,
+		ModelConfigService: s.service,
+		Router:             s.router,
+		Logger:             loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *workerSuite) modelConfig(c *tc.C, attrs coretesting.Attrs) *config.Config {
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(attrs))
+	c.Assert(err, tc.ErrorIsNil)
+	return cfg
+}
+
+func (s *workerSuite) checkNotValid(c *tc.C, cfg Config, expect string) {
+	err := cfg.Validate()
+	c.Check(err, tc.ErrorMatches, expect)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.service = NewMockModelConfigService(ctrl)
+	s.router = NewMockRouter(ctrl)
+
+	c.Cleanup(func() {
+		s.service = nil
+		s.router = nil
+	})
+
+	return ctrl
+}
@@ -393,6 +393,45 @@ func (c *MockTrackedObjectStoreReportCall) DoAndReturn(f func(context.Context) m
 	return c
 }
 
+// Scrub mocks base method.
+func (m *MockTrackedObjectStore) Scrub(arg0 context.Context) (objectstore.ScrubResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scrub", arg0)
+	ret0, _ := ret[0].(objectstore.ScrubResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scrub indicates an expected call of Scrub.
+func (mr *MockTrackedObjectStoreMockRecorder) Scrub(arg0 any) *MockTrackedObjectStoreScrubCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scrub", reflect.TypeOf((*MockTrackedObjectStore)(nil).Scrub), arg0)
+	return &MockTrackedObjectStoreScrubCall{Call: call}
+}
+
+// MockTrackedObjectStoreScrubCall wrap *gomock.Call
+type MockTrackedObjectStoreScrubCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTrackedObjectStoreScrubCall) Return(arg0 objectstore.ScrubResult, arg1 error) *MockTrackedObjectStoreScrubCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTrackedObjectStoreScrubCall) Do(f func(context.Context) (objectstore.ScrubResult, error)) *MockTrackedObjectStoreScrubCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTrackedObjectStoreScrubCall) DoAndReturn(f func(context.Context) (objectstore.ScrubResult, error)) *MockTrackedObjectStoreScrubCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Wait mocks base method.
 func (m *MockTrackedObjectStore) Wait() error {
 	m.ctrl.T.Helper()
@@ -186,6 +186,22 @@ func (t *trackerWorker) RemoveAll(ctx context.Context) (err error) {
 	return nil
 }
 
+// Scrub verifies every object held in the namespace against its metadata,
+// repairing corrupt objects where another copy is available.
+func (t *trackerWorker) Scrub(ctx context.Context) (_ objectstore.ScrubResult, err error) {
+	ctx, span := coretrace.Start(coretrace.WithTracer(ctx, t.tracer), coretrace.NameFromFunc())
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	result, err := t.objectStore.Scrub(ctx)
+	if err != nil {
+		return result, errors.Annotatef(err, "scrubbing objects")
+	}
+	return result, nil
+}
+
 func (t *trackerWorker) Report(ctx context.Context) map[string]any {
 	report := t.objectStore.Report(ctx)
 	report["modelUUID"] = t.modelUUID
@@ -34,6 +34,7 @@ type TrackedObjectStore interface {
 	worker.Worker
 	objectstore.ObjectStore
 	objectstore.ObjectStoreRemover
+	objectstore.ObjectStoreScrubber
 	Report(ctx context.Context) map[string]any
 }
 
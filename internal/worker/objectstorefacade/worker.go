@@ -279,3 +279,29 @@ func (o objectStoreFacade) RemoveAll(ctx context.Context) error {
 	}
 	return nil
 }
+
+// Scrub verifies every object in the namespace against its metadata.
+// The method will block until the fortress is drained or the context
+// is cancelled. If the fortress is draining, the method will return
+// [objectstore.ErrTimeoutWaitingForDraining] error.
+func (o objectStoreFacade) Scrub(ctx context.Context) (coreobjectstore.ScrubResult, error) {
+	visitCtx, cancel := context.WithTimeout(ctx, visitWaitTimeout)
+	defer cancel()
+
+	store, ok := o.ObjectStore.(coreobjectstore.ObjectStoreScrubber)
+	if !ok {
+		return coreobjectstore.ScrubResult{}, errors.NotSupportedf("object store %T does not support Scrub", o.ObjectStore)
+	}
+
+	var result coreobjectstore.ScrubResult
+	if visitErr := o.FortressVisitor.Visit(visitCtx, func() error {
+		var err error
+		result, err = store.Scrub(ctx)
+		return err
+	}); errors.Is(visitErr, fortress.ErrAborted) {
+		return result, coreobjectstore.ErrTimeoutWaitingForDraining
+	} else if visitErr != nil {
+		return result, errors.Trace(visitErr)
+	}
+	return result, nil
+}
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/objectstoregc (interfaces: BlobStore)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package objectstoregc -destination blobstore_mock_test.go github.com/juju/juju/internal/worker/objectstoregc BlobStore
+//
+
+// Package objectstoregc is a generated GoMock package.
+package objectstoregc
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	objectstore "github.com/juju/juju/internal/objectstore"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlobStore is a mock of BlobStore interface.
+type MockBlobStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStoreMockRecorder
+}
+
+// MockBlobStoreMockRecorder is the mock recorder for MockBlobStore.
+type MockBlobStoreMockRecorder struct {
+	mock *MockBlobStore
+}
+
+// NewMockBlobStore creates a new mock instance.
+func NewMockBlobStore(ctrl *gomock.Controller) *MockBlobStore {
+	mock := &MockBlobStore{ctrl: ctrl}
+	mock.recorder = &MockBlobStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStore) EXPECT() *MockBlobStoreMockRecorder {
+	return m.recorder
+}
+
+// Collect mocks base method.
+func (m *MockBlobStore) Collect(arg0 context.Context, arg1 objectstore.NamespaceMetadata, arg2 time.Duration) (objectstore.CollectResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collect", arg0, arg1, arg2)
+	ret0, _ := ret[0].(objectstore.CollectResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Collect indicates an expected call of Collect.
+func (mr *MockBlobStoreMockRecorder) Collect(arg0, arg1, arg2 any) *MockBlobStoreCollectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockBlobStore)(nil).Collect), arg0, arg1, arg2)
+	return &MockBlobStoreCollectCall{Call: call}
+}
+
+// MockBlobStoreCollectCall wrap *gomock.Call
+type MockBlobStoreCollectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobStoreCollectCall) Return(arg0 objectstore.CollectResult, arg1 error) *MockBlobStoreCollectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobStoreCollectCall) Do(f func(context.Context, objectstore.NamespaceMetadata, time.Duration) (objectstore.CollectResult, error)) *MockBlobStoreCollectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobStoreCollectCall) DoAndReturn(f func(context.Context, objectstore.NamespaceMetadata, time.Duration) (objectstore.CollectResult, error)) *MockBlobStoreCollectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Deduplicate mocks base method.
+func (m *MockBlobStore) Deduplicate(arg0 context.Context) (objectstore.DeduplicateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deduplicate", arg0)
+	ret0, _ := ret[0].(objectstore.DeduplicateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deduplicate indicates an expected call of Deduplicate.
+func (mr *MockBlobStoreMockRecorder) Deduplicate(arg0 any) *MockBlobStoreDeduplicateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deduplicate", reflect.TypeOf((*MockBlobStore)(nil).Deduplicate), arg0)
+	return &MockBlobStoreDeduplicateCall{Call: call}
+}
+
+// MockBlobStoreDeduplicateCall wrap *gomock.Call
+type MockBlobStoreDeduplicateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobStoreDeduplicateCall) Return(arg0 objectstore.DeduplicateResult, arg1 error) *MockBlobStoreDeduplicateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobStoreDeduplicateCall) Do(f func(context.Context) (objectstore.DeduplicateResult, error)) *MockBlobStoreDeduplicateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobStoreDeduplicateCall) DoAndReturn(f func(context.Context) (objectstore.DeduplicateResult, error)) *MockBlobStoreDeduplicateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
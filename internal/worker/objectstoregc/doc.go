@@ -0,0 +1,17 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package objectstoregc provides a worker that reclaims space in the file
+// backed object store.
+//
+// The file object store keeps a single, content addressed copy of every blob
+// in a controller wide blob directory, and each namespace (model) holds a hard
+// link to the blobs it references. On every interval the worker:
+//  1. Deduplicates namespaced files that are not yet shared with the blob
+//     directory, such as those written before it existed.
+//  2. Removes blobs that are no longer referenced by the object store metadata
+//     of any namespace, once they are older than the grace period.
+//
+// The number of blobs removed and bytes reclaimed are exposed through the
+// worker report.
+package objectstoregc
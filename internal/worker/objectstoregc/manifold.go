@@ -0,0 +1,156 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstoregc
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+	"github.com/juju/juju/internal/services"
+)
+
+// GetNamespaceMetadataFunc is a function that retrieves the object store
+// metadata of every namespace from the dependency getter.
+type GetNamespaceMetadataFunc func(dependency.Getter, string) (internalobjectstore.NamespaceMetadata, error)
+
+// ManifoldConfig describes the resources used by the object store garbage
+// collector.
+type ManifoldConfig struct {
+	AgentName               string
+	ObjectStoreServicesName string
+	Clock                   clock.Clock
+	Logger                  logger.Logger
+
+	GetNamespaceMetadata GetNamespaceMetadataFunc
+	NewWorker            func(Config) (worker.Worker, error)
+
+	// Interval specifies how often the collector should run.
+	Interval time.Duration
+
+	// GracePeriod is the minimum age of an unreferenced blob before it is
+	// removed.
+	GracePeriod time.Duration
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.AgentName == "" {
+		return errors.NotValidf("empty AgentName")
+	}
+	if config.ObjectStoreServicesName == "" {
+		return errors.NotValidf("empty ObjectStoreServicesName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.GetNamespaceMetadata == nil {
+		return errors.NotValidf("nil GetNamespaceMetadata")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if config.Interval <= 0 {
+		return errors.NotValidf("non-positive Interval")
+	}
+	if config.GracePeriod < 0 {
+		return errors.NotValidf("negative GracePeriod")
+	}
+	return nil
+}
+
+// Manifold returns a Manifold that encapsulates the object store garbage
+// collector.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AgentName,
+			config.ObjectStoreServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var a agent.Agent
+	if err := getter.Get(config.AgentName, &a); err != nil {
+		return nil, errors.Trace(err)
+	}
+	rootDir := a.CurrentConfig().DataDir()
+
+	metadata, err := config.GetNamespaceMetadata(getter, config.ObjectStoreServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		BlobStore:   internalobjectstore.NewBlobStore(rootDir, config.Clock, config.Logger),
+		Metadata:    metadata,
+		Clock:       config.Clock,
+		Logger:      config.Logger,
+		Interval:    config.Interval,
+		GracePeriod: config.GracePeriod,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// GetNamespaceMetadata retrieves the object store metadata of every namespace
+// from the object store services.
+func GetNamespaceMetadata(getter dependency.Getter, name string) (internalobjectstore.NamespaceMetadata, error) {
+	var controllerServices services.ControllerObjectStoreServices
+	if err := getter.Get(name, &controllerServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var servicesGetter services.ObjectStoreServicesGetter
+	if err := getter.Get(name, &servicesGetter); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return namespaceMetadata{
+		controllerServices: controllerServices,
+		servicesGetter:     servicesGetter,
+	}, nil
+}
+
+type namespaceMetadata struct {
+	controllerServices services.ControllerObjectStoreServices
+	servicesGetter     services.ObjectStoreServicesGetter
+}
+
+// Namespaces returns the controller namespace and the namespaces of every
+// model.
+func (m namespaceMetadata) Namespaces(ctx context.Context) ([]string, error) {
+	namespaces, err := m.controllerServices.Controller().GetModelNamespaces(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append([]string{database.ControllerNS}, namespaces...), nil
+}
+
+// ListMetadata returns the object store metadata of the namespace.
+func (m namespaceMetadata) ListMetadata(ctx context.Context, namespace string) ([]coreobjectstore.Metadata, error) {
+	if namespace == database.ControllerNS {
+		return m.controllerServices.AgentObjectStore().ListMetadata(ctx)
+	}
+	return m.servicesGetter.ServicesForModel(model.UUID(namespace)).ObjectStore().ListMetadata(ctx)
+}
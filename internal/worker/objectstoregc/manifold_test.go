@@ -0,0 +1,129 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstoregc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+	dt "github.com/juju/worker/v5/dependency/testing"
+	"go.uber.org/mock/gomock"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+)
+
+const (
+	agentName               = "agent"
+	objectStoreServicesName = "object-store-services"
+)
+
+type manifoldSuite struct{}
+
+func TestManifoldSuite(t *testing.T) { tc.Run(t, &manifoldSuite{}) }
+
+func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
+	cfg := s.newConfig(c)
+
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.AgentName = ""
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.ObjectStoreServicesName = ""
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.GetNamespaceMetadata = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.NewWorker = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.Interval = 0
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.GracePeriod = -time.Second
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+}
+
+func (s *manifoldSuite) TestInputs(c *tc.C) {
+	c.Check(Manifold(s.newConfig(c)).Inputs, tc.DeepEquals, []string{
+		agentName,
+		objectStoreServicesName,
+	})
+}
+
+func (s *manifoldSuite) TestStartMissingAgent(c *tc.C) {
+	getter := dt.StubGetter(map[string]any{
+		agentName: dependency.ErrMissing,
+	})
+
+	w, err := Manifold(s.newConfig(c)).Start(c.Context(), getter)
+	c.Check(w, tc.IsNil)
+	c.Check(err, tc.ErrorIs, dependency.ErrMissing)
+}
+
+func (s *manifoldSuite) TestStart(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	agentConfig := NewMockConfig(ctrl)
+	agentConfig.EXPECT().DataDir().Return(c.MkDir())
+	a := NewMockAgent(ctrl)
+	a.EXPECT().CurrentConfig().Return(agentConfig)
+
+	getter := dt.StubGetter(map[string]any{
+		agentName: a,
+	})
+
+	var started Config
+	cfg := s.newConfig(c)
+	cfg.NewWorker = func(config Config) (worker.Worker, error) {
+		started = config
+		return nil, nil
+	}
+
+	_, err := Manifold(cfg).Start(c.Context(), getter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(started.BlobStore, tc.NotNil)
+	c.Check(started.Metadata, tc.NotNil)
+	c.Check(started.Interval, tc.Equals, time.Minute)
+	c.Check(started.GracePeriod, tc.Equals, time.Hour)
+}
+
+func (s *manifoldSuite) newConfig(c *tc.C) ManifoldConfig {
+	return ManifoldConfig{
+		AgentName:               agentName,
+		ObjectStoreServicesName: objectStoreServicesName,
+		Clock:                   testclock.NewClock(time.Now()),
+		Logger:                  loggertesting.WrapCheckLog(c),
+		GetNamespaceMetadata: func(dependency.Getter, string) (internalobjectstore.NamespaceMetadata, error) {
+			return NewMockNamespaceMetadata(gomock.NewController(c)), nil
+		},
+		NewWorker: func(Config) (worker.Worker, error) {
+			return nil, nil
+		},
+		Interval:    time.Minute,
+		GracePeriod: time.Hour,
+	}
+}
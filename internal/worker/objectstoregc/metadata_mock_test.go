@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/objectstore (interfaces: NamespaceMetadata)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package objectstoregc -destination metadata_mock_test.go github.com/juju/juju/internal/objectstore NamespaceMetadata
+//
+
+// Package objectstoregc is a generated GoMock package.
+package objectstoregc
+
+import (
+	context "context"
+	reflect "reflect"
+
+	objectstore "github.com/juju/juju/core/objectstore"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNamespaceMetadata is a mock of NamespaceMetadata interface.
+type MockNamespaceMetadata struct {
+	ctrl     *gomock.Controller
+	recorder *MockNamespaceMetadataMockRecorder
+}
+
+// MockNamespaceMetadataMockRecorder is the mock recorder for MockNamespaceMetadata.
+type MockNamespaceMetadataMockRecorder struct {
+	mock *MockNamespaceMetadata
+}
+
+// NewMockNamespaceMetadata creates a new mock instance.
+func NewMockNamespaceMetadata(ctrl *gomock.Controller) *MockNamespaceMetadata {
+	mock := &MockNamespaceMetadata{ctrl: ctrl}
+	mock.recorder = &MockNamespaceMetadataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNamespaceMetadata) EXPECT() *MockNamespaceMetadataMockRecorder {
+	return m.recorder
+}
+
+// ListMetadata mocks base method.
+func (m *MockNamespaceMetadata) ListMetadata(arg0 context.Context, arg1 string) ([]objectstore.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMetadata", arg0, arg1)
+	ret0, _ := ret[0].([]objectstore.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMetadata indicates an expected call of ListMetadata.
+func (mr *MockNamespaceMetadataMockRecorder) ListMetadata(arg0, arg1 any) *MockNamespaceMetadataListMetadataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadata", reflect.TypeOf((*MockNamespaceMetadata)(nil).ListMetadata), arg0, arg1)
+	return &MockNamespaceMetadataListMetadataCall{Call: call}
+}
+
+// MockNamespaceMetadataListMetadataCall wrap *gomock.Call
+type MockNamespaceMetadataListMetadataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNamespaceMetadataListMetadataCall) Return(arg0 []objectstore.Metadata, arg1 error) *MockNamespaceMetadataListMetadataCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNamespaceMetadataListMetadataCall) Do(f func(context.Context, string) ([]objectstore.Metadata, error)) *MockNamespaceMetadataListMetadataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNamespaceMetadataListMetadataCall) DoAndReturn(f func(context.Context, string) ([]objectstore.Metadata, error)) *MockNamespaceMetadataListMetadataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Namespaces mocks base method.
+func (m *MockNamespaceMetadata) Namespaces(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Namespaces", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Namespaces indicates an expected call of Namespaces.
+func (mr *MockNamespaceMetadataMockRecorder) Namespaces(arg0 any) *MockNamespaceMetadataNamespacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Namespaces", reflect.TypeOf((*MockNamespaceMetadata)(nil).Namespaces), arg0)
+	return &MockNamespaceMetadataNamespacesCall{Call: call}
+}
+
+// MockNamespaceMetadataNamespacesCall wrap *gomock.Call
+type MockNamespaceMetadataNamespacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNamespaceMetadataNamespacesCall) Return(arg0 []string, arg1 error) *MockNamespaceMetadataNamespacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNamespaceMetadataNamespacesCall) Do(f func(context.Context) ([]string, error)) *MockNamespaceMetadataNamespacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNamespaceMetadataNamespacesCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockNamespaceMetadataNamespacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
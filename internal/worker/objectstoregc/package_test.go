@@ -0,0 +1,8 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstoregc
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstoregc -destination blobstore_mock_test.go github.com/juju/juju/internal/worker/objectstoregc BlobStore
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstoregc -destination metadata_mock_test.go github.com/juju/juju/internal/objectstore NamespaceMetadata
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstoregc -destination agent_mock_test.go github.com/juju/juju/agent Agent,Config
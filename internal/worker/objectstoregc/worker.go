@@ -0,0 +1,184 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstoregc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+	internalworker "github.com/juju/juju/internal/worker"
+)
+
+// BlobStore is the controller wide content addressed blob store.
+type BlobStore interface {
+	// Deduplicate moves namespaced files that are not yet shared into the
+	// blob store.
+	Deduplicate(ctx context.Context) (internalobjectstore.DeduplicateResult, error)
+
+	// Collect removes every blob that is no longer referenced by the object
+	// store metadata of any namespace and is older than the grace period.
+	Collect(ctx context.Context, metadata internalobjectstore.NamespaceMetadata, grace time.Duration) (internalobjectstore.CollectResult, error)
+}
+
+// Config is the configuration for the object store garbage collector.
+type Config struct {
+	BlobStore BlobStore
+	Metadata  internalobjectstore.NamespaceMetadata
+	Clock     clock.Clock
+	Logger    logger.Logger
+
+	// Interval is the interval at which the collector runs.
+	Interval time.Duration
+
+	// GracePeriod is the minimum age of an unreferenced blob before it is
+	// removed.
+	GracePeriod time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.BlobStore == nil {
+		return errors.Errorf("nil BlobStore").Add(coreerrors.NotValid)
+	}
+	if config.Metadata == nil {
+		return errors.Errorf("nil Metadata").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.Interval <= 0 {
+		return errors.Errorf("interval must be positive").Add(coreerrors.NotValid)
+	}
+	if config.GracePeriod < 0 {
+		return errors.Errorf("grace period must not be negative").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// collectorWorker periodically deduplicates and garbage collects the blob
+// store.
+type collectorWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastRun                time.Time
+	lastDeduplicated       internalobjectstore.DeduplicateResult
+	lastCollected          internalobjectstore.CollectResult
+	totalCollectedBlobs    int
+	totalCollectedBytes    int64
+	totalDeduplicatedFiles int
+	totalDeduplicatedBytes int64
+}
+
+// NewWorker returns a new object store garbage collector.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &collectorWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "object-store-gc",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *collectorWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *collectorWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *collectorWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"last-run": w.lastRun,
+		"last-collected": map[string]any{
+			"blobs": w.lastCollected.Blobs,
+			"bytes": w.lastCollected.Bytes,
+		},
+		"last-deduplicated": map[string]any{
+			"files": w.lastDeduplicated.Files,
+			"bytes": w.lastDeduplicated.Bytes,
+		},
+		"total-collected-blobs":    w.totalCollectedBlobs,
+		"total-collected-bytes":    w.totalCollectedBytes,
+		"total-deduplicated-files": w.totalDeduplicatedFiles,
+		"total-deduplicated-bytes": w.totalDeduplicatedBytes,
+	}
+}
+
+func (w *collectorWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(internalworker.JitterPeriod(w.config.Interval, 0.5))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			// Failures are transient file system errors, so log them and
+			// try again on the next interval rather than bouncing the
+			// worker.
+			if err := w.run(ctx); err != nil {
+				w.config.Logger.Errorf(ctx, "collecting object store blobs: %v", err)
+			}
+			timer.Reset(internalworker.JitterPeriod(w.config.Interval, 0.5))
+		}
+	}
+}
+
+func (w *collectorWorker) run(ctx context.Context) error {
+	deduplicated, err := w.config.BlobStore.Deduplicate(ctx)
+	if err != nil {
+		return errors.Errorf("deduplicating: %w", err)
+	}
+	collected, err := w.config.BlobStore.Collect(ctx, w.config.Metadata, w.config.GracePeriod)
+	if err != nil {
+		return errors.Errorf("collecting: %w", err)
+	}
+
+	if deduplicated.Files > 0 || collected.Blobs > 0 {
+		w.config.Logger.Infof(ctx,
+			"object store reclaimed %d bytes from %d duplicate files and %d bytes from %d unreferenced blobs",
+			deduplicated.Bytes, deduplicated.Files, collected.Bytes, collected.Blobs)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastRun = w.config.Clock.Now()
+	w.lastDeduplicated = deduplicated
+	w.lastCollected = collected
+	w.totalCollectedBlobs += collected.Blobs
+	w.totalCollectedBytes += collected.Bytes
+	w.totalDeduplicatedFiles += deduplicated.Files
+	w.totalDeduplicatedBytes += deduplicated.Bytes
+	return nil
+}
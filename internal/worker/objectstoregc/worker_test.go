@@ -0,0 +1,177 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstoregc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+)
+
+type workerSuite struct {
+	blobStore *MockBlobStore
+	metadata  *MockNamespaceMetadata
+	clock     *testclock.Clock
+}
+
+func TestWorkerSuite(t *testing.T) {
+	tc.Run(t, &workerSuite{})
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.blobStore = NewMockBlobStore(ctrl)
+	s.metadata = NewMockNamespaceMetadata(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+
+	c.Cleanup(func() {
+		s.blobStore = nil
+		s.metadata = nil
+		s.clock = nil
+	})
+
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		BlobStore:   s.blobStore,
+		Metadata:    s.metadata,
+		Clock:       s.clock,
+		Logger:      loggertesting.WrapCheckLog(c),
+		Interval:    time.Minute,
+		GracePeriod: time.Hour,
+	}
+}
+
+func (s *workerSuite) TestValidateConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.BlobStore = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.Metadata = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.Interval = 0
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.GracePeriod = -time.Second
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *workerSuite) TestCollect(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	s.blobStore.EXPECT().Deduplicate(gomock.Any()).Return(internalobjectstore.DeduplicateResult{
+		Files: 2,
+		Bytes: 200,
+	}, nil)
+	s.blobStore.EXPECT().Collect(gomock.Any(), s.metadata, time.Hour).DoAndReturn(
+		func(context.Context, internalobjectstore.NamespaceMetadata, time.Duration) (internalobjectstore.CollectResult, error) {
+			defer close(done)
+			return internalobjectstore.CollectResult{
+				Blobs: 1,
+				Bytes: 100,
+			}, nil
+		})
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.advanceInterval(c)
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for collection")
+	}
+
+	report := s.waitForReport(c, w.(*collectorWorker))
+	c.Check(report["total-collected-blobs"], tc.Equals, 1)
+	c.Check(report["total-collected-bytes"], tc.Equals, int64(100))
+	c.Check(report["total-deduplicated-files"], tc.Equals, 2)
+	c.Check(report["total-deduplicated-bytes"], tc.Equals, int64(200))
+}
+
+func (s *workerSuite) TestErrorDoesNotKillWorker(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	gomock.InOrder(
+		s.blobStore.EXPECT().Deduplicate(gomock.Any()).Return(
+			internalobjectstore.DeduplicateResult{}, errors.New("boom")),
+		s.blobStore.EXPECT().Deduplicate(gomock.Any()).Return(
+			internalobjectstore.DeduplicateResult{}, nil),
+	)
+	s.blobStore.EXPECT().Collect(gomock.Any(), s.metadata, time.Hour).DoAndReturn(
+		func(context.Context, internalobjectstore.NamespaceMetadata, time.Duration) (internalobjectstore.CollectResult, error) {
+			defer close(done)
+			return internalobjectstore.CollectResult{}, nil
+		})
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.advanceInterval(c)
+	s.advanceInterval(c)
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for collection")
+	}
+	workertest.CheckAlive(c, w)
+}
+
+// advanceInterval waits for the worker timer and advances the clock by at
+// least the jittered interval.
+func (s *workerSuite) advanceInterval(c *tc.C) {
+	err := s.clock.WaitAdvance(time.Minute*3/2, coretesting.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// waitForReport waits for the run to be recorded in the worker report.
+func (s *workerSuite) waitForReport(c *tc.C, w *collectorWorker) map[string]any {
+	timeout := time.After(coretesting.LongWait)
+	for {
+		report := w.Report(c.Context())
+		if report["total-collected-blobs"] != 0 {
+			return report
+		}
+		select {
+		case <-time.After(coretesting.ShortWait):
+		case <-timeout:
+			c.Fatalf("timed out waiting for report")
+		}
+	}
+}
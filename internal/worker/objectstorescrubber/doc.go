@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package objectstorescrubber provides a worker that periodically verifies
+// the integrity of the object store.
+//
+// On every interval the worker walks the controller namespace and the
+// namespace of every model, asking the object store to re-hash each stored
+// object and compare it against the SHA256 and SHA384 recorded in the object
+// store metadata. For the file backed object store, objects that don't match
+// are moved to a quarantine directory within the namespace and retrieved again
+// from another controller node. The s3 backed object store is shared by every
+// controller node, so corrupt objects are only reported.
+//
+// The outcome of the last pass, along with running totals, is exposed through
+// the worker report, which can be viewed on a controller machine with the
+// juju_object_store_scrub_report introspection command.
+package objectstorescrubber
@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstorescrubber
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/core/logger"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/services"
+)
+
+// GetControllerServiceFunc is a function that retrieves the controller
+// service from the dependency getter.
+type GetControllerServiceFunc func(dependency.Getter, string) (ControllerService, error)
+
+// ManifoldConfig describes the resources used by the object store scrubber.
+type ManifoldConfig struct {
+	ObjectStoreName         string
+	ObjectStoreServicesName string
+	Clock                   clock.Clock
+	Logger                  logger.Logger
+
+	GetControllerService GetControllerServiceFunc
+	NewWorker            func(Config) (worker.Worker, error)
+
+	// Interval specifies how often the scrubber should run.
+	Interval time.Duration
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.ObjectStoreName == "" {
+		return errors.NotValidf("empty ObjectStoreName")
+	}
+	if config.ObjectStoreServicesName == "" {
+		return errors.NotValidf("empty ObjectStoreServicesName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.GetControllerService == nil {
+		return errors.NotValidf("nil GetControllerService")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if config.Interval <= 0 {
+		return errors.NotValidf("non-positive Interval")
+	}
+	return nil
+}
+
+// Manifold returns a Manifold that encapsulates the object store scrubber.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.ObjectStoreName,
+			config.ObjectStoreServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var objectStoreGetter coreobjectstore.ObjectStoreGetter
+	if err := getter.Get(config.ObjectStoreName, &objectStoreGetter); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	controllerService, err := config.GetControllerService(getter, config.ObjectStoreServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		ObjectStoreGetter: objectStoreGetter,
+		ControllerService: controllerService,
+		Clock:             config.Clock,
+		Logger:            config.Logger,
+		Interval:          config.Interval,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// GetControllerService retrieves the ControllerService from the object store
+// services.
+func GetControllerService(getter dependency.Getter, name string) (ControllerService, error) {
+	var services services.ControllerObjectStoreServices
+	if err := getter.Get(name, &services); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return services.Controller(), nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstorescrubber
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+	dt "github.com/juju/worker/v5/dependency/testing"
+	"go.uber.org/mock/gomock"
+
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+const (
+	objectStoreName         = "object-store"
+	objectStoreServicesName = "object-store-services"
+)
+
+type manifoldSuite struct{}
+
+func TestManifoldSuite(t *testing.T) { tc.Run(t, &manifoldSuite{}) }
+
+func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
+	cfg := s.newConfig(c)
+
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.ObjectStoreName = ""
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.ObjectStoreServicesName = ""
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.GetControllerService = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.NewWorker = nil
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+
+	bad = cfg
+	bad.Interval = 0
+	c.Check(bad.Validate(), tc.ErrorIs, errors.NotValid)
+}
+
+func (s *manifoldSuite) TestInputs(c *tc.C) {
+	c.Check(Manifold(s.newConfig(c)).Inputs, tc.DeepEquals, []string{
+		objectStoreName,
+		objectStoreServicesName,
+	})
+}
+
+func (s *manifoldSuite) TestStartMissingObjectStore(c *tc.C) {
+	getter := dt.StubGetter(map[string]any{
+		objectStoreName: dependency.ErrMissing,
+	})
+
+	w, err := Manifold(s.newConfig(c)).Start(c.Context(), getter)
+	c.Check(w, tc.IsNil)
+	c.Check(err, tc.ErrorIs, dependency.ErrMissing)
+}
+
+func (s *manifoldSuite) TestStart(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	objectStoreGetter := NewMockObjectStoreGetter(ctrl)
+	controllerService := NewMockControllerService(ctrl)
+
+	getter := dt.StubGetter(map[string]any{
+		objectStoreName: coreobjectstore.ObjectStoreGetter(objectStoreGetter),
+	})
+
+	var started Config
+	cfg := s.newConfig(c)
+	cfg.GetControllerService = func(dependency.Getter, string) (ControllerService, error) {
+		return controllerService, nil
+	}
+	cfg.NewWorker = func(config Config) (worker.Worker, error) {
+		started = config
+		return nil, nil
+	}
+
+	_, err := Manifold(cfg).Start(c.Context(), getter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(started.ObjectStoreGetter, tc.Equals, objectStoreGetter)
+	c.Check(started.ControllerService, tc.Equals, controllerService)
+	c.Check(started.Interval, tc.Equals, time.Hour)
+}
+
+func (s *manifoldSuite) newConfig(c *tc.C) ManifoldConfig {
+	return ManifoldConfig{
+		ObjectStoreName:         objectStoreName,
+		ObjectStoreServicesName: objectStoreServicesName,
+		Clock:                   testclock.NewClock(time.Now()),
+		Logger:                  loggertesting.WrapCheckLog(c),
+		GetControllerService: func(dependency.Getter, string) (ControllerService, error) {
+			return nil, nil
+		},
+		NewWorker: func(Config) (worker.Worker, error) {
+			return nil, nil
+		},
+		Interval: time.Hour,
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/core/objectstore (interfaces: ObjectStoreGetter)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package objectstorescrubber -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStoreGetter
+//
+
+// Package objectstorescrubber is a generated GoMock package.
+package objectstorescrubber
+
+import (
+	context "context"
+	reflect "reflect"
+
+	objectstore "github.com/juju/juju/core/objectstore"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockObjectStoreGetter is a mock of ObjectStoreGetter interface.
+type MockObjectStoreGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStoreGetterMockRecorder
+}
+
+// MockObjectStoreGetterMockRecorder is the mock recorder for MockObjectStoreGetter.
+type MockObjectStoreGetterMockRecorder struct {
+	mock *MockObjectStoreGetter
+}
+
+// NewMockObjectStoreGetter creates a new mock instance.
+func NewMockObjectStoreGetter(ctrl *gomock.Controller) *MockObjectStoreGetter {
+	mock := &MockObjectStoreGetter{ctrl: ctrl}
+	mock.recorder = &MockObjectStoreGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStoreGetter) EXPECT() *MockObjectStoreGetterMockRecorder {
+	return m.recorder
+}
+
+// GetObjectStore mocks base method.
+func (m *MockObjectStoreGetter) GetObjectStore(arg0 context.Context, arg1 string) (objectstore.ObjectStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectStore", arg0, arg1)
+	ret0, _ := ret[0].(objectstore.ObjectStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectStore indicates an expected call of GetObjectStore.
+func (mr *MockObjectStoreGetterMockRecorder) GetObjectStore(arg0, arg1 any) *MockObjectStoreGetterGetObjectStoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStore", reflect.TypeOf((*MockObjectStoreGetter)(nil).GetObjectStore), arg0, arg1)
+	return &MockObjectStoreGetterGetObjectStoreCall{Call: call}
+}
+
+// MockObjectStoreGetterGetObjectStoreCall wrap *gomock.Call
+type MockObjectStoreGetterGetObjectStoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreGetterGetObjectStoreCall) Return(arg0 objectstore.ObjectStore, arg1 error) *MockObjectStoreGetterGetObjectStoreCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreGetterGetObjectStoreCall) Do(f func(context.Context, string) (objectstore.ObjectStore, error)) *MockObjectStoreGetterGetObjectStoreCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreGetterGetObjectStoreCall) DoAndReturn(f func(context.Context, string) (objectstore.ObjectStore, error)) *MockObjectStoreGetterGetObjectStoreCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
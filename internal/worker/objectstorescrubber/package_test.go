@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstorescrubber
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstorescrubber -destination service_mock_test.go github.com/juju/juju/internal/worker/objectstorescrubber ControllerService,ObjectStore
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstorescrubber -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStoreGetter
@@ -0,0 +1,258 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstorescrubber
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/core/database"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+	internalworker "github.com/juju/juju/internal/worker"
+)
+
+// ControllerService provides access to the namespaces of every model.
+type ControllerService interface {
+	// GetModelNamespaces returns the model namespaces of all models in the
+	// state.
+	GetModelNamespaces(ctx context.Context) ([]string, error)
+}
+
+// ObjectStore is an object store that can verify the integrity of the
+// objects it holds.
+type ObjectStore interface {
+	objectstore.ObjectStore
+	objectstore.ObjectStoreScrubber
+}
+
+// Config is the configuration for the object store scrubber.
+type Config struct {
+	ObjectStoreGetter objectstore.ObjectStoreGetter
+	ControllerService ControllerService
+	Clock             clock.Clock
+	Logger            logger.Logger
+
+	// Interval is the interval at which the scrubber runs.
+	Interval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ObjectStoreGetter == nil {
+		return errors.Errorf("nil ObjectStoreGetter").Add(coreerrors.NotValid)
+	}
+	if config.ControllerService == nil {
+		return errors.Errorf("nil ControllerService").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.Interval <= 0 {
+		return errors.Errorf("interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// namespaceResult is the outcome of scrubbing a single namespace.
+type namespaceResult struct {
+	objectstore.ScrubResult
+	err error
+}
+
+// scrubWorker periodically verifies every object held by the object store.
+type scrubWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastRun       time.Time
+	lastDuration  time.Duration
+	lastResults   map[string]namespaceResult
+	totalChecked  int
+	totalBytes    int64
+	totalCorrupt  int
+	totalRepaired int
+	totalRuns     int
+}
+
+// NewWorker returns a new object store scrubber.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &scrubWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "object-store-scrubber",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *scrubWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *scrubWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *scrubWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	namespaces := make(map[string]any, len(w.lastResults))
+	var unrepaired []string
+	for namespace, result := range w.lastResults {
+		entry := map[string]any{
+			"checked": result.Checked,
+			"bytes":   result.Bytes,
+			"missing": result.Missing,
+		}
+		if len(result.Corrupt) > 0 {
+			entry["corrupt"] = result.Corrupt
+		}
+		if len(result.Repaired) > 0 {
+			entry["repaired"] = result.Repaired
+		}
+		if result.err != nil {
+			entry["error"] = result.err.Error()
+		}
+		namespaces[namespace] = entry
+
+		for _, hash := range outstanding(result.ScrubResult) {
+			unrepaired = append(unrepaired, namespace+"/"+hash)
+		}
+	}
+	sort.Strings(unrepaired)
+
+	return map[string]any{
+		"last-run":            w.lastRun,
+		"last-duration":       w.lastDuration.String(),
+		"namespaces":          namespaces,
+		"unrepaired":          unrepaired,
+		"total-runs":          w.totalRuns,
+		"total-checked":       w.totalChecked,
+		"total-checked-bytes": w.totalBytes,
+		"total-corrupt":       w.totalCorrupt,
+		"total-repaired":      w.totalRepaired,
+	}
+}
+
+func (w *scrubWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(internalworker.JitterPeriod(w.config.Interval, 0.5))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			// A failure to list the namespaces is transient, so log it and
+			// try again on the next interval rather than bouncing the
+			// worker.
+			if err := w.run(ctx); err != nil {
+				w.config.Logger.Errorf(ctx, "scrubbing object store: %v", err)
+			}
+			timer.Reset(internalworker.JitterPeriod(w.config.Interval, 0.5))
+		}
+	}
+}
+
+func (w *scrubWorker) run(ctx context.Context) error {
+	started := w.config.Clock.Now()
+
+	namespaces, err := w.config.ControllerService.GetModelNamespaces(ctx)
+	if err != nil {
+		return errors.Errorf("getting model namespaces: %w", err)
+	}
+	namespaces = append([]string{database.ControllerNS}, namespaces...)
+
+	results := make(map[string]namespaceResult, len(namespaces))
+	for _, namespace := range namespaces {
+		if _, ok := results[namespace]; ok {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return errors.Capture(err)
+		}
+
+		result, err := w.scrubNamespace(ctx, namespace)
+		if err != nil {
+			// Keep going, one namespace shouldn't prevent the others from
+			// being verified.
+			w.config.Logger.Warningf(ctx, "scrubbing object store namespace %q: %v", namespace, err)
+		} else if len(result.Corrupt) > 0 {
+			w.config.Logger.Errorf(ctx,
+				"object store namespace %q has %d corrupt objects, %d repaired",
+				namespace, len(result.Corrupt), len(result.Repaired))
+		}
+		results[namespace] = namespaceResult{
+			ScrubResult: result,
+			err:         err,
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastRun = started
+	w.lastDuration = w.config.Clock.Now().Sub(started)
+	w.lastResults = results
+	w.totalRuns++
+	for _, result := range results {
+		w.totalChecked += result.Checked
+		w.totalBytes += result.Bytes
+		w.totalCorrupt += len(result.Corrupt)
+		w.totalRepaired += len(result.Repaired)
+	}
+	return nil
+}
+
+func (w *scrubWorker) scrubNamespace(ctx context.Context, namespace string) (objectstore.ScrubResult, error) {
+	store, err := w.config.ObjectStoreGetter.GetObjectStore(ctx, namespace)
+	if err != nil {
+		return objectstore.ScrubResult{}, errors.Errorf("getting object store: %w", err)
+	}
+	scrubber, ok := store.(objectstore.ObjectStoreScrubber)
+	if !ok {
+		return objectstore.ScrubResult{}, errors.Errorf("object store %T does not support scrubbing", store).Add(coreerrors.NotSupported)
+	}
+	return scrubber.Scrub(ctx)
+}
+
+// outstanding returns the corrupt objects that were not repaired.
+func outstanding(result objectstore.ScrubResult) []string {
+	repaired := make(map[string]struct{}, len(result.Repaired))
+	for _, hash := range result.Repaired {
+		repaired[hash] = struct{}{}
+	}
+	var hashes []string
+	for _, hash := range result.Corrupt {
+		if _, ok := repaired[hash]; !ok {
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes
+}
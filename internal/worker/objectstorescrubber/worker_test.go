@@ -0,0 +1,214 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstorescrubber
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/database"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type workerSuite struct {
+	objectStoreGetter *MockObjectStoreGetter
+	controllerService *MockControllerService
+	controllerStore   *MockObjectStore
+	modelStore        *MockObjectStore
+	clock             *testclock.Clock
+}
+
+func TestWorkerSuite(t *testing.T) {
+	tc.Run(t, &workerSuite{})
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.objectStoreGetter = NewMockObjectStoreGetter(ctrl)
+	s.controllerService = NewMockControllerService(ctrl)
+	s.controllerStore = NewMockObjectStore(ctrl)
+	s.modelStore = NewMockObjectStore(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+
+	c.Cleanup(func() {
+		s.objectStoreGetter = nil
+		s.controllerService = nil
+		s.controllerStore = nil
+		s.modelStore = nil
+		s.clock = nil
+	})
+
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		ObjectStoreGetter: s.objectStoreGetter,
+		ControllerService: s.controllerService,
+		Clock:             s.clock,
+		Logger:            loggertesting.WrapCheckLog(c),
+		Interval:          time.Minute,
+	}
+}
+
+func (s *workerSuite) TestValidateConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.ObjectStoreGetter = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.ControllerService = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	bad = cfg
+	bad.Interval = 0
+	c.Check(bad.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *workerSuite) TestScrub(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerService.EXPECT().GetModelNamespaces(gomock.Any()).Return([]string{"model-1"}, nil)
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any(), database.ControllerNS).Return(s.controllerStore, nil)
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any(), "model-1").Return(s.modelStore, nil)
+	s.controllerStore.EXPECT().Scrub(gomock.Any()).Return(objectstore.ScrubResult{
+		Checked: 2,
+		Bytes:   200,
+	}, nil)
+
+	done := make(chan struct{})
+	s.modelStore.EXPECT().Scrub(gomock.Any()).DoAndReturn(func(context.Context) (objectstore.ScrubResult, error) {
+		defer close(done)
+		return objectstore.ScrubResult{
+			Checked:  3,
+			Bytes:    300,
+			Corrupt:  []string{"abc", "def"},
+			Repaired: []string{"abc"},
+		}, nil
+	})
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.advanceInterval(c)
+	s.waitForDone(c, done)
+
+	report := s.waitForReport(c, w.(*scrubWorker))
+	c.Check(report["total-checked"], tc.Equals, 5)
+	c.Check(report["total-checked-bytes"], tc.Equals, int64(500))
+	c.Check(report["total-corrupt"], tc.Equals, 2)
+	c.Check(report["total-repaired"], tc.Equals, 1)
+	c.Check(report["unrepaired"], tc.DeepEquals, []string{"model-1/def"})
+
+	namespaces := report["namespaces"].(map[string]any)
+	c.Check(namespaces, tc.HasLen, 2)
+	c.Check(namespaces["model-1"], tc.DeepEquals, map[string]any{
+		"checked":  3,
+		"bytes":    int64(300),
+		"missing":  0,
+		"corrupt":  []string{"abc", "def"},
+		"repaired": []string{"abc"},
+	})
+}
+
+func (s *workerSuite) TestNamespaceErrorDoesNotStopScrub(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerService.EXPECT().GetModelNamespaces(gomock.Any()).Return([]string{"model-1"}, nil)
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any(), database.ControllerNS).Return(nil, errors.New("boom"))
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any(), "model-1").Return(s.modelStore, nil)
+
+	done := make(chan struct{})
+	s.modelStore.EXPECT().Scrub(gomock.Any()).DoAndReturn(func(context.Context) (objectstore.ScrubResult, error) {
+		defer close(done)
+		return objectstore.ScrubResult{Checked: 1}, nil
+	})
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.advanceInterval(c)
+	s.waitForDone(c, done)
+
+	report := s.waitForReport(c, w.(*scrubWorker))
+	namespaces := report["namespaces"].(map[string]any)
+	c.Check(namespaces[database.ControllerNS].(map[string]any)["error"], tc.Equals, "getting object store: boom")
+	c.Check(report["total-checked"], tc.Equals, 1)
+}
+
+func (s *workerSuite) TestListNamespacesErrorDoesNotKillWorker(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	s.controllerService.EXPECT().GetModelNamespaces(gomock.Any()).DoAndReturn(func(context.Context) ([]string, error) {
+		defer close(done)
+		return nil, errors.New("boom")
+	})
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.advanceInterval(c)
+	s.waitForDone(c, done)
+
+	workertest.CheckAlive(c, w)
+}
+
+// advanceInterval waits for the worker timer and advances the clock by at
+// least the jittered interval.
+func (s *workerSuite) advanceInterval(c *tc.C) {
+	err := s.clock.WaitAdvance(time.Minute*3/2, coretesting.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *workerSuite) waitForDone(c *tc.C, done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for scrub")
+	}
+}
+
+// waitForReport waits for the run to be recorded in the worker report.
+func (s *workerSuite) waitForReport(c *tc.C, w *scrubWorker) map[string]any {
+	timeout := time.After(coretesting.LongWait)
+	for {
+		report := w.Report(c.Context())
+		if report["total-runs"] != 0 {
+			return report
+		}
+		select {
+		case <-time.After(coretesting.ShortWait):
+		case <-timeout:
+			c.Fatalf("timed out waiting for report")
+		}
+	}
+}
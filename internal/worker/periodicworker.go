@@ -119,16 +119,18 @@ func (w *periodicWorker) scopedContext() (context.Context, context.CancelFunc) {
 	return context.WithCancel(w.tomb.Context(context.Background()))
 }
 
-var nextPeriod = func(period time.Duration, jitter float64) time.Duration {
-	r := rand.New(rand.NewSource(time.Now().Unix()))
-	p := period
-	if jitter != 0 {
-		lower := (1.0 - jitter) * float64(period)
-		window := (2.0 * jitter) * float64(period)
-		offset := float64(r.Int63n(int64(window)))
-		p = time.Duration(lower + offset)
+var nextPeriod = JitterPeriod
+
+// JitterPeriod returns a random duration around the given period, varied by
+// up to the specified amount (as percents - i.e. between 0 and 1) either
+// side of it.
+func JitterPeriod(period time.Duration, amount float64) time.Duration {
+	window := int64((2.0 * amount) * float64(period))
+	if window <= 0 {
+		return period
 	}
-	return p
+	lower := (1.0 - amount) * float64(period)
+	return time.Duration(lower + float64(rand.Int63n(window)))
 }
 
 // Kill implements Worker.Kill() and will close the channel given to the doWork
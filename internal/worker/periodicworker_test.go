@@ -75,6 +75,15 @@ func (s *periodicWorkerSuite) TestNextPeriodWithoutJitter(c *tc.C) {
 	}
 }
 
+func (s *periodicWorkerSuite) TestJitterPeriod(c *tc.C) {
+	for range 100 {
+		p := JitterPeriod(time.Hour, 0.5)
+		c.Assert(p >= 30*time.Minute, tc.IsTrue)
+		c.Assert(p <= 90*time.Minute, tc.IsTrue)
+	}
+	c.Assert(JitterPeriod(0, 0.5), tc.Equals, time.Duration(0))
+}
+
 func (s *periodicWorkerSuite) TestWaitWithJitter(c *tc.C) {
 	funcHasRun := make(chan struct{}, 1)
 	doWork := func(ctx context.Context) error {
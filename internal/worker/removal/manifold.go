@@ -39,8 +39,9 @@ type RemovalService interface {
 	// GetAllJobs returns all jobs for removals that have not been completed.
 	GetAllJobs(ctx context.Context) ([]removal.Job, error)
 
-	// ExecuteJob runs the appropriate removal logic for the input job.
-	ExecuteJob(ctx context.Context, job removal.Job) error
+	// RunJob runs the appropriate removal logic for the input job,
+	// recording the attempt and its outcome.
+	RunJob(ctx context.Context, job removal.Job) error
 }
 
 // Clock describes the ability get the current time and create timers.
@@ -43,79 +43,79 @@ func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
 	return m.recorder
 }
 
-// ExecuteJob mocks base method.
-func (m *MockRemovalService) ExecuteJob(arg0 context.Context, arg1 removal.Job) error {
+// GetAllJobs mocks base method.
+func (m *MockRemovalService) GetAllJobs(arg0 context.Context) ([]removal.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecuteJob", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetAllJobs", arg0)
+	ret0, _ := ret[0].([]removal.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ExecuteJob indicates an expected call of ExecuteJob.
-func (mr *MockRemovalServiceMockRecorder) ExecuteJob(arg0, arg1 any) *MockRemovalServiceExecuteJobCall {
+// GetAllJobs indicates an expected call of GetAllJobs.
+func (mr *MockRemovalServiceMockRecorder) GetAllJobs(arg0 any) *MockRemovalServiceGetAllJobsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteJob", reflect.TypeOf((*MockRemovalService)(nil).ExecuteJob), arg0, arg1)
-	return &MockRemovalServiceExecuteJobCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllJobs", reflect.TypeOf((*MockRemovalService)(nil).GetAllJobs), arg0)
+	return &MockRemovalServiceGetAllJobsCall{Call: call}
 }
 
-// MockRemovalServiceExecuteJobCall wrap *gomock.Call
-type MockRemovalServiceExecuteJobCall struct {
+// MockRemovalServiceGetAllJobsCall wrap *gomock.Call
+type MockRemovalServiceGetAllJobsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockRemovalServiceExecuteJobCall) Return(arg0 error) *MockRemovalServiceExecuteJobCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockRemovalServiceGetAllJobsCall) Return(arg0 []removal.Job, arg1 error) *MockRemovalServiceGetAllJobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockRemovalServiceExecuteJobCall) Do(f func(context.Context, removal.Job) error) *MockRemovalServiceExecuteJobCall {
+func (c *MockRemovalServiceGetAllJobsCall) Do(f func(context.Context) ([]removal.Job, error)) *MockRemovalServiceGetAllJobsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockRemovalServiceExecuteJobCall) DoAndReturn(f func(context.Context, removal.Job) error) *MockRemovalServiceExecuteJobCall {
+func (c *MockRemovalServiceGetAllJobsCall) DoAndReturn(f func(context.Context) ([]removal.Job, error)) *MockRemovalServiceGetAllJobsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetAllJobs mocks base method.
-func (m *MockRemovalService) GetAllJobs(arg0 context.Context) ([]removal.Job, error) {
+// RunJob mocks base method.
+func (m *MockRemovalService) RunJob(arg0 context.Context, arg1 removal.Job) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllJobs", arg0)
-	ret0, _ := ret[0].([]removal.Job)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RunJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllJobs indicates an expected call of GetAllJobs.
-func (mr *MockRemovalServiceMockRecorder) GetAllJobs(arg0 any) *MockRemovalServiceGetAllJobsCall {
+// RunJob indicates an expected call of RunJob.
+func (mr *MockRemovalServiceMockRecorder) RunJob(arg0, arg1 any) *MockRemovalServiceRunJobCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllJobs", reflect.TypeOf((*MockRemovalService)(nil).GetAllJobs), arg0)
-	return &MockRemovalServiceGetAllJobsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunJob", reflect.TypeOf((*MockRemovalService)(nil).RunJob), arg0, arg1)
+	return &MockRemovalServiceRunJobCall{Call: call}
 }
 
-// MockRemovalServiceGetAllJobsCall wrap *gomock.Call
-type MockRemovalServiceGetAllJobsCall struct {
+// MockRemovalServiceRunJobCall wrap *gomock.Call
+type MockRemovalServiceRunJobCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockRemovalServiceGetAllJobsCall) Return(arg0 []removal.Job, arg1 error) *MockRemovalServiceGetAllJobsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockRemovalServiceRunJobCall) Return(arg0 error) *MockRemovalServiceRunJobCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockRemovalServiceGetAllJobsCall) Do(f func(context.Context) ([]removal.Job, error)) *MockRemovalServiceGetAllJobsCall {
+func (c *MockRemovalServiceRunJobCall) Do(f func(context.Context, removal.Job) error) *MockRemovalServiceRunJobCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockRemovalServiceGetAllJobsCall) DoAndReturn(f func(context.Context) ([]removal.Job, error)) *MockRemovalServiceGetAllJobsCall {
+func (c *MockRemovalServiceRunJobCall) DoAndReturn(f func(context.Context, removal.Job) error) *MockRemovalServiceRunJobCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
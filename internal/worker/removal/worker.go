@@ -200,7 +200,7 @@ func newJobWorker(svc RemovalService, job removal.Job) func(context.Context) (wo
 	return func(ctx context.Context) (worker.Worker, error) {
 		w := jobWorker{job: job}
 		w.tomb.Go(func() error {
-			return svc.ExecuteJob(w.tomb.Context(context.Background()), job)
+			return svc.RunJob(w.tomb.Context(context.Background()), job)
 		})
 		return &w, nil
 	}
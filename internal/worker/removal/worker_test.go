@@ -103,7 +103,7 @@ func (s *workerSuite) TestWorkerNotifiedSchedulesDueJob(c *tc.C) {
 	// Use job execution as a synchronisation point below.
 	// so that we know we can kill the worker.
 	sync := make(chan struct{})
-	s.svc.EXPECT().ExecuteJob(gomock.Any(), dueJob).DoAndReturn(func(_ context.Context, job removal.Job) error {
+	s.svc.EXPECT().RunJob(gomock.Any(), dueJob).DoAndReturn(func(_ context.Context, job removal.Job) error {
 		sync <- struct{}{}
 		return nil
 	})
@@ -175,7 +175,7 @@ func (s *workerSuite) TestWorkerTimerSchedulesOnlyRequiredJob(c *tc.C) {
 	// Use job execution as a synchronisation point below.
 	// so that we know we can kill the worker.
 	sync := make(chan struct{})
-	s.svc.EXPECT().ExecuteJob(gomock.Any(), dueJob).DoAndReturn(func(ctx context.Context, job removal.Job) error {
+	s.svc.EXPECT().RunJob(gomock.Any(), dueJob).DoAndReturn(func(ctx context.Context, job removal.Job) error {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
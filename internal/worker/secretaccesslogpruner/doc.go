@@ -0,0 +1,16 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretaccesslogpruner provides a worker that periodically prunes
+// the secret access log of a model according to its configuration.
+//
+// On each run, the worker reads the following model config settings and
+// asks the secret service to remove the entries beyond them:
+//   - config.MaxSecretAccessLogAge: the maximum age of entries to keep.
+//   - config.MaxSecretAccessLogEntries: the maximum number of entries the
+//     model keeps.
+//
+// A zero value for either setting disables that part of the pruning. The
+// prune interval is randomized between 0.5 and 1.5 times its value so that
+// the workers of different models don't all prune at the same time.
+package secretaccesslogpruner
@@ -0,0 +1,6 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretaccesslogpruner
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package secretaccesslogpruner -destination services_mock_test.go github.com/juju/juju/internal/worker/secretaccesslogpruner ModelConfigService,SecretService
@@ -0,0 +1,86 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretaccesslogpruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/worker/v5"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	internalworker "github.com/juju/juju/internal/worker"
+)
+
+// ModelConfigService provides access to the model configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(ctx context.Context) (*config.Config, error)
+}
+
+// SecretService prunes the secret access log.
+type SecretService interface {
+	// PruneSecretAccessLog removes the secret access log entries older than
+	// maxAge, then the oldest entries beyond maxEntries.
+	PruneSecretAccessLog(ctx context.Context, maxAge time.Duration, maxEntries int) error
+}
+
+// Config is the configuration for the secret access log pruner.
+type Config struct {
+	ModelConfigService ModelConfigService
+	SecretService      SecretService
+	Logger             logger.Logger
+
+	// PruneInterval is the interval at which the pruner will run.
+	PruneInterval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ModelConfigService == nil {
+		return errors.Errorf("nil ModelConfigService").Add(coreerrors.NotValid)
+	}
+	if config.SecretService == nil {
+		return errors.Errorf("nil SecretService").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.PruneInterval <= 0 {
+		return errors.Errorf("prune interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// NewWorker returns a worker which prunes the secret access log of the model
+// once on start, then every prune interval.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	prune := func(ctx context.Context) error {
+		return pruneSecretAccessLog(ctx, config)
+	}
+	return internalworker.NewPeriodicWorker(
+		prune, config.PruneInterval, internalworker.NewTimer, internalworker.Jitter(0.5),
+	), nil
+}
+
+// pruneSecretAccessLog prunes the secret access log with the limits in the
+// current model config.
+func pruneSecretAccessLog(ctx context.Context, config Config) error {
+	cfg, err := config.ModelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting model config: %w", err)
+	}
+	maxAge, maxEntries := cfg.MaxSecretAccessLogAge(), cfg.MaxSecretAccessLogEntries()
+	config.Logger.Debugf(ctx, "pruning secret access log: max-age=%v, max-entries=%d", maxAge, maxEntries)
+	if err := config.SecretService.PruneSecretAccessLog(ctx, maxAge, maxEntries); err != nil {
+		return errors.Errorf("pruning secret access log: %w", err)
+	}
+	return nil
+}
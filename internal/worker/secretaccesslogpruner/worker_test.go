@@ -0,0 +1,117 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretaccesslogpruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"go.uber.org/mock/gomock"
+
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type workerSuite struct {
+	modelConfigService *MockModelConfigService
+	secretService      *MockSecretService
+}
+
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.secretService = NewMockSecretService(ctrl)
+	c.Cleanup(func() {
+		s.modelConfigService = nil
+		s.secretService = nil
+	})
+	return ctrl
+}
+
+func (s *workerSuite) config(c *tc.C) Config {
+	return Config{
+		ModelConfigService: s.modelConfigService,
+		SecretService:      s.secretService,
+		Logger:             loggertesting.WrapCheckLog(c),
+		PruneInterval:      time.Hour,
+	}
+}
+
+func (s *workerSuite) modelConfig(c *tc.C, age string, entries int) *config.Config {
+	cfg, err := config.New(config.UseDefaults, map[string]any{
+		"name":                           "test-model",
+		"type":                           "test-type",
+		"uuid":                           uuid.MustNewUUID().String(),
+		config.MaxSecretAccessLogAge:     age,
+		config.MaxSecretAccessLogEntries: entries,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return cfg
+}
+
+func (s *workerSuite) TestValidateConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.config(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.ModelConfigService = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil ModelConfigService.*")
+
+	bad = cfg
+	bad.SecretService = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil SecretService.*")
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	bad = cfg
+	bad.PruneInterval = 0
+	c.Check(bad.Validate(), tc.ErrorMatches, "prune interval must be positive.*")
+}
+
+func (s *workerSuite) TestPrunesOnStart(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, "24h", 500), nil)
+	pruned := make(chan struct{})
+	s.secretService.EXPECT().PruneSecretAccessLog(gomock.Any(), 24*time.Hour, 500).
+		DoAndReturn(func(context.Context, time.Duration, int) error {
+			close(pruned)
+			return nil
+		})
+
+	w, err := NewWorker(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	select {
+	case <-pruned:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for the secret access log to be pruned")
+	}
+}
+
+func (s *workerSuite) TestPruneError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, "0s", 0), nil)
+	s.secretService.EXPECT().PruneSecretAccessLog(gomock.Any(), time.Duration(0), 0).Return(errors.New("boom"))
+
+	w, err := NewWorker(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, tc.ErrorMatches, "pruning secret access log: boom")
+}
@@ -16,6 +16,7 @@ import (
 	coresecrets "github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/watcher"
 	jujusecrets "github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
 )
 
 // SecretsDrainFacade instances provide a set of API for the worker to deal with secret drain process.
@@ -184,6 +185,7 @@ func (w *Worker) drainSecret(
 	// and its revisions.
 	var args []secretsdrain.ChangeSecretBackendArg
 	var cleanUpInExternalBackendFuncs []func() error
+	var unverified int
 	for _, revisionMeta := range md.Revisions {
 		rev := revisionMeta
 		// We have to get the active backend for each drain operation because the active backend
@@ -202,6 +204,10 @@ func (w *Worker) drainSecret(
 		if err != nil {
 			return errors.Trace(err)
 		}
+		checksum, err := secretVal.Checksum()
+		if err != nil {
+			return errors.Trace(err)
+		}
 		newRevId, err := activeBackend.SaveContent(ctx, md.URI, rev.Revision, secretVal)
 		if err != nil && !errors.Is(err, errors.NotSupported) {
 			return errors.Trace(err)
@@ -210,7 +216,16 @@ func (w *Worker) drainSecret(
 		var newValueRef *coresecrets.ValueRef
 		data := secretVal.EncodedValues()
 		if err == nil {
-			// We are draining to an external backend,
+			// We are draining to an external backend, so read the content
+			// back to verify it before the secret is switched over to it.
+			if verifyErr := verifyDrainedContent(ctx, activeBackend, newRevId, checksum); verifyErr != nil {
+				w.config.Logger.Warningf(ctx, "failed to verify secret %s/%d in the new backend %q: %v", md.URI.ID, rev.Revision, activeBackendID, verifyErr)
+				if err := activeBackend.DeleteContent(ctx, newRevId); err != nil && !errors.Is(err, errors.NotFound) {
+					w.config.Logger.Warningf(ctx, "failed to clean up unverified secret %s/%d in the new backend %q: %v", md.URI.ID, rev.Revision, activeBackendID, err)
+				}
+				unverified++
+				continue
+			}
 			newValueRef = &coresecrets.ValueRef{
 				BackendID:  activeBackendID,
 				RevisionID: newRevId,
@@ -252,9 +267,13 @@ func (w *Worker) drainSecret(
 			Revision: rev.Revision,
 			ValueRef: newValueRef,
 			Data:     data,
+			Checksum: checksum,
 		})
 	}
 	if len(args) == 0 {
+		if unverified > 0 {
+			return errors.Errorf("failed to verify drained secret revisions for %q in the active backend", md.URI)
+		}
 		return nil
 	}
 
@@ -278,9 +297,28 @@ func (w *Worker) drainSecret(
 			w.config.Logger.Warningf(ctx, "failed to change secret backend for %q-%d: %v", arg.URI, arg.Revision, err)
 		}
 	}
-	if results.ErrorCount() > 0 {
+	if results.ErrorCount() > 0 || unverified > 0 {
 		// We got failed tasks, so we have to bounce the agent to retry those failed tasks.
 		return errors.Errorf("failed to drain secret revisions for %q to the active backend", md.URI)
 	}
 	return nil
 }
+
+// verifyDrainedContent reads back the content saved to a backend and checks
+// that it matches the checksum of the content that was drained.
+func verifyDrainedContent(
+	ctx context.Context, backend provider.SecretsBackend, revisionID, checksum string,
+) error {
+	saved, err := backend.GetContent(ctx, revisionID)
+	if err != nil {
+		return errors.Annotate(err, "reading back drained content")
+	}
+	savedChecksum, err := saved.Checksum()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if savedChecksum != checksum {
+		return errors.Errorf("checksum %q of drained content does not match %q", savedChecksum, checksum)
+	}
+	return nil
+}
@@ -149,6 +149,8 @@ func (s *workerSuite) TestDrainBetweenExternalBackends(c *tc.C) {
 	uri := coresecrets.NewURI()
 	s.notifyBackendChangedCh <- struct{}{}
 	secretValue := coresecrets.NewSecretValue(map[string]string{"foo": "bar"})
+	checksum, err := secretValue.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
 
 	oldBackend := mocks.NewMockSecretsBackend(ctrl)
 	activeBackend := mocks.NewMockSecretsBackend(ctrl)
@@ -168,6 +170,7 @@ func (s *workerSuite) TestDrainBetweenExternalBackends(c *tc.C) {
 		s.backendClient.EXPECT().GetBackend(gomock.Any(), nil, true).Return(activeBackend, "backend-2", nil),
 		s.backendClient.EXPECT().GetRevisionContent(gomock.Any(), uri, 1).Return(secretValue, nil),
 		activeBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, secretValue).Return("revision-1", nil),
+		activeBackend.EXPECT().GetContent(gomock.Any(), "revision-1").Return(secretValue, nil),
 		s.backendClient.EXPECT().GetBackend(gomock.Any(), new("backend-1"), true).Return(oldBackend, "", nil),
 		s.facade.EXPECT().ChangeSecretBackend(
 			gomock.Any(),
@@ -175,6 +178,7 @@ func (s *workerSuite) TestDrainBetweenExternalBackends(c *tc.C) {
 				{
 					URI:      uri,
 					Revision: 1,
+					Checksum: checksum,
 					ValueRef: &coresecrets.ValueRef{
 						BackendID:  "backend-2",
 						RevisionID: "revision-1",
@@ -202,6 +206,8 @@ func (s *workerSuite) TestDrainFromInternalToExternal(c *tc.C) {
 	uri := coresecrets.NewURI()
 	s.notifyBackendChangedCh <- struct{}{}
 	secretValue := coresecrets.NewSecretValue(map[string]string{"foo": "bar"})
+	checksum, err := secretValue.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
 
 	activeBackend := mocks.NewMockSecretsBackend(ctrl)
 
@@ -215,12 +221,14 @@ func (s *workerSuite) TestDrainFromInternalToExternal(c *tc.C) {
 		s.backendClient.EXPECT().GetBackend(gomock.Any(), nil, true).Return(activeBackend, "backend-2", nil),
 		s.backendClient.EXPECT().GetRevisionContent(gomock.Any(), uri, 1).Return(secretValue, nil),
 		activeBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, secretValue).Return("revision-1", nil),
+		activeBackend.EXPECT().GetContent(gomock.Any(), "revision-1").Return(secretValue, nil),
 		s.facade.EXPECT().ChangeSecretBackend(
 			gomock.Any(),
 			[]secretsdrain.ChangeSecretBackendArg{
 				{
 					URI:      uri,
 					Revision: 1,
+					Checksum: checksum,
 					ValueRef: &coresecrets.ValueRef{
 						BackendID:  "backend-2",
 						RevisionID: "revision-1",
@@ -235,6 +243,42 @@ func (s *workerSuite) TestDrainFromInternalToExternal(c *tc.C) {
 	start("")
 }
 
+func (s *workerSuite) TestDrainVerificationFailed(c *tc.C) {
+	start, ctrl := s.getWorkerNewer(c)
+	defer ctrl.Finish()
+
+	s.leadershipTracker.EXPECT().WithStableLeadership(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+
+	uri := coresecrets.NewURI()
+	s.notifyBackendChangedCh <- struct{}{}
+	secretValue := coresecrets.NewSecretValue(map[string]string{"foo": "bar"})
+	corrupted := coresecrets.NewSecretValue(map[string]string{"foo": "baz"})
+
+	activeBackend := mocks.NewMockSecretsBackend(ctrl)
+
+	gomock.InOrder(
+		s.facade.EXPECT().GetSecretsToDrain(gomock.Any()).Return([]coresecrets.SecretMetadataForDrain{
+			{
+				URI:       uri,
+				Revisions: []coresecrets.SecretExternalRevision{{Revision: 1}},
+			},
+		}, nil),
+		s.backendClient.EXPECT().GetBackend(gomock.Any(), nil, true).Return(activeBackend, "backend-2", nil),
+		s.backendClient.EXPECT().GetRevisionContent(gomock.Any(), uri, 1).Return(secretValue, nil),
+		activeBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, secretValue).Return("revision-1", nil),
+		activeBackend.EXPECT().GetContent(gomock.Any(), "revision-1").Return(corrupted, nil),
+		// The unverified copy is removed and the secret is not switched over.
+		activeBackend.EXPECT().DeleteContent(gomock.Any(), "revision-1").DoAndReturn(func(_ context.Context, _ string) error {
+			close(s.done)
+			return nil
+		}),
+	)
+	start(`failed to verify drained secret revisions for "secret:.*" in the active backend`)
+}
+
 func (s *workerSuite) TestDrainFromExternalToInternal(c *tc.C) {
 	start, ctrl := s.getWorkerNewer(c)
 	defer ctrl.Finish()
@@ -247,6 +291,8 @@ func (s *workerSuite) TestDrainFromExternalToInternal(c *tc.C) {
 	uri := coresecrets.NewURI()
 	s.notifyBackendChangedCh <- struct{}{}
 	secretValue := coresecrets.NewSecretValue(map[string]string{"foo": "bar"})
+	checksum, err := secretValue.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
 
 	oldBackend := mocks.NewMockSecretsBackend(ctrl)
 	activeBackend := mocks.NewMockSecretsBackend(ctrl)
@@ -272,6 +318,7 @@ func (s *workerSuite) TestDrainFromExternalToInternal(c *tc.C) {
 				{
 					URI:      uri,
 					Revision: 1,
+					Checksum: checksum,
 					Data:     secretValue.EncodedValues(),
 				},
 			},
@@ -298,6 +345,8 @@ func (s *workerSuite) TestDrainPartiallyFailed(c *tc.C) {
 	uri := coresecrets.NewURI()
 	s.notifyBackendChangedCh <- struct{}{}
 	secretValue := coresecrets.NewSecretValue(map[string]string{"foo": "bar"})
+	checksum, err := secretValue.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
 
 	oldBackend := mocks.NewMockSecretsBackend(ctrl)
 	activeBackend := mocks.NewMockSecretsBackend(ctrl)
@@ -336,11 +385,13 @@ func (s *workerSuite) TestDrainPartiallyFailed(c *tc.C) {
 				{
 					URI:      uri,
 					Revision: 1,
+					Checksum: checksum,
 					Data:     secretValue.EncodedValues(),
 				},
 				{
 					URI:      uri,
 					Revision: 2,
+					Checksum: checksum,
 					Data:     secretValue.EncodedValues(),
 				},
 			},
@@ -373,6 +424,8 @@ func (s *workerSuite) TestDrainLeadershipChange(c *tc.C) {
 	uri := coresecrets.NewURI()
 	s.notifyBackendChangedCh <- struct{}{}
 	secretValue := coresecrets.NewSecretValue(map[string]string{"foo": "bar"})
+	checksum, err := secretValue.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
 
 	activeBackend := mocks.NewMockSecretsBackend(ctrl)
 
@@ -386,11 +439,13 @@ func (s *workerSuite) TestDrainLeadershipChange(c *tc.C) {
 		s.backendClient.EXPECT().GetBackend(gomock.Any(), nil, true).Return(activeBackend, "backend-2", nil),
 		s.backendClient.EXPECT().GetRevisionContent(gomock.Any(), uri, 1).Return(secretValue, nil),
 		activeBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, secretValue).Return("revision-1", nil),
+		activeBackend.EXPECT().GetContent(gomock.Any(), "revision-1").Return(secretValue, nil),
 		s.facade.EXPECT().ChangeSecretBackend(gomock.Any(),
 			[]secretsdrain.ChangeSecretBackendArg{
 				{
 					URI:      uri,
 					Revision: 1,
+					Checksum: checksum,
 					ValueRef: &coresecrets.ValueRef{
 						BackendID:  "backend-2",
 						RevisionID: "revision-1",
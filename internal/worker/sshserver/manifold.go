@@ -6,6 +6,7 @@ package sshserver
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/dependency"
@@ -28,6 +29,18 @@ func GetControllerConfigService(getter dependency.Getter, name string) (Controll
 	})
 }
 
+// GetRecordingServiceFunc is a helper function that gets a recording service
+// from the manifold.
+type GetRecordingServiceFunc = func(getter dependency.Getter, name string) (RecordingService, error)
+
+// GetRecordingService is a helper function that gets the SSH session
+// recording service from the manifold.
+func GetRecordingService(getter dependency.Getter, name string) (RecordingService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) RecordingService {
+		return factory.SSHRecording()
+	})
+}
+
 // ManifoldConfig holds the information necessary to run an embedded SSH server
 // worker in a dependency.Engine.
 type ManifoldConfig struct {
@@ -40,8 +53,13 @@ type ManifoldConfig struct {
 	NewServerWorker func(ServerWorkerConfig) (worker.Worker, error)
 	// GetControllerConfigService is used to get a service from the manifold.
 	GetControllerConfigService GetControllerConfigServiceFunc
+	// GetRecordingService is used to get the session recording service from
+	// the manifold.
+	GetRecordingService GetRecordingServiceFunc
 	// Logger is the logger to use for the worker.
 	Logger logger.Logger
+	// Clock is used to timestamp session recordings.
+	Clock clock.Clock
 }
 
 // Validate validates the manifold configuration.
@@ -58,9 +76,15 @@ func (config ManifoldConfig) Validate() error {
 	if config.GetControllerConfigService == nil {
 		return errors.NotValidf("nil GetControllerConfigService")
 	}
+	if config.GetRecordingService == nil {
+		return errors.NotValidf("nil GetRecordingService")
+	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
 	return nil
 }
 
@@ -91,11 +115,17 @@ func (config ManifoldConfig) startWrapperWorker(_ context.Context, getter depend
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	recordingService, err := config.GetRecordingService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 
 	return config.NewServerWrapperWorker(ServerWrapperWorkerConfig{
 		ControllerConfigService: controllerConfigService,
 		NewServerWorker:         config.NewServerWorker,
 		Logger:                  config.Logger,
 		SessionHandler:          &stubSessionHandler{},
+		RecordingService:        recordingService,
+		Clock:                   config.Clock,
 	})
 }
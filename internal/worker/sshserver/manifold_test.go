@@ -8,6 +8,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5"
@@ -29,6 +30,7 @@ type manifoldSuite struct {
 	testhelpers.IsolationSuite
 
 	controllerConfigService *MockControllerConfigService
+	recordingService        *MockRecordingService
 }
 
 func TestManifoldSuite(t *testing.T) {
@@ -56,7 +58,9 @@ func (s *manifoldSuite) TestConfigValidate(c *tc.C) {
 		cfg.NewServerWrapperWorker = nil
 		cfg.NewServerWorker = nil
 		cfg.GetControllerConfigService = nil
+		cfg.GetRecordingService = nil
 		cfg.Logger = nil
+		cfg.Clock = nil
 	})
 	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
 
@@ -84,12 +88,24 @@ func (s *manifoldSuite) TestConfigValidate(c *tc.C) {
 	})
 	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
 
+	// Missing GetRecordingService.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.GetRecordingService = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
+
 	// Missing Logger.
 	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
 		cfg.Logger = nil
 	})
 	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
 
+	// Missing Clock.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.Clock = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
+
 }
 
 func (s *manifoldSuite) TestManifoldStart(c *tc.C) {
@@ -105,7 +121,11 @@ func (s *manifoldSuite) TestManifoldStart(c *tc.C) {
 		GetControllerConfigService: func(getter dependency.Getter, name string) (ControllerConfigService, error) {
 			return s.controllerConfigService, nil
 		},
+		GetRecordingService: func(getter dependency.Getter, name string) (RecordingService, error) {
+			return s.recordingService, nil
+		},
 		Logger: loggertesting.WrapCheckLog(c),
+		Clock:  clock.WallClock,
 	})
 
 	// Check the inputs are as expected
@@ -127,6 +147,7 @@ func (s *manifoldSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.recordingService = NewMockRecordingService(ctrl)
 
 	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).DoAndReturn(func(context.Context) (watcher.Watcher[[]string], error) {
 		return watchertest.NewMockStringsWatcher(make(<-chan []string)), nil
@@ -146,7 +167,11 @@ func (s *manifoldSuite) newManifoldConfig(c *tc.C, modifier func(cfg *ManifoldCo
 		GetControllerConfigService: func(getter dependency.Getter, name string) (ControllerConfigService, error) {
 			return s.controllerConfigService, nil
 		},
+		GetRecordingService: func(getter dependency.Getter, name string) (RecordingService, error) {
+			return s.recordingService, nil
+		},
 		Logger: loggertesting.WrapCheckLog(c),
+		Clock:  clock.WallClock,
 	}
 
 	modifier(cfg)
@@ -171,7 +196,11 @@ func (s *manifoldSuite) TestManifoldUninstall(c *tc.C) {
 		GetControllerConfigService: func(getter dependency.Getter, name string) (ControllerConfigService, error) {
 			return s.controllerConfigService, nil
 		},
+		GetRecordingService: func(getter dependency.Getter, name string) (RecordingService, error) {
+			return s.recordingService, nil
+		},
 		Logger: loggertesting.WrapCheckLog(c),
+		Clock:  clock.WallClock,
 	})
 
 	// Check the inputs are as expected
@@ -0,0 +1,212 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/domain/sshrecording"
+	"github.com/juju/juju/internal/asciicast"
+)
+
+// RecordingService stores the recordings of SSH sessions.
+type RecordingService interface {
+	// AddRecording stores the recording read from r and returns its UUID.
+	AddRecording(ctx context.Context, rec sshrecording.Recording, r io.Reader) (string, error)
+}
+
+// SessionRecorder records the SSH sessions proxied by the server.
+type SessionRecorder interface {
+	// Record returns a session that records everything written to and read
+	// from session, and a function that stores the recording once the
+	// session has ended.
+	Record(user string, session ssh.Session, destination virtualhostname.Info) (ssh.Session, func(), error)
+}
+
+// sessionRecorder records sessions as asciicast files. The recording is
+// written to a temporary file while the session is in progress, and stored
+// by the recording service once the session ends.
+type sessionRecorder struct {
+	service RecordingService
+	clock   clock.Clock
+	logger  logger.Logger
+
+	// tempDir is the directory holding recordings in progress. If empty,
+	// the default directory for temporary files is used.
+	tempDir string
+}
+
+// newSessionRecorder returns a SessionRecorder storing recordings with the
+// given service.
+func newSessionRecorder(service RecordingService, clock clock.Clock, logger logger.Logger) *sessionRecorder {
+	return &sessionRecorder{
+		service: service,
+		clock:   clock,
+		logger:  logger,
+	}
+}
+
+// Record implements SessionRecorder.
+func (r *sessionRecorder) Record(user string, session ssh.Session, destination virtualhostname.Info) (ssh.Session, func(), error) {
+	file, err := os.CreateTemp(r.tempDir, "ssh-recording-*.cast")
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "creating recording file")
+	}
+
+	header := asciicast.Header{
+		Command: session.RawCommand(),
+		Title:   fmt.Sprintf("%s@%s", user, destination.String()),
+	}
+	if pty, _, ok := session.Pty(); ok {
+		header.Width = pty.Window.Width
+		header.Height = pty.Window.Height
+		header.Env = map[string]string{"TERM": pty.Term}
+	}
+
+	started := r.clock.Now()
+	writer, err := asciicast.NewWriter(file, header, r.clock)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return nil, nil, errors.Annotate(err, "starting recording")
+	}
+
+	recorded := &recordedSession{
+		Session: session,
+		writer:  writer,
+		window: ssh.Window{
+			Width:  header.Width,
+			Height: header.Height,
+		},
+	}
+	finish := func() {
+		defer func() {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}()
+
+		ctx := context.Background()
+		rec := sshrecording.Recording{
+			User:      user,
+			Target:    destination.String(),
+			StartedAt: started,
+			EndedAt:   r.clock.Now(),
+		}
+		uuid, err := r.store(ctx, rec, writer, file)
+		if err != nil {
+			r.logger.Errorf(ctx, "storing recording of ssh session by %q to %q: %v", user, rec.Target, err)
+			return
+		}
+		r.logger.Infof(ctx, "recorded ssh session %q by %q to %q", uuid, user, rec.Target)
+	}
+	return recorded, finish, nil
+}
+
+func (r *sessionRecorder) store(ctx context.Context, rec sshrecording.Recording, writer *asciicast.Writer, file *os.File) (string, error) {
+	if err := writer.Close(); err != nil {
+		return "", errors.Trace(err)
+	}
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Trace(err)
+	}
+	rec.Size = size
+	return r.service.AddRecording(ctx, rec, file)
+}
+
+// recordedSession is an ssh.Session that records the data read from and
+// written to the session.
+type recordedSession struct {
+	ssh.Session
+
+	writer *asciicast.Writer
+
+	// window is the last recorded window size.
+	window  ssh.Window
+	ptyOnce sync.Once
+	windows <-chan ssh.Window
+}
+
+// Read implements io.Reader, recording the data read as input.
+func (s *recordedSession) Read(p []byte) (int, error) {
+	n, err := s.Session.Read(p)
+	if n > 0 {
+		_, _ = s.writer.Input().Write(p[:n])
+	}
+	return n, err
+}
+
+// Write implements io.Writer, recording the data written as output.
+func (s *recordedSession) Write(p []byte) (int, error) {
+	n, err := s.Session.Write(p)
+	if n > 0 {
+		_, _ = s.writer.Output().Write(p[:n])
+	}
+	return n, err
+}
+
+// Stderr returns the stderr stream of the session. Data written to it is
+// recorded as output, as that is how it appears on the user's terminal.
+func (s *recordedSession) Stderr() io.ReadWriter {
+	return recordedStderr{
+		ReadWriter: s.Session.Stderr(),
+		output:     s.writer.Output(),
+	}
+}
+
+// Pty returns the pty of the session. Changes to the window size are
+// recorded as they are passed on. The window channel of the session starts
+// with the initial size, which is already recorded in the header.
+func (s *recordedSession) Pty() (ssh.Pty, <-chan ssh.Window, bool) {
+	pty, windows, ok := s.Session.Pty()
+	if !ok {
+		return pty, windows, ok
+	}
+	s.ptyOnce.Do(func() {
+		recorded := make(chan ssh.Window)
+		go func() {
+			defer close(recorded)
+			for window := range windows {
+				if window.Width != s.window.Width || window.Height != s.window.Height {
+					_ = s.writer.Resize(window.Width, window.Height)
+					s.window = window
+				}
+				select {
+				case recorded <- window:
+				case <-s.Context().Done():
+					return
+				}
+			}
+		}()
+		s.windows = recorded
+	})
+	return pty, s.windows, ok
+}
+
+type recordedStderr struct {
+	io.ReadWriter
+	output io.Writer
+}
+
+// Write implements io.Writer.
+func (s recordedStderr) Write(p []byte) (int, error) {
+	n, err := s.ReadWriter.Write(p)
+	if n > 0 {
+		_, _ = s.output.Write(p[:n])
+	}
+	return n, err
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/domain/sshrecording"
+	"github.com/juju/juju/internal/asciicast"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type recordingSuite struct{}
+
+func TestRecordingSuite(t *testing.T) {
+	tc.Run(t, &recordingSuite{})
+}
+
+func (s *recordingSuite) TestRecordSession(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	started := time.Unix(1700000000, 0)
+	clock := testclock.NewClock(started)
+	service := NewMockRecordingService(ctrl)
+
+	recorder := newSessionRecorder(service, clock, loggertesting.WrapCheckLog(c))
+	recorder.tempDir = c.MkDir()
+
+	windows := make(chan ssh.Window)
+	session := &fakeSession{
+		stdin:   strings.NewReader("ls\r"),
+		pty:     ssh.Pty{Term: "xterm", Window: ssh.Window{Width: 80, Height: 24}},
+		windows: windows,
+		done:    make(chan struct{}),
+	}
+	defer close(session.done)
+	defer close(windows)
+
+	destination, err := virtualhostname.Parse(testVirtualHostname)
+	c.Assert(err, tc.ErrorIsNil)
+
+	recorded, finish, err := recorder.Record("admin", session, destination)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = io.WriteString(recorded, "$ ")
+	c.Assert(err, tc.ErrorIsNil)
+	clock.Advance(time.Second)
+
+	buf := make([]byte, 16)
+	n, err := recorded.Read(buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(buf[:n]), tc.Equals, "ls\r")
+
+	_, resized, ok := recorded.Pty()
+	c.Assert(ok, tc.IsTrue)
+	windows <- ssh.Window{Width: 80, Height: 24}
+	c.Check(<-resized, tc.DeepEquals, ssh.Window{Width: 80, Height: 24})
+	windows <- ssh.Window{Width: 100, Height: 40}
+	c.Check(<-resized, tc.DeepEquals, ssh.Window{Width: 100, Height: 40})
+
+	clock.Advance(time.Second)
+	_, err = io.WriteString(recorded.Stderr(), "oops")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Everything written reaches the user.
+	c.Check(session.stdout.String(), tc.Equals, "$ ")
+	c.Check(session.stderr.String(), tc.Equals, "oops")
+
+	service.EXPECT().AddRecording(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, rec sshrecording.Recording, r io.Reader) (string, error) {
+			data, err := io.ReadAll(r)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(rec, tc.DeepEquals, sshrecording.Recording{
+				User:      "admin",
+				Target:    testVirtualHostname,
+				Size:      int64(len(data)),
+				StartedAt: started,
+				EndedAt:   started.Add(2 * time.Second),
+			})
+
+			reader, err := asciicast.NewReader(bytes.NewReader(data))
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(reader.Header(), tc.DeepEquals, asciicast.Header{
+				Version:   asciicast.Version,
+				Width:     80,
+				Height:    24,
+				Timestamp: started.Unix(),
+				Title:     "admin@" + testVirtualHostname,
+				Env:       map[string]string{"TERM": "xterm"},
+			})
+
+			var events []asciicast.Event
+			for {
+				event, err := reader.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				c.Assert(err, tc.ErrorIsNil)
+				events = append(events, event)
+			}
+			c.Check(events, tc.DeepEquals, []asciicast.Event{
+				{Time: 0, Type: asciicast.Output, Data: "$ "},
+				{Time: time.Second, Type: asciicast.Input, Data: "ls\r"},
+				{Time: time.Second, Type: asciicast.Resize, Data: "100x40"},
+				{Time: 2 * time.Second, Type: asciicast.Output, Data: "oops"},
+			})
+			return "deadbeef", nil
+		})
+	finish()
+
+	// The temporary recording is removed once stored.
+	entries, err := os.ReadDir(recorder.tempDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *recordingSuite) TestRecordSessionStoreError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	service := NewMockRecordingService(ctrl)
+	service.EXPECT().AddRecording(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("boom"))
+
+	recorder := newSessionRecorder(service, testclock.NewClock(time.Now()), loggertesting.WrapCheckLog(c))
+	recorder.tempDir = c.MkDir()
+
+	destination, err := virtualhostname.Parse(testVirtualHostname)
+	c.Assert(err, tc.ErrorIsNil)
+
+	recorded, finish, err := recorder.Record("admin", &fakeSession{}, destination)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = io.WriteString(recorded, "hello")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A failure to store the recording is logged, the temporary recording
+	// is still removed.
+	finish()
+
+	entries, err := os.ReadDir(recorder.tempDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+// fakeSession is an ssh.Session holding the streams of a session in memory.
+type fakeSession struct {
+	ssh.Session
+
+	stdin   io.Reader
+	stdout  bytes.Buffer
+	stderr  bytes.Buffer
+	pty     ssh.Pty
+	windows chan ssh.Window
+	done    chan struct{}
+	exit    int
+}
+
+func (s *fakeSession) Read(p []byte) (int, error) {
+	if s.stdin == nil {
+		return 0, io.EOF
+	}
+	return s.stdin.Read(p)
+}
+
+func (s *fakeSession) Write(p []byte) (int, error) {
+	return s.stdout.Write(p)
+}
+
+func (s *fakeSession) Stderr() io.ReadWriter {
+	return &s.stderr
+}
+
+func (s *fakeSession) RawCommand() string {
+	return ""
+}
+
+func (s *fakeSession) Pty() (ssh.Pty, <-chan ssh.Window, bool) {
+	return s.pty, s.windows, s.windows != nil
+}
+
+func (s *fakeSession) Exit(code int) error {
+	s.exit = code
+	return nil
+}
+
+func (s *fakeSession) Context() ssh.Context {
+	return fakeContext{done: s.done}
+}
+
+// fakeContext is an ssh.Context that is only done once the fake session
+// ends.
+type fakeContext struct {
+	ssh.Context
+	done chan struct{}
+}
+
+func (c fakeContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c fakeContext) Value(any) any {
+	return nil
+}
@@ -54,6 +54,10 @@ type ServerWorkerConfig struct {
 
 	// SessionHandler handles proxying SSH sessions to the target machine.
 	SessionHandler SessionHandler
+
+	// SessionRecorder records the sessions proxied by the server. If nil,
+	// sessions are not recorded.
+	SessionRecorder SessionRecorder
 }
 
 // Validate validates the workers configuration is as expected.
@@ -295,7 +299,7 @@ func (s *ServerWorker) newEmbeddedSSHServer(ctx ssh.Context, info virtualhostnam
 			"cancel-tcpip-forward": forwardHandler.HandleSSHRequest,
 		},
 		Handler: func(session ssh.Session) {
-			s.config.SessionHandler.Handle(session, info)
+			s.handleSession(ctx.User(), session, info)
 		},
 	}
 
@@ -307,10 +311,33 @@ func (s *ServerWorker) newEmbeddedSSHServer(ctx ssh.Context, info virtualhostnam
 	return server, nil
 }
 
+// handleSession proxies the session to its destination, recording it if
+// recording is enabled. The user is the user authenticated by the jump
+// server, rather than the user of the embedded server session.
+func (s *ServerWorker) handleSession(user string, session ssh.Session, info virtualhostname.Info) {
+	if s.config.SessionRecorder == nil {
+		s.config.SessionHandler.Handle(session, info)
+		return
+	}
+
+	recorded, finish, err := s.config.SessionRecorder.Record(user, session, info)
+	if err != nil {
+		// Sessions must not go unrecorded while recording is enabled.
+		s.config.Logger.Errorf(session.Context(), "failed to record session: %v", err)
+		_, _ = session.Stderr().Write([]byte("failed to record session\n"))
+		_ = session.Exit(1)
+		return
+	}
+	defer finish()
+
+	s.config.SessionHandler.Handle(recorded, info)
+}
+
 // Report returns a map of metrics from the server worker.
 func (s *ServerWorker) Report(ctx context.Context) map[string]any {
 	return map[string]any{
 		"concurrent_connections": s.concurrentConnections.Load(),
+		"session_recording":      s.config.SessionRecorder != nil,
 	}
 }
 
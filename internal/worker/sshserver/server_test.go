@@ -252,6 +252,63 @@ func (s *sshServerSuite) TestSSHServerMaxConnections(c *tc.C) {
 	}
 }
 
+func (s *sshServerSuite) TestHandleSessionRecorded(c *tc.C) {
+	defer s.SetUpMocks(c).Finish()
+
+	recorder := &stubSessionRecorder{}
+	srv := &ServerWorker{config: ServerWorkerConfig{
+		Logger:          loggertesting.WrapCheckLog(c),
+		SessionHandler:  s.sessionHandler,
+		SessionRecorder: recorder,
+	}}
+
+	info, err := virtualhostname.Parse(testVirtualHostname)
+	c.Assert(err, tc.ErrorIsNil)
+
+	session := &fakeSession{}
+	s.sessionHandler.EXPECT().Handle(gomock.Any(), info).Do(func(recorded ssh.Session, _ virtualhostname.Info) {
+		c.Check(recorded, tc.Not(tc.Equals), session)
+		c.Check(recorder.finished, tc.IsFalse)
+	})
+
+	srv.handleSession("admin", session, info)
+	c.Check(recorder.user, tc.Equals, "admin")
+	c.Check(recorder.finished, tc.IsTrue)
+}
+
+func (s *sshServerSuite) TestHandleSessionRecorderError(c *tc.C) {
+	defer s.SetUpMocks(c).Finish()
+
+	srv := &ServerWorker{config: ServerWorkerConfig{
+		Logger:          loggertesting.WrapCheckLog(c),
+		SessionHandler:  s.sessionHandler,
+		SessionRecorder: &stubSessionRecorder{err: errors.New("boom")},
+	}}
+
+	info, err := virtualhostname.Parse(testVirtualHostname)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The session is refused rather than proxied without a recording.
+	session := &fakeSession{}
+	srv.handleSession("admin", session, info)
+	c.Check(session.stderr.String(), tc.Equals, "failed to record session\n")
+	c.Check(session.exit, tc.Equals, 1)
+}
+
+type stubSessionRecorder struct {
+	err      error
+	user     string
+	finished bool
+}
+
+func (r *stubSessionRecorder) Record(user string, session ssh.Session, _ virtualhostname.Info) (ssh.Session, func(), error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+	r.user = user
+	return &recordedSession{Session: session}, func() { r.finished = true }, nil
+}
+
 // dial returns and SSH connection that uses an in-memory transport.
 func dial(c *tc.C, network string, addr string, config *gossh.ClientConfig) *gossh.Client {
 	jumpServerConn, err := net.Dial(network, addr)
@@ -285,6 +342,7 @@ func (s *sshServerSuite) TestSSHWorkerReport(c *tc.C) {
 	report := worker.(*ServerWorker).Report(c.Context())
 	c.Assert(report, tc.DeepEquals, map[string]any{
 		"concurrent_connections": int32(0),
+		"session_recording":      false,
 	})
 
 	// Dial the listener
@@ -301,5 +359,6 @@ func (s *sshServerSuite) TestSSHWorkerReport(c *tc.C) {
 	report = worker.(*ServerWorker).Report(c.Context())
 	c.Assert(report, tc.DeepEquals, map[string]any{
 		"concurrent_connections": int32(1),
+		"session_recording":      false,
 	})
 }
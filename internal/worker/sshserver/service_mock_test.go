@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: ControllerConfigService,SessionHandler)
+// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: ControllerConfigService,SessionHandler,RecordingService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler
+//	mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler,RecordingService
 //
 
 // Package sshserver is a generated GoMock package.
@@ -11,12 +11,14 @@ package sshserver
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	ssh "github.com/gliderlabs/ssh"
 	controller "github.com/juju/juju/controller"
 	virtualhostname "github.com/juju/juju/core/virtualhostname"
 	watcher "github.com/juju/juju/core/watcher"
+	sshrecording "github.com/juju/juju/domain/sshrecording"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -179,3 +181,65 @@ func (c *MockSessionHandlerHandleCall) DoAndReturn(f func(ssh.Session, virtualho
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockRecordingService is a mock of RecordingService interface.
+type MockRecordingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordingServiceMockRecorder
+}
+
+// MockRecordingServiceMockRecorder is the mock recorder for MockRecordingService.
+type MockRecordingServiceMockRecorder struct {
+	mock *MockRecordingService
+}
+
+// NewMockRecordingService creates a new mock instance.
+func NewMockRecordingService(ctrl *gomock.Controller) *MockRecordingService {
+	mock := &MockRecordingService{ctrl: ctrl}
+	mock.recorder = &MockRecordingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordingService) EXPECT() *MockRecordingServiceMockRecorder {
+	return m.recorder
+}
+
+// AddRecording mocks base method.
+func (m *MockRecordingService) AddRecording(arg0 context.Context, arg1 sshrecording.Recording, arg2 io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecording", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRecording indicates an expected call of AddRecording.
+func (mr *MockRecordingServiceMockRecorder) AddRecording(arg0, arg1, arg2 any) *MockRecordingServiceAddRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecording", reflect.TypeOf((*MockRecordingService)(nil).AddRecording), arg0, arg1, arg2)
+	return &MockRecordingServiceAddRecordingCall{Call: call}
+}
+
+// MockRecordingServiceAddRecordingCall wrap *gomock.Call
+type MockRecordingServiceAddRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecordingServiceAddRecordingCall) Return(arg0 string, arg1 error) *MockRecordingServiceAddRecordingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecordingServiceAddRecordingCall) Do(f func(context.Context, sshrecording.Recording, io.Reader) (string, error)) *MockRecordingServiceAddRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecordingServiceAddRecordingCall) DoAndReturn(f func(context.Context, sshrecording.Recording, io.Reader) (string, error)) *MockRecordingServiceAddRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
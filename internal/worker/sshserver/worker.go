@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/catacomb"
@@ -48,6 +49,11 @@ type ServerWrapperWorkerConfig struct {
 	NewServerWorker         func(ServerWorkerConfig) (worker.Worker, error)
 	Logger                  logger.Logger
 	SessionHandler          SessionHandler
+
+	// RecordingService stores the recordings of sessions, when session
+	// recording is enabled in the controller config.
+	RecordingService RecordingService
+	Clock            clock.Clock
 }
 
 // Validate validates the workers configuration is as expected.
@@ -64,6 +70,12 @@ func (c ServerWrapperWorkerConfig) Validate() error {
 	if c.SessionHandler == nil {
 		return errors.NotValidf("SessionHandler is required")
 	}
+	if c.RecordingService == nil {
+		return errors.NotValidf("RecordingService is required")
+	}
+	if c.Clock == nil {
+		return errors.NotValidf("Clock is required")
+	}
 	return nil
 }
 
@@ -85,7 +97,8 @@ type serverWrapperWorker struct {
 
 // NewServerWrapperWorker returns a new worker that runs an ssh server worker internally.
 // This worker will listen for changes in the controller configuration and restart the
-// server worker when the port or max concurrent connections changes.
+// server worker when the port, max concurrent connections or session recording
+// changes.
 func NewServerWrapperWorker(config ServerWrapperWorkerConfig) (worker.Worker, error) {
 	if err := config.Validate(); err != nil {
 		return nil, errors.Trace(err)
@@ -154,14 +167,19 @@ func (ssw *serverWrapperWorker) loop() error {
 
 	port := config.SSHServerPort()
 	maxConns := config.SSHMaxConcurrentConnections()
+	recording := config.SSHSessionRecording()
 
-	srv, err := ssw.config.NewServerWorker(ServerWorkerConfig{
+	serverConfig := ServerWorkerConfig{
 		Logger:                   ssw.config.Logger,
 		JumpHostKey:              temporaryJumpHostKey,
 		Port:                     port,
 		MaxConcurrentConnections: maxConns,
 		SessionHandler:           ssw.config.SessionHandler,
-	})
+	}
+	if recording {
+		serverConfig.SessionRecorder = newSessionRecorder(ssw.config.RecordingService, ssw.config.Clock, ssw.config.Logger)
+	}
+	srv, err := ssw.config.NewServerWorker(serverConfig)
 	ssw.addWorkerReporter("ssh-server", srv)
 	if err != nil {
 		return errors.Trace(err)
@@ -185,7 +203,8 @@ func (ssw *serverWrapperWorker) loop() error {
 				return errors.Trace(err)
 			}
 			if maxConns == config.SSHMaxConcurrentConnections() &&
-				port == config.SSHServerPort() {
+				port == config.SSHServerPort() &&
+				recording == config.SSHSessionRecording() {
 				ssw.config.Logger.Debugf(ctx, "controller configuration changed, but nothing changed for the ssh server")
 				continue
 			}
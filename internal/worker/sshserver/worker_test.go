@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/workertest"
@@ -37,6 +38,8 @@ func newServerWrapperWorkerConfig(
 		ControllerConfigService: NewMockControllerConfigService(ctrl),
 		Logger:                  loggertesting.WrapCheckLog(c),
 		SessionHandler:          &MockSessionHandler{},
+		RecordingService:        NewMockRecordingService(ctrl),
+		Clock:                   clock.WallClock,
 	}
 
 	modifier(cfg)
@@ -80,6 +83,26 @@ func (s *workerSuite) TestValidate(c *tc.C) {
 		},
 	)
 	c.Assert(cfg.Validate(), tc.ErrorMatches, ".*is required.*")
+
+	// Test no RecordingService.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.RecordingService = nil
+		},
+	)
+	c.Assert(cfg.Validate(), tc.ErrorMatches, ".*is required.*")
+
+	// Test no Clock.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.Clock = nil
+		},
+	)
+	c.Assert(cfg.Validate(), tc.ErrorMatches, ".*is required.*")
 }
 
 func (s *workerSuite) TestSSHServerWrapperWorkerCanBeKilled(c *tc.C) {
@@ -109,7 +132,9 @@ func (s *workerSuite) TestSSHServerWrapperWorkerCanBeKilled(c *tc.C) {
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			return serverWorker, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:   &stubSessionHandler{},
+		RecordingService: NewMockRecordingService(ctrl),
+		Clock:            clock.WallClock,
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
@@ -187,7 +212,9 @@ func (s *workerSuite) TestSSHServerWrapperWorkerRestartsServerWorker(c *tc.C) {
 			c.Check(swc.Port, tc.Equals, 22)
 			return serverWorker, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:   &stubSessionHandler{},
+		RecordingService: NewMockRecordingService(ctrl),
+		Clock:            clock.WallClock,
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
@@ -272,7 +299,9 @@ func (s *workerSuite) TestSSHServerWrapperWorkerRestartsServerWorkerOnPortChange
 			c.Check(swc.Port, tc.Equals, 22)
 			return serverWorker, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:   &stubSessionHandler{},
+		RecordingService: NewMockRecordingService(ctrl),
+		Clock:            clock.WallClock,
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
@@ -292,6 +321,67 @@ func (s *workerSuite) TestSSHServerWrapperWorkerRestartsServerWorkerOnPortChange
 	c.Check(err, tc.ErrorMatches, "changes detected, stopping SSH server worker")
 }
 
+func (s *workerSuite) TestSSHServerWrapperWorkerSessionRecording(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	serverWorker := workertest.NewErrorWorker(nil)
+	defer workertest.DirtyKill(c, serverWorker)
+
+	ch := make(chan []string)
+	controllerConfigWatcher := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.DirtyKill(c, controllerConfigWatcher)
+
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(controllerConfigWatcher, nil)
+
+	// First call on startup, with session recording enabled.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHSessionRecording:         true,
+			},
+			nil,
+		).
+		Times(1)
+	// Second call after a watcher event: session recording disabled.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+			},
+			nil,
+		).
+		Times(1)
+
+	cfg := ServerWrapperWorkerConfig{
+		ControllerConfigService: controllerConfigService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
+			c.Check(swc.SessionRecorder, tc.NotNil)
+			return serverWorker, nil
+		},
+		SessionHandler:   &stubSessionHandler{},
+		RecordingService: NewMockRecordingService(ctrl),
+		Clock:            clock.WallClock,
+	}
+	w, err := NewServerWrapperWorker(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	workertest.CheckAlive(c, w)
+
+	// Disabling session recording restarts the server.
+	ch <- nil
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, tc.ErrorMatches, "changes detected, stopping SSH server worker")
+}
+
 func (s *workerSuite) TestSSHServerWrapperWorkerConfigWatcherClosed(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -316,7 +406,9 @@ func (s *workerSuite) TestSSHServerWrapperWorkerConfigWatcherClosed(c *tc.C) {
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			return serverWorker, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:   &stubSessionHandler{},
+		RecordingService: NewMockRecordingService(ctrl),
+		Clock:            clock.WallClock,
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
@@ -361,7 +453,9 @@ func (s *workerSuite) TestWrapperWorkerReport(c *tc.C) {
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			return &reportWorker{serverWorker}, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:   &stubSessionHandler{},
+		RecordingService: NewMockRecordingService(ctrl),
+		Clock:            clock.WallClock,
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
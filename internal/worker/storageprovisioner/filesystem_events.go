@@ -68,6 +68,71 @@ func filesystemsChanged(ctx context.Context, deps *dependencies, changes []strin
 	return nil
 }
 
+// filesystemResizesChanged is called when the filesystems with the provided
+// IDs have been seen to need growing to their requested size.
+func filesystemResizesChanged(ctx context.Context, deps *dependencies, changes []string) error {
+	deps.config.Logger.Tracef(ctx, "filesystemResizesChanged: %#v", changes)
+	tags := make([]names.FilesystemTag, len(changes))
+	for i, change := range changes {
+		tags[i] = names.NewFilesystemTag(change)
+	}
+	filesystemResults, err := deps.config.Filesystems.Filesystems(ctx, tags)
+	if err != nil {
+		return errors.Annotatef(err, "getting filesystem information")
+	}
+	provisioned := make([]names.FilesystemTag, 0, len(tags))
+	filesystems := make(map[names.FilesystemTag]storage.Filesystem)
+	for i, result := range filesystemResults {
+		if result.Error != nil {
+			if !params.IsCodeNotProvisioned(result.Error) {
+				return errors.Annotatef(
+					result.Error, "getting filesystem information for filesystem %q", tags[i].Id(),
+				)
+			}
+			// Filesystems that are yet to be provisioned will be
+			// created with their requested size.
+			deps.config.Logger.Debugf(ctx, "filesystem %q is not provisioned, not resizing", tags[i].Id())
+			continue
+		}
+		filesystem, err := filesystemFromParams(result.Result)
+		if err != nil {
+			return errors.Annotate(err, "getting filesystem info")
+		}
+		provisioned = append(provisioned, filesystem.Tag)
+		filesystems[filesystem.Tag] = filesystem
+	}
+	if len(provisioned) == 0 {
+		return nil
+	}
+	filesystemParams, err := filesystemParams(ctx, deps, provisioned)
+	if err != nil {
+		return errors.Annotate(err, "getting filesystem params")
+	}
+	ops := make([]scheduleOp, 0, len(filesystemParams))
+	for _, p := range filesystemParams {
+		filesystem := filesystems[p.Tag]
+		if p.Size <= filesystem.Size {
+			continue
+		}
+		op := &resizeFilesystemOp{
+			provider:   p.Provider,
+			filesystem: filesystem,
+			args: storage.ResizeFilesystemParams{
+				Tag:          p.Tag,
+				Volume:       filesystem.Volume,
+				FilesystemId: filesystem.ProviderId,
+				Size:         p.Size,
+			},
+		}
+		// Replace any pending resize of the filesystem, as the
+		// requested size may have changed.
+		deps.schedule.Remove(op.key())
+		ops = append(ops, op)
+	}
+	scheduleOperations(deps, ops...)
+	return nil
+}
+
 // filesystemAttachmentsChanged is called when the lifecycle states of the filesystem
 // attachments with the provided IDs have been seen to have changed.
 func filesystemAttachmentsChanged(ctx context.Context, deps *dependencies, watcherIds []watcher.MachineStorageID) error {
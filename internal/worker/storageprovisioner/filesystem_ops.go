@@ -5,6 +5,7 @@ package storageprovisioner
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 
 	"github.com/juju/errors"
@@ -385,6 +386,121 @@ func detachFilesystems(ctx context.Context, deps *dependencies, ops map[params.M
 	return nil
 }
 
+// resizeFilesystems grows filesystems to the sizes specified in the
+// operations.
+func resizeFilesystems(ctx context.Context, deps *dependencies, ops map[names.FilesystemTag]*resizeFilesystemOp) error {
+	deps.config.Logger.Tracef(ctx, "resizeFilesystems: %#v", ops)
+	// Volume-backed filesystems are managed by the storage provisioner
+	// itself, rather than by the provider's filesystem source.
+	type sourceKey struct {
+		provider storage.ProviderType
+		managed  bool
+	}
+	paramsBySource := make(map[sourceKey][]storage.ResizeFilesystemParams)
+	for _, op := range ops {
+		args := op.args
+		args.Attachments = nil
+		for _, attachment := range deps.filesystemAttachments {
+			if attachment.Filesystem != args.Tag {
+				continue
+			}
+			args.Attachments = append(args.Attachments, storage.FilesystemAttachmentParams{
+				AttachmentParams: storage.AttachmentParams{
+					Provider: op.provider,
+					Machine:  attachment.Machine,
+					ReadOnly: attachment.ReadOnly,
+				},
+				Filesystem:           args.Tag,
+				FilesystemProviderId: args.FilesystemId,
+				Path:                 attachment.Path,
+			})
+		}
+		key := sourceKey{
+			provider: op.provider,
+			managed:  args.Volume != (names.VolumeTag{}),
+		}
+		paramsBySource[key] = append(paramsBySource[key], args)
+	}
+	var reschedule []scheduleOp
+	var filesystems []storage.Filesystem
+	var statuses []params.EntityStatusArgs
+	for key, resizeParams := range paramsBySource {
+		sourceName := string(key.provider)
+		source := deps.managedFilesystemSource
+		if !key.managed {
+			var err error
+			source, err = filesystemSource(
+				deps.config.StorageDir, sourceName, key.provider, deps.config.Registry,
+			)
+			if errors.Cause(err) == errNonDynamic || errors.Is(err, errors.NotFound) {
+				// The filesystem is handled by another
+				// storage provisioner.
+				continue
+			} else if err != nil {
+				return errors.Annotate(err, "getting filesystem source")
+			}
+		}
+		resizer, ok := source.(storage.FilesystemResizer)
+		if !ok {
+			for _, p := range resizeParams {
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    p.Tag.String(),
+					Status: status.Error.String(),
+					Info:   fmt.Sprintf("storage provider %q does not support resizing filesystems", sourceName),
+				})
+			}
+			continue
+		}
+		deps.config.Logger.Debugf(ctx, "resizing filesystems: %v", resizeParams)
+		results, err := resizer.ResizeFilesystems(ctx, resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing filesystems from source %q", sourceName)
+		}
+		for i, result := range results {
+			p := resizeParams[i]
+			statuses = append(statuses, params.EntityStatusArgs{
+				Tag:    p.Tag.String(),
+				Status: status.Attached.String(),
+			})
+			if result.Error != nil {
+				// Reschedule the filesystem resize.
+				reschedule = append(reschedule, ops[p.Tag])
+				statuses[len(statuses)-1].Info = result.Error.Error()
+				deps.config.Logger.Warningf(ctx,
+					"failed to resize %s: %v",
+					names.ReadableString(p.Tag),
+					result.Error,
+				)
+				continue
+			}
+			filesystem := ops[p.Tag].filesystem
+			filesystem.Size = result.FilesystemInfo.Size
+			filesystems = append(filesystems, filesystem)
+		}
+	}
+	scheduleOperations(deps, reschedule...)
+	setStatus(ctx, deps, statuses)
+	if len(filesystems) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Filesystems.SetFilesystemInfo(ctx, filesystemsFromStorage(filesystems))
+	if err != nil {
+		return errors.Annotate(err, "publishing filesystems to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"publishing filesystem %s to state: %v",
+				filesystems[i].Tag.Id(),
+				result.Error,
+			)
+			continue
+		}
+		updateFilesystem(ctx, deps, filesystems[i])
+	}
+	return nil
+}
+
 // filesystemParamsBySource separates the filesystem parameters by filesystem source.
 func filesystemParamsBySource(
 	baseStorageDir string,
@@ -593,6 +709,17 @@ func (op *attachFilesystemOp) key() any {
 	}
 }
 
+type resizeFilesystemOp struct {
+	exponentialBackoff
+	provider   storage.ProviderType
+	filesystem storage.Filesystem
+	args       storage.ResizeFilesystemParams
+}
+
+func (op *resizeFilesystemOp) key() any {
+	return resizeOpKey{tag: op.args.Tag}
+}
+
 type detachFilesystemOp struct {
 	exponentialBackoff
 	args storage.FilesystemAttachmentParams
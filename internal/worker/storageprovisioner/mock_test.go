@@ -122,6 +122,8 @@ func (w *mockAttachmentPlansWatcher) Changes() watcher.MachineStorageIDsChannel
 
 type mockVolumeAccessor struct {
 	volumesWatcher         *mockStringsWatcher
+	resizesWatcher         *mockStringsWatcher
+	snapshotsWatcher       *mockStringsWatcher
 	attachmentsWatcher     *mockAttachmentsWatcher
 	attachmentPlansWatcher *mockAttachmentPlansWatcher
 	blockDevicesWatcher    *mockNotifyWatcher
@@ -133,6 +135,7 @@ type mockVolumeAccessor struct {
 	setVolumeInfo               func([]params.Volume) ([]params.ErrorResult, error)
 	setVolumeAttachmentInfo     func([]params.VolumeAttachment) ([]params.ErrorResult, error)
 	createVolumeAttachmentPlans func([]params.VolumeAttachmentPlan) ([]params.ErrorResult, error)
+	setStorageSnapshotResults   func([]params.StorageSnapshotResult) ([]params.ErrorResult, error)
 }
 
 func (m *mockVolumeAccessor) provisionVolume(tag names.VolumeTag) params.Volume {
@@ -150,6 +153,14 @@ func (w *mockVolumeAccessor) WatchVolumes(context.Context, names.Tag) (watcher.S
 	return w.volumesWatcher, nil
 }
 
+func (w *mockVolumeAccessor) WatchVolumeResizes(context.Context, names.Tag) (watcher.StringsWatcher, error) {
+	return w.resizesWatcher, nil
+}
+
+func (w *mockVolumeAccessor) WatchStorageSnapshots(context.Context, names.Tag) (watcher.StringsWatcher, error) {
+	return w.snapshotsWatcher, nil
+}
+
 func (w *mockVolumeAccessor) WatchVolumeAttachments(context.Context, names.Tag) (watcher.MachineStorageIDsWatcher, error) {
 	return w.attachmentsWatcher, nil
 }
@@ -283,6 +294,31 @@ func (v *mockVolumeAccessor) SetVolumeAttachmentInfo(_ context.Context, volumeAt
 	return make([]params.ErrorResult, len(volumeAttachments)), nil
 }
 
+func (v *mockVolumeAccessor) StorageSnapshotParams(_ context.Context, ids []string) ([]params.StorageSnapshotParamsResult, error) {
+	var result []params.StorageSnapshotParamsResult
+	for _, id := range ids {
+		result = append(result, params.StorageSnapshotParamsResult{
+			Result: params.StorageSnapshotParams{
+				Id:        id,
+				VolumeTag: "volume-1",
+				VolumeId:  "vol-1",
+				Provider:  "dummy",
+				Tags: map[string]string{
+					"very": "fancy",
+				},
+			},
+		})
+	}
+	return result, nil
+}
+
+func (v *mockVolumeAccessor) SetStorageSnapshotResults(_ context.Context, results []params.StorageSnapshotResult) ([]params.ErrorResult, error) {
+	if v.setStorageSnapshotResults != nil {
+		return v.setStorageSnapshotResults(results)
+	}
+	return make([]params.ErrorResult, len(results)), nil
+}
+
 func (v *mockVolumeAccessor) CreateVolumeAttachmentPlans(_ context.Context, volumeAttachmentPlans []params.VolumeAttachmentPlan) ([]params.ErrorResult, error) {
 	if v.createVolumeAttachmentPlans != nil {
 		return v.createVolumeAttachmentPlans(volumeAttachmentPlans)
@@ -305,6 +341,8 @@ func (v *mockVolumeAccessor) VolumeAttachmentPlans(context.Context, []params.Mac
 func newMockVolumeAccessor() *mockVolumeAccessor {
 	return &mockVolumeAccessor{
 		volumesWatcher:         newMockStringsWatcher(),
+		resizesWatcher:         newMockStringsWatcher(),
+		snapshotsWatcher:       newMockStringsWatcher(),
 		attachmentsWatcher:     newMockAttachmentsWatcher(),
 		attachmentPlansWatcher: newMockAttachmentPlansWatcher(),
 		blockDevicesWatcher:    newMockNotifyWatcher(),
@@ -318,6 +356,7 @@ func newMockVolumeAccessor() *mockVolumeAccessor {
 type mockFilesystemAccessor struct {
 	testhelpers.Stub
 	filesystemsWatcher             *mockStringsWatcher
+	resizesWatcher                 *mockStringsWatcher
 	attachmentsWatcher             *mockAttachmentsWatcher
 	provisionedMachines            map[string]instance.Id
 	provisionedMachinesFilesystems map[string]params.Filesystem
@@ -344,6 +383,10 @@ func (w *mockFilesystemAccessor) WatchFilesystems(_ context.Context, tag names.T
 	return w.filesystemsWatcher, nil
 }
 
+func (w *mockFilesystemAccessor) WatchFilesystemResizes(context.Context, names.Tag) (watcher.StringsWatcher, error) {
+	return w.resizesWatcher, nil
+}
+
 func (w *mockFilesystemAccessor) WatchFilesystemAttachments(context.Context, names.Tag) (watcher.MachineStorageIDsWatcher, error) {
 	return w.attachmentsWatcher, nil
 }
@@ -453,6 +496,7 @@ func (f *mockFilesystemAccessor) SetFilesystemAttachmentInfo(_ context.Context,
 func newMockFilesystemAccessor() *mockFilesystemAccessor {
 	return &mockFilesystemAccessor{
 		filesystemsWatcher:             newMockStringsWatcher(),
+		resizesWatcher:                 newMockStringsWatcher(),
 		attachmentsWatcher:             newMockAttachmentsWatcher(),
 		provisionedMachines:            make(map[string]instance.Id),
 		provisionedFilesystems:         make(map[string]params.Filesystem),
@@ -540,6 +584,9 @@ type dummyProvider struct {
 	attachFilesystemsFunc        func([]storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error)
 	detachVolumesFunc            func([]storage.VolumeAttachmentParams) ([]error, error)
 	detachFilesystemsFunc        func([]storage.FilesystemAttachmentParams) ([]error, error)
+	resizeVolumesFunc            func([]storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error)
+	resizeFilesystemsFunc        func([]storage.ResizeFilesystemParams) ([]storage.ResizeFilesystemsResult, error)
+	createVolumeSnapshotsFunc    func([]storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error)
 	destroyVolumesFunc           func([]string) ([]error, error)
 	releaseVolumesFunc           func([]string) ([]error, error)
 	destroyFilesystemsFunc       func([]string) ([]error, error)
@@ -661,6 +708,39 @@ func (s *dummyVolumeSource) DetachVolumes(ctx context.Context, params []storage.
 	return make([]error, len(params)), nil
 }
 
+// ResizeVolumes grows volumes to their requested size.
+func (s *dummyVolumeSource) ResizeVolumes(ctx context.Context, params []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+	if s.provider.resizeVolumesFunc != nil {
+		return s.provider.resizeVolumesFunc(params)
+	}
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId: p.VolumeId,
+			Size:     p.Size,
+		}
+	}
+	return results, nil
+}
+
+func (s *dummyVolumeSource) CreateVolumeSnapshots(ctx context.Context, params []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	if s.provider.createVolumeSnapshotsFunc != nil {
+		return s.provider.createVolumeSnapshotsFunc(params)
+	}
+	results := make([]storage.CreateVolumeSnapshotsResult, len(params))
+	for i, p := range params {
+		results[i].Snapshot = &storage.VolumeSnapshot{
+			SnapshotId: "snap-" + p.Id,
+			Size:       1024,
+		}
+	}
+	return results, nil
+}
+
+func (s *dummyVolumeSource) DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	return make([]error, len(snapshotIds)), nil
+}
+
 func (s *dummyFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
 	if s.provider != nil && s.provider.validateFilesystemParamsFunc != nil {
 		return s.provider.validateFilesystemParamsFunc(params)
@@ -740,6 +820,21 @@ func (s *dummyFilesystemSource) DetachFilesystems(ctx context.Context, params []
 	return make([]error, len(params)), nil
 }
 
+// ResizeFilesystems grows filesystems to their requested size.
+func (s *dummyFilesystemSource) ResizeFilesystems(ctx context.Context, params []storage.ResizeFilesystemParams) ([]storage.ResizeFilesystemsResult, error) {
+	if s.provider.resizeFilesystemsFunc != nil {
+		return s.provider.resizeFilesystemsFunc(params)
+	}
+	results := make([]storage.ResizeFilesystemsResult, len(params))
+	for i, p := range params {
+		results[i].FilesystemInfo = &storage.FilesystemInfo{
+			ProviderId: p.FilesystemId,
+			Size:       p.Size,
+		}
+	}
+	return results, nil
+}
+
 type mockManagedFilesystemSource struct {
 	blockDevices        map[names.VolumeTag]blockdevice.BlockDevice
 	filesystems         map[names.FilesystemTag]storage.Filesystem
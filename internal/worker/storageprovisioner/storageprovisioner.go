@@ -40,6 +40,10 @@ type VolumeAccessor interface {
 	// provisioner is responsible for.
 	WatchVolumes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error)
 
+	// WatchVolumeResizes watches for volumes that this storage provisioner
+	// is responsible for, which need to be grown to their requested size.
+	WatchVolumeResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error)
+
 	// WatchVolumeAttachments watches for changes to volume attachments
 	// that this storage provisioner is responsible for.
 	WatchVolumeAttachments(ctx context.Context, scope names.Tag) (watcher.MachineStorageIDsWatcher, error)
@@ -84,6 +88,18 @@ type VolumeAccessor interface {
 	CreateVolumeAttachmentPlans(ctx context.Context, volumeAttachmentPlans []params.VolumeAttachmentPlan) ([]params.ErrorResult, error)
 	RemoveVolumeAttachmentPlan(context.Context, []params.MachineStorageId) ([]params.ErrorResult, error)
 	SetVolumeAttachmentPlanBlockInfo(ctx context.Context, volumeAttachmentPlans []params.VolumeAttachmentPlan) ([]params.ErrorResult, error)
+
+	// WatchStorageSnapshots watches for snapshots of volumes that this
+	// storage provisioner is responsible for, which are ready to be taken.
+	WatchStorageSnapshots(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error)
+
+	// StorageSnapshotParams returns the parameters for taking the storage
+	// snapshots with the specified ids.
+	StorageSnapshotParams(context.Context, []string) ([]params.StorageSnapshotParamsResult, error)
+
+	// SetStorageSnapshotResults records the outcome of taking storage
+	// snapshots.
+	SetStorageSnapshotResults(context.Context, []params.StorageSnapshotResult) ([]params.ErrorResult, error)
 }
 
 // FilesystemAccessor defines an interface used to allow a storage provisioner
@@ -93,6 +109,11 @@ type FilesystemAccessor interface {
 	// storage provisioner is responsible for.
 	WatchFilesystems(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error)
 
+	// WatchFilesystemResizes watches for filesystems that this storage
+	// provisioner is responsible for, which need to be grown to their
+	// requested size.
+	WatchFilesystemResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error)
+
 	// WatchFilesystemAttachments watches for changes to filesystem attachments
 	// that this storage provisioner is responsible for.
 	WatchFilesystemAttachments(ctx context.Context, scope names.Tag) (watcher.MachineStorageIDsWatcher, error)
@@ -210,6 +231,9 @@ func (w *storageProvisioner) loop() error {
 		volumeAttachmentsChanges     watcher.MachineStorageIDsChannel
 		volumeAttachmentPlansChanges watcher.MachineStorageIDsChannel
 		filesystemAttachmentsChanges watcher.MachineStorageIDsChannel
+		volumeResizesChanges         watcher.StringsChannel
+		filesystemResizesChanges     watcher.StringsChannel
+		storageSnapshotsChanges      watcher.StringsChannel
 		machineBlockDevicesChanges   <-chan struct{}
 	)
 	machineChanges := make(chan names.MachineTag)
@@ -297,6 +321,33 @@ func (w *storageProvisioner) loop() error {
 	}
 	filesystemAttachmentsChanges = filesystemAttachmentsWatcher.Changes()
 
+	volumeResizesWatcher, err := w.config.Volumes.WatchVolumeResizes(ctx, w.config.Scope)
+	if err != nil {
+		return errors.Annotate(err, "watching volume resizes")
+	}
+	if err := w.catacomb.Add(volumeResizesWatcher); err != nil {
+		return errors.Trace(err)
+	}
+	volumeResizesChanges = volumeResizesWatcher.Changes()
+
+	filesystemResizesWatcher, err := w.config.Filesystems.WatchFilesystemResizes(ctx, w.config.Scope)
+	if err != nil {
+		return errors.Annotate(err, "watching filesystem resizes")
+	}
+	if err := w.catacomb.Add(filesystemResizesWatcher); err != nil {
+		return errors.Trace(err)
+	}
+	filesystemResizesChanges = filesystemResizesWatcher.Changes()
+
+	storageSnapshotsWatcher, err := w.config.Volumes.WatchStorageSnapshots(ctx, w.config.Scope)
+	if err != nil {
+		return errors.Annotate(err, "watching storage snapshots")
+	}
+	if err := w.catacomb.Add(storageSnapshotsWatcher); err != nil {
+		return errors.Trace(err)
+	}
+	storageSnapshotsChanges = storageSnapshotsWatcher.Changes()
+
 	for {
 		// Check if block devices need to be refreshed.
 		if err := processPendingVolumeBlockDevices(ctx, &deps); err != nil {
@@ -363,6 +414,27 @@ func (w *storageProvisioner) loop() error {
 			if err := filesystemAttachmentsChanged(ctx, &deps, changes); err != nil {
 				return errors.Trace(err)
 			}
+		case changes, ok := <-volumeResizesChanges:
+			if !ok {
+				return errors.New("volume resizes watcher closed")
+			}
+			if err := volumeResizesChanged(ctx, &deps, changes); err != nil {
+				return errors.Trace(err)
+			}
+		case changes, ok := <-filesystemResizesChanges:
+			if !ok {
+				return errors.New("filesystem resizes watcher closed")
+			}
+			if err := filesystemResizesChanged(ctx, &deps, changes); err != nil {
+				return errors.Trace(err)
+			}
+		case changes, ok := <-storageSnapshotsChanges:
+			if !ok {
+				return errors.New("storage snapshots watcher closed")
+			}
+			if err := storageSnapshotsChanged(ctx, &deps, changes); err != nil {
+				return errors.Trace(err)
+			}
 		case _, ok := <-machineBlockDevicesChanges:
 			if !ok {
 				return errors.New("machine block devices watcher closed")
@@ -440,6 +512,9 @@ func processSchedule(ctx context.Context, deps *dependencies) error {
 	removeFilesystemOps := make(map[names.FilesystemTag]*removeFilesystemOp)
 	attachFilesystemOps := make(map[params.MachineStorageId]*attachFilesystemOp)
 	detachFilesystemOps := make(map[params.MachineStorageId]*detachFilesystemOp)
+	resizeVolumeOps := make(map[names.VolumeTag]*resizeVolumeOp)
+	resizeFilesystemOps := make(map[names.FilesystemTag]*resizeFilesystemOp)
+	createVolumeSnapshotOps := make(map[string]*createVolumeSnapshotOp)
 	for _, item := range ready {
 		op := item.(scheduleOp)
 		key := op.key()
@@ -460,6 +535,12 @@ func processSchedule(ctx context.Context, deps *dependencies) error {
 			attachFilesystemOps[key.(params.MachineStorageId)] = op
 		case *detachFilesystemOp:
 			detachFilesystemOps[key.(params.MachineStorageId)] = op
+		case *resizeVolumeOp:
+			resizeVolumeOps[op.args.Tag] = op
+		case *resizeFilesystemOp:
+			resizeFilesystemOps[op.args.Tag] = op
+		case *createVolumeSnapshotOp:
+			createVolumeSnapshotOps[op.args.Id] = op
 		}
 	}
 	if len(removeVolumeOps) > 0 {
@@ -502,6 +583,21 @@ func processSchedule(ctx context.Context, deps *dependencies) error {
 			return errors.Annotate(err, "attaching filesystems")
 		}
 	}
+	if len(resizeVolumeOps) > 0 {
+		if err := resizeVolumes(ctx, deps, resizeVolumeOps); err != nil {
+			return errors.Annotate(err, "resizing volumes")
+		}
+	}
+	if len(resizeFilesystemOps) > 0 {
+		if err := resizeFilesystems(ctx, deps, resizeFilesystemOps); err != nil {
+			return errors.Annotate(err, "resizing filesystems")
+		}
+	}
+	if len(createVolumeSnapshotOps) > 0 {
+		if err := createVolumeSnapshots(ctx, deps, createVolumeSnapshotOps); err != nil {
+			return errors.Annotate(err, "creating volume snapshots")
+		}
+	}
 	return nil
 }
 
@@ -2479,3 +2479,228 @@ func assertNoEvent(c *tc.C, ch <-chan any, event string) {
 	case <-time.After(coretesting.ShortWait):
 	}
 }
+
+func (s *storageProvisionerSuite) TestResizeVolume(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+
+	resizedChan := make(chan any, 1)
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+		resizedChan <- args
+		return []storage.ResizeVolumesResult{{
+			VolumeInfo: &storage.VolumeInfo{Size: 2048},
+		}}, nil
+	}
+
+	volumeInfoSet := make(chan any, 1)
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.resizesWatcher.changes <- []string{"1"}
+
+	resized := waitChannel(c, resizedChan, "waiting for volume to be resized")
+	c.Assert(resized, tc.DeepEquals, []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "vol-1",
+		Size:     1024,
+	}})
+
+	volumes := waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(volumes, tc.DeepEquals, []params.Volume{{
+		VolumeTag: "volume-1",
+		Info: params.VolumeInfo{
+			ProviderId: "vol-1",
+			SizeMiB:    2048,
+		},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestCreateVolumeSnapshot(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+
+	snapshotChan := make(chan any, 1)
+	s.provider.createVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+		snapshotChan <- args
+		return []storage.CreateVolumeSnapshotsResult{{
+			Snapshot: &storage.VolumeSnapshot{SnapshotId: "snap-1", Size: 1024},
+		}}, nil
+	}
+
+	snapshotResultsSet := make(chan any, 1)
+	volumeAccessor.setStorageSnapshotResults = func(results []params.StorageSnapshotResult) ([]params.ErrorResult, error) {
+		snapshotResultsSet <- results
+		return make([]params.ErrorResult, len(results)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.snapshotsWatcher.changes <- []string{"1"}
+
+	snapshots := waitChannel(c, snapshotChan, "waiting for volume snapshot to be created")
+	c.Assert(snapshots, tc.DeepEquals, []storage.VolumeSnapshotParams{{
+		Id:           "1",
+		Volume:       names.NewVolumeTag("1"),
+		VolumeId:     "vol-1",
+		ResourceTags: map[string]string{"very": "fancy"},
+	}})
+
+	results := waitChannel(c, snapshotResultsSet, "waiting for storage snapshot results to be set")
+	c.Assert(results, tc.DeepEquals, []params.StorageSnapshotResult{{
+		Id:         "1",
+		ProviderId: "snap-1",
+		SizeMiB:    1024,
+	}})
+}
+
+func (s *storageProvisionerSuite) TestCreateVolumeSnapshotFailed(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+
+	s.provider.createVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+		return []storage.CreateVolumeSnapshotsResult{{
+			Error: errors.New("snapshot quota exceeded"),
+		}}, nil
+	}
+
+	snapshotResultsSet := make(chan any, 1)
+	volumeAccessor.setStorageSnapshotResults = func(results []params.StorageSnapshotResult) ([]params.ErrorResult, error) {
+		snapshotResultsSet <- results
+		return make([]params.ErrorResult, len(results)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	// Failed snapshots are reported rather than retried, so that the
+	// charm's storage-snapshot-post hook can resume normal operation.
+	volumeAccessor.snapshotsWatcher.changes <- []string{"1"}
+
+	results := waitChannel(c, snapshotResultsSet, "waiting for storage snapshot results to be set")
+	c.Assert(results, tc.DeepEquals, []params.StorageSnapshotResult{{
+		Id:    "1",
+		Error: &params.Error{Message: "snapshot quota exceeded"},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumeNotProvisioned(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+
+	resizedChan := make(chan any, 1)
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+		resizedChan <- args
+		return make([]storage.ResizeVolumesResult, len(args)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	// Volumes that have not been provisioned are created with their
+	// requested size, so there is nothing to resize.
+	volumeAccessor.resizesWatcher.changes <- []string{"1"}
+	assertNoEvent(c, resizedChan, "volume resized")
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumeRetry(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+
+	// mockFunc's After will progress the current time by the specified
+	// duration and signal the channel immediately.
+	clock := &mockClock{}
+	var resizeVolumeTimes []time.Time
+
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+		resizeVolumeTimes = append(resizeVolumeTimes, clock.Now())
+		if len(resizeVolumeTimes) < 3 {
+			return []storage.ResizeVolumesResult{{Error: errors.New("badness")}}, nil
+		}
+		return []storage.ResizeVolumesResult{{
+			VolumeInfo: &storage.VolumeInfo{Size: args[0].Size},
+		}}, nil
+	}
+
+	volumeInfoSet := make(chan any, 1)
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, clock: clock, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.resizesWatcher.changes <- []string{"1"}
+	waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(resizeVolumeTimes, tc.HasLen, 3)
+
+	delays := make([]time.Duration, len(resizeVolumeTimes)-1)
+	for i := range resizeVolumeTimes[1:] {
+		delays[i] = resizeVolumeTimes[i+1].Sub(resizeVolumeTimes[i])
+	}
+	c.Assert(delays, tc.DeepEquals, []time.Duration{
+		30 * time.Second,
+		1 * time.Minute,
+	})
+
+	c.Assert(args.statusSetter.args, tc.DeepEquals, []params.EntityStatusArgs{
+		{Tag: "volume-1", Status: "attached", Info: "badness"},
+		{Tag: "volume-1", Status: "attached", Info: "badness"},
+		{Tag: "volume-1", Status: "attached"},
+	})
+}
+
+func (s *storageProvisionerSuite) TestResizeFilesystem(c *tc.C) {
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.provisionFilesystem(names.NewFilesystemTag("1"))
+
+	resizedChan := make(chan any, 1)
+	s.provider.resizeFilesystemsFunc = func(args []storage.ResizeFilesystemParams) ([]storage.ResizeFilesystemsResult, error) {
+		resizedChan <- args
+		return []storage.ResizeFilesystemsResult{{
+			FilesystemInfo: &storage.FilesystemInfo{Size: 1024},
+		}}, nil
+	}
+
+	filesystemInfoSet := make(chan any, 1)
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		filesystemInfoSet <- filesystems
+		return make([]params.ErrorResult, len(filesystems)), nil
+	}
+
+	args := &workerArgs{filesystems: filesystemAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.resizesWatcher.changes <- []string{"1"}
+
+	resized := waitChannel(c, resizedChan, "waiting for filesystem to be resized")
+	c.Assert(resized, tc.DeepEquals, []storage.ResizeFilesystemParams{{
+		Tag:          names.NewFilesystemTag("1"),
+		FilesystemId: "fs-1",
+		Size:         1024,
+	}})
+
+	filesystems := waitChannel(c, filesystemInfoSet, "waiting for filesystem info to be set")
+	c.Assert(filesystems, tc.DeepEquals, []params.Filesystem{{
+		FilesystemTag: "filesystem-1",
+		Info: params.FilesystemInfo{
+			ProviderId: "fs-1",
+			SizeMiB:    1024,
+		},
+	}})
+}
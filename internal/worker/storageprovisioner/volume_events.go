@@ -61,6 +61,110 @@ func volumesChanged(ctx context.Context, deps *dependencies, changes []string) e
 	return nil
 }
 
+// volumeResizesChanged is called when the volumes with the provided IDs have
+// been seen to need growing to their requested size.
+func volumeResizesChanged(ctx context.Context, deps *dependencies, changes []string) error {
+	deps.config.Logger.Tracef(ctx, "volumeResizesChanged: %#v", changes)
+	tags := make([]names.VolumeTag, len(changes))
+	for i, change := range changes {
+		tags[i] = names.NewVolumeTag(change)
+	}
+	volumeResults, err := deps.config.Volumes.Volumes(ctx, tags)
+	if err != nil {
+		return errors.Annotatef(err, "getting volume information")
+	}
+	provisioned := make([]names.VolumeTag, 0, len(tags))
+	volumes := make(map[names.VolumeTag]storage.Volume)
+	for i, result := range volumeResults {
+		if result.Error != nil {
+			if !params.IsCodeNotProvisioned(result.Error) {
+				return errors.Annotatef(
+					result.Error, "getting volume information for volume %q", tags[i].Id(),
+				)
+			}
+			// Volumes that are yet to be provisioned will be
+			// created with their requested size.
+			deps.config.Logger.Debugf(ctx, "volume %q is not provisioned, not resizing", tags[i].Id())
+			continue
+		}
+		volume, err := volumeFromParams(result.Result)
+		if err != nil {
+			return errors.Annotate(err, "getting volume info")
+		}
+		provisioned = append(provisioned, volume.Tag)
+		volumes[volume.Tag] = volume
+	}
+	if len(provisioned) == 0 {
+		return nil
+	}
+	volumeParams, err := volumeParams(ctx, deps, provisioned)
+	if err != nil {
+		return errors.Annotate(err, "getting volume params")
+	}
+	ops := make([]scheduleOp, 0, len(volumeParams))
+	for _, p := range volumeParams {
+		volume := volumes[p.Tag]
+		if p.Size <= volume.Size {
+			continue
+		}
+		op := &resizeVolumeOp{
+			provider: p.Provider,
+			volume:   volume,
+			args: storage.ResizeVolumeParams{
+				Tag:      p.Tag,
+				VolumeId: volume.VolumeId,
+				Size:     p.Size,
+			},
+		}
+		// Replace any pending resize of the volume, as the
+		// requested size may have changed.
+		deps.schedule.Remove(op.key())
+		ops = append(ops, op)
+	}
+	scheduleOperations(deps, ops...)
+	return nil
+}
+
+// storageSnapshotsChanged is called when the storage snapshots with the
+// provided IDs have been seen to be ready to be taken.
+func storageSnapshotsChanged(ctx context.Context, deps *dependencies, changes []string) error {
+	deps.config.Logger.Tracef(ctx, "storageSnapshotsChanged: %#v", changes)
+	if len(changes) == 0 {
+		return nil
+	}
+	results, err := deps.config.Volumes.StorageSnapshotParams(ctx, changes)
+	if err != nil {
+		return errors.Annotate(err, "getting storage snapshot params")
+	}
+	ops := make([]scheduleOp, 0, len(results))
+	for i, result := range results {
+		if result.Error != nil {
+			return errors.Annotatef(
+				result.Error, "getting params for storage snapshot %q", changes[i],
+			)
+		}
+		volumeTag, err := names.ParseVolumeTag(result.Result.VolumeTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		op := &createVolumeSnapshotOp{
+			provider: storage.ProviderType(result.Result.Provider),
+			args: storage.VolumeSnapshotParams{
+				Id:           result.Result.Id,
+				Volume:       volumeTag,
+				VolumeId:     result.Result.VolumeId,
+				ResourceTags: result.Result.Tags,
+			},
+		}
+		// The watcher reports all snapshots that are ready to be taken,
+		// so the snapshot may already be scheduled.
+		deps.schedule.Remove(op.key())
+		ops = append(ops, op)
+	}
+	scheduleOperations(deps, ops...)
+	return nil
+}
+
 func sortVolumeAttachmentPlans(
 	ctx context.Context,
 	deps *dependencies, ids []params.MachineStorageId) (alive, dying, dead []params.VolumeAttachmentPlanResult, err error) {
@@ -713,6 +817,7 @@ func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error
 		Attributes:   in.Attributes,
 		ResourceTags: in.Tags,
 		Attachment:   attachment,
+		SnapshotId:   in.SnapshotId,
 	}, nil
 }
 
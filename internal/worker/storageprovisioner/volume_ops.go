@@ -5,6 +5,7 @@ package storageprovisioner
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -428,6 +429,168 @@ func detachVolumes(ctx context.Context, deps *dependencies, ops map[params.Machi
 	return nil
 }
 
+// resizeVolumes grows volumes to the sizes specified in the operations.
+func resizeVolumes(ctx context.Context, deps *dependencies, ops map[names.VolumeTag]*resizeVolumeOp) error {
+	deps.config.Logger.Tracef(ctx, "resizeVolumes: %#v", ops)
+	paramsBySource := make(map[storage.ProviderType][]storage.ResizeVolumeParams)
+	for _, op := range ops {
+		paramsBySource[op.provider] = append(paramsBySource[op.provider], op.args)
+	}
+	var reschedule []scheduleOp
+	var volumes []storage.Volume
+	var statuses []params.EntityStatusArgs
+	for providerType, resizeParams := range paramsBySource {
+		sourceName := string(providerType)
+		volumeSource, err := volumeSource(
+			deps.config.StorageDir, sourceName, providerType, deps.config.Registry,
+		)
+		if errors.Cause(err) == errNonDynamic {
+			// The storage provider does not support dynamic
+			// storage, there's nothing for the provisioner
+			// to do here.
+			continue
+		} else if err != nil {
+			return errors.Annotate(err, "getting volume source")
+		}
+		resizer, ok := volumeSource.(storage.VolumeResizer)
+		if !ok {
+			for _, p := range resizeParams {
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    p.Tag.String(),
+					Status: status.Error.String(),
+					Info:   fmt.Sprintf("storage provider %q does not support resizing volumes", sourceName),
+				})
+			}
+			continue
+		}
+		deps.config.Logger.Debugf(ctx, "resizing volumes: %v", resizeParams)
+		results, err := resizer.ResizeVolumes(ctx, resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing volumes from source %q", sourceName)
+		}
+		for i, result := range results {
+			p := resizeParams[i]
+			statuses = append(statuses, params.EntityStatusArgs{
+				Tag:    p.Tag.String(),
+				Status: status.Attached.String(),
+			})
+			if result.Error != nil {
+				// Reschedule the volume resize.
+				reschedule = append(reschedule, ops[p.Tag])
+				statuses[len(statuses)-1].Info = result.Error.Error()
+				deps.config.Logger.Warningf(ctx,
+					"failed to resize %s: %v",
+					names.ReadableString(p.Tag),
+					result.Error,
+				)
+				continue
+			}
+			volume := ops[p.Tag].volume
+			volume.Size = result.VolumeInfo.Size
+			volumes = append(volumes, volume)
+		}
+	}
+	scheduleOperations(deps, reschedule...)
+	setStatus(ctx, deps, statuses)
+	if len(volumes) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Volumes.SetVolumeInfo(ctx, volumesFromStorage(volumes))
+	if err != nil {
+		return errors.Annotate(err, "publishing volumes to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"publishing volume %s to state: %v",
+				volumes[i].Tag.Id(),
+				result.Error,
+			)
+			continue
+		}
+		updateVolume(ctx, deps, volumes[i])
+	}
+	return nil
+}
+
+// createVolumeSnapshots takes the volume snapshots specified in the
+// operations. A snapshot that the storage provider could not take is
+// recorded as failed, rather than retried, so that the unit waiting on the
+// snapshot can run its storage-snapshot-post hook.
+func createVolumeSnapshots(ctx context.Context, deps *dependencies, ops map[string]*createVolumeSnapshotOp) error {
+	deps.config.Logger.Tracef(ctx, "createVolumeSnapshots: %#v", ops)
+	paramsBySource := make(map[storage.ProviderType][]storage.VolumeSnapshotParams)
+	for _, op := range ops {
+		paramsBySource[op.provider] = append(paramsBySource[op.provider], op.args)
+	}
+	var snapshotResults []params.StorageSnapshotResult
+	for providerType, snapshotParams := range paramsBySource {
+		sourceName := string(providerType)
+		volumeSource, err := volumeSource(
+			deps.config.StorageDir, sourceName, providerType, deps.config.Registry,
+		)
+		if errors.Cause(err) == errNonDynamic {
+			volumeSource = nil
+		} else if err != nil {
+			return errors.Annotate(err, "getting volume source")
+		}
+		snapshotter, ok := volumeSource.(storage.VolumeSnapshotter)
+		if !ok {
+			for _, p := range snapshotParams {
+				snapshotResults = append(snapshotResults, params.StorageSnapshotResult{
+					Id: p.Id,
+					Error: &params.Error{
+						Message: fmt.Sprintf("storage provider %q does not support snapshots", sourceName),
+					},
+				})
+			}
+			continue
+		}
+		deps.config.Logger.Debugf(ctx, "creating volume snapshots: %v", snapshotParams)
+		results, err := snapshotter.CreateVolumeSnapshots(ctx, snapshotParams)
+		if err != nil {
+			return errors.Annotatef(err, "creating volume snapshots from source %q", sourceName)
+		}
+		for i, result := range results {
+			p := snapshotParams[i]
+			if result.Error != nil {
+				deps.config.Logger.Warningf(ctx,
+					"failed to snapshot %s: %v",
+					names.ReadableString(p.Volume),
+					result.Error,
+				)
+				snapshotResults = append(snapshotResults, params.StorageSnapshotResult{
+					Id:    p.Id,
+					Error: &params.Error{Message: result.Error.Error()},
+				})
+				continue
+			}
+			snapshotResults = append(snapshotResults, params.StorageSnapshotResult{
+				Id:         p.Id,
+				ProviderId: result.Snapshot.SnapshotId,
+				SizeMiB:    result.Snapshot.Size,
+			})
+		}
+	}
+	if len(snapshotResults) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Volumes.SetStorageSnapshotResults(ctx, snapshotResults)
+	if err != nil {
+		return errors.Annotate(err, "publishing storage snapshots to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"publishing storage snapshot %s to state: %v",
+				snapshotResults[i].Id,
+				result.Error,
+			)
+		}
+	}
+	return nil
+}
+
 // volumeParamsBySource separates the volume parameters by volume source.
 func volumeParamsBySource(
 	baseStorageDir string,
@@ -474,8 +637,12 @@ func validateVolumeParams(
 ) ([]storage.VolumeParams, []error) {
 	valid := make([]storage.VolumeParams, 0, len(volumeParams))
 	results := make([]error, len(volumeParams))
+	_, canRestore := volumeSource.(storage.VolumeSnapshotter)
 	for i, params := range volumeParams {
 		err := volumeSource.ValidateVolumeParams(params)
+		if err == nil && params.SnapshotId != "" && !canRestore {
+			err = errors.NotSupportedf("creating volumes from snapshots")
+		}
 		if err == nil {
 			valid = append(valid, params)
 		}
@@ -580,6 +747,40 @@ func (op *attachVolumeOp) key() any {
 	}
 }
 
+type resizeVolumeOp struct {
+	exponentialBackoff
+	provider storage.ProviderType
+	volume   storage.Volume
+	args     storage.ResizeVolumeParams
+}
+
+// resizeOpKey is the schedule key for resize operations, which must not
+// clash with the keys of creation and removal operations for the same
+// storage entity.
+type resizeOpKey struct {
+	tag names.Tag
+}
+
+func (op *resizeVolumeOp) key() any {
+	return resizeOpKey{tag: op.args.Tag}
+}
+
+type createVolumeSnapshotOp struct {
+	exponentialBackoff
+	provider storage.ProviderType
+	args     storage.VolumeSnapshotParams
+}
+
+// snapshotOpKey is the schedule key for snapshot operations, which must not
+// clash with the keys of operations on the volume being snapshotted.
+type snapshotOpKey struct {
+	id string
+}
+
+func (op *createVolumeSnapshotOp) key() any {
+	return snapshotOpKey{id: op.args.Id}
+}
+
 type detachVolumeOp struct {
 	exponentialBackoff
 	args storage.VolumeAttachmentParams
@@ -934,6 +934,44 @@ func (c *MockUnitPublicAddressCall) DoAndReturn(f func(context.Context) (string,
 	return c
 }
 
+// RecordHookHistory mocks base method.
+func (m *MockUnit) RecordHookHistory(arg0 context.Context, arg1 []params.HookHistoryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordHookHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordHookHistory indicates an expected call of RecordHookHistory.
+func (mr *MockUnitMockRecorder) RecordHookHistory(arg0, arg1 any) *MockUnitRecordHookHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHookHistory", reflect.TypeOf((*MockUnit)(nil).RecordHookHistory), arg0, arg1)
+	return &MockUnitRecordHookHistoryCall{Call: call}
+}
+
+// MockUnitRecordHookHistoryCall wrap *gomock.Call
+type MockUnitRecordHookHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitRecordHookHistoryCall) Return(arg0 error) *MockUnitRecordHookHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitRecordHookHistoryCall) Do(f func(context.Context, []params.HookHistoryEntry) error) *MockUnitRecordHookHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitRecordHookHistoryCall) DoAndReturn(f func(context.Context, []params.HookHistoryEntry) error) *MockUnitRecordHookHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Refresh mocks base method.
 func (m *MockUnit) Refresh(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -1279,6 +1317,45 @@ func (c *MockUnitStateCall) DoAndReturn(f func(context.Context) (params.UnitStat
 	return c
 }
 
+// StorageSnapshots mocks base method.
+func (m *MockUnit) StorageSnapshots(arg0 context.Context) ([]params.UnitStorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorageSnapshots", arg0)
+	ret0, _ := ret[0].([]params.UnitStorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StorageSnapshots indicates an expected call of StorageSnapshots.
+func (mr *MockUnitMockRecorder) StorageSnapshots(arg0 any) *MockUnitStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageSnapshots", reflect.TypeOf((*MockUnit)(nil).StorageSnapshots), arg0)
+	return &MockUnitStorageSnapshotsCall{Call: call}
+}
+
+// MockUnitStorageSnapshotsCall wrap *gomock.Call
+type MockUnitStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStorageSnapshotsCall) Return(arg0 []params.UnitStorageSnapshot, arg1 error) *MockUnitStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStorageSnapshotsCall) Do(f func(context.Context) ([]params.UnitStorageSnapshot, error)) *MockUnitStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStorageSnapshotsCall) DoAndReturn(f func(context.Context) ([]params.UnitStorageSnapshot, error)) *MockUnitStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Tag mocks base method.
 func (m *MockUnit) Tag() names.UnitTag {
 	m.ctrl.T.Helper()
@@ -1668,6 +1745,45 @@ func (c *MockUnitWatchStorageCall) DoAndReturn(f func(context.Context) (watcher.
 	return c
 }
 
+// WatchStorageSnapshots mocks base method.
+func (m *MockUnit) WatchStorageSnapshots(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchStorageSnapshots", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchStorageSnapshots indicates an expected call of WatchStorageSnapshots.
+func (mr *MockUnitMockRecorder) WatchStorageSnapshots(arg0 any) *MockUnitWatchStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchStorageSnapshots", reflect.TypeOf((*MockUnit)(nil).WatchStorageSnapshots), arg0)
+	return &MockUnitWatchStorageSnapshotsCall{Call: call}
+}
+
+// MockUnitWatchStorageSnapshotsCall wrap *gomock.Call
+type MockUnitWatchStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitWatchStorageSnapshotsCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockUnitWatchStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitWatchStorageSnapshotsCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockUnitWatchStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitWatchStorageSnapshotsCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockUnitWatchStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchTrustConfigSettingsHash mocks base method.
 func (m *MockUnit) WatchTrustConfigSettingsHash(arg0 context.Context) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -75,6 +75,8 @@ type Unit interface {
 	WatchAddressesHash(context.Context) (watcher.StringsWatcher, error)
 	WatchActionNotifications(context.Context) (watcher.StringsWatcher, error)
 	WatchStorage(context.Context) (watcher.StringsWatcher, error)
+	WatchStorageSnapshots(context.Context) (watcher.NotifyWatcher, error)
+	StorageSnapshots(context.Context) ([]params.UnitStorageSnapshot, error)
 	WatchInstanceData(context.Context) (watcher.NotifyWatcher, error)
 
 	// Used by relationer.
@@ -86,6 +88,10 @@ type Unit interface {
 	// Used by operation.Callbacks.
 
 	SetCharm(ctx context.Context, curl string) error
+
+	// Used by the operation executor.
+
+	RecordHookHistory(context.Context, []params.HookHistoryEntry) error
 }
 
 // Application defines the methods on uniter.api.Application.
@@ -175,4 +181,5 @@ type StorageAccessor interface {
 	UnitStorageAttachments(context.Context, names.UnitTag) ([]params.StorageAttachmentId, error)
 	DestroyUnitStorageAttachments(context.Context, names.UnitTag) error
 	RemoveStorageAttachment(context.Context, names.StorageTag, names.UnitTag) error
+	SetStorageSnapshotHookRun(context.Context, names.UnitTag, string) error
 }
@@ -785,6 +785,44 @@ func (c *MockUniterClientRemoveStorageAttachmentCall) DoAndReturn(f func(context
 	return c
 }
 
+// SetStorageSnapshotHookRun mocks base method.
+func (m *MockUniterClient) SetStorageSnapshotHookRun(arg0 context.Context, arg1 names.UnitTag, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotHookRun", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotHookRun indicates an expected call of SetStorageSnapshotHookRun.
+func (mr *MockUniterClientMockRecorder) SetStorageSnapshotHookRun(arg0, arg1, arg2 any) *MockUniterClientSetStorageSnapshotHookRunCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotHookRun", reflect.TypeOf((*MockUniterClient)(nil).SetStorageSnapshotHookRun), arg0, arg1, arg2)
+	return &MockUniterClientSetStorageSnapshotHookRunCall{Call: call}
+}
+
+// MockUniterClientSetStorageSnapshotHookRunCall wrap *gomock.Call
+type MockUniterClientSetStorageSnapshotHookRunCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUniterClientSetStorageSnapshotHookRunCall) Return(arg0 error) *MockUniterClientSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUniterClientSetStorageSnapshotHookRunCall) Do(f func(context.Context, names.UnitTag, string) error) *MockUniterClientSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUniterClientSetStorageSnapshotHookRunCall) DoAndReturn(f func(context.Context, names.UnitTag, string) error) *MockUniterClientSetStorageSnapshotHookRunCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetUnitWorkloadVersion mocks base method.
 func (m *MockUniterClient) SetUnitWorkloadVersion(ctx context.Context, tag names.UnitTag, version string) error {
 	m.ctrl.T.Helper()
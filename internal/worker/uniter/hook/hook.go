@@ -38,6 +38,10 @@ type Info struct {
 	// StorageId is the ID of the storage instance relevant to the hook.
 	StorageId string `yaml:"storage-id,omitempty"`
 
+	// SnapshotId is the ID of the storage snapshot relevant to the hook.
+	// It is only set when Kind indicates a storage snapshot hook.
+	SnapshotId string `yaml:"snapshot-id,omitempty"`
+
 	// DepartingUnit is the name of the unit that goes away. It is only set
 	// when Kind indicates a relation-departed hook.
 	DepartingUnit string `yaml:"departee,omitempty"`
@@ -128,6 +132,14 @@ func (hi Info) Validate() error {
 			return errors.Errorf("invalid storage ID %q", hi.StorageId)
 		}
 		return nil
+	case hooks.StorageSnapshotPre, hooks.StorageSnapshotPost:
+		if !names.IsValidStorage(hi.StorageId) {
+			return errors.Errorf("invalid storage ID %q", hi.StorageId)
+		}
+		if hi.SnapshotId == "" {
+			return errors.Errorf("%q hook requires a snapshot ID", hi.Kind)
+		}
+		return nil
 	case hooks.LeaderElected, hooks.LeaderDeposed:
 		return nil
 	case hooks.SecretRotate, hooks.SecretChanged, hooks.SecretExpired, hooks.SecretRemove:
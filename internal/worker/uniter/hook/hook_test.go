@@ -92,6 +92,10 @@ var validateTests = []struct {
 	{hook.Info{Kind: hooks.StorageAttached}, `invalid storage ID ""`},
 	{hook.Info{Kind: hooks.StorageAttached, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.StorageDetaching, StorageId: "data/0"}, ""},
+	{hook.Info{Kind: hooks.StorageSnapshotPre, SnapshotId: "1"}, `invalid storage ID ""`},
+	{hook.Info{Kind: hooks.StorageSnapshotPre, StorageId: "data/0"}, `"storage-snapshot-pre" hook requires a snapshot ID`},
+	{hook.Info{Kind: hooks.StorageSnapshotPre, StorageId: "data/0", SnapshotId: "1"}, ""},
+	{hook.Info{Kind: hooks.StorageSnapshotPost, StorageId: "data/0", SnapshotId: "1"}, ""},
 	{hook.Info{Kind: hooks.PebbleReady, WorkloadName: "gitlab"}, ""},
 }
 
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"runtime/pprof"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/logger"
@@ -35,6 +37,8 @@ type executor struct {
 	stateOps           *StateOps
 	state              *State
 	acquireMachineLock func(string, string) (func(), error)
+	recordExecution    RecordExecutionFunc
+	clock              clock.Clock
 	logger             logger.Logger
 }
 
@@ -44,6 +48,13 @@ type ExecutorConfig struct {
 	InitialState    State
 	AcquireLock     func(string, string) (func(), error)
 	Logger          logger.Logger
+
+	// RecordExecution, if set, is called with the record of each operation
+	// run which executes charm code.
+	RecordExecution RecordExecutionFunc
+	// Clock is used to time executed operations. It defaults to the wall
+	// clock.
+	Clock clock.Clock
 }
 
 func (e ExecutorConfig) validate() error {
@@ -70,11 +81,17 @@ func NewExecutor(ctx context.Context, unitName string, cfg ExecutorConfig) (Exec
 	} else if err != nil {
 		return nil, err
 	}
+	clk := cfg.Clock
+	if clk == nil {
+		clk = clock.WallClock
+	}
 	return &executor{
 		unitName:           unitName,
 		stateOps:           stateOps,
 		state:              state,
 		acquireMachineLock: cfg.AcquireLock,
+		recordExecution:    cfg.RecordExecution,
+		clock:              clk,
 		logger:             cfg.Logger,
 	}, nil
 }
@@ -151,11 +168,13 @@ func (x *executor) run(ctx context.Context, op Operation, remoteStateChange <-ch
 				}
 			}
 		}()
-		if err := x.do(ctx, op, stepExecute); err != nil {
-			close(done)
+		started := x.clock.Now()
+		err := x.do(ctx, op, stepExecute)
+		close(done)
+		x.record(ctx, op, started)
+		if err != nil {
 			return err
 		}
-		close(done)
 	default:
 		return err
 	}
@@ -190,3 +209,18 @@ func (x *executor) writeState(ctx context.Context, newState State) error {
 	x.state = &newState
 	return nil
 }
+
+// record passes the execution record of the input operation, if it ran
+// charm code, to the configured RecordExecution func.
+func (x *executor) record(ctx context.Context, op Operation, started time.Time) {
+	if x.recordExecution == nil {
+		return
+	}
+	record, ok := executionRecordOf(op)
+	if !ok {
+		return
+	}
+	record.Started = started
+	record.Duration = x.clock.Now().Sub(started)
+	x.recordExecution(ctx, record)
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
@@ -327,6 +328,86 @@ func (s *ExecutorSuite) TestErrSkipExecute(c *tc.C) {
 	c.Assert(executor.State(), tc.DeepEquals, *commit.newState)
 }
 
+func (s *ExecutorSuite) TestRecordsExecution(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	initialState := justInstalledState()
+	s.expectState(c, initialState)
+
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := testclock.NewClock(started)
+	var records []operation.ExecutionRecord
+	executor, err := operation.NewExecutor(c.Context(), "test", operation.ExecutorConfig{
+		StateReadWriter: s.mockStateRW,
+		InitialState:    operation.State{Step: operation.Queued},
+		AcquireLock:     failAcquireLock,
+		Logger:          loggertesting.WrapCheckLog(c),
+		RecordExecution: func(_ context.Context, record operation.ExecutionRecord) {
+			records = append(records, record)
+		},
+		Clock: clock,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	op := &mockRecordedOperation{
+		mockOperation: mockOperation{
+			prepare: newStep(nil, nil),
+			execute: mockStepFunc(func(context.Context, operation.State) (*operation.State, error) {
+				clock.Advance(3 * time.Second)
+				return nil, nil
+			}),
+			commit: newStep(nil, nil),
+		},
+		record: operation.ExecutionRecord{
+			Kind:     operation.ExecutionKindHook,
+			Name:     "config-changed",
+			ExitCode: 1,
+		},
+		recorded: true,
+	}
+
+	err = executor.Run(c.Context(), op, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(records, tc.DeepEquals, []operation.ExecutionRecord{{
+		Kind:     operation.ExecutionKindHook,
+		Name:     "config-changed",
+		Started:  started,
+		Duration: 3 * time.Second,
+		ExitCode: 1,
+	}})
+}
+
+func (s *ExecutorSuite) TestDoesNotRecordUnrecordedExecution(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	initialState := justInstalledState()
+	s.expectState(c, initialState)
+
+	var records []operation.ExecutionRecord
+	executor, err := operation.NewExecutor(c.Context(), "test", operation.ExecutorConfig{
+		StateReadWriter: s.mockStateRW,
+		InitialState:    operation.State{Step: operation.Queued},
+		AcquireLock:     failAcquireLock,
+		Logger:          loggertesting.WrapCheckLog(c),
+		RecordExecution: func(_ context.Context, record operation.ExecutionRecord) {
+			records = append(records, record)
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	op := &mockRecordedOperation{
+		mockOperation: mockOperation{
+			prepare: newStep(nil, nil),
+			execute: newStep(nil, nil),
+			commit:  newStep(nil, nil),
+		},
+	}
+
+	err = executor.Run(c.Context(), op, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(records, tc.HasLen, 0)
+}
+
 func (s *ExecutorSuite) TestValidateStateChange(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -785,3 +866,13 @@ func (op *mockOperation) RemoteStateChanged(snapshot remotestate.Snapshot) {
 		op.remoteStateFunc(snapshot)
 	}
 }
+
+type mockRecordedOperation struct {
+	mockOperation
+	record   operation.ExecutionRecord
+	recorded bool
+}
+
+func (op *mockRecordedOperation) ExecutionRecord() (operation.ExecutionRecord, bool) {
+	return op.record, op.recorded
+}
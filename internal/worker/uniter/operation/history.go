@@ -0,0 +1,101 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package operation
+
+import (
+	stdcontext "context"
+	"os/exec"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/worker/uniter/runner/context"
+)
+
+// These are the kinds of execution recorded in a unit's hook history.
+const (
+	ExecutionKindHook     = "hook"
+	ExecutionKindAction   = "action"
+	ExecutionKindCommands = "commands"
+)
+
+// ExecutionRecord describes a single run of charm code by the uniter, as
+// recorded in the unit's hook history.
+type ExecutionRecord struct {
+	// Kind is one of the ExecutionKind constants.
+	Kind string
+
+	// Name is the hook or action name. It is empty for commands.
+	Name string
+
+	// RelationId is the ID of the relation for relation hooks.
+	RelationId *int
+
+	// Started is when the operation started executing.
+	Started time.Time
+
+	// Duration is how long the operation took to execute.
+	Duration time.Duration
+
+	// ExitCode is the exit code of the process, or -1 if it could not be
+	// started or was killed.
+	ExitCode int
+
+	// Err is the error the execution failed with, if any.
+	Err error
+}
+
+// RecordedOperation is implemented by operations which run charm code, so
+// that the executor can record them in the unit's hook history.
+type RecordedOperation interface {
+	Operation
+
+	// ExecutionRecord returns the details of the charm code run by the
+	// operation when it was executed. Started and Duration are filled in
+	// by the executor. It returns false if no charm code was run.
+	ExecutionRecord() (ExecutionRecord, bool)
+}
+
+// RecordExecutionFunc is called by the executor with the record of each
+// recorded operation it executes.
+type RecordExecutionFunc func(stdcontext.Context, ExecutionRecord)
+
+// executionRecordOf returns the execution record of the first operation
+// implementing RecordedOperation in the input operation's wrapping chain.
+func executionRecordOf(op Operation) (ExecutionRecord, bool) {
+	for op != nil {
+		if recorded, ok := op.(RecordedOperation); ok {
+			return recorded.ExecutionRecord()
+		}
+		wrapped, ok := op.(WrappedOperation)
+		if !ok {
+			break
+		}
+		op = wrapped.WrappedOperation()
+	}
+	return ExecutionRecord{}, false
+}
+
+// executionError returns the error to record for an execution which
+// returned the input error. Reboot requests are not failures.
+func executionError(err error) error {
+	switch errors.Cause(err) {
+	case context.ErrReboot, context.ErrRequeueAndReboot:
+		return nil
+	}
+	return err
+}
+
+// exitCode returns the exit code of the process which resulted in the
+// input error, 0 if there was no error, or -1 if it is not known.
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
@@ -6,6 +6,7 @@ package operation
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/juju/errors"
 
@@ -29,6 +30,7 @@ type runAction struct {
 	name   string
 	runner runner.Runner
 	logger logger.Logger
+	record *ExecutionRecord
 }
 
 // String is part of the Operation interface.
@@ -119,6 +121,7 @@ func (ra *runAction) Execute(ctx context.Context, state State) (*State, error) {
 	handlerType, err := ra.runner.RunAction(ctx, ra.name)
 	close(done)
 	<-wait
+	ra.record = ra.executionRecord(executionError(err))
 
 	if err != nil {
 		// This indicates an actual error -- an action merely failing should
@@ -173,3 +176,47 @@ func continuationKind(state State) Kind {
 		return Continue
 	}
 }
+
+// executionRecord returns the record of the action's execution. Failures
+// of the action itself are not returned by the runner, so the exit code
+// and failure are taken from the action's results.
+func (ra *runAction) executionRecord(err error) *ExecutionRecord {
+	record := &ExecutionRecord{
+		Kind:     ExecutionKindAction,
+		Name:     ra.name,
+		ExitCode: exitCode(err),
+		Err:      err,
+	}
+	hctx := ra.runner.Context()
+	if hctx == nil {
+		return record
+	}
+	data, dataErr := hctx.ActionData()
+	if dataErr != nil || data == nil {
+		return record
+	}
+	switch code := data.ResultsMap["return-code"].(type) {
+	case int:
+		record.ExitCode = code
+	case string:
+		if n, err := strconv.Atoi(code); err == nil {
+			record.ExitCode = n
+		}
+	}
+	if data.Failed && record.Err == nil {
+		message := data.ResultsMessage
+		if message == "" {
+			message = "action failed"
+		}
+		record.Err = errors.New(message)
+	}
+	return record
+}
+
+// ExecutionRecord is part of the RecordedOperation interface.
+func (ra *runAction) ExecutionRecord() (ExecutionRecord, bool) {
+	if ra.record == nil {
+		return ExecutionRecord{}, false
+	}
+	return *ra.record, true
+}
@@ -24,6 +24,7 @@ type runCommands struct {
 
 	runner runner.Runner
 	logger logger.Logger
+	record *ExecutionRecord
 
 	RequiresMachineLock
 }
@@ -72,6 +73,15 @@ func (rc *runCommands) Execute(ctx stdcontext.Context, state State) (*State, err
 	}
 
 	response, err := rc.runner.RunCommands(ctx, rc.args.Commands)
+	recordErr := executionError(err)
+	rc.record = &ExecutionRecord{
+		Kind:     ExecutionKindCommands,
+		ExitCode: exitCode(recordErr),
+		Err:      recordErr,
+	}
+	if response != nil {
+		rc.record.ExitCode = response.Code
+	}
 	switch err {
 	case context.ErrRequeueAndReboot:
 		rc.logger.Warningf(ctx, "cannot requeue external commands")
@@ -98,3 +108,11 @@ func (rc *runCommands) Commit(ctx stdcontext.Context, state State) (*State, erro
 // of the operation.
 func (rc *runCommands) RemoteStateChanged(snapshot remotestate.Snapshot) {
 }
+
+// ExecutionRecord is part of the RecordedOperation interface.
+func (rc *runCommands) ExecutionRecord() (ExecutionRecord, bool) {
+	if rc.record == nil {
+		return ExecutionRecord{}, false
+	}
+	return *rc.record, true
+}
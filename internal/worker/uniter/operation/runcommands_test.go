@@ -172,6 +172,29 @@ func (s *RunCommandsSuite) TestExecuteSuccess(c *tc.C) {
 	c.Assert(*sendResponse.gotErr, tc.ErrorIsNil)
 }
 
+func (s *RunCommandsSuite) TestExecuteRecordsExitCode(c *tc.C) {
+	runnerFactory := NewRunCommandsRunnerFactory(
+		&utilexec.ExecResponse{Code: 222}, nil,
+	)
+	callbacks := &RunCommandsCallbacks{}
+	factory := newOpFactory(c, runnerFactory, callbacks)
+	sendResponse := &MockSendResponse{}
+	op, err := factory.NewCommands(someCommandArgs, sendResponse.Call)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = op.Prepare(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = op.Execute(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	record, ok := op.(operation.RecordedOperation).ExecutionRecord()
+	c.Assert(ok, tc.IsTrue)
+	c.Check(record, tc.DeepEquals, operation.ExecutionRecord{
+		Kind:     operation.ExecutionKindCommands,
+		ExitCode: 222,
+	})
+}
+
 func (s *RunCommandsSuite) TestCommit(c *tc.C) {
 	factory := newOpFactory(c, nil, nil)
 	sendResponse := func(*utilexec.ExecResponse, error) bool { panic("not expected") }
@@ -33,6 +33,7 @@ type runHook struct {
 	logger logger.Logger
 
 	hookFound bool
+	record    *ExecutionRecord
 
 	RequiresMachineLock
 }
@@ -153,6 +154,9 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 
 	handlerType, err := rh.runner.RunHook(ctx, rh.name)
 	cause := errors.Cause(err)
+	if !charmrunner.IsMissingHookError(cause) {
+		rh.record = rh.executionRecord(executionError(err))
+	}
 	switch {
 	case charmrunner.IsMissingHookError(cause):
 		rh.hookFound = false
@@ -175,6 +179,19 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 			Hook:     &rh.info,
 			HookStep: &step,
 		}.apply(state), runner.ErrTerminated
+	case cause == runner.ErrHookTimedOut:
+		// Leave the hook pending, as for any other hook failure, but record
+		// that it timed out so that it can be reported and retried as such.
+		rh.logger.Errorf(ctx, "hook %q (via %s) timed out: %v", rh.name, handlerType, err)
+		rh.callbacks.NotifyHookFailed(rh.name, rh.runner.Context())
+		step = Pending
+		return stateChange{
+			Kind:         RunHook,
+			Step:         step,
+			Hook:         &rh.info,
+			HookStep:     &step,
+			HookTimedOut: true,
+		}.apply(state), ErrHookFailed
 	case err == nil:
 	default:
 		rh.logger.Errorf(ctx, "hook %q (via %s) failed: %v", rh.name, handlerType, err)
@@ -351,3 +368,25 @@ func (rh *runHook) Commit(ctx stdcontext.Context, state State) (*State, error) {
 // of the operation.
 func (rh *runHook) RemoteStateChanged(snapshot remotestate.Snapshot) {
 }
+
+func (rh *runHook) executionRecord(err error) *ExecutionRecord {
+	record := &ExecutionRecord{
+		Kind:     ExecutionKindHook,
+		Name:     rh.name,
+		ExitCode: exitCode(err),
+		Err:      err,
+	}
+	if rh.info.Kind.IsRelation() {
+		relationId := rh.info.RelationId
+		record.RelationId = &relationId
+	}
+	return record
+}
+
+// ExecutionRecord is part of the RecordedOperation interface.
+func (rh *runHook) ExecutionRecord() (ExecutionRecord, bool) {
+	if rh.record == nil {
+		return ExecutionRecord{}, false
+	}
+	return *rh.record, true
+}
@@ -307,6 +307,82 @@ func (s *RunHookSuite) TestExecuteTerminated(c *tc.C) {
 	c.Assert(callbacks.MockNotifyHookCompleted.gotName, tc.IsNil)
 }
 
+func (s *RunHookSuite) TestExecuteTimedOut(c *tc.C) {
+	runErr := errors.Annotate(runner.ErrHookTimedOut, "killed after 1m0s")
+	op, callbacks, runnerFactory := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, runErr)
+	_, err := op.Prepare(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	newState, err := op.Execute(c.Context(), operation.State{})
+	c.Assert(err, tc.Equals, operation.ErrHookFailed)
+
+	s.assertStateMatches(c, newState, operation.RunHook, operation.Pending, hooks.ConfigChanged)
+	c.Check(newState.HookTimedOut, tc.IsTrue)
+
+	c.Assert(*runnerFactory.MockNewHookRunner.runner.MockRunHook.gotName, tc.Equals, "config-changed")
+	c.Assert(*callbacks.MockNotifyHookFailed.gotName, tc.Equals, "config-changed")
+	c.Assert(callbacks.MockNotifyHookCompleted.gotName, tc.IsNil)
+}
+
+func (s *RunHookSuite) TestExecuteRecordsFailure(c *tc.C) {
+	runErr := errors.New("graaargh")
+	op, _, _ := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, runErr)
+	_, err := op.Prepare(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = op.Execute(c.Context(), operation.State{})
+	c.Assert(err, tc.Equals, operation.ErrHookFailed)
+
+	record, ok := op.(operation.RecordedOperation).ExecutionRecord()
+	c.Assert(ok, tc.IsTrue)
+	c.Check(record.Kind, tc.Equals, operation.ExecutionKindHook)
+	c.Check(record.Name, tc.Equals, "config-changed")
+	c.Check(record.RelationId, tc.IsNil)
+	c.Check(record.ExitCode, tc.Equals, -1)
+	c.Check(record.Err, tc.ErrorMatches, "graaargh")
+}
+
+func (s *RunHookSuite) TestExecuteRecordsRelationHook(c *tc.C) {
+	runnerFactory := NewRunHookRunnerFactory(nil)
+	callbacks := &ExecuteHookCallbacks{
+		PrepareHookCallbacks:    NewPrepareHookCallbacks(hooks.RelationChanged),
+		MockNotifyHookCompleted: &MockNotify{},
+		MockNotifyHookFailed:    &MockNotify{},
+	}
+	factory := newOpFactory(c, runnerFactory, callbacks)
+	op, err := factory.NewRunHook(hook.Info{
+		Kind:              hooks.RelationChanged,
+		RelationId:        123,
+		RemoteUnit:        "db/0",
+		RemoteApplication: "db",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = op.Prepare(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = op.Execute(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	record, ok := op.(operation.RecordedOperation).ExecutionRecord()
+	c.Assert(ok, tc.IsTrue)
+	c.Check(record.RelationId, tc.DeepEquals, new(123))
+	c.Check(record.ExitCode, tc.Equals, 0)
+	c.Check(record.Err, tc.ErrorIsNil)
+}
+
+func (s *RunHookSuite) TestExecuteMissingHookNotRecorded(c *tc.C) {
+	runErr := charmrunner.NewMissingHookError("blah-blah")
+	op, _, _ := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, runErr)
+	_, err := op.Prepare(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = op.Execute(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, ok := op.(operation.RecordedOperation).ExecutionRecord()
+	c.Check(ok, tc.IsFalse)
+}
+
 func (s *RunHookSuite) TestInstallHookPreservesStatus(c *tc.C) {
 	op, callbacks, f := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.Install, nil)
 	err := f.MockNewHookRunner.runner.Context().SetUnitStatus(c.Context(), jujuc.StatusInfo{Status: "blocked", Info: "no database"})
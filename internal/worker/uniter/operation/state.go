@@ -98,6 +98,10 @@ type State struct {
 	// state when initialising the agent and running any upgrade operation.
 	HookStep *Step `yaml:"hook-step,omitempty"`
 
+	// HookTimedOut indicates that the pending hook failed because it ran
+	// for longer than the hook timeout and was killed.
+	HookTimedOut bool `yaml:"hook-timed-out,omitempty"`
+
 	// ActionId holds action information relevant to the current operation. If
 	// Kind is Continue, it holds the last action that was executed; if Kind is
 	// RunAction, it holds the running action.
@@ -218,6 +222,7 @@ type stateChange struct {
 	ActionId        *string
 	CharmURL        string
 	HasRunStatusSet bool
+	HookTimedOut    bool
 }
 
 func (change stateChange) apply(state State) *State {
@@ -228,6 +233,7 @@ func (change stateChange) apply(state State) *State {
 	state.ActionId = change.ActionId
 	state.CharmURL = change.CharmURL
 	state.StatusSet = state.StatusSet || change.HasRunStatusSet
+	state.HookTimedOut = change.HookTimedOut
 	return &state
 }
 
@@ -231,6 +231,8 @@ type mockUnit struct {
 	configSettingsWatcher            *mockStringsWatcher
 	applicationConfigSettingsWatcher *mockStringsWatcher
 	storageWatcher                   *mockStringsWatcher
+	storageSnapshotsWatcher          *mockNotifyWatcher
+	storageSnapshots                 []params.UnitStorageSnapshot
 	actionWatcher                    *mockStringsWatcher
 	relationsWatcher                 *mockStringsWatcher
 }
@@ -287,6 +289,17 @@ func (u *mockUnit) WatchStorage(_ context.Context) (watcher.StringsWatcher, erro
 	return u.storageWatcher, nil
 }
 
+func (u *mockUnit) WatchStorageSnapshots(_ context.Context) (watcher.NotifyWatcher, error) {
+	if u.storageSnapshotsWatcher == nil {
+		return nil, errors.NotImplementedf("WatchUnitStorageSnapshots() (need V23+)")
+	}
+	return u.storageSnapshotsWatcher, nil
+}
+
+func (u *mockUnit) StorageSnapshots(_ context.Context) ([]params.UnitStorageSnapshot, error) {
+	return u.storageSnapshots, nil
+}
+
 func (u *mockUnit) WatchActionNotifications(_ context.Context) (watcher.StringsWatcher, error) {
 	return u.actionWatcher, nil
 }
@@ -25,6 +25,10 @@ type Snapshot struct {
 	// states of each of the unit's storage attachments.
 	Storage map[names.StorageTag]StorageSnapshot
 
+	// StorageSnapshotHooks contains the storage snapshots that are waiting
+	// on the unit to run one of its storage snapshot hooks.
+	StorageSnapshotHooks []StorageSnapshotHook
+
 	// CharmModifiedVersion is increased whenever the application's charm was
 	// changed in some way.
 	CharmModifiedVersion int
@@ -134,3 +138,18 @@ type StorageSnapshot struct {
 	Attached bool
 	Location string
 }
+
+// StorageSnapshotHook has information relating to a snapshot of one of the
+// unit's storage instances that is waiting on a storage snapshot hook.
+type StorageSnapshotHook struct {
+	// Id is the id of the snapshot.
+	Id string
+
+	// StorageTag identifies the storage instance the snapshot is of.
+	StorageTag names.StorageTag
+
+	// Status is the status of the snapshot. A pending snapshot is waiting
+	// on the storage-snapshot-pre hook, while a created or failed snapshot
+	// is waiting on the storage-snapshot-post hook.
+	Status string
+}
@@ -195,6 +195,7 @@ func (w *RemoteStateWatcher) Snapshot() Snapshot {
 	}
 	snapshot.Storage = make(map[names.StorageTag]StorageSnapshot)
 	maps.Copy(snapshot.Storage, w.current.Storage)
+	snapshot.StorageSnapshotHooks = slices.Clone(w.current.StorageSnapshotHooks)
 	snapshot.ActionsPending = make([]string, len(w.current.ActionsPending))
 	copy(snapshot.ActionsPending, w.current.ActionsPending)
 	snapshot.Commands = make([]string, len(w.current.Commands))
@@ -449,6 +450,21 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 	}
 	requiredEvents++
 
+	// Storage snapshot hooks are only supported by newer controllers, so
+	// the watcher is optional and is not required for the initial event.
+	var storageSnapshotsChanges watcher.NotifyChannel
+	storageSnapshotsw, err := w.unit.WatchStorageSnapshots(ctx)
+	if errors.Is(err, errors.NotImplemented) {
+		w.logger.Debugf(ctx, "storage snapshot hooks not supported by the controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	} else {
+		if err := w.catacomb.Add(storageSnapshotsw); err != nil {
+			return errors.Trace(err)
+		}
+		storageSnapshotsChanges = storageSnapshotsw.Changes()
+	}
+
 	var seenActionsChange bool
 	actionsw, err := w.unit.WatchActionNotifications(ctx)
 	if err != nil {
@@ -626,6 +642,15 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 			}
 			observedEvent(&seenStorageChange)
 
+		case _, ok := <-storageSnapshotsChanges:
+			w.logger.Debugf(ctx, "got storage snapshots change for %s: ok=%t", w.unit.Tag().Id(), ok)
+			if !ok {
+				return errors.New("storage snapshots watcher closed")
+			}
+			if err := w.storageSnapshotsChanged(ctx); err != nil {
+				return errors.Trace(err)
+			}
+
 		case _, ok := <-updateStatusIntervalw.Changes():
 			w.logger.Debugf(ctx, "got update status interval change for %s: ok=%t", w.unit.Tag().Id(), ok)
 			if !ok {
@@ -1184,6 +1209,31 @@ func (w *RemoteStateWatcher) relationUnitsChanged(change relationUnitsChange) er
 	return nil
 }
 
+// storageSnapshotsChanged responds to changes in the storage snapshots
+// that may be waiting on the unit's storage snapshot hooks.
+func (w *RemoteStateWatcher) storageSnapshotsChanged(ctx context.Context) error {
+	snapshots, err := w.unit.StorageSnapshots(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	hooks := make([]StorageSnapshotHook, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		storageTag, err := names.ParseStorageTag(snapshot.StorageTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		hooks = append(hooks, StorageSnapshotHook{
+			Id:         snapshot.Id,
+			StorageTag: storageTag,
+			Status:     snapshot.Status,
+		})
+	}
+	w.mu.Lock()
+	w.current.StorageSnapshotHooks = hooks
+	w.mu.Unlock()
+	return nil
+}
+
 // storageAttachmentChanged responds to storage attachment changes.
 func (w *RemoteStateWatcher) storageAttachmentChanged(change storageAttachmentChange) {
 	w.mu.Lock()
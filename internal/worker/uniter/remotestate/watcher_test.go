@@ -562,6 +562,34 @@ func (s *WatcherSuite) TestStorageChanged(c *tc.C) {
 	})
 }
 
+func (s *WatcherSuiteIAAS) TestStorageSnapshotsChanged(c *tc.C) {
+	// Replace the watcher with one that sees a controller supporting
+	// storage snapshot hooks.
+	s.watcher.Kill()
+	c.Assert(s.watcher.Wait(), tc.ErrorIsNil)
+	s.uniterClient.unit.storageSnapshotsWatcher = newMockNotifyWatcher()
+	w, err := remotestate.NewWatcher(s.setupWatcherConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	s.watcher = w
+
+	s.signalAll()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().StorageSnapshotHooks, tc.HasLen, 0)
+
+	s.uniterClient.unit.storageSnapshots = []params.UnitStorageSnapshot{{
+		Id:         "1",
+		StorageTag: "storage-data-0",
+		Status:     "pending",
+	}}
+	s.uniterClient.unit.storageSnapshotsWatcher.changes <- struct{}{}
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().StorageSnapshotHooks, tc.DeepEquals, []remotestate.StorageSnapshotHook{{
+		Id:         "1",
+		StorageTag: names.NewStorageTag("data/0"),
+		Status:     "pending",
+	}})
+}
+
 func (s *WatcherSuite) TestStorageUnattachedChanged(c *tc.C) {
 	s.signalAll()
 	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
@@ -41,6 +41,13 @@ type ResolverConfig struct {
 	Secrets             resolver.Resolver
 	OptionalResolvers   []resolver.Resolver
 	Logger              logger.Logger
+
+	// ReportHookTimeout is used in place of ReportHookError when the
+	// failed hook was killed for exceeding the hook timeout.
+	ReportHookTimeout func(stdcontext.Context, hook.Info) error
+	// ShouldRetryTimedOutHooks causes timed out hooks to be retried
+	// even when ShouldRetryHooks is false.
+	ShouldRetryTimedOutHooks bool
 }
 
 type uniterResolver struct {
@@ -251,7 +258,11 @@ func (s *uniterResolver) nextOpHookError(
 ) (operation.Operation, error) {
 
 	// Report the hook error.
-	if err := s.config.ReportHookError(ctx, *localState.Hook); err != nil {
+	reportHookError := s.config.ReportHookError
+	if localState.HookTimedOut && s.config.ReportHookTimeout != nil {
+		reportHookError = s.config.ReportHookTimeout
+	}
+	if err := reportHookError(ctx, *localState.Hook); err != nil {
 		return nil, errors.Trace(err)
 	}
 
@@ -272,7 +283,9 @@ func (s *uniterResolver) nextOpHookError(
 			s.retryHookTimerStarted = false
 			return opFactory.NewRunHook(*localState.Hook)
 		}
-		if !s.retryHookTimerStarted && s.config.ShouldRetryHooks {
+		shouldRetry := s.config.ShouldRetryHooks ||
+			(localState.HookTimedOut && s.config.ShouldRetryTimedOutHooks)
+		if !s.retryHookTimerStarted && shouldRetry {
 			// We haven't yet started a retry timer, so start one
 			// now. If we retry and fail, retryHookTimerStarted is
 			// cleared so that we'll still start it again.
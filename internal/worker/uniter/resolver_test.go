@@ -125,6 +125,11 @@ func (s *baseResolverSuite) SetUpTest(c *tc.C, modelType model.ModelType, reboot
 			s.lastOptionalResolver,
 		},
 		Logger: logger,
+
+		ReportHookTimeout: func(_ context.Context, info hook.Info) error {
+			s.stub.AddCall("ReportHookTimeout", info)
+			return nil
+		},
 	}
 
 	s.stub = testhelpers.Stub{}
@@ -280,6 +285,63 @@ func (s *resolverSuite) TestHookErrorStartRetryTimer(c *tc.C) {
 	s.stub.CheckCallNames(c, "StartRetryHookTimer") // no change
 }
 
+func (s *resolverSuite) TestHookTimedOutReportsTimeout(c *tc.C) {
+	s.resolverConfig.ShouldRetryHooks = false
+	s.resolver = uniter.NewUniterResolver(s.resolverConfig)
+	s.reportHookError = func(hook.Info) error { return errors.New("unexpected") }
+	hookInfo := hook.Info{Kind: hooks.ConfigChanged}
+	localState := resolver.LocalState{
+		CharmURL: s.charmURL,
+		State: operation.State{
+			Kind:         operation.RunHook,
+			Step:         operation.Pending,
+			Installed:    true,
+			Started:      true,
+			Hook:         &hookInfo,
+			HookTimedOut: true,
+		},
+	}
+	// Neither retry option is set, so the timeout is reported but
+	// the hook retry timer is not started.
+	_, err := s.resolver.NextOp(c.Context(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+	s.stub.CheckCalls(c, []testhelpers.StubCall{{
+		FuncName: "ReportHookTimeout",
+		Args:     []any{hookInfo},
+	}})
+}
+
+func (s *resolverSuite) TestHookTimedOutStartRetryTimer(c *tc.C) {
+	s.resolverConfig.ShouldRetryHooks = false
+	s.resolverConfig.ShouldRetryTimedOutHooks = true
+	s.resolver = uniter.NewUniterResolver(s.resolverConfig)
+	hookInfo := hook.Info{Kind: hooks.ConfigChanged}
+	localState := resolver.LocalState{
+		CharmURL: s.charmURL,
+		State: operation.State{
+			Kind:         operation.RunHook,
+			Step:         operation.Pending,
+			Installed:    true,
+			Started:      true,
+			Hook:         &hookInfo,
+			HookTimedOut: true,
+		},
+	}
+	_, err := s.resolver.NextOp(c.Context(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+	s.stub.CheckCallNames(c, "ReportHookTimeout", "StartRetryHookTimer")
+
+	// An ordinary hook error is not retried when only timed out
+	// hooks should be.
+	s.stub.ResetCalls()
+	s.resolver = uniter.NewUniterResolver(s.resolverConfig)
+	s.reportHookError = func(hook.Info) error { return nil }
+	localState.HookTimedOut = false
+	_, err = s.resolver.NextOp(c.Context(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+	s.stub.CheckNoCalls(c)
+}
+
 func (s *resolverSuite) TestHookErrorStartRetryTimerAgain(c *tc.C) {
 	s.reportHookError = func(hook.Info) error { return nil }
 	localState := resolver.LocalState{
@@ -0,0 +1,412 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/rpc/params"
+)
+
+// BundleVersion is the version of the bundle format written by this package.
+const BundleVersion = 1
+
+// Bundle holds the execution context of a hook or action, as seen by the
+// hook tools just before it ran. It holds enough to serve the read-only
+// hook tools when replaying the hook away from the controller.
+// Secret contents and resources are never captured.
+type Bundle struct {
+	Version    int       `json:"version"`
+	Unit       string    `json:"unit"`
+	Hook       string    `json:"hook"`
+	ContextId  string    `json:"context-id"`
+	CapturedAt time.Time `json:"captured-at"`
+
+	// Env is the environment the hook was run with.
+	Env []string `json:"env"`
+
+	Config    map[string]any `json:"config,omitempty"`
+	GoalState *GoalState     `json:"goal-state,omitempty"`
+	IsLeader  bool           `json:"is-leader"`
+
+	PublicAddress    string                              `json:"public-address,omitempty"`
+	PrivateAddress   string                              `json:"private-address,omitempty"`
+	AvailabilityZone string                              `json:"availability-zone,omitempty"`
+	OpenedPorts      map[string][]string                 `json:"opened-ports,omitempty"`
+	NetworkInfo      map[string]params.NetworkInfoResult `json:"network-info,omitempty"`
+
+	UnitStatus        *StatusInfo        `json:"unit-status,omitempty"`
+	ApplicationStatus *ApplicationStatus `json:"application-status,omitempty"`
+	WorkloadVersion   string             `json:"workload-version,omitempty"`
+	CharmState        map[string]string  `json:"charm-state,omitempty"`
+
+	Relations         []Relation `json:"relations,omitempty"`
+	HookRelationId    *int       `json:"hook-relation-id,omitempty"`
+	RemoteUnit        string     `json:"remote-unit,omitempty"`
+	RemoteApplication string     `json:"remote-application,omitempty"`
+
+	Storage     []StorageAttachment `json:"storage,omitempty"`
+	HookStorage string              `json:"hook-storage,omitempty"`
+
+	Secrets map[string]SecretMetadata `json:"secrets,omitempty"`
+
+	ActionParams map[string]any `json:"action-params,omitempty"`
+	WorkloadName string         `json:"workload-name,omitempty"`
+}
+
+// GoalState holds the goal state of the unit.
+type GoalState struct {
+	Units     map[string]GoalStateStatus            `json:"units,omitempty"`
+	Relations map[string]map[string]GoalStateStatus `json:"relations,omitempty"`
+}
+
+// GoalStateStatus holds the goal status of a unit or application.
+type GoalStateStatus struct {
+	Status string     `json:"status"`
+	Since  *time.Time `json:"since,omitempty"`
+}
+
+// StatusInfo holds a unit or application status.
+type StatusInfo struct {
+	Tag    string         `json:"tag,omitempty"`
+	Status string         `json:"status"`
+	Info   string         `json:"info,omitempty"`
+	Data   map[string]any `json:"data,omitempty"`
+}
+
+// ApplicationStatus holds the status of the application and its units.
+// It is only captured on the leader.
+type ApplicationStatus struct {
+	Application StatusInfo   `json:"application"`
+	Units       []StatusInfo `json:"units,omitempty"`
+}
+
+// Relation holds a relation the unit participates in, along with the
+// settings of both sides of it.
+type Relation struct {
+	Id                int    `json:"id"`
+	Key               string `json:"key"`
+	Endpoint          string `json:"endpoint"`
+	RemoteApplication string `json:"remote-application"`
+	RemoteModelUUID   string `json:"remote-model-uuid,omitempty"`
+	Suspended         bool   `json:"suspended,omitempty"`
+	Life              string `json:"life"`
+
+	// Units holds the names of the remote units in the relation.
+	Units []string `json:"units,omitempty"`
+
+	// UnitSettings holds the local unit's settings.
+	UnitSettings params.Settings `json:"unit-settings,omitempty"`
+
+	// ApplicationSettings holds the local application's settings. They
+	// are only captured on the leader.
+	ApplicationSettings params.Settings `json:"application-settings,omitempty"`
+
+	RemoteUnitSettings        map[string]params.Settings `json:"remote-unit-settings,omitempty"`
+	RemoteApplicationSettings params.Settings            `json:"remote-application-settings,omitempty"`
+
+	// NetworkInfo holds the network info of the relation's endpoint.
+	NetworkInfo map[string]params.NetworkInfoResult `json:"network-info,omitempty"`
+}
+
+// StorageAttachment holds a storage attachment of the unit.
+type StorageAttachment struct {
+	Tag      string `json:"tag"`
+	Kind     string `json:"kind"`
+	Location string `json:"location"`
+}
+
+// SecretMetadata holds the metadata of a secret owned by the charm.
+type SecretMetadata struct {
+	OwnerKind        string               `json:"owner-kind"`
+	OwnerId          string               `json:"owner-id"`
+	Description      string               `json:"description,omitempty"`
+	Label            string               `json:"label,omitempty"`
+	RotatePolicy     string               `json:"rotate-policy,omitempty"`
+	LatestRevision   int                  `json:"latest-revision"`
+	LatestExpireTime *time.Time           `json:"latest-expire-time,omitempty"`
+	LatestChecksum   string               `json:"latest-checksum,omitempty"`
+	NextRotateTime   *time.Time           `json:"next-rotate-time,omitempty"`
+	Access           []secrets.AccessInfo `json:"access,omitempty"`
+}
+
+// NewBundle captures the execution context of the named hook or action from
+// the input hook context, which is about to run it with the input
+// environment.
+func NewBundle(
+	ctx context.Context, hctx jujuc.Context, hookName, contextId string, env []string, now time.Time,
+) (*Bundle, error) {
+	b := &Bundle{
+		Version:    BundleVersion,
+		Unit:       hctx.UnitName(),
+		Hook:       hookName,
+		ContextId:  contextId,
+		CapturedAt: now.UTC(),
+		Env:        env,
+	}
+
+	config, err := hctx.ConfigSettings(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "capturing config")
+	}
+	b.Config = config
+
+	goalState, err := hctx.GoalState(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "capturing goal state")
+	}
+	if goalState != nil {
+		b.GoalState = &GoalState{
+			Units:     goalStateUnits(goalState.Units),
+			Relations: make(map[string]map[string]GoalStateStatus),
+		}
+		for name, units := range goalState.Relations {
+			b.GoalState.Relations[name] = goalStateUnits(units)
+		}
+	}
+
+	if b.IsLeader, err = hctx.IsLeader(); err != nil {
+		return nil, errors.Annotate(err, "capturing leadership")
+	}
+
+	// Addresses are not available for every unit, so they are
+	// captured when they are available.
+	b.PublicAddress, _ = hctx.PublicAddress(ctx)
+	b.PrivateAddress, _ = hctx.PrivateAddress()
+	b.AvailabilityZone, _ = hctx.AvailabilityZone()
+	for endpoint, portRanges := range hctx.OpenedPortRanges() {
+		if b.OpenedPorts == nil {
+			b.OpenedPorts = make(map[string][]string)
+		}
+		for _, portRange := range portRanges {
+			b.OpenedPorts[endpoint] = append(b.OpenedPorts[endpoint], portRange.String())
+		}
+	}
+
+	if err := b.captureStatus(ctx, hctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if b.WorkloadVersion, err = hctx.UnitWorkloadVersion(ctx); err != nil {
+		return nil, errors.Annotate(err, "capturing workload version")
+	}
+	if b.CharmState, err = hctx.GetCharmState(ctx); err != nil {
+		return nil, errors.Annotate(err, "capturing charm state")
+	}
+	if err := b.captureRelations(ctx, hctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := b.captureStorage(ctx, hctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := b.captureSecrets(ctx, hctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if params, err := hctx.ActionParams(); err == nil {
+		b.ActionParams = params
+	}
+	b.WorkloadName, _ = hctx.WorkloadName()
+	return b, nil
+}
+
+func goalStateUnits(units application.UnitsGoalState) map[string]GoalStateStatus {
+	result := make(map[string]GoalStateStatus, len(units))
+	for name, status := range units {
+		result[name] = GoalStateStatus{Status: status.Status, Since: status.Since}
+	}
+	return result
+}
+
+func (b *Bundle) captureStatus(ctx context.Context, hctx jujuc.Context) error {
+	unitStatus, err := hctx.UnitStatus(ctx)
+	if err != nil {
+		return errors.Annotate(err, "capturing unit status")
+	}
+	status := statusInfo(*unitStatus)
+	b.UnitStatus = &status
+
+	if !b.IsLeader {
+		return nil
+	}
+	appStatus, err := hctx.ApplicationStatus(ctx)
+	if err != nil {
+		return errors.Annotate(err, "capturing application status")
+	}
+	b.ApplicationStatus = &ApplicationStatus{
+		Application: statusInfo(appStatus.Application),
+	}
+	for _, unit := range appStatus.Units {
+		b.ApplicationStatus.Units = append(b.ApplicationStatus.Units, statusInfo(unit))
+	}
+	return nil
+}
+
+func statusInfo(info jujuc.StatusInfo) StatusInfo {
+	return StatusInfo{
+		Tag:    info.Tag,
+		Status: info.Status,
+		Info:   info.Info,
+		Data:   info.Data,
+	}
+}
+
+func (b *Bundle) captureRelations(ctx context.Context, hctx jujuc.Context) error {
+	ids, err := hctx.RelationIds()
+	if err != nil {
+		return errors.Annotate(err, "capturing relations")
+	}
+	sort.Ints(ids)
+
+	var endpoints []string
+	for _, id := range ids {
+		r, err := hctx.Relation(id)
+		if err != nil {
+			return errors.Annotatef(err, "capturing relation %d", id)
+		}
+		relation, err := captureRelation(ctx, r, b.IsLeader)
+		if err != nil {
+			return errors.Annotatef(err, "capturing relation %d", id)
+		}
+		relation.NetworkInfo, _ = hctx.NetworkInfo(ctx, []string{relation.Endpoint}, id)
+		b.Relations = append(b.Relations, relation)
+		endpoints = append(endpoints, relation.Endpoint)
+	}
+
+	// Network info is not available for every binding on every substrate,
+	// so it is captured when it is available.
+	if len(endpoints) > 0 {
+		b.NetworkInfo, _ = hctx.NetworkInfo(ctx, endpoints, -1)
+	}
+
+	if r, err := hctx.HookRelation(); err == nil {
+		id := r.Id()
+		b.HookRelationId = &id
+	}
+	b.RemoteUnit, _ = hctx.RemoteUnitName()
+	b.RemoteApplication, _ = hctx.RemoteApplicationName()
+	return nil
+}
+
+func captureRelation(ctx context.Context, r jujuc.ContextRelation, isLeader bool) (Relation, error) {
+	relation := Relation{
+		Id:                r.Id(),
+		Key:               r.RelationTag().Id(),
+		Endpoint:          r.Name(),
+		RemoteApplication: r.RemoteApplicationName(),
+		RemoteModelUUID:   r.RemoteModelUUID(),
+		Suspended:         r.Suspended(),
+		Life:              string(r.Life()),
+		Units:             r.UnitNames(),
+	}
+
+	settings, err := r.Settings(ctx)
+	if err != nil {
+		return Relation{}, errors.Annotate(err, "reading unit settings")
+	}
+	relation.UnitSettings = settings.Map()
+
+	if isLeader {
+		settings, err := r.ApplicationSettings(ctx)
+		if err != nil {
+			return Relation{}, errors.Annotate(err, "reading application settings")
+		}
+		relation.ApplicationSettings = settings.Map()
+	}
+
+	for _, unit := range relation.Units {
+		settings, err := r.ReadSettings(ctx, unit)
+		if err != nil {
+			return Relation{}, errors.Annotatef(err, "reading settings of %q", unit)
+		}
+		if relation.RemoteUnitSettings == nil {
+			relation.RemoteUnitSettings = make(map[string]params.Settings)
+		}
+		relation.RemoteUnitSettings[unit] = settings
+	}
+
+	// The remote application settings are only readable once a remote
+	// unit has joined, or on cross model relations.
+	if relation.RemoteApplication != "" {
+		relation.RemoteApplicationSettings, _ = r.ReadApplicationSettings(ctx, relation.RemoteApplication)
+	}
+	return relation, nil
+}
+
+func (b *Bundle) captureStorage(ctx context.Context, hctx jujuc.Context) error {
+	tags, err := hctx.StorageTags(ctx)
+	if err != nil {
+		return errors.Annotate(err, "capturing storage")
+	}
+	for _, tag := range tags {
+		attachment, err := hctx.Storage(ctx, tag)
+		if err != nil {
+			return errors.Annotatef(err, "capturing storage %q", tag.Id())
+		}
+		b.Storage = append(b.Storage, StorageAttachment{
+			Tag:      tag.Id(),
+			Kind:     attachment.Kind().String(),
+			Location: attachment.Location(),
+		})
+	}
+	if attachment, err := hctx.HookStorage(ctx); err == nil {
+		b.HookStorage = attachment.Tag().Id()
+	}
+	return nil
+}
+
+func (b *Bundle) captureSecrets(ctx context.Context, hctx jujuc.Context) error {
+	metadata, err := hctx.SecretMetadata(ctx)
+	if err != nil {
+		return errors.Annotate(err, "capturing secrets metadata")
+	}
+	for id, md := range metadata {
+		if b.Secrets == nil {
+			b.Secrets = make(map[string]SecretMetadata)
+		}
+		b.Secrets[id] = SecretMetadata{
+			OwnerKind:        string(md.Owner.Kind),
+			OwnerId:          md.Owner.ID,
+			Description:      md.Description,
+			Label:            md.Label,
+			RotatePolicy:     string(md.RotatePolicy),
+			LatestRevision:   md.LatestRevision,
+			LatestExpireTime: md.LatestExpireTime,
+			LatestChecksum:   md.LatestChecksum,
+			NextRotateTime:   md.NextRotateTime,
+			Access:           md.Access,
+		}
+	}
+	return nil
+}
+
+// Marshal returns the bundle serialised as JSON.
+func (b *Bundle) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(b, "", "  ")
+	return data, errors.Trace(err)
+}
+
+// ReadBundle reads the bundle from the file at the input path.
+func ReadBundle(path string) (*Bundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var b Bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, errors.Annotatef(err, "parsing hook capture bundle %q", path)
+	}
+	if b.Version != BundleVersion {
+		return nil, errors.NotSupportedf("hook capture bundle version %d", b.Version)
+	}
+	return &b, nil
+}
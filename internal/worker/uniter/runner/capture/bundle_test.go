@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+	"github.com/juju/juju/rpc/params"
+)
+
+type BundleSuite struct{}
+
+func TestBundleSuite(t *testing.T) {
+	tc.Run(t, &BundleSuite{})
+}
+
+func newTestBundle() *capture.Bundle {
+	hookRelationId := 1
+	return &capture.Bundle{
+		Version:    capture.BundleVersion,
+		Unit:       "wordpress/0",
+		Hook:       "db-relation-changed",
+		ContextId:  "wordpress/0-db-relation-changed-1234",
+		CapturedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Env: []string{
+			"JUJU_UNIT_NAME=wordpress/0",
+			"JUJU_HOOK_NAME=db-relation-changed",
+			"JUJU_DISPATCH_PATH=hooks/db-relation-changed",
+			"JUJU_CONTEXT_ID=wordpress/0-db-relation-changed-1234",
+			"JUJU_CHARM_DIR=/var/lib/juju/agents/unit-wordpress-0/charm",
+			"HOME=/root",
+		},
+		Config:   map[string]any{"blog-title": "My Blog"},
+		IsLeader: true,
+		GoalState: &capture.GoalState{
+			Units: map[string]capture.GoalStateStatus{
+				"wordpress/0": {Status: "active"},
+			},
+			Relations: map[string]map[string]capture.GoalStateStatus{
+				"db": {"mysql/0": {Status: "active"}},
+			},
+		},
+		PublicAddress:  "203.0.113.1",
+		PrivateAddress: "10.0.0.1",
+		OpenedPorts:    map[string][]string{"": {"80/tcp"}},
+		UnitStatus:     &capture.StatusInfo{Status: "active", Info: "ready"},
+		ApplicationStatus: &capture.ApplicationStatus{
+			Application: capture.StatusInfo{Tag: "application-wordpress", Status: "active"},
+			Units: []capture.StatusInfo{
+				{Tag: "unit-wordpress-0", Status: "active", Info: "ready"},
+			},
+		},
+		WorkloadVersion: "6.4",
+		CharmState:      map[string]string{"installed": "true"},
+		Relations: []capture.Relation{{
+			Id:                  1,
+			Key:                 "wordpress:db mysql:server",
+			Endpoint:            "db",
+			RemoteApplication:   "mysql",
+			Life:                "alive",
+			Units:               []string{"mysql/0"},
+			UnitSettings:        params.Settings{"private-address": "10.0.0.1"},
+			ApplicationSettings: params.Settings{"database": "wordpress"},
+			RemoteUnitSettings: map[string]params.Settings{
+				"mysql/0": {"user": "admin"},
+			},
+			RemoteApplicationSettings: params.Settings{"host": "10.0.0.2"},
+			NetworkInfo: map[string]params.NetworkInfoResult{
+				"db": {IngressAddresses: []string{"10.0.0.1"}},
+			},
+		}},
+		NetworkInfo: map[string]params.NetworkInfoResult{
+			"db": {IngressAddresses: []string{"10.0.0.1"}},
+		},
+		HookRelationId: &hookRelationId,
+		RemoteUnit:     "mysql/0",
+		Storage: []capture.StorageAttachment{{
+			Tag:      "data/0",
+			Kind:     "filesystem",
+			Location: "/srv/data",
+		}},
+		Secrets: map[string]capture.SecretMetadata{
+			"cs7qvqg8qq9s1a8nk6p0": {
+				OwnerKind:      "application",
+				OwnerId:        "wordpress",
+				Label:          "admin-password",
+				RotatePolicy:   "never",
+				LatestRevision: 2,
+			},
+		},
+	}
+}
+
+func (s *BundleSuite) TestNewBundle(c *tc.C) {
+	// A replay context serves the hook tools from a bundle, so capturing
+	// from it must yield the bundle it was created from.
+	expected := newTestBundle()
+	hctx, err := capture.NewContext(newTestBundle(), loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	bundle, err := capture.NewBundle(
+		c.Context(), hctx, expected.Hook, expected.ContextId, expected.Env, expected.CapturedAt,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(bundle, tc.DeepEquals, expected)
+}
+
+func (s *BundleSuite) TestReadBundle(c *tc.C) {
+	expected := newTestBundle()
+	data, err := expected.Marshal()
+	c.Assert(err, tc.ErrorIsNil)
+	path := filepath.Join(c.MkDir(), "bundle.json")
+	err = os.WriteFile(path, data, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	bundle, err := capture.ReadBundle(path)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Round trip the expected bundle through JSON too, so that numbers
+	// in the config compare equal.
+	var roundTripped capture.Bundle
+	err = json.Unmarshal(data, &roundTripped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(bundle, tc.DeepEquals, &roundTripped)
+	c.Assert(bundle.Relations[0].RemoteUnitSettings["mysql/0"]["user"], tc.Equals, "admin")
+}
+
+func (s *BundleSuite) TestReadBundleVersion(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "bundle.json")
+	err := os.WriteFile(path, []byte(`{"version": 2}`), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = capture.ReadBundle(path)
+	c.Assert(err, tc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, tc.ErrorMatches, "hook capture bundle version 2 not supported")
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	goyaml "gopkg.in/yaml.v2"
+)
+
+const defaultDir = "/tmp"
+
+// HookCapture identifies the files through which the hooks of a unit are
+// captured: the request file written by "juju capture-hook", and the bundle
+// file written by the unit agent in response.
+type HookCapture struct {
+	Unit string
+	Dir  string
+}
+
+// NewHookCapture returns the HookCapture for the unit with the input name.
+func NewHookCapture(unitName string) *HookCapture {
+	return &HookCapture{Unit: unitName, Dir: defaultDir}
+}
+
+// RequestFile returns the path of the file requesting a capture.
+func (c *HookCapture) RequestFile() string {
+	basename := fmt.Sprintf("juju-%s-capture-hook", names.NewUnitTag(c.Unit))
+	return filepath.Join(c.Dir, basename)
+}
+
+// BundleFile returns the path of the file the captured bundle is written to.
+func (c *HookCapture) BundleFile() string {
+	return c.RequestFile() + ".json"
+}
+
+type captureArgs struct {
+	Hooks []string `yaml:"hooks,omitempty"`
+}
+
+// Request represents a pending "juju capture-hook" request.
+type Request struct {
+	*HookCapture
+	hooks set.Strings
+}
+
+// FindRequest returns the pending capture request for the unit, or an
+// error satisfying [errors.NotFound] if there is none.
+func (c *HookCapture) FindRequest() (*Request, error) {
+	data, err := os.ReadFile(c.RequestFile())
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("hook capture request for %q", c.Unit)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var args captureArgs
+	if err := goyaml.Unmarshal(data, &args); err != nil {
+		return nil, errors.Annotate(err, "parsing hook capture request")
+	}
+	return &Request{HookCapture: c, hooks: set.NewStrings(args.Hooks...)}, nil
+}
+
+// MatchHook returns true if the specified hook or action name matches
+// the ones requested by the capture-hook client.
+func (r *Request) MatchHook(hookName string) bool {
+	return r.hooks.IsEmpty() || r.hooks.Contains(hookName)
+}
+
+// Complete writes the bundle to the bundle file and removes the request,
+// so that only a single hook is captured per request. The bundle is only
+// readable by the agent user, as it holds the unit's configuration and
+// relation data.
+func (r *Request) Complete(bundle *Bundle) error {
+	data, err := bundle.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Write to a temporary file first, so that the client never sees a
+	// partially written bundle.
+	f, err := os.CreateTemp(r.Dir, filepath.Base(r.BundleFile())+".*")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return errors.Annotate(err, "writing hook capture bundle")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Annotate(err, "writing hook capture bundle")
+	}
+	if err := os.Rename(f.Name(), r.BundleFile()); err != nil {
+		return errors.Annotate(err, "writing hook capture bundle")
+	}
+	if err := os.Remove(r.RequestFile()); err != nil && !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// ClientScript returns a bash script suitable for executing on the unit
+// system to request the capture of the next matching hook or action, and
+// to write the resulting bundle to stdout.
+func ClientScript(c *HookCapture, match []string) string {
+	// If any argument is "*", then the client is interested in all.
+	if slices.Contains(match, "*") {
+		match = nil
+	}
+	yamlArgs, err := goyaml.Marshal(captureArgs{Hooks: match})
+	if err != nil {
+		// This should not happen: we're in full control.
+		panic(err)
+	}
+
+	s := strings.Replace(captureHookClientScript, "{request}", c.RequestFile(), -1)
+	s = strings.Replace(s, "{bundle}", c.BundleFile(), -1)
+	s = strings.Replace(s, "{capture_args}", base64.StdEncoding.EncodeToString(yamlArgs), 1)
+	return s
+}
+
+const captureHookClientScript = `#!/bin/bash
+set -e
+rm -f {bundle}
+trap 'rm -f {request}' EXIT
+
+# Write out the capture-hook args.
+(umask 077; echo "{capture_args}" | base64 -d > {request})
+
+echo "Waiting for a matching hook or action to run" >&2
+while [ ! -f {bundle} ]; do
+	sleep 1
+done
+cat {bundle}
+rm -f {bundle}
+`
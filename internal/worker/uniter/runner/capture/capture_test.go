@@ -0,0 +1,110 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+)
+
+type CaptureSuite struct {
+	hc *capture.HookCapture
+}
+
+func TestCaptureSuite(t *testing.T) {
+	tc.Run(t, &CaptureSuite{})
+}
+
+func (s *CaptureSuite) SetUpTest(c *tc.C) {
+	s.hc = capture.NewHookCapture("foo/8")
+	s.hc.Dir = c.MkDir()
+}
+
+func (s *CaptureSuite) TestHookCapture(c *tc.C) {
+	hc := capture.NewHookCapture("foo/8")
+	c.Assert(hc.Unit, tc.Equals, "foo/8")
+	c.Assert(hc.Dir, tc.Equals, "/tmp")
+	c.Assert(hc.RequestFile(), tc.SamePath, "/tmp/juju-unit-foo-8-capture-hook")
+	c.Assert(hc.BundleFile(), tc.SamePath, "/tmp/juju-unit-foo-8-capture-hook.json")
+}
+
+func (s *CaptureSuite) TestFindRequestNotFound(c *tc.C) {
+	_, err := s.hc.FindRequest()
+	c.Assert(err, tc.Satisfies, errors.IsNotFound)
+}
+
+func (s *CaptureSuite) TestFindRequestMatchAll(c *tc.C) {
+	err := os.WriteFile(s.hc.RequestFile(), []byte("{}\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	request, err := s.hc.FindRequest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(request.MatchHook("install"), tc.IsTrue)
+	c.Assert(request.MatchHook("config-changed"), tc.IsTrue)
+}
+
+func (s *CaptureSuite) TestFindRequestMatchHooks(c *tc.C) {
+	err := os.WriteFile(s.hc.RequestFile(), []byte("hooks: [config-changed, backup]\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	request, err := s.hc.FindRequest()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(request.MatchHook("config-changed"), tc.IsTrue)
+	c.Assert(request.MatchHook("backup"), tc.IsTrue)
+	c.Assert(request.MatchHook("install"), tc.IsFalse)
+}
+
+func (s *CaptureSuite) TestComplete(c *tc.C) {
+	err := os.WriteFile(s.hc.RequestFile(), []byte("{}\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	request, err := s.hc.FindRequest()
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = request.Complete(&capture.Bundle{
+		Version: capture.BundleVersion,
+		Unit:    "foo/8",
+		Hook:    "install",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = os.Stat(s.hc.RequestFile())
+	c.Assert(err, tc.Satisfies, os.IsNotExist)
+	info, err := os.Stat(s.hc.BundleFile())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(info.Mode().Perm(), tc.Equals, os.FileMode(0600))
+
+	bundle, err := capture.ReadBundle(s.hc.BundleFile())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(bundle.Unit, tc.Equals, "foo/8")
+	c.Assert(bundle.Hook, tc.Equals, "install")
+
+	// Only the bundle is left behind.
+	entries, err := os.ReadDir(s.hc.Dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 1)
+	c.Assert(entries[0].Name(), tc.Equals, filepath.Base(s.hc.BundleFile()))
+}
+
+func (s *CaptureSuite) TestClientScript(c *tc.C) {
+	script := capture.ClientScript(s.hc, []string{"config-changed"})
+	c.Assert(script, tc.Contains, "rm -f "+s.hc.BundleFile())
+	c.Assert(script, tc.Contains, "trap 'rm -f "+s.hc.RequestFile()+"' EXIT")
+	c.Assert(script, tc.Contains, "cat "+s.hc.BundleFile())
+
+	args := base64.StdEncoding.EncodeToString([]byte("hooks:\n- config-changed\n"))
+	c.Assert(script, tc.Contains, `echo "`+args+`" | base64 -d > `+s.hc.RequestFile())
+}
+
+func (s *CaptureSuite) TestClientScriptMatchAll(c *tc.C) {
+	script := capture.ClientScript(s.hc, []string{"install", "*"})
+	args := base64.StdEncoding.EncodeToString([]byte("{}\n"))
+	c.Assert(script, tc.Contains, `echo "`+args+`" | base64 -d > `+s.hc.RequestFile())
+}
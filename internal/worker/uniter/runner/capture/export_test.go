@@ -0,0 +1,9 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture
+
+var (
+	StartJujucServer = startJujucServer
+	ReplayEnv        = replayEnv
+)
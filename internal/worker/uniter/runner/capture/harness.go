@@ -0,0 +1,172 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/cmd/cmd"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/juju/sockets"
+)
+
+// ReplayArgs holds the arguments for replaying a captured hook.
+type ReplayArgs struct {
+	// Bundle is the captured execution context of the hook.
+	Bundle *Bundle
+
+	// CharmDir is the directory holding the charm to run the hook from.
+	CharmDir string
+
+	// JujucPath is the path of the jujuc binary, which the hook tools
+	// run by the hook are linked to.
+	JujucPath string
+
+	// Stdout and Stderr receive the output of the hook.
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// Logger receives the messages logged by the hook with juju-log.
+	Logger corelogger.Logger
+}
+
+// Replay runs the captured hook or action from the charm in the input
+// directory, with the environment it was captured with, and with its hook
+// tools served from the bundle. It returns the changes the hook made
+// through the hook tools, which never leave this process.
+func Replay(ctx context.Context, args ReplayArgs) ([]string, error) {
+	charmDir, err := filepath.Abs(args.CharmDir)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	handler, err := hookHandler(charmDir, args.Bundle)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	replayDir, err := os.MkdirTemp("", "juju-replay-hook-")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = os.RemoveAll(replayDir) }()
+
+	toolsDir := filepath.Join(replayDir, "tools")
+	if err := linkHookTools(toolsDir, args.JujucPath); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	hctx, err := NewContext(args.Bundle, args.Logger)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	socket := sockets.Socket{
+		Network: "unix",
+		Address: filepath.Join(replayDir, "agent.socket"),
+	}
+	srv, err := startJujucServer(hctx, args.Bundle.ContextId, socket)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer srv.Close()
+
+	ps := exec.CommandContext(ctx, handler)
+	ps.Dir = charmDir
+	ps.Env = replayEnv(os.Environ(), args.Bundle.Env, charmDir, toolsDir, socket)
+	ps.Stdout = args.Stdout
+	ps.Stderr = args.Stderr
+	err = ps.Run()
+	return hctx.Changes(), errors.Trace(err)
+}
+
+// hookHandler returns the script to run for the captured hook: the
+// charm's dispatch script if it has one, or the hook or action itself.
+func hookHandler(charmDir string, bundle *Bundle) (string, error) {
+	dispatch := filepath.Join(charmDir, "dispatch")
+	if _, err := os.Stat(dispatch); err == nil {
+		return dispatch, nil
+	}
+	dispatchPath := envValue(bundle.Env, "JUJU_DISPATCH_PATH")
+	if dispatchPath == "" {
+		return "", errors.NotValidf("hook capture bundle without JUJU_DISPATCH_PATH")
+	}
+	handler := filepath.Join(charmDir, dispatchPath)
+	if _, err := os.Stat(handler); err != nil {
+		return "", errors.NotFoundf("%q in charm directory %q", dispatchPath, charmDir)
+	}
+	return handler, nil
+}
+
+// linkHookTools creates the input directory holding a link to the jujuc
+// binary for each hook tool.
+func linkHookTools(dir, jujucPath string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Trace(err)
+	}
+	jujucPath, err := filepath.Abs(jujucPath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, name := range jujuc.CommandNames() {
+		if err := os.Symlink(jujucPath, filepath.Join(dir, name)); err != nil {
+			return errors.Annotatef(err, "linking hook tool %q", name)
+		}
+	}
+	return nil
+}
+
+func startJujucServer(hctx *Context, contextId string, socket sockets.Socket) (*jujuc.Server, error) {
+	getCmd := func(ctxId, cmdName string) (cmd.Command, error) {
+		if ctxId != contextId {
+			return nil, errors.Errorf("wrong context ID; got %q", ctxId)
+		}
+		return jujuc.NewCommand(hctx, cmdName)
+	}
+	srv, err := jujuc.NewServer(getCmd, socket)
+	if err != nil {
+		return nil, errors.Annotate(err, "starting jujuc server")
+	}
+	go func() { _ = srv.Run() }()
+	return srv, nil
+}
+
+// replayEnv returns the environment to replay the hook with: the local
+// environment, with the Juju variables of the captured environment, and
+// with the paths of the charm and of the hook tools replaced with local
+// ones.
+func replayEnv(local, captured []string, charmDir, toolsDir string, socket sockets.Socket) []string {
+	env := local
+	for _, v := range captured {
+		if strings.HasPrefix(v, "JUJU_") || strings.HasPrefix(v, "CLOUD_API_VERSION=") {
+			env = utils.Setenv(env, v)
+		}
+	}
+	for _, v := range []string{
+		"CHARM_DIR=" + charmDir,
+		"JUJU_CHARM_DIR=" + charmDir,
+		"JUJU_AGENT_SOCKET_ADDRESS=" + socket.Address,
+		"JUJU_AGENT_SOCKET_NETWORK=" + socket.Network,
+		"PATH=" + toolsDir + string(os.PathListSeparator) + envValue(local, "PATH"),
+	} {
+		env = utils.Setenv(env, v)
+	}
+	return env
+}
+
+func envValue(env []string, name string) string {
+	for _, v := range env {
+		if value, ok := strings.CutPrefix(v, name+"="); ok {
+			return value
+		}
+	}
+	return ""
+}
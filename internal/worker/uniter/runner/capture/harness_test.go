@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+	"github.com/juju/juju/juju/sockets"
+)
+
+type HarnessSuite struct {
+	charmDir string
+}
+
+func TestHarnessSuite(t *testing.T) {
+	tc.Run(t, &HarnessSuite{})
+}
+
+func (s *HarnessSuite) SetUpTest(c *tc.C) {
+	s.charmDir = c.MkDir()
+	err := os.Mkdir(filepath.Join(s.charmDir, "hooks"), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *HarnessSuite) writeHook(c *tc.C, name, script string) {
+	err := os.WriteFile(filepath.Join(s.charmDir, name), []byte("#!/bin/bash\n"+script), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *HarnessSuite) replay(c *tc.C) (string, error) {
+	var stdout bytes.Buffer
+	_, err := capture.Replay(c.Context(), capture.ReplayArgs{
+		Bundle:    newTestBundle(),
+		CharmDir:  s.charmDir,
+		JujucPath: "/bin/true",
+		Stdout:    &stdout,
+		Stderr:    &stdout,
+		Logger:    loggertesting.WrapCheckLog(c),
+	})
+	return stdout.String(), err
+}
+
+func (s *HarnessSuite) TestReplayHook(c *tc.C) {
+	s.writeHook(c, "hooks/db-relation-changed", `
+echo $JUJU_UNIT_NAME $JUJU_CONTEXT_ID
+[ "$CHARM_DIR" = "$PWD" ] && [ "$JUJU_CHARM_DIR" = "$PWD" ] && echo charm-dir
+[ -S "$JUJU_AGENT_SOCKET_ADDRESS" ] && echo socket
+command -v relation-get | xargs dirname | xargs basename
+`)
+	output, err := s.replay(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(output, tc.Equals, "wordpress/0 wordpress/0-db-relation-changed-1234\ncharm-dir\nsocket\ntools\n")
+}
+
+func (s *HarnessSuite) TestReplayDispatch(c *tc.C) {
+	s.writeHook(c, "dispatch", "echo dispatch $JUJU_DISPATCH_PATH\n")
+	s.writeHook(c, "hooks/db-relation-changed", "echo hook\n")
+	output, err := s.replay(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(output, tc.Equals, "dispatch hooks/db-relation-changed\n")
+}
+
+func (s *HarnessSuite) TestReplayHookFails(c *tc.C) {
+	s.writeHook(c, "hooks/db-relation-changed", "exit 3\n")
+	_, err := s.replay(c)
+	var exitErr *exec.ExitError
+	c.Assert(errors.As(err, &exitErr), tc.IsTrue)
+	c.Assert(exitErr.ExitCode(), tc.Equals, 3)
+}
+
+func (s *HarnessSuite) TestReplayHookNotFound(c *tc.C) {
+	_, err := s.replay(c)
+	c.Assert(err, tc.Satisfies, errors.IsNotFound)
+}
+
+func (s *HarnessSuite) TestReplayEnv(c *tc.C) {
+	env := capture.ReplayEnv(
+		[]string{"HOME=/home/me", "PATH=/usr/bin", "JUJU_MODEL_NAME=local"},
+		[]string{"HOME=/root", "JUJU_MODEL_NAME=prod", "JUJU_UNIT_NAME=wordpress/0", "CLOUD_API_VERSION=1.29"},
+		"/charm", "/replay/tools",
+		sockets.Socket{Network: "unix", Address: "/replay/agent.socket"},
+	)
+	c.Assert(env, tc.SameContents, []string{
+		"HOME=/home/me",
+		"PATH=/replay/tools:/usr/bin",
+		"JUJU_MODEL_NAME=prod",
+		"JUJU_UNIT_NAME=wordpress/0",
+		"CLOUD_API_VERSION=1.29",
+		"CHARM_DIR=/charm",
+		"JUJU_CHARM_DIR=/charm",
+		"JUJU_AGENT_SOCKET_ADDRESS=/replay/agent.socket",
+		"JUJU_AGENT_SOCKET_NETWORK=unix",
+	})
+}
@@ -0,0 +1,712 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/life"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/relation"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Context is a jujuc.Context serving the hook tools from a captured bundle.
+// Reads are answered from the bundle. Writes are applied to the bundle, so
+// that later reads in the same hook see them, and are recorded as changes,
+// but never leave the context.
+// The jujuc server runs a single hook tool at a time, so only the changes
+// need guarding against concurrent access.
+type Context struct {
+	bundle *Bundle
+	ports  network.GroupedPortRanges
+	logger corelogger.Logger
+
+	mu      sync.Mutex
+	changes []string
+}
+
+var _ jujuc.Context = (*Context)(nil)
+
+// NewContext returns a Context serving the hook tools from the input bundle.
+// Changes made through the hook tools are applied to the bundle.
+func NewContext(bundle *Bundle, logger corelogger.Logger) (*Context, error) {
+	ports := make(network.GroupedPortRanges)
+	for endpoint, portRanges := range bundle.OpenedPorts {
+		for _, portRange := range portRanges {
+			parsed, err := network.ParsePortRange(portRange)
+			if err != nil {
+				return nil, errors.Annotatef(err, "parsing opened ports of %q", endpoint)
+			}
+			ports[endpoint] = append(ports[endpoint], parsed)
+		}
+	}
+	return &Context{
+		bundle: bundle,
+		ports:  ports,
+		logger: logger,
+	}, nil
+}
+
+// Changes returns descriptions of the changes made through the hook tools,
+// in the order they were made.
+func (c *Context) Changes() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.changes)
+}
+
+func (c *Context) record(format string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.changes = append(c.changes, fmt.Sprintf(format, args...))
+}
+
+// GetLoggerByName is part of the jujuc.Context interface.
+func (c *Context) GetLoggerByName(module string) corelogger.Logger {
+	return c.logger.GetChildByName(module)
+}
+
+// UnitName is part of the jujuc.ContextUnit interface.
+func (c *Context) UnitName() string {
+	return c.bundle.Unit
+}
+
+// ConfigSettings is part of the jujuc.ContextUnit interface.
+func (c *Context) ConfigSettings(context.Context) (charm.Config, error) {
+	return maps.Clone(c.bundle.Config), nil
+}
+
+// GoalState is part of the jujuc.ContextUnit interface.
+func (c *Context) GoalState(context.Context) (*application.GoalState, error) {
+	if c.bundle.GoalState == nil {
+		return nil, errors.NotFoundf("goal state")
+	}
+	goalState := &application.GoalState{
+		Units:     unitsGoalState(c.bundle.GoalState.Units),
+		Relations: make(map[string]application.UnitsGoalState),
+	}
+	for name, units := range c.bundle.GoalState.Relations {
+		goalState.Relations[name] = unitsGoalState(units)
+	}
+	return goalState, nil
+}
+
+func unitsGoalState(units map[string]GoalStateStatus) application.UnitsGoalState {
+	result := make(application.UnitsGoalState, len(units))
+	for name, status := range units {
+		result[name] = application.GoalStateStatus{Status: status.Status, Since: status.Since}
+	}
+	return result
+}
+
+// CloudSpec is part of the jujuc.ContextUnit interface. Credentials are
+// never captured.
+func (c *Context) CloudSpec(context.Context) (*params.CloudSpec, error) {
+	return nil, errors.NotSupportedf("cloud credentials in a replayed hook")
+}
+
+// GetSecret is part of the jujuc.ContextSecrets interface. Secret contents
+// are never captured.
+func (c *Context) GetSecret(context.Context, *secrets.URI, string, bool, bool) (secrets.SecretValue, error) {
+	return nil, errors.NotSupportedf("secret content in a replayed hook")
+}
+
+// CreateSecret is part of the jujuc.ContextSecrets interface.
+func (c *Context) CreateSecret(_ context.Context, args *jujuc.SecretCreateArgs) (*secrets.URI, error) {
+	uri := secrets.NewURI()
+	c.record("secret-add %s (owner %s)", uri.ID, args.Owner)
+	return uri, nil
+}
+
+// UpdateSecret is part of the jujuc.ContextSecrets interface.
+func (c *Context) UpdateSecret(_ context.Context, uri *secrets.URI, _ *jujuc.SecretUpdateArgs) error {
+	c.record("secret-set %s", uri.ID)
+	return nil
+}
+
+// RemoveSecret is part of the jujuc.ContextSecrets interface.
+func (c *Context) RemoveSecret(_ context.Context, uri *secrets.URI, revision *int) error {
+	if revision != nil {
+		c.record("secret-remove %s --revision %d", uri.ID, *revision)
+	} else {
+		c.record("secret-remove %s", uri.ID)
+	}
+	return nil
+}
+
+// GrantSecret is part of the jujuc.ContextSecrets interface.
+func (c *Context) GrantSecret(_ context.Context, uri *secrets.URI, args *jujuc.SecretGrantRevokeArgs) error {
+	c.record("secret-grant %s%s", uri.ID, grantRevokeArgs(args))
+	return nil
+}
+
+// RevokeSecret is part of the jujuc.ContextSecrets interface.
+func (c *Context) RevokeSecret(_ context.Context, uri *secrets.URI, args *jujuc.SecretGrantRevokeArgs) error {
+	c.record("secret-revoke %s%s", uri.ID, grantRevokeArgs(args))
+	return nil
+}
+
+func grantRevokeArgs(args *jujuc.SecretGrantRevokeArgs) string {
+	var s string
+	if args.ApplicationName != nil {
+		s += " --app " + *args.ApplicationName
+	}
+	if args.UnitName != nil {
+		s += " --unit " + *args.UnitName
+	}
+	if args.RelationKey != nil {
+		s += " --relation " + *args.RelationKey
+	}
+	return s
+}
+
+// SecretMetadata is part of the jujuc.ContextSecrets interface.
+func (c *Context) SecretMetadata(context.Context) (map[string]jujuc.SecretMetadata, error) {
+	result := make(map[string]jujuc.SecretMetadata, len(c.bundle.Secrets))
+	for id, md := range c.bundle.Secrets {
+		result[id] = jujuc.SecretMetadata{
+			Owner: secrets.Owner{
+				Kind: secrets.OwnerKind(md.OwnerKind),
+				ID:   md.OwnerId,
+			},
+			Description:      md.Description,
+			Label:            md.Label,
+			RotatePolicy:     secrets.RotatePolicy(md.RotatePolicy),
+			LatestRevision:   md.LatestRevision,
+			LatestExpireTime: md.LatestExpireTime,
+			LatestChecksum:   md.LatestChecksum,
+			NextRotateTime:   md.NextRotateTime,
+			Access:           md.Access,
+		}
+	}
+	return result, nil
+}
+
+// UnitStatus is part of the jujuc.ContextStatus interface.
+func (c *Context) UnitStatus(context.Context) (*jujuc.StatusInfo, error) {
+	if c.bundle.UnitStatus == nil {
+		return nil, errors.NotFoundf("unit status")
+	}
+	info := jujucStatusInfo(*c.bundle.UnitStatus)
+	return &info, nil
+}
+
+// SetUnitStatus is part of the jujuc.ContextStatus interface.
+func (c *Context) SetUnitStatus(_ context.Context, info jujuc.StatusInfo) error {
+	c.record("status-set %s %q", info.Status, info.Info)
+	status := statusInfo(info)
+	c.bundle.UnitStatus = &status
+	return nil
+}
+
+// ApplicationStatus is part of the jujuc.ContextStatus interface.
+func (c *Context) ApplicationStatus(context.Context) (jujuc.ApplicationStatusInfo, error) {
+	if !c.bundle.IsLeader || c.bundle.ApplicationStatus == nil {
+		return jujuc.ApplicationStatusInfo{}, errors.New("this unit is not the leader")
+	}
+	result := jujuc.ApplicationStatusInfo{
+		Application: jujucStatusInfo(c.bundle.ApplicationStatus.Application),
+	}
+	for _, unit := range c.bundle.ApplicationStatus.Units {
+		result.Units = append(result.Units, jujucStatusInfo(unit))
+	}
+	return result, nil
+}
+
+// SetApplicationStatus is part of the jujuc.ContextStatus interface.
+func (c *Context) SetApplicationStatus(_ context.Context, info jujuc.StatusInfo) error {
+	if !c.bundle.IsLeader {
+		return errors.New("this unit is not the leader")
+	}
+	c.record("status-set --application %s %q", info.Status, info.Info)
+	if c.bundle.ApplicationStatus == nil {
+		c.bundle.ApplicationStatus = &ApplicationStatus{}
+	}
+	c.bundle.ApplicationStatus.Application = statusInfo(info)
+	return nil
+}
+
+func jujucStatusInfo(info StatusInfo) jujuc.StatusInfo {
+	return jujuc.StatusInfo{
+		Tag:    info.Tag,
+		Status: info.Status,
+		Info:   info.Info,
+		Data:   info.Data,
+	}
+}
+
+// AvailabilityZone is part of the jujuc.ContextInstance interface.
+func (c *Context) AvailabilityZone() (string, error) {
+	if c.bundle.AvailabilityZone == "" {
+		return "", errors.NotFoundf("availability zone")
+	}
+	return c.bundle.AvailabilityZone, nil
+}
+
+// RequestReboot is part of the jujuc.ContextInstance interface.
+func (c *Context) RequestReboot(priority jujuc.RebootPriority) error {
+	if priority == jujuc.RebootNow {
+		c.record("juju-reboot --now")
+	} else {
+		c.record("juju-reboot")
+	}
+	return nil
+}
+
+// PublicAddress is part of the jujuc.ContextNetworking interface.
+func (c *Context) PublicAddress(context.Context) (string, error) {
+	if c.bundle.PublicAddress == "" {
+		return "", errors.NotFoundf("public address")
+	}
+	return c.bundle.PublicAddress, nil
+}
+
+// PrivateAddress is part of the jujuc.ContextNetworking interface.
+func (c *Context) PrivateAddress() (string, error) {
+	if c.bundle.PrivateAddress == "" {
+		return "", errors.NotFoundf("private address")
+	}
+	return c.bundle.PrivateAddress, nil
+}
+
+// OpenPortRange is part of the jujuc.ContextNetworking interface.
+func (c *Context) OpenPortRange(endpointName string, portRange network.PortRange) error {
+	c.record("open-port %s%s", portRange, endpointsArg(endpointName))
+	if !slices.Contains(c.ports[endpointName], portRange) {
+		c.ports[endpointName] = append(c.ports[endpointName], portRange)
+	}
+	return nil
+}
+
+// ClosePortRange is part of the jujuc.ContextNetworking interface.
+func (c *Context) ClosePortRange(endpointName string, portRange network.PortRange) error {
+	c.record("close-port %s%s", portRange, endpointsArg(endpointName))
+	c.ports[endpointName] = slices.DeleteFunc(c.ports[endpointName], func(p network.PortRange) bool {
+		return p == portRange
+	})
+	if len(c.ports[endpointName]) == 0 {
+		delete(c.ports, endpointName)
+	}
+	return nil
+}
+
+func endpointsArg(endpointName string) string {
+	if endpointName == "" {
+		return ""
+	}
+	return " --endpoints " + endpointName
+}
+
+// OpenedPortRanges is part of the jujuc.ContextNetworking interface.
+func (c *Context) OpenedPortRanges() network.GroupedPortRanges {
+	result := make(network.GroupedPortRanges, len(c.ports))
+	for endpoint, portRanges := range c.ports {
+		result[endpoint] = slices.Clone(portRanges)
+	}
+	return result
+}
+
+// NetworkInfo is part of the jujuc.ContextNetworking interface. Only the
+// network info of the endpoints of the unit's relations is captured.
+func (c *Context) NetworkInfo(
+	_ context.Context, bindingNames []string, relationId int,
+) (map[string]params.NetworkInfoResult, error) {
+	captured := c.bundle.NetworkInfo
+	if relationId != -1 {
+		r, err := c.relation(relationId)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		captured = r.NetworkInfo
+	}
+	result := make(map[string]params.NetworkInfoResult, len(bindingNames))
+	for _, name := range bindingNames {
+		info, ok := captured[name]
+		if !ok {
+			info = params.NetworkInfoResult{Error: &params.Error{
+				Code:    params.CodeNotFound,
+				Message: fmt.Sprintf("network info for binding %q not captured", name),
+			}}
+		}
+		result[name] = info
+	}
+	return result, nil
+}
+
+// IsLeader is part of the jujuc.ContextLeadership interface.
+func (c *Context) IsLeader() (bool, error) {
+	return c.bundle.IsLeader, nil
+}
+
+// StorageTags is part of the jujuc.ContextStorage interface.
+func (c *Context) StorageTags(context.Context) ([]names.StorageTag, error) {
+	tags := make([]names.StorageTag, len(c.bundle.Storage))
+	for i, attachment := range c.bundle.Storage {
+		tags[i] = names.NewStorageTag(attachment.Tag)
+	}
+	return tags, nil
+}
+
+// Storage is part of the jujuc.ContextStorage interface.
+func (c *Context) Storage(_ context.Context, tag names.StorageTag) (jujuc.ContextStorageAttachment, error) {
+	for _, attachment := range c.bundle.Storage {
+		if attachment.Tag == tag.Id() {
+			return storageAttachment{attachment: attachment}, nil
+		}
+	}
+	return nil, errors.NotFoundf("storage %q", tag.Id())
+}
+
+// HookStorage is part of the jujuc.ContextStorage interface.
+func (c *Context) HookStorage(ctx context.Context) (jujuc.ContextStorageAttachment, error) {
+	if c.bundle.HookStorage == "" {
+		return nil, errors.NotFound
+	}
+	return c.Storage(ctx, names.NewStorageTag(c.bundle.HookStorage))
+}
+
+// AddUnitStorage is part of the jujuc.ContextStorage interface.
+func (c *Context) AddUnitStorage(directives map[string]params.StorageDirectives) error {
+	for _, name := range slices.Sorted(maps.Keys(directives)) {
+		count := 1
+		if directives[name].Count != nil {
+			count = int(*directives[name].Count)
+		}
+		c.record("storage-add %s=%d", name, count)
+	}
+	return nil
+}
+
+// storageAttachment is a jujuc.ContextStorageAttachment serving a captured
+// storage attachment.
+type storageAttachment struct {
+	attachment StorageAttachment
+}
+
+// Tag is part of the jujuc.ContextStorageAttachment interface.
+func (s storageAttachment) Tag() names.StorageTag {
+	return names.NewStorageTag(s.attachment.Tag)
+}
+
+// Kind is part of the jujuc.ContextStorageAttachment interface.
+func (s storageAttachment) Kind() storage.StorageKind {
+	switch s.attachment.Kind {
+	case storage.StorageKindBlock.String():
+		return storage.StorageKindBlock
+	case storage.StorageKindFilesystem.String():
+		return storage.StorageKindFilesystem
+	}
+	return storage.StorageKindUnknown
+}
+
+// Location is part of the jujuc.ContextStorageAttachment interface.
+func (s storageAttachment) Location() string {
+	return s.attachment.Location
+}
+
+// DownloadResource is part of the jujuc.ContextResources interface.
+// Resources are never captured.
+func (c *Context) DownloadResource(context.Context, string) (string, error) {
+	return "", errors.NotSupportedf("resources in a replayed hook")
+}
+
+// Relation is part of the jujuc.ContextRelations interface.
+func (c *Context) Relation(id int) (jujuc.ContextRelation, error) {
+	r, err := c.relation(id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &contextRelation{ctx: c, relation: r}, nil
+}
+
+func (c *Context) relation(id int) (*Relation, error) {
+	for i := range c.bundle.Relations {
+		if c.bundle.Relations[i].Id == id {
+			return &c.bundle.Relations[i], nil
+		}
+	}
+	return nil, errors.NotFoundf("relation")
+}
+
+// RelationIds is part of the jujuc.ContextRelations interface.
+func (c *Context) RelationIds() ([]int, error) {
+	ids := make([]int, len(c.bundle.Relations))
+	for i, r := range c.bundle.Relations {
+		ids[i] = r.Id
+	}
+	return ids, nil
+}
+
+// HookRelation is part of the jujuc.Context interface.
+func (c *Context) HookRelation() (jujuc.ContextRelation, error) {
+	if c.bundle.HookRelationId == nil {
+		return nil, errors.NotFoundf("relation")
+	}
+	return c.Relation(*c.bundle.HookRelationId)
+}
+
+// RemoteUnitName is part of the jujuc.Context interface.
+func (c *Context) RemoteUnitName() (string, error) {
+	if c.bundle.RemoteUnit == "" {
+		return "", errors.NotFoundf("remote unit")
+	}
+	return c.bundle.RemoteUnit, nil
+}
+
+// RemoteApplicationName is part of the jujuc.Context interface.
+func (c *Context) RemoteApplicationName() (string, error) {
+	if c.bundle.RemoteApplication == "" {
+		return "", errors.NotFoundf("saas application")
+	}
+	return c.bundle.RemoteApplication, nil
+}
+
+// UnitWorkloadVersion is part of the jujuc.ContextVersion interface.
+func (c *Context) UnitWorkloadVersion(context.Context) (string, error) {
+	return c.bundle.WorkloadVersion, nil
+}
+
+// SetUnitWorkloadVersion is part of the jujuc.ContextVersion interface.
+func (c *Context) SetUnitWorkloadVersion(_ context.Context, version string) error {
+	c.record("application-version-set %q", version)
+	c.bundle.WorkloadVersion = version
+	return nil
+}
+
+func (c *Context) runningAction() bool {
+	for _, v := range c.bundle.Env {
+		if strings.HasPrefix(v, "JUJU_ACTION_NAME=") {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionParams is part of the jujuc.Context interface.
+func (c *Context) ActionParams() (map[string]any, error) {
+	if !c.runningAction() {
+		return nil, errors.New("not running an action")
+	}
+	if c.bundle.ActionParams == nil {
+		return map[string]any{}, nil
+	}
+	return c.bundle.ActionParams, nil
+}
+
+// UpdateActionResults is part of the jujuc.Context interface.
+func (c *Context) UpdateActionResults(keys []string, value any) error {
+	if !c.runningAction() {
+		return errors.New("not running an action")
+	}
+	c.record("action-set %s=%v", strings.Join(keys, "."), value)
+	return nil
+}
+
+// SetActionMessage is part of the jujuc.Context interface.
+func (c *Context) SetActionMessage(message string) error {
+	if !c.runningAction() {
+		return errors.New("not running an action")
+	}
+	c.record("action-fail %q", message)
+	return nil
+}
+
+// SetActionFailed is part of the jujuc.Context interface.
+func (c *Context) SetActionFailed() error {
+	if !c.runningAction() {
+		return errors.New("not running an action")
+	}
+	return nil
+}
+
+// LogActionMessage is part of the jujuc.Context interface.
+func (c *Context) LogActionMessage(_ context.Context, message string) error {
+	if !c.runningAction() {
+		return errors.New("not running an action")
+	}
+	c.record("action-log %q", message)
+	return nil
+}
+
+// WorkloadName is part of the jujuc.Context interface.
+func (c *Context) WorkloadName() (string, error) {
+	if c.bundle.WorkloadName == "" {
+		return "", errors.NotFoundf("workload name")
+	}
+	return c.bundle.WorkloadName, nil
+}
+
+// GetCharmState is part of the jujuc.Context interface.
+func (c *Context) GetCharmState(context.Context) (map[string]string, error) {
+	return maps.Clone(c.bundle.CharmState), nil
+}
+
+// GetCharmStateValue is part of the jujuc.Context interface.
+func (c *Context) GetCharmStateValue(_ context.Context, key string) (string, error) {
+	value, ok := c.bundle.CharmState[key]
+	if !ok {
+		return "", errors.NotFoundf("%q", key)
+	}
+	return value, nil
+}
+
+// DeleteCharmStateValue is part of the jujuc.Context interface.
+func (c *Context) DeleteCharmStateValue(_ context.Context, key string) error {
+	c.record("state-delete %s", key)
+	delete(c.bundle.CharmState, key)
+	return nil
+}
+
+// SetCharmStateValue is part of the jujuc.Context interface.
+func (c *Context) SetCharmStateValue(_ context.Context, key, value string) error {
+	c.record("state-set %s=%s", key, value)
+	if c.bundle.CharmState == nil {
+		c.bundle.CharmState = make(map[string]string)
+	}
+	c.bundle.CharmState[key] = value
+	return nil
+}
+
+// contextRelation is a jujuc.ContextRelation serving a captured relation.
+type contextRelation struct {
+	ctx      *Context
+	relation *Relation
+}
+
+// Id is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) Id() int {
+	return r.relation.Id
+}
+
+// Name is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) Name() string {
+	return r.relation.Endpoint
+}
+
+// RelationTag is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) RelationTag() names.RelationTag {
+	return names.NewRelationTag(r.relation.Key)
+}
+
+// FakeId is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) FakeId() string {
+	return fmt.Sprintf("%s:%d", r.relation.Endpoint, r.relation.Id)
+}
+
+// Settings is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) Settings(context.Context) (jujuc.Settings, error) {
+	if r.relation.UnitSettings == nil {
+		r.relation.UnitSettings = make(params.Settings)
+	}
+	return &settings{relation: r, values: r.relation.UnitSettings}, nil
+}
+
+// ApplicationSettings is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) ApplicationSettings(context.Context) (jujuc.Settings, error) {
+	if !r.ctx.bundle.IsLeader {
+		return nil, errors.New("permission denied")
+	}
+	if r.relation.ApplicationSettings == nil {
+		r.relation.ApplicationSettings = make(params.Settings)
+	}
+	return &settings{relation: r, app: true, values: r.relation.ApplicationSettings}, nil
+}
+
+// UnitNames is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) UnitNames() []string {
+	units := slices.Clone(r.relation.Units)
+	sort.Strings(units)
+	return units
+}
+
+// ReadSettings is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) ReadSettings(_ context.Context, unit string) (params.Settings, error) {
+	settings, ok := r.relation.RemoteUnitSettings[unit]
+	if !ok {
+		return nil, errors.NotFoundf("settings for unit %q in relation %d", unit, r.relation.Id)
+	}
+	return maps.Clone(settings), nil
+}
+
+// ReadApplicationSettings is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) ReadApplicationSettings(_ context.Context, app string) (params.Settings, error) {
+	if app != r.relation.RemoteApplication || r.relation.RemoteApplicationSettings == nil {
+		return nil, errors.NotFoundf("settings for application %q in relation %d", app, r.relation.Id)
+	}
+	return maps.Clone(r.relation.RemoteApplicationSettings), nil
+}
+
+// Suspended is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) Suspended() bool {
+	return r.relation.Suspended
+}
+
+// SetStatus is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) SetStatus(_ context.Context, status relation.Status) error {
+	r.ctx.record("relation status of %s set to %s", r.FakeId(), status)
+	return nil
+}
+
+// RemoteApplicationName is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) RemoteApplicationName() string {
+	return r.relation.RemoteApplication
+}
+
+// RemoteModelUUID is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) RemoteModelUUID() string {
+	return r.relation.RemoteModelUUID
+}
+
+// Life is part of the jujuc.ContextRelation interface.
+func (r *contextRelation) Life() life.Value {
+	return life.Value(r.relation.Life)
+}
+
+// settings is a jujuc.Settings recording the changes made to the local
+// unit or application settings of a captured relation.
+type settings struct {
+	relation *contextRelation
+	app      bool
+	values   params.Settings
+}
+
+// Map is part of the jujuc.Settings interface.
+func (s *settings) Map() params.Settings {
+	return maps.Clone(s.values)
+}
+
+// Set is part of the jujuc.Settings interface.
+func (s *settings) Set(key, value string) {
+	s.relation.ctx.record("relation-set -r %s%s %s=%s", s.relation.FakeId(), s.appArg(), key, value)
+	s.values[key] = value
+}
+
+// Delete is part of the jujuc.Settings interface.
+func (s *settings) Delete(key string) {
+	s.relation.ctx.record("relation-set -r %s%s %s=", s.relation.FakeId(), s.appArg(), key)
+	delete(s.values, key)
+}
+
+func (s *settings) appArg() string {
+	if s.app {
+		return " --app"
+	}
+	return ""
+}
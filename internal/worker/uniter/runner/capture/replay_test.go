@@ -0,0 +1,107 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package capture_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/tc"
+	"github.com/juju/utils/v4/exec"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/juju/sockets"
+)
+
+type ReplayContextSuite struct {
+	bundle *capture.Bundle
+	hctx   *capture.Context
+	socket sockets.Socket
+}
+
+func TestReplayContextSuite(t *testing.T) {
+	tc.Run(t, &ReplayContextSuite{})
+}
+
+func (s *ReplayContextSuite) SetUpTest(c *tc.C) {
+	s.bundle = newTestBundle()
+	hctx, err := capture.NewContext(s.bundle, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+	s.hctx = hctx
+
+	s.socket = sockets.Socket{
+		Network: "unix",
+		Address: filepath.Join(c.MkDir(), "agent.socket"),
+	}
+	srv, err := capture.StartJujucServer(hctx, s.bundle.ContextId, s.socket)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Cleanup(srv.Close)
+}
+
+func (s *ReplayContextSuite) run(c *tc.C, contextId, name string, args ...string) (exec.ExecResponse, error) {
+	client, err := sockets.Dial(s.socket)
+	c.Assert(err, tc.ErrorIsNil)
+	defer client.Close()
+	var resp exec.ExecResponse
+	err = client.Call("Jujuc.Main", jujuc.Request{
+		ContextId:   contextId,
+		Dir:         c.MkDir(),
+		CommandName: name,
+		Args:        args,
+	}, &resp)
+	return resp, err
+}
+
+func (s *ReplayContextSuite) runTool(c *tc.C, name string, args ...string) string {
+	resp, err := s.run(c, s.bundle.ContextId, name, args...)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(resp.Code, tc.Equals, 0, tc.Commentf("stderr: %s", resp.Stderr))
+	return string(resp.Stdout)
+}
+
+func (s *ReplayContextSuite) TestWrongContextId(c *tc.C) {
+	_, err := s.run(c, "other-context", "config-get")
+	c.Assert(err, tc.ErrorMatches, `bad request: wrong context ID; got "other-context"`)
+}
+
+func (s *ReplayContextSuite) TestReadTools(c *tc.C) {
+	c.Check(s.runTool(c, "config-get", "blog-title"), tc.Equals, "My Blog\n")
+	c.Check(s.runTool(c, "is-leader"), tc.Equals, "True\n")
+	c.Check(s.runTool(c, "unit-get", "private-address"), tc.Equals, "10.0.0.1\n")
+	c.Check(s.runTool(c, "relation-ids", "db"), tc.Equals, "db:1\n")
+	c.Check(s.runTool(c, "relation-list"), tc.Equals, "mysql/0\n")
+	c.Check(s.runTool(c, "relation-get", "user"), tc.Equals, "admin\n")
+	c.Check(s.runTool(c, "relation-get", "--app", "host", "mysql"), tc.Equals, "10.0.0.2\n")
+	c.Check(s.runTool(c, "state-get", "installed"), tc.Equals, "true\n")
+	c.Check(s.runTool(c, "opened-ports"), tc.Equals, "80/tcp\n")
+	c.Check(s.hctx.Changes(), tc.HasLen, 0)
+}
+
+func (s *ReplayContextSuite) TestWriteTools(c *tc.C) {
+	s.runTool(c, "relation-set", "-r", "db:1", "ready=true")
+	s.runTool(c, "status-set", "maintenance", "upgrading")
+	s.runTool(c, "open-port", "443/tcp")
+	s.runTool(c, "state-set", "installed=false")
+
+	// Later hook tools see the changes.
+	c.Check(s.runTool(c, "relation-get", "-r", "db:1", "ready", "wordpress/0"), tc.Equals, "true\n")
+	c.Check(s.runTool(c, "state-get", "installed"), tc.Equals, "false\n")
+	c.Check(s.runTool(c, "opened-ports"), tc.Equals, "80/tcp\n443/tcp\n")
+
+	c.Check(s.hctx.Changes(), tc.DeepEquals, []string{
+		"relation-set -r db:1 ready=true",
+		`status-set maintenance "upgrading"`,
+		"open-port 443/tcp",
+		"state-set installed=false",
+	})
+}
+
+func (s *ReplayContextSuite) TestNotCaptured(c *tc.C) {
+	resp, err := s.run(c, s.bundle.ContextId, "secret-get", "secret:cs7qvqg8qq9s1a8nk6p0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(resp.Code, tc.Not(tc.Equals), 0)
+	c.Check(string(resp.Stderr), tc.Equals, "ERROR secret content in a replayed hook not supported\n")
+}
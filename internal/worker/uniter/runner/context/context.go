@@ -55,6 +55,7 @@ type Context interface {
 	HasExecutionSetUnitStatus() bool
 	ResetExecutionSetUnitStatus()
 	ModelType() model.ModelType
+	HookTimeout() time.Duration
 
 	Prepare(ctx context.Context) error
 	Flush(ctx context.Context, badge string, failure error) error
@@ -259,6 +260,10 @@ type HookContext struct {
 	// with the running hook.
 	storageTag names.StorageTag
 
+	// storageSnapshotId is the id of the storage snapshot associated with
+	// the running hook.
+	storageSnapshotId string
+
 	// storageTags returns the tags of storage instances attached to the unit
 	storageTags []names.StorageTag
 
@@ -291,6 +296,10 @@ type HookContext struct {
 	// The cloud API version, if available.
 	cloudAPIVersion string
 
+	// hookTimeout is how long a hook run in this context may take before it
+	// is killed. A zero value means that the hook is not timed out.
+	hookTimeout time.Duration
+
 	// A cached view of the unit's charm state that gets persisted by juju
 	// once the context is flushed.
 	cachedCharmState map[string]string
@@ -627,6 +636,11 @@ func (c *HookContext) ResetExecutionSetUnitStatus() {
 	c.hasRunStatusSet = false
 }
 
+// HookTimeout implements runner.Context.
+func (c *HookContext) HookTimeout() time.Duration {
+	return c.hookTimeout
+}
+
 // PublicAddress fetches the executing unit's public address if it has
 // not yet been retrieved.
 // The cached value is returned, or an error if it is not available.
@@ -1495,6 +1509,9 @@ func (c *HookContext) HookVars(
 			"JUJU_STORAGE_LOCATION="+storage.Location(),
 			"JUJU_STORAGE_KIND="+storage.Kind().String(),
 		)
+		if c.storageSnapshotId != "" {
+			vars = append(vars, "JUJU_STORAGE_SNAPSHOT_ID="+c.storageSnapshotId)
+		}
 	} else if !errors.Is(err, errors.NotFound) && !errors.Is(err, errors.NotProvisioned) {
 		return nil, errors.Trace(err)
 	}
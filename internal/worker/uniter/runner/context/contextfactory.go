@@ -262,6 +262,7 @@ func (f *contextFactory) HookContext(stdCtx context.Context, hookInfo hook.Info)
 	}
 	if hookInfo.Kind.IsStorage() {
 		ctx.storageTag = names.NewStorageTag(hookInfo.StorageId)
+		ctx.storageSnapshotId = hookInfo.SnapshotId
 		storageName, err := names.StorageName(hookInfo.StorageId)
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -384,6 +385,7 @@ func (f *contextFactory) updateContext(stdCtx context.Context, ctx *HookContext)
 	ctx.legacyProxySettings = info.LegacyProxySettings
 	ctx.jujuProxySettings = info.JujuProxySettings
 	ctx.charmTracingConfig = info.CharmTracingConfig
+	ctx.hookTimeout = info.HookTimeout
 
 	if f.modelType == model.IAAS {
 		if info.PrivateAddress != nil {
@@ -64,6 +64,7 @@ func (s *ContextFactorySuite) setupContextFactory(c *tc.C, ctrl *gomock.Controll
 		APIAddresses:    []string{"10.6.6.6"},
 		CloudAPIVersion: "6.6.6",
 		PrivateAddress:  &privateAddress,
+		HookTimeout:     10 * time.Minute,
 	}, nil).AnyTimes()
 
 	contextFactory, err := context.NewContextFactory(c.Context(), context.FactoryConfig{
@@ -137,6 +138,16 @@ func (s *ContextFactorySuite) TestRelationHookContext(c *tc.C) {
 	s.AssertNotSecretContext(c, ctx)
 }
 
+func (s *ContextFactorySuite) TestHookContextHookTimeout(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.setupContextFactory(c, ctrl)
+
+	ctx, err := s.factory.HookContext(c.Context(), hook.Info{Kind: hooks.ConfigChanged})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ctx.HookTimeout(), tc.Equals, 10*time.Minute)
+}
+
 func (s *ContextFactorySuite) TestWorkloadHookContext(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -194,6 +194,17 @@ func (s *EnvSuite) setStorage(ctx *context.HookContext) (expectVars []string) {
 	}
 }
 
+func (s *EnvSuite) setStorageSnapshot(ctx *context.HookContext) (expectVars []string) {
+	tag := names.NewStorageTag("data/0")
+	context.SetEnvironmentHookContextStorageSnapshot(ctx, tag, "3")
+	return []string{
+		"JUJU_STORAGE_ID=data/0",
+		"JUJU_STORAGE_KIND=block",
+		"JUJU_STORAGE_LOCATION=/dev/sdb",
+		"JUJU_STORAGE_SNAPSHOT_ID=3",
+	}
+}
+
 func (s *EnvSuite) TestHostEnv(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -264,6 +275,55 @@ func (s *EnvSuite) TestHostEnv(c *tc.C) {
 	)
 }
 
+func (s *EnvSuite) TestHostEnvStorageSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	state := api.NewMockUniterClient(ctrl)
+	state.EXPECT().StorageAttachment(gomock.Any(), names.NewStorageTag("data/0"), names.NewUnitTag("this-unit/123")).Return(params.StorageAttachment{
+		Kind:     params.StorageKindBlock,
+		Location: "/dev/sdb",
+	}, nil).AnyTimes()
+	unit := api.NewMockUnit(ctrl)
+	unit.EXPECT().Tag().Return(names.NewUnitTag("this-unit/123")).AnyTimes()
+
+	s.PatchValue(&jujuos.HostOS, func() ostype.OSType { return ostype.Ubuntu })
+	s.PatchValue(&jujuversion.Current, semversion.MustParse("1.2.3"))
+
+	ubuntuVars := []string{
+		"APT_LISTCHANGES_FRONTEND=none",
+		"DEBIAN_FRONTEND=noninteractive",
+		"LANG=C.UTF-8",
+		"PATH=path-to-tools:foo:bar",
+		"TERM=tmux-256color",
+	}
+
+	environmenter := context.NewRemoteEnvironmenter(
+		func() []string { return []string{} },
+		func(k string) string {
+			switch k {
+			case "PATH":
+				return "foo:bar"
+			}
+			return ""
+		},
+		func(k string) (string, bool) {
+			switch k {
+			case "PATH":
+				return "foo:bar", true
+			}
+			return "", false
+		},
+	)
+
+	hookContext, contextVars := s.getHookContext(c, false, state, unit)
+	paths, pathsVars := s.getPaths()
+	snapshotVars := s.setStorageSnapshot(hookContext)
+	actualVars, err := hookContext.HookVars(c.Context(), paths, environmenter)
+	c.Assert(err, tc.ErrorIsNil)
+	s.assertVars(c, actualVars, contextVars, pathsVars, ubuntuVars, snapshotVars)
+}
+
 func (s *EnvSuite) TestContextDependentDoesNotIncludeUnSet(c *tc.C) {
 	environmenter := context.NewRemoteEnvironmenter(
 		func() []string { return []string{} },
@@ -190,6 +190,13 @@ func SetEnvironmentHookContextStorage(context *HookContext, storageTag names.Sto
 	context.storageTag = storageTag
 }
 
+// SetEnvironmentHookContextStorageSnapshot exists purely to set the fields used in hookVars.
+// It makes no assumptions about the validity of context.
+func SetEnvironmentHookContextStorageSnapshot(context *HookContext, storageTag names.StorageTag, snapshotId string) {
+	context.storageTag = storageTag
+	context.storageSnapshotId = snapshotId
+}
+
 // SetEnvironmentHookContextWorkload exists purely to set the fields used in hookVars.
 // It makes no assumptions about the validity of context.
 func SetEnvironmentHookContextWorkload(context *HookContext, workloadName string) {
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	application "github.com/juju/juju/core/application"
 	logger "github.com/juju/juju/core/logger"
@@ -861,6 +862,44 @@ func (c *MockContextHookStorageCall) DoAndReturn(f func(context.Context) (jujuc.
 	return c
 }
 
+// HookTimeout mocks base method.
+func (m *MockContext) HookTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HookTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HookTimeout indicates an expected call of HookTimeout.
+func (mr *MockContextMockRecorder) HookTimeout() *MockContextHookTimeoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HookTimeout", reflect.TypeOf((*MockContext)(nil).HookTimeout))
+	return &MockContextHookTimeoutCall{Call: call}
+}
+
+// MockContextHookTimeoutCall wrap *gomock.Call
+type MockContextHookTimeoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextHookTimeoutCall) Return(arg0 time.Duration) *MockContextHookTimeoutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextHookTimeoutCall) Do(f func() time.Duration) *MockContextHookTimeoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextHookTimeoutCall) DoAndReturn(f func() time.Duration) *MockContextHookTimeoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // HookVars mocks base method.
 func (m *MockContext) HookVars(arg0 context.Context, arg1 context0.Paths, arg2 context0.Environmenter) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -26,6 +26,7 @@ import (
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/operation"
 	"github.com/juju/juju/internal/worker/common/charmrunner"
+	"github.com/juju/juju/internal/worker/uniter/runner/capture"
 	"github.com/juju/juju/internal/worker/uniter/runner/context"
 	"github.com/juju/juju/internal/worker/uniter/runner/debug"
 	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
@@ -339,6 +340,16 @@ func (runner *runner) runCharmHookWithLocation(ctx stdcontext.Context, hookName,
 	}()
 
 	logger := runner.logger()
+	capturectx := capture.NewHookCapture(runner.context.UnitName())
+	if request, _ := capturectx.FindRequest(); request != nil && request.MatchHook(hookName) {
+		// A failed capture must not fail the hook.
+		if err := runner.captureHook(ctx, request, hookName, env); err != nil {
+			logger.Warningf(ctx, "cannot capture %s: %v", hookName, err)
+		} else {
+			logger.Infof(ctx, "captured %s for capture-hook", hookName)
+		}
+	}
+
 	debugctx := debug.NewHooksContext(runner.context.UnitName())
 	if session, _ := debugctx.FindSession(); session != nil && session.MatchHook(hookName) {
 		// Note: hookScript might be relative but the debug session only requires its name
@@ -364,6 +375,16 @@ func (runner *runner) runCharmHookWithLocation(ctx stdcontext.Context, hookName,
 	return hookHandlerType, runner.runCharmProcessOnLocal(hookScript, hookName, charmDir, env)
 }
 
+// captureHook writes the execution context of the hook, which is about to
+// run with the input environment, for the pending capture-hook request.
+func (runner *runner) captureHook(ctx stdcontext.Context, request *capture.Request, hookName string, env []string) error {
+	bundle, err := capture.NewBundle(ctx, runner.context, hookName, runner.context.Id(), env, clock.WallClock.Now())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(request.Complete(bundle))
+}
+
 // loggerAdaptor implements MessageReceiver and
 // sends messages to a logger.
 type loggerAdaptor struct {
@@ -419,6 +440,10 @@ func (b *bufferAdaptor) Bytes() []byte {
 const (
 	// ErrTerminated indicate the hook or action exited due to a SIGTERM or SIGKILL signal.
 	ErrTerminated = errors.ConstError("terminated")
+
+	// ErrHookTimedOut indicates the hook was killed because it ran for longer
+	// than the hook timeout of its context.
+	ErrHookTimedOut = errors.ConstError("hook timed out")
 )
 
 // Check still tested
@@ -465,24 +490,45 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 		cancel = actionData.Cancel
 	}
 
+	// Unlike actions, hooks cannot be cancelled, so a hung hook would block
+	// every other operation of the unit. If a hook timeout is set, the hook
+	// is run in its own process group, so that it can be killed along with
+	// any processes it started once the timeout expires.
+	var timeout <-chan time.Time
+	hookTimeout := runner.context.HookTimeout()
+	if !runningAction && hookTimeout > 0 {
+		ps.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+		timeout = clock.WallClock.After(hookTimeout)
+	}
+
 	err = ps.Start()
 	var exitErr error
+	var timedOut bool
 	if err == nil {
 		done := make(chan struct{})
-		if cancel != nil {
+		killed := make(chan bool, 1)
+		if cancel != nil || timeout != nil {
 			go func() {
 				select {
 				case <-cancel:
 					_ = ps.Process.Kill()
+					killed <- false
+				case <-timeout:
+					_ = syscall.Kill(-ps.Process.Pid, syscall.SIGKILL)
+					killed <- true
 				case <-done:
+					killed <- false
 				}
 			}()
+		} else {
+			killed <- false
 		}
 		// Record the *os.Process of the hook
 		runner.context.SetProcess(hookProcess{ps.Process})
 		// Block until execution finishes
 		exitErr = ps.Wait()
 		close(done)
+		timedOut = <-killed
 	} else {
 		exitErr = err
 	}
@@ -503,6 +549,9 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 			return errors.Trace(err)
 		}
 	}
+	if timedOut {
+		return errors.Annotatef(ErrHookTimedOut, "%q killed after %v", hookName, hookTimeout)
+	}
 	if exitError, ok := exitErr.(*exec.ExitError); ok && exitError != nil {
 		waitStatus := exitError.ProcessState.Sys().(syscall.WaitStatus)
 		if waitStatus.Signal() == syscall.SIGTERM || waitStatus.Signal() == syscall.SIGKILL {
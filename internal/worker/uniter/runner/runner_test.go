@@ -251,6 +251,7 @@ type MockContext struct {
 	flushFailure    error
 	flushResult     error
 	modelType       model.ModelType
+	hookTimeout     time.Duration
 }
 
 func (ctx *MockContext) GetLoggerByName(module string) logger.Logger {
@@ -311,6 +312,10 @@ func (ctx *MockContext) UpdateActionResults(keys []string, value any) error {
 	return nil
 }
 
+func (ctx *MockContext) HookTimeout() time.Duration {
+	return ctx.hookTimeout
+}
+
 func (ctx *MockContext) ModelType() model.ModelType {
 	if ctx.modelType == "" {
 		return model.IAAS
@@ -439,6 +444,44 @@ func (s *RunMockContextSuite) TestRunHookFlushFailure(c *tc.C) {
 	s.assertRecordedPid(c, ctx.expectPid)
 }
 
+func (s *RunMockContextSuite) TestRunHookTimedOut(c *tc.C) {
+	ctx := &MockContext{
+		hookTimeout: 100 * time.Millisecond,
+	}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+		hang: true,
+	}, s.paths.GetCharmDir())
+
+	// The hook waits for a child process, so it only returns in time
+	// if the whole process group is killed.
+	start := time.Now()
+	_, err := runner.NewRunner(ctx, s.paths).RunHook(c.Context(), "something-happened")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(time.Since(start) < testing.LongWait, tc.IsTrue)
+	c.Check(ctx.flushBadge, tc.Equals, "something-happened")
+	c.Check(ctx.flushFailure, tc.ErrorIs, runner.ErrHookTimedOut)
+	c.Check(ctx.flushFailure, tc.ErrorMatches, `"something-happened" killed after 100ms: hook timed out`)
+}
+
+func (s *RunMockContextSuite) TestRunHookWithinTimeout(c *tc.C) {
+	ctx := &MockContext{
+		hookTimeout: testing.LongWait,
+	}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+		code: 123,
+	}, s.paths.GetCharmDir())
+	_, err := runner.NewRunner(ctx, s.paths).RunHook(c.Context(), "something-happened")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ctx.flushFailure, tc.ErrorMatches, "exit status 123")
+	s.assertRecordedPid(c, ctx.expectPid)
+}
+
 func (s *RunHookSuite) TestRunActionDispatchingHookHandler(c *tc.C) {
 	ctx := &MockContext{
 		actionData:    &context.ActionData{},
@@ -191,6 +191,9 @@ type hookSpec struct {
 	stderr string
 	// background holds a string to print in the background after 0.2s.
 	background string
+	// hang makes the hook wait for a long running child process
+	// before exiting.
+	hang bool
 	// missingShebang will omit the '#!/bin/bash' line
 	missingShebang bool
 	// charmMissing will remove the charm before running the hook
@@ -242,6 +245,10 @@ func makeCharm(c *tc.C, spec hookSpec, charmDir string) {
 		// expected.
 		printf("(sleep 0.2; echo %s; sleep 10) &", spec.background)
 	}
+	if spec.hang {
+		// Only use shell builtins, as the hook runs without a usable PATH.
+		printf("(while :; do :; done) & wait")
+	}
 	printf("exit %d", spec.code)
 }
 
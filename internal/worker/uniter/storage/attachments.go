@@ -25,6 +25,11 @@ type Attachments struct {
 	// for which no hooks have been run.
 	pending names.Set
 
+	// snapshotHooks records the last storage snapshot hook committed for
+	// each snapshot, so that a hook is not run again before the remote
+	// state catches up with the change.
+	snapshotHooks map[string]hooks.Kind
+
 	stateOps *stateOps
 
 	// TODO: hml
@@ -41,11 +46,12 @@ func NewAttachments(
 	abort <-chan struct{},
 ) (*Attachments, error) {
 	a := &Attachments{
-		client:   client,
-		unitTag:  tag,
-		abort:    abort,
-		stateOps: NewStateOps(rw),
-		pending:  names.NewSet(),
+		client:        client,
+		unitTag:       tag,
+		abort:         abort,
+		stateOps:      NewStateOps(rw),
+		pending:       names.NewSet(),
+		snapshotHooks: make(map[string]hooks.Kind),
 	}
 	if err := a.init(ctx); err != nil {
 		return nil, err
@@ -125,6 +131,15 @@ func (a *Attachments) CommitHook(ctx context.Context, hi hook.Info) error {
 	if !hi.Kind.IsStorage() {
 		return errors.Errorf("not a storage hook: %#v", hi)
 	}
+	if hi.Kind == hooks.StorageSnapshotPre || hi.Kind == hooks.StorageSnapshotPost {
+		// Snapshot hooks do not change the attachment state; the
+		// controller moves the snapshot on to its next step.
+		if err := a.client.SetStorageSnapshotHookRun(ctx, a.unitTag, hi.SnapshotId); err != nil {
+			return errors.Annotatef(err, "recording %q hook for snapshot %q", hi.Kind, hi.SnapshotId)
+		}
+		a.snapshotHooks[hi.SnapshotId] = hi.Kind
+		return nil
+	}
 	if hi.Kind == hooks.StorageDetaching {
 		err := a.storageState.Detach(hi.StorageId)
 		if err != nil {
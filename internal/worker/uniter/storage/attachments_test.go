@@ -353,6 +353,85 @@ func (s *attachmentsSuite) TestAttachmentsCommitHook(c *tc.C) {
 	c.Assert(removed, tc.IsTrue)
 }
 
+func (s *attachmentsSuite) TestAttachmentsStorageSnapshotHooks(c *tc.C) {
+	defer s.mockStateOpsSuite.setupMocks(c).Finish()
+
+	unitTag := names.NewUnitTag("mysql/0")
+	storageTag := names.NewStorageTag("data/0")
+	s.storSt.Attach(storageTag.Id())
+	s.expectState(c)
+	s.expectSetState(c, "")
+
+	var hooksRun []string
+	st := &mockStorageAccessor{
+		unitStorageAttachments: func(u names.UnitTag) ([]params.StorageAttachmentId, error) {
+			return []params.StorageAttachmentId{{
+				StorageTag: storageTag.String(),
+				UnitTag:    unitTag.String(),
+			}}, nil
+		},
+		setStorageSnapshotHookRun: func(u names.UnitTag, id string) error {
+			c.Assert(u, tc.Equals, unitTag)
+			hooksRun = append(hooksRun, id)
+			return nil
+		},
+	}
+
+	att, err := storage.NewAttachments(c.Context(), st, unitTag, s.mockStateOps, make(chan struct{}))
+	c.Assert(err, tc.ErrorIsNil)
+	r := storage.NewResolver(loggertesting.WrapCheckLog(c), att, s.modelType)
+
+	localState := resolver.LocalState{State: operation.State{
+		Kind:      operation.Continue,
+		Installed: true,
+		Started:   true,
+	}}
+	remoteState := func(status string) remotestate.Snapshot {
+		return remotestate.Snapshot{
+			Life: life.Alive,
+			Storage: map[names.StorageTag]remotestate.StorageSnapshot{
+				storageTag: {
+					Kind:     params.StorageKindBlock,
+					Life:     life.Alive,
+					Location: "/dev/sdb",
+					Attached: true,
+				},
+			},
+			StorageSnapshotHooks: []remotestate.StorageSnapshotHook{{
+				Id:         "1",
+				StorageTag: storageTag,
+				Status:     status,
+			}},
+		}
+	}
+
+	op, err := r.NextOp(c.Context(), localState, remoteState("pending"), &mockOperations{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(op.String(), tc.Equals, "run hook storage-snapshot-pre")
+
+	hi := hook.Info{
+		Kind:       hooks.StorageSnapshotPre,
+		StorageId:  storageTag.Id(),
+		SnapshotId: "1",
+	}
+	c.Assert(att.ValidateHook(hi), tc.ErrorIsNil)
+	err = att.CommitHook(c.Context(), hi)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(hooksRun, tc.DeepEquals, []string{"1"})
+
+	// The remote state has not caught up, so the hook is not run again.
+	_, err = r.NextOp(c.Context(), localState, remoteState("pending"), &mockOperations{})
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+
+	// The snapshot is being taken.
+	_, err = r.NextOp(c.Context(), localState, remoteState("ready"), &mockOperations{})
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+
+	op, err = r.NextOp(c.Context(), localState, remoteState("created"), &mockOperations{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(op.String(), tc.Equals, "run hook storage-snapshot-post")
+}
+
 func (s *attachmentsSuite) TestAttachmentsSetDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
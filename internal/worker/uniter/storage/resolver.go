@@ -125,6 +125,13 @@ func (s *storageResolver) NextOp(
 		}
 		return op, err
 	}
+	for _, snapshot := range remoteState.StorageSnapshotHooks {
+		op, err := s.nextSnapshotHookOp(snapshot, remoteState.Storage, opFactory)
+		if errors.Cause(err) == resolver.ErrNoOperation {
+			continue
+		}
+		return op, err
+	}
 	if s.storage.Pending() > 0 {
 		s.logger.Debugf(ctx, "still pending %v", s.storage.pending.SortedValues())
 		// For IAAS models, storage hooks are run before install.
@@ -206,3 +213,38 @@ func (s *storageResolver) nextHookOp(
 
 	return opFactory.NewRunHook(hookInfo)
 }
+
+func (s *storageResolver) nextSnapshotHookOp(
+	snapshot remotestate.StorageSnapshotHook,
+	storage map[names.StorageTag]remotestate.StorageSnapshot,
+	opFactory operation.Factory,
+) (operation.Operation, error) {
+	var kind hooks.Kind
+	switch snapshot.Status {
+	case "pending":
+		kind = hooks.StorageSnapshotPre
+	case "created", "failed":
+		kind = hooks.StorageSnapshotPost
+	default:
+		return nil, resolver.ErrNoOperation
+	}
+	if s.storage.snapshotHooks[snapshot.Id] == kind {
+		// The hook has been committed, but the remote state
+		// has not yet caught up.
+		return nil, resolver.ErrNoOperation
+	}
+	if snap, ok := storage[snapshot.StorageTag]; !ok || snap.Life != life.Alive {
+		return nil, resolver.ErrNoOperation
+	}
+	attached, ok := s.storage.storageState.Attached(snapshot.StorageTag.Id())
+	if !ok || !attached {
+		// The storage-attached hook has not been committed, so
+		// there is nothing for the charm to quiesce yet.
+		return nil, resolver.ErrNoOperation
+	}
+	return opFactory.NewRunHook(hook.Info{
+		Kind:       kind,
+		StorageId:  snapshot.StorageTag.Id(),
+		SnapshotId: snapshot.Id,
+	})
+}
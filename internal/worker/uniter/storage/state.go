@@ -60,7 +60,7 @@ func (s *State) ValidateHook(hi hook.Info) (err error) {
 		if attached {
 			return errors.New("storage already attached")
 		}
-	case hooks.StorageDetaching:
+	case hooks.StorageDetaching, hooks.StorageSnapshotPre, hooks.StorageSnapshotPost:
 		if !attached {
 			return errors.New("storage not attached")
 		}
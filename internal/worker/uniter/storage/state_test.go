@@ -101,6 +101,19 @@ func (s *stateSuite) TestValidateHookStorageAttachedError(c *tc.C) {
 	c.Assert(err, tc.NotNil)
 }
 
+func (s *stateSuite) TestValidateHookStorageSnapshot(c *tc.C) {
+	s.st.Attach(s.tag1.Id())
+	hi := hook.Info{Kind: hooks.StorageSnapshotPre, StorageId: s.tag1.Id(), SnapshotId: "1"}
+	err := s.st.ValidateHook(hi)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestValidateHookStorageSnapshotNotAttached(c *tc.C) {
+	hi := hook.Info{Kind: hooks.StorageSnapshotPost, StorageId: s.tag1.Id(), SnapshotId: "1"}
+	err := s.st.ValidateHook(hi)
+	c.Assert(err, tc.ErrorMatches, `inappropriate "storage-snapshot-post" hook for storage ".*": storage not attached`)
+}
+
 type stateOpsSuite struct {
 	mockStateOpsSuite
 
@@ -424,6 +424,7 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 			ModelType:           u.modelType,
 			ClearResolved:       clearResolved,
 			ReportHookError:     u.reportHookError,
+			ReportHookTimeout:   u.reportHookTimeout,
 			ShouldRetryHooks:    u.hookRetryStrategy.ShouldRetry,
 			StartRetryHookTimer: retryHookTimer.Start,
 			StopRetryHookTimer:  retryHookTimer.Reset,
@@ -451,6 +452,8 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 				watcher.RemoveSecretsCompleted,
 			),
 			Logger: u.logger,
+
+			ShouldRetryTimedOutHooks: u.hookRetryStrategy.ShouldRetryTimedOut,
 		}
 		if len(u.containerNames) > 0 {
 			cfg.OptionalResolvers = append(cfg.OptionalResolvers, container.NewWorkloadHookResolver(
@@ -795,6 +798,8 @@ func (u *Uniter) init(ctx stdcontext.Context, unitTag names.UnitTag) (err error)
 		InitialState:    initialState,
 		AcquireLock:     u.acquireExecutionLock,
 		Logger:          u.logger.Child("operation"),
+		RecordExecution: u.recordExecution,
+		Clock:           u.clock,
 	})
 	if err != nil {
 		return errors.Trace(err)
@@ -889,7 +894,41 @@ func (u *Uniter) acquireExecutionLock(action, executionGroup string) (func(), er
 	return releaser, nil
 }
 
+// recordExecution adds the input record to the unit's hook history. It is
+// used by operation.Executor after executing operations which run charm
+// code. Failing to record the history does not fail the operation.
+func (u *Uniter) recordExecution(ctx stdcontext.Context, record operation.ExecutionRecord) {
+	entry := params.HookHistoryEntry{
+		Kind:       record.Kind,
+		Name:       record.Name,
+		RelationId: record.RelationId,
+		Started:    record.Started,
+		Duration:   record.Duration,
+		ExitCode:   record.ExitCode,
+	}
+	if record.Err != nil {
+		entry.Error = record.Err.Error()
+	}
+	err := u.unit.RecordHookHistory(ctx, []params.HookHistoryEntry{entry})
+	if errors.Is(err, errors.NotImplemented) {
+		// The controller does not support hook history.
+		return
+	} else if err != nil {
+		u.logger.Warningf(ctx, "cannot record %s %q in hook history: %v", record.Kind, record.Name, err)
+	}
+}
+
 func (u *Uniter) reportHookError(ctx stdcontext.Context, hookInfo hook.Info) error {
+	return u.reportHookStatus(ctx, hookInfo, "hook failed: %q")
+}
+
+// reportHookTimeout sets the agent status to "error" for a hook that was
+// killed because it ran for longer than the configured hook timeout.
+func (u *Uniter) reportHookTimeout(ctx stdcontext.Context, hookInfo hook.Info) error {
+	return u.reportHookStatus(ctx, hookInfo, "hook timed out: %q")
+}
+
+func (u *Uniter) reportHookStatus(ctx stdcontext.Context, hookInfo hook.Info, format string) error {
 	// Set the agent status to "error". We must do this here in case the
 	// hook is interrupted (e.g. unit agent crashes), rather than immediately
 	// after attempting a runHookOp.
@@ -914,7 +953,7 @@ func (u *Uniter) reportHookError(ctx stdcontext.Context, hookInfo hook.Info) err
 		statusData["secret-label"] = hookInfo.SecretLabel
 	}
 	statusData["hook"] = hookName
-	statusMessage := fmt.Sprintf("hook failed: %q", hookMessage)
+	statusMessage := fmt.Sprintf(format, hookMessage)
 	return setAgentStatus(ctx, u, status.Error, statusMessage, statusData)
 }
 
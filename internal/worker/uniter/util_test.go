@@ -753,6 +753,14 @@ func (s *startUniter) expectRemoteStateWatchers(c tc.LikeC, ctx *testContext) {
 		return w, nil
 	}).AnyTimes()
 
+	ctx.unit.EXPECT().WatchStorageSnapshots(gomock.Any()).DoAndReturn(func(context.Context) (watcher.NotifyWatcher, error) {
+		ch := make(chan struct{}, 1)
+		ch <- struct{}{}
+		w := watchertest.NewMockNotifyWatcher(ch)
+		return w, nil
+	}).AnyTimes()
+	ctx.unit.EXPECT().StorageSnapshots(gomock.Any()).Return(nil, nil).AnyTimes()
+
 	ctx.unit.EXPECT().WatchActionNotifications(gomock.Any()).DoAndReturn(func(context.Context) (watcher.StringsWatcher, error) {
 		var actions []string
 		for _, a := range ctx.pendingActions {
@@ -919,6 +927,7 @@ func (s startUniter) setupUniterHookExec(c tc.LikeC, ctx *testContext) {
 	ctx.unit.EXPECT().SetState(gomock.Any(), uniterSecretsStateMatcher{}).DoAndReturn(setState).AnyTimes()
 	ctx.unit.EXPECT().SetState(gomock.Any(), uniterStorageStateMatcher{}).DoAndReturn(setState).AnyTimes()
 	ctx.unit.EXPECT().SetState(gomock.Any(), uniterRelationStateMatcher{}).DoAndReturn(setState).AnyTimes()
+	ctx.unit.EXPECT().RecordHookHistory(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 }
 
 func (s startUniter) step(c tc.LikeC, ctx *testContext) {
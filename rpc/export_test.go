@@ -5,6 +5,8 @@ package rpc
 
 const CodeNotImplemented = codeNotImplemented
 
+var ObservesLatency = observesLatency
+
 // TODO(katco): Remove this as it is exposing internal state of Conn. Age old story: ran out of time to rewrite the tests to do this correctly.
 
 // ClientRequestID exposes the client's request ID which is
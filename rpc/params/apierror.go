@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/errors"
@@ -139,6 +140,19 @@ func (e RedirectErrorInfo) AsMap() map[string]any {
 	return serializeToMap(e)
 }
 
+// RateLimitExceededErrorInfo provides additional information for
+// RateLimitExceeded errors.
+type RateLimitExceededErrorInfo struct {
+	// RetryAfter is how long the caller should wait before
+	// retrying the call.
+	RetryAfter time.Duration `json:"retry-after"`
+}
+
+// AsMap encodes the error info as a map that can be attached to an Error.
+func (e RateLimitExceededErrorInfo) AsMap() map[string]any {
+	return serializeToMap(e)
+}
+
 // serializeToMap is a convenience function for marshaling v into a
 // map[string]interface{}. It works by marshalling v into json and then
 // unmarshaling back to a map.
@@ -208,6 +222,7 @@ const (
 	CodeCloudRegionRequired        = "cloud region required"
 	CodeIncompatibleClouds         = "incompatible clouds"
 	CodeQuotaLimitExceeded         = "quota limit exceeded"
+	CodeRateLimitExceeded          = "rate limit exceeded"
 	CodeNotLeader                  = "not leader"
 	CodeDeadlineExceeded           = "deadline exceeded"
 	CodeNotYetAvailable            = "not yet available; try again later"
@@ -550,6 +565,12 @@ func IsCodeQuotaLimitExceeded(err error) bool {
 	return ErrCode(err) == CodeQuotaLimitExceeded
 }
 
+// IsCodeRateLimitExceeded returns true if err includes a RateLimitExceeded
+// error code. Such calls can be retried once the rate limit allows.
+func IsCodeRateLimitExceeded(err error) bool {
+	return ErrCode(err) == CodeRateLimitExceeded
+}
+
 func IsCodeNotLeader(err error) bool {
 	return ErrCode(err) == CodeNotLeader
 }
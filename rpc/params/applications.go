@@ -44,6 +44,10 @@ type CharmOrigin struct {
 	// charmhub Refresh action related to an application. Create with the
 	// charmhub.CreateInstanceKey method. LP: 1944582
 	InstanceKey string `json:"instance-key,omitempty"`
+
+	// Publisher is the username of the publisher of the charm in the
+	// repository it was resolved from.
+	Publisher string `json:"publisher,omitempty"`
 }
 
 // ApplicationDeploy holds the parameters for making the application Deploy
@@ -404,6 +408,10 @@ type DestroyApplicationInfo struct {
 	// DestroyedUnits is the tags of units that will be destroyed
 	// as a result of destroying the application.
 	DestroyedUnits []Entity `json:"destroyed-units,omitempty"`
+
+	// Plan is every entity that will be removed as a result of destroying
+	// the application. It is only reported for dry runs.
+	Plan *RemovalPlan `json:"plan,omitempty"`
 }
 
 // ScaleApplicationsParams holds bulk parameters for the Application.ScaleApplication call.
@@ -95,3 +95,44 @@ func CreateResult(meta *corebackups.Metadata, filename string) BackupsMetadataRe
 
 	return result
 }
+
+// BackupsListArgs holds the args for the API List method.
+type BackupsListArgs struct{}
+
+// BackupsListResult holds the list of all stored backups.
+type BackupsListResult struct {
+	List []BackupsMetadataResult `json:"list"`
+}
+
+// BackupsInfoArgs holds the args for the API Info method.
+type BackupsInfoArgs struct {
+	ID string `json:"id"`
+}
+
+// BackupsRemoveArgs holds the args for the API Remove method.
+type BackupsRemoveArgs struct {
+	IDs []string `json:"ids"`
+}
+
+// BackupsRestoreArgs holds the args for the API Restore method.
+type BackupsRestoreArgs struct {
+	// ID identifies the backup archive to restore.
+	ID string `json:"id"`
+
+	// DryRun, when true, only validates that the backup archive
+	// can be restored into the controller.
+	DryRun bool `json:"dry-run"`
+}
+
+// BackupsRestoreResult holds the result of the API Restore method.
+type BackupsRestoreResult struct {
+	// Metadata describes the backup archive being restored.
+	Metadata BackupsMetadataResult `json:"metadata"`
+
+	// ControllerVersion is the version of the running controller that
+	// the backup was validated against.
+	ControllerVersion semversion.Number `json:"controller-version"`
+
+	// Error holds the reason the backup cannot be restored, if any.
+	Error *Error `json:"error,omitempty"`
+}
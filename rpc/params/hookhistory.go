@@ -0,0 +1,83 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"time"
+)
+
+// HookHistoryEntry records a single hook, action or commands execution
+// by a unit agent.
+type HookHistoryEntry struct {
+	// Kind is one of "hook", "action" or "commands".
+	Kind string `json:"kind"`
+	// Name is the hook or action name. It is empty for commands.
+	Name string `json:"name,omitempty"`
+	// RelationId is the ID of the relation for relation hooks.
+	RelationId *int `json:"relation-id,omitempty"`
+	// Started is when the execution started.
+	Started time.Time `json:"started"`
+	// Duration is how long the execution took.
+	Duration time.Duration `json:"duration"`
+	// ExitCode is the exit code of the process, or -1 if it could not
+	// be started or was killed.
+	ExitCode int `json:"exit-code"`
+	// Error is the error the execution failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// UnitHookHistoryArg holds hook history entries to record for a unit.
+type UnitHookHistoryArg struct {
+	Tag     string             `json:"tag"`
+	Entries []HookHistoryEntry `json:"entries"`
+}
+
+// UnitHookHistoryArgs holds the arguments for recording the hook
+// history of units.
+type UnitHookHistoryArgs struct {
+	Args []UnitHookHistoryArg `json:"args"`
+}
+
+// UnitHookHistoryResult holds the hook history of a unit or an error.
+type UnitHookHistoryResult struct {
+	Entries []HookHistoryEntry `json:"entries,omitempty"`
+	Error   *Error             `json:"error,omitempty"`
+}
+
+// UnitHookHistoryResults holds the hook history of a number of units.
+type UnitHookHistoryResults struct {
+	Results []UnitHookHistoryResult `json:"results"`
+}
+
+// HookStats holds statistics for a hook, action or commands aggregated over
+// the hook history of the units of an application.
+type HookStats struct {
+	// Kind is one of "hook", "action" or "commands".
+	Kind string `json:"kind"`
+	// Name is the hook or action name. It is empty for commands.
+	Name string `json:"name,omitempty"`
+	// Count is the number of recorded executions.
+	Count int `json:"count"`
+	// Failures is the number of recorded executions which failed.
+	Failures int `json:"failures"`
+	// MeanDuration is the mean duration of the recorded executions.
+	MeanDuration time.Duration `json:"mean-duration"`
+	// MaxDuration is the longest duration of the recorded executions.
+	MaxDuration time.Duration `json:"max-duration"`
+	// LastRun is when the most recent recorded execution started.
+	LastRun time.Time `json:"last-run"`
+}
+
+// ApplicationHookStatsResult holds the hook stats of an application
+// or an error.
+type ApplicationHookStatsResult struct {
+	Stats []HookStats `json:"stats,omitempty"`
+	Error *Error      `json:"error,omitempty"`
+}
+
+// ApplicationHookStatsResults holds the hook stats of a number of
+// applications.
+type ApplicationHookStatsResults struct {
+	Results []ApplicationHookStatsResult `json:"results"`
+}
@@ -904,6 +904,13 @@ type LogMessageV1 struct {
 	Labels    []string  `json:"lab"`
 }
 
+// LogHistogram holds the number of log records matching a debug-log
+// query at each severity. It is sent by the api server /log endpoint
+// in place of the records when a histogram is requested.
+type LogHistogram struct {
+	Counts map[string]int `json:"counts"`
+}
+
 type logMessageJSON struct {
 	ModelUUID string    `json:"uuid,omitempty"`
 	Entity    string    `json:"tag"`
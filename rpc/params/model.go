@@ -491,3 +491,16 @@ type UpgradeModelResult struct {
 	ChosenVersion semversion.Number `json:"chosen-version"`
 	Error         *Error            `json:"error,omitempty"`
 }
+
+// ImportModelResult holds the details of a model imported into the
+// controller from a model archive.
+type ImportModelResult struct {
+	// ModelTag is the tag of the imported model.
+	ModelTag string `json:"model-tag"`
+
+	// Name is the name of the imported model.
+	Name string `json:"name"`
+
+	// Qualifier disambiguates the name of the imported model.
+	Qualifier string `json:"qualifier"`
+}
@@ -964,7 +964,15 @@ type ControllerDetailsResults struct {
 type ControllerDetails struct {
 	ControllerId string   `json:"controller-id"`
 	APIAddresses []string `json:"api-addresses"`
-	Error        *Error   `json:"error,omitempty"`
+
+	// DqliteNodeID is the ID of the controller's Dqlite node.
+	DqliteNodeID uint64 `json:"dqlite-node-id,omitempty"`
+	// DqliteRole is the role of the controller's Dqlite node in the Dqlite
+	// cluster (voter, standby or spare). It is empty if the node isn't a
+	// member of the cluster.
+	DqliteRole string `json:"dqlite-role,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
 }
 
 // ControllersChangeResult contains the results
@@ -1216,6 +1224,10 @@ type DestroyMachineInfo struct {
 	// DestroyedContainers are the results of the destroyed containers hosted
 	// on a machine, destroyed as a result of destroying the machine
 	DestroyedContainers []DestroyMachineResult `json:"destroyed-containers,omitempty"`
+
+	// Plan is every entity that will be removed as a result of destroying
+	// the machine. It is only reported for dry runs.
+	Plan *RemovalPlan `json:"plan,omitempty"`
 }
 
 // DestroyUnitResults contains the results of a DestroyUnit API request.
@@ -1241,6 +1253,58 @@ type DestroyUnitInfo struct {
 	// DestroyedStorage is the tags of storage instances that will be
 	// destroyed as a result of destroying the unit.
 	DestroyedStorage []Entity `json:"destroyed-storage,omitempty"`
+
+	// Plan is every entity that will be removed as a result of destroying
+	// the unit. It is only reported for dry runs.
+	Plan *RemovalPlan `json:"plan,omitempty"`
+}
+
+// RemovalPlan describes the entities that would be removed by a removal,
+// as reported by a dry run.
+type RemovalPlan struct {
+	// Applications are the applications that would be removed.
+	Applications []PlannedRemoval `json:"applications,omitempty"`
+
+	// Units are the units that would be removed.
+	Units []PlannedRemoval `json:"units,omitempty"`
+
+	// Relations are the relations that would be removed, named by their
+	// relation keys.
+	Relations []PlannedRemoval `json:"relations,omitempty"`
+
+	// Machines are the machines and containers that would be removed.
+	Machines []PlannedRemoval `json:"machines,omitempty"`
+
+	// Offers are the offers that would be removed.
+	Offers []PlannedRemoval `json:"offers,omitempty"`
+
+	// DestroyedStorage are the storage instances that would be destroyed.
+	DestroyedStorage []PlannedRemoval `json:"destroyed-storage,omitempty"`
+
+	// DetachedStorage are the storage instances that would be detached and
+	// would remain in the model.
+	DetachedStorage []PlannedRemoval `json:"detached-storage,omitempty"`
+}
+
+// PlannedRemoval is an entity in a removal plan.
+type PlannedRemoval struct {
+	// Name is the name of the entity.
+	Name string `json:"name"`
+
+	// ForceRequired, if not empty, is the reason that the entity can only
+	// be removed with force.
+	ForceRequired string `json:"force-required,omitempty"`
+}
+
+// RemovalPlanResults contains the results of a removal dry run.
+type RemovalPlanResults struct {
+	Results []RemovalPlanResult `json:"results"`
+}
+
+// RemovalPlanResult contains one of the results of a removal dry run.
+type RemovalPlanResult struct {
+	Plan  *RemovalPlan `json:"plan,omitempty"`
+	Error *Error       `json:"error,omitempty"`
 }
 
 // DumpModelRequest wraps the request for a dump-model call.
@@ -1466,4 +1530,5 @@ type UnitContext struct {
 	OpenedMachinePortRangesByEndpoint map[string]map[string][]PortRange `json:"opened-machine-port-ranges-by-endpoint,omitempty"`
 	OpenedPortRangesByEndpoint        map[string]map[string][]PortRange `json:"opened-port-ranges-by-endpoint,omitempty"`
 	CharmTracingConfig                CharmTracingConfig                `json:"charm-tracing-config,omitempty"`
+	HookTimeout                       time.Duration                     `json:"hook-timeout,omitempty"`
 }
@@ -0,0 +1,51 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// RemovalJob describes a pending job to remove an entity from a model.
+type RemovalJob struct {
+	// UUID uniquely identifies the job.
+	UUID string `json:"uuid"`
+
+	// Type is the type of entity that the job removes.
+	Type string `json:"type"`
+
+	// EntityUUID identifies the entity that the job removes.
+	EntityUUID string `json:"entity-uuid"`
+
+	// EntityName is the human readable identifier of the entity,
+	// where one is known.
+	EntityName string `json:"entity-name,omitempty"`
+
+	// Force indicates whether the job is executed with force.
+	Force bool `json:"force"`
+
+	// CreatedAt is when the job was created.
+	CreatedAt time.Time `json:"created-at"`
+
+	// ScheduledFor is the earliest time at which the job is executed.
+	ScheduledFor time.Time `json:"scheduled-for"`
+
+	// Attempts is the number of times that execution of the job has
+	// been started.
+	Attempts int `json:"attempts"`
+
+	// LastAttemptedAt is when execution of the job was last started.
+	LastAttemptedAt *time.Time `json:"last-attempted-at,omitempty"`
+
+	// LastError is the error from the most recent execution of the job.
+	LastError string `json:"last-error,omitempty"`
+}
+
+// RemovalJobResults holds the pending removal jobs for a model.
+type RemovalJobResults struct {
+	Results []RemovalJob `json:"results"`
+}
+
+// RemovalJobArgs identifies removal jobs to act on.
+type RemovalJobArgs struct {
+	UUIDs []string `json:"uuids"`
+}
@@ -15,6 +15,11 @@ type RetryStrategy struct {
 	MaxRetryTime    time.Duration `json:"max-retry-time"`
 	JitterRetryTime bool          `json:"jitter-retry-time"`
 	RetryTimeFactor int64         `json:"retry-time-factor"`
+
+	// ShouldRetryTimedOut reports whether hooks that were killed for
+	// exceeding the hook timeout should be retried, regardless of
+	// ShouldRetry.
+	ShouldRetryTimedOut bool `json:"should-retry-timed-out,omitempty"`
 }
 
 // RetryStrategyResult holds a RetryStrategy or an error.
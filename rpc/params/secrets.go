@@ -220,6 +220,8 @@ type ChangeSecretBackendArg struct {
 	URI      string              `json:"uri"`
 	Revision int                 `json:"revision"`
 	Content  SecretContentParams `json:"content,omitempty"`
+	// Checksum is the checksum of the content verified in the new backend.
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // SecretContentResults holds secret value results.
@@ -241,6 +243,30 @@ type SecretValueResult struct {
 	Error *Error            `json:"error,omitempty"`
 }
 
+// SecretValueResults holds secret value results.
+type SecretValueResults struct {
+	Results []SecretValueResult `json:"results"`
+}
+
+// BackendContentArgs holds the args for accessing secret content
+// held in backends which agents access through the controller.
+type BackendContentArgs struct {
+	Args []BackendContentArg `json:"args"`
+}
+
+// BackendContentArg holds the arg for accessing secret content held
+// in a backend, as permitted by the access token issued to the agent.
+type BackendContentArg struct {
+	BackendID string `json:"backend-id"`
+	Token     string `json:"token"`
+	// RevisionID identifies the content to get or delete.
+	RevisionID string `json:"revision-id,omitempty"`
+	// URI, Revision and Data describe the content to save.
+	URI      string            `json:"uri,omitempty"`
+	Revision int               `json:"revision,omitempty"`
+	Data     map[string]string `json:"data,omitempty"`
+}
+
 // SecretsFilter is used when querying secrets.
 type SecretsFilter struct {
 	URI      *string `json:"uri,omitempty"`
@@ -402,6 +428,52 @@ type GrantRevokeUserSecretArg struct {
 	Applications []string `json:"applications"`
 }
 
+// SecretAccessLogArgs holds the args for listing the access log of secrets.
+type SecretAccessLogArgs struct {
+	Args []SecretAccessLogArg `json:"args"`
+}
+
+// SecretAccessLogArg holds the args for listing the access log of a secret.
+type SecretAccessLogArg struct {
+	// Either URI or Label is required.
+
+	// URI identifies the secret.
+	URI string `json:"uri"`
+	// Label identifies the secret.
+	Label string `json:"label"`
+
+	// Since, if set, excludes the entries recorded before it.
+	Since *time.Time `json:"since,omitempty"`
+	// Limit, if positive, returns only that many of the most recent entries.
+	Limit int `json:"limit,omitempty"`
+}
+
+// SecretAccessLogResults holds secret access log results.
+type SecretAccessLogResults struct {
+	Results []SecretAccessLogResult `json:"results"`
+}
+
+// SecretAccessLogResult holds the access log of a secret.
+type SecretAccessLogResult struct {
+	Entries []SecretAccessLogEntry `json:"entries,omitempty"`
+	Error   *Error                 `json:"error,omitempty"`
+}
+
+// SecretAccessLogEntry holds a read of, or change to the access to, a secret.
+type SecretAccessLogEntry struct {
+	// Action is one of "read", "grant" or "revoke".
+	Action string `json:"action"`
+	// Revision is the revision read.
+	Revision *int `json:"revision,omitempty"`
+	// AccessorTag is the entity which accessed the secret.
+	AccessorTag string `json:"accessor-tag"`
+	// SubjectTag is the entity whose access was granted or revoked.
+	SubjectTag string `json:"subject-tag,omitempty"`
+	// BackendID is the external backend holding the content read.
+	BackendID string    `json:"backend-id,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
 // ListSecretBackendsResults holds secret backend results.
 type ListSecretBackendsResults struct {
 	Results []SecretBackendResult `json:"results"`
@@ -416,6 +488,22 @@ type SecretBackendResult struct {
 	Status     string `json:"status"`
 	Message    string `json:"message,omitempty"`
 	Error      *Error `json:"error,omitempty"`
+	// Drains are the moves of model secrets to or from the backend.
+	Drains []SecretBackendDrain `json:"drains,omitempty"`
+}
+
+// SecretBackendDrain holds the progress of a drain of a model's secrets
+// from one secret backend to another.
+type SecretBackendDrain struct {
+	ModelUUID         string    `json:"model-uuid"`
+	ModelName         string    `json:"model-name"`
+	SourceBackend     string    `json:"source-backend"`
+	TargetBackend     string    `json:"target-backend"`
+	Status            string    `json:"status"`
+	StartedAt         time.Time `json:"started-at"`
+	TotalRevisions    int       `json:"total-revisions"`
+	MovedRevisions    int       `json:"moved-revisions"`
+	VerifiedRevisions int       `json:"verified-revisions"`
 }
 
 // AddSecretBackendArgs holds args for adding secret backends.
@@ -464,6 +552,27 @@ type ListSecretBackendsArgs struct {
 	Reveal bool     `json:"reveal"`
 }
 
+// RotateSecretEncryptionKeyResult holds the result of rotating the controller
+// secret encryption key.
+type RotateSecretEncryptionKeyResult struct {
+	// KeyUUID is the UUID of the new active key-encryption key.
+	KeyUUID string `json:"key-uuid"`
+
+	// Models holds the outcome of rewrapping each model's data key.
+	Models []RewrapSecretDataKeyResult `json:"models"`
+
+	// RetiredKeysRemoved is true if every model was rewrapped and the
+	// previous key-encryption keys were deleted.
+	RetiredKeysRemoved bool `json:"retired-keys-removed"`
+}
+
+// RewrapSecretDataKeyResult holds the outcome of rewrapping a model's secret
+// data key.
+type RewrapSecretDataKeyResult struct {
+	ModelTag string `json:"model-tag"`
+	Error    *Error `json:"error,omitempty"`
+}
+
 // SetModelSecretBackendArg holds the arg for setting the model secret backend.
 type SetModelSecretBackendArg struct {
 	// SecretBackendName is the name of the secret backend.
@@ -3,6 +3,8 @@
 
 package params
 
+import "time"
+
 // SSHHostKeySet defines SSH host keys for one or more entities
 // (typically machines).
 type SSHHostKeySet struct {
@@ -58,3 +60,19 @@ type SSHPublicKeysResult struct {
 	Error      *Error   `json:"error,omitempty"`
 	PublicKeys []string `json:"public-keys,omitempty"`
 }
+
+// SSHRecording describes a recorded SSH session proxied by the controller.
+type SSHRecording struct {
+	UUID      string    `json:"uuid"`
+	User      string    `json:"user"`
+	Target    string    `json:"target"`
+	Size      int64     `json:"size"`
+	StartedAt time.Time `json:"started-at"`
+	EndedAt   time.Time `json:"ended-at"`
+}
+
+// SSHRecordingsResult holds the recorded SSH sessions returned by the
+// ssh-recordings HTTP endpoint.
+type SSHRecordingsResult struct {
+	Recordings []SSHRecording `json:"recordings"`
+}
@@ -283,6 +283,30 @@ type StatusHistoryResults struct {
 	Results []StatusHistoryResult `json:"results"`
 }
 
+// StatusHistoryQuery holds the arguments to query the status history of all
+// the entities in a model.
+type StatusHistoryQuery struct {
+	Kinds       []string   `json:"kinds,omitempty"`
+	Application string     `json:"application,omitempty"`
+	Statuses    []string   `json:"statuses,omitempty"`
+	From        *time.Time `json:"from,omitempty"`
+	To          *time.Time `json:"to,omitempty"`
+	Message     string     `json:"message,omitempty"`
+	Size        int        `json:"size,omitempty"`
+}
+
+// StatusHistoryEntry holds a status history entry of an entity.
+type StatusHistoryEntry struct {
+	Id     string         `json:"id"`
+	Status DetailedStatus `json:"status"`
+}
+
+// StatusHistoryQueryResult holds the entries matching a status history
+// query.
+type StatusHistoryQueryResult struct {
+	Entries []StatusHistoryEntry `json:"entries"`
+}
+
 // StatusResult holds an entity status, extra information, or an
 // error.
 type StatusResult struct {
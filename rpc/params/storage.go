@@ -277,6 +277,10 @@ type VolumeParams struct {
 	Attributes map[string]any          `json:"attributes,omitempty"`
 	Tags       map[string]string       `json:"tags,omitempty"`
 	Attachment *VolumeAttachmentParams `json:"attachment,omitempty"`
+
+	// SnapshotId is the provider id of the snapshot the volume is to be
+	// created from, if any.
+	SnapshotId string `json:"snapshot-id,omitempty"`
 }
 
 // RemoveVolumeParams holds the parameters for destroying or releasing a
@@ -956,6 +960,10 @@ type StorageAddParams struct {
 
 	// Directives are specified storage directives.
 	Directives StorageDirectives `json:"storage"`
+
+	// SnapshotId is the id of the storage snapshot to restore the new
+	// storage from, if any.
+	SnapshotId string `json:"snapshot-id,omitempty"`
 }
 
 // StoragesAddParams holds storage details to add to units dynamically.
@@ -993,6 +1001,156 @@ type RemoveStorageInstance struct {
 	MaxWait *time.Duration `json:"max-wait,omitempty"`
 }
 
+// ResizeStorageArgs holds the parameters for resizing storage instances.
+type ResizeStorageArgs struct {
+	Storage []ResizeStorageArg `json:"storage"`
+}
+
+// ResizeStorageArg holds the parameters for resizing a storage instance.
+type ResizeStorageArg struct {
+	// StorageTag is the tag of the storage instance to be resized.
+	StorageTag string `json:"storage-tag"`
+
+	// SizeMiB is the new size of the storage instance, in MiB. Storage
+	// instances can only be grown.
+	SizeMiB uint64 `json:"size-mib"`
+}
+
+// StorageSnapshotDetails holds information about a storage snapshot.
+type StorageSnapshotDetails struct {
+	// Id is the id of the snapshot.
+	Id string `json:"id"`
+
+	// StorageTag is the tag of the storage instance the snapshot is of.
+	StorageTag string `json:"storage-tag"`
+
+	// Kind is the kind of the storage instance the snapshot is of.
+	Kind StorageKind `json:"kind"`
+
+	// Pool is the name of the storage pool of the storage instance the
+	// snapshot is of.
+	Pool string `json:"pool"`
+
+	// UnitTag is the tag of the unit the storage was attached to when the
+	// snapshot was requested, if any.
+	UnitTag string `json:"unit-tag,omitempty"`
+
+	// Status is the status of the snapshot.
+	Status string `json:"status"`
+
+	// Message holds the reason a snapshot could not be taken, if any.
+	Message string `json:"message,omitempty"`
+
+	// SizeMiB is the size of the snapshotted volume, in MiB.
+	SizeMiB uint64 `json:"size,omitempty"`
+
+	// Created is the time the snapshot was requested.
+	Created time.Time `json:"created"`
+}
+
+// StorageSnapshotDetailsResults holds the details of storage snapshots.
+type StorageSnapshotDetailsResults struct {
+	Results []StorageSnapshotDetails `json:"results"`
+}
+
+// StorageSnapshotIds holds the ids of storage snapshots.
+type StorageSnapshotIds struct {
+	Ids []string `json:"ids"`
+}
+
+// StorageSnapshotParams holds the parameters for taking a storage snapshot.
+type StorageSnapshotParams struct {
+	// Id is the id of the snapshot.
+	Id string `json:"id"`
+
+	// VolumeTag is the tag of the volume to take a snapshot of.
+	VolumeTag string `json:"volume-tag"`
+
+	// VolumeId is the storage provider's unique ID for the volume.
+	VolumeId string `json:"volume-id"`
+
+	// Provider is the storage provider that manages the volume.
+	Provider string `json:"provider"`
+
+	// Tags is the set of resource tags to set on the snapshot.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// StorageSnapshotParamsResult holds the parameters for taking a storage
+// snapshot, or an error.
+type StorageSnapshotParamsResult struct {
+	Result StorageSnapshotParams `json:"result"`
+	Error  *Error                `json:"error,omitempty"`
+}
+
+// StorageSnapshotParamsResults holds the results of getting the parameters
+// for taking storage snapshots.
+type StorageSnapshotParamsResults struct {
+	Results []StorageSnapshotParamsResult `json:"results"`
+}
+
+// StorageSnapshotResult holds the outcome of taking a storage snapshot.
+type StorageSnapshotResult struct {
+	// Id is the id of the snapshot.
+	Id string `json:"id"`
+
+	// ProviderId is the storage provider's unique ID for the snapshot.
+	ProviderId string `json:"provider-id,omitempty"`
+
+	// SizeMiB is the size of the snapshotted volume, in MiB.
+	SizeMiB uint64 `json:"size,omitempty"`
+
+	// Error holds the reason the snapshot could not be taken, if any.
+	Error *Error `json:"error,omitempty"`
+}
+
+// StorageSnapshotResults holds the outcomes of taking storage snapshots.
+type StorageSnapshotResults struct {
+	Results []StorageSnapshotResult `json:"results"`
+}
+
+// UnitStorageSnapshot describes a storage snapshot that is waiting on a unit
+// to run one of its storage snapshot hooks.
+type UnitStorageSnapshot struct {
+	// Id is the id of the snapshot.
+	Id string `json:"id"`
+
+	// StorageTag is the tag of the storage instance the snapshot is of.
+	StorageTag string `json:"storage-tag"`
+
+	// Status is the status of the snapshot. A pending snapshot is waiting
+	// on the storage-snapshot-pre hook, a created or failed snapshot is
+	// waiting on the storage-snapshot-post hook.
+	Status string `json:"status"`
+}
+
+// UnitStorageSnapshotsResult holds the storage snapshots waiting on a unit,
+// or an error.
+type UnitStorageSnapshotsResult struct {
+	Snapshots []UnitStorageSnapshot `json:"snapshots,omitempty"`
+	Error     *Error                `json:"error,omitempty"`
+}
+
+// UnitStorageSnapshotsResults holds the storage snapshots waiting on units.
+type UnitStorageSnapshotsResults struct {
+	Results []UnitStorageSnapshotsResult `json:"results"`
+}
+
+// StorageSnapshotHookRunArg records that a unit has run the storage snapshot
+// hook a snapshot was waiting on.
+type StorageSnapshotHookRunArg struct {
+	// UnitTag is the tag of the unit that ran the hook.
+	UnitTag string `json:"unit-tag"`
+
+	// Id is the id of the snapshot.
+	Id string `json:"id"`
+}
+
+// StorageSnapshotHookRunArgs holds the storage snapshot hooks units have run.
+type StorageSnapshotHookRunArgs struct {
+	Args []StorageSnapshotHookRunArg `json:"args"`
+}
+
 // BulkImportStorageParams contains the parameters for importing a collection
 // of storage entities.
 type BulkImportStorageParams struct {
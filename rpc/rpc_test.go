@@ -332,7 +332,10 @@ type RestrictedMethods struct {
 }
 
 type CustomRoot struct {
-	root *Root
+	root           *Root
+	flightRecorder flightrecorder.FlightRecorder
+	// rootName is the name of the root served, "MultiVersion" if empty.
+	rootName string
 }
 
 type wrapper func(*SimpleMethods) reflect.Value
@@ -372,16 +375,38 @@ func (cc *CustomRoot) StartTrace(ctx context.Context) (context.Context, trace.Sp
 }
 
 func (cc *CustomRoot) FlightRecorder() flightrecorder.FlightRecorder {
+	if cc.flightRecorder != nil {
+		return cc.flightRecorder
+	}
 	return flightrecorder.NoopRecorder{}
 }
 
+// latencyRecorder is a flight recorder which records the latency
+// observations it is notified of.
+type latencyRecorder struct {
+	flightrecorder.NoopRecorder
+
+	mu      sync.Mutex
+	sources []flightrecorder.Source
+}
+
+func (r *latencyRecorder) ObserveLatency(source flightrecorder.Source, _ time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sources = append(r.sources, source)
+}
+
 func (cc *CustomRoot) FindMethod(
 	rootMethodName string, version int, objMethodName string,
 ) (
 	rpcreflect.MethodCaller, error,
 ) {
 	logger.Debugf(context.TODO(), "got to FindMethod: %q %d %q", rootMethodName, version, objMethodName)
-	if rootMethodName != "MultiVersion" {
+	rootName := cc.rootName
+	if rootName == "" {
+		rootName = "MultiVersion"
+	}
+	if rootMethodName != rootName {
 		return nil, &rpcreflect.CallNotImplementedError{
 			RootMethod: rootMethodName,
 		}
@@ -642,6 +667,54 @@ func (*rpcSuite) TestInterfaceMethods(c *tc.C) {
 	})
 }
 
+func (*rpcSuite) TestRequestLatencyObserved(c *tc.C) {
+	recorder := &latencyRecorder{}
+	root := &CustomRoot{root: SimpleRoot(c), flightRecorder: recorder}
+	client, _, srvDone, _ := newRPCClientServer(c, root, nil, false)
+	defer closeClient(c, client, srvDone)
+
+	var r stringVal
+	err := client.Call(c.Context(), rpc.Request{Type: "MultiVersion", Version: 1, Id: "a99", Action: "Call1r1"}, stringVal{Val: "arg"}, &r)
+	c.Assert(err, tc.ErrorIsNil)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	c.Check(recorder.sources, tc.DeepEquals, []flightrecorder.Source{flightrecorder.SourceAPILatency})
+}
+
+func (*rpcSuite) TestWatcherLatencyNotObserved(c *tc.C) {
+	recorder := &latencyRecorder{}
+	root := &CustomRoot{root: SimpleRoot(c), flightRecorder: recorder, rootName: "MultiVersionWatcher"}
+	client, _, srvDone, _ := newRPCClientServer(c, root, nil, false)
+	defer closeClient(c, client, srvDone)
+
+	var r stringVal
+	err := client.Call(c.Context(), rpc.Request{Type: "MultiVersionWatcher", Version: 1, Id: "a99", Action: "Call1r1"}, stringVal{Val: "arg"}, &r)
+	c.Assert(err, tc.ErrorIsNil)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	c.Check(recorder.sources, tc.HasLen, 0)
+}
+
+func (*rpcSuite) TestObservesLatency(c *tc.C) {
+	for _, test := range []struct {
+		request  rpc.Request
+		observed bool
+	}{
+		{rpc.Request{Type: "Client", Action: "FullStatus"}, true},
+		{rpc.Request{Type: "Uniter", Action: "WatchConfigSettingsHash"}, true},
+		{rpc.Request{Type: "NotifyWatcher", Action: "Next"}, false},
+		{rpc.Request{Type: "NotifyWatcher", Action: "Stop"}, false},
+		{rpc.Request{Type: "AllModelWatcher", Action: "Next"}, false},
+		{rpc.Request{Type: "MigrationStatusWatcher", Action: "Next"}, false},
+		{rpc.Request{Type: "LeadershipService", Action: "BlockUntilLeadershipReleased"}, false},
+		{rpc.Request{Type: "LeadershipService", Action: "ClaimLeadership"}, true},
+	} {
+		c.Check(rpc.ObservesLatency(test.request), tc.Equals, test.observed, tc.Commentf("%+v", test.request))
+	}
+}
+
 func (*rpcSuite) TestCustomRootV0(c *tc.C) {
 	root := &CustomRoot{root: SimpleRoot(c)}
 	client, _, srvDone, serverNotifier := newRPCClientServer(c, root, nil, false)
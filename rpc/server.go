@@ -682,6 +682,23 @@ func (conn *Conn) withTrace(ctx context.Context, request Request, fn func(ctx co
 	})
 }
 
+// longPollRequests holds the requests, as "Type.Action", other than those of
+// watchers, which block until there is something to return.
+var longPollRequests = map[string]bool{
+	"LeadershipService.BlockUntilLeadershipReleased": true,
+}
+
+// observesLatency reports whether the latency of the request is observed by
+// the flight recorder. Watcher Next calls and other long polls block until
+// there is a change or the request is cancelled, so their latency says
+// nothing about how the controller is performing.
+func observesLatency(request Request) bool {
+	if strings.HasSuffix(request.Type, "Watcher") || request.Action == "Next" {
+		return false
+	}
+	return !longPollRequests[request.Type+"."+request.Action]
+}
+
 func (conn *Conn) callRequest(
 	ctx context.Context,
 	req boundRequest,
@@ -689,7 +706,11 @@ func (conn *Conn) callRequest(
 	version int,
 	recorder Recorder,
 ) {
+	start := time.Now()
 	rv, err := req.Call(ctx, req.hdr.Request.Id, arg)
+	if observesLatency(req.hdr.Request) {
+		conn.getFlightRecorder().ObserveLatency(flightrecorder.SourceAPILatency, time.Since(start))
+	}
 	if err != nil {
 		if err := conn.getFlightRecorder().Capture(flightrecorder.KindError); err != nil {
 			logger.Tracef(ctx, "error capturing flight recorder: %v", err)